@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw v1.20.0. DO NOT EDIT.
 // @generated
 
@@ -28,6 +6,7 @@ package admin
 import (
 	bytes "bytes"
 	base64 "encoding/base64"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	shared "github.com/uber/cadence/.gen/go/shared"
@@ -35,6 +14,8 @@ import (
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	strconv "strconv"
 	strings "strings"
 )
 
@@ -271,12 +252,12 @@ func (v *AddSearchAttributeRequest) IsSetSearchAttribute() bool {
 	return v != nil && v.SearchAttribute != nil
 }
 
-type DescribeWorkflowExecutionRequest struct {
-	Domain    *string                   `json:"domain,omitempty"`
-	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+type CheckFailoverReadinessRequest struct {
+	Domain        *string `json:"domain,omitempty"`
+	TargetCluster *string `json:"targetCluster,omitempty"`
 }
 
-// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a CheckFailoverReadinessRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -291,7 +272,7 @@ type DescribeWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *CheckFailoverReadinessRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -307,8 +288,8 @@ func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.TargetCluster != nil {
+		w, err = wire.NewValueString(*(v.TargetCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -319,17 +300,11 @@ func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
-	var v shared.WorkflowExecution
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a CheckFailoverReadinessRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a CheckFailoverReadinessRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -337,12 +312,12 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionRequest
+//   var v CheckFailoverReadinessRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *CheckFailoverReadinessRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -358,8 +333,10 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TargetCluster = &x
 				if err != nil {
 					return err
 				}
@@ -371,9 +348,9 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// String returns a readable string representation of a CheckFailoverReadinessRequest
 // struct.
-func (v *DescribeWorkflowExecutionRequest) String() string {
+func (v *CheckFailoverReadinessRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -384,12 +361,12 @@ func (v *DescribeWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.TargetCluster != nil {
+		fields[i] = fmt.Sprintf("TargetCluster: %v", *(v.TargetCluster))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("CheckFailoverReadinessRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -402,11 +379,11 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
-// provided DescribeWorkflowExecutionRequest.
+// Equals returns true if all the fields of this CheckFailoverReadinessRequest match the
+// provided CheckFailoverReadinessRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+func (v *CheckFailoverReadinessRequest) Equals(rhs *CheckFailoverReadinessRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -415,7 +392,7 @@ func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecution
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !_String_EqualsPtr(v.TargetCluster, rhs.TargetCluster) {
 		return false
 	}
 
@@ -423,23 +400,23 @@ func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecution
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeWorkflowExecutionRequest.
-func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of CheckFailoverReadinessRequest.
+func (v *CheckFailoverReadinessRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	if v.TargetCluster != nil {
+		enc.AddString("targetCluster", *v.TargetCluster)
 	}
 	return err
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
+func (v *CheckFailoverReadinessRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -448,33 +425,60 @@ func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *DescribeWorkflowExecutionRequest) IsSetDomain() bool {
+func (v *CheckFailoverReadinessRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
-// GetExecution returns the value of Execution if it is set or its
+// GetTargetCluster returns the value of TargetCluster if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *CheckFailoverReadinessRequest) GetTargetCluster() (o string) {
+	if v != nil && v.TargetCluster != nil {
+		return *v.TargetCluster
 	}
 
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *DescribeWorkflowExecutionRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
+// IsSetTargetCluster returns true if TargetCluster is not nil.
+func (v *CheckFailoverReadinessRequest) IsSetTargetCluster() bool {
+	return v != nil && v.TargetCluster != nil
 }
 
-type DescribeWorkflowExecutionResponse struct {
-	ShardId                *string `json:"shardId,omitempty"`
-	HistoryAddr            *string `json:"historyAddr,omitempty"`
-	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
-	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+type CheckFailoverReadinessResponse struct {
+	Ready  *bool                     `json:"ready,omitempty"`
+	Checks []*FailoverReadinessCheck `json:"checks,omitempty"`
 }
 
-// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+type _List_FailoverReadinessCheck_ValueList []*FailoverReadinessCheck
+
+func (v _List_FailoverReadinessCheck_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_FailoverReadinessCheck_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_FailoverReadinessCheck_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_FailoverReadinessCheck_ValueList) Close() {}
+
+// ToWire translates a CheckFailoverReadinessResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -489,55 +493,63 @@ type DescribeWorkflowExecutionResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+func (v *CheckFailoverReadinessResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ShardId != nil {
-		w, err = wire.NewValueString(*(v.ShardId)), error(nil)
+	if v.Ready != nil {
+		w, err = wire.NewValueBool(*(v.Ready)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HistoryAddr != nil {
-		w, err = wire.NewValueString(*(v.HistoryAddr)), error(nil)
+	if v.Checks != nil {
+		w, err = wire.NewValueList(_List_FailoverReadinessCheck_ValueList(v.Checks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.MutableStateInCache != nil {
-		w, err = wire.NewValueString(*(v.MutableStateInCache)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _FailoverReadinessCheck_Read(w wire.Value) (*FailoverReadinessCheck, error) {
+	var v FailoverReadinessCheck
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_FailoverReadinessCheck_Read(l wire.ValueList) ([]*FailoverReadinessCheck, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
 	}
-	if v.MutableStateInDatabase != nil {
-		w, err = wire.NewValueString(*(v.MutableStateInDatabase)), error(nil)
+
+	o := make([]*FailoverReadinessCheck, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _FailoverReadinessCheck_Read(x)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// FromWire deserializes a CheckFailoverReadinessResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// An error is returned if we were unable to build a CheckFailoverReadinessResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -545,51 +557,29 @@ func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionResponse
+//   var v CheckFailoverReadinessResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+func (v *CheckFailoverReadinessResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ShardId = &x
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Ready = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.HistoryAddr = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MutableStateInCache = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MutableStateInDatabase = &x
+			if field.Value.Type() == wire.TList {
+				v.Checks, err = _List_FailoverReadinessCheck_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -601,152 +591,137 @@ func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionResponse
+// String returns a readable string representation of a CheckFailoverReadinessResponse
 // struct.
-func (v *DescribeWorkflowExecutionResponse) String() string {
+func (v *CheckFailoverReadinessResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
-	if v.ShardId != nil {
-		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+	if v.Ready != nil {
+		fields[i] = fmt.Sprintf("Ready: %v", *(v.Ready))
 		i++
 	}
-	if v.HistoryAddr != nil {
-		fields[i] = fmt.Sprintf("HistoryAddr: %v", *(v.HistoryAddr))
+	if v.Checks != nil {
+		fields[i] = fmt.Sprintf("Checks: %v", v.Checks)
 		i++
 	}
-	if v.MutableStateInCache != nil {
-		fields[i] = fmt.Sprintf("MutableStateInCache: %v", *(v.MutableStateInCache))
-		i++
+
+	return fmt.Sprintf("CheckFailoverReadinessResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
 	}
-	if v.MutableStateInDatabase != nil {
-		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
-		i++
+	return lhs == nil && rhs == nil
+}
+
+func _List_FailoverReadinessCheck_Equals(lhs, rhs []*FailoverReadinessCheck) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
-// provided DescribeWorkflowExecutionResponse.
+// Equals returns true if all the fields of this CheckFailoverReadinessResponse match the
+// provided CheckFailoverReadinessResponse.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+func (v *CheckFailoverReadinessResponse) Equals(rhs *CheckFailoverReadinessResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ShardId, rhs.ShardId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.HistoryAddr, rhs.HistoryAddr) {
-		return false
-	}
-	if !_String_EqualsPtr(v.MutableStateInCache, rhs.MutableStateInCache) {
+	if !_Bool_EqualsPtr(v.Ready, rhs.Ready) {
 		return false
 	}
-	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
+	if !((v.Checks == nil && rhs.Checks == nil) || (v.Checks != nil && rhs.Checks != nil && _List_FailoverReadinessCheck_Equals(v.Checks, rhs.Checks))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_FailoverReadinessCheck_Zapper []*FailoverReadinessCheck
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_FailoverReadinessCheck_Zapper.
+func (l _List_FailoverReadinessCheck_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeWorkflowExecutionResponse.
-func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of CheckFailoverReadinessResponse.
+func (v *CheckFailoverReadinessResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ShardId != nil {
-		enc.AddString("shardId", *v.ShardId)
-	}
-	if v.HistoryAddr != nil {
-		enc.AddString("historyAddr", *v.HistoryAddr)
-	}
-	if v.MutableStateInCache != nil {
-		enc.AddString("mutableStateInCache", *v.MutableStateInCache)
+	if v.Ready != nil {
+		enc.AddBool("ready", *v.Ready)
 	}
-	if v.MutableStateInDatabase != nil {
-		enc.AddString("mutableStateInDatabase", *v.MutableStateInDatabase)
+	if v.Checks != nil {
+		err = multierr.Append(err, enc.AddArray("checks", (_List_FailoverReadinessCheck_Zapper)(v.Checks)))
 	}
 	return err
 }
 
-// GetShardId returns the value of ShardId if it is set or its
-// zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetShardId() (o string) {
-	if v != nil && v.ShardId != nil {
-		return *v.ShardId
-	}
-
-	return
-}
-
-// IsSetShardId returns true if ShardId is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetShardId() bool {
-	return v != nil && v.ShardId != nil
-}
-
-// GetHistoryAddr returns the value of HistoryAddr if it is set or its
+// GetReady returns the value of Ready if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetHistoryAddr() (o string) {
-	if v != nil && v.HistoryAddr != nil {
-		return *v.HistoryAddr
+func (v *CheckFailoverReadinessResponse) GetReady() (o bool) {
+	if v != nil && v.Ready != nil {
+		return *v.Ready
 	}
 
 	return
 }
 
-// IsSetHistoryAddr returns true if HistoryAddr is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetHistoryAddr() bool {
-	return v != nil && v.HistoryAddr != nil
+// IsSetReady returns true if Ready is not nil.
+func (v *CheckFailoverReadinessResponse) IsSetReady() bool {
+	return v != nil && v.Ready != nil
 }
 
-// GetMutableStateInCache returns the value of MutableStateInCache if it is set or its
+// GetChecks returns the value of Checks if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetMutableStateInCache() (o string) {
-	if v != nil && v.MutableStateInCache != nil {
-		return *v.MutableStateInCache
+func (v *CheckFailoverReadinessResponse) GetChecks() (o []*FailoverReadinessCheck) {
+	if v != nil && v.Checks != nil {
+		return v.Checks
 	}
 
 	return
 }
 
-// IsSetMutableStateInCache returns true if MutableStateInCache is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInCache() bool {
-	return v != nil && v.MutableStateInCache != nil
+// IsSetChecks returns true if Checks is not nil.
+func (v *CheckFailoverReadinessResponse) IsSetChecks() bool {
+	return v != nil && v.Checks != nil
 }
 
-// GetMutableStateInDatabase returns the value of MutableStateInDatabase if it is set or its
-// zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o string) {
-	if v != nil && v.MutableStateInDatabase != nil {
-		return *v.MutableStateInDatabase
-	}
-
-	return
+type DeleteWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+	Force     *bool                     `json:"force,omitempty"`
+	Reason    *string                   `json:"reason,omitempty"`
+	Identity  *string                   `json:"identity,omitempty"`
 }
 
-// IsSetMutableStateInDatabase returns true if MutableStateInDatabase is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInDatabase() bool {
-	return v != nil && v.MutableStateInDatabase != nil
-}
-
-type GetWorkflowExecutionRawHistoryRequest struct {
-	Domain          *string                   `json:"domain,omitempty"`
-	Execution       *shared.WorkflowExecution `json:"execution,omitempty"`
-	FirstEventId    *int64                    `json:"firstEventId,omitempty"`
-	NextEventId     *int64                    `json:"nextEventId,omitempty"`
-	MaximumPageSize *int32                    `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                    `json:"nextPageToken,omitempty"`
-}
-
-// ToWire translates a GetWorkflowExecutionRawHistoryRequest struct into a Thrift-level intermediate
+// ToWire translates a DeleteWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -761,9 +736,9 @@ type GetWorkflowExecutionRawHistoryRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
+func (v *DeleteWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -785,47 +760,45 @@ func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+	if v.Force != nil {
+		w, err = wire.NewValueBool(*(v.Force)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetWorkflowExecutionRawHistoryRequest struct from its Thrift-level
+func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
+	var v shared.WorkflowExecution
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DeleteWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryRequest struct
+// An error is returned if we were unable to build a DeleteWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -833,12 +806,12 @@ func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryRequest
+//   var v DeleteWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
+func (v *DeleteWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -862,38 +835,30 @@ func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Force = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -905,14 +870,14 @@ func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryRequest
+// String returns a readable string representation of a DeleteWorkflowExecutionRequest
 // struct.
-func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
+func (v *DeleteWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [5]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -922,51 +887,27 @@ func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+	if v.Force != nil {
+		fields[i] = fmt.Sprintf("Force: %v", *(v.Force))
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DeleteWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryRequest match the
-// provided GetWorkflowExecutionRawHistoryRequest.
+// Equals returns true if all the fields of this DeleteWorkflowExecutionRequest match the
+// provided DeleteWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecutionRawHistoryRequest) bool {
+func (v *DeleteWorkflowExecutionRequest) Equals(rhs *DeleteWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -978,16 +919,13 @@ func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecution
 	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+	if !_Bool_EqualsPtr(v.Force, rhs.Force) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -995,8 +933,8 @@ func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecution
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryRequest.
-func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DeleteWorkflowExecutionRequest.
+func (v *DeleteWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -1006,24 +944,21 @@ func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.Obj
 	if v.Execution != nil {
 		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
+	if v.Force != nil {
+		enc.AddBool("force", *v.Force)
 	}
-	if v.MaximumPageSize != nil {
-		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
 	}
 	return err
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
+func (v *DeleteWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -1032,13 +967,13 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetDomain() bool {
+func (v *DeleteWorkflowExecutionRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
 // GetExecution returns the value of Execution if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+func (v *DeleteWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
 	if v != nil && v.Execution != nil {
 		return v.Execution
 	}
@@ -1047,145 +982,64 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.Workfl
 }
 
 // IsSetExecution returns true if Execution is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetExecution() bool {
+func (v *DeleteWorkflowExecutionRequest) IsSetExecution() bool {
 	return v != nil && v.Execution != nil
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetFirstEventId() (o int64) {
-	if v != nil && v.FirstEventId != nil {
-		return *v.FirstEventId
-	}
-
-	return
-}
-
-// IsSetFirstEventId returns true if FirstEventId is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetFirstEventId() bool {
-	return v != nil && v.FirstEventId != nil
-}
-
-// GetNextEventId returns the value of NextEventId if it is set or its
+// GetForce returns the value of Force if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+func (v *DeleteWorkflowExecutionRequest) GetForce() (o bool) {
+	if v != nil && v.Force != nil {
+		return *v.Force
 	}
 
 	return
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
+// IsSetForce returns true if Force is not nil.
+func (v *DeleteWorkflowExecutionRequest) IsSetForce() bool {
+	return v != nil && v.Force != nil
 }
 
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// GetReason returns the value of Reason if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() (o int32) {
-	if v != nil && v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
+func (v *DeleteWorkflowExecutionRequest) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
 	}
 
 	return
 }
 
-// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetMaximumPageSize() bool {
-	return v != nil && v.MaximumPageSize != nil
+// IsSetReason returns true if Reason is not nil.
+func (v *DeleteWorkflowExecutionRequest) IsSetReason() bool {
+	return v != nil && v.Reason != nil
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *DeleteWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
-}
-
-type GetWorkflowExecutionRawHistoryResponse struct {
-	NextPageToken     []byte                             `json:"nextPageToken,omitempty"`
-	HistoryBatches    []*shared.DataBlob                 `json:"historyBatches,omitempty"`
-	ReplicationInfo   map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	EventStoreVersion *int32                             `json:"eventStoreVersion,omitempty"`
-}
-
-type _List_DataBlob_ValueList []*shared.DataBlob
-
-func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_DataBlob_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_DataBlob_ValueList) ValueType() wire.Type {
-	return wire.TStruct
-}
-
-func (_List_DataBlob_ValueList) Close() {}
-
-type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
-
-func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
+// IsSetIdentity returns true if Identity is not nil.
+func (v *DeleteWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+type DescribeDynamicConfigRequest struct {
+	KeyPrefix *string              `json:"keyPrefix,omitempty"`
+	Domain    *string              `json:"domain,omitempty"`
+	TaskList  *string              `json:"taskList,omitempty"`
+	TaskType  *shared.TaskListType `json:"taskType,omitempty"`
+	ShardID   *int32               `json:"shardID,omitempty"`
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) Close() {}
-
-// ToWire translates a GetWorkflowExecutionRawHistoryResponse struct into a Thrift-level intermediate
+// ToWire translates a DescribeDynamicConfigRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1200,113 +1054,12152 @@ func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
+func (v *DescribeDynamicConfigRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.KeyPrefix != nil {
+		w, err = wire.NewValueString(*(v.KeyPrefix)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HistoryBatches != nil {
-		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+	if v.TaskList != nil {
+		w, err = wire.NewValueString(*(v.TaskList)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+	if v.TaskType != nil {
+		w, err = v.TaskType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
-	var v shared.DataBlob
+func _TaskListType_Read(w wire.Value) (shared.TaskListType, error) {
+	var v shared.TaskListType
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
+// FromWire deserializes a DescribeDynamicConfigRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeDynamicConfigRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeDynamicConfigRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeDynamicConfigRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.KeyPrefix = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskList = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeDynamicConfigRequest
+// struct.
+func (v *DescribeDynamicConfigRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.KeyPrefix != nil {
+		fields[i] = fmt.Sprintf("KeyPrefix: %v", *(v.KeyPrefix))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", *(v.TaskList))
+		i++
+	}
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeDynamicConfigRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _TaskListType_EqualsPtr(lhs, rhs *shared.TaskListType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DescribeDynamicConfigRequest match the
+// provided DescribeDynamicConfigRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeDynamicConfigRequest) Equals(rhs *DescribeDynamicConfigRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.KeyPrefix, rhs.KeyPrefix) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskList, rhs.TaskList) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeDynamicConfigRequest.
+func (v *DescribeDynamicConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.KeyPrefix != nil {
+		enc.AddString("keyPrefix", *v.KeyPrefix)
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		enc.AddString("taskList", *v.TaskList)
+	}
+	if v.TaskType != nil {
+		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	return err
+}
+
+// GetKeyPrefix returns the value of KeyPrefix if it is set or its
+// zero value if it is unset.
+func (v *DescribeDynamicConfigRequest) GetKeyPrefix() (o string) {
+	if v != nil && v.KeyPrefix != nil {
+		return *v.KeyPrefix
+	}
+
+	return
+}
+
+// IsSetKeyPrefix returns true if KeyPrefix is not nil.
+func (v *DescribeDynamicConfigRequest) IsSetKeyPrefix() bool {
+	return v != nil && v.KeyPrefix != nil
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DescribeDynamicConfigRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *DescribeDynamicConfigRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *DescribeDynamicConfigRequest) GetTaskList() (o string) {
+	if v != nil && v.TaskList != nil {
+		return *v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *DescribeDynamicConfigRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *DescribeDynamicConfigRequest) GetTaskType() (o shared.TaskListType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *DescribeDynamicConfigRequest) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *DescribeDynamicConfigRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *DescribeDynamicConfigRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+type DescribeDynamicConfigResponse struct {
+	Entries []*DynamicConfigEntry `json:"entries,omitempty"`
+}
+
+type _List_DynamicConfigEntry_ValueList []*DynamicConfigEntry
+
+func (v _List_DynamicConfigEntry_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DynamicConfigEntry_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DynamicConfigEntry_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DynamicConfigEntry_ValueList) Close() {}
+
+// ToWire translates a DescribeDynamicConfigResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeDynamicConfigResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Entries != nil {
+		w, err = wire.NewValueList(_List_DynamicConfigEntry_ValueList(v.Entries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DynamicConfigEntry_Read(w wire.Value) (*DynamicConfigEntry, error) {
+	var v DynamicConfigEntry
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DynamicConfigEntry_Read(l wire.ValueList) ([]*DynamicConfigEntry, error) {
 	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]*shared.DataBlob, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _DataBlob_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+	o := make([]*DynamicConfigEntry, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DynamicConfigEntry_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DescribeDynamicConfigResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeDynamicConfigResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeDynamicConfigResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeDynamicConfigResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Entries, err = _List_DynamicConfigEntry_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeDynamicConfigResponse
+// struct.
+func (v *DescribeDynamicConfigResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Entries != nil {
+		fields[i] = fmt.Sprintf("Entries: %v", v.Entries)
+		i++
+	}
+
+	return fmt.Sprintf("DescribeDynamicConfigResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_DynamicConfigEntry_Equals(lhs, rhs []*DynamicConfigEntry) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeDynamicConfigResponse match the
+// provided DescribeDynamicConfigResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeDynamicConfigResponse) Equals(rhs *DescribeDynamicConfigResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Entries == nil && rhs.Entries == nil) || (v.Entries != nil && rhs.Entries != nil && _List_DynamicConfigEntry_Equals(v.Entries, rhs.Entries))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_DynamicConfigEntry_Zapper []*DynamicConfigEntry
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DynamicConfigEntry_Zapper.
+func (l _List_DynamicConfigEntry_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeDynamicConfigResponse.
+func (v *DescribeDynamicConfigResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Entries != nil {
+		err = multierr.Append(err, enc.AddArray("entries", (_List_DynamicConfigEntry_Zapper)(v.Entries)))
+	}
+	return err
+}
+
+// GetEntries returns the value of Entries if it is set or its
+// zero value if it is unset.
+func (v *DescribeDynamicConfigResponse) GetEntries() (o []*DynamicConfigEntry) {
+	if v != nil && v.Entries != nil {
+		return v.Entries
+	}
+
+	return
+}
+
+// IsSetEntries returns true if Entries is not nil.
+func (v *DescribeDynamicConfigResponse) IsSetEntries() bool {
+	return v != nil && v.Entries != nil
+}
+
+type DescribeShardDistributionRequest struct {
+}
+
+// ToWire translates a DescribeShardDistributionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeShardDistributionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeShardDistributionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeShardDistributionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeShardDistributionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeShardDistributionRequest) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeShardDistributionRequest
+// struct.
+func (v *DescribeShardDistributionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("DescribeShardDistributionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeShardDistributionRequest match the
+// provided DescribeShardDistributionRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeShardDistributionRequest) Equals(rhs *DescribeShardDistributionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeShardDistributionRequest.
+func (v *DescribeShardDistributionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+type DescribeShardDistributionResponse struct {
+	NumberOfShards   *int32               `json:"numberOfShards,omitempty"`
+	Hosts            []*HistoryHostShards `json:"hosts,omitempty"`
+	MinShardsPerHost *int32               `json:"minShardsPerHost,omitempty"`
+	MaxShardsPerHost *int32               `json:"maxShardsPerHost,omitempty"`
+}
+
+type _List_HistoryHostShards_ValueList []*HistoryHostShards
+
+func (v _List_HistoryHostShards_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryHostShards_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryHostShards_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryHostShards_ValueList) Close() {}
+
+// ToWire translates a DescribeShardDistributionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeShardDistributionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NumberOfShards != nil {
+		w, err = wire.NewValueI32(*(v.NumberOfShards)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Hosts != nil {
+		w, err = wire.NewValueList(_List_HistoryHostShards_ValueList(v.Hosts)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MinShardsPerHost != nil {
+		w, err = wire.NewValueI32(*(v.MinShardsPerHost)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.MaxShardsPerHost != nil {
+		w, err = wire.NewValueI32(*(v.MaxShardsPerHost)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _HistoryHostShards_Read(w wire.Value) (*HistoryHostShards, error) {
+	var v HistoryHostShards
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryHostShards_Read(l wire.ValueList) ([]*HistoryHostShards, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*HistoryHostShards, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryHostShards_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DescribeShardDistributionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeShardDistributionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeShardDistributionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeShardDistributionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumberOfShards = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Hosts, err = _List_HistoryHostShards_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MinShardsPerHost = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxShardsPerHost = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeShardDistributionResponse
+// struct.
+func (v *DescribeShardDistributionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.NumberOfShards != nil {
+		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
+		i++
+	}
+	if v.Hosts != nil {
+		fields[i] = fmt.Sprintf("Hosts: %v", v.Hosts)
+		i++
+	}
+	if v.MinShardsPerHost != nil {
+		fields[i] = fmt.Sprintf("MinShardsPerHost: %v", *(v.MinShardsPerHost))
+		i++
+	}
+	if v.MaxShardsPerHost != nil {
+		fields[i] = fmt.Sprintf("MaxShardsPerHost: %v", *(v.MaxShardsPerHost))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeShardDistributionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_HistoryHostShards_Equals(lhs, rhs []*HistoryHostShards) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeShardDistributionResponse match the
+// provided DescribeShardDistributionResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeShardDistributionResponse) Equals(rhs *DescribeShardDistributionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
+		return false
+	}
+	if !((v.Hosts == nil && rhs.Hosts == nil) || (v.Hosts != nil && rhs.Hosts != nil && _List_HistoryHostShards_Equals(v.Hosts, rhs.Hosts))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MinShardsPerHost, rhs.MinShardsPerHost) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxShardsPerHost, rhs.MaxShardsPerHost) {
+		return false
+	}
+
+	return true
+}
+
+type _List_HistoryHostShards_Zapper []*HistoryHostShards
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_HistoryHostShards_Zapper.
+func (l _List_HistoryHostShards_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeShardDistributionResponse.
+func (v *DescribeShardDistributionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NumberOfShards != nil {
+		enc.AddInt32("numberOfShards", *v.NumberOfShards)
+	}
+	if v.Hosts != nil {
+		err = multierr.Append(err, enc.AddArray("hosts", (_List_HistoryHostShards_Zapper)(v.Hosts)))
+	}
+	if v.MinShardsPerHost != nil {
+		enc.AddInt32("minShardsPerHost", *v.MinShardsPerHost)
+	}
+	if v.MaxShardsPerHost != nil {
+		enc.AddInt32("maxShardsPerHost", *v.MaxShardsPerHost)
+	}
+	return err
+}
+
+// GetNumberOfShards returns the value of NumberOfShards if it is set or its
+// zero value if it is unset.
+func (v *DescribeShardDistributionResponse) GetNumberOfShards() (o int32) {
+	if v != nil && v.NumberOfShards != nil {
+		return *v.NumberOfShards
+	}
+
+	return
+}
+
+// IsSetNumberOfShards returns true if NumberOfShards is not nil.
+func (v *DescribeShardDistributionResponse) IsSetNumberOfShards() bool {
+	return v != nil && v.NumberOfShards != nil
+}
+
+// GetHosts returns the value of Hosts if it is set or its
+// zero value if it is unset.
+func (v *DescribeShardDistributionResponse) GetHosts() (o []*HistoryHostShards) {
+	if v != nil && v.Hosts != nil {
+		return v.Hosts
+	}
+
+	return
+}
+
+// IsSetHosts returns true if Hosts is not nil.
+func (v *DescribeShardDistributionResponse) IsSetHosts() bool {
+	return v != nil && v.Hosts != nil
+}
+
+// GetMinShardsPerHost returns the value of MinShardsPerHost if it is set or its
+// zero value if it is unset.
+func (v *DescribeShardDistributionResponse) GetMinShardsPerHost() (o int32) {
+	if v != nil && v.MinShardsPerHost != nil {
+		return *v.MinShardsPerHost
+	}
+
+	return
+}
+
+// IsSetMinShardsPerHost returns true if MinShardsPerHost is not nil.
+func (v *DescribeShardDistributionResponse) IsSetMinShardsPerHost() bool {
+	return v != nil && v.MinShardsPerHost != nil
+}
+
+// GetMaxShardsPerHost returns the value of MaxShardsPerHost if it is set or its
+// zero value if it is unset.
+func (v *DescribeShardDistributionResponse) GetMaxShardsPerHost() (o int32) {
+	if v != nil && v.MaxShardsPerHost != nil {
+		return *v.MaxShardsPerHost
+	}
+
+	return
+}
+
+// IsSetMaxShardsPerHost returns true if MaxShardsPerHost is not nil.
+func (v *DescribeShardDistributionResponse) IsSetMaxShardsPerHost() bool {
+	return v != nil && v.MaxShardsPerHost != nil
+}
+
+type DescribeWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// struct.
+func (v *DescribeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
+// provided DescribeWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeWorkflowExecutionRequest.
+func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *DescribeWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *DescribeWorkflowExecutionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type DescribeWorkflowExecutionResponse struct {
+	ShardId                *string `json:"shardId,omitempty"`
+	HistoryAddr            *string `json:"historyAddr,omitempty"`
+	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
+	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueString(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryAddr != nil {
+		w, err = wire.NewValueString(*(v.HistoryAddr)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MutableStateInCache != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInCache)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MutableStateInDatabase != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInDatabase)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.HistoryAddr = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MutableStateInCache = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MutableStateInDatabase = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeWorkflowExecutionResponse
+// struct.
+func (v *DescribeWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+	if v.HistoryAddr != nil {
+		fields[i] = fmt.Sprintf("HistoryAddr: %v", *(v.HistoryAddr))
+		i++
+	}
+	if v.MutableStateInCache != nil {
+		fields[i] = fmt.Sprintf("MutableStateInCache: %v", *(v.MutableStateInCache))
+		i++
+	}
+	if v.MutableStateInDatabase != nil {
+		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
+// provided DescribeWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.HistoryAddr, rhs.HistoryAddr) {
+		return false
+	}
+	if !_String_EqualsPtr(v.MutableStateInCache, rhs.MutableStateInCache) {
+		return false
+	}
+	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeWorkflowExecutionResponse.
+func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardId != nil {
+		enc.AddString("shardId", *v.ShardId)
+	}
+	if v.HistoryAddr != nil {
+		enc.AddString("historyAddr", *v.HistoryAddr)
+	}
+	if v.MutableStateInCache != nil {
+		enc.AddString("mutableStateInCache", *v.MutableStateInCache)
+	}
+	if v.MutableStateInDatabase != nil {
+		enc.AddString("mutableStateInDatabase", *v.MutableStateInDatabase)
+	}
+	return err
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetShardId() (o string) {
+	if v != nil && v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// IsSetShardId returns true if ShardId is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetShardId() bool {
+	return v != nil && v.ShardId != nil
+}
+
+// GetHistoryAddr returns the value of HistoryAddr if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetHistoryAddr() (o string) {
+	if v != nil && v.HistoryAddr != nil {
+		return *v.HistoryAddr
+	}
+
+	return
+}
+
+// IsSetHistoryAddr returns true if HistoryAddr is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetHistoryAddr() bool {
+	return v != nil && v.HistoryAddr != nil
+}
+
+// GetMutableStateInCache returns the value of MutableStateInCache if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetMutableStateInCache() (o string) {
+	if v != nil && v.MutableStateInCache != nil {
+		return *v.MutableStateInCache
+	}
+
+	return
+}
+
+// IsSetMutableStateInCache returns true if MutableStateInCache is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInCache() bool {
+	return v != nil && v.MutableStateInCache != nil
+}
+
+// GetMutableStateInDatabase returns the value of MutableStateInDatabase if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o string) {
+	if v != nil && v.MutableStateInDatabase != nil {
+		return *v.MutableStateInDatabase
+	}
+
+	return
+}
+
+// IsSetMutableStateInDatabase returns true if MutableStateInDatabase is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInDatabase() bool {
+	return v != nil && v.MutableStateInDatabase != nil
+}
+
+type DynamicConfigEntry struct {
+	Name         *string                  `json:"name,omitempty"`
+	ValueType    *string                  `json:"valueType,omitempty"`
+	DefaultValue *string                  `json:"defaultValue,omitempty"`
+	Value        *string                  `json:"value,omitempty"`
+	IsOverridden *bool                    `json:"isOverridden,omitempty"`
+	Filters      []string                 `json:"filters,omitempty"`
+	Overrides    []*DynamicConfigOverride `json:"overrides,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _List_DynamicConfigOverride_ValueList []*DynamicConfigOverride
+
+func (v _List_DynamicConfigOverride_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DynamicConfigOverride_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DynamicConfigOverride_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DynamicConfigOverride_ValueList) Close() {}
+
+// ToWire translates a DynamicConfigEntry struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DynamicConfigEntry) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ValueType != nil {
+		w, err = wire.NewValueString(*(v.ValueType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.DefaultValue != nil {
+		w, err = wire.NewValueString(*(v.DefaultValue)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Value != nil {
+		w, err = wire.NewValueString(*(v.Value)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.IsOverridden != nil {
+		w, err = wire.NewValueBool(*(v.IsOverridden)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Filters != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Filters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Overrides != nil {
+		w, err = wire.NewValueList(_List_DynamicConfigOverride_ValueList(v.Overrides)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _DynamicConfigOverride_Read(w wire.Value) (*DynamicConfigOverride, error) {
+	var v DynamicConfigOverride
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DynamicConfigOverride_Read(l wire.ValueList) ([]*DynamicConfigOverride, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*DynamicConfigOverride, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DynamicConfigOverride_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DynamicConfigEntry struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DynamicConfigEntry struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DynamicConfigEntry
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DynamicConfigEntry) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ValueType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DefaultValue = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Value = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsOverridden = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.Filters, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TList {
+				v.Overrides, err = _List_DynamicConfigOverride_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DynamicConfigEntry
+// struct.
+func (v *DynamicConfigEntry) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.ValueType != nil {
+		fields[i] = fmt.Sprintf("ValueType: %v", *(v.ValueType))
+		i++
+	}
+	if v.DefaultValue != nil {
+		fields[i] = fmt.Sprintf("DefaultValue: %v", *(v.DefaultValue))
+		i++
+	}
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+		i++
+	}
+	if v.IsOverridden != nil {
+		fields[i] = fmt.Sprintf("IsOverridden: %v", *(v.IsOverridden))
+		i++
+	}
+	if v.Filters != nil {
+		fields[i] = fmt.Sprintf("Filters: %v", v.Filters)
+		i++
+	}
+	if v.Overrides != nil {
+		fields[i] = fmt.Sprintf("Overrides: %v", v.Overrides)
+		i++
+	}
+
+	return fmt.Sprintf("DynamicConfigEntry{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_DynamicConfigOverride_Equals(lhs, rhs []*DynamicConfigOverride) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DynamicConfigEntry match the
+// provided DynamicConfigEntry.
+//
+// This function performs a deep comparison.
+func (v *DynamicConfigEntry) Equals(rhs *DynamicConfigEntry) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ValueType, rhs.ValueType) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DefaultValue, rhs.DefaultValue) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Value, rhs.Value) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsOverridden, rhs.IsOverridden) {
+		return false
+	}
+	if !((v.Filters == nil && rhs.Filters == nil) || (v.Filters != nil && rhs.Filters != nil && _List_String_Equals(v.Filters, rhs.Filters))) {
+		return false
+	}
+	if !((v.Overrides == nil && rhs.Overrides == nil) || (v.Overrides != nil && rhs.Overrides != nil && _List_DynamicConfigOverride_Equals(v.Overrides, rhs.Overrides))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+type _List_DynamicConfigOverride_Zapper []*DynamicConfigOverride
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DynamicConfigOverride_Zapper.
+func (l _List_DynamicConfigOverride_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DynamicConfigEntry.
+func (v *DynamicConfigEntry) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.ValueType != nil {
+		enc.AddString("valueType", *v.ValueType)
+	}
+	if v.DefaultValue != nil {
+		enc.AddString("defaultValue", *v.DefaultValue)
+	}
+	if v.Value != nil {
+		enc.AddString("value", *v.Value)
+	}
+	if v.IsOverridden != nil {
+		enc.AddBool("isOverridden", *v.IsOverridden)
+	}
+	if v.Filters != nil {
+		err = multierr.Append(err, enc.AddArray("filters", (_List_String_Zapper)(v.Filters)))
+	}
+	if v.Overrides != nil {
+		err = multierr.Append(err, enc.AddArray("overrides", (_List_DynamicConfigOverride_Zapper)(v.Overrides)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigEntry) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *DynamicConfigEntry) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetValueType returns the value of ValueType if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigEntry) GetValueType() (o string) {
+	if v != nil && v.ValueType != nil {
+		return *v.ValueType
+	}
+
+	return
+}
+
+// IsSetValueType returns true if ValueType is not nil.
+func (v *DynamicConfigEntry) IsSetValueType() bool {
+	return v != nil && v.ValueType != nil
+}
+
+// GetDefaultValue returns the value of DefaultValue if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigEntry) GetDefaultValue() (o string) {
+	if v != nil && v.DefaultValue != nil {
+		return *v.DefaultValue
+	}
+
+	return
+}
+
+// IsSetDefaultValue returns true if DefaultValue is not nil.
+func (v *DynamicConfigEntry) IsSetDefaultValue() bool {
+	return v != nil && v.DefaultValue != nil
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigEntry) GetValue() (o string) {
+	if v != nil && v.Value != nil {
+		return *v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *DynamicConfigEntry) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// GetIsOverridden returns the value of IsOverridden if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigEntry) GetIsOverridden() (o bool) {
+	if v != nil && v.IsOverridden != nil {
+		return *v.IsOverridden
+	}
+
+	return
+}
+
+// IsSetIsOverridden returns true if IsOverridden is not nil.
+func (v *DynamicConfigEntry) IsSetIsOverridden() bool {
+	return v != nil && v.IsOverridden != nil
+}
+
+// GetFilters returns the value of Filters if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigEntry) GetFilters() (o []string) {
+	if v != nil && v.Filters != nil {
+		return v.Filters
+	}
+
+	return
+}
+
+// IsSetFilters returns true if Filters is not nil.
+func (v *DynamicConfigEntry) IsSetFilters() bool {
+	return v != nil && v.Filters != nil
+}
+
+// GetOverrides returns the value of Overrides if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigEntry) GetOverrides() (o []*DynamicConfigOverride) {
+	if v != nil && v.Overrides != nil {
+		return v.Overrides
+	}
+
+	return
+}
+
+// IsSetOverrides returns true if Overrides is not nil.
+func (v *DynamicConfigEntry) IsSetOverrides() bool {
+	return v != nil && v.Overrides != nil
+}
+
+type DynamicConfigOverride struct {
+	Value       *string           `json:"value,omitempty"`
+	Constraints map[string]string `json:"constraints,omitempty"`
+}
+
+type _Map_String_String_MapItemList map[string]string
+
+func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) Close() {}
+
+// ToWire translates a DynamicConfigOverride struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DynamicConfigOverride) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Value != nil {
+		w, err = wire.NewValueString(*(v.Value)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Constraints != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Constraints)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a DynamicConfigOverride struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DynamicConfigOverride struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DynamicConfigOverride
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DynamicConfigOverride) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Value = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TMap {
+				v.Constraints, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DynamicConfigOverride
+// struct.
+func (v *DynamicConfigOverride) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+		i++
+	}
+	if v.Constraints != nil {
+		fields[i] = fmt.Sprintf("Constraints: %v", v.Constraints)
+		i++
+	}
+
+	return fmt.Sprintf("DynamicConfigOverride{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this DynamicConfigOverride match the
+// provided DynamicConfigOverride.
+//
+// This function performs a deep comparison.
+func (v *DynamicConfigOverride) Equals(rhs *DynamicConfigOverride) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Value, rhs.Value) {
+		return false
+	}
+	if !((v.Constraints == nil && rhs.Constraints == nil) || (v.Constraints != nil && rhs.Constraints != nil && _Map_String_String_Equals(v.Constraints, rhs.Constraints))) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DynamicConfigOverride.
+func (v *DynamicConfigOverride) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		enc.AddString("value", *v.Value)
+	}
+	if v.Constraints != nil {
+		err = multierr.Append(err, enc.AddObject("constraints", (_Map_String_String_Zapper)(v.Constraints)))
+	}
+	return err
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigOverride) GetValue() (o string) {
+	if v != nil && v.Value != nil {
+		return *v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *DynamicConfigOverride) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// GetConstraints returns the value of Constraints if it is set or its
+// zero value if it is unset.
+func (v *DynamicConfigOverride) GetConstraints() (o map[string]string) {
+	if v != nil && v.Constraints != nil {
+		return v.Constraints
+	}
+
+	return
+}
+
+// IsSetConstraints returns true if Constraints is not nil.
+func (v *DynamicConfigOverride) IsSetConstraints() bool {
+	return v != nil && v.Constraints != nil
+}
+
+type FailoverReadinessCheck struct {
+	Name    *string                       `json:"name,omitempty"`
+	Status  *FailoverReadinessCheckStatus `json:"status,omitempty"`
+	Details *string                       `json:"details,omitempty"`
+}
+
+// ToWire translates a FailoverReadinessCheck struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *FailoverReadinessCheck) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Status != nil {
+		w, err = v.Status.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueString(*(v.Details)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _FailoverReadinessCheckStatus_Read(w wire.Value) (FailoverReadinessCheckStatus, error) {
+	var v FailoverReadinessCheckStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a FailoverReadinessCheck struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a FailoverReadinessCheck struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v FailoverReadinessCheck
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *FailoverReadinessCheck) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x FailoverReadinessCheckStatus
+				x, err = _FailoverReadinessCheckStatus_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Details = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a FailoverReadinessCheck
+// struct.
+func (v *FailoverReadinessCheck) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", *(v.Details))
+		i++
+	}
+
+	return fmt.Sprintf("FailoverReadinessCheck{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _FailoverReadinessCheckStatus_EqualsPtr(lhs, rhs *FailoverReadinessCheckStatus) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this FailoverReadinessCheck match the
+// provided FailoverReadinessCheck.
+//
+// This function performs a deep comparison.
+func (v *FailoverReadinessCheck) Equals(rhs *FailoverReadinessCheck) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_FailoverReadinessCheckStatus_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Details, rhs.Details) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of FailoverReadinessCheck.
+func (v *FailoverReadinessCheck) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Status != nil {
+		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	}
+	if v.Details != nil {
+		enc.AddString("details", *v.Details)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *FailoverReadinessCheck) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *FailoverReadinessCheck) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetStatus returns the value of Status if it is set or its
+// zero value if it is unset.
+func (v *FailoverReadinessCheck) GetStatus() (o FailoverReadinessCheckStatus) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *FailoverReadinessCheck) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *FailoverReadinessCheck) GetDetails() (o string) {
+	if v != nil && v.Details != nil {
+		return *v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *FailoverReadinessCheck) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
+type FailoverReadinessCheckStatus int32
+
+const (
+	FailoverReadinessCheckStatusPassed     FailoverReadinessCheckStatus = 0
+	FailoverReadinessCheckStatusFailed     FailoverReadinessCheckStatus = 1
+	FailoverReadinessCheckStatusUnverified FailoverReadinessCheckStatus = 2
+)
+
+// FailoverReadinessCheckStatus_Values returns all recognized values of FailoverReadinessCheckStatus.
+func FailoverReadinessCheckStatus_Values() []FailoverReadinessCheckStatus {
+	return []FailoverReadinessCheckStatus{
+		FailoverReadinessCheckStatusPassed,
+		FailoverReadinessCheckStatusFailed,
+		FailoverReadinessCheckStatusUnverified,
+	}
+}
+
+// UnmarshalText tries to decode FailoverReadinessCheckStatus from a byte slice
+// containing its name.
+//
+//   var v FailoverReadinessCheckStatus
+//   err := v.UnmarshalText([]byte("PASSED"))
+func (v *FailoverReadinessCheckStatus) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "PASSED":
+		*v = FailoverReadinessCheckStatusPassed
+		return nil
+	case "FAILED":
+		*v = FailoverReadinessCheckStatusFailed
+		return nil
+	case "UNVERIFIED":
+		*v = FailoverReadinessCheckStatusUnverified
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "FailoverReadinessCheckStatus", err)
+		}
+		*v = FailoverReadinessCheckStatus(val)
+		return nil
+	}
+}
+
+// MarshalText encodes FailoverReadinessCheckStatus to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v FailoverReadinessCheckStatus) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("PASSED"), nil
+	case 1:
+		return []byte("FAILED"), nil
+	case 2:
+		return []byte("UNVERIFIED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of FailoverReadinessCheckStatus.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v FailoverReadinessCheckStatus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "PASSED")
+	case 1:
+		enc.AddString("name", "FAILED")
+	case 2:
+		enc.AddString("name", "UNVERIFIED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v FailoverReadinessCheckStatus) Ptr() *FailoverReadinessCheckStatus {
+	return &v
+}
+
+// ToWire translates FailoverReadinessCheckStatus into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v FailoverReadinessCheckStatus) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes FailoverReadinessCheckStatus from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return FailoverReadinessCheckStatus(0), err
+//   }
+//
+//   var v FailoverReadinessCheckStatus
+//   if err := v.FromWire(x); err != nil {
+//     return FailoverReadinessCheckStatus(0), err
+//   }
+//   return v, nil
+func (v *FailoverReadinessCheckStatus) FromWire(w wire.Value) error {
+	*v = (FailoverReadinessCheckStatus)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of FailoverReadinessCheckStatus.
+func (v FailoverReadinessCheckStatus) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "PASSED"
+	case 1:
+		return "FAILED"
+	case 2:
+		return "UNVERIFIED"
+	}
+	return fmt.Sprintf("FailoverReadinessCheckStatus(%d)", w)
+}
+
+// Equals returns true if this FailoverReadinessCheckStatus value matches the provided
+// value.
+func (v FailoverReadinessCheckStatus) Equals(rhs FailoverReadinessCheckStatus) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes FailoverReadinessCheckStatus into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v FailoverReadinessCheckStatus) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"PASSED\""), nil
+	case 1:
+		return ([]byte)("\"FAILED\""), nil
+	case 2:
+		return ([]byte)("\"UNVERIFIED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode FailoverReadinessCheckStatus from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *FailoverReadinessCheckStatus) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "FailoverReadinessCheckStatus")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "FailoverReadinessCheckStatus")
+		}
+		*v = (FailoverReadinessCheckStatus)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "FailoverReadinessCheckStatus")
+	}
+}
+
+type GetWorkflowExecutionRawHistoryRequest struct {
+	Domain          *string                   `json:"domain,omitempty"`
+	Execution       *shared.WorkflowExecution `json:"execution,omitempty"`
+	FirstEventId    *int64                    `json:"firstEventId,omitempty"`
+	NextEventId     *int64                    `json:"nextEventId,omitempty"`
+	MaximumPageSize *int32                    `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                    `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionRawHistoryRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryRequest
+// struct.
+func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryRequest match the
+// provided GetWorkflowExecutionRawHistoryRequest.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecutionRawHistoryRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryRequest.
+func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type GetWorkflowExecutionRawHistoryResponse struct {
+	NextPageToken     []byte                             `json:"nextPageToken,omitempty"`
+	HistoryBatches    []*shared.DataBlob                 `json:"historyBatches,omitempty"`
+	ReplicationInfo   map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	EventStoreVersion *int32                             `json:"eventStoreVersion,omitempty"`
+}
+
+type _List_DataBlob_ValueList []*shared.DataBlob
+
+func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DataBlob_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DataBlob_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DataBlob_ValueList) Close() {}
+
+type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+
+func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryBatches != nil {
+		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.DataBlob, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DataBlob_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
+	var v shared.ReplicationInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.ReplicationInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionRawHistoryResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryResponse
+// struct.
+func (v *GetWorkflowExecutionRawHistoryResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.HistoryBatches != nil {
+		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryResponse match the
+// provided GetWorkflowExecutionRawHistoryResponse.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+
+	return true
+}
+
+type _List_DataBlob_Zapper []*shared.DataBlob
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DataBlob_Zapper.
+func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ReplicationInfo_Zapper.
+func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryResponse.
+func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.HistoryBatches != nil {
+		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	return err
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
+	if v != nil && v.HistoryBatches != nil {
+		return v.HistoryBatches
+	}
+
+	return
+}
+
+// IsSetHistoryBatches returns true if HistoryBatches is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetHistoryBatches() bool {
+	return v != nil && v.HistoryBatches != nil
+}
+
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v != nil && v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
+}
+
+// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetReplicationInfo() bool {
+	return v != nil && v.ReplicationInfo != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+type HistoryHostShards struct {
+	Address  *string `json:"address,omitempty"`
+	ShardIDs []int32 `json:"shardIDs,omitempty"`
+}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+// ToWire translates a HistoryHostShards struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryHostShards) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Address != nil {
+		w, err = wire.NewValueString(*(v.Address)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a HistoryHostShards struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryHostShards struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryHostShards
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryHostShards) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Address = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryHostShards
+// struct.
+func (v *HistoryHostShards) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
+		i++
+	}
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
+		i++
+	}
+
+	return fmt.Sprintf("HistoryHostShards{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this HistoryHostShards match the
+// provided HistoryHostShards.
+//
+// This function performs a deep comparison.
+func (v *HistoryHostShards) Equals(rhs *HistoryHostShards) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Address, rhs.Address) {
+		return false
+	}
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryHostShards.
+func (v *HistoryHostShards) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Address != nil {
+		enc.AddString("address", *v.Address)
+	}
+	if v.ShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("shardIDs", (_List_I32_Zapper)(v.ShardIDs)))
+	}
+	return err
+}
+
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *HistoryHostShards) GetAddress() (o string) {
+	if v != nil && v.Address != nil {
+		return *v.Address
+	}
+
+	return
+}
+
+// IsSetAddress returns true if Address is not nil.
+func (v *HistoryHostShards) IsSetAddress() bool {
+	return v != nil && v.Address != nil
+}
+
+// GetShardIDs returns the value of ShardIDs if it is set or its
+// zero value if it is unset.
+func (v *HistoryHostShards) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
+	}
+
+	return
+}
+
+// IsSetShardIDs returns true if ShardIDs is not nil.
+func (v *HistoryHostShards) IsSetShardIDs() bool {
+	return v != nil && v.ShardIDs != nil
+}
+
+type ListWorkersRequest struct {
+	Domain   *string          `json:"domain,omitempty"`
+	TaskList *shared.TaskList `json:"taskList,omitempty"`
+}
+
+// ToWire translates a ListWorkersRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListWorkersRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
+	var v shared.TaskList
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ListWorkersRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListWorkersRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListWorkersRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListWorkersRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListWorkersRequest
+// struct.
+func (v *ListWorkersRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+
+	return fmt.Sprintf("ListWorkersRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListWorkersRequest match the
+// provided ListWorkersRequest.
+//
+// This function performs a deep comparison.
+func (v *ListWorkersRequest) Equals(rhs *ListWorkersRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListWorkersRequest.
+func (v *ListWorkersRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ListWorkersRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ListWorkersRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *ListWorkersRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *ListWorkersRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+type ListWorkersResponse struct {
+	Workers          []*shared.WorkerInfo `json:"workers,omitempty"`
+	OnlyStaleWorkers *bool                `json:"onlyStaleWorkers,omitempty"`
+}
+
+type _List_WorkerInfo_ValueList []*shared.WorkerInfo
+
+func (v _List_WorkerInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_WorkerInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_WorkerInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkerInfo_ValueList) Close() {}
+
+// ToWire translates a ListWorkersResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListWorkersResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Workers != nil {
+		w, err = wire.NewValueList(_List_WorkerInfo_ValueList(v.Workers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.OnlyStaleWorkers != nil {
+		w, err = wire.NewValueBool(*(v.OnlyStaleWorkers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkerInfo_Read(w wire.Value) (*shared.WorkerInfo, error) {
+	var v shared.WorkerInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkerInfo_Read(l wire.ValueList) ([]*shared.WorkerInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.WorkerInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkerInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListWorkersResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListWorkersResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListWorkersResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListWorkersResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Workers, err = _List_WorkerInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.OnlyStaleWorkers = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListWorkersResponse
+// struct.
+func (v *ListWorkersResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Workers != nil {
+		fields[i] = fmt.Sprintf("Workers: %v", v.Workers)
+		i++
+	}
+	if v.OnlyStaleWorkers != nil {
+		fields[i] = fmt.Sprintf("OnlyStaleWorkers: %v", *(v.OnlyStaleWorkers))
+		i++
+	}
+
+	return fmt.Sprintf("ListWorkersResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_WorkerInfo_Equals(lhs, rhs []*shared.WorkerInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListWorkersResponse match the
+// provided ListWorkersResponse.
+//
+// This function performs a deep comparison.
+func (v *ListWorkersResponse) Equals(rhs *ListWorkersResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Workers == nil && rhs.Workers == nil) || (v.Workers != nil && rhs.Workers != nil && _List_WorkerInfo_Equals(v.Workers, rhs.Workers))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.OnlyStaleWorkers, rhs.OnlyStaleWorkers) {
+		return false
+	}
+
+	return true
+}
+
+type _List_WorkerInfo_Zapper []*shared.WorkerInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkerInfo_Zapper.
+func (l _List_WorkerInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListWorkersResponse.
+func (v *ListWorkersResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Workers != nil {
+		err = multierr.Append(err, enc.AddArray("workers", (_List_WorkerInfo_Zapper)(v.Workers)))
+	}
+	if v.OnlyStaleWorkers != nil {
+		enc.AddBool("onlyStaleWorkers", *v.OnlyStaleWorkers)
+	}
+	return err
+}
+
+// GetWorkers returns the value of Workers if it is set or its
+// zero value if it is unset.
+func (v *ListWorkersResponse) GetWorkers() (o []*shared.WorkerInfo) {
+	if v != nil && v.Workers != nil {
+		return v.Workers
+	}
+
+	return
+}
+
+// IsSetWorkers returns true if Workers is not nil.
+func (v *ListWorkersResponse) IsSetWorkers() bool {
+	return v != nil && v.Workers != nil
+}
+
+// GetOnlyStaleWorkers returns the value of OnlyStaleWorkers if it is set or its
+// zero value if it is unset.
+func (v *ListWorkersResponse) GetOnlyStaleWorkers() (o bool) {
+	if v != nil && v.OnlyStaleWorkers != nil {
+		return *v.OnlyStaleWorkers
+	}
+
+	return
+}
+
+// IsSetOnlyStaleWorkers returns true if OnlyStaleWorkers is not nil.
+func (v *ListWorkersResponse) IsSetOnlyStaleWorkers() bool {
+	return v != nil && v.OnlyStaleWorkers != nil
+}
+
+type MigrateDomainRequest struct {
+	Domain        *string `json:"domain,omitempty"`
+	TargetCluster *string `json:"targetCluster,omitempty"`
+	Identity      *string `json:"identity,omitempty"`
+}
+
+// ToWire translates a MigrateDomainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MigrateDomainRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TargetCluster != nil {
+		w, err = wire.NewValueString(*(v.TargetCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MigrateDomainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MigrateDomainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MigrateDomainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MigrateDomainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TargetCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MigrateDomainRequest
+// struct.
+func (v *MigrateDomainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TargetCluster != nil {
+		fields[i] = fmt.Sprintf("TargetCluster: %v", *(v.TargetCluster))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("MigrateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MigrateDomainRequest match the
+// provided MigrateDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *MigrateDomainRequest) Equals(rhs *MigrateDomainRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TargetCluster, rhs.TargetCluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MigrateDomainRequest.
+func (v *MigrateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TargetCluster != nil {
+		enc.AddString("targetCluster", *v.TargetCluster)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *MigrateDomainRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *MigrateDomainRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTargetCluster returns the value of TargetCluster if it is set or its
+// zero value if it is unset.
+func (v *MigrateDomainRequest) GetTargetCluster() (o string) {
+	if v != nil && v.TargetCluster != nil {
+		return *v.TargetCluster
+	}
+
+	return
+}
+
+// IsSetTargetCluster returns true if TargetCluster is not nil.
+func (v *MigrateDomainRequest) IsSetTargetCluster() bool {
+	return v != nil && v.TargetCluster != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *MigrateDomainRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *MigrateDomainRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type MigrateDomainResponse struct {
+	WorkflowId *string `json:"workflowId,omitempty"`
+	RunId      *string `json:"runId,omitempty"`
+}
+
+// ToWire translates a MigrateDomainResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MigrateDomainResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MigrateDomainResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MigrateDomainResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MigrateDomainResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MigrateDomainResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MigrateDomainResponse
+// struct.
+func (v *MigrateDomainResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+
+	return fmt.Sprintf("MigrateDomainResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MigrateDomainResponse match the
+// provided MigrateDomainResponse.
+//
+// This function performs a deep comparison.
+func (v *MigrateDomainResponse) Equals(rhs *MigrateDomainResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MigrateDomainResponse.
+func (v *MigrateDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	return err
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *MigrateDomainResponse) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *MigrateDomainResponse) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *MigrateDomainResponse) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *MigrateDomainResponse) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+type ReindexWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a ReindexWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReindexWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReindexWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReindexWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReindexWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReindexWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReindexWorkflowExecutionRequest
+// struct.
+func (v *ReindexWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("ReindexWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReindexWorkflowExecutionRequest match the
+// provided ReindexWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *ReindexWorkflowExecutionRequest) Equals(rhs *ReindexWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReindexWorkflowExecutionRequest.
+func (v *ReindexWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ReindexWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ReindexWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *ReindexWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *ReindexWorkflowExecutionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type SetTerminationProtectionRequest struct {
+	Domain               *string                   `json:"domain,omitempty"`
+	Execution            *shared.WorkflowExecution `json:"execution,omitempty"`
+	TerminationProtected *bool                     `json:"terminationProtected,omitempty"`
+	Identity             *string                   `json:"identity,omitempty"`
+}
+
+// ToWire translates a SetTerminationProtectionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SetTerminationProtectionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TerminationProtected != nil {
+		w, err = wire.NewValueBool(*(v.TerminationProtected)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SetTerminationProtectionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SetTerminationProtectionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SetTerminationProtectionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SetTerminationProtectionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.TerminationProtected = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SetTerminationProtectionRequest
+// struct.
+func (v *SetTerminationProtectionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.TerminationProtected != nil {
+		fields[i] = fmt.Sprintf("TerminationProtected: %v", *(v.TerminationProtected))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+
+	return fmt.Sprintf("SetTerminationProtectionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SetTerminationProtectionRequest match the
+// provided SetTerminationProtectionRequest.
+//
+// This function performs a deep comparison.
+func (v *SetTerminationProtectionRequest) Equals(rhs *SetTerminationProtectionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.TerminationProtected, rhs.TerminationProtected) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SetTerminationProtectionRequest.
+func (v *SetTerminationProtectionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.TerminationProtected != nil {
+		enc.AddBool("terminationProtected", *v.TerminationProtected)
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *SetTerminationProtectionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *SetTerminationProtectionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *SetTerminationProtectionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *SetTerminationProtectionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetTerminationProtected returns the value of TerminationProtected if it is set or its
+// zero value if it is unset.
+func (v *SetTerminationProtectionRequest) GetTerminationProtected() (o bool) {
+	if v != nil && v.TerminationProtected != nil {
+		return *v.TerminationProtected
+	}
+
+	return
+}
+
+// IsSetTerminationProtected returns true if TerminationProtected is not nil.
+func (v *SetTerminationProtectionRequest) IsSetTerminationProtected() bool {
+	return v != nil && v.TerminationProtected != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *SetTerminationProtectionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *SetTerminationProtectionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+type UndeprecateDomainRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ToWire translates a UndeprecateDomainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UndeprecateDomainRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UndeprecateDomainRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UndeprecateDomainRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UndeprecateDomainRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UndeprecateDomainRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UndeprecateDomainRequest
+// struct.
+func (v *UndeprecateDomainRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+
+	return fmt.Sprintf("UndeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UndeprecateDomainRequest match the
+// provided UndeprecateDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *UndeprecateDomainRequest) Equals(rhs *UndeprecateDomainRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UndeprecateDomainRequest.
+func (v *UndeprecateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *UndeprecateDomainRequest) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+func (v *UndeprecateDomainRequest) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "admin",
+	Package:  "github.com/uber/cadence/.gen/go/admin",
+	FilePath: "admin.thrift",
+	SHA1:     "c0da12a5352849bea0cad41f30a618e603e93343",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * DescribeQueue returns the ack levels of a transfer, timer or replication queue of a shard\n  **/\n  shared.DescribeQueueResponse DescribeQueue(1: shared.DescribeQueueRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * ResetQueueState moves the ack level of a queue of a shard, so that the tasks after the new ack level are\n  * processed again by the queue processor. Moving the ack level forward skips tasks and requires force.\n  **/\n  void ResetQueueState(1: shared.ResetQueueStateRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddOperatorAnnotation records an operator note on a running workflow execution. The note is kept in the\n  * OperatorAnnotations memo field of the execution rather than in its history, so workflow replay is not affected.\n  **/\n  void AddOperatorAnnotation(1: shared.AddOperatorAnnotationRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n    )\n\n  /**\n  * CheckFailoverReadiness verifies whether a domain can be safely failed over to the target cluster.\n  **/\n  CheckFailoverReadinessResponse CheckFailoverReadiness(1: CheckFailoverReadinessRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeShardDistribution returns the history hosts owning each history shard of the cluster.\n  **/\n  DescribeShardDistributionResponse DescribeShardDistribution(1: DescribeShardDistributionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * DeleteWorkflowExecution removes the mutable state, current execution record, history and visibility records\n  * of a workflow execution. Only closed executions are deleted unless force is set, in which case running or\n  * corrupted executions are deleted as well and failures of individual steps are skipped.\n  **/\n  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ReindexWorkflowExecution re-sends the start fields, memo and search attributes of a running workflow\n  * execution to visibility, used to backfill executions started before advanced visibility was enabled.\n  **/\n  void ReindexWorkflowExecution(1: ReindexWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * UndeprecateDomain reverts a DeprecateDomain call, updating the status of a deprecated domain back to REGISTERED\n  * so that new workflow executions can be started in it again.\n  **/\n  void UndeprecateDomain(1: UndeprecateDomainRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * SetTerminationProtection sets or clears the termination protected flag of a running workflow execution.\n  * A termination protected workflow execution can only be terminated with the force flag.\n  **/\n  void SetTerminationProtection(1: SetTerminationProtectionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * ListWorkers returns the workers which polled the decision and activity tasklists of the given name recently,\n  * with their client versions and last poll times. A worker is stale if it has not polled for a while.\n  **/\n  ListWorkersResponse ListWorkers(1: ListWorkersRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeDynamicConfig returns the dynamic config keys with their types and the values applied for the given\n  * domain, tasklist, task type and shard. Default values are only returned for the keys read by the services of\n  * the frontend host serving the request.\n  **/\n  DescribeDynamicConfigResponse DescribeDynamicConfig(1: DescribeDynamicConfigRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * MigrateDomain starts the system workflow which moves a local domain to another cluster: the domain is\n  * promoted to a global domain, its existing workflow executions are replicated and checked for parity,\n  * the domain fails over to the target cluster and is converted back into a local domain there.\n  **/\n  MigrateDomainResponse MigrateDomain(1: MigrateDomainRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n}\n\nenum FailoverReadinessCheckStatus {\n  PASSED,\n  FAILED,\n  UNVERIFIED,\n}\n\nstruct FailoverReadinessCheck {\n  10: optional string name\n  20: optional FailoverReadinessCheckStatus status\n  30: optional string details\n}\n\nstruct CheckFailoverReadinessRequest {\n  10: optional string domain\n  20: optional string targetCluster\n}\n\nstruct CheckFailoverReadinessResponse {\n  10: optional bool ready\n  20: optional list<FailoverReadinessCheck> checks\n}\n\nstruct DescribeShardDistributionRequest {\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32 numberOfShards\n  20: optional list<HistoryHostShards> hosts\n  30: optional i32 minShardsPerHost\n  40: optional i32 maxShardsPerHost\n}\n\nstruct HistoryHostShards {\n  10: optional string address\n  20: optional list<i32> shardIDs\n}\n\nstruct DeleteWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional bool force\n  40: optional string reason\n  50: optional string identity\n}\n\nstruct ReindexWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n}\n\nstruct UndeprecateDomainRequest {\n  10: optional string name\n}\n\nstruct SetTerminationProtectionRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional bool terminationProtected\n  40: optional string identity\n}\n\nstruct ListWorkersRequest {\n  10: optional string domain\n  20: optional shared.TaskList taskList\n}\n\nstruct ListWorkersResponse {\n  10: optional list<shared.WorkerInfo> workers\n  // onlyStaleWorkers is true if workers polled the tasklists but none of them is polling anymore\n  20: optional bool onlyStaleWorkers\n}\n\nstruct DescribeDynamicConfigRequest {\n  // keyPrefix limits the response to the keys whose name starts with it\n  10: optional string keyPrefix\n  20: optional string domain\n  30: optional string taskList\n  40: optional shared.TaskListType taskType\n  50: optional i32 shardID\n}\n\nstruct DescribeDynamicConfigResponse {\n  10: optional list<DynamicConfigEntry> entries\n}\n\nstruct DynamicConfigEntry {\n  10: optional string name\n  20: optional string valueType\n  30: optional string defaultValue\n  // value is the value applied for the filters of the request which the key can be constrained by\n  40: optional string value\n  // isOverridden is true if value comes from the dynamic config source rather than the default value\n  50: optional bool isOverridden\n  60: optional list<string> filters\n  70: optional list<DynamicConfigOverride> overrides\n}\n\nstruct DynamicConfigOverride {\n  10: optional string value\n  20: optional map<string, string> constraints\n}\n\nstruct MigrateDomainRequest {\n  10: optional string domain\n  20: optional string targetCluster\n  30: optional string identity\n}\n\nstruct MigrateDomainResponse {\n  10: optional string workflowId\n  20: optional string runId\n}\n"
+
+// AdminService_AddOperatorAnnotation_Args represents the arguments for the AdminService.AddOperatorAnnotation function.
+//
+// The arguments for AddOperatorAnnotation are sent and received over the wire as this struct.
+type AdminService_AddOperatorAnnotation_Args struct {
+	Request *shared.AddOperatorAnnotationRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_AddOperatorAnnotation_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddOperatorAnnotation_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddOperatorAnnotationRequest_Read(w wire.Value) (*shared.AddOperatorAnnotationRequest, error) {
+	var v shared.AddOperatorAnnotationRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddOperatorAnnotation_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddOperatorAnnotation_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddOperatorAnnotation_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddOperatorAnnotation_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _AddOperatorAnnotationRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddOperatorAnnotation_Args
+// struct.
+func (v *AdminService_AddOperatorAnnotation_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddOperatorAnnotation_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddOperatorAnnotation_Args match the
+// provided AdminService_AddOperatorAnnotation_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddOperatorAnnotation_Args) Equals(rhs *AdminService_AddOperatorAnnotation_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddOperatorAnnotation_Args.
+func (v *AdminService_AddOperatorAnnotation_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddOperatorAnnotation_Args) GetRequest() (o *shared.AddOperatorAnnotationRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_AddOperatorAnnotation_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddOperatorAnnotation" for this struct.
+func (v *AdminService_AddOperatorAnnotation_Args) MethodName() string {
+	return "AddOperatorAnnotation"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_AddOperatorAnnotation_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_AddOperatorAnnotation_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.AddOperatorAnnotation
+// function.
+var AdminService_AddOperatorAnnotation_Helper = struct {
+	// Args accepts the parameters of AddOperatorAnnotation in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.AddOperatorAnnotationRequest,
+	) *AdminService_AddOperatorAnnotation_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddOperatorAnnotation.
+	//
+	// An error can be thrown by AddOperatorAnnotation only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddOperatorAnnotation
+	// given the error returned by it. The provided error may
+	// be nil if AddOperatorAnnotation did not fail.
+	//
+	// This allows mapping errors returned by AddOperatorAnnotation into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddOperatorAnnotation
+	//
+	//   err := AddOperatorAnnotation(args)
+	//   result, err := AdminService_AddOperatorAnnotation_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddOperatorAnnotation: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_AddOperatorAnnotation_Result, error)
+
+	// UnwrapResponse takes the result struct for AddOperatorAnnotation
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddOperatorAnnotation threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_AddOperatorAnnotation_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_AddOperatorAnnotation_Result) error
+}{}
+
+func init() {
+	AdminService_AddOperatorAnnotation_Helper.Args = func(
+		request *shared.AddOperatorAnnotationRequest,
+	) *AdminService_AddOperatorAnnotation_Args {
+		return &AdminService_AddOperatorAnnotation_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_AddOperatorAnnotation_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_AddOperatorAnnotation_Helper.WrapResponse = func(err error) (*AdminService_AddOperatorAnnotation_Result, error) {
+		if err == nil {
+			return &AdminService_AddOperatorAnnotation_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddOperatorAnnotation_Result.BadRequestError")
+			}
+			return &AdminService_AddOperatorAnnotation_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddOperatorAnnotation_Result.InternalServiceError")
+			}
+			return &AdminService_AddOperatorAnnotation_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddOperatorAnnotation_Result.EntityNotExistError")
+			}
+			return &AdminService_AddOperatorAnnotation_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddOperatorAnnotation_Result.ServiceBusyError")
+			}
+			return &AdminService_AddOperatorAnnotation_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddOperatorAnnotation_Result.DomainNotActiveError")
+			}
+			return &AdminService_AddOperatorAnnotation_Result{DomainNotActiveError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_AddOperatorAnnotation_Helper.UnwrapResponse = func(result *AdminService_AddOperatorAnnotation_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_AddOperatorAnnotation_Result represents the result of a AdminService.AddOperatorAnnotation function call.
+//
+// The result of a AddOperatorAnnotation execution is sent and received over the wire as this struct.
+type AdminService_AddOperatorAnnotation_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+}
+
+// ToWire translates a AdminService_AddOperatorAnnotation_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddOperatorAnnotation_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_AddOperatorAnnotation_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddOperatorAnnotation_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddOperatorAnnotation_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddOperatorAnnotation_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddOperatorAnnotation_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddOperatorAnnotation_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddOperatorAnnotation_Result
+// struct.
+func (v *AdminService_AddOperatorAnnotation_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddOperatorAnnotation_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddOperatorAnnotation_Result match the
+// provided AdminService_AddOperatorAnnotation_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddOperatorAnnotation_Result) Equals(rhs *AdminService_AddOperatorAnnotation_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddOperatorAnnotation_Result.
+func (v *AdminService_AddOperatorAnnotation_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddOperatorAnnotation_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_AddOperatorAnnotation_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddOperatorAnnotation_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_AddOperatorAnnotation_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddOperatorAnnotation_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_AddOperatorAnnotation_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddOperatorAnnotation_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_AddOperatorAnnotation_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddOperatorAnnotation_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_AddOperatorAnnotation_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddOperatorAnnotation" for this struct.
+func (v *AdminService_AddOperatorAnnotation_Result) MethodName() string {
+	return "AddOperatorAnnotation"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_AddOperatorAnnotation_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+//
+// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Args struct {
+	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
+	var v AddSearchAttributeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// struct.
+func (v *AdminService_AddSearchAttribute_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
+// provided AdminService_AddSearchAttribute_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Args.
+func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.AddSearchAttribute
+// function.
+var AdminService_AddSearchAttribute_Helper = struct {
+	// Args accepts the parameters of AddSearchAttribute in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddSearchAttribute.
+	//
+	// An error can be thrown by AddSearchAttribute only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddSearchAttribute
+	// given the error returned by it. The provided error may
+	// be nil if AddSearchAttribute did not fail.
+	//
+	// This allows mapping errors returned by AddSearchAttribute into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddSearchAttribute
+	//
+	//   err := AddSearchAttribute(args)
+	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+
+	// UnwrapResponse takes the result struct for AddSearchAttribute
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddSearchAttribute threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+}{}
+
+func init() {
+	AdminService_AddSearchAttribute_Helper.Args = func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args {
+		return &AdminService_AddSearchAttribute_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+		if err == nil {
+			return &AdminService_AddSearchAttribute_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+			}
+			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+			}
+			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+			}
+			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+//
+// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// struct.
+func (v *AdminService_AddSearchAttribute_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
+// provided AdminService_AddSearchAttribute_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Result.
+func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_CheckFailoverReadiness_Args represents the arguments for the AdminService.CheckFailoverReadiness function.
+//
+// The arguments for CheckFailoverReadiness are sent and received over the wire as this struct.
+type AdminService_CheckFailoverReadiness_Args struct {
+	Request *CheckFailoverReadinessRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_CheckFailoverReadiness_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CheckFailoverReadiness_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CheckFailoverReadinessRequest_Read(w wire.Value) (*CheckFailoverReadinessRequest, error) {
+	var v CheckFailoverReadinessRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CheckFailoverReadiness_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CheckFailoverReadiness_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CheckFailoverReadiness_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CheckFailoverReadiness_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _CheckFailoverReadinessRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CheckFailoverReadiness_Args
+// struct.
+func (v *AdminService_CheckFailoverReadiness_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CheckFailoverReadiness_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CheckFailoverReadiness_Args match the
+// provided AdminService_CheckFailoverReadiness_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CheckFailoverReadiness_Args) Equals(rhs *AdminService_CheckFailoverReadiness_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CheckFailoverReadiness_Args.
+func (v *AdminService_CheckFailoverReadiness_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CheckFailoverReadiness_Args) GetRequest() (o *CheckFailoverReadinessRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_CheckFailoverReadiness_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "CheckFailoverReadiness" for this struct.
+func (v *AdminService_CheckFailoverReadiness_Args) MethodName() string {
+	return "CheckFailoverReadiness"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_CheckFailoverReadiness_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_CheckFailoverReadiness_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.CheckFailoverReadiness
+// function.
+var AdminService_CheckFailoverReadiness_Helper = struct {
+	// Args accepts the parameters of CheckFailoverReadiness in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *CheckFailoverReadinessRequest,
+	) *AdminService_CheckFailoverReadiness_Args
+
+	// IsException returns true if the given error can be thrown
+	// by CheckFailoverReadiness.
+	//
+	// An error can be thrown by CheckFailoverReadiness only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for CheckFailoverReadiness
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// CheckFailoverReadiness into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by CheckFailoverReadiness
+	//
+	//   value, err := CheckFailoverReadiness(args)
+	//   result, err := AdminService_CheckFailoverReadiness_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from CheckFailoverReadiness: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*CheckFailoverReadinessResponse, error) (*AdminService_CheckFailoverReadiness_Result, error)
+
+	// UnwrapResponse takes the result struct for CheckFailoverReadiness
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if CheckFailoverReadiness threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_CheckFailoverReadiness_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_CheckFailoverReadiness_Result) (*CheckFailoverReadinessResponse, error)
+}{}
+
+func init() {
+	AdminService_CheckFailoverReadiness_Helper.Args = func(
+		request *CheckFailoverReadinessRequest,
+	) *AdminService_CheckFailoverReadiness_Args {
+		return &AdminService_CheckFailoverReadiness_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_CheckFailoverReadiness_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_CheckFailoverReadiness_Helper.WrapResponse = func(success *CheckFailoverReadinessResponse, err error) (*AdminService_CheckFailoverReadiness_Result, error) {
+		if err == nil {
+			return &AdminService_CheckFailoverReadiness_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CheckFailoverReadiness_Result.BadRequestError")
+			}
+			return &AdminService_CheckFailoverReadiness_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CheckFailoverReadiness_Result.InternalServiceError")
+			}
+			return &AdminService_CheckFailoverReadiness_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CheckFailoverReadiness_Result.EntityNotExistError")
+			}
+			return &AdminService_CheckFailoverReadiness_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CheckFailoverReadiness_Result.ServiceBusyError")
+			}
+			return &AdminService_CheckFailoverReadiness_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_CheckFailoverReadiness_Helper.UnwrapResponse = func(result *AdminService_CheckFailoverReadiness_Result) (success *CheckFailoverReadinessResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_CheckFailoverReadiness_Result represents the result of a AdminService.CheckFailoverReadiness function call.
+//
+// The result of a CheckFailoverReadiness execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_CheckFailoverReadiness_Result struct {
+	// Value returned by CheckFailoverReadiness after a successful execution.
+	Success              *CheckFailoverReadinessResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError         `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError    `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError    `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError        `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_CheckFailoverReadiness_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CheckFailoverReadiness_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_CheckFailoverReadiness_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CheckFailoverReadinessResponse_Read(w wire.Value) (*CheckFailoverReadinessResponse, error) {
+	var v CheckFailoverReadinessResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CheckFailoverReadiness_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CheckFailoverReadiness_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CheckFailoverReadiness_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CheckFailoverReadiness_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _CheckFailoverReadinessResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_CheckFailoverReadiness_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CheckFailoverReadiness_Result
+// struct.
+func (v *AdminService_CheckFailoverReadiness_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CheckFailoverReadiness_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CheckFailoverReadiness_Result match the
+// provided AdminService_CheckFailoverReadiness_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CheckFailoverReadiness_Result) Equals(rhs *AdminService_CheckFailoverReadiness_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CheckFailoverReadiness_Result.
+func (v *AdminService_CheckFailoverReadiness_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CheckFailoverReadiness_Result) GetSuccess() (o *CheckFailoverReadinessResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_CheckFailoverReadiness_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CheckFailoverReadiness_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_CheckFailoverReadiness_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CheckFailoverReadiness_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_CheckFailoverReadiness_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CheckFailoverReadiness_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_CheckFailoverReadiness_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CheckFailoverReadiness_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_CheckFailoverReadiness_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "CheckFailoverReadiness" for this struct.
+func (v *AdminService_CheckFailoverReadiness_Result) MethodName() string {
+	return "CheckFailoverReadiness"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_CheckFailoverReadiness_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_CloseShard_Args represents the arguments for the AdminService.CloseShard function.
+//
+// The arguments for CloseShard are sent and received over the wire as this struct.
+type AdminService_CloseShard_Args struct {
+	Request *shared.CloseShardRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_CloseShard_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
+	var v shared.CloseShardRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CloseShard_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CloseShard_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CloseShard_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _CloseShardRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CloseShard_Args
+// struct.
+func (v *AdminService_CloseShard_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CloseShard_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CloseShard_Args match the
+// provided AdminService_CloseShard_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CloseShard_Args.
+func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_CloseShard_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Args) MethodName() string {
+	return "CloseShard"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_CloseShard_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_CloseShard_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.CloseShard
+// function.
+var AdminService_CloseShard_Helper = struct {
+	// Args accepts the parameters of CloseShard in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args
+
+	// IsException returns true if the given error can be thrown
+	// by CloseShard.
+	//
+	// An error can be thrown by CloseShard only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for CloseShard
+	// given the error returned by it. The provided error may
+	// be nil if CloseShard did not fail.
+	//
+	// This allows mapping errors returned by CloseShard into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// CloseShard
+	//
+	//   err := CloseShard(args)
+	//   result, err := AdminService_CloseShard_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from CloseShard: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_CloseShard_Result, error)
+
+	// UnwrapResponse takes the result struct for CloseShard
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if CloseShard threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_CloseShard_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_CloseShard_Result) error
+}{}
+
+func init() {
+	AdminService_CloseShard_Helper.Args = func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args {
+		return &AdminService_CloseShard_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_CloseShard_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_CloseShard_Helper.WrapResponse = func(err error) (*AdminService_CloseShard_Result, error) {
+		if err == nil {
+			return &AdminService_CloseShard_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.BadRequestError")
+			}
+			return &AdminService_CloseShard_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.InternalServiceError")
+			}
+			return &AdminService_CloseShard_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.AccessDeniedError")
+			}
+			return &AdminService_CloseShard_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_CloseShard_Helper.UnwrapResponse = func(result *AdminService_CloseShard_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_CloseShard_Result represents the result of a AdminService.CloseShard function call.
+//
+// The result of a CloseShard execution is sent and received over the wire as this struct.
+type AdminService_CloseShard_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_CloseShard_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
+	var v shared.AccessDeniedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CloseShard_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CloseShard_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CloseShard_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CloseShard_Result
+// struct.
+func (v *AdminService_CloseShard_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CloseShard_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CloseShard_Result match the
+// provided AdminService_CloseShard_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CloseShard_Result.
+func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Result) MethodName() string {
+	return "CloseShard"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_CloseShard_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DeleteWorkflowExecution_Args represents the arguments for the AdminService.DeleteWorkflowExecution function.
+//
+// The arguments for DeleteWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_DeleteWorkflowExecution_Args struct {
+	Request *DeleteWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DeleteWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DeleteWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DeleteWorkflowExecutionRequest_Read(w wire.Value) (*DeleteWorkflowExecutionRequest, error) {
+	var v DeleteWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DeleteWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DeleteWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DeleteWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DeleteWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DeleteWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DeleteWorkflowExecution_Args
+// struct.
+func (v *AdminService_DeleteWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DeleteWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DeleteWorkflowExecution_Args match the
+// provided AdminService_DeleteWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DeleteWorkflowExecution_Args) Equals(rhs *AdminService_DeleteWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DeleteWorkflowExecution_Args.
+func (v *AdminService_DeleteWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Args) GetRequest() (o *DeleteWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DeleteWorkflowExecution" for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Args) MethodName() string {
+	return "DeleteWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DeleteWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DeleteWorkflowExecution
+// function.
+var AdminService_DeleteWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of DeleteWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DeleteWorkflowExecutionRequest,
+	) *AdminService_DeleteWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DeleteWorkflowExecution.
+	//
+	// An error can be thrown by DeleteWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DeleteWorkflowExecution
+	// given the error returned by it. The provided error may
+	// be nil if DeleteWorkflowExecution did not fail.
+	//
+	// This allows mapping errors returned by DeleteWorkflowExecution into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// DeleteWorkflowExecution
+	//
+	//   err := DeleteWorkflowExecution(args)
+	//   result, err := AdminService_DeleteWorkflowExecution_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DeleteWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_DeleteWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for DeleteWorkflowExecution
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if DeleteWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_DeleteWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DeleteWorkflowExecution_Result) error
+}{}
+
+func init() {
+	AdminService_DeleteWorkflowExecution_Helper.Args = func(
+		request *DeleteWorkflowExecutionRequest,
+	) *AdminService_DeleteWorkflowExecution_Args {
+		return &AdminService_DeleteWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DeleteWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DeleteWorkflowExecution_Helper.WrapResponse = func(err error) (*AdminService_DeleteWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_DeleteWorkflowExecution_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.ServiceBusyError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DeleteWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DeleteWorkflowExecution_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_DeleteWorkflowExecution_Result represents the result of a AdminService.DeleteWorkflowExecution function call.
+//
+// The result of a DeleteWorkflowExecution execution is sent and received over the wire as this struct.
+type AdminService_DeleteWorkflowExecution_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_DeleteWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DeleteWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DeleteWorkflowExecution_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_DeleteWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DeleteWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DeleteWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DeleteWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_DeleteWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DeleteWorkflowExecution_Result
+// struct.
+func (v *AdminService_DeleteWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DeleteWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DeleteWorkflowExecution_Result match the
+// provided AdminService_DeleteWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DeleteWorkflowExecution_Result) Equals(rhs *AdminService_DeleteWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DeleteWorkflowExecution_Result.
+func (v *AdminService_DeleteWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DeleteWorkflowExecution" for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Result) MethodName() string {
+	return "DeleteWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeDynamicConfig_Args represents the arguments for the AdminService.DescribeDynamicConfig function.
+//
+// The arguments for DescribeDynamicConfig are sent and received over the wire as this struct.
+type AdminService_DescribeDynamicConfig_Args struct {
+	Request *DescribeDynamicConfigRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeDynamicConfig_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeDynamicConfig_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeDynamicConfigRequest_Read(w wire.Value) (*DescribeDynamicConfigRequest, error) {
+	var v DescribeDynamicConfigRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeDynamicConfig_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeDynamicConfig_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeDynamicConfig_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeDynamicConfig_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeDynamicConfigRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeDynamicConfig_Args
+// struct.
+func (v *AdminService_DescribeDynamicConfig_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeDynamicConfig_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeDynamicConfig_Args match the
+// provided AdminService_DescribeDynamicConfig_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeDynamicConfig_Args) Equals(rhs *AdminService_DescribeDynamicConfig_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeDynamicConfig_Args.
+func (v *AdminService_DescribeDynamicConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDynamicConfig_Args) GetRequest() (o *DescribeDynamicConfigRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeDynamicConfig_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeDynamicConfig" for this struct.
+func (v *AdminService_DescribeDynamicConfig_Args) MethodName() string {
+	return "DescribeDynamicConfig"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeDynamicConfig_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeDynamicConfig_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeDynamicConfig
+// function.
+var AdminService_DescribeDynamicConfig_Helper = struct {
+	// Args accepts the parameters of DescribeDynamicConfig in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DescribeDynamicConfigRequest,
+	) *AdminService_DescribeDynamicConfig_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeDynamicConfig.
+	//
+	// An error can be thrown by DescribeDynamicConfig only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeDynamicConfig
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeDynamicConfig into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeDynamicConfig
+	//
+	//   value, err := DescribeDynamicConfig(args)
+	//   result, err := AdminService_DescribeDynamicConfig_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeDynamicConfig: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeDynamicConfigResponse, error) (*AdminService_DescribeDynamicConfig_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeDynamicConfig
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeDynamicConfig threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeDynamicConfig_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeDynamicConfig_Result) (*DescribeDynamicConfigResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeDynamicConfig_Helper.Args = func(
+		request *DescribeDynamicConfigRequest,
+	) *AdminService_DescribeDynamicConfig_Args {
+		return &AdminService_DescribeDynamicConfig_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeDynamicConfig_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeDynamicConfig_Helper.WrapResponse = func(success *DescribeDynamicConfigResponse, err error) (*AdminService_DescribeDynamicConfig_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeDynamicConfig_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeDynamicConfig_Result.BadRequestError")
+			}
+			return &AdminService_DescribeDynamicConfig_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeDynamicConfig_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeDynamicConfig_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeDynamicConfig_Result.ServiceBusyError")
+			}
+			return &AdminService_DescribeDynamicConfig_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeDynamicConfig_Helper.UnwrapResponse = func(result *AdminService_DescribeDynamicConfig_Result) (success *DescribeDynamicConfigResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeDynamicConfig_Result represents the result of a AdminService.DescribeDynamicConfig function call.
+//
+// The result of a DescribeDynamicConfig execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeDynamicConfig_Result struct {
+	// Value returned by DescribeDynamicConfig after a successful execution.
+	Success              *DescribeDynamicConfigResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError        `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeDynamicConfig_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeDynamicConfig_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeDynamicConfig_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeDynamicConfigResponse_Read(w wire.Value) (*DescribeDynamicConfigResponse, error) {
+	var v DescribeDynamicConfigResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeDynamicConfig_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeDynamicConfig_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeDynamicConfig_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeDynamicConfig_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeDynamicConfigResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeDynamicConfig_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeDynamicConfig_Result
+// struct.
+func (v *AdminService_DescribeDynamicConfig_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeDynamicConfig_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeDynamicConfig_Result match the
+// provided AdminService_DescribeDynamicConfig_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeDynamicConfig_Result) Equals(rhs *AdminService_DescribeDynamicConfig_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeDynamicConfig_Result.
+func (v *AdminService_DescribeDynamicConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDynamicConfig_Result) GetSuccess() (o *DescribeDynamicConfigResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeDynamicConfig_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDynamicConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeDynamicConfig_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDynamicConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeDynamicConfig_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeDynamicConfig_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_DescribeDynamicConfig_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeDynamicConfig" for this struct.
+func (v *AdminService_DescribeDynamicConfig_Result) MethodName() string {
+	return "DescribeDynamicConfig"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeDynamicConfig_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+//
+// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
+type AdminService_DescribeHistoryHost_Args struct {
+	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
+	var v shared.DescribeHistoryHostRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// struct.
+func (v *AdminService_DescribeHistoryHost_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
+// provided AdminService_DescribeHistoryHost_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Args.
+func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeHistoryHost
+// function.
+var AdminService_DescribeHistoryHost_Helper = struct {
+	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeHistoryHost.
+	//
+	// An error can be thrown by DescribeHistoryHost only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeHistoryHost
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeHistoryHost into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeHistoryHost
+	//
+	//   value, err := DescribeHistoryHost(args)
+	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeHistoryHost
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeHistoryHost threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeHistoryHost_Helper.Args = func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args {
+		return &AdminService_DescribeHistoryHost_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
+//
+// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeHistoryHost_Result struct {
+	// Value returned by DescribeHistoryHost after a successful execution.
+	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
+	var v shared.DescribeHistoryHostResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// struct.
+func (v *AdminService_DescribeHistoryHost_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
+// provided AdminService_DescribeHistoryHost_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Result.
+func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeQueue_Args represents the arguments for the AdminService.DescribeQueue function.
+//
+// The arguments for DescribeQueue are sent and received over the wire as this struct.
+type AdminService_DescribeQueue_Args struct {
+	Request *shared.DescribeQueueRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeQueue_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeQueue_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeQueueRequest_Read(w wire.Value) (*shared.DescribeQueueRequest, error) {
+	var v shared.DescribeQueueRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeQueue_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeQueue_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeQueue_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeQueue_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeQueueRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeQueue_Args
+// struct.
+func (v *AdminService_DescribeQueue_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeQueue_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeQueue_Args match the
+// provided AdminService_DescribeQueue_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeQueue_Args) Equals(rhs *AdminService_DescribeQueue_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeQueue_Args.
+func (v *AdminService_DescribeQueue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeQueue_Args) GetRequest() (o *shared.DescribeQueueRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeQueue_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeQueue" for this struct.
+func (v *AdminService_DescribeQueue_Args) MethodName() string {
+	return "DescribeQueue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeQueue_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeQueue_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeQueue
+// function.
+var AdminService_DescribeQueue_Helper = struct {
+	// Args accepts the parameters of DescribeQueue in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeQueueRequest,
+	) *AdminService_DescribeQueue_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeQueue.
+	//
+	// An error can be thrown by DescribeQueue only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeQueue
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeQueue into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeQueue
+	//
+	//   value, err := DescribeQueue(args)
+	//   result, err := AdminService_DescribeQueue_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeQueue: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeQueueResponse, error) (*AdminService_DescribeQueue_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeQueue
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeQueue threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeQueue_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeQueue_Result) (*shared.DescribeQueueResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeQueue_Helper.Args = func(
+		request *shared.DescribeQueueRequest,
+	) *AdminService_DescribeQueue_Args {
+		return &AdminService_DescribeQueue_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeQueue_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeQueue_Helper.WrapResponse = func(success *shared.DescribeQueueResponse, err error) (*AdminService_DescribeQueue_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeQueue_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.BadRequestError")
+			}
+			return &AdminService_DescribeQueue_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeQueue_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeQueue_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeQueue_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeQueue_Helper.UnwrapResponse = func(result *AdminService_DescribeQueue_Result) (success *shared.DescribeQueueResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeQueue_Result represents the result of a AdminService.DescribeQueue function call.
+//
+// The result of a DescribeQueue execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeQueue_Result struct {
+	// Value returned by DescribeQueue after a successful execution.
+	Success              *shared.DescribeQueueResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError     `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeQueue_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeQueue_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeQueueResponse_Read(w wire.Value) (*shared.DescribeQueueResponse, error) {
+	var v shared.DescribeQueueResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeQueue_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeQueue_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeQueue_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeQueue_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeQueueResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeQueue_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeQueue_Result
+// struct.
+func (v *AdminService_DescribeQueue_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeQueue_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeQueue_Result match the
+// provided AdminService_DescribeQueue_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeQueue_Result) Equals(rhs *AdminService_DescribeQueue_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeQueue_Result.
+func (v *AdminService_DescribeQueue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeQueue_Result) GetSuccess() (o *shared.DescribeQueueResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeQueue_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeQueue_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeQueue_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeQueue_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeQueue_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeQueue_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeQueue_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeQueue" for this struct.
+func (v *AdminService_DescribeQueue_Result) MethodName() string {
+	return "DescribeQueue"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeQueue_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeShardDistribution_Args represents the arguments for the AdminService.DescribeShardDistribution function.
+//
+// The arguments for DescribeShardDistribution are sent and received over the wire as this struct.
+type AdminService_DescribeShardDistribution_Args struct {
+	Request *DescribeShardDistributionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeShardDistribution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeShardDistribution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeShardDistributionRequest_Read(w wire.Value) (*DescribeShardDistributionRequest, error) {
+	var v DescribeShardDistributionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeShardDistribution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeShardDistribution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeShardDistribution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeShardDistribution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeShardDistributionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeShardDistribution_Args
+// struct.
+func (v *AdminService_DescribeShardDistribution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeShardDistribution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeShardDistribution_Args match the
+// provided AdminService_DescribeShardDistribution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeShardDistribution_Args) Equals(rhs *AdminService_DescribeShardDistribution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeShardDistribution_Args.
+func (v *AdminService_DescribeShardDistribution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardDistribution_Args) GetRequest() (o *DescribeShardDistributionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeShardDistribution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeShardDistribution" for this struct.
+func (v *AdminService_DescribeShardDistribution_Args) MethodName() string {
+	return "DescribeShardDistribution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeShardDistribution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeShardDistribution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeShardDistribution
+// function.
+var AdminService_DescribeShardDistribution_Helper = struct {
+	// Args accepts the parameters of DescribeShardDistribution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DescribeShardDistributionRequest,
+	) *AdminService_DescribeShardDistribution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeShardDistribution.
+	//
+	// An error can be thrown by DescribeShardDistribution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeShardDistribution
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeShardDistribution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeShardDistribution
+	//
+	//   value, err := DescribeShardDistribution(args)
+	//   result, err := AdminService_DescribeShardDistribution_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeShardDistribution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeShardDistributionResponse, error) (*AdminService_DescribeShardDistribution_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeShardDistribution
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeShardDistribution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeShardDistribution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeShardDistribution_Result) (*DescribeShardDistributionResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeShardDistribution_Helper.Args = func(
+		request *DescribeShardDistributionRequest,
+	) *AdminService_DescribeShardDistribution_Args {
+		return &AdminService_DescribeShardDistribution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeShardDistribution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeShardDistribution_Helper.WrapResponse = func(success *DescribeShardDistributionResponse, err error) (*AdminService_DescribeShardDistribution_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeShardDistribution_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardDistribution_Result.BadRequestError")
+			}
+			return &AdminService_DescribeShardDistribution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardDistribution_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeShardDistribution_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardDistribution_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeShardDistribution_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeShardDistribution_Helper.UnwrapResponse = func(result *AdminService_DescribeShardDistribution_Result) (success *DescribeShardDistributionResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeShardDistribution_Result represents the result of a AdminService.DescribeShardDistribution function call.
+//
+// The result of a DescribeShardDistribution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeShardDistribution_Result struct {
+	// Value returned by DescribeShardDistribution after a successful execution.
+	Success              *DescribeShardDistributionResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeShardDistribution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeShardDistribution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeShardDistributionResponse_Read(w wire.Value) (*DescribeShardDistributionResponse, error) {
+	var v DescribeShardDistributionResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeShardDistribution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeShardDistribution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeShardDistribution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeShardDistribution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeShardDistributionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeShardDistribution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeShardDistribution_Result
+// struct.
+func (v *AdminService_DescribeShardDistribution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeShardDistribution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeShardDistribution_Result match the
+// provided AdminService_DescribeShardDistribution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeShardDistribution_Result) Equals(rhs *AdminService_DescribeShardDistribution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeShardDistribution_Result.
+func (v *AdminService_DescribeShardDistribution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardDistribution_Result) GetSuccess() (o *DescribeShardDistributionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeShardDistribution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardDistribution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeShardDistribution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardDistribution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeShardDistribution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardDistribution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeShardDistribution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeShardDistribution" for this struct.
+func (v *AdminService_DescribeShardDistribution_Result) MethodName() string {
+	return "DescribeShardDistribution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeShardDistribution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+//
+// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_DescribeWorkflowExecution_Args struct {
+	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
+	var v DescribeWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
+// provided AdminService_DescribeWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeWorkflowExecution_Args.
+func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
+	return "DescribeWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeWorkflowExecution
+// function.
+var AdminService_DescribeWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeWorkflowExecution.
+	//
+	// An error can be thrown by DescribeWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeWorkflowExecution
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeWorkflowExecution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeWorkflowExecution
+	//
+	//   value, err := DescribeWorkflowExecution(args)
+	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeWorkflowExecution_Helper.Args = func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args {
+		return &AdminService_DescribeWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
+//
+// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeWorkflowExecution_Result struct {
+	// Value returned by DescribeWorkflowExecution after a successful execution.
+	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
+	var v DescribeWorkflowExecutionResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
+// provided AdminService_DescribeWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeWorkflowExecution_Result.
+func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
+	return "DescribeWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
+//
+// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
+type AdminService_GetWorkflowExecutionRawHistory_Args struct {
+	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.GetRequest != nil {
+		w, err = v.GetRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
+	var v GetWorkflowExecutionRawHistoryRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_GetWorkflowExecutionRawHistory_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.GetRequest != nil {
+		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.GetRequest != nil {
+		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	}
+	return err
+}
+
+// GetGetRequest returns the value of GetRequest if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
+	if v != nil && v.GetRequest != nil {
+		return v.GetRequest
+	}
+
+	return
+}
+
+// IsSetGetRequest returns true if GetRequest is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
+	return v != nil && v.GetRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
+// function.
+var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
+	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args
+
+	// IsException returns true if the given error can be thrown
+	// by GetWorkflowExecutionRawHistory.
+	//
+	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// GetWorkflowExecutionRawHistory into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetWorkflowExecutionRawHistory
+	//
+	//   value, err := GetWorkflowExecutionRawHistory(args)
+	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
+
+	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
+}{}
+
+func init() {
+	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args {
+		return &AdminService_GetWorkflowExecutionRawHistory_Args{
+			GetRequest: getRequest,
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
+		if err == nil {
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
+//
+// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_GetWorkflowExecutionRawHistory_Result struct {
+	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
+	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
+	var v GetWorkflowExecutionRawHistoryResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_GetWorkflowExecutionRawHistory_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ListWorkers_Args represents the arguments for the AdminService.ListWorkers function.
+//
+// The arguments for ListWorkers are sent and received over the wire as this struct.
+type AdminService_ListWorkers_Args struct {
+	Request *ListWorkersRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ListWorkers_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListWorkers_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListWorkersRequest_Read(w wire.Value) (*ListWorkersRequest, error) {
+	var v ListWorkersRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListWorkers_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListWorkers_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ListWorkers_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ListWorkers_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ListWorkersRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListWorkers_Args
+// struct.
+func (v *AdminService_ListWorkers_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListWorkers_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListWorkers_Args match the
+// provided AdminService_ListWorkers_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListWorkers_Args) Equals(rhs *AdminService_ListWorkers_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListWorkers_Args.
+func (v *AdminService_ListWorkers_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListWorkers_Args) GetRequest() (o *ListWorkersRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
 }
 
-func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
-	var v shared.ReplicationInfo
-	err := v.FromWire(w)
-	return &v, err
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ListWorkers_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ListWorkers" for this struct.
+func (v *AdminService_ListWorkers_Args) MethodName() string {
+	return "ListWorkers"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ListWorkers_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ListWorkers_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListWorkers
+// function.
+var AdminService_ListWorkers_Helper = struct {
+	// Args accepts the parameters of ListWorkers in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ListWorkersRequest,
+	) *AdminService_ListWorkers_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ListWorkers.
+	//
+	// An error can be thrown by ListWorkers only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ListWorkers
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// ListWorkers into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListWorkers
+	//
+	//   value, err := ListWorkers(args)
+	//   result, err := AdminService_ListWorkers_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ListWorkers: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*ListWorkersResponse, error) (*AdminService_ListWorkers_Result, error)
+
+	// UnwrapResponse takes the result struct for ListWorkers
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if ListWorkers threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_ListWorkers_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListWorkers_Result) (*ListWorkersResponse, error)
+}{}
+
+func init() {
+	AdminService_ListWorkers_Helper.Args = func(
+		request *ListWorkersRequest,
+	) *AdminService_ListWorkers_Args {
+		return &AdminService_ListWorkers_Args{
+			Request: request,
+		}
 	}
 
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
+	AdminService_ListWorkers_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
 	}
 
-	o := make(map[string]*shared.ReplicationInfo, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
+	AdminService_ListWorkers_Helper.WrapResponse = func(success *ListWorkersResponse, err error) (*AdminService_ListWorkers_Result, error) {
+		if err == nil {
+			return &AdminService_ListWorkers_Result{Success: success}, nil
 		}
 
-		v, err := _ReplicationInfo_Read(x.Value)
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListWorkers_Result.BadRequestError")
+			}
+			return &AdminService_ListWorkers_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListWorkers_Result.InternalServiceError")
+			}
+			return &AdminService_ListWorkers_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListWorkers_Result.EntityNotExistError")
+			}
+			return &AdminService_ListWorkers_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListWorkers_Result.ServiceBusyError")
+			}
+			return &AdminService_ListWorkers_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ListWorkers_Helper.UnwrapResponse = func(result *AdminService_ListWorkers_Result) (success *ListWorkersResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_ListWorkers_Result represents the result of a AdminService.ListWorkers function call.
+//
+// The result of a ListWorkers execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListWorkers_Result struct {
+	// Value returned by ListWorkers after a successful execution.
+	Success              *ListWorkersResponse         `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_ListWorkers_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListWorkers_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
-			return err
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ListWorkers_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetWorkflowExecutionRawHistoryResponse struct from its Thrift-level
+func _ListWorkersResponse_Read(w wire.Value) (*ListWorkersResponse, error) {
+	var v ListWorkersResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListWorkers_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryResponse struct
+// An error is returned if we were unable to build a AdminService_ListWorkers_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1314,45 +13207,51 @@ func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.Re
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryResponse
+//   var v AdminService_ListWorkers_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
+func (v *AdminService_ListWorkers_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ListWorkersResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TList {
-				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1361,221 +13260,213 @@ func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
 		}
 	}
 
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListWorkers_Result should have exactly one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryResponse
+// String returns a readable string representation of a AdminService_ListWorkers_Result
 // struct.
-func (v *GetWorkflowExecutionRawHistoryResponse) String() string {
+func (v *AdminService_ListWorkers_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
-	if v.HistoryBatches != nil {
-		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
-func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
+	return fmt.Sprintf("AdminService_ListWorkers_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryResponse match the
-// provided GetWorkflowExecutionRawHistoryResponse.
+// Equals returns true if all the fields of this AdminService_ListWorkers_Result match the
+// provided AdminService_ListWorkers_Result.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+func (v *AdminService_ListWorkers_Result) Equals(rhs *AdminService_ListWorkers_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-
-	return true
-}
-
-type _List_DataBlob_Zapper []*shared.DataBlob
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DataBlob_Zapper.
-func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
 	}
-	return err
-}
-
-type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_ReplicationInfo_Zapper.
-func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
-	}
-	return err
+	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryResponse.
-func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListWorkers_Result.
+func (v *AdminService_ListWorkers_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-	if v.HistoryBatches != nil {
-		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *AdminService_ListWorkers_Result) GetSuccess() (o *ListWorkersResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ListWorkers_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
-	if v != nil && v.HistoryBatches != nil {
-		return v.HistoryBatches
+func (v *AdminService_ListWorkers_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetHistoryBatches returns true if HistoryBatches is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetHistoryBatches() bool {
-	return v != nil && v.HistoryBatches != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ListWorkers_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v != nil && v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+func (v *AdminService_ListWorkers_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetReplicationInfo() bool {
-	return v != nil && v.ReplicationInfo != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ListWorkers_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *AdminService_ListWorkers_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_ListWorkers_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "admin",
-	Package:  "github.com/uber/cadence/.gen/go/admin",
-	FilePath: "admin.thrift",
-	SHA1:     "82b7ca0371394313ca7da19e03df4ed862ce90cb",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListWorkers_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n}\n"
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ListWorkers_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
 
-// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
 //
-// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Args struct {
-	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+// This will always be "ListWorkers" for this struct.
+func (v *AdminService_ListWorkers_Result) MethodName() string {
+	return "ListWorkers"
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ListWorkers_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_MigrateDomain_Args represents the arguments for the AdminService.MigrateDomain function.
+//
+// The arguments for MigrateDomain are sent and received over the wire as this struct.
+type AdminService_MigrateDomain_Args struct {
+	Request *MigrateDomainRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_MigrateDomain_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1590,7 +13481,7 @@ type AdminService_AddSearchAttribute_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_MigrateDomain_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -1610,17 +13501,17 @@ func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
-	var v AddSearchAttributeRequest
+func _MigrateDomainRequest_Read(w wire.Value) (*MigrateDomainRequest, error) {
+	var v MigrateDomainRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_MigrateDomain_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// An error is returned if we were unable to build a AdminService_MigrateDomain_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1628,19 +13519,19 @@ func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest,
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Args
+//   var v AdminService_MigrateDomain_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+func (v *AdminService_MigrateDomain_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				v.Request, err = _MigrateDomainRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1652,9 +13543,9 @@ func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// String returns a readable string representation of a AdminService_MigrateDomain_Args
 // struct.
-func (v *AdminService_AddSearchAttribute_Args) String() string {
+func (v *AdminService_MigrateDomain_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -1666,14 +13557,14 @@ func (v *AdminService_AddSearchAttribute_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MigrateDomain_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
-// provided AdminService_AddSearchAttribute_Args.
+// Equals returns true if all the fields of this AdminService_MigrateDomain_Args match the
+// provided AdminService_MigrateDomain_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+func (v *AdminService_MigrateDomain_Args) Equals(rhs *AdminService_MigrateDomain_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -1687,8 +13578,8 @@ func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Args.
-func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MigrateDomain_Args.
+func (v *AdminService_MigrateDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -1700,7 +13591,7 @@ func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.Obje
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+func (v *AdminService_MigrateDomain_Args) GetRequest() (o *MigrateDomainRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -1709,119 +13600,132 @@ func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttribu
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+func (v *AdminService_MigrateDomain_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "MigrateDomain" for this struct.
+func (v *AdminService_MigrateDomain_Args) MethodName() string {
+	return "MigrateDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MigrateDomain_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.AddSearchAttribute
+// AdminService_MigrateDomain_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.MigrateDomain
 // function.
-var AdminService_AddSearchAttribute_Helper = struct {
-	// Args accepts the parameters of AddSearchAttribute in-order and returns
+var AdminService_MigrateDomain_Helper = struct {
+	// Args accepts the parameters of MigrateDomain in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args
+		request *MigrateDomainRequest,
+	) *AdminService_MigrateDomain_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddSearchAttribute.
+	// by MigrateDomain.
 	//
-	// An error can be thrown by AddSearchAttribute only if the
+	// An error can be thrown by MigrateDomain only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddSearchAttribute
-	// given the error returned by it. The provided error may
-	// be nil if AddSearchAttribute did not fail.
+	// WrapResponse returns the result struct for MigrateDomain
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by AddSearchAttribute into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// AddSearchAttribute
+	// This allows mapping values and errors returned by
+	// MigrateDomain into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by MigrateDomain
 	//
-	//   err := AddSearchAttribute(args)
-	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   value, err := MigrateDomain(args)
+	//   result, err := AdminService_MigrateDomain_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//     return fmt.Errorf("unexpected error from MigrateDomain: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+	WrapResponse func(*MigrateDomainResponse, error) (*AdminService_MigrateDomain_Result, error)
 
-	// UnwrapResponse takes the result struct for AddSearchAttribute
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for MigrateDomain
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if AddSearchAttribute threw an
+	// The error is non-nil only if MigrateDomain threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+	//   value, err := AdminService_MigrateDomain_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_MigrateDomain_Result) (*MigrateDomainResponse, error)
 }{}
 
 func init() {
-	AdminService_AddSearchAttribute_Helper.Args = func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args {
-		return &AdminService_AddSearchAttribute_Args{
+	AdminService_MigrateDomain_Helper.Args = func(
+		request *MigrateDomainRequest,
+	) *AdminService_MigrateDomain_Args {
+		return &AdminService_MigrateDomain_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+	AdminService_MigrateDomain_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+	AdminService_MigrateDomain_Helper.WrapResponse = func(success *MigrateDomainResponse, err error) (*AdminService_MigrateDomain_Result, error) {
 		if err == nil {
-			return &AdminService_AddSearchAttribute_Result{}, nil
+			return &AdminService_MigrateDomain_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MigrateDomain_Result.BadRequestError")
 			}
-			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+			return &AdminService_MigrateDomain_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MigrateDomain_Result.InternalServiceError")
 			}
-			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
+			return &AdminService_MigrateDomain_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MigrateDomain_Result.EntityNotExistError")
+			}
+			return &AdminService_MigrateDomain_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MigrateDomain_Result.ServiceBusyError")
 			}
-			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+			return &AdminService_MigrateDomain_Result{ServiceBusyError: e}, nil
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_MigrateDomain_Result.WorkflowAlreadyStartedError")
+			}
+			return &AdminService_MigrateDomain_Result{WorkflowAlreadyStartedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+	AdminService_MigrateDomain_Helper.UnwrapResponse = func(result *AdminService_MigrateDomain_Result) (success *MigrateDomainResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -1830,25 +13734,46 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
+		if result.WorkflowAlreadyStartedError != nil {
+			err = result.WorkflowAlreadyStartedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+// AdminService_MigrateDomain_Result represents the result of a AdminService.MigrateDomain function call.
 //
-// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+// The result of a MigrateDomain execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_MigrateDomain_Result struct {
+	// Value returned by MigrateDomain after a successful execution.
+	Success                     *MigrateDomainResponse                       `json:"success,omitempty"`
+	BadRequestError             *shared.BadRequestError                      `json:"badRequestError,omitempty"`
+	InternalServiceError        *shared.InternalServiceError                 `json:"internalServiceError,omitempty"`
+	EntityNotExistError         *shared.EntityNotExistsError                 `json:"entityNotExistError,omitempty"`
+	ServiceBusyError            *shared.ServiceBusyError                     `json:"serviceBusyError,omitempty"`
+	WorkflowAlreadyStartedError *shared.WorkflowExecutionAlreadyStartedError `json:"workflowAlreadyStartedError,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_MigrateDomain_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1863,14 +13788,22 @@ type AdminService_AddSearchAttribute_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_MigrateDomain_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -1887,45 +13820,55 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.WorkflowAlreadyStartedError != nil {
+		w, err = v.WorkflowAlreadyStartedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_MigrateDomain_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
+func _MigrateDomainResponse_Read(w wire.Value) (*MigrateDomainResponse, error) {
+	var v MigrateDomainResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
+func _WorkflowExecutionAlreadyStartedError_Read(w wire.Value) (*shared.WorkflowExecutionAlreadyStartedError, error) {
+	var v shared.WorkflowExecutionAlreadyStartedError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_MigrateDomain_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// An error is returned if we were unable to build a AdminService_MigrateDomain_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1933,16 +13876,24 @@ func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Result
+//   var v AdminService_MigrateDomain_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+func (v *AdminService_MigrateDomain_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _MigrateDomainResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -1960,42 +13911,71 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 
 			}
 		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowAlreadyStartedError, err = _WorkflowExecutionAlreadyStartedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	if v.WorkflowAlreadyStartedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_MigrateDomain_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// String returns a readable string representation of a AdminService_MigrateDomain_Result
 // struct.
-func (v *AdminService_AddSearchAttribute_Result) String() string {
+func (v *AdminService_MigrateDomain_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [6]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -2004,58 +13984,99 @@ func (v *AdminService_AddSearchAttribute_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
+	if v.WorkflowAlreadyStartedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowAlreadyStartedError: %v", v.WorkflowAlreadyStartedError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_MigrateDomain_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
-// provided AdminService_AddSearchAttribute_Result.
+// Equals returns true if all the fields of this AdminService_MigrateDomain_Result match the
+// provided AdminService_MigrateDomain_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+func (v *AdminService_MigrateDomain_Result) Equals(rhs *AdminService_MigrateDomain_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
+	if !((v.WorkflowAlreadyStartedError == nil && rhs.WorkflowAlreadyStartedError == nil) || (v.WorkflowAlreadyStartedError != nil && rhs.WorkflowAlreadyStartedError != nil && v.WorkflowAlreadyStartedError.Equals(rhs.WorkflowAlreadyStartedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Result.
-func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_MigrateDomain_Result.
+func (v *AdminService_MigrateDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
+	if v.WorkflowAlreadyStartedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowAlreadyStartedError", v.WorkflowAlreadyStartedError))
+	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MigrateDomain_Result) GetSuccess() (o *MigrateDomainResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_MigrateDomain_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_MigrateDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2064,13 +14085,13 @@ func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+func (v *AdminService_MigrateDomain_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_MigrateDomain_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2079,13 +14100,28 @@ func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_MigrateDomain_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MigrateDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_MigrateDomain_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_MigrateDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -2094,33 +14130,48 @@ func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *share
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_MigrateDomain_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
+// GetWorkflowAlreadyStartedError returns the value of WorkflowAlreadyStartedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_MigrateDomain_Result) GetWorkflowAlreadyStartedError() (o *shared.WorkflowExecutionAlreadyStartedError) {
+	if v != nil && v.WorkflowAlreadyStartedError != nil {
+		return v.WorkflowAlreadyStartedError
+	}
+
+	return
+}
+
+// IsSetWorkflowAlreadyStartedError returns true if WorkflowAlreadyStartedError is not nil.
+func (v *AdminService_MigrateDomain_Result) IsSetWorkflowAlreadyStartedError() bool {
+	return v != nil && v.WorkflowAlreadyStartedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "MigrateDomain" for this struct.
+func (v *AdminService_MigrateDomain_Result) MethodName() string {
+	return "MigrateDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_MigrateDomain_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_CloseShard_Args represents the arguments for the AdminService.CloseShard function.
+// AdminService_ReindexWorkflowExecution_Args represents the arguments for the AdminService.ReindexWorkflowExecution function.
 //
-// The arguments for CloseShard are sent and received over the wire as this struct.
-type AdminService_CloseShard_Args struct {
-	Request *shared.CloseShardRequest `json:"request,omitempty"`
+// The arguments for ReindexWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_ReindexWorkflowExecution_Args struct {
+	Request *ReindexWorkflowExecutionRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_CloseShard_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ReindexWorkflowExecution_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2135,7 +14186,7 @@ type AdminService_CloseShard_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ReindexWorkflowExecution_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2155,17 +14206,17 @@ func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
-	var v shared.CloseShardRequest
+func _ReindexWorkflowExecutionRequest_Read(w wire.Value) (*ReindexWorkflowExecutionRequest, error) {
+	var v ReindexWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_CloseShard_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ReindexWorkflowExecution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_CloseShard_Args struct
+// An error is returned if we were unable to build a AdminService_ReindexWorkflowExecution_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2173,19 +14224,19 @@ func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_CloseShard_Args
+//   var v AdminService_ReindexWorkflowExecution_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ReindexWorkflowExecution_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _CloseShardRequest_Read(field.Value)
+				v.Request, err = _ReindexWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2197,9 +14248,9 @@ func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_CloseShard_Args
+// String returns a readable string representation of a AdminService_ReindexWorkflowExecution_Args
 // struct.
-func (v *AdminService_CloseShard_Args) String() string {
+func (v *AdminService_ReindexWorkflowExecution_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2211,14 +14262,14 @@ func (v *AdminService_CloseShard_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_CloseShard_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ReindexWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_CloseShard_Args match the
-// provided AdminService_CloseShard_Args.
+// Equals returns true if all the fields of this AdminService_ReindexWorkflowExecution_Args match the
+// provided AdminService_ReindexWorkflowExecution_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args) bool {
+func (v *AdminService_ReindexWorkflowExecution_Args) Equals(rhs *AdminService_ReindexWorkflowExecution_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2232,8 +14283,8 @@ func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_CloseShard_Args.
-func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ReindexWorkflowExecution_Args.
+func (v *AdminService_ReindexWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2245,7 +14296,7 @@ func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncode
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest) {
+func (v *AdminService_ReindexWorkflowExecution_Args) GetRequest() (o *ReindexWorkflowExecutionRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2254,119 +14305,126 @@ func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_CloseShard_Args) IsSetRequest() bool {
+func (v *AdminService_ReindexWorkflowExecution_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "CloseShard" for this struct.
-func (v *AdminService_CloseShard_Args) MethodName() string {
-	return "CloseShard"
+// This will always be "ReindexWorkflowExecution" for this struct.
+func (v *AdminService_ReindexWorkflowExecution_Args) MethodName() string {
+	return "ReindexWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_CloseShard_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ReindexWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_CloseShard_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.CloseShard
+// AdminService_ReindexWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ReindexWorkflowExecution
 // function.
-var AdminService_CloseShard_Helper = struct {
-	// Args accepts the parameters of CloseShard in-order and returns
+var AdminService_ReindexWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of ReindexWorkflowExecution in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.CloseShardRequest,
-	) *AdminService_CloseShard_Args
+		request *ReindexWorkflowExecutionRequest,
+	) *AdminService_ReindexWorkflowExecution_Args
 
 	// IsException returns true if the given error can be thrown
-	// by CloseShard.
+	// by ReindexWorkflowExecution.
 	//
-	// An error can be thrown by CloseShard only if the
+	// An error can be thrown by ReindexWorkflowExecution only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for CloseShard
+	// WrapResponse returns the result struct for ReindexWorkflowExecution
 	// given the error returned by it. The provided error may
-	// be nil if CloseShard did not fail.
+	// be nil if ReindexWorkflowExecution did not fail.
 	//
-	// This allows mapping errors returned by CloseShard into a
+	// This allows mapping errors returned by ReindexWorkflowExecution into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// CloseShard
+	// ReindexWorkflowExecution
 	//
-	//   err := CloseShard(args)
-	//   result, err := AdminService_CloseShard_Helper.WrapResponse(err)
+	//   err := ReindexWorkflowExecution(args)
+	//   result, err := AdminService_ReindexWorkflowExecution_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from CloseShard: %v", err)
+	//     return fmt.Errorf("unexpected error from ReindexWorkflowExecution: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_CloseShard_Result, error)
+	WrapResponse func(error) (*AdminService_ReindexWorkflowExecution_Result, error)
 
-	// UnwrapResponse takes the result struct for CloseShard
+	// UnwrapResponse takes the result struct for ReindexWorkflowExecution
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if CloseShard threw an
+	// The error is non-nil only if ReindexWorkflowExecution threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_CloseShard_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_CloseShard_Result) error
+	//   err := AdminService_ReindexWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ReindexWorkflowExecution_Result) error
 }{}
 
 func init() {
-	AdminService_CloseShard_Helper.Args = func(
-		request *shared.CloseShardRequest,
-	) *AdminService_CloseShard_Args {
-		return &AdminService_CloseShard_Args{
+	AdminService_ReindexWorkflowExecution_Helper.Args = func(
+		request *ReindexWorkflowExecutionRequest,
+	) *AdminService_ReindexWorkflowExecution_Args {
+		return &AdminService_ReindexWorkflowExecution_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_CloseShard_Helper.IsException = func(err error) bool {
+	AdminService_ReindexWorkflowExecution_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.AccessDeniedError:
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_CloseShard_Helper.WrapResponse = func(err error) (*AdminService_CloseShard_Result, error) {
+	AdminService_ReindexWorkflowExecution_Helper.WrapResponse = func(err error) (*AdminService_ReindexWorkflowExecution_Result, error) {
 		if err == nil {
-			return &AdminService_CloseShard_Result{}, nil
+			return &AdminService_ReindexWorkflowExecution_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReindexWorkflowExecution_Result.BadRequestError")
 			}
-			return &AdminService_CloseShard_Result{BadRequestError: e}, nil
+			return &AdminService_ReindexWorkflowExecution_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReindexWorkflowExecution_Result.InternalServiceError")
 			}
-			return &AdminService_CloseShard_Result{InternalServiceError: e}, nil
-		case *shared.AccessDeniedError:
+			return &AdminService_ReindexWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReindexWorkflowExecution_Result.EntityNotExistError")
 			}
-			return &AdminService_CloseShard_Result{AccessDeniedError: e}, nil
+			return &AdminService_ReindexWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ReindexWorkflowExecution_Result.ServiceBusyError")
+			}
+			return &AdminService_ReindexWorkflowExecution_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_CloseShard_Helper.UnwrapResponse = func(result *AdminService_CloseShard_Result) (err error) {
+	AdminService_ReindexWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_ReindexWorkflowExecution_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2375,8 +14433,12 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
 		return
@@ -2384,16 +14446,17 @@ func init() {
 
 }
 
-// AdminService_CloseShard_Result represents the result of a AdminService.CloseShard function call.
+// AdminService_ReindexWorkflowExecution_Result represents the result of a AdminService.ReindexWorkflowExecution function call.
 //
-// The result of a CloseShard execution is sent and received over the wire as this struct.
-type AdminService_CloseShard_Result struct {
+// The result of a ReindexWorkflowExecution execution is sent and received over the wire as this struct.
+type AdminService_ReindexWorkflowExecution_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_CloseShard_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ReindexWorkflowExecution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2408,9 +14471,9 @@ type AdminService_CloseShard_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ReindexWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2432,33 +14495,35 @@ func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ReindexWorkflowExecution_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
-	var v shared.AccessDeniedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_CloseShard_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ReindexWorkflowExecution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_CloseShard_Result struct
+// An error is returned if we were unable to build a AdminService_ReindexWorkflowExecution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2466,12 +14531,12 @@ func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_CloseShard_Result
+//   var v AdminService_ReindexWorkflowExecution_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ReindexWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -2494,7 +14559,15 @@ func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2510,24 +14583,27 @@ func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ReindexWorkflowExecution_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_CloseShard_Result
+// String returns a readable string representation of a AdminService_ReindexWorkflowExecution_Result
 // struct.
-func (v *AdminService_CloseShard_Result) String() string {
+func (v *AdminService_ReindexWorkflowExecution_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -2537,19 +14613,23 @@ func (v *AdminService_CloseShard_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_CloseShard_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ReindexWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_CloseShard_Result match the
-// provided AdminService_CloseShard_Result.
+// Equals returns true if all the fields of this AdminService_ReindexWorkflowExecution_Result match the
+// provided AdminService_ReindexWorkflowExecution_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Result) bool {
+func (v *AdminService_ReindexWorkflowExecution_Result) Equals(rhs *AdminService_ReindexWorkflowExecution_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2561,7 +14641,10 @@ func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Res
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -2569,8 +14652,8 @@ func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Res
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_CloseShard_Result.
-func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ReindexWorkflowExecution_Result.
+func (v *AdminService_ReindexWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2580,15 +14663,18 @@ func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.AccessDeniedError != nil {
-		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ReindexWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2597,13 +14683,13 @@ func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequ
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ReindexWorkflowExecution_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_ReindexWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2612,48 +14698,63 @@ func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.In
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_ReindexWorkflowExecution_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
+func (v *AdminService_ReindexWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_ReindexWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ReindexWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ReindexWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "CloseShard" for this struct.
-func (v *AdminService_CloseShard_Result) MethodName() string {
-	return "CloseShard"
+// This will always be "ReindexWorkflowExecution" for this struct.
+func (v *AdminService_ReindexWorkflowExecution_Result) MethodName() string {
+	return "ReindexWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_CloseShard_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ReindexWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+// AdminService_RemoveTask_Args represents the arguments for the AdminService.RemoveTask function.
 //
-// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
-type AdminService_DescribeHistoryHost_Args struct {
-	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+// The arguments for RemoveTask are sent and received over the wire as this struct.
+type AdminService_RemoveTask_Args struct {
+	Request *shared.RemoveTaskRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RemoveTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2668,7 +14769,7 @@ type AdminService_DescribeHistoryHost_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2688,17 +14789,17 @@ func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
-	var v shared.DescribeHistoryHostRequest
+func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
+	var v shared.RemoveTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RemoveTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// An error is returned if we were unable to build a AdminService_RemoveTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2706,19 +14807,19 @@ func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHost
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Args
+//   var v AdminService_RemoveTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				v.Request, err = _RemoveTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2730,9 +14831,9 @@ func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// String returns a readable string representation of a AdminService_RemoveTask_Args
 // struct.
-func (v *AdminService_DescribeHistoryHost_Args) String() string {
+func (v *AdminService_RemoveTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2744,14 +14845,14 @@ func (v *AdminService_DescribeHistoryHost_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RemoveTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
-// provided AdminService_DescribeHistoryHost_Args.
+// Equals returns true if all the fields of this AdminService_RemoveTask_Args match the
+// provided AdminService_RemoveTask_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2765,8 +14866,8 @@ func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_Describ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Args.
-func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RemoveTask_Args.
+func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2778,7 +14879,7 @@ func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2787,80 +14888,81 @@ func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.Describe
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+func (v *AdminService_RemoveTask_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "RemoveTask" for this struct.
+func (v *AdminService_RemoveTask_Args) MethodName() string {
+	return "RemoveTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeHistoryHost
+// AdminService_RemoveTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RemoveTask
 // function.
-var AdminService_DescribeHistoryHost_Helper = struct {
-	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+var AdminService_RemoveTask_Helper = struct {
+	// Args accepts the parameters of RemoveTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args
+		request *shared.RemoveTaskRequest,
+	) *AdminService_RemoveTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeHistoryHost.
+	// by RemoveTask.
 	//
-	// An error can be thrown by DescribeHistoryHost only if the
+	// An error can be thrown by RemoveTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeHistoryHost
-	// given its return value and error.
+	// WrapResponse returns the result struct for RemoveTask
+	// given the error returned by it. The provided error may
+	// be nil if RemoveTask did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// DescribeHistoryHost into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeHistoryHost
+	// This allows mapping errors returned by RemoveTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RemoveTask
 	//
-	//   value, err := DescribeHistoryHost(args)
-	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   err := RemoveTask(args)
+	//   result, err := AdminService_RemoveTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//     return fmt.Errorf("unexpected error from RemoveTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+	WrapResponse func(error) (*AdminService_RemoveTask_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeHistoryHost
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RemoveTask
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if DescribeHistoryHost threw an
+	// The error is non-nil only if RemoveTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+	//   err := AdminService_RemoveTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RemoveTask_Result) error
 }{}
 
 func init() {
-	AdminService_DescribeHistoryHost_Helper.Args = func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args {
-		return &AdminService_DescribeHistoryHost_Args{
+	AdminService_RemoveTask_Helper.Args = func(
+		request *shared.RemoveTaskRequest,
+	) *AdminService_RemoveTask_Args {
+		return &AdminService_RemoveTask_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+	AdminService_RemoveTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -2873,32 +14975,32 @@ func init() {
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+	AdminService_RemoveTask_Helper.WrapResponse = func(err error) (*AdminService_RemoveTask_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+			return &AdminService_RemoveTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.BadRequestError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+			return &AdminService_RemoveTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+			return &AdminService_RemoveTask_Result{InternalServiceError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+			return &AdminService_RemoveTask_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+	AdminService_RemoveTask_Helper.UnwrapResponse = func(result *AdminService_RemoveTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2911,32 +15013,21 @@ func init() {
 			err = result.AccessDeniedError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
-//
-// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+// AdminService_RemoveTask_Result represents the result of a AdminService.RemoveTask function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeHistoryHost_Result struct {
-	// Value returned by DescribeHistoryHost after a successful execution.
-	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+// The result of a RemoveTask execution is sent and received over the wire as this struct.
+type AdminService_RemoveTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RemoveTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2951,22 +15042,14 @@ type AdminService_DescribeHistoryHost_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -2992,24 +15075,18 @@ func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
-	var v shared.DescribeHistoryHostResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RemoveTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// An error is returned if we were unable to build a AdminService_RemoveTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3017,24 +15094,16 @@ func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHos
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Result
+//   var v AdminService_RemoveTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -3063,9 +15132,6 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -3075,26 +15141,22 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// String returns a readable string representation of a AdminService_RemoveTask_Result
 // struct.
-func (v *AdminService_DescribeHistoryHost_Result) String() string {
+func (v *AdminService_RemoveTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3108,22 +15170,19 @@ func (v *AdminService_DescribeHistoryHost_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RemoveTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
-// provided AdminService_DescribeHistoryHost_Result.
+// Equals returns true if all the fields of this AdminService_RemoveTask_Result match the
+// provided AdminService_RemoveTask_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -3138,14 +15197,11 @@ func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_Descr
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Result.
-func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RemoveTask_Result.
+func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -3158,24 +15214,9 @@ func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.O
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3184,13 +15225,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RemoveTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3199,13 +15240,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RemoveTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -3214,33 +15255,33 @@ func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *sha
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_RemoveTask_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "RemoveTask" for this struct.
+func (v *AdminService_RemoveTask_Result) MethodName() string {
+	return "RemoveTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+// AdminService_ResetQueueState_Args represents the arguments for the AdminService.ResetQueueState function.
 //
-// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
-type AdminService_DescribeWorkflowExecution_Args struct {
-	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+// The arguments for ResetQueueState are sent and received over the wire as this struct.
+type AdminService_ResetQueueState_Args struct {
+	Request *shared.ResetQueueStateRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetQueueState_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3255,7 +15296,7 @@ type AdminService_DescribeWorkflowExecution_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetQueueState_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3275,17 +15316,17 @@ func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
-	var v DescribeWorkflowExecutionRequest
+func _ResetQueueStateRequest_Read(w wire.Value) (*shared.ResetQueueStateRequest, error) {
+	var v shared.ResetQueueStateRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetQueueState_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// An error is returned if we were unable to build a AdminService_ResetQueueState_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3293,19 +15334,19 @@ func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Args
+//   var v AdminService_ResetQueueState_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ResetQueueState_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				v.Request, err = _ResetQueueStateRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3317,9 +15358,9 @@ func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// String returns a readable string representation of a AdminService_ResetQueueState_Args
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+func (v *AdminService_ResetQueueState_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -3331,14 +15372,14 @@ func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetQueueState_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
-// provided AdminService_DescribeWorkflowExecution_Args.
+// Equals returns true if all the fields of this AdminService_ResetQueueState_Args match the
+// provided AdminService_ResetQueueState_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+func (v *AdminService_ResetQueueState_Args) Equals(rhs *AdminService_ResetQueueState_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -3352,8 +15393,8 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_D
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Args.
-func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetQueueState_Args.
+func (v *AdminService_ResetQueueState_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -3365,7 +15406,7 @@ func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+func (v *AdminService_ResetQueueState_Args) GetRequest() (o *shared.ResetQueueStateRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -3374,87 +15415,86 @@ func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeW
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+func (v *AdminService_ResetQueueState_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "ResetQueueState" for this struct.
+func (v *AdminService_ResetQueueState_Args) MethodName() string {
+	return "ResetQueueState"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetQueueState_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeWorkflowExecution
+// AdminService_ResetQueueState_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ResetQueueState
 // function.
-var AdminService_DescribeWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+var AdminService_ResetQueueState_Helper = struct {
+	// Args accepts the parameters of ResetQueueState in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args
+		request *shared.ResetQueueStateRequest,
+	) *AdminService_ResetQueueState_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeWorkflowExecution.
+	// by ResetQueueState.
 	//
-	// An error can be thrown by DescribeWorkflowExecution only if the
+	// An error can be thrown by ResetQueueState only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeWorkflowExecution
-	// given its return value and error.
+	// WrapResponse returns the result struct for ResetQueueState
+	// given the error returned by it. The provided error may
+	// be nil if ResetQueueState did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// DescribeWorkflowExecution into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeWorkflowExecution
+	// This allows mapping errors returned by ResetQueueState into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// ResetQueueState
 	//
-	//   value, err := DescribeWorkflowExecution(args)
-	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   err := ResetQueueState(args)
+	//   result, err := AdminService_ResetQueueState_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from ResetQueueState: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+	WrapResponse func(error) (*AdminService_ResetQueueState_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for ResetQueueState
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// The error is non-nil only if ResetQueueState threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+	//   err := AdminService_ResetQueueState_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ResetQueueState_Result) error
 }{}
 
 func init() {
-	AdminService_DescribeWorkflowExecution_Helper.Args = func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args {
-		return &AdminService_DescribeWorkflowExecution_Args{
+	AdminService_ResetQueueState_Helper.Args = func(
+		request *shared.ResetQueueStateRequest,
+	) *AdminService_ResetQueueState_Args {
+		return &AdminService_ResetQueueState_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+	AdminService_ResetQueueState_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
 		case *shared.AccessDeniedError:
 			return true
 		default:
@@ -3462,37 +15502,32 @@ func init() {
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+	AdminService_ResetQueueState_Helper.WrapResponse = func(err error) (*AdminService_ResetQueueState_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+			return &AdminService_ResetQueueState_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueueState_Result.BadRequestError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+			return &AdminService_ResetQueueState_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
-			}
-			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueueState_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+			return &AdminService_ResetQueueState_Result{InternalServiceError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetQueueState_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+			return &AdminService_ResetQueueState_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+	AdminService_ResetQueueState_Helper.UnwrapResponse = func(result *AdminService_ResetQueueState_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -3501,41 +15536,25 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
 		if result.AccessDeniedError != nil {
 			err = result.AccessDeniedError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
-//
-// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+// AdminService_ResetQueueState_Result represents the result of a AdminService.ResetQueueState function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeWorkflowExecution_Result struct {
-	// Value returned by DescribeWorkflowExecution after a successful execution.
-	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+// The result of a ResetQueueState execution is sent and received over the wire as this struct.
+type AdminService_ResetQueueState_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetQueueState_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3550,22 +15569,14 @@ type AdminService_DescribeWorkflowExecution_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetQueueState_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -3582,47 +15593,27 @@ func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
 	if v.AccessDeniedError != nil {
 		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ResetQueueState_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
-	var v DescribeWorkflowExecutionResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetQueueState_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// An error is returned if we were unable to build a AdminService_ResetQueueState_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3630,24 +15621,16 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Result
+//   var v AdminService_ResetQueueState_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ResetQueueState_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -3665,14 +15648,6 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 
 			}
 		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
@@ -3684,41 +15659,31 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
 	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_ResetQueueState_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// String returns a readable string representation of a AdminService_ResetQueueState_Result
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+func (v *AdminService_ResetQueueState_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3727,40 +15692,30 @@ func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
 	if v.AccessDeniedError != nil {
 		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetQueueState_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
-// provided AdminService_DescribeWorkflowExecution_Result.
+// Equals returns true if all the fields of this AdminService_ResetQueueState_Result match the
+// provided AdminService_ResetQueueState_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+func (v *AdminService_ResetQueueState_Result) Equals(rhs *AdminService_ResetQueueState_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
 	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
@@ -3769,47 +15724,26 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Result.
-func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetQueueState_Result.
+func (v *AdminService_ResetQueueState_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
 	if v.AccessDeniedError != nil {
 		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ResetQueueState_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3818,13 +15752,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ResetQueueState_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_ResetQueueState_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3833,28 +15767,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError(
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_ResetQueueState_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_ResetQueueState_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -3863,33 +15782,33 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_ResetQueueState_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "ResetQueueState" for this struct.
+func (v *AdminService_ResetQueueState_Result) MethodName() string {
+	return "ResetQueueState"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetQueueState_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
+// AdminService_SetTerminationProtection_Args represents the arguments for the AdminService.SetTerminationProtection function.
 //
-// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
-type AdminService_GetWorkflowExecutionRawHistory_Args struct {
-	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
+// The arguments for SetTerminationProtection are sent and received over the wire as this struct.
+type AdminService_SetTerminationProtection_Args struct {
+	Request *SetTerminationProtectionRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_SetTerminationProtection_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3904,7 +15823,7 @@ type AdminService_GetWorkflowExecutionRawHistory_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_SetTerminationProtection_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3912,8 +15831,8 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 		err    error
 	)
 
-	if v.GetRequest != nil {
-		w, err = v.GetRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3924,17 +15843,17 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
-	var v GetWorkflowExecutionRawHistoryRequest
+func _SetTerminationProtectionRequest_Read(w wire.Value) (*SetTerminationProtectionRequest, error) {
+	var v SetTerminationProtectionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_SetTerminationProtection_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
+// An error is returned if we were unable to build a AdminService_SetTerminationProtection_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3942,19 +15861,19 @@ func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Args
+//   var v AdminService_SetTerminationProtection_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
+func (v *AdminService_SetTerminationProtection_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
+				v.Request, err = _SetTerminationProtectionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3966,34 +15885,34 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
+// String returns a readable string representation of a AdminService_SetTerminationProtection_Args
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
+func (v *AdminService_SetTerminationProtection_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.GetRequest != nil {
-		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_SetTerminationProtection_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Args.
+// Equals returns true if all the fields of this AdminService_SetTerminationProtection_Args match the
+// provided AdminService_SetTerminationProtection_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
+func (v *AdminService_SetTerminationProtection_Args) Equals(rhs *AdminService_SetTerminationProtection_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -4001,102 +15920,103 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminServ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_SetTerminationProtection_Args.
+func (v *AdminService_SetTerminationProtection_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.GetRequest != nil {
-		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetGetRequest returns the value of GetRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
-	if v != nil && v.GetRequest != nil {
-		return v.GetRequest
+func (v *AdminService_SetTerminationProtection_Args) GetRequest() (o *SetTerminationProtectionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetGetRequest returns true if GetRequest is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
-	return v != nil && v.GetRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_SetTerminationProtection_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "SetTerminationProtection" for this struct.
+func (v *AdminService_SetTerminationProtection_Args) MethodName() string {
+	return "SetTerminationProtection"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_SetTerminationProtection_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
+// AdminService_SetTerminationProtection_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.SetTerminationProtection
 // function.
-var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
-	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
+var AdminService_SetTerminationProtection_Helper = struct {
+	// Args accepts the parameters of SetTerminationProtection in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args
+		request *SetTerminationProtectionRequest,
+	) *AdminService_SetTerminationProtection_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetWorkflowExecutionRawHistory.
+	// by SetTerminationProtection.
 	//
-	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
+	// An error can be thrown by SetTerminationProtection only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
-	// given its return value and error.
+	// WrapResponse returns the result struct for SetTerminationProtection
+	// given the error returned by it. The provided error may
+	// be nil if SetTerminationProtection did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// GetWorkflowExecutionRawHistory into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetWorkflowExecutionRawHistory
+	// This allows mapping errors returned by SetTerminationProtection into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// SetTerminationProtection
 	//
-	//   value, err := GetWorkflowExecutionRawHistory(args)
-	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
+	//   err := SetTerminationProtection(args)
+	//   result, err := AdminService_SetTerminationProtection_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
+	//     return fmt.Errorf("unexpected error from SetTerminationProtection: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
+	WrapResponse func(error) (*AdminService_SetTerminationProtection_Result, error)
 
-	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for SetTerminationProtection
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
+	// The error is non-nil only if SetTerminationProtection threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
+	//   err := AdminService_SetTerminationProtection_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_SetTerminationProtection_Result) error
 }{}
 
 func init() {
-	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args {
-		return &AdminService_GetWorkflowExecutionRawHistory_Args{
-			GetRequest: getRequest,
+	AdminService_SetTerminationProtection_Helper.Args = func(
+		request *SetTerminationProtectionRequest,
+	) *AdminService_SetTerminationProtection_Args {
+		return &AdminService_SetTerminationProtection_Args{
+			Request: request,
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
+	AdminService_SetTerminationProtection_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -4111,37 +16031,37 @@ func init() {
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
+	AdminService_SetTerminationProtection_Helper.WrapResponse = func(err error) (*AdminService_SetTerminationProtection_Result, error) {
 		if err == nil {
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
+			return &AdminService_SetTerminationProtection_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_SetTerminationProtection_Result.BadRequestError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
+			return &AdminService_SetTerminationProtection_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_SetTerminationProtection_Result.InternalServiceError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
+			return &AdminService_SetTerminationProtection_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_SetTerminationProtection_Result.EntityNotExistError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
+			return &AdminService_SetTerminationProtection_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_SetTerminationProtection_Result.ServiceBusyError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
+			return &AdminService_SetTerminationProtection_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
+	AdminService_SetTerminationProtection_Helper.UnwrapResponse = func(result *AdminService_SetTerminationProtection_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4158,33 +16078,22 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
+// AdminService_SetTerminationProtection_Result represents the result of a AdminService.SetTerminationProtection function call.
 //
-// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
-//
-// Success is set only if the function did not throw an exception.
-type AdminService_GetWorkflowExecutionRawHistory_Result struct {
-	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
-	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
+// The result of a SetTerminationProtection execution is sent and received over the wire as this struct.
+type AdminService_SetTerminationProtection_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_SetTerminationProtection_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4199,22 +16108,14 @@ type AdminService_GetWorkflowExecutionRawHistory_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_SetTerminationProtection_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -4247,25 +16148,19 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Valu
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_SetTerminationProtection_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
-	var v GetWorkflowExecutionRawHistoryResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_SetTerminationProtection_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
+// An error is returned if we were unable to build a AdminService_SetTerminationProtection_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4273,24 +16168,16 @@ func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExe
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Result
+//   var v AdminService_SetTerminationProtection_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
+func (v *AdminService_SetTerminationProtection_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -4327,9 +16214,6 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -4342,26 +16226,22 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_SetTerminationProtection_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
+// String returns a readable string representation of a AdminService_SetTerminationProtection_Result
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
+func (v *AdminService_SetTerminationProtection_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -4379,22 +16259,19 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_SetTerminationProtection_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Result.
+// Equals returns true if all the fields of this AdminService_SetTerminationProtection_Result match the
+// provided AdminService_SetTerminationProtection_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
+func (v *AdminService_SetTerminationProtection_Result) Equals(rhs *AdminService_SetTerminationProtection_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -4412,14 +16289,11 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminSe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_SetTerminationProtection_Result.
+func (v *AdminService_SetTerminationProtection_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -4435,24 +16309,9 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(en
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_SetTerminationProtection_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -4461,13 +16320,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError(
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
+func (v *AdminService_SetTerminationProtection_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_SetTerminationProtection_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -4476,13 +16335,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceE
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_SetTerminationProtection_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_SetTerminationProtection_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -4491,13 +16350,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistEr
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_SetTerminationProtection_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_SetTerminationProtection_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -4506,33 +16365,33 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_SetTerminationProtection_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "SetTerminationProtection" for this struct.
+func (v *AdminService_SetTerminationProtection_Result) MethodName() string {
+	return "SetTerminationProtection"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_SetTerminationProtection_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_RemoveTask_Args represents the arguments for the AdminService.RemoveTask function.
+// AdminService_UndeprecateDomain_Args represents the arguments for the AdminService.UndeprecateDomain function.
 //
-// The arguments for RemoveTask are sent and received over the wire as this struct.
-type AdminService_RemoveTask_Args struct {
-	Request *shared.RemoveTaskRequest `json:"request,omitempty"`
+// The arguments for UndeprecateDomain are sent and received over the wire as this struct.
+type AdminService_UndeprecateDomain_Args struct {
+	Request *UndeprecateDomainRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_RemoveTask_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UndeprecateDomain_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4547,7 +16406,7 @@ type AdminService_RemoveTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_UndeprecateDomain_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -4567,17 +16426,17 @@ func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
-	var v shared.RemoveTaskRequest
+func _UndeprecateDomainRequest_Read(w wire.Value) (*UndeprecateDomainRequest, error) {
+	var v UndeprecateDomainRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_RemoveTask_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_UndeprecateDomain_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RemoveTask_Args struct
+// An error is returned if we were unable to build a AdminService_UndeprecateDomain_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4585,19 +16444,19 @@ func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_RemoveTask_Args
+//   var v AdminService_UndeprecateDomain_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
+func (v *AdminService_UndeprecateDomain_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RemoveTaskRequest_Read(field.Value)
+				v.Request, err = _UndeprecateDomainRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4609,9 +16468,9 @@ func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RemoveTask_Args
+// String returns a readable string representation of a AdminService_UndeprecateDomain_Args
 // struct.
-func (v *AdminService_RemoveTask_Args) String() string {
+func (v *AdminService_UndeprecateDomain_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4623,14 +16482,14 @@ func (v *AdminService_RemoveTask_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RemoveTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UndeprecateDomain_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RemoveTask_Args match the
-// provided AdminService_RemoveTask_Args.
+// Equals returns true if all the fields of this AdminService_UndeprecateDomain_Args match the
+// provided AdminService_UndeprecateDomain_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args) bool {
+func (v *AdminService_UndeprecateDomain_Args) Equals(rhs *AdminService_UndeprecateDomain_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4644,8 +16503,8 @@ func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RemoveTask_Args.
-func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UndeprecateDomain_Args.
+func (v *AdminService_UndeprecateDomain_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -4657,7 +16516,7 @@ func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncode
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest) {
+func (v *AdminService_UndeprecateDomain_Args) GetRequest() (o *UndeprecateDomainRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -4666,119 +16525,126 @@ func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_RemoveTask_Args) IsSetRequest() bool {
+func (v *AdminService_UndeprecateDomain_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RemoveTask" for this struct.
-func (v *AdminService_RemoveTask_Args) MethodName() string {
-	return "RemoveTask"
+// This will always be "UndeprecateDomain" for this struct.
+func (v *AdminService_UndeprecateDomain_Args) MethodName() string {
+	return "UndeprecateDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_RemoveTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UndeprecateDomain_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_RemoveTask_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.RemoveTask
+// AdminService_UndeprecateDomain_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.UndeprecateDomain
 // function.
-var AdminService_RemoveTask_Helper = struct {
-	// Args accepts the parameters of RemoveTask in-order and returns
+var AdminService_UndeprecateDomain_Helper = struct {
+	// Args accepts the parameters of UndeprecateDomain in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.RemoveTaskRequest,
-	) *AdminService_RemoveTask_Args
+		request *UndeprecateDomainRequest,
+	) *AdminService_UndeprecateDomain_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RemoveTask.
+	// by UndeprecateDomain.
 	//
-	// An error can be thrown by RemoveTask only if the
+	// An error can be thrown by UndeprecateDomain only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RemoveTask
+	// WrapResponse returns the result struct for UndeprecateDomain
 	// given the error returned by it. The provided error may
-	// be nil if RemoveTask did not fail.
+	// be nil if UndeprecateDomain did not fail.
 	//
-	// This allows mapping errors returned by RemoveTask into a
+	// This allows mapping errors returned by UndeprecateDomain into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// RemoveTask
+	// UndeprecateDomain
 	//
-	//   err := RemoveTask(args)
-	//   result, err := AdminService_RemoveTask_Helper.WrapResponse(err)
+	//   err := UndeprecateDomain(args)
+	//   result, err := AdminService_UndeprecateDomain_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RemoveTask: %v", err)
+	//     return fmt.Errorf("unexpected error from UndeprecateDomain: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_RemoveTask_Result, error)
+	WrapResponse func(error) (*AdminService_UndeprecateDomain_Result, error)
 
-	// UnwrapResponse takes the result struct for RemoveTask
+	// UnwrapResponse takes the result struct for UndeprecateDomain
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if RemoveTask threw an
+	// The error is non-nil only if UndeprecateDomain threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_RemoveTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_RemoveTask_Result) error
+	//   err := AdminService_UndeprecateDomain_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_UndeprecateDomain_Result) error
 }{}
 
 func init() {
-	AdminService_RemoveTask_Helper.Args = func(
-		request *shared.RemoveTaskRequest,
-	) *AdminService_RemoveTask_Args {
-		return &AdminService_RemoveTask_Args{
+	AdminService_UndeprecateDomain_Helper.Args = func(
+		request *UndeprecateDomainRequest,
+	) *AdminService_UndeprecateDomain_Args {
+		return &AdminService_UndeprecateDomain_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_RemoveTask_Helper.IsException = func(err error) bool {
+	AdminService_UndeprecateDomain_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.AccessDeniedError:
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_RemoveTask_Helper.WrapResponse = func(err error) (*AdminService_RemoveTask_Result, error) {
+	AdminService_UndeprecateDomain_Helper.WrapResponse = func(err error) (*AdminService_UndeprecateDomain_Result, error) {
 		if err == nil {
-			return &AdminService_RemoveTask_Result{}, nil
+			return &AdminService_UndeprecateDomain_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UndeprecateDomain_Result.BadRequestError")
 			}
-			return &AdminService_RemoveTask_Result{BadRequestError: e}, nil
+			return &AdminService_UndeprecateDomain_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UndeprecateDomain_Result.InternalServiceError")
 			}
-			return &AdminService_RemoveTask_Result{InternalServiceError: e}, nil
-		case *shared.AccessDeniedError:
+			return &AdminService_UndeprecateDomain_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UndeprecateDomain_Result.EntityNotExistError")
 			}
-			return &AdminService_RemoveTask_Result{AccessDeniedError: e}, nil
+			return &AdminService_UndeprecateDomain_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UndeprecateDomain_Result.ServiceBusyError")
+			}
+			return &AdminService_UndeprecateDomain_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_RemoveTask_Helper.UnwrapResponse = func(result *AdminService_RemoveTask_Result) (err error) {
+	AdminService_UndeprecateDomain_Helper.UnwrapResponse = func(result *AdminService_UndeprecateDomain_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4787,8 +16653,12 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
 		return
@@ -4796,16 +16666,17 @@ func init() {
 
 }
 
-// AdminService_RemoveTask_Result represents the result of a AdminService.RemoveTask function call.
+// AdminService_UndeprecateDomain_Result represents the result of a AdminService.UndeprecateDomain function call.
 //
-// The result of a RemoveTask execution is sent and received over the wire as this struct.
-type AdminService_RemoveTask_Result struct {
+// The result of a UndeprecateDomain execution is sent and received over the wire as this struct.
+type AdminService_UndeprecateDomain_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_RemoveTask_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UndeprecateDomain_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4820,9 +16691,9 @@ type AdminService_RemoveTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_UndeprecateDomain_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4844,27 +16715,35 @@ func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		w, err = v.AccessDeniedError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_UndeprecateDomain_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_RemoveTask_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_UndeprecateDomain_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RemoveTask_Result struct
+// An error is returned if we were unable to build a AdminService_UndeprecateDomain_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4872,12 +16751,12 @@ func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_RemoveTask_Result
+//   var v AdminService_UndeprecateDomain_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
+func (v *AdminService_UndeprecateDomain_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4900,7 +16779,15 @@ func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4916,24 +16803,27 @@ func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.AccessDeniedError != nil {
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_UndeprecateDomain_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RemoveTask_Result
+// String returns a readable string representation of a AdminService_UndeprecateDomain_Result
 // struct.
-func (v *AdminService_RemoveTask_Result) String() string {
+func (v *AdminService_UndeprecateDomain_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -4943,19 +16833,23 @@ func (v *AdminService_RemoveTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.AccessDeniedError != nil {
-		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RemoveTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UndeprecateDomain_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RemoveTask_Result match the
-// provided AdminService_RemoveTask_Result.
+// Equals returns true if all the fields of this AdminService_UndeprecateDomain_Result match the
+// provided AdminService_UndeprecateDomain_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Result) bool {
+func (v *AdminService_UndeprecateDomain_Result) Equals(rhs *AdminService_UndeprecateDomain_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4967,7 +16861,10 @@ func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Res
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -4975,8 +16872,8 @@ func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Res
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RemoveTask_Result.
-func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UndeprecateDomain_Result.
+func (v *AdminService_UndeprecateDomain_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -4986,15 +16883,18 @@ func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.AccessDeniedError != nil {
-		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_UndeprecateDomain_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -5003,13 +16903,13 @@ func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequ
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetBadRequestError() bool {
+func (v *AdminService_UndeprecateDomain_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_UndeprecateDomain_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -5018,36 +16918,51 @@ func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.In
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_UndeprecateDomain_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
-	if v != nil && v.AccessDeniedError != nil {
-		return v.AccessDeniedError
+func (v *AdminService_UndeprecateDomain_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetAccessDeniedError() bool {
-	return v != nil && v.AccessDeniedError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_UndeprecateDomain_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UndeprecateDomain_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_UndeprecateDomain_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RemoveTask" for this struct.
-func (v *AdminService_RemoveTask_Result) MethodName() string {
-	return "RemoveTask"
+// This will always be "UndeprecateDomain" for this struct.
+func (v *AdminService_UndeprecateDomain_Result) MethodName() string {
+	return "UndeprecateDomain"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_RemoveTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UndeprecateDomain_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
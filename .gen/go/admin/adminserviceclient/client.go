@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw-plugin-yarpc
 // @generated
 
@@ -38,24 +16,60 @@ import (
 
 // Interface is a client for the AdminService service.
 type Interface interface {
+	AddOperatorAnnotation(
+		ctx context.Context,
+		Request *shared.AddOperatorAnnotationRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	AddSearchAttribute(
 		ctx context.Context,
 		Request *admin.AddSearchAttributeRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	CheckFailoverReadiness(
+		ctx context.Context,
+		Request *admin.CheckFailoverReadinessRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.CheckFailoverReadinessResponse, error)
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		Request *admin.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DescribeDynamicConfig(
+		ctx context.Context,
+		Request *admin.DescribeDynamicConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeDynamicConfigResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeQueue(
+		ctx context.Context,
+		Request *shared.DescribeQueueRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeQueueResponse, error)
+
+	DescribeShardDistribution(
+		ctx context.Context,
+		Request *admin.DescribeShardDistributionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeShardDistributionResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -68,16 +82,52 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
 
+	ListWorkers(
+		ctx context.Context,
+		Request *admin.ListWorkersRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListWorkersResponse, error)
+
+	MigrateDomain(
+		ctx context.Context,
+		Request *admin.MigrateDomainRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MigrateDomainResponse, error)
+
+	ReindexWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ReindexWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	ResetQueueState(
+		ctx context.Context,
+		Request *shared.ResetQueueStateRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	SetTerminationProtection(
+		ctx context.Context,
+		Request *admin.SetTerminationProtectionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UndeprecateDomain(
+		ctx context.Context,
+		Request *admin.UndeprecateDomainRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
 //
-// 	client := adminserviceclient.New(dispatcher.ClientConfig("adminservice"))
+//	client := adminserviceclient.New(dispatcher.ClientConfig("adminservice"))
 func New(c transport.ClientConfig, opts ...thrift.ClientOption) Interface {
 	return client{
 		c: thrift.New(thrift.Config{
@@ -99,6 +149,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) AddOperatorAnnotation(
+	ctx context.Context,
+	_Request *shared.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_AddOperatorAnnotation_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_AddOperatorAnnotation_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_AddOperatorAnnotation_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) AddSearchAttribute(
 	ctx context.Context,
 	_Request *admin.AddSearchAttributeRequest,
@@ -122,6 +195,29 @@ func (c client) AddSearchAttribute(
 	return
 }
 
+func (c client) CheckFailoverReadiness(
+	ctx context.Context,
+	_Request *admin.CheckFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.CheckFailoverReadinessResponse, err error) {
+
+	args := admin.AdminService_CheckFailoverReadiness_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CheckFailoverReadiness_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_CheckFailoverReadiness_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -145,6 +241,52 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_DeleteWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeDynamicConfig(
+	ctx context.Context,
+	_Request *admin.DescribeDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeDynamicConfigResponse, err error) {
+
+	args := admin.AdminService_DescribeDynamicConfig_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeDynamicConfig_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeDynamicConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -168,6 +310,52 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeQueue(
+	ctx context.Context,
+	_Request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeQueueResponse, err error) {
+
+	args := admin.AdminService_DescribeQueue_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeQueue_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeQueue_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeShardDistribution(
+	ctx context.Context,
+	_Request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeShardDistributionResponse, err error) {
+
+	args := admin.AdminService_DescribeShardDistribution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeShardDistribution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeShardDistribution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -214,6 +402,75 @@ func (c client) GetWorkflowExecutionRawHistory(
 	return
 }
 
+func (c client) ListWorkers(
+	ctx context.Context,
+	_Request *admin.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListWorkersResponse, err error) {
+
+	args := admin.AdminService_ListWorkers_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListWorkers_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListWorkers_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) MigrateDomain(
+	ctx context.Context,
+	_Request *admin.MigrateDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateDomainResponse, err error) {
+
+	args := admin.AdminService_MigrateDomain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MigrateDomain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MigrateDomain_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReindexWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ReindexWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReindexWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ReindexWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -236,3 +493,72 @@ func (c client) RemoveTask(
 	err = admin.AdminService_RemoveTask_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ResetQueueState(
+	ctx context.Context,
+	_Request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResetQueueState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResetQueueState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResetQueueState_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) SetTerminationProtection(
+	ctx context.Context,
+	_Request *admin.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_SetTerminationProtection_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_SetTerminationProtection_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_SetTerminationProtection_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UndeprecateDomain(
+	ctx context.Context,
+	_Request *admin.UndeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UndeprecateDomain_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UndeprecateDomain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UndeprecateDomain_Helper.UnwrapResponse(&result)
+	return
+}
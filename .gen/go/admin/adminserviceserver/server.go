@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw-plugin-yarpc
 // @generated
 
@@ -36,21 +14,51 @@ import (
 
 // Interface is the server-side interface for the AdminService service.
 type Interface interface {
+	AddOperatorAnnotation(
+		ctx context.Context,
+		Request *shared.AddOperatorAnnotationRequest,
+	) error
+
 	AddSearchAttribute(
 		ctx context.Context,
 		Request *admin.AddSearchAttributeRequest,
 	) error
 
+	CheckFailoverReadiness(
+		ctx context.Context,
+		Request *admin.CheckFailoverReadinessRequest,
+	) (*admin.CheckFailoverReadinessResponse, error)
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		Request *admin.DeleteWorkflowExecutionRequest,
+	) error
+
+	DescribeDynamicConfig(
+		ctx context.Context,
+		Request *admin.DescribeDynamicConfigRequest,
+	) (*admin.DescribeDynamicConfigResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeQueue(
+		ctx context.Context,
+		Request *shared.DescribeQueueRequest,
+	) (*shared.DescribeQueueResponse, error)
+
+	DescribeShardDistribution(
+		ctx context.Context,
+		Request *admin.DescribeShardDistributionRequest,
+	) (*admin.DescribeShardDistributionResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -61,23 +69,64 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
 
+	ListWorkers(
+		ctx context.Context,
+		Request *admin.ListWorkersRequest,
+	) (*admin.ListWorkersResponse, error)
+
+	MigrateDomain(
+		ctx context.Context,
+		Request *admin.MigrateDomainRequest,
+	) (*admin.MigrateDomainResponse, error)
+
+	ReindexWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ReindexWorkflowExecutionRequest,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 	) error
+
+	ResetQueueState(
+		ctx context.Context,
+		Request *shared.ResetQueueStateRequest,
+	) error
+
+	SetTerminationProtection(
+		ctx context.Context,
+		Request *admin.SetTerminationProtectionRequest,
+	) error
+
+	UndeprecateDomain(
+		ctx context.Context,
+		Request *admin.UndeprecateDomainRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
 // registration.
 //
-// 	handler := AdminServiceHandler{}
-// 	dispatcher.Register(adminserviceserver.New(handler))
+//	handler := AdminServiceHandler{}
+//	dispatcher.Register(adminserviceserver.New(handler))
 func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 	h := handler{impl}
 	service := thrift.Service{
 		Name: "AdminService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "AddOperatorAnnotation",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.AddOperatorAnnotation),
+				},
+				Signature:    "AddOperatorAnnotation(Request *shared.AddOperatorAnnotationRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "AddSearchAttribute",
 				HandlerSpec: thrift.HandlerSpec{
@@ -89,6 +138,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "CheckFailoverReadiness",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CheckFailoverReadiness),
+				},
+				Signature:    "CheckFailoverReadiness(Request *admin.CheckFailoverReadinessRequest) (*admin.CheckFailoverReadinessResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CloseShard",
 				HandlerSpec: thrift.HandlerSpec{
@@ -100,6 +160,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteWorkflowExecution),
+				},
+				Signature:    "DeleteWorkflowExecution(Request *admin.DeleteWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeDynamicConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeDynamicConfig),
+				},
+				Signature:    "DescribeDynamicConfig(Request *admin.DescribeDynamicConfigRequest) (*admin.DescribeDynamicConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -111,6 +193,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeQueue",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeQueue),
+				},
+				Signature:    "DescribeQueue(Request *shared.DescribeQueueRequest) (*shared.DescribeQueueResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DescribeShardDistribution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShardDistribution),
+				},
+				Signature:    "DescribeShardDistribution(Request *admin.DescribeShardDistributionRequest) (*admin.DescribeShardDistributionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -133,6 +237,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListWorkers",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListWorkers),
+				},
+				Signature:    "ListWorkers(Request *admin.ListWorkersRequest) (*admin.ListWorkersResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "MigrateDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.MigrateDomain),
+				},
+				Signature:    "MigrateDomain(Request *admin.MigrateDomainRequest) (*admin.MigrateDomainResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReindexWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReindexWorkflowExecution),
+				},
+				Signature:    "ReindexWorkflowExecution(Request *admin.ReindexWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -143,16 +280,68 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RemoveTask(Request *shared.RemoveTaskRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ResetQueueState",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResetQueueState),
+				},
+				Signature:    "ResetQueueState(Request *shared.ResetQueueStateRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "SetTerminationProtection",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.SetTerminationProtection),
+				},
+				Signature:    "SetTerminationProtection(Request *admin.SetTerminationProtectionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UndeprecateDomain",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UndeprecateDomain),
+				},
+				Signature:    "UndeprecateDomain(Request *admin.UndeprecateDomainRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 6)
+	procedures := make([]transport.Procedure, 0, 18)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) AddOperatorAnnotation(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_AddOperatorAnnotation_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.AddOperatorAnnotation(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_AddOperatorAnnotation_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_AddSearchAttribute_Args
 	if err := args.FromWire(body); err != nil {
@@ -172,6 +361,25 @@ func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrif
 	return response, err
 }
 
+func (h handler) CheckFailoverReadiness(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CheckFailoverReadiness_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.CheckFailoverReadiness(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_CheckFailoverReadiness_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_CloseShard_Args
 	if err := args.FromWire(body); err != nil {
@@ -191,6 +399,44 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DeleteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DeleteWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DeleteWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DeleteWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeDynamicConfig(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeDynamicConfig_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -210,6 +456,44 @@ func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) DescribeQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeQueue_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeQueue(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeQueue_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeShardDistribution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShardDistribution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -248,6 +532,63 @@ func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) ListWorkers(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListWorkers_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListWorkers(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListWorkers_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) MigrateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MigrateDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.MigrateDomain(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_MigrateDomain_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReindexWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReindexWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ReindexWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ReindexWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RemoveTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -266,3 +607,60 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	}
 	return response, err
 }
+
+func (h handler) ResetQueueState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetQueueState_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResetQueueState(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResetQueueState_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) SetTerminationProtection(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_SetTerminationProtection_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.SetTerminationProtection(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_SetTerminationProtection_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UndeprecateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UndeprecateDomain_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UndeprecateDomain(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UndeprecateDomain_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw-plugin-yarpc
 // @generated
 
@@ -49,8 +27,8 @@ type _MockClientRecorder struct {
 
 // Build a new mock client for service AdminService.
 //
-// 	mockCtrl := gomock.NewController(t)
-// 	client := adminservicetest.NewMockClient(mockCtrl)
+//	mockCtrl := gomock.NewController(t)
+//	client := adminservicetest.NewMockClient(mockCtrl)
 //
 // Use EXPECT() to set expectations on the mock.
 func NewMockClient(ctrl *gomock.Controller) *MockClient {
@@ -65,12 +43,43 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// AddOperatorAnnotation responds to a AddOperatorAnnotation call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().AddOperatorAnnotation(gomock.Any(), ...).Return(...)
+//	... := client.AddOperatorAnnotation(...)
+func (m *MockClient) AddOperatorAnnotation(
+	ctx context.Context,
+	_Request *shared.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "AddOperatorAnnotation", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) AddOperatorAnnotation(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "AddOperatorAnnotation", args...)
+}
+
 // AddSearchAttribute responds to a AddSearchAttribute call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().AddSearchAttribute(gomock.Any(), ...).Return(...)
-// 	... := client.AddSearchAttribute(...)
+//	client.EXPECT().AddSearchAttribute(gomock.Any(), ...).Return(...)
+//	... := client.AddSearchAttribute(...)
 func (m *MockClient) AddSearchAttribute(
 	ctx context.Context,
 	_Request *admin.AddSearchAttributeRequest,
@@ -96,12 +105,45 @@ func (mr *_MockClientRecorder) AddSearchAttribute(
 	return mr.mock.ctrl.RecordCall(mr.mock, "AddSearchAttribute", args...)
 }
 
+// CheckFailoverReadiness responds to a CheckFailoverReadiness call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().CheckFailoverReadiness(gomock.Any(), ...).Return(...)
+//	... := client.CheckFailoverReadiness(...)
+func (m *MockClient) CheckFailoverReadiness(
+	ctx context.Context,
+	_Request *admin.CheckFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.CheckFailoverReadinessResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "CheckFailoverReadiness", args...)
+	success, _ = ret[i].(*admin.CheckFailoverReadinessResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) CheckFailoverReadiness(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "CheckFailoverReadiness", args...)
+}
+
 // CloseShard responds to a CloseShard call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().CloseShard(gomock.Any(), ...).Return(...)
-// 	... := client.CloseShard(...)
+//	client.EXPECT().CloseShard(gomock.Any(), ...).Return(...)
+//	... := client.CloseShard(...)
 func (m *MockClient) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -127,12 +169,76 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DeleteWorkflowExecution responds to a DeleteWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DeleteWorkflowExecution(...)
+func (m *MockClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflowExecution", args...)
+}
+
+// DescribeDynamicConfig responds to a DescribeDynamicConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeDynamicConfig(gomock.Any(), ...).Return(...)
+//	... := client.DescribeDynamicConfig(...)
+func (m *MockClient) DescribeDynamicConfig(
+	ctx context.Context,
+	_Request *admin.DescribeDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeDynamicConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeDynamicConfig", args...)
+	success, _ = ret[i].(*admin.DescribeDynamicConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeDynamicConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeDynamicConfig", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeHistoryHost(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeHistoryHost(...)
+//	client.EXPECT().DescribeHistoryHost(gomock.Any(), ...).Return(...)
+//	... := client.DescribeHistoryHost(...)
 func (m *MockClient) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -160,12 +266,78 @@ func (mr *_MockClientRecorder) DescribeHistoryHost(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeHistoryHost", args...)
 }
 
+// DescribeQueue responds to a DescribeQueue call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeQueue(gomock.Any(), ...).Return(...)
+//	... := client.DescribeQueue(...)
+func (m *MockClient) DescribeQueue(
+	ctx context.Context,
+	_Request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeQueueResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeQueue", args...)
+	success, _ = ret[i].(*shared.DescribeQueueResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeQueue(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeQueue", args...)
+}
+
+// DescribeShardDistribution responds to a DescribeShardDistribution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeShardDistribution(gomock.Any(), ...).Return(...)
+//	... := client.DescribeShardDistribution(...)
+func (m *MockClient) DescribeShardDistribution(
+	ctx context.Context,
+	_Request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeShardDistributionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeShardDistribution", args...)
+	success, _ = ret[i].(*admin.DescribeShardDistributionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeShardDistribution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeShardDistribution", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeWorkflowExecution(...)
+//	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DescribeWorkflowExecution(...)
 func (m *MockClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -197,8 +369,8 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), ...).Return(...)
-// 	... := client.GetWorkflowExecutionRawHistory(...)
+//	client.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), ...).Return(...)
+//	... := client.GetWorkflowExecutionRawHistory(...)
 func (m *MockClient) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	_GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
@@ -226,12 +398,109 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistory(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistory", args...)
 }
 
+// ListWorkers responds to a ListWorkers call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListWorkers(gomock.Any(), ...).Return(...)
+//	... := client.ListWorkers(...)
+func (m *MockClient) ListWorkers(
+	ctx context.Context,
+	_Request *admin.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListWorkersResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListWorkers", args...)
+	success, _ = ret[i].(*admin.ListWorkersResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListWorkers(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkers", args...)
+}
+
+// MigrateDomain responds to a MigrateDomain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().MigrateDomain(gomock.Any(), ...).Return(...)
+//	... := client.MigrateDomain(...)
+func (m *MockClient) MigrateDomain(
+	ctx context.Context,
+	_Request *admin.MigrateDomainRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateDomainResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MigrateDomain", args...)
+	success, _ = ret[i].(*admin.MigrateDomainResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MigrateDomain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MigrateDomain", args...)
+}
+
+// ReindexWorkflowExecution responds to a ReindexWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReindexWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ReindexWorkflowExecution(...)
+func (m *MockClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReindexWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReindexWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReindexWorkflowExecution", args...)
+}
+
 // RemoveTask responds to a RemoveTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RemoveTask(gomock.Any(), ...).Return(...)
-// 	... := client.RemoveTask(...)
+//	client.EXPECT().RemoveTask(gomock.Any(), ...).Return(...)
+//	... := client.RemoveTask(...)
 func (m *MockClient) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -256,3 +525,96 @@ func (mr *_MockClientRecorder) RemoveTask(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
+
+// ResetQueueState responds to a ResetQueueState call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetQueueState(gomock.Any(), ...).Return(...)
+//	... := client.ResetQueueState(...)
+func (m *MockClient) ResetQueueState(
+	ctx context.Context,
+	_Request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetQueueState", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetQueueState(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetQueueState", args...)
+}
+
+// SetTerminationProtection responds to a SetTerminationProtection call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().SetTerminationProtection(gomock.Any(), ...).Return(...)
+//	... := client.SetTerminationProtection(...)
+func (m *MockClient) SetTerminationProtection(
+	ctx context.Context,
+	_Request *admin.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SetTerminationProtection", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SetTerminationProtection(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SetTerminationProtection", args...)
+}
+
+// UndeprecateDomain responds to a UndeprecateDomain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UndeprecateDomain(gomock.Any(), ...).Return(...)
+//	... := client.UndeprecateDomain(...)
+func (m *MockClient) UndeprecateDomain(
+	ctx context.Context,
+	_Request *admin.UndeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UndeprecateDomain", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UndeprecateDomain(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UndeprecateDomain", args...)
+}
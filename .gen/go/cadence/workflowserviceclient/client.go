@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw-plugin-yarpc
 // @generated
 
@@ -39,6 +17,18 @@ import (
 
 // Interface is a client for the WorkflowService service.
 type Interface interface {
+	BatchDescribeWorkflowExecutions(
+		ctx context.Context,
+		BatchRequest *shared.BatchDescribeWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.BatchDescribeWorkflowExecutionsResponse, error)
+
+	BatchOperationWorkflow(
+		ctx context.Context,
+		BatchRequest *shared.BatchOperationWorkflowRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.BatchOperationWorkflowResponse, error)
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -51,6 +41,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DescribeBatchOperation(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeBatchOperationRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeBatchOperationResponse, error)
+
 	DescribeDomain(
 		ctx context.Context,
 		DescribeRequest *shared.DescribeDomainRequest,
@@ -86,6 +82,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.GetSearchAttributesResponse, error)
 
+	GetSearchAttributesSchema(
+		ctx context.Context,
+		Request *shared.GetSearchAttributesSchemaRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetSearchAttributesSchemaResponse, error)
+
 	GetWorkflowExecutionHistory(
 		ctx context.Context,
 		GetRequest *shared.GetWorkflowExecutionHistoryRequest,
@@ -116,12 +118,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListWorkflowExecutionChain(
+		ctx context.Context,
+		ListRequest *shared.ListWorkflowExecutionChainRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListWorkflowExecutionChainResponse, error)
+
 	ListWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *shared.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -230,6 +244,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *shared.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScanWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -269,7 +289,7 @@ type Interface interface {
 
 // New builds a new client for the WorkflowService service.
 //
-// 	client := workflowserviceclient.New(dispatcher.ClientConfig("workflowservice"))
+//	client := workflowserviceclient.New(dispatcher.ClientConfig("workflowservice"))
 func New(c transport.ClientConfig, opts ...thrift.ClientOption) Interface {
 	return client{
 		c: thrift.New(thrift.Config{
@@ -291,6 +311,52 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	_BatchRequest *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+
+	args := cadence.WorkflowService_BatchDescribeWorkflowExecutions_Helper.Args(_BatchRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_BatchDescribeWorkflowExecutions_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_BatchDescribeWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) BatchOperationWorkflow(
+	ctx context.Context,
+	_BatchRequest *shared.BatchOperationWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchOperationWorkflowResponse, err error) {
+
+	args := cadence.WorkflowService_BatchOperationWorkflow_Helper.Args(_BatchRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_BatchOperationWorkflow_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_BatchOperationWorkflow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CountWorkflowExecutions(
 	ctx context.Context,
 	_CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -337,6 +403,29 @@ func (c client) DeprecateDomain(
 	return
 }
 
+func (c client) DescribeBatchOperation(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeBatchOperationRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeBatchOperationResponse, err error) {
+
+	args := cadence.WorkflowService_DescribeBatchOperation_Helper.Args(_DescribeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DescribeBatchOperation_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_DescribeBatchOperation_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeDomain(
 	ctx context.Context,
 	_DescribeRequest *shared.DescribeDomainRequest,
@@ -474,6 +563,29 @@ func (c client) GetSearchAttributes(
 	return
 }
 
+func (c client) GetSearchAttributesSchema(
+	ctx context.Context,
+	_Request *shared.GetSearchAttributesSchemaRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetSearchAttributesSchemaResponse, err error) {
+
+	args := cadence.WorkflowService_GetSearchAttributesSchema_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_GetSearchAttributesSchema_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_GetSearchAttributesSchema_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	_GetRequest *shared.GetWorkflowExecutionHistoryRequest,
@@ -589,6 +701,29 @@ func (c client) ListOpenWorkflowExecutions(
 	return
 }
 
+func (c client) ListWorkflowExecutionChain(
+	ctx context.Context,
+	_ListRequest *shared.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkflowExecutionChainResponse, err error) {
+
+	args := cadence.WorkflowService_ListWorkflowExecutionChain_Helper.Args(_ListRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ListWorkflowExecutionChain_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_ListWorkflowExecutionChain_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -612,6 +747,29 @@ func (c client) ListWorkflowExecutions(
 	return
 }
 
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -1026,6 +1184,29 @@ func (c client) RespondQueryTaskCompleted(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_ResumeWorkflowExecution_Helper.Args(_ResumeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ResumeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScanWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw-plugin-yarpc
 // @generated
 
@@ -49,8 +27,8 @@ type _MockClientRecorder struct {
 
 // Build a new mock client for service WorkflowService.
 //
-// 	mockCtrl := gomock.NewController(t)
-// 	client := workflowservicetest.NewMockClient(mockCtrl)
+//	mockCtrl := gomock.NewController(t)
+//	client := workflowservicetest.NewMockClient(mockCtrl)
 //
 // Use EXPECT() to set expectations on the mock.
 func NewMockClient(ctrl *gomock.Controller) *MockClient {
@@ -65,12 +43,78 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// BatchDescribeWorkflowExecutions responds to a BatchDescribeWorkflowExecutions call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().BatchDescribeWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.BatchDescribeWorkflowExecutions(...)
+func (m *MockClient) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	_BatchRequest *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchDescribeWorkflowExecutionsResponse, err error) {
+
+	args := []interface{}{ctx, _BatchRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BatchDescribeWorkflowExecutions", args...)
+	success, _ = ret[i].(*shared.BatchDescribeWorkflowExecutionsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BatchDescribeWorkflowExecutions(
+	ctx interface{},
+	_BatchRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _BatchRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BatchDescribeWorkflowExecutions", args...)
+}
+
+// BatchOperationWorkflow responds to a BatchOperationWorkflow call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().BatchOperationWorkflow(gomock.Any(), ...).Return(...)
+//	... := client.BatchOperationWorkflow(...)
+func (m *MockClient) BatchOperationWorkflow(
+	ctx context.Context,
+	_BatchRequest *shared.BatchOperationWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.BatchOperationWorkflowResponse, err error) {
+
+	args := []interface{}{ctx, _BatchRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BatchOperationWorkflow", args...)
+	success, _ = ret[i].(*shared.BatchOperationWorkflowResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BatchOperationWorkflow(
+	ctx interface{},
+	_BatchRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _BatchRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BatchOperationWorkflow", args...)
+}
+
 // CountWorkflowExecutions responds to a CountWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().CountWorkflowExecutions(gomock.Any(), ...).Return(...)
-// 	... := client.CountWorkflowExecutions(...)
+//	client.EXPECT().CountWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.CountWorkflowExecutions(...)
 func (m *MockClient) CountWorkflowExecutions(
 	ctx context.Context,
 	_CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -102,8 +146,8 @@ func (mr *_MockClientRecorder) CountWorkflowExecutions(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DeprecateDomain(gomock.Any(), ...).Return(...)
-// 	... := client.DeprecateDomain(...)
+//	client.EXPECT().DeprecateDomain(gomock.Any(), ...).Return(...)
+//	... := client.DeprecateDomain(...)
 func (m *MockClient) DeprecateDomain(
 	ctx context.Context,
 	_DeprecateRequest *shared.DeprecateDomainRequest,
@@ -129,12 +173,45 @@ func (mr *_MockClientRecorder) DeprecateDomain(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DeprecateDomain", args...)
 }
 
+// DescribeBatchOperation responds to a DescribeBatchOperation call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeBatchOperation(gomock.Any(), ...).Return(...)
+//	... := client.DescribeBatchOperation(...)
+func (m *MockClient) DescribeBatchOperation(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeBatchOperationRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeBatchOperationResponse, err error) {
+
+	args := []interface{}{ctx, _DescribeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeBatchOperation", args...)
+	success, _ = ret[i].(*shared.DescribeBatchOperationResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeBatchOperation(
+	ctx interface{},
+	_DescribeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DescribeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeBatchOperation", args...)
+}
+
 // DescribeDomain responds to a DescribeDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeDomain(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeDomain(...)
+//	client.EXPECT().DescribeDomain(gomock.Any(), ...).Return(...)
+//	... := client.DescribeDomain(...)
 func (m *MockClient) DescribeDomain(
 	ctx context.Context,
 	_DescribeRequest *shared.DescribeDomainRequest,
@@ -166,8 +243,8 @@ func (mr *_MockClientRecorder) DescribeDomain(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeTaskList(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeTaskList(...)
+//	client.EXPECT().DescribeTaskList(gomock.Any(), ...).Return(...)
+//	... := client.DescribeTaskList(...)
 func (m *MockClient) DescribeTaskList(
 	ctx context.Context,
 	_Request *shared.DescribeTaskListRequest,
@@ -199,8 +276,8 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeWorkflowExecution(...)
+//	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DescribeWorkflowExecution(...)
 func (m *MockClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *shared.DescribeWorkflowExecutionRequest,
@@ -232,8 +309,8 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().GetDomainReplicationMessages(gomock.Any(), ...).Return(...)
-// 	... := client.GetDomainReplicationMessages(...)
+//	client.EXPECT().GetDomainReplicationMessages(gomock.Any(), ...).Return(...)
+//	... := client.GetDomainReplicationMessages(...)
 func (m *MockClient) GetDomainReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetDomainReplicationMessagesRequest,
@@ -265,8 +342,8 @@ func (mr *_MockClientRecorder) GetDomainReplicationMessages(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().GetReplicationMessages(gomock.Any(), ...).Return(...)
-// 	... := client.GetReplicationMessages(...)
+//	client.EXPECT().GetReplicationMessages(gomock.Any(), ...).Return(...)
+//	... := client.GetReplicationMessages(...)
 func (m *MockClient) GetReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetReplicationMessagesRequest,
@@ -298,8 +375,8 @@ func (mr *_MockClientRecorder) GetReplicationMessages(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().GetSearchAttributes(gomock.Any(), ...).Return(...)
-// 	... := client.GetSearchAttributes(...)
+//	client.EXPECT().GetSearchAttributes(gomock.Any(), ...).Return(...)
+//	... := client.GetSearchAttributes(...)
 func (m *MockClient) GetSearchAttributes(
 	ctx context.Context,
 	opts ...yarpc.CallOption,
@@ -325,12 +402,45 @@ func (mr *_MockClientRecorder) GetSearchAttributes(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetSearchAttributes", args...)
 }
 
+// GetSearchAttributesSchema responds to a GetSearchAttributesSchema call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetSearchAttributesSchema(gomock.Any(), ...).Return(...)
+//	... := client.GetSearchAttributesSchema(...)
+func (m *MockClient) GetSearchAttributesSchema(
+	ctx context.Context,
+	_Request *shared.GetSearchAttributesSchemaRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetSearchAttributesSchemaResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetSearchAttributesSchema", args...)
+	success, _ = ret[i].(*shared.GetSearchAttributesSchemaResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetSearchAttributesSchema(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetSearchAttributesSchema", args...)
+}
+
 // GetWorkflowExecutionHistory responds to a GetWorkflowExecutionHistory call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), ...).Return(...)
-// 	... := client.GetWorkflowExecutionHistory(...)
+//	client.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), ...).Return(...)
+//	... := client.GetWorkflowExecutionHistory(...)
 func (m *MockClient) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	_GetRequest *shared.GetWorkflowExecutionHistoryRequest,
@@ -362,8 +472,8 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionHistory(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), ...).Return(...)
-// 	... := client.ListArchivedWorkflowExecutions(...)
+//	client.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.ListArchivedWorkflowExecutions(...)
 func (m *MockClient) ListArchivedWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListArchivedWorkflowExecutionsRequest,
@@ -395,8 +505,8 @@ func (mr *_MockClientRecorder) ListArchivedWorkflowExecutions(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), ...).Return(...)
-// 	... := client.ListClosedWorkflowExecutions(...)
+//	client.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.ListClosedWorkflowExecutions(...)
 func (m *MockClient) ListClosedWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListClosedWorkflowExecutionsRequest,
@@ -428,8 +538,8 @@ func (mr *_MockClientRecorder) ListClosedWorkflowExecutions(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ListDomains(gomock.Any(), ...).Return(...)
-// 	... := client.ListDomains(...)
+//	client.EXPECT().ListDomains(gomock.Any(), ...).Return(...)
+//	... := client.ListDomains(...)
 func (m *MockClient) ListDomains(
 	ctx context.Context,
 	_ListRequest *shared.ListDomainsRequest,
@@ -461,8 +571,8 @@ func (mr *_MockClientRecorder) ListDomains(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), ...).Return(...)
-// 	... := client.ListOpenWorkflowExecutions(...)
+//	client.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.ListOpenWorkflowExecutions(...)
 func (m *MockClient) ListOpenWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListOpenWorkflowExecutionsRequest,
@@ -490,12 +600,45 @@ func (mr *_MockClientRecorder) ListOpenWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListOpenWorkflowExecutions", args...)
 }
 
+// ListWorkflowExecutionChain responds to a ListWorkflowExecutionChain call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListWorkflowExecutionChain(gomock.Any(), ...).Return(...)
+//	... := client.ListWorkflowExecutionChain(...)
+func (m *MockClient) ListWorkflowExecutionChain(
+	ctx context.Context,
+	_ListRequest *shared.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkflowExecutionChainResponse, err error) {
+
+	args := []interface{}{ctx, _ListRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListWorkflowExecutionChain", args...)
+	success, _ = ret[i].(*shared.ListWorkflowExecutionChainResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListWorkflowExecutionChain(
+	ctx interface{},
+	_ListRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ListRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkflowExecutionChain", args...)
+}
+
 // ListWorkflowExecutions responds to a ListWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ListWorkflowExecutions(gomock.Any(), ...).Return(...)
-// 	... := client.ListWorkflowExecutions(...)
+//	client.EXPECT().ListWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.ListWorkflowExecutions(...)
 func (m *MockClient) ListWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -523,12 +666,43 @@ func (mr *_MockClientRecorder) ListWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkflowExecutions", args...)
 }
 
+// PauseWorkflowExecution responds to a PauseWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PauseWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.PauseWorkflowExecution(...)
+func (m *MockClient) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseWorkflowExecution(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseWorkflowExecution", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().PollForActivityTask(gomock.Any(), ...).Return(...)
-// 	... := client.PollForActivityTask(...)
+//	client.EXPECT().PollForActivityTask(gomock.Any(), ...).Return(...)
+//	... := client.PollForActivityTask(...)
 func (m *MockClient) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -560,8 +734,8 @@ func (mr *_MockClientRecorder) PollForActivityTask(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().PollForDecisionTask(gomock.Any(), ...).Return(...)
-// 	... := client.PollForDecisionTask(...)
+//	client.EXPECT().PollForDecisionTask(gomock.Any(), ...).Return(...)
+//	... := client.PollForDecisionTask(...)
 func (m *MockClient) PollForDecisionTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForDecisionTaskRequest,
@@ -593,8 +767,8 @@ func (mr *_MockClientRecorder) PollForDecisionTask(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().QueryWorkflow(gomock.Any(), ...).Return(...)
-// 	... := client.QueryWorkflow(...)
+//	client.EXPECT().QueryWorkflow(gomock.Any(), ...).Return(...)
+//	... := client.QueryWorkflow(...)
 func (m *MockClient) QueryWorkflow(
 	ctx context.Context,
 	_QueryRequest *shared.QueryWorkflowRequest,
@@ -626,8 +800,8 @@ func (mr *_MockClientRecorder) QueryWorkflow(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), ...).Return(...)
-// 	... := client.RecordActivityTaskHeartbeat(...)
+//	client.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), ...).Return(...)
+//	... := client.RecordActivityTaskHeartbeat(...)
 func (m *MockClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest,
@@ -659,8 +833,8 @@ func (mr *_MockClientRecorder) RecordActivityTaskHeartbeat(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RecordActivityTaskHeartbeatByID(gomock.Any(), ...).Return(...)
-// 	... := client.RecordActivityTaskHeartbeatByID(...)
+//	client.EXPECT().RecordActivityTaskHeartbeatByID(gomock.Any(), ...).Return(...)
+//	... := client.RecordActivityTaskHeartbeatByID(...)
 func (m *MockClient) RecordActivityTaskHeartbeatByID(
 	ctx context.Context,
 	_HeartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest,
@@ -692,8 +866,8 @@ func (mr *_MockClientRecorder) RecordActivityTaskHeartbeatByID(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RegisterDomain(gomock.Any(), ...).Return(...)
-// 	... := client.RegisterDomain(...)
+//	client.EXPECT().RegisterDomain(gomock.Any(), ...).Return(...)
+//	... := client.RegisterDomain(...)
 func (m *MockClient) RegisterDomain(
 	ctx context.Context,
 	_RegisterRequest *shared.RegisterDomainRequest,
@@ -723,8 +897,8 @@ func (mr *_MockClientRecorder) RegisterDomain(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.RequestCancelWorkflowExecution(...)
+//	client.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.RequestCancelWorkflowExecution(...)
 func (m *MockClient) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	_CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
@@ -754,8 +928,8 @@ func (mr *_MockClientRecorder) RequestCancelWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ResetStickyTaskList(gomock.Any(), ...).Return(...)
-// 	... := client.ResetStickyTaskList(...)
+//	client.EXPECT().ResetStickyTaskList(gomock.Any(), ...).Return(...)
+//	... := client.ResetStickyTaskList(...)
 func (m *MockClient) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *shared.ResetStickyTaskListRequest,
@@ -787,8 +961,8 @@ func (mr *_MockClientRecorder) ResetStickyTaskList(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ResetWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.ResetWorkflowExecution(...)
+//	client.EXPECT().ResetWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ResetWorkflowExecution(...)
 func (m *MockClient) ResetWorkflowExecution(
 	ctx context.Context,
 	_ResetRequest *shared.ResetWorkflowExecutionRequest,
@@ -820,8 +994,8 @@ func (mr *_MockClientRecorder) ResetWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskCanceled(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskCanceled(...)
+//	client.EXPECT().RespondActivityTaskCanceled(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskCanceled(...)
 func (m *MockClient) RespondActivityTaskCanceled(
 	ctx context.Context,
 	_CanceledRequest *shared.RespondActivityTaskCanceledRequest,
@@ -851,8 +1025,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskCanceled(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskCanceledByID(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskCanceledByID(...)
+//	client.EXPECT().RespondActivityTaskCanceledByID(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskCanceledByID(...)
 func (m *MockClient) RespondActivityTaskCanceledByID(
 	ctx context.Context,
 	_CanceledRequest *shared.RespondActivityTaskCanceledByIDRequest,
@@ -882,8 +1056,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskCanceledByID(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskCompleted(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskCompleted(...)
+//	client.EXPECT().RespondActivityTaskCompleted(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskCompleted(...)
 func (m *MockClient) RespondActivityTaskCompleted(
 	ctx context.Context,
 	_CompleteRequest *shared.RespondActivityTaskCompletedRequest,
@@ -913,8 +1087,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskCompleted(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskCompletedByID(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskCompletedByID(...)
+//	client.EXPECT().RespondActivityTaskCompletedByID(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskCompletedByID(...)
 func (m *MockClient) RespondActivityTaskCompletedByID(
 	ctx context.Context,
 	_CompleteRequest *shared.RespondActivityTaskCompletedByIDRequest,
@@ -944,8 +1118,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskCompletedByID(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskFailed(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskFailed(...)
+//	client.EXPECT().RespondActivityTaskFailed(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskFailed(...)
 func (m *MockClient) RespondActivityTaskFailed(
 	ctx context.Context,
 	_FailRequest *shared.RespondActivityTaskFailedRequest,
@@ -975,8 +1149,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskFailed(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskFailedByID(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskFailedByID(...)
+//	client.EXPECT().RespondActivityTaskFailedByID(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskFailedByID(...)
 func (m *MockClient) RespondActivityTaskFailedByID(
 	ctx context.Context,
 	_FailRequest *shared.RespondActivityTaskFailedByIDRequest,
@@ -1006,8 +1180,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskFailedByID(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondDecisionTaskCompleted(gomock.Any(), ...).Return(...)
-// 	... := client.RespondDecisionTaskCompleted(...)
+//	client.EXPECT().RespondDecisionTaskCompleted(gomock.Any(), ...).Return(...)
+//	... := client.RespondDecisionTaskCompleted(...)
 func (m *MockClient) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	_CompleteRequest *shared.RespondDecisionTaskCompletedRequest,
@@ -1039,8 +1213,8 @@ func (mr *_MockClientRecorder) RespondDecisionTaskCompleted(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondDecisionTaskFailed(gomock.Any(), ...).Return(...)
-// 	... := client.RespondDecisionTaskFailed(...)
+//	client.EXPECT().RespondDecisionTaskFailed(gomock.Any(), ...).Return(...)
+//	... := client.RespondDecisionTaskFailed(...)
 func (m *MockClient) RespondDecisionTaskFailed(
 	ctx context.Context,
 	_FailedRequest *shared.RespondDecisionTaskFailedRequest,
@@ -1070,8 +1244,8 @@ func (mr *_MockClientRecorder) RespondDecisionTaskFailed(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondQueryTaskCompleted(gomock.Any(), ...).Return(...)
-// 	... := client.RespondQueryTaskCompleted(...)
+//	client.EXPECT().RespondQueryTaskCompleted(gomock.Any(), ...).Return(...)
+//	... := client.RespondQueryTaskCompleted(...)
 func (m *MockClient) RespondQueryTaskCompleted(
 	ctx context.Context,
 	_CompleteRequest *shared.RespondQueryTaskCompletedRequest,
@@ -1097,12 +1271,43 @@ func (mr *_MockClientRecorder) RespondQueryTaskCompleted(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondQueryTaskCompleted", args...)
 }
 
+// ResumeWorkflowExecution responds to a ResumeWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResumeWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ResumeWorkflowExecution(...)
+func (m *MockClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ResumeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeWorkflowExecution(
+	ctx interface{},
+	_ResumeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ResumeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeWorkflowExecution", args...)
+}
+
 // ScanWorkflowExecutions responds to a ScanWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ScanWorkflowExecutions(gomock.Any(), ...).Return(...)
-// 	... := client.ScanWorkflowExecutions(...)
+//	client.EXPECT().ScanWorkflowExecutions(gomock.Any(), ...).Return(...)
+//	... := client.ScanWorkflowExecutions(...)
 func (m *MockClient) ScanWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -1134,8 +1339,8 @@ func (mr *_MockClientRecorder) ScanWorkflowExecutions(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.SignalWithStartWorkflowExecution(...)
+//	client.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.SignalWithStartWorkflowExecution(...)
 func (m *MockClient) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	_SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest,
@@ -1167,8 +1372,8 @@ func (mr *_MockClientRecorder) SignalWithStartWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().SignalWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.SignalWorkflowExecution(...)
+//	client.EXPECT().SignalWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.SignalWorkflowExecution(...)
 func (m *MockClient) SignalWorkflowExecution(
 	ctx context.Context,
 	_SignalRequest *shared.SignalWorkflowExecutionRequest,
@@ -1198,8 +1403,8 @@ func (mr *_MockClientRecorder) SignalWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().StartWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.StartWorkflowExecution(...)
+//	client.EXPECT().StartWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.StartWorkflowExecution(...)
 func (m *MockClient) StartWorkflowExecution(
 	ctx context.Context,
 	_StartRequest *shared.StartWorkflowExecutionRequest,
@@ -1231,8 +1436,8 @@ func (mr *_MockClientRecorder) StartWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().TerminateWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.TerminateWorkflowExecution(...)
+//	client.EXPECT().TerminateWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.TerminateWorkflowExecution(...)
 func (m *MockClient) TerminateWorkflowExecution(
 	ctx context.Context,
 	_TerminateRequest *shared.TerminateWorkflowExecutionRequest,
@@ -1262,8 +1467,8 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().UpdateDomain(gomock.Any(), ...).Return(...)
-// 	... := client.UpdateDomain(...)
+//	client.EXPECT().UpdateDomain(gomock.Any(), ...).Return(...)
+//	... := client.UpdateDomain(...)
 func (m *MockClient) UpdateDomain(
 	ctx context.Context,
 	_UpdateRequest *shared.UpdateDomainRequest,
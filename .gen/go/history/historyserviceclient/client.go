@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw-plugin-yarpc
 // @generated
 
@@ -39,12 +17,24 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	AddOperatorAnnotation(
+		ctx context.Context,
+		AnnotationRequest *history.AddOperatorAnnotationRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		Request *history.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -57,12 +47,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.DescribeMutableStateResponse, error)
 
+	DescribeQueue(
+		ctx context.Context,
+		Request *shared.DescribeQueueRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeQueueResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	DetectZombieWorkflowExecution(
+		ctx context.Context,
+		Request *history.DetectZombieWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*history.DetectZombieWorkflowExecutionResponse, error)
+
 	GetMutableState(
 		ctx context.Context,
 		GetRequest *history.GetMutableStateRequest,
@@ -75,6 +77,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *history.GetReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetReplicationStatusResponse, error)
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *history.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	QueryWorkflow(
 		ctx context.Context,
 		QueryRequest *history.QueryWorkflowRequest,
@@ -87,12 +101,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.RecordActivityTaskHeartbeatResponse, error)
 
+	RecordActivityTaskHeartbeatBatch(
+		ctx context.Context,
+		BatchRequest *history.RecordActivityTaskHeartbeatBatchRequest,
+		opts ...yarpc.CallOption,
+	) (*history.RecordActivityTaskHeartbeatBatchResponse, error)
+
 	RecordActivityTaskStarted(
 		ctx context.Context,
 		AddRequest *history.RecordActivityTaskStartedRequest,
 		opts ...yarpc.CallOption,
 	) (*history.RecordActivityTaskStartedResponse, error)
 
+	RecordActivityTaskWorkerUnavailable(
+		ctx context.Context,
+		Request *history.RecordActivityTaskWorkerUnavailableRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RecordChildExecutionCompleted(
 		ctx context.Context,
 		CompletionRequest *history.RecordChildExecutionCompletedRequest,
@@ -105,6 +131,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	ReindexWorkflowExecution(
+		ctx context.Context,
+		Request *history.ReindexWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -129,12 +161,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReplicateWorkflowExecution(
+		ctx context.Context,
+		Request *history.ReplicateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RequestCancelWorkflowExecution(
 		ctx context.Context,
 		CancelRequest *history.RequestCancelWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetQueueState(
+		ctx context.Context,
+		Request *shared.ResetQueueStateRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *history.ResetStickyTaskListRequest,
@@ -177,12 +221,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResumeWorkflowExecution(
+		ctx context.Context,
+		ResumeRequest *history.ResumeWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	SetTerminationProtection(
+		ctx context.Context,
+		Request *history.SetTerminationProtectionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -222,7 +278,7 @@ type Interface interface {
 
 // New builds a new client for the HistoryService service.
 //
-// 	client := historyserviceclient.New(dispatcher.ClientConfig("historyservice"))
+//	client := historyserviceclient.New(dispatcher.ClientConfig("historyservice"))
 func New(c transport.ClientConfig, opts ...thrift.ClientOption) Interface {
 	return client{
 		c: thrift.New(thrift.Config{
@@ -244,6 +300,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) AddOperatorAnnotation(
+	ctx context.Context,
+	_AnnotationRequest *history.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_AddOperatorAnnotation_Helper.Args(_AnnotationRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_AddOperatorAnnotation_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_AddOperatorAnnotation_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -267,6 +346,29 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_Request *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_DeleteWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -313,6 +415,29 @@ func (c client) DescribeMutableState(
 	return
 }
 
+func (c client) DescribeQueue(
+	ctx context.Context,
+	_Request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeQueueResponse, err error) {
+
+	args := history.HistoryService_DescribeQueue_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeQueue_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeQueue_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -336,6 +461,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) DetectZombieWorkflowExecution(
+	ctx context.Context,
+	_Request *history.DetectZombieWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DetectZombieWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_DetectZombieWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DetectZombieWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DetectZombieWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetMutableState(
 	ctx context.Context,
 	_GetRequest *history.GetMutableStateRequest,
@@ -382,6 +530,52 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) GetReplicationStatus(
+	ctx context.Context,
+	_Request *history.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetReplicationStatusResponse, err error) {
+
+	args := history.HistoryService_GetReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) QueryWorkflow(
 	ctx context.Context,
 	_QueryRequest *history.QueryWorkflowRequest,
@@ -428,6 +622,29 @@ func (c client) RecordActivityTaskHeartbeat(
 	return
 }
 
+func (c client) RecordActivityTaskHeartbeatBatch(
+	ctx context.Context,
+	_BatchRequest *history.RecordActivityTaskHeartbeatBatchRequest,
+	opts ...yarpc.CallOption,
+) (success *history.RecordActivityTaskHeartbeatBatchResponse, err error) {
+
+	args := history.HistoryService_RecordActivityTaskHeartbeatBatch_Helper.Args(_BatchRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RecordActivityTaskHeartbeatBatch_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_RecordActivityTaskHeartbeatBatch_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskStarted(
 	ctx context.Context,
 	_AddRequest *history.RecordActivityTaskStartedRequest,
@@ -451,6 +668,29 @@ func (c client) RecordActivityTaskStarted(
 	return
 }
 
+func (c client) RecordActivityTaskWorkerUnavailable(
+	ctx context.Context,
+	_Request *history.RecordActivityTaskWorkerUnavailableRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RecordActivityTaskWorkerUnavailable_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RecordActivityTaskWorkerUnavailable_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RecordActivityTaskWorkerUnavailable_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordChildExecutionCompleted(
 	ctx context.Context,
 	_CompletionRequest *history.RecordChildExecutionCompletedRequest,
@@ -497,6 +737,29 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) ReindexWorkflowExecution(
+	ctx context.Context,
+	_Request *history.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ReindexWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReindexWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ReindexWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -589,6 +852,29 @@ func (c client) ReplicateRawEvents(
 	return
 }
 
+func (c client) ReplicateWorkflowExecution(
+	ctx context.Context,
+	_Request *history.ReplicateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ReplicateWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReplicateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ReplicateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	_CancelRequest *history.RequestCancelWorkflowExecutionRequest,
@@ -612,6 +898,29 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetQueueState(
+	ctx context.Context,
+	_Request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResetQueueState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResetQueueState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResetQueueState_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *history.ResetStickyTaskListRequest,
@@ -773,6 +1082,29 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResumeWorkflowExecution_Helper.Args(_ResumeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResumeWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResumeWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -796,6 +1128,29 @@ func (c client) ScheduleDecisionTask(
 	return
 }
 
+func (c client) SetTerminationProtection(
+	ctx context.Context,
+	_Request *history.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_SetTerminationProtection_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_SetTerminationProtection_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_SetTerminationProtection_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	_SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
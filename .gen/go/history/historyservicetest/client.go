@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw-plugin-yarpc
 // @generated
 
@@ -50,8 +28,8 @@ type _MockClientRecorder struct {
 
 // Build a new mock client for service HistoryService.
 //
-// 	mockCtrl := gomock.NewController(t)
-// 	client := historyservicetest.NewMockClient(mockCtrl)
+//	mockCtrl := gomock.NewController(t)
+//	client := historyservicetest.NewMockClient(mockCtrl)
 //
 // Use EXPECT() to set expectations on the mock.
 func NewMockClient(ctrl *gomock.Controller) *MockClient {
@@ -66,12 +44,43 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// AddOperatorAnnotation responds to a AddOperatorAnnotation call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().AddOperatorAnnotation(gomock.Any(), ...).Return(...)
+//	... := client.AddOperatorAnnotation(...)
+func (m *MockClient) AddOperatorAnnotation(
+	ctx context.Context,
+	_AnnotationRequest *history.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _AnnotationRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "AddOperatorAnnotation", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) AddOperatorAnnotation(
+	ctx interface{},
+	_AnnotationRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _AnnotationRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "AddOperatorAnnotation", args...)
+}
+
 // CloseShard responds to a CloseShard call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().CloseShard(gomock.Any(), ...).Return(...)
-// 	... := client.CloseShard(...)
+//	client.EXPECT().CloseShard(gomock.Any(), ...).Return(...)
+//	... := client.CloseShard(...)
 func (m *MockClient) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -97,12 +106,43 @@ func (mr *_MockClientRecorder) CloseShard(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
 }
 
+// DeleteWorkflowExecution responds to a DeleteWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DeleteWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DeleteWorkflowExecution(...)
+func (m *MockClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	_Request *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflowExecution", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeHistoryHost(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeHistoryHost(...)
+//	client.EXPECT().DescribeHistoryHost(gomock.Any(), ...).Return(...)
+//	... := client.DescribeHistoryHost(...)
 func (m *MockClient) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -134,8 +174,8 @@ func (mr *_MockClientRecorder) DescribeHistoryHost(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeMutableState(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeMutableState(...)
+//	client.EXPECT().DescribeMutableState(gomock.Any(), ...).Return(...)
+//	... := client.DescribeMutableState(...)
 func (m *MockClient) DescribeMutableState(
 	ctx context.Context,
 	_Request *history.DescribeMutableStateRequest,
@@ -163,12 +203,45 @@ func (mr *_MockClientRecorder) DescribeMutableState(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeMutableState", args...)
 }
 
+// DescribeQueue responds to a DescribeQueue call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeQueue(gomock.Any(), ...).Return(...)
+//	... := client.DescribeQueue(...)
+func (m *MockClient) DescribeQueue(
+	ctx context.Context,
+	_Request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeQueueResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeQueue", args...)
+	success, _ = ret[i].(*shared.DescribeQueueResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeQueue(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeQueue", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.DescribeWorkflowExecution(...)
+//	client.EXPECT().DescribeWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DescribeWorkflowExecution(...)
 func (m *MockClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -196,12 +269,45 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// DetectZombieWorkflowExecution responds to a DetectZombieWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.DetectZombieWorkflowExecution(...)
+func (m *MockClient) DetectZombieWorkflowExecution(
+	ctx context.Context,
+	_Request *history.DetectZombieWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DetectZombieWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DetectZombieWorkflowExecution", args...)
+	success, _ = ret[i].(*history.DetectZombieWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DetectZombieWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DetectZombieWorkflowExecution", args...)
+}
+
 // GetMutableState responds to a GetMutableState call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().GetMutableState(gomock.Any(), ...).Return(...)
-// 	... := client.GetMutableState(...)
+//	client.EXPECT().GetMutableState(gomock.Any(), ...).Return(...)
+//	... := client.GetMutableState(...)
 func (m *MockClient) GetMutableState(
 	ctx context.Context,
 	_GetRequest *history.GetMutableStateRequest,
@@ -233,8 +339,8 @@ func (mr *_MockClientRecorder) GetMutableState(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().GetReplicationMessages(gomock.Any(), ...).Return(...)
-// 	... := client.GetReplicationMessages(...)
+//	client.EXPECT().GetReplicationMessages(gomock.Any(), ...).Return(...)
+//	... := client.GetReplicationMessages(...)
 func (m *MockClient) GetReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetReplicationMessagesRequest,
@@ -262,12 +368,76 @@ func (mr *_MockClientRecorder) GetReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationMessages", args...)
 }
 
+// GetReplicationStatus responds to a GetReplicationStatus call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetReplicationStatus(gomock.Any(), ...).Return(...)
+//	... := client.GetReplicationStatus(...)
+func (m *MockClient) GetReplicationStatus(
+	ctx context.Context,
+	_Request *history.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetReplicationStatusResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetReplicationStatus", args...)
+	success, _ = ret[i].(*history.GetReplicationStatusResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetReplicationStatus(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationStatus", args...)
+}
+
+// PauseWorkflowExecution responds to a PauseWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PauseWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.PauseWorkflowExecution(...)
+func (m *MockClient) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *history.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseWorkflowExecution(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseWorkflowExecution", args...)
+}
+
 // QueryWorkflow responds to a QueryWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().QueryWorkflow(gomock.Any(), ...).Return(...)
-// 	... := client.QueryWorkflow(...)
+//	client.EXPECT().QueryWorkflow(gomock.Any(), ...).Return(...)
+//	... := client.QueryWorkflow(...)
 func (m *MockClient) QueryWorkflow(
 	ctx context.Context,
 	_QueryRequest *history.QueryWorkflowRequest,
@@ -299,8 +469,8 @@ func (mr *_MockClientRecorder) QueryWorkflow(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), ...).Return(...)
-// 	... := client.RecordActivityTaskHeartbeat(...)
+//	client.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), ...).Return(...)
+//	... := client.RecordActivityTaskHeartbeat(...)
 func (m *MockClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -328,12 +498,45 @@ func (mr *_MockClientRecorder) RecordActivityTaskHeartbeat(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RecordActivityTaskHeartbeat", args...)
 }
 
+// RecordActivityTaskHeartbeatBatch responds to a RecordActivityTaskHeartbeatBatch call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RecordActivityTaskHeartbeatBatch(gomock.Any(), ...).Return(...)
+//	... := client.RecordActivityTaskHeartbeatBatch(...)
+func (m *MockClient) RecordActivityTaskHeartbeatBatch(
+	ctx context.Context,
+	_BatchRequest *history.RecordActivityTaskHeartbeatBatchRequest,
+	opts ...yarpc.CallOption,
+) (success *history.RecordActivityTaskHeartbeatBatchResponse, err error) {
+
+	args := []interface{}{ctx, _BatchRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RecordActivityTaskHeartbeatBatch", args...)
+	success, _ = ret[i].(*history.RecordActivityTaskHeartbeatBatchResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RecordActivityTaskHeartbeatBatch(
+	ctx interface{},
+	_BatchRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _BatchRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RecordActivityTaskHeartbeatBatch", args...)
+}
+
 // RecordActivityTaskStarted responds to a RecordActivityTaskStarted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RecordActivityTaskStarted(gomock.Any(), ...).Return(...)
-// 	... := client.RecordActivityTaskStarted(...)
+//	client.EXPECT().RecordActivityTaskStarted(gomock.Any(), ...).Return(...)
+//	... := client.RecordActivityTaskStarted(...)
 func (m *MockClient) RecordActivityTaskStarted(
 	ctx context.Context,
 	_AddRequest *history.RecordActivityTaskStartedRequest,
@@ -361,12 +564,43 @@ func (mr *_MockClientRecorder) RecordActivityTaskStarted(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RecordActivityTaskStarted", args...)
 }
 
+// RecordActivityTaskWorkerUnavailable responds to a RecordActivityTaskWorkerUnavailable call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RecordActivityTaskWorkerUnavailable(gomock.Any(), ...).Return(...)
+//	... := client.RecordActivityTaskWorkerUnavailable(...)
+func (m *MockClient) RecordActivityTaskWorkerUnavailable(
+	ctx context.Context,
+	_Request *history.RecordActivityTaskWorkerUnavailableRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RecordActivityTaskWorkerUnavailable", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RecordActivityTaskWorkerUnavailable(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RecordActivityTaskWorkerUnavailable", args...)
+}
+
 // RecordChildExecutionCompleted responds to a RecordChildExecutionCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RecordChildExecutionCompleted(gomock.Any(), ...).Return(...)
-// 	... := client.RecordChildExecutionCompleted(...)
+//	client.EXPECT().RecordChildExecutionCompleted(gomock.Any(), ...).Return(...)
+//	... := client.RecordChildExecutionCompleted(...)
 func (m *MockClient) RecordChildExecutionCompleted(
 	ctx context.Context,
 	_CompletionRequest *history.RecordChildExecutionCompletedRequest,
@@ -396,8 +630,8 @@ func (mr *_MockClientRecorder) RecordChildExecutionCompleted(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RecordDecisionTaskStarted(gomock.Any(), ...).Return(...)
-// 	... := client.RecordDecisionTaskStarted(...)
+//	client.EXPECT().RecordDecisionTaskStarted(gomock.Any(), ...).Return(...)
+//	... := client.RecordDecisionTaskStarted(...)
 func (m *MockClient) RecordDecisionTaskStarted(
 	ctx context.Context,
 	_AddRequest *history.RecordDecisionTaskStartedRequest,
@@ -425,12 +659,43 @@ func (mr *_MockClientRecorder) RecordDecisionTaskStarted(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RecordDecisionTaskStarted", args...)
 }
 
+// ReindexWorkflowExecution responds to a ReindexWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReindexWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ReindexWorkflowExecution(...)
+func (m *MockClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	_Request *history.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReindexWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReindexWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReindexWorkflowExecution", args...)
+}
+
 // RemoveSignalMutableState responds to a RemoveSignalMutableState call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RemoveSignalMutableState(gomock.Any(), ...).Return(...)
-// 	... := client.RemoveSignalMutableState(...)
+//	client.EXPECT().RemoveSignalMutableState(gomock.Any(), ...).Return(...)
+//	... := client.RemoveSignalMutableState(...)
 func (m *MockClient) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -460,8 +725,8 @@ func (mr *_MockClientRecorder) RemoveSignalMutableState(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RemoveTask(gomock.Any(), ...).Return(...)
-// 	... := client.RemoveTask(...)
+//	client.EXPECT().RemoveTask(gomock.Any(), ...).Return(...)
+//	... := client.RemoveTask(...)
 func (m *MockClient) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -491,8 +756,8 @@ func (mr *_MockClientRecorder) RemoveTask(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ReplicateEvents(gomock.Any(), ...).Return(...)
-// 	... := client.ReplicateEvents(...)
+//	client.EXPECT().ReplicateEvents(gomock.Any(), ...).Return(...)
+//	... := client.ReplicateEvents(...)
 func (m *MockClient) ReplicateEvents(
 	ctx context.Context,
 	_ReplicateRequest *history.ReplicateEventsRequest,
@@ -522,8 +787,8 @@ func (mr *_MockClientRecorder) ReplicateEvents(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ReplicateRawEvents(gomock.Any(), ...).Return(...)
-// 	... := client.ReplicateRawEvents(...)
+//	client.EXPECT().ReplicateRawEvents(gomock.Any(), ...).Return(...)
+//	... := client.ReplicateRawEvents(...)
 func (m *MockClient) ReplicateRawEvents(
 	ctx context.Context,
 	_ReplicateRequest *history.ReplicateRawEventsRequest,
@@ -549,12 +814,43 @@ func (mr *_MockClientRecorder) ReplicateRawEvents(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReplicateRawEvents", args...)
 }
 
+// ReplicateWorkflowExecution responds to a ReplicateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReplicateWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ReplicateWorkflowExecution(...)
+func (m *MockClient) ReplicateWorkflowExecution(
+	ctx context.Context,
+	_Request *history.ReplicateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReplicateWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReplicateWorkflowExecution", args...)
+}
+
 // RequestCancelWorkflowExecution responds to a RequestCancelWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.RequestCancelWorkflowExecution(...)
+//	client.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.RequestCancelWorkflowExecution(...)
 func (m *MockClient) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	_CancelRequest *history.RequestCancelWorkflowExecutionRequest,
@@ -580,12 +876,43 @@ func (mr *_MockClientRecorder) RequestCancelWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RequestCancelWorkflowExecution", args...)
 }
 
+// ResetQueueState responds to a ResetQueueState call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetQueueState(gomock.Any(), ...).Return(...)
+//	... := client.ResetQueueState(...)
+func (m *MockClient) ResetQueueState(
+	ctx context.Context,
+	_Request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetQueueState", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetQueueState(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetQueueState", args...)
+}
+
 // ResetStickyTaskList responds to a ResetStickyTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ResetStickyTaskList(gomock.Any(), ...).Return(...)
-// 	... := client.ResetStickyTaskList(...)
+//	client.EXPECT().ResetStickyTaskList(gomock.Any(), ...).Return(...)
+//	... := client.ResetStickyTaskList(...)
 func (m *MockClient) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *history.ResetStickyTaskListRequest,
@@ -617,8 +944,8 @@ func (mr *_MockClientRecorder) ResetStickyTaskList(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ResetWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.ResetWorkflowExecution(...)
+//	client.EXPECT().ResetWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ResetWorkflowExecution(...)
 func (m *MockClient) ResetWorkflowExecution(
 	ctx context.Context,
 	_ResetRequest *history.ResetWorkflowExecutionRequest,
@@ -650,8 +977,8 @@ func (mr *_MockClientRecorder) ResetWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskCanceled(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskCanceled(...)
+//	client.EXPECT().RespondActivityTaskCanceled(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskCanceled(...)
 func (m *MockClient) RespondActivityTaskCanceled(
 	ctx context.Context,
 	_CanceledRequest *history.RespondActivityTaskCanceledRequest,
@@ -681,8 +1008,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskCanceled(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskCompleted(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskCompleted(...)
+//	client.EXPECT().RespondActivityTaskCompleted(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskCompleted(...)
 func (m *MockClient) RespondActivityTaskCompleted(
 	ctx context.Context,
 	_CompleteRequest *history.RespondActivityTaskCompletedRequest,
@@ -712,8 +1039,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskCompleted(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondActivityTaskFailed(gomock.Any(), ...).Return(...)
-// 	... := client.RespondActivityTaskFailed(...)
+//	client.EXPECT().RespondActivityTaskFailed(gomock.Any(), ...).Return(...)
+//	... := client.RespondActivityTaskFailed(...)
 func (m *MockClient) RespondActivityTaskFailed(
 	ctx context.Context,
 	_FailRequest *history.RespondActivityTaskFailedRequest,
@@ -743,8 +1070,8 @@ func (mr *_MockClientRecorder) RespondActivityTaskFailed(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondDecisionTaskCompleted(gomock.Any(), ...).Return(...)
-// 	... := client.RespondDecisionTaskCompleted(...)
+//	client.EXPECT().RespondDecisionTaskCompleted(gomock.Any(), ...).Return(...)
+//	... := client.RespondDecisionTaskCompleted(...)
 func (m *MockClient) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	_CompleteRequest *history.RespondDecisionTaskCompletedRequest,
@@ -776,8 +1103,8 @@ func (mr *_MockClientRecorder) RespondDecisionTaskCompleted(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().RespondDecisionTaskFailed(gomock.Any(), ...).Return(...)
-// 	... := client.RespondDecisionTaskFailed(...)
+//	client.EXPECT().RespondDecisionTaskFailed(gomock.Any(), ...).Return(...)
+//	... := client.RespondDecisionTaskFailed(...)
 func (m *MockClient) RespondDecisionTaskFailed(
 	ctx context.Context,
 	_FailedRequest *history.RespondDecisionTaskFailedRequest,
@@ -803,12 +1130,43 @@ func (mr *_MockClientRecorder) RespondDecisionTaskFailed(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondDecisionTaskFailed", args...)
 }
 
+// ResumeWorkflowExecution responds to a ResumeWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResumeWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.ResumeWorkflowExecution(...)
+func (m *MockClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	_ResumeRequest *history.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _ResumeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResumeWorkflowExecution(
+	ctx interface{},
+	_ResumeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ResumeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResumeWorkflowExecution", args...)
+}
+
 // ScheduleDecisionTask responds to a ScheduleDecisionTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().ScheduleDecisionTask(gomock.Any(), ...).Return(...)
-// 	... := client.ScheduleDecisionTask(...)
+//	client.EXPECT().ScheduleDecisionTask(gomock.Any(), ...).Return(...)
+//	... := client.ScheduleDecisionTask(...)
 func (m *MockClient) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -834,12 +1192,43 @@ func (mr *_MockClientRecorder) ScheduleDecisionTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ScheduleDecisionTask", args...)
 }
 
+// SetTerminationProtection responds to a SetTerminationProtection call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().SetTerminationProtection(gomock.Any(), ...).Return(...)
+//	... := client.SetTerminationProtection(...)
+func (m *MockClient) SetTerminationProtection(
+	ctx context.Context,
+	_Request *history.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SetTerminationProtection", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SetTerminationProtection(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SetTerminationProtection", args...)
+}
+
 // SignalWithStartWorkflowExecution responds to a SignalWithStartWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.SignalWithStartWorkflowExecution(...)
+//	client.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.SignalWithStartWorkflowExecution(...)
 func (m *MockClient) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	_SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -871,8 +1260,8 @@ func (mr *_MockClientRecorder) SignalWithStartWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().SignalWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.SignalWorkflowExecution(...)
+//	client.EXPECT().SignalWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.SignalWorkflowExecution(...)
 func (m *MockClient) SignalWorkflowExecution(
 	ctx context.Context,
 	_SignalRequest *history.SignalWorkflowExecutionRequest,
@@ -902,8 +1291,8 @@ func (mr *_MockClientRecorder) SignalWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().StartWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.StartWorkflowExecution(...)
+//	client.EXPECT().StartWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.StartWorkflowExecution(...)
 func (m *MockClient) StartWorkflowExecution(
 	ctx context.Context,
 	_StartRequest *history.StartWorkflowExecutionRequest,
@@ -935,8 +1324,8 @@ func (mr *_MockClientRecorder) StartWorkflowExecution(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().SyncActivity(gomock.Any(), ...).Return(...)
-// 	... := client.SyncActivity(...)
+//	client.EXPECT().SyncActivity(gomock.Any(), ...).Return(...)
+//	... := client.SyncActivity(...)
 func (m *MockClient) SyncActivity(
 	ctx context.Context,
 	_SyncActivityRequest *history.SyncActivityRequest,
@@ -966,8 +1355,8 @@ func (mr *_MockClientRecorder) SyncActivity(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().SyncShardStatus(gomock.Any(), ...).Return(...)
-// 	... := client.SyncShardStatus(...)
+//	client.EXPECT().SyncShardStatus(gomock.Any(), ...).Return(...)
+//	... := client.SyncShardStatus(...)
 func (m *MockClient) SyncShardStatus(
 	ctx context.Context,
 	_SyncShardStatusRequest *history.SyncShardStatusRequest,
@@ -997,8 +1386,8 @@ func (mr *_MockClientRecorder) SyncShardStatus(
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
 //
-// 	client.EXPECT().TerminateWorkflowExecution(gomock.Any(), ...).Return(...)
-// 	... := client.TerminateWorkflowExecution(...)
+//	client.EXPECT().TerminateWorkflowExecution(gomock.Any(), ...).Return(...)
+//	... := client.TerminateWorkflowExecution(...)
 func (m *MockClient) TerminateWorkflowExecution(
 	ctx context.Context,
 	_TerminateRequest *history.TerminateWorkflowExecutionRequest,
@@ -1072,6 +1072,7 @@ type MessageType int32
 const (
 	MessageTypeIndex  MessageType = 0
 	MessageTypeDelete MessageType = 1
+	MessageTypeUpdate MessageType = 2
 )
 
 // MessageType_Values returns all recognized values of MessageType.
@@ -1079,6 +1080,7 @@ func MessageType_Values() []MessageType {
 	return []MessageType{
 		MessageTypeIndex,
 		MessageTypeDelete,
+		MessageTypeUpdate,
 	}
 }
 
@@ -1095,6 +1097,9 @@ func (v *MessageType) UnmarshalText(value []byte) error {
 	case "Delete":
 		*v = MessageTypeDelete
 		return nil
+	case "Update":
+		*v = MessageTypeUpdate
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1117,6 +1122,8 @@ func (v MessageType) MarshalText() ([]byte, error) {
 		return []byte("Index"), nil
 	case 1:
 		return []byte("Delete"), nil
+	case 2:
+		return []byte("Update"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -1132,6 +1139,8 @@ func (v MessageType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "Index")
 	case 1:
 		enc.AddString("name", "Delete")
+	case 2:
+		enc.AddString("name", "Update")
 	}
 	return nil
 }
@@ -1176,6 +1185,8 @@ func (v MessageType) String() string {
 		return "Index"
 	case 1:
 		return "Delete"
+	case 2:
+		return "Update"
 	}
 	return fmt.Sprintf("MessageType(%d)", w)
 }
@@ -1198,6 +1209,8 @@ func (v MessageType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"Index\""), nil
 	case 1:
 		return ([]byte)("\"Delete\""), nil
+	case 2:
+		return ([]byte)("\"Update\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -1243,11 +1256,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "indexer",
 	Package:  "github.com/uber/cadence/.gen/go/indexer",
 	FilePath: "indexer.thrift",
-	SHA1:     "464fff1b44654c3f41da6b7a95de46593a9776d6",
+	SHA1:     "7c00a3834c9a2161f4de6516f4fd2ed835a51a19",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.indexer\n\ninclude \"shared.thrift\"\n\nenum MessageType {\n  Index\n  Delete\n}\n\nenum FieldType {\n  String\n  Int\n  Bool\n  Binary\n}\n\nstruct Field {\n  10: optional FieldType type\n  20: optional string stringData\n  30: optional i64 (js.type = \"Long\") intData\n  40: optional bool boolData\n  50: optional binary binaryData\n}\n\nstruct Message {\n  10: optional MessageType messageType\n  20: optional string domainID\n  30: optional string workflowID\n  40: optional string runID\n  50: optional i64 (js.type = \"Long\") version\n  60: optional map<string,Field> fields\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.indexer\n\ninclude \"shared.thrift\"\n\nenum MessageType {\n  Index\n  Delete\n  Update\n}\n\nenum FieldType {\n  String\n  Int\n  Bool\n  Binary\n}\n\nstruct Field {\n  10: optional FieldType type\n  20: optional string stringData\n  30: optional i64 (js.type = \"Long\") intData\n  40: optional bool boolData\n  50: optional binary binaryData\n}\n\nstruct Message {\n  10: optional MessageType messageType\n  20: optional string domainID\n  30: optional string workflowID\n  40: optional string runID\n  50: optional i64 (js.type = \"Long\") version\n  60: optional map<string,Field> fields\n}"
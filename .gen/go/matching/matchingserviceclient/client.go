@@ -62,6 +62,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	ListTaskListWorkers(
+		ctx context.Context,
+		Request *matching.ListTaskListWorkersRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.ListTaskListWorkersResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -203,6 +209,29 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) ListTaskListWorkers(
+	ctx context.Context,
+	_Request *matching.ListTaskListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.ListTaskListWorkersResponse, err error) {
+
+	args := matching.MatchingService_ListTaskListWorkers_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_ListTaskListWorkers_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_ListTaskListWorkers_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
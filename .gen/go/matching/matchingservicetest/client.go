@@ -191,6 +191,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// ListTaskListWorkers responds to a ListTaskListWorkers call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListTaskListWorkers(gomock.Any(), ...).Return(...)
+// 	... := client.ListTaskListWorkers(...)
+func (m *MockClient) ListTaskListWorkers(
+	ctx context.Context,
+	_Request *matching.ListTaskListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.ListTaskListWorkersResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListTaskListWorkers", args...)
+	success, _ = ret[i].(*matching.ListTaskListWorkersResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListTaskListWorkers(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListWorkers", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1,25 +1,3 @@
-// The MIT License (MIT)
-// 
-// Copyright (c) 2017 Uber Technologies, Inc.
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy
-// of this software and associated documentation files (the "Software"), to deal
-// in the Software without restriction, including without limitation the rights
-// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
-// copies of the Software, and to permit persons to whom the Software is
-// furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all
-// copies or substantial portions of the Software.
-// 
-// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
-// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
-// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
-// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
-// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
-// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
-// SOFTWARE.
-
 // Code generated by thriftrw v1.20.0. DO NOT EDIT.
 // @generated
 
@@ -2289,6 +2267,10 @@ type DomainInfo struct {
 	HistoryArchivalURI          *string           `json:"historyArchivalURI,omitempty"`
 	VisibilityArchivalStatus    *int16            `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI       *string           `json:"visibilityArchivalURI,omitempty"`
+	FailoverState               *int32            `json:"failoverState,omitempty"`
+	PendingActiveClusterName    *string           `json:"pendingActiveClusterName,omitempty"`
+	KnownTaskLists              []string          `json:"knownTaskLists,omitempty"`
+	PreviousFailoverVersion     *int64            `json:"previousFailoverVersion,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -2343,7 +2325,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [21]wire.Field
+		fields [25]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2517,6 +2499,38 @@ func (v *DomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 48, Value: w}
 		i++
 	}
+	if v.FailoverState != nil {
+		w, err = wire.NewValueI32(*(v.FailoverState)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.PendingActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.PendingActiveClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 52, Value: w}
+		i++
+	}
+	if v.KnownTaskLists != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.KnownTaskLists)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 54, Value: w}
+		i++
+	}
+	if v.PreviousFailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.PreviousFailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2774,6 +2788,44 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FailoverState = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 52:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PendingActiveClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 54:
+			if field.Value.Type() == wire.TList {
+				v.KnownTaskLists, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 56:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousFailoverVersion = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2788,7 +2840,7 @@ func (v *DomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [21]string
+	var fields [25]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -2874,6 +2926,22 @@ func (v *DomainInfo) String() string {
 		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
 		i++
 	}
+	if v.FailoverState != nil {
+		fields[i] = fmt.Sprintf("FailoverState: %v", *(v.FailoverState))
+		i++
+	}
+	if v.PendingActiveClusterName != nil {
+		fields[i] = fmt.Sprintf("PendingActiveClusterName: %v", *(v.PendingActiveClusterName))
+		i++
+	}
+	if v.KnownTaskLists != nil {
+		fields[i] = fmt.Sprintf("KnownTaskLists: %v", v.KnownTaskLists)
+		i++
+	}
+	if v.PreviousFailoverVersion != nil {
+		fields[i] = fmt.Sprintf("PreviousFailoverVersion: %v", *(v.PreviousFailoverVersion))
+		i++
+	}
 
 	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2978,6 +3046,18 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.FailoverState, rhs.FailoverState) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PendingActiveClusterName, rhs.PendingActiveClusterName) {
+		return false
+	}
+	if !((v.KnownTaskLists == nil && rhs.KnownTaskLists == nil) || (v.KnownTaskLists != nil && rhs.KnownTaskLists != nil && _List_String_Equals(v.KnownTaskLists, rhs.KnownTaskLists))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PreviousFailoverVersion, rhs.PreviousFailoverVersion) {
+		return false
+	}
 
 	return true
 }
@@ -3062,6 +3142,18 @@ func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.VisibilityArchivalURI != nil {
 		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
 	}
+	if v.FailoverState != nil {
+		enc.AddInt32("failoverState", *v.FailoverState)
+	}
+	if v.PendingActiveClusterName != nil {
+		enc.AddString("pendingActiveClusterName", *v.PendingActiveClusterName)
+	}
+	if v.KnownTaskLists != nil {
+		err = multierr.Append(err, enc.AddArray("knownTaskLists", (_List_String_Zapper)(v.KnownTaskLists)))
+	}
+	if v.PreviousFailoverVersion != nil {
+		enc.AddInt64("previousFailoverVersion", *v.PreviousFailoverVersion)
+	}
 	return err
 }
 
@@ -3380,6 +3472,66 @@ func (v *DomainInfo) IsSetVisibilityArchivalURI() bool {
 	return v != nil && v.VisibilityArchivalURI != nil
 }
 
+// GetFailoverState returns the value of FailoverState if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetFailoverState() (o int32) {
+	if v != nil && v.FailoverState != nil {
+		return *v.FailoverState
+	}
+
+	return
+}
+
+// IsSetFailoverState returns true if FailoverState is not nil.
+func (v *DomainInfo) IsSetFailoverState() bool {
+	return v != nil && v.FailoverState != nil
+}
+
+// GetPendingActiveClusterName returns the value of PendingActiveClusterName if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetPendingActiveClusterName() (o string) {
+	if v != nil && v.PendingActiveClusterName != nil {
+		return *v.PendingActiveClusterName
+	}
+
+	return
+}
+
+// IsSetPendingActiveClusterName returns true if PendingActiveClusterName is not nil.
+func (v *DomainInfo) IsSetPendingActiveClusterName() bool {
+	return v != nil && v.PendingActiveClusterName != nil
+}
+
+// GetKnownTaskLists returns the value of KnownTaskLists if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetKnownTaskLists() (o []string) {
+	if v != nil && v.KnownTaskLists != nil {
+		return v.KnownTaskLists
+	}
+
+	return
+}
+
+// IsSetKnownTaskLists returns true if KnownTaskLists is not nil.
+func (v *DomainInfo) IsSetKnownTaskLists() bool {
+	return v != nil && v.KnownTaskLists != nil
+}
+
+// GetPreviousFailoverVersion returns the value of PreviousFailoverVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetPreviousFailoverVersion() (o int64) {
+	if v != nil && v.PreviousFailoverVersion != nil {
+		return *v.PreviousFailoverVersion
+	}
+
+	return
+}
+
+// IsSetPreviousFailoverVersion returns true if PreviousFailoverVersion is not nil.
+func (v *DomainInfo) IsSetPreviousFailoverVersion() bool {
+	return v != nil && v.PreviousFailoverVersion != nil
+}
+
 type HistoryTreeInfo struct {
 	CreatedTimeNanos *int64                       `json:"createdTimeNanos,omitempty"`
 	Ancestors        []*shared.HistoryBranchRange `json:"ancestors,omitempty"`
@@ -4902,6 +5054,7 @@ type ShardInfo struct {
 	ClusterTimerAckLevel      map[string]int64 `json:"clusterTimerAckLevel,omitempty"`
 	Owner                     *string          `json:"owner,omitempty"`
 	ClusterReplicationLevel   map[string]int64 `json:"clusterReplicationLevel,omitempty"`
+	MaxObservedTimeNanos      *int64           `json:"maxObservedTimeNanos,omitempty"`
 }
 
 type _Map_String_I64_MapItemList map[string]int64
@@ -4956,7 +5109,7 @@ func (_Map_String_I64_MapItemList) Close() {}
 //   }
 func (v *ShardInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5042,6 +5195,14 @@ func (v *ShardInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.MaxObservedTimeNanos != nil {
+		w, err = wire.NewValueI64(*(v.MaxObservedTimeNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 42, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5189,6 +5350,16 @@ func (v *ShardInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 42:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxObservedTimeNanos = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -5203,7 +5374,7 @@ func (v *ShardInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [10]string
+	var fields [11]string
 	i := 0
 	if v.StolenSinceRenew != nil {
 		fields[i] = fmt.Sprintf("StolenSinceRenew: %v", *(v.StolenSinceRenew))
@@ -5245,6 +5416,10 @@ func (v *ShardInfo) String() string {
 		fields[i] = fmt.Sprintf("ClusterReplicationLevel: %v", v.ClusterReplicationLevel)
 		i++
 	}
+	if v.MaxObservedTimeNanos != nil {
+		fields[i] = fmt.Sprintf("MaxObservedTimeNanos: %v", *(v.MaxObservedTimeNanos))
+		i++
+	}
 
 	return fmt.Sprintf("ShardInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -5306,6 +5481,9 @@ func (v *ShardInfo) Equals(rhs *ShardInfo) bool {
 	if !((v.ClusterReplicationLevel == nil && rhs.ClusterReplicationLevel == nil) || (v.ClusterReplicationLevel != nil && rhs.ClusterReplicationLevel != nil && _Map_String_I64_Equals(v.ClusterReplicationLevel, rhs.ClusterReplicationLevel))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.MaxObservedTimeNanos, rhs.MaxObservedTimeNanos) {
+		return false
+	}
 
 	return true
 }
@@ -5357,6 +5535,9 @@ func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.ClusterReplicationLevel != nil {
 		err = multierr.Append(err, enc.AddObject("clusterReplicationLevel", (_Map_String_I64_Zapper)(v.ClusterReplicationLevel)))
 	}
+	if v.MaxObservedTimeNanos != nil {
+		enc.AddInt64("maxObservedTimeNanos", *v.MaxObservedTimeNanos)
+	}
 	return err
 }
 
@@ -5510,6 +5691,21 @@ func (v *ShardInfo) IsSetClusterReplicationLevel() bool {
 	return v != nil && v.ClusterReplicationLevel != nil
 }
 
+// GetMaxObservedTimeNanos returns the value of MaxObservedTimeNanos if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetMaxObservedTimeNanos() (o int64) {
+	if v != nil && v.MaxObservedTimeNanos != nil {
+		return *v.MaxObservedTimeNanos
+	}
+
+	return
+}
+
+// IsSetMaxObservedTimeNanos returns true if MaxObservedTimeNanos is not nil.
+func (v *ShardInfo) IsSetMaxObservedTimeNanos() bool {
+	return v != nil && v.MaxObservedTimeNanos != nil
+}
+
 type SignalInfo struct {
 	Version               *int64  `json:"version,omitempty"`
 	InitiatedEventBatchID *int64  `json:"initiatedEventBatchID,omitempty"`
@@ -6177,10 +6373,13 @@ func (v *TaskInfo) IsSetCreatedTimeNanos() bool {
 }
 
 type TaskListInfo struct {
-	Kind             *int16 `json:"kind,omitempty"`
-	AckLevel         *int64 `json:"ackLevel,omitempty"`
-	ExpiryTimeNanos  *int64 `json:"expiryTimeNanos,omitempty"`
-	LastUpdatedNanos *int64 `json:"lastUpdatedNanos,omitempty"`
+	Kind                     *int16 `json:"kind,omitempty"`
+	AckLevel                 *int64 `json:"ackLevel,omitempty"`
+	ExpiryTimeNanos          *int64 `json:"expiryTimeNanos,omitempty"`
+	LastUpdatedNanos         *int64 `json:"lastUpdatedNanos,omitempty"`
+	AdaptivePartitionVersion *int64 `json:"adaptivePartitionVersion,omitempty"`
+	NumReadPartitions        *int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions       *int32 `json:"numWritePartitions,omitempty"`
 }
 
 // ToWire translates a TaskListInfo struct into a Thrift-level intermediate
@@ -6200,7 +6399,7 @@ type TaskListInfo struct {
 //   }
 func (v *TaskListInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6238,6 +6437,30 @@ func (v *TaskListInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 16, Value: w}
 		i++
 	}
+	if v.AdaptivePartitionVersion != nil {
+		w, err = wire.NewValueI64(*(v.AdaptivePartitionVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+	if v.NumReadPartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumReadPartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumWritePartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -6303,6 +6526,36 @@ func (v *TaskListInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 18:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AdaptivePartitionVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumReadPartitions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumWritePartitions = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6317,7 +6570,7 @@ func (v *TaskListInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [7]string
 	i := 0
 	if v.Kind != nil {
 		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
@@ -6335,6 +6588,18 @@ func (v *TaskListInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedNanos: %v", *(v.LastUpdatedNanos))
 		i++
 	}
+	if v.AdaptivePartitionVersion != nil {
+		fields[i] = fmt.Sprintf("AdaptivePartitionVersion: %v", *(v.AdaptivePartitionVersion))
+		i++
+	}
+	if v.NumReadPartitions != nil {
+		fields[i] = fmt.Sprintf("NumReadPartitions: %v", *(v.NumReadPartitions))
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		fields[i] = fmt.Sprintf("NumWritePartitions: %v", *(v.NumWritePartitions))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6361,6 +6626,15 @@ func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedNanos, rhs.LastUpdatedNanos) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.AdaptivePartitionVersion, rhs.AdaptivePartitionVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumReadPartitions, rhs.NumReadPartitions) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumWritePartitions, rhs.NumWritePartitions) {
+		return false
+	}
 
 	return true
 }
@@ -6383,6 +6657,15 @@ func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedNanos != nil {
 		enc.AddInt64("lastUpdatedNanos", *v.LastUpdatedNanos)
 	}
+	if v.AdaptivePartitionVersion != nil {
+		enc.AddInt64("adaptivePartitionVersion", *v.AdaptivePartitionVersion)
+	}
+	if v.NumReadPartitions != nil {
+		enc.AddInt32("numReadPartitions", *v.NumReadPartitions)
+	}
+	if v.NumWritePartitions != nil {
+		enc.AddInt32("numWritePartitions", *v.NumWritePartitions)
+	}
 	return err
 }
 
@@ -6446,6 +6729,51 @@ func (v *TaskListInfo) IsSetLastUpdatedNanos() bool {
 	return v != nil && v.LastUpdatedNanos != nil
 }
 
+// GetAdaptivePartitionVersion returns the value of AdaptivePartitionVersion if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetAdaptivePartitionVersion() (o int64) {
+	if v != nil && v.AdaptivePartitionVersion != nil {
+		return *v.AdaptivePartitionVersion
+	}
+
+	return
+}
+
+// IsSetAdaptivePartitionVersion returns true if AdaptivePartitionVersion is not nil.
+func (v *TaskListInfo) IsSetAdaptivePartitionVersion() bool {
+	return v != nil && v.AdaptivePartitionVersion != nil
+}
+
+// GetNumReadPartitions returns the value of NumReadPartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetNumReadPartitions() (o int32) {
+	if v != nil && v.NumReadPartitions != nil {
+		return *v.NumReadPartitions
+	}
+
+	return
+}
+
+// IsSetNumReadPartitions returns true if NumReadPartitions is not nil.
+func (v *TaskListInfo) IsSetNumReadPartitions() bool {
+	return v != nil && v.NumReadPartitions != nil
+}
+
+// GetNumWritePartitions returns the value of NumWritePartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetNumWritePartitions() (o int32) {
+	if v != nil && v.NumWritePartitions != nil {
+		return *v.NumWritePartitions
+	}
+
+	return
+}
+
+// IsSetNumWritePartitions returns true if NumWritePartitions is not nil.
+func (v *TaskListInfo) IsSetNumWritePartitions() bool {
+	return v != nil && v.NumWritePartitions != nil
+}
+
 type TimerInfo struct {
 	Version         *int64 `json:"version,omitempty"`
 	StartedID       *int64 `json:"startedID,omitempty"`
@@ -7831,6 +8159,15 @@ type WorkflowExecutionInfo struct {
 	AutoResetPointsEncoding                 *string                     `json:"autoResetPointsEncoding,omitempty"`
 	SearchAttributes                        map[string][]byte           `json:"searchAttributes,omitempty"`
 	Memo                                    map[string][]byte           `json:"memo,omitempty"`
+	QueryTypes                              []string                    `json:"queryTypes,omitempty"`
+	LastDecisionBinaryChecksum              *string                     `json:"lastDecisionBinaryChecksum,omitempty"`
+	Paused                                  *bool                       `json:"paused,omitempty"`
+	Priority                                *int32                      `json:"priority,omitempty"`
+	EventCount                              *int64                      `json:"eventCount,omitempty"`
+	ActivityPayloadBytes                    *int64                      `json:"activityPayloadBytes,omitempty"`
+	SignalEventCount                        *int64                      `json:"signalEventCount,omitempty"`
+	TimerCount                              *int64                      `json:"timerCount,omitempty"`
+	TerminationProtected                    *bool                       `json:"terminationProtected,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -7888,7 +8225,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [58]wire.Field
+		fields [67]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8358,6 +8695,78 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.QueryTypes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.QueryTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 122, Value: w}
+		i++
+	}
+	if v.LastDecisionBinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.LastDecisionBinaryChecksum)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 124, Value: w}
+		i++
+	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 126, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 128, Value: w}
+		i++
+	}
+	if v.EventCount != nil {
+		w, err = wire.NewValueI64(*(v.EventCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.ActivityPayloadBytes != nil {
+		w, err = wire.NewValueI64(*(v.ActivityPayloadBytes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 132, Value: w}
+		i++
+	}
+	if v.SignalEventCount != nil {
+		w, err = wire.NewValueI64(*(v.SignalEventCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 134, Value: w}
+		i++
+	}
+	if v.TimerCount != nil {
+		w, err = wire.NewValueI64(*(v.TimerCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 136, Value: w}
+		i++
+	}
+	if v.TerminationProtected != nil {
+		w, err = wire.NewValueBool(*(v.TerminationProtected)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 138, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -8971,6 +9380,94 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 122:
+			if field.Value.Type() == wire.TList {
+				v.QueryTypes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 124:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.LastDecisionBinaryChecksum = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 126:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 128:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EventCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 132:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ActivityPayloadBytes = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 134:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SignalEventCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 136:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TimerCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 138:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.TerminationProtected = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -8985,7 +9482,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [58]string
+	var fields [67]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -9219,6 +9716,42 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("Memo: %v", v.Memo)
 		i++
 	}
+	if v.QueryTypes != nil {
+		fields[i] = fmt.Sprintf("QueryTypes: %v", v.QueryTypes)
+		i++
+	}
+	if v.LastDecisionBinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("LastDecisionBinaryChecksum: %v", *(v.LastDecisionBinaryChecksum))
+		i++
+	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.EventCount != nil {
+		fields[i] = fmt.Sprintf("EventCount: %v", *(v.EventCount))
+		i++
+	}
+	if v.ActivityPayloadBytes != nil {
+		fields[i] = fmt.Sprintf("ActivityPayloadBytes: %v", *(v.ActivityPayloadBytes))
+		i++
+	}
+	if v.SignalEventCount != nil {
+		fields[i] = fmt.Sprintf("SignalEventCount: %v", *(v.SignalEventCount))
+		i++
+	}
+	if v.TimerCount != nil {
+		fields[i] = fmt.Sprintf("TimerCount: %v", *(v.TimerCount))
+		i++
+	}
+	if v.TerminationProtected != nil {
+		fields[i] = fmt.Sprintf("TerminationProtected: %v", *(v.TerminationProtected))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -9424,6 +9957,33 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !((v.Memo == nil && rhs.Memo == nil) || (v.Memo != nil && rhs.Memo != nil && _Map_String_Binary_Equals(v.Memo, rhs.Memo))) {
 		return false
 	}
+	if !((v.QueryTypes == nil && rhs.QueryTypes == nil) || (v.QueryTypes != nil && rhs.QueryTypes != nil && _List_String_Equals(v.QueryTypes, rhs.QueryTypes))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LastDecisionBinaryChecksum, rhs.LastDecisionBinaryChecksum) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EventCount, rhs.EventCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ActivityPayloadBytes, rhs.ActivityPayloadBytes) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SignalEventCount, rhs.SignalEventCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TimerCount, rhs.TimerCount) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.TerminationProtected, rhs.TerminationProtected) {
+		return false
+	}
 
 	return true
 }
@@ -9619,6 +10179,33 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.Memo != nil {
 		err = multierr.Append(err, enc.AddObject("memo", (_Map_String_Binary_Zapper)(v.Memo)))
 	}
+	if v.QueryTypes != nil {
+		err = multierr.Append(err, enc.AddArray("queryTypes", (_List_String_Zapper)(v.QueryTypes)))
+	}
+	if v.LastDecisionBinaryChecksum != nil {
+		enc.AddString("lastDecisionBinaryChecksum", *v.LastDecisionBinaryChecksum)
+	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.EventCount != nil {
+		enc.AddInt64("eventCount", *v.EventCount)
+	}
+	if v.ActivityPayloadBytes != nil {
+		enc.AddInt64("activityPayloadBytes", *v.ActivityPayloadBytes)
+	}
+	if v.SignalEventCount != nil {
+		enc.AddInt64("signalEventCount", *v.SignalEventCount)
+	}
+	if v.TimerCount != nil {
+		enc.AddInt64("timerCount", *v.TimerCount)
+	}
+	if v.TerminationProtected != nil {
+		enc.AddBool("terminationProtected", *v.TerminationProtected)
+	}
 	return err
 }
 
@@ -10492,16 +11079,151 @@ func (v *WorkflowExecutionInfo) IsSetMemo() bool {
 	return v != nil && v.Memo != nil
 }
 
+// GetQueryTypes returns the value of QueryTypes if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetQueryTypes() (o []string) {
+	if v != nil && v.QueryTypes != nil {
+		return v.QueryTypes
+	}
+
+	return
+}
+
+// IsSetQueryTypes returns true if QueryTypes is not nil.
+func (v *WorkflowExecutionInfo) IsSetQueryTypes() bool {
+	return v != nil && v.QueryTypes != nil
+}
+
+// GetLastDecisionBinaryChecksum returns the value of LastDecisionBinaryChecksum if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetLastDecisionBinaryChecksum() (o string) {
+	if v != nil && v.LastDecisionBinaryChecksum != nil {
+		return *v.LastDecisionBinaryChecksum
+	}
+
+	return
+}
+
+// IsSetLastDecisionBinaryChecksum returns true if LastDecisionBinaryChecksum is not nil.
+func (v *WorkflowExecutionInfo) IsSetLastDecisionBinaryChecksum() bool {
+	return v != nil && v.LastDecisionBinaryChecksum != nil
+}
+
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *WorkflowExecutionInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *WorkflowExecutionInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetEventCount returns the value of EventCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetEventCount() (o int64) {
+	if v != nil && v.EventCount != nil {
+		return *v.EventCount
+	}
+
+	return
+}
+
+// IsSetEventCount returns true if EventCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetEventCount() bool {
+	return v != nil && v.EventCount != nil
+}
+
+// GetActivityPayloadBytes returns the value of ActivityPayloadBytes if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetActivityPayloadBytes() (o int64) {
+	if v != nil && v.ActivityPayloadBytes != nil {
+		return *v.ActivityPayloadBytes
+	}
+
+	return
+}
+
+// IsSetActivityPayloadBytes returns true if ActivityPayloadBytes is not nil.
+func (v *WorkflowExecutionInfo) IsSetActivityPayloadBytes() bool {
+	return v != nil && v.ActivityPayloadBytes != nil
+}
+
+// GetSignalEventCount returns the value of SignalEventCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetSignalEventCount() (o int64) {
+	if v != nil && v.SignalEventCount != nil {
+		return *v.SignalEventCount
+	}
+
+	return
+}
+
+// IsSetSignalEventCount returns true if SignalEventCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetSignalEventCount() bool {
+	return v != nil && v.SignalEventCount != nil
+}
+
+// GetTimerCount returns the value of TimerCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetTimerCount() (o int64) {
+	if v != nil && v.TimerCount != nil {
+		return *v.TimerCount
+	}
+
+	return
+}
+
+// IsSetTimerCount returns true if TimerCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetTimerCount() bool {
+	return v != nil && v.TimerCount != nil
+}
+
+// GetTerminationProtected returns the value of TerminationProtected if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetTerminationProtected() (o bool) {
+	if v != nil && v.TerminationProtected != nil {
+		return *v.TerminationProtected
+	}
+
+	return
+}
+
+// IsSetTerminationProtected returns true if TerminationProtected is not nil.
+func (v *WorkflowExecutionInfo) IsSetTerminationProtected() bool {
+	return v != nil && v.TerminationProtected != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "61f2c3f7ef165868b46f88f1a8e4db115450a1fa",
+	SHA1:     "6513145dae221d7c80f2aa95db2c522ca3c9722c",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct ReplicationInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") lastEventID\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  40: optional i64 (js.type = \"Long\") currentVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  46: optional map<string, ReplicationInfo> lastReplicationInfo\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  32: optional map<string, ReplicationInfo> lastReplicationInfo\n  34: optional binary newRunBranchToken\n  36: optional bool resetWorkflow\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional i64 (js.type = \"Long\") maxObservedTimeNanos\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i32 failoverState\n  52: optional string pendingActiveClusterName\n  54: optional list<string> knownTaskLists\n  56: optional i64 previousFailoverVersion\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct ReplicationInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") lastEventID\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  40: optional i64 (js.type = \"Long\") currentVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  46: optional map<string, ReplicationInfo> lastReplicationInfo\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional list<string> queryTypes\n  124: optional string lastDecisionBinaryChecksum\n  126: optional bool paused\n  128: optional i32 priority\n  130: optional i64 (js.type = \"Long\") eventCount\n  132: optional i64 (js.type = \"Long\") activityPayloadBytes\n  134: optional i64 (js.type = \"Long\") signalEventCount\n  136: optional i64 (js.type = \"Long\") timerCount\n  138: optional bool terminationProtected\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional i64 (js.type = \"Long\") adaptivePartitionVersion\n  20: optional i32 numReadPartitions\n  22: optional i32 numWritePartitions\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  32: optional map<string, ReplicationInfo> lastReplicationInfo\n  34: optional binary newRunBranchToken\n  36: optional bool resetWorkflow\n}"
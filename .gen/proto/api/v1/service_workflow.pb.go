@@ -0,0 +1,2118 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/api/v1/service_workflow.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type RegisterDomainResponse struct {
+}
+
+func (m *RegisterDomainResponse) Reset()         { *m = RegisterDomainResponse{} }
+func (m *RegisterDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterDomainResponse) ProtoMessage()    {}
+func (*RegisterDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{0}
+}
+func (m *RegisterDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDomainResponse.Merge(m, src)
+}
+func (m *RegisterDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterDomainResponse proto.InternalMessageInfo
+
+type DeprecateDomainResponse struct {
+}
+
+func (m *DeprecateDomainResponse) Reset()         { *m = DeprecateDomainResponse{} }
+func (m *DeprecateDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*DeprecateDomainResponse) ProtoMessage()    {}
+func (*DeprecateDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{1}
+}
+func (m *DeprecateDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeprecateDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecateDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeprecateDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecateDomainResponse.Merge(m, src)
+}
+func (m *DeprecateDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecateDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecateDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeprecateDomainResponse proto.InternalMessageInfo
+
+type RespondDecisionTaskFailedResponse struct {
+}
+
+func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisionTaskFailedResponse{} }
+func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
+func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{2}
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondDecisionTaskFailedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondDecisionTaskFailedResponse.Merge(m, src)
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondDecisionTaskFailedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondDecisionTaskFailedResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCompletedResponse struct {
+}
+
+func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondActivityTaskCompletedResponse{} }
+func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
+func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{3}
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCompletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCompletedResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCompletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCompletedResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCompletedByIDResponse struct {
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) Reset() {
+	*m = RespondActivityTaskCompletedByIDResponse{}
+}
+func (m *RespondActivityTaskCompletedByIDResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCompletedByIDResponse) ProtoMessage()    {}
+func (*RespondActivityTaskCompletedByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{4}
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCompletedByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCompletedByIDResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCompletedByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCompletedByIDResponse proto.InternalMessageInfo
+
+type RespondActivityTaskFailedResponse struct {
+}
+
+func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivityTaskFailedResponse{} }
+func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
+func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{5}
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskFailedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskFailedResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskFailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskFailedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskFailedResponse proto.InternalMessageInfo
+
+type RespondActivityTaskFailedByIDResponse struct {
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) Reset()         { *m = RespondActivityTaskFailedByIDResponse{} }
+func (m *RespondActivityTaskFailedByIDResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskFailedByIDResponse) ProtoMessage()    {}
+func (*RespondActivityTaskFailedByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{6}
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskFailedByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskFailedByIDResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskFailedByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskFailedByIDResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCanceledResponse struct {
+}
+
+func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActivityTaskCanceledResponse{} }
+func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
+func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{7}
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCanceledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCanceledResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCanceledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCanceledResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCanceledByIDResponse struct {
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) Reset() {
+	*m = RespondActivityTaskCanceledByIDResponse{}
+}
+func (m *RespondActivityTaskCanceledByIDResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondActivityTaskCanceledByIDResponse) ProtoMessage()    {}
+func (*RespondActivityTaskCanceledByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{8}
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCanceledByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCanceledByIDResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCanceledByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCanceledByIDResponse proto.InternalMessageInfo
+
+type RequestCancelWorkflowExecutionResponse struct {
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Reset() {
+	*m = RequestCancelWorkflowExecutionResponse{}
+}
+func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
+func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{9}
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelWorkflowExecutionResponse proto.InternalMessageInfo
+
+type SignalWorkflowExecutionResponse struct {
+}
+
+func (m *SignalWorkflowExecutionResponse) Reset()         { *m = SignalWorkflowExecutionResponse{} }
+func (m *SignalWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignalWorkflowExecutionResponse) ProtoMessage()    {}
+func (*SignalWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{10}
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWorkflowExecutionResponse proto.InternalMessageInfo
+
+type TerminateWorkflowExecutionResponse struct {
+}
+
+func (m *TerminateWorkflowExecutionResponse) Reset()         { *m = TerminateWorkflowExecutionResponse{} }
+func (m *TerminateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*TerminateWorkflowExecutionResponse) ProtoMessage()    {}
+func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{11}
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
+
+type PauseWorkflowExecutionResponse struct {
+}
+
+func (m *PauseWorkflowExecutionResponse) Reset()         { *m = PauseWorkflowExecutionResponse{} }
+func (m *PauseWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseWorkflowExecutionResponse) ProtoMessage()    {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{12}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type ResumeWorkflowExecutionResponse struct {
+}
+
+func (m *ResumeWorkflowExecutionResponse) Reset()         { *m = ResumeWorkflowExecutionResponse{} }
+func (m *ResumeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeWorkflowExecutionResponse) ProtoMessage()    {}
+func (*ResumeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{13}
+}
+func (m *ResumeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *ResumeWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeWorkflowExecutionResponse proto.InternalMessageInfo
+
+type GetSearchAttributesRequest struct {
+}
+
+func (m *GetSearchAttributesRequest) Reset()         { *m = GetSearchAttributesRequest{} }
+func (m *GetSearchAttributesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSearchAttributesRequest) ProtoMessage()    {}
+func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{14}
+}
+func (m *GetSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSearchAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSearchAttributesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSearchAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSearchAttributesRequest.Merge(m, src)
+}
+func (m *GetSearchAttributesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSearchAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSearchAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSearchAttributesRequest proto.InternalMessageInfo
+
+type RespondQueryTaskCompletedResponse struct {
+}
+
+func (m *RespondQueryTaskCompletedResponse) Reset()         { *m = RespondQueryTaskCompletedResponse{} }
+func (m *RespondQueryTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
+func (*RespondQueryTaskCompletedResponse) ProtoMessage()    {}
+func (*RespondQueryTaskCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_674d14d2fee4e473, []int{15}
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondQueryTaskCompletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondQueryTaskCompletedResponse.Merge(m, src)
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondQueryTaskCompletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondQueryTaskCompletedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*RegisterDomainResponse)(nil), "uber.cadence.api.v1.RegisterDomainResponse")
+	proto.RegisterType((*DeprecateDomainResponse)(nil), "uber.cadence.api.v1.DeprecateDomainResponse")
+	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.api.v1.RespondDecisionTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedByIDResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCompletedByIDResponse")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedByIDResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskFailedByIDResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledByIDResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCanceledByIDResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*ResumeWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.ResumeWorkflowExecutionResponse")
+	proto.RegisterType((*GetSearchAttributesRequest)(nil), "uber.cadence.api.v1.GetSearchAttributesRequest")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.api.v1.RespondQueryTaskCompletedResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/api/v1/service_workflow.proto", fileDescriptor_674d14d2fee4e473)
+}
+
+var fileDescriptor_674d14d2fee4e473 = []byte{
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xee, 0x5e, 0x38, 0x0c, 0x3f, 0x8a, 0xa6, 0x52, 0x4b, 0x43, 0x71, 0xd2, 0xf4, 0x47, 0x7e,
+	0xd0, 0xda, 0x6d, 0xd2, 0xa6, 0x69, 0x1b, 0x0e, 0x49, 0x4c, 0x5b, 0x24, 0xa4, 0x16, 0xbb, 0x08,
+	0x89, 0x0b, 0xac, 0xd7, 0xaf, 0xf1, 0x28, 0xf6, 0xee, 0xb2, 0x33, 0xeb, 0x36, 0x47, 0x24, 0x4e,
+	0x48, 0x48, 0x95, 0x10, 0x08, 0x24, 0x24, 0xfe, 0x1d, 0x8e, 0x3d, 0x72, 0x42, 0x28, 0xf9, 0x47,
+	0xd0, 0xee, 0xce, 0xba, 0xb3, 0xde, 0x79, 0xcf, 0xbb, 0xee, 0x81, 0x5b, 0x1b, 0x7f, 0xdf, 0x7b,
+	0x9f, 0xe7, 0xbd, 0x37, 0xf3, 0xcd, 0x98, 0xad, 0xc7, 0x3d, 0x88, 0x5a, 0x9e, 0xdb, 0x07, 0xdf,
+	0x83, 0x96, 0x1b, 0x8a, 0xd6, 0xf8, 0x66, 0x4b, 0x42, 0x34, 0x16, 0x1e, 0x7c, 0xf3, 0x3c, 0x88,
+	0x0e, 0x9f, 0x0d, 0x83, 0xe7, 0xcd, 0x30, 0x0a, 0x54, 0xc0, 0xcf, 0x24, 0xd8, 0xa6, 0xc6, 0x36,
+	0xdd, 0x50, 0x34, 0xc7, 0x37, 0x17, 0x16, 0x6d, 0x01, 0xd4, 0x51, 0x08, 0x32, 0x63, 0x2d, 0x7f,
+	0xc0, 0xce, 0x76, 0xe0, 0x40, 0x48, 0x05, 0x51, 0x3b, 0x18, 0xb9, 0xc2, 0xef, 0x80, 0x0c, 0x03,
+	0x5f, 0xc2, 0xf2, 0x79, 0x76, 0xae, 0x0d, 0x61, 0x04, 0x9e, 0xab, 0x60, 0xea, 0xa3, 0x4b, 0xec,
+	0x62, 0xf6, 0xef, 0x7e, 0x1b, 0x3c, 0x21, 0x45, 0xe0, 0x3f, 0x75, 0xe5, 0xe1, 0x03, 0x57, 0x0c,
+	0xa1, 0x3f, 0x01, 0x5d, 0x65, 0x97, 0x35, 0x68, 0xd7, 0x53, 0x62, 0x2c, 0xd4, 0x51, 0x02, 0xda,
+	0x0f, 0x46, 0xe1, 0x10, 0x94, 0x81, 0x5b, 0x67, 0xab, 0x14, 0x6e, 0xef, 0xe8, 0xb3, 0xb6, 0x25,
+	0xb1, 0x89, 0x9d, 0x4a, 0xbc, 0xc2, 0xae, 0xa0, 0xa0, 0x42, 0xb4, 0x2b, 0xec, 0x92, 0x2d, 0xb3,
+	0xeb, 0x7b, 0x60, 0xc6, 0x5b, 0x63, 0x2b, 0x04, 0xac, 0x10, 0x71, 0x95, 0x5d, 0xed, 0xc0, 0x77,
+	0x31, 0x48, 0x95, 0x7d, 0xfc, 0x95, 0x2e, 0xd1, 0xa7, 0x2f, 0xc0, 0x8b, 0x95, 0x08, 0x5e, 0x2f,
+	0xe1, 0x45, 0xb6, 0xd8, 0x15, 0x07, 0xbe, 0x4b, 0x40, 0x2e, 0xb3, 0xe5, 0xa7, 0x10, 0x8d, 0x84,
+	0xef, 0x2a, 0xc0, 0x51, 0x4b, 0xac, 0xf1, 0xc4, 0x8d, 0x25, 0x90, 0xa9, 0x3a, 0x20, 0xe3, 0x11,
+	0x01, 0xb9, 0xc0, 0x16, 0x1e, 0x82, 0xea, 0x82, 0x1b, 0x79, 0x83, 0x5d, 0xa5, 0x22, 0xd1, 0x8b,
+	0x15, 0x48, 0xfd, 0x55, 0x8c, 0x55, 0xff, 0x22, 0x86, 0xc8, 0x5e, 0xc6, 0x8d, 0x7f, 0xae, 0xb3,
+	0xd3, 0x79, 0x82, 0x6e, 0xd6, 0xa1, 0x5c, 0xb0, 0xf7, 0x8a, 0xcd, 0xc5, 0xd7, 0x9b, 0x96, 0x2e,
+	0x6d, 0x4e, 0x77, 0x60, 0x9a, 0x76, 0xe1, 0xe3, 0x4a, 0xd8, 0x2c, 0x7d, 0x92, 0xaa, 0x0d, 0xd2,
+	0x8b, 0x44, 0x0f, 0xc8, 0x54, 0x45, 0x10, 0x9d, 0x6a, 0x1a, 0xab, 0x53, 0x7d, 0xcb, 0xde, 0xfe,
+	0x5c, 0x48, 0x95, 0xfd, 0x55, 0xf2, 0x15, 0x2b, 0xd7, 0x40, 0xe4, 0x49, 0x56, 0x67, 0x03, 0x75,
+	0x06, 0x8f, 0xbd, 0xf3, 0x65, 0xd8, 0x9f, 0xcc, 0x1d, 0xb7, 0x33, 0x4d, 0x48, 0x9e, 0x63, 0xad,
+	0x02, 0x52, 0x27, 0x19, 0xb2, 0xd3, 0x53, 0xf3, 0xcd, 0xb1, 0x65, 0x98, 0xda, 0x05, 0xb2, 0x54,
+	0xd7, 0xaa, 0x81, 0x75, 0xb6, 0xef, 0x1d, 0x76, 0xb6, 0xab, 0xdc, 0x48, 0x95, 0x9a, 0x90, 0x6f,
+	0x58, 0x03, 0xd9, 0xc1, 0x79, 0xf2, 0xcd, 0x5a, 0x1c, 0xad, 0xe1, 0xa5, 0xc3, 0x3e, 0x7c, 0x08,
+	0x65, 0xc0, 0x23, 0x21, 0x55, 0x10, 0x1d, 0xf1, 0x3b, 0xd6, 0xa0, 0x04, 0x23, 0x57, 0xb3, 0x5d,
+	0x9f, 0xa8, 0x25, 0xbd, 0x60, 0x67, 0x9e, 0x04, 0xc3, 0xe1, 0x83, 0x20, 0x32, 0x77, 0x52, 0xde,
+	0xb2, 0x06, 0xb4, 0x20, 0x73, 0x05, 0x37, 0xaa, 0x13, 0x74, 0xe6, 0x9f, 0x1d, 0x76, 0xc1, 0xb2,
+	0x89, 0x4f, 0x06, 0x9b, 0x6f, 0x23, 0xe3, 0x87, 0x53, 0x72, 0x31, 0x77, 0xe7, 0x60, 0x6a, 0x55,
+	0x3f, 0x3a, 0xec, 0x3c, 0x7a, 0xb4, 0xf0, 0xdb, 0x55, 0x03, 0xe7, 0x27, 0x42, 0xa6, 0x67, 0xab,
+	0x2e, 0xad, 0x54, 0x1c, 0x73, 0xe3, 0xa7, 0x8b, 0x63, 0x22, 0x2b, 0x15, 0xa7, 0x48, 0x30, 0x3a,
+	0xb5, 0x03, 0x5e, 0x10, 0x15, 0x8e, 0x9c, 0x47, 0xe0, 0x46, 0xaa, 0x07, 0xae, 0x42, 0x3a, 0x95,
+	0x60, 0xd0, 0x9d, 0x4a, 0x12, 0xb5, 0xa4, 0xdf, 0x1c, 0xb6, 0x48, 0xe0, 0x92, 0x63, 0x90, 0xdf,
+	0xaf, 0x1b, 0x3d, 0x3b, 0x3c, 0xdf, 0x54, 0x9a, 0xd1, 0xca, 0x56, 0x0b, 0x41, 0xb7, 0x32, 0xe2,
+	0x4e, 0x2a, 0xb4, 0x32, 0xe9, 0x6b, 0xf8, 0x9f, 0x0e, 0x5b, 0x9a, 0x65, 0x6c, 0xf8, 0x4e, 0xed,
+	0xf8, 0xe6, 0x92, 0x7d, 0x32, 0x27, 0xbb, 0x3c, 0x6c, 0x65, 0xa7, 0x44, 0x0f, 0x9b, 0xcd, 0x7e,
+	0x55, 0x18, 0x36, 0xdc, 0xb5, 0xf1, 0x5f, 0x1d, 0xf6, 0x11, 0x69, 0xdb, 0xf8, 0xdd, 0x7a, 0x91,
+	0xcd, 0x85, 0xba, 0x37, 0x0f, 0xb5, 0x30, 0x8b, 0xa8, 0xff, 0x43, 0x67, 0x91, 0x30, 0x96, 0x74,
+	0xc3, 0xcf, 0x74, 0xa4, 0xfc, 0x8f, 0x74, 0x16, 0x49, 0x4b, 0x8a, 0xce, 0xe2, 0x0c, 0x23, 0x9b,
+	0x49, 0xdb, 0x99, 0x8f, 0xac, 0xe5, 0xfd, 0xee, 0xb0, 0x06, 0x6d, 0x83, 0x39, 0x56, 0x10, 0xda,
+	0x3b, 0x67, 0xe2, 0xee, 0xcf, 0xc5, 0xd5, 0xda, 0x7e, 0x70, 0xd8, 0x39, 0xc4, 0x78, 0x73, 0xc4,
+	0x54, 0x60, 0x36, 0x3d, 0x53, 0x73, 0xab, 0x1e, 0x49, 0xcb, 0xf8, 0xc5, 0x61, 0x4b, 0x1a, 0x23,
+	0xd4, 0x00, 0x31, 0x46, 0x3b, 0x54, 0x68, 0x94, 0xf6, 0x46, 0x16, 0x29, 0xb1, 0x69, 0x1d, 0x90,
+	0x50, 0xd9, 0xa6, 0xd9, 0xc1, 0xb4, 0x06, 0x8c, 0xa3, 0x35, 0xfc, 0xe4, 0xb0, 0x05, 0xfc, 0xe2,
+	0xc3, 0xed, 0x1b, 0x0c, 0x75, 0x53, 0xca, 0xb4, 0xdc, 0xa9, 0xcd, 0x33, 0xd6, 0xc4, 0x7e, 0xc5,
+	0x42, 0xd6, 0x04, 0xbb, 0x8f, 0x51, 0x6b, 0x42, 0xdf, 0xe1, 0xd2, 0xb6, 0x45, 0x2e, 0x71, 0x1c,
+	0x5d, 0xe4, 0x78, 0x84, 0xab, 0xb8, 0x55, 0x8f, 0x64, 0x94, 0x26, 0xb9, 0xb0, 0x3c, 0x0e, 0xc1,
+	0x2f, 0xa1, 0x24, 0x52, 0x1a, 0x9c, 0x40, 0x97, 0x86, 0xe2, 0x19, 0x27, 0x7f, 0x02, 0xdb, 0x1f,
+	0x06, 0x12, 0xfa, 0x16, 0x45, 0xdb, 0x68, 0x64, 0x8c, 0x42, 0x9f, 0xfc, 0x34, 0xd3, 0x68, 0x98,
+	0x04, 0x68, 0xd1, 0xb3, 0x81, 0x46, 0xc5, 0x95, 0x6c, 0xd6, 0xe2, 0x18, 0x7b, 0x70, 0x02, 0xd9,
+	0x8d, 0xbc, 0x81, 0x18, 0x5b, 0xd7, 0xe6, 0x1e, 0x1a, 0x17, 0x27, 0xd1, 0x7b, 0xf0, 0x2c, 0xae,
+	0x79, 0x17, 0xf4, 0x5c, 0xff, 0xff, 0x5c, 0x9f, 0x64, 0xa0, 0xf6, 0x83, 0xd8, 0xb7, 0x15, 0xc9,
+	0x1e, 0x10, 0x41, 0xd3, 0x03, 0x85, 0x92, 0x5e, 0x5f, 0x31, 0x2c, 0x0f, 0x2f, 0xc8, 0x15, 0x03,
+	0x7f, 0xa2, 0x59, 0xb8, 0x51, 0x9d, 0x60, 0x98, 0x3f, 0xcb, 0xe7, 0x5d, 0x6f, 0x00, 0x23, 0x17,
+	0x31, 0x7f, 0x28, 0x9e, 0x36, 0x7f, 0x04, 0xad, 0xec, 0x44, 0xcb, 0x4f, 0x4c, 0xb4, 0x13, 0xb5,
+	0x3d, 0x49, 0x55, 0x70, 0xa2, 0xf8, 0x4b, 0x56, 0x52, 0x93, 0xf4, 0x84, 0xea, 0x2a, 0xe1, 0x1d,
+	0xa6, 0x98, 0xa4, 0x97, 0x90, 0x9a, 0x58, 0x90, 0x74, 0x4d, 0xac, 0x04, 0x9d, 0xf9, 0x19, 0x7b,
+	0x37, 0xd5, 0x95, 0x37, 0x0c, 0xb7, 0x3f, 0xe7, 0x14, 0x30, 0x79, 0xb6, 0xf5, 0x2a, 0x50, 0x63,
+	0xb9, 0xf3, 0xc7, 0xad, 0xf2, 0x79, 0x72, 0x9b, 0x7c, 0x0c, 0x43, 0x4f, 0x94, 0xad, 0xba, 0x34,
+	0xc3, 0xcc, 0xee, 0xb9, 0xca, 0x1b, 0xa0, 0x50, 0x89, 0x98, 0xd9, 0x19, 0x2c, 0xda, 0xcc, 0xce,
+	0x24, 0x1b, 0x9b, 0x55, 0x8a, 0x7d, 0x1c, 0x42, 0xe4, 0x26, 0x9f, 0x4d, 0xaa, 0xb3, 0x81, 0x07,
+	0x2e, 0x81, 0xe9, 0xcd, 0x0a, 0xe3, 0x18, 0x1a, 0x72, 0xa9, 0x45, 0x28, 0xa2, 0xc1, 0x0e, 0xa6,
+	0x35, 0x60, 0x9c, 0xa9, 0xa3, 0xbf, 0xb4, 0x54, 0xfb, 0x83, 0xe4, 0xe9, 0x70, 0xab, 0xfa, 0x26,
+	0x9c, 0x12, 0x66, 0x1f, 0xfd, 0x18, 0x4f, 0xeb, 0x09, 0xd8, 0xfb, 0xb9, 0xe2, 0xc9, 0x88, 0x5e,
+	0x23, 0xbf, 0xd8, 0xf4, 0x7c, 0x5e, 0xaf, 0x88, 0xce, 0x12, 0xee, 0xed, 0xff, 0x75, 0xdc, 0x70,
+	0x5e, 0x1d, 0x37, 0x9c, 0x7f, 0x8f, 0x1b, 0xce, 0xcb, 0x93, 0xc6, 0xa9, 0x57, 0x27, 0x8d, 0x53,
+	0x7f, 0x9f, 0x34, 0x4e, 0x7d, 0xbd, 0x76, 0x20, 0xd4, 0x20, 0xee, 0x35, 0xbd, 0x60, 0xd4, 0x2a,
+	0xfc, 0xde, 0xd2, 0x3c, 0x00, 0xbf, 0x95, 0xfe, 0xce, 0xa2, 0x7f, 0x7a, 0xe9, 0xbd, 0x95, 0xfe,
+	0x6f, 0xf3, 0xbf, 0x01, 0x00, 0x7f, 0x77, 0xd3, 0x8e, 0xd9, 0x19, 0x00, 0x00,
+}
+
+func (m *RegisterDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *DeprecateDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecateDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondDecisionTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ResumeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondQueryTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondQueryTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeVarintServiceWorkflow(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RegisterDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeprecateDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondDecisionTaskFailedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskFailedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResumeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovServiceWorkflow(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozServiceWorkflow(x uint64) (n int) {
+	return sovServiceWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisterDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeprecateDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeprecateDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeprecateDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCompletedByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCanceledByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSearchAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSearchAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServiceWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipServiceWorkflow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowServiceWorkflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowServiceWorkflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthServiceWorkflow
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthServiceWorkflow
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowServiceWorkflow
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipServiceWorkflow(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthServiceWorkflow
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthServiceWorkflow = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowServiceWorkflow   = fmt.Errorf("proto: integer overflow")
+)
@@ -0,0 +1,3173 @@
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/api/v1/service_workflow.proto
+
+package v1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// WorkflowServiceYARPCClient is the YARPC client-side interface for the WorkflowService service.
+type WorkflowServiceYARPCClient interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest, ...yarpc.CallOption) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest, ...yarpc.CallOption) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest, ...yarpc.CallOption) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest, ...yarpc.CallOption) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest, ...yarpc.CallOption) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest, ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest, ...yarpc.CallOption) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest, ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest, ...yarpc.CallOption) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest, ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest, ...yarpc.CallOption) (*ResumeWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error)
+	ListArchivedWorkflowExecutions(context.Context, *ListArchivedWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListArchivedWorkflowExecutionsResponse, error)
+	ScanWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error)
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest, ...yarpc.CallOption) (*CountWorkflowExecutionsResponse, error)
+	GetSearchAttributes(context.Context, *GetSearchAttributesRequest, ...yarpc.CallOption) (*GetSearchAttributesResponse, error)
+	GetSearchAttributesSchema(context.Context, *GetSearchAttributesSchemaRequest, ...yarpc.CallOption) (*GetSearchAttributesSchemaResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
+	BatchDescribeWorkflowExecutions(context.Context, *BatchDescribeWorkflowExecutionsRequest, ...yarpc.CallOption) (*BatchDescribeWorkflowExecutionsResponse, error)
+	BatchOperationWorkflow(context.Context, *BatchOperationWorkflowRequest, ...yarpc.CallOption) (*BatchOperationWorkflowResponse, error)
+	DescribeBatchOperation(context.Context, *DescribeBatchOperationRequest, ...yarpc.CallOption) (*DescribeBatchOperationResponse, error)
+	ListWorkflowExecutionChain(context.Context, *ListWorkflowExecutionChainRequest, ...yarpc.CallOption) (*ListWorkflowExecutionChainResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
+}
+
+func newWorkflowServiceYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) WorkflowServiceYARPCClient {
+	return &_WorkflowServiceYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.WorkflowService",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewWorkflowServiceYARPCClient builds a new YARPC client for the WorkflowService service.
+func NewWorkflowServiceYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkflowServiceYARPCClient {
+	return newWorkflowServiceYARPCClient(clientConfig, nil, options...)
+}
+
+// WorkflowServiceYARPCServer is the YARPC server-side interface for the WorkflowService service.
+type WorkflowServiceYARPCServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*ResumeWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest) (*ListClosedWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
+	ListArchivedWorkflowExecutions(context.Context, *ListArchivedWorkflowExecutionsRequest) (*ListArchivedWorkflowExecutionsResponse, error)
+	ScanWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+	GetSearchAttributes(context.Context, *GetSearchAttributesRequest) (*GetSearchAttributesResponse, error)
+	GetSearchAttributesSchema(context.Context, *GetSearchAttributesSchemaRequest) (*GetSearchAttributesSchemaResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
+	BatchDescribeWorkflowExecutions(context.Context, *BatchDescribeWorkflowExecutionsRequest) (*BatchDescribeWorkflowExecutionsResponse, error)
+	BatchOperationWorkflow(context.Context, *BatchOperationWorkflowRequest) (*BatchOperationWorkflowResponse, error)
+	DescribeBatchOperation(context.Context, *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error)
+	ListWorkflowExecutionChain(context.Context, *ListWorkflowExecutionChainRequest) (*ListWorkflowExecutionChainResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
+}
+
+type buildWorkflowServiceYARPCProceduresParams struct {
+	Server      WorkflowServiceYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildWorkflowServiceYARPCProcedures(params buildWorkflowServiceYARPCProceduresParams) []transport.Procedure {
+	handler := &_WorkflowServiceYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.WorkflowService",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "RegisterDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RegisterDomain,
+							NewRequest:  newWorkflowServiceServiceRegisterDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeDomain,
+							NewRequest:  newWorkflowServiceServiceDescribeDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListDomains",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListDomains,
+							NewRequest:  newWorkflowServiceServiceListDomainsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDomain,
+							NewRequest:  newWorkflowServiceServiceUpdateDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeprecateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeprecateDomain,
+							NewRequest:  newWorkflowServiceServiceDeprecateDomainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "StartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.StartWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionHistory",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetWorkflowExecutionHistory,
+							NewRequest:  newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PollForDecisionTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PollForDecisionTask,
+							NewRequest:  newWorkflowServiceServicePollForDecisionTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondDecisionTaskCompleted,
+							NewRequest:  newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondDecisionTaskFailed,
+							NewRequest:  newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PollForActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PollForActivityTask,
+							NewRequest:  newWorkflowServiceServicePollForActivityTaskYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeat",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordActivityTaskHeartbeat,
+							NewRequest:  newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeatByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordActivityTaskHeartbeatByID,
+							NewRequest:  newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCompleted,
+							NewRequest:  newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompletedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCompletedByID,
+							NewRequest:  newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskFailed,
+							NewRequest:  newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskFailedByID,
+							NewRequest:  newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceled",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCanceled,
+							NewRequest:  newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceledByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondActivityTaskCanceledByID,
+							NewRequest:  newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RequestCancelWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RequestCancelWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.SignalWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWithStartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.SignalWithStartWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "TerminateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.TerminateWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "PauseWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.PauseWorkflowExecution,
+							NewRequest:  newWorkflowServiceServicePauseWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResumeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResumeWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListOpenWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListOpenWorkflowExecutions,
+							NewRequest:  newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListClosedWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListClosedWorkflowExecutions,
+							NewRequest:  newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListWorkflowExecutions,
+							NewRequest:  newWorkflowServiceServiceListWorkflowExecutionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListArchivedWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListArchivedWorkflowExecutions,
+							NewRequest:  newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ScanWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ScanWorkflowExecutions,
+							NewRequest:  newWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "CountWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.CountWorkflowExecutions,
+							NewRequest:  newWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetSearchAttributes",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetSearchAttributes,
+							NewRequest:  newWorkflowServiceServiceGetSearchAttributesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetSearchAttributesSchema",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetSearchAttributesSchema,
+							NewRequest:  newWorkflowServiceServiceGetSearchAttributesSchemaYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RespondQueryTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RespondQueryTaskCompleted,
+							NewRequest:  newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetStickyTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetStickyTaskList,
+							NewRequest:  newWorkflowServiceServiceResetStickyTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "QueryWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.QueryWorkflow,
+							NewRequest:  newWorkflowServiceServiceQueryWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeWorkflowExecution,
+							NewRequest:  newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "BatchDescribeWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.BatchDescribeWorkflowExecutions,
+							NewRequest:  newWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "BatchOperationWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.BatchOperationWorkflow,
+							NewRequest:  newWorkflowServiceServiceBatchOperationWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeBatchOperation",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeBatchOperation,
+							NewRequest:  newWorkflowServiceServiceDescribeBatchOperationYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListWorkflowExecutionChain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListWorkflowExecutionChain,
+							NewRequest:  newWorkflowServiceServiceListWorkflowExecutionChainYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeTaskList,
+							NewRequest:  newWorkflowServiceServiceDescribeTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildWorkflowServiceYARPCProcedures prepares an implementation of the WorkflowService service for YARPC registration.
+func BuildWorkflowServiceYARPCProcedures(server WorkflowServiceYARPCServer) []transport.Procedure {
+	return buildWorkflowServiceYARPCProcedures(buildWorkflowServiceYARPCProceduresParams{Server: server})
+}
+
+// FxWorkflowServiceYARPCClientParams defines the input
+// for NewFxWorkflowServiceYARPCClient. It provides the
+// paramaters to get a WorkflowServiceYARPCClient in an
+// Fx application.
+type FxWorkflowServiceYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxWorkflowServiceYARPCClientResult defines the output
+// of NewFxWorkflowServiceYARPCClient. It provides a
+// WorkflowServiceYARPCClient to an Fx application.
+type FxWorkflowServiceYARPCClientResult struct {
+	fx.Out
+
+	Client WorkflowServiceYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxWorkflowServiceYARPCClient provides a WorkflowServiceYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  v1.NewFxWorkflowServiceYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxWorkflowServiceYARPCClientParams) FxWorkflowServiceYARPCClientResult {
+		return FxWorkflowServiceYARPCClientResult{
+			Client: newWorkflowServiceYARPCClient(params.Provider.ClientConfig(name), params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxWorkflowServiceYARPCProceduresParams defines the input
+// for NewFxWorkflowServiceYARPCProcedures. It provides the
+// paramaters to get WorkflowServiceYARPCServer procedures in an
+// Fx application.
+type FxWorkflowServiceYARPCProceduresParams struct {
+	fx.In
+
+	Server      WorkflowServiceYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxWorkflowServiceYARPCProceduresResult defines the output
+// of NewFxWorkflowServiceYARPCProcedures. It provides
+// WorkflowServiceYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxWorkflowServiceYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxWorkflowServiceYARPCProcedures provides WorkflowServiceYARPCServer procedures to an Fx application.
+// It expects a WorkflowServiceYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  v1.NewFxWorkflowServiceYARPCProcedures(),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCProcedures() interface{} {
+	return func(params FxWorkflowServiceYARPCProceduresParams) FxWorkflowServiceYARPCProceduresResult {
+		return FxWorkflowServiceYARPCProceduresResult{
+			Procedures: buildWorkflowServiceYARPCProcedures(buildWorkflowServiceYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.api.v1.WorkflowService",
+				FileDescriptors: yarpcFileDescriptorClosure674d14d2fee4e473,
+			},
+		}
+	}
+}
+
+type _WorkflowServiceYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_WorkflowServiceYARPCCaller) RegisterDomain(ctx context.Context, request *RegisterDomainRequest, options ...yarpc.CallOption) (*RegisterDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RegisterDomain", request, newWorkflowServiceServiceRegisterDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RegisterDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeDomain(ctx context.Context, request *DescribeDomainRequest, options ...yarpc.CallOption) (*DescribeDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeDomain", request, newWorkflowServiceServiceDescribeDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListDomains(ctx context.Context, request *ListDomainsRequest, options ...yarpc.CallOption) (*ListDomainsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDomains", request, newWorkflowServiceServiceListDomainsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDomainsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) UpdateDomain(ctx context.Context, request *UpdateDomainRequest, options ...yarpc.CallOption) (*UpdateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomain", request, newWorkflowServiceServiceUpdateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DeprecateDomain(ctx context.Context, request *DeprecateDomainRequest, options ...yarpc.CallOption) (*DeprecateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeprecateDomain", request, newWorkflowServiceServiceDeprecateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeprecateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) StartWorkflowExecution(ctx context.Context, request *StartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StartWorkflowExecution", request, newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest, options ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionHistory", request, newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionHistoryResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForDecisionTask(ctx context.Context, request *PollForDecisionTaskRequest, options ...yarpc.CallOption) (*PollForDecisionTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForDecisionTask", request, newWorkflowServiceServicePollForDecisionTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForDecisionTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskCompleted(ctx context.Context, request *RespondDecisionTaskCompletedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskCompleted", request, newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskFailed(ctx context.Context, request *RespondDecisionTaskFailedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskFailed", request, newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForActivityTask(ctx context.Context, request *PollForActivityTaskRequest, options ...yarpc.CallOption) (*PollForActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForActivityTask", request, newWorkflowServiceServicePollForActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeat(ctx context.Context, request *RecordActivityTaskHeartbeatRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeat", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeatByID(ctx context.Context, request *RecordActivityTaskHeartbeatByIDRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeatByID", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompleted(ctx context.Context, request *RespondActivityTaskCompletedRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompleted", request, newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompletedByID(ctx context.Context, request *RespondActivityTaskCompletedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompletedByID", request, newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailed(ctx context.Context, request *RespondActivityTaskFailedRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailed", request, newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailedByID(ctx context.Context, request *RespondActivityTaskFailedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailedByID", request, newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceled(ctx context.Context, request *RespondActivityTaskCanceledRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceled", request, newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceledByID(ctx context.Context, request *RespondActivityTaskCanceledByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceledByID", request, newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RequestCancelWorkflowExecution(ctx context.Context, request *RequestCancelWorkflowExecutionRequest, options ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RequestCancelWorkflowExecution", request, newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RequestCancelWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWorkflowExecution(ctx context.Context, request *SignalWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWorkflowExecution", request, newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SignalWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWithStartWorkflowExecution(ctx context.Context, request *SignalWithStartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWithStartWorkflowExecution", request, newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest, options ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetWorkflowExecution", request, newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) TerminateWorkflowExecution(ctx context.Context, request *TerminateWorkflowExecutionRequest, options ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "TerminateWorkflowExecution", request, newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*TerminateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest, options ...yarpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PauseWorkflowExecution", request, newWorkflowServiceServicePauseWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePauseWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResumeWorkflowExecution(ctx context.Context, request *ResumeWorkflowExecutionRequest, options ...yarpc.CallOption) (*ResumeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResumeWorkflowExecution", request, newWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResumeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListOpenWorkflowExecutions(ctx context.Context, request *ListOpenWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListOpenWorkflowExecutions", request, newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListOpenWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListClosedWorkflowExecutions(ctx context.Context, request *ListClosedWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListClosedWorkflowExecutions", request, newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListClosedWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListWorkflowExecutions", request, newWorkflowServiceServiceListWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListArchivedWorkflowExecutions(ctx context.Context, request *ListArchivedWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListArchivedWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListArchivedWorkflowExecutions", request, newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListArchivedWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ScanWorkflowExecutions", request, newWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest, options ...yarpc.CallOption) (*CountWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "CountWorkflowExecutions", request, newWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CountWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetSearchAttributes(ctx context.Context, request *GetSearchAttributesRequest, options ...yarpc.CallOption) (*GetSearchAttributesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetSearchAttributes", request, newWorkflowServiceServiceGetSearchAttributesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetSearchAttributesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetSearchAttributesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetSearchAttributesSchema(ctx context.Context, request *GetSearchAttributesSchemaRequest, options ...yarpc.CallOption) (*GetSearchAttributesSchemaResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetSearchAttributesSchema", request, newWorkflowServiceServiceGetSearchAttributesSchemaYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetSearchAttributesSchemaResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetSearchAttributesSchemaYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondQueryTaskCompleted(ctx context.Context, request *RespondQueryTaskCompletedRequest, options ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondQueryTaskCompleted", request, newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetStickyTaskList(ctx context.Context, request *ResetStickyTaskListRequest, options ...yarpc.CallOption) (*ResetStickyTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetStickyTaskList", request, newWorkflowServiceServiceResetStickyTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetStickyTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) QueryWorkflow(ctx context.Context, request *QueryWorkflowRequest, options ...yarpc.CallOption) (*QueryWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "QueryWorkflow", request, newWorkflowServiceServiceQueryWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*QueryWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) BatchDescribeWorkflowExecutions(ctx context.Context, request *BatchDescribeWorkflowExecutionsRequest, options ...yarpc.CallOption) (*BatchDescribeWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "BatchDescribeWorkflowExecutions", request, newWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*BatchDescribeWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) BatchOperationWorkflow(ctx context.Context, request *BatchOperationWorkflowRequest, options ...yarpc.CallOption) (*BatchOperationWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "BatchOperationWorkflow", request, newWorkflowServiceServiceBatchOperationWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*BatchOperationWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceBatchOperationWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeBatchOperation(ctx context.Context, request *DescribeBatchOperationRequest, options ...yarpc.CallOption) (*DescribeBatchOperationResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeBatchOperation", request, newWorkflowServiceServiceDescribeBatchOperationYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeBatchOperationResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeBatchOperationYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListWorkflowExecutionChain(ctx context.Context, request *ListWorkflowExecutionChainRequest, options ...yarpc.CallOption) (*ListWorkflowExecutionChainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListWorkflowExecutionChain", request, newWorkflowServiceServiceListWorkflowExecutionChainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowExecutionChainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionChainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskList", request, newWorkflowServiceServiceDescribeTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkflowServiceYARPCHandler struct {
+	server WorkflowServiceYARPCServer
+}
+
+func (h *_WorkflowServiceYARPCHandler) RegisterDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RegisterDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RegisterDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RegisterDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListDomains(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDomainsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDomainsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDomains(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) UpdateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DeprecateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeprecateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeprecateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeprecateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) StartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetWorkflowExecutionHistory(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionHistoryRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionHistoryRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionHistory(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForDecisionTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForDecisionTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForDecisionTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForDecisionTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeat(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeatByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompletedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceled(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceled(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceledByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RequestCancelWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RequestCancelWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RequestCancelWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RequestCancelWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWithStartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWithStartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWithStartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) TerminateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *TerminateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*TerminateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.TerminateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PauseWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PauseWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PauseWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePauseWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PauseWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResumeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResumeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResumeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResumeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListOpenWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListOpenWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListOpenWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListOpenWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListClosedWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListClosedWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListClosedWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListClosedWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListArchivedWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListArchivedWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListArchivedWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListArchivedWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ScanWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ScanWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) CountWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CountWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CountWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CountWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetSearchAttributes(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetSearchAttributesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetSearchAttributesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetSearchAttributesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetSearchAttributes(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetSearchAttributesSchema(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetSearchAttributesSchemaRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetSearchAttributesSchemaRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetSearchAttributesSchemaYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetSearchAttributesSchema(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondQueryTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondQueryTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondQueryTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondQueryTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetStickyTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetStickyTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetStickyTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetStickyTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) QueryWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *QueryWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*QueryWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.QueryWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) BatchDescribeWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *BatchDescribeWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*BatchDescribeWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.BatchDescribeWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) BatchOperationWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *BatchOperationWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*BatchOperationWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceBatchOperationWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.BatchOperationWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeBatchOperation(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeBatchOperationRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeBatchOperationRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeBatchOperationYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeBatchOperation(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListWorkflowExecutionChain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowExecutionChainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowExecutionChainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionChainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListWorkflowExecutionChain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCRequest() proto.Message {
+	return &RegisterDomainRequest{}
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCResponse() proto.Message {
+	return &RegisterDomainResponse{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCRequest() proto.Message {
+	return &DescribeDomainRequest{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCResponse() proto.Message {
+	return &DescribeDomainResponse{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCRequest() proto.Message {
+	return &ListDomainsRequest{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCResponse() proto.Message {
+	return &ListDomainsResponse{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCRequest() proto.Message {
+	return &UpdateDomainRequest{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCResponse() proto.Message {
+	return &UpdateDomainResponse{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCRequest() proto.Message {
+	return &DeprecateDomainRequest{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCResponse() proto.Message {
+	return &DeprecateDomainResponse{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &StartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest() proto.Message {
+	return &GetWorkflowExecutionHistoryRequest{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse() proto.Message {
+	return &GetWorkflowExecutionHistoryResponse{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCRequest() proto.Message {
+	return &PollForDecisionTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCResponse() proto.Message {
+	return &PollForDecisionTaskResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskFailedResponse{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCRequest() proto.Message {
+	return &PollForActivityTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCResponse() proto.Message {
+	return &PollForActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledByIDResponse{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest() proto.Message {
+	return &RequestCancelWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse() proto.Message {
+	return &RequestCancelWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse() proto.Message {
+	return &SignalWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest() proto.Message {
+	return &ResetWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse() proto.Message {
+	return &ResetWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest() proto.Message {
+	return &TerminateWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse() proto.Message {
+	return &TerminateWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServicePauseWorkflowExecutionYARPCRequest() proto.Message {
+	return &PauseWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServicePauseWorkflowExecutionYARPCResponse() proto.Message {
+	return &PauseWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest() proto.Message {
+	return &ResumeWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse() proto.Message {
+	return &ResumeWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListOpenWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListOpenWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListClosedWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListClosedWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListArchivedWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListArchivedWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest() proto.Message {
+	return &CountWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse() proto.Message {
+	return &CountWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceGetSearchAttributesYARPCRequest() proto.Message {
+	return &GetSearchAttributesRequest{}
+}
+
+func newWorkflowServiceServiceGetSearchAttributesYARPCResponse() proto.Message {
+	return &GetSearchAttributesResponse{}
+}
+
+func newWorkflowServiceServiceGetSearchAttributesSchemaYARPCRequest() proto.Message {
+	return &GetSearchAttributesSchemaRequest{}
+}
+
+func newWorkflowServiceServiceGetSearchAttributesSchemaYARPCResponse() proto.Message {
+	return &GetSearchAttributesSchemaResponse{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest() proto.Message {
+	return &RespondQueryTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse() proto.Message {
+	return &RespondQueryTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCRequest() proto.Message {
+	return &ResetStickyTaskListRequest{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCResponse() proto.Message {
+	return &ResetStickyTaskListResponse{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCRequest() proto.Message {
+	return &QueryWorkflowRequest{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCResponse() proto.Message {
+	return &QueryWorkflowResponse{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
+	return &DescribeWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse() proto.Message {
+	return &DescribeWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCRequest() proto.Message {
+	return &BatchDescribeWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCResponse() proto.Message {
+	return &BatchDescribeWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceBatchOperationWorkflowYARPCRequest() proto.Message {
+	return &BatchOperationWorkflowRequest{}
+}
+
+func newWorkflowServiceServiceBatchOperationWorkflowYARPCResponse() proto.Message {
+	return &BatchOperationWorkflowResponse{}
+}
+
+func newWorkflowServiceServiceDescribeBatchOperationYARPCRequest() proto.Message {
+	return &DescribeBatchOperationRequest{}
+}
+
+func newWorkflowServiceServiceDescribeBatchOperationYARPCResponse() proto.Message {
+	return &DescribeBatchOperationResponse{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionChainYARPCRequest() proto.Message {
+	return &ListWorkflowExecutionChainRequest{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionChainYARPCResponse() proto.Message {
+	return &ListWorkflowExecutionChainResponse{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCRequest() proto.Message {
+	return &DescribeTaskListRequest{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCResponse() proto.Message {
+	return &DescribeTaskListResponse{}
+}
+
+var (
+	emptyWorkflowServiceServiceRegisterDomainYARPCRequest                    = &RegisterDomainRequest{}
+	emptyWorkflowServiceServiceRegisterDomainYARPCResponse                   = &RegisterDomainResponse{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCRequest                    = &DescribeDomainRequest{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCResponse                   = &DescribeDomainResponse{}
+	emptyWorkflowServiceServiceListDomainsYARPCRequest                       = &ListDomainsRequest{}
+	emptyWorkflowServiceServiceListDomainsYARPCResponse                      = &ListDomainsResponse{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCRequest                      = &UpdateDomainRequest{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCResponse                     = &UpdateDomainResponse{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCRequest                   = &DeprecateDomainRequest{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCResponse                  = &DeprecateDomainResponse{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest            = &StartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse           = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest       = &GetWorkflowExecutionHistoryRequest{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse      = &GetWorkflowExecutionHistoryResponse{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest      = &RespondDecisionTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse     = &RespondDecisionTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest         = &RespondDecisionTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse        = &RespondDecisionTaskFailedResponse{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest       = &RecordActivityTaskHeartbeatRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse      = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest   = &RecordActivityTaskHeartbeatByIDRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse  = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest      = &RespondActivityTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse     = &RespondActivityTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest  = &RespondActivityTaskCompletedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse = &RespondActivityTaskCompletedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest         = &RespondActivityTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse        = &RespondActivityTaskFailedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest     = &RespondActivityTaskFailedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse    = &RespondActivityTaskFailedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest       = &RespondActivityTaskCanceledRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse      = &RespondActivityTaskCanceledResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest   = &RespondActivityTaskCanceledByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse  = &RespondActivityTaskCanceledByIDResponse{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest    = &RequestCancelWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse   = &RequestCancelWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest           = &SignalWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse          = &SignalWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest  = &SignalWithStartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest            = &ResetWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse           = &ResetWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest        = &TerminateWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse       = &TerminateWorkflowExecutionResponse{}
+	emptyWorkflowServiceServicePauseWorkflowExecutionYARPCRequest            = &PauseWorkflowExecutionRequest{}
+	emptyWorkflowServiceServicePauseWorkflowExecutionYARPCResponse           = &PauseWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCRequest           = &ResumeWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceResumeWorkflowExecutionYARPCResponse          = &ResumeWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest        = &ListOpenWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse       = &ListOpenWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest      = &ListClosedWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse     = &ListClosedWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListWorkflowExecutionsYARPCRequest            = &ListWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListWorkflowExecutionsYARPCResponse           = &ListWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest    = &ListArchivedWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse   = &ListArchivedWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCRequest            = &ListWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceScanWorkflowExecutionsYARPCResponse           = &ListWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest           = &CountWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse          = &CountWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceGetSearchAttributesYARPCRequest               = &GetSearchAttributesRequest{}
+	emptyWorkflowServiceServiceGetSearchAttributesYARPCResponse              = &GetSearchAttributesResponse{}
+	emptyWorkflowServiceServiceGetSearchAttributesSchemaYARPCRequest         = &GetSearchAttributesSchemaRequest{}
+	emptyWorkflowServiceServiceGetSearchAttributesSchemaYARPCResponse        = &GetSearchAttributesSchemaResponse{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest               = &ResetStickyTaskListRequest{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse              = &ResetStickyTaskListResponse{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCRequest                     = &QueryWorkflowRequest{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCResponse                    = &QueryWorkflowResponse{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest         = &DescribeWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse        = &DescribeWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCRequest   = &BatchDescribeWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceBatchDescribeWorkflowExecutionsYARPCResponse  = &BatchDescribeWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceBatchOperationWorkflowYARPCRequest            = &BatchOperationWorkflowRequest{}
+	emptyWorkflowServiceServiceBatchOperationWorkflowYARPCResponse           = &BatchOperationWorkflowResponse{}
+	emptyWorkflowServiceServiceDescribeBatchOperationYARPCRequest            = &DescribeBatchOperationRequest{}
+	emptyWorkflowServiceServiceDescribeBatchOperationYARPCResponse           = &DescribeBatchOperationResponse{}
+	emptyWorkflowServiceServiceListWorkflowExecutionChainYARPCRequest        = &ListWorkflowExecutionChainRequest{}
+	emptyWorkflowServiceServiceListWorkflowExecutionChainYARPCResponse       = &ListWorkflowExecutionChainResponse{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCRequest                  = &DescribeTaskListRequest{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCResponse                 = &DescribeTaskListResponse{}
+)
+
+var yarpcFileDescriptorClosure674d14d2fee4e473 = [][]byte{
+	// uber/cadence/api/v1/service_workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5d, 0x6f, 0x13, 0x47,
+		0x14, 0xd5, 0xbe, 0xf4, 0x61, 0xfa, 0x41, 0x35, 0x48, 0x50, 0x5c, 0x4a, 0x42, 0xf8, 0xc8, 0x07,
+		0x60, 0x43, 0x02, 0x21, 0x40, 0xfa, 0x90, 0xc4, 0x05, 0x2a, 0x55, 0x82, 0xda, 0x54, 0x95, 0xfa,
+		0xd2, 0x8e, 0xd7, 0x97, 0x78, 0x14, 0x7b, 0x77, 0xbb, 0x33, 0x6b, 0xc8, 0x63, 0xa5, 0x3e, 0x55,
+		0xaa, 0x54, 0xa9, 0x6a, 0xd5, 0x4a, 0x95, 0xfa, 0xcf, 0xfa, 0x5b, 0xaa, 0xdd, 0x9d, 0x35, 0xb3,
+		0xde, 0xb9, 0xd7, 0xbb, 0xe6, 0x81, 0x37, 0x88, 0xcf, 0xb9, 0xf7, 0x78, 0xee, 0xbd, 0x33, 0x67,
+		0xc6, 0x6c, 0x2b, 0x19, 0x40, 0xdc, 0xf1, 0xc5, 0x10, 0x02, 0x1f, 0x3a, 0x22, 0x92, 0x9d, 0xe9,
+		0x9d, 0x8e, 0x82, 0x78, 0x2a, 0x7d, 0xf8, 0xfe, 0x55, 0x18, 0x9f, 0xbc, 0x1c, 0x87, 0xaf, 0xda,
+		0x51, 0x1c, 0xea, 0x90, 0x9f, 0x4d, 0xb1, 0x6d, 0x83, 0x6d, 0x8b, 0x48, 0xb6, 0xa7, 0x77, 0x5a,
+		0x2b, 0xae, 0x00, 0xfa, 0x34, 0x02, 0x95, 0xb3, 0xd6, 0x3e, 0x61, 0xe7, 0x7a, 0x70, 0x2c, 0x95,
+		0x86, 0xb8, 0x1b, 0x4e, 0x84, 0x0c, 0x7a, 0xa0, 0xa2, 0x30, 0x50, 0xb0, 0x76, 0x81, 0x9d, 0xef,
+		0x42, 0x14, 0x83, 0x2f, 0x34, 0xcc, 0x7d, 0x74, 0x85, 0x5d, 0xce, 0xff, 0x3d, 0xec, 0x82, 0x2f,
+		0x95, 0x0c, 0x83, 0x17, 0x42, 0x9d, 0x3c, 0x16, 0x72, 0x0c, 0xc3, 0x19, 0xe8, 0x3a, 0xbb, 0x6a,
+		0x40, 0x07, 0xbe, 0x96, 0x53, 0xa9, 0x4f, 0x53, 0xd0, 0x51, 0x38, 0x89, 0xc6, 0xa0, 0x2d, 0xdc,
+		0x16, 0xdb, 0xa0, 0x70, 0x87, 0xa7, 0x5f, 0x76, 0x1d, 0x89, 0x6d, 0xec, 0x5c, 0xe2, 0x75, 0x76,
+		0x0d, 0x05, 0x95, 0xa2, 0x5d, 0x63, 0x57, 0x5c, 0x99, 0x45, 0xe0, 0x83, 0x1d, 0x6f, 0x93, 0xad,
+		0x13, 0xb0, 0x52, 0xc4, 0x0d, 0x76, 0xbd, 0x07, 0x3f, 0x26, 0xa0, 0x74, 0xfe, 0xf1, 0xb7, 0xa6,
+		0x44, 0x5f, 0xbc, 0x06, 0x3f, 0xd1, 0x32, 0x7c, 0xb3, 0x84, 0x97, 0xd9, 0x4a, 0x5f, 0x1e, 0x07,
+		0x82, 0x80, 0x5c, 0x65, 0x6b, 0x2f, 0x20, 0x9e, 0xc8, 0x40, 0x68, 0xc0, 0x51, 0xab, 0xec, 0xd2,
+		0x73, 0x91, 0x28, 0x20, 0x53, 0xf5, 0x40, 0x25, 0x13, 0x02, 0x72, 0x91, 0xb5, 0x9e, 0x80, 0xee,
+		0x83, 0x88, 0xfd, 0xd1, 0x81, 0xd6, 0xb1, 0x1c, 0x24, 0x1a, 0x94, 0xf9, 0x2a, 0xd6, 0xaa, 0x7f,
+		0x9d, 0x40, 0xec, 0x2e, 0xe3, 0xf6, 0x7f, 0xb7, 0xd8, 0x99, 0x22, 0x41, 0x3f, 0xef, 0x50, 0x2e,
+		0xd9, 0x47, 0xe5, 0xe6, 0xe2, 0x5b, 0x6d, 0x47, 0x97, 0xb6, 0xe7, 0x3b, 0x30, 0x4b, 0xdb, 0xba,
+		0x51, 0x0b, 0x9b, 0xa7, 0x4f, 0x53, 0x75, 0x41, 0xf9, 0xb1, 0x1c, 0x00, 0x99, 0xaa, 0x0c, 0xa2,
+		0x53, 0xcd, 0x63, 0x4d, 0xaa, 0x1f, 0xd8, 0xfb, 0x5f, 0x49, 0xa5, 0xf3, 0xbf, 0x2a, 0xbe, 0xee,
+		0xe4, 0x5a, 0x88, 0x22, 0xc9, 0xc6, 0x62, 0xa0, 0xc9, 0xe0, 0xb3, 0x0f, 0xbe, 0x89, 0x86, 0xb3,
+		0xb9, 0xe3, 0x6e, 0xa6, 0x0d, 0x29, 0x72, 0x6c, 0xd6, 0x40, 0x9a, 0x24, 0x63, 0x76, 0x66, 0x6e,
+		0xbe, 0x39, 0xb6, 0x0c, 0x73, 0xbb, 0x40, 0x9e, 0xea, 0x66, 0x3d, 0xb0, 0xc9, 0xf6, 0x93, 0xc7,
+		0xce, 0xf5, 0xb5, 0x88, 0x75, 0xa5, 0x09, 0xf9, 0xb6, 0x33, 0x90, 0x1b, 0x5c, 0x24, 0xdf, 0x69,
+		0xc4, 0x31, 0x1a, 0x7e, 0xf3, 0xd8, 0xa7, 0x4f, 0xa0, 0x0a, 0x78, 0x2a, 0x95, 0x0e, 0xe3, 0x53,
+		0x7e, 0xdf, 0x19, 0x94, 0x60, 0x14, 0x6a, 0xf6, 0x9a, 0x13, 0x8d, 0xa4, 0xd7, 0xec, 0xec, 0xf3,
+		0x70, 0x3c, 0x7e, 0x1c, 0xc6, 0xf6, 0x4e, 0xca, 0x3b, 0xce, 0x80, 0x0e, 0x64, 0xa1, 0xe0, 0x76,
+		0x7d, 0x82, 0xc9, 0xfc, 0xbb, 0xc7, 0x2e, 0x3a, 0x36, 0xf1, 0xd9, 0x60, 0xf3, 0x3d, 0x64, 0xfc,
+		0x70, 0x4a, 0x21, 0xe6, 0xc1, 0x12, 0x4c, 0xa3, 0xea, 0x17, 0x8f, 0x5d, 0x40, 0x8f, 0x16, 0x7e,
+		0xaf, 0x6e, 0xe0, 0xe2, 0x44, 0xc8, 0xf5, 0xec, 0x36, 0xa5, 0x55, 0x8a, 0x63, 0x6f, 0xfc, 0x74,
+		0x71, 0x6c, 0x64, 0xad, 0xe2, 0x94, 0x09, 0x56, 0xa7, 0xf6, 0xc0, 0x0f, 0xe3, 0xd2, 0x91, 0xf3,
+		0x14, 0x44, 0xac, 0x07, 0x20, 0x34, 0xd2, 0xa9, 0x04, 0x83, 0xee, 0x54, 0x92, 0x68, 0x24, 0xfd,
+		0xe5, 0xb1, 0x15, 0x02, 0x97, 0x1e, 0x83, 0xfc, 0x51, 0xd3, 0xe8, 0xf9, 0xe1, 0xf9, 0xb6, 0xd2,
+		0xac, 0x56, 0x76, 0x5a, 0x08, 0xba, 0x95, 0x11, 0x77, 0x52, 0xa3, 0x95, 0x49, 0x5f, 0xc3, 0xff,
+		0xf5, 0xd8, 0xea, 0x22, 0x63, 0xc3, 0xf7, 0x1b, 0xc7, 0xb7, 0x97, 0xec, 0xf3, 0x25, 0xd9, 0xd5,
+		0x61, 0xab, 0x3a, 0x25, 0x7a, 0xd8, 0x5c, 0xf6, 0xab, 0xc6, 0xb0, 0xe1, 0xae, 0x8d, 0xff, 0xe9,
+		0xb1, 0xcf, 0x48, 0xdb, 0xc6, 0x1f, 0x34, 0x8b, 0x6c, 0x2f, 0xd4, 0xc3, 0x65, 0xa8, 0xa5, 0x59,
+		0x44, 0xfd, 0x1f, 0x3a, 0x8b, 0x84, 0xb1, 0xa4, 0x1b, 0x7e, 0xa1, 0x23, 0xe5, 0xff, 0x64, 0xb3,
+		0x48, 0x5a, 0x52, 0x74, 0x16, 0x17, 0x18, 0xd9, 0x5c, 0xda, 0xfe, 0x72, 0x64, 0x23, 0xef, 0x6f,
+		0x8f, 0x5d, 0xa2, 0x6d, 0x30, 0xc7, 0x0a, 0x42, 0x7b, 0xe7, 0x5c, 0xdc, 0xa3, 0xa5, 0xb8, 0x46,
+		0xdb, 0xcf, 0x1e, 0x3b, 0x8f, 0x18, 0x6f, 0x8e, 0x98, 0x0a, 0xcc, 0xa6, 0xe7, 0x6a, 0xee, 0x36,
+		0x23, 0x19, 0x19, 0x7f, 0x78, 0x6c, 0xd5, 0x60, 0xa4, 0x1e, 0x21, 0xc6, 0x68, 0x9f, 0x0a, 0x8d,
+		0xd2, 0xde, 0xca, 0x22, 0xa5, 0x36, 0xad, 0x07, 0x0a, 0x6a, 0xdb, 0x34, 0x37, 0x98, 0xd6, 0x80,
+		0x71, 0x8c, 0x86, 0x5f, 0x3d, 0xd6, 0xc2, 0x2f, 0x3e, 0xdc, 0xbd, 0xc1, 0x50, 0x37, 0xa5, 0x5c,
+		0xcb, 0xfd, 0xc6, 0x3c, 0x6b, 0x4d, 0xdc, 0x57, 0x2c, 0x64, 0x4d, 0xb0, 0xfb, 0x18, 0xb5, 0x26,
+		0xf4, 0x1d, 0x2e, 0x6b, 0x5b, 0xe4, 0x12, 0xc7, 0xd1, 0x45, 0x4e, 0x26, 0xb8, 0x8a, 0xbb, 0xcd,
+		0x48, 0x56, 0x69, 0xd2, 0x0b, 0xcb, 0xb3, 0x08, 0x82, 0x0a, 0x4a, 0x21, 0xa5, 0xc1, 0x09, 0x74,
+		0x69, 0x28, 0x9e, 0x75, 0xf2, 0xa7, 0xb0, 0xa3, 0x71, 0xa8, 0x60, 0xe8, 0x50, 0xb4, 0x87, 0x46,
+		0xc6, 0x28, 0xf4, 0xc9, 0x4f, 0x33, 0xad, 0x86, 0x49, 0x81, 0x0e, 0x3d, 0xdb, 0x68, 0x54, 0x5c,
+		0xc9, 0x4e, 0x23, 0x8e, 0xb5, 0x07, 0xa7, 0x90, 0x83, 0xd8, 0x1f, 0xc9, 0xa9, 0x73, 0x6d, 0x1e,
+		0xa2, 0x71, 0x71, 0x12, 0xbd, 0x07, 0x2f, 0xe2, 0xda, 0x77, 0x41, 0x5f, 0x04, 0xef, 0x72, 0x7d,
+		0xd2, 0x81, 0x3a, 0x0a, 0x93, 0xc0, 0x55, 0x24, 0x77, 0x40, 0x04, 0x4d, 0x0f, 0x14, 0x4a, 0x7a,
+		0x73, 0xc5, 0x70, 0x3c, 0xbc, 0x20, 0x57, 0x0c, 0xfc, 0x89, 0xa6, 0x75, 0xbb, 0x3e, 0xc1, 0x32,
+		0x7f, 0x8e, 0xcf, 0xfb, 0xfe, 0x08, 0x26, 0x02, 0x31, 0x7f, 0x28, 0x9e, 0x36, 0x7f, 0x04, 0xad,
+		0xea, 0x44, 0xab, 0x4f, 0x4c, 0xb4, 0x13, 0x75, 0x3d, 0x49, 0xd5, 0x70, 0xa2, 0xf8, 0x4b, 0x56,
+		0x5a, 0x93, 0xec, 0x84, 0xea, 0x6b, 0xe9, 0x9f, 0x64, 0x98, 0xb4, 0x97, 0x90, 0x9a, 0x38, 0x90,
+		0x74, 0x4d, 0x9c, 0x04, 0x93, 0xf9, 0x25, 0xfb, 0x30, 0xd3, 0x55, 0x34, 0x0c, 0x77, 0x3f, 0xe7,
+		0x94, 0x30, 0x45, 0xb6, 0xad, 0x3a, 0x50, 0x6b, 0xb9, 0x8b, 0xc7, 0xad, 0xea, 0x79, 0x72, 0x8f,
+		0x7c, 0x0c, 0x43, 0x4f, 0x94, 0xdd, 0xa6, 0x34, 0xcb, 0xcc, 0x1e, 0x0a, 0xed, 0x8f, 0x50, 0xa8,
+		0x42, 0xcc, 0xec, 0x02, 0x16, 0x6d, 0x66, 0x17, 0x92, 0xad, 0xcd, 0x2a, 0xc3, 0x3e, 0x8b, 0x20,
+		0x16, 0xe9, 0x67, 0xb3, 0xea, 0x6c, 0xe3, 0x81, 0x2b, 0x60, 0x7a, 0xb3, 0xc2, 0x38, 0x96, 0x86,
+		0x42, 0x6a, 0x19, 0x8a, 0x68, 0x70, 0x83, 0x69, 0x0d, 0x18, 0x67, 0xee, 0xe8, 0xaf, 0x2c, 0xd5,
+		0xd1, 0x28, 0x7d, 0x3a, 0xdc, 0xad, 0xbf, 0x09, 0x67, 0x84, 0xc5, 0x47, 0x3f, 0xc6, 0x33, 0x7a,
+		0x42, 0xf6, 0x71, 0xa1, 0x78, 0x36, 0xa2, 0x37, 0xc9, 0x2f, 0x36, 0x3f, 0x9f, 0xb7, 0x6a, 0xa2,
+		0xf3, 0x84, 0x87, 0x37, 0xbe, 0xdb, 0x3c, 0x96, 0x7a, 0x94, 0x0c, 0xda, 0x7e, 0x38, 0xe9, 0x94,
+		0x7e, 0x57, 0x69, 0x1f, 0x43, 0xd0, 0xc9, 0x7e, 0x4f, 0x31, 0x3f, 0xb1, 0x0c, 0xde, 0xcb, 0xfe,
+		0xb7, 0xf3, 0xff, 0x00, 0xce, 0xf7, 0x48, 0xdd, 0xc1, 0x19, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/types.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xc9,
+		0x75, 0x58, 0x66, 0xc1, 0x2f, 0x3c, 0x7c, 0x70, 0xd1, 0x58, 0x82, 0x00, 0x49, 0x2c, 0x89, 0xe1,
+		0xd7, 0x12, 0x24, 0x01, 0x12, 0xe4, 0x1d, 0xc9, 0x23, 0x79, 0x77, 0x0b, 0xec, 0xe2, 0xb8, 0x22,
+		0xb8, 0xc0, 0x0d, 0x16, 0x47, 0x1d, 0xe5, 0xf2, 0x64, 0xb0, 0xdb, 0x20, 0xc7, 0x5c, 0xec, 0xae,
+		0x66, 0x66, 0x09, 0xe0, 0x1c, 0xbb, 0x94, 0x8b, 0xe2, 0xd8, 0xd1, 0xd9, 0x92, 0xec, 0xf8, 0xe2,
+		0xa8, 0x6c, 0xd7, 0xf9, 0xe2, 0xd8, 0x29, 0xd9, 0x96, 0x12, 0x45, 0xfe, 0x4a, 0xe2, 0x44, 0x56,
+		0xa5, 0x1c, 0x25, 0xb1, 0x6c, 0x27, 0x71, 0x22, 0x57, 0xca, 0x49, 0x95, 0x4b, 0xe5, 0x94, 0x95,
+		0x54, 0xc5, 0x72, 0xfe, 0x45, 0x76, 0x52, 0xa9, 0xfe, 0x98, 0xef, 0x9e, 0xd9, 0x59, 0x00, 0x3c,
+		0x9e, 0xac, 0xfb, 0xb7, 0xdb, 0xfd, 0x5e, 0xcf, 0xeb, 0xd7, 0xaf, 0xdf, 0x7b, 0xfd, 0xfa, 0x75,
+		0x37, 0x1c, 0x6f, 0xaf, 0x62, 0x63, 0xba, 0xaa, 0xd5, 0x70, 0xa3, 0x8a, 0xa7, 0xb5, 0x96, 0x3e,
+		0xfd, 0xe4, 0xf2, 0xb4, 0xb5, 0xd5, 0xc2, 0xe6, 0x54, 0xcb, 0x68, 0x5a, 0x4d, 0x34, 0x4c, 0x00,
+		0xa6, 0x38, 0xc0, 0x94, 0xd6, 0xd2, 0xa7, 0x9e, 0x5c, 0x3e, 0x92, 0x7d, 0xd8, 0x6c, 0x3e, 0xac,
+		0xe3, 0x69, 0x0a, 0xb2, 0xda, 0x5e, 0x9b, 0xde, 0x30, 0xb4, 0x56, 0x0b, 0x1b, 0x1c, 0x49, 0x3e,
+		0x0f, 0x07, 0x67, 0xb5, 0x9a, 0x82, 0x3f, 0xda, 0xc6, 0xa6, 0x55, 0x34, 0x8c, 0xa6, 0x81, 0x46,
+		0x61, 0xff, 0x3a, 0x36, 0x4d, 0xed, 0x21, 0x1e, 0x95, 0x4e, 0x48, 0xb9, 0x5e, 0xc5, 0xfe, 0x2b,
+		0x5f, 0x82, 0x4c, 0xa9, 0x61, 0x61, 0xa3, 0xa1, 0xd5, 0x97, 0xb1, 0xf1, 0x44, 0xaf, 0xe2, 0x4e,
+		0x18, 0x57, 0x61, 0xb4, 0xd0, 0x5c, 0xd7, 0xf4, 0x46, 0xbe, 0x6e, 0x60, 0xad, 0xb6, 0x55, 0xdc,
+		0xd4, 0x4d, 0xcb, 0xec, 0x84, 0xf5, 0xd7, 0x25, 0x38, 0x75, 0xbf, 0x69, 0x3c, 0x5e, 0xab, 0x37,
+		0x37, 0x8a, 0x9b, 0xb8, 0xda, 0xb6, 0xf4, 0xa6, 0xdd, 0xc2, 0xb2, 0xa5, 0x19, 0x16, 0xae, 0x85,
+		0x9a, 0x00, 0x5f, 0x13, 0x28, 0x07, 0x69, 0x93, 0x40, 0xaa, 0x06, 0xeb, 0x9a, 0xaa, 0xd7, 0x46,
+		0x33, 0x14, 0x64, 0x90, 0x96, 0xf3, 0x1e, 0x97, 0x6a, 0xe8, 0x10, 0xec, 0x33, 0xda, 0x0d, 0x52,
+		0x9f, 0xa5, 0xf5, 0x7b, 0x8d, 0x76, 0xa3, 0x54, 0x23, 0x7d, 0x2d, 0x36, 0x2c, 0xdd, 0xda, 0x2a,
+		0x37, 0xad, 0x64, 0x54, 0x5f, 0x80, 0x34, 0xe7, 0xca, 0x6c, 0xdb, 0xdc, 0xea, 0x04, 0x7d, 0x1b,
+		0x26, 0xe6, 0xb4, 0x46, 0x15, 0xd7, 0xeb, 0x9a, 0xa7, 0x77, 0x9c, 0x2c, 0x5c, 0xeb, 0x84, 0x7e,
+		0x01, 0xd2, 0xaf, 0xb6, 0xb1, 0xb1, 0x35, 0xaf, 0xe9, 0xf5, 0xce, 0xd0, 0x5f, 0x93, 0x20, 0xc3,
+		0xc6, 0xa1, 0xdc, 0xb4, 0xf2, 0x55, 0x4b, 0x7f, 0xd2, 0x69, 0xe4, 0xd0, 0x71, 0xe8, 0xab, 0x51,
+		0x0c, 0xb5, 0xa1, 0xad, 0xe3, 0xd1, 0x14, 0xad, 0x05, 0x56, 0x54, 0xd6, 0xd6, 0x31, 0x3a, 0x0b,
+		0x07, 0xab, 0x6d, 0xc3, 0xc0, 0x0d, 0x4b, 0xad, 0xd6, 0xdb, 0xa6, 0x85, 0x8d, 0xd1, 0x1e, 0xc6,
+		0x60, 0x5e, 0x3c, 0xc7, 0x4a, 0xd1, 0x69, 0x18, 0xd4, 0xe8, 0x27, 0x1d, 0xb8, 0x3d, 0x14, 0x6e,
+		0x80, 0x95, 0xda, 0x60, 0x57, 0x61, 0xc4, 0x0f, 0xa6, 0x6a, 0xb5, 0x9a, 0x81, 0x4d, 0x73, 0x74,
+		0x2f, 0x05, 0xcf, 0xf8, 0xc0, 0xf3, 0xac, 0x4e, 0x9e, 0x02, 0xb4, 0xa0, 0xaf, 0xeb, 0x56, 0x71,
+		0xb3, 0x8a, 0x71, 0xad, 0x33, 0x27, 0x2e, 0xc2, 0x50, 0xbe, 0x5a, 0xc5, 0xa6, 0x59, 0xc0, 0x0d,
+		0xbd, 0x33, 0xf8, 0xdf, 0x93, 0x20, 0x3b, 0x57, 0xd7, 0x71, 0xc3, 0x7a, 0x0d, 0x1b, 0xa6, 0xde,
+		0x24, 0xfc, 0x5b, 0x6e, 0xb7, 0x5a, 0x4d, 0x57, 0x06, 0xcf, 0xc2, 0xc1, 0x35, 0xac, 0x59, 0x6d,
+		0x03, 0xab, 0x4f, 0x18, 0x0c, 0x6f, 0x64, 0x90, 0x17, 0x73, 0x4c, 0xc2, 0xd1, 0x2a, 0x6d, 0x4a,
+		0xd5, 0xd7, 0x5b, 0x75, 0x9b, 0xa3, 0xac, 0xa8, 0xb4, 0xde, 0xaa, 0xa3, 0x8b, 0x80, 0x4c, 0xbb,
+		0x6d, 0xbb, 0x2d, 0x93, 0x33, 0x75, 0xc8, 0xa9, 0xe1, 0xcd, 0x99, 0xf2, 0x7d, 0x38, 0xbc, 0x64,
+		0xe0, 0x6a, 0xb3, 0x51, 0xd3, 0x89, 0x04, 0x25, 0x92, 0x04, 0x24, 0xc3, 0x40, 0x03, 0x6f, 0x5a,
+		0x2a, 0x7e, 0x42, 0x09, 0xa9, 0x51, 0x32, 0x7a, 0x94, 0x3e, 0x52, 0x58, 0x24, 0x65, 0xa5, 0x9a,
+		0xfc, 0x71, 0x09, 0xf6, 0xdd, 0xc1, 0x5a, 0x0d, 0x1b, 0xe8, 0x25, 0xd8, 0xb7, 0xa6, 0xe3, 0x7a,
+		0xcd, 0x1c, 0x85, 0x13, 0x3d, 0xb9, 0xbe, 0x99, 0xb3, 0x53, 0x02, 0x25, 0x33, 0xc5, 0x80, 0xa7,
+		0xe6, 0x29, 0x64, 0xb1, 0x61, 0x19, 0x5b, 0x0a, 0x47, 0x3b, 0x72, 0x03, 0xfa, 0x3c, 0xc5, 0x28,
+		0x0d, 0x3d, 0x8f, 0xf1, 0x16, 0x27, 0x8a, 0xfc, 0x44, 0x19, 0xd8, 0xfb, 0x44, 0xab, 0xb7, 0x99,
+		0x84, 0xf5, 0x2b, 0xec, 0xcf, 0x0b, 0xa9, 0xeb, 0x92, 0x2c, 0x43, 0xbf, 0xad, 0x04, 0x2a, 0x5b,
+		0x2d, 0x8c, 0x10, 0xec, 0xa1, 0xa2, 0xc8, 0x66, 0x3a, 0xfd, 0x4d, 0x60, 0xa8, 0x38, 0xeb, 0xd6,
+		0x56, 0x24, 0xcc, 0x0a, 0x1c, 0xa8, 0x68, 0xe6, 0xe3, 0x05, 0xdd, 0xb4, 0x44, 0xf5, 0xe8, 0x39,
+		0xd8, 0xf3, 0x58, 0x6f, 0x30, 0xf5, 0x30, 0x38, 0x33, 0x21, 0xec, 0xa1, 0xdd, 0xc0, 0x5d, 0xbd,
+		0x51, 0x53, 0x28, 0xb8, 0x3c, 0x07, 0x69, 0xbb, 0xf4, 0x1e, 0xb6, 0xb4, 0x9a, 0x66, 0x69, 0x68,
+		0x1a, 0x32, 0xeb, 0xda, 0xa6, 0x6a, 0x69, 0xe6, 0x63, 0x53, 0x6d, 0x61, 0x43, 0x35, 0xe9, 0xf8,
+		0xd0, 0xcf, 0x49, 0xca, 0xd0, 0xba, 0xb6, 0x49, 0x50, 0xcc, 0x25, 0x6c, 0x2c, 0xd3, 0x0a, 0xf9,
+		0x2e, 0x0c, 0x85, 0x14, 0x1d, 0x11, 0x94, 0x0d, 0x5e, 0xa8, 0xea, 0x0c, 0xb9, 0x57, 0x01, 0xbb,
+		0xc8, 0xa7, 0xb2, 0x32, 0x5e, 0x95, 0xf5, 0x31, 0x09, 0xf6, 0xdc, 0xc3, 0xeb, 0x4d, 0x74, 0x3b,
+		0x30, 0x6a, 0xa7, 0x85, 0x7d, 0x22, 0xa0, 0xbb, 0x3d, 0x66, 0xbf, 0x2c, 0x11, 0x25, 0xa8, 0x19,
+		0xd5, 0x47, 0x79, 0xcb, 0x32, 0xf4, 0xd5, 0xb6, 0x85, 0x4d, 0xa4, 0xc2, 0xa0, 0xde, 0xa8, 0xe1,
+		0x4d, 0x5c, 0x53, 0x7d, 0x64, 0x5d, 0x17, 0x92, 0x15, 0x44, 0x9f, 0x2a, 0x31, 0x5c, 0x2f, 0xa5,
+		0x03, 0xba, 0xb7, 0xec, 0xc8, 0xcb, 0x80, 0xc2, 0x40, 0x5d, 0xd1, 0xfd, 0xee, 0x3e, 0x38, 0x14,
+		0x1a, 0x88, 0x52, 0x63, 0xad, 0x89, 0x0a, 0xd0, 0x8b, 0xed, 0x02, 0x3a, 0x14, 0x7d, 0x33, 0x67,
+		0x84, 0x74, 0x87, 0xd0, 0x15, 0x17, 0x91, 0xc8, 0x18, 0x31, 0xd5, 0x74, 0xbc, 0xfa, 0x66, 0x26,
+		0x62, 0x1b, 0x20, 0x82, 0xac, 0x50, 0x70, 0x34, 0x0e, 0xc0, 0xac, 0x98, 0xa5, 0xaf, 0x63, 0x6a,
+		0x9f, 0x7a, 0x94, 0x5e, 0x5a, 0x52, 0xd1, 0xd7, 0x69, 0x75, 0xb5, 0xde, 0x34, 0x31, 0xab, 0xce,
+		0xb1, 0x6a, 0x5a, 0x42, 0xab, 0x2b, 0xd0, 0xcf, 0xaa, 0x4d, 0x4b, 0xb3, 0xda, 0xe6, 0xe8, 0x0c,
+		0x15, 0xf0, 0xcb, 0xc9, 0xa8, 0x9f, 0x23, 0x98, 0xcb, 0x14, 0x51, 0xe9, 0xab, 0xba, 0x7f, 0x88,
+		0x3a, 0x7f, 0xa4, 0x9b, 0x56, 0xd3, 0xd8, 0x52, 0xeb, 0xb8, 0xf1, 0xd0, 0x7a, 0x34, 0x7a, 0x8b,
+		0x7e, 0x78, 0x80, 0x97, 0x2e, 0xd0, 0x42, 0x62, 0x80, 0x5b, 0x1a, 0xb5, 0x0e, 0xdc, 0x8c, 0xe8,
+		0xb5, 0xd1, 0x79, 0xa6, 0x17, 0x59, 0x39, 0xb3, 0x47, 0xa5, 0x1a, 0x7a, 0xd5, 0x81, 0x74, 0x19,
+		0xbd, 0xd4, 0x15, 0xa3, 0x0f, 0x32, 0x7c, 0x77, 0x06, 0x9d, 0x86, 0x41, 0xa7, 0x2d, 0xc6, 0x9c,
+		0x07, 0x8c, 0x46, 0xa7, 0x94, 0x32, 0xe8, 0x22, 0xec, 0x59, 0xc7, 0xeb, 0xcd, 0xd1, 0x1a, 0xfd,
+		0xda, 0x58, 0xe4, 0x2c, 0x51, 0x28, 0x18, 0x52, 0x60, 0xc8, 0xa4, 0xc2, 0xa9, 0x6a, 0x8e, 0x74,
+		0x8e, 0xe2, 0x13, 0x52, 0xe4, 0x0c, 0x0b, 0x8a, 0xb2, 0x92, 0x36, 0x83, 0x73, 0x63, 0x01, 0x86,
+		0xb4, 0xb6, 0xd5, 0x54, 0x0d, 0x6c, 0x62, 0x4b, 0x6d, 0x35, 0xf5, 0x86, 0x65, 0x8e, 0x36, 0x68,
+		0x9b, 0x27, 0x84, 0x6d, 0x2a, 0x04, 0x70, 0x89, 0xc2, 0x29, 0x07, 0x09, 0xaa, 0xa7, 0x00, 0x7d,
+		0x17, 0x1c, 0xb2, 0xc7, 0x86, 0x7c, 0x47, 0x7f, 0xa2, 0xd5, 0x55, 0xbd, 0xb1, 0xd6, 0x1c, 0xdd,
+		0xa4, 0x2d, 0xe6, 0xc4, 0xda, 0x9b, 0x61, 0xe4, 0x39, 0x02, 0x91, 0x7a, 0x65, 0xf8, 0x51, 0xb8,
+		0x50, 0xfe, 0xa2, 0x04, 0xc3, 0x02, 0x60, 0xf4, 0x12, 0xec, 0x25, 0x12, 0xc6, 0xb4, 0xea, 0xe0,
+		0xcc, 0xb9, 0x24, 0x5f, 0x21, 0xc2, 0x84, 0x15, 0x86, 0x47, 0x66, 0x6a, 0xdb, 0xd0, 0xb9, 0x32,
+		0x23, 0x3f, 0xd1, 0x49, 0x18, 0x60, 0x1d, 0xc0, 0x35, 0xaf, 0xec, 0xf7, 0xdb, 0x85, 0x74, 0xf8,
+		0x4e, 0xc3, 0xe0, 0x9a, 0xa6, 0xd7, 0x89, 0xe5, 0x35, 0xb0, 0x66, 0x36, 0x1b, 0x74, 0x0a, 0xf4,
+		0x2a, 0x03, 0xbc, 0x54, 0xa1, 0x85, 0xf2, 0x1f, 0x49, 0x30, 0x12, 0x92, 0x19, 0xf2, 0x7d, 0x13,
+		0x4d, 0x40, 0xbf, 0xcd, 0x2f, 0x53, 0x7f, 0x83, 0x75, 0xa0, 0x47, 0xe9, 0xe3, 0x65, 0xcb, 0xfa,
+		0x1b, 0xd4, 0x0f, 0x62, 0xb6, 0xb2, 0xda, 0x6c, 0x37, 0x2c, 0x4a, 0x63, 0x8f, 0x02, 0xb4, 0x68,
+		0x8e, 0x94, 0x38, 0x7e, 0x8b, 0x6e, 0x6d, 0xa9, 0x2d, 0x6d, 0xab, 0xde, 0xd4, 0x6a, 0xea, 0xea,
+		0x16, 0x11, 0x0d, 0x46, 0x73, 0xc6, 0xae, 0x5d, 0x62, 0x95, 0xb3, 0xa4, 0x0e, 0x5d, 0x00, 0x64,
+		0xea, 0x0f, 0x1b, 0x5a, 0x5d, 0xf5, 0xb6, 0xce, 0xa6, 0x70, 0x9a, 0xd5, 0x14, 0xdd, 0x6f, 0x1c,
+		0x87, 0x3e, 0xc2, 0x05, 0x83, 0x83, 0xcd, 0x30, 0x22, 0x68, 0x11, 0x05, 0x90, 0x3f, 0x96, 0x82,
+		0x6c, 0x78, 0x0a, 0x37, 0x1b, 0x6b, 0xfa, 0xc3, 0xb6, 0x41, 0x1d, 0x4c, 0xf4, 0x02, 0xf4, 0x12,
+		0xbb, 0xa4, 0xd6, 0x75, 0xd3, 0xe2, 0x8a, 0x6c, 0x3c, 0xd6, 0xd6, 0x29, 0x07, 0x2c, 0xfe, 0x0b,
+		0xad, 0x40, 0xce, 0x9d, 0x4f, 0x5c, 0x23, 0x35, 0x55, 0x57, 0xf7, 0x34, 0xdb, 0x16, 0x37, 0x75,
+		0x26, 0xe5, 0xd0, 0x5e, 0xe5, 0x24, 0xf6, 0x70, 0xda, 0xb0, 0x2a, 0xcd, 0x39, 0x5b, 0x2d, 0x35,
+		0xdb, 0x16, 0x33, 0x7e, 0x26, 0xba, 0x0b, 0x27, 0x29, 0x49, 0x1d, 0x5a, 0xcc, 0xd2, 0x16, 0xb3,
+		0x04, 0x34, 0xba, 0x31, 0xf9, 0xd7, 0xf7, 0xc2, 0x99, 0xe5, 0xea, 0x23, 0x5c, 0x6b, 0xd7, 0xb1,
+		0xe3, 0x13, 0x68, 0xe6, 0xe3, 0x02, 0xae, 0xea, 0xc4, 0x61, 0xf2, 0x4c, 0xba, 0xe3, 0xd0, 0xe7,
+		0x0c, 0x99, 0x6b, 0x60, 0xed, 0xa2, 0x52, 0x0d, 0xcd, 0xc3, 0x80, 0x03, 0xd0, 0x51, 0x6f, 0x7b,
+		0x1d, 0x10, 0xa5, 0x5f, 0xf3, 0xfc, 0x43, 0x23, 0xb0, 0x8f, 0x69, 0xbf, 0xd1, 0x31, 0xfa, 0x0d,
+		0xfe, 0xcf, 0x3f, 0x16, 0xd9, 0xee, 0xc6, 0x22, 0x03, 0x7b, 0xf5, 0x46, 0xab, 0xcd, 0x84, 0xa5,
+		0x5f, 0x61, 0x7f, 0xd0, 0x1d, 0x98, 0x30, 0x79, 0xe7, 0xa3, 0x19, 0x79, 0x91, 0x32, 0x72, 0xdc,
+		0x06, 0x14, 0x0f, 0x4a, 0xa0, 0x25, 0xd7, 0xfe, 0x78, 0x5b, 0x9a, 0x09, 0xb6, 0xb4, 0x6c, 0x1b,
+		0x25, 0x4f, 0x4b, 0xb3, 0x90, 0xed, 0x30, 0xb2, 0xd7, 0x68, 0x33, 0x47, 0xcc, 0x68, 0x11, 0x79,
+		0x01, 0xc6, 0x1e, 0x61, 0xcd, 0xb0, 0x56, 0xb1, 0x16, 0xa6, 0xe2, 0x16, 0x45, 0x3f, 0xec, 0x00,
+		0x04, 0x70, 0xe7, 0xa0, 0xdf, 0xc0, 0x96, 0xb1, 0xa5, 0xb6, 0x9a, 0x75, 0xbd, 0xba, 0x35, 0x3a,
+		0x1f, 0xab, 0x56, 0x2d, 0x63, 0x6b, 0x89, 0xc2, 0x29, 0x7d, 0x86, 0xfb, 0x07, 0x5d, 0x81, 0x7d,
+		0x8f, 0xa8, 0x7b, 0xcb, 0x6d, 0xd2, 0xd1, 0x18, 0x0f, 0x58, 0xe1, 0xa0, 0x68, 0x0a, 0x86, 0x6b,
+		0xb8, 0xae, 0x6d, 0x71, 0xee, 0xd9, 0xf4, 0x3e, 0xa0, 0xf4, 0x0e, 0xd1, 0x2a, 0xca, 0x30, 0x5b,
+		0x76, 0xcb, 0x70, 0x9e, 0xaf, 0xfc, 0xd8, 0x9a, 0x70, 0x87, 0xf2, 0x2b, 0x7f, 0x2f, 0x1c, 0x73,
+		0x06, 0xc4, 0x10, 0x34, 0x30, 0x06, 0x07, 0x98, 0x3e, 0x71, 0xb0, 0xf7, 0xd3, 0xff, 0xa5, 0x1a,
+		0x7a, 0x19, 0xc6, 0x9d, 0x41, 0x5b, 0xd3, 0x0d, 0xf1, 0xfc, 0xee, 0x51, 0xc6, 0xf8, 0x98, 0xcd,
+		0xeb, 0x46, 0x70, 0x22, 0x16, 0xe1, 0xfc, 0x5c, 0x73, 0xbd, 0x55, 0xc7, 0x16, 0x0e, 0xa9, 0x24,
+		0x01, 0x2d, 0x23, 0xb0, 0xcf, 0xc0, 0x66, 0xbb, 0xce, 0x94, 0x52, 0xbf, 0xc2, 0xff, 0xc9, 0x1f,
+		0x81, 0xb3, 0x64, 0x49, 0x93, 0xb8, 0x09, 0x6a, 0x00, 0x58, 0x67, 0xf8, 0x3f, 0xb2, 0x0c, 0xaa,
+		0x61, 0x4b, 0xd3, 0xeb, 0x8c, 0xea, 0x7e, 0xc5, 0xfe, 0x2b, 0xbf, 0x00, 0xe3, 0x8c, 0xd3, 0xdd,
+		0x73, 0x48, 0x2e, 0xc2, 0x39, 0x86, 0x9b, 0x84, 0x34, 0x0f, 0x09, 0xe0, 0x27, 0xe1, 0xf7, 0x25,
+		0xb8, 0xee, 0x1b, 0xf4, 0xe2, 0x26, 0x0b, 0xad, 0x24, 0xec, 0x31, 0x57, 0x2c, 0xe0, 0x53, 0x2c,
+		0x81, 0xa5, 0x43, 0x26, 0x66, 0xe9, 0xe0, 0x8d, 0x76, 0x10, 0x32, 0xab, 0xcd, 0x86, 0x65, 0x34,
+		0xeb, 0x5c, 0xad, 0xd8, 0x7f, 0x89, 0x28, 0x57, 0x1f, 0xe9, 0xf5, 0x9a, 0xea, 0xb4, 0xdb, 0x6c,
+		0xd4, 0xb7, 0xa8, 0x02, 0x38, 0xa0, 0x0c, 0xd1, 0x2a, 0x9b, 0xe0, 0xc5, 0x46, 0x7d, 0x4b, 0xfe,
+		0xd1, 0x14, 0x5c, 0x5e, 0x66, 0xf6, 0x6b, 0x17, 0xfa, 0xe3, 0xf3, 0xbe, 0x33, 0xdb, 0xf5, 0xbe,
+		0x8f, 0x43, 0x1f, 0x37, 0xb6, 0x74, 0xf1, 0xc7, 0x7a, 0x0e, 0xac, 0x88, 0xc6, 0x32, 0xc4, 0x3a,
+		0xd5, 0xc3, 0x94, 0x99, 0x44, 0x4c, 0xb9, 0x15, 0xc5, 0x94, 0x1f, 0x90, 0xe0, 0xd2, 0x4a, 0xcb,
+		0xc4, 0x86, 0x65, 0x17, 0x07, 0x5d, 0x43, 0x01, 0x4f, 0x84, 0xee, 0x26, 0xec, 0xc8, 0xdd, 0x94,
+		0x3f, 0x25, 0x41, 0x56, 0xc1, 0xd5, 0xa6, 0x51, 0xbb, 0xa7, 0x19, 0x8f, 0x85, 0x92, 0x7f, 0x1c,
+		0xfa, 0xd6, 0x69, 0x9d, 0xea, 0x59, 0x29, 0x03, 0x2b, 0xa2, 0xcc, 0x8a, 0x9c, 0x55, 0x1e, 0x5d,
+		0x99, 0x4d, 0xac, 0x2b, 0xe5, 0x7f, 0xb4, 0x1f, 0x2e, 0xcd, 0x35, 0x1b, 0x96, 0xde, 0x68, 0xe3,
+		0xbc, 0x59, 0xc6, 0x1b, 0x49, 0xe4, 0x65, 0x1e, 0x06, 0x1c, 0xd6, 0x53, 0x03, 0x0d, 0x49, 0x17,
+		0x56, 0xfd, 0x1b, 0x9e, 0x7f, 0x7e, 0x43, 0x9c, 0xd9, 0xa6, 0x21, 0xce, 0x7a, 0x85, 0xa6, 0x1b,
+		0x57, 0x29, 0xb7, 0xeb, 0xae, 0xd2, 0x4c, 0x12, 0x57, 0x89, 0x98, 0xf8, 0x55, 0xad, 0xfa, 0xb8,
+		0xb9, 0xb6, 0xc6, 0xdb, 0xd3, 0x1b, 0x16, 0x36, 0xd8, 0x62, 0x21, 0x60, 0x5c, 0xc7, 0x39, 0x20,
+		0x6d, 0xad, 0xc4, 0xc1, 0x4a, 0x8d, 0x5d, 0x35, 0xb1, 0x25, 0xe8, 0xd5, 0x1b, 0xba, 0xa5, 0x6b,
+		0x56, 0x93, 0x59, 0xd9, 0xc1, 0x99, 0xf3, 0xc2, 0x16, 0x7c, 0x62, 0x52, 0xb2, 0x51, 0x14, 0x17,
+		0x5b, 0xb0, 0x24, 0x78, 0x20, 0x58, 0x12, 0xd0, 0x98, 0x1d, 0x07, 0xb3, 0x45, 0xb9, 0x46, 0x07,
+		0xd1, 0xc6, 0x2e, 0x70, 0x89, 0xbe, 0x0a, 0x23, 0x75, 0xcd, 0x24, 0xee, 0x39, 0x35, 0x68, 0x64,
+		0x4c, 0xb9, 0xb1, 0x6a, 0x50, 0xf8, 0x0c, 0xa9, 0x9d, 0x73, 0x2a, 0x15, 0x5a, 0x47, 0x56, 0x2f,
+		0x55, 0x83, 0x0c, 0x3f, 0x77, 0x8f, 0xe8, 0xf2, 0xab, 0x57, 0xe9, 0x27, 0x85, 0xb6, 0x8b, 0x8a,
+		0xae, 0x3a, 0x93, 0xe5, 0x4d, 0xa9, 0x1b, 0xcf, 0x82, 0x2d, 0x59, 0xdf, 0x92, 0x92, 0xad, 0x59,
+		0x97, 0x45, 0x4a, 0xe4, 0x6d, 0x69, 0x67, 0x5a, 0xe4, 0xdd, 0xfd, 0x70, 0x91, 0x0a, 0xc4, 0x9c,
+		0x57, 0xd3, 0x3d, 0x15, 0x7b, 0x15, 0x9a, 0xe8, 0xd9, 0x5d, 0x98, 0xe8, 0xb9, 0x6d, 0x4e, 0xf4,
+		0x99, 0xed, 0x4e, 0xf4, 0x5b, 0xbb, 0x3e, 0xd1, 0xe7, 0x13, 0x4d, 0xf4, 0xd7, 0x60, 0x98, 0x87,
+		0x56, 0x58, 0x2b, 0x7c, 0x96, 0xbe, 0x4a, 0xe7, 0x98, 0xd8, 0x90, 0x2e, 0x69, 0x2c, 0x76, 0xdf,
+		0x34, 0x31, 0x9f, 0xab, 0x43, 0xad, 0x60, 0x91, 0xd7, 0x32, 0x3e, 0xf0, 0x5b, 0xc6, 0x2a, 0x8c,
+		0x7a, 0x06, 0x54, 0x35, 0x70, 0xdb, 0xfd, 0x6c, 0x8d, 0x7e, 0x76, 0x32, 0x76, 0xe8, 0x4a, 0x35,
+		0x05, 0xb7, 0xed, 0xef, 0x28, 0x87, 0x36, 0x44, 0xc5, 0x21, 0xad, 0xd3, 0xd8, 0x8e, 0xd6, 0xf9,
+		0xcb, 0x3e, 0x49, 0x7f, 0x7e, 0x08, 0x0e, 0xd8, 0x33, 0x91, 0x4c, 0xa3, 0x1a, 0xff, 0xed, 0xda,
+		0xcb, 0xa8, 0x60, 0xb7, 0x8d, 0xc5, 0xa6, 0x51, 0xcd, 0xf3, 0x0f, 0xfd, 0x5d, 0x09, 0x26, 0x9d,
+		0xd5, 0xa1, 0xbb, 0x44, 0x26, 0x02, 0xeb, 0xb4, 0xef, 0xe9, 0x03, 0xb3, 0xa8, 0x37, 0xc5, 0x5d,
+		0x48, 0xb4, 0x56, 0x57, 0xce, 0x98, 0x89, 0xe0, 0xd0, 0x26, 0x1c, 0x77, 0x97, 0xaa, 0x86, 0x90,
+		0x9a, 0x31, 0x4a, 0x8d, 0x38, 0xfe, 0x19, 0xb7, 0x5c, 0x52, 0x8e, 0x99, 0x31, 0xb5, 0xe8, 0xe7,
+		0x24, 0x98, 0xe6, 0xf6, 0x01, 0xbb, 0x0e, 0xa1, 0xab, 0x1b, 0x44, 0xa4, 0x30, 0x2d, 0xf6, 0x72,
+		0x84, 0x95, 0x4b, 0xbc, 0x78, 0x52, 0xce, 0x57, 0x93, 0x03, 0xa3, 0xcf, 0x48, 0x70, 0x9e, 0xd8,
+		0xb7, 0xa4, 0x44, 0x9e, 0xa4, 0x44, 0xde, 0x12, 0x12, 0x99, 0x70, 0x69, 0xa6, 0x9c, 0x5d, 0x4b,
+		0x06, 0x88, 0x7e, 0x5e, 0x82, 0x4b, 0xf6, 0x5e, 0x6d, 0x95, 0xae, 0x87, 0x12, 0xc8, 0x57, 0x2e,
+		0x86, 0x8d, 0x5d, 0x2c, 0xa8, 0x95, 0xf3, 0x46, 0x72, 0x60, 0xf4, 0xbd, 0x70, 0x82, 0x13, 0x18,
+		0x2d, 0x6a, 0x33, 0x94, 0xb0, 0x19, 0xf1, 0xf8, 0xc6, 0x2d, 0x3c, 0x95, 0xf1, 0x6a, 0x5c, 0x35,
+		0x7a, 0x47, 0x82, 0x8b, 0xfc, 0xeb, 0x09, 0x47, 0xf1, 0x16, 0x25, 0xe5, 0xc5, 0x18, 0x52, 0x92,
+		0x8c, 0xe3, 0xb9, 0x6a, 0x52, 0x50, 0xf4, 0xbb, 0x12, 0xbc, 0x18, 0x18, 0x49, 0xcc, 0x97, 0x82,
+		0x49, 0x69, 0x66, 0x6e, 0xe4, 0xbd, 0xce, 0xe3, 0xda, 0xc5, 0x1a, 0x53, 0xb9, 0x6e, 0x6c, 0x13,
+		0x13, 0x7d, 0x3f, 0x4c, 0x18, 0x74, 0xd1, 0xa4, 0xf2, 0x95, 0x91, 0x88, 0x66, 0x16, 0x1e, 0xba,
+		0x12, 0x41, 0x73, 0xdc, 0x92, 0x4b, 0xc9, 0x1a, 0xb1, 0xf5, 0xe8, 0x57, 0x25, 0x78, 0xbe, 0xca,
+		0x7d, 0x5f, 0x55, 0x33, 0xd5, 0x06, 0xde, 0x48, 0xca, 0xc9, 0x07, 0x94, 0xaa, 0x62, 0x67, 0x77,
+		0x3a, 0x09, 0x07, 0x2f, 0x55, 0xbb, 0xc4, 0x40, 0xbf, 0x24, 0xc1, 0x0c, 0x53, 0xcb, 0x81, 0xf5,
+		0x72, 0x3c, 0xd5, 0x6c, 0x43, 0x66, 0x36, 0x5a, 0x53, 0x27, 0x75, 0x3c, 0x95, 0x8b, 0x66, 0x37,
+		0xe0, 0xe8, 0xd7, 0x25, 0x78, 0xde, 0x8e, 0xcb, 0x77, 0x29, 0xb3, 0xcc, 0x09, 0x99, 0x17, 0xd3,
+		0xdc, 0x6d, 0x40, 0x44, 0xb9, 0x6c, 0x76, 0x8b, 0x82, 0x7e, 0x45, 0x82, 0xe7, 0xda, 0x34, 0xc8,
+		0xe0, 0x92, 0x1c, 0x72, 0x2b, 0x84, 0xa4, 0x6f, 0xc6, 0x08, 0x49, 0xb7, 0x61, 0x0b, 0xe5, 0x52,
+		0xbb, 0x4b, 0x0c, 0xf9, 0x8f, 0xfb, 0xe1, 0xac, 0x68, 0x83, 0x86, 0xe6, 0x58, 0x3c, 0xc1, 0x0d,
+		0xeb, 0x29, 0x2c, 0xfc, 0xaf, 0xc2, 0x08, 0xf7, 0x8c, 0x9d, 0xe6, 0xf8, 0x02, 0xa5, 0x9f, 0x65,
+		0x9b, 0xb0, 0x5a, 0xbb, 0x05, 0xb6, 0x59, 0x89, 0x56, 0x61, 0x2c, 0x88, 0xe5, 0x86, 0xa7, 0x06,
+		0xbb, 0x0a, 0x4f, 0x1d, 0xf6, 0x7f, 0xc0, 0xa9, 0x40, 0x37, 0x9c, 0x6f, 0xf0, 0x65, 0x2d, 0xae,
+		0xb9, 0xd9, 0x1a, 0x69, 0x1a, 0x7c, 0xe5, 0xa4, 0x97, 0xec, 0x7a, 0x9e, 0xb8, 0xf1, 0x1d, 0x1a,
+		0xcd, 0xb8, 0x09, 0x47, 0x6c, 0xbd, 0x54, 0xf3, 0xcc, 0x51, 0x1e, 0xe6, 0x7c, 0x9e, 0x0e, 0xe7,
+		0x61, 0x07, 0xc2, 0x1d, 0x01, 0x1a, 0xf8, 0xf4, 0xc5, 0x1e, 0xae, 0xed, 0x28, 0xf6, 0x70, 0x1d,
+		0x46, 0x5d, 0x3a, 0x02, 0x51, 0x88, 0xeb, 0x94, 0x8a, 0x11, 0xa7, 0x7e, 0xde, 0x17, 0x8e, 0x78,
+		0x01, 0xc6, 0xc2, 0x98, 0x76, 0x60, 0xe2, 0x06, 0x1d, 0x8f, 0xc3, 0x41, 0xd4, 0xce, 0x11, 0x8a,
+		0x17, 0x62, 0x22, 0x14, 0x37, 0x60, 0xac, 0x69, 0xe8, 0x0f, 0x75, 0xa6, 0xe8, 0x02, 0x2c, 0xbb,
+		0xc9, 0x88, 0xb5, 0x01, 0x02, 0x1c, 0x3b, 0x02, 0x07, 0xf4, 0x1a, 0xa6, 0xa9, 0x71, 0xd4, 0xb7,
+		0xe8, 0x55, 0x9c, 0xff, 0xe8, 0x0a, 0x8c, 0xac, 0xe9, 0x86, 0x69, 0x85, 0xdb, 0xbc, 0x4d, 0x21,
+		0x87, 0x69, 0x6d, 0xa0, 0xc1, 0x5d, 0x89, 0x21, 0x8d, 0xc2, 0x7e, 0xcd, 0xb2, 0xf0, 0x7a, 0xcb,
+		0xa2, 0x86, 0x78, 0xaf, 0x62, 0xff, 0x45, 0x97, 0x21, 0x83, 0x37, 0x5b, 0x3a, 0xdb, 0x05, 0xa5,
+		0x22, 0x66, 0x5a, 0xda, 0x7a, 0x8b, 0x67, 0x04, 0x0c, 0xbb, 0x75, 0x15, 0xbb, 0x2a, 0xbc, 0x34,
+		0xac, 0x09, 0x96, 0x86, 0x0b, 0x70, 0x92, 0xf5, 0xd5, 0x5d, 0x58, 0x11, 0x91, 0x76, 0x02, 0x6b,
+		0x5c, 0x86, 0x1b, 0x94, 0x9a, 0xe3, 0x14, 0xd4, 0x59, 0x58, 0x69, 0xe6, 0xe3, 0x59, 0x1e, 0x57,
+		0xe3, 0x42, 0x6c, 0xa7, 0x22, 0x6c, 0xee, 0x20, 0x15, 0x61, 0x6b, 0x67, 0xa9, 0x08, 0xaf, 0xc1,
+		0x48, 0xcb, 0xc0, 0x4f, 0xd4, 0x70, 0x3e, 0xc2, 0x9b, 0x52, 0xec, 0x90, 0xb8, 0x09, 0x09, 0xc3,
+		0xa4, 0x81, 0x7c, 0x20, 0x29, 0xc1, 0x5d, 0x43, 0xbf, 0xd5, 0xc5, 0x1a, 0xfa, 0x28, 0x1c, 0x68,
+		0x19, 0x7a, 0xd3, 0x20, 0x62, 0x46, 0x97, 0xc2, 0x7b, 0x15, 0xa7, 0x00, 0x5d, 0x85, 0x43, 0x16,
+		0x36, 0xd6, 0xf5, 0x06, 0x1b, 0xd4, 0x96, 0xd1, 0xb4, 0x70, 0xd5, 0xc2, 0xb5, 0xd1, 0x77, 0x24,
+		0x1a, 0x82, 0xcf, 0x78, 0x6a, 0x97, 0xec, 0x4a, 0xf9, 0x43, 0xd0, 0xe7, 0xa5, 0xeb, 0x26, 0xec,
+		0xe3, 0xfd, 0x63, 0xe9, 0x48, 0x27, 0x3b, 0x74, 0x8f, 0x26, 0x46, 0x70, 0x14, 0xf9, 0x87, 0x52,
+		0x30, 0xe8, 0xaf, 0x22, 0x41, 0xc5, 0x55, 0xbd, 0xa1, 0x19, 0x5b, 0x6a, 0xf5, 0x11, 0xae, 0x3e,
+		0x36, 0xdb, 0xeb, 0x3c, 0xd8, 0x35, 0xc8, 0x8a, 0xe7, 0x78, 0x69, 0x44, 0xfa, 0x16, 0xba, 0x0d,
+		0x47, 0x03, 0x02, 0x65, 0x2f, 0xee, 0x6a, 0xf6, 0x7e, 0x4d, 0x8f, 0x32, 0xea, 0x13, 0x24, 0x7b,
+		0xa9, 0x58, 0x2b, 0xd5, 0xd0, 0x24, 0x0c, 0x55, 0x0d, 0x4c, 0xcd, 0x05, 0x11, 0x72, 0xb5, 0xa1,
+		0x35, 0x9a, 0x3c, 0xa1, 0xe0, 0x20, 0xaf, 0x20, 0x12, 0x5e, 0xd6, 0x1a, 0x4d, 0x92, 0x7d, 0x40,
+		0xe5, 0x5e, 0x6f, 0x3c, 0xf4, 0x00, 0xb3, 0xb4, 0x82, 0xb4, 0x5d, 0xe3, 0x40, 0x67, 0x01, 0xa8,
+		0x38, 0x58, 0xda, 0x6a, 0x1d, 0xf3, 0x4d, 0x0e, 0x4f, 0x89, 0xfc, 0x09, 0x09, 0xce, 0x09, 0x92,
+		0x0f, 0x38, 0x69, 0x41, 0x0b, 0x1e, 0xb1, 0xdf, 0x87, 0xe6, 0xe1, 0x84, 0x7f, 0x26, 0xb9, 0xbd,
+		0x77, 0xcc, 0x1f, 0xdb, 0x7b, 0x3c, 0x56, 0xf3, 0xcc, 0x23, 0xff, 0x77, 0x4a, 0x35, 0xf9, 0x5d,
+		0x09, 0xce, 0x84, 0xa8, 0xe1, 0xc9, 0x91, 0x22, 0x52, 0xba, 0xd9, 0x37, 0x4c, 0x44, 0x64, 0x36,
+		0x01, 0x91, 0x4d, 0xc8, 0x85, 0x68, 0x24, 0xfc, 0xae, 0x2d, 0xb6, 0xad, 0x20, 0x95, 0x73, 0xd0,
+		0x6f, 0x1b, 0x46, 0x4f, 0xe8, 0x46, 0x3c, 0x19, 0xb9, 0x69, 0xa4, 0x0e, 0x4f, 0x9f, 0xe5, 0xfe,
+		0x91, 0x7f, 0x63, 0x3f, 0x08, 0x72, 0xbc, 0x6c, 0x9b, 0x42, 0x8d, 0x5a, 0xf0, 0xbb, 0xd3, 0x90,
+		0x21, 0x0b, 0x8d, 0x90, 0x2a, 0x67, 0xbc, 0x1a, 0x6a, 0xe0, 0x8d, 0x80, 0x22, 0x0f, 0xf9, 0x66,
+		0x99, 0x5d, 0x88, 0xd5, 0xee, 0x4a, 0x76, 0x44, 0x37, 0x6e, 0xcc, 0xcc, 0xae, 0xbb, 0x31, 0xb7,
+		0x12, 0xb9, 0x31, 0x49, 0x44, 0x6b, 0xbe, 0xb3, 0x68, 0x25, 0xdb, 0xdc, 0x59, 0x4a, 0xb2, 0xb9,
+		0xe3, 0xf3, 0x8d, 0x1e, 0xec, 0xf2, 0xbe, 0x4c, 0x2d, 0xe1, 0xbe, 0x4c, 0xa3, 0xcb, 0x7d, 0x99,
+		0xcd, 0x18, 0xaf, 0xe7, 0xdb, 0x38, 0x9a, 0xfb, 0x25, 0x09, 0x4e, 0x79, 0xdd, 0x07, 0xdb, 0x0d,
+		0x09, 0xe9, 0xb4, 0x9d, 0xa4, 0x79, 0x75, 0x4e, 0xd8, 0xc9, 0x74, 0x4c, 0xd8, 0xf1, 0x38, 0x62,
+		0x4c, 0x11, 0xda, 0x7f, 0xe5, 0x1f, 0x96, 0x40, 0xf6, 0x75, 0x41, 0xbc, 0xc2, 0x23, 0x99, 0x71,
+		0x76, 0xe7, 0x5c, 0xc9, 0x07, 0x9e, 0x19, 0xe7, 0xeb, 0x76, 0xc0, 0x1b, 0xcd, 0x04, 0xbc, 0xd1,
+		0x71, 0x00, 0x1e, 0xf2, 0x71, 0xf3, 0x1d, 0x7a, 0x0d, 0xfb, 0xe0, 0x87, 0xfc, 0xcd, 0x00, 0x4b,
+		0x23, 0x2d, 0xd6, 0x79, 0x18, 0x72, 0xb5, 0x07, 0xf1, 0xc3, 0xf1, 0xa6, 0x6d, 0xbc, 0xd2, 0xd8,
+		0xab, 0x4b, 0xf1, 0xa6, 0x15, 0x41, 0x7e, 0x26, 0x82, 0x7c, 0xfb, 0x98, 0x4a, 0xd8, 0x7e, 0x0c,
+		0x9a, 0x1e, 0xf6, 0x04, 0x3a, 0x9a, 0x0b, 0x74, 0x54, 0xe0, 0x79, 0xcc, 0x88, 0x3c, 0x0f, 0xf9,
+		0xcb, 0x12, 0x9c, 0xf4, 0x76, 0x39, 0xca, 0xe4, 0x74, 0x37, 0x06, 0xa2, 0x4e, 0x64, 0x84, 0x9d,
+		0x08, 0x9a, 0xb2, 0xec, 0x76, 0x4c, 0xd9, 0xff, 0x49, 0xc1, 0x84, 0xb7, 0x13, 0x62, 0xdb, 0xfe,
+		0xb4, 0xba, 0x30, 0x0b, 0x7b, 0xab, 0x5a, 0xdb, 0xb4, 0x69, 0xbf, 0x10, 0xbf, 0x83, 0xe2, 0x90,
+		0x37, 0x47, 0x70, 0x14, 0x86, 0xea, 0xf5, 0x2f, 0x4e, 0xfa, 0xfd, 0x8b, 0xb8, 0x51, 0x76, 0xbd,
+		0x95, 0x19, 0x9f, 0xb7, 0x92, 0x85, 0xbe, 0x55, 0xcd, 0xc4, 0xb6, 0x79, 0x66, 0x6b, 0xb2, 0x5e,
+		0x52, 0xc4, 0xcc, 0xf2, 0x31, 0x00, 0x62, 0xc7, 0x79, 0x35, 0xcb, 0xc1, 0x3e, 0xd0, 0xc0, 0x1b,
+		0xac, 0xf6, 0x02, 0xa0, 0xb5, 0xa6, 0xf1, 0x98, 0x77, 0xdb, 0x3e, 0xc1, 0xb2, 0xc4, 0xf8, 0x44,
+		0x6a, 0x68, 0xc7, 0xf9, 0xa1, 0x13, 0xf9, 0x63, 0xfb, 0xe0, 0x94, 0x37, 0x3c, 0x1e, 0xa9, 0x86,
+		0x3e, 0x48, 0xb1, 0xfc, 0x8e, 0x48, 0xb1, 0x4c, 0xe2, 0xb4, 0x3c, 0x48, 0xe0, 0xb4, 0xec, 0xca,
+		0x8e, 0xae, 0x9b, 0x7e, 0xb4, 0x99, 0xdc, 0xba, 0x4f, 0x8b, 0x53, 0x35, 0xdf, 0x94, 0xa2, 0x72,
+		0x35, 0x7f, 0x28, 0x05, 0xb2, 0x6f, 0x0a, 0x3c, 0x5b, 0x33, 0xe6, 0x35, 0xb8, 0x39, 0x7f, 0xe4,
+		0x63, 0x0a, 0x86, 0xa9, 0x93, 0x14, 0xf0, 0xbc, 0x98, 0xf6, 0x18, 0x22, 0x55, 0xfe, 0x30, 0xd4,
+		0x25, 0xc8, 0xf8, 0xe0, 0x6d, 0x1d, 0x75, 0x8b, 0xce, 0x02, 0xe4, 0x41, 0xe0, 0x6e, 0x98, 0xfc,
+		0x05, 0xc9, 0xaf, 0x0e, 0xba, 0x5e, 0xf4, 0x3d, 0x03, 0x6b, 0x29, 0xff, 0x1b, 0x09, 0x26, 0xbc,
+		0x44, 0xef, 0xd6, 0xda, 0x50, 0xdc, 0x97, 0x6c, 0x17, 0x7d, 0xc9, 0x75, 0xec, 0xcb, 0x4c, 0xa0,
+		0x2f, 0x7f, 0x28, 0xc1, 0x49, 0x6f, 0x5f, 0xa2, 0x0c, 0xba, 0x87, 0xea, 0xbd, 0x49, 0xa8, 0x7e,
+		0x9f, 0x98, 0xfa, 0x1f, 0x97, 0x60, 0xd2, 0x27, 0x5f, 0x74, 0xeb, 0xce, 0x3d, 0x24, 0xdb, 0xbd,
+		0xd1, 0xd9, 0x9d, 0x18, 0xc3, 0xe7, 0x25, 0x88, 0xde, 0x5f, 0x16, 0xcb, 0x53, 0x47, 0xda, 0x32,
+		0xb6, 0x63, 0xc1, 0x83, 0x42, 0xf4, 0xcf, 0xae, 0x05, 0x1c, 0xfe, 0x3c, 0x20, 0x28, 0x8c, 0x6c,
+		0x5c, 0x8b, 0x11, 0x14, 0x08, 0x85, 0x3e, 0xea, 0x9a, 0xe5, 0xd9, 0xd4, 0x35, 0xec, 0x61, 0x08,
+		0xf1, 0x8e, 0xc1, 0x89, 0x06, 0x8b, 0x39, 0x1c, 0xef, 0xf9, 0x34, 0xf9, 0xb2, 0x04, 0x47, 0xe9,
+		0x8e, 0x7a, 0x84, 0xb2, 0x7e, 0x9a, 0xf9, 0xf0, 0xbb, 0x36, 0x84, 0x7f, 0x15, 0xc6, 0x68, 0x1f,
+		0xc8, 0x27, 0xba, 0xe9, 0x41, 0xe2, 0x39, 0x2b, 0xff, 0x6b, 0x09, 0x8e, 0xd1, 0x4f, 0x44, 0x49,
+		0xc7, 0x6e, 0x7c, 0x65, 0xb7, 0xf8, 0x11, 0xab, 0xe3, 0x7f, 0x55, 0x82, 0x13, 0x9e, 0x3c, 0x0b,
+		0xf1, 0x94, 0x8c, 0xe9, 0xcd, 0x53, 0x9d, 0x8c, 0xb1, 0x94, 0xff, 0xed, 0x14, 0x4c, 0x87, 0x03,
+		0x75, 0xf1, 0x7a, 0xcf, 0xa1, 0x16, 0xbc, 0xd4, 0xde, 0x86, 0xa3, 0xce, 0x1e, 0xb6, 0x60, 0x2b,
+		0x91, 0x0d, 0xce, 0xa8, 0x0d, 0x12, 0xda, 0x4c, 0x5c, 0xf3, 0xa0, 0x0b, 0x76, 0x3b, 0xb3, 0x5d,
+		0xed, 0x76, 0x8e, 0xe1, 0xa8, 0x8d, 0xec, 0x58, 0x66, 0xbc, 0x25, 0x41, 0x2e, 0x82, 0x19, 0xa2,
+		0xad, 0xe1, 0xce, 0xa3, 0x03, 0x09, 0x46, 0x27, 0xfa, 0xd4, 0xc8, 0xd7, 0x24, 0x18, 0x67, 0x49,
+		0x1a, 0x2c, 0xa1, 0x43, 0xa8, 0xe5, 0xb7, 0x9b, 0x3c, 0xbf, 0x5b, 0xc2, 0xe5, 0x7a, 0xc1, 0xb9,
+		0xe4, 0x49, 0xf8, 0xdf, 0x27, 0xe0, 0x33, 0x4b, 0x5a, 0x10, 0xf6, 0xd1, 0x7b, 0x9a, 0x02, 0xa2,
+		0x4f, 0x53, 0x64, 0xbc, 0xcb, 0x27, 0xef, 0x38, 0x67, 0x03, 0xe3, 0xfc, 0x06, 0x4c, 0x86, 0xc3,
+		0xe1, 0x7c, 0x0b, 0x67, 0x37, 0x5c, 0xb3, 0xb8, 0x6f, 0xff, 0x66, 0x0a, 0x5e, 0x4c, 0x96, 0x53,
+		0xe4, 0x9f, 0x22, 0x4f, 0x41, 0xf2, 0xdc, 0xb5, 0x6c, 0xc6, 0xb7, 0x96, 0x5d, 0x01, 0xb4, 0xe3,
+		0x19, 0x38, 0xb4, 0x11, 0x9a, 0x79, 0xbb, 0x77, 0xe8, 0xe7, 0xc7, 0x7a, 0xe0, 0x66, 0x32, 0x1e,
+		0x8a, 0x35, 0xf1, 0x8a, 0x57, 0x81, 0x0d, 0xce, 0xbc, 0x14, 0x93, 0xac, 0xd6, 0xa1, 0x65, 0x5f,
+		0x9c, 0x65, 0x97, 0xdc, 0x3d, 0xcf, 0xb8, 0x64, 0x13, 0x8c, 0x4b, 0x6e, 0xa7, 0xe3, 0x72, 0x01,
+		0x90, 0x40, 0x5f, 0xf3, 0xdd, 0x39, 0x3d, 0xa8, 0xa7, 0x3d, 0xa3, 0x78, 0xcb, 0x37, 0x8a, 0xf2,
+		0x7f, 0x96, 0xe0, 0x5a, 0x24, 0xbb, 0x3a, 0x98, 0x14, 0x31, 0x0d, 0x10, 0x41, 0xc3, 0x7b, 0x2b,
+		0xb8, 0xf2, 0x9f, 0xa5, 0xe0, 0x5a, 0x87, 0x94, 0xaa, 0xbf, 0x6c, 0x73, 0x35, 0xa0, 0x74, 0x73,
+		0xd1, 0x4a, 0x77, 0x26, 0xe2, 0x08, 0xdb, 0xad, 0x44, 0x53, 0x7c, 0x3e, 0x6a, 0x8a, 0xbf, 0xd5,
+		0x03, 0x57, 0x3b, 0xf0, 0x7c, 0x07, 0x73, 0x3b, 0x51, 0xcb, 0x1f, 0xcc, 0x6d, 0x77, 0x6e, 0x7f,
+		0x43, 0x82, 0x4b, 0x91, 0xec, 0x8a, 0xb2, 0xdc, 0xef, 0xe7, 0x49, 0x1d, 0x6d, 0x8d, 0xe4, 0x7f,
+		0x27, 0xc1, 0xc5, 0xf8, 0x34, 0xc4, 0xa7, 0x35, 0xc9, 0x85, 0x69, 0x36, 0x99, 0x9d, 0xed, 0xe4,
+		0x7d, 0x42, 0x82, 0xeb, 0x91, 0xce, 0x8e, 0x9b, 0xaf, 0x42, 0x86, 0x11, 0x87, 0xe2, 0x38, 0x57,
+		0xa2, 0x12, 0x5f, 0x20, 0x3a, 0xef, 0x25, 0x2e, 0xb8, 0x28, 0xff, 0xd9, 0x7e, 0xb8, 0x12, 0x93,
+		0x51, 0x1b, 0xa9, 0x46, 0x3f, 0x38, 0xd0, 0xf5, 0x1d, 0x72, 0xa0, 0x2b, 0xc9, 0x54, 0xaa, 0x25,
+		0x98, 0x4a, 0x71, 0x07, 0xc3, 0x1a, 0x4f, 0xeb, 0x60, 0xd8, 0xe6, 0x76, 0xb6, 0x11, 0x4e, 0x05,
+		0xb3, 0xff, 0xde, 0x94, 0x62, 0x4f, 0x86, 0xbd, 0xb5, 0x8d, 0x5c, 0x82, 0xb7, 0x77, 0x92, 0x4b,
+		0xf0, 0xce, 0x0e, 0x73, 0x09, 0x3e, 0xdd, 0x03, 0x97, 0x62, 0xe6, 0x7c, 0x64, 0x3c, 0xfc, 0xd9,
+		0x4e, 0xf8, 0x05, 0xdb, 0x8f, 0xc8, 0x51, 0x69, 0x79, 0x5e, 0xbc, 0x46, 0x88, 0xe9, 0x51, 0x70,
+		0x0b, 0x36, 0xe2, 0x6c, 0xbf, 0xd8, 0x48, 0xde, 0x8a, 0x30, 0x92, 0xbb, 0x94, 0xb5, 0x23, 0x7f,
+		0x25, 0x05, 0x17, 0xc4, 0xc4, 0x47, 0x84, 0x2c, 0xa3, 0xc6, 0x43, 0x4c, 0x7e, 0x26, 0x82, 0xfc,
+		0xa7, 0x64, 0xcb, 0x43, 0x63, 0x9e, 0xdb, 0xde, 0x98, 0xbb, 0xb1, 0x8c, 0x99, 0xe4, 0xb1, 0x8c,
+		0xaf, 0xa5, 0x20, 0x42, 0x0e, 0xba, 0xde, 0x9e, 0x7a, 0x8f, 0x5d, 0xa0, 0xdd, 0x62, 0x5b, 0x77,
+		0x4e, 0xa6, 0x28, 0x72, 0x7b, 0x4b, 0x18, 0x1f, 0xfe, 0x5f, 0x29, 0x38, 0xdf, 0xa5, 0xce, 0xe8,
+		0x32, 0x50, 0xf3, 0x1e, 0x7b, 0xe3, 0x21, 0x86, 0xcf, 0xec, 0x26, 0xc3, 0x6f, 0x75, 0xc1, 0xf0,
+		0x79, 0x21, 0xc3, 0xff, 0x20, 0x05, 0x17, 0x23, 0x44, 0xb9, 0xeb, 0xfd, 0x9b, 0x0f, 0x64, 0xd9,
+		0xc7, 0xda, 0xbf, 0xd1, 0x13, 0xc5, 0xda, 0xa7, 0x99, 0x87, 0xfb, 0xc1, 0x28, 0xf8, 0x47, 0xe1,
+		0x4b, 0x29, 0x98, 0x8e, 0x18, 0x85, 0xb8, 0xf0, 0xaf, 0xd0, 0xf2, 0x89, 0x59, 0x98, 0xd9, 0x75,
+		0x16, 0x66, 0x77, 0x93, 0x85, 0xb9, 0x2e, 0x58, 0x38, 0x23, 0x64, 0xe1, 0x4f, 0xdd, 0x80, 0x7e,
+		0x7e, 0xb9, 0x22, 0x2d, 0x22, 0xdb, 0x5a, 0x81, 0x45, 0xee, 0x7e, 0xcc, 0x5b, 0x3d, 0x06, 0xbd,
+		0xee, 0x01, 0x18, 0xe6, 0x13, 0xb8, 0x05, 0xe8, 0x36, 0xb0, 0x7b, 0x0d, 0xbd, 0x1b, 0xf6, 0x59,
+		0x61, 0x37, 0xe9, 0x87, 0x68, 0x1f, 0x7b, 0xb1, 0xfd, 0x93, 0xa8, 0x1e, 0x3b, 0x7d, 0xec, 0x24,
+		0xfb, 0x2c, 0xff, 0x8b, 0x0e, 0xc3, 0x7e, 0xea, 0x1b, 0xe9, 0xb5, 0xd1, 0x53, 0xb4, 0x66, 0x1f,
+		0xf9, 0x5b, 0xaa, 0xd1, 0x13, 0xe9, 0xe1, 0x31, 0x53, 0xfd, 0x3d, 0x0f, 0x9d, 0xf7, 0xbe, 0x95,
+		0x6c, 0x30, 0xc5, 0x8e, 0x93, 0x72, 0x76, 0x23, 0x19, 0x20, 0x3d, 0x6a, 0x2d, 0x20, 0x2e, 0xe8,
+		0xe0, 0x85, 0x4e, 0x7d, 0xbf, 0x98, 0xf0, 0x82, 0xd5, 0x08, 0x67, 0x44, 0x39, 0xb7, 0x91, 0x14,
+		0x94, 0x5e, 0xc7, 0x20, 0x20, 0x71, 0x8d, 0x5a, 0xe3, 0x30, 0x7d, 0xb7, 0x62, 0xae, 0x63, 0x48,
+		0x66, 0xd2, 0x95, 0x33, 0x1b, 0xc9, 0x4c, 0xff, 0x4f, 0x8b, 0x99, 0x47, 0x84, 0xad, 0xa6, 0x12,
+		0x15, 0x1a, 0x22, 0x8e, 0x1d, 0xfb, 0xba, 0x9d, 0x8c, 0xb8, 0x08, 0x1d, 0xad, 0xe4, 0x36, 0x92,
+		0x6a, 0xf3, 0x4f, 0x4b, 0x90, 0xf3, 0x7b, 0xee, 0xc1, 0xac, 0x84, 0xd0, 0xd9, 0xee, 0x1b, 0x1d,
+		0x73, 0x3d, 0xa3, 0xd2, 0x21, 0x95, 0x53, 0xb5, 0x04, 0x50, 0xe8, 0x13, 0x12, 0x9c, 0x09, 0xd0,
+		0x14, 0x35, 0x13, 0xd8, 0xb9, 0xee, 0x6b, 0x9d, 0x29, 0x12, 0x4f, 0x02, 0xb9, 0xd6, 0x11, 0x46,
+		0xc0, 0xa1, 0x18, 0xd1, 0xaf, 0x25, 0xe4, 0x50, 0xa4, 0xd4, 0x9f, 0xaa, 0x25, 0x80, 0x42, 0x9f,
+		0x0c, 0xd1, 0x14, 0x23, 0x51, 0x2c, 0x89, 0xf0, 0x7a, 0x47, 0x9a, 0xa2, 0x84, 0xe9, 0x64, 0xad,
+		0x33, 0x10, 0xfa, 0x41, 0x09, 0x4e, 0xfb, 0x29, 0x8a, 0x9a, 0x7d, 0x2c, 0x18, 0xf1, 0x7c, 0xc2,
+		0x84, 0xe1, 0x20, 0x31, 0x13, 0xb5, 0x4e, 0x20, 0xe8, 0x47, 0x25, 0xc8, 0xf9, 0xef, 0xcc, 0x88,
+		0x11, 0xe9, 0x37, 0xa5, 0x98, 0x11, 0x4b, 0x92, 0xe2, 0xab, 0x9c, 0xd2, 0x12, 0x40, 0xa1, 0xb7,
+		0x24, 0x38, 0x13, 0x20, 0x2a, 0x4a, 0xa6, 0xdf, 0x92, 0x62, 0x84, 0xba, 0x73, 0xca, 0xa5, 0x22,
+		0x6b, 0x1d, 0x61, 0x04, 0x3c, 0x8a, 0x11, 0xea, 0xb7, 0x93, 0xf2, 0x28, 0x5a, 0xaa, 0xb5, 0x04,
+		0x50, 0xe8, 0x87, 0x24, 0x38, 0xed, 0x27, 0x2a, 0x4a, 0x86, 0xde, 0x91, 0x62, 0x84, 0xa8, 0x63,
+		0x12, 0x9a, 0x32, 0xa1, 0x75, 0x02, 0x41, 0x9f, 0x0a, 0x31, 0x28, 0x66, 0x86, 0x7d, 0x56, 0x8a,
+		0x99, 0x62, 0x09, 0xf2, 0x12, 0x95, 0x93, 0x5a, 0x67, 0x20, 0xf4, 0x04, 0xb2, 0x2c, 0x4f, 0x27,
+		0x52, 0x72, 0xbe, 0xc8, 0xe8, 0xb8, 0x14, 0xe9, 0x8b, 0x47, 0x24, 0x7e, 0x29, 0x47, 0xad, 0xe8,
+		0x4a, 0xd4, 0x82, 0x63, 0xec, 0xbb, 0x6b, 0xba, 0x21, 0xfa, 0xea, 0xbf, 0x60, 0x5f, 0x9d, 0x8a,
+		0xfe, 0xaa, 0x28, 0x55, 0x4b, 0x19, 0xb3, 0xa2, 0xaa, 0xd0, 0x3f, 0x90, 0x60, 0x3a, 0x20, 0x9d,
+		0xe2, 0x5c, 0x3b, 0x0f, 0x15, 0x5f, 0x61, 0x54, 0xbc, 0xd4, 0x59, 0x48, 0x63, 0x77, 0x7c, 0x95,
+		0x49, 0x2d, 0x31, 0x2c, 0xfa, 0x82, 0x04, 0x57, 0x63, 0x2f, 0xeb, 0x89, 0x92, 0xdf, 0xff, 0xc0,
+		0x88, 0x9d, 0xeb, 0xee, 0xc6, 0x1e, 0xb1, 0x30, 0x4f, 0x19, 0x5d, 0xc1, 0x13, 0xdb, 0x71, 0x56,
+		0xc4, 0x5c, 0x11, 0x9d, 0x7f, 0x98, 0x54, 0xb0, 0x23, 0xd6, 0xe1, 0x7e, 0xc1, 0x8e, 0x00, 0x42,
+		0x5b, 0x70, 0x9c, 0x09, 0x58, 0x34, 0x21, 0xff, 0x5d, 0x8a, 0xb9, 0xb4, 0x2a, 0x2e, 0x57, 0x4f,
+		0x39, 0x66, 0xc5, 0xd4, 0xa2, 0x1f, 0x90, 0xe0, 0x94, 0xef, 0x16, 0xa3, 0xa8, 0x11, 0xfb, 0x26,
+		0x23, 0xe0, 0xb9, 0x4e, 0x57, 0x19, 0x89, 0xc7, 0xe8, 0x44, 0xb5, 0x03, 0x04, 0xfa, 0x6b, 0x70,
+		0x82, 0x67, 0x4c, 0x19, 0x3c, 0xa7, 0x2a, 0x4c, 0xc3, 0xb7, 0xa4, 0x98, 0xeb, 0x94, 0x62, 0x13,
+		0xb2, 0x94, 0xf1, 0xf5, 0xb8, 0x6a, 0xe2, 0xa6, 0x5e, 0x10, 0x2d, 0x40, 0xf8, 0x06, 0x6a, 0x98,
+		0x94, 0x8f, 0xa7, 0xba, 0x71, 0x53, 0x23, 0x36, 0x62, 0x05, 0x6e, 0x6a, 0x04, 0x24, 0xfa, 0x59,
+		0x09, 0xa6, 0x04, 0x04, 0x5a, 0xce, 0xea, 0x38, 0x4c, 0xe2, 0x8f, 0xa4, 0x62, 0x14, 0x42, 0xf2,
+		0x75, 0xb6, 0x32, 0xb9, 0x91, 0x18, 0x16, 0x7d, 0x51, 0x82, 0xab, 0xa2, 0xb5, 0x52, 0x47, 0xed,
+		0xf5, 0x13, 0x8c, 0xd8, 0x42, 0xc2, 0x35, 0x53, 0xbc, 0x0a, 0x9b, 0xde, 0xe8, 0x0e, 0x21, 0x6a,
+		0xf8, 0xa3, 0xa7, 0xe3, 0xbb, 0x5d, 0x0d, 0x7f, 0xd4, 0xd4, 0xcc, 0x6d, 0x24, 0x84, 0x44, 0x5f,
+		0x97, 0xa0, 0xd8, 0xc5, 0x5d, 0x5a, 0xc1, 0x00, 0x83, 0x87, 0xf2, 0x5f, 0x64, 0x94, 0x2f, 0xef,
+		0xe0, 0x4e, 0xad, 0xa8, 0xcd, 0x60, 0xe5, 0x45, 0x63, 0x47, 0xf8, 0xe8, 0xbf, 0x48, 0x30, 0xdb,
+		0x45, 0x2f, 0xa3, 0x54, 0xd5, 0xaf, 0xb0, 0x2e, 0x2e, 0xed, 0xa0, 0x8b, 0x62, 0x2d, 0x76, 0xd3,
+		0xd8, 0x3e, 0x32, 0xfa, 0xaa, 0x04, 0xb7, 0xe3, 0x7a, 0xd3, 0x79, 0x8e, 0x7c, 0x89, 0xf5, 0x6b,
+		0x41, 0x1c, 0x8a, 0xd9, 0x5e, 0x82, 0x97, 0x72, 0x0d, 0x6f, 0x0f, 0x91, 0xda, 0x7e, 0x51, 0x37,
+		0x9c, 0x0b, 0x6c, 0xf8, 0x05, 0x65, 0xa1, 0x6e, 0xfc, 0xdb, 0x54, 0x8c, 0xed, 0xef, 0xee, 0x6e,
+		0x02, 0x65, 0x6a, 0xa3, 0x2b, 0x78, 0xf4, 0x9b, 0x12, 0xdc, 0xe8, 0x70, 0x0f, 0x59, 0xcc, 0xdc,
+		0xf9, 0x7d, 0x46, 0xf9, 0x9d, 0x6e, 0xef, 0x23, 0x8b, 0x9c, 0x30, 0x57, 0xcc, 0xee, 0x91, 0xd0,
+		0xaf, 0x91, 0xbb, 0xc9, 0xe2, 0xfb, 0x10, 0x35, 0x33, 0xfe, 0x5b, 0x2a, 0xe6, 0x86, 0xaf, 0x6e,
+		0xb7, 0x82, 0x95, 0x4b, 0x66, 0x97, 0x18, 0xe8, 0x17, 0x24, 0xb8, 0x1c, 0x49, 0x74, 0xa4, 0x57,
+		0xff, 0x27, 0x8c, 0xea, 0x7c, 0x17, 0x3b, 0xbd, 0x11, 0x6e, 0xfe, 0x85, 0x6a, 0x17, 0xd0, 0xe8,
+		0x1f, 0x4b, 0x70, 0x25, 0x92, 0xda, 0x98, 0xe5, 0xe2, 0xff, 0x8e, 0x13, 0xf0, 0xee, 0x76, 0x24,
+		0x95, 0xa9, 0x6a, 0x57, 0xf0, 0xe8, 0x1f, 0x4a, 0x70, 0xa9, 0x6b, 0xb1, 0xf8, 0x8b, 0x54, 0xdc,
+		0x35, 0xa4, 0x5d, 0x48, 0xc4, 0xf9, 0x6a, 0x17, 0xc2, 0xf0, 0x39, 0x09, 0x66, 0xa2, 0xd9, 0x1b,
+		0x69, 0x7a, 0x7f, 0xa0, 0x27, 0xe6, 0x52, 0xc0, 0xae, 0x36, 0xc9, 0x94, 0x8b, 0xd5, 0x6e, 0xc0,
+		0xd1, 0xe7, 0xe3, 0xe4, 0x21, 0x66, 0x75, 0xfc, 0xa9, 0xee, 0x29, 0x8e, 0x5a, 0x27, 0x5f, 0xac,
+		0x76, 0x03, 0x4e, 0xdd, 0xb1, 0x68, 0x8a, 0x63, 0x7c, 0xc7, 0xcf, 0xf4, 0xc4, 0xb8, 0x63, 0x5d,
+		0x6e, 0xd4, 0x28, 0xd3, 0xd5, 0xee, 0x10, 0xa8, 0xa9, 0x4c, 0x70, 0xfb, 0x62, 0x8c, 0xa6, 0xfe,
+		0xd9, 0x9e, 0x18, 0x53, 0xb9, 0xcd, 0x94, 0x61, 0xe5, 0x9a, 0xb9, 0x3d, 0x44, 0xf4, 0xaf, 0x24,
+		0x78, 0x21, 0x41, 0x7f, 0xa2, 0xa6, 0xe7, 0xe7, 0x58, 0x67, 0x4a, 0xdb, 0xcf, 0x98, 0x0d, 0xf6,
+		0xe4, 0xaa, 0xb9, 0x0d, 0x2c, 0x72, 0xfd, 0xe8, 0x73, 0x71, 0xf4, 0x47, 0xaf, 0x96, 0x7e, 0xad,
+		0x27, 0xc6, 0xee, 0x74, 0x9b, 0xbe, 0xaa, 0x5c, 0xc2, 0x5d, 0x62, 0x50, 0x55, 0xd3, 0xf9, 0x4a,
+		0xcc, 0x10, 0xd9, 0x5f, 0x8e, 0x9b, 0xb8, 0x5d, 0x65, 0xa2, 0x2a, 0x17, 0xdb, 0xdd, 0x80, 0xa3,
+		0xdf, 0x93, 0xe0, 0xc5, 0x98, 0x29, 0xeb, 0xc9, 0xf9, 0x24, 0x3f, 0x4d, 0x2c, 0xd0, 0x3a, 0xbf,
+		0xdd, 0x13, 0x73, 0x79, 0xee, 0x76, 0x13, 0x4f, 0x95, 0xeb, 0x1b, 0xdb, 0xc4, 0x94, 0x0b, 0xb0,
+		0x9f, 0x6f, 0x4f, 0xa2, 0x1b, 0xb0, 0x8f, 0x92, 0x6b, 0xdf, 0xb8, 0x36, 0x11, 0xf7, 0x52, 0x1c,
+		0x6d, 0x47, 0xe1, 0x08, 0xf2, 0xab, 0x70, 0x38, 0x2c, 0xad, 0x7a, 0xdd, 0xc2, 0xc6, 0xb6, 0x9f,
+		0xcb, 0xcc, 0x01, 0xf2, 0x6e, 0xd7, 0xf2, 0xd6, 0x44, 0x2f, 0x88, 0x5e, 0x05, 0x20, 0x39, 0x79,
+		0x1c, 0x82, 0xbf, 0x2b, 0x09, 0x82, 0x77, 0x25, 0x33, 0x9e, 0x77, 0x25, 0xe5, 0xfb, 0x70, 0xd0,
+		0xb9, 0xb2, 0x81, 0xa3, 0x9e, 0x84, 0x01, 0xac, 0x19, 0x75, 0x1d, 0x9b, 0xfc, 0x49, 0x47, 0xb6,
+		0x3f, 0xdb, 0x6f, 0x17, 0x12, 0x50, 0xd2, 0x1f, 0x7e, 0xd0, 0x96, 0x82, 0xf0, 0x17, 0xe7, 0x58,
+		0x11, 0x01, 0x90, 0x3f, 0x9b, 0x02, 0xe0, 0xaf, 0x27, 0x92, 0x7b, 0xe7, 0x04, 0x14, 0x13, 0x4e,
+		0xf3, 0x47, 0x1f, 0xe3, 0x5e, 0x35, 0x65, 0x8d, 0xf0, 0x47, 0x1e, 0x39, 0x02, 0x3a, 0x01, 0x7d,
+		0x35, 0x6c, 0x56, 0x0d, 0xbd, 0xe5, 0xa4, 0x20, 0xf4, 0x2a, 0xde, 0x22, 0x42, 0x60, 0x73, 0xa3,
+		0x81, 0x0d, 0x15, 0xaf, 0x6b, 0x7a, 0xdd, 0x3e, 0x8b, 0x40, 0x8b, 0x8a, 0xa4, 0x04, 0xdd, 0x86,
+		0x3d, 0x35, 0xcd, 0xd2, 0x46, 0x67, 0xe8, 0x28, 0x9f, 0x8b, 0xf9, 0x36, 0xe9, 0xc0, 0x54, 0x41,
+		0xb3, 0x34, 0xf6, 0xae, 0x27, 0x45, 0x23, 0x1d, 0x6a, 0xb7, 0x9d, 0x9b, 0x4c, 0xe8, 0xef, 0x23,
+		0xd7, 0xa0, 0xd7, 0x01, 0xeb, 0xf4, 0xb2, 0x67, 0xaf, 0xf7, 0x65, 0xcf, 0xaf, 0xec, 0x81, 0x61,
+		0xf6, 0x2d, 0xff, 0x73, 0x78, 0x1f, 0x11, 0xee, 0x3c, 0x1b, 0xd8, 0xc2, 0x0d, 0xfa, 0xab, 0x85,
+		0x0d, 0xbd, 0x59, 0x53, 0xf5, 0x86, 0x5a, 0xd3, 0xb6, 0x58, 0x32, 0xcd, 0x5e, 0xc1, 0xee, 0xa7,
+		0x62, 0x23, 0x2c, 0x51, 0xf8, 0x52, 0xa3, 0xa0, 0x6d, 0x91, 0xab, 0x05, 0xfb, 0xf0, 0xba, 0x6e,
+		0xa9, 0xeb, 0xd8, 0x32, 0xf4, 0x2a, 0xcf, 0x41, 0x38, 0x32, 0xc5, 0xde, 0xe2, 0x9e, 0xb2, 0xdf,
+		0xe2, 0x9e, 0x9a, 0x6d, 0x36, 0xeb, 0xaf, 0x11, 0x4a, 0x15, 0x20, 0xe0, 0xf7, 0x28, 0x34, 0xc9,
+		0x33, 0x59, 0x25, 0x6f, 0x08, 0xea, 0x0d, 0xcd, 0xd0, 0x9d, 0x8d, 0x51, 0x71, 0x9e, 0xc9, 0xac,
+		0x56, 0x9b, 0xe5, 0x70, 0x4a, 0xdf, 0xaa, 0xfb, 0x07, 0x7d, 0x04, 0x0e, 0x87, 0x5e, 0x82, 0xe4,
+		0x12, 0xc1, 0x5e, 0x58, 0x11, 0xdf, 0x76, 0xe8, 0x7d, 0x9e, 0xb1, 0x6d, 0x2a, 0x87, 0x1e, 0x85,
+		0x5f, 0x6d, 0x6c, 0x9b, 0xe4, 0xa2, 0x88, 0x50, 0xe3, 0x6d, 0x43, 0xe7, 0x6f, 0xad, 0xa0, 0x00,
+		0xd2, 0x8a, 0xa1, 0x23, 0x0d, 0x8e, 0x3c, 0xd1, 0x4d, 0x7d, 0x55, 0xaf, 0xeb, 0x96, 0x07, 0x89,
+		0x53, 0x54, 0x4b, 0x4e, 0xd1, 0xa8, 0xdb, 0x4c, 0x80, 0xa8, 0xe7, 0xe1, 0xb0, 0xe8, 0x13, 0x84,
+		0xae, 0x06, 0xa5, 0xeb, 0x50, 0x18, 0x95, 0x90, 0x96, 0x83, 0xf4, 0xe3, 0x46, 0x73, 0xa3, 0xa1,
+		0x3a, 0xe9, 0xe4, 0x64, 0xab, 0xae, 0x87, 0xdc, 0x9e, 0x44, 0xcb, 0xed, 0xfc, 0x71, 0x53, 0xfe,
+		0xa7, 0x12, 0xf4, 0x79, 0x18, 0x8e, 0x3e, 0x04, 0x07, 0x9c, 0x41, 0x62, 0x0a, 0x6d, 0xaa, 0xd3,
+		0x20, 0x4d, 0xd9, 0x3f, 0x98, 0xbc, 0x3b, 0xf8, 0x47, 0x54, 0x18, 0xf0, 0x55, 0x09, 0x64, 0xfc,
+		0xba, 0x57, 0xc6, 0xfb, 0x66, 0xe4, 0xd8, 0x6f, 0x6d, 0xd1, 0xdb, 0x2a, 0x3d, 0xf3, 0xa0, 0x09,
+		0x03, 0xbe, 0xba, 0xc8, 0xe4, 0xbc, 0x23, 0x70, 0xa0, 0xd9, 0xc2, 0x06, 0xbd, 0xf4, 0x8d, 0x9f,
+		0x16, 0xb0, 0xff, 0x8b, 0xef, 0x98, 0xcc, 0x0a, 0xef, 0x98, 0x94, 0x3f, 0x99, 0x82, 0xf4, 0x4a,
+		0xab, 0xa6, 0x59, 0xd8, 0xa3, 0xab, 0x5e, 0xf4, 0x2b, 0x17, 0x76, 0x3f, 0xd9, 0xb1, 0xd0, 0xc4,
+		0x58, 0xb6, 0xc8, 0x05, 0x94, 0x6c, 0x6a, 0xf8, 0x54, 0xcf, 0x6d, 0xbf, 0xea, 0xc9, 0x24, 0xc0,
+		0xf7, 0x2a, 0xa6, 0x39, 0xae, 0x98, 0xb2, 0x74, 0xb4, 0xa6, 0x23, 0xec, 0xbb, 0x9f, 0xe6, 0xa0,
+		0x7a, 0xda, 0xbe, 0x2a, 0xfa, 0x7e, 0x38, 0xce, 0x5f, 0x2f, 0x57, 0x70, 0xab, 0xae, 0x57, 0xb5,
+		0xf0, 0x23, 0x9d, 0x13, 0xe4, 0xc9, 0x5e, 0x0a, 0xe2, 0x3d, 0x5c, 0xdb, 0xc7, 0xcb, 0xe8, 0x41,
+		0xaf, 0xeb, 0x30, 0xca, 0x1c, 0xe0, 0x3a, 0x75, 0x1c, 0x9b, 0x4f, 0xb0, 0xe1, 0x5c, 0xdb, 0xc4,
+		0x6c, 0xc5, 0x08, 0xaf, 0x9f, 0xe7, 0xd5, 0xf6, 0xe5, 0x4d, 0x5f, 0x48, 0x41, 0x96, 0xf5, 0x2b,
+		0xf2, 0xfb, 0x53, 0x30, 0x1c, 0x78, 0x84, 0xdd, 0x43, 0xc6, 0x90, 0xef, 0x05, 0x76, 0x4a, 0xcc,
+		0x12, 0x1c, 0xe0, 0x80, 0xc4, 0xd2, 0x10, 0xa6, 0x5e, 0x15, 0x2f, 0x1d, 0xe2, 0xfb, 0xad, 0x38,
+		0xad, 0xa0, 0x45, 0x76, 0x53, 0x20, 0xed, 0x16, 0x7b, 0x55, 0x96, 0x25, 0x22, 0xe5, 0x62, 0xac,
+		0x88, 0xdd, 0x51, 0xf6, 0xa8, 0xec, 0xc0, 0x9a, 0xf7, 0x2f, 0x39, 0x06, 0xdf, 0xc2, 0x8d, 0x1a,
+		0xb9, 0xea, 0x54, 0xd4, 0x35, 0x66, 0xbd, 0x46, 0x39, 0x48, 0x3e, 0xd8, 0x43, 0xf9, 0x9b, 0xfb,
+		0xe0, 0x90, 0x82, 0x1f, 0xea, 0xa4, 0xc0, 0x66, 0x1e, 0x8d, 0x8c, 0x09, 0xed, 0x6e, 0xc0, 0x78,
+		0x66, 0x3a, 0x1a, 0xcf, 0x6c, 0xc8, 0x78, 0x76, 0x69, 0x98, 0x72, 0x5d, 0x19, 0xa6, 0xe3, 0x7e,
+		0xc3, 0xc4, 0x0e, 0xf4, 0x7a, 0x8d, 0x8f, 0x77, 0x40, 0x6f, 0xed, 0xca, 0x80, 0x46, 0x88, 0xd4,
+		0x7c, 0x94, 0x48, 0xdd, 0xe1, 0x73, 0x74, 0x29, 0xe6, 0xeb, 0xc2, 0x01, 0x09, 0xf9, 0x11, 0xa7,
+		0x61, 0xd0, 0xc4, 0xd5, 0xb6, 0x41, 0xf7, 0xfb, 0x9a, 0x8f, 0xb1, 0xf3, 0x18, 0x98, 0x5d, 0x5a,
+		0x21, 0x85, 0xc4, 0x00, 0xe8, 0xa6, 0xfa, 0xb0, 0xde, 0x5c, 0xd5, 0xea, 0xf6, 0x25, 0xf0, 0x9b,
+		0x94, 0x31, 0x83, 0xba, 0xf9, 0x0a, 0x2d, 0x66, 0xdf, 0x41, 0xdf, 0x15, 0x6d, 0x54, 0xdf, 0x94,
+		0x92, 0xdb, 0xb0, 0x08, 0xab, 0x7a, 0x39, 0xc2, 0xaa, 0xbe, 0x25, 0x45, 0x9a, 0xd5, 0xd5, 0x58,
+		0xb3, 0xfa, 0xb6, 0xb4, 0x1b, 0x76, 0xf5, 0x5a, 0xb4, 0x5d, 0x7d, 0x47, 0x8a, 0x31, 0xac, 0xdb,
+		0xd7, 0x93, 0xaf, 0x03, 0x22, 0x06, 0x97, 0x31, 0xdd, 0xb4, 0xa7, 0xdb, 0x51, 0xe8, 0x6d, 0x69,
+		0x0f, 0xb1, 0xfb, 0x50, 0x33, 0xb9, 0x10, 0x5a, 0x7b, 0x88, 0xe9, 0x2b, 0xcd, 0x67, 0xe0, 0x60,
+		0x03, 0x6f, 0x5a, 0x2a, 0x85, 0x60, 0x63, 0xcd, 0x7c, 0xf1, 0x01, 0x52, 0xbc, 0xa4, 0x3d, 0xc4,
+		0x74, 0xac, 0xe5, 0x8f, 0x4b, 0x30, 0xec, 0x6b, 0xdb, 0x6c, 0x35, 0x1b, 0x26, 0x46, 0x45, 0xd8,
+		0xcf, 0x46, 0xde, 0xb6, 0xe4, 0xe2, 0x8b, 0x4e, 0x0b, 0x74, 0x22, 0xaf, 0x62, 0x5b, 0xee, 0x18,
+		0xb6, 0x62, 0xe3, 0x26, 0x26, 0xe3, 0x25, 0x38, 0x14, 0x6c, 0x2a, 0x5a, 0xa7, 0xd8, 0xee, 0x70,
+		0xc6, 0x75, 0x87, 0xe5, 0x5f, 0xea, 0x81, 0x11, 0x31, 0x31, 0xe8, 0x65, 0xe8, 0xb3, 0x5f, 0x5c,
+		0x27, 0x2f, 0x7f, 0x33, 0x13, 0x7b, 0xbc, 0x83, 0x0f, 0xae, 0x40, 0xcd, 0xf9, 0x8d, 0xca, 0x30,
+		0x50, 0xf5, 0x4e, 0x66, 0x6e, 0x66, 0xe3, 0x34, 0xb0, 0x7f, 0xf2, 0xfb, 0xd1, 0x51, 0x0b, 0xc6,
+		0x0c, 0x57, 0x4f, 0xa8, 0xfe, 0xb6, 0xb3, 0x31, 0xcf, 0xa6, 0xc4, 0x1b, 0x2b, 0x65, 0xd4, 0x88,
+		0xa8, 0x41, 0xe7, 0x20, 0x1d, 0xb2, 0x8d, 0xfc, 0x2a, 0xec, 0x35, 0xbf, 0x51, 0x14, 0xce, 0xfe,
+		0x19, 0xe1, 0xec, 0x7f, 0x01, 0xc6, 0x5a, 0x06, 0x7e, 0xa2, 0x37, 0xdb, 0x66, 0xd8, 0xf2, 0xb2,
+		0xf4, 0xe6, 0xc3, 0x36, 0x40, 0xd0, 0xf4, 0xfe, 0xfd, 0x3d, 0x30, 0xec, 0x75, 0x2c, 0xe2, 0xc6,
+		0xfb, 0x0e, 0xf4, 0xb7, 0x29, 0x68, 0x8d, 0x8d, 0x60, 0xdc, 0x79, 0xd3, 0xa0, 0xb3, 0xa2, 0xf4,
+		0x71, 0x54, 0xf1, 0x40, 0x66, 0x9f, 0xe2, 0x40, 0xe6, 0x9e, 0xc6, 0x40, 0x86, 0x55, 0xf8, 0x8c,
+		0x48, 0x85, 0x4f, 0x02, 0xb9, 0xa8, 0x0f, 0x5b, 0x58, 0x75, 0x56, 0x4e, 0xf6, 0xe3, 0x04, 0x07,
+		0x59, 0x85, 0xe3, 0xfb, 0x92, 0xdb, 0x5c, 0x1f, 0x1a, 0x5a, 0x15, 0xaf, 0xb5, 0x5d, 0x07, 0x8a,
+		0x1f, 0x7b, 0x4f, 0xdb, 0x15, 0xf6, 0xf0, 0xa1, 0x6b, 0x30, 0xda, 0x32, 0x9a, 0xeb, 0x4d, 0x8b,
+		0x4c, 0xe7, 0x80, 0x94, 0x2c, 0x51, 0x9c, 0x43, 0xbc, 0xbe, 0xd2, 0xf4, 0x09, 0xcb, 0x15, 0x18,
+		0xa9, 0x61, 0x1b, 0xaf, 0xde, 0xac, 0xba, 0x68, 0x0f, 0x28, 0xda, 0x30, 0xab, 0xad, 0x34, 0x17,
+		0x9a, 0x55, 0x1b, 0x49, 0xfe, 0xd3, 0x14, 0x64, 0xfc, 0x52, 0xf2, 0xc1, 0x9c, 0x7e, 0xaa, 0x73,
+		0x5a, 0x5e, 0x26, 0x6a, 0xb4, 0x65, 0xe0, 0x6a, 0xa2, 0x99, 0x19, 0x96, 0xc6, 0x8c, 0x40, 0x1a,
+		0xe5, 0xcf, 0xef, 0x87, 0x71, 0x1a, 0xf9, 0x09, 0x9f, 0x2f, 0xe0, 0x8d, 0x7f, 0x70, 0x7a, 0xfa,
+		0x99, 0x9c, 0x9e, 0xf6, 0x1e, 0x84, 0x5f, 0x8a, 0xbd, 0x65, 0xf3, 0x41, 0xf0, 0x96, 0xcd, 0x67,
+		0xf2, 0xae, 0xe5, 0x53, 0x3c, 0xbe, 0xbc, 0x2b, 0x97, 0x9a, 0xff, 0xf0, 0xce, 0x0e, 0x22, 0x7b,
+		0xce, 0x50, 0xbf, 0xbd, 0xcd, 0x97, 0x41, 0xde, 0x49, 0xfc, 0x32, 0xc8, 0x67, 0xe3, 0x5e, 0x06,
+		0xb9, 0x06, 0xd9, 0xa8, 0x09, 0xcb, 0x35, 0xb0, 0x1b, 0x43, 0x06, 0x6f, 0x0c, 0xf9, 0x97, 0x25,
+		0x38, 0xb2, 0xd4, 0xac, 0xd7, 0xe7, 0x9b, 0x86, 0x37, 0x85, 0xbb, 0xd3, 0x3c, 0xdf, 0xc9, 0x43,
+		0x4d, 0x31, 0x77, 0x59, 0x89, 0x2e, 0x02, 0xcf, 0x09, 0x2f, 0x02, 0xff, 0xe9, 0x7d, 0x70, 0x54,
+		0x48, 0x37, 0xef, 0xee, 0x38, 0x00, 0x25, 0x90, 0x69, 0x39, 0x76, 0x92, 0x90, 0x92, 0xcc, 0xec,
+		0xed, 0xfb, 0xfc, 0xa8, 0xd5, 0x0d, 0x8f, 0xa7, 0x16, 0x71, 0x7b, 0xe4, 0x88, 0x0d, 0xb0, 0xec,
+		0xbf, 0x9c, 0x30, 0xf1, 0xb9, 0x2b, 0xef, 0xbd, 0xbb, 0x57, 0x7c, 0x17, 0xdd, 0x93, 0x93, 0x5e,
+		0xe4, 0x61, 0x85, 0x7a, 0xf3, 0xa1, 0x5a, 0x6d, 0xb6, 0x1b, 0x96, 0xfa, 0x48, 0x6f, 0x58, 0xf4,
+		0x21, 0xaa, 0x1e, 0x25, 0xcd, 0x6b, 0xe6, 0x48, 0xc5, 0x1d, 0xbd, 0x61, 0xa1, 0xe7, 0x61, 0x3f,
+		0x5f, 0xd9, 0xf1, 0xf3, 0x39, 0xc7, 0xe2, 0x76, 0x45, 0x14, 0x1b, 0x58, 0xb4, 0xd6, 0x98, 0x17,
+		0xac, 0x35, 0x48, 0xd8, 0xf0, 0xa3, 0x6d, 0x6c, 0x6c, 0x8d, 0x2e, 0xc5, 0x84, 0x0d, 0x6d, 0x66,
+		0xbe, 0x4a, 0x20, 0x15, 0x86, 0x80, 0xbe, 0x1b, 0x8e, 0x89, 0xf6, 0xa2, 0x1c, 0xc1, 0x7d, 0x90,
+		0x44, 0x70, 0xc7, 0xc2, 0x1b, 0x47, 0xb6, 0x24, 0x4f, 0xc3, 0xb0, 0x7b, 0x40, 0xc1, 0x3d, 0x97,
+		0xc6, 0x6e, 0x93, 0x70, 0x2f, 0x09, 0x75, 0xdf, 0x65, 0x3a, 0x0f, 0x43, 0xf6, 0xe0, 0xb8, 0xe0,
+		0x0d, 0xc6, 0x57, 0x5e, 0xe1, 0x02, 0xdf, 0x82, 0xfd, 0xa4, 0x1b, 0x3a, 0x66, 0xe1, 0xdc, 0x64,
+		0x3d, 0xb7, 0x51, 0xe4, 0xcf, 0x49, 0x30, 0xb6, 0x6c, 0xe9, 0xd5, 0xc7, 0x5b, 0x0e, 0xdd, 0x1e,
+		0xc5, 0xf5, 0x0a, 0xa4, 0x49, 0xb7, 0xb0, 0xa1, 0x76, 0xf9, 0xd6, 0xc2, 0x20, 0x43, 0x73, 0x58,
+		0x90, 0xe8, 0x26, 0xf0, 0x4c, 0x82, 0x9b, 0xc0, 0xe5, 0xdf, 0xd8, 0x03, 0x27, 0xd9, 0xf4, 0xad,
+		0x09, 0xcf, 0xdb, 0xd8, 0x2a, 0xa9, 0xc3, 0xcc, 0xbe, 0x09, 0xbd, 0xf6, 0x41, 0x13, 0x3b, 0xa2,
+		0x37, 0x1e, 0x7b, 0x62, 0x45, 0x71, 0xe1, 0xc5, 0x0f, 0x25, 0x64, 0x23, 0x1e, 0x4a, 0x88, 0xbb,
+		0xea, 0xfe, 0x23, 0x64, 0xa0, 0x09, 0xf3, 0xc3, 0xa7, 0xeb, 0xa6, 0x22, 0x72, 0x98, 0x22, 0x86,
+		0x8a, 0x08, 0x06, 0xa9, 0xf2, 0x0c, 0xde, 0x35, 0x18, 0x35, 0xb0, 0xd5, 0x36, 0x1a, 0x34, 0x4d,
+		0xcd, 0x77, 0x76, 0x87, 0x3f, 0x6e, 0x74, 0x88, 0xd5, 0x97, 0xf1, 0x86, 0x97, 0x95, 0xe4, 0xb2,
+		0xf5, 0xb5, 0xa6, 0x51, 0xc5, 0x2a, 0x0b, 0x75, 0x0b, 0xd0, 0xd9, 0x32, 0xe2, 0x08, 0x85, 0x9a,
+		0xa3, 0x40, 0xc1, 0x36, 0x04, 0x1a, 0x7a, 0x49, 0xf8, 0x48, 0xd4, 0x3d, 0x18, 0xa0, 0xb3, 0x90,
+		0xbf, 0x6b, 0x42, 0x4e, 0x7c, 0xf5, 0x44, 0x3a, 0xec, 0x7e, 0x21, 0xa6, 0x08, 0x4a, 0xff, 0x47,
+		0xdd, 0x3f, 0x34, 0xf0, 0xc7, 0x9a, 0x23, 0x7a, 0x95, 0xec, 0xb8, 0x90, 0x0d, 0x0e, 0xa0, 0x45,
+		0x44, 0x65, 0x9a, 0xf2, 0xf7, 0xc1, 0xa9, 0x78, 0xf1, 0xe1, 0x96, 0x61, 0x05, 0x06, 0xfc, 0x7d,
+		0x86, 0x98, 0xa3, 0x17, 0x31, 0x26, 0xc6, 0xf3, 0xb0, 0xb4, 0x66, 0x3e, 0x96, 0xff, 0x99, 0x04,
+		0x27, 0x04, 0xdf, 0x67, 0x09, 0x09, 0x09, 0x65, 0x77, 0xd6, 0x7b, 0x69, 0xeb, 0xce, 0x9f, 0x66,
+		0xc8, 0x26, 0x7e, 0x9a, 0x41, 0xfe, 0x23, 0xd7, 0x0d, 0xf0, 0x1e, 0x0e, 0x78, 0x56, 0x6e, 0xc0,
+		0x32, 0x20, 0xa7, 0x5d, 0x75, 0x1d, 0x5b, 0x1a, 0x0d, 0xa8, 0xe6, 0x62, 0x9c, 0x35, 0xfb, 0x03,
+		0xf7, 0x38, 0xb0, 0x92, 0xb6, 0x02, 0x25, 0xf2, 0xb7, 0x5c, 0x97, 0xc1, 0xdf, 0xc7, 0x67, 0xea,
+		0x32, 0x04, 0xae, 0xd5, 0xce, 0x76, 0x7e, 0x67, 0x22, 0xb7, 0xbd, 0x77, 0x26, 0xc4, 0xab, 0x9e,
+		0x08, 0x13, 0x36, 0x1f, 0x69, 0xc2, 0x12, 0x3d, 0x22, 0xb1, 0x94, 0xe4, 0x11, 0x09, 0xa1, 0x31,
+		0x7c, 0x10, 0x61, 0x0c, 0x3b, 0xbf, 0x13, 0x51, 0xdb, 0xd9, 0x3b, 0x11, 0x8d, 0xf8, 0x77, 0x22,
+		0x3c, 0xce, 0xd2, 0xa6, 0xff, 0x91, 0x82, 0x7b, 0x70, 0x52, 0xc0, 0x41, 0xb5, 0xb9, 0xa6, 0x5a,
+		0x8f, 0x74, 0x53, 0xb5, 0xb1, 0xe8, 0x22, 0xa6, 0x47, 0x39, 0x1e, 0x66, 0xe9, 0xe2, 0x5a, 0xe5,
+		0x91, 0x6e, 0xe6, 0x1d, 0xdf, 0x6b, 0xc8, 0x25, 0xd2, 0x9e, 0xc9, 0x74, 0x91, 0xd3, 0xaf, 0xa4,
+		0x9d, 0x1a, 0xfb, 0x19, 0xa9, 0x57, 0x82, 0x0e, 0xe7, 0xdb, 0x52, 0x8c, 0x74, 0xc4, 0x78, 0x9c,
+		0x39, 0x38, 0x18, 0x7c, 0x47, 0x96, 0x07, 0xc7, 0x07, 0x37, 0xfc, 0x4f, 0xc8, 0xba, 0x6b, 0x9e,
+		0xcf, 0x26, 0x5f, 0xf3, 0x90, 0x75, 0x86, 0xcc, 0xce, 0xb0, 0x78, 0xe7, 0xde, 0x1d, 0xbb, 0x3f,
+		0x09, 0x15, 0xe4, 0xb6, 0x2e, 0xc8, 0x25, 0xe3, 0xce, 0xb2, 0x32, 0xeb, 0x6c, 0x4d, 0xb5, 0xa1,
+		0xe9, 0xc1, 0x47, 0x5f, 0x0f, 0x7b, 0x01, 0xee, 0x6b, 0xba, 0xe3, 0x95, 0x7c, 0x22, 0x05, 0x67,
+		0x62, 0xe8, 0x9e, 0xdd, 0x2a, 0x15, 0x76, 0x1c, 0x13, 0x71, 0x97, 0x66, 0x59, 0xef, 0x73, 0x8a,
+		0x01, 0xcd, 0x90, 0x0b, 0x69, 0x06, 0x0f, 0x37, 0x66, 0xa2, 0xb9, 0x71, 0xab, 0x1b, 0x6e, 0xcc,
+		0xc7, 0x73, 0x63, 0x09, 0x4e, 0xc6, 0x30, 0xc3, 0xd1, 0xa4, 0xe7, 0x20, 0x1d, 0x3c, 0x14, 0xc0,
+		0xef, 0xf7, 0x3b, 0x58, 0xf5, 0xe7, 0xe6, 0xcb, 0x9b, 0x8e, 0xd3, 0x27, 0x3c, 0x8e, 0x9a, 0x50,
+		0x2e, 0xdc, 0xeb, 0x8f, 0x32, 0xbe, 0xeb, 0x8f, 0xe2, 0xae, 0x4d, 0xfe, 0xa4, 0x6b, 0xb0, 0xc3,
+		0x47, 0xef, 0xba, 0xf9, 0x2e, 0x4d, 0x41, 0xc8, 0x44, 0xdd, 0x0f, 0xd4, 0x85, 0x11, 0xde, 0x02,
+		0x59, 0x40, 0x90, 0x9d, 0xd4, 0xfb, 0x34, 0xa7, 0x88, 0xfc, 0x3b, 0x12, 0x9c, 0x8d, 0x1b, 0x87,
+		0x67, 0x2a, 0xe7, 0xee, 0xe8, 0xce, 0x44, 0x8e, 0x6e, 0x40, 0xca, 0xe5, 0x3f, 0x96, 0xe0, 0x94,
+		0xa0, 0x43, 0x6c, 0x74, 0x9f, 0x7d, 0x6f, 0x04, 0xaf, 0x60, 0x79, 0x06, 0xee, 0x56, 0xf4, 0xc0,
+		0xcd, 0x07, 0xfa, 0xf9, 0xbb, 0x12, 0x9c, 0x11, 0xf4, 0xd3, 0x16, 0x9a, 0x6f, 0x3f, 0xfd, 0x24,
+		0xff, 0x7b, 0x09, 0x4e, 0xfb, 0xce, 0x3a, 0x75, 0x1d, 0x84, 0x7e, 0x4a, 0x9e, 0x5a, 0x9c, 0x89,
+		0xf1, 0x07, 0x5f, 0x73, 0xc1, 0x97, 0xfa, 0xde, 0xed, 0x01, 0xf9, 0x15, 0x1c, 0x8e, 0xce, 0xd9,
+		0x31, 0x91, 0x0e, 0x1d, 0x2a, 0x40, 0xef, 0x76, 0xfb, 0xe1, 0x22, 0x92, 0x3d, 0xa6, 0x75, 0x6d,
+		0x53, 0x5f, 0x6f, 0xaf, 0xab, 0xee, 0x3e, 0x74, 0x96, 0x2a, 0xfc, 0x83, 0xbc, 0x62, 0x29, 0x66,
+		0x3b, 0x3a, 0x27, 0x8a, 0xcd, 0x9c, 0x07, 0x44, 0xed, 0xc7, 0x5a, 0xd3, 0x70, 0xcf, 0x4c, 0xf1,
+		0xad, 0x8a, 0x83, 0xa4, 0x66, 0xbe, 0x69, 0xd8, 0xa7, 0x99, 0xd0, 0x1a, 0x8c, 0xd9, 0xf9, 0x01,
+		0x14, 0x4e, 0x5d, 0xa3, 0x49, 0xa5, 0xcc, 0x71, 0xb9, 0x15, 0xf3, 0x3c, 0xa7, 0x37, 0xa1, 0x96,
+		0x25, 0xa2, 0x52, 0x0f, 0x66, 0xe4, 0x91, 0xb0, 0x1c, 0x3d, 0x07, 0x87, 0xd7, 0x9a, 0x75, 0x32,
+		0xfa, 0xc1, 0x33, 0x5d, 0x7c, 0x7d, 0x9b, 0x61, 0xd5, 0xfe, 0x13, 0x57, 0xf2, 0xcf, 0x48, 0x70,
+		0x32, 0x76, 0x90, 0xb8, 0x75, 0xf3, 0xc4, 0xbb, 0x60, 0x87, 0xf1, 0x2e, 0xd1, 0xde, 0x3a, 0x91,
+		0x33, 0x96, 0xa4, 0x80, 0xd9, 0x84, 0x3b, 0xa0, 0x38, 0xff, 0xe5, 0xdf, 0x4b, 0x41, 0x96, 0xe5,
+		0x8a, 0xbf, 0x5f, 0x66, 0x45, 0xe0, 0x5e, 0xef, 0x6c, 0xf4, 0xbd, 0xde, 0xb9, 0xa8, 0xc7, 0x14,
+		0x66, 0x62, 0x27, 0xd3, 0x2d, 0xc1, 0x7b, 0x61, 0xf6, 0xcd, 0x97, 0xf3, 0xfe, 0x9b, 0x2f, 0xaf,
+		0xc0, 0x08, 0xde, 0x6c, 0xd1, 0x88, 0xb8, 0x4a, 0x59, 0xed, 0x44, 0x40, 0x97, 0x9c, 0xb7, 0xd2,
+		0x69, 0x6d, 0x19, 0x6f, 0x5a, 0xf6, 0xf5, 0x53, 0x7f, 0xb1, 0x1f, 0xce, 0x72, 0x96, 0xea, 0xd6,
+		0xa3, 0x0f, 0xb6, 0xbd, 0x3e, 0xd8, 0xf6, 0xea, 0x7a, 0xdb, 0x2b, 0x30, 0x07, 0x1a, 0xa1, 0x39,
+		0x30, 0x01, 0xfd, 0x1c, 0x80, 0x8d, 0x00, 0x7b, 0xe3, 0x97, 0x23, 0x95, 0xe8, 0x38, 0x8c, 0xb9,
+		0x52, 0xfd, 0xa6, 0xe4, 0x17, 0xeb, 0x42, 0x60, 0x57, 0xed, 0x2d, 0x69, 0x57, 0xb6, 0xd5, 0xde,
+		0x8e, 0xdb, 0x56, 0x7b, 0x67, 0x27, 0xdb, 0x6a, 0x3f, 0xb3, 0x6b, 0xdb, 0x6a, 0x5d, 0x2c, 0x31,
+		0xa3, 0x77, 0xce, 0xbe, 0x18, 0xb7, 0x73, 0xf6, 0x53, 0x29, 0x98, 0x70, 0x0e, 0x74, 0xbd, 0x5f,
+		0x94, 0xaa, 0xeb, 0x23, 0x66, 0xa3, 0x7c, 0xc4, 0x5c, 0xb4, 0x47, 0x15, 0xd4, 0xa7, 0x19, 0xd8,
+		0x4b, 0x03, 0xba, 0x3c, 0x38, 0xcc, 0xfe, 0x08, 0x72, 0x01, 0xe6, 0x45, 0xb9, 0x00, 0x5f, 0x96,
+		0x60, 0x7c, 0x49, 0x6b, 0x9b, 0xef, 0x7b, 0xde, 0xc4, 0xad, 0xac, 0x7e, 0x41, 0x82, 0x2c, 0x09,
+		0x24, 0xaf, 0xe3, 0x6f, 0x03, 0x67, 0x52, 0xfe, 0x7f, 0x12, 0x8c, 0x2b, 0xd8, 0xc4, 0xd6, 0xfb,
+		0x9d, 0xe5, 0xd7, 0x60, 0xd4, 0x09, 0xa5, 0xaf, 0xe9, 0x0d, 0xdd, 0x7c, 0x14, 0xdc, 0xa5, 0x3c,
+		0x64, 0xd7, 0xcf, 0xd3, 0x6a, 0x7b, 0xeb, 0xd1, 0xaf, 0xb4, 0x67, 0x82, 0xee, 0xf2, 0x35, 0xc8,
+		0x46, 0xf5, 0x3f, 0x7e, 0x37, 0xfb, 0xe3, 0x3d, 0x30, 0x41, 0x6c, 0xdd, 0x62, 0x0b, 0x37, 0x42,
+		0xc8, 0x66, 0x27, 0xee, 0x09, 0x1d, 0xe4, 0x4c, 0x62, 0x07, 0x39, 0x2b, 0x72, 0xe6, 0x96, 0x78,
+		0x90, 0x93, 0x1d, 0x37, 0x60, 0x0e, 0x2f, 0x37, 0xdc, 0xa7, 0xa2, 0x0f, 0xb3, 0xbb, 0x27, 0xae,
+		0x94, 0x83, 0xa6, 0xbf, 0x00, 0xdd, 0x87, 0xb4, 0xe7, 0xe4, 0x25, 0x6b, 0x90, 0xed, 0x2c, 0x5d,
+		0x48, 0x78, 0x2f, 0x22, 0x6f, 0x18, 0xfb, 0x0b, 0xd0, 0x1d, 0xe8, 0x23, 0xae, 0x89, 0xdd, 0x26,
+		0xdb, 0xcb, 0x3d, 0xdb, 0xd1, 0x43, 0xe1, 0xcd, 0x81, 0xe5, 0xfc, 0x96, 0x7f, 0x42, 0x02, 0x39,
+		0x6e, 0x18, 0xf8, 0x20, 0x7e, 0x08, 0xc0, 0xa1, 0xc1, 0x4e, 0x5b, 0x9d, 0x4c, 0xd6, 0x07, 0x96,
+		0x1e, 0xe6, 0x62, 0x27, 0x4e, 0x5c, 0x7d, 0x67, 0x0f, 0x9c, 0x24, 0xa4, 0x51, 0x37, 0xa3, 0xf6,
+		0x81, 0x8c, 0x3c, 0x2b, 0x19, 0x41, 0xaf, 0xc1, 0x00, 0xcb, 0xf2, 0xb6, 0xdb, 0x9a, 0xa7, 0xde,
+		0xd8, 0xe5, 0x84, 0x97, 0x6b, 0x90, 0xe1, 0xe3, 0x99, 0xdf, 0xfd, 0xac, 0x1d, 0xde, 0xee, 0xcb,
+		0xd0, 0x47, 0x1c, 0x13, 0xbb, 0xd5, 0xa5, 0x98, 0x4c, 0x43, 0xf7, 0x48, 0xa4, 0x02, 0xeb, 0xce,
+		0x6f, 0xf9, 0x33, 0x12, 0x9c, 0x8a, 0x17, 0x91, 0x67, 0x28, 0xbf, 0xbf, 0x23, 0xc1, 0x38, 0x21,
+		0xae, 0x7b, 0xc9, 0xf5, 0xa5, 0x9f, 0x67, 0x3a, 0xa7, 0x9f, 0x0b, 0x45, 0x35, 0x63, 0xe7, 0x62,
+		0x30, 0x0b, 0xcb, 0xfe, 0x90, 0x0b, 0x7b, 0xcd, 0xba, 0x5e, 0xc5, 0xb6, 0x32, 0xdf, 0xab, 0xec,
+		0xa7, 0xff, 0x59, 0x90, 0x88, 0x55, 0xd1, 0x44, 0x12, 0xbe, 0x82, 0x00, 0x5a, 0x44, 0x33, 0x48,
+		0xe4, 0xbf, 0x23, 0x41, 0x36, 0xaa, 0x43, 0xcf, 0x90, 0xcf, 0x9f, 0x91, 0xe0, 0x34, 0x21, 0x2b,
+		0xcf, 0x57, 0xde, 0xef, 0x27, 0x7e, 0xcb, 0x3f, 0x29, 0xc1, 0x99, 0x4e, 0xc4, 0x3d, 0x43, 0xde,
+		0x95, 0x21, 0x4b, 0xc7, 0xb6, 0x7b, 0x9e, 0x39, 0xdd, 0xcd, 0x78, 0xbb, 0x7b, 0x0d, 0x8e, 0x47,
+		0xb6, 0xc7, 0xbb, 0x49, 0x1e, 0x10, 0xa5, 0x02, 0xc6, 0xce, 0x23, 0xb3, 0x3f, 0xf2, 0x57, 0x25,
+		0x38, 0xfa, 0x0a, 0xb6, 0x42, 0x2b, 0x0e, 0x1b, 0xab, 0x0c, 0x7b, 0x1e, 0xe3, 0x2d, 0x9b, 0x2d,
+		0x2f, 0x08, 0xd9, 0x12, 0x83, 0x3f, 0x75, 0x17, 0x6f, 0xf1, 0x73, 0x90, 0xb4, 0x9d, 0x23, 0xdf,
+		0x0d, 0xbd, 0x4e, 0x91, 0xe0, 0xc0, 0xc8, 0x4d, 0xef, 0x81, 0x91, 0xc1, 0x88, 0xe5, 0x51, 0xa9,
+		0x51, 0xc3, 0x9b, 0xb8, 0x46, 0x8f, 0xfe, 0xd1, 0x00, 0x80, 0xe7, 0x5c, 0xc9, 0x0b, 0x70, 0x42,
+		0x40, 0x0e, 0x59, 0xbb, 0xad, 0x6b, 0x1d, 0x58, 0x2b, 0x7f, 0x3d, 0x05, 0x13, 0x31, 0xc8, 0x9c,
+		0x23, 0x15, 0x1f, 0x47, 0x5e, 0x4e, 0xca, 0x11, 0x7f, 0x2b, 0x41, 0xbe, 0xa0, 0x69, 0xc8, 0x34,
+		0xda, 0xeb, 0xab, 0xd8, 0x20, 0xbb, 0xa2, 0xa4, 0x44, 0xad, 0xeb, 0xeb, 0xba, 0xc5, 0x67, 0xc5,
+		0x10, 0xab, 0x5b, 0x5c, 0x23, 0x68, 0x0b, 0xa4, 0x02, 0x5d, 0x84, 0x61, 0x32, 0x6d, 0x08, 0x38,
+		0xed, 0x3d, 0x87, 0x67, 0xc1, 0xca, 0x34, 0xa9, 0x5a, 0x5c, 0xa3, 0x1c, 0x62, 0xe0, 0x39, 0x48,
+		0x5b, 0x4d, 0x8b, 0x9c, 0x1b, 0xd2, 0xdf, 0xb0, 0x61, 0xd9, 0xbe, 0xde, 0x20, 0x2d, 0x27, 0x53,
+		0x8e, 0x42, 0x3e, 0xf5, 0x11, 0xfa, 0x64, 0x0a, 0x32, 0x34, 0x87, 0xc5, 0x96, 0xd5, 0xf7, 0x26,
+		0xb4, 0xeb, 0xa4, 0xc8, 0x65, 0xbb, 0x4d, 0x91, 0x53, 0x61, 0xc4, 0xce, 0xd2, 0xf9, 0x1e, 0x5c,
+		0xb5, 0x48, 0xc4, 0xb4, 0xa6, 0x3b, 0xc7, 0x21, 0x06, 0x23, 0xce, 0xbe, 0xb3, 0x26, 0x28, 0xc6,
+		0x9c, 0x8d, 0xa0, 0x64, 0x3e, 0x2a, 0x28, 0x95, 0x31, 0x0c, 0x78, 0xa0, 0x71, 0x0d, 0x55, 0xa0,
+		0x9f, 0xc5, 0x82, 0xf8, 0x21, 0x2f, 0xd8, 0xae, 0xdd, 0xef, 0xab, 0xba, 0x7f, 0xe4, 0xbf, 0x29,
+		0xc1, 0xa1, 0x00, 0xe3, 0xb9, 0x48, 0x4f, 0x40, 0xbf, 0x37, 0x0f, 0x89, 0xef, 0x98, 0xf5, 0x79,
+		0x92, 0x8b, 0x50, 0x09, 0x06, 0xbd, 0x4c, 0xc0, 0xb5, 0xd1, 0x4c, 0x0c, 0x1f, 0x7d, 0xdd, 0x51,
+		0x06, 0x3e, 0xea, 0xfd, 0x2b, 0xdf, 0x83, 0x01, 0x1f, 0x9f, 0xc9, 0x52, 0xc7, 0xcd, 0x5b, 0xe2,
+		0x83, 0xdf, 0xeb, 0xa4, 0x2d, 0xb9, 0xd5, 0x9a, 0xf1, 0xd0, 0xde, 0xb1, 0x63, 0xd5, 0x79, 0xe3,
+		0xa1, 0x29, 0xbf, 0x01, 0x47, 0xe8, 0x4a, 0x88, 0xa5, 0x87, 0x39, 0x41, 0xbd, 0xf7, 0x42, 0xa8,
+		0xe4, 0x71, 0x38, 0x2a, 0xfc, 0x36, 0xe3, 0xab, 0xfc, 0x5f, 0xdd, 0xfd, 0x53, 0xda, 0xd3, 0xed,
+		0xec, 0xdb, 0x2a, 0x30, 0xe8, 0x5e, 0x47, 0x45, 0x19, 0x94, 0x89, 0xd9, 0x06, 0x08, 0x7f, 0x86,
+		0x4e, 0xbf, 0x81, 0xaa, 0xf7, 0x6f, 0x68, 0xbc, 0xb3, 0xe1, 0xf1, 0x26, 0xb7, 0x58, 0x18, 0x46,
+		0xd3, 0x50, 0xd7, 0xb1, 0x69, 0x6a, 0x0f, 0xed, 0x33, 0xb4, 0xfd, 0xb4, 0xf0, 0x1e, 0x2b, 0x93,
+		0xff, 0xb9, 0x04, 0xc3, 0x82, 0xb4, 0x34, 0x54, 0x84, 0x3e, 0xd6, 0xb2, 0xf7, 0x11, 0x8d, 0x53,
+		0x71, 0x92, 0x42, 0x80, 0x29, 0xa5, 0x60, 0x38, 0xbf, 0xc9, 0xd8, 0x69, 0x0d, 0x73, 0x03, 0x1b,
+		0xf6, 0x96, 0x35, 0xfb, 0x47, 0xc8, 0x67, 0xb4, 0xf9, 0x56, 0xdc, 0x7d, 0xb4, 0x4c, 0xa1, 0x45,
+		0x2e, 0xf9, 0xfe, 0x58, 0x10, 0xc3, 0xe3, 0x99, 0x21, 0xf2, 0xc7, 0x24, 0x38, 0x61, 0x1f, 0xb0,
+		0xeb, 0x3a, 0x8e, 0xb0, 0x3b, 0x02, 0xf4, 0xd5, 0xbd, 0x30, 0xbc, 0xe4, 0x39, 0x96, 0x4c, 0x76,
+		0x05, 0xc9, 0x51, 0x9e, 0xc0, 0xc6, 0x21, 0x74, 0x4e, 0x79, 0xca, 0x6c, 0x2f, 0xe5, 0xe9, 0x25,
+		0xd8, 0xeb, 0x3d, 0x81, 0x2d, 0xd6, 0x65, 0x01, 0x0a, 0xd9, 0x11, 0x6c, 0x86, 0x47, 0x52, 0x94,
+		0xc2, 0xc9, 0x38, 0xb9, 0x88, 0x5c, 0x9c, 0xeb, 0x30, 0x5a, 0xd7, 0x4c, 0x4b, 0xf5, 0xe7, 0x18,
+		0xb1, 0xb4, 0x26, 0x96, 0x81, 0x3d, 0x42, 0xea, 0xef, 0x78, 0x33, 0x8c, 0x68, 0x2d, 0xba, 0x0a,
+		0xb4, 0x46, 0x0d, 0xa7, 0x43, 0xb1, 0x53, 0x79, 0x19, 0x52, 0xbb, 0x1c, 0x4c, 0x89, 0xf2, 0xa4,
+		0x24, 0xcd, 0xfb, 0x53, 0x92, 0xce, 0x41, 0xda, 0x5e, 0xa4, 0xf2, 0x22, 0x3b, 0x25, 0xcb, 0x5e,
+		0xa3, 0xf2, 0x6c, 0x23, 0x33, 0x2a, 0xff, 0xeb, 0x41, 0x64, 0xfe, 0xd7, 0x65, 0xc8, 0xe0, 0xcd,
+		0x96, 0xce, 0xce, 0x34, 0x85, 0x92, 0x9e, 0x87, 0xdd, 0x3a, 0x17, 0x65, 0x0a, 0x86, 0x69, 0xf7,
+		0xc8, 0x29, 0xa7, 0xb6, 0x81, 0x6d, 0xc9, 0x66, 0x61, 0xf2, 0x21, 0x52, 0x35, 0xcf, 0x6a, 0xb8,
+		0x7c, 0x5f, 0x02, 0xda, 0x61, 0x95, 0x67, 0x28, 0x3b, 0x71, 0xb2, 0x4d, 0x8a, 0x80, 0x48, 0xdd,
+		0x7d, 0x5a, 0x55, 0xe2, 0x35, 0x84, 0x28, 0xdf, 0x17, 0xec, 0xa1, 0xe2, 0x91, 0x74, 0xe4, 0xf9,
+		0x86, 0x3d, 0x5a, 0x97, 0x21, 0x43, 0x9d, 0x59, 0x16, 0x59, 0x77, 0xfb, 0xf1, 0x16, 0xcb, 0xd3,
+		0x42, 0xa4, 0x92, 0xc6, 0xd2, 0x9d, 0x7e, 0xc8, 0xdf, 0x92, 0x60, 0x8c, 0x4b, 0x0b, 0xbd, 0x49,
+		0xcc, 0xe7, 0x29, 0x6f, 0xf7, 0x16, 0x1f, 0x12, 0x51, 0xf0, 0x6e, 0x0d, 0x79, 0xb7, 0xd1, 0x0e,
+		0x7a, 0xf6, 0x7e, 0xec, 0x7d, 0x04, 0xf7, 0xee, 0x30, 0x27, 0xc8, 0xd6, 0xe7, 0x94, 0x95, 0x6a,
+		0x51, 0x0f, 0x2c, 0xce, 0xec, 0xf0, 0x81, 0x45, 0xf9, 0xb7, 0xf7, 0xc0, 0x44, 0x8c, 0x3e, 0xe1,
+		0xc6, 0xb6, 0x0e, 0x87, 0x7d, 0x09, 0xd4, 0x9e, 0x33, 0x75, 0x10, 0x73, 0xa6, 0x4e, 0x74, 0x79,
+		0xa6, 0x8b, 0xaa, 0x8c, 0x60, 0x61, 0x39, 0x5a, 0x85, 0xc3, 0xc2, 0xdb, 0xd5, 0x9c, 0x33, 0xa7,
+		0xdd, 0xac, 0x74, 0x0e, 0x6d, 0x88, 0x8a, 0xd1, 0x7d, 0x40, 0xbe, 0xdb, 0x17, 0x74, 0x4b, 0xc7,
+		0xe6, 0x68, 0x36, 0x26, 0x97, 0x59, 0xa0, 0xf2, 0x94, 0xa1, 0x96, 0xaf, 0x50, 0xc7, 0x26, 0x7a,
+		0x1d, 0xd2, 0x76, 0xc3, 0xf4, 0x9a, 0x39, 0x83, 0xee, 0xb1, 0x47, 0x5f, 0xc2, 0x12, 0x29, 0x79,
+		0xca, 0xc1, 0x96, 0xa7, 0xca, 0x60, 0x69, 0x49, 0x2d, 0xad, 0x6d, 0xe2, 0x1a, 0xdf, 0x89, 0xe7,
+		0xff, 0x50, 0x05, 0x0e, 0xfa, 0xf6, 0xfa, 0x2c, 0xfb, 0x45, 0x95, 0xf3, 0x89, 0x1f, 0xa4, 0xb1,
+		0x4c, 0x65, 0x10, 0xfb, 0xfe, 0x47, 0x3f, 0xe9, 0x3a, 0x1f, 0xb3, 0xed, 0xf2, 0x83, 0x12, 0x9c,
+		0x99, 0xd5, 0xac, 0xea, 0xa3, 0x48, 0x99, 0xea, 0xb8, 0x58, 0x9c, 0xf7, 0x2d, 0x6d, 0x59, 0xaa,
+		0x7f, 0x52, 0x2b, 0xe5, 0xc1, 0x94, 0x7f, 0x38, 0x05, 0xa3, 0xe1, 0xae, 0xb6, 0xd7, 0xd7, 0xc9,
+		0x61, 0x5b, 0x9f, 0x25, 0x84, 0xed, 0xfa, 0xe7, 0xaf, 0xc2, 0xe0, 0x8e, 0xe5, 0x73, 0x00, 0x7b,
+		0xff, 0xa2, 0x59, 0x60, 0xfe, 0x2f, 0x4f, 0xb9, 0x88, 0xdb, 0x4f, 0xf6, 0xdd, 0x47, 0x06, 0x14,
+		0x8b, 0xfe, 0x26, 0xab, 0x66, 0xea, 0x33, 0xd8, 0xd1, 0x05, 0xfa, 0x47, 0xde, 0x84, 0xb3, 0x1d,
+		0x47, 0x86, 0x4f, 0xf7, 0x7b, 0x82, 0xe8, 0xc2, 0xc5, 0x84, 0xb2, 0xc4, 0x18, 0xec, 0x1b, 0x89,
+		0xdf, 0x4a, 0xc1, 0x38, 0xfd, 0xf4, 0x22, 0xbd, 0xaf, 0x87, 0x24, 0x1f, 0x26, 0x5c, 0x46, 0x09,
+		0x03, 0x07, 0xa8, 0x0c, 0x83, 0x4d, 0xbb, 0x25, 0xef, 0x9b, 0x50, 0x67, 0x23, 0x6e, 0x1e, 0xf2,
+		0x7e, 0x99, 0xb9, 0x96, 0x4d, 0xef, 0x5f, 0xcf, 0x3e, 0x48, 0x2e, 0x72, 0xeb, 0xa9, 0xcb, 0x64,
+		0x86, 0xc0, 0xd6, 0xf1, 0x7c, 0xc7, 0xad, 0xe3, 0xa5, 0xf0, 0xd6, 0x71, 0x1a, 0x7a, 0x8c, 0x16,
+		0x7b, 0x51, 0x67, 0xaf, 0x42, 0x7e, 0x92, 0x0d, 0x94, 0x28, 0x3e, 0xba, 0x1b, 0x28, 0xdf, 0xd3,
+		0x5c, 0xf5, 0x6c, 0xa0, 0x7c, 0x4f, 0x73, 0xb5, 0x54, 0x93, 0xcb, 0x30, 0x6e, 0x0f, 0xbb, 0xbf,
+		0x81, 0x4e, 0x03, 0xe0, 0xb6, 0x97, 0xf1, 0xb6, 0xf7, 0xf5, 0x1e, 0xc8, 0x46, 0x35, 0x18, 0x4b,
+		0x09, 0x7a, 0xd1, 0xf6, 0xdd, 0x32, 0x31, 0xb7, 0xe7, 0xf8, 0x9b, 0xf4, 0xb9, 0x6e, 0xbb, 0x3d,
+		0xf6, 0xe2, 0xc8, 0x67, 0x54, 0x32, 0x5f, 0x5c, 0x02, 0xee, 0x38, 0x80, 0x1b, 0xee, 0xe7, 0x99,
+		0xf6, 0xbd, 0x4e, 0x04, 0x9f, 0x54, 0xbb, 0xb9, 0x12, 0x3c, 0x71, 0xa5, 0xb7, 0x6a, 0x67, 0x45,
+		0x90, 0x6d, 0x5b, 0x16, 0xeb, 0xc0, 0xa6, 0xa5, 0xaf, 0x13, 0x06, 0x31, 0x5f, 0x6d, 0x80, 0x96,
+		0x16, 0x79, 0x21, 0x59, 0x23, 0x98, 0xed, 0x6a, 0x15, 0x9b, 0x26, 0x8f, 0xbc, 0x32, 0xff, 0xac,
+		0x9f, 0x17, 0xd2, 0x78, 0x1a, 0x11, 0x3e, 0xb6, 0x90, 0x60, 0x20, 0xec, 0x20, 0x1a, 0xd0, 0x22,
+		0x06, 0x70, 0x1a, 0x06, 0x6d, 0x97, 0x8a, 0x77, 0x93, 0xf9, 0x60, 0x03, 0x6b, 0x5e, 0x87, 0x4d,
+		0xfe, 0x2d, 0x89, 0x6d, 0xbb, 0x85, 0xcd, 0xf8, 0x23, 0x4d, 0xef, 0x28, 0x3a, 0xbb, 0x13, 0x02,
+		0xf1, 0x85, 0x5b, 0xb3, 0x9d, 0xc3, 0xad, 0xa2, 0x74, 0x36, 0xf9, 0x9f, 0xa4, 0xe0, 0xa8, 0xb8,
+		0x13, 0x2c, 0x62, 0x14, 0xd6, 0xe3, 0xb0, 0x53, 0x3d, 0x7e, 0x13, 0x8e, 0xb8, 0x59, 0x6a, 0x6e,
+		0xe3, 0x3e, 0x4f, 0xf1, 0xb0, 0x03, 0xe1, 0x76, 0x9d, 0xfa, 0x8e, 0x25, 0xe8, 0xe5, 0xbe, 0x5f,
+		0xd3, 0xe0, 0xf2, 0x2d, 0x36, 0xe5, 0x76, 0xaa, 0x1b, 0xcd, 0x74, 0x2b, 0xd9, 0x28, 0x8a, 0x8b,
+		0x4d, 0x8e, 0x9c, 0x36, 0x0d, 0xfd, 0xa1, 0xce, 0xee, 0x7a, 0x0d, 0x90, 0xc1, 0x64, 0x7e, 0xc4,
+		0x06, 0xf0, 0x53, 0x21, 0xff, 0x47, 0xbe, 0xdd, 0x17, 0x35, 0xfc, 0x7c, 0xa2, 0x5f, 0x81, 0x91,
+		0x35, 0xdd, 0x30, 0xad, 0x70, 0xf3, 0x4c, 0x1e, 0x86, 0x69, 0x6d, 0xa0, 0x87, 0x4b, 0x02, 0x23,
+		0x7f, 0x29, 0xa1, 0x0f, 0xe9, 0x8c, 0x5b, 0xa7, 0x28, 0xb6, 0x28, 0xf4, 0x2e, 0xff, 0xa9, 0x04,
+		0x87, 0x6d, 0xd5, 0x95, 0x34, 0xf0, 0xb2, 0x93, 0xf3, 0x50, 0xaf, 0xc0, 0xa0, 0x83, 0xeb, 0x55,
+		0x58, 0x13, 0xb1, 0x0d, 0xb0, 0x55, 0xaf, 0xe5, 0xf9, 0x47, 0x76, 0xe5, 0xf5, 0x46, 0xb5, 0xde,
+		0xae, 0x61, 0xf7, 0x70, 0xa7, 0x1d, 0x6c, 0xcb, 0xb1, 0xe3, 0x81, 0xbc, 0xde, 0x6e, 0x84, 0xc7,
+		0xd0, 0xfe, 0xaf, 0x04, 0xa3, 0xe1, 0x1e, 0xf3, 0xd1, 0xbb, 0x01, 0xfb, 0x5b, 0xcd, 0x7a, 0x1d,
+		0x1b, 0xb6, 0x9d, 0x3f, 0x1e, 0x79, 0x60, 0x0e, 0x1b, 0x54, 0xd0, 0x6d, 0x78, 0x74, 0x0f, 0xd2,
+		0x21, 0x42, 0x18, 0x73, 0x4e, 0xc6, 0xf6, 0x8d, 0xc7, 0xf9, 0x06, 0x2d, 0xdf, 0x7f, 0xb2, 0xb9,
+		0xd9, 0xd2, 0x0c, 0x4b, 0xf7, 0xac, 0x31, 0x46, 0xb3, 0x31, 0x9b, 0x9b, 0x76, 0x73, 0x4b, 0x36,
+		0x12, 0x5b, 0x44, 0x28, 0x07, 0x5b, 0xfe, 0x02, 0xf9, 0xc7, 0x25, 0x38, 0x1c, 0x01, 0xec, 0x7d,
+		0x1b, 0x12, 0xfc, 0x6f, 0x43, 0x4e, 0xc1, 0x70, 0xa3, 0xbd, 0x4e, 0xf4, 0x63, 0x4d, 0x75, 0x5a,
+		0x34, 0x3d, 0xb1, 0x6d, 0x05, 0x6b, 0x35, 0xa7, 0x39, 0x7a, 0xf7, 0x24, 0x81, 0xdf, 0x30, 0x74,
+		0x0b, 0x7b, 0x11, 0x98, 0xce, 0x42, 0x8d, 0xf6, 0xfa, 0x7d, 0x52, 0xe5, 0x62, 0xc8, 0x7f, 0x22,
+		0xc1, 0xa0, 0x9f, 0x27, 0x11, 0x27, 0xb4, 0x21, 0xe2, 0x84, 0x36, 0xf5, 0x41, 0xb4, 0x9a, 0x5a,
+		0xc7, 0x4f, 0x70, 0xdd, 0x7e, 0x36, 0x93, 0x94, 0x2c, 0x90, 0x02, 0xa2, 0x3a, 0xb5, 0xea, 0x63,
+		0x5e, 0xcb, 0x2e, 0x43, 0x3c, 0xa0, 0x55, 0x1f, 0xb3, 0xca, 0x33, 0x70, 0xd0, 0xd0, 0x08, 0xa5,
+		0xd8, 0xe0, 0x59, 0x7b, 0xf4, 0x71, 0x4c, 0x49, 0x19, 0x20, 0xc5, 0x4b, 0xd8, 0x60, 0x49, 0x7a,
+		0xa8, 0x00, 0x03, 0xfc, 0x89, 0x4c, 0x75, 0xb5, 0xde, 0xac, 0x3e, 0x1e, 0xcd, 0xc5, 0x24, 0xa9,
+		0x91, 0xde, 0x94, 0x0a, 0xb3, 0x04, 0x4e, 0xe9, 0x63, 0x4f, 0x69, 0xd2, 0x3f, 0xf2, 0x4b, 0xd0,
+		0xe7, 0xa9, 0xa3, 0x1b, 0x8b, 0xd4, 0x54, 0xba, 0x2f, 0x81, 0xd2, 0xff, 0x6c, 0x35, 0x8d, 0x1b,
+		0x35, 0xf7, 0x69, 0xf0, 0xbd, 0xb8, 0x51, 0x2b, 0xd5, 0xe4, 0x37, 0x00, 0x5c, 0x11, 0x24, 0x1b,
+		0x03, 0x34, 0x2e, 0xa0, 0x31, 0x4b, 0xe8, 0xb9, 0xb1, 0x76, 0x90, 0x94, 0xe7, 0x69, 0x31, 0x35,
+		0xab, 0x5e, 0x83, 0x9d, 0x09, 0x18, 0x6c, 0x01, 0x0b, 0xb2, 0x02, 0x16, 0xc8, 0xff, 0x33, 0x05,
+		0x7d, 0x9e, 0xf4, 0x3b, 0x72, 0x71, 0x9f, 0x7d, 0xd1, 0xa1, 0xde, 0xb0, 0xb0, 0xf1, 0x84, 0xfe,
+		0x70, 0x72, 0x1f, 0xd9, 0x15, 0x60, 0xf6, 0x5d, 0x88, 0x25, 0x0e, 0x51, 0x6a, 0xd8, 0x59, 0x8f,
+		0xd3, 0x30, 0x4c, 0x46, 0xb2, 0xb9, 0xb6, 0xa6, 0x56, 0x9b, 0x78, 0x6d, 0x4d, 0xaf, 0xea, 0xc4,
+		0xd3, 0xcf, 0xd0, 0x4f, 0x23, 0x5e, 0x35, 0xe7, 0xd6, 0x90, 0xef, 0xd9, 0x61, 0x1f, 0xd1, 0xf7,
+		0x98, 0x80, 0x8d, 0x72, 0x90, 0xf0, 0xf7, 0x44, 0x51, 0xa3, 0x9c, 0x38, 0x6a, 0x74, 0x1b, 0x8e,
+		0x36, 0xd8, 0x9d, 0x7e, 0x86, 0xae, 0xad, 0xd6, 0xb1, 0xea, 0x0d, 0x59, 0x9a, 0xf4, 0xda, 0xdc,
+		0x5e, 0x65, 0xb4, 0x41, 0x2f, 0xf1, 0x63, 0x10, 0x45, 0x37, 0x7e, 0x49, 0x1e, 0x22, 0xce, 0x7a,
+		0x62, 0x48, 0x22, 0x5a, 0xd9, 0x3e, 0xf1, 0x51, 0x17, 0x2a, 0x44, 0xee, 0xe4, 0xdf, 0x4a, 0xc1,
+		0x21, 0x61, 0x96, 0x26, 0x3a, 0x05, 0x27, 0xee, 0x2f, 0x2a, 0x77, 0xe7, 0x17, 0x16, 0xef, 0xab,
+		0xa5, 0x82, 0xaa, 0x14, 0x57, 0x96, 0x8b, 0xea, 0xd2, 0xe2, 0x42, 0x69, 0xee, 0x75, 0xb5, 0x54,
+		0x7e, 0x2d, 0xbf, 0x50, 0x2a, 0xa4, 0xff, 0x0a, 0xba, 0x0e, 0x57, 0x23, 0xa1, 0xf2, 0x0b, 0xa4,
+		0xb4, 0xb0, 0xb2, 0xb4, 0x50, 0x9a, 0xcb, 0x57, 0x8a, 0xea, 0x7c, 0xbe, 0xb4, 0x50, 0x2c, 0xa8,
+		0x8b, 0xe5, 0x85, 0xd7, 0xd3, 0x12, 0xba, 0x00, 0xb9, 0xa4, 0x98, 0xe9, 0x14, 0xba, 0x08, 0xe7,
+		0x22, 0xa1, 0x95, 0xe2, 0x87, 0x8a, 0x73, 0x15, 0x0f, 0x78, 0x0f, 0xba, 0x0c, 0x17, 0x23, 0xc1,
+		0x2b, 0x45, 0xe5, 0x5e, 0xa9, 0x4c, 0x08, 0x2a, 0xcd, 0xab, 0xca, 0x4a, 0xb9, 0x5c, 0x2a, 0xbf,
+		0x92, 0xde, 0x33, 0xf9, 0x31, 0x09, 0xfa, 0xbd, 0x37, 0x21, 0xa3, 0x31, 0x38, 0x54, 0x58, 0xbc,
+		0x97, 0x2f, 0x95, 0xd5, 0xe5, 0x4a, 0xbe, 0xb2, 0xb2, 0xec, 0xe9, 0xf5, 0x31, 0x18, 0xf5, 0x57,
+		0x29, 0xc5, 0x57, 0x4a, 0xcb, 0x95, 0xa2, 0x52, 0x2c, 0xa4, 0xa5, 0x70, 0x6d, 0xa1, 0xb8, 0xa4,
+		0x14, 0x09, 0x65, 0x85, 0x74, 0x2a, 0xdc, 0x6c, 0xa1, 0xb8, 0x50, 0x24, 0x55, 0x3d, 0x93, 0xef,
+		0x4a, 0xf6, 0x25, 0xc5, 0xbe, 0xab, 0x2c, 0xd1, 0x04, 0x8c, 0x73, 0x14, 0xc2, 0xc2, 0xc5, 0xd7,
+		0x8a, 0x0a, 0xc5, 0x2d, 0x7a, 0x28, 0x3a, 0x01, 0xc7, 0xc4, 0x20, 0xf9, 0xb9, 0x4a, 0xe9, 0xb5,
+		0x62, 0x5a, 0x42, 0x32, 0x64, 0xc5, 0x10, 0x77, 0xf2, 0xe5, 0x02, 0xf9, 0x9b, 0x4e, 0x45, 0xc3,
+		0x2c, 0xdf, 0x2f, 0x55, 0xe6, 0xee, 0x50, 0x22, 0x7f, 0x51, 0x82, 0x3e, 0xcf, 0xbb, 0xd6, 0x68,
+		0x14, 0x32, 0x95, 0xd2, 0xbd, 0xe2, 0xe2, 0x4a, 0x45, 0xad, 0xbc, 0xbe, 0xe4, 0xa5, 0xe9, 0x38,
+		0x1c, 0xf5, 0xd5, 0x2c, 0x57, 0xf2, 0x4a, 0x45, 0xad, 0x2c, 0xaa, 0x73, 0x0b, 0x8b, 0xcb, 0x9c,
+		0x24, 0x3f, 0x00, 0xf9, 0xc4, 0xca, 0x42, 0x91, 0xc0, 0x50, 0xe0, 0x74, 0x2a, 0x16, 0x86, 0xb5,
+		0xd3, 0x83, 0x8e, 0xc0, 0x88, 0x0f, 0xe6, 0x4e, 0x31, 0xaf, 0x54, 0x66, 0x8b, 0xf9, 0x4a, 0x7a,
+		0xcf, 0xe4, 0x3b, 0x12, 0x0c, 0x85, 0x42, 0x73, 0x84, 0xb4, 0xa5, 0xbc, 0x52, 0x2c, 0x57, 0x58,
+		0x1b, 0x61, 0xb9, 0x8e, 0x00, 0xc8, 0xcf, 0x12, 0x66, 0x95, 0xd3, 0x12, 0x3a, 0x03, 0xb2, 0x08,
+		0x40, 0x29, 0xbe, 0xba, 0x52, 0x5c, 0xae, 0xa8, 0x73, 0xf9, 0xf2, 0x5c, 0x71, 0x21, 0x9d, 0x22,
+		0x63, 0x27, 0x82, 0x73, 0x84, 0x30, 0xdd, 0x33, 0xf9, 0xe7, 0x7b, 0xa0, 0xdf, 0x39, 0x8f, 0x4e,
+		0x58, 0x4a, 0x44, 0xa4, 0x38, 0x57, 0x5a, 0x2e, 0x2d, 0x96, 0x83, 0x3c, 0xcd, 0xc1, 0x29, 0x7f,
+		0x95, 0xc3, 0x0f, 0x3a, 0xd0, 0xa5, 0xca, 0xeb, 0x6a, 0x25, 0xbf, 0x7c, 0x37, 0x2d, 0xa1, 0x29,
+		0x98, 0xf4, 0x43, 0xfa, 0x49, 0x0b, 0xc0, 0xa7, 0xd0, 0x38, 0x8c, 0x05, 0x5a, 0x66, 0xc3, 0x55,
+		0xba, 0x57, 0x54, 0xd2, 0x3d, 0x64, 0x02, 0xfa, 0xab, 0xe7, 0x16, 0xef, 0x2d, 0x11, 0xc1, 0x55,
+		0x9d, 0x89, 0x56, 0xfc, 0x70, 0x71, 0x6e, 0xa5, 0x52, 0x5a, 0x2c, 0xa7, 0xf7, 0xa0, 0x73, 0x70,
+		0xda, 0x0f, 0x4e, 0x04, 0x4a, 0x04, 0xba, 0x17, 0x65, 0xe1, 0x48, 0xa0, 0x65, 0x46, 0x20, 0xfb,
+		0xf2, 0x3e, 0x74, 0x1e, 0xce, 0x0a, 0xeb, 0x05, 0x8d, 0xed, 0x47, 0xb7, 0xe0, 0x7a, 0x6c, 0xaf,
+		0x8b, 0x1f, 0xae, 0x14, 0x95, 0x72, 0x5e, 0x88, 0x7d, 0x80, 0x8c, 0x7a, 0x10, 0x7b, 0x6e, 0x51,
+		0x29, 0xa8, 0xf7, 0xf2, 0xca, 0xdd, 0xa2, 0x92, 0xee, 0x45, 0x57, 0xe1, 0x52, 0x90, 0x0b, 0xe5,
+		0x4a, 0xa9, 0xbc, 0x52, 0x54, 0xf3, 0xcb, 0x6a, 0xb9, 0x78, 0x5f, 0xd4, 0x2c, 0xa0, 0x4b, 0x70,
+		0x41, 0xc4, 0xda, 0xb9, 0x3b, 0xa5, 0x85, 0x82, 0x08, 0xa3, 0x2f, 0xfc, 0x9d, 0xe5, 0xd2, 0x2b,
+		0xe5, 0x7c, 0x3c, 0xf9, 0xfd, 0xe8, 0x0a, 0x4c, 0xfb, 0xb1, 0x56, 0x96, 0x96, 0x8b, 0x4a, 0xc5,
+		0x05, 0x5e, 0x2e, 0xe6, 0x95, 0xb9, 0x3b, 0x6a, 0xbe, 0x52, 0x51, 0x4a, 0xb3, 0x2b, 0x95, 0xe2,
+		0x72, 0x7a, 0x60, 0xf2, 0x1b, 0x07, 0xa1, 0xd7, 0x79, 0xc8, 0x1b, 0x8d, 0x00, 0x2a, 0xbe, 0x46,
+		0xa4, 0x35, 0x20, 0x77, 0xe7, 0xe0, 0xb4, 0xa7, 0x3c, 0xfc, 0x75, 0xd6, 0x25, 0xaa, 0xfe, 0xce,
+		0xc3, 0xd9, 0x78, 0x50, 0x5b, 0x72, 0x88, 0x36, 0xcc, 0xc1, 0xa9, 0x78, 0x60, 0x66, 0x34, 0xd2,
+		0x3d, 0x9d, 0x9b, 0x25, 0xf2, 0x52, 0x50, 0x17, 0x57, 0x2a, 0xe9, 0x3d, 0x64, 0x76, 0x7a, 0x80,
+		0x5d, 0xa6, 0xe4, 0x97, 0xef, 0x3a, 0x33, 0xa6, 0x90, 0xde, 0x4b, 0x8c, 0x5c, 0x34, 0x1c, 0xef,
+		0xd1, 0xbe, 0xd8, 0xd6, 0xdc, 0xce, 0xec, 0x8f, 0x85, 0x73, 0xa9, 0x3b, 0x80, 0x4e, 0xc2, 0xf1,
+		0x48, 0x38, 0xde, 0xdf, 0xde, 0x40, 0x63, 0xbe, 0xd9, 0xea, 0xe9, 0x02, 0x04, 0xba, 0x10, 0x80,
+		0xe3, 0x5d, 0xe8, 0x8b, 0x6d, 0xcd, 0xed, 0x42, 0x7f, 0x80, 0x34, 0x3f, 0x1c, 0x27, 0x6d, 0x20,
+		0xb6, 0x31, 0xb7, 0x9f, 0x83, 0x44, 0x67, 0x44, 0x7f, 0x94, 0xcd, 0x47, 0x3e, 0x3d, 0x8b, 0x85,
+		0xf4, 0x41, 0x34, 0x03, 0x53, 0x1e, 0xf0, 0x38, 0x75, 0x65, 0x93, 0x92, 0x46, 0xa7, 0x61, 0xa2,
+		0xc3, 0x27, 0x8a, 0x85, 0xf4, 0x10, 0x31, 0xc9, 0x1e, 0x30, 0xaa, 0x59, 0x1c, 0xe6, 0x20, 0x62,
+		0x3f, 0x42, 0xb5, 0xf3, 0x25, 0x62, 0xcc, 0x87, 0x89, 0xfd, 0xf1, 0xd4, 0x79, 0x55, 0x93, 0x4d,
+		0x44, 0x86, 0xa8, 0xce, 0x10, 0xbe, 0xf3, 0xf1, 0x43, 0x81, 0x7e, 0x89, 0x26, 0x44, 0x90, 0x17,
+		0x23, 0x68, 0x12, 0xce, 0x24, 0xc1, 0x29, 0x16, 0xd2, 0x87, 0xd1, 0x1c, 0xbc, 0x14, 0xcd, 0xb7,
+		0x18, 0x8d, 0xa1, 0x96, 0xca, 0xa5, 0x4a, 0x89, 0xba, 0x25, 0xa3, 0xe8, 0x65, 0xb8, 0xb5, 0xbd,
+		0x46, 0x38, 0x17, 0xc6, 0xd0, 0x4d, 0xb8, 0xe6, 0x69, 0x21, 0x0e, 0x25, 0xd4, 0xdf, 0x23, 0xc4,
+		0x08, 0x78, 0x90, 0x99, 0xbe, 0xe5, 0xda, 0xb7, 0x58, 0x48, 0x1f, 0xed, 0xcc, 0x0f, 0xa6, 0x20,
+		0x8b, 0x85, 0xf4, 0x31, 0xe2, 0x59, 0x76, 0xd0, 0x14, 0xb6, 0xf1, 0x2d, 0xa4, 0xc7, 0x13, 0x8c,
+		0x0e, 0x57, 0xf1, 0x05, 0xae, 0xe3, 0xd3, 0x59, 0x74, 0x0d, 0xae, 0x78, 0x70, 0xe2, 0xb5, 0xb9,
+		0x87, 0xcb, 0xc7, 0x89, 0x5e, 0x4f, 0x8e, 0xc8, 0x39, 0x7b, 0x02, 0x4d, 0xc3, 0x79, 0xaf, 0x0c,
+		0x46, 0xc1, 0xdb, 0x02, 0x3d, 0x41, 0xdc, 0xdf, 0x24, 0x08, 0xee, 0xc4, 0x97, 0x89, 0xbb, 0x90,
+		0x04, 0x85, 0xd3, 0x74, 0x92, 0xd8, 0xb4, 0x44, 0x9f, 0xb0, 0xc5, 0xf4, 0x54, 0x52, 0xa2, 0x5c,
+		0x05, 0x72, 0x3a, 0x30, 0x36, 0xd1, 0x28, 0xee, 0x78, 0x9e, 0x09, 0x88, 0x61, 0x67, 0xbb, 0xe9,
+		0x19, 0x9f, 0xb3, 0x64, 0x39, 0xd3, 0x1d, 0x32, 0xe7, 0x47, 0x8e, 0xd8, 0xde, 0x84, 0xd2, 0xef,
+		0x48, 0xea, 0xb9, 0x00, 0x13, 0x3b, 0x5b, 0xeb, 0x49, 0xe2, 0xdf, 0x24, 0x93, 0x6d, 0xf2, 0x7b,
+		0x49, 0x59, 0xac, 0x14, 0xe7, 0xd8, 0x47, 0x8b, 0x95, 0xf4, 0xf9, 0xc9, 0xff, 0xd4, 0x07, 0x87,
+		0x1d, 0x4f, 0xd3, 0x7f, 0xf3, 0x11, 0x51, 0xea, 0x22, 0x4b, 0xa4, 0xce, 0xe5, 0x57, 0x96, 0xbd,
+		0x9e, 0xc0, 0x65, 0xb8, 0x18, 0x03, 0xb7, 0x52, 0x26, 0xcb, 0x09, 0xf2, 0xdf, 0x06, 0x4a, 0x4b,
+		0xe8, 0x25, 0xb8, 0x19, 0x83, 0x32, 0x9b, 0x2f, 0x08, 0xbc, 0x58, 0x4f, 0xaf, 0x53, 0xa8, 0x08,
+		0xf9, 0x0e, 0x0d, 0x44, 0x59, 0x0b, 0x4f, 0x33, 0x3d, 0xe8, 0x06, 0x3c, 0xd7, 0x89, 0x0e, 0xd7,
+		0xe7, 0xf5, 0xa2, 0xee, 0x41, 0x2f, 0xc0, 0xf3, 0x1d, 0x50, 0x7d, 0xa6, 0xc1, 0x83, 0xbb, 0x97,
+		0x48, 0x64, 0x47, 0xea, 0x3d, 0x6e, 0xa6, 0x17, 0x79, 0x1f, 0x2a, 0x41, 0xb1, 0xd3, 0x87, 0xa3,
+		0x1d, 0x71, 0x6f, 0x53, 0xfb, 0x13, 0x70, 0x31, 0xc2, 0x49, 0xf7, 0x36, 0x73, 0x00, 0xbd, 0x02,
+		0x73, 0xc9, 0x58, 0x11, 0xdf, 0x50, 0x2f, 0xfa, 0x30, 0x54, 0xba, 0x1b, 0xd5, 0xb8, 0x59, 0xe5,
+		0x69, 0x19, 0xd0, 0x6d, 0xb8, 0xd1, 0x91, 0x69, 0x7e, 0xbf, 0xdd, 0x83, 0xde, 0x47, 0xd4, 0x7b,
+		0x0c, 0xba, 0x57, 0x46, 0xdc, 0xe0, 0x46, 0x89, 0x78, 0x4f, 0x3e, 0xb7, 0x3d, 0x84, 0xa8, 0x14,
+		0x97, 0x8b, 0x15, 0x75, 0xb9, 0x52, 0x9a, 0xbb, 0xcb, 0x3c, 0x94, 0x85, 0xd2, 0x72, 0x25, 0x3d,
+		0x40, 0x4c, 0x6f, 0x0c, 0x96, 0xd3, 0x57, 0xf2, 0xa3, 0xa8, 0x78, 0x66, 0x18, 0x01, 0x5b, 0x51,
+		0x8a, 0xe9, 0xc1, 0x04, 0x43, 0xc2, 0x55, 0x59, 0x3c, 0xe3, 0x0e, 0x12, 0x57, 0x22, 0xd1, 0x0c,
+		0x61, 0xaa, 0x58, 0xd8, 0x48, 0xda, 0xbf, 0x0c, 0x09, 0x35, 0x32, 0xbf, 0xa8, 0xcc, 0x15, 0xf9,
+		0x62, 0xd8, 0xd1, 0x11, 0x43, 0xe8, 0x79, 0x98, 0x89, 0x43, 0xb2, 0xc3, 0x11, 0x01, 0x3c, 0xd4,
+		0x81, 0xe5, 0x9e, 0xae, 0x97, 0xca, 0x4b, 0x2b, 0x15, 0x75, 0xb9, 0xf4, 0xa0, 0x98, 0x1e, 0xf6,
+		0xaf, 0x66, 0x23, 0x06, 0xca, 0xe6, 0x55, 0x3a, 0xe3, 0x5f, 0xcd, 0x0a, 0x3f, 0x32, 0x5b, 0x2a,
+		0xe7, 0x95, 0xd7, 0xd3, 0x87, 0x3a, 0x88, 0x5e, 0x58, 0xcf, 0xf9, 0x24, 0x68, 0x24, 0x49, 0x77,
+		0x42, 0x56, 0xe1, 0xf0, 0xe4, 0x97, 0x25, 0x98, 0x4c, 0xf4, 0x2a, 0x31, 0x53, 0xf5, 0x37, 0xe1,
+		0x5a, 0x62, 0xc7, 0x2e, 0xa4, 0xff, 0xef, 0xc3, 0x72, 0xb7, 0xc8, 0x2b, 0xe5, 0xbb, 0xe5, 0xc5,
+		0xfb, 0xe5, 0xd8, 0xd5, 0xab, 0x44, 0x3b, 0x91, 0xe8, 0x39, 0x43, 0xa7, 0x13, 0x89, 0xed, 0xb3,
+		0xa8, 0x13, 0xdd, 0x22, 0x27, 0xeb, 0xc4, 0x67, 0x24, 0x98, 0x88, 0x7b, 0x32, 0x95, 0xd1, 0x7e,
+		0x19, 0x2e, 0x76, 0xf0, 0xb0, 0x42, 0x14, 0xcf, 0xc2, 0x8b, 0xc9, 0x50, 0x9c, 0xfa, 0xfc, 0x82,
+		0x52, 0xcc, 0x17, 0x5e, 0x77, 0x42, 0x9c, 0xd2, 0xe4, 0x37, 0x52, 0x70, 0x2c, 0x2e, 0x19, 0x9c,
+		0xac, 0xb1, 0x45, 0x3e, 0x1c, 0x9d, 0x73, 0xa1, 0x20, 0xa8, 0x37, 0x24, 0x1b, 0x01, 0xec, 0x3a,
+		0x98, 0x12, 0xf1, 0xe0, 0x3b, 0x81, 0x73, 0x67, 0x2a, 0xe5, 0x8b, 0x0d, 0x47, 0x35, 0x6d, 0x3b,
+		0x96, 0x3d, 0xc4, 0x75, 0xed, 0x04, 0xed, 0xf1, 0x10, 0xf7, 0x90, 0x39, 0xd6, 0x99, 0xf0, 0x80,
+		0xcf, 0xbf, 0x37, 0x49, 0x77, 0x5d, 0xd7, 0x75, 0xdf, 0xe4, 0x8f, 0x48, 0x30, 0x22, 0x4e, 0xb5,
+		0x26, 0x6b, 0xd6, 0x57, 0x57, 0x8a, 0x4a, 0x70, 0xf5, 0x1d, 0x0c, 0xb9, 0x9c, 0x85, 0x93, 0xd1,
+		0x60, 0x5e, 0xce, 0x9e, 0x82, 0x13, 0xd1, 0x80, 0x36, 0x4f, 0x27, 0x1b, 0x70, 0x30, 0x90, 0x49,
+		0x4d, 0xd6, 0xad, 0x0c, 0x51, 0x29, 0x2e, 0xaf, 0x2c, 0x84, 0x62, 0x3e, 0x59, 0x38, 0x12, 0xae,
+		0xce, 0x97, 0x97, 0xef, 0xbb, 0x71, 0xee, 0x70, 0xbd, 0xf3, 0xbd, 0x3f, 0x90, 0x20, 0x23, 0xca,
+		0x0a, 0x26, 0x2b, 0xea, 0xa5, 0x62, 0xb9, 0x50, 0x2a, 0xbf, 0xe2, 0x6a, 0xc1, 0x60, 0x38, 0xfb,
+		0x14, 0x9c, 0x88, 0x80, 0x71, 0x43, 0x1f, 0x52, 0x4c, 0x4b, 0xf6, 0x52, 0x28, 0x45, 0x44, 0x3a,
+		0x02, 0x26, 0xb4, 0x0a, 0xed, 0x21, 0x23, 0x13, 0x01, 0x5c, 0x5e, 0x24, 0x91, 0xdb, 0x85, 0xa2,
+		0xb2, 0x9c, 0xde, 0x33, 0xf9, 0x63, 0x12, 0x8c, 0x88, 0x6f, 0xd3, 0x21, 0x2d, 0xdc, 0x29, 0x2d,
+		0x57, 0x16, 0x95, 0xd7, 0x55, 0xe6, 0xa7, 0xcf, 0x97, 0x16, 0x2a, 0x45, 0x45, 0x30, 0xb6, 0xd1,
+		0x60, 0xf9, 0x85, 0x05, 0x56, 0x9a, 0x96, 0x88, 0xe5, 0x89, 0x06, 0x64, 0xc2, 0xc7, 0x40, 0x53,
+		0x93, 0xdf, 0x0b, 0xfd, 0xf6, 0xfe, 0xe6, 0x5d, 0xbd, 0x51, 0xa3, 0x51, 0x71, 0x22, 0x10, 0xc4,
+		0xcb, 0x50, 0xef, 0x96, 0xca, 0x05, 0xcf, 0xf7, 0xc7, 0xe0, 0x50, 0xa0, 0xae, 0xbc, 0xa8, 0xdc,
+		0xcb, 0x2f, 0xa4, 0x25, 0x41, 0x15, 0xf3, 0x58, 0xd2, 0x29, 0x41, 0x15, 0xf3, 0x49, 0xd2, 0x3d,
+		0x93, 0x8f, 0x60, 0x30, 0xf0, 0x60, 0xd0, 0x51, 0x38, 0x4c, 0x0c, 0x55, 0xe9, 0xb5, 0xfc, 0x82,
+		0x70, 0x03, 0x25, 0x58, 0x59, 0x28, 0x2d, 0xe7, 0x67, 0xd9, 0xb8, 0x0a, 0x50, 0x8b, 0x65, 0x56,
+		0x99, 0x9a, 0xfc, 0x1f, 0x12, 0xa4, 0x83, 0x87, 0x87, 0x88, 0xa8, 0x96, 0xca, 0x85, 0xe2, 0x87,
+		0x8b, 0x05, 0xf5, 0xb5, 0xfc, 0xc2, 0x4a, 0x31, 0xc8, 0xef, 0x71, 0x18, 0x13, 0xd4, 0x2f, 0x57,
+		0x14, 0xaa, 0x18, 0x23, 0xd0, 0xef, 0x16, 0x5f, 0xbf, 0xbf, 0xa8, 0x10, 0x21, 0x3a, 0x02, 0x23,
+		0xc2, 0xe6, 0x2b, 0xe9, 0x9e, 0x88, 0xa6, 0x0b, 0x8b, 0x2b, 0xb3, 0x0b, 0xc5, 0xf4, 0x1e, 0xd2,
+		0x17, 0x41, 0xf5, 0xec, 0xe2, 0xe2, 0x42, 0x7a, 0x2f, 0x89, 0x37, 0x8b, 0x70, 0xf3, 0x95, 0x22,
+		0x51, 0x25, 0xe9, 0x7d, 0x93, 0x9f, 0x96, 0xf8, 0x49, 0xa8, 0xc0, 0x81, 0x20, 0x22, 0xfa, 0xf6,
+		0xdc, 0xa3, 0x9b, 0x5f, 0x73, 0x8b, 0xe5, 0x42, 0x89, 0x2f, 0x67, 0xed, 0x4e, 0x9f, 0x84, 0xe3,
+		0x11, 0x30, 0xe5, 0xc5, 0x8a, 0xba, 0xb8, 0x54, 0x24, 0x6b, 0xb3, 0x4b, 0x70, 0x21, 0x06, 0xc8,
+		0x55, 0x26, 0x73, 0x0b, 0xc5, 0x3c, 0xd9, 0xb8, 0x4b, 0x4d, 0xfe, 0x9c, 0x04, 0x99, 0x3b, 0xe1,
+		0xa7, 0xab, 0xe8, 0xc4, 0xb6, 0x65, 0xd5, 0x37, 0x7c, 0xde, 0x81, 0x88, 0x86, 0xe1, 0x13, 0x2f,
+		0x2d, 0x11, 0xba, 0x23, 0x60, 0xd8, 0x5f, 0x3a, 0xaf, 0x27, 0x60, 0x3c, 0x02, 0xc8, 0x8e, 0x18,
+		0x4f, 0xfe, 0x4b, 0x09, 0x46, 0xc4, 0x29, 0x3b, 0x64, 0x9a, 0x06, 0x57, 0x00, 0x3c, 0x0c, 0xb0,
+		0xa8, 0x78, 0xa8, 0x8d, 0x05, 0x23, 0x7e, 0x5a, 0xa1, 0xa8, 0x30, 0xd3, 0x16, 0x0d, 0xa6, 0x14,
+		0x2b, 0xca, 0xeb, 0x7c, 0xdb, 0x87, 0xe9, 0xa3, 0x68, 0xd8, 0x39, 0x65, 0xb1, 0xec, 0x68, 0xb8,
+		0x74, 0xcf, 0xe4, 0x4f, 0x4a, 0x80, 0xc2, 0x49, 0x75, 0x64, 0xb3, 0x6f, 0x36, 0x5f, 0x99, 0xbb,
+		0x43, 0xc6, 0x50, 0x61, 0x4b, 0xfd, 0x80, 0xbc, 0xcb, 0x90, 0x15, 0x42, 0xb8, 0xdb, 0x4e, 0x12,
+		0x11, 0x3e, 0x21, 0x8c, 0xb3, 0x75, 0x15, 0x05, 0xc0, 0x3c, 0x27, 0xbe, 0x5f, 0x29, 0x48, 0x1e,
+		0x24, 0x63, 0x13, 0x44, 0x0c, 0xca, 0x41, 0x24, 0x88, 0xe3, 0xad, 0x10, 0x31, 0x10, 0x83, 0x78,
+		0xf7, 0x0f, 0x04, 0xac, 0x08, 0x48, 0x41, 0xcd, 0x55, 0x8b, 0x94, 0x79, 0x3e, 0xb5, 0x18, 0x60,
+		0xdb, 0x51, 0x38, 0x1c, 0xa8, 0xf3, 0x44, 0x31, 0xc2, 0x95, 0xb6, 0x8d, 0x48, 0xa7, 0x66, 0xcf,
+		0x3f, 0x38, 0xf7, 0x50, 0xb7, 0x1e, 0xb5, 0x57, 0xa7, 0xaa, 0xcd, 0xf5, 0xe9, 0xf6, 0x2a, 0x36,
+		0xa6, 0x79, 0xb6, 0xc6, 0xf4, 0xd4, 0x43, 0xdc, 0x98, 0xa6, 0x6f, 0x52, 0x4e, 0x6b, 0x2d, 0x7d,
+		0xfa, 0xc9, 0xe5, 0xd5, 0x7d, 0xf4, 0xdf, 0x95, 0xff, 0x3f, 0x00, 0x23, 0x6e, 0x4e, 0x48, 0xcc,
+		0xf1, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) WorkflowServiceYARPCClient {
+			return NewWorkflowServiceYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
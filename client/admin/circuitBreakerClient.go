@@ -0,0 +1,329 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*circuitBreakerClient)(nil)
+
+// ErrCircuitBreakerOpen is returned without calling the remote cluster while the circuit breaker is open
+var ErrCircuitBreakerOpen = &shared.ServiceBusyError{Message: "Remote cluster is unavailable, circuit breaker is open."}
+
+type circuitBreakerClient struct {
+	client       Client
+	breaker      backoff.CircuitBreaker
+	isFailure    func(error) bool
+	metricsScope metrics.Scope
+}
+
+// NewCircuitBreakerClient creates a new instance of Client which stops calling the remote
+// cluster once the circuit breaker trips on sustained failures
+func NewCircuitBreakerClient(
+	client Client,
+	breaker backoff.CircuitBreaker,
+	isFailure func(error) bool,
+	metricsScope metrics.Scope,
+) Client {
+	return &circuitBreakerClient{
+		client:       client,
+		breaker:      breaker,
+		isFailure:    isFailure,
+		metricsScope: metricsScope,
+	}
+}
+
+func (c *circuitBreakerClient) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.AddSearchAttribute(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) DescribeHistoryHost(
+	ctx context.Context,
+	request *shared.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeHistoryHostResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeHistoryHost(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RemoveTask(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.CloseShard(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) DescribeQueue(
+	ctx context.Context,
+	request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeQueueResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeQueue(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ResetQueueState(
+	ctx context.Context,
+	request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.ResetQueueState(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *admin.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeWorkflowExecutionResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	request *admin.GetWorkflowExecutionRawHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*admin.GetWorkflowExecutionRawHistoryResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) CheckFailoverReadiness(
+	ctx context.Context,
+	request *admin.CheckFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*admin.CheckFailoverReadinessResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.CheckFailoverReadiness(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeShardDistribution(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *admin.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.ReindexWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) SetTerminationProtection(
+	ctx context.Context,
+	request *admin.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.SetTerminationProtection(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) ListWorkers(
+	ctx context.Context,
+	request *admin.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListWorkersResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListWorkers(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) DescribeDynamicConfig(
+	ctx context.Context,
+	request *admin.DescribeDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeDynamicConfigResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeDynamicConfig(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) MigrateDomain(
+	ctx context.Context,
+	request *admin.MigrateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*admin.MigrateDomainResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.MigrateDomain(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) UndeprecateDomain(
+	ctx context.Context,
+	request *admin.UndeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.UndeprecateDomain(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) AddOperatorAnnotation(
+	ctx context.Context,
+	request *shared.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.AddOperatorAnnotation(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) allow() error {
+	if !c.breaker.Allow() {
+		c.metricsScope.IncCounter(metrics.CadenceClientCircuitBreakerRejected)
+		return ErrCircuitBreakerOpen
+	}
+	return nil
+}
+
+func (c *circuitBreakerClient) record(err error) {
+	if err == nil || !c.isFailure(err) {
+		c.breaker.RecordSuccess()
+		return
+	}
+	if c.breaker.RecordFailure() {
+		c.metricsScope.IncCounter(metrics.CadenceClientCircuitBreakerOpened)
+	}
+}
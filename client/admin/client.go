@@ -119,6 +119,38 @@ func (c *clientImpl) CloseShard(
 	return client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeQueue(
+	ctx context.Context,
+	request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeQueueResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeQueue(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetQueueState(
+	ctx context.Context,
+	request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResetQueueState(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -151,6 +183,166 @@ func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	return client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
 }
 
+func (c *clientImpl) CheckFailoverReadiness(
+	ctx context.Context,
+	request *admin.CheckFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*admin.CheckFailoverReadinessResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CheckFailoverReadiness(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeShardDistribution(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *admin.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReindexWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetTerminationProtection(
+	ctx context.Context,
+	request *admin.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.SetTerminationProtection(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListWorkers(
+	ctx context.Context,
+	request *admin.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListWorkersResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListWorkers(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeDynamicConfig(
+	ctx context.Context,
+	request *admin.DescribeDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeDynamicConfigResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) MigrateDomain(
+	ctx context.Context,
+	request *admin.MigrateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*admin.MigrateDomainResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MigrateDomain(ctx, request, opts...)
+}
+
+func (c *clientImpl) UndeprecateDomain(
+	ctx context.Context,
+	request *admin.UndeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UndeprecateDomain(ctx, request, opts...)
+}
+
+func (c *clientImpl) AddOperatorAnnotation(
+	ctx context.Context,
+	request *shared.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AddOperatorAnnotation(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
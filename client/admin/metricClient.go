@@ -115,6 +115,42 @@ func (c *metricClient) CloseShard(
 	return err
 }
 
+func (c *metricClient) DescribeQueue(
+	ctx context.Context,
+	request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeQueueResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeQueueScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeQueueScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeQueue(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeQueueScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ResetQueueState(
+	ctx context.Context,
+	request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResetQueueStateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResetQueueStateScope, metrics.CadenceClientLatency)
+	err := c.client.ResetQueueState(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResetQueueStateScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -150,3 +186,183 @@ func (c *metricClient) GetWorkflowExecutionRawHistory(
 	}
 	return resp, err
 }
+
+func (c *metricClient) CheckFailoverReadiness(
+	ctx context.Context,
+	request *admin.CheckFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*admin.CheckFailoverReadinessResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCheckFailoverReadinessScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCheckFailoverReadinessScope, metrics.CadenceClientLatency)
+	resp, err := c.client.CheckFailoverReadiness(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCheckFailoverReadinessScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeShardDistributionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeShardDistributionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeShardDistribution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeShardDistributionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *admin.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReindexWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReindexWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ReindexWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReindexWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) SetTerminationProtection(
+	ctx context.Context,
+	request *admin.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSetTerminationProtectionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSetTerminationProtectionScope, metrics.CadenceClientLatency)
+	err := c.client.SetTerminationProtection(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSetTerminationProtectionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListWorkers(
+	ctx context.Context,
+	request *admin.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListWorkersResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListWorkersScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListWorkersScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListWorkers(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListWorkersScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeDynamicConfig(
+	ctx context.Context,
+	request *admin.DescribeDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeDynamicConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeDynamicConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeDynamicConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) MigrateDomain(
+	ctx context.Context,
+	request *admin.MigrateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*admin.MigrateDomainResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientMigrateDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientMigrateDomainScope, metrics.CadenceClientLatency)
+	resp, err := c.client.MigrateDomain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientMigrateDomainScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UndeprecateDomain(
+	ctx context.Context,
+	request *admin.UndeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUndeprecateDomainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUndeprecateDomainScope, metrics.CadenceClientLatency)
+	err := c.client.UndeprecateDomain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUndeprecateDomainScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) AddOperatorAnnotation(
+	ctx context.Context,
+	request *shared.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientAddOperatorAnnotationScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientAddOperatorAnnotationScope, metrics.CadenceClientLatency)
+	err := c.client.AddOperatorAnnotation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientAddOperatorAnnotationScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
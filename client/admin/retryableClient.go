@@ -104,6 +104,34 @@ func (c *retryableClient) CloseShard(
 	return err
 }
 
+func (c *retryableClient) DescribeQueue(
+	ctx context.Context,
+	request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeQueueResponse, error) {
+
+	var resp *shared.DescribeQueueResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetQueueState(
+	ctx context.Context,
+	request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResetQueueState(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -135,3 +163,143 @@ func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) CheckFailoverReadiness(
+	ctx context.Context,
+	request *admin.CheckFailoverReadinessRequest,
+	opts ...yarpc.CallOption,
+) (*admin.CheckFailoverReadinessResponse, error) {
+
+	var resp *admin.CheckFailoverReadinessResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CheckFailoverReadiness(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeShardDistributionResponse, error) {
+
+	var resp *admin.DescribeShardDistributionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShardDistribution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *admin.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReindexWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) SetTerminationProtection(
+	ctx context.Context,
+	request *admin.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.SetTerminationProtection(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListWorkers(
+	ctx context.Context,
+	request *admin.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ListWorkersResponse, error) {
+
+	var resp *admin.ListWorkersResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListWorkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeDynamicConfig(
+	ctx context.Context,
+	request *admin.DescribeDynamicConfigRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeDynamicConfigResponse, error) {
+
+	var resp *admin.DescribeDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) MigrateDomain(
+	ctx context.Context,
+	request *admin.MigrateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*admin.MigrateDomainResponse, error) {
+
+	var resp *admin.MigrateDomainResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MigrateDomain(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UndeprecateDomain(
+	ctx context.Context,
+	request *admin.UndeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UndeprecateDomain(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) AddOperatorAnnotation(
+	ctx context.Context,
+	request *shared.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.AddOperatorAnnotation(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
@@ -33,9 +33,14 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/peer"
 	"go.uber.org/yarpc/api/transport"
@@ -45,6 +50,10 @@ import (
 
 const (
 	defaultRefreshInterval = time.Second * 10
+
+	defaultRemoteConnectionPoolSize             = 1
+	defaultRemoteCircuitBreakerFailureThreshold = 10
+	defaultRemoteCircuitBreakerResetTimeout     = time.Second * 10
 )
 
 type (
@@ -95,7 +104,12 @@ type (
 )
 
 // NewClientBean provides a collection of clients
-func NewClientBean(factory Factory, dispatcherProvider DispatcherProvider, clusterMetadata cluster.Metadata) (Bean, error) {
+func NewClientBean(
+	factory Factory,
+	dispatcherProvider DispatcherProvider,
+	clusterMetadata cluster.Metadata,
+	metricsClient metrics.Client,
+) (Bean, error) {
 
 	historyClient, err := factory.NewHistoryClient()
 	if err != nil {
@@ -105,30 +119,54 @@ func NewClientBean(factory Factory, dispatcherProvider DispatcherProvider, clust
 	remoteAdminClients := map[string]admin.Client{}
 	remoteFrontendClients := map[string]frontend.Client{}
 	for clusterName, info := range clusterMetadata.GetAllClusterInfo() {
-		dispatcher, err := dispatcherProvider.Get(info.RPCName, info.RPCAddress)
-		if err != nil {
-			return nil, err
+		poolSize := info.RPCConnectionPoolSize
+		if poolSize <= 0 {
+			poolSize = defaultRemoteConnectionPoolSize
+		}
+		dispatchers := make([]*yarpc.Dispatcher, 0, poolSize)
+		for i := 0; i < poolSize; i++ {
+			dispatcher, err := dispatcherProvider.Get(info.RPCName, info.RPCAddress)
+			if err != nil {
+				return nil, err
+			}
+			dispatchers = append(dispatchers, dispatcher)
 		}
 
-		adminClient, err := factory.NewAdminClientWithTimeoutAndDispatcher(
+		adminClient, err := factory.NewAdminClientWithTimeoutAndDispatchers(
 			info.RPCName,
-			admin.DefaultTimeout,
-			dispatcher,
+			durationOrDefault(info.RPCTimeout, admin.DefaultTimeout),
+			dispatchers,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		frontendClient, err := factory.NewFrontendClientWithTimeoutAndDispatcher(
+		frontendClient, err := factory.NewFrontendClientWithTimeoutAndDispatchers(
 			info.RPCName,
-			frontend.DefaultTimeout,
-			frontend.DefaultLongPollTimeout,
-			dispatcher,
+			durationOrDefault(info.RPCTimeout, frontend.DefaultTimeout),
+			durationOrDefault(info.RPCLongPollTimeout, frontend.DefaultLongPollTimeout),
+			dispatchers,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		if clusterName != clusterMetadata.GetCurrentClusterName() {
+			// a dead remote cluster should fail fast instead of holding on to the callers until timeout
+			adminClient = admin.NewCircuitBreakerClient(
+				adminClient,
+				newRemoteCircuitBreaker(info.CircuitBreaker),
+				common.IsRemoteClusterUnavailableError,
+				metricsClient.Scope(metrics.AdminClientCircuitBreakerScope, metrics.TargetClusterTag(clusterName)),
+			)
+			frontendClient = frontend.NewCircuitBreakerClient(
+				frontendClient,
+				newRemoteCircuitBreaker(info.CircuitBreaker),
+				common.IsRemoteClusterUnavailableError,
+				metricsClient.Scope(metrics.FrontendClientCircuitBreakerScope, metrics.TargetClusterTag(clusterName)),
+			)
+		}
+
 		remoteAdminClients[clusterName] = adminClient
 		remoteFrontendClients[clusterName] = frontendClient
 	}
@@ -195,6 +233,25 @@ func (h *clientBeanImpl) lazyInitMatchingClient(domainIDToName DomainIDToNameFun
 	return client, nil
 }
 
+func newRemoteCircuitBreaker(cfg config.CircuitBreakerConfig) backoff.CircuitBreaker {
+	failureThreshold := cfg.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = defaultRemoteCircuitBreakerFailureThreshold
+	}
+	return backoff.NewCircuitBreaker(
+		failureThreshold,
+		durationOrDefault(cfg.ResetTimeout, defaultRemoteCircuitBreakerResetTimeout),
+		clock.NewRealTimeSource(),
+	)
+}
+
+func durationOrDefault(d time.Duration, defaultValue time.Duration) time.Duration {
+	if d <= 0 {
+		return defaultValue
+	}
+	return d
+}
+
 // NewDNSYarpcDispatcherProvider create a dispatcher provider which handles with IP address
 func NewDNSYarpcDispatcherProvider(logger log.Logger, interval time.Duration) DispatcherProvider {
 	if interval <= 0 {
@@ -21,12 +21,17 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
@@ -46,10 +51,6 @@ const (
 	crossDCCaller  = "cadence-xdc-client"
 )
 
-const (
-	clientKeyDispatcher = "client-key-dispatcher"
-)
-
 type (
 	// Factory can be used to create RPC clients for cadence services
 	Factory interface {
@@ -61,8 +62,8 @@ type (
 		NewMatchingClientWithTimeout(domainIDToName DomainIDToNameFunc, timeout time.Duration, longPollTimeout time.Duration) (matching.Client, error)
 		NewFrontendClientWithTimeout(timeout time.Duration, longPollTimeout time.Duration) (frontend.Client, error)
 
-		NewAdminClientWithTimeoutAndDispatcher(rpcName string, timeout time.Duration, dispatcher *yarpc.Dispatcher) (admin.Client, error)
-		NewFrontendClientWithTimeoutAndDispatcher(rpcName string, timeout time.Duration, longPollTimeout time.Duration, dispatcher *yarpc.Dispatcher) (frontend.Client, error)
+		NewAdminClientWithTimeoutAndDispatchers(rpcName string, timeout time.Duration, dispatchers []*yarpc.Dispatcher) (admin.Client, error)
+		NewFrontendClientWithTimeoutAndDispatchers(rpcName string, timeout time.Duration, longPollTimeout time.Duration, dispatchers []*yarpc.Dispatcher) (frontend.Client, error)
 	}
 
 	// DomainIDToNameFunc maps a domainID to domain name. Returns error when mapping is not possible.
@@ -76,6 +77,12 @@ type (
 		numberOfHistoryShards int
 		logger                log.Logger
 	}
+
+	// dispatcherPool spreads the calls of a client across a pool of dispatchers to the same remote service
+	dispatcherPool struct {
+		dispatchers []*yarpc.Dispatcher
+		next        uint32
+	}
 )
 
 // NewRPCClientFactory creates an instance of client factory that knows how to dispatch RPC calls.
@@ -132,6 +139,15 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 	if cf.metricsClient != nil {
 		client = history.NewMetricClient(client, cf.metricsClient)
 	}
+	if cf.dynConfig != nil {
+		client = history.NewBatchingClient(
+			client,
+			cf.numberOfHistoryShards,
+			cf.dynConfig.GetBoolProperty(dynamicconfig.FrontendEnableHeartbeatBatching, false),
+			cf.dynConfig.GetDurationProperty(dynamicconfig.FrontendHeartbeatBatchingWindow, 10*time.Millisecond),
+			cf.dynConfig.GetIntProperty(dynamicconfig.FrontendHeartbeatBatchMaxSize, 100),
+		)
+	}
 	return client, nil
 }
 
@@ -158,11 +174,21 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 		return matchingserviceclient.New(dispatcher.ClientConfig(common.MatchingServiceName)), nil
 	}
 
-	client := matching.NewClient(
+	// the partitions decided by the adaptive scaler are described through the client being created
+	var client matching.Client
+	partitionConfigs := matching.NewPartitionConfigProvider(
+		matching.NewPartitionConfigProviderConfig(cf.dynConfig),
+		domainIDToName,
+		func(ctx context.Context, domainID string, taskList shared.TaskList, taskListType int) (*shared.TaskListPartitionConfig, error) {
+			return matching.DescribePartitionConfig(ctx, client, domainID, taskList, taskListType)
+		},
+		cf.logger,
+	)
+	client = matching.NewClient(
 		timeout,
 		longPollTimeout,
 		common.NewClientCache(keyResolver, clientProvider),
-		matching.NewLoadBalancer(domainIDToName, cf.dynConfig),
+		matching.NewLoadBalancer(partitionConfigs),
 	)
 
 	if cf.metricsClient != nil {
@@ -202,43 +228,61 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeout(
 	return client, nil
 }
 
-func (cf *rpcClientFactory) NewAdminClientWithTimeoutAndDispatcher(
+func (cf *rpcClientFactory) NewAdminClientWithTimeoutAndDispatchers(
 	rpcName string,
 	timeout time.Duration,
-	dispatcher *yarpc.Dispatcher,
+	dispatchers []*yarpc.Dispatcher,
 ) (admin.Client, error) {
-	keyResolver := func(key string) (string, error) {
-		return clientKeyDispatcher, nil
-	}
+	pool := &dispatcherPool{dispatchers: dispatchers}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
+		dispatcher, err := pool.getDispatcher(clientKey)
+		if err != nil {
+			return nil, err
+		}
 		return adminserviceclient.New(dispatcher.ClientConfig(rpcName)), nil
 	}
 
-	client := admin.NewClient(timeout, common.NewClientCache(keyResolver, clientProvider))
+	client := admin.NewClient(timeout, common.NewClientCache(pool.keyResolver, clientProvider))
 	if cf.metricsClient != nil {
 		client = admin.NewMetricClient(client, cf.metricsClient)
 	}
 	return client, nil
 }
 
-func (cf *rpcClientFactory) NewFrontendClientWithTimeoutAndDispatcher(
+func (cf *rpcClientFactory) NewFrontendClientWithTimeoutAndDispatchers(
 	rpcName string,
 	timeout time.Duration,
 	longPollTimeout time.Duration,
-	dispatcher *yarpc.Dispatcher,
+	dispatchers []*yarpc.Dispatcher,
 ) (frontend.Client, error) {
-	keyResolver := func(key string) (string, error) {
-		return clientKeyDispatcher, nil
-	}
+	pool := &dispatcherPool{dispatchers: dispatchers}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
+		dispatcher, err := pool.getDispatcher(clientKey)
+		if err != nil {
+			return nil, err
+		}
 		return workflowserviceclient.New(dispatcher.ClientConfig(rpcName)), nil
 	}
 
-	client := frontend.NewClient(timeout, longPollTimeout, common.NewClientCache(keyResolver, clientProvider))
+	client := frontend.NewClient(timeout, longPollTimeout, common.NewClientCache(pool.keyResolver, clientProvider))
 	if cf.metricsClient != nil {
 		client = frontend.NewMetricClient(client, cf.metricsClient)
 	}
 	return client, nil
 }
+
+// keyResolver picks the dispatchers of the pool in a round robin fashion
+func (p *dispatcherPool) keyResolver(key string) (string, error) {
+	index := atomic.AddUint32(&p.next, 1) % uint32(len(p.dispatchers))
+	return strconv.Itoa(int(index)), nil
+}
+
+func (p *dispatcherPool) getDispatcher(clientKey string) (*yarpc.Dispatcher, error) {
+	index, err := strconv.Atoi(clientKey)
+	if err != nil || index < 0 || index >= len(p.dispatchers) {
+		return nil, fmt.Errorf("invalid dispatcher pool client key: %v", clientKey)
+	}
+	return p.dispatchers[index], nil
+}
@@ -0,0 +1,706 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*circuitBreakerClient)(nil)
+
+// ErrCircuitBreakerOpen is returned without calling the remote cluster while the circuit breaker is open
+var ErrCircuitBreakerOpen = &shared.ServiceBusyError{Message: "Remote cluster is unavailable, circuit breaker is open."}
+
+type circuitBreakerClient struct {
+	client       Client
+	breaker      backoff.CircuitBreaker
+	isFailure    func(error) bool
+	metricsScope metrics.Scope
+}
+
+// NewCircuitBreakerClient creates a new instance of Client which stops calling the remote
+// cluster once the circuit breaker trips on sustained failures
+func NewCircuitBreakerClient(
+	client Client,
+	breaker backoff.CircuitBreaker,
+	isFailure func(error) bool,
+	metricsScope metrics.Scope,
+) Client {
+	return &circuitBreakerClient{
+		client:       client,
+		breaker:      breaker,
+		isFailure:    isFailure,
+		metricsScope: metricsScope,
+	}
+}
+
+func (c *circuitBreakerClient) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.DeprecateDomain(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDomainResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeDomain(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeTaskList(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchDescribeWorkflowExecutionsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.BatchDescribeWorkflowExecutions(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) BatchOperationWorkflow(
+	ctx context.Context,
+	request *shared.BatchOperationWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchOperationWorkflowResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.BatchOperationWorkflow(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *shared.DescribeBatchOperationRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeBatchOperationResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.DescribeBatchOperation(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionChainResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListWorkflowExecutionChain(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetWorkflowExecutionHistory(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListArchivedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListArchivedWorkflowExecutions(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListClosedWorkflowExecutions(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDomainsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListDomains(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListOpenWorkflowExecutions(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ListWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListWorkflowExecutions(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ScanWorkflowExecutions(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.CountWorkflowExecutions(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) GetSearchAttributes(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetSearchAttributes(ctx, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) GetSearchAttributesSchema(
+	ctx context.Context,
+	request *shared.GetSearchAttributesSchemaRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesSchemaResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetSearchAttributesSchema(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForActivityTaskResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.PollForActivityTask(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForDecisionTaskResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.PollForDecisionTask(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.QueryWorkflowResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.QueryWorkflow(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.RecordActivityTaskHeartbeatByID(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RegisterDomain(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetStickyTaskListResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ResetStickyTaskList(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ResetWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondActivityTaskCanceled(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondActivityTaskCanceledByID(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondActivityTaskCompleted(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondActivityTaskCompletedByID(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondActivityTaskFailed(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondActivityTaskFailedByID(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RespondDecisionTaskCompletedResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.RespondDecisionTaskCompleted(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondDecisionTaskFailed(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.RespondQueryTaskCompleted(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.SignalWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.StartWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.TerminateWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	if err := c.allow(); err != nil {
+		return err
+	}
+	err := c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	c.record(err)
+	return err
+}
+
+func (c *circuitBreakerClient) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateDomainResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.UpdateDomain(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetReplicationMessagesResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetReplicationMessages(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) GetDomainReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetDomainReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetDomainReplicationMessagesResponse, error) {
+
+	if err := c.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetDomainReplicationMessages(ctx, request, opts...)
+	c.record(err)
+	return resp, err
+}
+
+func (c *circuitBreakerClient) allow() error {
+	if !c.breaker.Allow() {
+		c.metricsScope.IncCounter(metrics.CadenceClientCircuitBreakerRejected)
+		return ErrCircuitBreakerOpen
+	}
+	return nil
+}
+
+func (c *circuitBreakerClient) record(err error) {
+	if err == nil || !c.isFailure(err) {
+		c.breaker.RecordSuccess()
+		return
+	}
+	if c.breaker.RecordFailure() {
+		c.metricsScope.IncCounter(metrics.CadenceClientCircuitBreakerOpened)
+	}
+}
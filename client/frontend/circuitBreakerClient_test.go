@@ -0,0 +1,132 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type circuitBreakerClientSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	controller *gomock.Controller
+	mockClient *workflowservicetest.MockClient
+	timeSource *clock.EventTimeSource
+	scope      tally.TestScope
+	client     Client
+}
+
+func TestCircuitBreakerClientSuite(t *testing.T) {
+	suite.Run(t, new(circuitBreakerClientSuite))
+}
+
+func (s *circuitBreakerClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockClient = workflowservicetest.NewMockClient(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.scope = tally.NewTestScope("test", nil)
+	s.client = NewCircuitBreakerClient(
+		s.mockClient,
+		backoff.NewCircuitBreaker(2, time.Minute, s.timeSource),
+		common.IsRemoteClusterUnavailableError,
+		metrics.NewClient(s.scope, metrics.Frontend).Scope(metrics.FrontendClientCircuitBreakerScope),
+	)
+}
+
+func (s *circuitBreakerClientSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *circuitBreakerClientSuite) TestUnavailable_OpensCircuit() {
+	unavailableErr := yarpcerrors.UnavailableErrorf("connection refused")
+	s.mockClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, unavailableErr).Times(2)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.client.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{})
+		s.Equal(unavailableErr, err)
+	}
+	_, err := s.client.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{})
+	s.Equal(ErrCircuitBreakerOpen, err)
+	s.Equal(int64(1), s.counterValue("cadence_client_circuit_breaker_opened"))
+	s.Equal(int64(1), s.counterValue("cadence_client_circuit_breaker_rejected"))
+}
+
+func (s *circuitBreakerClientSuite) TestProbeSuccess_ClosesCircuit() {
+	unavailableErr := yarpcerrors.UnavailableErrorf("connection refused")
+	gomock.InOrder(
+		s.mockClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, unavailableErr).Times(2),
+		s.mockClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&shared.DescribeDomainResponse{}, nil).Times(2),
+	)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.client.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{})
+		s.Equal(unavailableErr, err)
+	}
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	for i := 0; i < 2; i++ {
+		_, err := s.client.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{})
+		s.NoError(err)
+	}
+	s.Equal(int64(0), s.counterValue("cadence_client_circuit_breaker_rejected"))
+}
+
+func (s *circuitBreakerClientSuite) TestTimeoutsAndRejections_KeepCircuitClosed() {
+	errs := []error{
+		yarpcerrors.DeadlineExceededErrorf("timeout"),
+		context.DeadlineExceeded,
+		&shared.BadRequestError{},
+		&shared.ServiceBusyError{},
+	}
+	for _, err := range errs {
+		s.mockClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, err).Times(2)
+		for i := 0; i < 2; i++ {
+			_, actualErr := s.client.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{})
+			s.Equal(err, actualErr)
+		}
+	}
+	s.Equal(int64(0), s.counterValue("cadence_client_circuit_breaker_opened"))
+}
+
+func (s *circuitBreakerClientSuite) counterValue(name string) int64 {
+	var value int64
+	for _, counter := range s.scope.Snapshot().Counters() {
+		if counter.Name() == "test."+name {
+			value += counter.Value()
+		}
+	}
+	return value
+}
@@ -124,6 +124,70 @@ func (c *clientImpl) DescribeWorkflowExecution(
 	return client.DescribeWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchDescribeWorkflowExecutionsResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.BatchDescribeWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *clientImpl) BatchOperationWorkflow(
+	ctx context.Context,
+	request *shared.BatchOperationWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchOperationWorkflowResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.BatchOperationWorkflow(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeBatchOperation(
+	ctx context.Context,
+	request *shared.DescribeBatchOperationRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeBatchOperationResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionChainResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListWorkflowExecutionChain(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *shared.GetWorkflowExecutionHistoryRequest,
@@ -267,6 +331,22 @@ func (c *clientImpl) GetSearchAttributes(
 	return client.GetSearchAttributes(ctx, opts...)
 }
 
+func (c *clientImpl) GetSearchAttributesSchema(
+	ctx context.Context,
+	request *shared.GetSearchAttributesSchemaRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesSchemaResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetSearchAttributesSchema(ctx, request, opts...)
+}
+
 func (c *clientImpl) PollForActivityTask(
 	ctx context.Context,
 	request *shared.PollForActivityTaskRequest,
@@ -619,6 +699,38 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return client.TerminateWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResumeWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateDomain(
 	ctx context.Context,
 	request *shared.UpdateDomainRequest,
@@ -116,6 +116,78 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchDescribeWorkflowExecutionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientBatchDescribeWorkflowExecutionsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientBatchDescribeWorkflowExecutionsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.BatchDescribeWorkflowExecutions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientBatchDescribeWorkflowExecutionsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) BatchOperationWorkflow(
+	ctx context.Context,
+	request *shared.BatchOperationWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchOperationWorkflowResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientBatchOperationWorkflowScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientBatchOperationWorkflowScope, metrics.CadenceClientLatency)
+	resp, err := c.client.BatchOperationWorkflow(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientBatchOperationWorkflowScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *shared.DescribeBatchOperationRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientDescribeBatchOperationScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientDescribeBatchOperationScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientDescribeBatchOperationScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionChainResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientListWorkflowExecutionChainScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientListWorkflowExecutionChainScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListWorkflowExecutionChain(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientListWorkflowExecutionChainScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *shared.GetWorkflowExecutionHistoryRequest,
@@ -277,6 +349,24 @@ func (c *metricClient) GetSearchAttributes(
 	return resp, err
 }
 
+func (c *metricClient) GetSearchAttributesSchema(
+	ctx context.Context,
+	request *shared.GetSearchAttributesSchemaRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesSchemaResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientGetSearchAttributesSchemaScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientGetSearchAttributesSchemaScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetSearchAttributesSchema(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientGetSearchAttributesSchemaScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) PollForActivityTask(
 	ctx context.Context,
 	request *shared.PollForActivityTaskRequest,
@@ -673,6 +763,42 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientResumeWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientResumeWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientResumeWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) UpdateDomain(
 	ctx context.Context,
 	request *shared.UpdateDomainRequest,
@@ -106,6 +106,70 @@ func (c *retryableClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *shared.BatchDescribeWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchDescribeWorkflowExecutionsResponse, error) {
+
+	var resp *shared.BatchDescribeWorkflowExecutionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BatchDescribeWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) BatchOperationWorkflow(
+	ctx context.Context,
+	request *shared.BatchOperationWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.BatchOperationWorkflowResponse, error) {
+
+	var resp *shared.BatchOperationWorkflowResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BatchOperationWorkflow(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *shared.DescribeBatchOperationRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeBatchOperationResponse, error) {
+
+	var resp *shared.DescribeBatchOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionChainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListWorkflowExecutionChainResponse, error) {
+
+	var resp *shared.ListWorkflowExecutionChainResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListWorkflowExecutionChain(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *shared.GetWorkflowExecutionHistoryRequest,
@@ -249,6 +313,22 @@ func (c *retryableClient) GetSearchAttributes(
 	return resp, err
 }
 
+func (c *retryableClient) GetSearchAttributesSchema(
+	ctx context.Context,
+	request *shared.GetSearchAttributesSchemaRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesSchemaResponse, error) {
+
+	var resp *shared.GetSearchAttributesSchemaResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetSearchAttributesSchema(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollForActivityTask(
 	ctx context.Context,
 	request *shared.PollForActivityTaskRequest,
@@ -553,6 +633,30 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) UpdateDomain(
 	ctx context.Context,
 	request *shared.UpdateDomainRequest,
@@ -0,0 +1,525 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/yarpc"
+)
+
+var _ Client = (*batchingClient)(nil)
+
+type (
+	batchingClient struct {
+		client          Client
+		numberOfShards  int
+		tokenSerializer common.TaskTokenSerializer
+		enabled         dynamicconfig.BoolPropertyFn
+		window          dynamicconfig.DurationPropertyFn
+		maxBatchSize    dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		batches map[int]*heartbeatBatch
+	}
+
+	// heartbeatBatch is the set of heartbeats destined to the same history shard within a batching window
+	heartbeatBatch struct {
+		shardID   int
+		requests  []*h.RecordActivityTaskHeartbeatRequest
+		flushOnce sync.Once
+		doneCh    chan struct{}
+		response  *h.RecordActivityTaskHeartbeatBatchResponse
+		err       error
+
+		// the following fields are guarded by the batchingClient lock
+		waiters     int
+		deadline    time.Time
+		hasDeadline bool
+		cancel      context.CancelFunc
+	}
+)
+
+// NewBatchingClient creates a new instance of Client which coalesces the activity heartbeats destined
+// to the same history shard within a small window into a single RecordActivityTaskHeartbeatBatch call
+func NewBatchingClient(
+	client Client,
+	numberOfShards int,
+	enabled dynamicconfig.BoolPropertyFn,
+	window dynamicconfig.DurationPropertyFn,
+	maxBatchSize dynamicconfig.IntPropertyFn,
+) Client {
+	return &batchingClient{
+		client:          client,
+		numberOfShards:  numberOfShards,
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		enabled:         enabled,
+		window:          window,
+		maxBatchSize:    maxBatchSize,
+		batches:         make(map[int]*heartbeatBatch),
+	}
+}
+
+func (c *batchingClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *h.RecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	if !c.enabled() || request.GetHeartbeatRequest().GetCancellationWaitSeconds() > 0 {
+		// a heartbeat waiting for cancellation would hold up every other heartbeat in its batch
+		return c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+	}
+	token, err := c.tokenSerializer.Deserialize(request.GetHeartbeatRequest().GetTaskToken())
+	if err != nil {
+		return c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+	}
+	shardID := common.WorkflowIDToHistoryShard(token.WorkflowID, c.numberOfShards)
+
+	batch, index := c.addToBatch(ctx, shardID, request)
+	select {
+	case <-batch.doneCh:
+	case <-ctx.Done():
+		c.leaveBatch(batch)
+		return nil, ctx.Err()
+	}
+	c.leaveBatch(batch)
+
+	if batch.err != nil || index >= len(batch.response.GetResults()) {
+		// the host may not support batching yet, e.g. during deployment
+		return c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+	}
+	result := batch.response.Results[index]
+	if result.ShardOwnershipLostError != nil {
+		// the individual call follows the shard to its new owner
+		return c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
+	}
+	return result.GetResponse(), recordActivityTaskHeartbeatResultError(result)
+}
+
+func (c *batchingClient) addToBatch(
+	ctx context.Context,
+	shardID int,
+	request *h.RecordActivityTaskHeartbeatRequest,
+) (*heartbeatBatch, int) {
+
+	c.Lock()
+	batch, ok := c.batches[shardID]
+	if !ok {
+		batch = &heartbeatBatch{
+			shardID:     shardID,
+			doneCh:      make(chan struct{}),
+			hasDeadline: true,
+		}
+		c.batches[shardID] = batch
+		time.AfterFunc(c.window(), func() { c.flush(batch) })
+	}
+	index := len(batch.requests)
+	batch.requests = append(batch.requests, request)
+	batch.waiters++
+	// the batch is sent with the latest deadline of its callers
+	if deadline, ok := ctx.Deadline(); !ok {
+		batch.hasDeadline = false
+	} else if deadline.After(batch.deadline) {
+		batch.deadline = deadline
+	}
+	full := len(batch.requests) >= c.maxBatchSize()
+	if full {
+		delete(c.batches, shardID)
+	}
+	c.Unlock()
+
+	if full {
+		go c.flush(batch)
+	}
+	return batch, index
+}
+
+// leaveBatch cancels the batch call once none of its callers waits for it anymore
+func (c *batchingClient) leaveBatch(batch *heartbeatBatch) {
+	c.Lock()
+	defer c.Unlock()
+
+	batch.waiters--
+	if batch.waiters == 0 && batch.cancel != nil {
+		batch.cancel()
+	}
+}
+
+func (c *batchingClient) flush(batch *heartbeatBatch) {
+	batch.flushOnce.Do(func() {
+		defer close(batch.doneCh)
+
+		var ctx context.Context
+		c.Lock()
+		if c.batches[batch.shardID] == batch {
+			delete(c.batches, batch.shardID)
+		}
+		if batch.waiters == 0 {
+			c.Unlock()
+			batch.err = context.Canceled
+			return
+		}
+		if batch.hasDeadline {
+			ctx, batch.cancel = context.WithDeadline(context.Background(), batch.deadline)
+		} else {
+			ctx, batch.cancel = context.WithCancel(context.Background())
+		}
+		c.Unlock()
+		defer batch.cancel()
+
+		batch.response, batch.err = c.client.RecordActivityTaskHeartbeatBatch(
+			ctx,
+			&h.RecordActivityTaskHeartbeatBatchRequest{Requests: batch.requests},
+		)
+	})
+}
+
+func recordActivityTaskHeartbeatResultError(
+	result *h.RecordActivityTaskHeartbeatResult,
+) error {
+
+	switch {
+	case result.BadRequestError != nil:
+		return result.BadRequestError
+	case result.InternalServiceError != nil:
+		return result.InternalServiceError
+	case result.EntityNotExistError != nil:
+		return result.EntityNotExistError
+	case result.ShardOwnershipLostError != nil:
+		return result.ShardOwnershipLostError
+	case result.DomainNotActiveError != nil:
+		return result.DomainNotActiveError
+	case result.LimitExceededError != nil:
+		return result.LimitExceededError
+	case result.ServiceBusyError != nil:
+		return result.ServiceBusyError
+	}
+	return nil
+}
+
+func (c *batchingClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *h.StartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
+	return c.client.StartWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) DescribeHistoryHost(
+	ctx context.Context,
+	request *shared.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeHistoryHostResponse, error) {
+	return c.client.DescribeHistoryHost(ctx, request, opts...)
+}
+
+func (c *batchingClient) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.CloseShard(ctx, request, opts...)
+}
+
+func (c *batchingClient) DescribeQueue(
+	ctx context.Context,
+	request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeQueueResponse, error) {
+	return c.client.DescribeQueue(ctx, request, opts...)
+}
+
+func (c *batchingClient) ResetQueueState(
+	ctx context.Context,
+	request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.ResetQueueState(ctx, request, opts...)
+}
+
+func (c *batchingClient) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RemoveTask(ctx, request, opts...)
+}
+
+func (c *batchingClient) DescribeMutableState(
+	ctx context.Context,
+	request *h.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.DescribeMutableStateResponse, error) {
+	return c.client.DescribeMutableState(ctx, request, opts...)
+}
+
+func (c *batchingClient) GetMutableState(
+	ctx context.Context,
+	request *h.GetMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.GetMutableStateResponse, error) {
+	return c.client.GetMutableState(ctx, request, opts...)
+}
+
+func (c *batchingClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *h.ResetStickyTaskListRequest,
+	opts ...yarpc.CallOption) (*h.ResetStickyTaskListResponse, error) {
+	return c.client.ResetStickyTaskList(ctx, request, opts...)
+}
+
+func (c *batchingClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *h.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeWorkflowExecutionResponse, error) {
+	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) RecordDecisionTaskStarted(
+	ctx context.Context,
+	request *h.RecordDecisionTaskStartedRequest,
+	opts ...yarpc.CallOption) (*h.RecordDecisionTaskStartedResponse, error) {
+	return c.client.RecordDecisionTaskStarted(ctx, request, opts...)
+}
+
+func (c *batchingClient) RecordActivityTaskStarted(
+	ctx context.Context,
+	request *h.RecordActivityTaskStartedRequest,
+	opts ...yarpc.CallOption) (*h.RecordActivityTaskStartedResponse, error) {
+	return c.client.RecordActivityTaskStarted(ctx, request, opts...)
+}
+
+func (c *batchingClient) RecordActivityTaskWorkerUnavailable(
+	ctx context.Context,
+	request *h.RecordActivityTaskWorkerUnavailableRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RecordActivityTaskWorkerUnavailable(ctx, request, opts...)
+}
+
+func (c *batchingClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *h.RespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption) (*h.RespondDecisionTaskCompletedResponse, error) {
+	return c.client.RespondDecisionTaskCompleted(ctx, request, opts...)
+}
+
+func (c *batchingClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *h.RespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RespondDecisionTaskFailed(ctx, request, opts...)
+}
+
+func (c *batchingClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *h.RespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RespondActivityTaskCompleted(ctx, request, opts...)
+}
+
+func (c *batchingClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *h.RespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RespondActivityTaskFailed(ctx, request, opts...)
+}
+
+func (c *batchingClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *h.RespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RespondActivityTaskCanceled(ctx, request, opts...)
+}
+
+func (c *batchingClient) RecordActivityTaskHeartbeatBatch(
+	ctx context.Context,
+	request *h.RecordActivityTaskHeartbeatBatchRequest,
+	opts ...yarpc.CallOption) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+	return c.client.RecordActivityTaskHeartbeatBatch(ctx, request, opts...)
+}
+
+func (c *batchingClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *h.RequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *h.SignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.SignalWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) AddOperatorAnnotation(
+	ctx context.Context,
+	request *h.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.AddOperatorAnnotation(ctx, request, opts...)
+}
+
+func (c *batchingClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
+	return c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) RemoveSignalMutableState(
+	ctx context.Context,
+	request *h.RemoveSignalMutableStateRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RemoveSignalMutableState(ctx, request, opts...)
+}
+
+func (c *batchingClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *h.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.TerminateWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *h.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
+	return c.client.ResetWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) ScheduleDecisionTask(
+	ctx context.Context,
+	request *h.ScheduleDecisionTaskRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.ScheduleDecisionTask(ctx, request, opts...)
+}
+
+func (c *batchingClient) RecordChildExecutionCompleted(
+	ctx context.Context,
+	request *h.RecordChildExecutionCompletedRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RecordChildExecutionCompleted(ctx, request, opts...)
+}
+
+func (c *batchingClient) ReplicateEvents(
+	ctx context.Context,
+	request *h.ReplicateEventsRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.ReplicateEvents(ctx, request, opts...)
+}
+
+func (c *batchingClient) ReplicateRawEvents(
+	ctx context.Context,
+	request *h.ReplicateRawEventsRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.ReplicateRawEvents(ctx, request, opts...)
+}
+
+func (c *batchingClient) SyncShardStatus(
+	ctx context.Context,
+	request *h.SyncShardStatusRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.SyncShardStatus(ctx, request, opts...)
+}
+
+func (c *batchingClient) SyncActivity(
+	ctx context.Context,
+	request *h.SyncActivityRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.SyncActivity(ctx, request, opts...)
+}
+
+func (c *batchingClient) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetReplicationMessagesResponse, error) {
+	return c.client.GetReplicationMessages(ctx, request, opts...)
+}
+
+func (c *batchingClient) QueryWorkflow(
+	ctx context.Context,
+	request *h.QueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*h.QueryWorkflowResponse, error) {
+	return c.client.QueryWorkflow(ctx, request, opts...)
+}
+
+func (c *batchingClient) GetReplicationStatus(
+	ctx context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*h.GetReplicationStatusResponse, error) {
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
+func (c *batchingClient) DetectZombieWorkflowExecution(
+	ctx context.Context,
+	request *h.DetectZombieWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*h.DetectZombieWorkflowExecutionResponse, error) {
+	return c.client.DetectZombieWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.client.DeleteWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *h.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.client.ReindexWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *batchingClient) SetTerminationProtection(
+	ctx context.Context,
+	request *h.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.client.SetTerminationProtection(ctx, request, opts...)
+}
+
+func (c *batchingClient) ReplicateWorkflowExecution(
+	ctx context.Context,
+	request *h.ReplicateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return c.client.ReplicateWorkflowExecution(ctx, request, opts...)
+}
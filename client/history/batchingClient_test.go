@@ -0,0 +1,202 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	batchingClientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		mockClient *historyservicetest.MockClient
+		client     Client
+	}
+)
+
+func TestBatchingClientSuite(t *testing.T) {
+	s := new(batchingClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *batchingClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockClient = historyservicetest.NewMockClient(s.controller)
+	s.client = NewBatchingClient(
+		s.mockClient,
+		1,
+		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+		dynamicconfig.GetIntPropertyFn(2),
+	)
+}
+
+func (s *batchingClientSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *batchingClientSuite) TestRecordActivityTaskHeartbeat_Batched() {
+	request1 := s.newHeartbeatRequest("workflow-1")
+	request2 := s.newHeartbeatRequest("workflow-2")
+	s.mockClient.EXPECT().RecordActivityTaskHeartbeatBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *h.RecordActivityTaskHeartbeatBatchRequest, _ ...interface{}) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+			s.Len(request.Requests, 2)
+			results := make([]*h.RecordActivityTaskHeartbeatResult, 2)
+			for i, r := range request.Requests {
+				if r == request1 {
+					results[i] = &h.RecordActivityTaskHeartbeatResult{
+						Response: &shared.RecordActivityTaskHeartbeatResponse{CancelRequested: common.BoolPtr(true)},
+					}
+				} else {
+					results[i] = &h.RecordActivityTaskHeartbeatResult{
+						EntityNotExistError: &shared.EntityNotExistsError{},
+					}
+				}
+			}
+			return &h.RecordActivityTaskHeartbeatBatchResponse{Results: results}, nil
+		}).Times(1)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		resp, err := s.client.RecordActivityTaskHeartbeat(context.Background(), request1)
+		s.NoError(err)
+		s.True(resp.GetCancelRequested())
+	}()
+	go func() {
+		defer wg.Done()
+		_, err := s.client.RecordActivityTaskHeartbeat(context.Background(), request2)
+		s.IsType(&shared.EntityNotExistsError{}, err)
+	}()
+	wg.Wait()
+}
+
+func (s *batchingClientSuite) TestRecordActivityTaskHeartbeat_BatchFailed_FallbackToIndividualCalls() {
+	request1 := s.newHeartbeatRequest("workflow-1")
+	request2 := s.newHeartbeatRequest("workflow-2")
+	s.mockClient.EXPECT().RecordActivityTaskHeartbeatBatch(gomock.Any(), gomock.Any()).
+		Return(nil, &shared.InternalServiceError{}).Times(1)
+	s.mockClient.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), gomock.Any()).
+		Return(&shared.RecordActivityTaskHeartbeatResponse{}, nil).Times(2)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for _, request := range []*h.RecordActivityTaskHeartbeatRequest{request1, request2} {
+		go func(request *h.RecordActivityTaskHeartbeatRequest) {
+			defer wg.Done()
+			_, err := s.client.RecordActivityTaskHeartbeat(context.Background(), request)
+			s.NoError(err)
+		}(request)
+	}
+	wg.Wait()
+}
+
+func (s *batchingClientSuite) TestRecordActivityTaskHeartbeat_CallerDeadline() {
+	s.client.(*batchingClient).window = dynamicconfig.GetDurationPropertyFn(time.Millisecond)
+	request := s.newHeartbeatRequest("workflow-1")
+	deadline := time.Now().Add(time.Minute)
+	s.mockClient.EXPECT().RecordActivityTaskHeartbeatBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *h.RecordActivityTaskHeartbeatBatchRequest, _ ...interface{}) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+			batchDeadline, ok := ctx.Deadline()
+			s.True(ok)
+			s.Equal(deadline, batchDeadline)
+			return &h.RecordActivityTaskHeartbeatBatchResponse{
+				Results: []*h.RecordActivityTaskHeartbeatResult{{Response: &shared.RecordActivityTaskHeartbeatResponse{}}},
+			}, nil
+		}).Times(1)
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	_, err := s.client.RecordActivityTaskHeartbeat(ctx, request)
+	s.NoError(err)
+}
+
+func (s *batchingClientSuite) TestRecordActivityTaskHeartbeat_CallerCanceled() {
+	s.client.(*batchingClient).window = dynamicconfig.GetDurationPropertyFn(time.Millisecond)
+	request := s.newHeartbeatRequest("workflow-1")
+	startedCh := make(chan struct{})
+	s.mockClient.EXPECT().RecordActivityTaskHeartbeatBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *h.RecordActivityTaskHeartbeatBatchRequest, _ ...interface{}) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+			close(startedCh)
+			// the batch call is canceled once its only caller gave up
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-startedCh
+		cancel()
+	}()
+	_, err := s.client.RecordActivityTaskHeartbeat(ctx, request)
+	s.Equal(context.Canceled, err)
+}
+
+func (s *batchingClientSuite) TestRecordActivityTaskHeartbeat_Disabled() {
+	s.client.(*batchingClient).enabled = dynamicconfig.GetBoolPropertyFn(false)
+	request := s.newHeartbeatRequest("workflow-1")
+	s.mockClient.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), request).
+		Return(&shared.RecordActivityTaskHeartbeatResponse{}, nil).Times(1)
+
+	_, err := s.client.RecordActivityTaskHeartbeat(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *batchingClientSuite) TestRecordActivityTaskHeartbeat_CancellationWait_NotBatched() {
+	request := s.newHeartbeatRequest("workflow-1")
+	request.HeartbeatRequest.CancellationWaitSeconds = common.Int32Ptr(5)
+	s.mockClient.EXPECT().RecordActivityTaskHeartbeat(gomock.Any(), request).
+		Return(&shared.RecordActivityTaskHeartbeatResponse{}, nil).Times(1)
+
+	_, err := s.client.RecordActivityTaskHeartbeat(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *batchingClientSuite) newHeartbeatRequest(workflowID string) *h.RecordActivityTaskHeartbeatRequest {
+	token, err := common.NewJSONTaskTokenSerializer().Serialize(&common.TaskToken{
+		DomainID:   "some random domain ID",
+		WorkflowID: workflowID,
+		RunID:      "some random run ID",
+		ScheduleID: 5,
+	})
+	s.NoError(err)
+	return &h.RecordActivityTaskHeartbeatRequest{
+		DomainUUID:       common.StringPtr("some random domain ID"),
+		HeartbeatRequest: &shared.RecordActivityTaskHeartbeatRequest{TaskToken: token},
+	}
+}
@@ -199,6 +199,49 @@ func (c *clientImpl) CloseShard(
 	return nil
 }
 
+func (c *clientImpl) DescribeQueue(
+	ctx context.Context,
+	request *workflow.DescribeQueueRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeQueueResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.DescribeQueueResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeQueue(ctx, request, opts...)
+		return err
+	}
+
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ResetQueueState(
+	ctx context.Context,
+	request *workflow.ResetQueueStateRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ResetQueueState(ctx, request, opts...)
+	}
+
+	return c.executeWithRedirect(ctx, client, op)
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *h.DescribeMutableStateRequest,
@@ -319,6 +362,24 @@ func (c *clientImpl) RecordActivityTaskStarted(
 	return response, nil
 }
 
+func (c *clientImpl) RecordActivityTaskWorkerUnavailable(
+	ctx context.Context,
+	request *h.RecordActivityTaskWorkerUnavailableRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RecordActivityTaskWorkerUnavailable(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	request *h.RespondDecisionTaskCompletedRequest,
@@ -459,6 +520,89 @@ func (c *clientImpl) RecordActivityTaskHeartbeat(
 	return response, nil
 }
 
+// RecordActivityTaskHeartbeatBatch splits the batch by history shard and sends each part to the host owning
+// the shard. The results are returned in the order of the requests, the batch fails if any of its parts fails.
+func (c *clientImpl) RecordActivityTaskHeartbeatBatch(
+	ctx context.Context,
+	request *h.RecordActivityTaskHeartbeatBatchRequest,
+	opts ...yarpc.CallOption) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+
+	requestIndexesByShard := make(map[int][]int)
+	for i, heartbeatRequest := range request.GetRequests() {
+		taskToken, err := c.tokenSerializer.Deserialize(heartbeatRequest.GetHeartbeatRequest().GetTaskToken())
+		if err != nil {
+			return nil, err
+		}
+		shardID := common.WorkflowIDToHistoryShard(taskToken.WorkflowID, c.numberOfShards)
+		requestIndexesByShard[shardID] = append(requestIndexesByShard[shardID], i)
+	}
+	if len(requestIndexesByShard) <= 1 {
+		for shardID := range requestIndexesByShard {
+			return c.recordActivityTaskHeartbeatBatchForShard(ctx, shardID, request, opts...)
+		}
+		return &h.RecordActivityTaskHeartbeatBatchResponse{}, nil
+	}
+
+	results := make([]*h.RecordActivityTaskHeartbeatResult, len(request.GetRequests()))
+	errCh := make(chan error, len(requestIndexesByShard))
+	for shardID, indexes := range requestIndexesByShard {
+		go func(shardID int, indexes []int) {
+			shardRequest := &h.RecordActivityTaskHeartbeatBatchRequest{
+				Requests: make([]*h.RecordActivityTaskHeartbeatRequest, 0, len(indexes)),
+			}
+			for _, index := range indexes {
+				shardRequest.Requests = append(shardRequest.Requests, request.Requests[index])
+			}
+			response, err := c.recordActivityTaskHeartbeatBatchForShard(ctx, shardID, shardRequest, opts...)
+			if err == nil && len(response.GetResults()) != len(indexes) {
+				err = &workflow.InternalServiceError{Message: "Heartbeat batch response does not match the request."}
+			}
+			if err == nil {
+				for i, index := range indexes {
+					results[index] = response.Results[i]
+				}
+			}
+			errCh <- err
+		}(shardID, indexes)
+	}
+
+	var err error
+	for range requestIndexesByShard {
+		if shardErr := <-errCh; shardErr != nil {
+			err = shardErr
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &h.RecordActivityTaskHeartbeatBatchResponse{Results: results}, nil
+}
+
+func (c *clientImpl) recordActivityTaskHeartbeatBatchForShard(
+	ctx context.Context,
+	shardID int,
+	request *h.RecordActivityTaskHeartbeatBatchRequest,
+	opts ...yarpc.CallOption) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+	client, err := c.getClientForShardID(shardID)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.RecordActivityTaskHeartbeatBatchResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RecordActivityTaskHeartbeatBatch(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	request *h.RequestCancelWorkflowExecutionRequest,
@@ -495,6 +639,25 @@ func (c *clientImpl) SignalWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) AddOperatorAnnotation(
+	ctx context.Context,
+	request *h.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(request.AnnotationRequest.WorkflowExecution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.AddOperatorAnnotation(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+
+	return err
+}
+
 func (c *clientImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
@@ -556,6 +719,42 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.PauseRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.ResumeRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *h.ResetWorkflowExecutionRequest,
@@ -768,6 +967,64 @@ func (c *clientImpl) GetReplicationMessages(
 	return response, nil
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*h.GetReplicationStatusResponse, error) {
+	requestsByClient := make(map[historyserviceclient.Interface]*h.GetReplicationStatusRequest)
+
+	for _, shardID := range request.ShardIDs {
+		client, err := c.getClientForShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByClient[client]; !ok {
+			requestsByClient[client] = &h.GetReplicationStatusRequest{}
+		}
+
+		req := requestsByClient[client]
+		req.ShardIDs = append(req.ShardIDs, shardID)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(requestsByClient))
+	respChan := make(chan *h.GetReplicationStatusResponse, len(requestsByClient))
+	errChan := make(chan error, len(requestsByClient))
+	for client, req := range requestsByClient {
+		go func(client historyserviceclient.Interface, request *h.GetReplicationStatusRequest) {
+			defer wg.Done()
+
+			ctx, cancel := c.createContext(ctx)
+			defer cancel()
+			resp, err := client.GetReplicationStatus(ctx, request, opts...)
+			if err != nil {
+				c.logger.Warn("Failed to get replication status from client", tag.Error(err))
+				errChan <- err
+				return
+			}
+			respChan <- resp
+		}(client, req)
+	}
+
+	wg.Wait()
+	close(respChan)
+	close(errChan)
+
+	// unlike replication message fetching, a partial status is useless to the caller
+	if err, ok := <-errChan; ok {
+		return nil, err
+	}
+
+	response := &h.GetReplicationStatusResponse{}
+	for resp := range respChan {
+		response.Shards = append(response.Shards, resp.Shards...)
+	}
+
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -816,3 +1073,95 @@ redirectLoop:
 	}
 	return err
 }
+
+func (c *clientImpl) DetectZombieWorkflowExecution(
+	ctx context.Context,
+	request *h.DetectZombieWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*h.DetectZombieWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.DetectZombieWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DetectZombieWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(request.Execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *h.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(request.Execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ReindexWorkflowExecution(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) SetTerminationProtection(
+	ctx context.Context,
+	request *h.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(request.Execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.SetTerminationProtection(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) ReplicateWorkflowExecution(
+	ctx context.Context,
+	request *h.ReplicateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(request.Execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ReplicateWorkflowExecution(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+)
+
+func TestRecordActivityTaskHeartbeatBatch_SplitByShard(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	numberOfShards := 2
+	mockClients := []*historyservicetest.MockClient{
+		historyservicetest.NewMockClient(controller),
+		historyservicetest.NewMockClient(controller),
+	}
+	clients := common.NewClientCache(
+		func(key string) (string, error) { return key, nil },
+		func(clientKey string) (interface{}, error) { return mockClients[int([]rune(clientKey)[0])], nil },
+	)
+	client := NewClient(numberOfShards, DefaultTimeout, clients, log.NewNoop())
+
+	// one heartbeat of the first shard between two heartbeats of the second shard
+	var requests []*h.RecordActivityTaskHeartbeatRequest
+	var workflowIDs []string
+	for i := 0; len(requests) < 3; i++ {
+		workflowID := fmt.Sprintf("workflow-%v", i)
+		shardID := common.WorkflowIDToHistoryShard(workflowID, numberOfShards)
+		if shardID != len(requests)%2 {
+			continue
+		}
+		token, err := common.NewJSONTaskTokenSerializer().Serialize(&common.TaskToken{WorkflowID: workflowID})
+		require.NoError(t, err)
+		requests = append(requests, &h.RecordActivityTaskHeartbeatRequest{
+			HeartbeatRequest: &shared.RecordActivityTaskHeartbeatRequest{TaskToken: token},
+		})
+		workflowIDs = append(workflowIDs, workflowID)
+	}
+
+	resultFor := func(request *h.RecordActivityTaskHeartbeatRequest) *h.RecordActivityTaskHeartbeatResult {
+		return &h.RecordActivityTaskHeartbeatResult{
+			Response: &shared.RecordActivityTaskHeartbeatResponse{
+				CancelRequested: common.BoolPtr(request == requests[1]),
+			},
+		}
+	}
+	for shardID, mockClient := range mockClients {
+		expected := requests[1:2]
+		if shardID == 0 {
+			expected = []*h.RecordActivityTaskHeartbeatRequest{requests[0], requests[2]}
+		}
+		mockClient.EXPECT().RecordActivityTaskHeartbeatBatch(gomock.Any(), &h.RecordActivityTaskHeartbeatBatchRequest{
+			Requests: expected,
+		}, gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *h.RecordActivityTaskHeartbeatBatchRequest, _ ...interface{}) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+				response := &h.RecordActivityTaskHeartbeatBatchResponse{}
+				for _, r := range request.Requests {
+					response.Results = append(response.Results, resultFor(r))
+				}
+				return response, nil
+			}).Times(1)
+	}
+
+	response, err := client.RecordActivityTaskHeartbeatBatch(context.Background(), &h.RecordActivityTaskHeartbeatBatchRequest{
+		Requests: requests,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 3)
+	for i, result := range response.Results {
+		require.Equal(t, i == 1, result.Response.GetCancelRequested(), workflowIDs[i])
+	}
+}
@@ -89,6 +89,24 @@ func (c *metricClient) CloseShard(
 	return err
 }
 
+func (c *metricClient) DescribeQueue(
+	context context.Context,
+	request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeQueueResponse, error) {
+	resp, err := c.client.DescribeQueue(context, request, opts...)
+
+	return resp, err
+}
+
+func (c *metricClient) ResetQueueState(
+	context context.Context,
+	request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption) error {
+	err := c.client.ResetQueueState(context, request, opts...)
+
+	return err
+}
+
 func (c *metricClient) DescribeMutableState(
 	context context.Context,
 	request *h.DescribeMutableStateRequest,
@@ -183,6 +201,23 @@ func (c *metricClient) RecordActivityTaskStarted(
 	return resp, err
 }
 
+func (c *metricClient) RecordActivityTaskWorkerUnavailable(
+	context context.Context,
+	request *h.RecordActivityTaskWorkerUnavailableRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskWorkerUnavailableScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRecordActivityTaskWorkerUnavailableScope, metrics.CadenceClientLatency)
+	err := c.client.RecordActivityTaskWorkerUnavailable(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskWorkerUnavailableScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) RespondDecisionTaskCompleted(
 	context context.Context,
 	request *h.RespondDecisionTaskCompletedRequest,
@@ -285,6 +320,23 @@ func (c *metricClient) RecordActivityTaskHeartbeat(
 	return resp, err
 }
 
+func (c *metricClient) RecordActivityTaskHeartbeatBatch(
+	context context.Context,
+	request *h.RecordActivityTaskHeartbeatBatchRequest,
+	opts ...yarpc.CallOption) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskHeartbeatBatchScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRecordActivityTaskHeartbeatBatchScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RecordActivityTaskHeartbeatBatch(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRecordActivityTaskHeartbeatBatchScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) RequestCancelWorkflowExecution(
 	context context.Context,
 	request *h.RequestCancelWorkflowExecutionRequest,
@@ -319,6 +371,23 @@ func (c *metricClient) SignalWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) AddOperatorAnnotation(
+	context context.Context,
+	request *h.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientAddOperatorAnnotationScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientAddOperatorAnnotationScope, metrics.CadenceClientLatency)
+	err := c.client.AddOperatorAnnotation(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientAddOperatorAnnotationScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) SignalWithStartWorkflowExecution(
 	context context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
@@ -370,6 +439,40 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	context context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ResumeWorkflowExecution(
+	context context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ResumeWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResumeWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) ResetWorkflowExecution(
 	context context.Context,
 	request *h.ResetWorkflowExecutionRequest,
@@ -524,3 +627,111 @@ func (c *metricClient) QueryWorkflow(
 
 	return resp, err
 }
+
+func (c *metricClient) GetReplicationStatus(
+	ctx context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*h.GetReplicationStatusResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationStatus(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DetectZombieWorkflowExecution(
+	ctx context.Context,
+	request *h.DetectZombieWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*h.DetectZombieWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDetectZombieWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDetectZombieWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DetectZombieWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDetectZombieWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *h.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientReindexWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReindexWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ReindexWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReindexWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) SetTerminationProtection(
+	ctx context.Context,
+	request *h.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientSetTerminationProtectionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSetTerminationProtectionScope, metrics.CadenceClientLatency)
+	err := c.client.SetTerminationProtection(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSetTerminationProtectionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ReplicateWorkflowExecution(
+	ctx context.Context,
+	request *h.ReplicateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientReplicateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReplicateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ReplicateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReplicateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
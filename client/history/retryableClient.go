@@ -93,6 +93,34 @@ func (c *retryableClient) CloseShard(
 	return err
 }
 
+func (c *retryableClient) DescribeQueue(
+	ctx context.Context,
+	request *shared.DescribeQueueRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeQueueResponse, error) {
+
+	var resp *shared.DescribeQueueResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeQueue(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetQueueState(
+	ctx context.Context,
+	request *shared.ResetQueueStateRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.ResetQueueState(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) RemoveTask(
 	ctx context.Context,
 	request *shared.RemoveTaskRequest,
@@ -204,6 +232,18 @@ func (c *retryableClient) RecordActivityTaskStarted(
 	return resp, err
 }
 
+func (c *retryableClient) RecordActivityTaskWorkerUnavailable(
+	ctx context.Context,
+	request *h.RecordActivityTaskWorkerUnavailableRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RecordActivityTaskWorkerUnavailable(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	request *h.RespondDecisionTaskCompletedRequest,
@@ -284,6 +324,22 @@ func (c *retryableClient) RecordActivityTaskHeartbeat(
 	return resp, err
 }
 
+func (c *retryableClient) RecordActivityTaskHeartbeatBatch(
+	ctx context.Context,
+	request *h.RecordActivityTaskHeartbeatBatchRequest,
+	opts ...yarpc.CallOption) (*h.RecordActivityTaskHeartbeatBatchResponse, error) {
+
+	var resp *h.RecordActivityTaskHeartbeatBatchResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RecordActivityTaskHeartbeatBatch(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	request *h.RequestCancelWorkflowExecutionRequest,
@@ -308,6 +364,18 @@ func (c *retryableClient) SignalWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) AddOperatorAnnotation(
+	ctx context.Context,
+	request *h.AddOperatorAnnotationRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.AddOperatorAnnotation(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
@@ -348,6 +416,30 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *h.ResumeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) ResetWorkflowExecution(
 	ctx context.Context,
 	request *h.ResetWorkflowExecutionRequest,
@@ -467,3 +559,88 @@ func (c *retryableClient) QueryWorkflow(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) GetReplicationStatus(
+	ctx context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*h.GetReplicationStatusResponse, error) {
+	var resp *h.GetReplicationStatusResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DetectZombieWorkflowExecution(
+	ctx context.Context,
+	request *h.DetectZombieWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*h.DetectZombieWorkflowExecutionResponse, error) {
+
+	var resp *h.DetectZombieWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DetectZombieWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *h.ReindexWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReindexWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) SetTerminationProtection(
+	ctx context.Context,
+	request *h.SetTerminationProtectionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.SetTerminationProtection(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReplicateWorkflowExecution(
+	ctx context.Context,
+	request *h.ReplicateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReplicateWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
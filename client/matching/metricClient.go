@@ -215,6 +215,23 @@ func (c *metricClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *metricClient) ListTaskListWorkers(
+	ctx context.Context,
+	request *m.ListTaskListWorkersRequest,
+	opts ...yarpc.CallOption) (*m.ListTaskListWorkersResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientListTaskListWorkersScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientListTaskListWorkersScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListTaskListWorkers(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientListTaskListWorkersScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) emitForwardedFromStats(scope int, forwardedFrom string, taskList *workflow.TaskList) {
 	if taskList == nil {
 		return
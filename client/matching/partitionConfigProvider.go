@@ -0,0 +1,262 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// PartitionConfigProvider returns the number of read and write partitions of task lists. The
+	// partitions of the task lists scaled by the adaptive scaler are refreshed asynchronously from
+	// the root partition, the static config is used for the other task lists and until the partition
+	// config of a task list is known
+	PartitionConfigProvider interface {
+		// GetNumberOfReadPartitions returns the number of read partitions of a task list
+		GetNumberOfReadPartitions(domainID string, taskList shared.TaskList, taskListType int) int
+		// GetNumberOfWritePartitions returns the number of write partitions of a task list
+		GetNumberOfWritePartitions(domainID string, taskList shared.TaskList, taskListType int) int
+		// UpdatePartitionConfig caches the partition config of a task list, configs older than the cached one are ignored
+		UpdatePartitionConfig(domainID string, taskList shared.TaskList, taskListType int, config *shared.TaskListPartitionConfig)
+	}
+
+	// PartitionConfigDescriber returns the partition config of a task list decided by the adaptive scaler
+	PartitionConfigDescriber func(
+		ctx context.Context,
+		domainID string,
+		taskList shared.TaskList,
+		taskListType int,
+	) (*shared.TaskListPartitionConfig, error)
+
+	// PartitionConfigProviderConfig is the dynamic config of a PartitionConfigProvider
+	PartitionConfigProviderConfig struct {
+		EnableAdaptiveScaler           dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		NumTasklistReadPartitions      dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		NumTasklistWritePartitions     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionConfigRefreshInterval dynamicconfig.DurationPropertyFn
+	}
+
+	partitionConfigProviderImpl struct {
+		config         *PartitionConfigProviderConfig
+		domainIDToName func(string) (string, error)
+		describe       PartitionConfigDescriber
+		logger         log.Logger
+		configs        cache.Cache
+	}
+
+	partitionConfigKey struct {
+		domainID     string
+		name         string
+		taskListType int
+	}
+
+	partitionConfigEntry struct {
+		sync.RWMutex
+		config      *shared.TaskListPartitionConfig
+		lastRefresh time.Time
+		refreshing  int32
+	}
+)
+
+const (
+	partitionConfigCacheSize       = 10000
+	partitionConfigCacheTTL        = time.Hour
+	partitionConfigDescribeTimeout = 5 * time.Second
+)
+
+// NewPartitionConfigProviderConfig returns the dynamic config of a PartitionConfigProvider
+func NewPartitionConfigProviderConfig(dc *dynamicconfig.Collection) *PartitionConfigProviderConfig {
+	return &PartitionConfigProviderConfig{
+		EnableAdaptiveScaler:           dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveScaler, false),
+		NumTasklistReadPartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
+		NumTasklistWritePartitions:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+		PartitionConfigRefreshInterval: dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval, 10*time.Second),
+	}
+}
+
+// NewPartitionConfigProvider returns a PartitionConfigProvider refreshing the partition configs
+// decided by the adaptive scaler with the given describer
+func NewPartitionConfigProvider(
+	config *PartitionConfigProviderConfig,
+	domainIDToName func(string) (string, error),
+	describe PartitionConfigDescriber,
+	logger log.Logger,
+) PartitionConfigProvider {
+	return &partitionConfigProviderImpl{
+		config:         config,
+		domainIDToName: domainIDToName,
+		describe:       describe,
+		logger:         logger,
+		configs: cache.New(partitionConfigCacheSize, &cache.Options{
+			TTL: partitionConfigCacheTTL,
+		}),
+	}
+}
+
+// DescribePartitionConfig returns the partition config of a task list from the matching host owning its root partition
+func DescribePartitionConfig(
+	ctx context.Context,
+	client Client,
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) (*shared.TaskListPartitionConfig, error) {
+	descTaskListType := shared.TaskListTypeDecision
+	if taskListType == int(shared.TaskListTypeActivity) {
+		descTaskListType = shared.TaskListTypeActivity
+	}
+	resp, err := client.DescribeTaskList(ctx, &m.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &shared.DescribeTaskListRequest{
+			TaskList:     &taskList,
+			TaskListType: &descTaskListType,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PartitionConfig, nil
+}
+
+func (p *partitionConfigProviderImpl) GetNumberOfReadPartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) int {
+	if config := p.getPartitionConfig(domainID, taskList, taskListType); config != nil {
+		return int(config.GetNumReadPartitions())
+	}
+	return p.staticPartitions(domainID, taskList, taskListType, p.config.NumTasklistReadPartitions)
+}
+
+func (p *partitionConfigProviderImpl) GetNumberOfWritePartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) int {
+	if config := p.getPartitionConfig(domainID, taskList, taskListType); config != nil {
+		return int(config.GetNumWritePartitions())
+	}
+	return p.staticPartitions(domainID, taskList, taskListType, p.config.NumTasklistWritePartitions)
+}
+
+func (p *partitionConfigProviderImpl) UpdatePartitionConfig(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+	config *shared.TaskListPartitionConfig,
+) {
+	entry := p.getEntry(partitionConfigKey{domainID: domainID, name: taskList.GetName(), taskListType: taskListType})
+	entry.Lock()
+	defer entry.Unlock()
+	entry.lastRefresh = time.Now()
+	if config != nil && (entry.config == nil || config.GetVersion() > entry.config.GetVersion()) {
+		entry.config = config
+	}
+}
+
+// getPartitionConfig returns the partition config decided by the adaptive scaler, nil if the task list
+// is not scaled automatically or its config is not known yet
+func (p *partitionConfigProviderImpl) getPartitionConfig(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) *shared.TaskListPartitionConfig {
+	domainName, err := p.domainIDToName(domainID)
+	if err != nil || !p.config.EnableAdaptiveScaler(domainName, taskList.GetName(), taskListType) {
+		return nil
+	}
+
+	key := partitionConfigKey{domainID: domainID, name: taskList.GetName(), taskListType: taskListType}
+	entry := p.getEntry(key)
+	entry.RLock()
+	config := entry.config
+	lastRefresh := entry.lastRefresh
+	entry.RUnlock()
+	if time.Since(lastRefresh) > p.config.PartitionConfigRefreshInterval() &&
+		atomic.CompareAndSwapInt32(&entry.refreshing, 0, 1) {
+		go p.refresh(key, taskList, entry)
+	}
+
+	if config == nil || config.GetNumReadPartitions() <= 0 || config.GetNumWritePartitions() <= 0 {
+		return nil
+	}
+	return config
+}
+
+func (p *partitionConfigProviderImpl) refresh(
+	key partitionConfigKey,
+	taskList shared.TaskList,
+	entry *partitionConfigEntry,
+) {
+	defer atomic.StoreInt32(&entry.refreshing, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), partitionConfigDescribeTimeout)
+	defer cancel()
+	// the root partition owns the partition config of the task list
+	root := shared.TaskList{Name: common.StringPtr(taskList.GetName()), Kind: shared.TaskListKindNormal.Ptr()}
+	config, err := p.describe(ctx, key.domainID, root, key.taskListType)
+	if err != nil {
+		p.logger.Warn("Failed to refresh task list partition config",
+			tag.WorkflowDomainID(key.domainID),
+			tag.WorkflowTaskListName(key.name),
+			tag.WorkflowTaskListType(key.taskListType),
+			tag.Error(err))
+		// retry once the refresh interval elapsed again
+		entry.Lock()
+		entry.lastRefresh = time.Now()
+		entry.Unlock()
+		return
+	}
+	p.UpdatePartitionConfig(key.domainID, root, key.taskListType, config)
+}
+
+func (p *partitionConfigProviderImpl) getEntry(key partitionConfigKey) *partitionConfigEntry {
+	if entry, ok := p.configs.Get(key).(*partitionConfigEntry); ok {
+		return entry
+	}
+	entry, _ := p.configs.PutIfNotExist(key, &partitionConfigEntry{})
+	return entry.(*partitionConfigEntry)
+}
+
+func (p *partitionConfigProviderImpl) staticPartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+	nPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+) int {
+	domainName, err := p.domainIDToName(domainID)
+	if err != nil {
+		return 1
+	}
+	return common.MaxInt(1, nPartitions(domainName, taskList.GetName(), taskListType))
+}
@@ -156,3 +156,19 @@ func (c *retryableClient) DescribeTaskList(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ListTaskListWorkers(
+	ctx context.Context,
+	request *m.ListTaskListWorkersRequest,
+	opts ...yarpc.CallOption) (*m.ListTaskListWorkersResponse, error) {
+
+	var resp *m.ListTaskListWorkersResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListTaskListWorkers(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
@@ -126,6 +127,11 @@ func (s *server) startService() common.Daemon {
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
+	params.HTTPGateway = svcCfg.HTTPGateway
+	params.TaskTokenConfig = s.cfg.TaskToken
+	params.AdmissionControl = s.cfg.AdmissionControl
+	params.MeteringConfig = s.cfg.Metering
+	params.IDGeneratorConfig = s.cfg.IDGenerator
 
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
 
@@ -151,10 +157,15 @@ func (s *server) startService() common.Daemon {
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)()
 	isAdvancedVisEnabled := advancedVisMode != common.AdvancedVisibilityWritingModeOff
-	if params.ClusterMetadata.IsGlobalDomainEnabled() {
-		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, isAdvancedVisEnabled)
-	} else if isAdvancedVisEnabled {
-		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, isAdvancedVisEnabled)
+	isKafkaMeteringEnabled := s.cfg.Metering.Sink == metering.SinkKafka
+	checkKafkaApp := isAdvancedVisEnabled || isKafkaMeteringEnabled
+	// replication tasks pulled over RPC are served from persistence, so kafka is only needed for the other features
+	isKafkaReplicationEnabled := params.ClusterMetadata.IsGlobalDomainEnabled() &&
+		params.ClusterMetadata.GetReplicationConsumerConfig().Type != config.ReplicationConsumerTypeRPC
+	if isKafkaReplicationEnabled {
+		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, checkKafkaApp)
+	} else if checkKafkaApp {
+		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, checkKafkaApp)
 	} else {
 		params.MessagingClient = nil
 	}
@@ -0,0 +1,139 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+)
+
+const (
+	// WorkflowIDPatternPolicyName is the name of the policy denying workflow IDs not matching a regular expression,
+	// configured with the "pattern" option
+	WorkflowIDPatternPolicyName = "workflowIDPattern"
+	// RequiredSearchAttributesPolicyName is the name of the policy denying workflows started without the search
+	// attributes listed in the comma separated "keys" option
+	RequiredSearchAttributesPolicyName = "requiredSearchAttributes"
+
+	// domainsOption restricts a built-in policy to the comma separated list of domains, it applies to all
+	// domains if not set
+	domainsOption  = "domains"
+	patternOption  = "pattern"
+	keysOption     = "keys"
+	optionsListSep = ","
+)
+
+type (
+	// startAttributesPolicy evaluates the attributes shared by StartWorkflowExecution and
+	// SignalWithStartWorkflowExecution requests
+	startAttributesPolicy struct {
+		domains map[string]struct{}
+		admit   func(workflowID string, searchAttributes *shared.SearchAttributes) *Result
+	}
+)
+
+func init() {
+	Register(WorkflowIDPatternPolicyName, newWorkflowIDPatternPolicy)
+	Register(RequiredSearchAttributesPolicyName, newRequiredSearchAttributesPolicy)
+}
+
+func newWorkflowIDPatternPolicy(options map[string]string, logger log.Logger) (Policy, error) {
+	if options[patternOption] == "" {
+		return nil, errors.New("pattern option is not set")
+	}
+	pattern, err := regexp.Compile(options[patternOption])
+	if err != nil {
+		return nil, err
+	}
+	return &startAttributesPolicy{
+		domains: parseOptionsList(options[domainsOption]),
+		admit: func(workflowID string, _ *shared.SearchAttributes) *Result {
+			if !pattern.MatchString(workflowID) {
+				return Deny(fmt.Sprintf("WorkflowID does not match pattern %v.", pattern.String()))
+			}
+			return Allow()
+		},
+	}, nil
+}
+
+func newRequiredSearchAttributesPolicy(options map[string]string, logger log.Logger) (Policy, error) {
+	keys := parseOptionsList(options[keysOption])
+	if len(keys) == 0 {
+		return nil, errors.New("keys option is not set")
+	}
+	return &startAttributesPolicy{
+		domains: parseOptionsList(options[domainsOption]),
+		admit: func(_ string, searchAttributes *shared.SearchAttributes) *Result {
+			for key := range keys {
+				if _, ok := searchAttributes.GetIndexedFields()[key]; !ok {
+					return Deny(fmt.Sprintf("Search attribute %v is required.", key))
+				}
+			}
+			return Allow()
+		},
+	}, nil
+}
+
+func (p *startAttributesPolicy) AdmitStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*Result, error) {
+
+	if !p.appliesTo(request.GetDomain()) {
+		return Allow(), nil
+	}
+	return p.admit(request.GetWorkflowId(), request.SearchAttributes), nil
+}
+
+func (p *startAttributesPolicy) AdmitSignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (*Result, error) {
+
+	if !p.appliesTo(request.GetDomain()) {
+		return Allow(), nil
+	}
+	return p.admit(request.GetWorkflowId(), request.SearchAttributes), nil
+}
+
+func (p *startAttributesPolicy) appliesTo(domain string) bool {
+	if len(p.domains) == 0 {
+		return true
+	}
+	_, ok := p.domains[domain]
+	return ok
+}
+
+func parseOptionsList(value string) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, item := range strings.Split(value, optionsListSep) {
+		if item = strings.TrimSpace(item); item != "" {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admission
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// Decision is the outcome of evaluating an admission policy
+	Decision int
+
+	// Result is returned by an admission policy for a request
+	Result struct {
+		Decision Decision
+		// Reason is returned to the caller when the request is denied
+		Reason string
+		// StartRequest replaces the StartWorkflowExecution request when it is mutated
+		StartRequest *shared.StartWorkflowExecutionRequest
+		// SignalWithStartRequest replaces the SignalWithStartWorkflowExecution request when it is mutated
+		SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest
+	}
+
+	// Policy decides whether requests starting a workflow execution are admitted by the frontend.
+	// A policy may allow or deny a request, or mutate it by returning a replacement request, which is
+	// then validated like the original request. Errors returned by a policy are returned to the caller
+	// as is, which allows quota policies to return errors such as LimitExceededError.
+	Policy interface {
+		AdmitStartWorkflowExecution(
+			ctx context.Context,
+			request *shared.StartWorkflowExecutionRequest,
+		) (*Result, error)
+		AdmitSignalWithStartWorkflowExecution(
+			ctx context.Context,
+			request *shared.SignalWithStartWorkflowExecutionRequest,
+		) (*Result, error)
+	}
+
+	// Factory creates an admission policy from the options configured for it
+	Factory func(options map[string]string, logger log.Logger) (Policy, error)
+
+	namedPolicy struct {
+		name   string
+		policy Policy
+	}
+
+	chain struct {
+		policies []namedPolicy
+	}
+)
+
+const (
+	// DecisionAllow admits the request unchanged
+	DecisionAllow Decision = iota
+	// DecisionDeny rejects the request with a BadRequestError
+	DecisionDeny
+	// DecisionMutate admits the replacement request of the result
+	DecisionMutate
+)
+
+var (
+	factoriesLock sync.RWMutex
+	factories     = make(map[string]Factory)
+)
+
+// Register makes an admission policy available under the given name, it is meant to be called from
+// the init function of the package implementing the policy and panics if the name is already taken
+func Register(name string, factory Factory) {
+	factoriesLock.Lock()
+	defer factoriesLock.Unlock()
+
+	if factory == nil {
+		panic("admission: Register factory is nil")
+	}
+	if _, ok := factories[name]; ok {
+		panic("admission: Register called twice for policy " + name)
+	}
+	factories[name] = factory
+}
+
+// NewPolicy creates the chain of admission policies configured, policies are evaluated in order and
+// a request is admitted only if no policy denies it. Each policy sees the request as mutated by the
+// policies before it.
+func NewPolicy(cfg config.AdmissionControl, logger log.Logger) (Policy, error) {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	policies := make([]namedPolicy, 0, len(cfg.Policies))
+	for _, policyCfg := range cfg.Policies {
+		factory, ok := factories[policyCfg.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown admission policy: %v", policyCfg.Name)
+		}
+		policy, err := factory(policyCfg.Options, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create admission policy %v: %v", policyCfg.Name, err)
+		}
+		policies = append(policies, namedPolicy{name: policyCfg.Name, policy: policy})
+	}
+	return &chain{policies: policies}, nil
+}
+
+// Allow returns the result admitting a request unchanged
+func Allow() *Result {
+	return &Result{Decision: DecisionAllow}
+}
+
+// Deny returns the result rejecting a request for the given reason
+func Deny(reason string) *Result {
+	return &Result{Decision: DecisionDeny, Reason: reason}
+}
+
+// MutateStart returns the result admitting the given replacement StartWorkflowExecution request
+func MutateStart(request *shared.StartWorkflowExecutionRequest) *Result {
+	return &Result{Decision: DecisionMutate, StartRequest: request}
+}
+
+// MutateSignalWithStart returns the result admitting the given replacement SignalWithStartWorkflowExecution request
+func MutateSignalWithStart(request *shared.SignalWithStartWorkflowExecutionRequest) *Result {
+	return &Result{Decision: DecisionMutate, SignalWithStartRequest: request}
+}
+
+func (c *chain) AdmitStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*Result, error) {
+
+	mutated := false
+	for _, p := range c.policies {
+		result, err := p.policy.AdmitStartWorkflowExecution(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		switch result.Decision {
+		case DecisionAllow:
+		case DecisionDeny:
+			return result, nil
+		case DecisionMutate:
+			if err := validateMutation(p.name, request.GetDomain(), result.StartRequest == nil, result.StartRequest.GetDomain()); err != nil {
+				return nil, err
+			}
+			request = result.StartRequest
+			mutated = true
+		default:
+			return nil, unknownDecisionError(p.name, result.Decision)
+		}
+	}
+	if mutated {
+		return MutateStart(request), nil
+	}
+	return Allow(), nil
+}
+
+func (c *chain) AdmitSignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (*Result, error) {
+
+	mutated := false
+	for _, p := range c.policies {
+		result, err := p.policy.AdmitSignalWithStartWorkflowExecution(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		switch result.Decision {
+		case DecisionAllow:
+		case DecisionDeny:
+			return result, nil
+		case DecisionMutate:
+			if err := validateMutation(p.name, request.GetDomain(), result.SignalWithStartRequest == nil, result.SignalWithStartRequest.GetDomain()); err != nil {
+				return nil, err
+			}
+			request = result.SignalWithStartRequest
+			mutated = true
+		default:
+			return nil, unknownDecisionError(p.name, result.Decision)
+		}
+	}
+	if mutated {
+		return MutateSignalWithStart(request), nil
+	}
+	return Allow(), nil
+}
+
+func validateMutation(policyName string, domain string, missingRequest bool, mutatedDomain string) error {
+	if missingRequest {
+		return &shared.InternalServiceError{
+			Message: fmt.Sprintf("Admission policy %v mutated the request without returning it.", policyName),
+		}
+	}
+	// the domain decides which policies and limits apply to the request, so it can not be changed
+	if mutatedDomain != domain {
+		return &shared.InternalServiceError{
+			Message: fmt.Sprintf("Admission policy %v changed the domain of the request.", policyName),
+		}
+	}
+	return nil
+}
+
+func unknownDecisionError(policyName string, decision Decision) error {
+	return &shared.InternalServiceError{
+		Message: fmt.Sprintf("Admission policy %v returned unknown decision %v.", policyName, decision),
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	policySuite struct {
+		suite.Suite
+		*require.Assertions
+		logger log.Logger
+	}
+
+	taskListPolicy struct {
+		taskList string
+	}
+)
+
+func init() {
+	Register("testTaskList", func(options map[string]string, logger log.Logger) (Policy, error) {
+		return &taskListPolicy{taskList: options["taskList"]}, nil
+	})
+}
+
+func TestPolicySuite(t *testing.T) {
+	suite.Run(t, new(policySuite))
+}
+
+func (s *policySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = loggerimpl.NewNopLogger()
+}
+
+func (s *policySuite) TestNewPolicy_UnknownPolicy() {
+	_, err := NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{{Name: "unknown"}},
+	}, s.logger)
+	s.Error(err)
+}
+
+func (s *policySuite) TestNewPolicy_InvalidOptions() {
+	_, err := NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{{Name: WorkflowIDPatternPolicyName}},
+	}, s.logger)
+	s.Error(err)
+
+	_, err = NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{{Name: WorkflowIDPatternPolicyName, Options: map[string]string{"pattern": "("}}},
+	}, s.logger)
+	s.Error(err)
+}
+
+func (s *policySuite) TestEmptyChain() {
+	policy, err := NewPolicy(config.AdmissionControl{}, s.logger)
+	s.NoError(err)
+
+	result, err := policy.AdmitStartWorkflowExecution(context.Background(), newStartRequest("domain", "wid"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policySuite) TestWorkflowIDPattern() {
+	policy, err := NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{{
+			Name:    WorkflowIDPatternPolicyName,
+			Options: map[string]string{"pattern": "^order-", "domains": "orders, payments"},
+		}},
+	}, s.logger)
+	s.NoError(err)
+
+	result, err := policy.AdmitStartWorkflowExecution(context.Background(), newStartRequest("orders", "order-1"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = policy.AdmitStartWorkflowExecution(context.Background(), newStartRequest("orders", "1"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.NotEmpty(result.Reason)
+
+	result, err = policy.AdmitSignalWithStartWorkflowExecution(context.Background(), &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("payments"),
+		WorkflowId: common.StringPtr("1"),
+	})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// domains not listed are not subject to the policy
+	result, err = policy.AdmitStartWorkflowExecution(context.Background(), newStartRequest("other", "1"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policySuite) TestRequiredSearchAttributes() {
+	policy, err := NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{{
+			Name:    RequiredSearchAttributesPolicyName,
+			Options: map[string]string{"keys": "CustomerID"},
+		}},
+	}, s.logger)
+	s.NoError(err)
+
+	request := newStartRequest("domain", "wid")
+	result, err := policy.AdmitStartWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	request.SearchAttributes = &shared.SearchAttributes{
+		IndexedFields: map[string][]byte{"CustomerID": []byte(`"customer"`)},
+	}
+	result, err = policy.AdmitStartWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *policySuite) TestChain_MutateThenDeny() {
+	policy, err := NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{
+			{Name: "testTaskList", Options: map[string]string{"taskList": "default"}},
+			{Name: WorkflowIDPatternPolicyName, Options: map[string]string{"pattern": "^order-"}},
+		},
+	}, s.logger)
+	s.NoError(err)
+
+	request := newStartRequest("domain", "order-1")
+	result, err := policy.AdmitStartWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal(DecisionMutate, result.Decision)
+	s.Equal("default", result.StartRequest.TaskList.GetName())
+	s.Nil(request.TaskList)
+
+	result, err = policy.AdmitStartWorkflowExecution(context.Background(), newStartRequest("domain", "1"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policySuite) TestChain_DomainMutationRejected() {
+	policy, err := NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{{Name: "testTaskList", Options: map[string]string{"taskList": "default"}}},
+	}, s.logger)
+	s.NoError(err)
+
+	_, err = policy.AdmitSignalWithStartWorkflowExecution(context.Background(), &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("domain"),
+		WorkflowId: common.StringPtr("wid"),
+	})
+	s.IsType(&shared.InternalServiceError{}, err)
+}
+
+func (p *taskListPolicy) AdmitStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*Result, error) {
+
+	if request.TaskList != nil {
+		return Allow(), nil
+	}
+	mutated := *request
+	mutated.TaskList = &shared.TaskList{Name: common.StringPtr(p.taskList)}
+	return MutateStart(&mutated), nil
+}
+
+func (p *taskListPolicy) AdmitSignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (*Result, error) {
+
+	// a misbehaving policy moving the request to another domain
+	mutated := *request
+	mutated.Domain = common.StringPtr("other")
+	return MutateSignalWithStart(&mutated), nil
+}
+
+func newStartRequest(domain string, workflowID string) *shared.StartWorkflowExecutionRequest {
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(domain),
+		WorkflowId: common.StringPtr(workflowID),
+	}
+}
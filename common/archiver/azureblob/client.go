@@ -0,0 +1,133 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azureblob
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	defaultEndpointFormat = "https://%s.blob.core.windows.net"
+
+	downloadMaxRetryRequests = 3
+)
+
+type (
+	// blobClient is the subset of Azure Blob Storage operations used by the archivers
+	blobClient interface {
+		// Upload writes data to the blob, replacing its previous content
+		Upload(ctx context.Context, container string, blob string, data []byte) error
+		// Download returns the content of the blob, or errBlobNotExist if the blob does not exist
+		Download(ctx context.Context, container string, blob string) ([]byte, error)
+		// ContainerExists returns true if the container exists and is accessible
+		ContainerExists(ctx context.Context, container string) (bool, error)
+		// List returns the names of all blobs starting with prefix
+		List(ctx context.Context, container string, prefix string) ([]string, error)
+	}
+
+	azureBlobClient struct {
+		serviceURL azblob.ServiceURL
+		blockSize  int64
+	}
+)
+
+// newBlobClient creates a blobClient for the storage account of the config
+func newBlobClient(config *config.AzureBlobArchiver) (blobClient, error) {
+	credential, err := azblob.NewSharedKeyCredential(config.AccountName, config.AccountKey)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(defaultEndpointFormat, config.AccountName)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := azblob.NewPipeline(credential, azblob.PipelineOptions{
+		Retry: azblob.RetryOptions{
+			MaxTries: config.MaxTries,
+		},
+	})
+	return &azureBlobClient{
+		serviceURL: azblob.NewServiceURL(*endpointURL, pipeline),
+		blockSize:  config.BlockSize,
+	}, nil
+}
+
+func (c *azureBlobClient) Upload(ctx context.Context, container string, blob string, data []byte) error {
+	// blobs larger than a block are uploaded in blocks which are only committed once all of them are staged
+	blobURL := c.serviceURL.NewContainerURL(container).NewBlockBlobURL(blob)
+	_, err := azblob.UploadBufferToBlockBlob(ctx, data, blobURL, azblob.UploadToBlockBlobOptions{
+		BlockSize: c.blockSize,
+	})
+	return err
+}
+
+func (c *azureBlobClient) Download(ctx context.Context, container string, blob string) ([]byte, error) {
+	blobURL := c.serviceURL.NewContainerURL(container).NewBlobURL(blob)
+	response, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false)
+	if err != nil {
+		if isServiceCode(err, azblob.ServiceCodeBlobNotFound) {
+			return nil, errBlobNotExist
+		}
+		return nil, err
+	}
+	body := response.Body(azblob.RetryReaderOptions{MaxRetryRequests: downloadMaxRetryRequests})
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+func (c *azureBlobClient) ContainerExists(ctx context.Context, container string) (bool, error) {
+	_, err := c.serviceURL.NewContainerURL(container).GetProperties(ctx, azblob.LeaseAccessConditions{})
+	if isServiceCode(err, azblob.ServiceCodeContainerNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *azureBlobClient) List(ctx context.Context, container string, prefix string) ([]string, error) {
+	containerURL := c.serviceURL.NewContainerURL(container)
+	var names []string
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		response, err := containerURL.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{Prefix: prefix})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range response.Segment.BlobItems {
+			names = append(names, item.Name)
+		}
+		marker = response.NextMarker
+	}
+	return names, nil
+}
+
+func isServiceCode(err error, serviceCode azblob.ServiceCodeType) bool {
+	storageErr, ok := err.(azblob.StorageError)
+	return ok && storageErr.ServiceCode() == serviceCode
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Azure Blob Storage History Archiver will archive workflow histories to an Azure Blob Storage container.
+
+// The URI is in the format of azblob://container/path, the container belongs to the storage account
+// configured in the static config and the path is used as a prefix of all blob names.
+// The history is stored in parts as described in the blobstore package. Requests to the blob
+// service are retried on transient errors as configured in the static config.
+
+package azureblob
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/blobstore"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// URIScheme is the scheme for the Azure Blob Storage implementation
+	URIScheme = "azblob"
+
+	errUploadHistory = "failed to upload history to Azure Blob Storage"
+)
+
+type (
+	// historyBlobClient adapts the blobClient to the client of the blob store history archiver
+	historyBlobClient struct {
+		blobClient
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on Azure Blob Storage
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.AzureBlobArchiver,
+	encrypter archiver.BlobEncrypter,
+) (archiver.HistoryArchiver, error) {
+	client, err := newBlobClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiver(container, client, encrypter, nil), nil
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	client blobClient,
+	encrypter archiver.BlobEncrypter,
+	historyIterator archiver.HistoryIterator,
+) archiver.HistoryArchiver {
+	// the requests are retried by the pipeline of the client
+	return blobstore.NewHistoryArchiver(container, &historyBlobClient{client}, encrypter, &blobstore.HistoryArchiverOptions{
+		URIScheme:        URIScheme,
+		ValidateBucket:   validateContainerName,
+		IsRetryableError: isRetryableError,
+		UploadFailReason: errUploadHistory,
+	}, historyIterator)
+}
+
+func (c *historyBlobClient) BucketExists(ctx context.Context, container string) (bool, error) {
+	return c.ContainerExists(ctx, container)
+}
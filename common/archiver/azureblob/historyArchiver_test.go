@@ -0,0 +1,592 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azureblob
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/zap"
+)
+
+const (
+	testDomainID             = "test-domain-id"
+	testDomainName           = "test-domain-name"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = 100
+	testPageSize             = 100
+	testContainer            = "test-container"
+	testArchivalURI          = "azblob://test-container/a/b/c"
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type (
+	historyArchiverSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		container          *archiver.HistoryBootstrapContainer
+		client             *fakeBlobClient
+		testArchivalURI    archiver.URI
+		historyBatchesV1   []*shared.History
+		historyBatchesV100 []*shared.History
+	}
+
+	// fakeBlobClient keeps blobs in memory, uploads fail with the queued errors first, nil entries let an upload through
+	fakeBlobClient struct {
+		sync.Mutex
+		containers   map[string]map[string][]byte
+		uploadErrors []error
+		numUploads   int
+	}
+)
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupSuite() {
+	var err error
+	s.testArchivalURI, err = archiver.NewURI(testArchivalURI)
+	s.Require().NoError(err)
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger: loggerimpl.NewLogger(zap.NewNop()),
+	}
+	s.client = newFakeBlobClient(testContainer)
+	s.setupHistoryBatches()
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme://test-container/a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "azblob:///a/b/c",
+			expectedErr: errInvalidContainerName,
+		},
+		{
+			URI:         "azblob://Invalid_Container/a/b/c",
+			expectedErr: errInvalidContainerName,
+		},
+		{
+			URI:         "azblob://ab/a/b/c",
+			expectedErr: errInvalidContainerName,
+		},
+		{
+			URI:         "azblob://test--container/a/b/c",
+			expectedErr: errInvalidContainerName,
+		},
+		{
+			URI:         "azblob://test-container",
+			expectedErr: nil,
+		},
+		{
+			URI:         "azblob://test-container/a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newArchiveRequest()
+	request.WorkflowID = "" // an invalid request
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, request)
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: []*shared.History{
+			{
+				Events: []*shared.HistoryEvent{
+					{
+						EventId:   common.Int64Ptr(common.FirstEventID + 1),
+						Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+						Version:   common.Int64Ptr(testCloseFailoverVersion + 1),
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Equal(archiver.ErrHistoryMutated, err)
+	s.Empty(s.client.blobNames(testContainer))
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_NonRetriableErrorOption() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest(), archiver.GetNonRetriableErrorOption(nonRetryableErr))
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Success_MultipleParts() {
+	historyIterator := s.newHistoryIterator(s.historyBatchesV100[:1], s.historyBatchesV100[1:])
+	defer historyIterator.Finish()
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator.iterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.NoError(err)
+	s.Equal(3, s.client.numUploads)
+
+	blobName := blobstore.ConstructHistoryBlobName(s.testArchivalURI.Path(), testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.Equal([]string{
+		blobName,
+		blobstore.ConstructHistoryPartBlobName(blobName, 0),
+		blobstore.ConstructHistoryPartBlobName(blobName, 1),
+	}, s.client.blobNames(testContainer))
+	s.True(strings.HasPrefix(blobName, "a/b/c/"))
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_UploadError() {
+	historyIterator := s.newHistoryIterator(s.historyBatchesV100)
+	defer historyIterator.Finish()
+	s.client.uploadErrors = []error{errors.New("some upload error")}
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator.iterator)
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest(), archiver.GetNonRetriableErrorOption(nonRetryableErr))
+	s.Equal(nonRetryableErr, err)
+	s.Equal(1, s.client.numUploads)
+	s.Empty(s.client.blobNames(testContainer))
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_TransientUploadError() {
+	historyIterator := s.newHistoryIterator(s.historyBatchesV100[:1], s.historyBatchesV100[1:])
+	defer historyIterator.Finish()
+	s.client.uploadErrors = []error{nil, io.ErrUnexpectedEOF}
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator.iterator)
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest(), archiver.GetNonRetriableErrorOption(nonRetryableErr))
+	s.Equal(io.ErrUnexpectedEOF, err, "the caller should retry transient errors")
+
+	// the history blob is not written, so the partially archived history is not visible
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.PageSize = 0 // pageSize should be greater than 0
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_ContainerNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("azblob://some-other-container/a/b/c")
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_HistoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+
+	request := s.newGetRequest()
+	request.CloseFailoverVersion = common.Int64Ptr(testCloseFailoverVersion)
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidToken() {
+	s.archiveHistory(nil, testCloseFailoverVersion, s.historyBatchesV100)
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.NextPageToken = []byte{'r', 'a', 'n', 'd', 'o', 'm'}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+
+	request.NextPageToken, err = json.Marshal(map[string]int64{"CloseFailoverVersion": testCloseFailoverVersion, "NextBatchIdx": 10})
+	s.NoError(err)
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	s.archiveHistory(nil, 1, s.historyBatchesV1)
+	s.archiveHistory(nil, testCloseFailoverVersion, s.historyBatchesV100)
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	s.archiveHistory(nil, 1, s.historyBatchesV1)
+	s.archiveHistory(nil, testCloseFailoverVersion, s.historyBatchesV100)
+
+	request := s.newGetRequest()
+	request.CloseFailoverVersion = common.Int64Ptr(1)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_MultipleParts() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	s.archiveHistory(nil, testCloseFailoverVersion, s.historyBatchesV100[:1], s.historyBatchesV100[1:])
+
+	request := s.newGetRequest()
+	request.PageSize = 1
+	combinedHistory := []*shared.History{}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	s.Equal(s.historyBatchesV100, combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_Encrypted() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndGet_Encrypted")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	encrypter := newTestEncrypter(s.T(), dir)
+	s.archiveHistory(encrypter, testCloseFailoverVersion, s.historyBatchesV100)
+	historyArchiver := s.newTestHistoryArchiverWithEncrypter(encrypter, nil)
+
+	blobName := blobstore.ConstructHistoryBlobName(s.testArchivalURI.Path(), testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	part, err := s.client.Download(context.Background(), testContainer, blobstore.ConstructHistoryPartBlobName(blobName, 0))
+	s.NoError(err)
+	s.NotContains(string(part), "EventId")
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	s.NoError(err)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	// the history can not be read by an archiver which does not encrypt the domain's histories
+	historyArchiver = s.newTestHistoryArchiver(nil)
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&shared.InternalServiceError{}, err)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) archiver.HistoryArchiver {
+	encrypter, err := archiver.NewBlobEncrypter(nil, nil)
+	s.NoError(err)
+	return s.newTestHistoryArchiverWithEncrypter(encrypter, historyIterator)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiverWithEncrypter(
+	encrypter archiver.BlobEncrypter,
+	historyIterator archiver.HistoryIterator,
+) archiver.HistoryArchiver {
+	return newHistoryArchiver(s.container, s.client, encrypter, historyIterator)
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newGetRequest() *archiver.GetHistoryRequest {
+	return &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+}
+
+type testHistoryIterator struct {
+	iterator *archiver.MockHistoryIterator
+	mockCtrl *gomock.Controller
+}
+
+func (t *testHistoryIterator) Finish() {
+	t.mockCtrl.Finish()
+}
+
+// newHistoryIterator returns a history iterator which returns a blob for each of the given lists of history batches
+func (s *historyArchiverSuite) newHistoryIterator(blobs ...[]*shared.History) *testHistoryIterator {
+	mockCtrl := gomock.NewController(s.T())
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	var calls []*gomock.Call
+	for i, blob := range blobs {
+		historyBlob := &archiver.HistoryBlob{
+			Header: &archiver.HistoryBlobHeader{
+				IsLast: common.BoolPtr(i == len(blobs)-1),
+			},
+			Body: blob,
+		}
+		calls = append(calls,
+			historyIterator.EXPECT().HasNext().Return(true),
+			historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		)
+	}
+	// not reached if archiving fails
+	calls = append(calls, historyIterator.EXPECT().HasNext().Return(false).AnyTimes())
+	gomock.InOrder(calls...)
+	return &testHistoryIterator{iterator: historyIterator, mockCtrl: mockCtrl}
+}
+
+// archiveHistory archives the history blobs, encrypted with the encrypter unless it is nil
+func (s *historyArchiverSuite) archiveHistory(encrypter archiver.BlobEncrypter, version int64, blobs ...[]*shared.History) {
+	historyIterator := s.newHistoryIterator(blobs...)
+	defer historyIterator.Finish()
+	historyArchiver := s.newTestHistoryArchiver(historyIterator.iterator)
+	if encrypter != nil {
+		historyArchiver = s.newTestHistoryArchiverWithEncrypter(encrypter, historyIterator.iterator)
+	}
+
+	request := s.newArchiveRequest()
+	request.CloseFailoverVersion = version
+	lastBatch := blobs[len(blobs)-1][len(blobs[len(blobs)-1])-1]
+	request.NextEventID = lastBatch.Events[len(lastBatch.Events)-1].GetEventId() + 1
+	s.NoError(historyArchiver.Archive(context.Background(), s.testArchivalURI, request))
+}
+
+func (s *historyArchiverSuite) setupHistoryBatches() {
+	s.historyBatchesV1 = []*shared.History{
+		{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(testNextEventID - 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(1),
+				},
+			},
+		},
+	}
+
+	s.historyBatchesV100 = []*shared.History{
+		{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(common.FirstEventID + 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+				{
+					EventId:   common.Int64Ptr(common.FirstEventID + 2),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+		{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(testNextEventID - 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+	}
+}
+
+// newTestEncrypter returns an encrypter which encrypts blobs of the test domain with a key written to dir
+func newTestEncrypter(t *testing.T, dir string) archiver.BlobEncrypter {
+	keyFile := filepath.Join(dir, "test.key")
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(key), 0666))
+
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetDomainName", testDomainID).Return(testDomainName, nil)
+	domainCache.On("GetDomainName", mock.Anything).Return("some random domain name", nil)
+	encrypter, err := archiver.NewBlobEncrypter(&config.ArchiverEncryption{
+		Keys: map[string]config.ArchiverEncryptionKey{
+			"test-key": {KeyFile: keyFile},
+		},
+		Domains: map[string]config.ArchiverDomainEncryption{
+			testDomainName: {Scheme: archiver.EncryptionSchemeAES256GCM, KeyID: "test-key"},
+		},
+	}, domainCache)
+	require.NoError(t, err)
+	return encrypter
+}
+
+func newFakeBlobClient(containers ...string) *fakeBlobClient {
+	c := &fakeBlobClient{containers: make(map[string]map[string][]byte)}
+	for _, container := range containers {
+		c.containers[container] = make(map[string][]byte)
+	}
+	return c
+}
+
+func (c *fakeBlobClient) Upload(_ context.Context, container string, blob string, data []byte) error {
+	c.Lock()
+	defer c.Unlock()
+	c.numUploads++
+	if len(c.uploadErrors) > 0 {
+		err := c.uploadErrors[0]
+		c.uploadErrors = c.uploadErrors[1:]
+		if err != nil {
+			return err
+		}
+	}
+	blobs, ok := c.containers[container]
+	if !ok {
+		return errors.New("container does not exist")
+	}
+	blobs[blob] = append([]byte(nil), data...)
+	return nil
+}
+
+func (c *fakeBlobClient) Download(_ context.Context, container string, blob string) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	data, ok := c.containers[container][blob]
+	if !ok {
+		return nil, errBlobNotExist
+	}
+	return data, nil
+}
+
+func (c *fakeBlobClient) ContainerExists(_ context.Context, container string) (bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	_, ok := c.containers[container]
+	return ok, nil
+}
+
+func (c *fakeBlobClient) List(_ context.Context, container string, prefix string) ([]string, error) {
+	c.Lock()
+	defer c.Unlock()
+	var names []string
+	for name := range c.containers[container] {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (c *fakeBlobClient) blobNames(container string) []string {
+	names, _ := c.List(context.Background(), container, "")
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azureblob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/dgryski/go-farm"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/blobstore"
+)
+
+var (
+	errBlobNotExist         = blobstore.ErrBlobNotExist
+	errInvalidContainerName = errors.New("container name is invalid")
+
+	containerNameRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+const (
+	minContainerNameLength = 3
+	maxContainerNameLength = 63
+)
+
+// Blob name construction
+
+// constructVisibilityBlobNamePrefix returns the prefix of the visibility records of the domain
+func constructVisibilityBlobNamePrefix(dirPath, domainID string) string {
+	return path.Join(strings.TrimPrefix(dirPath, "/"), domainID) + "/"
+}
+
+func constructVisibilityBlobName(dirPath, domainID string, closeTimestamp int64, runID string) string {
+	return fmt.Sprintf("%s%v_%s%s", constructVisibilityBlobNamePrefix(dirPath, domainID), closeTimestamp, hash(runID), visibilityBlobSuffix)
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+// Validation
+
+func validateContainerName(container string) error {
+	if len(container) < minContainerNameLength || len(container) > maxContainerNameLength || !containerNameRegex.MatchString(container) {
+		return errInvalidContainerName
+	}
+	return nil
+}
+
+// Error classification
+
+// isRetryableError returns true for errors which may succeed when the request to Azure Blob Storage is retried
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if err == io.ErrUnexpectedEOF {
+		return true
+	}
+	switch e := err.(type) {
+	case azblob.StorageError:
+		if e.ServiceCode() == azblob.ServiceCodeServerBusy {
+			return true
+		}
+		if e.Response() == nil {
+			return e.Temporary() || e.Timeout()
+		}
+		code := e.Response().StatusCode
+		return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+	case net.Error:
+		return e.Temporary() || e.Timeout()
+	}
+	return false
+}
+
+// Encoding & decoding util
+
+func encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// encryptVisibilityRecord wraps the encoded visibility record in an encrypted envelope
+// if an encryption key is configured for the domain
+func encryptVisibilityRecord(encrypter archiver.BlobEncrypter, domainID string, data []byte) ([]byte, error) {
+	metadata, err := encrypter.GetEncryptionMetadata(domainID)
+	if err != nil || metadata == nil {
+		return data, err
+	}
+	encrypted, err := encrypter.Encrypt(metadata, data)
+	if err != nil {
+		return nil, err
+	}
+	return encode(&encryptedVisibilityRecord{
+		Encryption: metadata,
+		Ciphertext: encrypted,
+	})
+}
+
+// decodeVisibilityRecord decodes a visibility record of the domain, which may be wrapped in an encrypted envelope
+func decodeVisibilityRecord(encrypter archiver.BlobEncrypter, domainID string, data []byte) (*visibilityRecord, error) {
+	envelope := &encryptedVisibilityRecord{}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, err
+	}
+	if err := encrypter.ValidateEncryptionMetadata(domainID, envelope.Encryption); err != nil {
+		return nil, err
+	}
+	if envelope.Encryption != nil {
+		decrypted, err := encrypter.Decrypt(envelope.Encryption, envelope.Ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		data = decrypted
+	}
+
+	record := &visibilityRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Misc.
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
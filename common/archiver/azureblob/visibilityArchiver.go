@@ -0,0 +1,336 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Azure Blob Storage Visibility Archiver will archive workflow visibility records to an Azure Blob Storage container.
+
+// Each Archive() request results in a blob named in the format of
+// path/domainID/closeTimestamp_hash(runID).visibility in the container specified in the URI.
+// The blob name allows the archiver to sort and filter records by close time without
+// downloading them, so Query() only downloads the records which may match the query.
+
+package azureblob
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/query"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errUploadVisibilityRecord = "failed to upload visibility record to Azure Blob Storage"
+
+	visibilityBlobSuffix = ".visibility"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		client      blobClient
+		encrypter   archiver.BlobEncrypter
+		queryParser query.Parser
+	}
+
+	queryVisibilityToken struct {
+		LastCloseTime int64
+		LastRunID     string
+	}
+
+	visibilityRecord archiver.ArchiveVisibilityRequest
+
+	// encryptedVisibilityRecord is the envelope of a visibility record encrypted with the key of its domain
+	encryptedVisibilityRecord struct {
+		Encryption *archiver.EncryptionMetadata
+		Ciphertext []byte
+	}
+
+	parsedVisBlobName struct {
+		name        string
+		closeTime   int64
+		hashedRunID string
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on Azure Blob Storage
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.AzureBlobArchiver,
+	encrypter archiver.BlobEncrypter,
+) (archiver.VisibilityArchiver, error) {
+	client, err := newBlobClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(container, client, encrypter), nil
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	client blobClient,
+	encrypter archiver.BlobEncrypter,
+) *visibilityArchiver {
+	return &visibilityArchiver{
+		container:   container,
+		client:      client,
+		encrypter:   encrypter,
+		queryParser: query.NewParser(),
+	}
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !isRetryableError(err) && featureCatalog.NonRetriableError != nil {
+			err = featureCatalog.NonRetriableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err = encryptVisibilityRecord(v.encrypter, request.DomainID, encodedVisibilityRecord)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonEncryptBlob), tag.Error(err))
+		return err
+	}
+
+	blobName := constructVisibilityBlobName(URI.Path(), request.DomainID, request.CloseTimestamp, request.RunID)
+	if err := v.client.Upload(ctx, URI.Hostname(), blobName, encodedVisibilityRecord); err != nil {
+		logger := logger.WithTags(tag.ArchivalArchiveFailReason(errUploadVisibilityRecord), tag.Error(err))
+		if isRetryableError(err) {
+			logger.Error(archiver.ArchiveTransientErrorMsg)
+		} else {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, &shared.BadRequestError{Message: err.Error()}
+	}
+
+	if parsedQuery.EmptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	var token *queryVisibilityToken
+	if request.NextPageToken != nil {
+		token, err = deserializeQueryVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	}
+
+	container := URI.Hostname()
+	exists, err := v.client.ContainerExists(ctx, container)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	blobNames, err := v.client.List(ctx, container, constructVisibilityBlobNamePrefix(URI.Path(), request.DomainID))
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+
+	blobNames, err = sortAndFilterBlobNames(blobNames, token, parsedQuery)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for idx, blobName := range blobNames {
+		encodedRecord, err := v.client.Download(ctx, container, blobName)
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+
+		record, err := decodeVisibilityRecord(v.encrypter, request.DomainID, encodedRecord)
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+
+		if matchQuery(record, parsedQuery) {
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+			if len(response.Executions) == request.PageSize {
+				if idx != len(blobNames)-1 {
+					newToken := &queryVisibilityToken{
+						LastCloseTime: record.CloseTimestamp,
+						LastRunID:     record.RunID,
+					}
+					encodedToken, err := serializeToken(newToken)
+					if err != nil {
+						return nil, &shared.InternalServiceError{Message: err.Error()}
+					}
+					response.NextPageToken = encodedToken
+				}
+				break
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return validateContainerName(URI.Hostname())
+}
+
+// sortAndFilterBlobNames sorts visibility record blob names based on close timestamp (desc) and uses hashed runID to break ties.
+// Only blobs within the close time range of the query and after the nextPageToken, if given, are returned.
+func sortAndFilterBlobNames(blobNames []string, token *queryVisibilityToken, parsedQuery *query.ParsedQuery) ([]string, error) {
+	var parsedBlobNames []*parsedVisBlobName
+	for _, name := range blobNames {
+		pieces := strings.Split(strings.TrimSuffix(name[strings.LastIndex(name, "/")+1:], visibilityBlobSuffix), "_")
+		if len(pieces) != 2 {
+			return nil, fmt.Errorf("failed to parse visibility blob name %s", name)
+		}
+
+		closeTime, err := strconv.ParseInt(pieces[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse visibility blob name %s", name)
+		}
+		if closeTime < parsedQuery.EarliestCloseTime || closeTime > parsedQuery.LatestCloseTime {
+			continue
+		}
+		parsedBlobNames = append(parsedBlobNames, &parsedVisBlobName{
+			name:        name,
+			closeTime:   closeTime,
+			hashedRunID: pieces[1],
+		})
+	}
+
+	sort.Slice(parsedBlobNames, func(i, j int) bool {
+		if parsedBlobNames[i].closeTime == parsedBlobNames[j].closeTime {
+			return parsedBlobNames[i].hashedRunID > parsedBlobNames[j].hashedRunID
+		}
+		return parsedBlobNames[i].closeTime > parsedBlobNames[j].closeTime
+	})
+
+	startIdx := 0
+	if token != nil {
+		lastHashedRunID := hash(token.LastRunID)
+		startIdx = sort.Search(len(parsedBlobNames), func(i int) bool {
+			if parsedBlobNames[i].closeTime == token.LastCloseTime {
+				return parsedBlobNames[i].hashedRunID < lastHashedRunID
+			}
+			return parsedBlobNames[i].closeTime < token.LastCloseTime
+		})
+	}
+
+	var filteredBlobNames []string
+	for _, parsedBlobName := range parsedBlobNames[startIdx:] {
+		filteredBlobNames = append(filteredBlobNames, parsedBlobName.name)
+	}
+	return filteredBlobNames, nil
+}
+
+func matchQuery(record *visibilityRecord, parsedQuery *query.ParsedQuery) bool {
+	if record.CloseTimestamp < parsedQuery.EarliestCloseTime || record.CloseTimestamp > parsedQuery.LatestCloseTime {
+		return false
+	}
+	if parsedQuery.WorkflowID != nil && record.WorkflowID != *parsedQuery.WorkflowID {
+		return false
+	}
+	if parsedQuery.RunID != nil && record.RunID != *parsedQuery.RunID {
+		return false
+	}
+	if parsedQuery.WorkflowTypeName != nil && record.WorkflowTypeName != *parsedQuery.WorkflowTypeName {
+		return false
+	}
+	if parsedQuery.CloseStatus != nil && record.CloseStatus != *parsedQuery.CloseStatus {
+		return false
+	}
+	return true
+}
+
+func convertToExecutionInfo(record *visibilityRecord) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(record.WorkflowID),
+			RunId:      common.StringPtr(record.RunID),
+		},
+		Type: &shared.WorkflowType{
+			Name: common.StringPtr(record.WorkflowTypeName),
+		},
+		StartTime:     common.Int64Ptr(record.StartTimestamp),
+		ExecutionTime: common.Int64Ptr(record.ExecutionTimestamp),
+		CloseTime:     common.Int64Ptr(record.CloseTimestamp),
+		CloseStatus:   record.CloseStatus.Ptr(),
+		HistoryLength: common.Int64Ptr(record.HistoryLength),
+		Memo:          record.Memo,
+		SearchAttributes: &shared.SearchAttributes{
+			IndexedFields: archiver.ConvertSearchAttrToBytes(record.SearchAttributes),
+		},
+		HistoryArchivalInfo: archiver.ConvertHistoryArchivalInfo((*archiver.ArchiveVisibilityRequest)(record)),
+	}
+}
@@ -0,0 +1,500 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azureblob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/query"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"go.uber.org/zap"
+)
+
+const (
+	testWorkflowTypeName = "test-workflow-type"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container         *archiver.VisibilityBootstrapContainer
+	client            *fakeBlobClient
+	testArchivalURI   archiver.URI
+	visibilityRecords []*visibilityRecord
+
+	controller *gomock.Controller
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupSuite() {
+	var err error
+	s.testArchivalURI, err = archiver.NewURI(testArchivalURI)
+	s.Require().NoError(err)
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: loggerimpl.NewLogger(zap.NewNop()),
+	}
+	s.client = newFakeBlobClient(testContainer)
+	s.controller = gomock.NewController(s.T())
+	s.setupVisibilityRecords()
+}
+
+func (s *visibilityArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme://test-container/a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "azblob:///a/b/c",
+			expectedErr: errInvalidContainerName,
+		},
+		{
+			URI:         "azblob://test-container/a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[0]))
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiver.ArchiveVisibilityRequest{})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_NonRetriableErrorOption() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	nonRetriableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(
+		context.Background(),
+		s.testArchivalURI,
+		&archiver.ArchiveVisibilityRequest{},
+		archiver.GetNonRetriableErrorOption(nonRetriableErr),
+	)
+	s.Equal(nonRetriableErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_TransientUploadError() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	s.client.uploadErrors = []error{io.ErrUnexpectedEOF}
+	nonRetriableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(
+		context.Background(),
+		s.testArchivalURI,
+		(*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[0]),
+		archiver.GetNonRetriableErrorOption(nonRetriableErr),
+	)
+	s.Equal(io.ErrUnexpectedEOF, err)
+	s.Empty(s.client.blobNames(testContainer))
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	closeTimestamp := time.Now()
+	request := &archiver.ArchiveVisibilityRequest{
+		DomainID:           testDomainID,
+		WorkflowID:         testWorkflowID,
+		RunID:              testRunID,
+		WorkflowTypeName:   testWorkflowTypeName,
+		StartTimestamp:     closeTimestamp.Add(-time.Hour).UnixNano(),
+		ExecutionTimestamp: 0, // workflow without backoff
+		CloseTimestamp:     closeTimestamp.UnixNano(),
+		CloseStatus:        shared.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:      int64(101),
+		Memo: &shared.Memo{
+			Fields: map[string][]byte{
+				"testFields": {1, 2, 3},
+			},
+		},
+		SearchAttributes: map[string]string{
+			"testAttribute": "456",
+		},
+	}
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+
+	expectedBlobName := constructVisibilityBlobName(s.testArchivalURI.Path(), testDomainID, closeTimestamp.UnixNano(), testRunID)
+	s.Equal([]string{expectedBlobName}, s.client.blobNames(testContainer))
+
+	data, err := s.client.Download(context.Background(), testContainer, expectedBlobName)
+	s.NoError(err)
+	archivedRecord, err := decodeVisibilityRecord(visibilityArchiver.encrypter, testDomainID, data)
+	s.NoError(err)
+	s.Equal(request, (*archiver.ArchiveVisibilityRequest)(archivedRecord))
+}
+
+func (s *visibilityArchiverSuite) TestSortAndFilterBlobNames() {
+	blobNames := []string{"a/9_12345.visibility", "a/5_0.visibility", "a/9_54321.visibility", "a/1000_654.visibility", "a/1000_78.visibility"}
+	parsedQuery := &query.ParsedQuery{
+		EarliestCloseTime: 0,
+		LatestCloseTime:   10000,
+	}
+	testCases := []struct {
+		query          *query.ParsedQuery
+		token          *queryVisibilityToken
+		expectedResult []string
+	}{
+		{
+			query:          parsedQuery,
+			expectedResult: []string{"a/1000_78.visibility", "a/1000_654.visibility", "a/9_54321.visibility", "a/9_12345.visibility", "a/5_0.visibility"},
+		},
+		{
+			query: &query.ParsedQuery{
+				EarliestCloseTime: 6,
+				LatestCloseTime:   999,
+			},
+			expectedResult: []string{"a/9_54321.visibility", "a/9_12345.visibility"},
+		},
+		{
+			query: parsedQuery,
+			token: &queryVisibilityToken{
+				LastCloseTime: 3,
+			},
+			expectedResult: nil,
+		},
+		{
+			query: parsedQuery,
+			token: &queryVisibilityToken{
+				LastCloseTime: 999,
+			},
+			expectedResult: []string{"a/9_54321.visibility", "a/9_12345.visibility", "a/5_0.visibility"},
+		},
+		{
+			query: parsedQuery,
+			token: &queryVisibilityToken{
+				LastCloseTime: 5,
+			},
+			expectedResult: []string{"a/5_0.visibility"},
+		},
+	}
+
+	for _, tc := range testCases {
+		result, err := sortAndFilterBlobNames(blobNames, tc.token, tc.query)
+		s.NoError(err)
+		s.Equal(tc.expectedResult, result)
+	}
+
+	_, err := sortAndFilterBlobNames([]string{"a/invalid.visibility"}, nil, parsedQuery)
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	s.IsType(&shared.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{})
+	s.IsType(&shared.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	visibilityArchiver.queryParser = mockParser
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: "some random domainID",
+		PageSize: 10,
+		Query:    "some invalid query",
+	})
+	s.IsType(&shared.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	visibilityArchiver.queryParser = s.newMockQueryParser(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(101),
+	})
+	request := &archiver.QueryVisibilityRequest{
+		DomainID:      testDomainID,
+		Query:         "parsed by mockParser",
+		PageSize:      1,
+		NextPageToken: []byte{1, 2, 3},
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.IsType(&shared.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_ContainerNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	visibilityArchiver.queryParser = s.newMockQueryParser(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(101),
+	})
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		Query:    "parsed by mockParser",
+		PageSize: 1,
+	}
+	URI, err := archiver.NewURI("azblob://some-other-container/a/b/c")
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Empty(response.Executions)
+	s.Empty(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	s.archiveVisibilityRecords(visibilityArchiver)
+	visibilityArchiver.queryParser = s.newMockQueryParser(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(10001),
+		WorkflowID:        common.StringPtr(testWorkflowID),
+	})
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	s.archiveVisibilityRecords(visibilityArchiver)
+	visibilityArchiver.queryParser = s.newMockQueryParser(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(10001),
+		CloseStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+	})
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 2,
+		Query:    "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[3]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_OnlyDownloadsBlobsInRange() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	s.archiveVisibilityRecords(visibilityArchiver)
+	// records outside of the close time range are not downloaded, so corrupting them does not fail the query
+	for _, record := range s.visibilityRecords[2:4] {
+		blobName := constructVisibilityBlobName(s.testArchivalURI.Path(), record.DomainID, record.CloseTimestamp, record.RunID)
+		s.NoError(s.client.Upload(context.Background(), testContainer, blobName, []byte("corrupted")))
+	}
+	visibilityArchiver.queryParser = s.newMockQueryParser(&query.ParsedQuery{
+		EarliestCloseTime: int64(100),
+		LatestCloseTime:   int64(10001),
+	})
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_Encrypted() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQuery_Encrypted")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	visibilityArchiver.encrypter = newTestEncrypter(s.T(), dir)
+	s.archiveVisibilityRecords(visibilityArchiver)
+	mockParser := s.newMockQueryParser(&query.ParsedQuery{
+		EarliestCloseTime: int64(10),
+		LatestCloseTime:   int64(10001),
+		CloseStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+	})
+	visibilityArchiver.queryParser = mockParser
+
+	blobName := constructVisibilityBlobName(s.testArchivalURI.Path(), testDomainID, s.visibilityRecords[0].CloseTimestamp, testRunID)
+	data, err := s.client.Download(context.Background(), testContainer, blobName)
+	s.NoError(err)
+	s.NotContains(string(data), testWorkflowID)
+
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+
+	// records encrypted with a key which is not configured can not be read
+	plainVisibilityArchiver := s.newTestVisibilityArchiver()
+	plainVisibilityArchiver.queryParser = mockParser
+	_, err = plainVisibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.IsType(&shared.InternalServiceError{}, err)
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
+	encrypter, err := archiver.NewBlobEncrypter(nil, nil)
+	s.NoError(err)
+	return newVisibilityArchiver(s.container, s.client, encrypter)
+}
+
+func (s *visibilityArchiverSuite) newMockQueryParser(parsedQuery *query.ParsedQuery) query.Parser {
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(parsedQuery, nil).AnyTimes()
+	return mockParser
+}
+
+func (s *visibilityArchiverSuite) archiveVisibilityRecords(visibilityArchiver *visibilityArchiver) {
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.Require().NoError(err)
+	}
+}
+
+func (s *visibilityArchiverSuite) setupVisibilityRecords() {
+	s.visibilityRecords = []*visibilityRecord{
+		{
+			DomainID:         testDomainID,
+			WorkflowID:       testWorkflowID,
+			RunID:            testRunID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   10000,
+			CloseStatus:      shared.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:    101,
+		},
+		{
+			DomainID:           testDomainID,
+			WorkflowID:         "some random workflow ID",
+			RunID:              "some random run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     2,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     1000,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:      123,
+		},
+		{
+			DomainID:           testDomainID,
+			WorkflowID:         "another workflow ID",
+			RunID:              "another run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     3,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     10,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusContinuedAsNew,
+			HistoryLength:      456,
+		},
+		{
+			DomainID:           testDomainID,
+			WorkflowID:         "and another workflow ID",
+			RunID:              "and another run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     3,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     5,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:      456,
+		},
+		{
+			DomainID:           "some random domain ID",
+			WorkflowID:         "another workflow ID",
+			RunID:              "another run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     3,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     10000,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusContinuedAsNew,
+			HistoryLength:      456,
+		},
+	}
+}
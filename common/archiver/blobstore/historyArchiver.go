@@ -0,0 +1,432 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package blobstore implements the history archiver shared by the archivers backed by a blob store.
+
+// Each Archive() request results in a blob named in the format of
+// path/hash(domainID, workflowID, runID)_version.history which lists the parts of the history.
+// Workflow histories are read one blob of history at a time and each of them is uploaded as a separate
+// part named path/hash(domainID, workflowID, runID)_version.history.part<index>, holding the JSON
+// encoded list of history batches, so that the full history never needs to be held in memory.
+// The history blob is only written once all parts are uploaded, so partially archived histories
+// are never visible.
+
+// The Get() method retrieves the archived histories from the bucket specified in the URI. It
+// optionally takes in a NextPageToken which specifies the workflow close failover version, the
+// index of the next part and the index of the first history batch in that part that should be
+// returned. Instead of NextPageToken, caller can also provide a close failover version, in which
+// case, Get() method will return history batches starting from the beginning of that history
+// version. If neither of NextPageToken or close failover version is specified, the highest close
+// failover version will be picked. Only the parts needed to serve the page are downloaded.
+
+// If an encryption key is configured for the domain, each part is encrypted with that key and
+// the encryption metadata is stored in the history blob. Get() rejects histories which are not
+// encrypted for the domain as configured.
+
+package blobstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	errEncodeHistory = "failed to encode history batches"
+
+	historyBlobSuffix    = ".history"
+	historyPartBlobInfix = ".part"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+)
+
+var (
+	// ErrBlobNotExist is returned by Client.Download if the blob does not exist
+	ErrBlobNotExist = errors.New("blob does not exist")
+)
+
+type (
+	// Client is the subset of blob store operations used by the history archiver,
+	// a bucket is the top level namespace of the store, e.g. the container of Azure Blob Storage
+	Client interface {
+		// Upload writes data to the blob, replacing its previous content
+		Upload(ctx context.Context, bucket string, blob string, data []byte) error
+		// Download returns the content of the blob, or ErrBlobNotExist if the blob does not exist
+		Download(ctx context.Context, bucket string, blob string) ([]byte, error)
+		// BucketExists returns true if the bucket exists and is accessible
+		BucketExists(ctx context.Context, bucket string) (bool, error)
+		// List returns the names of all blobs starting with prefix
+		List(ctx context.Context, bucket string, prefix string) ([]string, error)
+	}
+
+	// HistoryArchiverOptions are the blob store specific parts of the history archiver
+	HistoryArchiverOptions struct {
+		// URIScheme is the scheme of the URIs handled by the archiver
+		URIScheme string
+		// ValidateBucket returns an error if the bucket name is invalid for the blob store
+		ValidateBucket func(bucket string) error
+		// IsRetryableError returns true for client errors which may succeed when the request is retried
+		IsRetryableError func(err error) bool
+		// UploadRetryPolicy retries uploads on retryable errors, nil if the client retries on its own
+		UploadRetryPolicy backoff.RetryPolicy
+		// UploadFailReason is logged when an upload fails
+		UploadFailReason string
+	}
+
+	historyArchiver struct {
+		container *archiver.HistoryBootstrapContainer
+		client    Client
+		encrypter archiver.BlobEncrypter
+		options   *HistoryArchiverOptions
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	// historyManifest is the content of the history blob, it lists the parts holding the history batches
+	historyManifest struct {
+		NumParts   int
+		Encryption *archiver.EncryptionMetadata
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		NextPartIdx          int
+		NextBatchIdx         int
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver storing the history in parts in the blob store,
+// historyIterator is only set in test code
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	client Client,
+	encrypter archiver.BlobEncrypter,
+	options *HistoryArchiverOptions,
+	historyIterator archiver.HistoryIterator,
+) archiver.HistoryArchiver {
+	return &historyArchiver{
+		container:       container,
+		client:          client,
+		encrypter:       encrypter,
+		options:         options,
+		historyIterator: historyIterator,
+	}
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !common.IsPersistenceTransientError(err) && !h.options.IsRetryableError(err) && featureCatalog.NonRetriableError != nil {
+			err = featureCatalog.NonRetriableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := h.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encryptionMetadata, err := h.encrypter.GetEncryptionMetadata(request.DomainID)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonEncryptBlob), tag.Error(err))
+		return err
+	}
+
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = archiver.NewHistoryIterator(request, h.container.HistoryManager, h.container.HistoryV2Manager, targetHistoryBlobSize)
+	}
+
+	bucket := URI.Hostname()
+	blobName := ConstructHistoryBlobName(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	logUploadError := func(err error) {
+		logger := logger.WithTags(tag.ArchivalArchiveFailReason(h.options.UploadFailReason), tag.Error(err))
+		if h.options.IsRetryableError(err) {
+			logger.Error(archiver.ArchiveTransientErrorMsg)
+		} else {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+		}
+	}
+
+	// history is uploaded one blob at a time, so that at most one blob
+	// of history is held in memory regardless of the size of the whole history
+	numParts := 0
+	for historyIterator.HasNext() {
+		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
+		if err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if !common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			}
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, *historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		data, err := h.encodePart(historyBlob.Body, encryptionMetadata)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
+		if err := h.upload(ctx, bucket, ConstructHistoryPartBlobName(blobName, numParts), data); err != nil {
+			logUploadError(err)
+			return err
+		}
+		numParts++
+	}
+
+	data, err := encode(&historyManifest{
+		NumParts:   numParts,
+		Encryption: encryptionMetadata,
+	})
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	if err := h.upload(ctx, bucket, blobName, data); err != nil {
+		logUploadError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidGetHistoryRequest.Error()}
+	}
+
+	bucket := URI.Hostname()
+	exists, err := h.client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil, &shared.BadRequestError{Message: archiver.ErrHistoryNotExist.Error()}
+	}
+
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+		}
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, URI, request)
+		if err != nil {
+			if err == archiver.ErrHistoryNotExist {
+				return nil, &shared.EntityNotExistsError{Message: err.Error()}
+			}
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: *highestVersion,
+		}
+	}
+
+	blobName := ConstructHistoryBlobName(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	data, err := h.client.Download(ctx, bucket, blobName)
+	if err != nil {
+		if err == ErrBlobNotExist {
+			return nil, &shared.EntityNotExistsError{Message: archiver.ErrHistoryNotExist.Error()}
+		}
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	manifest := &historyManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	if err := h.encrypter.ValidateEncryptionMetadata(request.DomainID, manifest.Encryption); err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	if token.NextPartIdx >= manifest.NumParts && manifest.NumParts > 0 {
+		return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+	}
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	for numOfEvents < request.PageSize && token.NextPartIdx < manifest.NumParts {
+		historyBatches, err := h.downloadPart(ctx, bucket, ConstructHistoryPartBlobName(blobName, token.NextPartIdx), manifest.Encryption)
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		if token.NextBatchIdx >= len(historyBatches) {
+			return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+		for _, batch := range historyBatches[token.NextBatchIdx:] {
+			if numOfEvents >= request.PageSize {
+				break
+			}
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			numOfEvents += len(batch.Events)
+			token.NextBatchIdx++
+		}
+		if token.NextBatchIdx == len(historyBatches) {
+			token.NextPartIdx++
+			token.NextBatchIdx = 0
+		}
+	}
+
+	if token.NextPartIdx < manifest.NumParts {
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != h.options.URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return h.options.ValidateBucket(URI.Hostname())
+}
+
+func (h *historyArchiver) encodePart(
+	historyBatches []*shared.History,
+	encryptionMetadata *archiver.EncryptionMetadata,
+) ([]byte, error) {
+	data, err := encode(historyBatches)
+	if err != nil || encryptionMetadata == nil {
+		return data, err
+	}
+	return h.encrypter.Encrypt(encryptionMetadata, data)
+}
+
+func (h *historyArchiver) downloadPart(
+	ctx context.Context,
+	bucket string,
+	partBlobName string,
+	encryptionMetadata *archiver.EncryptionMetadata,
+) ([]*shared.History, error) {
+	data, err := h.client.Download(ctx, bucket, partBlobName)
+	if err != nil {
+		return nil, err
+	}
+	if encryptionMetadata != nil {
+		if data, err = h.encrypter.Decrypt(encryptionMetadata, data); err != nil {
+			return nil, err
+		}
+	}
+	var historyBatches []*shared.History
+	if err := json.Unmarshal(data, &historyBatches); err != nil {
+		return nil, err
+	}
+	return historyBatches, nil
+}
+
+// upload uploads the blob, retrying on retryable errors until the retry policy expires or the context is done
+func (h *historyArchiver) upload(ctx context.Context, bucket string, blobName string, data []byte) error {
+	op := func() error {
+		return h.client.Upload(ctx, bucket, blobName, data)
+	}
+	if h.options.UploadRetryPolicy == nil {
+		return op()
+	}
+	isRetryable := func(err error) bool {
+		return h.options.IsRetryableError(err) && !contextExpired(ctx)
+	}
+	return backoff.Retry(op, h.options.UploadRetryPolicy, isRetryable)
+}
+
+func (h *historyArchiver) getHighestVersion(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*int64, error) {
+	prefix := constructHistoryBlobNamePrefix(URI.Path(), request.DomainID, request.WorkflowID, request.RunID)
+	blobNames, err := h.client.List(ctx, URI.Hostname(), prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var highestVersion *int64
+	for _, blobName := range blobNames {
+		version, err := extractCloseFailoverVersion(blobName)
+		if err != nil {
+			continue
+		}
+		if highestVersion == nil || version > *highestVersion {
+			highestVersion = &version
+		}
+	}
+	if highestVersion == nil {
+		return nil, archiver.ErrHistoryNotExist
+	}
+	return highestVersion, nil
+}
+
+func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiver.HistoryBlob, error) {
+	historyBlob, err := historyIterator.Next()
+	op := func() error {
+		historyBlob, err = historyIterator.Next()
+		return err
+	}
+	for err != nil {
+		if !common.IsPersistenceTransientError(err) {
+			return nil, err
+		}
+		if contextExpired(ctx) {
+			return nil, archiver.ErrContextTimeout
+		}
+		err = backoff.Retry(op, common.CreatePersistanceRetryPolicy(), common.IsPersistenceTransientError)
+	}
+	return historyBlob, nil
+}
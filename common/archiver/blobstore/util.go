@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blobstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/dgryski/go-farm"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/archiver"
+)
+
+// Blob name construction
+
+// ConstructHistoryBlobName returns the name of the blob listing the parts of the history
+func ConstructHistoryBlobName(dirPath, domainID, workflowID, runID string, version int64) string {
+	prefix := constructHistoryBlobNamePrefix(dirPath, domainID, workflowID, runID)
+	return fmt.Sprintf("%s_%v%s", prefix, version, historyBlobSuffix)
+}
+
+// ConstructHistoryPartBlobName returns the name of the blob holding the part of the history
+func ConstructHistoryPartBlobName(historyBlobName string, partIdx int) string {
+	return fmt.Sprintf("%s%s%v", historyBlobName, historyPartBlobInfix, partIdx)
+}
+
+func constructHistoryBlobNamePrefix(dirPath, domainID, workflowID, runID string) string {
+	combinedHash := strings.Join([]string{hash(domainID), hash(workflowID), hash(runID)}, "")
+	return path.Join(strings.TrimPrefix(dirPath, "/"), combinedHash)
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+// Encoding & decoding util
+
+func encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Misc.
+
+func extractCloseFailoverVersion(blobName string) (int64, error) {
+	if !strings.HasSuffix(blobName, historyBlobSuffix) {
+		return -1, errors.New("unknown blob name structure")
+	}
+	blobName = strings.TrimSuffix(path.Base(blobName), historyBlobSuffix)
+	nameParts := strings.Split(blobName, "_")
+	if len(nameParts) != 2 {
+		return -1, errors.New("unknown blob name structure")
+	}
+	return strconv.ParseInt(nameParts[1], 10, 64)
+}
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*shared.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
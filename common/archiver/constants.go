@@ -40,6 +40,8 @@ const (
 	ErrReasonReadHistory = "failed to read history batches"
 	// ErrReasonHistoryMutated is the error reason for mutated history
 	ErrReasonHistoryMutated = "history was mutated"
+	// ErrReasonEncryptBlob is the error reason for failing to encrypt archived blob
+	ErrReasonEncryptBlob = "failed to encrypt archived blob"
 )
 
 var (
@@ -59,4 +61,12 @@ var (
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrBlobNotEncrypted is the error for reading an unencrypted blob of a domain which requires encryption
+	ErrBlobNotEncrypted = errors.New("archived blob is not encrypted but the domain requires encryption")
+	// ErrBlobDomainMismatch is the error for reading a blob which was encrypted for another domain
+	ErrBlobDomainMismatch = errors.New("archived blob was encrypted for another domain")
+	// ErrUnknownEncryptionKey is the error for an encryption key which is not configured
+	ErrUnknownEncryptionKey = errors.New("encryption key is not configured")
+	// ErrUnknownEncryptionScheme is the error for an unsupported encryption scheme
+	ErrUnknownEncryptionScheme = errors.New("encryption scheme is not supported")
 )
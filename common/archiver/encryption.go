@@ -0,0 +1,203 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// EncryptionSchemeAES256GCM is the scheme for encrypting archived blobs with AES-256 in GCM mode
+	EncryptionSchemeAES256GCM = "aes256-gcm"
+
+	aes256KeySize = 32
+)
+
+type (
+	// EncryptionMetadata describes how an archived blob is encrypted, it is stored alongside the blob
+	EncryptionMetadata struct {
+		Scheme   string
+		KeyID    string
+		DomainID string
+	}
+
+	// BlobEncrypter encrypts and decrypts archived blobs with the key configured for their domain
+	BlobEncrypter interface {
+		// GetEncryptionMetadata returns the metadata to encrypt new blobs of the domain with,
+		// nil is returned if blobs of the domain are not encrypted
+		GetEncryptionMetadata(domainID string) (*EncryptionMetadata, error)
+		// ValidateEncryptionMetadata validates the metadata of a blob read for the domain,
+		// nil metadata means the blob is not encrypted
+		ValidateEncryptionMetadata(domainID string, metadata *EncryptionMetadata) error
+		Encrypt(metadata *EncryptionMetadata, plaintext []byte) ([]byte, error)
+		Decrypt(metadata *EncryptionMetadata, ciphertext []byte) ([]byte, error)
+	}
+
+	blobEncrypter struct {
+		domainCache cache.DomainCache
+		// key ID to cipher
+		ciphers map[string]cipher.AEAD
+		// domain name to the config of the key new blobs are encrypted with
+		domains map[string]config.ArchiverDomainEncryption
+	}
+)
+
+// NewBlobEncrypter creates a new BlobEncrypter based on the encryption config, blobs are not encrypted if config is nil
+func NewBlobEncrypter(
+	encryptionConfig *config.ArchiverEncryption,
+	domainCache cache.DomainCache,
+) (BlobEncrypter, error) {
+	encrypter := &blobEncrypter{
+		domainCache: domainCache,
+		ciphers:     make(map[string]cipher.AEAD),
+		domains:     make(map[string]config.ArchiverDomainEncryption),
+	}
+	if encryptionConfig == nil {
+		return encrypter, nil
+	}
+
+	for keyID, keyConfig := range encryptionConfig.Keys {
+		aead, err := newAES256GCMCipher(keyConfig.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load archiver encryption key %v: %v", keyID, err)
+		}
+		encrypter.ciphers[keyID] = aead
+	}
+	for domainName, domainConfig := range encryptionConfig.Domains {
+		if domainConfig.Scheme != EncryptionSchemeAES256GCM {
+			return nil, fmt.Errorf("invalid archiver encryption config for domain %v: %v", domainName, ErrUnknownEncryptionScheme)
+		}
+		if _, ok := encrypter.ciphers[domainConfig.KeyID]; !ok {
+			return nil, fmt.Errorf("invalid archiver encryption config for domain %v: %v", domainName, ErrUnknownEncryptionKey)
+		}
+		encrypter.domains[domainName] = domainConfig
+	}
+	return encrypter, nil
+}
+
+func (e *blobEncrypter) GetEncryptionMetadata(domainID string) (*EncryptionMetadata, error) {
+	domainConfig, ok, err := e.getDomainConfig(domainID)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &EncryptionMetadata{
+		Scheme:   domainConfig.Scheme,
+		KeyID:    domainConfig.KeyID,
+		DomainID: domainID,
+	}, nil
+}
+
+func (e *blobEncrypter) ValidateEncryptionMetadata(domainID string, metadata *EncryptionMetadata) error {
+	if metadata == nil {
+		_, ok, err := e.getDomainConfig(domainID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return ErrBlobNotEncrypted
+		}
+		return nil
+	}
+
+	if metadata.DomainID != domainID {
+		return ErrBlobDomainMismatch
+	}
+	_, err := e.getCipher(metadata)
+	return err
+}
+
+func (e *blobEncrypter) Encrypt(metadata *EncryptionMetadata, plaintext []byte) ([]byte, error) {
+	aead, err := e.getCipher(metadata)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	// the domainID is authenticated, so that the blob can not be moved to another domain
+	return aead.Seal(nonce, nonce, plaintext, []byte(metadata.DomainID)), nil
+}
+
+func (e *blobEncrypter) Decrypt(metadata *EncryptionMetadata, ciphertext []byte) ([]byte, error) {
+	aead, err := e.getCipher(metadata)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("archived blob is too short to be decrypted")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, []byte(metadata.DomainID))
+}
+
+func (e *blobEncrypter) getDomainConfig(domainID string) (config.ArchiverDomainEncryption, bool, error) {
+	if len(e.domains) == 0 {
+		return config.ArchiverDomainEncryption{}, false, nil
+	}
+	domainName, err := e.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return config.ArchiverDomainEncryption{}, false, err
+	}
+	domainConfig, ok := e.domains[domainName]
+	return domainConfig, ok, nil
+}
+
+func (e *blobEncrypter) getCipher(metadata *EncryptionMetadata) (cipher.AEAD, error) {
+	if metadata.Scheme != EncryptionSchemeAES256GCM {
+		return nil, ErrUnknownEncryptionScheme
+	}
+	aead, ok := e.ciphers[metadata.KeyID]
+	if !ok {
+		return nil, ErrUnknownEncryptionKey
+	}
+	return aead, nil
+}
+
+func newAES256GCMCipher(keyFile string) (cipher.AEAD, error) {
+	encodedKey, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encodedKey)))
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != aes256KeySize {
+		return nil, fmt.Errorf("key must be %v bytes", aes256KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	testEncryptionDomainID   = "test-domain-id"
+	testEncryptionDomainName = "test-domain-name"
+	testEncryptionKeyID      = "test-key"
+)
+
+type (
+	EncryptionSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		dir         string
+		domainCache *cache.DomainCacheMock
+	}
+)
+
+func TestEncryptionSuite(t *testing.T) {
+	suite.Run(t, new(EncryptionSuite))
+}
+
+func (s *EncryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "TestEncryption")
+	s.NoError(err)
+	s.dir = dir
+	s.domainCache = &cache.DomainCacheMock{}
+	s.domainCache.On("GetDomainName", testEncryptionDomainID).Return(testEncryptionDomainName, nil)
+	s.domainCache.On("GetDomainName", "some random domain ID").Return("some random domain name", nil)
+}
+
+func (s *EncryptionSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *EncryptionSuite) TestNewBlobEncrypter_InvalidConfig() {
+	keyFile := s.writeKeyFile(32)
+	shortKeyFile := s.writeKeyFile(16)
+
+	testCases := []*config.ArchiverEncryption{
+		{
+			Keys: map[string]config.ArchiverEncryptionKey{
+				testEncryptionKeyID: {KeyFile: shortKeyFile},
+			},
+		},
+		{
+			Keys: map[string]config.ArchiverEncryptionKey{
+				testEncryptionKeyID: {KeyFile: filepath.Join(s.dir, "some random file")},
+			},
+		},
+		{
+			Keys: map[string]config.ArchiverEncryptionKey{
+				testEncryptionKeyID: {KeyFile: keyFile},
+			},
+			Domains: map[string]config.ArchiverDomainEncryption{
+				testEncryptionDomainName: {Scheme: "some random scheme", KeyID: testEncryptionKeyID},
+			},
+		},
+		{
+			Keys: map[string]config.ArchiverEncryptionKey{
+				testEncryptionKeyID: {KeyFile: keyFile},
+			},
+			Domains: map[string]config.ArchiverDomainEncryption{
+				testEncryptionDomainName: {Scheme: EncryptionSchemeAES256GCM, KeyID: "some random key"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		_, err := NewBlobEncrypter(tc, s.domainCache)
+		s.Error(err)
+	}
+}
+
+func (s *EncryptionSuite) TestEncryptDecrypt() {
+	encrypter := s.newTestEncrypter()
+
+	metadata, err := encrypter.GetEncryptionMetadata("some random domain ID")
+	s.NoError(err)
+	s.Nil(metadata)
+	s.NoError(encrypter.ValidateEncryptionMetadata("some random domain ID", nil))
+	s.Equal(ErrBlobNotEncrypted, encrypter.ValidateEncryptionMetadata(testEncryptionDomainID, nil))
+
+	metadata, err = encrypter.GetEncryptionMetadata(testEncryptionDomainID)
+	s.NoError(err)
+	s.Equal(&EncryptionMetadata{
+		Scheme:   EncryptionSchemeAES256GCM,
+		KeyID:    testEncryptionKeyID,
+		DomainID: testEncryptionDomainID,
+	}, metadata)
+	s.NoError(encrypter.ValidateEncryptionMetadata(testEncryptionDomainID, metadata))
+	s.Equal(ErrBlobDomainMismatch, encrypter.ValidateEncryptionMetadata("some random domain ID", metadata))
+
+	plaintext := []byte("some random blob")
+	ciphertext, err := encrypter.Encrypt(metadata, plaintext)
+	s.NoError(err)
+	s.False(bytes.Contains(ciphertext, plaintext))
+	decrypted, err := encrypter.Decrypt(metadata, ciphertext)
+	s.NoError(err)
+	s.Equal(plaintext, decrypted)
+
+	// the domain is authenticated as part of the blob
+	_, err = encrypter.Decrypt(&EncryptionMetadata{
+		Scheme:   EncryptionSchemeAES256GCM,
+		KeyID:    testEncryptionKeyID,
+		DomainID: "some random domain ID",
+	}, ciphertext)
+	s.Error(err)
+
+	_, err = encrypter.Decrypt(&EncryptionMetadata{
+		Scheme:   EncryptionSchemeAES256GCM,
+		KeyID:    "some random key",
+		DomainID: testEncryptionDomainID,
+	}, ciphertext)
+	s.Equal(ErrUnknownEncryptionKey, err)
+}
+
+func (s *EncryptionSuite) newTestEncrypter() BlobEncrypter {
+	encrypter, err := NewBlobEncrypter(&config.ArchiverEncryption{
+		Keys: map[string]config.ArchiverEncryptionKey{
+			testEncryptionKeyID: {KeyFile: s.writeKeyFile(32)},
+		},
+		Domains: map[string]config.ArchiverDomainEncryption{
+			testEncryptionDomainName: {Scheme: EncryptionSchemeAES256GCM, KeyID: testEncryptionKeyID},
+		},
+	}, s.domainCache)
+	s.NoError(err)
+	return encrypter
+}
+
+func (s *EncryptionSuite) writeKeyFile(keySize int) string {
+	f, err := ioutil.TempFile(s.dir, "key")
+	s.NoError(err)
+	defer f.Close()
+	_, err = f.WriteString(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, keySize)))
+	s.NoError(err)
+	return f.Name()
+}
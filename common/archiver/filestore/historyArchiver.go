@@ -22,21 +22,30 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(domainID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories are streamed to that file in chunks, each chunk being a
+// JSON encoded list of history batches on its own line, so that the full history never
+// needs to be held in memory.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
-// version and the index of the first history batch that should be returned. Instead of
+// version, and the byte offset of the chunk holding the first history batch that should be
+// returned along with the index of that batch within the chunk. Instead of
 // NextPageToken, caller can also provide a close failover version, in which case, Get() method
 // will return history batches starting from the beginning of that history version. If neither
 // of NextPageToken or close failover version is specified, the highest close failover version
-// will be picked.
+// will be picked. History batches are read from the file one chunk at a time starting at the
+// offset in the token, so serving a page only requires reading the chunks of that page.
+
+// If an encryption key is configured for the domain, each chunk is encrypted with that key and
+// the encryption metadata is stored at the beginning of the file. Get() rejects files which are
+// not encrypted for the domain as configured.
 
 package filestore
 
 import (
 	"context"
 	"errors"
+	"io"
 	"os"
 	"path"
 	"strconv"
@@ -70,6 +79,7 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		encrypter archiver.BlobEncrypter
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -77,7 +87,10 @@ type (
 
 	getHistoryToken struct {
 		CloseFailoverVersion int64
-		NextBatchIdx         int
+		// ChunkOffset is the byte offset of the chunk holding the next history batch,
+		// and NextBatchIdx is the index of that batch within the chunk
+		ChunkOffset  int64
+		NextBatchIdx int
 	}
 )
 
@@ -85,13 +98,15 @@ type (
 func NewHistoryArchiver(
 	container *archiver.HistoryBootstrapContainer,
 	config *config.FilestoreArchiver,
+	encrypter archiver.BlobEncrypter,
 ) (archiver.HistoryArchiver, error) {
-	return newHistoryArchiver(container, config, nil)
+	return newHistoryArchiver(container, config, encrypter, nil)
 }
 
 func newHistoryArchiver(
 	container *archiver.HistoryBootstrapContainer,
 	config *config.FilestoreArchiver,
+	encrypter archiver.BlobEncrypter,
 	historyIterator archiver.HistoryIterator,
 ) (*historyArchiver, error) {
 	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
@@ -106,6 +121,7 @@ func newHistoryArchiver(
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		encrypter:       encrypter,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -135,15 +151,24 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	encryptionMetadata, err := h.encrypter.GetEncryptionMetadata(request.DomainID)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonEncryptBlob), tag.Error(err))
+		return err
+	}
+
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
 		historyIterator = archiver.NewHistoryIterator(request, h.container.HistoryManager, h.container.HistoryV2Manager, targetHistoryBlobSize)
 	}
 
-	historyBatches := []*shared.History{}
+	// history is written to file one blob at a time, so that at most one blob
+	// of history is held in memory regardless of the size of the whole history
+	var writer *historyBatchWriter
 	for historyIterator.HasNext() {
 		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
 		if err != nil {
+			abortHistoryBatchWriter(writer)
 			logger := logger.WithTags(tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
 			if !common.IsPersistenceTransientError(err) {
 				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
@@ -154,27 +179,31 @@ func (h *historyArchiver) Archive(
 		}
 
 		if historyMutated(request, historyBlob.Body, *historyBlob.Header.IsLast) {
+			abortHistoryBatchWriter(writer)
 			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
 			return archiver.ErrHistoryMutated
 		}
 
-		historyBatches = append(historyBatches, historyBlob.Body...)
-	}
-
-	encodedHistoryBatches, err := encode(historyBatches)
-	if err != nil {
-		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
-		return err
+		if writer == nil {
+			if writer, err = h.newHistoryBatchWriter(URI, request, encryptionMetadata); err != nil {
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+				return err
+			}
+		}
+		if err := writer.Write(historyBlob.Body); err != nil {
+			writer.Abort()
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
 	}
 
-	dirPath := URI.Path()
-	if err = mkdirAll(dirPath, h.dirMode); err != nil {
-		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
-		return err
+	if writer == nil {
+		if writer, err = h.newHistoryBatchWriter(URI, request, encryptionMetadata); err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+			return err
+		}
 	}
-
-	filename := constructHistoryFilename(request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
-	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+	if err := writer.Commit(); err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
@@ -236,31 +265,28 @@ func (h *historyArchiver) Get(
 		return nil, &shared.EntityNotExistsError{Message: archiver.ErrHistoryNotExist.Error()}
 	}
 
-	encodedHistoryBatches, err := readFile(filepath)
+	reader, err := newHistoryBatchReader(filepath, request.DomainID, h.encrypter)
 	if err != nil {
 		return nil, &shared.InternalServiceError{Message: err.Error()}
 	}
+	defer reader.Close()
 
-	historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
+	if err := reader.SetPosition(token.ChunkOffset, token.NextBatchIdx); err != nil {
+		if err == io.EOF || err == errInvalidChunkOffset {
+			return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+
+	historyBatches, hasNext, err := archiver.ReadHistoryPage(reader, request.PageSize)
 	if err != nil {
 		return nil, &shared.InternalServiceError{Message: err.Error()}
 	}
-	historyBatches = historyBatches[token.NextBatchIdx:]
-
-	response := &archiver.GetHistoryResponse{}
-	numOfEvents := 0
-	numOfBatches := 0
-	for _, batch := range historyBatches {
-		response.HistoryBatches = append(response.HistoryBatches, batch)
-		numOfBatches++
-		numOfEvents += len(batch.Events)
-		if numOfEvents >= request.PageSize {
-			break
-		}
+	response := &archiver.GetHistoryResponse{
+		HistoryBatches: historyBatches,
 	}
-
-	if numOfBatches < len(historyBatches) {
-		token.NextBatchIdx += numOfBatches
+	if hasNext {
+		token.ChunkOffset, token.NextBatchIdx = reader.Position()
 		nextToken, err := serializeToken(token)
 		if err != nil {
 			return nil, &shared.InternalServiceError{Message: err.Error()}
@@ -279,6 +305,25 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	return validateDirPath(URI.Path())
 }
 
+func (h *historyArchiver) newHistoryBatchWriter(
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	encryptionMetadata *archiver.EncryptionMetadata,
+) (*historyBatchWriter, error) {
+	dirPath := URI.Path()
+	if err := mkdirAll(dirPath, h.dirMode); err != nil {
+		return nil, err
+	}
+	filename := constructHistoryFilename(request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	return newHistoryBatchWriter(path.Join(dirPath, filename), h.fileMode, h.encrypter, encryptionMetadata)
+}
+
+func abortHistoryBatchWriter(writer *historyBatchWriter) {
+	if writer != nil {
+		writer.Abort()
+	}
+}
+
 func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiver.HistoryBlob, error) {
 	historyBlob, err := historyIterator.Next()
 	op := func() error {
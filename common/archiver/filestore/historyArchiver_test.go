@@ -471,6 +471,46 @@ func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
 	s.Equal(s.historyBatchesV100, combinedHistory)
 }
 
+func (s *historyArchiverSuite) TestGet_Success_TokenWithoutOffset() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	token, err := serializeToken(map[string]int64{"CloseFailoverVersion": 100, "NextBatchIdx": 1})
+	s.NoError(err)
+	request := &archiver.GetHistoryRequest{
+		DomainID:      testDomainID,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		PageSize:      testPageSize,
+		NextPageToken: token,
+	}
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100[1:], response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidTokenOffset() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	token, err := serializeToken(&getHistoryToken{
+		CloseFailoverVersion: 100,
+		ChunkOffset:          1,
+	})
+	s.NoError(err)
+	request := &archiver.GetHistoryRequest{
+		DomainID:      testDomainID,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		PageSize:      testPageSize,
+		NextPageToken: token,
+	}
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, request)
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
 func (s *historyArchiverSuite) TestArchiveAndGet() {
 	mockCtrl := gomock.NewController(s.T())
 	defer mockCtrl.Finish()
@@ -522,12 +562,84 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_MultipleBlobs() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob1 := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(false),
+		},
+		Body: s.historyBatchesV100[:1],
+	}
+	historyBlob2 := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100[1:],
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob1, nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob2, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndGet")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   1,
+	}
+	combinedHistory := []*shared.History{}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+	token, err := deserializeGetHistoryToken(response.NextPageToken)
+	s.NoError(err)
+	s.True(token.ChunkOffset > 0)
+	s.Equal(0, token.NextBatchIdx)
+
+	getRequest.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	s.Equal(s.historyBatchesV100, combinedHistory)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
 		DirMode:  testDirModeStr,
 	}
-	archiver, err := newHistoryArchiver(s.container, config, historyIterator)
+	encrypter, err := archiver.NewBlobEncrypter(nil, nil)
+	s.NoError(err)
+	archiver, err := newHistoryArchiver(s.container, config, encrypter, historyIterator)
 	s.NoError(err)
 	return archiver
 }
@@ -0,0 +1,342 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/archiver"
+)
+
+const (
+	tmpHistoryFileSuffix = ".tmp"
+)
+
+var (
+	errInvalidChunkOffset = errors.New("offset is not the beginning of a history chunk")
+)
+
+type (
+	// historyBatchWriter writes history batches to an archived history file one chunk at a time.
+	// Each chunk is encoded as a JSON array of history batches followed by a newline, so the whole
+	// history never needs to be held in memory. Chunks are written to a temporary file which is
+	// only renamed to the target file on Commit, so partially written histories are never visible.
+	// If the history is encrypted, the file starts with a header line holding the encryption metadata,
+	// and each chunk is encrypted and written as a base64 encoded JSON string.
+	historyBatchWriter struct {
+		filepath  string
+		file      *os.File
+		buffer    *bufio.Writer
+		encoder   *json.Encoder
+		encrypter archiver.BlobEncrypter
+		metadata  *archiver.EncryptionMetadata
+	}
+
+	// historyBatchReader reads history batches from an archived history file one chunk at a time.
+	// Files written as a single JSON array of history batches are read as a single chunk.
+	// The position of the reader is the byte offset of a chunk plus the index of a batch within
+	// that chunk, so a reader can seek to a position without reading the chunks before it.
+	historyBatchReader struct {
+		file      *os.File
+		reader    *bufio.Reader
+		encrypter archiver.BlobEncrypter
+		metadata  *archiver.EncryptionMetadata
+
+		// offset of the first chunk, offset of the next line to read,
+		// and offset of the chunk the remaining batches belong to
+		dataOffset  int64
+		offset      int64
+		chunkOffset int64
+		chunkIdx    int
+		chunk       []*shared.History
+	}
+
+	historyFileHeader struct {
+		Encryption *archiver.EncryptionMetadata
+	}
+)
+
+// newHistoryBatchWriter creates a writer for the archived history file specified by filepath,
+// chunks are encrypted if metadata is not nil
+func newHistoryBatchWriter(
+	filepath string,
+	fileMode os.FileMode,
+	encrypter archiver.BlobEncrypter,
+	metadata *archiver.EncryptionMetadata,
+) (*historyBatchWriter, error) {
+	tmpFilepath := filepath + tmpHistoryFileSuffix
+	if err := os.Remove(tmpFilepath); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	f, err := os.OpenFile(tmpFilepath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fileMode)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Chmod(fileMode); err != nil {
+		f.Close()
+		os.Remove(tmpFilepath)
+		return nil, err
+	}
+	buffer := bufio.NewWriter(f)
+	writer := &historyBatchWriter{
+		filepath:  filepath,
+		file:      f,
+		buffer:    buffer,
+		encoder:   json.NewEncoder(buffer),
+		encrypter: encrypter,
+		metadata:  metadata,
+	}
+	if metadata != nil {
+		if err := writer.encoder.Encode(&historyFileHeader{Encryption: metadata}); err != nil {
+			writer.Abort()
+			return nil, err
+		}
+	}
+	return writer, nil
+}
+
+// Write appends a chunk of history batches to the file
+func (w *historyBatchWriter) Write(historyBatches []*shared.History) error {
+	if w.metadata == nil {
+		return w.encoder.Encode(historyBatches)
+	}
+	data, err := json.Marshal(historyBatches)
+	if err != nil {
+		return err
+	}
+	encrypted, err := w.encrypter.Encrypt(w.metadata, data)
+	if err != nil {
+		return err
+	}
+	return w.encoder.Encode(encrypted)
+}
+
+// Commit flushes all written chunks and atomically replaces the target file
+func (w *historyBatchWriter) Commit() error {
+	if err := w.buffer.Flush(); err != nil {
+		w.Abort()
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		os.Remove(w.file.Name())
+		return err
+	}
+	if err := os.Rename(w.file.Name(), w.filepath); err != nil {
+		os.Remove(w.file.Name())
+		return err
+	}
+	return nil
+}
+
+// Abort discards all written chunks
+func (w *historyBatchWriter) Abort() {
+	w.file.Close()
+	os.Remove(w.file.Name())
+}
+
+// newHistoryBatchReader opens the archived history file of the domain specified by filepath,
+// the encryption metadata of the file is validated before any history batch is read
+// WARNING: callers of this method should be extremely careful not to use it in a context where filepath is supplied by
+// the user.
+func newHistoryBatchReader(
+	filepath string,
+	domainID string,
+	encrypter archiver.BlobEncrypter,
+) (*historyBatchReader, error) {
+	// #nosec
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	reader := &historyBatchReader{
+		file:      f,
+		reader:    bufio.NewReader(f),
+		encrypter: encrypter,
+	}
+	if err := reader.readHeader(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := encrypter.ValidateEncryptionMetadata(domainID, reader.metadata); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return reader, nil
+}
+
+// HasNext returns true if there are more history batches to read
+func (r *historyBatchReader) HasNext() (bool, error) {
+	for len(r.chunk) == 0 {
+		encoded, offset, err := r.readLine()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if err := r.loadChunk(encoded, offset); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Next returns the next history batch, or io.EOF if there are no more history batches
+func (r *historyBatchReader) Next() (*shared.History, error) {
+	hasNext, err := r.HasNext()
+	if err != nil {
+		return nil, err
+	}
+	if !hasNext {
+		return nil, io.EOF
+	}
+	batch := r.chunk[0]
+	r.chunk = r.chunk[1:]
+	r.chunkIdx++
+	return batch, nil
+}
+
+// Skip discards the next n history batches
+func (r *historyBatchReader) Skip(n int) error {
+	for i := 0; i < n; i++ {
+		if _, err := r.Next(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Position returns the offset of the chunk holding the next history batch and the index of that
+// batch within the chunk. HasNext should be called first so that the chunk is loaded.
+func (r *historyBatchReader) Position() (int64, int) {
+	if len(r.chunk) == 0 {
+		return r.offset, 0
+	}
+	return r.chunkOffset, r.chunkIdx
+}
+
+// SetPosition moves the reader to a position previously returned by Position, an offset of 0
+// means the beginning of the file
+func (r *historyBatchReader) SetPosition(offset int64, batchIdx int) error {
+	if offset != 0 {
+		if offset < r.dataOffset {
+			return errInvalidChunkOffset
+		}
+		// every chunk starts on a new line
+		previous := make([]byte, 1)
+		if _, err := r.file.ReadAt(previous, offset-1); err != nil {
+			if err == io.EOF {
+				return errInvalidChunkOffset
+			}
+			return err
+		}
+		if previous[0] != '\n' {
+			return errInvalidChunkOffset
+		}
+		if _, err := r.file.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		r.reader.Reset(r.file)
+		r.offset = offset
+		r.chunk = nil
+	}
+	return r.Skip(batchIdx)
+}
+
+// readHeader reads the header of an encrypted file, the first chunk of an unencrypted file is kept instead
+func (r *historyBatchReader) readHeader() error {
+	encoded, offset, err := r.readLine()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if encoded[0] != '{' {
+		r.dataOffset = offset
+		return r.loadChunk(encoded, offset)
+	}
+	header := &historyFileHeader{}
+	if err := json.Unmarshal(encoded, header); err != nil {
+		return err
+	}
+	r.metadata = header.Encryption
+	r.dataOffset = r.offset
+	return nil
+}
+
+// readLine returns the next non empty line of the file along with its offset, or io.EOF
+func (r *historyBatchReader) readLine() ([]byte, int64, error) {
+	for {
+		offset := r.offset
+		line, err := r.reader.ReadBytes('\n')
+		r.offset += int64(len(line))
+		if err != nil && err != io.EOF {
+			return nil, 0, err
+		}
+		if line = bytes.TrimSpace(line); len(line) != 0 {
+			return line, offset, nil
+		}
+		if err == io.EOF {
+			return nil, 0, io.EOF
+		}
+	}
+}
+
+func (r *historyBatchReader) loadChunk(encoded []byte, offset int64) error {
+	chunk, err := r.decodeChunk(encoded)
+	if err != nil {
+		return err
+	}
+	r.chunk = chunk
+	r.chunkOffset = offset
+	r.chunkIdx = 0
+	return nil
+}
+
+func (r *historyBatchReader) decodeChunk(encoded []byte) ([]*shared.History, error) {
+	var chunk []*shared.History
+	if r.metadata == nil {
+		err := json.Unmarshal(encoded, &chunk)
+		return chunk, err
+	}
+	var encrypted []byte
+	if err := json.Unmarshal(encoded, &encrypted); err != nil {
+		return nil, err
+	}
+	data, err := r.encrypter.Decrypt(r.metadata, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &chunk)
+	return chunk, err
+}
+
+// Close closes the underlying file
+func (r *historyBatchReader) Close() error {
+	return r.file.Close()
+}
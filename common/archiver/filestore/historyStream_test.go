@@ -0,0 +1,336 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type historyStreamSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	dir            string
+	keyDir         string
+	historyBatches []*shared.History
+	encrypter      archiver.BlobEncrypter
+	plainEncrypter archiver.BlobEncrypter
+}
+
+func TestHistoryStreamSuite(t *testing.T) {
+	suite.Run(t, new(historyStreamSuite))
+}
+
+func (s *historyStreamSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "TestHistoryStream")
+	s.NoError(err)
+	s.dir = dir
+	s.keyDir, err = ioutil.TempDir("", "TestHistoryStreamKey")
+	s.NoError(err)
+	s.encrypter = newTestEncrypter(s.T(), s.keyDir)
+	s.plainEncrypter, err = archiver.NewBlobEncrypter(nil, nil)
+	s.NoError(err)
+	s.historyBatches = []*shared.History{
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId: common.Int64Ptr(common.FirstEventID),
+					Version: common.Int64Ptr(1),
+				},
+			},
+		},
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId:   common.Int64Ptr(common.FirstEventID + 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(1),
+				},
+				&shared.HistoryEvent{
+					EventId: common.Int64Ptr(common.FirstEventID + 2),
+					Version: common.Int64Ptr(2),
+					DecisionTaskStartedEventAttributes: &shared.DecisionTaskStartedEventAttributes{
+						Identity: common.StringPtr("some random identity"),
+					},
+				},
+			},
+		},
+		&shared.History{
+			Events: []*shared.HistoryEvent{
+				&shared.HistoryEvent{
+					EventId: common.Int64Ptr(common.FirstEventID + 3),
+					Version: common.Int64Ptr(2),
+				},
+			},
+		},
+	}
+}
+
+func (s *historyStreamSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+	os.RemoveAll(s.keyDir)
+}
+
+func (s *historyStreamSuite) TestWriteAndRead_MultipleChunks() {
+	fpath := filepath.Join(s.dir, "test.history")
+	writer, err := newHistoryBatchWriter(fpath, testFileMode, s.plainEncrypter, nil)
+	s.NoError(err)
+	s.NoError(writer.Write(s.historyBatches[:2]))
+	s.NoError(writer.Write(s.historyBatches[2:]))
+
+	exists, err := fileExists(fpath)
+	s.NoError(err)
+	s.False(exists)
+
+	s.NoError(writer.Commit())
+	info, err := os.Stat(fpath)
+	s.NoError(err)
+	s.Equal(testFileMode, info.Mode())
+	s.Equal(s.historyBatches, s.readAll(fpath, 0))
+	s.Equal(s.historyBatches[1:], s.readAll(fpath, 1))
+	s.Equal(s.historyBatches[2:], s.readAll(fpath, 2))
+}
+
+func (s *historyStreamSuite) TestWriteAndRead_Overwrite() {
+	fpath := filepath.Join(s.dir, "test.history")
+	writer, err := newHistoryBatchWriter(fpath, testFileMode, s.plainEncrypter, nil)
+	s.NoError(err)
+	s.NoError(writer.Write(s.historyBatches[:1]))
+	s.NoError(writer.Commit())
+
+	writer, err = newHistoryBatchWriter(fpath, testFileMode, s.plainEncrypter, nil)
+	s.NoError(err)
+	s.NoError(writer.Write(s.historyBatches))
+	s.NoError(writer.Commit())
+	s.Equal(s.historyBatches, s.readAll(fpath, 0))
+}
+
+func (s *historyStreamSuite) TestAbort() {
+	fpath := filepath.Join(s.dir, "test.history")
+	writer, err := newHistoryBatchWriter(fpath, testFileMode, s.plainEncrypter, nil)
+	s.NoError(err)
+	s.NoError(writer.Write(s.historyBatches))
+	writer.Abort()
+
+	files, err := listFiles(s.dir)
+	s.NoError(err)
+	s.Empty(files)
+}
+
+func (s *historyStreamSuite) TestRead_SingleArrayFile() {
+	fpath := filepath.Join(s.dir, "test.history")
+	data, err := encode(s.historyBatches)
+	s.NoError(err)
+	s.NoError(writeFile(fpath, data, testFileMode))
+	s.Equal(s.historyBatches, s.readAll(fpath, 0))
+	s.Equal(s.historyBatches[2:], s.readAll(fpath, 2))
+}
+
+func (s *historyStreamSuite) TestRead_SkipPastEnd() {
+	fpath := filepath.Join(s.dir, "test.history")
+	writer, err := newHistoryBatchWriter(fpath, testFileMode, s.plainEncrypter, nil)
+	s.NoError(err)
+	s.NoError(writer.Write(s.historyBatches))
+	s.NoError(writer.Commit())
+
+	reader, err := newHistoryBatchReader(fpath, testDomainID, s.plainEncrypter)
+	s.NoError(err)
+	defer reader.Close()
+	s.Equal(io.EOF, reader.Skip(len(s.historyBatches)+1))
+}
+
+func (s *historyStreamSuite) TestSetPosition() {
+	fpath := filepath.Join(s.dir, "test.history")
+	s.writeEncrypted(fpath)
+
+	reader, err := newHistoryBatchReader(fpath, testDomainID, s.encrypter)
+	s.NoError(err)
+	defer reader.Close()
+	var offsets []int64
+	var batchIdxs []int
+	for {
+		hasNext, err := reader.HasNext()
+		s.NoError(err)
+		if !hasNext {
+			break
+		}
+		offset, batchIdx := reader.Position()
+		offsets = append(offsets, offset)
+		batchIdxs = append(batchIdxs, batchIdx)
+		_, err = reader.Next()
+		s.NoError(err)
+	}
+	s.Equal([]int{0, 1, 0}, batchIdxs)
+	s.True(offsets[0] > 0)
+	s.Equal(offsets[0], offsets[1])
+	s.True(offsets[2] > offsets[1])
+
+	for i := range s.historyBatches {
+		reader, err := newHistoryBatchReader(fpath, testDomainID, s.encrypter)
+		s.NoError(err)
+		s.NoError(reader.SetPosition(offsets[i], batchIdxs[i]))
+		batch, err := reader.Next()
+		s.NoError(err)
+		s.Equal(s.historyBatches[i], batch)
+		reader.Close()
+	}
+}
+
+func (s *historyStreamSuite) TestSetPosition_InvalidOffset() {
+	fpath := filepath.Join(s.dir, "test.history")
+	s.writeEncrypted(fpath)
+	info, err := os.Stat(fpath)
+	s.NoError(err)
+
+	for _, offset := range []int64{1, info.Size() - 1, info.Size() + 1} {
+		reader, err := newHistoryBatchReader(fpath, testDomainID, s.encrypter)
+		s.NoError(err)
+		s.Equal(errInvalidChunkOffset, reader.SetPosition(offset, 0))
+		reader.Close()
+	}
+}
+
+func (s *historyStreamSuite) TestRead_CorruptedFile() {
+	fpath := filepath.Join(s.dir, "test.history")
+	s.NoError(writeFile(fpath, []byte("random bytes"), testFileMode))
+
+	_, err := newHistoryBatchReader(fpath, testDomainID, s.plainEncrypter)
+	s.Error(err)
+	s.NotEqual(io.EOF, err)
+}
+
+func (s *historyStreamSuite) TestWriteAndRead_Encrypted() {
+	fpath := filepath.Join(s.dir, "test.history")
+	s.writeEncrypted(fpath)
+
+	data, err := readFile(fpath)
+	s.NoError(err)
+	s.False(bytes.Contains(data, []byte("some random identity")))
+
+	reader, err := newHistoryBatchReader(fpath, testDomainID, s.encrypter)
+	s.NoError(err)
+	defer reader.Close()
+	s.NoError(reader.Skip(1))
+	var historyBatches []*shared.History
+	for {
+		batch, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		s.NoError(err)
+		historyBatches = append(historyBatches, batch)
+	}
+	s.Equal(s.historyBatches[1:], historyBatches)
+}
+
+func (s *historyStreamSuite) TestRead_Encrypted_DomainMismatch() {
+	fpath := filepath.Join(s.dir, "test.history")
+	s.writeEncrypted(fpath)
+
+	_, err := newHistoryBatchReader(fpath, "some random domain ID", s.encrypter)
+	s.Equal(archiver.ErrBlobDomainMismatch, err)
+}
+
+func (s *historyStreamSuite) TestRead_Encrypted_UnknownKey() {
+	fpath := filepath.Join(s.dir, "test.history")
+	s.writeEncrypted(fpath)
+
+	_, err := newHistoryBatchReader(fpath, testDomainID, s.plainEncrypter)
+	s.Equal(archiver.ErrUnknownEncryptionKey, err)
+}
+
+func (s *historyStreamSuite) TestRead_NotEncrypted_EncryptionRequired() {
+	fpath := filepath.Join(s.dir, "test.history")
+	writer, err := newHistoryBatchWriter(fpath, testFileMode, s.plainEncrypter, nil)
+	s.NoError(err)
+	s.NoError(writer.Write(s.historyBatches))
+	s.NoError(writer.Commit())
+
+	_, err = newHistoryBatchReader(fpath, testDomainID, s.encrypter)
+	s.Equal(archiver.ErrBlobNotEncrypted, err)
+}
+
+func (s *historyStreamSuite) writeEncrypted(fpath string) {
+	metadata, err := s.encrypter.GetEncryptionMetadata(testDomainID)
+	s.NoError(err)
+	s.NotNil(metadata)
+	writer, err := newHistoryBatchWriter(fpath, testFileMode, s.encrypter, metadata)
+	s.NoError(err)
+	s.NoError(writer.Write(s.historyBatches[:2]))
+	s.NoError(writer.Write(s.historyBatches[2:]))
+	s.NoError(writer.Commit())
+}
+
+func (s *historyStreamSuite) readAll(fpath string, skip int) []*shared.History {
+	reader, err := newHistoryBatchReader(fpath, testDomainID, s.plainEncrypter)
+	s.NoError(err)
+	defer reader.Close()
+	s.NoError(reader.Skip(skip))
+
+	var historyBatches []*shared.History
+	for {
+		batch, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		s.NoError(err)
+		historyBatches = append(historyBatches, batch)
+	}
+	return historyBatches
+}
+
+// newTestEncrypter returns an encrypter which encrypts blobs of the test domain with a key written to dir
+func newTestEncrypter(t *testing.T, dir string) archiver.BlobEncrypter {
+	keyFile := filepath.Join(dir, "test.key")
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte(key), testFileMode))
+
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetDomainName", testDomainID).Return(testDomainName, nil)
+	domainCache.On("GetDomainName", mock.Anything).Return("some random domain name", nil)
+	encrypter, err := archiver.NewBlobEncrypter(&config.ArchiverEncryption{
+		Keys: map[string]config.ArchiverEncryptionKey{
+			"test-key": {KeyFile: keyFile},
+		},
+		Domains: map[string]config.ArchiverDomainEncryption{
+			testDomainName: {Scheme: archiver.EncryptionSchemeAES256GCM, KeyID: "test-key"},
+		},
+	}, domainCache)
+	require.NoError(t, err)
+	return encrypter
+}
@@ -137,16 +137,40 @@ func encode(v interface{}) ([]byte, error) {
 	return json.Marshal(v)
 }
 
-func decodeHistoryBatches(data []byte) ([]*shared.History, error) {
-	historyBatches := []*shared.History{}
-	err := json.Unmarshal(data, &historyBatches)
+// encryptVisibilityRecord wraps the encoded visibility record in an encrypted envelope
+// if an encryption key is configured for the domain
+func encryptVisibilityRecord(encrypter archiver.BlobEncrypter, domainID string, data []byte) ([]byte, error) {
+	metadata, err := encrypter.GetEncryptionMetadata(domainID)
+	if err != nil || metadata == nil {
+		return data, err
+	}
+	encrypted, err := encrypter.Encrypt(metadata, data)
 	if err != nil {
 		return nil, err
 	}
-	return historyBatches, nil
+	return encode(&encryptedVisibilityRecord{
+		Encryption: metadata,
+		Ciphertext: encrypted,
+	})
 }
 
-func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
+// decodeVisibilityRecord decodes a visibility record of the domain, which may be wrapped in an encrypted envelope
+func decodeVisibilityRecord(encrypter archiver.BlobEncrypter, domainID string, data []byte) (*visibilityRecord, error) {
+	envelope := &encryptedVisibilityRecord{}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, err
+	}
+	if err := encrypter.ValidateEncryptionMetadata(domainID, envelope.Encryption); err != nil {
+		return nil, err
+	}
+	if envelope.Encryption != nil {
+		decrypted, err := encrypter.Decrypt(envelope.Encryption, envelope.Ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		data = decrypted
+	}
+
 	record := &visibilityRecord{}
 	err := json.Unmarshal(data, record)
 	if err != nil {
@@ -25,7 +25,6 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -179,42 +178,6 @@ func (s *UtilSuite) TestListFilesByPrefix() {
 	s.Equal(len(expectedFileNames), len(actualFileNames))
 }
 
-func (s *UtilSuite) TestEncodeDecodeHistoryBatches() {
-	historyBatches := []*shared.History{
-		&shared.History{
-			Events: []*shared.HistoryEvent{
-				&shared.HistoryEvent{
-					EventId: common.Int64Ptr(common.FirstEventID),
-					Version: common.Int64Ptr(1),
-				},
-			},
-		},
-		&shared.History{
-			Events: []*shared.HistoryEvent{
-				&shared.HistoryEvent{
-					EventId:   common.Int64Ptr(common.FirstEventID + 1),
-					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
-					Version:   common.Int64Ptr(1),
-				},
-				&shared.HistoryEvent{
-					EventId: common.Int64Ptr(common.FirstEventID + 2),
-					Version: common.Int64Ptr(2),
-					DecisionTaskStartedEventAttributes: &shared.DecisionTaskStartedEventAttributes{
-						Identity: common.StringPtr("some random identity"),
-					},
-				},
-			},
-		},
-	}
-
-	encodedHistoryBatches, err := encode(historyBatches)
-	s.NoError(err)
-
-	decodedHistoryBatches, err := decodeHistoryBatches(encodedHistoryBatches)
-	s.NoError(err)
-	s.Equal(historyBatches, decodedHistoryBatches)
-}
-
 func (s *UtilSuite) TestValidateDirPath() {
 	dir, err := ioutil.TempDir("", "TestValidateDirPath")
 	s.NoError(err)
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/query"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/service/config"
 )
@@ -46,7 +47,8 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		fileMode    os.FileMode
 		dirMode     os.FileMode
-		queryParser QueryParser
+		encrypter   archiver.BlobEncrypter
+		queryParser query.Parser
 	}
 
 	queryVisibilityToken struct {
@@ -56,11 +58,17 @@ type (
 
 	visibilityRecord archiver.ArchiveVisibilityRequest
 
+	// encryptedVisibilityRecord is the envelope of a visibility record encrypted with the key of its domain
+	encryptedVisibilityRecord struct {
+		Encryption *archiver.EncryptionMetadata
+		Ciphertext []byte
+	}
+
 	queryVisibilityRequest struct {
 		domainID      string
 		pageSize      int
 		nextPageToken []byte
-		parsedQuery   *parsedQuery
+		parsedQuery   *query.ParsedQuery
 	}
 )
 
@@ -68,6 +76,7 @@ type (
 func NewVisibilityArchiver(
 	container *archiver.VisibilityBootstrapContainer,
 	config *config.FilestoreArchiver,
+	encrypter archiver.BlobEncrypter,
 ) (archiver.VisibilityArchiver, error) {
 	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
 	if err != nil {
@@ -81,7 +90,8 @@ func NewVisibilityArchiver(
 		container:   container,
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
-		queryParser: NewQueryParser(),
+		encrypter:   encrypter,
+		queryParser: query.NewParser(),
 	}, nil
 }
 
@@ -122,6 +132,12 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	encodedVisibilityRecord, err = encryptVisibilityRecord(v.encrypter, request.DomainID, encodedVisibilityRecord)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonEncryptBlob), tag.Error(err))
+		return err
+	}
+
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.CloseTimestamp, request.RunID)
@@ -151,7 +167,7 @@ func (v *visibilityArchiver) Query(
 		return nil, &shared.BadRequestError{Message: err.Error()}
 	}
 
-	if parsedQuery.emptyResult {
+	if parsedQuery.EmptyResult {
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
@@ -206,12 +222,12 @@ func (v *visibilityArchiver) query(
 			return nil, &shared.InternalServiceError{Message: err.Error()}
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
+		record, err := decodeVisibilityRecord(v.encrypter, request.domainID, encodedRecord)
 		if err != nil {
 			return nil, &shared.InternalServiceError{Message: err.Error()}
 		}
 
-		if record.CloseTimestamp < request.parsedQuery.earliestCloseTime {
+		if record.CloseTimestamp < request.parsedQuery.EarliestCloseTime {
 			break
 		}
 
@@ -303,20 +319,20 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
-func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
-	if record.CloseTimestamp < query.earliestCloseTime || record.CloseTimestamp > query.latestCloseTime {
+func matchQuery(record *visibilityRecord, parsedQuery *query.ParsedQuery) bool {
+	if record.CloseTimestamp < parsedQuery.EarliestCloseTime || record.CloseTimestamp > parsedQuery.LatestCloseTime {
 		return false
 	}
-	if query.workflowID != nil && record.WorkflowID != *query.workflowID {
+	if parsedQuery.WorkflowID != nil && record.WorkflowID != *parsedQuery.WorkflowID {
 		return false
 	}
-	if query.runID != nil && record.RunID != *query.runID {
+	if parsedQuery.RunID != nil && record.RunID != *parsedQuery.RunID {
 		return false
 	}
-	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
+	if parsedQuery.WorkflowTypeName != nil && record.WorkflowTypeName != *parsedQuery.WorkflowTypeName {
 		return false
 	}
-	if query.closeStatus != nil && record.CloseStatus != *query.closeStatus {
+	if parsedQuery.CloseStatus != nil && record.CloseStatus != *parsedQuery.CloseStatus {
 		return false
 	}
 	return true
@@ -340,5 +356,6 @@ func convertToExecutionInfo(record *visibilityRecord) *shared.WorkflowExecutionI
 		SearchAttributes: &shared.SearchAttributes{
 			IndexedFields: archiver.ConvertSearchAttrToBytes(record.SearchAttributes),
 		},
+		HistoryArchivalInfo: archiver.ConvertHistoryArchivalInfo((*archiver.ArchiveVisibilityRequest)(record)),
 	}
 }
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/query"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/service/config"
@@ -199,14 +200,14 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 
 func (s *visibilityArchiverSuite) TestMatchQuery() {
 	testCases := []struct {
-		query       *parsedQuery
+		query       *query.ParsedQuery
 		record      *visibilityRecord
 		shouldMatch bool
 	}{
 		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
+			query: &query.ParsedQuery{
+				EarliestCloseTime: int64(1000),
+				LatestCloseTime:   int64(12345),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp: int64(1999),
@@ -214,9 +215,9 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			shouldMatch: true,
 		},
 		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
+			query: &query.ParsedQuery{
+				EarliestCloseTime: int64(1000),
+				LatestCloseTime:   int64(12345),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp: int64(999),
@@ -224,10 +225,10 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			shouldMatch: false,
 		},
 		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowID:        common.StringPtr("random workflowID"),
+			query: &query.ParsedQuery{
+				EarliestCloseTime: int64(1000),
+				LatestCloseTime:   int64(12345),
+				WorkflowID:        common.StringPtr("random workflowID"),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp: int64(2000),
@@ -235,11 +236,11 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			shouldMatch: false,
 		},
 		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowID:        common.StringPtr("random workflowID"),
-				runID:             common.StringPtr("random runID"),
+			query: &query.ParsedQuery{
+				EarliestCloseTime: int64(1000),
+				LatestCloseTime:   int64(12345),
+				WorkflowID:        common.StringPtr("random workflowID"),
+				RunID:             common.StringPtr("random runID"),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp:   int64(12345),
@@ -250,10 +251,10 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			shouldMatch: true,
 		},
 		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowTypeName:  common.StringPtr("some random type name"),
+			query: &query.ParsedQuery{
+				EarliestCloseTime: int64(1000),
+				LatestCloseTime:   int64(12345),
+				WorkflowTypeName:  common.StringPtr("some random type name"),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp: int64(12345),
@@ -261,11 +262,11 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			shouldMatch: false,
 		},
 		{
-			query: &parsedQuery{
-				earliestCloseTime: int64(1000),
-				latestCloseTime:   int64(12345),
-				workflowTypeName:  common.StringPtr("some random type name"),
-				closeStatus:       shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
+			query: &query.ParsedQuery{
+				EarliestCloseTime: int64(1000),
+				LatestCloseTime:   int64(12345),
+				WorkflowTypeName:  common.StringPtr("some random type name"),
+				CloseStatus:       shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
 			},
 			record: &visibilityRecord{
 				CloseTimestamp:   int64(12345),
@@ -343,7 +344,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
+	mockParser := query.NewMockParser(s.controller)
 	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
@@ -357,10 +358,10 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(101),
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(101),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -377,10 +378,10 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(101),
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(101),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -396,11 +397,11 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(10001),
-		workflowID:        common.StringPtr(testWorkflowID),
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(10001),
+		WorkflowID:        common.StringPtr(testWorkflowID),
 	}, nil)
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -420,11 +421,11 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(1),
-		latestCloseTime:   int64(10001),
-		closeStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&query.ParsedQuery{
+		EarliestCloseTime: int64(1),
+		LatestCloseTime:   int64(10001),
+		CloseStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	request := &archiver.QueryVisibilityRequest{
@@ -457,11 +458,11 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	defer os.RemoveAll(dir)
 
 	visibilityArchiver := s.newTestVisibilityArchiver()
-	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
-		earliestCloseTime: int64(10),
-		latestCloseTime:   int64(10001),
-		closeStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&query.ParsedQuery{
+		EarliestCloseTime: int64(10),
+		LatestCloseTime:   int64(10001),
+		CloseStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
 	URI, err := archiver.NewURI("file://" + dir)
@@ -489,12 +490,57 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_Encrypted() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQuery")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	visibilityArchiver.encrypter = newTestEncrypter(s.T(), dir)
+	mockParser := query.NewMockParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&query.ParsedQuery{
+		EarliestCloseTime: int64(10),
+		LatestCloseTime:   int64(10001),
+		CloseStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.NoError(err)
+	}
+
+	data, err := readFile(path.Join(dir, testDomainID, constructVisibilityFilename(s.visibilityRecords[0].CloseTimestamp, testRunID)))
+	s.NoError(err)
+	s.NotContains(string(data), testWorkflowID)
+
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "parsed by mockParser",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	s.NoError(err)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+
+	// records encrypted with a key which is not configured can not be read
+	plainVisibilityArchiver := s.newTestVisibilityArchiver()
+	plainVisibilityArchiver.queryParser = mockParser
+	_, err = plainVisibilityArchiver.Query(context.Background(), URI, request)
+	s.IsType(&shared.InternalServiceError{}, err)
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
 		DirMode:  testDirModeStr,
 	}
-	archiver, err := NewVisibilityArchiver(s.container, config)
+	encrypter, err := archiver.NewBlobEncrypter(nil, nil)
+	s.NoError(err)
+	archiver, err := NewVisibilityArchiver(s.container, config, encrypter)
 	s.NoError(err)
 	return archiver.(*visibilityArchiver)
 }
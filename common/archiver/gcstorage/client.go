@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcstorage
+
+import (
+	"context"
+	"io/ioutil"
+
+	"cloud.google.com/go/storage"
+	"github.com/uber/cadence/common/service/config"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+type (
+	// storageClient is the subset of Google Cloud Storage operations used by the archiver
+	storageClient interface {
+		// Upload writes data to the object, replacing its previous content
+		Upload(ctx context.Context, bucket string, object string, data []byte) error
+		// Download returns the content of the object, or errObjectNotExist if the object does not exist
+		Download(ctx context.Context, bucket string, object string) ([]byte, error)
+		// BucketExists returns true if the bucket exists and is accessible
+		BucketExists(ctx context.Context, bucket string) (bool, error)
+		// List returns the names of all objects starting with prefix
+		List(ctx context.Context, bucket string, prefix string) ([]string, error)
+	}
+
+	gcsClient struct {
+		client    *storage.Client
+		chunkSize int
+	}
+)
+
+// newStorageClient creates a storageClient backed by Google Cloud Storage, the application default
+// credentials are used unless a credentials file is configured
+func newStorageClient(ctx context.Context, config *config.GCStorageArchiver) (storageClient, error) {
+	var opts []option.ClientOption
+	if config.CredentialsPath != "" {
+		opts = append(opts, option.WithCredentialsFile(config.CredentialsPath))
+	}
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &gcsClient{
+		client:    client,
+		chunkSize: config.ChunkSize,
+	}, nil
+}
+
+func (c *gcsClient) Upload(ctx context.Context, bucket string, object string, data []byte) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer := c.client.Bucket(bucket).Object(object).NewWriter(ctx)
+	if c.chunkSize > 0 {
+		writer.ChunkSize = c.chunkSize
+	}
+	if _, err := writer.Write(data); err != nil {
+		// canceling the context aborts the upload, so the object is left untouched
+		cancel()
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (c *gcsClient) Download(ctx context.Context, bucket string, object string) ([]byte, error) {
+	reader, err := c.client.Bucket(bucket).Object(object).NewReader(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil, errObjectNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+func (c *gcsClient) BucketExists(ctx context.Context, bucket string) (bool, error) {
+	_, err := c.client.Bucket(bucket).Attrs(ctx)
+	if err == storage.ErrBucketNotExist {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *gcsClient) List(ctx context.Context, bucket string, prefix string) ([]string, error) {
+	var names []string
+	it := c.client.Bucket(bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return names, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, attrs.Name)
+	}
+}
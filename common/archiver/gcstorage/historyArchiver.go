@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Google Cloud Storage History Archiver will archive workflow histories to a GCS bucket.
+
+// The URI is in the format of gs://bucket/path, the path is used as a prefix of all object names.
+// The history is stored in parts as described in the blobstore package. Each part upload is
+// retried on transient errors.
+
+package gcstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/blobstore"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// URIScheme is the scheme for the Google Cloud Storage implementation
+	URIScheme = "gs"
+
+	errUploadHistory = "failed to upload history to GCS"
+
+	uploadRetryInitialInterval    = 200 * time.Millisecond
+	uploadRetryMaxInterval        = 10 * time.Second
+	uploadRetryExpirationInterval = time.Minute
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on Google Cloud Storage
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.GCStorageArchiver,
+	encrypter archiver.BlobEncrypter,
+) (archiver.HistoryArchiver, error) {
+	client, err := newStorageClient(context.Background(), config)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiver(container, client, encrypter, newUploadRetryPolicy(), nil), nil
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	client storageClient,
+	encrypter archiver.BlobEncrypter,
+	retryPolicy backoff.RetryPolicy,
+	historyIterator archiver.HistoryIterator,
+) archiver.HistoryArchiver {
+	return blobstore.NewHistoryArchiver(container, client, encrypter, &blobstore.HistoryArchiverOptions{
+		URIScheme:         URIScheme,
+		ValidateBucket:    validateBucketName,
+		IsRetryableError:  isRetryableError,
+		UploadRetryPolicy: retryPolicy,
+		UploadFailReason:  errUploadHistory,
+	}, historyIterator)
+}
+
+func newUploadRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(uploadRetryInitialInterval)
+	policy.SetMaximumInterval(uploadRetryMaxInterval)
+	policy.SetExpirationInterval(uploadRetryExpirationInterval)
+	return policy
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcstorage
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+
+	"github.com/uber/cadence/common/archiver/blobstore"
+	"google.golang.org/api/googleapi"
+)
+
+var (
+	errObjectNotExist    = blobstore.ErrBlobNotExist
+	errInvalidBucketName = errors.New("bucket name is invalid")
+
+	bucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,61}[a-z0-9]$`)
+)
+
+// Validation
+
+func validateBucketName(bucket string) error {
+	if !bucketNameRegex.MatchString(bucket) {
+		return errInvalidBucketName
+	}
+	return nil
+}
+
+// Error classification
+
+// isRetryableError returns true for errors which may succeed when the request to Google Cloud Storage is retried
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if err == io.ErrUnexpectedEOF {
+		return true
+	}
+	switch e := err.(type) {
+	case *googleapi.Error:
+		return e.Code == http.StatusRequestTimeout || e.Code == http.StatusTooManyRequests || e.Code >= http.StatusInternalServerError
+	case net.Error:
+		return e.Temporary() || e.Timeout()
+	}
+	return false
+}
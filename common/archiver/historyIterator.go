@@ -233,6 +233,9 @@ func (i *historyIterator) readHistory(firstEventID int64) ([]*shared.History, er
 			MaxEventID:  common.EndEventID,
 			PageSize:    i.historyPageSize,
 			ShardID:     common.IntPtr(i.request.ShardID),
+			DomainName:  i.request.DomainName,
+			DomainID:    i.request.DomainID,
+			WorkflowID:  i.request.WorkflowID,
 		}
 		historyBatches, _, _, err := persistence.ReadFullPageV2EventsByBatch(i.historyV2Manager, req)
 		return historyBatches, err
@@ -71,6 +71,13 @@ type (
 		DomainCache      cache.DomainCache
 	}
 
+	// HistoryBatchIterator is used to read archived history one batch at a time, so that serving
+	// a page of archived history does not require holding the whole history in memory
+	HistoryBatchIterator interface {
+		HasNext() (bool, error)
+		Next() (*shared.History, error)
+	}
+
 	// HistoryArchiver is used to archive history and read archived history
 	HistoryArchiver interface {
 		Archive(context.Context, URI, *ArchiveHistoryRequest, ...ArchiveOption) error
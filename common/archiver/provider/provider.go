@@ -25,7 +25,9 @@ import (
 	"sync"
 
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/azureblob"
 	"github.com/uber/cadence/common/archiver/filestore"
+	"github.com/uber/cadence/common/archiver/gcstorage"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -124,25 +126,55 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (archi
 		return nil, ErrBootstrapContainerNotFound
 	}
 
+	var historyArchiver archiver.HistoryArchiver
 	switch scheme {
 	case filestore.URIScheme:
 		if p.historyArchiverConfigs.Filestore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		historyArchiver, err := filestore.NewHistoryArchiver(container, p.historyArchiverConfigs.Filestore)
+		encrypter, err := archiver.NewBlobEncrypter(p.historyArchiverConfigs.Encryption, container.DomainCache)
 		if err != nil {
 			return nil, err
 		}
-
-		p.Lock()
-		defer p.Unlock()
-		if existingHistoryArchiver, ok := p.historyArchivers[archiverKey]; ok {
-			return existingHistoryArchiver, nil
+		historyArchiver, err = filestore.NewHistoryArchiver(container, p.historyArchiverConfigs.Filestore, encrypter)
+		if err != nil {
+			return nil, err
 		}
-		p.historyArchivers[archiverKey] = historyArchiver
-		return historyArchiver, nil
+	case gcstorage.URIScheme:
+		if p.historyArchiverConfigs.GCStorage == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		encrypter, err := archiver.NewBlobEncrypter(p.historyArchiverConfigs.Encryption, container.DomainCache)
+		if err != nil {
+			return nil, err
+		}
+		historyArchiver, err = gcstorage.NewHistoryArchiver(container, p.historyArchiverConfigs.GCStorage, encrypter)
+		if err != nil {
+			return nil, err
+		}
+	case azureblob.URIScheme:
+		if p.historyArchiverConfigs.AzureBlob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		encrypter, err := archiver.NewBlobEncrypter(p.historyArchiverConfigs.Encryption, container.DomainCache)
+		if err != nil {
+			return nil, err
+		}
+		historyArchiver, err = azureblob.NewHistoryArchiver(container, p.historyArchiverConfigs.AzureBlob, encrypter)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownScheme
 	}
-	return nil, ErrUnknownScheme
+
+	p.Lock()
+	defer p.Unlock()
+	if existingHistoryArchiver, ok := p.historyArchivers[archiverKey]; ok {
+		return existingHistoryArchiver, nil
+	}
+	p.historyArchivers[archiverKey] = historyArchiver
+	return historyArchiver, nil
 }
 
 func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (archiver.VisibilityArchiver, error) {
@@ -159,25 +191,43 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 		return nil, ErrBootstrapContainerNotFound
 	}
 
+	var visibilityArchiver archiver.VisibilityArchiver
 	switch scheme {
 	case filestore.URIScheme:
 		if p.visibilityArchiverConfigs.Filestore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		visibilityArchiver, err := filestore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore)
+		encrypter, err := archiver.NewBlobEncrypter(p.visibilityArchiverConfigs.Encryption, container.DomainCache)
 		if err != nil {
 			return nil, err
 		}
-
-		p.Lock()
-		defer p.Unlock()
-		if existingVisibilityArchiver, ok := p.visibilityArchivers[archiverKey]; ok {
-			return existingVisibilityArchiver, nil
+		visibilityArchiver, err = filestore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore, encrypter)
+		if err != nil {
+			return nil, err
 		}
-		p.visibilityArchivers[archiverKey] = visibilityArchiver
-		return visibilityArchiver, nil
+	case azureblob.URIScheme:
+		if p.visibilityArchiverConfigs.AzureBlob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		encrypter, err := archiver.NewBlobEncrypter(p.visibilityArchiverConfigs.Encryption, container.DomainCache)
+		if err != nil {
+			return nil, err
+		}
+		visibilityArchiver, err = azureblob.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.AzureBlob, encrypter)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownScheme
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if existingVisibilityArchiver, ok := p.visibilityArchivers[archiverKey]; ok {
+		return existingVisibilityArchiver, nil
 	}
-	return nil, ErrUnknownScheme
+	p.visibilityArchivers[archiverKey] = visibilityArchiver
+	return visibilityArchiver, nil
 }
 
 func (p *archiverProvider) getArchiverKey(scheme, serviceName string) string {
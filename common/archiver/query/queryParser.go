@@ -18,9 +18,9 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source queryParser.go -destination queryParser_mock.go -mock_names Interface=MockQueryParser
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -self_package github.com/uber/cadence/common/archiver/query -source queryParser.go -destination queryParser_mock.go -mock_names Parser=MockParser
 
-package filestore
+package query
 
 import (
 	"errors"
@@ -35,21 +35,23 @@ import (
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
-	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+	// Parser parses a limited SQL where clause of a visibility query into a struct
+	Parser interface {
+		Parse(query string) (*ParsedQuery, error)
 	}
 
-	queryParser struct{}
+	parser struct{}
 
-	parsedQuery struct {
-		earliestCloseTime int64
-		latestCloseTime   int64
-		workflowID        *string
-		runID             *string
-		workflowTypeName  *string
-		closeStatus       *shared.WorkflowExecutionCloseStatus
-		emptyResult       bool
+	// ParsedQuery is the filter on visibility records specified by a query
+	ParsedQuery struct {
+		EarliestCloseTime int64
+		LatestCloseTime   int64
+		WorkflowID        *string
+		RunID             *string
+		WorkflowTypeName  *string
+		CloseStatus       *shared.WorkflowExecutionCloseStatus
+		// EmptyResult is true if the query can not match any record
+		EmptyResult bool
 	}
 )
 
@@ -68,20 +70,20 @@ const (
 	defaultDateTimeFormat = time.RFC3339
 )
 
-// NewQueryParser creates a new query parser for filestore
-func NewQueryParser() QueryParser {
-	return &queryParser{}
+// NewParser creates a new query parser for the archived visibility records
+func NewParser() Parser {
+	return &parser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
+func (p *parser) Parse(query string) (*ParsedQuery, error) {
 	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
 	if err != nil {
 		return nil, err
 	}
 	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	parsedQuery := &parsedQuery{
-		earliestCloseTime: 0,
-		latestCloseTime:   time.Now().UnixNano(),
+	parsedQuery := &ParsedQuery{
+		EarliestCloseTime: 0,
+		LatestCloseTime:   time.Now().UnixNano(),
 	}
 	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
 		return nil, err
@@ -89,7 +91,7 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
+func (p *parser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *ParsedQuery) error {
 	if expr == nil {
 		return errors.New("where expression is nil")
 	}
@@ -106,11 +108,11 @@ func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQ
 	}
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
+func (p *parser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *ParsedQuery) error {
 	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
 }
 
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
+func (p *parser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *ParsedQuery) error {
 	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
 		return err
 	}
@@ -120,7 +122,7 @@ func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *pa
 	return nil
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
+func (p *parser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *ParsedQuery) error {
 	colName, ok := compExpr.Left.(*sqlparser.ColName)
 	if !ok {
 		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
@@ -142,11 +144,11 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		if op != "=" {
 			return fmt.Errorf("only operation = is support for %s", WorkflowID)
 		}
-		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
-			parsedQuery.emptyResult = true
+		if parsedQuery.WorkflowID != nil && *parsedQuery.WorkflowID != val {
+			parsedQuery.EmptyResult = true
 			return nil
 		}
-		parsedQuery.workflowID = common.StringPtr(val)
+		parsedQuery.WorkflowID = common.StringPtr(val)
 	case RunID:
 		val, err := extractStringValue(valStr)
 		if err != nil {
@@ -155,11 +157,11 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		if op != "=" {
 			return fmt.Errorf("only operation = is support for %s", RunID)
 		}
-		if parsedQuery.runID != nil && *parsedQuery.runID != val {
-			parsedQuery.emptyResult = true
+		if parsedQuery.RunID != nil && *parsedQuery.RunID != val {
+			parsedQuery.EmptyResult = true
 			return nil
 		}
-		parsedQuery.runID = common.StringPtr(val)
+		parsedQuery.RunID = common.StringPtr(val)
 	case WorkflowType:
 		val, err := extractStringValue(valStr)
 		if err != nil {
@@ -168,11 +170,11 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		if op != "=" {
 			return fmt.Errorf("only operation = is support for %s", WorkflowType)
 		}
-		if parsedQuery.workflowTypeName != nil && *parsedQuery.workflowTypeName != val {
-			parsedQuery.emptyResult = true
+		if parsedQuery.WorkflowTypeName != nil && *parsedQuery.WorkflowTypeName != val {
+			parsedQuery.EmptyResult = true
 			return nil
 		}
-		parsedQuery.workflowTypeName = common.StringPtr(val)
+		parsedQuery.WorkflowTypeName = common.StringPtr(val)
 	case CloseStatus:
 		val, err := extractStringValue(valStr)
 		if err != nil {
@@ -185,11 +187,11 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		if err != nil {
 			return err
 		}
-		if parsedQuery.closeStatus != nil && *parsedQuery.closeStatus != status {
-			parsedQuery.emptyResult = true
+		if parsedQuery.CloseStatus != nil && *parsedQuery.CloseStatus != status {
+			parsedQuery.EmptyResult = true
 			return nil
 		}
-		parsedQuery.closeStatus = status.Ptr()
+		parsedQuery.CloseStatus = status.Ptr()
 	case CloseTime:
 		timestamp, err := convertToTimestamp(valStr)
 		if err != nil {
@@ -203,7 +205,7 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 	return nil
 }
 
-func (p *queryParser) convertCloseTime(timestamp int64, op string, parsedQuery *parsedQuery) error {
+func (p *parser) convertCloseTime(timestamp int64, op string, parsedQuery *ParsedQuery) error {
 	switch op {
 	case "=":
 		if err := p.convertCloseTime(timestamp, ">=", parsedQuery); err != nil {
@@ -213,13 +215,13 @@ func (p *queryParser) convertCloseTime(timestamp int64, op string, parsedQuery *
 			return err
 		}
 	case "<":
-		parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, timestamp-1)
+		parsedQuery.LatestCloseTime = common.MinInt64(parsedQuery.LatestCloseTime, timestamp-1)
 	case "<=":
-		parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, timestamp)
+		parsedQuery.LatestCloseTime = common.MinInt64(parsedQuery.LatestCloseTime, timestamp)
 	case ">":
-		parsedQuery.earliestCloseTime = common.MaxInt64(parsedQuery.earliestCloseTime, timestamp+1)
+		parsedQuery.EarliestCloseTime = common.MaxInt64(parsedQuery.EarliestCloseTime, timestamp+1)
 	case ">=":
-		parsedQuery.earliestCloseTime = common.MaxInt64(parsedQuery.earliestCloseTime, timestamp)
+		parsedQuery.EarliestCloseTime = common.MaxInt64(parsedQuery.EarliestCloseTime, timestamp)
 	default:
 		return fmt.Errorf("operator %s is not supported for close time", op)
 	}
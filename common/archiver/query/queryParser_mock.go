@@ -24,48 +24,48 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: queryParser.go
 
-// Package filestore is a generated GoMock package.
-package filestore
+// Package query is a generated GoMock package.
+package query
 
 import (
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
 
-// MockQueryParser is a mock of QueryParser interface
-type MockQueryParser struct {
+// MockParser is a mock of Parser interface
+type MockParser struct {
 	ctrl     *gomock.Controller
-	recorder *MockQueryParserMockRecorder
+	recorder *MockParserMockRecorder
 }
 
-// MockQueryParserMockRecorder is the mock recorder for MockQueryParser
-type MockQueryParserMockRecorder struct {
-	mock *MockQueryParser
+// MockParserMockRecorder is the mock recorder for MockParser
+type MockParserMockRecorder struct {
+	mock *MockParser
 }
 
-// NewMockQueryParser creates a new mock instance
-func NewMockQueryParser(ctrl *gomock.Controller) *MockQueryParser {
-	mock := &MockQueryParser{ctrl: ctrl}
-	mock.recorder = &MockQueryParserMockRecorder{mock}
+// NewMockParser creates a new mock instance
+func NewMockParser(ctrl *gomock.Controller) *MockParser {
+	mock := &MockParser{ctrl: ctrl}
+	mock.recorder = &MockParserMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockQueryParser) EXPECT() *MockQueryParserMockRecorder {
+func (m *MockParser) EXPECT() *MockParserMockRecorder {
 	return m.recorder
 }
 
 // Parse mocks base method
-func (m *MockQueryParser) Parse(query string) (*parsedQuery, error) {
+func (m *MockParser) Parse(query string) (*ParsedQuery, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Parse", query)
-	ret0, _ := ret[0].(*parsedQuery)
+	ret0, _ := ret[0].(*ParsedQuery)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Parse indicates an expected call of Parse
-func (mr *MockQueryParserMockRecorder) Parse(query interface{}) *gomock.Call {
+func (mr *MockParserMockRecorder) Parse(query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockQueryParser)(nil).Parse), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockParser)(nil).Parse), query)
 }
@@ -18,7 +18,7 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-package filestore
+package query
 
 import (
 	"testing"
@@ -33,7 +33,7 @@ type queryParserSuite struct {
 	*require.Assertions
 	suite.Suite
 
-	parser QueryParser
+	parser Parser
 }
 
 func TestQueryParserSuite(t *testing.T) {
@@ -42,64 +42,64 @@ func TestQueryParserSuite(t *testing.T) {
 
 func (s *queryParserSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
-	s.parser = NewQueryParser()
+	s.parser = NewParser()
 }
 
 func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *ParsedQuery
 	}{
 		{
 			query:     "WorkflowID = \"random workflowID\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
+			parsedQuery: &ParsedQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
 			},
 		},
 		{
 			query:     "WorkflowID = \"random workflowID\" and WorkflowID = \"random workflowID\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
+			parsedQuery: &ParsedQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
 			},
 		},
 		{
 			query:     "RunID = \"random runID\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				runID: common.StringPtr("random runID"),
+			parsedQuery: &ParsedQuery{
+				RunID: common.StringPtr("random runID"),
 			},
 		},
 		{
 			query:     "WorkflowType = \"random typeName\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowTypeName: common.StringPtr("random typeName"),
+			parsedQuery: &ParsedQuery{
+				WorkflowTypeName: common.StringPtr("random typeName"),
 			},
 		},
 		{
 			query:     "WorkflowID = 'random workflowID'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID: common.StringPtr("random workflowID"),
+			parsedQuery: &ParsedQuery{
+				WorkflowID: common.StringPtr("random workflowID"),
 			},
 		},
 		{
 			query:     "WorkflowType = 'random typeName' and WorkflowType = \"another typeName\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				emptyResult: true,
+			parsedQuery: &ParsedQuery{
+				EmptyResult: true,
 			},
 		},
 		{
 			query:     "WorkflowType = 'random typeName' and (WorkflowID = \"random workflowID\" and RunID='random runID')",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				workflowID:       common.StringPtr("random workflowID"),
-				runID:            common.StringPtr("random runID"),
-				workflowTypeName: common.StringPtr("random typeName"),
+			parsedQuery: &ParsedQuery{
+				WorkflowID:       common.StringPtr("random workflowID"),
+				RunID:            common.StringPtr("random runID"),
+				WorkflowTypeName: common.StringPtr("random typeName"),
 			},
 		},
 		{
@@ -131,11 +131,11 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
-		if !tc.parsedQuery.emptyResult {
-			s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID)
-			s.Equal(tc.parsedQuery.runID, parsedQuery.runID)
-			s.Equal(tc.parsedQuery.workflowTypeName, parsedQuery.workflowTypeName)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult)
+		if !tc.parsedQuery.EmptyResult {
+			s.Equal(tc.parsedQuery.WorkflowID, parsedQuery.WorkflowID)
+			s.Equal(tc.parsedQuery.RunID, parsedQuery.RunID)
+			s.Equal(tc.parsedQuery.WorkflowTypeName, parsedQuery.WorkflowTypeName)
 		}
 	}
 }
@@ -144,34 +144,34 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *ParsedQuery
 	}{
 		{
 			query:     "CloseStatus = \"Completed\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeStatus: shared.WorkflowExecutionCloseStatusCompleted.Ptr(),
+			parsedQuery: &ParsedQuery{
+				CloseStatus: shared.WorkflowExecutionCloseStatusCompleted.Ptr(),
 			},
 		},
 		{
 			query:     "CloseStatus = 'continuedasnew'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeStatus: shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
+			parsedQuery: &ParsedQuery{
+				CloseStatus: shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
 			},
 		},
 		{
 			query:     "CloseStatus = 'Failed' and CloseStatus = \"Failed\"",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				closeStatus: shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+			parsedQuery: &ParsedQuery{
+				CloseStatus: shared.WorkflowExecutionCloseStatusFailed.Ptr(),
 			},
 		},
 		{
 			query:     "(CloseStatus = 'Timedout' and CloseStatus = \"canceled\")",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				emptyResult: true,
+			parsedQuery: &ParsedQuery{
+				EmptyResult: true,
 			},
 		},
 		{
@@ -199,9 +199,9 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
-		if !tc.parsedQuery.emptyResult {
-			s.Equal(tc.parsedQuery.closeStatus, parsedQuery.closeStatus)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult)
+		if !tc.parsedQuery.EmptyResult {
+			s.Equal(tc.parsedQuery.CloseStatus, parsedQuery.CloseStatus)
 		}
 	}
 }
@@ -210,38 +210,38 @@ func (s *queryParserSuite) TestParseCloseTime() {
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *ParsedQuery
 	}{
 		{
 			query:     "CloseTime <= 1000",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 0,
-				latestCloseTime:   1000,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: 0,
+				LatestCloseTime:   1000,
 			},
 		},
 		{
 			query:     "CloseTime < 2000 and CloseTime <= 1000 and CloseTime > 300",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 301,
-				latestCloseTime:   1000,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: 301,
+				LatestCloseTime:   1000,
 			},
 		},
 		{
 			query:     "CloseTime = 2000 and (CloseTime > 1000 and CloseTime <= 9999)",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 2000,
-				latestCloseTime:   2000,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: 2000,
+				LatestCloseTime:   2000,
 			},
 		},
 		{
 			query:     "CloseTime <= \"2019-01-01T11:11:11Z\" and CloseTime >= 1000000",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 1000000,
-				latestCloseTime:   1546341071000000000,
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: 1000000,
+				LatestCloseTime:   1546341071000000000,
 			},
 		},
 		{
@@ -265,10 +265,10 @@ func (s *queryParserSuite) TestParseCloseTime() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
-		if !tc.parsedQuery.emptyResult {
-			s.Equal(tc.parsedQuery.earliestCloseTime, parsedQuery.earliestCloseTime)
-			s.Equal(tc.parsedQuery.latestCloseTime, parsedQuery.latestCloseTime)
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult)
+		if !tc.parsedQuery.EmptyResult {
+			s.Equal(tc.parsedQuery.EarliestCloseTime, parsedQuery.EarliestCloseTime)
+			s.Equal(tc.parsedQuery.LatestCloseTime, parsedQuery.LatestCloseTime)
 		}
 	}
 }
@@ -277,32 +277,32 @@ func (s *queryParserSuite) TestParse() {
 	testCases := []struct {
 		query       string
 		expectErr   bool
-		parsedQuery *parsedQuery
+		parsedQuery *ParsedQuery
 	}{
 		{
 			query:     "CloseTime <= \"2019-01-01T11:11:11Z\" and WorkflowID = 'random workflowID'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 0,
-				latestCloseTime:   1546341071000000000,
-				workflowID:        common.StringPtr("random workflowID"),
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: 0,
+				LatestCloseTime:   1546341071000000000,
+				WorkflowID:        common.StringPtr("random workflowID"),
 			},
 		},
 		{
 			query:     "CloseTime > 1999 and CloseTime < 10000 and RunID = 'random runID' and CloseStatus = 'Failed'",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				earliestCloseTime: 2000,
-				latestCloseTime:   9999,
-				runID:             common.StringPtr("random runID"),
-				closeStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+			parsedQuery: &ParsedQuery{
+				EarliestCloseTime: 2000,
+				LatestCloseTime:   9999,
+				RunID:             common.StringPtr("random runID"),
+				CloseStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
 			},
 		},
 		{
 			query:     "CloseTime > 2001 and CloseTime < 10000 and (RunID = 'random runID') and CloseStatus = 'Failed' and (RunID = 'another ID')",
 			expectErr: false,
-			parsedQuery: &parsedQuery{
-				emptyResult: true,
+			parsedQuery: &ParsedQuery{
+				EmptyResult: true,
 			},
 		},
 	}
@@ -314,8 +314,8 @@ func (s *queryParserSuite) TestParse() {
 			continue
 		}
 		s.NoError(err)
-		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
-		if !tc.parsedQuery.emptyResult {
+		s.Equal(tc.parsedQuery.EmptyResult, parsedQuery.EmptyResult)
+		if !tc.parsedQuery.EmptyResult {
 			s.Equal(tc.parsedQuery, parsedQuery)
 		}
 	}
@@ -23,6 +23,8 @@ package archiver
 import (
 	"errors"
 
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 )
@@ -39,6 +41,33 @@ var (
 	errEmptyQuery            = errors.New("Query string is empty")
 )
 
+// ReadHistoryPage reads history batches from the iterator until the page holds at least pageSize events
+// or the iterator is depleted, it also returns whether the iterator has more history batches
+func ReadHistoryPage(iterator HistoryBatchIterator, pageSize int) ([]*shared.History, bool, error) {
+	var historyBatches []*shared.History
+	numOfEvents := 0
+	for numOfEvents < pageSize {
+		hasNext, err := iterator.HasNext()
+		if err != nil {
+			return nil, false, err
+		}
+		if !hasNext {
+			return historyBatches, false, nil
+		}
+		batch, err := iterator.Next()
+		if err != nil {
+			return nil, false, err
+		}
+		historyBatches = append(historyBatches, batch)
+		numOfEvents += len(batch.Events)
+	}
+	hasNext, err := iterator.HasNext()
+	if err != nil {
+		return nil, false, err
+	}
+	return historyBatches, hasNext, nil
+}
+
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
 func TagLoggerWithArchiveHistoryRequestAndURI(logger log.Logger, request *ArchiveHistoryRequest, URI string) log.Logger {
 	return logger.WithTags(
@@ -139,6 +168,18 @@ func ValidateQueryRequest(request *QueryVisibilityRequest) error {
 	return nil
 }
 
+// ConvertHistoryArchivalInfo converts the history archival URI recorded in an archived visibility record,
+// nil is returned if the history of the workflow was not archived. History and visibility are archived
+// in parallel, so the outcome of history archival is not known to an archived visibility record.
+func ConvertHistoryArchivalInfo(record *ArchiveVisibilityRequest) *shared.HistoryArchivalInfo {
+	if record.HistoryArchivalURI == "" {
+		return nil
+	}
+	return &shared.HistoryArchivalInfo{
+		URI: common.StringPtr(record.HistoryArchivalURI),
+	}
+}
+
 // ConvertSearchAttrToBytes converts search attribute value from string back to byte array
 func ConvertSearchAttrToBytes(searchAttrStr map[string]string) map[string][]byte {
 	searchAttr := make(map[string][]byte)
@@ -0,0 +1,108 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	UtilSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	testHistoryBatchIterator struct {
+		historyBatches []*shared.History
+		err            error
+	}
+)
+
+func TestUtilSuite(t *testing.T) {
+	suite.Run(t, new(UtilSuite))
+}
+
+func (s *UtilSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UtilSuite) TestConvertHistoryArchivalInfo() {
+	s.Nil(ConvertHistoryArchivalInfo(&ArchiveVisibilityRequest{}))
+
+	info := ConvertHistoryArchivalInfo(&ArchiveVisibilityRequest{
+		HistoryArchivalURI: "test:///history/archival",
+	})
+	s.Equal("test:///history/archival", info.GetURI())
+	s.Nil(info.State)
+	s.Nil(info.ArchivedTime)
+	s.Nil(info.FailureReason)
+}
+
+func (s *UtilSuite) TestReadHistoryPage() {
+	historyBatches := []*shared.History{
+		{Events: []*shared.HistoryEvent{{}, {}}},
+		{Events: []*shared.HistoryEvent{{}}},
+		{Events: []*shared.HistoryEvent{{}, {}}},
+	}
+	iterator := &testHistoryBatchIterator{historyBatches: historyBatches}
+
+	page, hasNext, err := ReadHistoryPage(iterator, 3)
+	s.NoError(err)
+	s.True(hasNext)
+	s.Equal(historyBatches[:2], page)
+
+	page, hasNext, err = ReadHistoryPage(iterator, 3)
+	s.NoError(err)
+	s.False(hasNext)
+	s.Equal(historyBatches[2:], page)
+
+	page, hasNext, err = ReadHistoryPage(iterator, 3)
+	s.NoError(err)
+	s.False(hasNext)
+	s.Empty(page)
+}
+
+func (s *UtilSuite) TestReadHistoryPage_Error() {
+	iterator := &testHistoryBatchIterator{err: errors.New("some random error")}
+	page, hasNext, err := ReadHistoryPage(iterator, 3)
+	s.Equal(iterator.err, err)
+	s.False(hasNext)
+	s.Nil(page)
+}
+
+func (i *testHistoryBatchIterator) HasNext() (bool, error) {
+	return len(i.historyBatches) != 0, i.err
+}
+
+func (i *testHistoryBatchIterator) Next() (*shared.History, error) {
+	if len(i.historyBatches) == 0 {
+		return nil, io.EOF
+	}
+	batch := i.historyBatches[0]
+	i.historyBatches = i.historyBatches[1:]
+	return batch, nil
+}
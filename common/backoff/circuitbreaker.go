@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backoff
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+const (
+	// CircuitBreakerStateClosed means calls are allowed through
+	CircuitBreakerStateClosed CircuitBreakerState = iota
+	// CircuitBreakerStateOpen means calls are rejected until the reset timeout elapses
+	CircuitBreakerStateOpen
+	// CircuitBreakerStateHalfOpen means a single probe call is allowed through
+	CircuitBreakerStateHalfOpen
+)
+
+type (
+	// CircuitBreakerState is the state of a circuit breaker
+	CircuitBreakerState int
+
+	// CircuitBreaker stops calls to a downstream after sustained failures, and periodically
+	// lets a single probe call through to detect recovery
+	CircuitBreaker interface {
+		// Allow returns whether the next call should be made
+		Allow() bool
+		// RecordSuccess records a successful call and closes the circuit
+		RecordSuccess()
+		// RecordFailure records a failed call and returns true if this failure tripped the circuit open
+		RecordFailure() bool
+		// State returns the current state of the circuit
+		State() CircuitBreakerState
+	}
+
+	circuitBreakerImpl struct {
+		sync.Mutex
+		failureThreshold    int
+		resetTimeout        time.Duration
+		timeSource          clock.TimeSource
+		state               CircuitBreakerState
+		consecutiveFailures int
+		openedTime          time.Time
+		probeInFlight       bool
+	}
+)
+
+// NewCircuitBreaker creates a circuit breaker which opens after failureThreshold consecutive
+// failures and stays open for resetTimeout before allowing a probe call through
+func NewCircuitBreaker(failureThreshold int, resetTimeout time.Duration, timeSource clock.TimeSource) CircuitBreaker {
+	return &circuitBreakerImpl{
+		failureThreshold: failureThreshold,
+		resetTimeout:     resetTimeout,
+		timeSource:       timeSource,
+		state:            CircuitBreakerStateClosed,
+	}
+}
+
+func (c *circuitBreakerImpl) Allow() bool {
+	c.Lock()
+	defer c.Unlock()
+
+	switch c.state {
+	case CircuitBreakerStateOpen:
+		if c.timeSource.Now().Sub(c.openedTime) < c.resetTimeout {
+			return false
+		}
+		c.state = CircuitBreakerStateHalfOpen
+		c.probeInFlight = true
+		return true
+	case CircuitBreakerStateHalfOpen:
+		if c.probeInFlight {
+			return false
+		}
+		c.probeInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (c *circuitBreakerImpl) RecordSuccess() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.state = CircuitBreakerStateClosed
+	c.consecutiveFailures = 0
+	c.probeInFlight = false
+}
+
+func (c *circuitBreakerImpl) RecordFailure() bool {
+	c.Lock()
+	defer c.Unlock()
+
+	c.probeInFlight = false
+	c.consecutiveFailures++
+	switch c.state {
+	case CircuitBreakerStateHalfOpen:
+		c.open()
+		return true
+	case CircuitBreakerStateClosed:
+		if c.consecutiveFailures >= c.failureThreshold {
+			c.open()
+			return true
+		}
+	}
+	return false
+}
+
+func (c *circuitBreakerImpl) State() CircuitBreakerState {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.state
+}
+
+func (c *circuitBreakerImpl) open() {
+	c.state = CircuitBreakerStateOpen
+	c.openedTime = c.timeSource.Now()
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/clock"
+)
+
+type (
+	CircuitBreakerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		timeSource *clock.EventTimeSource
+		breaker    CircuitBreaker
+	}
+)
+
+func TestCircuitBreakerSuite(t *testing.T) {
+	suite.Run(t, new(CircuitBreakerSuite))
+}
+
+func (s *CircuitBreakerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.breaker = NewCircuitBreaker(3, time.Second, s.timeSource)
+}
+
+func (s *CircuitBreakerSuite) TestOpenAfterConsecutiveFailures() {
+	s.False(s.breaker.RecordFailure())
+	s.False(s.breaker.RecordFailure())
+	s.breaker.RecordSuccess()
+	s.False(s.breaker.RecordFailure())
+	s.False(s.breaker.RecordFailure())
+	s.Equal(CircuitBreakerStateClosed, s.breaker.State())
+	s.True(s.breaker.Allow())
+
+	s.True(s.breaker.RecordFailure())
+	s.Equal(CircuitBreakerStateOpen, s.breaker.State())
+	s.False(s.breaker.Allow())
+}
+
+func (s *CircuitBreakerSuite) TestHalfOpen_ProbeSuccess() {
+	s.tripBreaker()
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	s.True(s.breaker.Allow())
+	s.Equal(CircuitBreakerStateHalfOpen, s.breaker.State())
+	// only a single probe is allowed while half open
+	s.False(s.breaker.Allow())
+
+	s.breaker.RecordSuccess()
+	s.Equal(CircuitBreakerStateClosed, s.breaker.State())
+	s.True(s.breaker.Allow())
+}
+
+func (s *CircuitBreakerSuite) TestHalfOpen_ProbeFailure() {
+	s.tripBreaker()
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	s.True(s.breaker.Allow())
+	s.True(s.breaker.RecordFailure())
+	s.Equal(CircuitBreakerStateOpen, s.breaker.State())
+	s.False(s.breaker.Allow())
+}
+
+func (s *CircuitBreakerSuite) tripBreaker() {
+	for i := 0; i < 3; i++ {
+		s.breaker.RecordFailure()
+	}
+	s.Equal(CircuitBreakerStateOpen, s.breaker.State())
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batch
+
+import (
+	"time"
+)
+
+const (
+	// BatcherTaskListName is the tasklist name
+	BatcherTaskListName = "cadence-sys-batcher-tasklist"
+	// BatchWFTypeName is the workflow type
+	BatchWFTypeName = "cadence-sys-batch-workflow"
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+)
+
+const (
+	// BatchTypeTerminate is batch type for terminating workflows
+	BatchTypeTerminate = "terminate"
+	// BatchTypeCancel is the batch type for canceling workflows
+	BatchTypeCancel = "cancel"
+	// BatchTypeSignal is batch type for signaling workflows
+	BatchTypeSignal = "signal"
+)
+
+// AllBatchTypes is the batch types we supported
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+
+type (
+	// TerminateParams is the parameters for terminating workflow
+	TerminateParams struct {
+		// this indicates whether to terminate children workflow. Default to true.
+		// TODO https://github.com/uber/cadence/issues/2159
+		// Ideally default should be childPolicy of the workflow. But it's currently totally broken.
+		TerminateChildren *bool
+		// this indicates whether to terminate workflows which are termination protected,
+		// the batcher forces the terminations with the admin operation token of the worker
+		Force bool
+	}
+
+	// CancelParams is the parameters for canceling workflow
+	CancelParams struct {
+		// this indicates whether to cancel children workflow. Default to true.
+		// TODO https://github.com/uber/cadence/issues/2159
+		// Ideally default should be childPolicy of the workflow. But it's currently totally broken.
+		CancelChildren *bool
+	}
+
+	// SignalParams is the parameters for signaling workflow
+	SignalParams struct {
+		SignalName string
+		Input      []byte
+	}
+
+	// BatchParams is the parameters for batch operation workflow
+	BatchParams struct {
+		// Target domain to execute batch operation
+		DomainName string
+		// To get the target workflows for processing
+		Query string
+		// Reason for the operation
+		Reason string
+		// Supporting: reset,terminate
+		BatchType string
+
+		// Below are all optional
+		// TerminateParams is params only for BatchTypeTerminate
+		TerminateParams TerminateParams
+		// CancelParams is params only for BatchTypeCancel
+		CancelParams CancelParams
+		// SignalParams is params only for BatchTypeSignal
+		SignalParams SignalParams
+		// RPS of processing. Default to DefaultRPS
+		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
+		RPS int
+		// Number of goroutines running in parallel to process
+		Concurrency int
+		// Number of attempts for each workflow to process in case of retryable error before giving up
+		AttemptsOnRetryableError int
+		// timeout for activity heartbeat
+		ActivityHeartBeatTimeout time.Duration
+		// errors that will not retry which consumes AttemptsOnRetryableError. Default to empty
+		NonRetryableErrors []string
+	}
+
+	// HeartBeatDetails is the struct for heartbeat details
+	HeartBeatDetails struct {
+		PageToken   []byte
+		CurrentPage int
+		// This is just an estimation for visibility
+		TotalEstimate int64
+		// Number of workflows processed successfully
+		SuccessCount int
+		// Number of workflows that give up due to errors.
+		ErrorCount int
+	}
+)
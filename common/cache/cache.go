@@ -34,6 +34,10 @@ type Cache interface {
 	// Put adds an element to the cache, returning the previous element
 	Put(key interface{}, value interface{}) interface{}
 
+	// PutWithTTL adds an element to the cache with a TTL overriding the one of the cache,
+	// returning the previous element
+	PutWithTTL(key interface{}, value interface{}, ttl time.Duration) interface{}
+
 	// PutIfNotExist puts a value associated with a given key if it does not exist
 	PutIfNotExist(key interface{}, value interface{}) (interface{}, error)
 
@@ -66,6 +70,22 @@ type Options struct {
 	// RemovedFunc is an optional function called when an element
 	// is scheduled for deletion
 	RemovedFunc RemovedFunc
+
+	// MaxBytes controls the max total size of the cache entries as reported by SizeFunc,
+	// least recently used entries are evicted once it is exceeded. Zero means no limit
+	MaxBytes int
+
+	// SizeFunc is an optional function returning the size in bytes of a cache value,
+	// it is required for MaxBytes to take effect
+	SizeFunc SizeFunc
+
+	// Shards controls the number of independently locked partitions of the cache,
+	// each holding its share of the max size and max bytes. Zero or one means no partitioning
+	Shards int
+
+	// KeyHashFunc is an optional function hashing a key to pick its partition,
+	// keys are hashed by their string representation by default
+	KeyHashFunc KeyHashFunc
 }
 
 // RemovedFunc is a type for notifying applications when an item is
@@ -74,6 +94,12 @@ type Options struct {
 // deletion, Cache calls go f(i)
 type RemovedFunc func(interface{})
 
+// SizeFunc is a type for computing the size in bytes of a cache value
+type SizeFunc func(interface{}) int
+
+// KeyHashFunc is a type for hashing a cache key
+type KeyHashFunc func(interface{}) uint32
+
 // Iterator represents the interface for cache iterators
 type Iterator interface {
 	// Close closes the iterator
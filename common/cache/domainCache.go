@@ -60,12 +60,21 @@ const (
 	// DomainCacheRefreshInterval domain cache refresh interval
 	DomainCacheRefreshInterval = 10 * time.Second
 	domainCacheRefreshPageSize = 100
+	// domainCacheEntryOverhead is the approximate size of the fixed size fields of a domain cache entry
+	domainCacheEntryOverhead = 256
 
 	domainCacheInitialized int32 = 0
 	domainCacheStarted     int32 = 1
 	domainCacheStopped     int32 = 2
 )
 
+const (
+	// WatermarkLevelLow indicates the domain cache memory usage fell back below the low watermark
+	WatermarkLevelLow WatermarkLevel = iota
+	// WatermarkLevelHigh indicates the domain cache memory usage rose above the high watermark
+	WatermarkLevelHigh
+)
+
 type (
 	// PrepareCallbackFn is function to be called before CallbackFn is called,
 	// it is guaranteed that PrepareCallbackFn and CallbackFn pair will be both called or non will be called
@@ -75,6 +84,13 @@ type (
 	// it is guaranteed that PrepareCallbackFn and CallbackFn pair will be both called or non will be called
 	CallbackFn func(prevDomains []*DomainCacheEntry, nextDomains []*DomainCacheEntry)
 
+	// WatermarkLevel is the memory watermark crossed by the domain cache
+	WatermarkLevel int
+
+	// WatermarkCallbackFn is function to be called when the estimated memory usage of the domain cache
+	// rises above the registered high watermark, or falls back below the registered low watermark
+	WatermarkCallbackFn func(level WatermarkLevel, memoryUsage int64)
+
 	// DomainCache is used the cache domain information and configuration to avoid making too many calls to cassandra.
 	// This cache is mainly used by frontend for resolving domain names to domain uuids which are used throughout the
 	// system.  Each domain entry is kept in the cache for one hour but also has an expiry of 10 seconds.  This results
@@ -84,28 +100,44 @@ type (
 		common.Daemon
 		RegisterDomainChangeCallback(shard int, initialNotificationVersion int64, prepareCallback PrepareCallbackFn, callback CallbackFn)
 		UnregisterDomainChangeCallback(shard int)
+		RegisterMemoryWatermarkCallback(id int, lowWatermark int64, highWatermark int64, callback WatermarkCallbackFn)
+		UnregisterMemoryWatermarkCallback(id int)
 		GetDomain(name string) (*DomainCacheEntry, error)
 		GetDomainByID(id string) (*DomainCacheEntry, error)
 		GetDomainID(name string) (string, error)
 		GetDomainName(id string) (string, error)
 		GetAllDomain() map[string]*DomainCacheEntry
 		GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID int64)
+		GetMemoryUsage() int64
 	}
 
 	domainCache struct {
-		status          int32
-		shutdownChan    chan struct{}
-		cacheNameToID   *atomic.Value
-		cacheByID       *atomic.Value
-		metadataMgr     persistence.MetadataManager
-		clusterMetadata cluster.Metadata
-		timeSource      clock.TimeSource
-		metricsClient   metrics.Client
-		logger          log.Logger
+		memoryUsage int64
+		// notificationVersion is the domain notification version the last refresh loaded the domains up to
+		notificationVersion int64
+		status              int32
+		shutdownChan        chan struct{}
+		cacheNameToID       *atomic.Value
+		cacheByID           *atomic.Value
+		metadataMgr         persistence.MetadataManager
+		clusterMetadata     cluster.Metadata
+		timeSource          clock.TimeSource
+		metricsClient       metrics.Client
+		logger              log.Logger
 
 		callbackLock     sync.Mutex
 		prepareCallbacks map[int]PrepareCallbackFn
 		callbacks        map[int]CallbackFn
+
+		watermarkLock      sync.Mutex
+		watermarkCallbacks map[int]*watermarkCallback
+	}
+
+	watermarkCallback struct {
+		lowWatermark  int64
+		highWatermark int64
+		callback      WatermarkCallbackFn
+		aboveHigh     bool
 	}
 
 	// DomainCacheEntries is DomainCacheEntry slice
@@ -124,6 +156,8 @@ type (
 		failoverNotificationVersion int64
 		notificationVersion         int64
 		expiry                      time.Time
+		// accountedSize is the size of the entry accounted in the memory usage of the domain cache
+		accountedSize int64
 	}
 )
 
@@ -147,17 +181,20 @@ func NewDomainCache(
 		logger:           logger,
 		prepareCallbacks: make(map[int]PrepareCallbackFn),
 		callbacks:        make(map[int]CallbackFn),
+
+		watermarkCallbacks: make(map[int]*watermarkCallback),
 	}
-	cache.cacheNameToID.Store(newDomainCache())
-	cache.cacheByID.Store(newDomainCache())
+	cache.cacheNameToID.Store(newDomainCache(nil))
+	cache.cacheByID.Store(newDomainCache(cache.onEntryRemoved))
 
 	return cache
 }
 
-func newDomainCache() Cache {
+func newDomainCache(removedFunc RemovedFunc) Cache {
 	opts := &Options{}
 	opts.InitialCapacity = domainCacheInitialSize
 	opts.TTL = domainCacheTTL
+	opts.RemovedFunc = removedFunc
 	return New(domainCacheMaxSize, opts)
 }
 
@@ -229,6 +266,11 @@ func (c *domainCache) GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID i
 	return int64(c.cacheByID.Load().(Cache).Size()), int64(c.cacheNameToID.Load().(Cache).Size())
 }
 
+// GetMemoryUsage returns the estimated number of bytes retained by the cached domain entries
+func (c *domainCache) GetMemoryUsage() int64 {
+	return atomic.LoadInt64(&c.memoryUsage)
+}
+
 // Start start the background refresh of domain
 func (c *domainCache) Start() {
 	if !atomic.CompareAndSwapInt32(&c.status, domainCacheInitialized, domainCacheStarted) {
@@ -309,6 +351,34 @@ func (c *domainCache) UnregisterDomainChangeCallback(shard int) {
 	delete(c.callbacks, shard)
 }
 
+// RegisterMemoryWatermarkCallback set a memory watermark callback, the callback is notified with WatermarkLevelHigh
+// once the estimated memory usage rises above highWatermark, and with WatermarkLevelLow once it falls back below
+// lowWatermark. If the memory usage is already above highWatermark, the callback is notified immediately.
+// Once the high watermark is crossed, the least recently used local domains are evicted until the memory usage is
+// below lowWatermark, evicted domains are loaded again on lookup or once they are changed. Global domains are never
+// evicted, since domain change callbacks registered later catch up from the cached domains.
+// The callback is invoked when NOT holding any domain cache lock.
+func (c *domainCache) RegisterMemoryWatermarkCallback(id int, lowWatermark int64, highWatermark int64,
+	callback WatermarkCallbackFn) {
+	c.watermarkLock.Lock()
+	c.watermarkCallbacks[id] = &watermarkCallback{
+		lowWatermark:  lowWatermark,
+		highWatermark: highWatermark,
+		callback:      callback,
+	}
+	c.watermarkLock.Unlock()
+
+	c.checkMemoryWatermarks()
+}
+
+// UnregisterMemoryWatermarkCallback delete a memory watermark callback
+func (c *domainCache) UnregisterMemoryWatermarkCallback(id int) {
+	c.watermarkLock.Lock()
+	defer c.watermarkLock.Unlock()
+
+	delete(c.watermarkCallbacks, id)
+}
+
 // GetDomain retrieves the information from the cache if it exists, otherwise retrieves the information from metadata
 // store and writes it to the cache with an expiry before returning back
 func (c *domainCache) GetDomain(name string) (*DomainCacheEntry, error) {
@@ -365,6 +435,9 @@ func (c *domainCache) refreshLoop() {
 // this function only refresh the domains in the v2 table
 // the domains in the v1 table will be refreshed if cache is stale
 func (c *domainCache) refreshDomains() error {
+	sw := c.metricsClient.StartTimer(metrics.DomainCacheScope, metrics.DomainCacheRefreshLatency)
+	defer sw.Stop()
+
 	// first load the metadata record, then load domains
 	// this can guarantee that domains in the cache are not updated more than metadata record
 	metadata, err := c.metadataMgr.GetMetadata()
@@ -373,6 +446,8 @@ func (c *domainCache) refreshDomains() error {
 	}
 	domainNotificationVersion := metadata.NotificationVersion
 
+	// entries are prepared page by page without touching the current cache,
+	// so lookups keep being served until the refreshed cache is swapped in
 	var token []byte
 	request := &persistence.ListDomainsRequest{PageSize: domainCacheRefreshPageSize}
 	var domains DomainCacheEntries
@@ -399,13 +474,25 @@ func (c *domainCache) refreshDomains() error {
 	prevEntries := []*DomainCacheEntry{}
 	nextEntries := []*DomainCacheEntry{}
 
-	// make a copy of the existing domain cache, so we can calculate diff and do compare and swap
-	newCacheNameToID := newDomainCache()
-	newCacheByID := newDomainCache()
-	for _, domain := range c.GetAllDomain() {
-		newCacheNameToID.Put(domain.info.Name, domain.info.ID)
-		newCacheByID.Put(domain.info.ID, domain)
+	// carry the existing entries over to a new domain cache, so we can calculate diff and do compare and swap,
+	// entries are not deep copied since every refreshed domain is replaced by its prepared entry below
+	newCacheNameToID := newDomainCache(nil)
+	newCacheByID := newDomainCache(c.onEntryRemoved)
+	ite := c.cacheByID.Load().(Cache).Iterator()
+	for ite.HasNext() {
+		entry := ite.Next().Value().(*DomainCacheEntry)
+		entry.RLock()
+		// expiry will be non zero when the entry is initialized / valid
+		if !entry.expiry.IsZero() {
+			newCacheNameToID.Put(entry.info.Name, entry.info.ID)
+			newCacheByID.Put(entry.info.ID, entry)
+		}
+		entry.RUnlock()
 	}
+	ite.Close()
+
+	expiry := c.timeSource.Now().Add(domainCacheEntryTTL)
+	lastNotificationVersion := atomic.LoadInt64(&c.notificationVersion)
 
 UpdateLoop:
 	for _, domain := range domains {
@@ -417,18 +504,64 @@ UpdateLoop:
 			// will be loaded into cache in the next refresh
 			break UpdateLoop
 		}
-		prevEntry, nextEntry, err := c.updateIDToDomainCache(newCacheByID, domain.info.ID, domain)
-		if err != nil {
-			return err
+		var prevEntry *DomainCacheEntry
+		changed := false
+		globalDomainEnabled := c.clusterMetadata.IsGlobalDomainEnabled()
+		entry, ok := newCacheByID.Get(domain.info.ID).(*DomainCacheEntry)
+		if ok {
+			entry.Lock()
+			if globalDomainEnabled && entry.isChangedBy(domain) {
+				prevEntry = entry.duplicate()
+				changed = true
+			}
+			// the entry is replaced, it is no longer accounted in the memory usage
+			entry.accountedSize = 0
+			entry.Unlock()
+		} else if lastNotificationVersion > 0 {
+			if domain.notificationVersion < lastNotificationVersion {
+				// the domain was evicted and has not changed since, it is loaded again on lookup
+				continue UpdateLoop
+			}
+			// the previous entry was evicted, the change is notified without it
+			changed = globalDomainEnabled
 		}
-		c.updateNameToIDCache(newCacheNameToID, nextEntry.info.Name, nextEntry.info.ID)
 
-		if prevEntry != nil {
+		domain.expiry = expiry
+		newCacheByID.Put(domain.info.ID, domain)
+		c.updateNameToIDCache(newCacheNameToID, domain.info.Name, domain.info.ID)
+
+		if changed {
 			prevEntries = append(prevEntries, prevEntry)
-			nextEntries = append(nextEntries, nextEntry)
+			nextEntries = append(nextEntries, domain.duplicate())
 		}
 	}
 
+	memoryUsage := int64(0)
+	ite = newCacheByID.Iterator()
+	for ite.HasNext() {
+		entry := ite.Next().Value().(*DomainCacheEntry)
+		entry.Lock()
+		entry.accountedSize = entry.estimateSize()
+		memoryUsage += entry.accountedSize
+		entry.Unlock()
+	}
+	ite.Close()
+
+	c.swapDomainCache(newCacheByID, newCacheNameToID, prevEntries, nextEntries)
+
+	atomic.StoreInt64(&c.memoryUsage, memoryUsage)
+	atomic.StoreInt64(&c.notificationVersion, domainNotificationVersion)
+	c.emitCacheStats()
+	c.checkMemoryWatermarks()
+	return nil
+}
+
+func (c *domainCache) swapDomainCache(
+	newCacheByID Cache,
+	newCacheNameToID Cache,
+	prevEntries []*DomainCacheEntry,
+	nextEntries []*DomainCacheEntry,
+) {
 	// NOTE: READ REF BEFORE MODIFICATION
 	// ref: historyEngine.go registerDomainFailoverCallback function
 	c.callbackLock.Lock()
@@ -437,7 +570,6 @@ UpdateLoop:
 	c.cacheByID.Store(newCacheByID)
 	c.cacheNameToID.Store(newCacheNameToID)
 	c.triggerDomainChangeCallbackLocked(prevEntries, nextEntries)
-	return nil
 }
 
 func (c *domainCache) loadDomain(name string, id string) (*persistence.GetDomainResponse, error) {
@@ -449,9 +581,10 @@ func (c *domainCache) loadDomain(name string, id string) (*persistence.GetDomain
 			// and NotificationVersion has complete different meaning
 			resp.FailoverNotificationVersion = 0
 			resp.NotificationVersion = 0
-		} else {
+		} else if resp.NotificationVersion >= atomic.LoadInt64(&c.notificationVersion) {
 			// the result is from V2 table
-			// this should not happen since background thread is refreshing.
+			// this should not happen since background thread is refreshing,
+			// unless the domain was evicted, which can be loaded if it has not changed since the last refresh.
 			// if this actually happen, just discard the result
 			// since we need to guarantee that domainNotificationVersion > all notification versions
 			// inside the cache
@@ -474,16 +607,19 @@ func (c *domainCache) updateIDToDomainCache(cacheByID Cache, id string, record *
 	}
 	entry := elem.(*DomainCacheEntry)
 
+	prevDomain, nextDomain, sizeDelta := c.updateDomainCacheEntry(entry, record)
+	atomic.AddInt64(&c.memoryUsage, sizeDelta)
+	c.emitCacheStats()
+	c.checkMemoryWatermarks()
+	return prevDomain, nextDomain, nil
+}
+
+func (c *domainCache) updateDomainCacheEntry(entry *DomainCacheEntry, record *DomainCacheEntry) (*DomainCacheEntry, *DomainCacheEntry, int64) {
 	entry.Lock()
 	defer entry.Unlock()
 
 	var prevDomain *DomainCacheEntry
-	triggerCallback := c.clusterMetadata.IsGlobalDomainEnabled() &&
-		// expiry will be non zero when the entry is initialized / valid
-		!entry.expiry.IsZero() &&
-		record.notificationVersion > entry.notificationVersion
-	// expiry will be non zero when the entry is initialized / valid
-	if triggerCallback {
+	if c.clusterMetadata.IsGlobalDomainEnabled() && entry.isChangedBy(record) {
 		prevDomain = entry.duplicate()
 	}
 
@@ -499,7 +635,10 @@ func (c *domainCache) updateIDToDomainCache(cacheByID Cache, id string, record *
 
 	nextDomain := entry.duplicate()
 
-	return prevDomain, nextDomain, nil
+	size := entry.estimateSize()
+	sizeDelta := size - entry.accountedSize
+	entry.accountedSize = size
+	return prevDomain, nextDomain, sizeDelta
 }
 
 // getDomain retrieves the information from the cache if it exists, otherwise retrieves the information from metadata
@@ -509,6 +648,7 @@ func (c *domainCache) getDomain(name string) (*DomainCacheEntry, error) {
 	if cacheHit {
 		return c.getDomainByID(id)
 	}
+	c.recordLookup(false)
 
 	record, err := c.loadDomain(name, "")
 	if err != nil {
@@ -536,11 +676,13 @@ func (c *domainCache) getDomainByID(id string) (*DomainCacheEntry, error) {
 		if !entry.isExpired(now) {
 			result = entry.duplicate()
 			entry.RUnlock()
+			c.recordLookup(true)
 			return result, nil
 		}
 		// cache expired, need to refresh
 		entry.RUnlock()
 	}
+	c.recordLookup(false)
 
 	record, err := c.loadDomain("", id)
 	if err != nil {
@@ -573,6 +715,110 @@ func (c *domainCache) getDomainByID(id string) (*DomainCacheEntry, error) {
 	return newEntry, nil
 }
 
+// recordLookup emits the lookup and hit / miss counters,
+// lookup QPS and hit ratio are derived from these counters
+func (c *domainCache) recordLookup(cacheHit bool) {
+	c.metricsClient.IncCounter(metrics.DomainCacheScope, metrics.DomainCacheLookupCounter)
+	if cacheHit {
+		c.metricsClient.IncCounter(metrics.DomainCacheScope, metrics.DomainCacheHitCounter)
+	} else {
+		c.metricsClient.IncCounter(metrics.DomainCacheScope, metrics.DomainCacheMissCounter)
+	}
+}
+
+func (c *domainCache) emitCacheStats() {
+	c.metricsClient.UpdateGauge(metrics.DomainCacheScope, metrics.DomainCacheEntriesGauge, float64(c.cacheByID.Load().(Cache).Size()))
+	c.metricsClient.UpdateGauge(metrics.DomainCacheScope, metrics.DomainCacheMemoryUsageGauge, float64(c.GetMemoryUsage()))
+}
+
+// onEntryRemoved is invoked when an entry is evicted from the domain cache by LRU or deleted,
+// the size of the entry is no longer accounted in the memory usage
+func (c *domainCache) onEntryRemoved(value interface{}) {
+	entry := value.(*DomainCacheEntry)
+	entry.Lock()
+	size := entry.accountedSize
+	entry.accountedSize = 0
+	entry.Unlock()
+
+	if size != 0 {
+		atomic.AddInt64(&c.memoryUsage, -size)
+		c.emitCacheStats()
+		c.checkMemoryWatermarks()
+	}
+}
+
+func (c *domainCache) checkMemoryWatermarks() {
+	memoryUsage := c.GetMemoryUsage()
+	var callbacks []WatermarkCallbackFn
+	var levels []WatermarkLevel
+	evictTarget := int64(-1)
+
+	c.watermarkLock.Lock()
+	for _, watermark := range c.watermarkCallbacks {
+		switch {
+		case !watermark.aboveHigh && memoryUsage > watermark.highWatermark:
+			watermark.aboveHigh = true
+			callbacks = append(callbacks, watermark.callback)
+			levels = append(levels, WatermarkLevelHigh)
+			if evictTarget < 0 || watermark.lowWatermark < evictTarget {
+				evictTarget = watermark.lowWatermark
+			}
+		case watermark.aboveHigh && memoryUsage < watermark.lowWatermark:
+			watermark.aboveHigh = false
+			callbacks = append(callbacks, watermark.callback)
+			levels = append(levels, WatermarkLevelLow)
+		}
+	}
+	c.watermarkLock.Unlock()
+
+	for i, callback := range callbacks {
+		callback(levels[i], memoryUsage)
+	}
+	if evictTarget >= 0 {
+		c.evictDomains(evictTarget)
+	}
+}
+
+// evictDomains evicts the least recently used local domains until the memory usage is below the target,
+// the memory usage is decreased once the cache notifies the removal of the evicted entries
+func (c *domainCache) evictDomains(target int64) {
+	type evictCandidate struct {
+		id   string
+		name string
+		size int64
+	}
+
+	cacheByID := c.cacheByID.Load().(Cache)
+	cacheNameToID := c.cacheNameToID.Load().(Cache)
+	var candidates []evictCandidate
+	ite := cacheByID.Iterator()
+	for ite.HasNext() {
+		entry := ite.Next().Value().(*DomainCacheEntry)
+		entry.RLock()
+		// global domains are kept so that shards registering later are notified about their failover
+		if !entry.isGlobalDomain {
+			candidates = append(candidates, evictCandidate{
+				id:   entry.info.ID,
+				name: entry.info.Name,
+				size: entry.accountedSize,
+			})
+		}
+		entry.RUnlock()
+	}
+	ite.Close()
+
+	// the iterator returns the most recently used entries first
+	memoryUsage := c.GetMemoryUsage()
+	evicted := 0
+	for i := len(candidates) - 1; i >= 0 && memoryUsage >= target; i-- {
+		cacheByID.Delete(candidates[i].id)
+		cacheNameToID.Delete(candidates[i].name)
+		memoryUsage -= candidates[i].size
+		evicted++
+	}
+	c.metricsClient.AddCounter(metrics.DomainCacheScope, metrics.DomainCacheEvictionCounter, int64(evicted))
+}
+
 func (c *domainCache) triggerDomainChangePrepareCallbackLocked() {
 	sw := c.metricsClient.StartTimer(metrics.DomainCacheScope, metrics.DomainCachePrepareCallbacksLatency)
 	defer sw.Stop()
@@ -639,8 +885,14 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 		VisibilityArchivalURI:    entry.config.VisibilityArchivalURI,
 		BadBinaries:              copyResetBinary(entry.config.BadBinaries),
 	}
+	if entry.config.KnownTaskLists != nil {
+		result.config.KnownTaskLists = append([]string{}, entry.config.KnownTaskLists...)
+	}
 	result.replicationConfig = &persistence.DomainReplicationConfig{
-		ActiveClusterName: entry.replicationConfig.ActiveClusterName,
+		ActiveClusterName:        entry.replicationConfig.ActiveClusterName,
+		FailoverState:            entry.replicationConfig.FailoverState,
+		PendingActiveClusterName: entry.replicationConfig.PendingActiveClusterName,
+		PreviousFailoverVersion:  entry.replicationConfig.PreviousFailoverVersion,
 	}
 	for _, cluster := range entry.replicationConfig.Clusters {
 		result.replicationConfig.Clusters = append(result.replicationConfig.Clusters, &*cluster)
@@ -654,6 +906,44 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 	return result
 }
 
+// estimateSize returns the approximate number of bytes retained by the entry,
+// caller should hold the entry lock
+func (entry *DomainCacheEntry) estimateSize() int64 {
+	size := domainCacheEntryOverhead
+	if info := entry.info; info != nil {
+		size += len(info.ID) + len(info.Name) + len(info.Description) + len(info.OwnerEmail)
+		for k, v := range info.Data {
+			size += len(k) + len(v)
+		}
+	}
+	if config := entry.config; config != nil {
+		size += len(config.HistoryArchivalURI) + len(config.VisibilityArchivalURI)
+		for _, taskList := range config.KnownTaskLists {
+			size += len(taskList)
+		}
+		for checksum, binary := range config.BadBinaries.Binaries {
+			size += len(checksum)
+			if binary != nil {
+				size += len(binary.GetReason()) + len(binary.GetOperator())
+			}
+		}
+	}
+	if replicationConfig := entry.replicationConfig; replicationConfig != nil {
+		size += len(replicationConfig.ActiveClusterName) + len(replicationConfig.PendingActiveClusterName)
+		for _, cluster := range replicationConfig.Clusters {
+			size += len(cluster.ClusterName)
+		}
+	}
+	return int64(size)
+}
+
+// isChangedBy returns whether the record is a newer version of the valid entry,
+// i.e. whether the domain change callbacks should be triggered, caller should hold the entry lock
+func (entry *DomainCacheEntry) isChangedBy(record *DomainCacheEntry) bool {
+	// expiry will be non zero when the entry is initialized / valid
+	return !entry.expiry.IsZero() && record.notificationVersion > entry.notificationVersion
+}
+
 func (entry *DomainCacheEntry) isExpired(now time.Time) bool {
 	return entry.expiry.IsZero() || now.After(entry.expiry)
 }
@@ -707,6 +997,13 @@ func (entry *DomainCacheEntry) IsDomainActive() bool {
 	return entry.clusterMetadata.GetCurrentClusterName() == entry.replicationConfig.ActiveClusterName
 }
 
+// IsDomainInHandover return whether the domain is active in current cluster and is handing over to another cluster
+func (entry *DomainCacheEntry) IsDomainInHandover() bool {
+	return entry.isGlobalDomain &&
+		entry.IsDomainActive() &&
+		entry.replicationConfig.FailoverState == workflow.DomainFailoverStateHandover
+}
+
 // GetReplicationPolicy return the derived workflow replication policy
 func (entry *DomainCacheEntry) GetReplicationPolicy() ReplicationPolicy {
 	// frontend guarantee that the clusters always contains the active domain, so if the # of clusters is 1
@@ -719,6 +1016,16 @@ func (entry *DomainCacheEntry) GetReplicationPolicy() ReplicationPolicy {
 
 // GetDomainNotActiveErr return err if domain is not active, nil otherwise
 func (entry *DomainCacheEntry) GetDomainNotActiveErr() error {
+	if entry.IsDomainInHandover() {
+		// writes are rejected with a retryable error until the handover finishes
+		return &workflow.ServiceBusyError{
+			Message: fmt.Sprintf(
+				"Domain: %v is in handover to cluster: %v, retry later.",
+				entry.info.Name,
+				entry.replicationConfig.PendingActiveClusterName,
+			),
+		}
+	}
 	if entry.IsDomainActive() {
 		// domain is consider active
 		return nil
@@ -749,6 +1056,20 @@ func CreateDomainCacheEntry(domainName string) *DomainCacheEntry {
 // SampleRetentionKey is key to specify sample retention
 var SampleRetentionKey = "sample_retention_days"
 
+// IsTaskListKnown returns whether the task list is declared by the domain,
+// domains which declare no task lists accept any task list
+func (entry *DomainCacheEntry) IsTaskListKnown(taskList string) bool {
+	if len(entry.config.KnownTaskLists) == 0 {
+		return true
+	}
+	for _, known := range entry.config.KnownTaskLists {
+		if known == taskList {
+			return true
+		}
+	}
+	return false
+}
+
 // SampleRateKey is key to specify sample rate
 var SampleRateKey = "sample_retention_rate"
 
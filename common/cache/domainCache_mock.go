@@ -173,4 +173,29 @@ func (_m *DomainCacheMock) UnregisterDomainChangeCallback(shard int) {
 	_m.Called(shard)
 }
 
+// RegisterMemoryWatermarkCallback provides a mock function with given fields: id, lowWatermark, highWatermark, callback
+func (_m *DomainCacheMock) RegisterMemoryWatermarkCallback(id int, lowWatermark int64, highWatermark int64,
+	callback WatermarkCallbackFn) {
+	_m.Called(id, lowWatermark, highWatermark, callback)
+}
+
+// UnregisterMemoryWatermarkCallback provides a mock function with given fields: id
+func (_m *DomainCacheMock) UnregisterMemoryWatermarkCallback(id int) {
+	_m.Called(id)
+}
+
+// GetMemoryUsage provides a mock function with given fields:
+func (_m *DomainCacheMock) GetMemoryUsage() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 var _ DomainCache = (*DomainCacheMock)(nil)
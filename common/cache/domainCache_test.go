@@ -22,6 +22,7 @@ package cache
 
 import (
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -602,6 +603,205 @@ func (s *domainCacheSuite) TestGetUpdateCache_ConcurrentAccess() {
 	waitGroup.Wait()
 }
 
+func (s *domainCacheSuite) TestGetDomain_LookupStats() {
+	s.clusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	metricsScope := tally.NewTestScope("test", nil)
+	s.domainCache.metricsClient = metrics.NewClient(metricsScope, metrics.History)
+	domainRecord := &persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{ID: uuid.New(), Name: "some random domain name", Data: map[string]string{"k": "v"}},
+		Config: &persistence.DomainConfig{
+			Retention: 1,
+			BadBinaries: shared.BadBinaries{
+				Binaries: map[string]*shared.BadBinaryInfo{},
+			},
+		},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+		TableVersion: persistence.DomainTableVersionV1,
+	}
+	entry := s.buildEntryFromRecord(domainRecord)
+
+	s.metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: entry.info.Name}).Return(domainRecord, nil).Once()
+
+	_, err := s.domainCache.GetDomain(domainRecord.Info.Name)
+	s.Nil(err)
+	_, err = s.domainCache.GetDomain(domainRecord.Info.Name)
+	s.Nil(err)
+	_, err = s.domainCache.GetDomainByID(domainRecord.Info.ID)
+	s.Nil(err)
+
+	counters := map[string]int64{}
+	for _, counter := range metricsScope.Snapshot().Counters() {
+		counters[counter.Name()] = counter.Value()
+	}
+	s.Equal(int64(3), counters["test.domain_cache_lookups"])
+	s.Equal(int64(2), counters["test.domain_cache_hits"])
+	s.Equal(int64(1), counters["test.domain_cache_misses"])
+
+	expectedUsage := entry.estimateSize()
+	s.Equal(int64(domainCacheEntryOverhead+len(entry.info.ID)+len(entry.info.Name)+len("kv")+2*len(cluster.TestCurrentClusterName)), expectedUsage)
+	s.Equal(expectedUsage, s.domainCache.GetMemoryUsage())
+
+	gauges := map[string]float64{}
+	for _, gauge := range metricsScope.Snapshot().Gauges() {
+		gauges[gauge.Name()] = gauge.Value()
+	}
+	s.Equal(float64(1), gauges["test.domain_cache_entries"])
+	s.Equal(float64(expectedUsage), gauges["test.domain_cache_memory_usage_bytes"])
+}
+
+func (s *domainCacheSuite) TestMemoryWatermarkCallback() {
+	var levels []WatermarkLevel
+	setMemoryUsage := func(memoryUsage int64) {
+		atomic.StoreInt64(&s.domainCache.memoryUsage, memoryUsage)
+		s.domainCache.checkMemoryWatermarks()
+	}
+
+	setMemoryUsage(200)
+	s.domainCache.RegisterMemoryWatermarkCallback(0, 100, 150, func(level WatermarkLevel, memoryUsage int64) {
+		levels = append(levels, level)
+	})
+	// already above the high watermark when registered
+	s.Equal([]WatermarkLevel{WatermarkLevelHigh}, levels)
+
+	// still above the low watermark, no notification
+	setMemoryUsage(120)
+	s.Equal([]WatermarkLevel{WatermarkLevelHigh}, levels)
+
+	setMemoryUsage(90)
+	s.Equal([]WatermarkLevel{WatermarkLevelHigh, WatermarkLevelLow}, levels)
+
+	// below the high watermark, no notification
+	setMemoryUsage(140)
+	s.Equal([]WatermarkLevel{WatermarkLevelHigh, WatermarkLevelLow}, levels)
+
+	setMemoryUsage(160)
+	s.Equal([]WatermarkLevel{WatermarkLevelHigh, WatermarkLevelLow, WatermarkLevelHigh}, levels)
+
+	s.domainCache.UnregisterMemoryWatermarkCallback(0)
+	setMemoryUsage(0)
+	s.Equal([]WatermarkLevel{WatermarkLevelHigh, WatermarkLevelLow, WatermarkLevelHigh}, levels)
+}
+
+func (s *domainCacheSuite) TestMemoryWatermarkCallback_EvictLeastRecentlyUsed() {
+	s.clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	var names []string
+	for i := 0; i < 3; i++ {
+		domainRecord := &persistence.GetDomainResponse{
+			Info: &persistence.DomainInfo{ID: uuid.New(), Name: uuid.New()},
+			Config: &persistence.DomainConfig{
+				BadBinaries: shared.BadBinaries{
+					Binaries: map[string]*shared.BadBinaryInfo{},
+				},
+			},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		}
+		s.metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: domainRecord.Info.Name}).Return(domainRecord, nil).Once()
+		_, err := s.domainCache.GetDomain(domainRecord.Info.Name)
+		s.Nil(err)
+		names = append(names, domainRecord.Info.Name)
+	}
+	// the first domain becomes the most recently used one
+	_, err := s.domainCache.GetDomain(names[0])
+	s.Nil(err)
+	memoryUsage := s.domainCache.GetMemoryUsage()
+	entrySize := memoryUsage / 3
+	s.Equal(3*entrySize, memoryUsage)
+
+	var levels []WatermarkLevel
+	var levelsLock sync.Mutex
+	getLevels := func() []WatermarkLevel {
+		levelsLock.Lock()
+		defer levelsLock.Unlock()
+		return append([]WatermarkLevel{}, levels...)
+	}
+	s.domainCache.RegisterMemoryWatermarkCallback(0, entrySize+1, memoryUsage-1, func(level WatermarkLevel, memoryUsage int64) {
+		levelsLock.Lock()
+		defer levelsLock.Unlock()
+		levels = append(levels, level)
+		if level == WatermarkLevelLow {
+			// callbacks are not invoked when holding the watermark lock
+			s.domainCache.UnregisterMemoryWatermarkCallback(0)
+		}
+	})
+
+	// evicted until below the low watermark, least recently used first
+	sizeByName, sizeByID := s.domainCache.GetCacheSize()
+	s.Equal(int64(1), sizeByName)
+	s.Equal(int64(1), sizeByID)
+	s.NotNil(s.domainCache.cacheNameToID.Load().(Cache).Get(names[0]))
+
+	// the memory usage is decreased once the removal of the evicted entries is notified
+	for i := 0; i < 100 && s.domainCache.GetMemoryUsage() != entrySize; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.Equal(entrySize, s.domainCache.GetMemoryUsage())
+	s.Equal([]WatermarkLevel{WatermarkLevelHigh, WatermarkLevelLow}, getLevels())
+}
+
+func (s *domainCacheSuite) TestMemoryWatermarkCallback_RegisterCallbackAfterEviction() {
+	s.clusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	var ids []string
+	var names []string
+	for i := 0; i < 3; i++ {
+		domainRecord := &persistence.GetDomainResponse{
+			Info: &persistence.DomainInfo{ID: uuid.New(), Name: uuid.New()},
+			Config: &persistence.DomainConfig{
+				BadBinaries: shared.BadBinaries{
+					Binaries: map[string]*shared.BadBinaryInfo{},
+				},
+			},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+			},
+			// the least recently used domain is a global one
+			IsGlobalDomain: i == 1,
+			TableVersion:   persistence.DomainTableVersionV1,
+		}
+		s.metadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: domainRecord.Info.Name}).Return(domainRecord, nil).Once()
+		_, err := s.domainCache.GetDomain(domainRecord.Info.Name)
+		s.Nil(err)
+		ids = append(ids, domainRecord.Info.ID)
+		names = append(names, domainRecord.Info.Name)
+	}
+	_, err := s.domainCache.GetDomain(names[0])
+	s.Nil(err)
+	_, err = s.domainCache.GetDomain(names[2])
+	s.Nil(err)
+	memoryUsage := s.domainCache.GetMemoryUsage()
+	entrySize := memoryUsage / 3
+
+	// the global domain is skipped, the least recently used local domain is evicted instead
+	s.domainCache.RegisterMemoryWatermarkCallback(0, 2*entrySize+1, memoryUsage-1, func(WatermarkLevel, int64) {})
+	s.domainCache.UnregisterMemoryWatermarkCallback(0)
+	sizeByName, sizeByID := s.domainCache.GetCacheSize()
+	s.Equal(int64(2), sizeByName)
+	s.Equal(int64(2), sizeByID)
+	s.Nil(s.domainCache.cacheByID.Load().(Cache).Get(ids[0]))
+
+	// shards registered after the eviction still catch up with the global domain
+	var notifiedIDs []string
+	s.domainCache.RegisterDomainChangeCallback(
+		0,
+		0,
+		func() {},
+		func(prevDomains []*DomainCacheEntry, nextDomains []*DomainCacheEntry) {
+			for _, domain := range nextDomains {
+				notifiedIDs = append(notifiedIDs, domain.GetInfo().ID)
+			}
+		},
+	)
+	s.Contains(notifiedIDs, ids[1])
+	s.NotContains(notifiedIDs, ids[0])
+}
+
 func (s *domainCacheSuite) buildEntryFromRecord(record *persistence.GetDomainResponse) *DomainCacheEntry {
 	newEntry := newDomainCacheEntry(s.clusterMetadata)
 	newEntry.info = &*record.Info
@@ -708,3 +908,30 @@ func Test_IsSampledForLongerRetention(t *testing.T) {
 	d.info.Data[SampleRateKey] = "invalid-value"
 	require.False(t, d.IsSampledForLongerRetention(wid))
 }
+
+func Test_GetDomainNotActiveErr_Handover(t *testing.T) {
+	d := newDomainCacheEntry(cluster.GetTestClusterMetadata(true, true))
+	d.info = &persistence.DomainInfo{Name: "some random domain name"}
+	d.isGlobalDomain = true
+	d.replicationConfig = &persistence.DomainReplicationConfig{
+		ActiveClusterName: cluster.TestCurrentClusterName,
+		Clusters: []*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestCurrentClusterName},
+			{ClusterName: cluster.TestAlternativeClusterName},
+		},
+	}
+	require.NoError(t, d.GetDomainNotActiveErr())
+
+	d.replicationConfig.FailoverState = shared.DomainFailoverStateHandover
+	d.replicationConfig.PendingActiveClusterName = cluster.TestAlternativeClusterName
+	require.True(t, d.IsDomainInHandover())
+	_, ok := d.GetDomainNotActiveErr().(*shared.ServiceBusyError)
+	require.True(t, ok)
+
+	d.replicationConfig.ActiveClusterName = cluster.TestAlternativeClusterName
+	d.replicationConfig.FailoverState = shared.DomainFailoverStateSwitched
+	d.replicationConfig.PendingActiveClusterName = ""
+	require.False(t, d.IsDomainInHandover())
+	_, ok = d.GetDomainNotActiveErr().(*shared.DomainNotActiveError)
+	require.True(t, ok)
+}
@@ -30,6 +30,8 @@ import (
 var (
 	// ErrCacheFull is returned if Put fails due to cache being filled with pinned elements
 	ErrCacheFull = errors.New("Cache capacity is fully occupied with pinned elements")
+	// ErrEntryTooLarge is returned if Put fails due to the element being larger than the max bytes of the cache
+	ErrEntryTooLarge = errors.New("Cache element is larger than the max bytes of the cache")
 )
 
 // lru is a concurrent fixed size cache that evicts elements in lru order
@@ -42,6 +44,9 @@ type (
 		ttl      time.Duration
 		pin      bool
 		rmFunc   RemovedFunc
+		sizeFunc SizeFunc
+		maxBytes int
+		currSize int
 	}
 
 	iteratorImpl struct {
@@ -55,6 +60,8 @@ type (
 		createTime time.Time
 		value      interface{}
 		refCount   int
+		ttl        time.Duration
+		size       int
 	}
 )
 
@@ -130,15 +137,26 @@ func New(maxSize int, opts *Options) Cache {
 	if opts == nil {
 		opts = &Options{}
 	}
+	if numShards := getNumShards(maxSize, opts.Shards); numShards > 1 {
+		return newShardedCache(maxSize, numShards, opts)
+	}
+	return newLRU(maxSize, opts.InitialCapacity, opts.MaxBytes, opts)
+}
 
-	return &lru{
+func newLRU(maxSize int, initialCapacity int, maxBytes int, opts *Options) *lru {
+	c := &lru{
 		byAccess: list.New(),
-		byKey:    make(map[interface{}]*list.Element, opts.InitialCapacity),
+		byKey:    make(map[interface{}]*list.Element, initialCapacity),
 		ttl:      opts.TTL,
 		maxSize:  maxSize,
 		pin:      opts.Pin,
 		rmFunc:   opts.RemovedFunc,
 	}
+	if opts.SizeFunc != nil && maxBytes > 0 {
+		c.sizeFunc = opts.SizeFunc
+		c.maxBytes = maxBytes
+	}
+	return c
 }
 
 // NewLRU creates a new LRU cache of the given size, setting initial capacity
@@ -185,13 +203,23 @@ func (c *lru) Put(key interface{}, value interface{}) interface{} {
 	if c.pin {
 		panic("Cannot use Put API in Pin mode. Use Delete and PutIfNotExist if necessary")
 	}
-	val, _ := c.putInternal(key, value, true)
+	val, _ := c.putInternal(key, value, c.ttl, true)
+	return val
+}
+
+// PutWithTTL puts a new value associated with a given key with a TTL overriding the one of the cache,
+// returning the existing value (if present)
+func (c *lru) PutWithTTL(key interface{}, value interface{}, ttl time.Duration) interface{} {
+	if c.pin {
+		panic("Cannot use PutWithTTL API in Pin mode. Use Delete and PutIfNotExist if necessary")
+	}
+	val, _ := c.putInternal(key, value, ttl, true)
 	return val
 }
 
 // PutIfNotExist puts a value associated with a given key if it does not exist
 func (c *lru) PutIfNotExist(key interface{}, value interface{}) (interface{}, error) {
-	existing, err := c.putInternal(key, value, false)
+	existing, err := c.putInternal(key, value, c.ttl, false)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +266,7 @@ func (c *lru) Size() int {
 
 // Put puts a new value associated with a given key, returning the existing value (if present)
 // allowUpdate flag is used to control overwrite behavior if the value exists
-func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool) (interface{}, error) {
+func (c *lru) putInternal(key interface{}, value interface{}, ttl time.Duration, allowUpdate bool) (interface{}, error) {
 	c.mut.Lock()
 	defer c.mut.Unlock()
 
@@ -252,15 +280,26 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			existing := entry.value
 			if allowUpdate {
 				entry.value = value
-				if c.ttl != 0 {
+				entry.ttl = ttl
+				if ttl != 0 {
 					entry.createTime = time.Now()
 				}
+				c.currSize -= entry.size
+				entry.size = c.sizeOf(value)
+				c.currSize += entry.size
+				if c.isTooLarge(entry) && entry.refCount == 0 {
+					c.deleteInternal(elt)
+					return existing, nil
+				}
 			}
 
 			c.byAccess.MoveToFront(elt)
 			if c.pin {
 				entry.refCount++
 			}
+			// the updated entry may exceed the byte limit, make room by evicting other entries,
+			// Put does not fail so the limit may stay exceeded if all the others are pinned
+			c.evictInternal(elt)
 			return existing, nil
 		}
 	}
@@ -268,31 +307,66 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	entry := &entryImpl{
 		key:   key,
 		value: value,
+		ttl:   ttl,
+		size:  c.sizeOf(value),
+	}
+
+	if c.isTooLarge(entry) {
+		return nil, ErrEntryTooLarge
 	}
 
 	if c.pin {
 		entry.refCount++
 	}
 
-	if c.ttl != 0 {
+	if ttl != 0 {
 		entry.createTime = time.Now()
 	}
 
-	c.byKey[key] = c.byAccess.PushFront(entry)
-	if len(c.byKey) == c.maxSize {
-		oldest := c.byAccess.Back().Value.(*entryImpl)
+	elt = c.byAccess.PushFront(entry)
+	c.byKey[key] = elt
+	c.currSize += entry.size
+	if !c.evictInternal(elt) {
+		// Cache is full with pinned elements
+		// revert the insert and return
+		c.deleteInternal(elt)
+		return nil, ErrCacheFull
+	}
+
+	return nil, nil
+}
 
-		if oldest.refCount > 0 {
-			// Cache is full with pinned elements
-			// revert the insert and return
-			c.deleteInternal(c.byAccess.Front())
-			return nil, ErrCacheFull
+// evictInternal evicts the least recently used entries, other than the given one,
+// until the cache is within its size limits, returns false if pinned entries prevent that
+func (c *lru) evictInternal(protected *list.Element) bool {
+	if len(c.byKey) == c.maxSize {
+		oldest := c.byAccess.Back()
+		if oldest == protected || oldest.Value.(*entryImpl).refCount > 0 {
+			return false
 		}
+		c.deleteInternal(oldest)
+	}
 
-		c.deleteInternal(c.byAccess.Back())
+	element := c.byAccess.Back()
+	for c.currSize > c.maxBytes && c.maxBytes > 0 && element != nil {
+		prev := element.Prev()
+		if element != protected && element.Value.(*entryImpl).refCount == 0 {
+			c.deleteInternal(element)
+		}
+		element = prev
 	}
+	return c.maxBytes == 0 || c.currSize <= c.maxBytes
+}
 
-	return nil, nil
+func (c *lru) isTooLarge(entry *entryImpl) bool {
+	return c.maxBytes > 0 && entry.size > c.maxBytes
+}
+
+func (c *lru) sizeOf(value interface{}) int {
+	if c.sizeFunc == nil {
+		return 0
+	}
+	return c.sizeFunc(value)
 }
 
 func (c *lru) deleteInternal(element *list.Element) {
@@ -300,9 +374,10 @@ func (c *lru) deleteInternal(element *list.Element) {
 	if c.rmFunc != nil {
 		go c.rmFunc(entry.value)
 	}
+	c.currSize -= entry.size
 	delete(c.byKey, entry.key)
 }
 
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
-	return entry.refCount == 0 && !entry.createTime.IsZero() && currentTime.After(entry.createTime.Add(c.ttl))
+	return entry.refCount == 0 && !entry.createTime.IsZero() && currentTime.After(entry.createTime.Add(entry.ttl))
 }
@@ -263,3 +263,82 @@ func TestIterator(t *testing.T) {
 	it.Close()
 	assert.Equal(t, expected, actual)
 }
+
+func TestLRUWithMaxBytes(t *testing.T) {
+	cache := New(5, &Options{
+		MaxBytes: 10,
+		SizeFunc: func(value interface{}) int {
+			return len(value.(string))
+		},
+	})
+
+	cache.Put("A", "1234")
+	cache.Put("B", "5678")
+	assert.Equal(t, 2, cache.Size())
+
+	// exceeds the max bytes, the least recently used entry is evicted
+	cache.Get("A")
+	cache.Put("C", "90")
+	cache.Put("D", "12")
+	assert.Nil(t, cache.Get("B"))
+	assert.Equal(t, "1234", cache.Get("A"))
+	assert.Equal(t, "90", cache.Get("C"))
+	assert.Equal(t, "12", cache.Get("D"))
+
+	// growing an existing entry evicts the others
+	cache.Put("A", "1234567890")
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, "1234567890", cache.Get("A"))
+
+	// an entry larger than the max bytes is not cached
+	_, err := cache.PutIfNotExist("E", "12345678901")
+	assert.Equal(t, ErrEntryTooLarge, err)
+	assert.Nil(t, cache.Get("E"))
+	assert.Equal(t, "1234567890", cache.Get("A"))
+	cache.Put("A", "12345678901")
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 0, cache.Size())
+}
+
+func TestLRUWithMaxBytes_Pin(t *testing.T) {
+	cache := New(5, &Options{
+		Pin:      true,
+		MaxBytes: 10,
+		SizeFunc: func(value interface{}) int {
+			return len(value.(string))
+		},
+	})
+
+	_, err := cache.PutIfNotExist("A", "123456")
+	assert.NoError(t, err)
+	_, err = cache.PutIfNotExist("B", "7890")
+	assert.NoError(t, err)
+
+	// all the entries are pinned
+	_, err = cache.PutIfNotExist("C", "1")
+	assert.Equal(t, ErrCacheFull, err)
+	assert.Equal(t, 2, cache.Size())
+
+	cache.Release("A")
+	_, err = cache.PutIfNotExist("C", "1")
+	assert.NoError(t, err)
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, 2, cache.Size())
+}
+
+func TestLRUPutWithTTL(t *testing.T) {
+	cache := New(5, &Options{
+		TTL: time.Hour,
+	})
+	cache.PutWithTTL("A", "foo", time.Millisecond*100)
+	cache.Put("B", "bar")
+	assert.Equal(t, "foo", cache.Get("A"))
+	time.Sleep(time.Millisecond * 300)
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, "bar", cache.Get("B"))
+
+	// entries without TTL never expire, even if the cache has a TTL
+	cache.PutWithTTL("C", "zed", 0)
+	assert.Equal(t, "zed", cache.Get("C"))
+	assert.Equal(t, 2, cache.Size())
+}
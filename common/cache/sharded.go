@@ -0,0 +1,175 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// minShardSize is the min number of entries of a partition,
+// small caches are partitioned into fewer partitions than requested
+const minShardSize = 16
+
+type (
+	// shardedCache partitions the keys across independently locked lru caches,
+	// to reduce lock contention of caches under heavy concurrent access
+	shardedCache struct {
+		shards      []*lru
+		keyHashFunc KeyHashFunc
+	}
+
+	shardedIterator struct {
+		cache   *shardedCache
+		shardID int
+		current Iterator
+	}
+)
+
+var _ Cache = (*shardedCache)(nil)
+
+func newShardedCache(maxSize int, numShards int, opts *Options) *shardedCache {
+	keyHashFunc := opts.KeyHashFunc
+	if keyHashFunc == nil {
+		keyHashFunc = hashKey
+	}
+
+	c := &shardedCache{
+		shards:      make([]*lru, numShards),
+		keyHashFunc: keyHashFunc,
+	}
+	for i := range c.shards {
+		c.shards[i] = newLRU(
+			divideRoundUp(maxSize, numShards),
+			divideRoundUp(opts.InitialCapacity, numShards),
+			divideRoundUp(opts.MaxBytes, numShards),
+			opts,
+		)
+	}
+	return c
+}
+
+// Get retrieves the value stored under the given key
+func (c *shardedCache) Get(key interface{}) interface{} {
+	return c.getShard(key).Get(key)
+}
+
+// Put puts a new value associated with a given key, returning the existing value (if present)
+func (c *shardedCache) Put(key interface{}, value interface{}) interface{} {
+	return c.getShard(key).Put(key, value)
+}
+
+// PutWithTTL puts a new value associated with a given key with a TTL overriding the one of the cache,
+// returning the existing value (if present)
+func (c *shardedCache) PutWithTTL(key interface{}, value interface{}, ttl time.Duration) interface{} {
+	return c.getShard(key).PutWithTTL(key, value, ttl)
+}
+
+// PutIfNotExist puts a value associated with a given key if it does not exist
+func (c *shardedCache) PutIfNotExist(key interface{}, value interface{}) (interface{}, error) {
+	return c.getShard(key).PutIfNotExist(key, value)
+}
+
+// Delete deletes a key, value pair associated with a key
+func (c *shardedCache) Delete(key interface{}) {
+	c.getShard(key).Delete(key)
+}
+
+// Release decrements the ref count of a pinned element.
+func (c *shardedCache) Release(key interface{}) {
+	c.getShard(key).Release(key)
+}
+
+// Size returns the number of entries currently in the cache
+func (c *shardedCache) Size() int {
+	size := 0
+	for _, shard := range c.shards {
+		size += shard.Size()
+	}
+	return size
+}
+
+// Iterator returns an iterator to the cache, which locks one partition at a time.
+// Access or modification to the partition being iterated can cause a dead lock.
+func (c *shardedCache) Iterator() Iterator {
+	return &shardedIterator{
+		cache:   c,
+		shardID: -1,
+	}
+}
+
+func (c *shardedCache) getShard(key interface{}) *lru {
+	return c.shards[c.keyHashFunc(key)%uint32(len(c.shards))]
+}
+
+// Close closes the iterator
+func (it *shardedIterator) Close() {
+	if it.current != nil {
+		it.current.Close()
+		it.current = nil
+	}
+}
+
+// HasNext return true if there is more items to be returned
+func (it *shardedIterator) HasNext() bool {
+	for it.current == nil || !it.current.HasNext() {
+		it.Close()
+		if it.shardID+1 >= len(it.cache.shards) {
+			return false
+		}
+		it.shardID++
+		it.current = it.cache.shards[it.shardID].Iterator()
+	}
+	return true
+}
+
+// Next return the next item
+func (it *shardedIterator) Next() Entry {
+	if !it.HasNext() {
+		panic("Sharded cache iterator Next called when there is no next item")
+	}
+	return it.current.Next()
+}
+
+func hashKey(key interface{}) uint32 {
+	hash := fnv.New32a()
+	switch k := key.(type) {
+	case string:
+		hash.Write([]byte(k))
+	case fmt.Stringer:
+		hash.Write([]byte(k.String()))
+	default:
+		fmt.Fprintf(hash, "%v", k)
+	}
+	return hash.Sum32()
+}
+
+func getNumShards(maxSize int, shards int) int {
+	if maxSize/minShardSize < shards {
+		return maxSize / minShardSize
+	}
+	return shards
+}
+
+func divideRoundUp(dividend int, divisor int) int {
+	return (dividend + divisor - 1) / divisor
+}
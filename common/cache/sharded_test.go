@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardedCache(t *testing.T) {
+	cache := New(64, &Options{
+		Shards: 4,
+	})
+	_, ok := cache.(*shardedCache)
+	assert.True(t, ok)
+
+	for i := 0; i < 32; i++ {
+		cache.Put(strconv.Itoa(i), i)
+	}
+	assert.Equal(t, 32, cache.Size())
+	for i := 0; i < 32; i++ {
+		assert.Equal(t, i, cache.Get(strconv.Itoa(i)))
+	}
+
+	cache.PutWithTTL("ttl", "foo", time.Millisecond*100)
+	assert.Equal(t, "foo", cache.Get("ttl"))
+	time.Sleep(time.Millisecond * 300)
+	assert.Nil(t, cache.Get("ttl"))
+
+	cache.Delete("0")
+	assert.Nil(t, cache.Get("0"))
+	assert.Equal(t, 31, cache.Size())
+}
+
+func TestShardedCache_KeyHashFunc(t *testing.T) {
+	cache := New(64, &Options{
+		Shards: 4,
+		KeyHashFunc: func(key interface{}) uint32 {
+			return uint32(key.(keyType).dummyInt)
+		},
+	}).(*shardedCache)
+
+	cache.Put(keyType{dummyString: "A", dummyInt: 1}, "foo")
+	cache.Put(keyType{dummyString: "B", dummyInt: 5}, "bar")
+	cache.Put(keyType{dummyString: "C", dummyInt: 2}, "zed")
+	assert.Equal(t, "foo", cache.Get(keyType{dummyString: "A", dummyInt: 1}))
+	assert.Equal(t, "bar", cache.Get(keyType{dummyString: "B", dummyInt: 5}))
+	assert.Equal(t, 2, cache.shards[1].Size())
+	assert.Equal(t, 1, cache.shards[2].Size())
+}
+
+func TestShardedCache_SmallCache(t *testing.T) {
+	_, ok := New(2, &Options{Shards: 8}).(*lru)
+	assert.True(t, ok)
+
+	cache, ok := New(64, &Options{Shards: 8}).(*shardedCache)
+	assert.True(t, ok)
+	assert.Equal(t, 4, len(cache.shards))
+}
+
+func TestShardedCache_MaxBytes(t *testing.T) {
+	cache := New(64, &Options{
+		Shards:   2,
+		MaxBytes: 20,
+		SizeFunc: func(value interface{}) int {
+			return len(value.(string))
+		},
+	}).(*shardedCache)
+
+	for _, shard := range cache.shards {
+		assert.Equal(t, 10, shard.maxBytes)
+	}
+	for i := 0; i < 32; i++ {
+		cache.Put(strconv.Itoa(i), "12345")
+	}
+	// every shard holds up to 2 entries
+	assert.True(t, cache.Size() <= 4)
+}
+
+func TestShardedCache_Iterator(t *testing.T) {
+	expected := map[string]string{
+		"A": "Alpha",
+		"B": "Beta",
+		"G": "Gamma",
+		"D": "Delta",
+	}
+
+	cache := New(64, &Options{
+		Shards: 4,
+	})
+	for k, v := range expected {
+		cache.Put(k, v)
+	}
+
+	actual := map[string]string{}
+	it := cache.Iterator()
+	for it.HasNext() {
+		entry := it.Next()
+		actual[entry.Key().(string)] = entry.Value().(string)
+	}
+	it.Close()
+	assert.Equal(t, expected, actual)
+
+	actual = map[string]string{}
+	it = cache.Iterator()
+	for i := 0; i < len(expected); i++ {
+		entry := it.Next()
+		actual[entry.Key().(string)] = entry.Value().(string)
+	}
+	it.Close()
+	assert.Equal(t, expected, actual)
+}
+
+func TestShardedCache_ConcurrentAccess(t *testing.T) {
+	cache := New(1024, &Options{
+		Shards: 16,
+		Pin:    true,
+	})
+
+	waitGroup := &sync.WaitGroup{}
+	for i := 0; i < 32; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			for j := 0; j < 100; j++ {
+				key := strconv.Itoa((i + j) % 64)
+				value, err := cache.PutIfNotExist(key, key)
+				assert.NoError(t, err)
+				assert.Equal(t, key, value)
+				cache.Release(key)
+			}
+		}(i)
+	}
+	waitGroup.Wait()
+	assert.Equal(t, 64, cache.Size())
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// HybridLogicalClock is a TimeSource combining the physical clock with a logical counter,
+	// timestamps it serves are strictly increasing and never earlier than any timestamp it has
+	// served or observed, so ordering survives physical clock regressions and skew across hosts.
+	// The logical counter is carried in the low order nanoseconds: when the physical clock lags
+	// behind, the clock ticks forward one nanosecond per reading.
+	HybridLogicalClock struct {
+		physical TimeSource
+		maxDrift time.Duration
+		last     int64 // unix nano, accessed atomically
+	}
+)
+
+var _ TimeSource = (*HybridLogicalClock)(nil)
+
+// NewHybridLogicalClock returns a hybrid logical clock on top of the physical time source,
+// observed timestamps further than maxDrift ahead of the physical clock are rejected
+func NewHybridLogicalClock(physical TimeSource, maxDrift time.Duration) *HybridLogicalClock {
+	return &HybridLogicalClock{
+		physical: physical,
+		maxDrift: maxDrift,
+	}
+}
+
+// Now returns a timestamp strictly greater than any timestamp previously served or observed
+func (c *HybridLogicalClock) Now() time.Time {
+	for {
+		last := atomic.LoadInt64(&c.last)
+		next := c.physical.Now().UnixNano()
+		if next <= last {
+			next = last + 1
+		}
+		if atomic.CompareAndSwapInt64(&c.last, last, next) {
+			return time.Unix(0, next)
+		}
+	}
+}
+
+// Update merges a timestamp observed from another host into the clock,
+// returns false if the timestamp is rejected for drifting too far ahead of the physical clock
+func (c *HybridLogicalClock) Update(observed time.Time) bool {
+	if observed.Sub(c.physical.Now()) > c.maxDrift {
+		return false
+	}
+	c.advance(observed)
+	return true
+}
+
+// Seed merges a timestamp persisted by a previous owner of the clock, e.g. on shard acquire,
+// it is never rejected since serving timestamps earlier than it would break ordering
+func (c *HybridLogicalClock) Seed(persisted time.Time) {
+	if persisted.IsZero() {
+		return
+	}
+	c.advance(persisted)
+}
+
+func (c *HybridLogicalClock) advance(observed time.Time) {
+	for {
+		last := atomic.LoadInt64(&c.last)
+		if observed.UnixNano() <= last || atomic.CompareAndSwapInt64(&c.last, last, observed.UnixNano()) {
+			return
+		}
+	}
+}
+
+// Last returns the latest timestamp served or observed, zero time if there is none
+func (c *HybridLogicalClock) Last() time.Time {
+	last := atomic.LoadInt64(&c.last)
+	if last == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, last)
+}
+
+// Skew returns how far the clock runs ahead of the physical clock
+func (c *HybridLogicalClock) Skew() time.Duration {
+	skew := atomic.LoadInt64(&c.last) - c.physical.Now().UnixNano()
+	if skew < 0 {
+		return 0
+	}
+	return time.Duration(skew)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	hybridLogicalClockSuite struct {
+		suite.Suite
+		physical *EventTimeSource
+		clock    *HybridLogicalClock
+	}
+)
+
+func TestHybridLogicalClockSuite(t *testing.T) {
+	suite.Run(t, new(hybridLogicalClockSuite))
+}
+
+func (s *hybridLogicalClockSuite) SetupTest() {
+	s.physical = NewEventTimeSource().Update(time.Unix(0, 1000))
+	s.clock = NewHybridLogicalClock(s.physical, time.Minute)
+}
+
+func (s *hybridLogicalClockSuite) TestNow() {
+	s.True(s.clock.Last().IsZero())
+	s.Equal(int64(1000), s.clock.Now().UnixNano())
+	// physical clock does not move, logical counter ticks
+	s.Equal(int64(1001), s.clock.Now().UnixNano())
+
+	// physical clock regresses
+	s.physical.Update(time.Unix(0, 500))
+	s.Equal(int64(1002), s.clock.Now().UnixNano())
+	s.Equal(time.Duration(502), s.clock.Skew())
+
+	// physical clock catches up
+	s.physical.Update(time.Unix(0, 2000))
+	s.Equal(int64(2000), s.clock.Now().UnixNano())
+	s.Equal(time.Duration(0), s.clock.Skew())
+}
+
+func (s *hybridLogicalClockSuite) TestUpdate() {
+	observed := time.Unix(0, 1000).Add(time.Second)
+	s.True(s.clock.Update(observed))
+	s.Equal(observed, s.clock.Last())
+	s.Equal(observed.UnixNano()+1, s.clock.Now().UnixNano())
+
+	// earlier timestamps do not move the clock
+	s.True(s.clock.Update(time.Unix(0, 1000)))
+	s.Equal(observed.UnixNano()+1, s.clock.Last().UnixNano())
+
+	// timestamps drifting too far ahead are rejected
+	s.False(s.clock.Update(time.Unix(0, 1000).Add(time.Hour)))
+	s.Equal(observed.UnixNano()+1, s.clock.Last().UnixNano())
+}
+
+func (s *hybridLogicalClockSuite) TestSeed() {
+	s.clock.Seed(time.Time{})
+	s.True(s.clock.Last().IsZero())
+
+	// persisted timestamps are never rejected, however far ahead
+	persisted := time.Unix(0, 1000).Add(time.Hour)
+	s.clock.Seed(persisted)
+	s.Equal(persisted, s.clock.Last())
+	s.Equal(persisted.UnixNano()+1, s.clock.Now().UnixNano())
+	s.Equal(time.Hour+1, s.clock.Skew())
+
+	// earlier timestamps do not move the clock
+	s.clock.Seed(time.Unix(0, 1000))
+	s.Equal(persisted.UnixNano()+1, s.clock.Last().UnixNano())
+}
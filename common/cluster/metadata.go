@@ -23,6 +23,7 @@ package cluster
 import (
 	"fmt"
 
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/service/config"
@@ -202,6 +203,19 @@ func (metadata *metadataImpl) ClusterNameForFailoverVersion(failoverVersion int6
 	return clusterName
 }
 
+// SetActiveClusterAddress fills in the public frontend address of the active cluster
+// of the domain not active error, if one is configured for that cluster
+func SetActiveClusterAddress(err *shared.DomainNotActiveError, metadata Metadata) {
+	if err.ActiveClusterAddress != nil {
+		return
+	}
+	clusterInfo, ok := metadata.GetAllClusterInfo()[err.GetActiveCluster()]
+	if !ok || clusterInfo.PublicRPCAddress == "" {
+		return
+	}
+	err.ActiveClusterAddress = common.StringPtr(clusterInfo.PublicRPCAddress)
+}
+
 func (metadata *metadataImpl) GetReplicationConsumerConfig() *config.ReplicationConsumerConfig {
 	if metadata.replicationConsumer == nil {
 		return &config.ReplicationConsumerConfig{Type: config.ReplicationConsumerTypeKafka}
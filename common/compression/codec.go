@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang/snappy"
+)
+
+const (
+	// AcceptEncodingHeaderName is the request header listing the compression codecs accepted by the caller,
+	// separated by comma, in the order of preference
+	AcceptEncodingHeaderName = "cadence-accept-encoding"
+	// ContentEncodingHeaderName is the response header carrying the compression codec used for the response body
+	ContentEncodingHeaderName = "cadence-content-encoding"
+
+	// CodecGzip is the gzip compression codec
+	CodecGzip = "gzip"
+	// CodecSnappy is the snappy compression codec
+	CodecSnappy = "snappy"
+
+	// DefaultMinResponseSize is the default min size in bytes of the response body to be compressed
+	DefaultMinResponseSize = 4 * 1024
+)
+
+// IsSupportedCodec returns true if the given compression codec is supported
+func IsSupportedCodec(codec string) bool {
+	return codec == CodecGzip || codec == CodecSnappy
+}
+
+// NegotiateCodec returns the first supported codec from the value of AcceptEncodingHeaderName,
+// empty string is returned if none of the accepted codecs is supported
+func NegotiateCodec(acceptEncoding string) string {
+	for _, codec := range strings.Split(acceptEncoding, ",") {
+		codec = strings.ToLower(strings.TrimSpace(codec))
+		if IsSupportedCodec(codec) {
+			return codec
+		}
+	}
+	return ""
+}
+
+// Compress compresses the data using the given codec
+func Compress(codec string, data []byte) ([]byte, error) {
+	switch codec {
+	case CodecGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CodecSnappy:
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec: %v", codec)
+	}
+}
+
+// Decompress decompresses the data using the given codec
+func Decompress(codec string, data []byte) ([]byte, error) {
+	switch codec {
+	case CodecGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	case CodecSnappy:
+		return snappy.Decode(nil, data)
+	default:
+		return nil, fmt.Errorf("unsupported compression codec: %v", codec)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	codecSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestCodecSuite(t *testing.T) {
+	s := new(codecSuite)
+	suite.Run(t, s)
+}
+
+func (s *codecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *codecSuite) TestNegotiateCodec() {
+	s.Equal("", NegotiateCodec(""))
+	s.Equal("", NegotiateCodec("br, deflate"))
+	s.Equal(CodecGzip, NegotiateCodec("gzip"))
+	s.Equal(CodecSnappy, NegotiateCodec("br, Snappy, gzip"))
+}
+
+func (s *codecSuite) TestCompressDecompress() {
+	data := bytes.Repeat([]byte("cadence-history-event"), 100)
+	for _, codec := range []string{CodecGzip, CodecSnappy} {
+		compressed, err := Compress(codec, data)
+		s.NoError(err)
+		s.True(len(compressed) < len(data))
+
+		decompressed, err := Decompress(codec, compressed)
+		s.NoError(err)
+		s.Equal(data, decompressed)
+	}
+
+	_, err := Compress("br", data)
+	s.Error(err)
+	_, err = Decompress("br", data)
+	s.Error(err)
+}
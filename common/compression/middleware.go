@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compression
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	// InboundMiddleware compresses the response body of unary calls, if the caller accepts
+	// a supported compression codec and the response body is not smaller than the min response size
+	InboundMiddleware struct {
+		minResponseSize int
+	}
+
+	// OutboundMiddleware requests compressed response for unary calls, and decompresses the response body
+	OutboundMiddleware struct {
+		acceptEncoding string
+	}
+
+	bufferedResponseWriter struct {
+		headers          transport.Headers
+		applicationError bool
+		body             bytes.Buffer
+	}
+)
+
+// NewInboundMiddleware creates a new inbound middleware which compresses response body
+// not smaller than the min response size, in bytes
+func NewInboundMiddleware(minResponseSize int) *InboundMiddleware {
+	return &InboundMiddleware{
+		minResponseSize: minResponseSize,
+	}
+}
+
+// NewOutboundMiddleware creates a new outbound middleware which requests compressed response
+// using the given codec
+func NewOutboundMiddleware(codec string) *OutboundMiddleware {
+	return &OutboundMiddleware{
+		acceptEncoding: codec,
+	}
+}
+
+// Handle implements middleware.UnaryInbound
+func (m *InboundMiddleware) Handle(
+	ctx context.Context,
+	req *transport.Request,
+	resw transport.ResponseWriter,
+	h transport.UnaryHandler,
+) error {
+
+	acceptEncoding, _ := req.Headers.Get(AcceptEncodingHeaderName)
+	codec := NegotiateCodec(acceptEncoding)
+	if codec == "" {
+		return h.Handle(ctx, req, resw)
+	}
+
+	writer := &bufferedResponseWriter{}
+	err := h.Handle(ctx, req, writer)
+	body := writer.body.Bytes()
+	if err == nil && len(body) >= m.minResponseSize {
+		compressed, compressErr := Compress(codec, body)
+		if compressErr == nil && len(compressed) < len(body) {
+			writer.AddHeaders(transport.NewHeaders().With(ContentEncodingHeaderName, codec))
+			body = compressed
+		}
+	}
+
+	resw.AddHeaders(writer.headers)
+	if writer.applicationError {
+		resw.SetApplicationError()
+	}
+	if _, writeErr := resw.Write(body); writeErr != nil && err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+// Call implements middleware.UnaryOutbound
+func (m *OutboundMiddleware) Call(
+	ctx context.Context,
+	req *transport.Request,
+	out transport.UnaryOutbound,
+) (*transport.Response, error) {
+
+	req.Headers = req.Headers.With(AcceptEncodingHeaderName, m.acceptEncoding)
+	resp, err := out.Call(ctx, req)
+	if resp == nil || resp.Body == nil {
+		return resp, err
+	}
+	codec, ok := resp.Headers.Get(ContentEncodingHeaderName)
+	if !ok {
+		return resp, err
+	}
+
+	compressed, readErr := ioutil.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); readErr == nil {
+		readErr = closeErr
+	}
+	if readErr != nil {
+		return nil, readErr
+	}
+	body, decompressErr := Decompress(codec, compressed)
+	if decompressErr != nil {
+		return nil, decompressErr
+	}
+	resp.Headers.Del(ContentEncodingHeaderName)
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, err
+}
+
+func (w *bufferedResponseWriter) Write(p []byte) (int, error) {
+	return w.body.Write(p)
+}
+
+func (w *bufferedResponseWriter) AddHeaders(headers transport.Headers) {
+	for k, v := range headers.OriginalItems() {
+		w.headers = w.headers.With(k, v)
+	}
+}
+
+func (w *bufferedResponseWriter) SetApplicationError() {
+	w.applicationError = true
+}
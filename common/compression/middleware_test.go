@@ -0,0 +1,170 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compression
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	middlewareSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	testResponseWriter struct {
+		headers          transport.Headers
+		applicationError bool
+		body             bytes.Buffer
+	}
+
+	testHandlerFunc func(context.Context, *transport.Request, transport.ResponseWriter) error
+
+	testOutbound struct {
+		transport.UnaryOutbound
+		inbound *InboundMiddleware
+		handler transport.UnaryHandler
+		request *transport.Request
+	}
+)
+
+func TestMiddlewareSuite(t *testing.T) {
+	s := new(middlewareSuite)
+	suite.Run(t, s)
+}
+
+func (s *middlewareSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *middlewareSuite) TestInbound_NotAccepted() {
+	body := bytes.Repeat([]byte("a"), 1024)
+	resw := &testResponseWriter{}
+	err := NewInboundMiddleware(16).Handle(context.Background(), &transport.Request{}, resw, newTestHandler(body, false))
+	s.NoError(err)
+	s.Equal(body, resw.body.Bytes())
+	_, ok := resw.headers.Get(ContentEncodingHeaderName)
+	s.False(ok)
+}
+
+func (s *middlewareSuite) TestInbound_BelowMinResponseSize() {
+	body := bytes.Repeat([]byte("a"), 1024)
+	req := &transport.Request{Headers: transport.NewHeaders().With(AcceptEncodingHeaderName, CodecGzip)}
+	resw := &testResponseWriter{}
+	err := NewInboundMiddleware(2048).Handle(context.Background(), req, resw, newTestHandler(body, true))
+	s.NoError(err)
+	s.Equal(body, resw.body.Bytes())
+	s.True(resw.applicationError)
+	_, ok := resw.headers.Get(ContentEncodingHeaderName)
+	s.False(ok)
+	value, ok := resw.headers.Get("test-header")
+	s.True(ok)
+	s.Equal("test-value", value)
+}
+
+func (s *middlewareSuite) TestInbound_Compressed() {
+	body := bytes.Repeat([]byte("a"), 1024)
+	req := &transport.Request{Headers: transport.NewHeaders().With(AcceptEncodingHeaderName, CodecSnappy)}
+	resw := &testResponseWriter{}
+	err := NewInboundMiddleware(16).Handle(context.Background(), req, resw, newTestHandler(body, false))
+	s.NoError(err)
+	codec, ok := resw.headers.Get(ContentEncodingHeaderName)
+	s.True(ok)
+	s.Equal(CodecSnappy, codec)
+	s.True(resw.body.Len() < len(body))
+	decompressed, err := Decompress(CodecSnappy, resw.body.Bytes())
+	s.NoError(err)
+	s.Equal(body, decompressed)
+}
+
+func (s *middlewareSuite) TestRoundTrip() {
+	body := bytes.Repeat([]byte("a"), 1024)
+	out := &testOutbound{
+		inbound: NewInboundMiddleware(16),
+		handler: newTestHandler(body, true),
+	}
+	req := &transport.Request{Headers: transport.NewHeaders()}
+	resp, err := NewOutboundMiddleware(CodecGzip).Call(context.Background(), req, out)
+	s.NoError(err)
+
+	acceptEncoding, ok := out.request.Headers.Get(AcceptEncodingHeaderName)
+	s.True(ok)
+	s.Equal(CodecGzip, acceptEncoding)
+
+	s.True(resp.ApplicationError)
+	_, ok = resp.Headers.Get(ContentEncodingHeaderName)
+	s.False(ok)
+	value, ok := resp.Headers.Get("test-header")
+	s.True(ok)
+	s.Equal("test-value", value)
+	respBody, err := ioutil.ReadAll(resp.Body)
+	s.NoError(err)
+	s.Equal(body, respBody)
+}
+
+func newTestHandler(body []byte, applicationError bool) transport.UnaryHandler {
+	return testHandlerFunc(func(ctx context.Context, req *transport.Request, resw transport.ResponseWriter) error {
+		resw.AddHeaders(transport.NewHeaders().With("test-header", "test-value"))
+		if applicationError {
+			resw.SetApplicationError()
+		}
+		_, err := resw.Write(body)
+		return err
+	})
+}
+
+func (f testHandlerFunc) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter) error {
+	return f(ctx, req, resw)
+}
+
+func (w *testResponseWriter) Write(p []byte) (int, error) {
+	return w.body.Write(p)
+}
+
+func (w *testResponseWriter) AddHeaders(headers transport.Headers) {
+	for k, v := range headers.OriginalItems() {
+		w.headers = w.headers.With(k, v)
+	}
+}
+
+func (w *testResponseWriter) SetApplicationError() {
+	w.applicationError = true
+}
+
+func (o *testOutbound) Call(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+	o.request = req
+	resw := &testResponseWriter{}
+	if err := o.inbound.Handle(ctx, req, resw, o.handler); err != nil {
+		return nil, err
+	}
+	return &transport.Response{
+		Headers:          resw.headers,
+		Body:             ioutil.NopCloser(&resw.body),
+		ApplicationError: resw.applicationError,
+	}, nil
+}
@@ -60,6 +60,7 @@ const (
 const (
 	EncodingTypeJSON     EncodingType = "json"
 	EncodingTypeThriftRW EncodingType = "thriftrw"
+	EncodingTypeProto    EncodingType = "proto"
 	EncodingTypeGob      EncodingType = "gob"
 	EncodingTypeUnknown  EncodingType = "unknow"
 	EncodingTypeEmpty    EncodingType = ""
@@ -136,3 +137,15 @@ const (
 	// AdvancedVisibilityWritingModeDual means write to both normal visibility and advanced visibility store
 	AdvancedVisibilityWritingModeDual = "dual"
 )
+
+// Built-in query types answered by the server directly from mutable state, without a worker round trip
+const (
+	// QueryTypeQueryTypes returns the query handler names reported by the worker on decision completion
+	QueryTypeQueryTypes = "__query_types"
+	// QueryTypeOpenSessions returns the sessions which are currently open, i.e. pending session creation activities
+	QueryTypeOpenSessions = "__open_sessions"
+	// QueryTypeStartTime returns the workflow start time
+	QueryTypeStartTime = "__start_time"
+	// QueryTypePendingActivities returns the summary of pending activities
+	QueryTypePendingActivities = "__pending_activities"
+)
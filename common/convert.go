@@ -148,6 +148,11 @@ func ArchivalStatusPtr(t s.ArchivalStatus) *s.ArchivalStatus {
 	return &t
 }
 
+// DomainFailoverStatePtr makes a copy and returns the pointer to a DomainFailoverState.
+func DomainFailoverStatePtr(t s.DomainFailoverState) *s.DomainFailoverState {
+	return &t
+}
+
 // ClientArchivalStatusPtr makes a copy and returns the pointer to a client ArchivalStatus.
 func ClientArchivalStatusPtr(t shared.ArchivalStatus) *shared.ArchivalStatus {
 	return &t
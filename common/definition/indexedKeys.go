@@ -46,7 +46,9 @@ const (
 
 // valid non-indexed fields on ES
 const (
-	Memo = "Memo"
+	Memo                    = "Memo"
+	HistoryArchivalInfo     = "HistoryArchivalInfo"
+	HistoryArchivalEncoding = "HistoryArchivalEncoding"
 )
 
 // Attr is prefix of custom search attributes
@@ -57,6 +57,11 @@ func (d *AttrValidatorImpl) validateDomainConfig(config *persistence.DomainConfi
 	if config.VisibilityArchivalStatus == shared.ArchivalStatusEnabled && len(config.VisibilityArchivalURI) == 0 {
 		return errInvalidArchivalConfig
 	}
+	for _, taskList := range config.KnownTaskLists {
+		if len(taskList) == 0 {
+			return errInvalidKnownTaskList
+		}
+	}
 	return nil
 }
 
@@ -114,6 +119,23 @@ func (d *AttrValidatorImpl) validateDomainReplicationConfigForGlobalDomain(
 		return errActiveClusterNotInClusters
 	}
 
+	if replicationConfig.FailoverState == shared.DomainFailoverStateHandover {
+		pendingCluster := replicationConfig.PendingActiveClusterName
+		if err := d.validateClusterName(pendingCluster); err != nil {
+			return err
+		}
+		pendingClusterInClusters := false
+		for _, clusterConfig := range clusters {
+			if clusterConfig.ClusterName == pendingCluster {
+				pendingClusterInClusters = true
+				break
+			}
+		}
+		if !pendingClusterInClusters {
+			return errPendingActiveClusterNotInClusters
+		}
+	}
+
 	return nil
 }
 
@@ -32,6 +32,20 @@ var (
 	errActiveClusterNotInClusters      = &workflow.BadRequestError{Message: "Active cluster is not contained in all clusters."}
 	errCannotDoDomainFailoverAndUpdate = &workflow.BadRequestError{Message: "Cannot set active cluster to current cluster when other parameters are set."}
 
+	errGracefulFailoverOnLocalDomain        = &workflow.BadRequestError{Message: "Cannot do graceful failover on a local domain."}
+	errGracefulFailoverNotFromActiveCluster = &workflow.BadRequestError{Message: "Graceful failover can only be started from the active cluster of the domain."}
+	errGracefulFailoverToActiveCluster      = &workflow.BadRequestError{Message: "Cannot do graceful failover to the current active cluster."}
+	errPendingActiveClusterNotInClusters    = &workflow.BadRequestError{Message: "Pending active cluster is not contained in all clusters."}
+	errGracefulFailoverWithoutActiveCluster = &workflow.BadRequestError{Message: "Graceful failover requires the target active cluster to be set."}
+	errPromoteGlobalDomain                  = &workflow.BadRequestError{Message: "Domain is already a global domain."}
+	errPromoteGlobalDomainNotEnabled        = &workflow.BadRequestError{Message: "Cannot promote domain to global domain when global domain is not enabled."}
+	errDemoteLocalDomain                    = &workflow.BadRequestError{Message: "Domain is already a local domain."}
+	errDemoteNotFromActiveCluster           = &workflow.BadRequestError{Message: "Domain can only be converted into a local domain from its active cluster."}
+	errDemoteWithReplicationConfig          = &workflow.BadRequestError{Message: "Cannot update the replication configuration while converting the domain into a local domain."}
+
 	errInvalidRetentionPeriod = &workflow.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &workflow.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+	errInvalidKnownTaskList   = &workflow.BadRequestError{Message: "Known task list names cannot be empty."}
+
+	errDomainNotDeprecated = &workflow.BadRequestError{Message: "Domain is not deprecated."}
 )
@@ -30,6 +30,7 @@ import (
 
 	"github.com/pborman/uuid"
 
+	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -49,6 +50,10 @@ type (
 			ctx context.Context,
 			deprecateRequest *shared.DeprecateDomainRequest,
 		) error
+		UndeprecateDomain(
+			ctx context.Context,
+			undeprecateRequest *admin.UndeprecateDomainRequest,
+		) error
 		DescribeDomain(
 			ctx context.Context,
 			describeRequest *shared.DescribeDomainRequest,
@@ -329,8 +334,9 @@ func (d *HandlerImpl) DescribeDomain(
 	}
 
 	response := &shared.DescribeDomainResponse{
-		IsGlobalDomain:  common.BoolPtr(resp.IsGlobalDomain),
-		FailoverVersion: common.Int64Ptr(resp.FailoverVersion),
+		IsGlobalDomain:          common.BoolPtr(resp.IsGlobalDomain),
+		FailoverVersion:         common.Int64Ptr(resp.FailoverVersion),
+		PreviousFailoverVersion: common.Int64Ptr(resp.ReplicationConfig.PreviousFailoverVersion),
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(ctx, resp.Info, resp.Config, resp.ReplicationConfig)
 	return response, nil
@@ -406,6 +412,10 @@ func (d *HandlerImpl) UpdateDomain(
 	activeClusterChanged := false
 	// whether anything other than active cluster is changed
 	configurationChanged := false
+	// whether a graceful failover is started, i.e. domain moves into handover state
+	handoverStarted := false
+	// whether a local domain is converted into a global domain
+	promoted := false
 
 	if updateRequest.UpdatedInfo != nil {
 		updatedInfo := updateRequest.UpdatedInfo
@@ -453,6 +463,11 @@ func (d *HandlerImpl) UpdateDomain(
 				}
 			}
 		}
+		if updatedConfig.KnownTaskLists != nil {
+			configurationChanged = true
+			// the declared set is replaced as a whole, an empty list clears it
+			config.KnownTaskLists = d.dedupTaskLists(updatedConfig.KnownTaskLists)
+		}
 	}
 
 	if updateRequest.DeleteBadBinary != nil {
@@ -467,6 +482,40 @@ func (d *HandlerImpl) UpdateDomain(
 		delete(config.BadBinaries.Binaries, binChecksum)
 	}
 
+	if updateRequest.GetPromoteToGlobalDomain() {
+		if isGlobalDomain {
+			return nil, errPromoteGlobalDomain
+		}
+		if !d.clusterMetadata.IsGlobalDomainEnabled() {
+			return nil, errPromoteGlobalDomainNotEnabled
+		}
+		// the domain is replicated from now on, it stays active in the cluster it was local to
+		promoted = true
+		configurationChanged = true
+		isGlobalDomain = true
+		failoverVersion = d.clusterMetadata.GetNextFailoverVersion(replicationConfig.ActiveClusterName, 0)
+	}
+
+	if updateRequest.GetDemoteToLocalDomain() {
+		if !isGlobalDomain {
+			return nil, errDemoteLocalDomain
+		}
+		if updateRequest.ReplicationConfiguration != nil || updateRequest.GetPromoteToGlobalDomain() {
+			return nil, errDemoteWithReplicationConfig
+		}
+		if replicationConfig.ActiveClusterName != d.clusterMetadata.GetCurrentClusterName() ||
+			replicationConfig.FailoverState == shared.DomainFailoverStateHandover {
+			return nil, errDemoteNotFromActiveCluster
+		}
+		// the domain stops being replicated, the other clusters keep their copy of it with this cluster
+		// as the active cluster, so the requests they receive for the domain are still forwarded here
+		configurationChanged = true
+		isGlobalDomain = false
+		replicationConfig.Clusters = []*persistence.ClusterReplicationConfig{
+			{ClusterName: replicationConfig.ActiveClusterName},
+		}
+	}
+
 	if updateRequest.ReplicationConfiguration != nil {
 		updateReplicationConfig := updateRequest.ReplicationConfiguration
 		if len(updateReplicationConfig.Clusters) != 0 {
@@ -478,20 +527,51 @@ func (d *HandlerImpl) UpdateDomain(
 				})
 			}
 
-			if err := d.domainAttrValidator.validateDomainReplicationConfigClustersDoesNotChange(
-				replicationConfig.Clusters,
-				clustersNew,
-			); err != nil {
-				return nil, err
+			// clusters can only be added while the domain is promoted to a global domain
+			if !promoted {
+				if err := d.domainAttrValidator.validateDomainReplicationConfigClustersDoesNotChange(
+					replicationConfig.Clusters,
+					clustersNew,
+				); err != nil {
+					return nil, err
+				}
 			}
 			replicationConfig.Clusters = clustersNew
 		}
 
 		if updateReplicationConfig.ActiveClusterName != nil {
-			activeClusterChanged = true
-			replicationConfig.ActiveClusterName = updateReplicationConfig.GetActiveClusterName()
+			targetActiveCluster := updateReplicationConfig.GetActiveClusterName()
+			if updateRequest.GetGracefulFailover() {
+				// graceful failover only moves the domain into handover, the active cluster keeps
+				// rejecting writes until replication drains and the handover processor finishes the failover
+				if !isGlobalDomain {
+					return nil, errGracefulFailoverOnLocalDomain
+				}
+				if replicationConfig.ActiveClusterName != d.clusterMetadata.GetCurrentClusterName() {
+					return nil, errGracefulFailoverNotFromActiveCluster
+				}
+				if replicationConfig.ActiveClusterName == targetActiveCluster {
+					return nil, errGracefulFailoverToActiveCluster
+				}
+				handoverStarted = true
+				replicationConfig.FailoverState = shared.DomainFailoverStateHandover
+				replicationConfig.PendingActiveClusterName = targetActiveCluster
+			} else {
+				activeClusterChanged = true
+				if replicationConfig.FailoverState == shared.DomainFailoverStateHandover &&
+					replicationConfig.PendingActiveClusterName == targetActiveCluster {
+					replicationConfig.FailoverState = shared.DomainFailoverStateSwitched
+				} else {
+					replicationConfig.FailoverState = shared.DomainFailoverStateActive
+				}
+				replicationConfig.PendingActiveClusterName = ""
+				replicationConfig.ActiveClusterName = targetActiveCluster
+			}
 		}
 	}
+	if updateRequest.GetGracefulFailover() && !handoverStarted {
+		return nil, errGracefulFailoverWithoutActiveCluster
+	}
 
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return nil, err
@@ -510,9 +590,9 @@ func (d *HandlerImpl) UpdateDomain(
 		}
 	}
 
-	if configurationChanged && activeClusterChanged && isGlobalDomain {
+	if configurationChanged && (activeClusterChanged || handoverStarted) && isGlobalDomain {
 		return nil, errCannotDoDomainFailoverAndUpdate
-	} else if configurationChanged || activeClusterChanged {
+	} else if configurationChanged || activeClusterChanged || handoverStarted {
 		if configurationChanged && isGlobalDomain && !d.clusterMetadata.IsMasterCluster() {
 			return nil, errNotMasterCluster
 		}
@@ -522,6 +602,7 @@ func (d *HandlerImpl) UpdateDomain(
 			configVersion++
 		}
 		if activeClusterChanged && isGlobalDomain {
+			replicationConfig.PreviousFailoverVersion = failoverVersion
 			failoverVersion = d.clusterMetadata.GetNextFailoverVersion(
 				replicationConfig.ActiveClusterName,
 				failoverVersion,
@@ -533,6 +614,7 @@ func (d *HandlerImpl) UpdateDomain(
 			Info:                        info,
 			Config:                      config,
 			ReplicationConfig:           replicationConfig,
+			IsGlobalDomain:              isGlobalDomain,
 			ConfigVersion:               configVersion,
 			FailoverVersion:             failoverVersion,
 			FailoverNotificationVersion: failoverNotificationVersion,
@@ -579,12 +661,29 @@ func (d *HandlerImpl) UpdateDomain(
 	return response, nil
 }
 
-// DeprecateDomain deprecates a domain
+// DeprecateDomain deprecates a domain, new workflow executions can no longer be started in it
 func (d *HandlerImpl) DeprecateDomain(
 	ctx context.Context,
 	deprecateRequest *shared.DeprecateDomainRequest,
 ) error {
 
+	return d.updateDomainStatus(deprecateRequest.GetName(), persistence.DomainStatusDeprecated)
+}
+
+// UndeprecateDomain reverts the deprecation of a domain
+func (d *HandlerImpl) UndeprecateDomain(
+	ctx context.Context,
+	undeprecateRequest *admin.UndeprecateDomainRequest,
+) error {
+
+	return d.updateDomainStatus(undeprecateRequest.GetName(), persistence.DomainStatusRegistered)
+}
+
+func (d *HandlerImpl) updateDomainStatus(
+	name string,
+	status int,
+) error {
+
 	clusterMetadata := d.clusterMetadata
 	// TODO remove the IsGlobalDomainEnabled check once cross DC is public
 	if clusterMetadata.IsGlobalDomainEnabled() && !clusterMetadata.IsMasterCluster() {
@@ -600,17 +699,21 @@ func (d *HandlerImpl) DeprecateDomain(
 		return err
 	}
 	notificationVersion := metadata.NotificationVersion
-	getResponse, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: deprecateRequest.GetName()})
+	getResponse, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: name})
 	if err != nil {
 		return err
 	}
+	if status == persistence.DomainStatusRegistered && getResponse.Info.Status != persistence.DomainStatusDeprecated {
+		return errDomainNotDeprecated
+	}
 
 	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
-	getResponse.Info.Status = persistence.DomainStatusDeprecated
+	getResponse.Info.Status = status
 	updateReq := &persistence.UpdateDomainRequest{
 		Info:              getResponse.Info,
 		Config:            getResponse.Config,
 		ReplicationConfig: getResponse.ReplicationConfig,
+		IsGlobalDomain:    getResponse.IsGlobalDomain,
 		ConfigVersion:     getResponse.ConfigVersion,
 		FailoverVersion:   getResponse.FailoverVersion,
 	}
@@ -630,6 +733,21 @@ func (d *HandlerImpl) DeprecateDomain(
 	if err != nil {
 		return err
 	}
+
+	if getResponse.IsGlobalDomain {
+		// the status is replicated along with the bumped config version
+		err = d.domainReplicator.HandleTransmissionTask(replicator.DomainOperationUpdate,
+			getResponse.Info, getResponse.Config, getResponse.ReplicationConfig,
+			getResponse.ConfigVersion, getResponse.FailoverVersion, getResponse.IsGlobalDomain)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.logger.Info("Update domain status succeeded",
+		tag.WorkflowDomainName(getResponse.Info.Name),
+		tag.WorkflowDomainID(getResponse.Info.ID),
+	)
 	return nil
 }
 
@@ -657,23 +775,47 @@ func (d *HandlerImpl) createResponse(
 		VisibilityArchivalStatus:               common.ArchivalStatusPtr(config.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  common.StringPtr(config.VisibilityArchivalURI),
 		BadBinaries:                            &config.BadBinaries,
+		KnownTaskLists:                         config.KnownTaskLists,
 	}
 
+	clusterInfo := d.clusterMetadata.GetAllClusterInfo()
 	clusters := []*shared.ClusterReplicationConfiguration{}
 	for _, cluster := range replicationConfig.Clusters {
-		clusters = append(clusters, &shared.ClusterReplicationConfiguration{
+		clusterConfig := &shared.ClusterReplicationConfiguration{
 			ClusterName: common.StringPtr(cluster.ClusterName),
-		})
+		}
+		if info, ok := clusterInfo[cluster.ClusterName]; ok {
+			clusterConfig.InitialFailoverVersion = common.Int64Ptr(info.InitialFailoverVersion)
+		}
+		clusters = append(clusters, clusterConfig)
 	}
 
 	replicationConfigResult := &shared.DomainReplicationConfiguration{
-		ActiveClusterName: common.StringPtr(replicationConfig.ActiveClusterName),
-		Clusters:          clusters,
+		ActiveClusterName:        common.StringPtr(replicationConfig.ActiveClusterName),
+		Clusters:                 clusters,
+		FailoverState:            common.DomainFailoverStatePtr(replicationConfig.FailoverState),
+		PendingActiveClusterName: common.StringPtr(replicationConfig.PendingActiveClusterName),
 	}
 
 	return infoResult, configResult, replicationConfigResult
 }
 
+func (d *HandlerImpl) dedupTaskLists(
+	taskLists []string,
+) []string {
+
+	seen := make(map[string]struct{}, len(taskLists))
+	result := make([]string, 0, len(taskLists))
+	for _, taskList := range taskLists {
+		if _, ok := seen[taskList]; ok {
+			continue
+		}
+		seen[taskList] = struct{}{}
+		result = append(result, taskList)
+	}
+	return result
+}
+
 func (d *HandlerImpl) mergeBadBinaries(
 	old map[string]*shared.BadBinaryInfo,
 	new map[string]*shared.BadBinaryInfo,
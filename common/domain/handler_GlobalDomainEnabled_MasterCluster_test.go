@@ -761,12 +761,13 @@ func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain
 	prevActiveClusterName := ""
 	nextActiveClusterName := s.ClusterMetadata.GetCurrentClusterName()
 	clusters := []*shared.ClusterReplicationConfiguration{}
-	for clusterName := range s.ClusterMetadata.GetAllClusterInfo() {
+	for clusterName, clusterInfo := range s.ClusterMetadata.GetAllClusterInfo() {
 		if clusterName != nextActiveClusterName {
 			prevActiveClusterName = clusterName
 		}
 		clusters = append(clusters, &shared.ClusterReplicationConfiguration{
-			ClusterName: common.StringPtr(clusterName),
+			ClusterName:            common.StringPtr(clusterName),
+			InitialFailoverVersion: common.Int64Ptr(clusterInfo.InitialFailoverVersion),
 		})
 	}
 	s.True(len(prevActiveClusterName) > 0)
@@ -846,6 +847,147 @@ func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain
 		getResp.GetIsGlobalDomain(),
 		getResp.GetFailoverVersion(),
 	)
+	s.Equal(s.ClusterMetadata.GetNextFailoverVersion(prevActiveClusterName, 0), getResp.GetPreviousFailoverVersion())
+}
+
+func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain_LocalDomain_PromoteToGlobal() {
+	domainName := s.getRandomDomainName()
+	currentClusterName := s.ClusterMetadata.GetCurrentClusterName()
+	clusters := []*shared.ClusterReplicationConfiguration{}
+	for clusterName := range s.ClusterMetadata.GetAllClusterInfo() {
+		clusters = append(clusters, &shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterName),
+		})
+	}
+	s.True(len(clusters) > 1)
+
+	err := s.handler.RegisterDomain(context.Background(), &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domainName),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(7),
+		ActiveClusterName:                      common.StringPtr(currentClusterName),
+		IsGlobalDomain:                         common.BoolPtr(false),
+	})
+	s.Nil(err)
+
+	// clusters cannot be added to a local domain without promoting it
+	_, err = s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			Clusters: clusters,
+		},
+	})
+	s.Error(err)
+
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Once()
+	updateResp, err := s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			Clusters: clusters,
+		},
+		PromoteToGlobalDomain: common.BoolPtr(true),
+	})
+	s.Nil(err)
+	s.True(updateResp.GetIsGlobalDomain())
+	s.Equal(s.ClusterMetadata.GetNextFailoverVersion(currentClusterName, 0), updateResp.GetFailoverVersion())
+
+	getResp, err := s.handler.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	})
+	s.Nil(err)
+	s.True(getResp.GetIsGlobalDomain())
+	s.Equal(currentClusterName, getResp.ReplicationConfiguration.GetActiveClusterName())
+	s.Equal(len(clusters), len(getResp.ReplicationConfiguration.Clusters))
+
+	_, err = s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name:                  common.StringPtr(domainName),
+		PromoteToGlobalDomain: common.BoolPtr(true),
+	})
+	s.Equal(errPromoteGlobalDomain, err)
+}
+
+func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain_GlobalDomain_DemoteToLocal() {
+	domainName := s.getRandomDomainName()
+	currentClusterName := s.ClusterMetadata.GetCurrentClusterName()
+	clusters := []*shared.ClusterReplicationConfiguration{}
+	for clusterName := range s.ClusterMetadata.GetAllClusterInfo() {
+		clusters = append(clusters, &shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterName),
+		})
+	}
+	s.True(len(clusters) > 1)
+
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Once()
+	err := s.handler.RegisterDomain(context.Background(), &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domainName),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(7),
+		Clusters:                               clusters,
+		ActiveClusterName:                      common.StringPtr(currentClusterName),
+		IsGlobalDomain:                         common.BoolPtr(true),
+	})
+	s.Nil(err)
+
+	// the replication configuration is decided by the demotion
+	_, err = s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			Clusters: clusters,
+		},
+		DemoteToLocalDomain: common.BoolPtr(true),
+	})
+	s.Equal(errDemoteWithReplicationConfig, err)
+
+	updateResp, err := s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name:                common.StringPtr(domainName),
+		DemoteToLocalDomain: common.BoolPtr(true),
+	})
+	s.Nil(err)
+	s.False(updateResp.GetIsGlobalDomain())
+
+	getResp, err := s.handler.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	})
+	s.Nil(err)
+	s.False(getResp.GetIsGlobalDomain())
+	s.Equal(currentClusterName, getResp.ReplicationConfiguration.GetActiveClusterName())
+	s.Equal(1, len(getResp.ReplicationConfiguration.Clusters))
+	s.Equal(currentClusterName, getResp.ReplicationConfiguration.Clusters[0].GetClusterName())
+
+	_, err = s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name:                common.StringPtr(domainName),
+		DemoteToLocalDomain: common.BoolPtr(true),
+	})
+	s.Equal(errDemoteLocalDomain, err)
+}
+
+func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateDomain_GlobalDomain_DemoteNotFromActiveCluster() {
+	domainName := s.getRandomDomainName()
+	clusters := []*shared.ClusterReplicationConfiguration{}
+	activeClusterName := ""
+	for clusterName := range s.ClusterMetadata.GetAllClusterInfo() {
+		clusters = append(clusters, &shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterName),
+		})
+		if clusterName != s.ClusterMetadata.GetCurrentClusterName() {
+			activeClusterName = clusterName
+		}
+	}
+	s.NotEmpty(activeClusterName)
+
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Once()
+	err := s.handler.RegisterDomain(context.Background(), &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domainName),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(7),
+		Clusters:                               clusters,
+		ActiveClusterName:                      common.StringPtr(activeClusterName),
+		IsGlobalDomain:                         common.BoolPtr(true),
+	})
+	s.Nil(err)
+
+	_, err = s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name:                common.StringPtr(domainName),
+		DemoteToLocalDomain: common.BoolPtr(true),
+	})
+	s.Equal(errDemoteNotFromActiveCluster, err)
 }
 
 func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) getRandomDomainName() string {
@@ -0,0 +1,46 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+const (
+	// MigrationTaskListName is the task list of the domain migration workflow
+	MigrationTaskListName = "cadence-sys-migration-tasklist"
+	// MigrationWFTypeName is the workflow type of the domain migration workflow
+	MigrationWFTypeName = "cadence-sys-migration-workflow"
+
+	migrationWorkflowIDPrefix = "cadence-sys-migration-"
+)
+
+// MigrationParams is the input of the domain migration workflow
+type MigrationParams struct {
+	// DomainName is the local domain to migrate
+	DomainName string
+	// SourceCluster is the cluster the domain is local to when the migration starts
+	SourceCluster string
+	// TargetCluster is the cluster the domain is local to when the migration completes
+	TargetCluster string
+}
+
+// MigrationWorkflowID returns the workflow ID of the migration workflow of a domain, so that
+// a domain is migrated by at most one workflow at a time
+func MigrationWorkflowID(domainName string) string {
+	return migrationWorkflowIDPrefix + domainName
+}
@@ -95,10 +95,13 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(domainOpera
 			VisibilityArchivalStatus:               common.ArchivalStatusPtr(config.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  common.StringPtr(config.VisibilityArchivalURI),
 			BadBinaries:                            &config.BadBinaries,
+			KnownTaskLists:                         config.KnownTaskLists,
 		},
 		ReplicationConfig: &shared.DomainReplicationConfiguration{
-			ActiveClusterName: common.StringPtr(replicationConfig.ActiveClusterName),
-			Clusters:          domainReplicator.convertClusterReplicationConfigToThrift(replicationConfig.Clusters),
+			ActiveClusterName:        common.StringPtr(replicationConfig.ActiveClusterName),
+			Clusters:                 domainReplicator.convertClusterReplicationConfigToThrift(replicationConfig.Clusters),
+			FailoverState:            common.DomainFailoverStatePtr(replicationConfig.FailoverState),
+			PendingActiveClusterName: common.StringPtr(replicationConfig.PendingActiveClusterName),
 		},
 		ConfigVersion:   common.Int64Ptr(configVersion),
 		FailoverVersion: common.Int64Ptr(failoverVersion),
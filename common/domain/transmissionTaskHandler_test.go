@@ -138,8 +138,10 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_RegisterDomainTask_Is
 				BadBinaries:                            &shared.BadBinaries{Binaries: map[string]*shared.BadBinaryInfo{}},
 			},
 			ReplicationConfig: &shared.DomainReplicationConfiguration{
-				ActiveClusterName: common.StringPtr(clusterActive),
-				Clusters:          s.domainReplicator.convertClusterReplicationConfigToThrift(clusters),
+				ActiveClusterName:        common.StringPtr(clusterActive),
+				Clusters:                 s.domainReplicator.convertClusterReplicationConfigToThrift(clusters),
+				FailoverState:            common.DomainFailoverStatePtr(shared.DomainFailoverStateActive),
+				PendingActiveClusterName: common.StringPtr(""),
 			},
 			ConfigVersion:   common.Int64Ptr(configVersion),
 			FailoverVersion: common.Int64Ptr(failoverVersion),
@@ -276,8 +278,10 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_UpdateDomainTask_IsGl
 				BadBinaries:                            &shared.BadBinaries{Binaries: map[string]*shared.BadBinaryInfo{}},
 			},
 			ReplicationConfig: &shared.DomainReplicationConfiguration{
-				ActiveClusterName: common.StringPtr(clusterActive),
-				Clusters:          s.domainReplicator.convertClusterReplicationConfigToThrift(clusters),
+				ActiveClusterName:        common.StringPtr(clusterActive),
+				Clusters:                 s.domainReplicator.convertClusterReplicationConfigToThrift(clusters),
+				FailoverState:            common.DomainFailoverStatePtr(shared.DomainFailoverStateActive),
+				PendingActiveClusterName: common.StringPtr(""),
 			},
 			ConfigVersion:   common.Int64Ptr(configVersion),
 			FailoverVersion: common.Int64Ptr(failoverVersion),
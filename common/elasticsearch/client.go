@@ -22,7 +22,9 @@ package elasticsearch
 
 import (
 	"context"
+	"fmt"
 	"github.com/olivere/elastic"
+	"strconv"
 	"time"
 )
 
@@ -39,6 +41,12 @@ type (
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (*elastic.BulkProcessor, error)
 		PutMapping(ctx context.Context, index, root, key, valueType string) error
 		CreateIndex(ctx context.Context, index string) error
+		CreateIndexWithAlias(ctx context.Context, index, alias string) error
+		DeleteIndex(ctx context.Context, index string) error
+		GetAliasIndices(ctx context.Context, alias string) ([]string, error)
+		IndexExists(ctx context.Context, index string) (bool, error)
+		GetIndexCreationTime(ctx context.Context, index string) (time.Time, error)
+		Reindex(ctx context.Context, source, dest string) error
 	}
 
 	// ScrollService is a interface for elastic.ScrollService
@@ -54,6 +62,8 @@ type (
 		PageSize    int
 		Sorter      []elastic.Sorter
 		SearchAfter []interface{}
+		// IgnoreUnavailable skips indices that do not exist, such as rolled over indices without any records
+		IgnoreUnavailable bool
 	}
 
 	// BulkProcessorParameters holds all required and optional parameters for executing bulk service
@@ -80,6 +90,8 @@ type (
 
 var _ Client = (*elasticWrapper)(nil)
 
+const indexCreationDateSetting = "index.creation_date"
+
 // NewClient create a ES client
 func NewClient(config *Config) (Client, error) {
 	client, err := elastic.NewClient(
@@ -112,6 +124,10 @@ func (c *elasticWrapper) Search(ctx context.Context, p *SearchParameters) (*elas
 		searchService.SearchAfter(p.SearchAfter...)
 	}
 
+	if p.IgnoreUnavailable {
+		searchService.IgnoreUnavailable(true).AllowNoIndices(true)
+	}
+
 	return searchService.Do(ctx)
 }
 
@@ -164,6 +180,85 @@ func (c *elasticWrapper) CreateIndex(ctx context.Context, index string) error {
 	return err
 }
 
+// CreateIndexWithAlias creates the index if it does not exist yet and makes sure it is part of the alias
+func (c *elasticWrapper) CreateIndexWithAlias(ctx context.Context, index, alias string) error {
+	body := map[string]interface{}{
+		"aliases": map[string]interface{}{
+			alias: map[string]interface{}{},
+		},
+	}
+	_, err := c.client.CreateIndex(index).BodyJson(body).Do(ctx)
+	if err == nil || !isResourceAlreadyExists(err) {
+		return err
+	}
+	_, err = c.client.Alias().Add(index, alias).Do(ctx)
+	return err
+}
+
+func (c *elasticWrapper) DeleteIndex(ctx context.Context, index string) error {
+	_, err := c.client.DeleteIndex(index).Do(ctx)
+	return err
+}
+
+func (c *elasticWrapper) GetAliasIndices(ctx context.Context, alias string) ([]string, error) {
+	result, err := c.client.Aliases().Alias(alias).Do(ctx)
+	if err != nil {
+		if elastic.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return result.IndicesByAlias(alias), nil
+}
+
+func (c *elasticWrapper) IndexExists(ctx context.Context, index string) (bool, error) {
+	return c.client.IndexExists(index).Do(ctx)
+}
+
+func (c *elasticWrapper) GetIndexCreationTime(ctx context.Context, index string) (time.Time, error) {
+	result, err := c.client.IndexGetSettings(index).FlatSettings(true).Name(indexCreationDateSetting).Do(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	settings, ok := result[index]
+	if !ok {
+		return time.Time{}, fmt.Errorf("missing settings of index %v", index)
+	}
+	creationDate, ok := settings.Settings[indexCreationDateSetting].(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("missing creation date of index %v", index)
+	}
+	millis, err := strconv.ParseInt(creationDate, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, millis*int64(time.Millisecond)), nil
+}
+
+// Reindex copies all documents of the source index to the dest index keeping their versions, documents
+// already in the dest index with the same or a newer version are left untouched
+func (c *elasticWrapper) Reindex(ctx context.Context, source, dest string) error {
+	result, err := c.client.Reindex().
+		SourceIndex(source).
+		Destination(elastic.NewReindexDestination().Index(dest).VersionType("external")).
+		ProceedOnVersionConflict().
+		Do(ctx)
+	if err != nil {
+		return err
+	}
+	if len(result.Failures) != 0 {
+		return fmt.Errorf("failed to reindex %v documents from %v to %v", len(result.Failures), source, dest)
+	}
+	return nil
+}
+
+func isResourceAlreadyExists(err error) bool {
+	if e, ok := err.(*elastic.Error); ok && e.Details != nil {
+		return e.Details.Type == "resource_already_exists_exception"
+	}
+	return false
+}
+
 func buildPutMappingBody(root, key, valueType string) map[string]interface{} {
 	body := make(map[string]interface{})
 	if len(root) != 0 {
@@ -23,6 +23,7 @@ package elasticsearch
 import (
 	"fmt"
 	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/.gen/go/shared"
 	"testing"
 )
 
@@ -47,3 +48,43 @@ func Test_BuildPutMappingBody(t *testing.T) {
 		require.Equal(t, test.expected, fmt.Sprintf("%v", buildPutMappingBody(test.root, k, v)))
 	}
 }
+
+func Test_ConvertIndexedValueTypeToESDataType(t *testing.T) {
+	tests := []struct {
+		input    shared.IndexedValueType
+		expected string
+	}{
+		{
+			input:    shared.IndexedValueTypeString,
+			expected: "text",
+		},
+		{
+			input:    shared.IndexedValueTypeKeyword,
+			expected: "keyword",
+		},
+		{
+			input:    shared.IndexedValueTypeInt,
+			expected: "long",
+		},
+		{
+			input:    shared.IndexedValueTypeDouble,
+			expected: "double",
+		},
+		{
+			input:    shared.IndexedValueTypeBool,
+			expected: "boolean",
+		},
+		{
+			input:    shared.IndexedValueTypeDatetime,
+			expected: "date",
+		},
+		{
+			input:    shared.IndexedValueType(-1),
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, ConvertIndexedValueTypeToESDataType(test.input))
+	}
+}
@@ -23,6 +23,7 @@ package elasticsearch
 import (
 	"github.com/uber/cadence/common"
 	"net/url"
+	"time"
 )
 
 // Config for connecting to ElasticSearch
@@ -30,6 +31,13 @@ type (
 	Config struct {
 		URL     url.URL           `yaml:url`
 		Indices map[string]string `yaml:indices`
+		// IndexRollover splits the visibility index into one index per time range, either "daily" or "weekly".
+		// The configured visibility index name becomes an alias over all rolled over indices.
+		IndexRollover string `yaml:"indexRollover"`
+		// IndexRolloverRetention is how long a rolled over index is kept after its time range ends.
+		// Records are deleted once their domain retention expires, dropping the index removes the
+		// records left behind, so it must cover the longest workflow execution plus the largest domain retention
+		IndexRolloverRetention time.Duration `yaml:"indexRolloverRetention"`
 	}
 )
 
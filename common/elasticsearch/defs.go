@@ -20,7 +20,10 @@
 
 package elasticsearch
 
-import "github.com/uber/cadence/.gen/go/indexer"
+import (
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/shared"
+)
 
 // All legal fields allowed in elastic search index
 const (
@@ -36,6 +39,9 @@ const (
 	Memo          = "Memo"
 	Encoding      = "Encoding"
 
+	HistoryArchivalInfo     = "HistoryArchivalInfo"
+	HistoryArchivalEncoding = "HistoryArchivalEncoding"
+
 	KafkaKey = "KafkaKey"
 )
 
@@ -46,3 +52,23 @@ var (
 	FieldTypeBool   = indexer.FieldTypeBool
 	FieldTypeBinary = indexer.FieldTypeBinary
 )
+
+// ConvertIndexedValueTypeToESDataType converts a search attribute type to the ElasticSearch mapping type
+func ConvertIndexedValueTypeToESDataType(valueType shared.IndexedValueType) string {
+	switch valueType {
+	case shared.IndexedValueTypeString:
+		return "text"
+	case shared.IndexedValueTypeKeyword:
+		return "keyword"
+	case shared.IndexedValueTypeInt:
+		return "long"
+	case shared.IndexedValueTypeDouble:
+		return "double"
+	case shared.IndexedValueTypeBool:
+		return "boolean"
+	case shared.IndexedValueTypeDatetime:
+		return "date"
+	default:
+		return ""
+	}
+}
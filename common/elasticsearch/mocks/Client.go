@@ -25,6 +25,7 @@ import context "context"
 import elastic "github.com/olivere/elastic"
 import elasticsearch "github.com/uber/cadence/common/elasticsearch"
 import mock "github.com/stretchr/testify/mock"
+import time "time"
 
 // Client is an autogenerated mock type for the Client type
 type Client struct {
@@ -212,3 +213,110 @@ func (_m *Client) SearchWithDSL(ctx context.Context, index string, query string)
 
 	return r0, r1
 }
+
+// CreateIndexWithAlias provides a mock function with given fields: ctx, index, alias
+func (_m *Client) CreateIndexWithAlias(ctx context.Context, index string, alias string) error {
+	ret := _m.Called(ctx, index, alias)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, index, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteIndex provides a mock function with given fields: ctx, index
+func (_m *Client) DeleteIndex(ctx context.Context, index string) error {
+	ret := _m.Called(ctx, index)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, index)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAliasIndices provides a mock function with given fields: ctx, alias
+func (_m *Client) GetAliasIndices(ctx context.Context, alias string) ([]string, error) {
+	ret := _m.Called(ctx, alias)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, alias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, alias)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IndexExists provides a mock function with given fields: ctx, index
+func (_m *Client) IndexExists(ctx context.Context, index string) (bool, error) {
+	ret := _m.Called(ctx, index)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, index)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, index)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIndexCreationTime provides a mock function with given fields: ctx, index
+func (_m *Client) GetIndexCreationTime(ctx context.Context, index string) (time.Time, error) {
+	ret := _m.Called(ctx, index)
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Time); ok {
+		r0 = rf(ctx, index)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, index)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reindex provides a mock function with given fields: ctx, source, dest
+func (_m *Client) Reindex(ctx context.Context, source string, dest string) error {
+	ret := _m.Called(ctx, source, dest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, source, dest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
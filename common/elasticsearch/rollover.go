@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"strings"
+	"time"
+)
+
+// Visibility records are routed to a rolled over index by workflow start time, which never changes
+// for a run, so a record stays in one index for its whole life and external versioning keeps working.
+const (
+	// IndexRolloverDaily rolls the visibility index over every day
+	IndexRolloverDaily = "daily"
+	// IndexRolloverWeekly rolls the visibility index over every week, weeks start on Monday
+	IndexRolloverWeekly = "weekly"
+
+	rolloverIndexDateLayout = "2006.01.02"
+	// the records of a visibility index created before rollover was enabled are moved to the legacy index
+	legacyIndexSuffix = "-legacy"
+	// wider time ranges are searched through the alias instead of listing every index
+	maxRolloverIndicesPerSearch = 64
+)
+
+// IsIndexRolloverEnabled returns whether the visibility index is split into per time range indices
+func (cfg *Config) IsIndexRolloverEnabled() bool {
+	return cfg.rolloverDays() > 0
+}
+
+// GetVisibilityIndexForStartTime returns the index holding the record of a workflow started at startTime
+func (cfg *Config) GetVisibilityIndexForStartTime(startTime time.Time) string {
+	if !cfg.IsIndexRolloverEnabled() {
+		return cfg.GetVisibilityIndex()
+	}
+	return cfg.rolloverIndexName(cfg.rolloverPeriodStart(startTime))
+}
+
+// GetLegacyVisibilityIndex returns the index behind the alias holding the records written before rollover was enabled
+func (cfg *Config) GetLegacyVisibilityIndex() string {
+	return cfg.GetVisibilityIndex() + legacyIndexSuffix
+}
+
+// GetVisibilityIndicesForStartTimeRange returns the comma separated indices holding the records of workflows
+// started within [earliest, latest], or the alias when the range spans too many indices.
+// The legacy index is always included, searches skip it once it is dropped.
+func (cfg *Config) GetVisibilityIndicesForStartTimeRange(earliest, latest time.Time) string {
+	alias := cfg.GetVisibilityIndex()
+	if !cfg.IsIndexRolloverEnabled() || latest.Before(earliest) {
+		return alias
+	}
+
+	last := cfg.rolloverPeriodStart(latest)
+	var indices []string
+	for start := cfg.rolloverPeriodStart(earliest); !start.After(last); start = start.AddDate(0, 0, cfg.rolloverDays()) {
+		if len(indices) == maxRolloverIndicesPerSearch {
+			return alias
+		}
+		indices = append(indices, cfg.rolloverIndexName(start))
+	}
+	indices = append(indices, cfg.GetLegacyVisibilityIndex())
+	return strings.Join(indices, ",")
+}
+
+// IsRolloverIndexExpired returns whether a rolled over index is past IndexRolloverRetention and can be dropped,
+// indices not following the rollover naming are never expired
+func (cfg *Config) IsRolloverIndexExpired(index string, now time.Time) bool {
+	if !cfg.IsIndexRolloverEnabled() || cfg.IndexRolloverRetention <= 0 {
+		return false
+	}
+	prefix := cfg.GetVisibilityIndex() + "-"
+	if !strings.HasPrefix(index, prefix) {
+		return false
+	}
+	start, err := time.Parse(rolloverIndexDateLayout, strings.TrimPrefix(index, prefix))
+	if err != nil {
+		return false
+	}
+	end := start.AddDate(0, 0, cfg.rolloverDays())
+	return now.Sub(end) > cfg.IndexRolloverRetention
+}
+
+func (cfg *Config) rolloverDays() int {
+	switch cfg.IndexRollover {
+	case IndexRolloverDaily:
+		return 1
+	case IndexRolloverWeekly:
+		return 7
+	default:
+		return 0
+	}
+}
+
+func (cfg *Config) rolloverPeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if cfg.IndexRollover == IndexRolloverWeekly {
+		daysSinceMonday := (int(start.Weekday()) + 6) % 7
+		start = start.AddDate(0, 0, -daysSinceMonday)
+	}
+	return start
+}
+
+func (cfg *Config) rolloverIndexName(periodStart time.Time) string {
+	return cfg.GetVisibilityIndex() + "-" + periodStart.Format(rolloverIndexDateLayout)
+}
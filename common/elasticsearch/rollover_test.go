@@ -0,0 +1,85 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common"
+)
+
+func newRolloverConfigForTest(rollover string) *Config {
+	return &Config{
+		Indices:                map[string]string{common.VisibilityAppName: "cadence-visibility"},
+		IndexRollover:          rollover,
+		IndexRolloverRetention: 30 * 24 * time.Hour,
+	}
+}
+
+func Test_GetVisibilityIndexForStartTime(t *testing.T) {
+	// Wednesday
+	startTime := time.Date(2019, 8, 21, 23, 30, 0, 0, time.UTC)
+
+	require.Equal(t, "cadence-visibility", newRolloverConfigForTest("").GetVisibilityIndexForStartTime(startTime))
+	require.Equal(t, "cadence-visibility-2019.08.21", newRolloverConfigForTest(IndexRolloverDaily).GetVisibilityIndexForStartTime(startTime))
+	require.Equal(t, "cadence-visibility-2019.08.19", newRolloverConfigForTest(IndexRolloverWeekly).GetVisibilityIndexForStartTime(startTime))
+	require.Equal(t, "cadence-visibility-2019.08.22",
+		newRolloverConfigForTest(IndexRolloverDaily).GetVisibilityIndexForStartTime(startTime.In(time.FixedZone("UTC-1", -3600)).Add(time.Hour)))
+}
+
+func Test_GetVisibilityIndicesForStartTimeRange(t *testing.T) {
+	earliest := time.Date(2019, 8, 20, 10, 0, 0, 0, time.UTC)
+	latest := time.Date(2019, 8, 22, 1, 0, 0, 0, time.UTC)
+
+	cfg := newRolloverConfigForTest(IndexRolloverDaily)
+	require.Equal(t, "cadence-visibility-2019.08.20,cadence-visibility-2019.08.21,cadence-visibility-2019.08.22,cadence-visibility-legacy",
+		cfg.GetVisibilityIndicesForStartTimeRange(earliest, latest))
+	require.Equal(t, "cadence-visibility", cfg.GetVisibilityIndicesForStartTimeRange(latest, earliest))
+	require.Equal(t, "cadence-visibility", cfg.GetVisibilityIndicesForStartTimeRange(time.Unix(0, 0), latest))
+
+	cfg = newRolloverConfigForTest(IndexRolloverWeekly)
+	require.Equal(t, "cadence-visibility-2019.08.19,cadence-visibility-legacy", cfg.GetVisibilityIndicesForStartTimeRange(earliest, latest))
+	require.Equal(t, "cadence-visibility-2019.08.12,cadence-visibility-2019.08.19,cadence-visibility-legacy",
+		cfg.GetVisibilityIndicesForStartTimeRange(earliest.AddDate(0, 0, -7), latest))
+
+	require.Equal(t, "cadence-visibility", newRolloverConfigForTest("").GetVisibilityIndicesForStartTimeRange(earliest, latest))
+}
+
+func Test_IsRolloverIndexExpired(t *testing.T) {
+	now := time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	cfg := newRolloverConfigForTest(IndexRolloverDaily)
+	require.True(t, cfg.IsRolloverIndexExpired("cadence-visibility-2019.08.29", now))
+	require.False(t, cfg.IsRolloverIndexExpired("cadence-visibility-2019.08.31", now))
+	require.False(t, cfg.IsRolloverIndexExpired("cadence-visibility", now))
+	require.False(t, cfg.IsRolloverIndexExpired("cadence-visibility-archive", now))
+	require.False(t, cfg.IsRolloverIndexExpired(cfg.GetLegacyVisibilityIndex(), now))
+	require.False(t, cfg.IsRolloverIndexExpired("other-2019.01.01", now))
+
+	cfg = newRolloverConfigForTest(IndexRolloverWeekly)
+	require.True(t, cfg.IsRolloverIndexExpired("cadence-visibility-2019.08.19", now))
+	require.False(t, cfg.IsRolloverIndexExpired("cadence-visibility-2019.08.26", now))
+
+	cfg.IndexRolloverRetention = 0
+	require.False(t, cfg.IsRolloverIndexExpired("cadence-visibility-2019.08.19", now))
+}
@@ -23,7 +23,9 @@ package validator
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -35,12 +37,49 @@ import (
 // VisibilityQueryValidator for sql query validation
 type VisibilityQueryValidator struct {
 	validSearchAttributes dynamicconfig.MapPropertyFn
+	maxBooleanClauses     dynamicconfig.IntPropertyFnWithDomainFilter
+	maxTimeRange          dynamicconfig.DurationPropertyFnWithDomainFilter
+	timeSource            func() time.Time
 }
 
-// NewQueryValidator create VisibilityQueryValidator
-func NewQueryValidator(validSearchAttributes dynamicconfig.MapPropertyFn) *VisibilityQueryValidator {
+// timeBounds is the tightest range of a time attribute implied by the conditions of a query
+type timeBounds struct {
+	lower    int64
+	upper    int64
+	hasLower bool
+	hasUpper bool
+}
+
+// allowedComparisonOperators are the operators which translate to cheap ElasticSearch term and range queries
+var allowedComparisonOperators = map[string]struct{}{
+	sqlparser.EqualStr:        {},
+	sqlparser.NotEqualStr:     {},
+	sqlparser.LessThanStr:     {},
+	sqlparser.LessEqualStr:    {},
+	sqlparser.GreaterThanStr:  {},
+	sqlparser.GreaterEqualStr: {},
+	sqlparser.InStr:           {},
+	sqlparser.NotInStr:        {},
+}
+
+// timeRangeKeys are the attributes whose queried time range is capped
+var timeRangeKeys = map[string]struct{}{
+	definition.StartTime:     {},
+	definition.CloseTime:     {},
+	definition.ExecutionTime: {},
+}
+
+// NewQueryValidator create VisibilityQueryValidator, a limit of 0 disables the corresponding check
+func NewQueryValidator(
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+	maxBooleanClauses dynamicconfig.IntPropertyFnWithDomainFilter,
+	maxTimeRange dynamicconfig.DurationPropertyFnWithDomainFilter,
+) *VisibilityQueryValidator {
 	return &VisibilityQueryValidator{
 		validSearchAttributes: validSearchAttributes,
+		maxBooleanClauses:     maxBooleanClauses,
+		maxTimeRange:          maxTimeRange,
+		timeSource:            time.Now,
 	}
 }
 
@@ -48,7 +87,7 @@ func NewQueryValidator(validSearchAttributes dynamicconfig.MapPropertyFn) *Visib
 // and add prefix for custom keys
 func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *workflow.ListWorkflowExecutionsRequest) error {
 	whereClause := listRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause)
+	newQuery, err := qv.validateListOrCountRequestForQuery(listRequest.GetDomain(), whereClause)
 	if err != nil {
 		return err
 	}
@@ -60,7 +99,7 @@ func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *wor
 // and add prefix for custom keys
 func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *workflow.CountWorkflowExecutionsRequest) error {
 	whereClause := countRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause)
+	newQuery, err := qv.validateListOrCountRequestForQuery(countRequest.GetDomain(), whereClause)
 	if err != nil {
 		return err
 	}
@@ -70,7 +109,7 @@ func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *w
 
 // validateListOrCountRequestForQuery valid sql for visibility API
 // it also adds attr prefix for customized fields
-func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClause string) (string, error) {
+func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(domain string, whereClause string) (string, error) {
 	if len(whereClause) != 0 {
 		// Build a placeholder query that allows us to easily parse the contents of the where clause.
 		// IMPORTANT: This query is never executed, it is just used to parse and validate whereClause
@@ -99,6 +138,11 @@ func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClau
 			if err != nil {
 				return "", &workflow.BadRequestError{Message: err.Error()}
 			}
+			// validate query cost
+			err = qv.validateQueryCost(domain, sel.Where.Expr)
+			if err != nil {
+				return "", &workflow.BadRequestError{Message: err.Error()}
+			}
 			sel.Where.Expr.Format(buf)
 		}
 		// validate order by
@@ -162,6 +206,12 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr)
 	if !ok {
 		return errors.New("invalid comparison expression")
 	}
+	if _, ok := allowedComparisonOperators[comparisonExpr.Operator]; !ok {
+		return fmt.Errorf("operator %v is not allowed", comparisonExpr.Operator)
+	}
+	if _, ok := comparisonExpr.Right.(*sqlparser.Subquery); ok {
+		return errors.New("invalid comparison expression")
+	}
 	colNameStr := colName.Name.String()
 	if qv.isValidSearchAttributes(colNameStr) {
 		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
@@ -218,6 +268,174 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 	return nil
 }
 
+// validateQueryCost rejects queries with too many conditions or spanning a too large time range
+func (qv *VisibilityQueryValidator) validateQueryCost(domain string, expr sqlparser.Expr) error {
+	if maxClauses := qv.maxBooleanClauses(domain); maxClauses > 0 {
+		if clauses := countClauses(expr); clauses > maxClauses {
+			return fmt.Errorf("query has %v conditions which exceeds the limit of %v", clauses, maxClauses)
+		}
+	}
+
+	maxTimeRange := qv.maxTimeRange(domain)
+	if maxTimeRange <= 0 {
+		return nil
+	}
+	bounds := make(map[string]*timeBounds)
+	if err := collectTimeBounds(expr, bounds, true, maxTimeRange); err != nil {
+		return err
+	}
+	for key, b := range bounds {
+		// a range without lower bound is not capped, as the only way to bound it is to reject it
+		if !b.hasLower {
+			continue
+		}
+		upper := qv.timeSource().UnixNano()
+		if b.hasUpper {
+			upper = b.upper
+		}
+		if time.Duration(upper-b.lower) > maxTimeRange {
+			return fmt.Errorf("time range of %v exceeds the limit of %v", key, maxTimeRange)
+		}
+	}
+	return nil
+}
+
+// countClauses returns the number of leaf conditions of the expression, each value of an in list counts as one
+func countClauses(expr sqlparser.Expr) int {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		return countClauses(e.Left) + countClauses(e.Right)
+	case *sqlparser.OrExpr:
+		return countClauses(e.Left) + countClauses(e.Right)
+	case *sqlparser.ParenExpr:
+		return countClauses(e.Expr)
+	case *sqlparser.ComparisonExpr:
+		if tuple, ok := e.Right.(sqlparser.ValTuple); ok {
+			return len(tuple)
+		}
+		return 1
+	default:
+		return 1
+	}
+}
+
+// collectTimeBounds checks between conditions on time attributes and records the bounds implied by
+// comparisons which must all hold, i.e. which are not under an or
+func collectTimeBounds(
+	expr sqlparser.Expr,
+	bounds map[string]*timeBounds,
+	conjunction bool,
+	maxTimeRange time.Duration,
+) error {
+
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := collectTimeBounds(e.Left, bounds, conjunction, maxTimeRange); err != nil {
+			return err
+		}
+		return collectTimeBounds(e.Right, bounds, conjunction, maxTimeRange)
+	case *sqlparser.OrExpr:
+		if err := collectTimeBounds(e.Left, bounds, false, maxTimeRange); err != nil {
+			return err
+		}
+		return collectTimeBounds(e.Right, bounds, false, maxTimeRange)
+	case *sqlparser.ParenExpr:
+		return collectTimeBounds(e.Expr, bounds, conjunction, maxTimeRange)
+	case *sqlparser.RangeCond:
+		key, ok := timeRangeKey(e.Left)
+		if !ok || e.Operator != sqlparser.BetweenStr {
+			return nil
+		}
+		from, fromOK := parseTimeValue(e.From)
+		to, toOK := parseTimeValue(e.To)
+		if !fromOK || !toOK {
+			return nil
+		}
+		if time.Duration(to-from) > maxTimeRange {
+			return fmt.Errorf("time range of %v exceeds the limit of %v", key, maxTimeRange)
+		}
+		if conjunction {
+			getTimeBounds(bounds, key).addLower(from)
+			getTimeBounds(bounds, key).addUpper(to)
+		}
+	case *sqlparser.ComparisonExpr:
+		key, ok := timeRangeKey(e.Left)
+		if !ok || !conjunction {
+			return nil
+		}
+		value, ok := parseTimeValue(e.Right)
+		if !ok {
+			return nil
+		}
+		switch e.Operator {
+		case sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+			getTimeBounds(bounds, key).addLower(value)
+		case sqlparser.LessThanStr, sqlparser.LessEqualStr:
+			getTimeBounds(bounds, key).addUpper(value)
+		case sqlparser.EqualStr:
+			getTimeBounds(bounds, key).addLower(value)
+			getTimeBounds(bounds, key).addUpper(value)
+		}
+	}
+	return nil
+}
+
+func getTimeBounds(bounds map[string]*timeBounds, key string) *timeBounds {
+	b, ok := bounds[key]
+	if !ok {
+		b = &timeBounds{}
+		bounds[key] = b
+	}
+	return b
+}
+
+func (b *timeBounds) addLower(value int64) {
+	if !b.hasLower || value > b.lower {
+		b.lower = value
+		b.hasLower = true
+	}
+}
+
+func (b *timeBounds) addUpper(value int64) {
+	if !b.hasUpper || value < b.upper {
+		b.upper = value
+		b.hasUpper = true
+	}
+}
+
+func timeRangeKey(expr sqlparser.Expr) (string, bool) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", false
+	}
+	key := colName.Name.String()
+	_, ok = timeRangeKeys[key]
+	return key, ok
+}
+
+// parseTimeValue parses a time value of a query, either unix nanos or a RFC3339 string
+func parseTimeValue(expr sqlparser.Expr) (int64, bool) {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return 0, false
+	}
+	switch val.Type {
+	case sqlparser.IntVal:
+		value, err := strconv.ParseInt(string(val.Val), 10, 64)
+		return value, err == nil
+	case sqlparser.StrVal:
+		if value, err := strconv.ParseInt(string(val.Val), 10, 64); err == nil {
+			return value, true
+		}
+		t, err := time.Parse(time.RFC3339, string(val.Val))
+		if err != nil {
+			return 0, false
+		}
+		return t.UnixNano(), true
+	}
+	return 0, false
+}
+
 // isValidSearchAttributes return true if key is registered
 func (qv *VisibilityQueryValidator) isValidSearchAttributes(key string) bool {
 	validAttr := qv.validSearchAttributes()
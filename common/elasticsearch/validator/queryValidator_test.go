@@ -21,12 +21,16 @@
 package validator
 
 import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
 	"github.com/stretchr/testify/suite"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/service/dynamicconfig"
-	"testing"
 )
 
 type queryValidatorSuite struct {
@@ -40,7 +44,11 @@ func TestQueryValidatorSuite(t *testing.T) {
 
 func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
 	validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
-	qv := NewQueryValidator(validSearchAttr)
+	qv := NewQueryValidator(
+		validSearchAttr,
+		dynamicconfig.GetIntPropertyFilteredByDomain(0),
+		dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+	)
 
 	listRequest := &shared.ListWorkflowExecutionsRequest{}
 	s.Nil(qv.ValidateListRequestForQuery(listRequest))
@@ -130,3 +138,97 @@ func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
 	listRequest.Query = common.StringPtr(query)
 	s.NotNil(qv.ValidateListRequestForQuery(listRequest))
 }
+
+func (s *queryValidatorSuite) TestValidateListRequestForQuery_Operators() {
+	validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	qv := NewQueryValidator(
+		validSearchAttr,
+		dynamicconfig.GetIntPropertyFilteredByDomain(0),
+		dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+	)
+
+	listRequest := &shared.ListWorkflowExecutionsRequest{}
+	for _, query := range []string{
+		"WorkflowID != 'wid'",
+		"StartTime >= 1 and StartTime < 2",
+		"WorkflowType in ('a', 'b')",
+		"CloseTime = missing",
+	} {
+		listRequest.Query = common.StringPtr(query)
+		s.Nil(qv.ValidateListRequestForQuery(listRequest), query)
+	}
+
+	query := "WorkflowID like '%wid%'"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: operator like is not allowed}", qv.ValidateListRequestForQuery(listRequest).Error())
+
+	query = "WorkflowID regexp 'wid.*'"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: operator regexp is not allowed}", qv.ValidateListRequestForQuery(listRequest).Error())
+
+	query = "WorkflowID in (select WorkflowID from dummy)"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: invalid comparison expression}", qv.ValidateListRequestForQuery(listRequest).Error())
+}
+
+func (s *queryValidatorSuite) TestValidateListRequestForQuery_BooleanClauses() {
+	validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	qv := NewQueryValidator(
+		validSearchAttr,
+		dynamicconfig.GetIntPropertyFilteredByDomain(3),
+		dynamicconfig.GetDurationPropertyFnFilteredByDomain(0),
+	)
+
+	listRequest := &shared.ListWorkflowExecutionsRequest{}
+	query := "WorkflowID = 'wid' and (RunID = 'rid' or WorkflowType = 'wtype')"
+	listRequest.Query = common.StringPtr(query)
+	s.Nil(qv.ValidateListRequestForQuery(listRequest))
+
+	query = "WorkflowID = 'wid' and WorkflowType in ('a', 'b', 'c')"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: query has 4 conditions which exceeds the limit of 3}", qv.ValidateListRequestForQuery(listRequest).Error())
+
+	var conditions []string
+	for i := 0; i < 4; i++ {
+		conditions = append(conditions, fmt.Sprintf("WorkflowID = 'wid%v'", i))
+	}
+	countRequest := &shared.CountWorkflowExecutionsRequest{Query: common.StringPtr(strings.Join(conditions, " or "))}
+	s.NotNil(qv.ValidateCountRequestForQuery(countRequest))
+}
+
+func (s *queryValidatorSuite) TestValidateListRequestForQuery_TimeRange() {
+	validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	qv := NewQueryValidator(
+		validSearchAttr,
+		dynamicconfig.GetIntPropertyFilteredByDomain(0),
+		dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Hour),
+	)
+	now := time.Now()
+	qv.timeSource = func() time.Time { return now }
+	nanos := func(t time.Time) int64 { return t.UnixNano() }
+
+	testCases := []struct {
+		query   string
+		isValid bool
+	}{
+		{"WorkflowID = 'wid'", true},
+		{fmt.Sprintf("StartTime between %v and %v", nanos(now.Add(-time.Minute)), nanos(now)), true},
+		{fmt.Sprintf("StartTime between %v and %v", nanos(now.Add(-2*time.Hour)), nanos(now)), false},
+		{fmt.Sprintf("CloseTime > %v", nanos(now.Add(-time.Minute))), true},
+		{fmt.Sprintf("CloseTime > %v", nanos(now.Add(-2*time.Hour))), false},
+		{fmt.Sprintf("CloseTime > %v and CloseTime < %v", nanos(now.Add(-3*time.Hour)), nanos(now.Add(-150*time.Minute))), true},
+		{fmt.Sprintf("CloseTime > %v and (WorkflowID = 'wid' or CloseTime < %v)", nanos(now.Add(-3*time.Hour)), nanos(now.Add(-150*time.Minute))), false},
+		{fmt.Sprintf("CloseTime < %v", nanos(now.Add(-2*time.Hour))), true},
+		{fmt.Sprintf("ExecutionTime > '%v'", now.Add(-2*time.Hour).Format(time.RFC3339)), false},
+	}
+
+	for _, tc := range testCases {
+		listRequest := &shared.ListWorkflowExecutionsRequest{Query: common.StringPtr(tc.query)}
+		err := qv.ValidateListRequestForQuery(listRequest)
+		if tc.isValid {
+			s.NoError(err, tc.query)
+		} else {
+			s.Error(err, tc.query)
+		}
+	}
+}
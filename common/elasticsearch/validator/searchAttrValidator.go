@@ -21,8 +21,13 @@
 package validator
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
+
 	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -64,11 +69,8 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchA
 
 	// verify: number of keys <= limit
 	fields := input.GetIndexedFields()
-	lengthOfFields := len(fields)
-	if lengthOfFields > sv.searchAttributesNumberOfKeysLimit(domain) {
-		sv.logger.WithTags(tag.Number(int64(lengthOfFields)), tag.WorkflowDomainName(domain)).
-			Error("number of keys in search attributes exceed limit")
-		return &gen.BadRequestError{Message: fmt.Sprintf("number of keys %d exceed limit", lengthOfFields)}
+	if err := sv.validateNumberOfKeys(len(fields), domain); err != nil {
+		return err
 	}
 
 	totalSize := 0
@@ -91,16 +93,65 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchA
 				Error("value size of search attribute exceed limit")
 			return &gen.BadRequestError{Message: fmt.Sprintf("size limit exceed for key %s", key)}
 		}
+		// verify: value can be indexed as the registered type of the key
+		if !sv.isValidValue(key, val) {
+			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
+				Error("value of search attribute does not match its type")
+			return &gen.BadRequestError{Message: fmt.Sprintf("invalid value for key %s", key)}
+		}
 		totalSize += len(key) + len(val)
 	}
 
 	// verify: total size <= limit
+	return sv.validateTotalSize(totalSize, domain)
+}
+
+// ValidateUpsertSearchAttributes validate search attributes to be upserted are valid for writing, and
+// the search attributes of the workflow execution after the upsert not exceed limits
+func (sv *SearchAttributesValidator) ValidateUpsertSearchAttributes(
+	current map[string][]byte,
+	upsert *gen.SearchAttributes,
+	domain string,
+) error {
+
+	if err := sv.ValidateSearchAttributes(upsert, domain); err != nil {
+		return err
+	}
+
+	merged := make(map[string][]byte, len(current)+len(upsert.GetIndexedFields()))
+	for key, val := range current {
+		merged[key] = val
+	}
+	for key, val := range upsert.GetIndexedFields() {
+		merged[key] = val
+	}
+
+	if err := sv.validateNumberOfKeys(len(merged), domain); err != nil {
+		return err
+	}
+
+	totalSize := 0
+	for key, val := range merged {
+		totalSize += len(key) + len(val)
+	}
+	return sv.validateTotalSize(totalSize, domain)
+}
+
+func (sv *SearchAttributesValidator) validateNumberOfKeys(numberOfKeys int, domain string) error {
+	if numberOfKeys > sv.searchAttributesNumberOfKeysLimit(domain) {
+		sv.logger.WithTags(tag.Number(int64(numberOfKeys)), tag.WorkflowDomainName(domain)).
+			Error("number of keys in search attributes exceed limit")
+		return &gen.BadRequestError{Message: fmt.Sprintf("number of keys %d exceed limit", numberOfKeys)}
+	}
+	return nil
+}
+
+func (sv *SearchAttributesValidator) validateTotalSize(totalSize int, domain string) error {
 	if totalSize > sv.searchAttributesTotalSizeLimit(domain) {
 		sv.logger.WithTags(tag.Number(int64(totalSize)), tag.WorkflowDomainName(domain)).
 			Error("total size of search attributes exceed limit")
 		return &gen.BadRequestError{Message: fmt.Sprintf("total size %d exceed limit", totalSize)}
 	}
-
 	return nil
 }
 
@@ -110,3 +161,65 @@ func (sv *SearchAttributesValidator) isValidSearchAttributes(key string) bool {
 	_, isValidKey := validAttr[key]
 	return isValidKey
 }
+
+// isValidValue return true if the json encoded value can be indexed as the registered type of the key,
+// following the type coercion of ElasticSearch. Arrays are valid if all of their elements are. Values
+// which are not json are let through, the indexer drops them instead of failing the whole document.
+func (sv *SearchAttributesValidator) isValidValue(key string, val []byte) bool {
+	var decoded interface{}
+	if err := json.Unmarshal(val, &decoded); err != nil {
+		return true
+	}
+	valueType := common.ConvertIndexedValueTypeToThriftType(sv.validSearchAttributes()[key], sv.logger)
+	if elements, ok := decoded.([]interface{}); ok {
+		for _, element := range elements {
+			if !isValidValueOfType(element, valueType) {
+				return false
+			}
+		}
+		return true
+	}
+	return isValidValueOfType(decoded, valueType)
+}
+
+func isValidValueOfType(value interface{}, valueType gen.IndexedValueType) bool {
+	switch valueType {
+	case gen.IndexedValueTypeString, gen.IndexedValueTypeKeyword:
+		switch value.(type) {
+		case string, float64, bool:
+			return true
+		}
+	case gen.IndexedValueTypeInt:
+		switch v := value.(type) {
+		case float64:
+			return v == float64(int64(v))
+		case string:
+			_, err := strconv.ParseInt(v, 10, 64)
+			return err == nil
+		}
+	case gen.IndexedValueTypeDouble:
+		switch v := value.(type) {
+		case float64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(v, 64)
+			return err == nil
+		}
+	case gen.IndexedValueTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return true
+		case string:
+			return v == "true" || v == "false"
+		}
+	case gen.IndexedValueTypeDatetime:
+		switch v := value.(type) {
+		case float64:
+			return true
+		case string:
+			_, err := time.Parse(time.RFC3339Nano, v)
+			return err == nil
+		}
+	}
+	return false
+}
@@ -21,6 +21,8 @@
 package validator
 
 import (
+	"fmt"
+
 	"github.com/stretchr/testify/suite"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/definition"
@@ -102,3 +104,92 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, domain)
 	s.Equal(`BadRequestError{Message: total size 40 exceed limit}`, err.Error())
 }
+
+func (s *searchAttributesValidatorSuite) TestValidateUpsertSearchAttributes() {
+	numOfKeysLimit := 2
+	sizeOfValueLimit := 5
+	sizeOfTotalLimit := 40
+
+	validator := NewSearchAttributesValidator(log.NewNoop(),
+		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		dynamicconfig.GetIntPropertyFilteredByDomain(numOfKeysLimit),
+		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfValueLimit),
+		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfTotalLimit))
+
+	domain := "domain"
+	current := map[string][]byte{
+		"CustomIntField": []byte(`1`),
+	}
+	attr := &gen.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"CustomIntField": []byte(`2`),
+		},
+	}
+	err := validator.ValidateUpsertSearchAttributes(current, attr, domain)
+	s.Nil(err)
+
+	attr.IndexedFields = map[string][]byte{
+		"CustomKeywordField": []byte(`123`),
+	}
+	err = validator.ValidateUpsertSearchAttributes(current, attr, domain)
+	s.Nil(err)
+
+	current["CustomBoolField"] = []byte(`true`)
+	err = validator.ValidateUpsertSearchAttributes(current, attr, domain)
+	s.Equal("BadRequestError{Message: number of keys 3 exceed limit}", err.Error())
+
+	current = map[string][]byte{
+		"CustomStringField": []byte(`12345`),
+	}
+	attr.IndexedFields = map[string][]byte{
+		"CustomKeywordField": []byte(`12345`),
+	}
+	err = validator.ValidateUpsertSearchAttributes(current, attr, domain)
+	s.Equal("BadRequestError{Message: total size 45 exceed limit}", err.Error())
+
+	attr.IndexedFields = map[string][]byte{
+		"CustomKeywordField": []byte(`123456`),
+	}
+	err = validator.ValidateUpsertSearchAttributes(current, attr, domain)
+	s.Equal("BadRequestError{Message: size limit exceed for key CustomKeywordField}", err.Error())
+}
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_ValueType() {
+	validator := NewSearchAttributesValidator(log.NewNoop(),
+		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		dynamicconfig.GetIntPropertyFilteredByDomain(10),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1000))
+
+	domain := "domain"
+	validValues := map[string][]string{
+		"CustomStringField":   {`"text"`, `1`, `["a","b"]`},
+		"CustomKeywordField":  {`"keyword"`, `true`},
+		"CustomIntField":      {`1`, `"2"`, `[1,2]`},
+		"CustomDoubleField":   {`1.5`, `"2.5"`},
+		"CustomBoolField":     {`true`, `"false"`},
+		"CustomDatetimeField": {`"2019-01-01T01:01:01Z"`, `1546304461000`},
+	}
+	for key, values := range validValues {
+		for _, value := range values {
+			attr := &gen.SearchAttributes{IndexedFields: map[string][]byte{key: []byte(value)}}
+			s.Nil(validator.ValidateSearchAttributes(attr, domain), "%v: %v", key, value)
+		}
+	}
+
+	invalidValues := map[string][]string{
+		"CustomStringField":   {`{"a":1}`},
+		"CustomKeywordField":  {`[{"a":1}]`},
+		"CustomIntField":      {`1.5`, `"abc"`, `[1,"abc"]`},
+		"CustomDoubleField":   {`"abc"`, `true`},
+		"CustomBoolField":     {`1`, `"yes"`},
+		"CustomDatetimeField": {`"yesterday"`, `true`},
+	}
+	for key, values := range invalidValues {
+		for _, value := range values {
+			attr := &gen.SearchAttributes{IndexedFields: map[string][]byte{key: []byte(value)}}
+			err := validator.ValidateSearchAttributes(attr, domain)
+			s.Equal(fmt.Sprintf("BadRequestError{Message: invalid value for key %v}", key), err.Error())
+		}
+	}
+}
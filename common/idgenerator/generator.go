@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package idgenerator
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// TypeUUID generates random (version 4) UUIDs, it is the default
+	TypeUUID = "uuid"
+	// TypeSortable generates UUID formatted IDs starting with their millisecond creation time, followed by
+	// random bits, so that they sort by creation time both as strings and as cassandra uuids
+	TypeSortable = "sortable"
+
+	sortableVersion = 0x70
+	rfc4122Variant  = 0x80
+)
+
+type (
+	// Generator generates the IDs the services assign to workflow runs, requests and in-memory tasks
+	Generator interface {
+		// RunID returns the ID for a new workflow run
+		RunID() string
+		// RequestID returns an ID for deduplicating a request sent on behalf of the service
+		RequestID() string
+		// TaskID returns the ID for a new in-memory task, e.g. a query task
+		TaskID() string
+	}
+
+	generator struct {
+		runID func() string
+	}
+)
+
+var _ Generator = (*generator)(nil)
+
+// NewGenerator returns the generator described by the config, run IDs are UUIDs if no type is configured.
+// Request and task IDs never outlive a workflow run and are always UUIDs.
+func NewGenerator(cfg config.IDGenerator) (Generator, error) {
+	switch cfg.RunID {
+	case "", TypeUUID:
+		return NewUUIDGenerator(), nil
+	case TypeSortable:
+		return &generator{runID: NewSortableID}, nil
+	default:
+		return nil, fmt.Errorf("unknown run ID generator type: %v", cfg.RunID)
+	}
+}
+
+// NewUUIDGenerator returns a generator of random UUIDs
+func NewUUIDGenerator() Generator {
+	return &generator{runID: uuid.New}
+}
+
+func (g *generator) RunID() string {
+	return g.runID()
+}
+
+func (g *generator) RequestID() string {
+	return uuid.New()
+}
+
+func (g *generator) TaskID() string {
+	return uuid.New()
+}
+
+// NewSortableID returns a UUID formatted ID which sorts by its creation time
+func NewSortableID() string {
+	return newSortableID(time.Now())
+}
+
+func newSortableID(now time.Time) string {
+	id := make([]byte, 16)
+	binary.BigEndian.PutUint64(id, uint64(now.UnixNano()/int64(time.Millisecond))<<16)
+	if _, err := rand.Read(id[6:]); err != nil {
+		// crypto/rand only fails if the OS entropy source is unavailable
+		panic(err)
+	}
+	id[6] = id[6]&0x0f | sortableVersion
+	id[8] = id[8]&0x3f | rfc4122Variant
+	return uuid.UUID(id).String()
+}
+
+// SortableIDTime returns the creation time of an ID generated by NewSortableID,
+// ok is false if the ID was not generated by it
+func SortableIDTime(id string) (t time.Time, ok bool) {
+	parsed := uuid.Parse(id)
+	if parsed == nil || parsed[6]&0xf0 != sortableVersion {
+		return time.Time{}, false
+	}
+	millis := int64(binary.BigEndian.Uint64(parsed[:8]) >> 16)
+	return time.Unix(0, millis*int64(time.Millisecond)), true
+}
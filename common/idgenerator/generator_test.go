@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package idgenerator
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	generatorSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestGeneratorSuite(t *testing.T) {
+	suite.Run(t, new(generatorSuite))
+}
+
+func (s *generatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *generatorSuite) TestNewGenerator() {
+	for _, runIDType := range []string{"", TypeUUID} {
+		generator, err := NewGenerator(config.IDGenerator{RunID: runIDType})
+		s.NoError(err)
+		runID := generator.RunID()
+		version, ok := uuid.Parse(runID).Version()
+		s.True(ok)
+		s.Equal(uuid.Version(4), version)
+		_, ok = SortableIDTime(runID)
+		s.False(ok)
+	}
+
+	generator, err := NewGenerator(config.IDGenerator{RunID: TypeSortable})
+	s.NoError(err)
+	_, ok := SortableIDTime(generator.RunID())
+	s.True(ok)
+	s.NotNil(uuid.Parse(generator.RequestID()))
+	s.NotNil(uuid.Parse(generator.TaskID()))
+
+	_, err = NewGenerator(config.IDGenerator{RunID: "unknown"})
+	s.Error(err)
+}
+
+func (s *generatorSuite) TestSortableID() {
+	now := time.Now()
+	id := newSortableID(now)
+
+	parsed := uuid.Parse(id)
+	s.NotNil(parsed)
+	s.Equal(uuid.RFC4122, parsed.Variant())
+
+	idTime, ok := SortableIDTime(id)
+	s.True(ok)
+	s.Equal(now.UnixNano()/int64(time.Millisecond), idTime.UnixNano()/int64(time.Millisecond))
+	s.NotEqual(id, newSortableID(now))
+}
+
+func (s *generatorSuite) TestSortableIDSortsByCreationTime() {
+	start := time.Now()
+	ids := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		ids = append(ids, newSortableID(start.Add(time.Duration(i)*time.Millisecond)))
+	}
+	s.True(sort.StringsAreSorted(ids))
+
+	// byte wise comparison, as used by cassandra for non time based uuids
+	for i := 1; i < len(ids); i++ {
+		s.True(string(uuid.Parse(ids[i-1])) < string(uuid.Parse(ids[i])))
+	}
+}
@@ -20,39 +20,137 @@
 
 package common
 
-import "encoding/json"
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// TaskTokenVersion is the version of the task tokens generated by this host, tokens of a newer
+	// version are rejected, so a new token format must be understood by all hosts before it is issued
+	TaskTokenVersion = 1
+)
+
+var (
+	// ErrTaskTokenVersionNotSupported is the error for a task token of a version newer than TaskTokenVersion
+	ErrTaskTokenVersionNotSupported = &workflow.BadRequestError{Message: "Task token version is not supported."}
+	// ErrTaskTokenSignatureMissing is the error for an unsigned task token while signatures are enforced
+	ErrTaskTokenSignatureMissing = &workflow.BadRequestError{Message: "Task token is not signed."}
+	// ErrTaskTokenSignatureInvalid is the error for a task token whose signature does not match its content
+	ErrTaskTokenSignatureInvalid = &workflow.BadRequestError{Message: "Task token signature is invalid."}
+)
 
 type (
-	jsonTaskTokenSerializer struct{}
+	jsonTaskTokenSerializer struct {
+		signingKey       []byte
+		enforceSignature dynamicconfig.BoolPropertyFn
+	}
+
+	// signedTaskToken is the encoding of a signed token, the signature is the HMAC of the payload
+	// bytes exactly as they are carried in the token, an unsigned token is encoded as its plain payload
+	signedTaskToken struct {
+		Payload   json.RawMessage `json:"payload,omitempty"`
+		Signature []byte          `json:"signature,omitempty"`
+	}
 )
 
 // NewJSONTaskTokenSerializer creates a new instance of TaskTokenSerializer
 func NewJSONTaskTokenSerializer() TaskTokenSerializer {
-	return &jsonTaskTokenSerializer{}
+	return NewSignedJSONTaskTokenSerializer(nil, nil)
 }
 
-func (j *jsonTaskTokenSerializer) Serialize(token *TaskToken) ([]byte, error) {
-	data, err := json.Marshal(token)
+// NewSignedJSONTaskTokenSerializer creates a new instance of TaskTokenSerializer which signs the tokens
+// with an HMAC of the signing key and rejects tokens with an invalid signature, unsigned tokens are only
+// rejected while enforceSignature is true, so signing can be rolled out to all hosts first
+func NewSignedJSONTaskTokenSerializer(signingKey []byte, enforceSignature dynamicconfig.BoolPropertyFn) TaskTokenSerializer {
+	if enforceSignature == nil {
+		enforceSignature = dynamicconfig.GetBoolPropertyFn(false)
+	}
+	return &jsonTaskTokenSerializer{
+		signingKey:       signingKey,
+		enforceSignature: enforceSignature,
+	}
+}
 
-	return data, err
+func (j *jsonTaskTokenSerializer) Serialize(token *TaskToken) ([]byte, error) {
+	versioned := *token
+	versioned.Version = TaskTokenVersion
+	return j.encode(&versioned)
 }
 
 func (j *jsonTaskTokenSerializer) Deserialize(data []byte) (*TaskToken, error) {
 	var token TaskToken
-	err := json.Unmarshal(data, &token)
-
-	return &token, err
+	if err := j.decode(data, &token); err != nil {
+		return nil, err
+	}
+	if token.Version > TaskTokenVersion {
+		return nil, ErrTaskTokenVersionNotSupported
+	}
+	return &token, nil
 }
 
 func (j *jsonTaskTokenSerializer) SerializeQueryTaskToken(token *QueryTaskToken) ([]byte, error) {
-	data, err := json.Marshal(token)
-
-	return data, err
+	versioned := *token
+	versioned.Version = TaskTokenVersion
+	return j.encode(&versioned)
 }
 
 func (j *jsonTaskTokenSerializer) DeserializeQueryTaskToken(data []byte) (*QueryTaskToken, error) {
 	var token QueryTaskToken
-	err := json.Unmarshal(data, &token)
+	if err := j.decode(data, &token); err != nil {
+		return nil, err
+	}
+	if token.Version > TaskTokenVersion {
+		return nil, ErrTaskTokenVersionNotSupported
+	}
+	return &token, nil
+}
+
+func (j *jsonTaskTokenSerializer) isSigningEnabled() bool {
+	return len(j.signingKey) > 0
+}
+
+// encode returns the json encoding of the token, wrapped with its signature if signing is enabled
+func (j *jsonTaskTokenSerializer) encode(token interface{}) ([]byte, error) {
+	payload, err := json.Marshal(token)
+	if err != nil || !j.isSigningEnabled() {
+		return payload, err
+	}
+	return json.Marshal(&signedTaskToken{
+		Payload:   payload,
+		Signature: j.sign(payload),
+	})
+}
+
+// decode verifies the signature of the encoded token against its payload bytes as received,
+// and only then decodes the payload into token
+func (j *jsonTaskTokenSerializer) decode(data []byte, token interface{}) error {
+	var signed signedTaskToken
+	if err := json.Unmarshal(data, &signed); err != nil {
+		return err
+	}
+
+	payload := data
+	if len(signed.Payload) == 0 {
+		if j.isSigningEnabled() && j.enforceSignature() {
+			return ErrTaskTokenSignatureMissing
+		}
+	} else {
+		if j.isSigningEnabled() && !hmac.Equal(signed.Signature, j.sign(signed.Payload)) {
+			return ErrTaskTokenSignatureInvalid
+		}
+		payload = signed.Payload
+	}
+	return json.Unmarshal(payload, token)
+}
 
-	return &token, err
+// sign returns the HMAC of the payload
+func (j *jsonTaskTokenSerializer) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, j.signingKey)
+	mac.Write(payload)
+	return mac.Sum(nil)
 }
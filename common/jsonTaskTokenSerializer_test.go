@@ -0,0 +1,159 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	jsonTaskTokenSerializerSuite struct {
+		suite.Suite
+	}
+)
+
+func TestJSONTaskTokenSerializerSuite(t *testing.T) {
+	suite.Run(t, new(jsonTaskTokenSerializerSuite))
+}
+
+func (s *jsonTaskTokenSerializerSuite) newToken() *TaskToken {
+	return &TaskToken{
+		DomainID:        "domain-id",
+		WorkflowID:      "workflow-id",
+		RunID:           "run-id",
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
+		ActivityID:      "activity-id",
+	}
+}
+
+func (s *jsonTaskTokenSerializerSuite) TestSerialize_Unsigned() {
+	serializer := NewJSONTaskTokenSerializer()
+
+	data, err := serializer.Serialize(s.newToken())
+	s.NoError(err)
+	token, err := serializer.Deserialize(data)
+	s.NoError(err)
+	s.Equal(int32(TaskTokenVersion), token.Version)
+	s.Equal("workflow-id", token.WorkflowID)
+
+	var signed signedTaskToken
+	s.NoError(json.Unmarshal(data, &signed))
+	s.Empty(signed.Payload)
+	s.Empty(signed.Signature)
+}
+
+func (s *jsonTaskTokenSerializerSuite) TestSerialize_Signed() {
+	serializer := NewSignedJSONTaskTokenSerializer([]byte("secret"), nil)
+
+	data, err := serializer.Serialize(s.newToken())
+	s.NoError(err)
+	token, err := serializer.Deserialize(data)
+	s.NoError(err)
+	s.Equal(int32(TaskTokenVersion), token.Version)
+	s.Equal("activity-id", token.ActivityID)
+
+	var signed signedTaskToken
+	s.NoError(json.Unmarshal(data, &signed))
+	s.NotEmpty(signed.Payload)
+	s.NotEmpty(signed.Signature)
+
+	queryData, err := serializer.SerializeQueryTaskToken(&QueryTaskToken{DomainID: "domain-id", TaskList: "tl", TaskID: "task-id"})
+	s.NoError(err)
+	queryToken, err := serializer.DeserializeQueryTaskToken(queryData)
+	s.NoError(err)
+	s.Equal("task-id", queryToken.TaskID)
+
+	// a host without the signing key still reads signed tokens
+	token, err = NewJSONTaskTokenSerializer().Deserialize(data)
+	s.NoError(err)
+	s.Equal("activity-id", token.ActivityID)
+}
+
+func (s *jsonTaskTokenSerializerSuite) TestDeserialize_Forged() {
+	serializer := NewSignedJSONTaskTokenSerializer([]byte("secret"), nil)
+
+	data, err := serializer.Serialize(s.newToken())
+	s.NoError(err)
+	var signed signedTaskToken
+	s.NoError(json.Unmarshal(data, &signed))
+	signature, err := json.Marshal(signed.Signature)
+	s.NoError(err)
+	forge := func(payload []byte) []byte {
+		// built by hand since json.Marshal would compact the payload
+		return []byte(`{"payload":` + string(payload) + `,"signature":` + string(signature) + `}`)
+	}
+	_, err = serializer.Deserialize(forge(signed.Payload))
+	s.NoError(err)
+
+	var token TaskToken
+	s.NoError(json.Unmarshal(signed.Payload, &token))
+	token.WorkflowID = "another-workflow-id"
+	payload, err := json.Marshal(&token)
+	s.NoError(err)
+	_, err = serializer.Deserialize(forge(payload))
+	s.Equal(ErrTaskTokenSignatureInvalid, err)
+
+	// fields unknown to this host and re-encodings which decode to the same token are covered by the signature too
+	unknownField := append([]byte(`{"unknownField":"value",`), signed.Payload[1:]...)
+	_, err = serializer.Deserialize(forge(unknownField))
+	s.Equal(ErrTaskTokenSignatureInvalid, err)
+	reencoded := append([]byte(`{ `), signed.Payload[1:]...)
+	_, err = serializer.Deserialize(forge(reencoded))
+	s.Equal(ErrTaskTokenSignatureInvalid, err)
+
+	data, err = NewSignedJSONTaskTokenSerializer([]byte("another-secret"), nil).Serialize(s.newToken())
+	s.NoError(err)
+	_, err = serializer.Deserialize(data)
+	s.Equal(ErrTaskTokenSignatureInvalid, err)
+}
+
+func (s *jsonTaskTokenSerializerSuite) TestDeserialize_Unsigned() {
+	data, err := json.Marshal(s.newToken())
+	s.NoError(err)
+
+	enforce := false
+	serializer := NewSignedJSONTaskTokenSerializer([]byte("secret"), func(opts ...dynamicconfig.FilterOption) bool {
+		return enforce
+	})
+	token, err := serializer.Deserialize(data)
+	s.NoError(err)
+	s.Equal(int32(0), token.Version)
+
+	enforce = true
+	_, err = serializer.Deserialize(data)
+	s.Equal(ErrTaskTokenSignatureMissing, err)
+}
+
+func (s *jsonTaskTokenSerializerSuite) TestDeserialize_VersionNotSupported() {
+	token := s.newToken()
+	token.Version = TaskTokenVersion + 1
+	data, err := json.Marshal(token)
+	s.NoError(err)
+
+	_, err = NewJSONTaskTokenSerializer().Deserialize(data)
+	s.Equal(ErrTaskTokenVersionNotSupported, err)
+}
@@ -244,6 +244,21 @@ func WorkflowTaskListName(taskListName string) Tag {
 	return newStringTag("wf-task-list-name", taskListName)
 }
 
+// WorkflowTaskListPartitionVersion returns tag for the version of the partition config of a task list
+func WorkflowTaskListPartitionVersion(version int64) Tag {
+	return newInt64("wf-task-list-partition-version", version)
+}
+
+// WorkflowTaskListReadPartitions returns tag for the number of read partitions of a task list
+func WorkflowTaskListReadPartitions(partitions int) Tag {
+	return newInt("wf-task-list-read-partitions", partitions)
+}
+
+// WorkflowTaskListWritePartitions returns tag for the number of write partitions of a task list
+func WorkflowTaskListWritePartitions(partitions int) Tag {
+	return newInt("wf-task-list-write-partitions", partitions)
+}
+
 // size limit
 
 // WorkflowSize returns tag for WorkflowSize
@@ -271,6 +286,11 @@ func WorkflowEventCount(eventCount int) Tag {
 	return newInt("wf-event-count", eventCount)
 }
 
+// WorkflowPendingActivityCount returns tag for PendingActivityCount
+func WorkflowPendingActivityCount(pendingActivityCount int) Tag {
+	return newInt("wf-pending-activity-count", pendingActivityCount)
+}
+
 ///////////////////  System tags defined here:  ///////////////////
 // Tags with pre-define values
 
@@ -371,11 +391,31 @@ func StoreType(storeType string) Tag {
 	return newPredefinedStringTag("store-type", storeType)
 }
 
+// StoreLatency returns tag for the latency of a persistence operation
+func StoreLatency(latency time.Duration) Tag {
+	return newDurationTag("store-latency", latency)
+}
+
+// StorePayloadSize returns tag for the size of data read or written by a persistence operation
+func StorePayloadSize(size int) Tag {
+	return newInt("store-payload-size", size)
+}
+
 // DetailInfo returns tag for DetailInfo
 func DetailInfo(i string) Tag {
 	return newStringTag("detail-info", i)
 }
 
+// OperatorIdentity returns tag for the identity of the operator issuing an admin operation
+func OperatorIdentity(identity string) Tag {
+	return newStringTag("operator-identity", identity)
+}
+
+// OperatorReason returns tag for the reason given by the operator for an admin operation
+func OperatorReason(reason string) Tag {
+	return newStringTag("operator-reason", reason)
+}
+
 // Counter returns tag for Counter
 func Counter(c int) Tag {
 	return newInt("counter", c)
@@ -448,6 +488,16 @@ func ShardTimerAcks(shardTimerAcks interface{}) Tag {
 	return newObjectTag("shard-timer-acks", shardTimerAcks)
 }
 
+// QueueType returns tag for QueueType
+func QueueType(queueType string) Tag {
+	return newStringTag("queue-type", queueType)
+}
+
+// AckLevel returns tag for AckLevel
+func AckLevel(lv int64) Tag {
+	return newInt64("ack-level", lv)
+}
+
 // task queue processor
 
 // TaskID returns tag for TaskID
@@ -539,6 +589,11 @@ func ESDocID(id string) Tag {
 	return newStringTag("es-doc-id", id)
 }
 
+// ESIndex returns tag for ESIndex
+func ESIndex(index string) Tag {
+	return newStringTag("es-index", index)
+}
+
 // LoggingCallAtKey is reserved tag
 const LoggingCallAtKey = "logging-call-at"
 
@@ -590,6 +645,11 @@ func SourceCluster(sourceCluster string) Tag {
 	return newStringTag("xdc-source-cluster", sourceCluster)
 }
 
+// TargetCluster returns tag for TargetCluster
+func TargetCluster(targetCluster string) Tag {
+	return newStringTag("xdc-target-cluster", targetCluster)
+}
+
 // PrevActiveCluster returns tag for PrevActiveCluster
 func PrevActiveCluster(prevActiveCluster string) Tag {
 	return newStringTag("xdc-prev-active-cluster", prevActiveCluster)
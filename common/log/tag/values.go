@@ -36,6 +36,8 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionTerminationProtectionSet       = workflowAction("add-workflow-termination-protection-set-event")
+	WorkflowActionOperatorAnnotation             = workflowAction("add-operator-annotation")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -93,6 +95,7 @@ var (
 	WorkflowListWorkflowFilterByID     = workflowListFilterType("WID")
 	WorkflowListWorkflowFilterByType   = workflowListFilterType("WType")
 	WorkflowListWorkflowFilterByStatus = workflowListFilterType("status")
+	WorkflowListWorkflowFilterByMemo   = workflowListFilterType("memo")
 )
 
 // Pre-defined values for TagSysComponent
@@ -120,8 +123,13 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentHandover                 = component("handover")
+	ComponentMetering                 = component("metering")
+	ComponentParityVerifier           = component("parity-verifier")
+	ComponentDomainMigrator           = component("domain-migrator")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
+	ComponentOverloadController       = component("overload-controller")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -180,4 +188,10 @@ var (
 	StoreOperationCreateTask              = storeOperation("create-task")
 	StoreOperationUpdateTaskList          = storeOperation("update-task-list")
 	StoreOperationStopTaskList            = storeOperation("stop-task-list")
+	StoreOperationLeaseTaskList           = storeOperation("lease-task-list")
+
+	StoreOperationConflictResolveWorkflowExecution = storeOperation("conflict-resolve-wf-execution")
+	StoreOperationResetWorkflowExecution           = storeOperation("reset-wf-execution")
+	StoreOperationAppendHistoryNodes               = storeOperation("append-history-nodes")
+	StoreOperationReadHistoryBranch                = storeOperation("read-history-branch")
 )
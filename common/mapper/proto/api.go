@@ -0,0 +1,6289 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+)
+
+// FromBadRequestError converts a thrift BadRequestError to its protobuf counterpart
+func FromBadRequestError(t *shared.BadRequestError) *apiv1.BadRequestError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BadRequestError{
+		Message: t.Message,
+	}
+}
+
+// ToBadRequestError converts a protobuf BadRequestError to its thrift counterpart
+func ToBadRequestError(t *apiv1.BadRequestError) *shared.BadRequestError {
+	if t == nil {
+		return nil
+	}
+	return &shared.BadRequestError{
+		Message: t.Message,
+	}
+}
+
+// FromInternalServiceError converts a thrift InternalServiceError to its protobuf counterpart
+func FromInternalServiceError(t *shared.InternalServiceError) *apiv1.InternalServiceError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.InternalServiceError{
+		Message: t.Message,
+	}
+}
+
+// ToInternalServiceError converts a protobuf InternalServiceError to its thrift counterpart
+func ToInternalServiceError(t *apiv1.InternalServiceError) *shared.InternalServiceError {
+	if t == nil {
+		return nil
+	}
+	return &shared.InternalServiceError{
+		Message: t.Message,
+	}
+}
+
+// FromDomainAlreadyExistsError converts a thrift DomainAlreadyExistsError to its protobuf counterpart
+func FromDomainAlreadyExistsError(t *shared.DomainAlreadyExistsError) *apiv1.DomainAlreadyExistsError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainAlreadyExistsError{
+		Message: t.Message,
+	}
+}
+
+// ToDomainAlreadyExistsError converts a protobuf DomainAlreadyExistsError to its thrift counterpart
+func ToDomainAlreadyExistsError(t *apiv1.DomainAlreadyExistsError) *shared.DomainAlreadyExistsError {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainAlreadyExistsError{
+		Message: t.Message,
+	}
+}
+
+// FromWorkflowExecutionAlreadyStartedError converts a thrift WorkflowExecutionAlreadyStartedError to its protobuf counterpart
+func FromWorkflowExecutionAlreadyStartedError(t *shared.WorkflowExecutionAlreadyStartedError) *apiv1.WorkflowExecutionAlreadyStartedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionAlreadyStartedError{
+		Message:        t.GetMessage(),
+		StartRequestId: t.GetStartRequestId(),
+		RunId:          t.GetRunId(),
+	}
+}
+
+// ToWorkflowExecutionAlreadyStartedError converts a protobuf WorkflowExecutionAlreadyStartedError to its thrift counterpart
+func ToWorkflowExecutionAlreadyStartedError(t *apiv1.WorkflowExecutionAlreadyStartedError) *shared.WorkflowExecutionAlreadyStartedError {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionAlreadyStartedError{
+		Message:        stringPtr(t.Message),
+		StartRequestId: stringPtr(t.StartRequestId),
+		RunId:          stringPtr(t.RunId),
+	}
+}
+
+// FromEntityNotExistsError converts a thrift EntityNotExistsError to its protobuf counterpart
+func FromEntityNotExistsError(t *shared.EntityNotExistsError) *apiv1.EntityNotExistsError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.EntityNotExistsError{
+		Message: t.Message,
+	}
+}
+
+// ToEntityNotExistsError converts a protobuf EntityNotExistsError to its thrift counterpart
+func ToEntityNotExistsError(t *apiv1.EntityNotExistsError) *shared.EntityNotExistsError {
+	if t == nil {
+		return nil
+	}
+	return &shared.EntityNotExistsError{
+		Message: t.Message,
+	}
+}
+
+// FromServiceBusyError converts a thrift ServiceBusyError to its protobuf counterpart
+func FromServiceBusyError(t *shared.ServiceBusyError) *apiv1.ServiceBusyError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ServiceBusyError{
+		Message: t.Message,
+	}
+}
+
+// ToServiceBusyError converts a protobuf ServiceBusyError to its thrift counterpart
+func ToServiceBusyError(t *apiv1.ServiceBusyError) *shared.ServiceBusyError {
+	if t == nil {
+		return nil
+	}
+	return &shared.ServiceBusyError{
+		Message: t.Message,
+	}
+}
+
+// FromCancellationAlreadyRequestedError converts a thrift CancellationAlreadyRequestedError to its protobuf counterpart
+func FromCancellationAlreadyRequestedError(t *shared.CancellationAlreadyRequestedError) *apiv1.CancellationAlreadyRequestedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancellationAlreadyRequestedError{
+		Message: t.Message,
+	}
+}
+
+// ToCancellationAlreadyRequestedError converts a protobuf CancellationAlreadyRequestedError to its thrift counterpart
+func ToCancellationAlreadyRequestedError(t *apiv1.CancellationAlreadyRequestedError) *shared.CancellationAlreadyRequestedError {
+	if t == nil {
+		return nil
+	}
+	return &shared.CancellationAlreadyRequestedError{
+		Message: t.Message,
+	}
+}
+
+// FromQueryFailedError converts a thrift QueryFailedError to its protobuf counterpart
+func FromQueryFailedError(t *shared.QueryFailedError) *apiv1.QueryFailedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryFailedError{
+		Message: t.Message,
+	}
+}
+
+// ToQueryFailedError converts a protobuf QueryFailedError to its thrift counterpart
+func ToQueryFailedError(t *apiv1.QueryFailedError) *shared.QueryFailedError {
+	if t == nil {
+		return nil
+	}
+	return &shared.QueryFailedError{
+		Message: t.Message,
+	}
+}
+
+// FromDomainNotActiveError converts a thrift DomainNotActiveError to its protobuf counterpart
+func FromDomainNotActiveError(t *shared.DomainNotActiveError) *apiv1.DomainNotActiveError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainNotActiveError{
+		Message:              t.Message,
+		DomainName:           t.DomainName,
+		CurrentCluster:       t.CurrentCluster,
+		ActiveCluster:        t.ActiveCluster,
+		ActiveClusterAddress: t.GetActiveClusterAddress(),
+	}
+}
+
+// ToDomainNotActiveError converts a protobuf DomainNotActiveError to its thrift counterpart
+func ToDomainNotActiveError(t *apiv1.DomainNotActiveError) *shared.DomainNotActiveError {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainNotActiveError{
+		Message:              t.Message,
+		DomainName:           t.DomainName,
+		CurrentCluster:       t.CurrentCluster,
+		ActiveCluster:        t.ActiveCluster,
+		ActiveClusterAddress: stringPtr(t.ActiveClusterAddress),
+	}
+}
+
+// FromLimitExceededError converts a thrift LimitExceededError to its protobuf counterpart
+func FromLimitExceededError(t *shared.LimitExceededError) *apiv1.LimitExceededError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.LimitExceededError{
+		Message: t.Message,
+	}
+}
+
+// ToLimitExceededError converts a protobuf LimitExceededError to its thrift counterpart
+func ToLimitExceededError(t *apiv1.LimitExceededError) *shared.LimitExceededError {
+	if t == nil {
+		return nil
+	}
+	return &shared.LimitExceededError{
+		Message: t.Message,
+	}
+}
+
+// FromAccessDeniedError converts a thrift AccessDeniedError to its protobuf counterpart
+func FromAccessDeniedError(t *shared.AccessDeniedError) *apiv1.AccessDeniedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.AccessDeniedError{
+		Message: t.Message,
+	}
+}
+
+// ToAccessDeniedError converts a protobuf AccessDeniedError to its thrift counterpart
+func ToAccessDeniedError(t *apiv1.AccessDeniedError) *shared.AccessDeniedError {
+	if t == nil {
+		return nil
+	}
+	return &shared.AccessDeniedError{
+		Message: t.Message,
+	}
+}
+
+// FromClientVersionNotSupportedError converts a thrift ClientVersionNotSupportedError to its protobuf counterpart
+func FromClientVersionNotSupportedError(t *shared.ClientVersionNotSupportedError) *apiv1.ClientVersionNotSupportedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ClientVersionNotSupportedError{
+		FeatureVersion:    t.FeatureVersion,
+		ClientImpl:        t.ClientImpl,
+		SupportedVersions: t.SupportedVersions,
+	}
+}
+
+// ToClientVersionNotSupportedError converts a protobuf ClientVersionNotSupportedError to its thrift counterpart
+func ToClientVersionNotSupportedError(t *apiv1.ClientVersionNotSupportedError) *shared.ClientVersionNotSupportedError {
+	if t == nil {
+		return nil
+	}
+	return &shared.ClientVersionNotSupportedError{
+		FeatureVersion:    t.FeatureVersion,
+		ClientImpl:        t.ClientImpl,
+		SupportedVersions: t.SupportedVersions,
+	}
+}
+
+// FromPreconditionFailedError converts a thrift PreconditionFailedError to its protobuf counterpart
+func FromPreconditionFailedError(t *shared.PreconditionFailedError) *apiv1.PreconditionFailedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PreconditionFailedError{
+		Message:     t.Message,
+		NextEventId: t.GetNextEventId(),
+	}
+}
+
+// ToPreconditionFailedError converts a protobuf PreconditionFailedError to its thrift counterpart
+func ToPreconditionFailedError(t *apiv1.PreconditionFailedError) *shared.PreconditionFailedError {
+	if t == nil {
+		return nil
+	}
+	return &shared.PreconditionFailedError{
+		Message:     t.Message,
+		NextEventId: int64Ptr(t.NextEventId),
+	}
+}
+
+// FromWorkflowIdReusePolicy converts a thrift WorkflowIdReusePolicy to its protobuf counterpart
+func FromWorkflowIdReusePolicy(t *shared.WorkflowIdReusePolicy) apiv1.WorkflowIdReusePolicy {
+	if t == nil {
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
+	}
+	switch *t {
+	case shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY
+	case shared.WorkflowIdReusePolicyAllowDuplicate:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	case shared.WorkflowIdReusePolicyRejectDuplicate:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+	case shared.WorkflowIdReusePolicyTerminateIfRunning:
+		return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING
+	}
+	return apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID
+}
+
+// ToWorkflowIdReusePolicy converts a protobuf WorkflowIdReusePolicy to its thrift counterpart
+func ToWorkflowIdReusePolicy(t apiv1.WorkflowIdReusePolicy) *shared.WorkflowIdReusePolicy {
+	switch t {
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID:
+		return nil
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY:
+		return shared.WorkflowIdReusePolicyAllowDuplicateFailedOnly.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE:
+		return shared.WorkflowIdReusePolicyAllowDuplicate.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE:
+		return shared.WorkflowIdReusePolicyRejectDuplicate.Ptr()
+	case apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING:
+		return shared.WorkflowIdReusePolicyTerminateIfRunning.Ptr()
+	}
+	return nil
+}
+
+// FromDomainStatus converts a thrift DomainStatus to its protobuf counterpart
+func FromDomainStatus(t *shared.DomainStatus) apiv1.DomainStatus {
+	if t == nil {
+		return apiv1.DomainStatus_DOMAIN_STATUS_INVALID
+	}
+	switch *t {
+	case shared.DomainStatusRegistered:
+		return apiv1.DomainStatus_DOMAIN_STATUS_REGISTERED
+	case shared.DomainStatusDeprecated:
+		return apiv1.DomainStatus_DOMAIN_STATUS_DEPRECATED
+	case shared.DomainStatusDeleted:
+		return apiv1.DomainStatus_DOMAIN_STATUS_DELETED
+	}
+	return apiv1.DomainStatus_DOMAIN_STATUS_INVALID
+}
+
+// ToDomainStatus converts a protobuf DomainStatus to its thrift counterpart
+func ToDomainStatus(t apiv1.DomainStatus) *shared.DomainStatus {
+	switch t {
+	case apiv1.DomainStatus_DOMAIN_STATUS_INVALID:
+		return nil
+	case apiv1.DomainStatus_DOMAIN_STATUS_REGISTERED:
+		return shared.DomainStatusRegistered.Ptr()
+	case apiv1.DomainStatus_DOMAIN_STATUS_DEPRECATED:
+		return shared.DomainStatusDeprecated.Ptr()
+	case apiv1.DomainStatus_DOMAIN_STATUS_DELETED:
+		return shared.DomainStatusDeleted.Ptr()
+	}
+	return nil
+}
+
+// FromDomainFailoverState converts a thrift DomainFailoverState to its protobuf counterpart
+func FromDomainFailoverState(t *shared.DomainFailoverState) apiv1.DomainFailoverState {
+	if t == nil {
+		return apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_INVALID
+	}
+	switch *t {
+	case shared.DomainFailoverStateActive:
+		return apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_ACTIVE
+	case shared.DomainFailoverStateHandover:
+		return apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_HANDOVER
+	case shared.DomainFailoverStateSwitched:
+		return apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_SWITCHED
+	}
+	return apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_INVALID
+}
+
+// ToDomainFailoverState converts a protobuf DomainFailoverState to its thrift counterpart
+func ToDomainFailoverState(t apiv1.DomainFailoverState) *shared.DomainFailoverState {
+	switch t {
+	case apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_INVALID:
+		return nil
+	case apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_ACTIVE:
+		return shared.DomainFailoverStateActive.Ptr()
+	case apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_HANDOVER:
+		return shared.DomainFailoverStateHandover.Ptr()
+	case apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_SWITCHED:
+		return shared.DomainFailoverStateSwitched.Ptr()
+	}
+	return nil
+}
+
+// FromTimeoutType converts a thrift TimeoutType to its protobuf counterpart
+func FromTimeoutType(t *shared.TimeoutType) apiv1.TimeoutType {
+	if t == nil {
+		return apiv1.TimeoutType_TIMEOUT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.TimeoutTypeStartToClose:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_START_TO_CLOSE
+	case shared.TimeoutTypeScheduleToStart:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_START
+	case shared.TimeoutTypeScheduleToClose:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_CLOSE
+	case shared.TimeoutTypeHeartbeat:
+		return apiv1.TimeoutType_TIMEOUT_TYPE_HEARTBEAT
+	}
+	return apiv1.TimeoutType_TIMEOUT_TYPE_INVALID
+}
+
+// ToTimeoutType converts a protobuf TimeoutType to its thrift counterpart
+func ToTimeoutType(t apiv1.TimeoutType) *shared.TimeoutType {
+	switch t {
+	case apiv1.TimeoutType_TIMEOUT_TYPE_INVALID:
+		return nil
+	case apiv1.TimeoutType_TIMEOUT_TYPE_START_TO_CLOSE:
+		return shared.TimeoutTypeStartToClose.Ptr()
+	case apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_START:
+		return shared.TimeoutTypeScheduleToStart.Ptr()
+	case apiv1.TimeoutType_TIMEOUT_TYPE_SCHEDULE_TO_CLOSE:
+		return shared.TimeoutTypeScheduleToClose.Ptr()
+	case apiv1.TimeoutType_TIMEOUT_TYPE_HEARTBEAT:
+		return shared.TimeoutTypeHeartbeat.Ptr()
+	}
+	return nil
+}
+
+// FromParentClosePolicy converts a thrift ParentClosePolicy to its protobuf counterpart
+func FromParentClosePolicy(t *shared.ParentClosePolicy) apiv1.ParentClosePolicy {
+	if t == nil {
+		return apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_INVALID
+	}
+	switch *t {
+	case shared.ParentClosePolicyAbandon:
+		return apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON
+	case shared.ParentClosePolicyRequestCancel:
+		return apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_REQUEST_CANCEL
+	case shared.ParentClosePolicyTerminate:
+		return apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_TERMINATE
+	}
+	return apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_INVALID
+}
+
+// ToParentClosePolicy converts a protobuf ParentClosePolicy to its thrift counterpart
+func ToParentClosePolicy(t apiv1.ParentClosePolicy) *shared.ParentClosePolicy {
+	switch t {
+	case apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_INVALID:
+		return nil
+	case apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON:
+		return shared.ParentClosePolicyAbandon.Ptr()
+	case apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_REQUEST_CANCEL:
+		return shared.ParentClosePolicyRequestCancel.Ptr()
+	case apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_TERMINATE:
+		return shared.ParentClosePolicyTerminate.Ptr()
+	}
+	return nil
+}
+
+// FromDecisionType converts a thrift DecisionType to its protobuf counterpart
+func FromDecisionType(t *shared.DecisionType) apiv1.DecisionType {
+	if t == nil {
+		return apiv1.DecisionType_DECISION_TYPE_INVALID
+	}
+	switch *t {
+	case shared.DecisionTypeScheduleActivityTask:
+		return apiv1.DecisionType_DECISION_TYPE_SCHEDULE_ACTIVITY_TASK
+	case shared.DecisionTypeRequestCancelActivityTask:
+		return apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_ACTIVITY_TASK
+	case shared.DecisionTypeStartTimer:
+		return apiv1.DecisionType_DECISION_TYPE_START_TIMER
+	case shared.DecisionTypeCompleteWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_COMPLETE_WORKFLOW_EXECUTION
+	case shared.DecisionTypeFailWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_FAIL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeCancelTimer:
+		return apiv1.DecisionType_DECISION_TYPE_CANCEL_TIMER
+	case shared.DecisionTypeCancelWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_CANCEL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeRequestCancelExternalWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeRecordMarker:
+		return apiv1.DecisionType_DECISION_TYPE_RECORD_MARKER
+	case shared.DecisionTypeContinueAsNewWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION
+	case shared.DecisionTypeStartChildWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_START_CHILD_WORKFLOW_EXECUTION
+	case shared.DecisionTypeSignalExternalWorkflowExecution:
+		return apiv1.DecisionType_DECISION_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION
+	case shared.DecisionTypeUpsertWorkflowSearchAttributes:
+		return apiv1.DecisionType_DECISION_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES
+	}
+	return apiv1.DecisionType_DECISION_TYPE_INVALID
+}
+
+// ToDecisionType converts a protobuf DecisionType to its thrift counterpart
+func ToDecisionType(t apiv1.DecisionType) *shared.DecisionType {
+	switch t {
+	case apiv1.DecisionType_DECISION_TYPE_INVALID:
+		return nil
+	case apiv1.DecisionType_DECISION_TYPE_SCHEDULE_ACTIVITY_TASK:
+		return shared.DecisionTypeScheduleActivityTask.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_ACTIVITY_TASK:
+		return shared.DecisionTypeRequestCancelActivityTask.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_START_TIMER:
+		return shared.DecisionTypeStartTimer.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_COMPLETE_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeCompleteWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_FAIL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeFailWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_CANCEL_TIMER:
+		return shared.DecisionTypeCancelTimer.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_CANCEL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeCancelWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeRequestCancelExternalWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_RECORD_MARKER:
+		return shared.DecisionTypeRecordMarker.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_CONTINUE_AS_NEW_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeContinueAsNewWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_START_CHILD_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeStartChildWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.DecisionTypeSignalExternalWorkflowExecution.Ptr()
+	case apiv1.DecisionType_DECISION_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		return shared.DecisionTypeUpsertWorkflowSearchAttributes.Ptr()
+	}
+	return nil
+}
+
+// FromEventType converts a thrift EventType to its protobuf counterpart
+func FromEventType(t *shared.EventType) apiv1.EventType {
+	if t == nil {
+		return apiv1.EventType_EVENT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.EventTypeWorkflowExecutionStarted:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED
+	case shared.EventTypeWorkflowExecutionCompleted:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	case shared.EventTypeWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeWorkflowExecutionTimedOut:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT
+	case shared.EventTypeDecisionTaskScheduled:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_SCHEDULED
+	case shared.EventTypeDecisionTaskStarted:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_STARTED
+	case shared.EventTypeDecisionTaskCompleted:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_COMPLETED
+	case shared.EventTypeDecisionTaskTimedOut:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_TIMED_OUT
+	case shared.EventTypeDecisionTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_DECISION_TASK_FAILED
+	case shared.EventTypeActivityTaskScheduled:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_SCHEDULED
+	case shared.EventTypeActivityTaskStarted:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_STARTED
+	case shared.EventTypeActivityTaskCompleted:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_COMPLETED
+	case shared.EventTypeActivityTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_FAILED
+	case shared.EventTypeActivityTaskTimedOut:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT
+	case shared.EventTypeActivityTaskCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED
+	case shared.EventTypeRequestCancelActivityTaskFailed:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED
+	case shared.EventTypeActivityTaskCanceled:
+		return apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCELED
+	case shared.EventTypeTimerStarted:
+		return apiv1.EventType_EVENT_TYPE_TIMER_STARTED
+	case shared.EventTypeTimerFired:
+		return apiv1.EventType_EVENT_TYPE_TIMER_FIRED
+	case shared.EventTypeCancelTimerFailed:
+		return apiv1.EventType_EVENT_TYPE_CANCEL_TIMER_FAILED
+	case shared.EventTypeTimerCanceled:
+		return apiv1.EventType_EVENT_TYPE_TIMER_CANCELED
+	case shared.EventTypeWorkflowExecutionCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case shared.EventTypeWorkflowExecutionCanceled:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeExternalWorkflowExecutionCancelRequested:
+		return apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED
+	case shared.EventTypeMarkerRecorded:
+		return apiv1.EventType_EVENT_TYPE_MARKER_RECORDED
+	case shared.EventTypeWorkflowExecutionSignaled:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED
+	case shared.EventTypeWorkflowExecutionTerminated:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED
+	case shared.EventTypeWorkflowExecutionContinuedAsNew:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW
+	case shared.EventTypeStartChildWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeStartChildWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeChildWorkflowExecutionStarted:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED
+	case shared.EventTypeChildWorkflowExecutionCompleted:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED
+	case shared.EventTypeChildWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeChildWorkflowExecutionCanceled:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED
+	case shared.EventTypeChildWorkflowExecutionTimedOut:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT
+	case shared.EventTypeChildWorkflowExecutionTerminated:
+		return apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED
+	case shared.EventTypeSignalExternalWorkflowExecutionInitiated:
+		return apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED
+	case shared.EventTypeSignalExternalWorkflowExecutionFailed:
+		return apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED
+	case shared.EventTypeExternalWorkflowExecutionSignaled:
+		return apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED
+	case shared.EventTypeUpsertWorkflowSearchAttributes:
+		return apiv1.EventType_EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES
+	case shared.EventTypeWorkflowExecutionTerminationProtectionSet:
+		return apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATION_PROTECTION_SET
+	}
+	return apiv1.EventType_EVENT_TYPE_INVALID
+}
+
+// ToEventType converts a protobuf EventType to its thrift counterpart
+func ToEventType(t apiv1.EventType) *shared.EventType {
+	switch t {
+	case apiv1.EventType_EVENT_TYPE_INVALID:
+		return nil
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+		return shared.EventTypeWorkflowExecutionStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return shared.EventTypeWorkflowExecutionCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return shared.EventTypeWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_SCHEDULED:
+		return shared.EventTypeDecisionTaskScheduled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_STARTED:
+		return shared.EventTypeDecisionTaskStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_COMPLETED:
+		return shared.EventTypeDecisionTaskCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_TIMED_OUT:
+		return shared.EventTypeDecisionTaskTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_DECISION_TASK_FAILED:
+		return shared.EventTypeDecisionTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+		return shared.EventTypeActivityTaskScheduled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_STARTED:
+		return shared.EventTypeActivityTaskStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+		return shared.EventTypeActivityTaskCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		return shared.EventTypeActivityTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+		return shared.EventTypeActivityTaskTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED:
+		return shared.EventTypeActivityTaskCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_ACTIVITY_TASK_FAILED:
+		return shared.EventTypeRequestCancelActivityTaskFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+		return shared.EventTypeActivityTaskCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_STARTED:
+		return shared.EventTypeTimerStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_FIRED:
+		return shared.EventTypeTimerFired.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CANCEL_TIMER_FAILED:
+		return shared.EventTypeCancelTimerFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_TIMER_CANCELED:
+		return shared.EventTypeTimerCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return shared.EventTypeWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return shared.EventTypeWorkflowExecutionCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeRequestCancelExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_CANCEL_REQUESTED:
+		return shared.EventTypeExternalWorkflowExecutionCancelRequested.Ptr()
+	case apiv1.EventType_EVENT_TYPE_MARKER_RECORDED:
+		return shared.EventTypeMarkerRecorded.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		return shared.EventTypeWorkflowExecutionSignaled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return shared.EventTypeWorkflowExecutionTerminated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return shared.EventTypeWorkflowExecutionContinuedAsNew.Ptr()
+	case apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeStartChildWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeStartChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+		return shared.EventTypeChildWorkflowExecutionStarted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+		return shared.EventTypeChildWorkflowExecutionCompleted.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeChildWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+		return shared.EventTypeChildWorkflowExecutionCanceled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+		return shared.EventTypeChildWorkflowExecutionTimedOut.Ptr()
+	case apiv1.EventType_EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+		return shared.EventTypeChildWorkflowExecutionTerminated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:
+		return shared.EventTypeSignalExternalWorkflowExecutionInitiated.Ptr()
+	case apiv1.EventType_EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED:
+		return shared.EventTypeSignalExternalWorkflowExecutionFailed.Ptr()
+	case apiv1.EventType_EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED:
+		return shared.EventTypeExternalWorkflowExecutionSignaled.Ptr()
+	case apiv1.EventType_EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		return shared.EventTypeUpsertWorkflowSearchAttributes.Ptr()
+	case apiv1.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATION_PROTECTION_SET:
+		return shared.EventTypeWorkflowExecutionTerminationProtectionSet.Ptr()
+	}
+	return nil
+}
+
+// FromDecisionTaskFailedCause converts a thrift DecisionTaskFailedCause to its protobuf counterpart
+func FromDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) apiv1.DecisionTaskFailedCause {
+	if t == nil {
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.DecisionTaskFailedCauseUnhandledDecision:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_UNHANDLED_DECISION
+	case shared.DecisionTaskFailedCauseBadScheduleActivityAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRequestCancelActivityAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadStartTimerAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCancelTimerAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_TIMER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRecordMarkerAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadContinueAsNewAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseStartTimerDuplicateID:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_START_TIMER_DUPLICATE_ID
+	case shared.DecisionTaskFailedCauseResetStickyTasklist:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_STICKY_TASKLIST
+	case shared.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE
+	case shared.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseBadStartChildExecutionAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES
+	case shared.DecisionTaskFailedCauseForceCloseDecision:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FORCE_CLOSE_DECISION
+	case shared.DecisionTaskFailedCauseFailoverCloseDecision:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FAILOVER_CLOSE_DECISION
+	case shared.DecisionTaskFailedCauseBadSignalInputSize:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE
+	case shared.DecisionTaskFailedCauseResetWorkflow:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_WORKFLOW
+	case shared.DecisionTaskFailedCauseBadBinary:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_BINARY
+	case shared.DecisionTaskFailedCauseScheduleActivityDuplicateID:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID
+	case shared.DecisionTaskFailedCauseBadSearchAttributes:
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES
+	}
+	return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID
+}
+
+// ToDecisionTaskFailedCause converts a protobuf DecisionTaskFailedCause to its thrift counterpart
+func ToDecisionTaskFailedCause(t apiv1.DecisionTaskFailedCause) *shared.DecisionTaskFailedCause {
+	switch t {
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID:
+		return nil
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_UNHANDLED_DECISION:
+		return shared.DecisionTaskFailedCauseUnhandledDecision.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadScheduleActivityAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRequestCancelActivityAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_TIMER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadStartTimerAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_TIMER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCancelTimerAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRecordMarkerAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadContinueAsNewAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_START_TIMER_DUPLICATE_ID:
+		return shared.DecisionTaskFailedCauseStartTimerDuplicateID.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_STICKY_TASKLIST:
+		return shared.DecisionTaskFailedCauseResetStickyTasklist.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE:
+		return shared.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadStartChildExecutionAttributes.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FORCE_CLOSE_DECISION:
+		return shared.DecisionTaskFailedCauseForceCloseDecision.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_FAILOVER_CLOSE_DECISION:
+		return shared.DecisionTaskFailedCauseFailoverCloseDecision.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SIGNAL_INPUT_SIZE:
+		return shared.DecisionTaskFailedCauseBadSignalInputSize.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_RESET_WORKFLOW:
+		return shared.DecisionTaskFailedCauseResetWorkflow.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_BINARY:
+		return shared.DecisionTaskFailedCauseBadBinary.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID:
+		return shared.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr()
+	case apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES:
+		return shared.DecisionTaskFailedCauseBadSearchAttributes.Ptr()
+	}
+	return nil
+}
+
+// FromCancelExternalWorkflowExecutionFailedCause converts a thrift CancelExternalWorkflowExecutionFailedCause to its protobuf counterpart
+func FromCancelExternalWorkflowExecutionFailedCause(t *shared.CancelExternalWorkflowExecutionFailedCause) apiv1.CancelExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.CancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution:
+		return apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToCancelExternalWorkflowExecutionFailedCause converts a protobuf CancelExternalWorkflowExecutionFailedCause to its thrift counterpart
+func ToCancelExternalWorkflowExecutionFailedCause(t apiv1.CancelExternalWorkflowExecutionFailedCause) *shared.CancelExternalWorkflowExecutionFailedCause {
+	switch t {
+	case apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID:
+		return nil
+	case apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.CancelExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromSignalExternalWorkflowExecutionFailedCause converts a thrift SignalExternalWorkflowExecutionFailedCause to its protobuf counterpart
+func FromSignalExternalWorkflowExecutionFailedCause(t *shared.SignalExternalWorkflowExecutionFailedCause) apiv1.SignalExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution:
+		return apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION
+	}
+	return apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToSignalExternalWorkflowExecutionFailedCause converts a protobuf SignalExternalWorkflowExecutionFailedCause to its thrift counterpart
+func ToSignalExternalWorkflowExecutionFailedCause(t apiv1.SignalExternalWorkflowExecutionFailedCause) *shared.SignalExternalWorkflowExecutionFailedCause {
+	switch t {
+	case apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID:
+		return nil
+	case apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION:
+		return shared.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution.Ptr()
+	}
+	return nil
+}
+
+// FromChildWorkflowExecutionFailedCause converts a thrift ChildWorkflowExecutionFailedCause to its protobuf counterpart
+func FromChildWorkflowExecutionFailedCause(t *shared.ChildWorkflowExecutionFailedCause) apiv1.ChildWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	switch *t {
+	case shared.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning:
+		return apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_RUNNING
+	}
+	return apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+}
+
+// ToChildWorkflowExecutionFailedCause converts a protobuf ChildWorkflowExecutionFailedCause to its thrift counterpart
+func ToChildWorkflowExecutionFailedCause(t apiv1.ChildWorkflowExecutionFailedCause) *shared.ChildWorkflowExecutionFailedCause {
+	switch t {
+	case apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID:
+		return nil
+	case apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_RUNNING:
+		return shared.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning.Ptr()
+	}
+	return nil
+}
+
+// FromWorkflowExecutionCloseStatus converts a thrift WorkflowExecutionCloseStatus to its protobuf counterpart
+func FromWorkflowExecutionCloseStatus(t *shared.WorkflowExecutionCloseStatus) apiv1.WorkflowExecutionCloseStatus {
+	if t == nil {
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+	}
+	switch *t {
+	case shared.WorkflowExecutionCloseStatusCompleted:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_COMPLETED
+	case shared.WorkflowExecutionCloseStatusFailed:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_FAILED
+	case shared.WorkflowExecutionCloseStatusCanceled:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CANCELED
+	case shared.WorkflowExecutionCloseStatusTerminated:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TERMINATED
+	case shared.WorkflowExecutionCloseStatusContinuedAsNew:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CONTINUED_AS_NEW
+	case shared.WorkflowExecutionCloseStatusTimedOut:
+		return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TIMED_OUT
+	}
+	return apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+}
+
+// ToWorkflowExecutionCloseStatus converts a protobuf WorkflowExecutionCloseStatus to its thrift counterpart
+func ToWorkflowExecutionCloseStatus(t apiv1.WorkflowExecutionCloseStatus) *shared.WorkflowExecutionCloseStatus {
+	switch t {
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID:
+		return nil
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_COMPLETED:
+		return shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_FAILED:
+		return shared.WorkflowExecutionCloseStatusFailed.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CANCELED:
+		return shared.WorkflowExecutionCloseStatusCanceled.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TERMINATED:
+		return shared.WorkflowExecutionCloseStatusTerminated.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_CONTINUED_AS_NEW:
+		return shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr()
+	case apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_TIMED_OUT:
+		return shared.WorkflowExecutionCloseStatusTimedOut.Ptr()
+	}
+	return nil
+}
+
+// FromQueryTaskCompletedType converts a thrift QueryTaskCompletedType to its protobuf counterpart
+func FromQueryTaskCompletedType(t *shared.QueryTaskCompletedType) apiv1.QueryTaskCompletedType {
+	if t == nil {
+		return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_INVALID
+	}
+	switch *t {
+	case shared.QueryTaskCompletedTypeCompleted:
+		return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_COMPLETED
+	case shared.QueryTaskCompletedTypeFailed:
+		return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_FAILED
+	}
+	return apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_INVALID
+}
+
+// ToQueryTaskCompletedType converts a protobuf QueryTaskCompletedType to its thrift counterpart
+func ToQueryTaskCompletedType(t apiv1.QueryTaskCompletedType) *shared.QueryTaskCompletedType {
+	switch t {
+	case apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_INVALID:
+		return nil
+	case apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_COMPLETED:
+		return shared.QueryTaskCompletedTypeCompleted.Ptr()
+	case apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_FAILED:
+		return shared.QueryTaskCompletedTypeFailed.Ptr()
+	}
+	return nil
+}
+
+// FromQueryResultType converts a thrift QueryResultType to its protobuf counterpart
+func FromQueryResultType(t *shared.QueryResultType) apiv1.QueryResultType {
+	if t == nil {
+		return apiv1.QueryResultType_QUERY_RESULT_TYPE_INVALID
+	}
+	switch *t {
+	case shared.QueryResultTypeAnswered:
+		return apiv1.QueryResultType_QUERY_RESULT_TYPE_ANSWERED
+	case shared.QueryResultTypeFailed:
+		return apiv1.QueryResultType_QUERY_RESULT_TYPE_FAILED
+	}
+	return apiv1.QueryResultType_QUERY_RESULT_TYPE_INVALID
+}
+
+// ToQueryResultType converts a protobuf QueryResultType to its thrift counterpart
+func ToQueryResultType(t apiv1.QueryResultType) *shared.QueryResultType {
+	switch t {
+	case apiv1.QueryResultType_QUERY_RESULT_TYPE_INVALID:
+		return nil
+	case apiv1.QueryResultType_QUERY_RESULT_TYPE_ANSWERED:
+		return shared.QueryResultTypeAnswered.Ptr()
+	case apiv1.QueryResultType_QUERY_RESULT_TYPE_FAILED:
+		return shared.QueryResultTypeFailed.Ptr()
+	}
+	return nil
+}
+
+// FromPendingActivityState converts a thrift PendingActivityState to its protobuf counterpart
+func FromPendingActivityState(t *shared.PendingActivityState) apiv1.PendingActivityState {
+	if t == nil {
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID
+	}
+	switch *t {
+	case shared.PendingActivityStateScheduled:
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_SCHEDULED
+	case shared.PendingActivityStateStarted:
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_STARTED
+	case shared.PendingActivityStateCancelRequested:
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_CANCEL_REQUESTED
+	case shared.PendingActivityStateNoPollers:
+		return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_NO_POLLERS
+	}
+	return apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID
+}
+
+// ToPendingActivityState converts a protobuf PendingActivityState to its thrift counterpart
+func ToPendingActivityState(t apiv1.PendingActivityState) *shared.PendingActivityState {
+	switch t {
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID:
+		return nil
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_SCHEDULED:
+		return shared.PendingActivityStateScheduled.Ptr()
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_STARTED:
+		return shared.PendingActivityStateStarted.Ptr()
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_CANCEL_REQUESTED:
+		return shared.PendingActivityStateCancelRequested.Ptr()
+	case apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_NO_POLLERS:
+		return shared.PendingActivityStateNoPollers.Ptr()
+	}
+	return nil
+}
+
+// FromHistoryEventFilterType converts a thrift HistoryEventFilterType to its protobuf counterpart
+func FromHistoryEventFilterType(t *shared.HistoryEventFilterType) apiv1.HistoryEventFilterType {
+	if t == nil {
+		return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_INVALID
+	}
+	switch *t {
+	case shared.HistoryEventFilterTypeAllEvent:
+		return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_ALL_EVENT
+	case shared.HistoryEventFilterTypeCloseEvent:
+		return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT
+	}
+	return apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_INVALID
+}
+
+// ToHistoryEventFilterType converts a protobuf HistoryEventFilterType to its thrift counterpart
+func ToHistoryEventFilterType(t apiv1.HistoryEventFilterType) *shared.HistoryEventFilterType {
+	switch t {
+	case apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_INVALID:
+		return nil
+	case apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_ALL_EVENT:
+		return shared.HistoryEventFilterTypeAllEvent.Ptr()
+	case apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT:
+		return shared.HistoryEventFilterTypeCloseEvent.Ptr()
+	}
+	return nil
+}
+
+// FromTaskListKind converts a thrift TaskListKind to its protobuf counterpart
+func FromTaskListKind(t *shared.TaskListKind) apiv1.TaskListKind {
+	if t == nil {
+		return apiv1.TaskListKind_TASK_LIST_KIND_INVALID
+	}
+	switch *t {
+	case shared.TaskListKindNormal:
+		return apiv1.TaskListKind_TASK_LIST_KIND_NORMAL
+	case shared.TaskListKindSticky:
+		return apiv1.TaskListKind_TASK_LIST_KIND_STICKY
+	case shared.TaskListKindWorker:
+		return apiv1.TaskListKind_TASK_LIST_KIND_WORKER
+	}
+	return apiv1.TaskListKind_TASK_LIST_KIND_INVALID
+}
+
+// ToTaskListKind converts a protobuf TaskListKind to its thrift counterpart
+func ToTaskListKind(t apiv1.TaskListKind) *shared.TaskListKind {
+	switch t {
+	case apiv1.TaskListKind_TASK_LIST_KIND_INVALID:
+		return nil
+	case apiv1.TaskListKind_TASK_LIST_KIND_NORMAL:
+		return shared.TaskListKindNormal.Ptr()
+	case apiv1.TaskListKind_TASK_LIST_KIND_STICKY:
+		return shared.TaskListKindSticky.Ptr()
+	case apiv1.TaskListKind_TASK_LIST_KIND_WORKER:
+		return shared.TaskListKindWorker.Ptr()
+	}
+	return nil
+}
+
+// FromArchivalStatus converts a thrift ArchivalStatus to its protobuf counterpart
+func FromArchivalStatus(t *shared.ArchivalStatus) apiv1.ArchivalStatus {
+	if t == nil {
+		return apiv1.ArchivalStatus_ARCHIVAL_STATUS_INVALID
+	}
+	switch *t {
+	case shared.ArchivalStatusDisabled:
+		return apiv1.ArchivalStatus_ARCHIVAL_STATUS_DISABLED
+	case shared.ArchivalStatusEnabled:
+		return apiv1.ArchivalStatus_ARCHIVAL_STATUS_ENABLED
+	}
+	return apiv1.ArchivalStatus_ARCHIVAL_STATUS_INVALID
+}
+
+// ToArchivalStatus converts a protobuf ArchivalStatus to its thrift counterpart
+func ToArchivalStatus(t apiv1.ArchivalStatus) *shared.ArchivalStatus {
+	switch t {
+	case apiv1.ArchivalStatus_ARCHIVAL_STATUS_INVALID:
+		return nil
+	case apiv1.ArchivalStatus_ARCHIVAL_STATUS_DISABLED:
+		return shared.ArchivalStatusDisabled.Ptr()
+	case apiv1.ArchivalStatus_ARCHIVAL_STATUS_ENABLED:
+		return shared.ArchivalStatusEnabled.Ptr()
+	}
+	return nil
+}
+
+// FromIndexedValueType converts a thrift IndexedValueType to its protobuf counterpart
+func FromIndexedValueType(t *shared.IndexedValueType) apiv1.IndexedValueType {
+	if t == nil {
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_INVALID
+	}
+	switch *t {
+	case shared.IndexedValueTypeString:
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_STRING
+	case shared.IndexedValueTypeKeyword:
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD
+	case shared.IndexedValueTypeInt:
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_INT
+	case shared.IndexedValueTypeDouble:
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_DOUBLE
+	case shared.IndexedValueTypeBool:
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_BOOL
+	case shared.IndexedValueTypeDatetime:
+		return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME
+	}
+	return apiv1.IndexedValueType_INDEXED_VALUE_TYPE_INVALID
+}
+
+// ToIndexedValueType converts a protobuf IndexedValueType to its thrift counterpart
+func ToIndexedValueType(t apiv1.IndexedValueType) *shared.IndexedValueType {
+	switch t {
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_INVALID:
+		return nil
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_STRING:
+		return shared.IndexedValueTypeString.Ptr()
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD:
+		return shared.IndexedValueTypeKeyword.Ptr()
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_INT:
+		return shared.IndexedValueTypeInt.Ptr()
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_DOUBLE:
+		return shared.IndexedValueTypeDouble.Ptr()
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_BOOL:
+		return shared.IndexedValueTypeBool.Ptr()
+	case apiv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME:
+		return shared.IndexedValueTypeDatetime.Ptr()
+	}
+	return nil
+}
+
+// FromHeader converts a thrift Header to its protobuf counterpart
+func FromHeader(t *shared.Header) *apiv1.Header {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Header{
+		Fields: t.Fields,
+	}
+}
+
+// ToHeader converts a protobuf Header to its thrift counterpart
+func ToHeader(t *apiv1.Header) *shared.Header {
+	if t == nil {
+		return nil
+	}
+	return &shared.Header{
+		Fields: t.Fields,
+	}
+}
+
+// FromWorkflowType converts a thrift WorkflowType to its protobuf counterpart
+func FromWorkflowType(t *shared.WorkflowType) *apiv1.WorkflowType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowType{
+		Name: t.GetName(),
+	}
+}
+
+// ToWorkflowType converts a protobuf WorkflowType to its thrift counterpart
+func ToWorkflowType(t *apiv1.WorkflowType) *shared.WorkflowType {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowType{
+		Name: stringPtr(t.Name),
+	}
+}
+
+// FromActivityType converts a thrift ActivityType to its protobuf counterpart
+func FromActivityType(t *shared.ActivityType) *apiv1.ActivityType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityType{
+		Name: t.GetName(),
+	}
+}
+
+// ToActivityType converts a protobuf ActivityType to its thrift counterpart
+func ToActivityType(t *apiv1.ActivityType) *shared.ActivityType {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityType{
+		Name: stringPtr(t.Name),
+	}
+}
+
+// FromTaskList converts a thrift TaskList to its protobuf counterpart
+func FromTaskList(t *shared.TaskList) *apiv1.TaskList {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskList{
+		Name: t.GetName(),
+		Kind: FromTaskListKind(t.Kind),
+	}
+}
+
+// ToTaskList converts a protobuf TaskList to its thrift counterpart
+func ToTaskList(t *apiv1.TaskList) *shared.TaskList {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskList{
+		Name: stringPtr(t.Name),
+		Kind: ToTaskListKind(t.Kind),
+	}
+}
+
+// FromQueryRejectCondition converts a thrift QueryRejectCondition to its protobuf counterpart
+func FromQueryRejectCondition(t *shared.QueryRejectCondition) apiv1.QueryRejectCondition {
+	if t == nil {
+		return apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID
+	}
+	switch *t {
+	case shared.QueryRejectConditionNotOpen:
+		return apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_NOT_OPEN
+	case shared.QueryRejectConditionNotCompletedCleanly:
+		return apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY
+	}
+	return apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID
+}
+
+// ToQueryRejectCondition converts a protobuf QueryRejectCondition to its thrift counterpart
+func ToQueryRejectCondition(t apiv1.QueryRejectCondition) *shared.QueryRejectCondition {
+	switch t {
+	case apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID:
+		return nil
+	case apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_NOT_OPEN:
+		return shared.QueryRejectConditionNotOpen.Ptr()
+	case apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY:
+		return shared.QueryRejectConditionNotCompletedCleanly.Ptr()
+	}
+	return nil
+}
+
+// FromTaskListMetadata converts a thrift TaskListMetadata to its protobuf counterpart
+func FromTaskListMetadata(t *shared.TaskListMetadata) *apiv1.TaskListMetadata {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListMetadata{
+		MaxTasksPerSecond: t.GetMaxTasksPerSecond(),
+	}
+}
+
+// ToTaskListMetadata converts a protobuf TaskListMetadata to its thrift counterpart
+func ToTaskListMetadata(t *apiv1.TaskListMetadata) *shared.TaskListMetadata {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListMetadata{
+		MaxTasksPerSecond: float64Ptr(t.MaxTasksPerSecond),
+	}
+}
+
+// FromWorkflowExecution converts a thrift WorkflowExecution to its protobuf counterpart
+func FromWorkflowExecution(t *shared.WorkflowExecution) *apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecution{
+		WorkflowId: t.GetWorkflowId(),
+		RunId:      t.GetRunId(),
+	}
+}
+
+// ToWorkflowExecution converts a protobuf WorkflowExecution to its thrift counterpart
+func ToWorkflowExecution(t *apiv1.WorkflowExecution) *shared.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecution{
+		WorkflowId: stringPtr(t.WorkflowId),
+		RunId:      stringPtr(t.RunId),
+	}
+}
+
+// FromMemo converts a thrift Memo to its protobuf counterpart
+func FromMemo(t *shared.Memo) *apiv1.Memo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Memo{
+		Fields: t.Fields,
+	}
+}
+
+// ToMemo converts a protobuf Memo to its thrift counterpart
+func ToMemo(t *apiv1.Memo) *shared.Memo {
+	if t == nil {
+		return nil
+	}
+	return &shared.Memo{
+		Fields: t.Fields,
+	}
+}
+
+// FromSearchAttributes converts a thrift SearchAttributes to its protobuf counterpart
+func FromSearchAttributes(t *shared.SearchAttributes) *apiv1.SearchAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SearchAttributes{
+		IndexedFields: t.IndexedFields,
+	}
+}
+
+// ToSearchAttributes converts a protobuf SearchAttributes to its thrift counterpart
+func ToSearchAttributes(t *apiv1.SearchAttributes) *shared.SearchAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.SearchAttributes{
+		IndexedFields: t.IndexedFields,
+	}
+}
+
+// FromWorkflowExecutionInfo converts a thrift WorkflowExecutionInfo to its protobuf counterpart
+func FromWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionInfo{
+		Execution:           FromWorkflowExecution(t.Execution),
+		Type:                FromWorkflowType(t.Type),
+		StartTime:           t.GetStartTime(),
+		CloseTime:           t.GetCloseTime(),
+		CloseStatus:         FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength:       t.GetHistoryLength(),
+		ParentDomainId:      t.GetParentDomainId(),
+		ParentExecution:     FromWorkflowExecution(t.ParentExecution),
+		ExecutionTime:       t.GetExecutionTime(),
+		Memo:                FromMemo(t.Memo),
+		SearchAttributes:    FromSearchAttributes(t.SearchAttributes),
+		AutoResetPoints:     FromResetPoints(t.AutoResetPoints),
+		HistoryArchivalInfo: FromHistoryArchivalInfo(t.HistoryArchivalInfo),
+	}
+}
+
+// ToWorkflowExecutionInfo converts a protobuf WorkflowExecutionInfo to its thrift counterpart
+func ToWorkflowExecutionInfo(t *apiv1.WorkflowExecutionInfo) *shared.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionInfo{
+		Execution:           ToWorkflowExecution(t.Execution),
+		Type:                ToWorkflowType(t.Type),
+		StartTime:           int64Ptr(t.StartTime),
+		CloseTime:           int64Ptr(t.CloseTime),
+		CloseStatus:         ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength:       int64Ptr(t.HistoryLength),
+		ParentDomainId:      stringPtr(t.ParentDomainId),
+		ParentExecution:     ToWorkflowExecution(t.ParentExecution),
+		ExecutionTime:       int64Ptr(t.ExecutionTime),
+		Memo:                ToMemo(t.Memo),
+		SearchAttributes:    ToSearchAttributes(t.SearchAttributes),
+		AutoResetPoints:     ToResetPoints(t.AutoResetPoints),
+		HistoryArchivalInfo: ToHistoryArchivalInfo(t.HistoryArchivalInfo),
+	}
+}
+
+// FromHistoryArchivalState converts a thrift HistoryArchivalState to its protobuf counterpart
+func FromHistoryArchivalState(t *shared.HistoryArchivalState) apiv1.HistoryArchivalState {
+	if t == nil {
+		return apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_INVALID
+	}
+	switch *t {
+	case shared.HistoryArchivalStatePending:
+		return apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_PENDING
+	case shared.HistoryArchivalStateArchived:
+		return apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_ARCHIVED
+	case shared.HistoryArchivalStateFailed:
+		return apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_FAILED
+	}
+	return apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_INVALID
+}
+
+// ToHistoryArchivalState converts a protobuf HistoryArchivalState to its thrift counterpart
+func ToHistoryArchivalState(t apiv1.HistoryArchivalState) *shared.HistoryArchivalState {
+	switch t {
+	case apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_INVALID:
+		return nil
+	case apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_PENDING:
+		return shared.HistoryArchivalStatePending.Ptr()
+	case apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_ARCHIVED:
+		return shared.HistoryArchivalStateArchived.Ptr()
+	case apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_FAILED:
+		return shared.HistoryArchivalStateFailed.Ptr()
+	}
+	return nil
+}
+
+// FromHistoryArchivalInfo converts a thrift HistoryArchivalInfo to its protobuf counterpart
+func FromHistoryArchivalInfo(t *shared.HistoryArchivalInfo) *apiv1.HistoryArchivalInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.HistoryArchivalInfo{
+		State:         FromHistoryArchivalState(t.State),
+		Uri:           t.GetURI(),
+		ArchivedTime:  t.GetArchivedTime(),
+		FailureReason: t.GetFailureReason(),
+	}
+}
+
+// ToHistoryArchivalInfo converts a protobuf HistoryArchivalInfo to its thrift counterpart
+func ToHistoryArchivalInfo(t *apiv1.HistoryArchivalInfo) *shared.HistoryArchivalInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.HistoryArchivalInfo{
+		State:         ToHistoryArchivalState(t.State),
+		URI:           stringPtr(t.Uri),
+		ArchivedTime:  int64Ptr(t.ArchivedTime),
+		FailureReason: stringPtr(t.FailureReason),
+	}
+}
+
+// FromWorkflowExecutionStats converts a thrift WorkflowExecutionStats to its protobuf counterpart
+func FromWorkflowExecutionStats(t *shared.WorkflowExecutionStats) *apiv1.WorkflowExecutionStats {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionStats{
+		HistorySize:          t.GetHistorySize(),
+		EventCount:           t.GetEventCount(),
+		ActivityPayloadBytes: t.GetActivityPayloadBytes(),
+		SignalEventCount:     t.GetSignalEventCount(),
+		TimerCount:           t.GetTimerCount(),
+	}
+}
+
+// ToWorkflowExecutionStats converts a protobuf WorkflowExecutionStats to its thrift counterpart
+func ToWorkflowExecutionStats(t *apiv1.WorkflowExecutionStats) *shared.WorkflowExecutionStats {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionStats{
+		HistorySize:          int64Ptr(t.HistorySize),
+		EventCount:           int64Ptr(t.EventCount),
+		ActivityPayloadBytes: int64Ptr(t.ActivityPayloadBytes),
+		SignalEventCount:     int64Ptr(t.SignalEventCount),
+		TimerCount:           int64Ptr(t.TimerCount),
+	}
+}
+
+// FromWorkflowExecutionConfiguration converts a thrift WorkflowExecutionConfiguration to its protobuf counterpart
+func FromWorkflowExecutionConfiguration(t *shared.WorkflowExecutionConfiguration) *apiv1.WorkflowExecutionConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionConfiguration{
+		TaskList:                            FromTaskList(t.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+	}
+}
+
+// ToWorkflowExecutionConfiguration converts a protobuf WorkflowExecutionConfiguration to its thrift counterpart
+func ToWorkflowExecutionConfiguration(t *apiv1.WorkflowExecutionConfiguration) *shared.WorkflowExecutionConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionConfiguration{
+		TaskList:                            ToTaskList(t.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+	}
+}
+
+// FromScheduleActivityTaskDecisionAttributes converts a thrift ScheduleActivityTaskDecisionAttributes to its protobuf counterpart
+func FromScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDecisionAttributes) *apiv1.ScheduleActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  FromActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      FromTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+		Header:                        FromHeader(t.Header),
+		DelayStartSeconds:             t.GetDelayStartSeconds(),
+	}
+}
+
+// ToScheduleActivityTaskDecisionAttributes converts a protobuf ScheduleActivityTaskDecisionAttributes to its thrift counterpart
+func ToScheduleActivityTaskDecisionAttributes(t *apiv1.ScheduleActivityTaskDecisionAttributes) *shared.ScheduleActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    stringPtr(t.ActivityId),
+		ActivityType:                  ToActivityType(t.ActivityType),
+		Domain:                        stringPtr(t.Domain),
+		TaskList:                      ToTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: int32Ptr(t.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: int32Ptr(t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    int32Ptr(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       int32Ptr(t.HeartbeatTimeoutSeconds),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		Header:                        ToHeader(t.Header),
+		DelayStartSeconds:             int32Ptr(t.DelayStartSeconds),
+	}
+}
+
+// FromRequestCancelActivityTaskDecisionAttributes converts a thrift RequestCancelActivityTaskDecisionAttributes to its protobuf counterpart
+func FromRequestCancelActivityTaskDecisionAttributes(t *shared.RequestCancelActivityTaskDecisionAttributes) *apiv1.RequestCancelActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: t.GetActivityId(),
+	}
+}
+
+// ToRequestCancelActivityTaskDecisionAttributes converts a protobuf RequestCancelActivityTaskDecisionAttributes to its thrift counterpart
+func ToRequestCancelActivityTaskDecisionAttributes(t *apiv1.RequestCancelActivityTaskDecisionAttributes) *shared.RequestCancelActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: stringPtr(t.ActivityId),
+	}
+}
+
+// FromStartTimerDecisionAttributes converts a thrift StartTimerDecisionAttributes to its protobuf counterpart
+func FromStartTimerDecisionAttributes(t *shared.StartTimerDecisionAttributes) *apiv1.StartTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimerDecisionAttributes{
+		TimerId:                   t.GetTimerId(),
+		StartToFireTimeoutSeconds: t.GetStartToFireTimeoutSeconds(),
+	}
+}
+
+// ToStartTimerDecisionAttributes converts a protobuf StartTimerDecisionAttributes to its thrift counterpart
+func ToStartTimerDecisionAttributes(t *apiv1.StartTimerDecisionAttributes) *shared.StartTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartTimerDecisionAttributes{
+		TimerId:                   stringPtr(t.TimerId),
+		StartToFireTimeoutSeconds: int64Ptr(t.StartToFireTimeoutSeconds),
+	}
+}
+
+// FromCompleteWorkflowExecutionDecisionAttributes converts a thrift CompleteWorkflowExecutionDecisionAttributes to its protobuf counterpart
+func FromCompleteWorkflowExecutionDecisionAttributes(t *shared.CompleteWorkflowExecutionDecisionAttributes) *apiv1.CompleteWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompleteWorkflowExecutionDecisionAttributes{
+		Result: t.Result,
+	}
+}
+
+// ToCompleteWorkflowExecutionDecisionAttributes converts a protobuf CompleteWorkflowExecutionDecisionAttributes to its thrift counterpart
+func ToCompleteWorkflowExecutionDecisionAttributes(t *apiv1.CompleteWorkflowExecutionDecisionAttributes) *shared.CompleteWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompleteWorkflowExecutionDecisionAttributes{
+		Result: t.Result,
+	}
+}
+
+// FromFailWorkflowExecutionDecisionAttributes converts a thrift FailWorkflowExecutionDecisionAttributes to its protobuf counterpart
+func FromFailWorkflowExecutionDecisionAttributes(t *shared.FailWorkflowExecutionDecisionAttributes) *apiv1.FailWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.FailWorkflowExecutionDecisionAttributes{
+		Reason:  t.GetReason(),
+		Details: t.Details,
+	}
+}
+
+// ToFailWorkflowExecutionDecisionAttributes converts a protobuf FailWorkflowExecutionDecisionAttributes to its thrift counterpart
+func ToFailWorkflowExecutionDecisionAttributes(t *apiv1.FailWorkflowExecutionDecisionAttributes) *shared.FailWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.FailWorkflowExecutionDecisionAttributes{
+		Reason:  stringPtr(t.Reason),
+		Details: t.Details,
+	}
+}
+
+// FromCancelTimerDecisionAttributes converts a thrift CancelTimerDecisionAttributes to its protobuf counterpart
+func FromCancelTimerDecisionAttributes(t *shared.CancelTimerDecisionAttributes) *apiv1.CancelTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerDecisionAttributes{
+		TimerId: t.GetTimerId(),
+	}
+}
+
+// ToCancelTimerDecisionAttributes converts a protobuf CancelTimerDecisionAttributes to its thrift counterpart
+func ToCancelTimerDecisionAttributes(t *apiv1.CancelTimerDecisionAttributes) *shared.CancelTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.CancelTimerDecisionAttributes{
+		TimerId: stringPtr(t.TimerId),
+	}
+}
+
+// FromCancelWorkflowExecutionDecisionAttributes converts a thrift CancelWorkflowExecutionDecisionAttributes to its protobuf counterpart
+func FromCancelWorkflowExecutionDecisionAttributes(t *shared.CancelWorkflowExecutionDecisionAttributes) *apiv1.CancelWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelWorkflowExecutionDecisionAttributes{
+		Details: t.Details,
+	}
+}
+
+// ToCancelWorkflowExecutionDecisionAttributes converts a protobuf CancelWorkflowExecutionDecisionAttributes to its thrift counterpart
+func ToCancelWorkflowExecutionDecisionAttributes(t *apiv1.CancelWorkflowExecutionDecisionAttributes) *shared.CancelWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.CancelWorkflowExecutionDecisionAttributes{
+		Details: t.Details,
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionDecisionAttributes converts a thrift RequestCancelExternalWorkflowExecutionDecisionAttributes to its protobuf counterpart
+func FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes) *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowId:        t.GetWorkflowId(),
+		RunId:             t.GetRunId(),
+		Control:           t.Control,
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionDecisionAttributes converts a protobuf RequestCancelExternalWorkflowExecutionDecisionAttributes to its thrift counterpart
+func ToRequestCancelExternalWorkflowExecutionDecisionAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes) *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(t.Domain),
+		WorkflowId:        stringPtr(t.WorkflowId),
+		RunId:             stringPtr(t.RunId),
+		Control:           t.Control,
+		ChildWorkflowOnly: boolPtr(t.ChildWorkflowOnly),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionDecisionAttributes converts a thrift SignalExternalWorkflowExecutionDecisionAttributes to its protobuf counterpart
+func FromSignalExternalWorkflowExecutionDecisionAttributes(t *shared.SignalExternalWorkflowExecutionDecisionAttributes) *apiv1.SignalExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		Execution:         FromWorkflowExecution(t.Execution),
+		SignalName:        t.GetSignalName(),
+		Input:             t.Input,
+		Control:           t.Control,
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToSignalExternalWorkflowExecutionDecisionAttributes converts a protobuf SignalExternalWorkflowExecutionDecisionAttributes to its thrift counterpart
+func ToSignalExternalWorkflowExecutionDecisionAttributes(t *apiv1.SignalExternalWorkflowExecutionDecisionAttributes) *shared.SignalExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(t.Domain),
+		Execution:         ToWorkflowExecution(t.Execution),
+		SignalName:        stringPtr(t.SignalName),
+		Input:             t.Input,
+		Control:           t.Control,
+		ChildWorkflowOnly: boolPtr(t.ChildWorkflowOnly),
+	}
+}
+
+// FromUpsertWorkflowSearchAttributesDecisionAttributes converts a thrift UpsertWorkflowSearchAttributesDecisionAttributes to its protobuf counterpart
+func FromUpsertWorkflowSearchAttributesDecisionAttributes(t *shared.UpsertWorkflowSearchAttributesDecisionAttributes) *apiv1.UpsertWorkflowSearchAttributesDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpsertWorkflowSearchAttributesDecisionAttributes{
+		SearchAttributes: FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToUpsertWorkflowSearchAttributesDecisionAttributes converts a protobuf UpsertWorkflowSearchAttributesDecisionAttributes to its thrift counterpart
+func ToUpsertWorkflowSearchAttributesDecisionAttributes(t *apiv1.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowSearchAttributesDecisionAttributes{
+		SearchAttributes: ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// FromRecordMarkerDecisionAttributes converts a thrift RecordMarkerDecisionAttributes to its protobuf counterpart
+func FromRecordMarkerDecisionAttributes(t *shared.RecordMarkerDecisionAttributes) *apiv1.RecordMarkerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordMarkerDecisionAttributes{
+		MarkerName: t.GetMarkerName(),
+		Details:    t.Details,
+		Header:     FromHeader(t.Header),
+	}
+}
+
+// ToRecordMarkerDecisionAttributes converts a protobuf RecordMarkerDecisionAttributes to its thrift counterpart
+func ToRecordMarkerDecisionAttributes(t *apiv1.RecordMarkerDecisionAttributes) *shared.RecordMarkerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.RecordMarkerDecisionAttributes{
+		MarkerName: stringPtr(t.MarkerName),
+		Details:    t.Details,
+		Header:     ToHeader(t.Header),
+	}
+}
+
+// FromContinueAsNewWorkflowExecutionDecisionAttributes converts a thrift ContinueAsNewWorkflowExecutionDecisionAttributes to its protobuf counterpart
+func FromContinueAsNewWorkflowExecutionDecisionAttributes(t *shared.ContinueAsNewWorkflowExecutionDecisionAttributes) *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		Initiator:                           FromContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       t.GetFailureReason(),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		CronSchedule:                        t.GetCronSchedule(),
+		Header:                              FromHeader(t.Header),
+		Memo:                                FromMemo(t.Memo),
+		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToContinueAsNewWorkflowExecutionDecisionAttributes converts a protobuf ContinueAsNewWorkflowExecutionDecisionAttributes to its thrift counterpart
+func ToContinueAsNewWorkflowExecutionDecisionAttributes(t *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes) *shared.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+		BackoffStartIntervalInSeconds:       int32Ptr(t.BackoffStartIntervalInSeconds),
+		RetryPolicy:                         ToRetryPolicy(t.RetryPolicy),
+		Initiator:                           ToContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       stringPtr(t.FailureReason),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		CronSchedule:                        stringPtr(t.CronSchedule),
+		Header:                              ToHeader(t.Header),
+		Memo:                                ToMemo(t.Memo),
+		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// FromStartChildWorkflowExecutionDecisionAttributes converts a thrift StartChildWorkflowExecutionDecisionAttributes to its protobuf counterpart
+func FromStartChildWorkflowExecutionDecisionAttributes(t *shared.StartChildWorkflowExecutionDecisionAttributes) *apiv1.StartChildWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ParentClosePolicy:                   FromParentClosePolicy(t.ParentClosePolicy),
+		Control:                             t.Control,
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Header:                              FromHeader(t.Header),
+		Memo:                                FromMemo(t.Memo),
+		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToStartChildWorkflowExecutionDecisionAttributes converts a protobuf StartChildWorkflowExecutionDecisionAttributes to its thrift counterpart
+func ToStartChildWorkflowExecutionDecisionAttributes(t *apiv1.StartChildWorkflowExecutionDecisionAttributes) *shared.StartChildWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              stringPtr(t.Domain),
+		WorkflowId:                          stringPtr(t.WorkflowId),
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+		ParentClosePolicy:                   ToParentClosePolicy(t.ParentClosePolicy),
+		Control:                             t.Control,
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        stringPtr(t.CronSchedule),
+		Header:                              ToHeader(t.Header),
+		Memo:                                ToMemo(t.Memo),
+		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// FromDecision converts a thrift Decision to its protobuf counterpart
+func FromDecision(t *shared.Decision) *apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Decision{
+		DecisionType:                                             FromDecisionType(t.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   FromScheduleActivityTaskDecisionAttributes(t.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             FromStartTimerDecisionAttributes(t.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              FromCompleteWorkflowExecutionDecisionAttributes(t.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  FromFailWorkflowExecutionDecisionAttributes(t.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              FromRequestCancelActivityTaskDecisionAttributes(t.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            FromCancelTimerDecisionAttributes(t.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                FromCancelWorkflowExecutionDecisionAttributes(t.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: FromRequestCancelExternalWorkflowExecutionDecisionAttributes(t.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           FromRecordMarkerDecisionAttributes(t.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         FromContinueAsNewWorkflowExecutionDecisionAttributes(t.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes:         FromUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+	}
+}
+
+// ToDecision converts a protobuf Decision to its thrift counterpart
+func ToDecision(t *apiv1.Decision) *shared.Decision {
+	if t == nil {
+		return nil
+	}
+	return &shared.Decision{
+		DecisionType:                                             ToDecisionType(t.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   ToScheduleActivityTaskDecisionAttributes(t.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             ToStartTimerDecisionAttributes(t.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              ToCompleteWorkflowExecutionDecisionAttributes(t.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  ToFailWorkflowExecutionDecisionAttributes(t.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              ToRequestCancelActivityTaskDecisionAttributes(t.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            ToCancelTimerDecisionAttributes(t.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                ToCancelWorkflowExecutionDecisionAttributes(t.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: ToRequestCancelExternalWorkflowExecutionDecisionAttributes(t.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           ToRecordMarkerDecisionAttributes(t.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         ToContinueAsNewWorkflowExecutionDecisionAttributes(t.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes:         ToUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+	}
+}
+
+// FromWorkflowExecutionStartedEventAttributes converts a thrift WorkflowExecutionStartedEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStartedEventAttributes) *apiv1.WorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		ParentWorkflowDomain:                t.GetParentWorkflowDomain(),
+		ParentWorkflowExecution:             FromWorkflowExecution(t.ParentWorkflowExecution),
+		ParentInitiatedEventId:              t.GetParentInitiatedEventId(),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ContinuedExecutionRunId:             t.GetContinuedExecutionRunId(),
+		Initiator:                           FromContinueAsNewInitiator(t.Initiator),
+		ContinuedFailureReason:              t.GetContinuedFailureReason(),
+		ContinuedFailureDetails:             t.ContinuedFailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		OriginalExecutionRunId:              t.GetOriginalExecutionRunId(),
+		Identity:                            t.GetIdentity(),
+		FirstExecutionRunId:                 t.GetFirstExecutionRunId(),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		Attempt:                             t.GetAttempt(),
+		ExpirationTimestamp:                 t.GetExpirationTimestamp(),
+		CronSchedule:                        t.GetCronSchedule(),
+		FirstDecisionTaskBackoffSeconds:     t.GetFirstDecisionTaskBackoffSeconds(),
+		Memo:                                FromMemo(t.Memo),
+		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+		PrevAutoResetPoints:                 FromResetPoints(t.PrevAutoResetPoints),
+		Header:                              FromHeader(t.Header),
+		Priority:                            t.GetPriority(),
+		TerminationProtected:                t.GetTerminationProtected(),
+	}
+}
+
+// ToWorkflowExecutionStartedEventAttributes converts a protobuf WorkflowExecutionStartedEventAttributes to its thrift counterpart
+func ToWorkflowExecutionStartedEventAttributes(t *apiv1.WorkflowExecutionStartedEventAttributes) *shared.WorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		ParentWorkflowDomain:                stringPtr(t.ParentWorkflowDomain),
+		ParentWorkflowExecution:             ToWorkflowExecution(t.ParentWorkflowExecution),
+		ParentInitiatedEventId:              int64Ptr(t.ParentInitiatedEventId),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+		ContinuedExecutionRunId:             stringPtr(t.ContinuedExecutionRunId),
+		Initiator:                           ToContinueAsNewInitiator(t.Initiator),
+		ContinuedFailureReason:              stringPtr(t.ContinuedFailureReason),
+		ContinuedFailureDetails:             t.ContinuedFailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		OriginalExecutionRunId:              stringPtr(t.OriginalExecutionRunId),
+		Identity:                            stringPtr(t.Identity),
+		FirstExecutionRunId:                 stringPtr(t.FirstExecutionRunId),
+		RetryPolicy:                         ToRetryPolicy(t.RetryPolicy),
+		Attempt:                             int32Ptr(t.Attempt),
+		ExpirationTimestamp:                 int64Ptr(t.ExpirationTimestamp),
+		CronSchedule:                        stringPtr(t.CronSchedule),
+		FirstDecisionTaskBackoffSeconds:     int32Ptr(t.FirstDecisionTaskBackoffSeconds),
+		Memo:                                ToMemo(t.Memo),
+		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		PrevAutoResetPoints:                 ToResetPoints(t.PrevAutoResetPoints),
+		Header:                              ToHeader(t.Header),
+		Priority:                            int32Ptr(t.Priority),
+		TerminationProtected:                boolPtr(t.TerminationProtected),
+	}
+}
+
+// FromResetPoints converts a thrift ResetPoints to its protobuf counterpart
+func FromResetPoints(t *shared.ResetPoints) *apiv1.ResetPoints {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetPoints{
+		Points: FromResetPointInfoArray(t.Points),
+	}
+}
+
+// ToResetPoints converts a protobuf ResetPoints to its thrift counterpart
+func ToResetPoints(t *apiv1.ResetPoints) *shared.ResetPoints {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetPoints{
+		Points: ToResetPointInfoArray(t.Points),
+	}
+}
+
+// FromResetPointInfo converts a thrift ResetPointInfo to its protobuf counterpart
+func FromResetPointInfo(t *shared.ResetPointInfo) *apiv1.ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetPointInfo{
+		BinaryChecksum:           t.GetBinaryChecksum(),
+		RunId:                    t.GetRunId(),
+		FirstDecisionCompletedId: t.GetFirstDecisionCompletedId(),
+		CreatedTimeNano:          t.GetCreatedTimeNano(),
+		ExpiringTimeNano:         t.GetExpiringTimeNano(),
+		Resettable:               t.GetResettable(),
+	}
+}
+
+// ToResetPointInfo converts a protobuf ResetPointInfo to its thrift counterpart
+func ToResetPointInfo(t *apiv1.ResetPointInfo) *shared.ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetPointInfo{
+		BinaryChecksum:           stringPtr(t.BinaryChecksum),
+		RunId:                    stringPtr(t.RunId),
+		FirstDecisionCompletedId: int64Ptr(t.FirstDecisionCompletedId),
+		CreatedTimeNano:          int64Ptr(t.CreatedTimeNano),
+		ExpiringTimeNano:         int64Ptr(t.ExpiringTimeNano),
+		Resettable:               boolPtr(t.Resettable),
+	}
+}
+
+// FromWorkflowExecutionCompletedEventAttributes converts a thrift WorkflowExecutionCompletedEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionCompletedEventAttributes(t *shared.WorkflowExecutionCompletedEventAttributes) *apiv1.WorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCompletedEventAttributes{
+		Result:                       t.Result,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToWorkflowExecutionCompletedEventAttributes converts a protobuf WorkflowExecutionCompletedEventAttributes to its thrift counterpart
+func ToWorkflowExecutionCompletedEventAttributes(t *apiv1.WorkflowExecutionCompletedEventAttributes) *shared.WorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCompletedEventAttributes{
+		Result:                       t.Result,
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromWorkflowExecutionFailedEventAttributes converts a thrift WorkflowExecutionFailedEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionFailedEventAttributes(t *shared.WorkflowExecutionFailedEventAttributes) *apiv1.WorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFailedEventAttributes{
+		Reason:                       t.GetReason(),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToWorkflowExecutionFailedEventAttributes converts a protobuf WorkflowExecutionFailedEventAttributes to its thrift counterpart
+func ToWorkflowExecutionFailedEventAttributes(t *apiv1.WorkflowExecutionFailedEventAttributes) *shared.WorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFailedEventAttributes{
+		Reason:                       stringPtr(t.Reason),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromWorkflowExecutionTimedOutEventAttributes converts a thrift WorkflowExecutionTimedOutEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimedOutEventAttributes) *apiv1.WorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToWorkflowExecutionTimedOutEventAttributes converts a protobuf WorkflowExecutionTimedOutEventAttributes to its thrift counterpart
+func ToWorkflowExecutionTimedOutEventAttributes(t *apiv1.WorkflowExecutionTimedOutEventAttributes) *shared.WorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: ToTimeoutType(t.TimeoutType),
+	}
+}
+
+// FromContinueAsNewInitiator converts a thrift ContinueAsNewInitiator to its protobuf counterpart
+func FromContinueAsNewInitiator(t *shared.ContinueAsNewInitiator) apiv1.ContinueAsNewInitiator {
+	if t == nil {
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID
+	}
+	switch *t {
+	case shared.ContinueAsNewInitiatorDecider:
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_DECIDER
+	case shared.ContinueAsNewInitiatorRetryPolicy:
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_RETRY_POLICY
+	case shared.ContinueAsNewInitiatorCronSchedule:
+		return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE
+	}
+	return apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID
+}
+
+// ToContinueAsNewInitiator converts a protobuf ContinueAsNewInitiator to its thrift counterpart
+func ToContinueAsNewInitiator(t apiv1.ContinueAsNewInitiator) *shared.ContinueAsNewInitiator {
+	switch t {
+	case apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID:
+		return nil
+	case apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_DECIDER:
+		return shared.ContinueAsNewInitiatorDecider.Ptr()
+	case apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_RETRY_POLICY:
+		return shared.ContinueAsNewInitiatorRetryPolicy.Ptr()
+	case apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE:
+		return shared.ContinueAsNewInitiatorCronSchedule.Ptr()
+	}
+	return nil
+}
+
+// FromWorkflowExecutionContinuedAsNewEventAttributes converts a thrift WorkflowExecutionContinuedAsNewEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionContinuedAsNewEventAttributes(t *shared.WorkflowExecutionContinuedAsNewEventAttributes) *apiv1.WorkflowExecutionContinuedAsNewEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   t.GetNewExecutionRunId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+		Initiator:                           FromContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       t.GetFailureReason(),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		Header:                              FromHeader(t.Header),
+		Memo:                                FromMemo(t.Memo),
+		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToWorkflowExecutionContinuedAsNewEventAttributes converts a protobuf WorkflowExecutionContinuedAsNewEventAttributes to its thrift counterpart
+func ToWorkflowExecutionContinuedAsNewEventAttributes(t *apiv1.WorkflowExecutionContinuedAsNewEventAttributes) *shared.WorkflowExecutionContinuedAsNewEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   stringPtr(t.NewExecutionRunId),
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+		DecisionTaskCompletedEventId:        int64Ptr(t.DecisionTaskCompletedEventId),
+		BackoffStartIntervalInSeconds:       int32Ptr(t.BackoffStartIntervalInSeconds),
+		Initiator:                           ToContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       stringPtr(t.FailureReason),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		Header:                              ToHeader(t.Header),
+		Memo:                                ToMemo(t.Memo),
+		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// FromDecisionTaskScheduledEventAttributes converts a thrift DecisionTaskScheduledEventAttributes to its protobuf counterpart
+func FromDecisionTaskScheduledEventAttributes(t *shared.DecisionTaskScheduledEventAttributes) *apiv1.DecisionTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskScheduledEventAttributes{
+		TaskList:                   FromTaskList(t.TaskList),
+		StartToCloseTimeoutSeconds: t.GetStartToCloseTimeoutSeconds(),
+		Attempt:                    t.GetAttempt(),
+	}
+}
+
+// ToDecisionTaskScheduledEventAttributes converts a protobuf DecisionTaskScheduledEventAttributes to its thrift counterpart
+func ToDecisionTaskScheduledEventAttributes(t *apiv1.DecisionTaskScheduledEventAttributes) *shared.DecisionTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.DecisionTaskScheduledEventAttributes{
+		TaskList:                   ToTaskList(t.TaskList),
+		StartToCloseTimeoutSeconds: int32Ptr(t.StartToCloseTimeoutSeconds),
+		Attempt:                    int64Ptr(t.Attempt),
+	}
+}
+
+// FromDecisionTaskStartedEventAttributes converts a thrift DecisionTaskStartedEventAttributes to its protobuf counterpart
+func FromDecisionTaskStartedEventAttributes(t *shared.DecisionTaskStartedEventAttributes) *apiv1.DecisionTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		Identity:         t.GetIdentity(),
+		RequestId:        t.GetRequestId(),
+	}
+}
+
+// ToDecisionTaskStartedEventAttributes converts a protobuf DecisionTaskStartedEventAttributes to its thrift counterpart
+func ToDecisionTaskStartedEventAttributes(t *apiv1.DecisionTaskStartedEventAttributes) *shared.DecisionTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: int64Ptr(t.ScheduledEventId),
+		Identity:         stringPtr(t.Identity),
+		RequestId:        stringPtr(t.RequestId),
+	}
+}
+
+// FromDecisionTaskCompletedEventAttributes converts a thrift DecisionTaskCompletedEventAttributes to its protobuf counterpart
+func FromDecisionTaskCompletedEventAttributes(t *shared.DecisionTaskCompletedEventAttributes) *apiv1.DecisionTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: t.ExecutionContext,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+		BinaryChecksum:   t.GetBinaryChecksum(),
+	}
+}
+
+// ToDecisionTaskCompletedEventAttributes converts a protobuf DecisionTaskCompletedEventAttributes to its thrift counterpart
+func ToDecisionTaskCompletedEventAttributes(t *apiv1.DecisionTaskCompletedEventAttributes) *shared.DecisionTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: t.ExecutionContext,
+		ScheduledEventId: int64Ptr(t.ScheduledEventId),
+		StartedEventId:   int64Ptr(t.StartedEventId),
+		Identity:         stringPtr(t.Identity),
+		BinaryChecksum:   stringPtr(t.BinaryChecksum),
+	}
+}
+
+// FromDecisionTaskTimedOutEventAttributes converts a thrift DecisionTaskTimedOutEventAttributes to its protobuf counterpart
+func FromDecisionTaskTimedOutEventAttributes(t *shared.DecisionTaskTimedOutEventAttributes) *apiv1.DecisionTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToDecisionTaskTimedOutEventAttributes converts a protobuf DecisionTaskTimedOutEventAttributes to its thrift counterpart
+func ToDecisionTaskTimedOutEventAttributes(t *apiv1.DecisionTaskTimedOutEventAttributes) *shared.DecisionTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: int64Ptr(t.ScheduledEventId),
+		StartedEventId:   int64Ptr(t.StartedEventId),
+		TimeoutType:      ToTimeoutType(t.TimeoutType),
+	}
+}
+
+// FromDecisionTaskFailedEventAttributes converts a thrift DecisionTaskFailedEventAttributes to its protobuf counterpart
+func FromDecisionTaskFailedEventAttributes(t *shared.DecisionTaskFailedEventAttributes) *apiv1.DecisionTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Cause:            FromDecisionTaskFailedCause(t.Cause),
+		Details:          t.Details,
+		Identity:         t.GetIdentity(),
+		Reason:           t.GetReason(),
+		BaseRunId:        t.GetBaseRunId(),
+		NewRunId:         t.GetNewRunId(),
+		ForkEventVersion: t.GetForkEventVersion(),
+	}
+}
+
+// ToDecisionTaskFailedEventAttributes converts a protobuf DecisionTaskFailedEventAttributes to its thrift counterpart
+func ToDecisionTaskFailedEventAttributes(t *apiv1.DecisionTaskFailedEventAttributes) *shared.DecisionTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: int64Ptr(t.ScheduledEventId),
+		StartedEventId:   int64Ptr(t.StartedEventId),
+		Cause:            ToDecisionTaskFailedCause(t.Cause),
+		Details:          t.Details,
+		Identity:         stringPtr(t.Identity),
+		Reason:           stringPtr(t.Reason),
+		BaseRunId:        stringPtr(t.BaseRunId),
+		NewRunId:         stringPtr(t.NewRunId),
+		ForkEventVersion: int64Ptr(t.ForkEventVersion),
+	}
+}
+
+// FromActivityTaskScheduledEventAttributes converts a thrift ActivityTaskScheduledEventAttributes to its protobuf counterpart
+func FromActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEventAttributes) *apiv1.ActivityTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  FromActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      FromTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		DecisionTaskCompletedEventId:  t.GetDecisionTaskCompletedEventId(),
+		RetryPolicy:                   FromRetryPolicy(t.RetryPolicy),
+		Header:                        FromHeader(t.Header),
+		DelayStartSeconds:             t.GetDelayStartSeconds(),
+	}
+}
+
+// ToActivityTaskScheduledEventAttributes converts a protobuf ActivityTaskScheduledEventAttributes to its thrift counterpart
+func ToActivityTaskScheduledEventAttributes(t *apiv1.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    stringPtr(t.ActivityId),
+		ActivityType:                  ToActivityType(t.ActivityType),
+		Domain:                        stringPtr(t.Domain),
+		TaskList:                      ToTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: int32Ptr(t.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: int32Ptr(t.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    int32Ptr(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       int32Ptr(t.HeartbeatTimeoutSeconds),
+		DecisionTaskCompletedEventId:  int64Ptr(t.DecisionTaskCompletedEventId),
+		RetryPolicy:                   ToRetryPolicy(t.RetryPolicy),
+		Header:                        ToHeader(t.Header),
+		DelayStartSeconds:             int32Ptr(t.DelayStartSeconds),
+	}
+}
+
+// FromActivityTaskStartedEventAttributes converts a thrift ActivityTaskStartedEventAttributes to its protobuf counterpart
+func FromActivityTaskStartedEventAttributes(t *shared.ActivityTaskStartedEventAttributes) *apiv1.ActivityTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskStartedEventAttributes{
+		ScheduledEventId:   t.GetScheduledEventId(),
+		Identity:           t.GetIdentity(),
+		RequestId:          t.GetRequestId(),
+		Attempt:            t.GetAttempt(),
+		LastFailureReason:  t.GetLastFailureReason(),
+		LastFailureDetails: t.LastFailureDetails,
+	}
+}
+
+// ToActivityTaskStartedEventAttributes converts a protobuf ActivityTaskStartedEventAttributes to its thrift counterpart
+func ToActivityTaskStartedEventAttributes(t *apiv1.ActivityTaskStartedEventAttributes) *shared.ActivityTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskStartedEventAttributes{
+		ScheduledEventId:   int64Ptr(t.ScheduledEventId),
+		Identity:           stringPtr(t.Identity),
+		RequestId:          stringPtr(t.RequestId),
+		Attempt:            int32Ptr(t.Attempt),
+		LastFailureReason:  stringPtr(t.LastFailureReason),
+		LastFailureDetails: t.LastFailureDetails,
+	}
+}
+
+// FromActivityTaskCompletedEventAttributes converts a thrift ActivityTaskCompletedEventAttributes to its protobuf counterpart
+func FromActivityTaskCompletedEventAttributes(t *shared.ActivityTaskCompletedEventAttributes) *apiv1.ActivityTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCompletedEventAttributes{
+		Result:           t.Result,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskCompletedEventAttributes converts a protobuf ActivityTaskCompletedEventAttributes to its thrift counterpart
+func ToActivityTaskCompletedEventAttributes(t *apiv1.ActivityTaskCompletedEventAttributes) *shared.ActivityTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCompletedEventAttributes{
+		Result:           t.Result,
+		ScheduledEventId: int64Ptr(t.ScheduledEventId),
+		StartedEventId:   int64Ptr(t.StartedEventId),
+		Identity:         stringPtr(t.Identity),
+	}
+}
+
+// FromActivityTaskFailedEventAttributes converts a thrift ActivityTaskFailedEventAttributes to its protobuf counterpart
+func FromActivityTaskFailedEventAttributes(t *shared.ActivityTaskFailedEventAttributes) *apiv1.ActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskFailedEventAttributes{
+		Reason:           t.GetReason(),
+		Details:          t.Details,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskFailedEventAttributes converts a protobuf ActivityTaskFailedEventAttributes to its thrift counterpart
+func ToActivityTaskFailedEventAttributes(t *apiv1.ActivityTaskFailedEventAttributes) *shared.ActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskFailedEventAttributes{
+		Reason:           stringPtr(t.Reason),
+		Details:          t.Details,
+		ScheduledEventId: int64Ptr(t.ScheduledEventId),
+		StartedEventId:   int64Ptr(t.StartedEventId),
+		Identity:         stringPtr(t.Identity),
+	}
+}
+
+// FromActivityTaskTimedOutEventAttributes converts a thrift ActivityTaskTimedOutEventAttributes to its protobuf counterpart
+func FromActivityTaskTimedOutEventAttributes(t *shared.ActivityTaskTimedOutEventAttributes) *apiv1.ActivityTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskTimedOutEventAttributes{
+		Details:          t.Details,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      FromTimeoutType(t.TimeoutType),
+	}
+}
+
+// ToActivityTaskTimedOutEventAttributes converts a protobuf ActivityTaskTimedOutEventAttributes to its thrift counterpart
+func ToActivityTaskTimedOutEventAttributes(t *apiv1.ActivityTaskTimedOutEventAttributes) *shared.ActivityTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskTimedOutEventAttributes{
+		Details:          t.Details,
+		ScheduledEventId: int64Ptr(t.ScheduledEventId),
+		StartedEventId:   int64Ptr(t.StartedEventId),
+		TimeoutType:      ToTimeoutType(t.TimeoutType),
+	}
+}
+
+// FromActivityTaskCancelRequestedEventAttributes converts a thrift ActivityTaskCancelRequestedEventAttributes to its protobuf counterpart
+func FromActivityTaskCancelRequestedEventAttributes(t *shared.ActivityTaskCancelRequestedEventAttributes) *apiv1.ActivityTaskCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToActivityTaskCancelRequestedEventAttributes converts a protobuf ActivityTaskCancelRequestedEventAttributes to its thrift counterpart
+func ToActivityTaskCancelRequestedEventAttributes(t *apiv1.ActivityTaskCancelRequestedEventAttributes) *shared.ActivityTaskCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   stringPtr(t.ActivityId),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromRequestCancelActivityTaskFailedEventAttributes converts a thrift RequestCancelActivityTaskFailedEventAttributes to its protobuf counterpart
+func FromRequestCancelActivityTaskFailedEventAttributes(t *shared.RequestCancelActivityTaskFailedEventAttributes) *apiv1.RequestCancelActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToRequestCancelActivityTaskFailedEventAttributes converts a protobuf RequestCancelActivityTaskFailedEventAttributes to its thrift counterpart
+func ToRequestCancelActivityTaskFailedEventAttributes(t *apiv1.RequestCancelActivityTaskFailedEventAttributes) *shared.RequestCancelActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   stringPtr(t.ActivityId),
+		Cause:                        stringPtr(t.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromActivityTaskCanceledEventAttributes converts a thrift ActivityTaskCanceledEventAttributes to its protobuf counterpart
+func FromActivityTaskCanceledEventAttributes(t *shared.ActivityTaskCanceledEventAttributes) *apiv1.ActivityTaskCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCanceledEventAttributes{
+		Details:                      t.Details,
+		LatestCancelRequestedEventId: t.GetLatestCancelRequestedEventId(),
+		ScheduledEventId:             t.GetScheduledEventId(),
+		StartedEventId:               t.GetStartedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToActivityTaskCanceledEventAttributes converts a protobuf ActivityTaskCanceledEventAttributes to its thrift counterpart
+func ToActivityTaskCanceledEventAttributes(t *apiv1.ActivityTaskCanceledEventAttributes) *shared.ActivityTaskCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCanceledEventAttributes{
+		Details:                      t.Details,
+		LatestCancelRequestedEventId: int64Ptr(t.LatestCancelRequestedEventId),
+		ScheduledEventId:             int64Ptr(t.ScheduledEventId),
+		StartedEventId:               int64Ptr(t.StartedEventId),
+		Identity:                     stringPtr(t.Identity),
+	}
+}
+
+// FromTimerStartedEventAttributes converts a thrift TimerStartedEventAttributes to its protobuf counterpart
+func FromTimerStartedEventAttributes(t *shared.TimerStartedEventAttributes) *apiv1.TimerStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerStartedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartToFireTimeoutSeconds:    t.GetStartToFireTimeoutSeconds(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToTimerStartedEventAttributes converts a protobuf TimerStartedEventAttributes to its thrift counterpart
+func ToTimerStartedEventAttributes(t *apiv1.TimerStartedEventAttributes) *shared.TimerStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.TimerStartedEventAttributes{
+		TimerId:                      stringPtr(t.TimerId),
+		StartToFireTimeoutSeconds:    int64Ptr(t.StartToFireTimeoutSeconds),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromTimerFiredEventAttributes converts a thrift TimerFiredEventAttributes to its protobuf counterpart
+func FromTimerFiredEventAttributes(t *shared.TimerFiredEventAttributes) *apiv1.TimerFiredEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerFiredEventAttributes{
+		TimerId:        t.GetTimerId(),
+		StartedEventId: t.GetStartedEventId(),
+	}
+}
+
+// ToTimerFiredEventAttributes converts a protobuf TimerFiredEventAttributes to its thrift counterpart
+func ToTimerFiredEventAttributes(t *apiv1.TimerFiredEventAttributes) *shared.TimerFiredEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.TimerFiredEventAttributes{
+		TimerId:        stringPtr(t.TimerId),
+		StartedEventId: int64Ptr(t.StartedEventId),
+	}
+}
+
+// FromTimerCanceledEventAttributes converts a thrift TimerCanceledEventAttributes to its protobuf counterpart
+func FromTimerCanceledEventAttributes(t *shared.TimerCanceledEventAttributes) *apiv1.TimerCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerCanceledEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartedEventId:               t.GetStartedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToTimerCanceledEventAttributes converts a protobuf TimerCanceledEventAttributes to its thrift counterpart
+func ToTimerCanceledEventAttributes(t *apiv1.TimerCanceledEventAttributes) *shared.TimerCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.TimerCanceledEventAttributes{
+		TimerId:                      stringPtr(t.TimerId),
+		StartedEventId:               int64Ptr(t.StartedEventId),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(t.Identity),
+	}
+}
+
+// FromCancelTimerFailedEventAttributes converts a thrift CancelTimerFailedEventAttributes to its protobuf counterpart
+func FromCancelTimerFailedEventAttributes(t *shared.CancelTimerFailedEventAttributes) *apiv1.CancelTimerFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerFailedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// ToCancelTimerFailedEventAttributes converts a protobuf CancelTimerFailedEventAttributes to its thrift counterpart
+func ToCancelTimerFailedEventAttributes(t *apiv1.CancelTimerFailedEventAttributes) *shared.CancelTimerFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.CancelTimerFailedEventAttributes{
+		TimerId:                      stringPtr(t.TimerId),
+		Cause:                        stringPtr(t.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(t.Identity),
+	}
+}
+
+// FromWorkflowExecutionCancelRequestedEventAttributes converts a thrift WorkflowExecutionCancelRequestedEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionCancelRequestedEventAttributes(t *shared.WorkflowExecutionCancelRequestedEventAttributes) *apiv1.WorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     t.GetCause(),
+		ExternalInitiatedEventId:  t.GetExternalInitiatedEventId(),
+		ExternalWorkflowExecution: FromWorkflowExecution(t.ExternalWorkflowExecution),
+		Identity:                  t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionCancelRequestedEventAttributes converts a protobuf WorkflowExecutionCancelRequestedEventAttributes to its thrift counterpart
+func ToWorkflowExecutionCancelRequestedEventAttributes(t *apiv1.WorkflowExecutionCancelRequestedEventAttributes) *shared.WorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     stringPtr(t.Cause),
+		ExternalInitiatedEventId:  int64Ptr(t.ExternalInitiatedEventId),
+		ExternalWorkflowExecution: ToWorkflowExecution(t.ExternalWorkflowExecution),
+		Identity:                  stringPtr(t.Identity),
+	}
+}
+
+// FromWorkflowExecutionCanceledEventAttributes converts a thrift WorkflowExecutionCanceledEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionCanceledEventAttributes(t *shared.WorkflowExecutionCanceledEventAttributes) *apiv1.WorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Details:                      t.Details,
+	}
+}
+
+// ToWorkflowExecutionCanceledEventAttributes converts a protobuf WorkflowExecutionCanceledEventAttributes to its thrift counterpart
+func ToWorkflowExecutionCanceledEventAttributes(t *apiv1.WorkflowExecutionCanceledEventAttributes) *shared.WorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Details:                      t.Details,
+	}
+}
+
+// FromMarkerRecordedEventAttributes converts a thrift MarkerRecordedEventAttributes to its protobuf counterpart
+func FromMarkerRecordedEventAttributes(t *shared.MarkerRecordedEventAttributes) *apiv1.MarkerRecordedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.MarkerRecordedEventAttributes{
+		MarkerName:                   t.GetMarkerName(),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Header:                       FromHeader(t.Header),
+	}
+}
+
+// ToMarkerRecordedEventAttributes converts a protobuf MarkerRecordedEventAttributes to its thrift counterpart
+func ToMarkerRecordedEventAttributes(t *apiv1.MarkerRecordedEventAttributes) *shared.MarkerRecordedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.MarkerRecordedEventAttributes{
+		MarkerName:                   stringPtr(t.MarkerName),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Header:                       ToHeader(t.Header),
+	}
+}
+
+// FromWorkflowExecutionSignaledEventAttributes converts a thrift WorkflowExecutionSignaledEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionSignaledEventAttributes(t *shared.WorkflowExecutionSignaledEventAttributes) *apiv1.WorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionSignaledEventAttributes{
+		SignalName: t.GetSignalName(),
+		Input:      t.Input,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionSignaledEventAttributes converts a protobuf WorkflowExecutionSignaledEventAttributes to its thrift counterpart
+func ToWorkflowExecutionSignaledEventAttributes(t *apiv1.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSignaledEventAttributes{
+		SignalName: stringPtr(t.SignalName),
+		Input:      t.Input,
+		Identity:   stringPtr(t.Identity),
+	}
+}
+
+// FromWorkflowExecutionTerminatedEventAttributes converts a thrift WorkflowExecutionTerminatedEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionTerminatedEventAttributes(t *shared.WorkflowExecutionTerminatedEventAttributes) *apiv1.WorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   t.GetReason(),
+		Details:  t.Details,
+		Identity: t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionTerminatedEventAttributes converts a protobuf WorkflowExecutionTerminatedEventAttributes to its thrift counterpart
+func ToWorkflowExecutionTerminatedEventAttributes(t *apiv1.WorkflowExecutionTerminatedEventAttributes) *shared.WorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   stringPtr(t.Reason),
+		Details:  t.Details,
+		Identity: stringPtr(t.Identity),
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts a thrift RequestCancelExternalWorkflowExecutionInitiatedEventAttributes to its protobuf counterpart
+func FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts a protobuf RequestCancelExternalWorkflowExecutionInitiatedEventAttributes to its thrift counterpart
+func ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(t.Domain),
+		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
+		Control:                      t.Control,
+		ChildWorkflowOnly:            boolPtr(t.ChildWorkflowOnly),
+	}
+}
+
+// FromRequestCancelExternalWorkflowExecutionFailedEventAttributes converts a thrift RequestCancelExternalWorkflowExecutionFailedEventAttributes to its protobuf counterpart
+func FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromCancelExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.Control,
+	}
+}
+
+// ToRequestCancelExternalWorkflowExecutionFailedEventAttributes converts a protobuf RequestCancelExternalWorkflowExecutionFailedEventAttributes to its thrift counterpart
+func ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToCancelExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(t.Domain),
+		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             int64Ptr(t.InitiatedEventId),
+		Control:                      t.Control,
+	}
+}
+
+// FromExternalWorkflowExecutionCancelRequestedEventAttributes converts a thrift ExternalWorkflowExecutionCancelRequestedEventAttributes to its protobuf counterpart
+func FromExternalWorkflowExecutionCancelRequestedEventAttributes(t *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes) *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToExternalWorkflowExecutionCancelRequestedEventAttributes converts a protobuf ExternalWorkflowExecutionCancelRequestedEventAttributes to its thrift counterpart
+func ToExternalWorkflowExecutionCancelRequestedEventAttributes(t *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes) *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionInitiatedEventAttributes converts a thrift SignalExternalWorkflowExecutionInitiatedEventAttributes to its protobuf counterpart
+func FromSignalExternalWorkflowExecutionInitiatedEventAttributes(t *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		SignalName:                   t.GetSignalName(),
+		Input:                        t.Input,
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// ToSignalExternalWorkflowExecutionInitiatedEventAttributes converts a protobuf SignalExternalWorkflowExecutionInitiatedEventAttributes to its thrift counterpart
+func ToSignalExternalWorkflowExecutionInitiatedEventAttributes(t *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes) *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(t.Domain),
+		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
+		SignalName:                   stringPtr(t.SignalName),
+		Input:                        t.Input,
+		Control:                      t.Control,
+		ChildWorkflowOnly:            boolPtr(t.ChildWorkflowOnly),
+	}
+}
+
+// FromSignalExternalWorkflowExecutionFailedEventAttributes converts a thrift SignalExternalWorkflowExecutionFailedEventAttributes to its protobuf counterpart
+func FromSignalExternalWorkflowExecutionFailedEventAttributes(t *shared.SignalExternalWorkflowExecutionFailedEventAttributes) *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromSignalExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            FromWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.Control,
+	}
+}
+
+// ToSignalExternalWorkflowExecutionFailedEventAttributes converts a protobuf SignalExternalWorkflowExecutionFailedEventAttributes to its thrift counterpart
+func ToSignalExternalWorkflowExecutionFailedEventAttributes(t *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes) *shared.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToSignalExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(t.Domain),
+		WorkflowExecution:            ToWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             int64Ptr(t.InitiatedEventId),
+		Control:                      t.Control,
+	}
+}
+
+// FromExternalWorkflowExecutionSignaledEventAttributes converts a thrift ExternalWorkflowExecutionSignaledEventAttributes to its protobuf counterpart
+func FromExternalWorkflowExecutionSignaledEventAttributes(t *shared.ExternalWorkflowExecutionSignaledEventAttributes) *apiv1.ExternalWorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Control:           t.Control,
+	}
+}
+
+// ToExternalWorkflowExecutionSignaledEventAttributes converts a protobuf ExternalWorkflowExecutionSignaledEventAttributes to its thrift counterpart
+func ToExternalWorkflowExecutionSignaledEventAttributes(t *apiv1.ExternalWorkflowExecutionSignaledEventAttributes) *shared.ExternalWorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Control:           t.Control,
+	}
+}
+
+// FromUpsertWorkflowSearchAttributesEventAttributes converts a thrift UpsertWorkflowSearchAttributesEventAttributes to its protobuf counterpart
+func FromUpsertWorkflowSearchAttributesEventAttributes(t *shared.UpsertWorkflowSearchAttributesEventAttributes) *apiv1.UpsertWorkflowSearchAttributesEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpsertWorkflowSearchAttributesEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		SearchAttributes:             FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToUpsertWorkflowSearchAttributesEventAttributes converts a protobuf UpsertWorkflowSearchAttributesEventAttributes to its thrift counterpart
+func ToUpsertWorkflowSearchAttributesEventAttributes(t *apiv1.UpsertWorkflowSearchAttributesEventAttributes) *shared.UpsertWorkflowSearchAttributesEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowSearchAttributesEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+		SearchAttributes:             ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// FromWorkflowExecutionTerminationProtectionSetEventAttributes converts a thrift WorkflowExecutionTerminationProtectionSetEventAttributes to its protobuf counterpart
+func FromWorkflowExecutionTerminationProtectionSetEventAttributes(t *shared.WorkflowExecutionTerminationProtectionSetEventAttributes) *apiv1.WorkflowExecutionTerminationProtectionSetEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTerminationProtectionSetEventAttributes{
+		TerminationProtected: t.GetTerminationProtected(),
+		Identity:             t.GetIdentity(),
+	}
+}
+
+// ToWorkflowExecutionTerminationProtectionSetEventAttributes converts a protobuf WorkflowExecutionTerminationProtectionSetEventAttributes to its thrift counterpart
+func ToWorkflowExecutionTerminationProtectionSetEventAttributes(t *apiv1.WorkflowExecutionTerminationProtectionSetEventAttributes) *shared.WorkflowExecutionTerminationProtectionSetEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTerminationProtectionSetEventAttributes{
+		TerminationProtected: boolPtr(t.TerminationProtected),
+		Identity:             stringPtr(t.Identity),
+	}
+}
+
+// FromStartChildWorkflowExecutionInitiatedEventAttributes converts a thrift StartChildWorkflowExecutionInitiatedEventAttributes to its protobuf counterpart
+func FromStartChildWorkflowExecutionInitiatedEventAttributes(t *shared.StartChildWorkflowExecutionInitiatedEventAttributes) *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ParentClosePolicy:                   FromParentClosePolicy(t.ParentClosePolicy),
+		Control:                             t.Control,
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Header:                              FromHeader(t.Header),
+		Memo:                                FromMemo(t.Memo),
+		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// ToStartChildWorkflowExecutionInitiatedEventAttributes converts a protobuf StartChildWorkflowExecutionInitiatedEventAttributes to its thrift counterpart
+func ToStartChildWorkflowExecutionInitiatedEventAttributes(t *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes) *shared.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              stringPtr(t.Domain),
+		WorkflowId:                          stringPtr(t.WorkflowId),
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+		ParentClosePolicy:                   ToParentClosePolicy(t.ParentClosePolicy),
+		Control:                             t.Control,
+		DecisionTaskCompletedEventId:        int64Ptr(t.DecisionTaskCompletedEventId),
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        stringPtr(t.CronSchedule),
+		Header:                              ToHeader(t.Header),
+		Memo:                                ToMemo(t.Memo),
+		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+	}
+}
+
+// FromStartChildWorkflowExecutionFailedEventAttributes converts a thrift StartChildWorkflowExecutionFailedEventAttributes to its protobuf counterpart
+func FromStartChildWorkflowExecutionFailedEventAttributes(t *shared.StartChildWorkflowExecutionFailedEventAttributes) *apiv1.StartChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       t.GetDomain(),
+		WorkflowId:                   t.GetWorkflowId(),
+		WorkflowType:                 FromWorkflowType(t.WorkflowType),
+		Cause:                        FromChildWorkflowExecutionFailedCause(t.Cause),
+		Control:                      t.Control,
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// ToStartChildWorkflowExecutionFailedEventAttributes converts a protobuf StartChildWorkflowExecutionFailedEventAttributes to its thrift counterpart
+func ToStartChildWorkflowExecutionFailedEventAttributes(t *apiv1.StartChildWorkflowExecutionFailedEventAttributes) *shared.StartChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       stringPtr(t.Domain),
+		WorkflowId:                   stringPtr(t.WorkflowId),
+		WorkflowType:                 ToWorkflowType(t.WorkflowType),
+		Cause:                        ToChildWorkflowExecutionFailedCause(t.Cause),
+		Control:                      t.Control,
+		InitiatedEventId:             int64Ptr(t.InitiatedEventId),
+		DecisionTaskCompletedEventId: int64Ptr(t.DecisionTaskCompletedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionStartedEventAttributes converts a thrift ChildWorkflowExecutionStartedEventAttributes to its protobuf counterpart
+func FromChildWorkflowExecutionStartedEventAttributes(t *shared.ChildWorkflowExecutionStartedEventAttributes) *apiv1.ChildWorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            t.GetDomain(),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		Header:            FromHeader(t.Header),
+	}
+}
+
+// ToChildWorkflowExecutionStartedEventAttributes converts a protobuf ChildWorkflowExecutionStartedEventAttributes to its thrift counterpart
+func ToChildWorkflowExecutionStartedEventAttributes(t *apiv1.ChildWorkflowExecutionStartedEventAttributes) *shared.ChildWorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            stringPtr(t.Domain),
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(t.WorkflowType),
+		Header:            ToHeader(t.Header),
+	}
+}
+
+// FromChildWorkflowExecutionCompletedEventAttributes converts a thrift ChildWorkflowExecutionCompletedEventAttributes to its protobuf counterpart
+func FromChildWorkflowExecutionCompletedEventAttributes(t *shared.ChildWorkflowExecutionCompletedEventAttributes) *apiv1.ChildWorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            t.Result,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionCompletedEventAttributes converts a protobuf ChildWorkflowExecutionCompletedEventAttributes to its thrift counterpart
+func ToChildWorkflowExecutionCompletedEventAttributes(t *apiv1.ChildWorkflowExecutionCompletedEventAttributes) *shared.ChildWorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            t.Result,
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(t.WorkflowType),
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		StartedEventId:    int64Ptr(t.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionFailedEventAttributes converts a thrift ChildWorkflowExecutionFailedEventAttributes to its protobuf counterpart
+func FromChildWorkflowExecutionFailedEventAttributes(t *shared.ChildWorkflowExecutionFailedEventAttributes) *apiv1.ChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            t.GetReason(),
+		Details:           t.Details,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionFailedEventAttributes converts a protobuf ChildWorkflowExecutionFailedEventAttributes to its thrift counterpart
+func ToChildWorkflowExecutionFailedEventAttributes(t *apiv1.ChildWorkflowExecutionFailedEventAttributes) *shared.ChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            stringPtr(t.Reason),
+		Details:           t.Details,
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(t.WorkflowType),
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		StartedEventId:    int64Ptr(t.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionCanceledEventAttributes converts a thrift ChildWorkflowExecutionCanceledEventAttributes to its protobuf counterpart
+func FromChildWorkflowExecutionCanceledEventAttributes(t *shared.ChildWorkflowExecutionCanceledEventAttributes) *apiv1.ChildWorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           t.Details,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionCanceledEventAttributes converts a protobuf ChildWorkflowExecutionCanceledEventAttributes to its thrift counterpart
+func ToChildWorkflowExecutionCanceledEventAttributes(t *apiv1.ChildWorkflowExecutionCanceledEventAttributes) *shared.ChildWorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           t.Details,
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(t.WorkflowType),
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		StartedEventId:    int64Ptr(t.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionTimedOutEventAttributes converts a thrift ChildWorkflowExecutionTimedOutEventAttributes to its protobuf counterpart
+func FromChildWorkflowExecutionTimedOutEventAttributes(t *shared.ChildWorkflowExecutionTimedOutEventAttributes) *apiv1.ChildWorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       FromTimeoutType(t.TimeoutType),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionTimedOutEventAttributes converts a protobuf ChildWorkflowExecutionTimedOutEventAttributes to its thrift counterpart
+func ToChildWorkflowExecutionTimedOutEventAttributes(t *apiv1.ChildWorkflowExecutionTimedOutEventAttributes) *shared.ChildWorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       ToTimeoutType(t.TimeoutType),
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(t.WorkflowType),
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		StartedEventId:    int64Ptr(t.StartedEventId),
+	}
+}
+
+// FromChildWorkflowExecutionTerminatedEventAttributes converts a thrift ChildWorkflowExecutionTerminatedEventAttributes to its protobuf counterpart
+func FromChildWorkflowExecutionTerminatedEventAttributes(t *shared.ChildWorkflowExecutionTerminatedEventAttributes) *apiv1.ChildWorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      FromWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// ToChildWorkflowExecutionTerminatedEventAttributes converts a protobuf ChildWorkflowExecutionTerminatedEventAttributes to its thrift counterpart
+func ToChildWorkflowExecutionTerminatedEventAttributes(t *apiv1.ChildWorkflowExecutionTerminatedEventAttributes) *shared.ChildWorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToWorkflowType(t.WorkflowType),
+		InitiatedEventId:  int64Ptr(t.InitiatedEventId),
+		StartedEventId:    int64Ptr(t.StartedEventId),
+	}
+}
+
+// FromHistoryEvent converts a thrift HistoryEvent to its protobuf counterpart
+func FromHistoryEvent(t *shared.HistoryEvent) *apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.HistoryEvent{
+		EventId:                                 t.GetEventId(),
+		Timestamp:                               t.GetTimestamp(),
+		EventType:                               FromEventType(t.EventType),
+		Version:                                 t.GetVersion(),
+		TaskId:                                  t.GetTaskId(),
+		WorkflowExecutionStartedEventAttributes: FromWorkflowExecutionStartedEventAttributes(t.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      FromWorkflowExecutionCompletedEventAttributes(t.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         FromWorkflowExecutionFailedEventAttributes(t.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       FromWorkflowExecutionTimedOutEventAttributes(t.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           FromDecisionTaskScheduledEventAttributes(t.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             FromDecisionTaskStartedEventAttributes(t.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           FromDecisionTaskCompletedEventAttributes(t.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            FromDecisionTaskTimedOutEventAttributes(t.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              FromDecisionTaskFailedEventAttributes(t.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           FromActivityTaskScheduledEventAttributes(t.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             FromActivityTaskStartedEventAttributes(t.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           FromActivityTaskCompletedEventAttributes(t.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              FromActivityTaskFailedEventAttributes(t.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            FromActivityTaskTimedOutEventAttributes(t.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    FromTimerStartedEventAttributes(t.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      FromTimerFiredEventAttributes(t.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     FromActivityTaskCancelRequestedEventAttributes(t.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 FromRequestCancelActivityTaskFailedEventAttributes(t.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            FromActivityTaskCanceledEventAttributes(t.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   FromTimerCanceledEventAttributes(t.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               FromCancelTimerFailedEventAttributes(t.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  FromMarkerRecordedEventAttributes(t.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       FromWorkflowExecutionSignaledEventAttributes(t.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     FromWorkflowExecutionTerminatedEventAttributes(t.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                FromWorkflowExecutionCancelRequestedEventAttributes(t.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       FromWorkflowExecutionCanceledEventAttributes(t.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: FromRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    FromRequestCancelExternalWorkflowExecutionFailedEventAttributes(t.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        FromExternalWorkflowExecutionCancelRequestedEventAttributes(t.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 FromWorkflowExecutionContinuedAsNewEventAttributes(t.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            FromStartChildWorkflowExecutionInitiatedEventAttributes(t.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               FromStartChildWorkflowExecutionFailedEventAttributes(t.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   FromChildWorkflowExecutionStartedEventAttributes(t.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 FromChildWorkflowExecutionCompletedEventAttributes(t.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    FromChildWorkflowExecutionFailedEventAttributes(t.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  FromChildWorkflowExecutionCanceledEventAttributes(t.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  FromChildWorkflowExecutionTimedOutEventAttributes(t.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                FromChildWorkflowExecutionTerminatedEventAttributes(t.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        FromSignalExternalWorkflowExecutionInitiatedEventAttributes(t.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
+		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionTerminationProtectionSetEventAttributes:       FromWorkflowExecutionTerminationProtectionSetEventAttributes(t.WorkflowExecutionTerminationProtectionSetEventAttributes),
+	}
+}
+
+// ToHistoryEvent converts a protobuf HistoryEvent to its thrift counterpart
+func ToHistoryEvent(t *apiv1.HistoryEvent) *shared.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	return &shared.HistoryEvent{
+		EventId:                                 int64Ptr(t.EventId),
+		Timestamp:                               int64Ptr(t.Timestamp),
+		EventType:                               ToEventType(t.EventType),
+		Version:                                 int64Ptr(t.Version),
+		TaskId:                                  int64Ptr(t.TaskId),
+		WorkflowExecutionStartedEventAttributes: ToWorkflowExecutionStartedEventAttributes(t.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      ToWorkflowExecutionCompletedEventAttributes(t.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         ToWorkflowExecutionFailedEventAttributes(t.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       ToWorkflowExecutionTimedOutEventAttributes(t.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           ToDecisionTaskScheduledEventAttributes(t.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             ToDecisionTaskStartedEventAttributes(t.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           ToDecisionTaskCompletedEventAttributes(t.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            ToDecisionTaskTimedOutEventAttributes(t.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              ToDecisionTaskFailedEventAttributes(t.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           ToActivityTaskScheduledEventAttributes(t.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             ToActivityTaskStartedEventAttributes(t.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           ToActivityTaskCompletedEventAttributes(t.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              ToActivityTaskFailedEventAttributes(t.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            ToActivityTaskTimedOutEventAttributes(t.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    ToTimerStartedEventAttributes(t.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      ToTimerFiredEventAttributes(t.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     ToActivityTaskCancelRequestedEventAttributes(t.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 ToRequestCancelActivityTaskFailedEventAttributes(t.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            ToActivityTaskCanceledEventAttributes(t.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   ToTimerCanceledEventAttributes(t.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               ToCancelTimerFailedEventAttributes(t.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  ToMarkerRecordedEventAttributes(t.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       ToWorkflowExecutionSignaledEventAttributes(t.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     ToWorkflowExecutionTerminatedEventAttributes(t.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                ToWorkflowExecutionCancelRequestedEventAttributes(t.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       ToWorkflowExecutionCanceledEventAttributes(t.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: ToRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    ToRequestCancelExternalWorkflowExecutionFailedEventAttributes(t.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        ToExternalWorkflowExecutionCancelRequestedEventAttributes(t.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 ToWorkflowExecutionContinuedAsNewEventAttributes(t.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            ToStartChildWorkflowExecutionInitiatedEventAttributes(t.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               ToStartChildWorkflowExecutionFailedEventAttributes(t.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   ToChildWorkflowExecutionStartedEventAttributes(t.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 ToChildWorkflowExecutionCompletedEventAttributes(t.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    ToChildWorkflowExecutionFailedEventAttributes(t.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  ToChildWorkflowExecutionCanceledEventAttributes(t.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  ToChildWorkflowExecutionTimedOutEventAttributes(t.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                ToChildWorkflowExecutionTerminatedEventAttributes(t.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        ToSignalExternalWorkflowExecutionInitiatedEventAttributes(t.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
+		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		WorkflowExecutionTerminationProtectionSetEventAttributes:       ToWorkflowExecutionTerminationProtectionSetEventAttributes(t.WorkflowExecutionTerminationProtectionSetEventAttributes),
+	}
+}
+
+// FromHistory converts a thrift History to its protobuf counterpart
+func FromHistory(t *shared.History) *apiv1.History {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.History{
+		Events: FromHistoryEventArray(t.Events),
+	}
+}
+
+// ToHistory converts a protobuf History to its thrift counterpart
+func ToHistory(t *apiv1.History) *shared.History {
+	if t == nil {
+		return nil
+	}
+	return &shared.History{
+		Events: ToHistoryEventArray(t.Events),
+	}
+}
+
+// FromWorkflowExecutionFilter converts a thrift WorkflowExecutionFilter to its protobuf counterpart
+func FromWorkflowExecutionFilter(t *shared.WorkflowExecutionFilter) *apiv1.WorkflowExecutionFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFilter{
+		WorkflowId: t.GetWorkflowId(),
+		RunId:      t.GetRunId(),
+	}
+}
+
+// ToWorkflowExecutionFilter converts a protobuf WorkflowExecutionFilter to its thrift counterpart
+func ToWorkflowExecutionFilter(t *apiv1.WorkflowExecutionFilter) *shared.WorkflowExecutionFilter {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFilter{
+		WorkflowId: stringPtr(t.WorkflowId),
+		RunId:      stringPtr(t.RunId),
+	}
+}
+
+// FromWorkflowTypeFilter converts a thrift WorkflowTypeFilter to its protobuf counterpart
+func FromWorkflowTypeFilter(t *shared.WorkflowTypeFilter) *apiv1.WorkflowTypeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowTypeFilter{
+		Name: t.GetName(),
+	}
+}
+
+// ToWorkflowTypeFilter converts a protobuf WorkflowTypeFilter to its thrift counterpart
+func ToWorkflowTypeFilter(t *apiv1.WorkflowTypeFilter) *shared.WorkflowTypeFilter {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowTypeFilter{
+		Name: stringPtr(t.Name),
+	}
+}
+
+// FromMemoFilter converts a thrift MemoFilter to its protobuf counterpart
+func FromMemoFilter(t *shared.MemoFilter) *apiv1.MemoFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.MemoFilter{
+		Key:   t.GetKey(),
+		Value: t.Value,
+	}
+}
+
+// ToMemoFilter converts a protobuf MemoFilter to its thrift counterpart
+func ToMemoFilter(t *apiv1.MemoFilter) *shared.MemoFilter {
+	if t == nil {
+		return nil
+	}
+	return &shared.MemoFilter{
+		Key:   stringPtr(t.Key),
+		Value: t.Value,
+	}
+}
+
+// FromStartTimeFilter converts a thrift StartTimeFilter to its protobuf counterpart
+func FromStartTimeFilter(t *shared.StartTimeFilter) *apiv1.StartTimeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimeFilter{
+		EarliestTime: t.GetEarliestTime(),
+		LatestTime:   t.GetLatestTime(),
+	}
+}
+
+// ToStartTimeFilter converts a protobuf StartTimeFilter to its thrift counterpart
+func ToStartTimeFilter(t *apiv1.StartTimeFilter) *shared.StartTimeFilter {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartTimeFilter{
+		EarliestTime: int64Ptr(t.EarliestTime),
+		LatestTime:   int64Ptr(t.LatestTime),
+	}
+}
+
+// FromDomainInfo converts a thrift DomainInfo to its protobuf counterpart
+func FromDomainInfo(t *shared.DomainInfo) *apiv1.DomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainInfo{
+		Name:        t.GetName(),
+		Status:      FromDomainStatus(t.Status),
+		Description: t.GetDescription(),
+		OwnerEmail:  t.GetOwnerEmail(),
+		Data:        t.Data,
+		Uuid:        t.GetUUID(),
+	}
+}
+
+// ToDomainInfo converts a protobuf DomainInfo to its thrift counterpart
+func ToDomainInfo(t *apiv1.DomainInfo) *shared.DomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainInfo{
+		Name:        stringPtr(t.Name),
+		Status:      ToDomainStatus(t.Status),
+		Description: stringPtr(t.Description),
+		OwnerEmail:  stringPtr(t.OwnerEmail),
+		Data:        t.Data,
+		UUID:        stringPtr(t.Uuid),
+	}
+}
+
+// FromDomainConfiguration converts a thrift DomainConfiguration to its protobuf counterpart
+func FromDomainConfiguration(t *shared.DomainConfiguration) *apiv1.DomainConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: t.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             fromBoolValue(t.EmitMetric),
+		BadBinaries:                            FromBadBinaries(t.BadBinaries),
+		HistoryArchivalStatus:                  FromArchivalStatus(t.HistoryArchivalStatus),
+		HistoryArchivalUri:                     t.GetHistoryArchivalURI(),
+		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
+		VisibilityArchivalUri:                  t.GetVisibilityArchivalURI(),
+		KnownTaskLists:                         t.KnownTaskLists,
+	}
+}
+
+// ToDomainConfiguration converts a protobuf DomainConfiguration to its thrift counterpart
+func ToDomainConfiguration(t *apiv1.DomainConfiguration) *shared.DomainConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: int32Ptr(t.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             toBoolValue(t.EmitMetric),
+		BadBinaries:                            ToBadBinaries(t.BadBinaries),
+		HistoryArchivalStatus:                  ToArchivalStatus(t.HistoryArchivalStatus),
+		HistoryArchivalURI:                     stringPtr(t.HistoryArchivalUri),
+		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
+		VisibilityArchivalURI:                  stringPtr(t.VisibilityArchivalUri),
+		KnownTaskLists:                         t.KnownTaskLists,
+	}
+}
+
+// FromBadBinaries converts a thrift BadBinaries to its protobuf counterpart
+func FromBadBinaries(t *shared.BadBinaries) *apiv1.BadBinaries {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BadBinaries{
+		Binaries: FromBadBinaryInfoMap(t.Binaries),
+	}
+}
+
+// ToBadBinaries converts a protobuf BadBinaries to its thrift counterpart
+func ToBadBinaries(t *apiv1.BadBinaries) *shared.BadBinaries {
+	if t == nil {
+		return nil
+	}
+	return &shared.BadBinaries{
+		Binaries: ToBadBinaryInfoMap(t.Binaries),
+	}
+}
+
+// FromBadBinaryInfo converts a thrift BadBinaryInfo to its protobuf counterpart
+func FromBadBinaryInfo(t *shared.BadBinaryInfo) *apiv1.BadBinaryInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BadBinaryInfo{
+		Reason:          t.GetReason(),
+		Operator:        t.GetOperator(),
+		CreatedTimeNano: t.GetCreatedTimeNano(),
+	}
+}
+
+// ToBadBinaryInfo converts a protobuf BadBinaryInfo to its thrift counterpart
+func ToBadBinaryInfo(t *apiv1.BadBinaryInfo) *shared.BadBinaryInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.BadBinaryInfo{
+		Reason:          stringPtr(t.Reason),
+		Operator:        stringPtr(t.Operator),
+		CreatedTimeNano: int64Ptr(t.CreatedTimeNano),
+	}
+}
+
+// FromUpdateDomainInfo converts a thrift UpdateDomainInfo to its protobuf counterpart
+func FromUpdateDomainInfo(t *shared.UpdateDomainInfo) *apiv1.UpdateDomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainInfo{
+		Description: fromStringValue(t.Description),
+		OwnerEmail:  fromStringValue(t.OwnerEmail),
+		Data:        t.Data,
+	}
+}
+
+// ToUpdateDomainInfo converts a protobuf UpdateDomainInfo to its thrift counterpart
+func ToUpdateDomainInfo(t *apiv1.UpdateDomainInfo) *shared.UpdateDomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateDomainInfo{
+		Description: toStringValue(t.Description),
+		OwnerEmail:  toStringValue(t.OwnerEmail),
+		Data:        t.Data,
+	}
+}
+
+// FromClusterReplicationConfiguration converts a thrift ClusterReplicationConfiguration to its protobuf counterpart
+func FromClusterReplicationConfiguration(t *shared.ClusterReplicationConfiguration) *apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ClusterReplicationConfiguration{
+		ClusterName:            t.GetClusterName(),
+		InitialFailoverVersion: t.GetInitialFailoverVersion(),
+	}
+}
+
+// ToClusterReplicationConfiguration converts a protobuf ClusterReplicationConfiguration to its thrift counterpart
+func ToClusterReplicationConfiguration(t *apiv1.ClusterReplicationConfiguration) *shared.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &shared.ClusterReplicationConfiguration{
+		ClusterName:            stringPtr(t.ClusterName),
+		InitialFailoverVersion: int64Ptr(t.InitialFailoverVersion),
+	}
+}
+
+// FromDomainReplicationConfiguration converts a thrift DomainReplicationConfiguration to its protobuf counterpart
+func FromDomainReplicationConfiguration(t *shared.DomainReplicationConfiguration) *apiv1.DomainReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainReplicationConfiguration{
+		ActiveClusterName:        t.GetActiveClusterName(),
+		Clusters:                 FromClusterReplicationConfigurationArray(t.Clusters),
+		FailoverState:            FromDomainFailoverState(t.FailoverState),
+		PendingActiveClusterName: t.GetPendingActiveClusterName(),
+	}
+}
+
+// ToDomainReplicationConfiguration converts a protobuf DomainReplicationConfiguration to its thrift counterpart
+func ToDomainReplicationConfiguration(t *apiv1.DomainReplicationConfiguration) *shared.DomainReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainReplicationConfiguration{
+		ActiveClusterName:        stringPtr(t.ActiveClusterName),
+		Clusters:                 ToClusterReplicationConfigurationArray(t.Clusters),
+		FailoverState:            ToDomainFailoverState(t.FailoverState),
+		PendingActiveClusterName: stringPtr(t.PendingActiveClusterName),
+	}
+}
+
+// FromRegisterDomainRequest converts a thrift RegisterDomainRequest to its protobuf counterpart
+func FromRegisterDomainRequest(t *shared.RegisterDomainRequest) *apiv1.RegisterDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RegisterDomainRequest{
+		Name:                                   t.GetName(),
+		Description:                            t.GetDescription(),
+		OwnerEmail:                             t.GetOwnerEmail(),
+		WorkflowExecutionRetentionPeriodInDays: t.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             t.GetEmitMetric(),
+		Clusters:                               FromClusterReplicationConfigurationArray(t.Clusters),
+		ActiveClusterName:                      t.GetActiveClusterName(),
+		Data:                                   t.Data,
+		SecurityToken:                          t.GetSecurityToken(),
+		IsGlobalDomain:                         t.GetIsGlobalDomain(),
+		HistoryArchivalStatus:                  FromArchivalStatus(t.HistoryArchivalStatus),
+		HistoryArchivalUri:                     t.GetHistoryArchivalURI(),
+		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
+		VisibilityArchivalUri:                  t.GetVisibilityArchivalURI(),
+	}
+}
+
+// ToRegisterDomainRequest converts a protobuf RegisterDomainRequest to its thrift counterpart
+func ToRegisterDomainRequest(t *apiv1.RegisterDomainRequest) *shared.RegisterDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RegisterDomainRequest{
+		Name:                                   stringPtr(t.Name),
+		Description:                            stringPtr(t.Description),
+		OwnerEmail:                             stringPtr(t.OwnerEmail),
+		WorkflowExecutionRetentionPeriodInDays: int32Ptr(t.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             boolPtr(t.EmitMetric),
+		Clusters:                               ToClusterReplicationConfigurationArray(t.Clusters),
+		ActiveClusterName:                      stringPtr(t.ActiveClusterName),
+		Data:                                   t.Data,
+		SecurityToken:                          stringPtr(t.SecurityToken),
+		IsGlobalDomain:                         boolPtr(t.IsGlobalDomain),
+		HistoryArchivalStatus:                  ToArchivalStatus(t.HistoryArchivalStatus),
+		HistoryArchivalURI:                     stringPtr(t.HistoryArchivalUri),
+		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
+		VisibilityArchivalURI:                  stringPtr(t.VisibilityArchivalUri),
+	}
+}
+
+// FromListDomainsRequest converts a thrift ListDomainsRequest to its protobuf counterpart
+func FromListDomainsRequest(t *shared.ListDomainsRequest) *apiv1.ListDomainsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsRequest{
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDomainsRequest converts a protobuf ListDomainsRequest to its thrift counterpart
+func ToListDomainsRequest(t *apiv1.ListDomainsRequest) *shared.ListDomainsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListDomainsRequest{
+		PageSize:      int32Ptr(t.PageSize),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListDomainsResponse converts a thrift ListDomainsResponse to its protobuf counterpart
+func FromListDomainsResponse(t *shared.ListDomainsResponse) *apiv1.ListDomainsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsResponse{
+		Domains:       FromDescribeDomainResponseArray(t.Domains),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListDomainsResponse converts a protobuf ListDomainsResponse to its thrift counterpart
+func ToListDomainsResponse(t *apiv1.ListDomainsResponse) *shared.ListDomainsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListDomainsResponse{
+		Domains:       ToDescribeDomainResponseArray(t.Domains),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromDescribeDomainRequest converts a thrift DescribeDomainRequest to its protobuf counterpart
+func FromDescribeDomainRequest(t *shared.DescribeDomainRequest) *apiv1.DescribeDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainRequest{
+		Name: t.GetName(),
+		Uuid: t.GetUUID(),
+	}
+}
+
+// ToDescribeDomainRequest converts a protobuf DescribeDomainRequest to its thrift counterpart
+func ToDescribeDomainRequest(t *apiv1.DescribeDomainRequest) *shared.DescribeDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeDomainRequest{
+		Name: stringPtr(t.Name),
+		UUID: stringPtr(t.Uuid),
+	}
+}
+
+// FromDescribeDomainResponse converts a thrift DescribeDomainResponse to its protobuf counterpart
+func FromDescribeDomainResponse(t *shared.DescribeDomainResponse) *apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainResponse{
+		DomainInfo:               FromDomainInfo(t.DomainInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+		PreviousFailoverVersion:  t.GetPreviousFailoverVersion(),
+	}
+}
+
+// ToDescribeDomainResponse converts a protobuf DescribeDomainResponse to its thrift counterpart
+func ToDescribeDomainResponse(t *apiv1.DescribeDomainResponse) *shared.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeDomainResponse{
+		DomainInfo:               ToDomainInfo(t.DomainInfo),
+		Configuration:            ToDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          int64Ptr(t.FailoverVersion),
+		IsGlobalDomain:           boolPtr(t.IsGlobalDomain),
+		PreviousFailoverVersion:  int64Ptr(t.PreviousFailoverVersion),
+	}
+}
+
+// FromUpdateDomainRequest converts a thrift UpdateDomainRequest to its protobuf counterpart
+func FromUpdateDomainRequest(t *shared.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainRequest{
+		Name:                     t.GetName(),
+		UpdatedInfo:              FromUpdateDomainInfo(t.UpdatedInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		SecurityToken:            t.GetSecurityToken(),
+		DeleteBadBinary:          t.GetDeleteBadBinary(),
+		GracefulFailover:         t.GetGracefulFailover(),
+		PromoteToGlobalDomain:    t.GetPromoteToGlobalDomain(),
+		DemoteToLocalDomain:      t.GetDemoteToLocalDomain(),
+	}
+}
+
+// ToUpdateDomainRequest converts a protobuf UpdateDomainRequest to its thrift counterpart
+func ToUpdateDomainRequest(t *apiv1.UpdateDomainRequest) *shared.UpdateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateDomainRequest{
+		Name:                     stringPtr(t.Name),
+		UpdatedInfo:              ToUpdateDomainInfo(t.UpdatedInfo),
+		Configuration:            ToDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(t.ReplicationConfiguration),
+		SecurityToken:            stringPtr(t.SecurityToken),
+		DeleteBadBinary:          stringPtr(t.DeleteBadBinary),
+		GracefulFailover:         boolPtr(t.GracefulFailover),
+		PromoteToGlobalDomain:    boolPtr(t.PromoteToGlobalDomain),
+		DemoteToLocalDomain:      boolPtr(t.DemoteToLocalDomain),
+	}
+}
+
+// FromUpdateDomainResponse converts a thrift UpdateDomainResponse to its protobuf counterpart
+func FromUpdateDomainResponse(t *shared.UpdateDomainResponse) *apiv1.UpdateDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainResponse{
+		DomainInfo:               FromDomainInfo(t.DomainInfo),
+		Configuration:            FromDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: FromDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+	}
+}
+
+// ToUpdateDomainResponse converts a protobuf UpdateDomainResponse to its thrift counterpart
+func ToUpdateDomainResponse(t *apiv1.UpdateDomainResponse) *shared.UpdateDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateDomainResponse{
+		DomainInfo:               ToDomainInfo(t.DomainInfo),
+		Configuration:            ToDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: ToDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          int64Ptr(t.FailoverVersion),
+		IsGlobalDomain:           boolPtr(t.IsGlobalDomain),
+	}
+}
+
+// FromDeprecateDomainRequest converts a thrift DeprecateDomainRequest to its protobuf counterpart
+func FromDeprecateDomainRequest(t *shared.DeprecateDomainRequest) *apiv1.DeprecateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeprecateDomainRequest{
+		Name:          t.GetName(),
+		SecurityToken: t.GetSecurityToken(),
+	}
+}
+
+// ToDeprecateDomainRequest converts a protobuf DeprecateDomainRequest to its thrift counterpart
+func ToDeprecateDomainRequest(t *apiv1.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DeprecateDomainRequest{
+		Name:          stringPtr(t.Name),
+		SecurityToken: stringPtr(t.SecurityToken),
+	}
+}
+
+// FromStartWorkflowExecutionRequest converts a thrift StartWorkflowExecutionRequest to its protobuf counterpart
+func FromStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *apiv1.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Memo:                                FromMemo(t.Memo),
+		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+		Header:                              FromHeader(t.Header),
+		Priority:                            t.GetPriority(),
+		TerminationProtected:                t.GetTerminationProtected(),
+	}
+}
+
+// ToStartWorkflowExecutionRequest converts a protobuf StartWorkflowExecutionRequest to its thrift counterpart
+func ToStartWorkflowExecutionRequest(t *apiv1.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:                              stringPtr(t.Domain),
+		WorkflowId:                          stringPtr(t.WorkflowId),
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(t.Identity),
+		RequestId:                           stringPtr(t.RequestId),
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        stringPtr(t.CronSchedule),
+		Memo:                                ToMemo(t.Memo),
+		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		Header:                              ToHeader(t.Header),
+		Priority:                            int32Ptr(t.Priority),
+		TerminationProtected:                boolPtr(t.TerminationProtected),
+	}
+}
+
+// FromStartWorkflowExecutionResponse converts a thrift StartWorkflowExecutionResponse to its protobuf counterpart
+func FromStartWorkflowExecutionResponse(t *shared.StartWorkflowExecutionResponse) *apiv1.StartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionResponse{
+		RunId: t.GetRunId(),
+	}
+}
+
+// ToStartWorkflowExecutionResponse converts a protobuf StartWorkflowExecutionResponse to its thrift counterpart
+func ToStartWorkflowExecutionResponse(t *apiv1.StartWorkflowExecutionResponse) *shared.StartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionResponse{
+		RunId: stringPtr(t.RunId),
+	}
+}
+
+// FromPollForDecisionTaskRequest converts a thrift PollForDecisionTaskRequest to its protobuf counterpart
+func FromPollForDecisionTaskRequest(t *shared.PollForDecisionTaskRequest) *apiv1.PollForDecisionTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskRequest{
+		Domain:         t.GetDomain(),
+		TaskList:       FromTaskList(t.TaskList),
+		Identity:       t.GetIdentity(),
+		BinaryChecksum: t.GetBinaryChecksum(),
+	}
+}
+
+// ToPollForDecisionTaskRequest converts a protobuf PollForDecisionTaskRequest to its thrift counterpart
+func ToPollForDecisionTaskRequest(t *apiv1.PollForDecisionTaskRequest) *shared.PollForDecisionTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskRequest{
+		Domain:         stringPtr(t.Domain),
+		TaskList:       ToTaskList(t.TaskList),
+		Identity:       stringPtr(t.Identity),
+		BinaryChecksum: stringPtr(t.BinaryChecksum),
+	}
+}
+
+// FromPollForDecisionTaskResponse converts a thrift PollForDecisionTaskResponse to its protobuf counterpart
+func FromPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *apiv1.PollForDecisionTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskResponse{
+		TaskToken:                 t.TaskToken,
+		WorkflowExecution:         FromWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:              FromWorkflowType(t.WorkflowType),
+		PreviousStartedEventId:    t.GetPreviousStartedEventId(),
+		StartedEventId:            t.GetStartedEventId(),
+		Attempt:                   t.GetAttempt(),
+		BacklogCountHint:          t.GetBacklogCountHint(),
+		History:                   FromHistory(t.History),
+		NextPageToken:             t.NextPageToken,
+		Query:                     FromWorkflowQuery(t.Query),
+		WorkflowExecutionTaskList: FromTaskList(t.WorkflowExecutionTaskList),
+		ScheduledTimestamp:        t.GetScheduledTimestamp(),
+		StartedTimestamp:          t.GetStartedTimestamp(),
+		Queries:                   FromWorkflowQueryArray(t.Queries),
+	}
+}
+
+// ToPollForDecisionTaskResponse converts a protobuf PollForDecisionTaskResponse to its thrift counterpart
+func ToPollForDecisionTaskResponse(t *apiv1.PollForDecisionTaskResponse) *shared.PollForDecisionTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskResponse{
+		TaskToken:                 t.TaskToken,
+		WorkflowExecution:         ToWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:              ToWorkflowType(t.WorkflowType),
+		PreviousStartedEventId:    int64Ptr(t.PreviousStartedEventId),
+		StartedEventId:            int64Ptr(t.StartedEventId),
+		Attempt:                   int64Ptr(t.Attempt),
+		BacklogCountHint:          int64Ptr(t.BacklogCountHint),
+		History:                   ToHistory(t.History),
+		NextPageToken:             t.NextPageToken,
+		Query:                     ToWorkflowQuery(t.Query),
+		WorkflowExecutionTaskList: ToTaskList(t.WorkflowExecutionTaskList),
+		ScheduledTimestamp:        int64Ptr(t.ScheduledTimestamp),
+		StartedTimestamp:          int64Ptr(t.StartedTimestamp),
+		Queries:                   ToWorkflowQueryArray(t.Queries),
+	}
+}
+
+// FromStickyExecutionAttributes converts a thrift StickyExecutionAttributes to its protobuf counterpart
+func FromStickyExecutionAttributes(t *shared.StickyExecutionAttributes) *apiv1.StickyExecutionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StickyExecutionAttributes{
+		WorkerTaskList:                FromTaskList(t.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+	}
+}
+
+// ToStickyExecutionAttributes converts a protobuf StickyExecutionAttributes to its thrift counterpart
+func ToStickyExecutionAttributes(t *apiv1.StickyExecutionAttributes) *shared.StickyExecutionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.StickyExecutionAttributes{
+		WorkerTaskList:                ToTaskList(t.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: int32Ptr(t.ScheduleToStartTimeoutSeconds),
+	}
+}
+
+// FromRespondDecisionTaskCompletedRequest converts a thrift RespondDecisionTaskCompletedRequest to its protobuf counterpart
+func FromRespondDecisionTaskCompletedRequest(t *shared.RespondDecisionTaskCompletedRequest) *apiv1.RespondDecisionTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  t.TaskToken,
+		Decisions:                  FromDecisionArray(t.Decisions),
+		ExecutionContext:           t.ExecutionContext,
+		Identity:                   t.GetIdentity(),
+		StickyAttributes:           FromStickyExecutionAttributes(t.StickyAttributes),
+		ReturnNewDecisionTask:      t.GetReturnNewDecisionTask(),
+		ForceCreateNewDecisionTask: t.GetForceCreateNewDecisionTask(),
+		BinaryChecksum:             t.GetBinaryChecksum(),
+		QueryResults:               FromWorkflowQueryResultArray(t.QueryResults),
+		QueryTypes:                 t.QueryTypes,
+	}
+}
+
+// ToRespondDecisionTaskCompletedRequest converts a protobuf RespondDecisionTaskCompletedRequest to its thrift counterpart
+func ToRespondDecisionTaskCompletedRequest(t *apiv1.RespondDecisionTaskCompletedRequest) *shared.RespondDecisionTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  t.TaskToken,
+		Decisions:                  ToDecisionArray(t.Decisions),
+		ExecutionContext:           t.ExecutionContext,
+		Identity:                   stringPtr(t.Identity),
+		StickyAttributes:           ToStickyExecutionAttributes(t.StickyAttributes),
+		ReturnNewDecisionTask:      boolPtr(t.ReturnNewDecisionTask),
+		ForceCreateNewDecisionTask: boolPtr(t.ForceCreateNewDecisionTask),
+		BinaryChecksum:             stringPtr(t.BinaryChecksum),
+		QueryResults:               ToWorkflowQueryResultArray(t.QueryResults),
+		QueryTypes:                 t.QueryTypes,
+	}
+}
+
+// FromRespondDecisionTaskCompletedResponse converts a thrift RespondDecisionTaskCompletedResponse to its protobuf counterpart
+func FromRespondDecisionTaskCompletedResponse(t *shared.RespondDecisionTaskCompletedResponse) *apiv1.RespondDecisionTaskCompletedResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedResponse{
+		DecisionTask: FromPollForDecisionTaskResponse(t.DecisionTask),
+	}
+}
+
+// ToRespondDecisionTaskCompletedResponse converts a protobuf RespondDecisionTaskCompletedResponse to its thrift counterpart
+func ToRespondDecisionTaskCompletedResponse(t *apiv1.RespondDecisionTaskCompletedResponse) *shared.RespondDecisionTaskCompletedResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedResponse{
+		DecisionTask: ToPollForDecisionTaskResponse(t.DecisionTask),
+	}
+}
+
+// FromRespondDecisionTaskFailedRequest converts a thrift RespondDecisionTaskFailedRequest to its protobuf counterpart
+func FromRespondDecisionTaskFailedRequest(t *shared.RespondDecisionTaskFailedRequest) *apiv1.RespondDecisionTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Cause:     FromDecisionTaskFailedCause(t.Cause),
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondDecisionTaskFailedRequest converts a protobuf RespondDecisionTaskFailedRequest to its thrift counterpart
+func ToRespondDecisionTaskFailedRequest(t *apiv1.RespondDecisionTaskFailedRequest) *shared.RespondDecisionTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Cause:     ToDecisionTaskFailedCause(t.Cause),
+		Details:   t.Details,
+		Identity:  stringPtr(t.Identity),
+	}
+}
+
+// FromPollForActivityTaskRequest converts a thrift PollForActivityTaskRequest to its protobuf counterpart
+func FromPollForActivityTaskRequest(t *shared.PollForActivityTaskRequest) *apiv1.PollForActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskRequest{
+		Domain:           t.GetDomain(),
+		TaskList:         FromTaskList(t.TaskList),
+		Identity:         t.GetIdentity(),
+		TaskListMetadata: FromTaskListMetadata(t.TaskListMetadata),
+	}
+}
+
+// ToPollForActivityTaskRequest converts a protobuf PollForActivityTaskRequest to its thrift counterpart
+func ToPollForActivityTaskRequest(t *apiv1.PollForActivityTaskRequest) *shared.PollForActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskRequest{
+		Domain:           stringPtr(t.Domain),
+		TaskList:         ToTaskList(t.TaskList),
+		Identity:         stringPtr(t.Identity),
+		TaskListMetadata: ToTaskListMetadata(t.TaskListMetadata),
+	}
+}
+
+// FromPollForActivityTaskResponse converts a thrift PollForActivityTaskResponse to its protobuf counterpart
+func FromPollForActivityTaskResponse(t *shared.PollForActivityTaskResponse) *apiv1.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskResponse{
+		TaskToken:                       t.TaskToken,
+		WorkflowExecution:               FromWorkflowExecution(t.WorkflowExecution),
+		ActivityId:                      t.GetActivityId(),
+		ActivityType:                    FromActivityType(t.ActivityType),
+		Input:                           t.Input,
+		ScheduledTimestamp:              t.GetScheduledTimestamp(),
+		ScheduleToCloseTimeoutSeconds:   t.GetScheduleToCloseTimeoutSeconds(),
+		StartedTimestamp:                t.GetStartedTimestamp(),
+		StartToCloseTimeoutSeconds:      t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:         t.GetHeartbeatTimeoutSeconds(),
+		Attempt:                         t.GetAttempt(),
+		ScheduledTimestampOfThisAttempt: t.GetScheduledTimestampOfThisAttempt(),
+		HeartbeatDetails:                t.HeartbeatDetails,
+		WorkflowType:                    FromWorkflowType(t.WorkflowType),
+		WorkflowDomain:                  t.GetWorkflowDomain(),
+		Header:                          FromHeader(t.Header),
+	}
+}
+
+// ToPollForActivityTaskResponse converts a protobuf PollForActivityTaskResponse to its thrift counterpart
+func ToPollForActivityTaskResponse(t *apiv1.PollForActivityTaskResponse) *shared.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskResponse{
+		TaskToken:                       t.TaskToken,
+		WorkflowExecution:               ToWorkflowExecution(t.WorkflowExecution),
+		ActivityId:                      stringPtr(t.ActivityId),
+		ActivityType:                    ToActivityType(t.ActivityType),
+		Input:                           t.Input,
+		ScheduledTimestamp:              int64Ptr(t.ScheduledTimestamp),
+		ScheduleToCloseTimeoutSeconds:   int32Ptr(t.ScheduleToCloseTimeoutSeconds),
+		StartedTimestamp:                int64Ptr(t.StartedTimestamp),
+		StartToCloseTimeoutSeconds:      int32Ptr(t.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:         int32Ptr(t.HeartbeatTimeoutSeconds),
+		Attempt:                         int32Ptr(t.Attempt),
+		ScheduledTimestampOfThisAttempt: int64Ptr(t.ScheduledTimestampOfThisAttempt),
+		HeartbeatDetails:                t.HeartbeatDetails,
+		WorkflowType:                    ToWorkflowType(t.WorkflowType),
+		WorkflowDomain:                  stringPtr(t.WorkflowDomain),
+		Header:                          ToHeader(t.Header),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatRequest converts a thrift RecordActivityTaskHeartbeatRequest to its protobuf counterpart
+func FromRecordActivityTaskHeartbeatRequest(t *shared.RecordActivityTaskHeartbeatRequest) *apiv1.RecordActivityTaskHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatRequest{
+		TaskToken:               t.TaskToken,
+		Details:                 t.Details,
+		Identity:                t.GetIdentity(),
+		CancellationWaitSeconds: t.GetCancellationWaitSeconds(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatRequest converts a protobuf RecordActivityTaskHeartbeatRequest to its thrift counterpart
+func ToRecordActivityTaskHeartbeatRequest(t *apiv1.RecordActivityTaskHeartbeatRequest) *shared.RecordActivityTaskHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatRequest{
+		TaskToken:               t.TaskToken,
+		Details:                 t.Details,
+		Identity:                stringPtr(t.Identity),
+		CancellationWaitSeconds: int32Ptr(t.CancellationWaitSeconds),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatByIDRequest converts a thrift RecordActivityTaskHeartbeatByIDRequest to its protobuf counterpart
+func FromRecordActivityTaskHeartbeatByIDRequest(t *shared.RecordActivityTaskHeartbeatByIDRequest) *apiv1.RecordActivityTaskHeartbeatByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:                  t.GetDomain(),
+		WorkflowId:              t.GetWorkflowID(),
+		RunId:                   t.GetRunID(),
+		ActivityId:              t.GetActivityID(),
+		Details:                 t.Details,
+		Identity:                t.GetIdentity(),
+		CancellationWaitSeconds: t.GetCancellationWaitSeconds(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatByIDRequest converts a protobuf RecordActivityTaskHeartbeatByIDRequest to its thrift counterpart
+func ToRecordActivityTaskHeartbeatByIDRequest(t *apiv1.RecordActivityTaskHeartbeatByIDRequest) *shared.RecordActivityTaskHeartbeatByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:                  stringPtr(t.Domain),
+		WorkflowID:              stringPtr(t.WorkflowId),
+		RunID:                   stringPtr(t.RunId),
+		ActivityID:              stringPtr(t.ActivityId),
+		Details:                 t.Details,
+		Identity:                stringPtr(t.Identity),
+		CancellationWaitSeconds: int32Ptr(t.CancellationWaitSeconds),
+	}
+}
+
+// FromRecordActivityTaskHeartbeatResponse converts a thrift RecordActivityTaskHeartbeatResponse to its protobuf counterpart
+func FromRecordActivityTaskHeartbeatResponse(t *shared.RecordActivityTaskHeartbeatResponse) *apiv1.RecordActivityTaskHeartbeatResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: t.GetCancelRequested(),
+	}
+}
+
+// ToRecordActivityTaskHeartbeatResponse converts a protobuf RecordActivityTaskHeartbeatResponse to its thrift counterpart
+func ToRecordActivityTaskHeartbeatResponse(t *apiv1.RecordActivityTaskHeartbeatResponse) *shared.RecordActivityTaskHeartbeatResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: boolPtr(t.CancelRequested),
+	}
+}
+
+// FromRespondActivityTaskCompletedRequest converts a thrift RespondActivityTaskCompletedRequest to its protobuf counterpart
+func FromRespondActivityTaskCompletedRequest(t *shared.RespondActivityTaskCompletedRequest) *apiv1.RespondActivityTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedRequest{
+		TaskToken: t.TaskToken,
+		Result:    t.Result,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCompletedRequest converts a protobuf RespondActivityTaskCompletedRequest to its thrift counterpart
+func ToRespondActivityTaskCompletedRequest(t *apiv1.RespondActivityTaskCompletedRequest) *shared.RespondActivityTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedRequest{
+		TaskToken: t.TaskToken,
+		Result:    t.Result,
+		Identity:  stringPtr(t.Identity),
+	}
+}
+
+// FromRespondActivityTaskFailedRequest converts a thrift RespondActivityTaskFailedRequest to its protobuf counterpart
+func FromRespondActivityTaskFailedRequest(t *shared.RespondActivityTaskFailedRequest) *apiv1.RespondActivityTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Reason:    t.GetReason(),
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskFailedRequest converts a protobuf RespondActivityTaskFailedRequest to its thrift counterpart
+func ToRespondActivityTaskFailedRequest(t *apiv1.RespondActivityTaskFailedRequest) *shared.RespondActivityTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Reason:    stringPtr(t.Reason),
+		Details:   t.Details,
+		Identity:  stringPtr(t.Identity),
+	}
+}
+
+// FromRespondActivityTaskCanceledRequest converts a thrift RespondActivityTaskCanceledRequest to its protobuf counterpart
+func FromRespondActivityTaskCanceledRequest(t *shared.RespondActivityTaskCanceledRequest) *apiv1.RespondActivityTaskCanceledRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledRequest{
+		TaskToken: t.TaskToken,
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCanceledRequest converts a protobuf RespondActivityTaskCanceledRequest to its thrift counterpart
+func ToRespondActivityTaskCanceledRequest(t *apiv1.RespondActivityTaskCanceledRequest) *shared.RespondActivityTaskCanceledRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledRequest{
+		TaskToken: t.TaskToken,
+		Details:   t.Details,
+		Identity:  stringPtr(t.Identity),
+	}
+}
+
+// FromRespondActivityTaskCompletedByIDRequest converts a thrift RespondActivityTaskCompletedByIDRequest to its protobuf counterpart
+func FromRespondActivityTaskCompletedByIDRequest(t *shared.RespondActivityTaskCompletedByIDRequest) *apiv1.RespondActivityTaskCompletedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Result:     t.Result,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCompletedByIDRequest converts a protobuf RespondActivityTaskCompletedByIDRequest to its thrift counterpart
+func ToRespondActivityTaskCompletedByIDRequest(t *apiv1.RespondActivityTaskCompletedByIDRequest) *shared.RespondActivityTaskCompletedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedByIDRequest{
+		Domain:     stringPtr(t.Domain),
+		WorkflowID: stringPtr(t.WorkflowId),
+		RunID:      stringPtr(t.RunId),
+		ActivityID: stringPtr(t.ActivityId),
+		Result:     t.Result,
+		Identity:   stringPtr(t.Identity),
+	}
+}
+
+// FromRespondActivityTaskFailedByIDRequest converts a thrift RespondActivityTaskFailedByIDRequest to its protobuf counterpart
+func FromRespondActivityTaskFailedByIDRequest(t *shared.RespondActivityTaskFailedByIDRequest) *apiv1.RespondActivityTaskFailedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Reason:     t.GetReason(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskFailedByIDRequest converts a protobuf RespondActivityTaskFailedByIDRequest to its thrift counterpart
+func ToRespondActivityTaskFailedByIDRequest(t *apiv1.RespondActivityTaskFailedByIDRequest) *shared.RespondActivityTaskFailedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedByIDRequest{
+		Domain:     stringPtr(t.Domain),
+		WorkflowID: stringPtr(t.WorkflowId),
+		RunID:      stringPtr(t.RunId),
+		ActivityID: stringPtr(t.ActivityId),
+		Reason:     stringPtr(t.Reason),
+		Details:    t.Details,
+		Identity:   stringPtr(t.Identity),
+	}
+}
+
+// FromRespondActivityTaskCanceledByIDRequest converts a thrift RespondActivityTaskCanceledByIDRequest to its protobuf counterpart
+func FromRespondActivityTaskCanceledByIDRequest(t *shared.RespondActivityTaskCanceledByIDRequest) *apiv1.RespondActivityTaskCanceledByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// ToRespondActivityTaskCanceledByIDRequest converts a protobuf RespondActivityTaskCanceledByIDRequest to its thrift counterpart
+func ToRespondActivityTaskCanceledByIDRequest(t *apiv1.RespondActivityTaskCanceledByIDRequest) *shared.RespondActivityTaskCanceledByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledByIDRequest{
+		Domain:     stringPtr(t.Domain),
+		WorkflowID: stringPtr(t.WorkflowId),
+		RunID:      stringPtr(t.RunId),
+		ActivityID: stringPtr(t.ActivityId),
+		Details:    t.Details,
+		Identity:   stringPtr(t.Identity),
+	}
+}
+
+// FromRequestCancelWorkflowExecutionRequest converts a thrift RequestCancelWorkflowExecutionRequest to its protobuf counterpart
+func FromRequestCancelWorkflowExecutionRequest(t *shared.RequestCancelWorkflowExecutionRequest) *apiv1.RequestCancelWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+		RequestId:         t.GetRequestId(),
+	}
+}
+
+// ToRequestCancelWorkflowExecutionRequest converts a protobuf RequestCancelWorkflowExecutionRequest to its thrift counterpart
+func ToRequestCancelWorkflowExecutionRequest(t *apiv1.RequestCancelWorkflowExecutionRequest) *shared.RequestCancelWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionRequest{
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          stringPtr(t.Identity),
+		RequestId:         stringPtr(t.RequestId),
+	}
+}
+
+// FromGetWorkflowExecutionHistoryRequest converts a thrift GetWorkflowExecutionHistoryRequest to its protobuf counterpart
+func FromGetWorkflowExecutionHistoryRequest(t *shared.GetWorkflowExecutionHistoryRequest) *apiv1.GetWorkflowExecutionHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryRequest{
+		Domain:                 t.GetDomain(),
+		Execution:              FromWorkflowExecution(t.Execution),
+		MaximumPageSize:        t.GetMaximumPageSize(),
+		NextPageToken:          t.NextPageToken,
+		WaitForNewEvent:        t.GetWaitForNewEvent(),
+		HistoryEventFilterType: FromHistoryEventFilterType(t.HistoryEventFilterType),
+		FollowContinuedAsNew:   t.GetFollowContinuedAsNew(),
+	}
+}
+
+// ToGetWorkflowExecutionHistoryRequest converts a protobuf GetWorkflowExecutionHistoryRequest to its thrift counterpart
+func ToGetWorkflowExecutionHistoryRequest(t *apiv1.GetWorkflowExecutionHistoryRequest) *shared.GetWorkflowExecutionHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:                 stringPtr(t.Domain),
+		Execution:              ToWorkflowExecution(t.Execution),
+		MaximumPageSize:        int32Ptr(t.MaximumPageSize),
+		NextPageToken:          t.NextPageToken,
+		WaitForNewEvent:        boolPtr(t.WaitForNewEvent),
+		HistoryEventFilterType: ToHistoryEventFilterType(t.HistoryEventFilterType),
+		FollowContinuedAsNew:   boolPtr(t.FollowContinuedAsNew),
+	}
+}
+
+// FromGetWorkflowExecutionHistoryResponse converts a thrift GetWorkflowExecutionHistoryResponse to its protobuf counterpart
+func FromGetWorkflowExecutionHistoryResponse(t *shared.GetWorkflowExecutionHistoryResponse) *apiv1.GetWorkflowExecutionHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryResponse{
+		History:       FromHistory(t.History),
+		NextPageToken: t.NextPageToken,
+		Archived:      t.GetArchived(),
+	}
+}
+
+// ToGetWorkflowExecutionHistoryResponse converts a protobuf GetWorkflowExecutionHistoryResponse to its thrift counterpart
+func ToGetWorkflowExecutionHistoryResponse(t *apiv1.GetWorkflowExecutionHistoryResponse) *shared.GetWorkflowExecutionHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryResponse{
+		History:       ToHistory(t.History),
+		NextPageToken: t.NextPageToken,
+		Archived:      boolPtr(t.Archived),
+	}
+}
+
+// FromSignalWorkflowExecutionRequest converts a thrift SignalWorkflowExecutionRequest to its protobuf counterpart
+func FromSignalWorkflowExecutionRequest(t *shared.SignalWorkflowExecutionRequest) *apiv1.SignalWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWorkflowExecutionRequest{
+		Domain:              t.GetDomain(),
+		WorkflowExecution:   FromWorkflowExecution(t.WorkflowExecution),
+		SignalName:          t.GetSignalName(),
+		Input:               t.Input,
+		Identity:            t.GetIdentity(),
+		RequestId:           t.GetRequestId(),
+		Control:             t.Control,
+		ExpectedNextEventId: t.GetExpectedNextEventId(),
+	}
+}
+
+// ToSignalWorkflowExecutionRequest converts a protobuf SignalWorkflowExecutionRequest to its thrift counterpart
+func ToSignalWorkflowExecutionRequest(t *apiv1.SignalWorkflowExecutionRequest) *shared.SignalWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionRequest{
+		Domain:              stringPtr(t.Domain),
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		SignalName:          stringPtr(t.SignalName),
+		Input:               t.Input,
+		Identity:            stringPtr(t.Identity),
+		RequestId:           stringPtr(t.RequestId),
+		Control:             t.Control,
+		ExpectedNextEventId: int64Ptr(t.ExpectedNextEventId),
+	}
+}
+
+// FromSignalWithStartWorkflowExecutionRequest converts a thrift SignalWithStartWorkflowExecutionRequest to its protobuf counterpart
+func FromSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflowExecutionRequest) *apiv1.SignalWithStartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        FromWorkflowType(t.WorkflowType),
+		TaskList:                            FromTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               FromWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		SignalName:                          t.GetSignalName(),
+		SignalInput:                         t.SignalInput,
+		Control:                             t.Control,
+		RetryPolicy:                         FromRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+		Memo:                                FromMemo(t.Memo),
+		SearchAttributes:                    FromSearchAttributes(t.SearchAttributes),
+		Header:                              FromHeader(t.Header),
+		TerminationProtected:                t.GetTerminationProtected(),
+	}
+}
+
+// ToSignalWithStartWorkflowExecutionRequest converts a protobuf SignalWithStartWorkflowExecutionRequest to its thrift counterpart
+func ToSignalWithStartWorkflowExecutionRequest(t *apiv1.SignalWithStartWorkflowExecutionRequest) *shared.SignalWithStartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              stringPtr(t.Domain),
+		WorkflowId:                          stringPtr(t.WorkflowId),
+		WorkflowType:                        ToWorkflowType(t.WorkflowType),
+		TaskList:                            ToTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(t.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(t.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(t.Identity),
+		RequestId:                           stringPtr(t.RequestId),
+		WorkflowIdReusePolicy:               ToWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		SignalName:                          stringPtr(t.SignalName),
+		SignalInput:                         t.SignalInput,
+		Control:                             t.Control,
+		RetryPolicy:                         ToRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        stringPtr(t.CronSchedule),
+		Memo:                                ToMemo(t.Memo),
+		SearchAttributes:                    ToSearchAttributes(t.SearchAttributes),
+		Header:                              ToHeader(t.Header),
+		TerminationProtected:                boolPtr(t.TerminationProtected),
+	}
+}
+
+// FromTerminateWorkflowExecutionRequest converts a thrift TerminateWorkflowExecutionRequest to its protobuf counterpart
+func FromTerminateWorkflowExecutionRequest(t *shared.TerminateWorkflowExecutionRequest) *apiv1.TerminateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TerminateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Details:           t.Details,
+		Identity:          t.GetIdentity(),
+		Force:             t.GetForce(),
+		SecurityToken:     t.GetSecurityToken(),
+	}
+}
+
+// ToTerminateWorkflowExecutionRequest converts a protobuf TerminateWorkflowExecutionRequest to its thrift counterpart
+func ToTerminateWorkflowExecutionRequest(t *apiv1.TerminateWorkflowExecutionRequest) *shared.TerminateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionRequest{
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            stringPtr(t.Reason),
+		Details:           t.Details,
+		Identity:          stringPtr(t.Identity),
+		Force:             boolPtr(t.Force),
+		SecurityToken:     stringPtr(t.SecurityToken),
+	}
+}
+
+// FromPauseWorkflowExecutionRequest converts a thrift PauseWorkflowExecutionRequest to its protobuf counterpart
+func FromPauseWorkflowExecutionRequest(t *shared.PauseWorkflowExecutionRequest) *apiv1.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PauseWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToPauseWorkflowExecutionRequest converts a protobuf PauseWorkflowExecutionRequest to its thrift counterpart
+func ToPauseWorkflowExecutionRequest(t *apiv1.PauseWorkflowExecutionRequest) *shared.PauseWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.PauseWorkflowExecutionRequest{
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Reason:            stringPtr(t.Reason),
+		Identity:          stringPtr(t.Identity),
+	}
+}
+
+// FromResumeWorkflowExecutionRequest converts a thrift ResumeWorkflowExecutionRequest to its protobuf counterpart
+func FromResumeWorkflowExecutionRequest(t *shared.ResumeWorkflowExecutionRequest) *apiv1.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResumeWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// ToResumeWorkflowExecutionRequest converts a protobuf ResumeWorkflowExecutionRequest to its thrift counterpart
+func ToResumeWorkflowExecutionRequest(t *apiv1.ResumeWorkflowExecutionRequest) *shared.ResumeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResumeWorkflowExecutionRequest{
+		Domain:            stringPtr(t.Domain),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Identity:          stringPtr(t.Identity),
+	}
+}
+
+// FromResetWorkflowExecutionRequest converts a thrift ResetWorkflowExecutionRequest to its protobuf counterpart
+func FromResetWorkflowExecutionRequest(t *shared.ResetWorkflowExecutionRequest) *apiv1.ResetWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetWorkflowExecutionRequest{
+		Domain:                t.GetDomain(),
+		WorkflowExecution:     FromWorkflowExecution(t.WorkflowExecution),
+		Reason:                t.GetReason(),
+		DecisionFinishEventId: t.GetDecisionFinishEventId(),
+		RequestId:             t.GetRequestId(),
+	}
+}
+
+// ToResetWorkflowExecutionRequest converts a protobuf ResetWorkflowExecutionRequest to its thrift counterpart
+func ToResetWorkflowExecutionRequest(t *apiv1.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionRequest{
+		Domain:                stringPtr(t.Domain),
+		WorkflowExecution:     ToWorkflowExecution(t.WorkflowExecution),
+		Reason:                stringPtr(t.Reason),
+		DecisionFinishEventId: int64Ptr(t.DecisionFinishEventId),
+		RequestId:             stringPtr(t.RequestId),
+	}
+}
+
+// FromResetWorkflowExecutionResponse converts a thrift ResetWorkflowExecutionResponse to its protobuf counterpart
+func FromResetWorkflowExecutionResponse(t *shared.ResetWorkflowExecutionResponse) *apiv1.ResetWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetWorkflowExecutionResponse{
+		RunId: t.GetRunId(),
+	}
+}
+
+// ToResetWorkflowExecutionResponse converts a protobuf ResetWorkflowExecutionResponse to its thrift counterpart
+func ToResetWorkflowExecutionResponse(t *apiv1.ResetWorkflowExecutionResponse) *shared.ResetWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionResponse{
+		RunId: stringPtr(t.RunId),
+	}
+}
+
+// FromListOpenWorkflowExecutionsRequest converts a thrift ListOpenWorkflowExecutionsRequest to its protobuf counterpart
+func FromListOpenWorkflowExecutionsRequest(t *shared.ListOpenWorkflowExecutionsRequest) *apiv1.ListOpenWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: FromStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: FromWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      FromWorkflowTypeFilter(t.TypeFilter),
+	}
+}
+
+// ToListOpenWorkflowExecutionsRequest converts a protobuf ListOpenWorkflowExecutionsRequest to its thrift counterpart
+func ToListOpenWorkflowExecutionsRequest(t *apiv1.ListOpenWorkflowExecutionsRequest) *shared.ListOpenWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          stringPtr(t.Domain),
+		MaximumPageSize: int32Ptr(t.MaximumPageSize),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: ToStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: ToWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      ToWorkflowTypeFilter(t.TypeFilter),
+	}
+}
+
+// FromListOpenWorkflowExecutionsResponse converts a thrift ListOpenWorkflowExecutionsResponse to its protobuf counterpart
+func FromListOpenWorkflowExecutionsResponse(t *shared.ListOpenWorkflowExecutionsResponse) *apiv1.ListOpenWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListOpenWorkflowExecutionsResponse converts a protobuf ListOpenWorkflowExecutionsResponse to its thrift counterpart
+func ToListOpenWorkflowExecutionsResponse(t *apiv1.ListOpenWorkflowExecutionsResponse) *shared.ListOpenWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsResponse{
+		Executions:    ToWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListClosedWorkflowExecutionsRequest converts a thrift ListClosedWorkflowExecutionsRequest to its protobuf counterpart
+func FromListClosedWorkflowExecutionsRequest(t *shared.ListClosedWorkflowExecutionsRequest) *apiv1.ListClosedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: FromStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: FromWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      FromWorkflowTypeFilter(t.TypeFilter),
+		StatusFilter:    FromWorkflowExecutionCloseStatus(t.StatusFilter),
+		MemoFilter:      FromMemoFilter(t.MemoFilter),
+	}
+}
+
+// ToListClosedWorkflowExecutionsRequest converts a protobuf ListClosedWorkflowExecutionsRequest to its thrift counterpart
+func ToListClosedWorkflowExecutionsRequest(t *apiv1.ListClosedWorkflowExecutionsRequest) *shared.ListClosedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsRequest{
+		Domain:          stringPtr(t.Domain),
+		MaximumPageSize: int32Ptr(t.MaximumPageSize),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: ToStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: ToWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      ToWorkflowTypeFilter(t.TypeFilter),
+		StatusFilter:    ToWorkflowExecutionCloseStatus(t.StatusFilter),
+		MemoFilter:      ToMemoFilter(t.MemoFilter),
+	}
+}
+
+// FromListClosedWorkflowExecutionsResponse converts a thrift ListClosedWorkflowExecutionsResponse to its protobuf counterpart
+func FromListClosedWorkflowExecutionsResponse(t *shared.ListClosedWorkflowExecutionsResponse) *apiv1.ListClosedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListClosedWorkflowExecutionsResponse converts a protobuf ListClosedWorkflowExecutionsResponse to its thrift counterpart
+func ToListClosedWorkflowExecutionsResponse(t *apiv1.ListClosedWorkflowExecutionsResponse) *shared.ListClosedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsResponse{
+		Executions:    ToWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListWorkflowExecutionsRequest converts a thrift ListWorkflowExecutionsRequest to its protobuf counterpart
+func FromListWorkflowExecutionsRequest(t *shared.ListWorkflowExecutionsRequest) *apiv1.ListWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListWorkflowExecutionsRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+		Query:         t.GetQuery(),
+		SliceId:       t.GetSliceID(),
+		SliceCount:    t.GetSliceCount(),
+	}
+}
+
+// ToListWorkflowExecutionsRequest converts a protobuf ListWorkflowExecutionsRequest to its thrift counterpart
+func ToListWorkflowExecutionsRequest(t *apiv1.ListWorkflowExecutionsRequest) *shared.ListWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionsRequest{
+		Domain:        stringPtr(t.Domain),
+		PageSize:      int32Ptr(t.PageSize),
+		NextPageToken: t.NextPageToken,
+		Query:         stringPtr(t.Query),
+		SliceID:       int32Ptr(t.SliceId),
+		SliceCount:    int32Ptr(t.SliceCount),
+	}
+}
+
+// FromListWorkflowExecutionsResponse converts a thrift ListWorkflowExecutionsResponse to its protobuf counterpart
+func FromListWorkflowExecutionsResponse(t *shared.ListWorkflowExecutionsResponse) *apiv1.ListWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListWorkflowExecutionsResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListWorkflowExecutionsResponse converts a protobuf ListWorkflowExecutionsResponse to its thrift counterpart
+func ToListWorkflowExecutionsResponse(t *apiv1.ListWorkflowExecutionsResponse) *shared.ListWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionsResponse{
+		Executions:    ToWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromListArchivedWorkflowExecutionsRequest converts a thrift ListArchivedWorkflowExecutionsRequest to its protobuf counterpart
+func FromListArchivedWorkflowExecutionsRequest(t *shared.ListArchivedWorkflowExecutionsRequest) *apiv1.ListArchivedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListArchivedWorkflowExecutionsRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+		Query:         t.GetQuery(),
+	}
+}
+
+// ToListArchivedWorkflowExecutionsRequest converts a protobuf ListArchivedWorkflowExecutionsRequest to its thrift counterpart
+func ToListArchivedWorkflowExecutionsRequest(t *apiv1.ListArchivedWorkflowExecutionsRequest) *shared.ListArchivedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListArchivedWorkflowExecutionsRequest{
+		Domain:        stringPtr(t.Domain),
+		PageSize:      int32Ptr(t.PageSize),
+		NextPageToken: t.NextPageToken,
+		Query:         stringPtr(t.Query),
+	}
+}
+
+// FromListArchivedWorkflowExecutionsResponse converts a thrift ListArchivedWorkflowExecutionsResponse to its protobuf counterpart
+func FromListArchivedWorkflowExecutionsResponse(t *shared.ListArchivedWorkflowExecutionsResponse) *apiv1.ListArchivedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListArchivedWorkflowExecutionsResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListArchivedWorkflowExecutionsResponse converts a protobuf ListArchivedWorkflowExecutionsResponse to its thrift counterpart
+func ToListArchivedWorkflowExecutionsResponse(t *apiv1.ListArchivedWorkflowExecutionsResponse) *shared.ListArchivedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListArchivedWorkflowExecutionsResponse{
+		Executions:    ToWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromCountWorkflowExecutionsRequest converts a thrift CountWorkflowExecutionsRequest to its protobuf counterpart
+func FromCountWorkflowExecutionsRequest(t *shared.CountWorkflowExecutionsRequest) *apiv1.CountWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CountWorkflowExecutionsRequest{
+		Domain: t.GetDomain(),
+		Query:  t.GetQuery(),
+	}
+}
+
+// ToCountWorkflowExecutionsRequest converts a protobuf CountWorkflowExecutionsRequest to its thrift counterpart
+func ToCountWorkflowExecutionsRequest(t *apiv1.CountWorkflowExecutionsRequest) *shared.CountWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsRequest{
+		Domain: stringPtr(t.Domain),
+		Query:  stringPtr(t.Query),
+	}
+}
+
+// FromCountWorkflowExecutionsResponse converts a thrift CountWorkflowExecutionsResponse to its protobuf counterpart
+func FromCountWorkflowExecutionsResponse(t *shared.CountWorkflowExecutionsResponse) *apiv1.CountWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CountWorkflowExecutionsResponse{
+		Count: t.GetCount(),
+	}
+}
+
+// ToCountWorkflowExecutionsResponse converts a protobuf CountWorkflowExecutionsResponse to its thrift counterpart
+func ToCountWorkflowExecutionsResponse(t *apiv1.CountWorkflowExecutionsResponse) *shared.CountWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsResponse{
+		Count: int64Ptr(t.Count),
+	}
+}
+
+// FromGetSearchAttributesResponse converts a thrift GetSearchAttributesResponse to its protobuf counterpart
+func FromGetSearchAttributesResponse(t *shared.GetSearchAttributesResponse) *apiv1.GetSearchAttributesResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetSearchAttributesResponse{
+		Keys: FromIndexedValueTypeMap(t.Keys),
+	}
+}
+
+// ToGetSearchAttributesResponse converts a protobuf GetSearchAttributesResponse to its thrift counterpart
+func ToGetSearchAttributesResponse(t *apiv1.GetSearchAttributesResponse) *shared.GetSearchAttributesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetSearchAttributesResponse{
+		Keys: ToIndexedValueTypeMap(t.Keys),
+	}
+}
+
+// FromGetSearchAttributesSchemaRequest converts a thrift GetSearchAttributesSchemaRequest to its protobuf counterpart
+func FromGetSearchAttributesSchemaRequest(t *shared.GetSearchAttributesSchemaRequest) *apiv1.GetSearchAttributesSchemaRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetSearchAttributesSchemaRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// ToGetSearchAttributesSchemaRequest converts a protobuf GetSearchAttributesSchemaRequest to its thrift counterpart
+func ToGetSearchAttributesSchemaRequest(t *apiv1.GetSearchAttributesSchemaRequest) *shared.GetSearchAttributesSchemaRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetSearchAttributesSchemaRequest{
+		Domain: stringPtr(t.Domain),
+	}
+}
+
+// FromGetSearchAttributesSchemaResponse converts a thrift GetSearchAttributesSchemaResponse to its protobuf counterpart
+func FromGetSearchAttributesSchemaResponse(t *shared.GetSearchAttributesSchemaResponse) *apiv1.GetSearchAttributesSchemaResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetSearchAttributesSchemaResponse{
+		Keys:              FromIndexedValueTypeMap(t.Keys),
+		NumberOfKeysLimit: t.GetNumberOfKeysLimit(),
+		SizeOfValueLimit:  t.GetSizeOfValueLimit(),
+		TotalSizeLimit:    t.GetTotalSizeLimit(),
+	}
+}
+
+// ToGetSearchAttributesSchemaResponse converts a protobuf GetSearchAttributesSchemaResponse to its thrift counterpart
+func ToGetSearchAttributesSchemaResponse(t *apiv1.GetSearchAttributesSchemaResponse) *shared.GetSearchAttributesSchemaResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetSearchAttributesSchemaResponse{
+		Keys:              ToIndexedValueTypeMap(t.Keys),
+		NumberOfKeysLimit: int32Ptr(t.NumberOfKeysLimit),
+		SizeOfValueLimit:  int32Ptr(t.SizeOfValueLimit),
+		TotalSizeLimit:    int32Ptr(t.TotalSizeLimit),
+	}
+}
+
+// FromQueryWorkflowRequest converts a thrift QueryWorkflowRequest to its protobuf counterpart
+func FromQueryWorkflowRequest(t *shared.QueryWorkflowRequest) *apiv1.QueryWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowRequest{
+		Domain:               t.GetDomain(),
+		Execution:            FromWorkflowExecution(t.Execution),
+		Query:                FromWorkflowQuery(t.Query),
+		QueryRejectCondition: FromQueryRejectCondition(t.QueryRejectCondition),
+	}
+}
+
+// ToQueryWorkflowRequest converts a protobuf QueryWorkflowRequest to its thrift counterpart
+func ToQueryWorkflowRequest(t *apiv1.QueryWorkflowRequest) *shared.QueryWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowRequest{
+		Domain:               stringPtr(t.Domain),
+		Execution:            ToWorkflowExecution(t.Execution),
+		Query:                ToWorkflowQuery(t.Query),
+		QueryRejectCondition: ToQueryRejectCondition(t.QueryRejectCondition),
+	}
+}
+
+// FromQueryRejected converts a thrift QueryRejected to its protobuf counterpart
+func FromQueryRejected(t *shared.QueryRejected) *apiv1.QueryRejected {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryRejected{
+		CloseStatus: FromWorkflowExecutionCloseStatus(t.CloseStatus),
+	}
+}
+
+// ToQueryRejected converts a protobuf QueryRejected to its thrift counterpart
+func ToQueryRejected(t *apiv1.QueryRejected) *shared.QueryRejected {
+	if t == nil {
+		return nil
+	}
+	return &shared.QueryRejected{
+		CloseStatus: ToWorkflowExecutionCloseStatus(t.CloseStatus),
+	}
+}
+
+// FromQueryWorkflowResponse converts a thrift QueryWorkflowResponse to its protobuf counterpart
+func FromQueryWorkflowResponse(t *shared.QueryWorkflowResponse) *apiv1.QueryWorkflowResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowResponse{
+		QueryResult:   t.QueryResult,
+		QueryRejected: FromQueryRejected(t.QueryRejected),
+	}
+}
+
+// ToQueryWorkflowResponse converts a protobuf QueryWorkflowResponse to its thrift counterpart
+func ToQueryWorkflowResponse(t *apiv1.QueryWorkflowResponse) *shared.QueryWorkflowResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowResponse{
+		QueryResult:   t.QueryResult,
+		QueryRejected: ToQueryRejected(t.QueryRejected),
+	}
+}
+
+// FromWorkflowQuery converts a thrift WorkflowQuery to its protobuf counterpart
+func FromWorkflowQuery(t *shared.WorkflowQuery) *apiv1.WorkflowQuery {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowQuery{
+		QueryType: t.GetQueryType(),
+		QueryArgs: t.QueryArgs,
+	}
+}
+
+// ToWorkflowQuery converts a protobuf WorkflowQuery to its thrift counterpart
+func ToWorkflowQuery(t *apiv1.WorkflowQuery) *shared.WorkflowQuery {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowQuery{
+		QueryType: stringPtr(t.QueryType),
+		QueryArgs: t.QueryArgs,
+	}
+}
+
+// FromResetStickyTaskListRequest converts a thrift ResetStickyTaskListRequest to its protobuf counterpart
+func FromResetStickyTaskListRequest(t *shared.ResetStickyTaskListRequest) *apiv1.ResetStickyTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToResetStickyTaskListRequest converts a protobuf ResetStickyTaskListRequest to its thrift counterpart
+func ToResetStickyTaskListRequest(t *apiv1.ResetStickyTaskListRequest) *shared.ResetStickyTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListRequest{
+		Domain:    stringPtr(t.Domain),
+		Execution: ToWorkflowExecution(t.Execution),
+	}
+}
+
+// FromResetStickyTaskListResponse converts a thrift ResetStickyTaskListResponse to its protobuf counterpart
+func FromResetStickyTaskListResponse(t *shared.ResetStickyTaskListResponse) *apiv1.ResetStickyTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListResponse{}
+}
+
+// ToResetStickyTaskListResponse converts a protobuf ResetStickyTaskListResponse to its thrift counterpart
+func ToResetStickyTaskListResponse(t *apiv1.ResetStickyTaskListResponse) *shared.ResetStickyTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListResponse{}
+}
+
+// FromRespondQueryTaskCompletedRequest converts a thrift RespondQueryTaskCompletedRequest to its protobuf counterpart
+func FromRespondQueryTaskCompletedRequest(t *shared.RespondQueryTaskCompletedRequest) *apiv1.RespondQueryTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondQueryTaskCompletedRequest{
+		TaskToken:     t.TaskToken,
+		CompletedType: FromQueryTaskCompletedType(t.CompletedType),
+		QueryResult:   t.QueryResult,
+		ErrorMessage:  t.GetErrorMessage(),
+	}
+}
+
+// ToRespondQueryTaskCompletedRequest converts a protobuf RespondQueryTaskCompletedRequest to its thrift counterpart
+func ToRespondQueryTaskCompletedRequest(t *apiv1.RespondQueryTaskCompletedRequest) *shared.RespondQueryTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RespondQueryTaskCompletedRequest{
+		TaskToken:     t.TaskToken,
+		CompletedType: ToQueryTaskCompletedType(t.CompletedType),
+		QueryResult:   t.QueryResult,
+		ErrorMessage:  stringPtr(t.ErrorMessage),
+	}
+}
+
+// FromWorkflowQueryResult converts a thrift WorkflowQueryResult to its protobuf counterpart
+func FromWorkflowQueryResult(t *shared.WorkflowQueryResult) *apiv1.WorkflowQueryResult {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowQueryResult{
+		ResultType:   FromQueryResultType(t.ResultType),
+		Answer:       t.Answer,
+		ErrorReason:  t.GetErrorReason(),
+		ErrorDetails: t.ErrorDetails,
+	}
+}
+
+// ToWorkflowQueryResult converts a protobuf WorkflowQueryResult to its thrift counterpart
+func ToWorkflowQueryResult(t *apiv1.WorkflowQueryResult) *shared.WorkflowQueryResult {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowQueryResult{
+		ResultType:   ToQueryResultType(t.ResultType),
+		Answer:       t.Answer,
+		ErrorReason:  stringPtr(t.ErrorReason),
+		ErrorDetails: t.ErrorDetails,
+	}
+}
+
+// FromDescribeWorkflowExecutionRequest converts a thrift DescribeWorkflowExecutionRequest to its protobuf counterpart
+func FromDescribeWorkflowExecutionRequest(t *shared.DescribeWorkflowExecutionRequest) *apiv1.DescribeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionRequest{
+		Domain:    t.GetDomain(),
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToDescribeWorkflowExecutionRequest converts a protobuf DescribeWorkflowExecutionRequest to its thrift counterpart
+func ToDescribeWorkflowExecutionRequest(t *apiv1.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionRequest{
+		Domain:    stringPtr(t.Domain),
+		Execution: ToWorkflowExecution(t.Execution),
+	}
+}
+
+// FromPendingActivityInfo converts a thrift PendingActivityInfo to its protobuf counterpart
+func FromPendingActivityInfo(t *shared.PendingActivityInfo) *apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PendingActivityInfo{
+		ActivityId:             t.GetActivityID(),
+		ActivityType:           FromActivityType(t.ActivityType),
+		State:                  FromPendingActivityState(t.State),
+		HeartbeatDetails:       t.HeartbeatDetails,
+		LastHeartbeatTimestamp: t.GetLastHeartbeatTimestamp(),
+		LastStartedTimestamp:   t.GetLastStartedTimestamp(),
+		Attempt:                t.GetAttempt(),
+		MaximumAttempts:        t.GetMaximumAttempts(),
+		ScheduledTimestamp:     t.GetScheduledTimestamp(),
+		ExpirationTimestamp:    t.GetExpirationTimestamp(),
+		LastFailureReason:      t.GetLastFailureReason(),
+		LastWorkerIdentity:     t.GetLastWorkerIdentity(),
+		LastFailureDetails:     t.LastFailureDetails,
+		NextRetryTimestamp:     t.GetNextRetryTimestamp(),
+	}
+}
+
+// ToPendingActivityInfo converts a protobuf PendingActivityInfo to its thrift counterpart
+func ToPendingActivityInfo(t *apiv1.PendingActivityInfo) *shared.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.PendingActivityInfo{
+		ActivityID:             stringPtr(t.ActivityId),
+		ActivityType:           ToActivityType(t.ActivityType),
+		State:                  ToPendingActivityState(t.State),
+		HeartbeatDetails:       t.HeartbeatDetails,
+		LastHeartbeatTimestamp: int64Ptr(t.LastHeartbeatTimestamp),
+		LastStartedTimestamp:   int64Ptr(t.LastStartedTimestamp),
+		Attempt:                int32Ptr(t.Attempt),
+		MaximumAttempts:        int32Ptr(t.MaximumAttempts),
+		ScheduledTimestamp:     int64Ptr(t.ScheduledTimestamp),
+		ExpirationTimestamp:    int64Ptr(t.ExpirationTimestamp),
+		LastFailureReason:      stringPtr(t.LastFailureReason),
+		LastWorkerIdentity:     stringPtr(t.LastWorkerIdentity),
+		LastFailureDetails:     t.LastFailureDetails,
+		NextRetryTimestamp:     int64Ptr(t.NextRetryTimestamp),
+	}
+}
+
+// FromPendingChildExecutionInfo converts a thrift PendingChildExecutionInfo to its protobuf counterpart
+func FromPendingChildExecutionInfo(t *shared.PendingChildExecutionInfo) *apiv1.PendingChildExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PendingChildExecutionInfo{
+		WorkflowId:        t.GetWorkflowID(),
+		RunId:             t.GetRunID(),
+		WorkflowTypName:   t.GetWorkflowTypName(),
+		InitiatedId:       t.GetInitiatedID(),
+		ParentClosePolicy: FromParentClosePolicy(t.ParentClosePolicy),
+	}
+}
+
+// ToPendingChildExecutionInfo converts a protobuf PendingChildExecutionInfo to its thrift counterpart
+func ToPendingChildExecutionInfo(t *apiv1.PendingChildExecutionInfo) *shared.PendingChildExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.PendingChildExecutionInfo{
+		WorkflowID:        stringPtr(t.WorkflowId),
+		RunID:             stringPtr(t.RunId),
+		WorkflowTypName:   stringPtr(t.WorkflowTypName),
+		InitiatedID:       int64Ptr(t.InitiatedId),
+		ParentClosePolicy: ToParentClosePolicy(t.ParentClosePolicy),
+	}
+}
+
+// FromDescribeWorkflowExecutionResponse converts a thrift DescribeWorkflowExecutionResponse to its protobuf counterpart
+func FromDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResponse) *apiv1.DescribeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: FromWorkflowExecutionConfiguration(t.ExecutionConfiguration),
+		WorkflowExecutionInfo:  FromWorkflowExecutionInfo(t.WorkflowExecutionInfo),
+		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
+		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
+		Paused:                 t.GetPaused(),
+		ExecutionStats:         FromWorkflowExecutionStats(t.ExecutionStats),
+		TerminationProtected:   t.GetTerminationProtected(),
+	}
+}
+
+// ToDescribeWorkflowExecutionResponse converts a protobuf DescribeWorkflowExecutionResponse to its thrift counterpart
+func ToDescribeWorkflowExecutionResponse(t *apiv1.DescribeWorkflowExecutionResponse) *shared.DescribeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: ToWorkflowExecutionConfiguration(t.ExecutionConfiguration),
+		WorkflowExecutionInfo:  ToWorkflowExecutionInfo(t.WorkflowExecutionInfo),
+		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
+		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
+		Paused:                 boolPtr(t.Paused),
+		ExecutionStats:         ToWorkflowExecutionStats(t.ExecutionStats),
+		TerminationProtected:   boolPtr(t.TerminationProtected),
+	}
+}
+
+// FromBatchDescribeWorkflowExecutionsRequest converts a thrift BatchDescribeWorkflowExecutionsRequest to its protobuf counterpart
+func FromBatchDescribeWorkflowExecutionsRequest(t *shared.BatchDescribeWorkflowExecutionsRequest) *apiv1.BatchDescribeWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     t.GetDomain(),
+		Executions: FromWorkflowExecutionArray(t.Executions),
+	}
+}
+
+// ToBatchDescribeWorkflowExecutionsRequest converts a protobuf BatchDescribeWorkflowExecutionsRequest to its thrift counterpart
+func ToBatchDescribeWorkflowExecutionsRequest(t *apiv1.BatchDescribeWorkflowExecutionsRequest) *shared.BatchDescribeWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     stringPtr(t.Domain),
+		Executions: ToWorkflowExecutionArray(t.Executions),
+	}
+}
+
+// FromWorkflowExecutionSummary converts a thrift WorkflowExecutionSummary to its protobuf counterpart
+func FromWorkflowExecutionSummary(t *shared.WorkflowExecutionSummary) *apiv1.WorkflowExecutionSummary {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionSummary{
+		Execution:     FromWorkflowExecution(t.Execution),
+		ExecutionInfo: FromWorkflowExecutionInfo(t.ExecutionInfo),
+		CloseEvent:    FromHistoryEvent(t.CloseEvent),
+		Error:         t.GetError(),
+	}
+}
+
+// ToWorkflowExecutionSummary converts a protobuf WorkflowExecutionSummary to its thrift counterpart
+func ToWorkflowExecutionSummary(t *apiv1.WorkflowExecutionSummary) *shared.WorkflowExecutionSummary {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSummary{
+		Execution:     ToWorkflowExecution(t.Execution),
+		ExecutionInfo: ToWorkflowExecutionInfo(t.ExecutionInfo),
+		CloseEvent:    ToHistoryEvent(t.CloseEvent),
+		Error:         stringPtr(t.Error),
+	}
+}
+
+// FromBatchDescribeWorkflowExecutionsResponse converts a thrift BatchDescribeWorkflowExecutionsResponse to its protobuf counterpart
+func FromBatchDescribeWorkflowExecutionsResponse(t *shared.BatchDescribeWorkflowExecutionsResponse) *apiv1.BatchDescribeWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BatchDescribeWorkflowExecutionsResponse{
+		Executions: FromWorkflowExecutionSummaryArray(t.Executions),
+	}
+}
+
+// ToBatchDescribeWorkflowExecutionsResponse converts a protobuf BatchDescribeWorkflowExecutionsResponse to its thrift counterpart
+func ToBatchDescribeWorkflowExecutionsResponse(t *apiv1.BatchDescribeWorkflowExecutionsResponse) *shared.BatchDescribeWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchDescribeWorkflowExecutionsResponse{
+		Executions: ToWorkflowExecutionSummaryArray(t.Executions),
+	}
+}
+
+// FromBatchOperationType converts a thrift BatchOperationType to its protobuf counterpart
+func FromBatchOperationType(t *shared.BatchOperationType) apiv1.BatchOperationType {
+	if t == nil {
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_INVALID
+	}
+	switch *t {
+	case shared.BatchOperationTypeTerminate:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_TERMINATE
+	case shared.BatchOperationTypeCancel:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_CANCEL
+	case shared.BatchOperationTypeSignal:
+		return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_SIGNAL
+	}
+	return apiv1.BatchOperationType_BATCH_OPERATION_TYPE_INVALID
+}
+
+// ToBatchOperationType converts a protobuf BatchOperationType to its thrift counterpart
+func ToBatchOperationType(t apiv1.BatchOperationType) *shared.BatchOperationType {
+	switch t {
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_INVALID:
+		return nil
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_TERMINATE:
+		return shared.BatchOperationTypeTerminate.Ptr()
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_CANCEL:
+		return shared.BatchOperationTypeCancel.Ptr()
+	case apiv1.BatchOperationType_BATCH_OPERATION_TYPE_SIGNAL:
+		return shared.BatchOperationTypeSignal.Ptr()
+	}
+	return nil
+}
+
+// FromBatchOperationState converts a thrift BatchOperationState to its protobuf counterpart
+func FromBatchOperationState(t *shared.BatchOperationState) apiv1.BatchOperationState {
+	if t == nil {
+		return apiv1.BatchOperationState_BATCH_OPERATION_STATE_INVALID
+	}
+	switch *t {
+	case shared.BatchOperationStateRunning:
+		return apiv1.BatchOperationState_BATCH_OPERATION_STATE_RUNNING
+	case shared.BatchOperationStateCompleted:
+		return apiv1.BatchOperationState_BATCH_OPERATION_STATE_COMPLETED
+	case shared.BatchOperationStateFailed:
+		return apiv1.BatchOperationState_BATCH_OPERATION_STATE_FAILED
+	}
+	return apiv1.BatchOperationState_BATCH_OPERATION_STATE_INVALID
+}
+
+// ToBatchOperationState converts a protobuf BatchOperationState to its thrift counterpart
+func ToBatchOperationState(t apiv1.BatchOperationState) *shared.BatchOperationState {
+	switch t {
+	case apiv1.BatchOperationState_BATCH_OPERATION_STATE_INVALID:
+		return nil
+	case apiv1.BatchOperationState_BATCH_OPERATION_STATE_RUNNING:
+		return shared.BatchOperationStateRunning.Ptr()
+	case apiv1.BatchOperationState_BATCH_OPERATION_STATE_COMPLETED:
+		return shared.BatchOperationStateCompleted.Ptr()
+	case apiv1.BatchOperationState_BATCH_OPERATION_STATE_FAILED:
+		return shared.BatchOperationStateFailed.Ptr()
+	}
+	return nil
+}
+
+// FromBatchOperationWorkflowRequest converts a thrift BatchOperationWorkflowRequest to its protobuf counterpart
+func FromBatchOperationWorkflowRequest(t *shared.BatchOperationWorkflowRequest) *apiv1.BatchOperationWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BatchOperationWorkflowRequest{
+		Domain:        t.GetDomain(),
+		Query:         t.GetQuery(),
+		OperationType: FromBatchOperationType(t.OperationType),
+		Reason:        t.GetReason(),
+		Identity:      t.GetIdentity(),
+		RequestId:     t.GetRequestId(),
+		SignalName:    t.GetSignalName(),
+		SignalInput:   t.SignalInput,
+		Rps:           t.GetRps(),
+	}
+}
+
+// ToBatchOperationWorkflowRequest converts a protobuf BatchOperationWorkflowRequest to its thrift counterpart
+func ToBatchOperationWorkflowRequest(t *apiv1.BatchOperationWorkflowRequest) *shared.BatchOperationWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchOperationWorkflowRequest{
+		Domain:        stringPtr(t.Domain),
+		Query:         stringPtr(t.Query),
+		OperationType: ToBatchOperationType(t.OperationType),
+		Reason:        stringPtr(t.Reason),
+		Identity:      stringPtr(t.Identity),
+		RequestId:     stringPtr(t.RequestId),
+		SignalName:    stringPtr(t.SignalName),
+		SignalInput:   t.SignalInput,
+		Rps:           int32Ptr(t.Rps),
+	}
+}
+
+// FromBatchOperationWorkflowResponse converts a thrift BatchOperationWorkflowResponse to its protobuf counterpart
+func FromBatchOperationWorkflowResponse(t *shared.BatchOperationWorkflowResponse) *apiv1.BatchOperationWorkflowResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BatchOperationWorkflowResponse{
+		JobId: t.GetJobID(),
+	}
+}
+
+// ToBatchOperationWorkflowResponse converts a protobuf BatchOperationWorkflowResponse to its thrift counterpart
+func ToBatchOperationWorkflowResponse(t *apiv1.BatchOperationWorkflowResponse) *shared.BatchOperationWorkflowResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.BatchOperationWorkflowResponse{
+		JobID: stringPtr(t.JobId),
+	}
+}
+
+// FromDescribeBatchOperationRequest converts a thrift DescribeBatchOperationRequest to its protobuf counterpart
+func FromDescribeBatchOperationRequest(t *shared.DescribeBatchOperationRequest) *apiv1.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeBatchOperationRequest{
+		Domain: t.GetDomain(),
+		JobId:  t.GetJobID(),
+	}
+}
+
+// ToDescribeBatchOperationRequest converts a protobuf DescribeBatchOperationRequest to its thrift counterpart
+func ToDescribeBatchOperationRequest(t *apiv1.DescribeBatchOperationRequest) *shared.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeBatchOperationRequest{
+		Domain: stringPtr(t.Domain),
+		JobID:  stringPtr(t.JobId),
+	}
+}
+
+// FromDescribeBatchOperationResponse converts a thrift DescribeBatchOperationResponse to its protobuf counterpart
+func FromDescribeBatchOperationResponse(t *shared.DescribeBatchOperationResponse) *apiv1.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeBatchOperationResponse{
+		JobId:         t.GetJobID(),
+		State:         FromBatchOperationState(t.State),
+		OperationType: FromBatchOperationType(t.OperationType),
+		Query:         t.GetQuery(),
+		Reason:        t.GetReason(),
+		Identity:      t.GetIdentity(),
+		StartTime:     t.GetStartTime(),
+		CloseTime:     t.GetCloseTime(),
+		TotalEstimate: t.GetTotalEstimate(),
+		SuccessCount:  t.GetSuccessCount(),
+		ErrorCount:    t.GetErrorCount(),
+		FailureReason: t.GetFailureReason(),
+	}
+}
+
+// ToDescribeBatchOperationResponse converts a protobuf DescribeBatchOperationResponse to its thrift counterpart
+func ToDescribeBatchOperationResponse(t *apiv1.DescribeBatchOperationResponse) *shared.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeBatchOperationResponse{
+		JobID:         stringPtr(t.JobId),
+		State:         ToBatchOperationState(t.State),
+		OperationType: ToBatchOperationType(t.OperationType),
+		Query:         stringPtr(t.Query),
+		Reason:        stringPtr(t.Reason),
+		Identity:      stringPtr(t.Identity),
+		StartTime:     int64Ptr(t.StartTime),
+		CloseTime:     int64Ptr(t.CloseTime),
+		TotalEstimate: int64Ptr(t.TotalEstimate),
+		SuccessCount:  int64Ptr(t.SuccessCount),
+		ErrorCount:    int64Ptr(t.ErrorCount),
+		FailureReason: stringPtr(t.FailureReason),
+	}
+}
+
+// FromListWorkflowExecutionChainRequest converts a thrift ListWorkflowExecutionChainRequest to its protobuf counterpart
+func FromListWorkflowExecutionChainRequest(t *shared.ListWorkflowExecutionChainRequest) *apiv1.ListWorkflowExecutionChainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListWorkflowExecutionChainRequest{
+		Domain:        t.GetDomain(),
+		Execution:     FromWorkflowExecution(t.Execution),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToListWorkflowExecutionChainRequest converts a protobuf ListWorkflowExecutionChainRequest to its thrift counterpart
+func ToListWorkflowExecutionChainRequest(t *apiv1.ListWorkflowExecutionChainRequest) *shared.ListWorkflowExecutionChainRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionChainRequest{
+		Domain:        stringPtr(t.Domain),
+		Execution:     ToWorkflowExecution(t.Execution),
+		PageSize:      int32Ptr(t.PageSize),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromWorkflowExecutionChainEntry converts a thrift WorkflowExecutionChainEntry to its protobuf counterpart
+func FromWorkflowExecutionChainEntry(t *shared.WorkflowExecutionChainEntry) *apiv1.WorkflowExecutionChainEntry {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionChainEntry{
+		ExecutionInfo:           FromWorkflowExecutionInfo(t.ExecutionInfo),
+		ContinuedExecutionRunId: t.GetContinuedExecutionRunId(),
+		Initiator:               FromContinueAsNewInitiator(t.Initiator),
+		OriginalExecutionRunId:  t.GetOriginalExecutionRunId(),
+	}
+}
+
+// ToWorkflowExecutionChainEntry converts a protobuf WorkflowExecutionChainEntry to its thrift counterpart
+func ToWorkflowExecutionChainEntry(t *apiv1.WorkflowExecutionChainEntry) *shared.WorkflowExecutionChainEntry {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionChainEntry{
+		ExecutionInfo:           ToWorkflowExecutionInfo(t.ExecutionInfo),
+		ContinuedExecutionRunId: stringPtr(t.ContinuedExecutionRunId),
+		Initiator:               ToContinueAsNewInitiator(t.Initiator),
+		OriginalExecutionRunId:  stringPtr(t.OriginalExecutionRunId),
+	}
+}
+
+// FromListWorkflowExecutionChainResponse converts a thrift ListWorkflowExecutionChainResponse to its protobuf counterpart
+func FromListWorkflowExecutionChainResponse(t *shared.ListWorkflowExecutionChainResponse) *apiv1.ListWorkflowExecutionChainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListWorkflowExecutionChainResponse{
+		FirstExecutionRunId: t.GetFirstExecutionRunId(),
+		Executions:          FromWorkflowExecutionChainEntryArray(t.Executions),
+		NextPageToken:       t.NextPageToken,
+	}
+}
+
+// ToListWorkflowExecutionChainResponse converts a protobuf ListWorkflowExecutionChainResponse to its thrift counterpart
+func ToListWorkflowExecutionChainResponse(t *apiv1.ListWorkflowExecutionChainResponse) *shared.ListWorkflowExecutionChainResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionChainResponse{
+		FirstExecutionRunId: stringPtr(t.FirstExecutionRunId),
+		Executions:          ToWorkflowExecutionChainEntryArray(t.Executions),
+		NextPageToken:       t.NextPageToken,
+	}
+}
+
+// FromDescribeTaskListRequest converts a thrift DescribeTaskListRequest to its protobuf counterpart
+func FromDescribeTaskListRequest(t *shared.DescribeTaskListRequest) *apiv1.DescribeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListRequest{
+		Domain:                t.GetDomain(),
+		TaskList:              FromTaskList(t.TaskList),
+		TaskListType:          FromTaskListType(t.TaskListType),
+		IncludeTaskListStatus: t.GetIncludeTaskListStatus(),
+	}
+}
+
+// ToDescribeTaskListRequest converts a protobuf DescribeTaskListRequest to its thrift counterpart
+func ToDescribeTaskListRequest(t *apiv1.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListRequest{
+		Domain:                stringPtr(t.Domain),
+		TaskList:              ToTaskList(t.TaskList),
+		TaskListType:          ToTaskListType(t.TaskListType),
+		IncludeTaskListStatus: boolPtr(t.IncludeTaskListStatus),
+	}
+}
+
+// FromDescribeTaskListResponse converts a thrift DescribeTaskListResponse to its protobuf counterpart
+func FromDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *apiv1.DescribeTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListResponse{
+		Pollers:         FromPollerInfoArray(t.Pollers),
+		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig: FromTaskListPartitionConfig(t.PartitionConfig),
+	}
+}
+
+// ToDescribeTaskListResponse converts a protobuf DescribeTaskListResponse to its thrift counterpart
+func ToDescribeTaskListResponse(t *apiv1.DescribeTaskListResponse) *shared.DescribeTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListResponse{
+		Pollers:         ToPollerInfoArray(t.Pollers),
+		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
+		PartitionConfig: ToTaskListPartitionConfig(t.PartitionConfig),
+	}
+}
+
+// FromTaskListPartitionConfig converts a thrift TaskListPartitionConfig to its protobuf counterpart
+func FromTaskListPartitionConfig(t *shared.TaskListPartitionConfig) *apiv1.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListPartitionConfig{
+		Version:            t.GetVersion(),
+		NumReadPartitions:  t.GetNumReadPartitions(),
+		NumWritePartitions: t.GetNumWritePartitions(),
+	}
+}
+
+// ToTaskListPartitionConfig converts a protobuf TaskListPartitionConfig to its thrift counterpart
+func ToTaskListPartitionConfig(t *apiv1.TaskListPartitionConfig) *shared.TaskListPartitionConfig {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListPartitionConfig{
+		Version:            int64Ptr(t.Version),
+		NumReadPartitions:  int32Ptr(t.NumReadPartitions),
+		NumWritePartitions: int32Ptr(t.NumWritePartitions),
+	}
+}
+
+// FromTaskListStatus converts a thrift TaskListStatus to its protobuf counterpart
+func FromTaskListStatus(t *shared.TaskListStatus) *apiv1.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListStatus{
+		BacklogCountHint: t.GetBacklogCountHint(),
+		ReadLevel:        t.GetReadLevel(),
+		AckLevel:         t.GetAckLevel(),
+		RatePerSecond:    t.GetRatePerSecond(),
+		TaskIdBlock:      FromTaskIDBlock(t.TaskIDBlock),
+	}
+}
+
+// ToTaskListStatus converts a protobuf TaskListStatus to its thrift counterpart
+func ToTaskListStatus(t *apiv1.TaskListStatus) *shared.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListStatus{
+		BacklogCountHint: int64Ptr(t.BacklogCountHint),
+		ReadLevel:        int64Ptr(t.ReadLevel),
+		AckLevel:         int64Ptr(t.AckLevel),
+		RatePerSecond:    float64Ptr(t.RatePerSecond),
+		TaskIDBlock:      ToTaskIDBlock(t.TaskIdBlock),
+	}
+}
+
+// FromTaskIDBlock converts a thrift TaskIDBlock to its protobuf counterpart
+func FromTaskIDBlock(t *shared.TaskIDBlock) *apiv1.TaskIDBlock {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskIDBlock{
+		StartId: t.GetStartID(),
+		EndId:   t.GetEndID(),
+	}
+}
+
+// ToTaskIDBlock converts a protobuf TaskIDBlock to its thrift counterpart
+func ToTaskIDBlock(t *apiv1.TaskIDBlock) *shared.TaskIDBlock {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskIDBlock{
+		StartID: int64Ptr(t.StartId),
+		EndID:   int64Ptr(t.EndId),
+	}
+}
+
+// FromTaskListType converts a thrift TaskListType to its protobuf counterpart
+func FromTaskListType(t *shared.TaskListType) apiv1.TaskListType {
+	if t == nil {
+		return apiv1.TaskListType_TASK_LIST_TYPE_INVALID
+	}
+	switch *t {
+	case shared.TaskListTypeDecision:
+		return apiv1.TaskListType_TASK_LIST_TYPE_DECISION
+	case shared.TaskListTypeActivity:
+		return apiv1.TaskListType_TASK_LIST_TYPE_ACTIVITY
+	}
+	return apiv1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+// ToTaskListType converts a protobuf TaskListType to its thrift counterpart
+func ToTaskListType(t apiv1.TaskListType) *shared.TaskListType {
+	switch t {
+	case apiv1.TaskListType_TASK_LIST_TYPE_INVALID:
+		return nil
+	case apiv1.TaskListType_TASK_LIST_TYPE_DECISION:
+		return shared.TaskListTypeDecision.Ptr()
+	case apiv1.TaskListType_TASK_LIST_TYPE_ACTIVITY:
+		return shared.TaskListTypeActivity.Ptr()
+	}
+	return nil
+}
+
+// FromPollerInfo converts a thrift PollerInfo to its protobuf counterpart
+func FromPollerInfo(t *shared.PollerInfo) *apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollerInfo{
+		LastAccessTime: t.GetLastAccessTime(),
+		Identity:       t.GetIdentity(),
+		RatePerSecond:  t.GetRatePerSecond(),
+	}
+}
+
+// ToPollerInfo converts a protobuf PollerInfo to its thrift counterpart
+func ToPollerInfo(t *apiv1.PollerInfo) *shared.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.PollerInfo{
+		LastAccessTime: int64Ptr(t.LastAccessTime),
+		Identity:       stringPtr(t.Identity),
+		RatePerSecond:  float64Ptr(t.RatePerSecond),
+	}
+}
+
+// FromRetryPolicy converts a thrift RetryPolicy to its protobuf counterpart
+func FromRetryPolicy(t *shared.RetryPolicy) *apiv1.RetryPolicy {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RetryPolicy{
+		InitialIntervalInSeconds:    t.GetInitialIntervalInSeconds(),
+		BackoffCoefficient:          t.GetBackoffCoefficient(),
+		MaximumIntervalInSeconds:    t.GetMaximumIntervalInSeconds(),
+		MaximumAttempts:             t.GetMaximumAttempts(),
+		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: t.GetExpirationIntervalInSeconds(),
+	}
+}
+
+// ToRetryPolicy converts a protobuf RetryPolicy to its thrift counterpart
+func ToRetryPolicy(t *apiv1.RetryPolicy) *shared.RetryPolicy {
+	if t == nil {
+		return nil
+	}
+	return &shared.RetryPolicy{
+		InitialIntervalInSeconds:    int32Ptr(t.InitialIntervalInSeconds),
+		BackoffCoefficient:          float64Ptr(t.BackoffCoefficient),
+		MaximumIntervalInSeconds:    int32Ptr(t.MaximumIntervalInSeconds),
+		MaximumAttempts:             int32Ptr(t.MaximumAttempts),
+		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: int32Ptr(t.ExpirationIntervalInSeconds),
+	}
+}
+
+// FromClusterReplicationConfigurationArray converts a list of thrift ClusterReplicationConfiguration to its protobuf counterpart
+func FromClusterReplicationConfigurationArray(t []*shared.ClusterReplicationConfiguration) []*apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ClusterReplicationConfiguration, len(t))
+	for i := range t {
+		v[i] = FromClusterReplicationConfiguration(t[i])
+	}
+	return v
+}
+
+// ToClusterReplicationConfigurationArray converts a list of protobuf ClusterReplicationConfiguration to its thrift counterpart
+func ToClusterReplicationConfigurationArray(t []*apiv1.ClusterReplicationConfiguration) []*shared.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ClusterReplicationConfiguration, len(t))
+	for i := range t {
+		v[i] = ToClusterReplicationConfiguration(t[i])
+	}
+	return v
+}
+
+// FromDecisionArray converts a list of thrift Decision to its protobuf counterpart
+func FromDecisionArray(t []*shared.Decision) []*apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.Decision, len(t))
+	for i := range t {
+		v[i] = FromDecision(t[i])
+	}
+	return v
+}
+
+// ToDecisionArray converts a list of protobuf Decision to its thrift counterpart
+func ToDecisionArray(t []*apiv1.Decision) []*shared.Decision {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.Decision, len(t))
+	for i := range t {
+		v[i] = ToDecision(t[i])
+	}
+	return v
+}
+
+// FromDescribeDomainResponseArray converts a list of thrift DescribeDomainResponse to its protobuf counterpart
+func FromDescribeDomainResponseArray(t []*shared.DescribeDomainResponse) []*apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.DescribeDomainResponse, len(t))
+	for i := range t {
+		v[i] = FromDescribeDomainResponse(t[i])
+	}
+	return v
+}
+
+// ToDescribeDomainResponseArray converts a list of protobuf DescribeDomainResponse to its thrift counterpart
+func ToDescribeDomainResponseArray(t []*apiv1.DescribeDomainResponse) []*shared.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.DescribeDomainResponse, len(t))
+	for i := range t {
+		v[i] = ToDescribeDomainResponse(t[i])
+	}
+	return v
+}
+
+// FromHistoryEventArray converts a list of thrift HistoryEvent to its protobuf counterpart
+func FromHistoryEventArray(t []*shared.HistoryEvent) []*apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.HistoryEvent, len(t))
+	for i := range t {
+		v[i] = FromHistoryEvent(t[i])
+	}
+	return v
+}
+
+// ToHistoryEventArray converts a list of protobuf HistoryEvent to its thrift counterpart
+func ToHistoryEventArray(t []*apiv1.HistoryEvent) []*shared.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.HistoryEvent, len(t))
+	for i := range t {
+		v[i] = ToHistoryEvent(t[i])
+	}
+	return v
+}
+
+// FromPendingActivityInfoArray converts a list of thrift PendingActivityInfo to its protobuf counterpart
+func FromPendingActivityInfoArray(t []*shared.PendingActivityInfo) []*apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PendingActivityInfo, len(t))
+	for i := range t {
+		v[i] = FromPendingActivityInfo(t[i])
+	}
+	return v
+}
+
+// ToPendingActivityInfoArray converts a list of protobuf PendingActivityInfo to its thrift counterpart
+func ToPendingActivityInfoArray(t []*apiv1.PendingActivityInfo) []*shared.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PendingActivityInfo, len(t))
+	for i := range t {
+		v[i] = ToPendingActivityInfo(t[i])
+	}
+	return v
+}
+
+// FromPendingChildExecutionInfoArray converts a list of thrift PendingChildExecutionInfo to its protobuf counterpart
+func FromPendingChildExecutionInfoArray(t []*shared.PendingChildExecutionInfo) []*apiv1.PendingChildExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PendingChildExecutionInfo, len(t))
+	for i := range t {
+		v[i] = FromPendingChildExecutionInfo(t[i])
+	}
+	return v
+}
+
+// ToPendingChildExecutionInfoArray converts a list of protobuf PendingChildExecutionInfo to its thrift counterpart
+func ToPendingChildExecutionInfoArray(t []*apiv1.PendingChildExecutionInfo) []*shared.PendingChildExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PendingChildExecutionInfo, len(t))
+	for i := range t {
+		v[i] = ToPendingChildExecutionInfo(t[i])
+	}
+	return v
+}
+
+// FromPollerInfoArray converts a list of thrift PollerInfo to its protobuf counterpart
+func FromPollerInfoArray(t []*shared.PollerInfo) []*apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PollerInfo, len(t))
+	for i := range t {
+		v[i] = FromPollerInfo(t[i])
+	}
+	return v
+}
+
+// ToPollerInfoArray converts a list of protobuf PollerInfo to its thrift counterpart
+func ToPollerInfoArray(t []*apiv1.PollerInfo) []*shared.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.PollerInfo, len(t))
+	for i := range t {
+		v[i] = ToPollerInfo(t[i])
+	}
+	return v
+}
+
+// FromResetPointInfoArray converts a list of thrift ResetPointInfo to its protobuf counterpart
+func FromResetPointInfoArray(t []*shared.ResetPointInfo) []*apiv1.ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ResetPointInfo, len(t))
+	for i := range t {
+		v[i] = FromResetPointInfo(t[i])
+	}
+	return v
+}
+
+// ToResetPointInfoArray converts a list of protobuf ResetPointInfo to its thrift counterpart
+func ToResetPointInfoArray(t []*apiv1.ResetPointInfo) []*shared.ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ResetPointInfo, len(t))
+	for i := range t {
+		v[i] = ToResetPointInfo(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionArray converts a list of thrift WorkflowExecution to its protobuf counterpart
+func FromWorkflowExecutionArray(t []*shared.WorkflowExecution) []*apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecution(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionArray converts a list of protobuf WorkflowExecution to its thrift counterpart
+func ToWorkflowExecutionArray(t []*apiv1.WorkflowExecution) []*shared.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecution, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecution(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionChainEntryArray converts a list of thrift WorkflowExecutionChainEntry to its protobuf counterpart
+func FromWorkflowExecutionChainEntryArray(t []*shared.WorkflowExecutionChainEntry) []*apiv1.WorkflowExecutionChainEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecutionChainEntry, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecutionChainEntry(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionChainEntryArray converts a list of protobuf WorkflowExecutionChainEntry to its thrift counterpart
+func ToWorkflowExecutionChainEntryArray(t []*apiv1.WorkflowExecutionChainEntry) []*shared.WorkflowExecutionChainEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecutionChainEntry, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecutionChainEntry(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionInfoArray converts a list of thrift WorkflowExecutionInfo to its protobuf counterpart
+func FromWorkflowExecutionInfoArray(t []*shared.WorkflowExecutionInfo) []*apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecutionInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecutionInfo(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionInfoArray converts a list of protobuf WorkflowExecutionInfo to its thrift counterpart
+func ToWorkflowExecutionInfoArray(t []*apiv1.WorkflowExecutionInfo) []*shared.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecutionInfo, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecutionInfo(t[i])
+	}
+	return v
+}
+
+// FromWorkflowExecutionSummaryArray converts a list of thrift WorkflowExecutionSummary to its protobuf counterpart
+func FromWorkflowExecutionSummaryArray(t []*shared.WorkflowExecutionSummary) []*apiv1.WorkflowExecutionSummary {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecutionSummary, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecutionSummary(t[i])
+	}
+	return v
+}
+
+// ToWorkflowExecutionSummaryArray converts a list of protobuf WorkflowExecutionSummary to its thrift counterpart
+func ToWorkflowExecutionSummaryArray(t []*apiv1.WorkflowExecutionSummary) []*shared.WorkflowExecutionSummary {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecutionSummary, len(t))
+	for i := range t {
+		v[i] = ToWorkflowExecutionSummary(t[i])
+	}
+	return v
+}
+
+// FromWorkflowQueryArray converts a list of thrift WorkflowQuery to its protobuf counterpart
+func FromWorkflowQueryArray(t []*shared.WorkflowQuery) []*apiv1.WorkflowQuery {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowQuery, len(t))
+	for i := range t {
+		v[i] = FromWorkflowQuery(t[i])
+	}
+	return v
+}
+
+// ToWorkflowQueryArray converts a list of protobuf WorkflowQuery to its thrift counterpart
+func ToWorkflowQueryArray(t []*apiv1.WorkflowQuery) []*shared.WorkflowQuery {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowQuery, len(t))
+	for i := range t {
+		v[i] = ToWorkflowQuery(t[i])
+	}
+	return v
+}
+
+// FromWorkflowQueryResultArray converts a list of thrift WorkflowQueryResult to its protobuf counterpart
+func FromWorkflowQueryResultArray(t []*shared.WorkflowQueryResult) []*apiv1.WorkflowQueryResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowQueryResult, len(t))
+	for i := range t {
+		v[i] = FromWorkflowQueryResult(t[i])
+	}
+	return v
+}
+
+// ToWorkflowQueryResultArray converts a list of protobuf WorkflowQueryResult to its thrift counterpart
+func ToWorkflowQueryResultArray(t []*apiv1.WorkflowQueryResult) []*shared.WorkflowQueryResult {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowQueryResult, len(t))
+	for i := range t {
+		v[i] = ToWorkflowQueryResult(t[i])
+	}
+	return v
+}
+
+// FromBadBinaryInfoMap converts a map of thrift BadBinaryInfo to its protobuf counterpart
+func FromBadBinaryInfoMap(t map[string]*shared.BadBinaryInfo) map[string]*apiv1.BadBinaryInfo {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*apiv1.BadBinaryInfo, len(t))
+	for key, value := range t {
+		v[key] = FromBadBinaryInfo(value)
+	}
+	return v
+}
+
+// ToBadBinaryInfoMap converts a map of protobuf BadBinaryInfo to its thrift counterpart
+func ToBadBinaryInfoMap(t map[string]*apiv1.BadBinaryInfo) map[string]*shared.BadBinaryInfo {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*shared.BadBinaryInfo, len(t))
+	for key, value := range t {
+		v[key] = ToBadBinaryInfo(value)
+	}
+	return v
+}
+
+// FromIndexedValueTypeMap converts a map of thrift IndexedValueType to its protobuf counterpart
+func FromIndexedValueTypeMap(t map[string]shared.IndexedValueType) map[string]apiv1.IndexedValueType {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]apiv1.IndexedValueType, len(t))
+	for key, value := range t {
+		v[key] = FromIndexedValueType(value.Ptr())
+	}
+	return v
+}
+
+// ToIndexedValueTypeMap converts a map of protobuf IndexedValueType to its thrift counterpart
+func ToIndexedValueTypeMap(t map[string]apiv1.IndexedValueType) map[string]shared.IndexedValueType {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]shared.IndexedValueType, len(t))
+	for key, value := range t {
+		if e := ToIndexedValueType(value); e != nil {
+			v[key] = *e
+		}
+	}
+	return v
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+)
+
+func TestEnums(t *testing.T) {
+	for _, v := range shared.ArchivalStatus_Values() {
+		assert.Equal(t, &v, ToArchivalStatus(FromArchivalStatus(&v)))
+	}
+	assert.Equal(t, apiv1.ArchivalStatus_ARCHIVAL_STATUS_INVALID, FromArchivalStatus(nil))
+	assert.Nil(t, ToArchivalStatus(apiv1.ArchivalStatus_ARCHIVAL_STATUS_INVALID))
+
+	for _, v := range shared.BatchOperationState_Values() {
+		assert.Equal(t, &v, ToBatchOperationState(FromBatchOperationState(&v)))
+	}
+	assert.Equal(t, apiv1.BatchOperationState_BATCH_OPERATION_STATE_INVALID, FromBatchOperationState(nil))
+	assert.Nil(t, ToBatchOperationState(apiv1.BatchOperationState_BATCH_OPERATION_STATE_INVALID))
+
+	for _, v := range shared.BatchOperationType_Values() {
+		assert.Equal(t, &v, ToBatchOperationType(FromBatchOperationType(&v)))
+	}
+	assert.Equal(t, apiv1.BatchOperationType_BATCH_OPERATION_TYPE_INVALID, FromBatchOperationType(nil))
+	assert.Nil(t, ToBatchOperationType(apiv1.BatchOperationType_BATCH_OPERATION_TYPE_INVALID))
+
+	for _, v := range shared.CancelExternalWorkflowExecutionFailedCause_Values() {
+		assert.Equal(t, &v, ToCancelExternalWorkflowExecutionFailedCause(FromCancelExternalWorkflowExecutionFailedCause(&v)))
+	}
+	assert.Equal(t, apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID, FromCancelExternalWorkflowExecutionFailedCause(nil))
+	assert.Nil(t, ToCancelExternalWorkflowExecutionFailedCause(apiv1.CancelExternalWorkflowExecutionFailedCause_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID))
+
+	for _, v := range shared.ChildWorkflowExecutionFailedCause_Values() {
+		assert.Equal(t, &v, ToChildWorkflowExecutionFailedCause(FromChildWorkflowExecutionFailedCause(&v)))
+	}
+	assert.Equal(t, apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID, FromChildWorkflowExecutionFailedCause(nil))
+	assert.Nil(t, ToChildWorkflowExecutionFailedCause(apiv1.ChildWorkflowExecutionFailedCause_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID))
+
+	for _, v := range shared.ContinueAsNewInitiator_Values() {
+		assert.Equal(t, &v, ToContinueAsNewInitiator(FromContinueAsNewInitiator(&v)))
+	}
+	assert.Equal(t, apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID, FromContinueAsNewInitiator(nil))
+	assert.Nil(t, ToContinueAsNewInitiator(apiv1.ContinueAsNewInitiator_CONTINUE_AS_NEW_INITIATOR_INVALID))
+
+	for _, v := range shared.DecisionTaskFailedCause_Values() {
+		assert.Equal(t, &v, ToDecisionTaskFailedCause(FromDecisionTaskFailedCause(&v)))
+	}
+	assert.Equal(t, apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID, FromDecisionTaskFailedCause(nil))
+	assert.Nil(t, ToDecisionTaskFailedCause(apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID))
+
+	for _, v := range shared.DecisionType_Values() {
+		assert.Equal(t, &v, ToDecisionType(FromDecisionType(&v)))
+	}
+	assert.Equal(t, apiv1.DecisionType_DECISION_TYPE_INVALID, FromDecisionType(nil))
+	assert.Nil(t, ToDecisionType(apiv1.DecisionType_DECISION_TYPE_INVALID))
+
+	for _, v := range shared.DomainFailoverState_Values() {
+		assert.Equal(t, &v, ToDomainFailoverState(FromDomainFailoverState(&v)))
+	}
+	assert.Equal(t, apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_INVALID, FromDomainFailoverState(nil))
+	assert.Nil(t, ToDomainFailoverState(apiv1.DomainFailoverState_DOMAIN_FAILOVER_STATE_INVALID))
+
+	for _, v := range shared.DomainStatus_Values() {
+		assert.Equal(t, &v, ToDomainStatus(FromDomainStatus(&v)))
+	}
+	assert.Equal(t, apiv1.DomainStatus_DOMAIN_STATUS_INVALID, FromDomainStatus(nil))
+	assert.Nil(t, ToDomainStatus(apiv1.DomainStatus_DOMAIN_STATUS_INVALID))
+
+	for _, v := range shared.EventType_Values() {
+		assert.Equal(t, &v, ToEventType(FromEventType(&v)))
+	}
+	assert.Equal(t, apiv1.EventType_EVENT_TYPE_INVALID, FromEventType(nil))
+	assert.Nil(t, ToEventType(apiv1.EventType_EVENT_TYPE_INVALID))
+
+	for _, v := range shared.HistoryArchivalState_Values() {
+		assert.Equal(t, &v, ToHistoryArchivalState(FromHistoryArchivalState(&v)))
+	}
+	assert.Equal(t, apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_INVALID, FromHistoryArchivalState(nil))
+	assert.Nil(t, ToHistoryArchivalState(apiv1.HistoryArchivalState_HISTORY_ARCHIVAL_STATE_INVALID))
+
+	for _, v := range shared.HistoryEventFilterType_Values() {
+		assert.Equal(t, &v, ToHistoryEventFilterType(FromHistoryEventFilterType(&v)))
+	}
+	assert.Equal(t, apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_INVALID, FromHistoryEventFilterType(nil))
+	assert.Nil(t, ToHistoryEventFilterType(apiv1.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_INVALID))
+
+	for _, v := range shared.IndexedValueType_Values() {
+		assert.Equal(t, &v, ToIndexedValueType(FromIndexedValueType(&v)))
+	}
+	assert.Equal(t, apiv1.IndexedValueType_INDEXED_VALUE_TYPE_INVALID, FromIndexedValueType(nil))
+	assert.Nil(t, ToIndexedValueType(apiv1.IndexedValueType_INDEXED_VALUE_TYPE_INVALID))
+
+	for _, v := range shared.ParentClosePolicy_Values() {
+		assert.Equal(t, &v, ToParentClosePolicy(FromParentClosePolicy(&v)))
+	}
+	assert.Equal(t, apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_INVALID, FromParentClosePolicy(nil))
+	assert.Nil(t, ToParentClosePolicy(apiv1.ParentClosePolicy_PARENT_CLOSE_POLICY_INVALID))
+
+	for _, v := range shared.PendingActivityState_Values() {
+		assert.Equal(t, &v, ToPendingActivityState(FromPendingActivityState(&v)))
+	}
+	assert.Equal(t, apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID, FromPendingActivityState(nil))
+	assert.Nil(t, ToPendingActivityState(apiv1.PendingActivityState_PENDING_ACTIVITY_STATE_INVALID))
+
+	for _, v := range shared.QueryRejectCondition_Values() {
+		assert.Equal(t, &v, ToQueryRejectCondition(FromQueryRejectCondition(&v)))
+	}
+	assert.Equal(t, apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID, FromQueryRejectCondition(nil))
+	assert.Nil(t, ToQueryRejectCondition(apiv1.QueryRejectCondition_QUERY_REJECT_CONDITION_INVALID))
+
+	for _, v := range shared.QueryResultType_Values() {
+		assert.Equal(t, &v, ToQueryResultType(FromQueryResultType(&v)))
+	}
+	assert.Equal(t, apiv1.QueryResultType_QUERY_RESULT_TYPE_INVALID, FromQueryResultType(nil))
+	assert.Nil(t, ToQueryResultType(apiv1.QueryResultType_QUERY_RESULT_TYPE_INVALID))
+
+	for _, v := range shared.QueryTaskCompletedType_Values() {
+		assert.Equal(t, &v, ToQueryTaskCompletedType(FromQueryTaskCompletedType(&v)))
+	}
+	assert.Equal(t, apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_INVALID, FromQueryTaskCompletedType(nil))
+	assert.Nil(t, ToQueryTaskCompletedType(apiv1.QueryTaskCompletedType_QUERY_TASK_COMPLETED_TYPE_INVALID))
+
+	for _, v := range shared.SignalExternalWorkflowExecutionFailedCause_Values() {
+		assert.Equal(t, &v, ToSignalExternalWorkflowExecutionFailedCause(FromSignalExternalWorkflowExecutionFailedCause(&v)))
+	}
+	assert.Equal(t, apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID, FromSignalExternalWorkflowExecutionFailedCause(nil))
+	assert.Nil(t, ToSignalExternalWorkflowExecutionFailedCause(apiv1.SignalExternalWorkflowExecutionFailedCause_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID))
+
+	for _, v := range shared.TaskListKind_Values() {
+		assert.Equal(t, &v, ToTaskListKind(FromTaskListKind(&v)))
+	}
+	assert.Equal(t, apiv1.TaskListKind_TASK_LIST_KIND_INVALID, FromTaskListKind(nil))
+	assert.Nil(t, ToTaskListKind(apiv1.TaskListKind_TASK_LIST_KIND_INVALID))
+
+	for _, v := range shared.TaskListType_Values() {
+		assert.Equal(t, &v, ToTaskListType(FromTaskListType(&v)))
+	}
+	assert.Equal(t, apiv1.TaskListType_TASK_LIST_TYPE_INVALID, FromTaskListType(nil))
+	assert.Nil(t, ToTaskListType(apiv1.TaskListType_TASK_LIST_TYPE_INVALID))
+
+	for _, v := range shared.TimeoutType_Values() {
+		assert.Equal(t, &v, ToTimeoutType(FromTimeoutType(&v)))
+	}
+	assert.Equal(t, apiv1.TimeoutType_TIMEOUT_TYPE_INVALID, FromTimeoutType(nil))
+	assert.Nil(t, ToTimeoutType(apiv1.TimeoutType_TIMEOUT_TYPE_INVALID))
+
+	for _, v := range shared.WorkflowExecutionCloseStatus_Values() {
+		assert.Equal(t, &v, ToWorkflowExecutionCloseStatus(FromWorkflowExecutionCloseStatus(&v)))
+	}
+	assert.Equal(t, apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID, FromWorkflowExecutionCloseStatus(nil))
+	assert.Nil(t, ToWorkflowExecutionCloseStatus(apiv1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID))
+
+	for _, v := range shared.WorkflowIdReusePolicy_Values() {
+		assert.Equal(t, &v, ToWorkflowIdReusePolicy(FromWorkflowIdReusePolicy(&v)))
+	}
+	assert.Equal(t, apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID, FromWorkflowIdReusePolicy(nil))
+	assert.Nil(t, ToWorkflowIdReusePolicy(apiv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_INVALID))
+}
+
+func TestStartWorkflowExecutionRequest(t *testing.T) {
+	request := &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr("domain"),
+		WorkflowId:                          common.StringPtr("workflowID"),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflowType")},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr("taskList"), Kind: shared.TaskListKindNormal.Ptr()},
+		Input:                               []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr("identity"),
+		RequestId:                           common.StringPtr("requestID"),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicyRejectDuplicate.Ptr(),
+		RetryPolicy: &shared.RetryPolicy{
+			InitialIntervalInSeconds:    common.Int32Ptr(1),
+			BackoffCoefficient:          common.Float64Ptr(2),
+			MaximumAttempts:             common.Int32Ptr(3),
+			NonRetriableErrorReasons:    []string{"reason"},
+			ExpirationIntervalInSeconds: common.Int32Ptr(100),
+		},
+		Memo:             &shared.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+		SearchAttributes: &shared.SearchAttributes{IndexedFields: map[string][]byte{"key": []byte("value")}},
+		Header:           &shared.Header{Fields: map[string][]byte{"key": []byte("value")}},
+	}
+	assert.Equal(t, request, ToStartWorkflowExecutionRequest(FromStartWorkflowExecutionRequest(request)))
+	assert.Nil(t, FromStartWorkflowExecutionRequest(nil))
+	assert.Nil(t, ToStartWorkflowExecutionRequest(nil))
+}
+
+func TestHistoryEvent(t *testing.T) {
+	history := &shared.History{Events: []*shared.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			Timestamp: common.Int64Ptr(100),
+			EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			Version:   common.Int64Ptr(common.EmptyVersion),
+			TaskId:    common.Int64Ptr(5),
+			WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+				WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflowType")},
+				TaskList:                            &shared.TaskList{Name: common.StringPtr("taskList")},
+				Input:                               []byte("input"),
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				Initiator:                           shared.ContinueAsNewInitiatorRetryPolicy.Ptr(),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(2),
+			EventType: shared.EventTypeDecisionTaskScheduled.Ptr(),
+			DecisionTaskScheduledEventAttributes: &shared.DecisionTaskScheduledEventAttributes{
+				TaskList:                   &shared.TaskList{Name: common.StringPtr("taskList")},
+				StartToCloseTimeoutSeconds: common.Int32Ptr(10),
+				Attempt:                    common.Int64Ptr(1),
+			},
+		},
+	}}
+	assert.Equal(t, history, ToHistory(FromHistory(history)))
+}
+
+func TestUpdateDomainRequest_Presence(t *testing.T) {
+	// cleared fields must survive the conversion, they are not the same as unset ones for the frontend
+	request := &shared.UpdateDomainRequest{
+		Name: common.StringPtr("domain"),
+		UpdatedInfo: &shared.UpdateDomainInfo{
+			Description: common.StringPtr(""),
+		},
+		Configuration: &shared.DomainConfiguration{
+			EmitMetric: common.BoolPtr(false),
+		},
+	}
+	converted := FromUpdateDomainRequest(request)
+	assert.Equal(t, &types.StringValue{Value: ""}, converted.UpdatedInfo.Description)
+	assert.Nil(t, converted.UpdatedInfo.OwnerEmail)
+	assert.Equal(t, &types.BoolValue{Value: false}, converted.Configuration.EmitMetric)
+	assert.Equal(t, request, ToUpdateDomainRequest(converted))
+
+	// any other zero value is read back as unset
+	converted = &apiv1.UpdateDomainRequest{Name: "domain", SecurityToken: ""}
+	assert.Equal(t, &shared.UpdateDomainRequest{Name: common.StringPtr("domain")}, ToUpdateDomainRequest(converted))
+}
+
+func TestGetSearchAttributesResponse(t *testing.T) {
+	response := &shared.GetSearchAttributesResponse{
+		Keys: map[string]shared.IndexedValueType{
+			"CustomKeywordField": shared.IndexedValueTypeKeyword,
+			"CustomIntField":     shared.IndexedValueTypeInt,
+		},
+	}
+	assert.Equal(t, response, ToGetSearchAttributesResponse(FromGetSearchAttributesResponse(response)))
+}
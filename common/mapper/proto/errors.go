@@ -0,0 +1,102 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/yarpcerrors"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+)
+
+// FromError converts an error returned by the thrift handler into a protobuf error. Thrift exceptions
+// become a gRPC status with a matching code and carry the exception as the error detail, any other
+// error is returned as is.
+func FromError(err error) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *shared.BadRequestError:
+		return protobuf.NewError(yarpcerrors.CodeInvalidArgument, e.Message, protobuf.WithErrorDetails(FromBadRequestError(e)))
+	case *shared.InternalServiceError:
+		return protobuf.NewError(yarpcerrors.CodeInternal, e.Message, protobuf.WithErrorDetails(FromInternalServiceError(e)))
+	case *shared.DomainAlreadyExistsError:
+		return protobuf.NewError(yarpcerrors.CodeAlreadyExists, e.Message, protobuf.WithErrorDetails(FromDomainAlreadyExistsError(e)))
+	case *shared.WorkflowExecutionAlreadyStartedError:
+		return protobuf.NewError(yarpcerrors.CodeAlreadyExists, e.GetMessage(), protobuf.WithErrorDetails(FromWorkflowExecutionAlreadyStartedError(e)))
+	case *shared.EntityNotExistsError:
+		return protobuf.NewError(yarpcerrors.CodeNotFound, e.Message, protobuf.WithErrorDetails(FromEntityNotExistsError(e)))
+	case *shared.ServiceBusyError:
+		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(FromServiceBusyError(e)))
+	case *shared.CancellationAlreadyRequestedError:
+		return protobuf.NewError(yarpcerrors.CodeAlreadyExists, e.Message, protobuf.WithErrorDetails(FromCancellationAlreadyRequestedError(e)))
+	case *shared.QueryFailedError:
+		return protobuf.NewError(yarpcerrors.CodeInvalidArgument, e.Message, protobuf.WithErrorDetails(FromQueryFailedError(e)))
+	case *shared.DomainNotActiveError:
+		return protobuf.NewError(yarpcerrors.CodeFailedPrecondition, e.Message, protobuf.WithErrorDetails(FromDomainNotActiveError(e)))
+	case *shared.LimitExceededError:
+		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(FromLimitExceededError(e)))
+	case *shared.AccessDeniedError:
+		return protobuf.NewError(yarpcerrors.CodePermissionDenied, e.Message, protobuf.WithErrorDetails(FromAccessDeniedError(e)))
+	case *shared.ClientVersionNotSupportedError:
+		return protobuf.NewError(yarpcerrors.CodeFailedPrecondition, e.Error(), protobuf.WithErrorDetails(FromClientVersionNotSupportedError(e)))
+	case *shared.PreconditionFailedError:
+		return protobuf.NewError(yarpcerrors.CodeFailedPrecondition, e.Message, protobuf.WithErrorDetails(FromPreconditionFailedError(e)))
+	}
+	return err
+}
+
+// ToError converts an error returned by a protobuf client back into the thrift exception carried in its
+// details, errors without such a detail are returned as is
+func ToError(err error) error {
+	for _, detail := range protobuf.GetErrorDetails(err) {
+		switch d := detail.(type) {
+		case *apiv1.BadRequestError:
+			return ToBadRequestError(d)
+		case *apiv1.InternalServiceError:
+			return ToInternalServiceError(d)
+		case *apiv1.DomainAlreadyExistsError:
+			return ToDomainAlreadyExistsError(d)
+		case *apiv1.WorkflowExecutionAlreadyStartedError:
+			return ToWorkflowExecutionAlreadyStartedError(d)
+		case *apiv1.EntityNotExistsError:
+			return ToEntityNotExistsError(d)
+		case *apiv1.ServiceBusyError:
+			return ToServiceBusyError(d)
+		case *apiv1.CancellationAlreadyRequestedError:
+			return ToCancellationAlreadyRequestedError(d)
+		case *apiv1.QueryFailedError:
+			return ToQueryFailedError(d)
+		case *apiv1.DomainNotActiveError:
+			return ToDomainNotActiveError(d)
+		case *apiv1.LimitExceededError:
+			return ToLimitExceededError(d)
+		case *apiv1.AccessDeniedError:
+			return ToAccessDeniedError(d)
+		case *apiv1.ClientVersionNotSupportedError:
+			return ToClientVersionNotSupportedError(d)
+		case *apiv1.PreconditionFailedError:
+			return ToPreconditionFailedError(d)
+		}
+	}
+	return err
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"github.com/gogo/protobuf/types"
+)
+
+// proto3 scalars carry no presence, an unset optional thrift field and its zero value are both sent as
+// the zero value, so the zero value is read back as an unset field
+
+func stringPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	if !v {
+		return nil
+	}
+	return &v
+}
+
+func int32Ptr(v int32) *int32 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func int64Ptr(v int64) *int64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func float64Ptr(v float64) *float64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+// fields where the frontend tells an unset value from a cleared one use wrapper types instead
+
+func fromStringValue(v *string) *types.StringValue {
+	if v == nil {
+		return nil
+	}
+	return &types.StringValue{Value: *v}
+}
+
+func toStringValue(v *types.StringValue) *string {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
+
+func fromBoolValue(v *bool) *types.BoolValue {
+	if v == nil {
+		return nil
+	}
+	return &types.BoolValue{Value: *v}
+}
+
+func toBoolValue(v *types.BoolValue) *bool {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
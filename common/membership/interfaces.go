@@ -74,5 +74,7 @@ type (
 		AddListener(name string, notifyChannel chan<- *ChangedEvent) error
 		// RemoveListener removes a listener for this service.
 		RemoveListener(name string) error
+		// MemberCount returns the number of hosts of this service.
+		MemberCount() int
 	}
 )
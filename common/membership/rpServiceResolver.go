@@ -135,6 +135,13 @@ func (r *ringpopServiceResolver) Lookup(key string) (*HostInfo, error) {
 	return NewHostInfo(addr, r.getLabelsMap()), nil
 }
 
+// MemberCount returns the number of hosts in the ring
+func (r *ringpopServiceResolver) MemberCount() int {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+	return r.ring.ServerCount()
+}
+
 func (r *ringpopServiceResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
 	r.listenerLock.Lock()
 	defer r.listenerLock.Unlock()
@@ -0,0 +1,266 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	// InMemoryBroker holds the topics shared by the in memory messaging clients of the clusters
+	// of a test environment, it replaces Kafka when all clusters run in the same process
+	InMemoryBroker struct {
+		sync.Mutex
+		topics map[string]*inMemoryTopic
+	}
+
+	inMemoryTopic struct {
+		sync.Mutex
+		name     string
+		messages [][]byte
+		// offsets is the offset of the next message of each consumer group
+		offsets map[string]int
+		// notifyC is closed and replaced whenever a message is published
+		notifyC chan struct{}
+	}
+
+	inMemoryClient struct {
+		broker      *InMemoryBroker
+		clusterName string
+		logger      log.Logger
+	}
+
+	inMemoryProducer struct {
+		topic      *inMemoryTopic
+		msgEncoder codec.BinaryEncoder
+	}
+
+	inMemoryConsumer struct {
+		topic    *inMemoryTopic
+		dlqTopic *inMemoryTopic
+		group    string
+		logger   log.Logger
+		msgC     chan Message
+		doneC    chan struct{}
+	}
+
+	inMemoryMessage struct {
+		value    []byte
+		offset   int64
+		dlqTopic *inMemoryTopic
+	}
+)
+
+var _ Client = (*inMemoryClient)(nil)
+var _ Producer = (*inMemoryProducer)(nil)
+var _ Consumer = (*inMemoryConsumer)(nil)
+var _ Message = (*inMemoryMessage)(nil)
+
+// NewInMemoryBroker creates a broker for the in memory messaging clients
+func NewInMemoryBroker() *InMemoryBroker {
+	return &InMemoryBroker{
+		topics: make(map[string]*inMemoryTopic),
+	}
+}
+
+// NewInMemoryClient creates a messaging client of the given cluster backed by the broker. Replication
+// topics are shared by all the clusters of the broker, application topics are private to the cluster.
+func NewInMemoryClient(broker *InMemoryBroker, clusterName string, logger log.Logger) Client {
+	return &inMemoryClient{
+		broker:      broker,
+		clusterName: clusterName,
+		logger:      logger,
+	}
+}
+
+// NewConsumer is used to create a consumer of an application topic of the cluster
+func (c *inMemoryClient) NewConsumer(appName, consumerName string, concurrency int) (Consumer, error) {
+	topicName := c.clusterName + "-" + appName
+	return c.newConsumer(topicName, consumerName), nil
+}
+
+// NewConsumerWithClusterName is used to create a consumer of the replication tasks of the source cluster
+func (c *inMemoryClient) NewConsumerWithClusterName(currentCluster, sourceCluster, consumerName string, concurrency int) (Consumer, error) {
+	return c.newConsumer(sourceCluster, consumerName), nil
+}
+
+// NewProducer is used to create a producer of an application topic of the cluster
+func (c *inMemoryClient) NewProducer(appName string) (Producer, error) {
+	return newInMemoryProducer(c.broker.getTopic(c.clusterName + "-" + appName)), nil
+}
+
+// NewProducerWithClusterName is used to create a producer shipping replication tasks of the source cluster
+func (c *inMemoryClient) NewProducerWithClusterName(sourceCluster string) (Producer, error) {
+	return newInMemoryProducer(c.broker.getTopic(sourceCluster)), nil
+}
+
+func (c *inMemoryClient) newConsumer(topicName, consumerName string) Consumer {
+	return &inMemoryConsumer{
+		topic:    c.broker.getTopic(topicName),
+		dlqTopic: c.broker.getTopic(topicName + "-dlq"),
+		group:    consumerName,
+		logger:   c.logger.WithTags(tag.KafkaTopicName(topicName), tag.KafkaConsumerName(consumerName)),
+		msgC:     make(chan Message, rcvBufferSize),
+		doneC:    make(chan struct{}),
+	}
+}
+
+func (b *InMemoryBroker) getTopic(name string) *inMemoryTopic {
+	b.Lock()
+	defer b.Unlock()
+
+	topic, ok := b.topics[name]
+	if !ok {
+		topic = &inMemoryTopic{
+			name:    name,
+			offsets: make(map[string]int),
+			notifyC: make(chan struct{}),
+		}
+		b.topics[name] = topic
+	}
+	return topic
+}
+
+func (t *inMemoryTopic) publish(value []byte) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.messages = append(t.messages, value)
+	close(t.notifyC)
+	t.notifyC = make(chan struct{})
+}
+
+// next returns the next message of the consumer group, or a channel closed once a message is published
+// if the group already received all the messages of the topic
+func (t *inMemoryTopic) next(group string) (*inMemoryMessage, <-chan struct{}) {
+	t.Lock()
+	defer t.Unlock()
+
+	offset := t.offsets[group]
+	if offset >= len(t.messages) {
+		return nil, t.notifyC
+	}
+	t.offsets[group] = offset + 1
+	return &inMemoryMessage{value: t.messages[offset], offset: int64(offset)}, nil
+}
+
+func newInMemoryProducer(topic *inMemoryTopic) Producer {
+	return &inMemoryProducer{
+		topic:      topic,
+		msgEncoder: codec.NewThriftRWEncoder(),
+	}
+}
+
+// Publish is used to append a message to the topic
+func (p *inMemoryProducer) Publish(msg interface{}) error {
+	thriftObject, ok := msg.(codec.ThriftObject)
+	if !ok {
+		return errors.New("unknown producer message type")
+	}
+	payload, err := p.msgEncoder.Encode(thriftObject)
+	if err != nil {
+		return err
+	}
+	p.topic.publish(payload)
+	return nil
+}
+
+// PublishBatch is used to append multiple messages to the topic
+func (p *inMemoryProducer) PublishBatch(msgs []interface{}) error {
+	for _, msg := range msgs {
+		if err := p.Publish(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close is a noop, the messages stay in the broker
+func (p *inMemoryProducer) Close() error {
+	return nil
+}
+
+// Start starts delivering the messages of the topic not yet received by the consumer group
+func (c *inMemoryConsumer) Start() error {
+	go func() {
+		defer close(c.msgC)
+		for {
+			msg, notifyC := c.topic.next(c.group)
+			if msg == nil {
+				select {
+				case <-notifyC:
+					continue
+				case <-c.doneC:
+					return
+				}
+			}
+			msg.dlqTopic = c.dlqTopic
+			select {
+			case c.msgC <- msg:
+			case <-c.doneC:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the consumer
+func (c *inMemoryConsumer) Stop() {
+	c.logger.Info("Stopping consumer")
+	close(c.doneC)
+}
+
+// Messages return the message channel for this consumer
+func (c *inMemoryConsumer) Messages() <-chan Message {
+	return c.msgC
+}
+
+// Value is the payload of the message
+func (m *inMemoryMessage) Value() []byte {
+	return m.value
+}
+
+// Partition is always 0, in memory topics are not partitioned
+func (m *inMemoryMessage) Partition() int32 {
+	return 0
+}
+
+// Offset is the position of the message in the topic
+func (m *inMemoryMessage) Offset() int64 {
+	return m.offset
+}
+
+// Ack is a noop, the offset of the consumer group is advanced once the message is delivered
+func (m *inMemoryMessage) Ack() error {
+	return nil
+}
+
+// Nack moves the message to the DLQ topic
+func (m *inMemoryMessage) Nack() error {
+	m.dlqTopic.publish(m.value)
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	inMemoryClientSuite struct {
+		suite.Suite
+		broker *InMemoryBroker
+	}
+)
+
+func TestInMemoryClientSuite(t *testing.T) {
+	s := new(inMemoryClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *inMemoryClientSuite) SetupTest() {
+	s.broker = NewInMemoryBroker()
+}
+
+func (s *inMemoryClientSuite) TestReplicationTopic() {
+	active := NewInMemoryClient(s.broker, "active", loggerimpl.NewNopLogger())
+	standby := NewInMemoryClient(s.broker, "standby", loggerimpl.NewNopLogger())
+
+	producer, err := active.NewProducerWithClusterName("active")
+	s.NoError(err)
+	s.NoError(producer.Publish(newTestReplicationTask(1)))
+
+	consumer, err := standby.NewConsumerWithClusterName("standby", "active", "standby_consumer", 1)
+	s.NoError(err)
+	s.NoError(consumer.Start())
+	defer consumer.Stop()
+
+	// messages published before and after the consumer started are delivered in order
+	s.NoError(producer.PublishBatch([]interface{}{newTestReplicationTask(2), newTestReplicationTask(3)}))
+	for i := int64(1); i <= 3; i++ {
+		msg := s.receive(consumer)
+		s.Equal(i-1, msg.Offset())
+		s.Equal(i, s.decode(msg).GetSourceTaskId())
+		s.NoError(msg.Ack())
+	}
+}
+
+func (s *inMemoryClientSuite) TestConsumerGroups() {
+	client := NewInMemoryClient(s.broker, "active", loggerimpl.NewNopLogger())
+	producer, err := client.NewProducerWithClusterName("standby")
+	s.NoError(err)
+	s.NoError(producer.Publish(newTestReplicationTask(1)))
+
+	// every consumer group receives all the messages of the topic
+	for _, group := range []string{"consumer1", "consumer2"} {
+		consumer, err := client.NewConsumerWithClusterName("active", "standby", group, 1)
+		s.NoError(err)
+		s.NoError(consumer.Start())
+		s.Equal(int64(1), s.decode(s.receive(consumer)).GetSourceTaskId())
+		consumer.Stop()
+	}
+}
+
+func (s *inMemoryClientSuite) TestApplicationTopicIsPrivate() {
+	active := NewInMemoryClient(s.broker, "active", loggerimpl.NewNopLogger())
+	standby := NewInMemoryClient(s.broker, "standby", loggerimpl.NewNopLogger())
+
+	producer, err := active.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	s.NoError(producer.Publish(newTestReplicationTask(1)))
+
+	standbyConsumer, err := standby.NewConsumer(common.VisibilityAppName, "consumer", 1)
+	s.NoError(err)
+	s.NoError(standbyConsumer.Start())
+	defer standbyConsumer.Stop()
+	select {
+	case <-standbyConsumer.Messages():
+		s.Fail("message of another cluster is delivered")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	activeConsumer, err := active.NewConsumer(common.VisibilityAppName, "consumer", 1)
+	s.NoError(err)
+	s.NoError(activeConsumer.Start())
+	defer activeConsumer.Stop()
+	msg := s.receive(activeConsumer)
+	s.Equal(int64(1), s.decode(msg).GetSourceTaskId())
+
+	// nacked messages are moved to the DLQ topic
+	s.NoError(msg.Nack())
+	dlqMsg, _ := s.broker.getTopic("active-" + common.VisibilityAppName + "-dlq").next("dlq")
+	s.NotNil(dlqMsg)
+	s.Equal(msg.Value(), dlqMsg.Value())
+}
+
+func (s *inMemoryClientSuite) TestPublishUnknownMessage() {
+	client := NewInMemoryClient(s.broker, "active", loggerimpl.NewNopLogger())
+	producer, err := client.NewProducerWithClusterName("active")
+	s.NoError(err)
+	s.Error(producer.Publish("not a thrift object"))
+}
+
+func (s *inMemoryClientSuite) receive(consumer Consumer) Message {
+	select {
+	case msg := <-consumer.Messages():
+		return msg
+	case <-time.After(time.Second):
+		s.FailNow("no message received")
+	}
+	return nil
+}
+
+func (s *inMemoryClientSuite) decode(msg Message) *replicator.ReplicationTask {
+	var task replicator.ReplicationTask
+	s.NoError(codec.NewThriftRWEncoder().Decode(msg.Value(), &task))
+	return &task
+}
+
+func newTestReplicationTask(sourceTaskID int64) *replicator.ReplicationTask {
+	return &replicator.ReplicationTask{
+		TaskType:     replicator.ReplicationTaskTypeHistory.Ptr(),
+		SourceTaskId: common.Int64Ptr(sourceTaskID),
+	}
+}
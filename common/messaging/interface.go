@@ -56,6 +56,7 @@ type (
 	// Producer is the interface used to send replication tasks to other clusters through replicator
 	Producer interface {
 		Publish(message interface{}) error
+		PublishBatch(messages []interface{}) error
 	}
 
 	// CloseableProducer is a Producer that can be closed
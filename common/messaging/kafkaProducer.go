@@ -25,6 +25,7 @@ import (
 
 	"github.com/Shopify/sarama"
 	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/metering"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log"
@@ -72,6 +73,31 @@ func (p *kafkaProducer) Publish(msg interface{}) error {
 	return nil
 }
 
+// PublishBatch is used to send multiple messages to other clusters through Kafka topic in a single call
+func (p *kafkaProducer) PublishBatch(msgs []interface{}) error {
+	messages := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		message, err := p.getProducerMessage(msg)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message)
+	}
+
+	err := p.producer.SendMessages(messages)
+	if err != nil {
+		p.logger.Warn("Failed to publish message batch to kafka",
+			tag.Counter(len(messages)),
+			tag.Error(err))
+		if producerErrs, ok := err.(sarama.ProducerErrors); ok && len(producerErrs) > 0 {
+			return p.convertErr(producerErrs[0].Err)
+		}
+		return p.convertErr(err)
+	}
+
+	return nil
+}
+
 // Close is used to close Kafka publisher
 func (p *kafkaProducer) Close() error {
 	return p.convertErr(p.producer.Close())
@@ -138,6 +164,18 @@ func (p *kafkaProducer) getProducerMessage(message interface{}) (*sarama.Produce
 			Value: sarama.ByteEncoder(payload),
 		}
 		return msg, nil
+	case *metering.UsageRecord:
+		record := message.(*metering.UsageRecord)
+		payload, err := p.serializeThrift(record)
+		if err != nil {
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(record.GetDomainID()),
+			Value: sarama.ByteEncoder(payload),
+		}
+		return msg, nil
 	default:
 		return nil, errors.New("unknown producer message type")
 	}
@@ -53,6 +53,19 @@ func (p *metricsProducer) Publish(msg interface{}) error {
 	return err
 }
 
+func (p *metricsProducer) PublishBatch(msgs []interface{}) error {
+	p.metricsClient.IncCounter(metrics.MessagingClientPublishBatchScope, metrics.CadenceClientRequests)
+
+	sw := p.metricsClient.StartTimer(metrics.MessagingClientPublishBatchScope, metrics.CadenceClientLatency)
+	err := p.producer.PublishBatch(msgs)
+	sw.Stop()
+
+	if err != nil {
+		p.metricsClient.IncCounter(metrics.MessagingClientPublishBatchScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (p *metricsProducer) Close() error {
 	if closeableProducer, ok := p.producer.(CloseableProducer); ok {
 		return closeableProducer.Close()
@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"github.com/uber/cadence/.gen/go/metering"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// Counter is a usage counter metered per domain
+	Counter int
+
+	// Meter aggregates the usage counters of each domain on a host and periodically
+	// exports them as usage records to a sink
+	Meter interface {
+		common.Daemon
+		// Add adds delta to the counter of the given domain
+		Add(domainID string, counter Counter, delta int64)
+	}
+
+	// Sink exports usage records
+	Sink interface {
+		// Export exports the usage records, the records are exported again with the
+		// next export if an error is returned
+		Export(records []*metering.UsageRecord) error
+		// Close releases the resources held by the sink
+		Close() error
+	}
+)
+
+// Counters metered per domain
+const (
+	// CounterWorkflowStarts is the number of workflow executions started
+	CounterWorkflowStarts Counter = iota
+	// CounterDecisions is the number of decision tasks completed
+	CounterDecisions
+	// CounterActivities is the number of activity tasks scheduled
+	CounterActivities
+	// CounterSignals is the number of signals received by workflow executions
+	CounterSignals
+	// CounterHistoryBytes is the number of bytes of history events written
+	CounterHistoryBytes
+	// CounterESDocuments is the number of documents written to elasticsearch
+	CounterESDocuments
+
+	numCounters
+)
+
+const (
+	// SinkFile is the name of the sink appending usage records to a file
+	SinkFile = "file"
+	// SinkKafka is the name of the sink publishing usage records to kafka
+	SinkKafka = "kafka"
+
+	// KafkaApplication is the kafka application whose topic usage records are published to
+	KafkaApplication = "metering"
+)
+
+var counterNames = [numCounters]string{
+	CounterWorkflowStarts: "workflow_starts",
+	CounterDecisions:      "decisions",
+	CounterActivities:     "activities",
+	CounterSignals:        "signals",
+	CounterHistoryBytes:   "history_bytes",
+	CounterESDocuments:    "es_documents",
+}
+
+// String returns the name of the counter used in usage records
+func (c Counter) String() string {
+	if c < 0 || c >= numCounters {
+		return "unknown"
+	}
+	return counterNames[c]
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/metering"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	usage [numCounters]int64
+
+	meterImpl struct {
+		status         int32
+		serviceName    string
+		hostName       string
+		sink           Sink
+		exportInterval dynamicconfig.DurationPropertyFn
+		timeSource     clock.TimeSource
+		metricsClient  metrics.Client
+		logger         log.Logger
+
+		sync.Mutex
+		startTime time.Time
+		usage     map[string]*usage
+		// pending are the records of earlier intervals which failed to export
+		pending []*metering.UsageRecord
+
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+
+	noopMeter struct{}
+)
+
+const (
+	exportIntervalJitterCoefficient = 0.1
+	// maxPendingRecords bounds the records kept in memory while the sink is failing
+	maxPendingRecords = 100000
+)
+
+var _ Meter = (*meterImpl)(nil)
+var _ Meter = (*noopMeter)(nil)
+
+// NewMeter creates a meter exporting the usage of the domains on this host to the sink,
+// a meter which drops all usage is returned if the sink is nil
+func NewMeter(
+	serviceName string,
+	hostName string,
+	sink Sink,
+	exportInterval dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) Meter {
+
+	if sink == nil {
+		return NewNoopMeter()
+	}
+	return &meterImpl{
+		status:         common.DaemonStatusInitialized,
+		serviceName:    serviceName,
+		hostName:       hostName,
+		sink:           sink,
+		exportInterval: exportInterval,
+		timeSource:     timeSource,
+		metricsClient:  metricsClient,
+		logger:         logger.WithTags(tag.ComponentMetering),
+		startTime:      timeSource.Now(),
+		usage:          make(map[string]*usage),
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+// NewNoopMeter creates a meter which drops all usage
+func NewNoopMeter() Meter {
+	return &noopMeter{}
+}
+
+func (m *meterImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	m.shutdownWG.Add(1)
+	go m.exportLoop()
+	m.logger.Info("Meter started.")
+}
+
+func (m *meterImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(m.shutdownCh)
+	m.shutdownWG.Wait()
+	// export the usage of the last interval before the sink is closed
+	m.export()
+	if err := m.sink.Close(); err != nil {
+		m.logger.Warn("Failed to close metering sink.", tag.Error(err))
+	}
+	m.logger.Info("Meter stopped.")
+}
+
+func (m *meterImpl) Add(
+	domainID string,
+	counter Counter,
+	delta int64,
+) {
+
+	if counter < 0 || counter >= numCounters || delta == 0 || domainID == "" {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	u, ok := m.usage[domainID]
+	if !ok {
+		u = &usage{}
+		m.usage[domainID] = u
+	}
+	u[counter] += delta
+}
+
+func (m *meterImpl) exportLoop() {
+	defer m.shutdownWG.Done()
+
+	timer := time.NewTimer(backoff.JitDuration(m.exportInterval(), exportIntervalJitterCoefficient))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-timer.C:
+			m.export()
+			timer.Reset(backoff.JitDuration(m.exportInterval(), exportIntervalJitterCoefficient))
+		}
+	}
+}
+
+func (m *meterImpl) export() {
+	records := m.collect()
+	if len(records) == 0 {
+		return
+	}
+
+	sw := m.metricsClient.StartTimer(metrics.MeteringScope, metrics.MeteringExportLatency)
+	err := m.sink.Export(records)
+	sw.Stop()
+	if err != nil {
+		m.metricsClient.IncCounter(metrics.MeteringScope, metrics.MeteringExportFailures)
+		m.logger.Warn("Failed to export usage records.", tag.Counter(len(records)), tag.Error(err))
+		m.retain(records)
+		return
+	}
+	m.metricsClient.AddCounter(metrics.MeteringScope, metrics.MeteringExportedRecords, int64(len(records)))
+}
+
+// collect returns the usage records of the interval ended, together with the records
+// which failed to export earlier, and starts a new interval
+func (m *meterImpl) collect() []*metering.UsageRecord {
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	records := m.pending
+	for domainID, u := range m.usage {
+		counters := make(map[string]int64, numCounters)
+		for counter, value := range u {
+			if value != 0 {
+				counters[Counter(counter).String()] = value
+			}
+		}
+		records = append(records, &metering.UsageRecord{
+			DomainID:       common.StringPtr(domainID),
+			ServiceName:    common.StringPtr(m.serviceName),
+			HostName:       common.StringPtr(m.hostName),
+			StartTimestamp: common.Int64Ptr(m.startTime.UnixNano()),
+			EndTimestamp:   common.Int64Ptr(now.UnixNano()),
+			Counters:       counters,
+		})
+	}
+
+	m.startTime = now
+	m.usage = make(map[string]*usage)
+	m.pending = nil
+	return records
+}
+
+func (m *meterImpl) retain(records []*metering.UsageRecord) {
+	m.Lock()
+	defer m.Unlock()
+
+	if dropped := len(records) - maxPendingRecords; dropped > 0 {
+		m.metricsClient.AddCounter(metrics.MeteringScope, metrics.MeteringDroppedRecords, int64(dropped))
+		records = records[dropped:]
+	}
+	m.pending = records
+}
+
+func (m *noopMeter) Start() {}
+
+func (m *noopMeter) Stop() {}
+
+func (m *noopMeter) Add(domainID string, counter Counter, delta int64) {}
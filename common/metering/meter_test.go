@@ -0,0 +1,224 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/metering"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	meterSuite struct {
+		suite.Suite
+		timeSource *clock.EventTimeSource
+		sink       *fakeSink
+		meter      *meterImpl
+	}
+
+	fakeSink struct {
+		err     error
+		records [][]*metering.UsageRecord
+		closed  bool
+	}
+)
+
+func TestMeterSuite(t *testing.T) {
+	suite.Run(t, new(meterSuite))
+}
+
+func (s *meterSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource().Update(time.Unix(0, 1000))
+	s.sink = &fakeSink{}
+	s.meter = NewMeter(
+		"cadence-history",
+		"host",
+		s.sink,
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+		s.timeSource,
+		metrics.NewClient(tally.NoopScope, metrics.History),
+		loggerimpl.NewNopLogger(),
+	).(*meterImpl)
+}
+
+func (s *meterSuite) TestNilSink() {
+	meter := NewMeter("cadence-history", "host", nil, dynamicconfig.GetDurationPropertyFn(time.Hour),
+		s.timeSource, metrics.NewClient(tally.NoopScope, metrics.History), loggerimpl.NewNopLogger())
+	s.IsType(&noopMeter{}, meter)
+}
+
+func (s *meterSuite) TestExport() {
+	s.meter.Add("domain1", CounterWorkflowStarts, 1)
+	s.meter.Add("domain1", CounterHistoryBytes, 100)
+	s.meter.Add("domain1", CounterHistoryBytes, 50)
+	s.meter.Add("domain2", CounterSignals, 2)
+	// ignored
+	s.meter.Add("", CounterSignals, 2)
+	s.meter.Add("domain2", numCounters, 2)
+	s.meter.Add("domain3", CounterSignals, 0)
+
+	s.timeSource.Update(time.Unix(0, 2000))
+	s.meter.export()
+
+	s.Len(s.sink.records, 1)
+	records := sortRecords(s.sink.records[0])
+	s.Equal([]*metering.UsageRecord{
+		newRecord("domain1", 1000, 2000, map[string]int64{"workflow_starts": 1, "history_bytes": 150}),
+		newRecord("domain2", 1000, 2000, map[string]int64{"signals": 2}),
+	}, records)
+
+	// nothing is exported for an interval without usage
+	s.timeSource.Update(time.Unix(0, 3000))
+	s.meter.export()
+	s.Len(s.sink.records, 1)
+
+	s.meter.Add("domain1", CounterDecisions, 3)
+	s.timeSource.Update(time.Unix(0, 4000))
+	s.meter.export()
+	s.Len(s.sink.records, 2)
+	s.Equal([]*metering.UsageRecord{
+		newRecord("domain1", 3000, 4000, map[string]int64{"decisions": 3}),
+	}, s.sink.records[1])
+}
+
+func (s *meterSuite) TestExport_Failure() {
+	s.meter.Add("domain1", CounterActivities, 1)
+	s.sink.err = errors.New("sink unavailable")
+	s.timeSource.Update(time.Unix(0, 2000))
+	s.meter.export()
+	s.Empty(s.sink.records)
+
+	// records failed to export are exported with the next interval
+	s.meter.Add("domain1", CounterActivities, 2)
+	s.sink.err = nil
+	s.timeSource.Update(time.Unix(0, 3000))
+	s.meter.export()
+	s.Len(s.sink.records, 1)
+	s.Equal([]*metering.UsageRecord{
+		newRecord("domain1", 1000, 2000, map[string]int64{"activities": 1}),
+		newRecord("domain1", 2000, 3000, map[string]int64{"activities": 2}),
+	}, s.sink.records[0])
+}
+
+func (s *meterSuite) TestStop_ExportsAndClosesSink() {
+	s.meter.Start()
+	s.meter.Add("domain1", CounterESDocuments, 5)
+	s.meter.Stop()
+
+	s.True(s.sink.closed)
+	s.Len(s.sink.records, 1)
+	s.Equal(map[string]int64{"es_documents": 5}, s.sink.records[0][0].Counters)
+}
+
+func (s *meterSuite) TestFileSink() {
+	dir, err := ioutil.TempDir("", "metering")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "usage.log")
+
+	sink, err := NewFileSink(path)
+	s.NoError(err)
+	s.NoError(sink.Export([]*metering.UsageRecord{
+		newRecord("domain1", 1000, 2000, map[string]int64{"signals": 1}),
+		newRecord("domain2", 1000, 2000, map[string]int64{"signals": 2}),
+	}))
+	s.NoError(sink.Close())
+
+	// records are appended to the existing file
+	sink, err = NewFileSink(path)
+	s.NoError(err)
+	s.NoError(sink.Export([]*metering.UsageRecord{
+		newRecord("domain1", 2000, 3000, map[string]int64{"signals": 3}),
+	}))
+	s.NoError(sink.Close())
+
+	content, err := ioutil.ReadFile(path)
+	s.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	s.Len(lines, 3)
+	s.Contains(lines[2], `"domainID":"domain1"`)
+	s.Contains(lines[2], `"signals":3`)
+
+	_, err = NewFileSink("")
+	s.Equal(errMissingFilePath, err)
+}
+
+func (s *meterSuite) TestNewSink() {
+	sink, err := NewSink(config.Metering{}, nil)
+	s.NoError(err)
+	s.Nil(sink)
+
+	_, err = NewSink(config.Metering{Sink: SinkFile}, nil)
+	s.Equal(errMissingFilePath, err)
+
+	_, err = NewSink(config.Metering{Sink: SinkKafka}, nil)
+	s.Equal(errMissingMessagingClient, err)
+
+	_, err = NewSink(config.Metering{Sink: "unknown"}, nil)
+	s.Error(err)
+}
+
+func newRecord(domainID string, start, end int64, counters map[string]int64) *metering.UsageRecord {
+	serviceName := "cadence-history"
+	hostName := "host"
+	return &metering.UsageRecord{
+		DomainID:       &domainID,
+		ServiceName:    &serviceName,
+		HostName:       &hostName,
+		StartTimestamp: &start,
+		EndTimestamp:   &end,
+		Counters:       counters,
+	}
+}
+
+func sortRecords(records []*metering.UsageRecord) []*metering.UsageRecord {
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].GetDomainID() < records[j].GetDomainID()
+	})
+	return records
+}
+
+func (s *fakeSink) Export(records []*metering.UsageRecord) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, records)
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	s.closed = true
+	return nil
+}
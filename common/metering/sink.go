@@ -0,0 +1,142 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metering
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/uber/cadence/.gen/go/metering"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	fileSink struct {
+		sync.Mutex
+		file *os.File
+	}
+
+	kafkaSink struct {
+		producer messaging.Producer
+	}
+)
+
+var (
+	errMissingFilePath        = errors.New("metering file sink requires filePath")
+	errMissingMessagingClient = errors.New("metering kafka sink requires kafka to be configured")
+)
+
+// NewSink creates the sink configured for metering, nil is returned if metering is disabled
+func NewSink(
+	cfg config.Metering,
+	messagingClient messaging.Client,
+) (Sink, error) {
+
+	switch cfg.Sink {
+	case "":
+		return nil, nil
+	case SinkFile:
+		return NewFileSink(cfg.FilePath)
+	case SinkKafka:
+		if messagingClient == nil {
+			return nil, errMissingMessagingClient
+		}
+		producer, err := messagingClient.NewProducer(KafkaApplication)
+		if err != nil {
+			return nil, err
+		}
+		return NewKafkaSink(producer), nil
+	default:
+		return nil, fmt.Errorf("unknown metering sink: %v", cfg.Sink)
+	}
+}
+
+// NewFileSink creates a sink appending usage records to the file as JSON, one record per line
+func NewFileSink(
+	path string,
+) (Sink, error) {
+
+	if path == "" {
+		return nil, errMissingFilePath
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{file: file}, nil
+}
+
+// NewKafkaSink creates a sink publishing usage records with the producer, keyed by domain ID
+func NewKafkaSink(
+	producer messaging.Producer,
+) Sink {
+
+	return &kafkaSink{producer: producer}
+}
+
+func (s *fileSink) Export(
+	records []*metering.UsageRecord,
+) error {
+
+	s.Lock()
+	defer s.Unlock()
+
+	// records are written with a single write so a failed encoding does not leave partial records behind
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	_, err := s.file.Write(buffer.Bytes())
+	return err
+}
+
+func (s *fileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.file.Close()
+}
+
+func (s *kafkaSink) Export(
+	records []*metering.UsageRecord,
+) error {
+
+	messages := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		messages = append(messages, record)
+	}
+	return s.producer.PublishBatch(messages)
+}
+
+func (s *kafkaSink) Close() error {
+	if closeable, ok := s.producer.(messaging.CloseableProducer); ok {
+		return closeable.Close()
+	}
+	return nil
+}
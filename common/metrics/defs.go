@@ -52,6 +52,7 @@ const (
 	Counter MetricType = iota
 	Timer
 	Gauge
+	Histogram
 )
 
 // Service names for all services that emit metrics.
@@ -139,6 +140,8 @@ const (
 	PersistenceGetWorkflowExecutionScope
 	// PersistenceUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution calls made by service to persistence layer
 	PersistenceUpdateWorkflowExecutionScope
+	// PersistenceUpdateWorkflowExecutionBatchScope tracks UpdateWorkflowExecutionBatch calls made by service to persistence layer
+	PersistenceUpdateWorkflowExecutionBatchScope
 	// PersistenceConflictResolveWorkflowExecutionScope tracks ConflictResolveWorkflowExecution calls made by service to persistence layer
 	PersistenceConflictResolveWorkflowExecutionScope
 	// PersistenceResetWorkflowExecutionScope tracks ResetWorkflowExecution calls made by service to persistence layer
@@ -207,8 +210,12 @@ const (
 	PersistenceRecordWorkflowExecutionStartedScope
 	// PersistenceRecordWorkflowExecutionClosedScope tracks RecordWorkflowExecutionClosed calls made by service to persistence layer
 	PersistenceRecordWorkflowExecutionClosedScope
+	// PersistenceRecordWorkflowExecutionClosedBatchScope tracks RecordWorkflowExecutionClosedBatch calls made by service to persistence layer
+	PersistenceRecordWorkflowExecutionClosedBatchScope
 	// PersistenceUpsertWorkflowExecutionScope tracks UpsertWorkflowExecution calls made by service to persistence layer
 	PersistenceUpsertWorkflowExecutionScope
+	// PersistenceUpdateHistoryArchivalInfoScope tracks UpdateHistoryArchivalInfo calls made by service to persistence layer
+	PersistenceUpdateHistoryArchivalInfoScope
 	// PersistenceListOpenWorkflowExecutionsScope tracks ListOpenWorkflowExecutions calls made by service to persistence layer
 	PersistenceListOpenWorkflowExecutionsScope
 	// PersistenceListClosedWorkflowExecutionsScope tracks ListClosedWorkflowExecutions calls made by service to persistence layer
@@ -223,6 +230,8 @@ const (
 	PersistenceListClosedWorkflowExecutionsByWorkflowIDScope
 	// PersistenceListClosedWorkflowExecutionsByStatusScope tracks ListClosedWorkflowExecutionsByStatus calls made by service to persistence layer
 	PersistenceListClosedWorkflowExecutionsByStatusScope
+	// PersistenceListClosedWorkflowExecutionsByMemoScope tracks ListClosedWorkflowExecutionsByMemo calls made by service to persistence layer
+	PersistenceListClosedWorkflowExecutionsByMemoScope
 	// PersistenceGetClosedWorkflowExecutionScope tracks GetClosedWorkflowExecution calls made by service to persistence layer
 	PersistenceGetClosedWorkflowExecutionScope
 	// PersistenceVisibilityDeleteWorkflowExecutionScope is the metrics scope for persistence.VisibilityManager.DeleteWorkflowExecution
@@ -237,10 +246,18 @@ const (
 	PersistenceEnqueueMessageScope
 	// PersistenceDequeueMessagesScope tracks DequeueMessages calls made by service to persistence layer
 	PersistenceDequeueMessagesScope
+	// PersistenceUpdateAckLevelScope tracks UpdateAckLevel calls made by service to persistence layer
+	PersistenceUpdateAckLevelScope
+	// PersistenceGetAckLevelScope tracks GetAckLevel calls made by service to persistence layer
+	PersistenceGetAckLevelScope
+	// PersistenceDeleteQueueMessagesScope tracks DeleteMessagesBefore calls made by service to persistence layer
+	PersistenceDeleteQueueMessagesScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientStartWorkflowExecutionScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
 	HistoryClientRecordActivityTaskHeartbeatScope
+	// HistoryClientRecordActivityTaskHeartbeatBatchScope tracks RPC calls to history service
+	HistoryClientRecordActivityTaskHeartbeatBatchScope
 	// HistoryClientRespondDecisionTaskCompletedScope tracks RPC calls to history service
 	HistoryClientRespondDecisionTaskCompletedScope
 	// HistoryClientRespondDecisionTaskFailedScope tracks RPC calls to history service
@@ -261,16 +278,24 @@ const (
 	HistoryClientRecordDecisionTaskStartedScope
 	// HistoryClientRecordActivityTaskStartedScope tracks RPC calls to history service
 	HistoryClientRecordActivityTaskStartedScope
+	// HistoryClientRecordActivityTaskWorkerUnavailableScope tracks RPC calls to history service
+	HistoryClientRecordActivityTaskWorkerUnavailableScope
 	// HistoryClientRequestCancelWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientAddOperatorAnnotationScope tracks RPC calls to history service
+	HistoryClientAddOperatorAnnotationScope
 	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientResumeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResumeWorkflowExecutionScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -289,6 +314,18 @@ const (
 	HistoryClientGetReplicationTasksScope
 	// HistoryClientQueryWorkflowScope tracks RPC calls to history service
 	HistoryClientQueryWorkflowScope
+	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetReplicationStatusScope
+	// HistoryClientDetectZombieWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDetectZombieWorkflowExecutionScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientReindexWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientReindexWorkflowExecutionScope
+	// HistoryClientSetTerminationProtectionScope tracks RPC calls to history service
+	HistoryClientSetTerminationProtectionScope
+	// HistoryClientReplicateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientReplicateWorkflowExecutionScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -305,6 +342,8 @@ const (
 	MatchingClientCancelOutstandingPollScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
+	// MatchingClientListTaskListWorkersScope tracks RPC calls to matching service
+	MatchingClientListTaskListWorkersScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -313,6 +352,14 @@ const (
 	FrontendClientDescribeTaskListScope
 	// FrontendClientDescribeWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientDescribeWorkflowExecutionScope
+	// FrontendClientBatchDescribeWorkflowExecutionsScope tracks RPC calls to frontend service
+	FrontendClientBatchDescribeWorkflowExecutionsScope
+	// FrontendClientBatchOperationWorkflowScope tracks RPC calls to frontend service
+	FrontendClientBatchOperationWorkflowScope
+	// FrontendClientDescribeBatchOperationScope tracks RPC calls to frontend service
+	FrontendClientDescribeBatchOperationScope
+	// FrontendClientListWorkflowExecutionChainScope tracks RPC calls to frontend service
+	FrontendClientListWorkflowExecutionChainScope
 	// FrontendClientGetWorkflowExecutionHistoryScope tracks RPC calls to frontend service
 	FrontendClientGetWorkflowExecutionHistoryScope
 	// FrontendClientListArchivedWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -367,6 +414,10 @@ const (
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientResumeWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientResumeWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -377,6 +428,8 @@ const (
 	FrontendClientCountWorkflowExecutionsScope
 	// FrontendClientGetSearchAttributesScope tracks RPC calls to frontend service
 	FrontendClientGetSearchAttributesScope
+	// FrontendClientGetSearchAttributesSchemaScope tracks RPC calls to frontend service
+	FrontendClientGetSearchAttributesSchemaScope
 	// FrontendClientGetReplicationTasksScope tracks RPC calls to frontend service
 	FrontendClientGetReplicationTasksScope
 	// FrontendClientGetDomainReplicationTasksScope tracks RPC calls to frontend service
@@ -391,6 +444,36 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientCheckFailoverReadinessScope tracks RPC calls to admin service
+	AdminClientCheckFailoverReadinessScope
+	// AdminClientDescribeShardDistributionScope tracks RPC calls to admin service
+	AdminClientDescribeShardDistributionScope
+	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientDeleteWorkflowExecutionScope
+	// AdminClientReindexWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientReindexWorkflowExecutionScope
+	// AdminClientUndeprecateDomainScope tracks RPC calls to admin service
+	AdminClientUndeprecateDomainScope
+	// AdminClientSetTerminationProtectionScope tracks RPC calls to admin service
+	AdminClientSetTerminationProtectionScope
+	// AdminClientListWorkersScope tracks RPC calls to admin service
+	AdminClientListWorkersScope
+	// AdminClientDescribeDynamicConfigScope tracks RPC calls to admin service
+	AdminClientDescribeDynamicConfigScope
+	// AdminClientMigrateDomainScope tracks RPC calls to admin service
+	AdminClientMigrateDomainScope
+	// AdminClientDescribeQueueScope tracks RPC calls to admin service
+	AdminClientDescribeQueueScope
+	// AdminClientResetQueueStateScope tracks RPC calls to admin service
+	AdminClientResetQueueStateScope
+	// AdminClientAddOperatorAnnotationScope tracks RPC calls to admin service
+	AdminClientAddOperatorAnnotationScope
+	// FrontendClientCircuitBreakerScope tracks the circuit breaker of remote frontend clients
+	FrontendClientCircuitBreakerScope
+	// AdminClientCircuitBreakerScope tracks the circuit breaker of remote admin clients
+	AdminClientCircuitBreakerScope
+	// DCRedirectionRemoteClientScope tracks calls forwarded to remote clusters by dc redirection
+	DCRedirectionRemoteClientScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -399,6 +482,14 @@ const (
 	DCRedirectionDescribeTaskListScope
 	// DCRedirectionDescribeWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionDescribeWorkflowExecutionScope
+	// DCRedirectionBatchDescribeWorkflowExecutionsScope tracks RPC calls for dc redirection
+	DCRedirectionBatchDescribeWorkflowExecutionsScope
+	// DCRedirectionBatchOperationWorkflowScope tracks RPC calls for dc redirection
+	DCRedirectionBatchOperationWorkflowScope
+	// DCRedirectionDescribeBatchOperationScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeBatchOperationScope
+	// DCRedirectionListWorkflowExecutionChainScope tracks RPC calls for dc redirection
+	DCRedirectionListWorkflowExecutionChainScope
 	// DCRedirectionGetWorkflowExecutionHistoryScope tracks RPC calls for dc redirection
 	DCRedirectionGetWorkflowExecutionHistoryScope
 	// DCRedirectionListArchviedWorkflowExecutionsScope tracks RPC calls for dc redirection
@@ -417,6 +508,8 @@ const (
 	DCRedirectionCountWorkflowExecutionsScope
 	// DCRedirectionGetSearchAttributesScope tracks RPC calls for dc redirection
 	DCRedirectionGetSearchAttributesScope
+	// DCRedirectionGetSearchAttributesSchemaScope tracks RPC calls for dc redirection
+	DCRedirectionGetSearchAttributesSchemaScope
 	// DCRedirectionPollForActivityTaskScope tracks RPC calls for dc redirection
 	DCRedirectionPollForActivityTaskScope
 	// DCRedirectionPollForDecisionTaskScope tracks RPC calls for dc redirection
@@ -461,8 +554,14 @@ const (
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionResumeWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionResumeWorkflowExecutionScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
+	// DCRedirectionShadowScope tracks the target clusters evaluated by the shadow dc redirection policy
+	DCRedirectionShadowScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -496,6 +595,12 @@ const (
 	PersistenceGetHistoryTreeScope
 	// PersistenceGetAllHistoryTreeBranchesScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetAllHistoryTreeBranchesScope
+	// PersistenceQuarantineHistoryBranchScope tracks QuarantineHistoryBranch calls made by service to persistence layer
+	PersistenceQuarantineHistoryBranchScope
+	// PersistenceGetQuarantinedHistoryBranchesScope tracks GetQuarantinedHistoryBranches calls made by service to persistence layer
+	PersistenceGetQuarantinedHistoryBranchesScope
+	// PersistenceDeleteQuarantinedHistoryBranchScope tracks DeleteQuarantinedHistoryBranch calls made by service to persistence layer
+	PersistenceDeleteQuarantinedHistoryBranchScope
 
 	// ClusterMetadataArchivalConfigScope tracks ArchivalConfig calls to ClusterMetadata
 	ClusterMetadataArchivalConfigScope
@@ -504,8 +609,12 @@ const (
 	ElasticsearchRecordWorkflowExecutionStartedScope
 	// ElasticsearchRecordWorkflowExecutionClosedScope tracks RecordWorkflowExecutionClosed calls made by service to persistence layer
 	ElasticsearchRecordWorkflowExecutionClosedScope
+	// ElasticsearchRecordWorkflowExecutionClosedBatchScope tracks RecordWorkflowExecutionClosedBatch calls made by service to persistence layer
+	ElasticsearchRecordWorkflowExecutionClosedBatchScope
 	// ElasticsearchUpsertWorkflowExecutionScope tracks UpsertWorkflowExecution calls made by service to persistence layer
 	ElasticsearchUpsertWorkflowExecutionScope
+	// ElasticsearchUpdateHistoryArchivalInfoScope tracks UpdateHistoryArchivalInfo calls made by service to persistence layer
+	ElasticsearchUpdateHistoryArchivalInfoScope
 	// ElasticsearchListOpenWorkflowExecutionsScope tracks ListOpenWorkflowExecutions calls made by service to persistence layer
 	ElasticsearchListOpenWorkflowExecutionsScope
 	// ElasticsearchListClosedWorkflowExecutionsScope tracks ListClosedWorkflowExecutions calls made by service to persistence layer
@@ -520,6 +629,8 @@ const (
 	ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope
 	// ElasticsearchListClosedWorkflowExecutionsByStatusScope tracks ListClosedWorkflowExecutionsByStatus calls made by service to persistence layer
 	ElasticsearchListClosedWorkflowExecutionsByStatusScope
+	// ElasticsearchListClosedWorkflowExecutionsByMemoScope tracks ListClosedWorkflowExecutionsByMemo calls made by service to persistence layer
+	ElasticsearchListClosedWorkflowExecutionsByMemoScope
 	// ElasticsearchGetClosedWorkflowExecutionScope tracks GetClosedWorkflowExecution calls made by service to persistence layer
 	ElasticsearchGetClosedWorkflowExecutionScope
 	// ElasticsearchListWorkflowExecutionsScope tracks ListWorkflowExecutions calls made by service to persistence layer
@@ -537,6 +648,13 @@ const (
 	// HistoryArchiverScope is used by history archivers
 	HistoryArchiverScope
 
+	// OverloadControllerScope is used by the persistence overload controller
+	OverloadControllerScope
+	// MeteringScope is used by the meter exporting per domain usage records
+	MeteringScope
+	// DynamicConfigScope is used to track changes of dynamic config values
+	DynamicConfigScope
+
 	// The following metrics are only used by internal archiver implemention.
 	// TODO: move them to internal repo once cadence plugin model is in place.
 
@@ -568,6 +686,30 @@ const (
 	AdminRemoveTaskScope
 	//AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminCloseShardTaskScope
+	// AdminCheckFailoverReadinessScope is the metric scope for admin.CheckFailoverReadiness
+	AdminCheckFailoverReadinessScope
+	// AdminDescribeShardDistributionScope is the metric scope for admin.DescribeShardDistribution
+	AdminDescribeShardDistributionScope
+	// AdminDeleteWorkflowExecutionScope is the metric scope for admin.DeleteWorkflowExecution
+	AdminDeleteWorkflowExecutionScope
+	// AdminReindexWorkflowExecutionScope is the metric scope for admin.ReindexWorkflowExecution
+	AdminReindexWorkflowExecutionScope
+	// AdminUndeprecateDomainScope is the metric scope for admin.UndeprecateDomain
+	AdminUndeprecateDomainScope
+	// AdminSetTerminationProtectionScope is the metric scope for admin.SetTerminationProtection
+	AdminSetTerminationProtectionScope
+	// AdminListWorkersScope is the metric scope for admin.ListWorkers
+	AdminListWorkersScope
+	// AdminDescribeDynamicConfigScope is the metric scope for admin.DescribeDynamicConfig
+	AdminDescribeDynamicConfigScope
+	// AdminMigrateDomainScope is the metric scope for admin.MigrateDomain
+	AdminMigrateDomainScope
+	// AdminDescribeQueueScope is the metric scope for admin.DescribeQueue
+	AdminDescribeQueueScope
+	// AdminResetQueueStateScope is the metric scope for admin.ResetQueueState
+	AdminResetQueueStateScope
+	// AdminAddOperatorAnnotationScope is the metric scope for admin.AddOperatorAnnotation
+	AdminAddOperatorAnnotationScope
 
 	NumAdminScopes
 )
@@ -610,6 +752,10 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendResumeWorkflowExecutionScope is the metric scope for frontend.ResumeWorkflowExecution
+	FrontendResumeWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
@@ -634,6 +780,14 @@ const (
 	FrontendQueryWorkflowScope
 	// FrontendDescribeWorkflowExecutionScope is the metric scope for frontend.DescribeWorkflowExecution
 	FrontendDescribeWorkflowExecutionScope
+	// FrontendBatchDescribeWorkflowExecutionsScope is the metric scope for frontend.BatchDescribeWorkflowExecutions
+	FrontendBatchDescribeWorkflowExecutionsScope
+	// FrontendBatchOperationWorkflowScope is the metric scope for frontend.BatchOperationWorkflow
+	FrontendBatchOperationWorkflowScope
+	// FrontendDescribeBatchOperationScope is the metric scope for frontend.DescribeBatchOperation
+	FrontendDescribeBatchOperationScope
+	// FrontendListWorkflowExecutionChainScope is the metric scope for frontend.ListWorkflowExecutionChain
+	FrontendListWorkflowExecutionChainScope
 	// FrontendDescribeTaskListScope is the metric scope for frontend.DescribeTaskList
 	FrontendDescribeTaskListScope
 	// FrontendResetStickyTaskListScope is the metric scope for frontend.ResetStickyTaskList
@@ -644,6 +798,8 @@ const (
 	FrontendResetWorkflowExecutionScope
 	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
 	FrontendGetSearchAttributesScope
+	// FrontendGetSearchAttributesSchemaScope is the metric scope for frontend.GetSearchAttributesSchema
+	FrontendGetSearchAttributesSchemaScope
 	// FrontendGetReplicationMessagesScope is the metric scope for frontend.GetReplicationMessages
 	FrontendGetReplicationMessagesScope
 	// FrontendGetDomainReplicationMessagesScope is the metric scope for frontend.GetDomainReplicationMessages
@@ -658,6 +814,8 @@ const (
 	HistoryStartWorkflowExecutionScope = iota + NumCommonScopes
 	// HistoryRecordActivityTaskHeartbeatScope tracks RecordActivityTaskHeartbeat API calls received by service
 	HistoryRecordActivityTaskHeartbeatScope
+	// HistoryRecordActivityTaskHeartbeatBatchScope tracks RecordActivityTaskHeartbeatBatch API calls received by service
+	HistoryRecordActivityTaskHeartbeatBatchScope
 	// HistoryRespondDecisionTaskCompletedScope tracks RespondDecisionTaskCompleted API calls received by service
 	HistoryRespondDecisionTaskCompletedScope
 	// HistoryRespondDecisionTaskFailedScope tracks RespondDecisionTaskFailed API calls received by service
@@ -678,14 +836,22 @@ const (
 	HistoryRecordDecisionTaskStartedScope
 	// HistoryRecordActivityTaskStartedScope tracks RecordActivityTaskStarted API calls received by service
 	HistoryRecordActivityTaskStartedScope
+	// HistoryRecordActivityTaskWorkerUnavailableScope tracks RecordActivityTaskWorkerUnavailable API calls received by service
+	HistoryRecordActivityTaskWorkerUnavailableScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistoryAddOperatorAnnotationScope tracks AddOperatorAnnotation API calls received by service
+	HistoryAddOperatorAnnotationScope
 	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
 	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryResumeWorkflowExecutionScope tracks ResumeWorkflowExecution API calls received by service
+	HistoryResumeWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -704,6 +870,22 @@ const (
 	HistoryDescribeMutableStateScope
 	// GetReplicationMessages tracks GetReplicationMessages API calls received by service
 	HistoryGetReplicationMessagesScope
+	// HistoryGetReplicationStatusScope tracks GetReplicationStatus API calls received by service
+	HistoryGetReplicationStatusScope
+	// HistoryDetectZombieWorkflowExecutionScope tracks DetectZombieWorkflowExecution API calls received by service
+	HistoryDetectZombieWorkflowExecutionScope
+	// HistoryDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution API calls received by service
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryReindexWorkflowExecutionScope tracks ReindexWorkflowExecution API calls received by service
+	HistoryReindexWorkflowExecutionScope
+	// HistorySetTerminationProtectionScope tracks SetTerminationProtection API calls received by service
+	HistorySetTerminationProtectionScope
+	// HistoryReplicateWorkflowExecutionScope tracks ReplicateWorkflowExecution API calls received by service
+	HistoryReplicateWorkflowExecutionScope
+	// HistoryDescribeQueueScope tracks DescribeQueue API calls received by service
+	HistoryDescribeQueueScope
+	// HistoryResetQueueStateScope tracks ResetQueueState API calls received by service
+	HistoryResetQueueStateScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -768,6 +950,8 @@ const (
 	TimerActiveTaskWorkflowBackoffTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
+	// TimerActiveTaskDeleteVisibilityScope is the scope used by metric emitted by timer queue processor for processing visibility record cleanup
+	TimerActiveTaskDeleteVisibilityScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
 	TimerStandbyTaskActivityTimeoutScope
 	// TimerStandbyTaskDecisionTimeoutScope is the scope used by metric emitted by timer queue processor for processing decision timeouts
@@ -782,6 +966,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskDeleteVisibilityScope is the scope used by metric emitted by timer queue processor for processing visibility record cleanup
+	TimerStandbyTaskDeleteVisibilityScope
 	// HistoryEventNotificationScope is the scope used by shard history event nitification
 	HistoryEventNotificationScope
 	// ReplicatorQueueProcessorScope is the scope used by all metric emitted by replicator queue processor
@@ -828,6 +1014,8 @@ const (
 	HistoryProcessDeleteHistoryEventScope
 	// WorkflowCompletionStatsScope tracks workflow completion updates
 	WorkflowCompletionStatsScope
+	// WorkflowTypeLatencyStatsScope is the scope used for emitting per workflow type latency stats
+	WorkflowTypeLatencyStatsScope
 	// ArchiverClientScope is scope used by all metrics emitted by archiver.Client
 	ArchiverClientScope
 	// ReplicationTaskFetcherScope is scope used by all metrics emitted by ReplicationTaskFetcher
@@ -856,6 +1044,8 @@ const (
 	MatchingCancelOutstandingPollScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
+	// MatchingListTaskListWorkersScope tracks ListTaskListWorkers API calls received by service
+	MatchingListTaskListWorkersScope
 
 	NumMatchingScopes
 )
@@ -898,6 +1088,16 @@ const (
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// HandoverProcessorScope is scope used by all metrics emitted by worker.HandoverProcessor
+	HandoverProcessorScope
+	// VisibilityTiererScope is scope used by all metrics emitted by worker.visibility.Tierer module
+	VisibilityTiererScope
+	// ParityVerifierScope is scope used by all metrics emitted by worker.parity.Verifier module
+	ParityVerifierScope
+	// DomainMigratorScope is scope used by all metrics emitted by worker.migration.Migrator module
+	DomainMigratorScope
+	// ExecutionsReporterScope is scope used by all metrics emitted by worker.executions.Reporter module
+	ExecutionsReporterScope
 
 	NumWorkerScopes
 )
@@ -912,6 +1112,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCreateWorkflowExecutionScope:                  {operation: "CreateWorkflowExecution"},
 		PersistenceGetWorkflowExecutionScope:                     {operation: "GetWorkflowExecution"},
 		PersistenceUpdateWorkflowExecutionScope:                  {operation: "UpdateWorkflowExecution"},
+		PersistenceUpdateWorkflowExecutionBatchScope:             {operation: "UpdateWorkflowExecutionBatch"},
 		PersistenceConflictResolveWorkflowExecutionScope:         {operation: "ConflictResolveWorkflowExecution"},
 		PersistenceResetWorkflowExecutionScope:                   {operation: "ResetWorkflowExecution"},
 		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
@@ -946,7 +1147,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetMetadataScope:                              {operation: "GetMetadata"},
 		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
 		PersistenceRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
+		PersistenceRecordWorkflowExecutionClosedBatchScope:       {operation: "RecordWorkflowExecutionClosedBatch"},
 		PersistenceUpsertWorkflowExecutionScope:                  {operation: "UpsertWorkflowExecution"},
+		PersistenceUpdateHistoryArchivalInfoScope:                {operation: "UpdateHistoryArchivalInfo"},
 		PersistenceListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
 		PersistenceListClosedWorkflowExecutionsScope:             {operation: "ListClosedWorkflowExecutions"},
 		PersistenceListOpenWorkflowExecutionsByTypeScope:         {operation: "ListOpenWorkflowExecutionsByType"},
@@ -954,6 +1157,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceListOpenWorkflowExecutionsByWorkflowIDScope:   {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
 		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
 		PersistenceListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
+		PersistenceListClosedWorkflowExecutionsByMemoScope:       {operation: "ListClosedWorkflowExecutionsByMemo"},
 		PersistenceGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
 		PersistenceVisibilityDeleteWorkflowExecutionScope:        {operation: "VisibilityDeleteWorkflowExecution"},
 		PersistenceListWorkflowExecutionsScope:                   {operation: "ListWorkflowExecutions"},
@@ -966,124 +1170,172 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree"},
 		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches"},
+		PersistenceQuarantineHistoryBranchScope:                  {operation: "QuarantineHistoryBranch"},
+		PersistenceGetQuarantinedHistoryBranchesScope:            {operation: "GetQuarantinedHistoryBranches"},
+		PersistenceDeleteQuarantinedHistoryBranchScope:           {operation: "DeleteQuarantinedHistoryBranch"},
 		PersistenceEnqueueMessageScope:                           {operation: "EnqueueMessage"},
 		PersistenceDequeueMessagesScope:                          {operation: "DequeueMessages"},
+		PersistenceUpdateAckLevelScope:                           {operation: "UpdateAckLevel"},
+		PersistenceGetAckLevelScope:                              {operation: "GetAckLevel"},
+		PersistenceDeleteQueueMessagesScope:                      {operation: "DeleteQueueMessages"},
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
-		HistoryClientStartWorkflowExecutionScope:            {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRecordActivityTaskHeartbeatScope:       {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRespondDecisionTaskCompletedScope:      {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRespondDecisionTaskFailedScope:         {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRespondActivityTaskCompletedScope:      {operation: "HistoryClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRespondActivityTaskFailedScope:         {operation: "HistoryClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRespondActivityTaskCanceledScope:       {operation: "HistoryClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientGetMutableStateScope:                   {operation: "HistoryClientGetMutableState", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientResetStickyTaskListScope:               {operation: "HistoryClientResetStickyTaskListScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientDescribeWorkflowExecutionScope:         {operation: "HistoryClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRecordDecisionTaskStartedScope:         {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRecordActivityTaskStartedScope:         {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRequestCancelWorkflowExecutionScope:    {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientSignalWorkflowExecutionScope:           {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientReplicateEventsScope:                   {operation: "HistoryClientReplicateEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientReplicateRawEventsScope:                {operation: "HistoryClientReplicateRawEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientGetReplicationTasksScope:               {operation: "HistoryClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflowScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
-		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientAddDecisionTaskScope:                  {operation: "MatchingClientAddDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientQueryWorkflowScope:                    {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientRespondQueryTaskCompletedScope:        {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientCancelOutstandingPollScope:            {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		MatchingClientDescribeTaskListScope:                 {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
-		FrontendClientDeprecateDomainScope:                  {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientDescribeDomainScope:                   {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientDescribeTaskListScope:                 {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientDescribeWorkflowExecutionScope:        {operation: "FrontendClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientGetWorkflowExecutionHistoryScope:      {operation: "FrontendClientGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientListArchivedWorkflowExecutionsScope:   {operation: "FrontendClientListArchivedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientListClosedWorkflowExecutionsScope:     {operation: "FrontendClientListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientListDomainsScope:                      {operation: "FrontendClientListDomains", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientListOpenWorkflowExecutionsScope:       {operation: "FrontendClientListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientPollForActivityTaskScope:              {operation: "FrontendClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientPollForDecisionTaskScope:              {operation: "FrontendClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientQueryWorkflowScope:                    {operation: "FrontendClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRecordActivityTaskHeartbeatScope:      {operation: "FrontendClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRecordActivityTaskHeartbeatByIDScope:  {operation: "FrontendClientRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRegisterDomainScope:                   {operation: "FrontendClientRegisterDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRequestCancelWorkflowExecutionScope:   {operation: "FrontendClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientResetStickyTaskListScope:              {operation: "FrontendClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientResetWorkflowExecutionScope:           {operation: "FrontendClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondActivityTaskCanceledScope:      {operation: "FrontendClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondActivityTaskCanceledByIDScope:  {operation: "FrontendClientRespondActivityTaskCanceledByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondActivityTaskCompletedScope:     {operation: "FrontendClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondActivityTaskCompletedByIDScope: {operation: "FrontendClientRespondActivityTaskCompletedByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondActivityTaskFailedScope:        {operation: "FrontendClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondActivityTaskFailedByIDScope:    {operation: "FrontendClientRespondActivityTaskFailedByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondDecisionTaskCompletedScope:     {operation: "FrontendClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondDecisionTaskFailedScope:        {operation: "FrontendClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientRespondQueryTaskCompletedScope:        {operation: "FrontendClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientSignalWithStartWorkflowExecutionScope: {operation: "FrontendClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientSignalWorkflowExecutionScope:          {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientStartWorkflowExecutionScope:           {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientTerminateWorkflowExecutionScope:       {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientUpdateDomainScope:                     {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientListWorkflowExecutionsScope:           {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientScanWorkflowExecutionsScope:           {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientCountWorkflowExecutionsScope:          {operation: "FrontendClientCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientGetSearchAttributesScope:              {operation: "FrontendClientGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientGetReplicationTasksScope:              {operation: "FrontendClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		FrontendClientGetDomainReplicationTasksScope:        {operation: "FrontendClientGetDomainReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
-		AdminClientAddSearchAttributeScope:                  {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
-		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
-		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
-		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
-		AdminClientCloseShardScope:                          {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
-		DCRedirectionDeprecateDomainScope:                   {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionDescribeDomainScope:                    {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionDescribeTaskListScope:                  {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionDescribeWorkflowExecutionScope:         {operation: "DCRedirectionDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionGetWorkflowExecutionHistoryScope:       {operation: "DCRedirectionGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionListArchviedWorkflowExecutionsScope:    {operation: "DCRedirectionListArchivedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionListClosedWorkflowExecutionsScope:      {operation: "DCRedirectionListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionListDomainsScope:                       {operation: "DCRedirectionListDomains", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionListOpenWorkflowExecutionsScope:        {operation: "DCRedirectionListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionListWorkflowExecutionsScope:            {operation: "DCRedirectionListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionScanWorkflowExecutionsScope:            {operation: "DCRedirectionScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionCountWorkflowExecutionsScope:           {operation: "DCRedirectionCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionGetSearchAttributesScope:               {operation: "DCRedirectionGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionPollForActivityTaskScope:               {operation: "DCRedirectionPollForActivityTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionPollForDecisionTaskScope:               {operation: "DCRedirectionPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionQueryWorkflowScope:                     {operation: "DCRedirectionQueryWorkflow", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRecordActivityTaskHeartbeatScope:       {operation: "DCRedirectionRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRecordActivityTaskHeartbeatByIDScope:   {operation: "DCRedirectionRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRegisterDomainScope:                    {operation: "DCRedirectionRegisterDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRequestCancelWorkflowExecutionScope:    {operation: "DCRedirectionRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionResetStickyTaskListScope:               {operation: "DCRedirectionResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionResetWorkflowExecutionScope:            {operation: "DCRedirectionResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondActivityTaskCanceledScope:       {operation: "DCRedirectionRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondActivityTaskCanceledByIDScope:   {operation: "DCRedirectionRespondActivityTaskCanceledByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondActivityTaskCompletedScope:      {operation: "DCRedirectionRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondActivityTaskCompletedByIDScope:  {operation: "DCRedirectionRespondActivityTaskCompletedByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondActivityTaskFailedScope:         {operation: "DCRedirectionRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondActivityTaskFailedByIDScope:     {operation: "DCRedirectionRespondActivityTaskFailedByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondDecisionTaskCompletedScope:      {operation: "DCRedirectionRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondDecisionTaskFailedScope:         {operation: "DCRedirectionRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionRespondQueryTaskCompletedScope:         {operation: "DCRedirectionRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionSignalWithStartWorkflowExecutionScope:  {operation: "DCRedirectionSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionSignalWorkflowExecutionScope:           {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionStartWorkflowExecutionScope:            {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionTerminateWorkflowExecutionScope:        {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
-		DCRedirectionUpdateDomainScope:                      {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		HistoryClientStartWorkflowExecutionScope:              {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRecordActivityTaskHeartbeatScope:         {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRecordActivityTaskHeartbeatBatchScope:    {operation: "HistoryClientRecordActivityTaskHeartbeatBatch", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRespondDecisionTaskCompletedScope:        {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRespondDecisionTaskFailedScope:           {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRespondActivityTaskCompletedScope:        {operation: "HistoryClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRespondActivityTaskFailedScope:           {operation: "HistoryClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRespondActivityTaskCanceledScope:         {operation: "HistoryClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetMutableStateScope:                     {operation: "HistoryClientGetMutableState", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResetStickyTaskListScope:                 {operation: "HistoryClientResetStickyTaskListScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeWorkflowExecutionScope:           {operation: "HistoryClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRecordDecisionTaskStartedScope:           {operation: "HistoryClientRecordDecisionTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRecordActivityTaskStartedScope:           {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRecordActivityTaskWorkerUnavailableScope: {operation: "HistoryClientRecordActivityTaskWorkerUnavailable", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRequestCancelWorkflowExecutionScope:      {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientSignalWorkflowExecutionScope:             {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientAddOperatorAnnotationScope:               {operation: "HistoryClientAddOperatorAnnotation", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientSignalWithStartWorkflowExecutionScope:    {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRemoveSignalMutableStateScope:            {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientTerminateWorkflowExecutionScope:          {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResumeWorkflowExecutionScope:             {operation: "HistoryClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResetWorkflowExecutionScope:              {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientScheduleDecisionTaskScope:                {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRecordChildExecutionCompletedScope:       {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReplicateEventsScope:                     {operation: "HistoryClientReplicateEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReplicateRawEventsScope:                  {operation: "HistoryClientReplicateRawEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientSyncShardStatusScope:                     {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientSyncActivityScope:                        {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationTasksScope:                 {operation: "HistoryClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientQueryWorkflowScope:                       {operation: "HistoryClientQueryWorkflowScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationStatusScope:                {operation: "HistoryClientGetReplicationStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDetectZombieWorkflowExecutionScope:       {operation: "HistoryClientDetectZombieWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:             {operation: "HistoryClientDeleteWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReindexWorkflowExecutionScope:            {operation: "HistoryClientReindexWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientSetTerminationProtectionScope:            {operation: "HistoryClientSetTerminationProtectionScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReplicateWorkflowExecutionScope:          {operation: "HistoryClientReplicateWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		MatchingClientPollForDecisionTaskScope:                {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientPollForActivityTaskScope:                {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientAddDecisionTaskScope:                    {operation: "MatchingClientAddDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientQueryWorkflowScope:                      {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientRespondQueryTaskCompletedScope:          {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientListTaskListWorkersScope:                {operation: "MatchingClientListTaskListWorkers", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDescribeWorkflowExecutionScope:          {operation: "FrontendClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientBatchDescribeWorkflowExecutionsScope:    {operation: "FrontendClientBatchDescribeWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientBatchOperationWorkflowScope:             {operation: "FrontendClientBatchOperationWorkflow", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDescribeBatchOperationScope:             {operation: "FrontendClientDescribeBatchOperation", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListWorkflowExecutionChainScope:         {operation: "FrontendClientListWorkflowExecutionChain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientGetWorkflowExecutionHistoryScope:        {operation: "FrontendClientGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListArchivedWorkflowExecutionsScope:     {operation: "FrontendClientListArchivedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListClosedWorkflowExecutionsScope:       {operation: "FrontendClientListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListDomainsScope:                        {operation: "FrontendClientListDomains", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListOpenWorkflowExecutionsScope:         {operation: "FrontendClientListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPollForActivityTaskScope:                {operation: "FrontendClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPollForDecisionTaskScope:                {operation: "FrontendClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientQueryWorkflowScope:                      {operation: "FrontendClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRecordActivityTaskHeartbeatScope:        {operation: "FrontendClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRecordActivityTaskHeartbeatByIDScope:    {operation: "FrontendClientRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRegisterDomainScope:                     {operation: "FrontendClientRegisterDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRequestCancelWorkflowExecutionScope:     {operation: "FrontendClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientResetStickyTaskListScope:                {operation: "FrontendClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientResetWorkflowExecutionScope:             {operation: "FrontendClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondActivityTaskCanceledScope:        {operation: "FrontendClientRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondActivityTaskCanceledByIDScope:    {operation: "FrontendClientRespondActivityTaskCanceledByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondActivityTaskCompletedScope:       {operation: "FrontendClientRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondActivityTaskCompletedByIDScope:   {operation: "FrontendClientRespondActivityTaskCompletedByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondActivityTaskFailedScope:          {operation: "FrontendClientRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondActivityTaskFailedByIDScope:      {operation: "FrontendClientRespondActivityTaskFailedByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondDecisionTaskCompletedScope:       {operation: "FrontendClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondDecisionTaskFailedScope:          {operation: "FrontendClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientRespondQueryTaskCompletedScope:          {operation: "FrontendClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientSignalWithStartWorkflowExecutionScope:   {operation: "FrontendClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientSignalWorkflowExecutionScope:            {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientStartWorkflowExecutionScope:             {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientTerminateWorkflowExecutionScope:         {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:             {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientResumeWorkflowExecutionScope:            {operation: "FrontendClientResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUpdateDomainScope:                       {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListWorkflowExecutionsScope:             {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientScanWorkflowExecutionsScope:             {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientCountWorkflowExecutionsScope:            {operation: "FrontendClientCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientGetSearchAttributesScope:                {operation: "FrontendClientGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientGetSearchAttributesSchemaScope:          {operation: "FrontendClientGetSearchAttributesSchema", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientGetReplicationTasksScope:                {operation: "FrontendClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientGetDomainReplicationTasksScope:          {operation: "FrontendClientGetDomainReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		AdminClientAddSearchAttributeScope:                    {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeHistoryHostScope:                   {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeWorkflowExecutionScope:             {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetWorkflowExecutionRawHistoryScope:        {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientCheckFailoverReadinessScope:                {operation: "AdminClientCheckFailoverReadiness", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeShardDistributionScope:             {operation: "AdminClientDescribeShardDistribution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteWorkflowExecutionScope:               {operation: "AdminClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReindexWorkflowExecutionScope:              {operation: "AdminClientReindexWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUndeprecateDomainScope:                     {operation: "AdminClientUndeprecateDomain", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientSetTerminationProtectionScope:              {operation: "AdminClientSetTerminationProtection", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListWorkersScope:                           {operation: "AdminClientListWorkers", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeDynamicConfigScope:                 {operation: "AdminClientDescribeDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientMigrateDomainScope:                         {operation: "AdminClientMigrateDomain", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeQueueScope:                         {operation: "AdminClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientResetQueueStateScope:                       {operation: "AdminClientResetQueueState", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientAddOperatorAnnotationScope:                 {operation: "AdminClientAddOperatorAnnotation", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		FrontendClientCircuitBreakerScope:                     {operation: "FrontendClientCircuitBreaker", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		AdminClientCircuitBreakerScope:                        {operation: "AdminClientCircuitBreaker", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		DCRedirectionRemoteClientScope:                        {operation: "DCRedirectionRemoteClient", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeprecateDomainScope:                     {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeDomainScope:                      {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeTaskListScope:                    {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeWorkflowExecutionScope:           {operation: "DCRedirectionDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionBatchDescribeWorkflowExecutionsScope:     {operation: "DCRedirectionBatchDescribeWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionBatchOperationWorkflowScope:              {operation: "DCRedirectionBatchOperationWorkflow", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeBatchOperationScope:              {operation: "DCRedirectionDescribeBatchOperation", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListWorkflowExecutionChainScope:          {operation: "DCRedirectionListWorkflowExecutionChain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionGetWorkflowExecutionHistoryScope:         {operation: "DCRedirectionGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListArchviedWorkflowExecutionsScope:      {operation: "DCRedirectionListArchivedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListClosedWorkflowExecutionsScope:        {operation: "DCRedirectionListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListDomainsScope:                         {operation: "DCRedirectionListDomains", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListOpenWorkflowExecutionsScope:          {operation: "DCRedirectionListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListWorkflowExecutionsScope:              {operation: "DCRedirectionListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionScanWorkflowExecutionsScope:              {operation: "DCRedirectionScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCountWorkflowExecutionsScope:             {operation: "DCRedirectionCountWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionGetSearchAttributesScope:                 {operation: "DCRedirectionGetSearchAttributes", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionGetSearchAttributesSchemaScope:           {operation: "DCRedirectionGetSearchAttributesSchema", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPollForActivityTaskScope:                 {operation: "DCRedirectionPollForActivityTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPollForDecisionTaskScope:                 {operation: "DCRedirectionPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionQueryWorkflowScope:                       {operation: "DCRedirectionQueryWorkflow", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRecordActivityTaskHeartbeatScope:         {operation: "DCRedirectionRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRecordActivityTaskHeartbeatByIDScope:     {operation: "DCRedirectionRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRegisterDomainScope:                      {operation: "DCRedirectionRegisterDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRequestCancelWorkflowExecutionScope:      {operation: "DCRedirectionRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetStickyTaskListScope:                 {operation: "DCRedirectionResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetWorkflowExecutionScope:              {operation: "DCRedirectionResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondActivityTaskCanceledScope:         {operation: "DCRedirectionRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondActivityTaskCanceledByIDScope:     {operation: "DCRedirectionRespondActivityTaskCanceledByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondActivityTaskCompletedScope:        {operation: "DCRedirectionRespondActivityTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondActivityTaskCompletedByIDScope:    {operation: "DCRedirectionRespondActivityTaskCompletedByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondActivityTaskFailedScope:           {operation: "DCRedirectionRespondActivityTaskFailed", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondActivityTaskFailedByIDScope:       {operation: "DCRedirectionRespondActivityTaskFailedByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondDecisionTaskCompletedScope:        {operation: "DCRedirectionRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondDecisionTaskFailedScope:           {operation: "DCRedirectionRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRespondQueryTaskCompletedScope:           {operation: "DCRedirectionRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionSignalWithStartWorkflowExecutionScope:    {operation: "DCRedirectionSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionSignalWorkflowExecutionScope:             {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionStartWorkflowExecutionScope:              {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionTerminateWorkflowExecutionScope:          {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:              {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResumeWorkflowExecutionScope:             {operation: "DCRedirectionResumeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateDomainScope:                        {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionShadowScope:                              {operation: "DCRedirectionShadow", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -1097,7 +1349,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 
 		ElasticsearchRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
 		ElasticsearchRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
+		ElasticsearchRecordWorkflowExecutionClosedBatchScope:       {operation: "RecordWorkflowExecutionClosedBatch"},
 		ElasticsearchUpsertWorkflowExecutionScope:                  {operation: "UpsertWorkflowExecution"},
+		ElasticsearchUpdateHistoryArchivalInfoScope:                {operation: "UpdateHistoryArchivalInfo"},
 		ElasticsearchListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
 		ElasticsearchListClosedWorkflowExecutionsScope:             {operation: "ListClosedWorkflowExecutions"},
 		ElasticsearchListOpenWorkflowExecutionsByTypeScope:         {operation: "ListOpenWorkflowExecutionsByType"},
@@ -1105,6 +1359,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope:   {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
 		ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
 		ElasticsearchListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
+		ElasticsearchListClosedWorkflowExecutionsByMemoScope:       {operation: "ListClosedWorkflowExecutionsByMemo"},
 		ElasticsearchGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
 		ElasticsearchListWorkflowExecutionsScope:                   {operation: "ListWorkflowExecutions"},
 		ElasticsearchScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
@@ -1114,6 +1369,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 
 		HistoryArchiverScope: {operation: "HistoryArchiver"},
 
+		OverloadControllerScope: {operation: "OverloadController"},
+		MeteringScope:           {operation: "Metering"},
+		DynamicConfigScope:      {operation: "DynamicConfig"},
+
 		BlobstoreClientUploadScope:          {operation: "BlobstoreClientUpload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDownloadScope:        {operation: "BlobstoreClientDownload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientGetMetadataScope:     {operation: "BlobstoreClientGetMetadata", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
@@ -1129,6 +1388,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminCheckFailoverReadinessScope:         {operation: "CheckFailoverReadiness"},
+		AdminDescribeShardDistributionScope:      {operation: "DescribeShardDistribution"},
+		AdminDeleteWorkflowExecutionScope:        {operation: "DeleteWorkflowExecution"},
+		AdminReindexWorkflowExecutionScope:       {operation: "ReindexWorkflowExecution"},
+		AdminUndeprecateDomainScope:              {operation: "UndeprecateDomain"},
+		AdminSetTerminationProtectionScope:       {operation: "SetTerminationProtection"},
+		AdminListWorkersScope:                    {operation: "ListWorkers"},
+		AdminDescribeDynamicConfigScope:          {operation: "DescribeDynamicConfig"},
+		AdminMigrateDomainScope:                  {operation: "MigrateDomain"},
+		AdminDescribeQueueScope:                  {operation: "DescribeQueue"},
+		AdminResetQueueStateScope:                {operation: "ResetQueueState"},
+		AdminAddOperatorAnnotationScope:          {operation: "AddOperatorAnnotation"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -1148,6 +1419,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		FrontendResumeWorkflowExecutionScope:          {operation: "ResumeWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
@@ -1162,9 +1435,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDeprecateDomainScope:                  {operation: "DeprecateDomain"},
 		FrontendQueryWorkflowScope:                    {operation: "QueryWorkflow"},
 		FrontendDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
+		FrontendBatchDescribeWorkflowExecutionsScope:  {operation: "BatchDescribeWorkflowExecutions"},
+		FrontendBatchOperationWorkflowScope:           {operation: "BatchOperationWorkflow"},
+		FrontendDescribeBatchOperationScope:           {operation: "DescribeBatchOperation"},
+		FrontendListWorkflowExecutionChainScope:       {operation: "ListWorkflowExecutionChain"},
 		FrontendDescribeTaskListScope:                 {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:              {operation: "ResetStickyTaskList"},
 		FrontendGetSearchAttributesScope:              {operation: "GetSearchAttributes"},
+		FrontendGetSearchAttributesSchemaScope:        {operation: "GetSearchAttributesSchema"},
 		FrontendGetReplicationMessagesScope:           {operation: "GetReplicationMessages"},
 		FrontendGetDomainReplicationMessagesScope:     {operation: "GetDomainReplicationMessages"},
 	},
@@ -1172,6 +1450,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	History: {
 		HistoryStartWorkflowExecutionScope:                     {operation: "StartWorkflowExecution"},
 		HistoryRecordActivityTaskHeartbeatScope:                {operation: "RecordActivityTaskHeartbeat"},
+		HistoryRecordActivityTaskHeartbeatBatchScope:           {operation: "RecordActivityTaskHeartbeatBatch"},
 		HistoryRespondDecisionTaskCompletedScope:               {operation: "RespondDecisionTaskCompleted"},
 		HistoryRespondDecisionTaskFailedScope:                  {operation: "RespondDecisionTaskFailed"},
 		HistoryRespondActivityTaskCompletedScope:               {operation: "RespondActivityTaskCompleted"},
@@ -1182,10 +1461,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryDescribeWorkflowExecutionScope:                  {operation: "DescribeWorkflowExecution"},
 		HistoryRecordDecisionTaskStartedScope:                  {operation: "RecordDecisionTaskStarted"},
 		HistoryRecordActivityTaskStartedScope:                  {operation: "RecordActivityTaskStarted"},
+		HistoryRecordActivityTaskWorkerUnavailableScope:        {operation: "RecordActivityTaskWorkerUnavailable"},
 		HistorySignalWorkflowExecutionScope:                    {operation: "SignalWorkflowExecution"},
+		HistoryAddOperatorAnnotationScope:                      {operation: "AddOperatorAnnotation"},
 		HistorySignalWithStartWorkflowExecutionScope:           {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                   {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                 {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryResumeWorkflowExecutionScope:                    {operation: "ResumeWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                     {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                              {operation: "QueryWorkflow"},
 		HistoryProcessDeleteHistoryEventScope:                  {operation: "ProcessDeleteHistoryEvent"},
@@ -1198,6 +1481,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySyncActivityScope:                               {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:                       {operation: "DescribeMutableState"},
 		HistoryGetReplicationMessagesScope:                     {operation: "GetReplicationMessages"},
+		HistoryGetReplicationStatusScope:                       {operation: "GetReplicationStatus"},
+		HistoryDetectZombieWorkflowExecutionScope:              {operation: "DetectZombieWorkflowExecution"},
+		HistoryDeleteWorkflowExecutionScope:                    {operation: "DeleteWorkflowExecution"},
+		HistoryReindexWorkflowExecutionScope:                   {operation: "ReindexWorkflowExecution"},
+		HistorySetTerminationProtectionScope:                   {operation: "SetTerminationProtection"},
+		HistoryReplicateWorkflowExecutionScope:                 {operation: "ReplicateWorkflowExecution"},
+		HistoryDescribeQueueScope:                              {operation: "DescribeQueue"},
+		HistoryResetQueueStateScope:                            {operation: "ResetQueueState"},
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1230,6 +1521,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerActiveTaskActivityRetryTimerScope:                 {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:               {operation: "TimerActiveTaskWorkflowBackoffTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:                 {operation: "TimerActiveTaskDeleteHistoryEvent"},
+		TimerActiveTaskDeleteVisibilityScope:                   {operation: "TimerActiveTaskDeleteVisibility"},
 		TimerStandbyTaskActivityTimeoutScope:                   {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:                   {operation: "TimerStandbyTaskDecisionTimeout"},
 		TimerStandbyTaskUserTimerScope:                         {operation: "TimerStandbyTaskUserTimer"},
@@ -1237,6 +1529,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerStandbyTaskActivityRetryTimerScope:                {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:              {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:                {operation: "TimerStandbyTaskDeleteHistoryEvent"},
+		TimerStandbyTaskDeleteVisibilityScope:                  {operation: "TimerStandbyTaskDeleteVisibility"},
 		HistoryEventNotificationScope:                          {operation: "HistoryEventNotification"},
 		ReplicatorQueueProcessorScope:                          {operation: "ReplicatorQueueProcessor"},
 		ReplicatorTaskHistoryScope:                             {operation: "ReplicatorTaskHistory"},
@@ -1257,6 +1550,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		SessionSizeStatsScope:                                  {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: SizeStatsTypeTagValue}},
 		SessionCountStatsScope:                                 {operation: "SessionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		WorkflowCompletionStatsScope:                           {operation: "CompletionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
+		WorkflowTypeLatencyStatsScope:                          {operation: "WorkflowTypeLatencyStats"},
 		ArchiverClientScope:                                    {operation: "ArchiverClient"},
 		ReplicationTaskFetcherScope:                            {operation: "ReplicationTaskFetcher"},
 	},
@@ -1271,6 +1565,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
+		MatchingListTaskListWorkersScope:       {operation: "ListTaskListWorkers"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1290,8 +1585,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ArchiverArchivalWorkflowScope:          {operation: "ArchiverArchivalWorkflow"},
 		TaskListScavengerScope:                 {operation: "tasklistscavenger"},
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
+		VisibilityTiererScope:                  {operation: "visibilitytierer"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		HandoverProcessorScope:                 {operation: "HandoverProcessor"},
+		ParityVerifierScope:                    {operation: "parityverifier"},
+		DomainMigratorScope:                    {operation: "domainmigrator"},
+		ExecutionsReporterScope:                {operation: "executionsreporter"},
 	},
 }
 
@@ -1314,6 +1614,9 @@ const (
 	CadenceErrRetryTaskCounter
 	CadenceErrBadBinaryCounter
 	CadenceErrClientVersionNotSupportedCounter
+	CadenceErrAccessDeniedCounter
+	CadenceErrPreconditionFailedCounter
+	CadenceErrTaskDispatchFailedCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -1327,21 +1630,42 @@ const (
 	PersistenceErrExecutionAlreadyStartedCounter
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
+	PersistenceErrDataLossCounter
+	PersistenceHistoryCorruptionCounter
 	PersistenceSampledCounter
+	PersistenceRetries
+	PersistencePayloadSize
 
 	CadenceClientRequests
 	CadenceClientFailures
 	CadenceClientLatency
+	CadenceClientCircuitBreakerOpened
+	CadenceClientCircuitBreakerRejected
 
 	CadenceDcRedirectionClientRequests
 	CadenceDcRedirectionClientFailures
 	CadenceDcRedirectionClientLatency
+	CadenceDcRedirectionClientRetries
+	CadenceDcRedirectionClientTranslatedErrors
+	CadenceDcRedirectionShadowRequests
+	CadenceDcRedirectionShadowRedirects
+	CadenceDcRedirectionShadowFailures
 
 	DomainCachePrepareCallbacksLatency
 	DomainCacheCallbacksLatency
+	DomainCacheRefreshLatency
+	DomainCacheLookupCounter
+	DomainCacheHitCounter
+	DomainCacheMissCounter
+	DomainCacheEntriesGauge
+	DomainCacheMemoryUsageGauge
+	DomainCacheEvictionCounter
 
 	HistorySize
 	HistoryCount
+	ActivityPayloadSize
+	SignalEventCount
+	TimerCount
 	EventBlobSize
 
 	ArchivalConfigFailures
@@ -1380,6 +1704,16 @@ const (
 	MatchingClientForwardedCounter
 	MatchingClientInvalidTaskListName
 
+	PersistenceHealthScore
+	LoadSheddingCounter
+
+	MeteringExportedRecords
+	MeteringExportFailures
+	MeteringExportLatency
+	MeteringDroppedRecords
+
+	DynamicConfigChangesCounter
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -1393,9 +1727,16 @@ const (
 	TaskStandbyRetryCounter
 	TaskNotActiveCounter
 	TaskLimitExceededCounter
+	TaskDomainPausedCounter
+	TaskDispatchRateLimitedCounter
+	TaskDispatchDrainedCounter
+	TaskDispatchNoPollersCounter
 	TaskBatchCompleteCounter
 	TaskProcessingLatency
 	TaskQueueLatency
+	TaskSkipped
+	TaskDropped
+	TaskRegenerated
 
 	AckLevelUpdateCounter
 	AckLevelUpdateFailedCounter
@@ -1412,6 +1753,9 @@ const (
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
+	LocalActivityMarkerCounter
+	LocalActivityMarkerSize
+	LocalActivityMarkerLimitExceededCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
 	StaleMutableStateCounter
@@ -1420,12 +1764,22 @@ const (
 	ConcurrencyUpdateFailureCounter
 	CadenceErrEventAlreadyStartedCounter
 	CadenceErrShardOwnershipLostCounter
+	CadenceErrIncompatibleBinaryChecksumCounter
 	HeartbeatTimeoutCounter
 	ScheduleToStartTimeoutCounter
 	StartToCloseTimeoutCounter
 	ScheduleToCloseTimeoutCounter
+	ScheduleToStartTimeoutNoPollersCounter
+	ActivityScheduleToStartLatency
+	ActivityStartToCloseLatency
+	ActivityEndToEndLatency
+	DecisionScheduleToStartLatency
+	DecisionStartToCloseLatency
+	WorkflowEndToEndLatency
 	NewTimerCounter
 	NewTimerNotifyCounter
+	TimerTaskFireLatency
+	TimerWheelFiredCounter
 	AcquireShardsCounter
 	AcquireShardsLatency
 	ShardClosedCounter
@@ -1443,6 +1797,7 @@ const (
 	ShardInfoTimerDiffTimer
 	ShardInfoTransferFailoverInProgressTimer
 	ShardInfoTimerFailoverInProgressTimer
+	ShardInfoClockSkewTimer
 	ShardInfoTransferFailoverLatencyTimer
 	ShardInfoTimerFailoverLatencyTimer
 	MembershipChangedCounter
@@ -1464,6 +1819,7 @@ const (
 	BufferReplicationTaskTimer
 	UnbufferReplicationTaskTimer
 	HistoryConflictsCounter
+	HistoryZombieWorkflowCounter
 	CompleteTaskFailedCounter
 	CacheRequests
 	CacheFailures
@@ -1471,6 +1827,9 @@ const (
 	CacheMissCounter
 	AcquireLockFailedCounter
 	WorkflowContextCleared
+	StaleVersionWriteCounter
+	FutureVersionWriteCounter
+	ActiveWriteOnPassiveDomainCounter
 	MutableStateSize
 	ExecutionInfoSize
 	ActivityInfoSize
@@ -1512,6 +1871,8 @@ const (
 	ReplicationTasksLag
 	ReplicationTasksFetched
 	ReplicationTasksReturned
+	ReplicationTasksBatchLatency
+	ReplicationTaskExecutionGroups
 	GetReplicationMessagesForShardLatency
 	ArchiveVisibilityAttemptCount
 	ArchiveVisibilityFailedCount
@@ -1547,6 +1908,17 @@ const (
 	LocalToRemoteMatchCounter
 	RemoteToLocalMatchCounter
 	RemoteToRemoteMatchCounter
+	WorkerUnavailableTaskCounter
+	AffinityMatchCounter
+	DrainedPollCounter
+	TaskListDrainedGauge
+	StaleWorkersOnlyTaskCounter
+	PartitionUpscaleCounter
+	PartitionDownscaleCounter
+	PartitionUpdateFailedCounter
+	EstimatedAddTaskQPSGauge
+	TaskListReadPartitionsGauge
+	TaskListWritePartitionsGauge
 
 	NumMatchingMetrics
 )
@@ -1564,6 +1936,7 @@ const (
 	ESProcessorProcessMsgLatency
 	IndexProcessorCorruptedData
 	IndexProcessorProcessMsgLatency
+	IndexProcessorRolloverIndexDeleted
 	ArchiverNonRetryableErrorCount
 	ArchiverStartedCount
 	ArchiverStoppedCount
@@ -1604,12 +1977,31 @@ const (
 	HistoryScavengerSuccessCount
 	HistoryScavengerErrorCount
 	HistoryScavengerSkipCount
+	HistoryScavengerZombieCount
+	HistoryScavengerQuarantinedCount
+	HistoryScavengerQuarantineClearedCount
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
+	HandoverDomainsCount
+	HandoverCompletedCount
+	HandoverFailures
+	VisibilityTiererArchivedCount
+	VisibilityTiererErrorCount
+	VisibilityTiererSkipCount
+	ParityVerifiedCount
+	ParityMismatchCount
+	ParityVerifierFailures
+	DomainMigrationReplicatedCount
+	DomainMigrationFailures
+	ExecutionsReporterScannedCount
+	ExecutionsReporterErrorCount
 
 	NumWorkerMetrics
 )
 
+// payloadSizeBuckets are the histogram buckets of payload sizes in bytes, from 1KB to 32MB
+var payloadSizeBuckets = tally.MustMakeExponentialValueBuckets(1024, 2, 16)
+
 // MetricDefs record the metrics for all services
 var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 	Common: {
@@ -1630,6 +2022,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrRetryTaskCounter:                          {metricName: "cadence_errors_retry_task", metricType: Counter},
 		CadenceErrBadBinaryCounter:                          {metricName: "cadence_errors_bad_binary", metricType: Counter},
 		CadenceErrClientVersionNotSupportedCounter:          {metricName: "cadence_errors_client_version_not_supported", metricType: Counter},
+		CadenceErrAccessDeniedCounter:                       {metricName: "cadence_errors_access_denied", metricType: Counter},
+		CadenceErrPreconditionFailedCounter:                 {metricName: "cadence_errors_precondition_failed", metricType: Counter},
+		CadenceErrTaskDispatchFailedCounter:                 {metricName: "cadence_errors_task_dispatch_failed", metricType: Counter},
 		PersistenceRequests:                                 {metricName: "persistence_requests", metricType: Counter},
 		PersistenceFailures:                                 {metricName: "persistence_errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence_latency", metricType: Timer},
@@ -1643,17 +2038,38 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrExecutionAlreadyStartedCounter:        {metricName: "persistence_errors_execution_already_started", metricType: Counter},
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence_errors_domain_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
+		PersistenceErrDataLossCounter:                       {metricName: "persistence_errors_data_loss", metricType: Counter},
+		PersistenceHistoryCorruptionCounter:                 {metricName: "persistence_history_corruptions", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceRetries:                                  {metricName: "persistence_retries", metricType: Counter},
+		PersistencePayloadSize:                              {metricName: "persistence_payload_size", metricType: Histogram, buckets: payloadSizeBuckets},
 		CadenceClientRequests:                               {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                {metricName: "cadence_client_latency", metricType: Timer},
+		CadenceClientCircuitBreakerOpened:                   {metricName: "cadence_client_circuit_breaker_opened", metricType: Counter},
+		CadenceClientCircuitBreakerRejected:                 {metricName: "cadence_client_circuit_breaker_rejected", metricType: Counter},
 		CadenceDcRedirectionClientRequests:                  {metricName: "cadence_client_requests_redirection", metricType: Counter},
 		CadenceDcRedirectionClientFailures:                  {metricName: "cadence_client_errors_redirection", metricType: Counter},
 		CadenceDcRedirectionClientLatency:                   {metricName: "cadence_client_latency_redirection", metricType: Timer},
+		CadenceDcRedirectionClientRetries:                   {metricName: "cadence_client_retries_redirection", metricType: Counter},
+		CadenceDcRedirectionClientTranslatedErrors:          {metricName: "cadence_client_translated_errors_redirection", metricType: Counter},
+		CadenceDcRedirectionShadowRequests:                  {metricName: "cadence_shadow_requests_redirection", metricType: Counter},
+		CadenceDcRedirectionShadowRedirects:                 {metricName: "cadence_shadow_redirects_redirection", metricType: Counter},
+		CadenceDcRedirectionShadowFailures:                  {metricName: "cadence_shadow_errors_redirection", metricType: Counter},
 		DomainCachePrepareCallbacksLatency:                  {metricName: "domain_cache_prepare_callbacks_latency", metricType: Timer},
 		DomainCacheCallbacksLatency:                         {metricName: "domain_cache_callbacks_latency", metricType: Timer},
+		DomainCacheRefreshLatency:                           {metricName: "domain_cache_refresh_latency", metricType: Timer},
+		DomainCacheLookupCounter:                            {metricName: "domain_cache_lookups", metricType: Counter},
+		DomainCacheHitCounter:                               {metricName: "domain_cache_hits", metricType: Counter},
+		DomainCacheMissCounter:                              {metricName: "domain_cache_misses", metricType: Counter},
+		DomainCacheEntriesGauge:                             {metricName: "domain_cache_entries", metricType: Gauge},
+		DomainCacheMemoryUsageGauge:                         {metricName: "domain_cache_memory_usage_bytes", metricType: Gauge},
+		DomainCacheEvictionCounter:                          {metricName: "domain_cache_evictions", metricType: Counter},
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
+		ActivityPayloadSize:                                 {metricName: "activity_payload_size", metricType: Timer},
+		SignalEventCount:                                    {metricName: "signal_event_count", metricType: Timer},
+		TimerCount:                                          {metricName: "timer_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
 		ArchivalConfigFailures:                              {metricName: "archivalconfig_failures", metricType: Counter},
 		ElasticsearchRequests:                               {metricName: "elasticsearch_requests", metricType: Counter},
@@ -1684,6 +2100,13 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryArchiverDuplicateArchivalsCount:                    {metricName: "history_archiver_duplicate_archivals", metricType: Counter},
 		MatchingClientForwardedCounter:                            {metricName: "forwarded", metricType: Counter},
 		MatchingClientInvalidTaskListName:                         {metricName: "invalid_task_list_name", metricType: Counter},
+		PersistenceHealthScore:                                    {metricName: "persistence_health_score", metricType: Gauge},
+		LoadSheddingCounter:                                       {metricName: "load_shedding", metricType: Counter},
+		MeteringExportedRecords:                                   {metricName: "metering_exported_records", metricType: Counter},
+		MeteringExportFailures:                                    {metricName: "metering_export_errors", metricType: Counter},
+		MeteringExportLatency:                                     {metricName: "metering_export_latency", metricType: Timer},
+		MeteringDroppedRecords:                                    {metricName: "metering_dropped_records", metricType: Counter},
+		DynamicConfigChangesCounter:                               {metricName: "dynamic_config_changes", metricType: Counter},
 	},
 	Frontend: {},
 	History: {
@@ -1695,8 +2118,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskStandbyRetryCounter:                           {metricName: "task_errors_standby_retry_counter", metricType: Counter},
 		TaskNotActiveCounter:                              {metricName: "task_errors_not_active_counter", metricType: Counter},
 		TaskLimitExceededCounter:                          {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
+		TaskDomainPausedCounter:                           {metricName: "task_errors_domain_paused_counter", metricType: Counter},
+		TaskDispatchRateLimitedCounter:                    {metricName: "task_errors_dispatch_rate_limited_counter", metricType: Counter},
+		TaskDispatchDrainedCounter:                        {metricName: "task_errors_dispatch_drained_counter", metricType: Counter},
+		TaskDispatchNoPollersCounter:                      {metricName: "task_errors_dispatch_no_pollers_counter", metricType: Counter},
 		TaskProcessingLatency:                             {metricName: "task_latency_processing", metricType: Timer},
 		TaskQueueLatency:                                  {metricName: "task_latency_queue", metricType: Timer},
+		TaskSkipped:                                       {metricName: "task_skipped", metricType: Counter},
+		TaskDropped:                                       {metricName: "task_dropped", metricType: Counter},
+		TaskRegenerated:                                   {metricName: "task_regenerated", metricType: Counter},
 		TaskBatchCompleteCounter:                          {metricName: "task_batch_complete_counter", metricType: Counter},
 		AckLevelUpdateCounter:                             {metricName: "ack_level_update", metricType: Counter},
 		AckLevelUpdateFailedCounter:                       {metricName: "ack_level_update_failed", metricType: Counter},
@@ -1713,6 +2143,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeSignalExternalWorkflowCounter:         {metricName: "signal_external_workflow_decision", metricType: Counter},
 		DecisionTypeUpsertWorkflowSearchAttributesCounter: {metricName: "upsert_workflow_search_attributes_decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:                  {metricName: "child_workflow_decision", metricType: Counter},
+		LocalActivityMarkerCounter:                        {metricName: "local_activity_marker", metricType: Counter},
+		LocalActivityMarkerSize:                           {metricName: "local_activity_marker_size", metricType: Timer},
+		LocalActivityMarkerLimitExceededCounter:           {metricName: "local_activity_marker_limit_exceeded", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                {metricName: "multiple_completion_decisions", metricType: Counter},
 		FailedDecisionsCounter:                            {metricName: "failed_decisions", metricType: Counter},
 		StaleMutableStateCounter:                          {metricName: "stale_mutable_state", metricType: Counter},
@@ -1721,12 +2154,22 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ConcurrencyUpdateFailureCounter:                   {metricName: "concurrency_update_failure", metricType: Counter},
 		CadenceErrShardOwnershipLostCounter:               {metricName: "cadence_errors_shard_ownership_lost", metricType: Counter},
 		CadenceErrEventAlreadyStartedCounter:              {metricName: "cadence_errors_event_already_started", metricType: Counter},
+		CadenceErrIncompatibleBinaryChecksumCounter:       {metricName: "cadence_errors_incompatible_binary_checksum", metricType: Counter},
 		HeartbeatTimeoutCounter:                           {metricName: "heartbeat_timeout", metricType: Counter},
 		ScheduleToStartTimeoutCounter:                     {metricName: "schedule_to_start_timeout", metricType: Counter},
 		StartToCloseTimeoutCounter:                        {metricName: "start_to_close_timeout", metricType: Counter},
 		ScheduleToCloseTimeoutCounter:                     {metricName: "schedule_to_close_timeout", metricType: Counter},
+		ScheduleToStartTimeoutNoPollersCounter:            {metricName: "schedule_to_start_timeout_no_pollers", metricType: Counter},
+		ActivityScheduleToStartLatency:                    {metricName: "activity_schedule_to_start_latency", metricType: Timer},
+		ActivityStartToCloseLatency:                       {metricName: "activity_start_to_close_latency", metricType: Timer},
+		ActivityEndToEndLatency:                           {metricName: "activity_end_to_end_latency", metricType: Timer},
+		DecisionScheduleToStartLatency:                    {metricName: "decision_schedule_to_start_latency", metricType: Timer},
+		DecisionStartToCloseLatency:                       {metricName: "decision_start_to_close_latency", metricType: Timer},
+		WorkflowEndToEndLatency:                           {metricName: "workflow_end_to_end_latency", metricType: Timer},
 		NewTimerCounter:                                   {metricName: "new_timer", metricType: Counter},
 		NewTimerNotifyCounter:                             {metricName: "new_timer_notifications", metricType: Counter},
+		TimerTaskFireLatency:                              {metricName: "timer_task_fire_latency", metricType: Timer},
+		TimerWheelFiredCounter:                            {metricName: "timer_wheel_fired", metricType: Counter},
 		AcquireShardsCounter:                              {metricName: "acquire_shards_count", metricType: Counter},
 		AcquireShardsLatency:                              {metricName: "acquire_shards_latency", metricType: Timer},
 		ShardClosedCounter:                                {metricName: "shard_closed_count", metricType: Counter},
@@ -1744,6 +2187,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardInfoTimerDiffTimer:                           {metricName: "shardinfo_timer_diff", metricType: Timer},
 		ShardInfoTransferFailoverInProgressTimer:          {metricName: "shardinfo_transfer_failover_in_progress", metricType: Timer},
 		ShardInfoTimerFailoverInProgressTimer:             {metricName: "shardinfo_timer_failover_in_progress", metricType: Timer},
+		ShardInfoClockSkewTimer:                           {metricName: "shardinfo_clock_skew", metricType: Timer},
 		ShardInfoTransferFailoverLatencyTimer:             {metricName: "shardinfo_transfer_failover_latency", metricType: Timer},
 		ShardInfoTimerFailoverLatencyTimer:                {metricName: "shardinfo_timer_failover_latency", metricType: Timer},
 		MembershipChangedCounter:                          {metricName: "membership_changed_count", metricType: Counter},
@@ -1765,6 +2209,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		BufferReplicationTaskTimer:                        {metricName: "buffer_replication_tasks", metricType: Timer},
 		UnbufferReplicationTaskTimer:                      {metricName: "unbuffer_replication_tasks", metricType: Timer},
 		HistoryConflictsCounter:                           {metricName: "history_conflicts", metricType: Counter},
+		HistoryZombieWorkflowCounter:                      {metricName: "history_zombie_workflows", metricType: Counter},
 		CompleteTaskFailedCounter:                         {metricName: "complete_task_fail_count", metricType: Counter},
 		CacheRequests:                                     {metricName: "cache_requests", metricType: Counter},
 		CacheFailures:                                     {metricName: "cache_errors", metricType: Counter},
@@ -1772,6 +2217,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
+		StaleVersionWriteCounter:                          {metricName: "stale_version_write", metricType: Counter},
+		FutureVersionWriteCounter:                         {metricName: "future_version_write", metricType: Counter},
+		ActiveWriteOnPassiveDomainCounter:                 {metricName: "active_write_on_passive_domain", metricType: Counter},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                                 {metricName: "execution_info_size", metricType: Timer},
 		ActivityInfoSize:                                  {metricName: "activity_info_size", metricType: Timer},
@@ -1813,6 +2261,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksLag:                               {metricName: "replication_tasks_lag", metricType: Timer},
 		ReplicationTasksFetched:                           {metricName: "replication_tasks_fetched", metricType: Timer},
 		ReplicationTasksReturned:                          {metricName: "replication_tasks_returned", metricType: Timer},
+		ReplicationTasksBatchLatency:                      {metricName: "replication_tasks_batch_latency", metricType: Timer},
+		ReplicationTaskExecutionGroups:                    {metricName: "replication_task_execution_groups", metricType: Timer},
 		GetReplicationMessagesForShardLatency:             {metricName: "get_replication_messages_for_shard", metricType: Timer},
 		ArchiveVisibilityAttemptCount:                     {metricName: "archive_visibility_attempt_count", metricType: Counter},
 		ArchiveVisibilityFailedCount:                      {metricName: "archive_visibility_failed_count", metricType: Counter},
@@ -1844,6 +2294,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LocalToRemoteMatchCounter:     {metricName: "local_to_remote_matches"},
 		RemoteToLocalMatchCounter:     {metricName: "remote_to_local_matches"},
 		RemoteToRemoteMatchCounter:    {metricName: "remote_to_remote_matches"},
+		WorkerUnavailableTaskCounter:  {metricName: "worker_unavailable_tasks"},
+		AffinityMatchCounter:          {metricName: "affinity_matches"},
+		DrainedPollCounter:            {metricName: "drained_polls"},
+		TaskListDrainedGauge:          {metricName: "tasklist_drained", metricType: Gauge},
+		StaleWorkersOnlyTaskCounter:   {metricName: "stale_workers_only_tasks"},
+		PartitionUpscaleCounter:       {metricName: "partition_upscale"},
+		PartitionDownscaleCounter:     {metricName: "partition_downscale"},
+		PartitionUpdateFailedCounter:  {metricName: "partition_update_failed"},
+		EstimatedAddTaskQPSGauge:      {metricName: "estimated_add_task_qps", metricType: Gauge},
+		TaskListReadPartitionsGauge:   {metricName: "tasklist_read_partitions", metricType: Gauge},
+		TaskListWritePartitionsGauge:  {metricName: "tasklist_write_partitions", metricType: Gauge},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -1857,6 +2318,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ESProcessorProcessMsgLatency:                  {metricName: "es_processor_process_msg_latency", metricType: Timer},
 		IndexProcessorCorruptedData:                   {metricName: "index_processor_corrupted_data"},
 		IndexProcessorProcessMsgLatency:               {metricName: "index_processor_process_msg_latency", metricType: Timer},
+		IndexProcessorRolloverIndexDeleted:            {metricName: "index_processor_rollover_index_deleted"},
 		ArchiverNonRetryableErrorCount:                {metricName: "archiver_non_retryable_error"},
 		ArchiverStartedCount:                          {metricName: "archiver_started"},
 		ArchiverStoppedCount:                          {metricName: "archiver_stopped"},
@@ -1897,8 +2359,24 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryScavengerSuccessCount:                  {metricName: "scavenger_success", metricType: Counter},
 		HistoryScavengerErrorCount:                    {metricName: "scavenger_errors", metricType: Counter},
 		HistoryScavengerSkipCount:                     {metricName: "scavenger_skips", metricType: Counter},
+		HistoryScavengerZombieCount:                   {metricName: "scavenger_zombies", metricType: Counter},
+		HistoryScavengerQuarantinedCount:              {metricName: "scavenger_quarantined", metricType: Counter},
+		HistoryScavengerQuarantineClearedCount:        {metricName: "scavenger_quarantine_cleared", metricType: Counter},
 		ParentClosePolicyProcessorSuccess:             {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:            {metricName: "parent_close_policy_processor_errors", metricType: Counter},
+		HandoverDomainsCount:                          {metricName: "handover_domains", metricType: Gauge},
+		HandoverCompletedCount:                        {metricName: "handover_completed", metricType: Counter},
+		HandoverFailures:                              {metricName: "handover_errors", metricType: Counter},
+		VisibilityTiererArchivedCount:                 {metricName: "visibility_tierer_archived", metricType: Counter},
+		VisibilityTiererErrorCount:                    {metricName: "visibility_tierer_errors", metricType: Counter},
+		VisibilityTiererSkipCount:                     {metricName: "visibility_tierer_skips", metricType: Counter},
+		ParityVerifiedCount:                           {metricName: "parity_verified", metricType: Counter},
+		ParityMismatchCount:                           {metricName: "parity_mismatches", metricType: Counter},
+		ParityVerifierFailures:                        {metricName: "parity_verifier_errors", metricType: Counter},
+		DomainMigrationReplicatedCount:                {metricName: "domain_migration_replicated", metricType: Counter},
+		DomainMigrationFailures:                       {metricName: "domain_migration_errors", metricType: Counter},
+		ExecutionsReporterScannedCount:                {metricName: "executions_reporter_scanned", metricType: Counter},
+		ExecutionsReporterErrorCount:                  {metricName: "executions_reporter_errors", metricType: Counter},
 	},
 }
 
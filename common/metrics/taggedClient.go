@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package metrics
+
+import (
+	"time"
+
+	"github.com/uber-go/tally"
+)
+
+type (
+	// taggedClient is a Client which attaches a fixed set of tags to
+	// every metric emitted through the wrapped client
+	taggedClient struct {
+		client Client
+		tags   []Tag
+	}
+
+	scopeTimerRecorder struct {
+		scope Scope
+		timer int
+	}
+)
+
+var _ Client = (*taggedClient)(nil)
+
+// NewTaggedClient returns a Client which emits all metrics through the
+// given client with the given tags attached
+func NewTaggedClient(client Client, tags ...Tag) Client {
+	if len(tags) == 0 {
+		return client
+	}
+	return &taggedClient{
+		client: client,
+		tags:   tags,
+	}
+}
+
+// IncCounter increments a counter metric
+func (m *taggedClient) IncCounter(scope int, counter int) {
+	m.Scope(scope).IncCounter(counter)
+}
+
+// AddCounter adds delta to the counter metric
+func (m *taggedClient) AddCounter(scope int, counter int, delta int64) {
+	m.Scope(scope).AddCounter(counter, delta)
+}
+
+// StartTimer starts a timer for the given metric name
+func (m *taggedClient) StartTimer(scope int, timer int) tally.Stopwatch {
+	return tally.NewStopwatch(time.Now(), &scopeTimerRecorder{
+		scope: m.Scope(scope),
+		timer: timer,
+	})
+}
+
+// RecordTimer records a timer for the given metric name
+func (m *taggedClient) RecordTimer(scope int, timer int, d time.Duration) {
+	m.Scope(scope).RecordTimer(timer, d)
+}
+
+// UpdateGauge reports Gauge type absolute value metric
+func (m *taggedClient) UpdateGauge(scope int, gauge int, value float64) {
+	m.Scope(scope).UpdateGauge(gauge, value)
+}
+
+// Scope returns an internal scope carrying the client tags as well as the given tags
+func (m *taggedClient) Scope(scope int, tags ...Tag) Scope {
+	if len(tags) == 0 {
+		return m.client.Scope(scope, m.tags...)
+	}
+	allTags := make([]Tag, 0, len(m.tags)+len(tags))
+	allTags = append(allTags, m.tags...)
+	allTags = append(allTags, tags...)
+	return m.client.Scope(scope, allTags...)
+}
+
+// RecordStopwatch records the time elapsed since the stopwatch was started
+func (r *scopeTimerRecorder) RecordStopwatch(stopwatchStart time.Time) {
+	r.scope.RecordTimer(r.timer, time.Now().Sub(stopwatchStart))
+}
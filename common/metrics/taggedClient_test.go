@@ -0,0 +1,62 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestTaggedClient(t *testing.T) {
+	scope := tally.NewTestScope("test", nil)
+	client := NewTaggedClient(NewClient(scope, Common), StoreTag("cassandra"))
+
+	client.IncCounter(PersistenceGetShardScope, PersistenceRequests)
+	sw := client.StartTimer(PersistenceGetShardScope, PersistenceLatency)
+	sw.Stop()
+	client.Scope(PersistenceGetShardScope).RecordHistogramValue(PersistencePayloadSize, 1500)
+
+	snapshot := scope.Snapshot()
+	require.Len(t, snapshot.Counters(), 1)
+	for _, counter := range snapshot.Counters() {
+		require.Equal(t, "test.persistence_requests", counter.Name())
+		require.Equal(t, int64(1), counter.Value())
+		require.Equal(t, "cassandra", counter.Tags()[store])
+		require.Equal(t, "GetShard", counter.Tags()[OperationTagName])
+	}
+	require.Len(t, snapshot.Timers(), 1)
+	for _, timer := range snapshot.Timers() {
+		require.Equal(t, "cassandra", timer.Tags()[store])
+		require.Len(t, timer.Values(), 1)
+	}
+	require.Len(t, snapshot.Histograms(), 1)
+	for _, histogram := range snapshot.Histograms() {
+		require.Equal(t, "test.persistence_payload_size", histogram.Name())
+		require.Equal(t, "cassandra", histogram.Tags()[store])
+		require.Equal(t, int64(1), histogram.Values()[2048])
+	}
+}
+
+func TestTaggedClient_NoTags(t *testing.T) {
+	client := NewClient(tally.NoopScope, Common)
+	require.Equal(t, client, NewTaggedClient(client))
+}
@@ -30,6 +30,11 @@ const (
 	instance      = "instance"
 	domain        = "domain"
 	targetCluster = "target_cluster"
+	writeCluster  = "write_cluster"
+	activityType  = "activity_type"
+	workflowType  = "workflow_type"
+	store         = "store"
+	taskList      = "tasklist"
 
 	domainAllValue = "all"
 	unknownValue   = "_unknown_"
@@ -55,6 +60,26 @@ type (
 	targetClusterTag struct {
 		value string
 	}
+
+	writeClusterTag struct {
+		value string
+	}
+
+	activityTypeTag struct {
+		value string
+	}
+
+	workflowTypeTag struct {
+		value string
+	}
+
+	storeTag struct {
+		value string
+	}
+
+	taskListTag struct {
+		value string
+	}
 )
 
 // DomainTag returns a new domain tag. For timers, this also ensures that we
@@ -124,3 +149,96 @@ func (d targetClusterTag) Key() string {
 func (d targetClusterTag) Value() string {
 	return d.value
 }
+
+// WriteClusterTag returns a new write cluster tag, which is the cluster owning the failover version of a write.
+func WriteClusterTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return writeClusterTag{value}
+}
+
+// Key returns the key of the write cluster tag
+func (d writeClusterTag) Key() string {
+	return writeCluster
+}
+
+// Value returns the value of a write cluster tag
+func (d writeClusterTag) Value() string {
+	return d.value
+}
+
+// ActivityTypeTag returns a new activity type tag. Callers are expected to bound
+// the cardinality of this tag, as activity types are user defined.
+func ActivityTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return activityTypeTag{value}
+}
+
+// Key returns the key of the activity type tag
+func (d activityTypeTag) Key() string {
+	return activityType
+}
+
+// Value returns the value of a activity type tag
+func (d activityTypeTag) Value() string {
+	return d.value
+}
+
+// WorkflowTypeTag returns a new workflow type tag. Callers are expected to bound
+// the cardinality of this tag, as workflow types are user defined.
+func WorkflowTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return workflowTypeTag{value}
+}
+
+// Key returns the key of the workflow type tag
+func (d workflowTypeTag) Key() string {
+	return workflowType
+}
+
+// Value returns the value of a workflow type tag
+func (d workflowTypeTag) Value() string {
+	return d.value
+}
+
+// StoreTag returns a new persistence store tag, e.g. cassandra, mysql or elasticsearch
+func StoreTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return storeTag{value}
+}
+
+// Key returns the key of the store tag
+func (d storeTag) Key() string {
+	return store
+}
+
+// Value returns the value of a store tag
+func (d storeTag) Value() string {
+	return d.value
+}
+
+// TaskListTag returns a new task list tag. Callers are expected to bound
+// the cardinality of this tag, as task lists are user defined.
+func TaskListTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return taskListTag{value}
+}
+
+// Key returns the key of the task list tag
+func (d taskListTag) Key() string {
+	return taskList
+}
+
+// Value returns the value of a task list tag
+func (d taskListTag) Value() string {
+	return d.value
+}
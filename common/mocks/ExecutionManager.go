@@ -125,6 +125,29 @@ func (_m *ExecutionManager) UpdateWorkflowExecution(request *persistence.UpdateW
 	return r0, r1
 }
 
+// UpdateWorkflowExecutionBatch provides a mock function with given fields: request
+func (_m *ExecutionManager) UpdateWorkflowExecutionBatch(request *persistence.UpdateWorkflowExecutionBatchRequest) (*persistence.UpdateWorkflowExecutionBatchResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.UpdateWorkflowExecutionBatchResponse
+	if rf, ok := ret.Get(0).(func(*persistence.UpdateWorkflowExecutionBatchRequest) *persistence.UpdateWorkflowExecutionBatchResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.UpdateWorkflowExecutionBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.UpdateWorkflowExecutionBatchRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConflictResolveWorkflowExecution provides a mock function with given fields: request
 func (_m *ExecutionManager) ConflictResolveWorkflowExecution(request *persistence.ConflictResolveWorkflowExecutionRequest) error {
 	ret := _m.Called(request)
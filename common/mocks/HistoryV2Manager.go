@@ -206,6 +206,50 @@ func (_m *HistoryV2Manager) GetAllHistoryTreeBranches(request *persistence.GetAl
 	return r0, r1
 }
 
+// QuarantineHistoryBranch provides a mock function with given fields: request
+func (_m *HistoryV2Manager) QuarantineHistoryBranch(request *persistence.QuarantineHistoryBranchRequest) error {
+	ret := _m.Called(request)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.QuarantineHistoryBranchRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// GetQuarantinedHistoryBranches provides a mock function with given fields: request
+func (_m *HistoryV2Manager) GetQuarantinedHistoryBranches(request *persistence.GetQuarantinedHistoryBranchesRequest) (*persistence.GetQuarantinedHistoryBranchesResponse, error) {
+	ret := _m.Called(request)
+	var r0 *persistence.GetQuarantinedHistoryBranchesResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetQuarantinedHistoryBranchesRequest) *persistence.GetQuarantinedHistoryBranchesResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetQuarantinedHistoryBranchesResponse)
+		}
+	}
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetQuarantinedHistoryBranchesRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DeleteQuarantinedHistoryBranch provides a mock function with given fields: request
+func (_m *HistoryV2Manager) DeleteQuarantinedHistoryBranch(request *persistence.DeleteQuarantinedHistoryBranchRequest) error {
+	ret := _m.Called(request)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteQuarantinedHistoryBranchRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *HistoryV2Manager) Close() {
 	_m.Called()
@@ -79,4 +79,18 @@ func (_m *ServiceResolver) RemoveListener(name string) error {
 	return r0
 }
 
+// MemberCount is am mock implementation
+func (_m *ServiceResolver) MemberCount() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Int(0)
+	}
+
+	return r0
+}
+
 var _ membership.ServiceResolver = (*ServiceResolver)(nil)
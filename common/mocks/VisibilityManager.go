@@ -154,6 +154,29 @@ func (_m *VisibilityManager) ListClosedWorkflowExecutionsByStatus(request *persi
 	return r0, r1
 }
 
+// ListClosedWorkflowExecutionsByMemo provides a mock function with given fields: request
+func (_m *VisibilityManager) ListClosedWorkflowExecutionsByMemo(request *persistence.ListClosedWorkflowExecutionsByMemoRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListClosedWorkflowExecutionsByMemoRequest) *persistence.ListWorkflowExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListClosedWorkflowExecutionsByMemoRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListClosedWorkflowExecutionsByType provides a mock function with given fields: request
 func (_m *VisibilityManager) ListClosedWorkflowExecutionsByType(request *persistence.ListWorkflowExecutionsByTypeRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	ret := _m.Called(request)
@@ -306,6 +329,20 @@ func (_m *VisibilityManager) RecordWorkflowExecutionClosed(request *persistence.
 	return r0
 }
 
+// RecordWorkflowExecutionClosedBatch provides a mock function with given fields: request
+func (_m *VisibilityManager) RecordWorkflowExecutionClosedBatch(request *persistence.RecordWorkflowExecutionClosedBatchRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.RecordWorkflowExecutionClosedBatchRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RecordWorkflowExecutionStarted provides a mock function with given fields: request
 func (_m *VisibilityManager) RecordWorkflowExecutionStarted(request *persistence.RecordWorkflowExecutionStartedRequest) error {
 	ret := _m.Called(request)
@@ -343,6 +380,20 @@ func (_m *VisibilityManager) ScanWorkflowExecutions(request *persistence.ListWor
 	return r0, r1
 }
 
+// UpdateHistoryArchivalInfo provides a mock function with given fields: request
+func (_m *VisibilityManager) UpdateHistoryArchivalInfo(request *persistence.UpdateHistoryArchivalInfoRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.UpdateHistoryArchivalInfoRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpsertWorkflowExecution provides a mock function with given fields: request
 func (_m *VisibilityManager) UpsertWorkflowExecution(request *persistence.UpsertWorkflowExecutionRequest) error {
 	ret := _m.Called(request)
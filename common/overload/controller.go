@@ -0,0 +1,271 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package overload
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	uberatomic "go.uber.org/atomic"
+)
+
+type (
+	// Controller tracks the health of persistence, as seen by this host, from the latency
+	// and failures of persistence operations, and decides how much low priority work is
+	// admitted while persistence is overloaded
+	Controller interface {
+		common.Daemon
+		// RecordPersistenceLatency records the latency of a persistence operation
+		RecordPersistenceLatency(latency time.Duration)
+		// RecordPersistenceFailure records a failed persistence operation
+		RecordPersistenceFailure()
+		// HealthScore returns the persistence health score, from 0 (overloaded) to 1 (healthy)
+		HealthScore() float64
+		// AdmissionRatio returns the ratio of low priority work to admit, which is the health
+		// score while load shedding is enabled and 1 otherwise
+		AdmissionRatio() float64
+		// ShouldShed returns whether a low priority operation should be shed, while persistence
+		// is overloaded operations are admitted up to the host share of the global low priority
+		// rate scaled by the health score, or with a probability equal to the health score when
+		// no global rate is configured
+		ShouldShed() bool
+	}
+
+	// MemberCountFn returns the number of hosts of the service, which share the global limits
+	MemberCountFn func() int
+
+	// Config is the config of the overload controller
+	Config struct {
+		// EnableLoadShedding is whether low priority operations are shed while persistence is overloaded
+		EnableLoadShedding dynamicconfig.BoolPropertyFn
+		// LatencyThreshold is the average persistence latency above which persistence is overloaded
+		LatencyThreshold dynamicconfig.DurationPropertyFn
+		// ErrorRatioThreshold is the ratio of failed persistence operations above which persistence is overloaded
+		ErrorRatioThreshold dynamicconfig.FloatPropertyFn
+		// EvaluationInterval is the interval at which the health score is re-evaluated
+		EvaluationInterval dynamicconfig.DurationPropertyFn
+		// GlobalLowPriorityRPS is the cluster wide rate of low priority operations, scaled by the health
+		// score, admitted while persistence is overloaded, it is split evenly across the hosts of the service
+		// and 0 means operations are shed by probability instead
+		GlobalLowPriorityRPS dynamicconfig.IntPropertyFn
+	}
+
+	controllerImpl struct {
+		status        int32
+		config        *Config
+		memberCount   MemberCountFn
+		rateLimiter   *quotas.DynamicRateLimiter
+		metricsClient metrics.Client
+		logger        log.Logger
+		shutdownCh    chan struct{}
+		shutdownWG    sync.WaitGroup
+
+		// updated on every persistence operation, so they are atomics rather than guarded by a lock
+		requests     uberatomic.Int64
+		failures     uberatomic.Int64
+		totalLatency uberatomic.Int64
+		healthScore  *uberatomic.Float64
+	}
+)
+
+var _ Controller = (*controllerImpl)(nil)
+
+// NewController creates a new overload controller
+func NewController(
+	config *Config,
+	memberCount MemberCountFn,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) Controller {
+	c := &controllerImpl{
+		status:        common.DaemonStatusInitialized,
+		config:        config,
+		memberCount:   memberCount,
+		metricsClient: metricsClient,
+		logger:        logger.WithTags(tag.ComponentOverloadController),
+		shutdownCh:    make(chan struct{}),
+		healthScore:   uberatomic.NewFloat64(1),
+	}
+	c.rateLimiter = quotas.NewDynamicRateLimiter(c.lowPriorityRPS)
+	return c
+}
+
+// NewServiceMemberCountFn returns a MemberCountFn which counts the hosts of the given service
+// through the membership monitor of the service, falling back to 1 while membership is unavailable
+func NewServiceMemberCountFn(svc service.Service, serviceName string) MemberCountFn {
+	return func() int {
+		monitor := svc.GetMembershipMonitor()
+		if monitor == nil {
+			return 1
+		}
+		resolver, err := monitor.GetResolver(serviceName)
+		if err != nil {
+			return 1
+		}
+		if count := resolver.MemberCount(); count > 0 {
+			return count
+		}
+		return 1
+	}
+}
+
+func (c *controllerImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	c.shutdownWG.Add(1)
+	go c.evaluateLoop()
+
+	c.logger.Info("", tag.LifeCycleStarted)
+}
+
+func (c *controllerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(c.shutdownCh)
+	if success := common.AwaitWaitGroup(&c.shutdownWG, time.Minute); !success {
+		c.logger.Warn("", tag.LifeCycleStopTimedout)
+	}
+
+	c.logger.Info("", tag.LifeCycleStopped)
+}
+
+func (c *controllerImpl) RecordPersistenceLatency(latency time.Duration) {
+	c.requests.Inc()
+	c.totalLatency.Add(int64(latency))
+}
+
+func (c *controllerImpl) RecordPersistenceFailure() {
+	c.failures.Inc()
+}
+
+func (c *controllerImpl) HealthScore() float64 {
+	return c.healthScore.Load()
+}
+
+func (c *controllerImpl) AdmissionRatio() float64 {
+	if !c.config.EnableLoadShedding() {
+		return 1
+	}
+	return c.HealthScore()
+}
+
+func (c *controllerImpl) ShouldShed() bool {
+	ratio := c.AdmissionRatio()
+	if ratio >= 1 {
+		return false
+	}
+
+	var admitted bool
+	if c.config.GlobalLowPriorityRPS() > 0 {
+		admitted = c.rateLimiter.Allow()
+	} else {
+		admitted = rand.Float64() < ratio
+	}
+	if admitted {
+		return false
+	}
+	c.metricsClient.IncCounter(metrics.OverloadControllerScope, metrics.LoadSheddingCounter)
+	return true
+}
+
+// lowPriorityRPS returns the share of this host of the global low priority rate, scaled by the health score
+func (c *controllerImpl) lowPriorityRPS() float64 {
+	return float64(c.config.GlobalLowPriorityRPS()) * c.HealthScore() / float64(c.memberCount())
+}
+
+func (c *controllerImpl) evaluateLoop() {
+	defer c.shutdownWG.Done()
+
+	timer := time.NewTimer(c.config.EvaluationInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+			c.evaluate()
+			timer.Reset(c.config.EvaluationInterval())
+		}
+	}
+}
+
+// evaluate computes the health score from the persistence operations recorded since the last
+// evaluation, the score is 1 while both the average latency and the error ratio are within
+// their thresholds and drops linearly to 0 as either of them reaches twice its threshold
+func (c *controllerImpl) evaluate() {
+	requests := c.requests.Swap(0)
+	failures := c.failures.Swap(0)
+	totalLatency := time.Duration(c.totalLatency.Swap(0))
+
+	score := 1.0
+	if requests > 0 {
+		load := 0.0
+		if threshold := c.config.LatencyThreshold(); threshold > 0 {
+			avgLatency := totalLatency / time.Duration(requests)
+			load = math.Max(load, float64(avgLatency)/float64(threshold))
+		}
+		if threshold := c.config.ErrorRatioThreshold(); threshold > 0 {
+			load = math.Max(load, float64(failures)/float64(requests)/threshold)
+		}
+		score = math.Min(1, math.Max(0, 2-load))
+	}
+
+	previousScore := c.healthScore.Load()
+	c.healthScore.Store(score)
+	c.rateLimiter.Refresh()
+
+	c.metricsClient.UpdateGauge(metrics.OverloadControllerScope, metrics.PersistenceHealthScore, score)
+	if score < 1 && previousScore == 1 {
+		c.logger.Warn("Persistence is overloaded.",
+			tag.Number(requests), tag.Counter(int(failures)), tag.Value(score))
+	} else if score == 1 && previousScore < 1 {
+		c.logger.Info("Persistence recovered from overload.")
+	}
+}
+
+// ScaledIntPropertyFn returns an int property fn which scales the value of the given property fn,
+// usually the rate of some background processing, by the admission ratio of the controller,
+// the scaled value is never below 1 unless the value itself is
+func ScaledIntPropertyFn(controller Controller, fn dynamicconfig.IntPropertyFn) dynamicconfig.IntPropertyFn {
+	return func(opts ...dynamicconfig.FilterOption) int {
+		value := fn(opts...)
+		scaled := int(float64(value) * controller.AdmissionRatio())
+		if scaled < 1 && value >= 1 {
+			return 1
+		}
+		return scaled
+	}
+}
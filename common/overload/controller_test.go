@@ -0,0 +1,168 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package overload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	controllerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		enableLoadShedding   bool
+		globalLowPriorityRPS int
+		memberCount          int
+		controller           *controllerImpl
+	}
+)
+
+func TestControllerSuite(t *testing.T) {
+	s := new(controllerSuite)
+	suite.Run(t, s)
+}
+
+func (s *controllerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.enableLoadShedding = true
+	s.globalLowPriorityRPS = 0
+	s.memberCount = 1
+	s.controller = NewController(
+		&Config{
+			EnableLoadShedding:   func(...dynamicconfig.FilterOption) bool { return s.enableLoadShedding },
+			LatencyThreshold:     dynamicconfig.GetDurationPropertyFn(100 * time.Millisecond),
+			ErrorRatioThreshold:  dynamicconfig.GetFloatPropertyFn(0.1),
+			EvaluationInterval:   dynamicconfig.GetDurationPropertyFn(time.Minute),
+			GlobalLowPriorityRPS: func(...dynamicconfig.FilterOption) int { return s.globalLowPriorityRPS },
+		},
+		func() int { return s.memberCount },
+		metrics.NewClient(tally.NoopScope, metrics.History),
+		loggerimpl.NewNopLogger(),
+	).(*controllerImpl)
+}
+
+func (s *controllerSuite) TestHealthy() {
+	s.controller.evaluate()
+	s.Equal(1.0, s.controller.HealthScore())
+
+	for i := 0; i < 10; i++ {
+		s.controller.RecordPersistenceLatency(50 * time.Millisecond)
+	}
+	s.controller.RecordPersistenceFailure()
+	s.controller.evaluate()
+	s.Equal(1.0, s.controller.HealthScore())
+	s.Equal(1.0, s.controller.AdmissionRatio())
+	s.False(s.controller.ShouldShed())
+}
+
+func (s *controllerSuite) TestOverloaded_Latency() {
+	for i := 0; i < 10; i++ {
+		s.controller.RecordPersistenceLatency(150 * time.Millisecond)
+	}
+	s.controller.evaluate()
+	s.InDelta(0.5, s.controller.HealthScore(), 0.001)
+	s.InDelta(0.5, s.controller.AdmissionRatio(), 0.001)
+
+	// the score is re-evaluated from the operations recorded since the last evaluation only
+	s.controller.RecordPersistenceLatency(10 * time.Millisecond)
+	s.controller.evaluate()
+	s.Equal(1.0, s.controller.HealthScore())
+}
+
+func (s *controllerSuite) TestOverloaded_Failures() {
+	for i := 0; i < 10; i++ {
+		s.controller.RecordPersistenceLatency(10 * time.Millisecond)
+	}
+	s.controller.RecordPersistenceFailure()
+	s.controller.RecordPersistenceFailure()
+	s.controller.RecordPersistenceFailure()
+	s.controller.evaluate()
+	s.Equal(0.0, s.controller.HealthScore())
+	s.True(s.controller.ShouldShed())
+
+	s.enableLoadShedding = false
+	s.Equal(0.0, s.controller.HealthScore())
+	s.Equal(1.0, s.controller.AdmissionRatio())
+	s.False(s.controller.ShouldShed())
+}
+
+func (s *controllerSuite) TestOverloaded_GlobalLowPriorityRPS() {
+	s.globalLowPriorityRPS = 40
+	s.memberCount = 4
+	s.Equal(10.0, s.controller.lowPriorityRPS())
+
+	for i := 0; i < 10; i++ {
+		s.controller.RecordPersistenceLatency(150 * time.Millisecond)
+	}
+	s.controller.evaluate()
+	// the global rate is split across the hosts and scaled by the health score
+	s.InDelta(5.0, s.controller.lowPriorityRPS(), 0.001)
+
+	admitted := 0
+	for i := 0; i < 100; i++ {
+		if !s.controller.ShouldShed() {
+			admitted++
+		}
+	}
+	s.True(admitted > 0)
+	s.True(admitted < 100)
+}
+
+func (s *controllerSuite) TestScaledIntPropertyFn() {
+	rps := ScaledIntPropertyFn(s.controller, dynamicconfig.GetIntPropertyFn(20))
+	s.Equal(20, rps())
+
+	for i := 0; i < 10; i++ {
+		s.controller.RecordPersistenceLatency(175 * time.Millisecond)
+	}
+	s.controller.evaluate()
+	s.Equal(5, rps())
+
+	s.controller.RecordPersistenceLatency(time.Second)
+	s.controller.evaluate()
+	s.Equal(1, rps())
+}
+
+func (s *controllerSuite) TestPersistenceMetricsClient() {
+	metricsClient := NewPersistenceMetricsClient(metrics.NewClient(tally.NoopScope, metrics.History), s.controller)
+	taggedClient := metrics.NewTaggedClient(metricsClient, metrics.StoreTag("cassandra"))
+
+	sw := taggedClient.StartTimer(metrics.PersistenceGetShardScope, metrics.PersistenceLatency)
+	sw.Stop()
+	taggedClient.RecordTimer(metrics.PersistenceGetShardScope, metrics.PersistenceLatency, time.Second)
+	taggedClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests)
+	taggedClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceFailures)
+	metricsClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceFailures)
+
+	s.Equal(int64(2), s.controller.requests.Load())
+	s.Equal(int64(2), s.controller.failures.Load())
+	s.True(time.Duration(s.controller.totalLatency.Load()) >= time.Second)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package overload
+
+import (
+	"time"
+
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// persistenceMetricsClient is a metrics Client which feeds the persistence latencies
+	// and failures emitted through it to an overload controller, it is meant to be handed
+	// to the persistence factory so that the persistence metric clients drive the controller
+	persistenceMetricsClient struct {
+		client     metrics.Client
+		controller Controller
+	}
+
+	persistenceMetricsScope struct {
+		metrics.Scope
+		controller Controller
+	}
+
+	persistenceTimerRecorder struct {
+		client *persistenceMetricsClient
+		scope  int
+		timer  int
+	}
+)
+
+var _ metrics.Client = (*persistenceMetricsClient)(nil)
+var _ metrics.Scope = (*persistenceMetricsScope)(nil)
+
+// NewPersistenceMetricsClient returns a metrics Client which emits all metrics through the
+// given client and records persistence latencies and failures to the given controller
+func NewPersistenceMetricsClient(client metrics.Client, controller Controller) metrics.Client {
+	return &persistenceMetricsClient{
+		client:     client,
+		controller: controller,
+	}
+}
+
+// IncCounter increments a counter metric
+func (m *persistenceMetricsClient) IncCounter(scope int, counter int) {
+	m.AddCounter(scope, counter, 1)
+}
+
+// AddCounter adds delta to the counter metric
+func (m *persistenceMetricsClient) AddCounter(scope int, counter int, delta int64) {
+	recordCounter(m.controller, counter, delta)
+	m.client.AddCounter(scope, counter, delta)
+}
+
+// StartTimer starts a timer for the given metric name
+func (m *persistenceMetricsClient) StartTimer(scope int, timer int) tally.Stopwatch {
+	return tally.NewStopwatch(time.Now(), &persistenceTimerRecorder{
+		client: m,
+		scope:  scope,
+		timer:  timer,
+	})
+}
+
+// RecordTimer records a timer for the given metric name
+func (m *persistenceMetricsClient) RecordTimer(scope int, timer int, d time.Duration) {
+	recordTimer(m.controller, timer, d)
+	m.client.RecordTimer(scope, timer, d)
+}
+
+// UpdateGauge reports Gauge type absolute value metric
+func (m *persistenceMetricsClient) UpdateGauge(scope int, gauge int, value float64) {
+	m.client.UpdateGauge(scope, gauge, value)
+}
+
+// Scope returns an internal scope which records persistence latencies and failures to the controller
+func (m *persistenceMetricsClient) Scope(scope int, tags ...metrics.Tag) metrics.Scope {
+	return &persistenceMetricsScope{
+		Scope:      m.client.Scope(scope, tags...),
+		controller: m.controller,
+	}
+}
+
+// IncCounter increments a counter metric
+func (s *persistenceMetricsScope) IncCounter(counter int) {
+	s.AddCounter(counter, 1)
+}
+
+// AddCounter adds delta to the counter metric
+func (s *persistenceMetricsScope) AddCounter(counter int, delta int64) {
+	recordCounter(s.controller, counter, delta)
+	s.Scope.AddCounter(counter, delta)
+}
+
+// RecordTimer records a timer for the given metric name
+func (s *persistenceMetricsScope) RecordTimer(timer int, d time.Duration) {
+	recordTimer(s.controller, timer, d)
+	s.Scope.RecordTimer(timer, d)
+}
+
+// Tagged returns an internal scope which records persistence latencies and failures to the controller
+func (s *persistenceMetricsScope) Tagged(tags ...metrics.Tag) metrics.Scope {
+	return &persistenceMetricsScope{
+		Scope:      s.Scope.Tagged(tags...),
+		controller: s.controller,
+	}
+}
+
+// RecordStopwatch records the time elapsed since the stopwatch was started
+func (r *persistenceTimerRecorder) RecordStopwatch(stopwatchStart time.Time) {
+	r.client.RecordTimer(r.scope, r.timer, time.Now().Sub(stopwatchStart))
+}
+
+func recordCounter(controller Controller, counter int, delta int64) {
+	if counter != metrics.PersistenceFailures {
+		return
+	}
+	for i := int64(0); i < delta; i++ {
+		controller.RecordPersistenceFailure()
+	}
+}
+
+func recordTimer(controller Controller, timer int, d time.Duration) {
+	if timer == metrics.PersistenceLatency {
+		controller.RecordPersistenceLatency(d)
+	}
+}
@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -57,11 +58,35 @@ const (
 	v2templateUpdateBranch = `UPDATE history_tree set in_progress = ? WHERE tree_id = ? AND branch_id = ? `
 
 	v2templateScanAllTreeBranches = `SELECT tree_id, branch_id, fork_time, info FROM history_tree `
+
+	// below are templates for history_quarantine table
+	v2templateInsertQuarantine = `INSERT INTO history_quarantine (` +
+		`tree_id, branch_id, shard_id, domain_name, min_event_id, max_event_id, reason, quarantine_time) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?) `
+
+	v2templateScanAllQuarantines = `SELECT tree_id, branch_id, shard_id, domain_name, min_event_id, max_event_id, reason, quarantine_time ` +
+		`FROM history_quarantine `
+
+	v2templateDeleteQuarantine = `DELETE FROM history_quarantine WHERE tree_id = ? AND branch_id = ? `
 )
 
 type (
 	cassandraHistoryV2Persistence struct {
 		cassandraStore
+		// hedgeSession coordinates hedged reads on another host than the token aware session
+		hedgeSession *gocql.Session
+		hedgedReader *hedgedReader
+	}
+
+	historyNodeRow struct {
+		nodeID int64
+		txnID  int64
+		data   *p.DataBlob
+	}
+
+	historyNodePage struct {
+		rows          []historyNodeRow
+		nextPageToken []byte
 	}
 )
 
@@ -94,7 +119,26 @@ func newHistoryV2Persistence(
 		return nil, err
 	}
 
-	return &cassandraHistoryV2Persistence{cassandraStore: cassandraStore{session: session, logger: logger}}, nil
+	store := &cassandraHistoryV2Persistence{cassandraStore: cassandraStore{session: session, logger: logger}}
+	if cfg.HistoryHedgedReads != nil {
+		// round robin instead of token aware host selection, so hedged reads are coordinated by other hosts
+		cluster.PoolConfig.HostSelectionPolicy = gocql.RoundRobinHostPolicy()
+		store.hedgeSession, err = cluster.CreateSession()
+		if err != nil {
+			session.Close()
+			return nil, err
+		}
+		store.hedgedReader = newHedgedReader(cfg.HistoryHedgedReads)
+	}
+	return store, nil
+}
+
+// Close releases the underlying resources
+func (h *cassandraHistoryV2Persistence) Close() {
+	h.cassandraStore.Close()
+	if h.hedgeSession != nil {
+		h.hedgeSession.Close()
+	}
 }
 
 func convertCommonErrors(
@@ -168,30 +212,24 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(
 	request *p.InternalReadHistoryBranchRequest,
 ) (*p.InternalReadHistoryBranchResponse, error) {
 
-	treeID := request.TreeID
-	branchID := request.BranchID
-
 	lastNodeID := request.LastNodeID
 	lastTxnID := request.LastTransactionID
 
-	query := h.session.Query(v2templateReadData, treeID, branchID, request.MinNodeID, request.MaxNodeID)
-
-	iter := query.PageSize(int(request.PageSize)).PageState(request.NextPageToken).Iter()
-	if iter == nil {
-		return nil, &workflow.InternalServiceError{
-			Message: "ReadHistoryBranch operation failed.  Not able to create query iterator.",
+	result, err := h.hedgedReader.read(func(ctx context.Context, isHedge bool) (interface{}, error) {
+		session := h.session
+		if isHedge {
+			session = h.hedgeSession
 		}
+		return readHistoryNodePage(ctx, session, request)
+	})
+	if err != nil {
+		return nil, err
 	}
-	pagingToken := iter.PageState()
+	page := result.(*historyNodePage)
 
 	history := make([]*p.DataBlob, 0, int(request.PageSize))
-
-	eventBlob := &p.DataBlob{}
-	nodeID := int64(0)
-	txnID := int64(0)
-
-	for iter.Scan(&nodeID, &txnID, &eventBlob.Data, &eventBlob.Encoding) {
-		if txnID < lastTxnID {
+	for _, row := range page.rows {
+		if row.txnID < lastTxnID {
 			// assuming that business logic layer is correct and transaction ID only increase
 			// thus, valid event batch will come with increasing transaction ID
 
@@ -206,81 +244,111 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(
 		}
 
 		switch {
-		case nodeID < lastNodeID:
+		case row.nodeID < lastNodeID:
 			return nil, &workflow.InternalServiceError{
 				Message: fmt.Sprintf("corrupted data, nodeID cannot decrease"),
 			}
-		case nodeID == lastNodeID:
+		case row.nodeID == lastNodeID:
 			return nil, &workflow.InternalServiceError{
 				Message: fmt.Sprintf("corrupted data, same nodeID must have smaller txnID"),
 			}
 		default: // row.NodeID > lastNodeID:
 			// NOTE: when row.nodeID > lastNodeID, we expect the one with largest txnID comes first
-			lastTxnID = txnID
-			lastNodeID = nodeID
-			history = append(history, eventBlob)
-			eventBlob = &p.DataBlob{}
-		}
-	}
-
-	if err := iter.Close(); err != nil {
-		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("ReadHistoryBranch. Close operation failed. Error: %v", err),
+			lastTxnID = row.txnID
+			lastNodeID = row.nodeID
+			history = append(history, row.data)
 		}
 	}
 
 	return &p.InternalReadHistoryBranchResponse{
 		History:           history,
-		NextPageToken:     pagingToken,
+		NextPageToken:     page.nextPageToken,
 		LastNodeID:        lastNodeID,
 		LastTransactionID: lastTxnID,
 	}, nil
 }
 
+// readHistoryNodePage reads one page of history nodes of a branch
+func readHistoryNodePage(
+	ctx context.Context,
+	session *gocql.Session,
+	request *p.InternalReadHistoryBranchRequest,
+) (*historyNodePage, error) {
+
+	query := session.Query(v2templateReadData, request.TreeID, request.BranchID, request.MinNodeID, request.MaxNodeID).WithContext(ctx)
+
+	iter := query.PageSize(int(request.PageSize)).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ReadHistoryBranch operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	page := &historyNodePage{
+		rows:          make([]historyNodeRow, 0, int(request.PageSize)),
+		nextPageToken: iter.PageState(),
+	}
+	row := historyNodeRow{data: &p.DataBlob{}}
+	for iter.Scan(&row.nodeID, &row.txnID, &row.data.Data, &row.data.Encoding) {
+		page.rows = append(page.rows, row)
+		row = historyNodeRow{data: &p.DataBlob{}}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ReadHistoryBranch. Close operation failed. Error: %v", err),
+		}
+	}
+	return page, nil
+}
+
 // ForkHistoryBranch forks a new branch from an existing branch
 // Note that application must provide a void forking nodeID, it must be a valid nodeID in that branch.
 // A valid forking nodeID can be an ancestor from the existing branch.
 // For example, we have branch B1 with three nodes(1[1,2], 3[3,4,5] and 6[6,7,8]. 1, 3 and 6 are nodeIDs (first eventID of the batch).
 // So B1 looks like this:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//        /
-//      6[6,7,8]
+//
+//	     1[1,2]
+//	     /
+//	   3[3,4,5]
+//	  /
+//	6[6,7,8]
 //
 // Assuming we have branch B2 which contains one ancestor B1 stopping at 6 (exclusive). So B2 inherit nodeID 1 and 3 from B1, and have its own nodeID 6 and 8.
 // Branch B2 looks like this:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//          \
-//           6[6,7]
-//           \
-//            8[8]
 //
-//Now we want to fork a new branch B3 from B2.
+//	  1[1,2]
+//	  /
+//	3[3,4,5]
+//	 \
+//	  6[6,7]
+//	  \
+//	   8[8]
+//
+// Now we want to fork a new branch B3 from B2.
 // The only valid forking nodeIDs are 3,6 or 8.
 // 1 is not valid because we can't fork from first node.
 // 2/4/5 is NOT valid either because they are inside a batch.
 //
 // Case #1: If we fork from nodeID 6, then B3 will have an ancestor B1 which stops at 6(exclusive).
 // As we append a batch of events[6,7,8,9] to B3, it will look like :
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//          \
-//         6[6,7,8,9]
+//
+//	  1[1,2]
+//	  /
+//	3[3,4,5]
+//	 \
+//	6[6,7,8,9]
 //
 // Case #2: If we fork from node 8, then B3 will have two ancestors: B1 stops at 6(exclusive) and ancestor B2 stops at 8(exclusive)
 // As we append a batch of events[8,9] to B3, it will look like:
-//           1[1,2]
-//           /
-//         3[3,4,5]
-//        /
-//      6[6,7]
-//       \
-//       8[8,9]
 //
+//	     1[1,2]
+//	     /
+//	   3[3,4,5]
+//	  /
+//	6[6,7]
+//	 \
+//	 8[8,9]
 func (h *cassandraHistoryV2Persistence) ForkHistoryBranch(
 	request *p.InternalForkHistoryBranchRequest,
 ) (*p.InternalForkHistoryBranchResponse, error) {
@@ -494,6 +562,89 @@ func (h *cassandraHistoryV2Persistence) GetAllHistoryTreeBranches(
 	return response, nil
 }
 
+// QuarantineHistoryBranch records a history branch found to be corrupted,
+// quarantining the same branch again overrides the previous record
+func (h *cassandraHistoryV2Persistence) QuarantineHistoryBranch(
+	request *p.QuarantineHistoryBranchRequest,
+) error {
+
+	branch := request.Branch
+	query := h.session.Query(v2templateInsertQuarantine,
+		branch.TreeID,
+		branch.BranchID,
+		branch.ShardID,
+		branch.DomainName,
+		branch.MinEventID,
+		branch.MaxEventID,
+		branch.Reason,
+		p.UnixNanoToDBTimestamp(branch.QuarantineTime.UnixNano()),
+	)
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("QuarantineHistoryBranch", err)
+	}
+	return nil
+}
+
+// GetQuarantinedHistoryBranches returns all quarantined history branches
+func (h *cassandraHistoryV2Persistence) GetQuarantinedHistoryBranches(
+	request *p.GetQuarantinedHistoryBranchesRequest,
+) (*p.GetQuarantinedHistoryBranchesResponse, error) {
+
+	query := h.session.Query(v2templateScanAllQuarantines)
+
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetQuarantinedHistoryBranches operation failed.  Not able to create query iterator.",
+		}
+	}
+	pagingToken := iter.PageState()
+
+	branches := make([]p.QuarantinedHistoryBranch, 0, request.PageSize)
+	treeUUID := gocql.UUID{}
+	branchUUID := gocql.UUID{}
+	branch := p.QuarantinedHistoryBranch{}
+
+	for iter.Scan(
+		&treeUUID,
+		&branchUUID,
+		&branch.ShardID,
+		&branch.DomainName,
+		&branch.MinEventID,
+		&branch.MaxEventID,
+		&branch.Reason,
+		&branch.QuarantineTime,
+	) {
+		branch.TreeID = treeUUID.String()
+		branch.BranchID = branchUUID.String()
+		branches = append(branches, branch)
+		branch = p.QuarantinedHistoryBranch{}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetQuarantinedHistoryBranches. Close operation failed. Error: %v", err),
+		}
+	}
+
+	return &p.GetQuarantinedHistoryBranchesResponse{
+		Branches:      branches,
+		NextPageToken: pagingToken,
+	}, nil
+}
+
+// DeleteQuarantinedHistoryBranch removes a history branch from quarantine
+func (h *cassandraHistoryV2Persistence) DeleteQuarantinedHistoryBranch(
+	request *p.DeleteQuarantinedHistoryBranchRequest,
+) error {
+
+	query := h.session.Query(v2templateDeleteQuarantine, request.TreeID, request.BranchID)
+	if err := query.Exec(); err != nil {
+		return convertCommonErrors("DeleteQuarantinedHistoryBranch", err)
+	}
+	return nil
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (h *cassandraHistoryV2Persistence) GetHistoryTree(
 	request *p.GetHistoryTreeRequest,
@@ -52,12 +52,16 @@ const (
 		`visibility_archival_status: ?, ` +
 		`visibility_archival_uri: ?, ` +
 		`bad_binaries: ?,` +
-		`bad_binaries_encoding: ?` +
+		`bad_binaries_encoding: ?, ` +
+		`known_task_lists: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
 		`active_cluster_name: ?, ` +
-		`clusters: ? ` +
+		`clusters: ?, ` +
+		`failover_state: ?, ` +
+		`pending_active_cluster_name: ?, ` +
+		`previous_failover_version: ? ` +
 		`}`
 
 	templateCreateDomainQuery = `INSERT INTO domains (` +
@@ -77,8 +81,10 @@ const (
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
-		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.bad_binaries, config.bad_binaries_encoding, config.known_task_lists, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`replication_config.failover_state, replication_config.pending_active_cluster_name, ` +
+		`replication_config.previous_failover_version, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
@@ -90,6 +96,7 @@ const (
 		`SET domain = ` + templateDomainInfoType + `, ` +
 		`config = ` + templateDomainConfigType + `, ` +
 		`replication_config = ` + templateDomainReplicationConfigType + `, ` +
+		`is_global_domain = ?, ` +
 		`config_version = ? ,` +
 		`failover_version = ? ,` +
 		`db_version = ? ` +
@@ -177,8 +184,12 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *p.InternalCreateDom
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		request.Config.KnownTaskLists,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.FailoverState,
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.PreviousFailoverVersion,
 		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
@@ -280,8 +291,12 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 		&config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&config.KnownTaskLists,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
+		&replicationConfig.FailoverState,
+		&replicationConfig.PendingActiveClusterName,
+		&replicationConfig.PreviousFailoverVersion,
 		&isGlobalDomain,
 		&configVersion,
 		&failoverVersion,
@@ -336,8 +351,13 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *p.InternalUpdateDom
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		request.Config.KnownTaskLists,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.FailoverState,
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.PreviousFailoverVersion,
+		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
 		nextVersion,
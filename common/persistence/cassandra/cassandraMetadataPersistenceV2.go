@@ -46,8 +46,10 @@ const (
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
-		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.bad_binaries, config.bad_binaries_encoding, config.known_task_lists, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`replication_config.failover_state, replication_config.pending_active_cluster_name, ` +
+		`replication_config.previous_failover_version, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
@@ -61,6 +63,7 @@ const (
 		`SET domain = ` + templateDomainInfoType + `, ` +
 		`config = ` + templateDomainConfigType + `, ` +
 		`replication_config = ` + templateDomainReplicationConfigType + `, ` +
+		`is_global_domain = ?, ` +
 		`config_version = ? ,` +
 		`failover_version = ? ,` +
 		`failover_notification_version = ? , ` +
@@ -88,8 +91,10 @@ const (
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
-		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.bad_binaries, config.bad_binaries_encoding, config.known_task_lists, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`replication_config.failover_state, replication_config.pending_active_cluster_name, ` +
+		`replication_config.previous_failover_version, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
@@ -182,8 +187,12 @@ func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.Intern
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		request.Config.KnownTaskLists,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.FailoverState,
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.PreviousFailoverVersion,
 		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
@@ -246,8 +255,13 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.InternalUpdateD
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		request.Config.KnownTaskLists,
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.FailoverState,
+		request.ReplicationConfig.PendingActiveClusterName,
+		request.ReplicationConfig.PreviousFailoverVersion,
+		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
 		request.FailoverNotificationVersion,
@@ -347,8 +361,12 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		&config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&config.KnownTaskLists,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
+		&replicationConfig.FailoverState,
+		&replicationConfig.PendingActiveClusterName,
+		&replicationConfig.PreviousFailoverVersion,
 		&isGlobalDomain,
 		&configVersion,
 		&failoverVersion,
@@ -421,8 +439,12 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 		&domain.Config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&domain.Config.KnownTaskLists,
 		&domain.ReplicationConfig.ActiveClusterName,
 		&replicationClusters,
+		&domain.ReplicationConfig.FailoverState,
+		&domain.ReplicationConfig.PendingActiveClusterName,
+		&domain.ReplicationConfig.PreviousFailoverVersion,
 		&domain.IsGlobalDomain,
 		&domain.ConfigVersion,
 		&domain.FailoverVersion,
@@ -0,0 +1,497 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/uber/cadence/.gen/go/cassandrablobs"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+// tombstone key prefixes, one per mutable state map stored in the snapshot
+const (
+	tombstonePrefixActivity      = "a:"
+	tombstonePrefixTimer         = "t:"
+	tombstonePrefixChild         = "c:"
+	tombstonePrefixRequestCancel = "r:"
+	tombstonePrefixSignal        = "s:"
+	tombstonePrefixSignalRequest = "q:"
+)
+
+type (
+	// mutableStateSnapshot is the compacted form of the mutable state maps of an execution,
+	// stored as a single blob in the mutable_state_snapshot column.
+	// Map columns and tombstones written after the snapshot are merged on top of it during reads.
+	mutableStateSnapshot struct {
+		ActivityInfos       map[int64]*p.InternalActivityInfo
+		TimerInfos          map[string]*p.TimerInfo
+		ChildExecutionInfos map[int64]*p.InternalChildExecutionInfo
+		RequestCancelInfos  map[int64]*p.RequestCancelInfo
+		SignalInfos         map[int64]*p.SignalInfo
+		SignalRequestedIDs  []string
+	}
+)
+
+var mutableStateSnapshotEncoder = codec.NewThriftRWEncoder()
+
+func newMutableStateSnapshot(
+	workflowMutation *p.InternalWorkflowMutation,
+) *mutableStateSnapshot {
+
+	snapshot := &mutableStateSnapshot{
+		ActivityInfos:       make(map[int64]*p.InternalActivityInfo, len(workflowMutation.UpsertActivityInfos)),
+		TimerInfos:          make(map[string]*p.TimerInfo, len(workflowMutation.UpserTimerInfos)),
+		ChildExecutionInfos: make(map[int64]*p.InternalChildExecutionInfo, len(workflowMutation.UpsertChildExecutionInfos)),
+		RequestCancelInfos:  make(map[int64]*p.RequestCancelInfo, len(workflowMutation.UpsertRequestCancelInfos)),
+		SignalInfos:         make(map[int64]*p.SignalInfo, len(workflowMutation.UpsertSignalInfos)),
+		SignalRequestedIDs:  workflowMutation.UpsertSignalRequestedIDs,
+	}
+	for _, info := range workflowMutation.UpsertActivityInfos {
+		snapshot.ActivityInfos[info.ScheduleID] = info
+	}
+	for _, info := range workflowMutation.UpserTimerInfos {
+		snapshot.TimerInfos[info.TimerID] = info
+	}
+	for _, info := range workflowMutation.UpsertChildExecutionInfos {
+		snapshot.ChildExecutionInfos[info.InitiatedID] = info
+	}
+	for _, info := range workflowMutation.UpsertRequestCancelInfos {
+		snapshot.RequestCancelInfos[info.InitiatedID] = info
+	}
+	for _, info := range workflowMutation.UpsertSignalInfos {
+		snapshot.SignalInfos[info.InitiatedID] = info
+	}
+	return snapshot
+}
+
+func serializeMutableStateSnapshot(
+	snapshot *mutableStateSnapshot,
+) (*p.DataBlob, error) {
+
+	data, err := mutableStateSnapshotEncoder.Encode(mutableStateSnapshotToThrift(snapshot))
+	if err != nil {
+		return nil, err
+	}
+	return p.NewDataBlob(data, common.EncodingTypeThriftRW), nil
+}
+
+func deserializeMutableStateSnapshot(
+	blob *p.DataBlob,
+) (*mutableStateSnapshot, error) {
+
+	if blob.Encoding != common.EncodingTypeThriftRW {
+		return nil, fmt.Errorf("unsupported mutable state snapshot encoding: %v", blob.Encoding)
+	}
+	snapshot := &cassandrablobs.MutableStateSnapshot{}
+	if err := mutableStateSnapshotEncoder.Decode(blob.Data, snapshot); err != nil {
+		return nil, err
+	}
+	return mutableStateSnapshotFromThrift(snapshot), nil
+}
+
+func mutableStateSnapshotToThrift(
+	snapshot *mutableStateSnapshot,
+) *cassandrablobs.MutableStateSnapshot {
+
+	result := &cassandrablobs.MutableStateSnapshot{
+		SignalRequestedIDs: snapshot.SignalRequestedIDs,
+	}
+	for _, info := range snapshot.ActivityInfos {
+		scheduledEvent, scheduledEventEncoding := dataBlobToThrift(info.ScheduledEvent)
+		startedEvent, startedEventEncoding := dataBlobToThrift(info.StartedEvent)
+		result.ActivityInfos = append(result.ActivityInfos, &cassandrablobs.ActivityInfo{
+			Version:                       common.Int64Ptr(info.Version),
+			ScheduleID:                    common.Int64Ptr(info.ScheduleID),
+			ScheduledEventBatchID:         common.Int64Ptr(info.ScheduledEventBatchID),
+			ScheduledEvent:                scheduledEvent,
+			ScheduledEventEncoding:        scheduledEventEncoding,
+			ScheduledTimeNanos:            timeToThrift(info.ScheduledTime),
+			StartedID:                     common.Int64Ptr(info.StartedID),
+			StartedEvent:                  startedEvent,
+			StartedEventEncoding:          startedEventEncoding,
+			StartedTimeNanos:              timeToThrift(info.StartedTime),
+			ActivityID:                    common.StringPtr(info.ActivityID),
+			RequestID:                     common.StringPtr(info.RequestID),
+			Details:                       info.Details,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(info.ScheduleToStartTimeout),
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(info.ScheduleToCloseTimeout),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(info.StartToCloseTimeout),
+			HeartbeatTimeoutSeconds:       common.Int32Ptr(info.HeartbeatTimeout),
+			CancelRequested:               common.BoolPtr(info.CancelRequested),
+			CancelRequestID:               common.Int64Ptr(info.CancelRequestID),
+			LastHeartbeatUpdatedTimeNanos: timeToThrift(info.LastHeartBeatUpdatedTime),
+			TimerTaskStatus:               common.Int32Ptr(info.TimerTaskStatus),
+			Attempt:                       common.Int32Ptr(info.Attempt),
+			DomainID:                      common.StringPtr(info.DomainID),
+			StartedIdentity:               common.StringPtr(info.StartedIdentity),
+			TaskList:                      common.StringPtr(info.TaskList),
+			HasRetryPolicy:                common.BoolPtr(info.HasRetryPolicy),
+			RetryInitialIntervalSeconds:   common.Int32Ptr(info.InitialInterval),
+			RetryMaximumIntervalSeconds:   common.Int32Ptr(info.MaximumInterval),
+			RetryMaximumAttempts:          common.Int32Ptr(info.MaximumAttempts),
+			RetryExpirationTimeNanos:      timeToThrift(info.ExpirationTime),
+			RetryBackoffCoefficient:       common.Float64Ptr(info.BackoffCoefficient),
+			RetryNonRetryableErrors:       info.NonRetriableErrors,
+			RetryLastFailureReason:        common.StringPtr(info.LastFailureReason),
+			RetryLastWorkerIdentity:       common.StringPtr(info.LastWorkerIdentity),
+			RetryLastFailureDetails:       info.LastFailureDetails,
+		})
+	}
+	for _, info := range snapshot.TimerInfos {
+		result.TimerInfos = append(result.TimerInfos, &cassandrablobs.TimerInfo{
+			Version:         common.Int64Ptr(info.Version),
+			TimerID:         common.StringPtr(info.TimerID),
+			StartedID:       common.Int64Ptr(info.StartedID),
+			ExpiryTimeNanos: timeToThrift(info.ExpiryTime),
+			TaskID:          common.Int64Ptr(info.TaskID),
+		})
+	}
+	for _, info := range snapshot.ChildExecutionInfos {
+		initiatedEvent, initiatedEventEncoding := dataBlobToThrift(info.InitiatedEvent)
+		startedEvent, startedEventEncoding := dataBlobToThrift(info.StartedEvent)
+		result.ChildExecutionInfos = append(result.ChildExecutionInfos, &cassandrablobs.ChildExecutionInfo{
+			Version:                common.Int64Ptr(info.Version),
+			InitiatedID:            common.Int64Ptr(info.InitiatedID),
+			InitiatedEventBatchID:  common.Int64Ptr(info.InitiatedEventBatchID),
+			InitiatedEvent:         initiatedEvent,
+			InitiatedEventEncoding: initiatedEventEncoding,
+			StartedID:              common.Int64Ptr(info.StartedID),
+			StartedWorkflowID:      common.StringPtr(info.StartedWorkflowID),
+			StartedRunID:           common.StringPtr(info.StartedRunID),
+			StartedEvent:           startedEvent,
+			StartedEventEncoding:   startedEventEncoding,
+			CreateRequestID:        common.StringPtr(info.CreateRequestID),
+			DomainName:             common.StringPtr(info.DomainName),
+			WorkflowTypeName:       common.StringPtr(info.WorkflowTypeName),
+			ParentClosePolicy:      common.Int32Ptr(int32(info.ParentClosePolicy)),
+		})
+	}
+	for _, info := range snapshot.RequestCancelInfos {
+		result.RequestCancelInfos = append(result.RequestCancelInfos, &cassandrablobs.RequestCancelInfo{
+			Version:               common.Int64Ptr(info.Version),
+			InitiatedID:           common.Int64Ptr(info.InitiatedID),
+			InitiatedEventBatchID: common.Int64Ptr(info.InitiatedEventBatchID),
+			CancelRequestID:       common.StringPtr(info.CancelRequestID),
+		})
+	}
+	for _, info := range snapshot.SignalInfos {
+		result.SignalInfos = append(result.SignalInfos, &cassandrablobs.SignalInfo{
+			Version:               common.Int64Ptr(info.Version),
+			InitiatedID:           common.Int64Ptr(info.InitiatedID),
+			InitiatedEventBatchID: common.Int64Ptr(info.InitiatedEventBatchID),
+			RequestID:             common.StringPtr(info.SignalRequestID),
+			Name:                  common.StringPtr(info.SignalName),
+			Input:                 info.Input,
+			Control:               info.Control,
+		})
+	}
+	return result
+}
+
+func mutableStateSnapshotFromThrift(
+	snapshot *cassandrablobs.MutableStateSnapshot,
+) *mutableStateSnapshot {
+
+	result := &mutableStateSnapshot{
+		ActivityInfos:       make(map[int64]*p.InternalActivityInfo, len(snapshot.ActivityInfos)),
+		TimerInfos:          make(map[string]*p.TimerInfo, len(snapshot.TimerInfos)),
+		ChildExecutionInfos: make(map[int64]*p.InternalChildExecutionInfo, len(snapshot.ChildExecutionInfos)),
+		RequestCancelInfos:  make(map[int64]*p.RequestCancelInfo, len(snapshot.RequestCancelInfos)),
+		SignalInfos:         make(map[int64]*p.SignalInfo, len(snapshot.SignalInfos)),
+		SignalRequestedIDs:  snapshot.SignalRequestedIDs,
+	}
+	for _, info := range snapshot.ActivityInfos {
+		result.ActivityInfos[info.GetScheduleID()] = &p.InternalActivityInfo{
+			Version:                  info.GetVersion(),
+			ScheduleID:               info.GetScheduleID(),
+			ScheduledEventBatchID:    info.GetScheduledEventBatchID(),
+			ScheduledEvent:           dataBlobFromThrift(info.ScheduledEvent, info.ScheduledEventEncoding),
+			ScheduledTime:            timeFromThrift(info.ScheduledTimeNanos),
+			StartedID:                info.GetStartedID(),
+			StartedEvent:             dataBlobFromThrift(info.StartedEvent, info.StartedEventEncoding),
+			StartedTime:              timeFromThrift(info.StartedTimeNanos),
+			ActivityID:               info.GetActivityID(),
+			RequestID:                info.GetRequestID(),
+			Details:                  info.Details,
+			ScheduleToStartTimeout:   info.GetScheduleToStartTimeoutSeconds(),
+			ScheduleToCloseTimeout:   info.GetScheduleToCloseTimeoutSeconds(),
+			StartToCloseTimeout:      info.GetStartToCloseTimeoutSeconds(),
+			HeartbeatTimeout:         info.GetHeartbeatTimeoutSeconds(),
+			CancelRequested:          info.GetCancelRequested(),
+			CancelRequestID:          info.GetCancelRequestID(),
+			LastHeartBeatUpdatedTime: timeFromThrift(info.LastHeartbeatUpdatedTimeNanos),
+			TimerTaskStatus:          info.GetTimerTaskStatus(),
+			Attempt:                  info.GetAttempt(),
+			DomainID:                 info.GetDomainID(),
+			StartedIdentity:          info.GetStartedIdentity(),
+			TaskList:                 info.GetTaskList(),
+			HasRetryPolicy:           info.GetHasRetryPolicy(),
+			InitialInterval:          info.GetRetryInitialIntervalSeconds(),
+			BackoffCoefficient:       info.GetRetryBackoffCoefficient(),
+			MaximumInterval:          info.GetRetryMaximumIntervalSeconds(),
+			ExpirationTime:           timeFromThrift(info.RetryExpirationTimeNanos),
+			MaximumAttempts:          info.GetRetryMaximumAttempts(),
+			NonRetriableErrors:       info.RetryNonRetryableErrors,
+			LastFailureReason:        info.GetRetryLastFailureReason(),
+			LastWorkerIdentity:       info.GetRetryLastWorkerIdentity(),
+			LastFailureDetails:       info.RetryLastFailureDetails,
+		}
+	}
+	for _, info := range snapshot.TimerInfos {
+		result.TimerInfos[info.GetTimerID()] = &p.TimerInfo{
+			Version:    info.GetVersion(),
+			TimerID:    info.GetTimerID(),
+			StartedID:  info.GetStartedID(),
+			ExpiryTime: timeFromThrift(info.ExpiryTimeNanos),
+			TaskID:     info.GetTaskID(),
+		}
+	}
+	for _, info := range snapshot.ChildExecutionInfos {
+		result.ChildExecutionInfos[info.GetInitiatedID()] = &p.InternalChildExecutionInfo{
+			Version:               info.GetVersion(),
+			InitiatedID:           info.GetInitiatedID(),
+			InitiatedEventBatchID: info.GetInitiatedEventBatchID(),
+			InitiatedEvent:        dataBlobFromThrift(info.InitiatedEvent, info.InitiatedEventEncoding),
+			StartedID:             info.GetStartedID(),
+			StartedWorkflowID:     info.GetStartedWorkflowID(),
+			StartedRunID:          info.GetStartedRunID(),
+			StartedEvent:          dataBlobFromThrift(info.StartedEvent, info.StartedEventEncoding),
+			CreateRequestID:       info.GetCreateRequestID(),
+			DomainName:            info.GetDomainName(),
+			WorkflowTypeName:      info.GetWorkflowTypeName(),
+			ParentClosePolicy:     workflow.ParentClosePolicy(info.GetParentClosePolicy()),
+		}
+	}
+	for _, info := range snapshot.RequestCancelInfos {
+		result.RequestCancelInfos[info.GetInitiatedID()] = &p.RequestCancelInfo{
+			Version:               info.GetVersion(),
+			InitiatedID:           info.GetInitiatedID(),
+			InitiatedEventBatchID: info.GetInitiatedEventBatchID(),
+			CancelRequestID:       info.GetCancelRequestID(),
+		}
+	}
+	for _, info := range snapshot.SignalInfos {
+		result.SignalInfos[info.GetInitiatedID()] = &p.SignalInfo{
+			Version:               info.GetVersion(),
+			InitiatedID:           info.GetInitiatedID(),
+			InitiatedEventBatchID: info.GetInitiatedEventBatchID(),
+			SignalRequestID:       info.GetRequestID(),
+			SignalName:            info.GetName(),
+			Input:                 info.Input,
+			Control:               info.Control,
+		}
+	}
+	return result
+}
+
+func dataBlobToThrift(
+	blob *p.DataBlob,
+) ([]byte, *string) {
+
+	if blob == nil {
+		return nil, nil
+	}
+	return blob.Data, common.StringPtr(string(blob.Encoding))
+}
+
+func dataBlobFromThrift(
+	data []byte,
+	encoding *string,
+) *p.DataBlob {
+
+	if encoding == nil {
+		return nil
+	}
+	return p.NewDataBlob(data, common.EncodingType(*encoding))
+}
+
+// timeToThrift leaves zero times unset, as they cannot be represented in unix nanos
+func timeToThrift(
+	t time.Time,
+) *int64 {
+
+	if t.IsZero() {
+		return nil
+	}
+	return common.Int64Ptr(t.UnixNano())
+}
+
+func timeFromThrift(
+	nanos *int64,
+) time.Time {
+
+	if nanos == nil {
+		return time.Time{}
+	}
+	return time.Unix(0, *nanos).UTC()
+}
+
+// mergeMutableStateSnapshot applies the snapshot, minus the tombstoned entries, underneath the given state.
+// Entries already present in the state were written after the snapshot and take precedence.
+func mergeMutableStateSnapshot(
+	state *p.InternalWorkflowMutableState,
+	snapshot *mutableStateSnapshot,
+	tombstones map[string]struct{},
+) {
+
+	isDeleted := func(prefix string, key string) bool {
+		_, ok := tombstones[prefix+key]
+		return ok
+	}
+
+	for id, info := range snapshot.ActivityInfos {
+		if _, ok := state.ActivitInfos[id]; !ok && !isDeleted(tombstonePrefixActivity, strconv.FormatInt(id, 10)) {
+			state.ActivitInfos[id] = info
+		}
+	}
+	for id, info := range snapshot.TimerInfos {
+		if _, ok := state.TimerInfos[id]; !ok && !isDeleted(tombstonePrefixTimer, id) {
+			state.TimerInfos[id] = info
+		}
+	}
+	for id, info := range snapshot.ChildExecutionInfos {
+		if _, ok := state.ChildExecutionInfos[id]; !ok && !isDeleted(tombstonePrefixChild, strconv.FormatInt(id, 10)) {
+			state.ChildExecutionInfos[id] = info
+		}
+	}
+	for id, info := range snapshot.RequestCancelInfos {
+		if _, ok := state.RequestCancelInfos[id]; !ok && !isDeleted(tombstonePrefixRequestCancel, strconv.FormatInt(id, 10)) {
+			state.RequestCancelInfos[id] = info
+		}
+	}
+	for id, info := range snapshot.SignalInfos {
+		if _, ok := state.SignalInfos[id]; !ok && !isDeleted(tombstonePrefixSignal, strconv.FormatInt(id, 10)) {
+			state.SignalInfos[id] = info
+		}
+	}
+	for _, id := range snapshot.SignalRequestedIDs {
+		if !isDeleted(tombstonePrefixSignalRequest, id) {
+			state.SignalRequestedIDs[id] = struct{}{}
+		}
+	}
+}
+
+// getMutableStateTombstones returns the tombstones for entries deleted by the mutation,
+// which hide the corresponding entries of an existing snapshot
+func getMutableStateTombstones(
+	workflowMutation *p.InternalWorkflowMutation,
+) []string {
+
+	var tombstones []string
+	for _, id := range workflowMutation.DeleteActivityInfos {
+		tombstones = append(tombstones, tombstonePrefixActivity+strconv.FormatInt(id, 10))
+	}
+	for _, id := range workflowMutation.DeleteTimerInfos {
+		tombstones = append(tombstones, tombstonePrefixTimer+id)
+	}
+	if workflowMutation.DeleteChildExecutionInfo != nil {
+		tombstones = append(tombstones, tombstonePrefixChild+strconv.FormatInt(*workflowMutation.DeleteChildExecutionInfo, 10))
+	}
+	if workflowMutation.DeleteRequestCancelInfo != nil {
+		tombstones = append(tombstones, tombstonePrefixRequestCancel+strconv.FormatInt(*workflowMutation.DeleteRequestCancelInfo, 10))
+	}
+	if workflowMutation.DeleteSignalInfo != nil {
+		tombstones = append(tombstones, tombstonePrefixSignal+strconv.FormatInt(*workflowMutation.DeleteSignalInfo, 10))
+	}
+	if workflowMutation.DeleteSignalRequestedID != "" {
+		tombstones = append(tombstones, tombstonePrefixSignalRequest+workflowMutation.DeleteSignalRequestedID)
+	}
+	return tombstones
+}
+
+func updateMutableStateSnapshot(
+	batch *gocql.Batch,
+	workflowMutation *p.InternalWorkflowMutation,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+
+	blob, err := serializeMutableStateSnapshot(newMutableStateSnapshot(workflowMutation))
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	batch.Query(templateUpdateMutableStateSnapshotQuery,
+		blob.Data,
+		string(blob.Encoding),
+		shardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID)
+	return nil
+}
+
+func updateMutableStateTombstones(
+	batch *gocql.Batch,
+	workflowMutation *p.InternalWorkflowMutation,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) {
+
+	tombstones := getMutableStateTombstones(workflowMutation)
+	if len(tombstones) == 0 {
+		return
+	}
+
+	batch.Query(templateAddMutableStateTombstonesQuery,
+		tombstones,
+		shardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID)
+}
+
+func resetMutableStateSnapshot(
+	batch *gocql.Batch,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) {
+
+	batch.Query(templateResetMutableStateSnapshotQuery,
+		shardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	mutableStateSnapshotSuite struct {
+		suite.Suite
+	}
+)
+
+func TestMutableStateSnapshotSuite(t *testing.T) {
+	s := new(mutableStateSnapshotSuite)
+	suite.Run(t, s)
+}
+
+func (s *mutableStateSnapshotSuite) TestSerializeDeserialize() {
+	deleteSignal := int64(9)
+	mutation := &p.InternalWorkflowMutation{
+		UpsertActivityInfos: []*p.InternalActivityInfo{{
+			ScheduleID:               5,
+			ActivityID:               "activity",
+			ScheduledEvent:           p.NewDataBlob([]byte("scheduled"), common.EncodingTypeThriftRW),
+			ScheduledTime:            time.Unix(0, 100).UTC(),
+			Details:                  []byte("details"),
+			LastHeartBeatUpdatedTime: time.Unix(0, 200).UTC(),
+			HasRetryPolicy:           true,
+			BackoffCoefficient:       2,
+			NonRetriableErrors:       []string{"error"},
+		}},
+		UpserTimerInfos: []*p.TimerInfo{{TimerID: "timer", StartedID: 6, ExpiryTime: time.Unix(0, 300).UTC()}},
+		UpsertChildExecutionInfos: []*p.InternalChildExecutionInfo{{
+			InitiatedID:       7,
+			InitiatedEvent:    p.NewDataBlob([]byte("initiated"), common.EncodingTypeThriftRW),
+			StartedRunID:      "run",
+			ParentClosePolicy: workflow.ParentClosePolicyRequestCancel,
+		}},
+		UpsertRequestCancelInfos: []*p.RequestCancelInfo{{InitiatedID: 8, CancelRequestID: "cancel"}},
+		UpsertSignalInfos:        []*p.SignalInfo{{InitiatedID: 9, SignalName: "signal", Input: []byte("input")}},
+		DeleteSignalInfo:         &deleteSignal,
+		UpsertSignalRequestedIDs: []string{"signal-request"},
+	}
+
+	blob, err := serializeMutableStateSnapshot(newMutableStateSnapshot(mutation))
+	s.NoError(err)
+	s.Equal(common.EncodingTypeThriftRW, blob.Encoding)
+
+	snapshot, err := deserializeMutableStateSnapshot(blob)
+	s.NoError(err)
+	s.Equal(newMutableStateSnapshot(mutation), snapshot)
+
+	_, err = deserializeMutableStateSnapshot(&p.DataBlob{Data: blob.Data, Encoding: common.EncodingTypeGob})
+	s.Error(err)
+}
+
+func (s *mutableStateSnapshotSuite) TestMerge() {
+	snapshot := &mutableStateSnapshot{
+		ActivityInfos: map[int64]*p.InternalActivityInfo{
+			1: {ScheduleID: 1, ActivityID: "snapshot"},
+			2: {ScheduleID: 2, ActivityID: "snapshot"},
+			3: {ScheduleID: 3, ActivityID: "snapshot"},
+		},
+		TimerInfos: map[string]*p.TimerInfo{
+			"t1": {TimerID: "t1"},
+			"t2": {TimerID: "t2"},
+		},
+		SignalRequestedIDs: []string{"r1", "r2"},
+	}
+	state := &p.InternalWorkflowMutableState{
+		ActivitInfos: map[int64]*p.InternalActivityInfo{
+			2: {ScheduleID: 2, ActivityID: "delta"},
+			3: {ScheduleID: 3, ActivityID: "delta"},
+			4: {ScheduleID: 4, ActivityID: "delta"},
+		},
+		TimerInfos:          map[string]*p.TimerInfo{},
+		ChildExecutionInfos: map[int64]*p.InternalChildExecutionInfo{},
+		RequestCancelInfos:  map[int64]*p.RequestCancelInfo{},
+		SignalInfos:         map[int64]*p.SignalInfo{},
+		SignalRequestedIDs:  map[string]struct{}{},
+	}
+	// activity 3 was deleted after the snapshot and then scheduled again
+	tombstones := map[string]struct{}{
+		tombstonePrefixActivity + "1":       {},
+		tombstonePrefixActivity + "3":       {},
+		tombstonePrefixTimer + "t2":         {},
+		tombstonePrefixSignalRequest + "r1": {},
+	}
+
+	mergeMutableStateSnapshot(state, snapshot, tombstones)
+	s.Equal(3, len(state.ActivitInfos))
+	s.Equal("delta", state.ActivitInfos[2].ActivityID)
+	s.Equal("delta", state.ActivitInfos[3].ActivityID)
+	s.Equal("delta", state.ActivitInfos[4].ActivityID)
+	s.Equal(map[string]*p.TimerInfo{"t1": {TimerID: "t1"}}, state.TimerInfos)
+	s.Equal(map[string]struct{}{"r2": {}}, state.SignalRequestedIDs)
+}
+
+func (s *mutableStateSnapshotSuite) TestGetMutableStateTombstones() {
+	deleteChild := int64(7)
+	mutation := &p.InternalWorkflowMutation{
+		DeleteActivityInfos:      []int64{5},
+		DeleteTimerInfos:         []string{"timer"},
+		DeleteChildExecutionInfo: &deleteChild,
+		DeleteSignalRequestedID:  "signal-request",
+	}
+	s.Equal([]string{"a:5", "t:timer", "c:7", "q:signal-request"}, getMutableStateTombstones(mutation))
+	s.Empty(getMutableStateTombstones(&p.InternalWorkflowMutation{}))
+}
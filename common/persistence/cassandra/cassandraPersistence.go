@@ -108,7 +108,8 @@ const (
 		`cluster_transfer_ack_level: ?, ` +
 		`cluster_timer_ack_level: ?, ` +
 		`domain_notification_version: ?, ` +
-		`cluster_replication_level: ? ` +
+		`cluster_replication_level: ?, ` +
+		`max_observed_time: ? ` +
 		`}`
 
 	templateWorkflowExecutionType = `{` +
@@ -169,7 +170,16 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`query_types: ?, ` +
+		`last_decision_binary_checksum: ?, ` +
+		`paused: ?, ` +
+		`priority: ?, ` +
+		`termination_protected: ?, ` +
+		`event_count: ?, ` +
+		`activity_payload_bytes: ?, ` +
+		`signal_event_count: ?, ` +
+		`timer_count: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -317,7 +327,10 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`adaptive_partition_version: ?, ` +
+		`num_read_partitions: ?, ` +
+		`num_write_partitions: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -407,7 +420,8 @@ workflow_state = ? ` +
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map, ` +
+		`mutable_state_snapshot, mutable_state_snapshot_encoding, mutable_state_tombstones ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -671,6 +685,46 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateUpdateMutableStateSnapshotQuery = `UPDATE executions ` +
+		`SET mutable_state_snapshot = ?, ` +
+		`mutable_state_snapshot_encoding = ?, ` +
+		`mutable_state_tombstones = {}, ` +
+		`activity_map = {}, ` +
+		`timer_map = {}, ` +
+		`child_executions_map = {}, ` +
+		`request_cancel_map = {}, ` +
+		`signal_map = {}, ` +
+		`signal_requested = {} ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
+	templateAddMutableStateTombstonesQuery = `UPDATE executions ` +
+		`SET mutable_state_tombstones = mutable_state_tombstones + ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
+	templateResetMutableStateSnapshotQuery = `UPDATE executions ` +
+		`SET mutable_state_snapshot = null, ` +
+		`mutable_state_snapshot_encoding = null, ` +
+		`mutable_state_tombstones = {} ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
 	templateGetTransferTasksQuery = `SELECT transfer ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -836,6 +890,8 @@ type (
 		cassandraStore
 		shardID            int
 		currentClusterName string
+		// oldLayout is set while the number of history shards is changed
+		oldLayout *oldShardLayout
 	}
 )
 
@@ -844,7 +900,7 @@ var _ p.ExecutionStore = (*cassandraPersistence)(nil)
 // newShardPersistence is used to create an instance of ShardManager implementation
 func newShardPersistence(cfg config.Cassandra, clusterName string, logger log.Logger) (p.ShardStore, error) {
 	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
+	cluster.Keyspace = cfg.GetExecutionsKeyspace()
 	cluster.ProtoVersion = cassandraProtoVersion
 	cluster.Consistency = gocql.LocalQuorum
 	cluster.SerialConsistency = gocql.LocalSerial
@@ -854,11 +910,17 @@ func newShardPersistence(cfg config.Cassandra, clusterName string, logger log.Lo
 	if err != nil {
 		return nil, err
 	}
+	oldLayout, err := newOldShardLayout(cfg, clusterName, logger)
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
 
 	return &cassandraPersistence{
 		cassandraStore:     cassandraStore{session: session, logger: logger},
 		shardID:            -1,
 		currentClusterName: clusterName,
+		oldLayout:          oldLayout,
 	}, nil
 }
 
@@ -894,6 +956,14 @@ func (d *cassandraStore) Close() {
 	}
 }
 
+// Close releases the underlying resources held by this object
+func (d *cassandraPersistence) Close() {
+	d.cassandraStore.Close()
+	if d.oldLayout != nil {
+		d.oldLayout.close()
+	}
+}
+
 func (d *cassandraPersistence) GetShardID() int {
 	return d.shardID
 }
@@ -921,6 +991,7 @@ func (d *cassandraPersistence) CreateShard(request *p.CreateShardRequest) error
 		shardInfo.ClusterTimerAckLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.ClusterReplicationLevel,
+		shardInfo.MaxObservedTime,
 		shardInfo.RangeID)
 
 	previous := make(map[string]interface{})
@@ -961,6 +1032,9 @@ func (d *cassandraPersistence) GetShard(request *p.GetShardRequest) (*p.GetShard
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
 		if err == gocql.ErrNotFound {
+			if d.oldLayout != nil {
+				return d.createShardFromOldLayout(shardID)
+			}
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("Shard not found.  ShardId: %v", shardID),
 			}
@@ -980,6 +1054,19 @@ func (d *cassandraPersistence) GetShard(request *p.GetShardRequest) (*p.GetShard
 	return &p.GetShardResponse{ShardInfo: info}, nil
 }
 
+// createShardFromOldLayout creates a shard missing from the executions keyspace while the number of history shards is changed
+func (d *cassandraPersistence) createShardFromOldLayout(shardID int) (*p.GetShardResponse, error) {
+	shardInfo, err := d.oldLayout.newShardInfo(shardID)
+	if err != nil {
+		return nil, err
+	}
+	err = d.CreateShard(&p.CreateShardRequest{ShardInfo: shardInfo})
+	if _, ok := err.(*p.ShardAlreadyExistError); err != nil && !ok {
+		return nil, err
+	}
+	return d.GetShard(&p.GetShardRequest{ShardID: shardID})
+}
+
 func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error {
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 	shardInfo := request.ShardInfo
@@ -997,6 +1084,7 @@ func (d *cassandraPersistence) UpdateShard(request *p.UpdateShardRequest) error
 		shardInfo.ClusterTimerAckLevel,
 		shardInfo.DomainNotificationVersion,
 		shardInfo.ClusterReplicationLevel,
+		shardInfo.MaxObservedTime,
 		shardInfo.RangeID,
 		shardInfo.ShardID,
 		rowTypeShard,
@@ -1048,6 +1136,11 @@ func (d *cassandraPersistence) CreateWorkflowExecution(
 	workflowID := executionInfo.WorkflowID
 	runID := executionInfo.RunID
 
+	// the current run of the workflow may still be in the old layout
+	if _, err := d.moveExecutionFromOldLayout("CreateWorkflowExecution", domainID, workflowID, permanentRunID); err != nil {
+		return nil, err
+	}
+
 	if err := createOrUpdateCurrentExecution(batch,
 		request.CreateWorkflowMode,
 		d.shardID,
@@ -1204,6 +1297,13 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *p.GetWorkflowExecut
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
 		if err == gocql.ErrNotFound {
+			moved, err := d.moveExecutionFromOldLayout("GetWorkflowExecution", request.DomainID, *execution.WorkflowId, *execution.RunId)
+			if err != nil {
+				return nil, err
+			}
+			if moved {
+				return d.GetWorkflowExecution(request)
+			}
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
 					*execution.WorkflowId, *execution.RunId),
@@ -1281,6 +1381,25 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *p.GetWorkflowExecut
 	}
 	state.BufferedEvents = bufferedEventsBlobs
 
+	tombstones := make(map[string]struct{})
+	for _, v := range result["mutable_state_tombstones"].([]string) {
+		tombstones[v] = struct{}{}
+	}
+	state.MutableStateDeltaSize = len(activityInfos) + len(timerInfos) + len(childExecutionInfos) +
+		len(requestCancelInfos) + len(signalInfos) + len(signalRequestedIDs) + len(tombstones)
+
+	if data := result["mutable_state_snapshot"].([]byte); len(data) > 0 {
+		encoding := result["mutable_state_snapshot_encoding"].(string)
+		snapshot, err := deserializeMutableStateSnapshot(p.NewDataBlob(data, common.EncodingType(encoding)))
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		mergeMutableStateSnapshot(state, snapshot, tombstones)
+		state.HasMutableStateSnapshot = true
+	}
+
 	return &p.InternalGetWorkflowExecutionResponse{State: state}, nil
 }
 
@@ -1289,68 +1408,64 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 	batch := d.session.NewBatch(gocql.LoggedBatch)
 
 	updateWorkflow := request.UpdateWorkflowMutation
-	shardID := d.shardID
 	executionInfo := updateWorkflow.ExecutionInfo
 
-	if err := applyWorkflowMutationBatch(batch, shardID, &updateWorkflow); err != nil {
+	if err := d.prepareUpdateWorkflowExecutionBatch(batch, request); err != nil {
 		return err
 	}
 
-	if request.NewWorkflowSnapshot != nil {
+	// Verifies that the RangeID has not changed
+	batch.Query(templateUpdateLeaseQuery,
+		request.RangeID,
+		d.shardID,
+		rowTypeShard,
+		rowTypeShardDomainID,
+		rowTypeShardWorkflowID,
+		rowTypeShardRunID,
+		defaultVisibilityTimestamp,
+		rowTypeShardTaskID,
+		request.RangeID,
+	)
 
-		newExecutionInfo := request.NewWorkflowSnapshot.ExecutionInfo
-		newReplicationState := request.NewWorkflowSnapshot.ReplicationState
-		newDomainID := newExecutionInfo.DomainID
-		newWorkflowID := newExecutionInfo.WorkflowID
-		newRunID := newExecutionInfo.RunID
+	previous := make(map[string]interface{})
+	applied, iter, err := d.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
 
-		if err := createOrUpdateCurrentExecution(batch,
-			p.CreateWorkflowModeContinueAsNew,
-			d.shardID,
-			newDomainID,
-			newWorkflowID,
-			newRunID,
-			newExecutionInfo.State,
-			newExecutionInfo.CloseStatus,
-			newExecutionInfo.CreateRequestID,
-			newReplicationState,
-			executionInfo.RunID,
-			0, // for continue as new, this is not used
-		); err != nil {
-			return err
+	if err != nil {
+		if isTimeoutError(err) {
+			// Write may have succeeded, but we don't know
+			// return this info to the caller so they have the option of trying to find out by executing a read
+			return &p.TimeoutError{Msg: fmt.Sprintf("UpdateWorkflowExecution timed out. Error: %v", err)}
+		} else if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+			}
 		}
-		if err := applyWorkflowSnapshotBatchAsNew(batch,
-			d.shardID,
-			request.NewWorkflowSnapshot,
-		); err != nil {
-			return err
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
 		}
-	} else {
-		startVersion := common.EmptyVersion
-		lastWriteVersion := common.EmptyVersion
-		if updateWorkflow.ReplicationState != nil {
-			startVersion = updateWorkflow.ReplicationState.StartVersion
-			lastWriteVersion = updateWorkflow.ReplicationState.LastWriteVersion
+	}
+
+	if !applied {
+		return d.getExecutionConditionalUpdateFailure(previous, iter, executionInfo.RunID, updateWorkflow.Condition, request.RangeID, executionInfo.RunID)
+	}
+	return nil
+}
+
+func (d *cassandraPersistence) UpdateWorkflowExecutionBatch(request *p.InternalUpdateWorkflowExecutionBatchRequest) error {
+
+	// all executions of the shard are stored in the same partition,
+	// so the updates can be applied with a single conditional batch
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+
+	for _, updateRequest := range request.Requests {
+		if err := d.prepareUpdateWorkflowExecutionBatch(batch, updateRequest); err != nil {
+			return err
 		}
-		batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
-			executionInfo.RunID,
-			executionInfo.RunID,
-			executionInfo.CreateRequestID,
-			executionInfo.State,
-			executionInfo.CloseStatus,
-			startVersion,
-			lastWriteVersion,
-			lastWriteVersion,
-			executionInfo.State,
-			d.shardID,
-			rowTypeExecution,
-			executionInfo.DomainID,
-			executionInfo.WorkflowID,
-			permanentRunID,
-			defaultVisibilityTimestamp,
-			rowTypeExecutionTaskID,
-			executionInfo.RunID,
-		)
 	}
 
 	// Verifies that the RangeID has not changed
@@ -1378,19 +1493,151 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *p.InternalUpdate
 		if isTimeoutError(err) {
 			// Write may have succeeded, but we don't know
 			// return this info to the caller so they have the option of trying to find out by executing a read
-			return &p.TimeoutError{Msg: fmt.Sprintf("UpdateWorkflowExecution timed out. Error: %v", err)}
+			return &p.TimeoutError{Msg: fmt.Sprintf("UpdateWorkflowExecutionBatch timed out. Error: %v", err)}
 		} else if isThrottlingError(err) {
 			return &workflow.ServiceBusyError{
-				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+				Message: fmt.Sprintf("UpdateWorkflowExecutionBatch operation failed. Error: %v", err),
 			}
 		}
 		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+			Message: fmt.Sprintf("UpdateWorkflowExecutionBatch operation failed. Error: %v", err),
 		}
 	}
 
 	if !applied {
-		return d.getExecutionConditionalUpdateFailure(previous, iter, executionInfo.RunID, updateWorkflow.Condition, request.RangeID, executionInfo.RunID)
+		return d.getExecutionBatchConditionalUpdateFailure(previous, iter, request.RangeID, len(request.Requests))
+	}
+	return nil
+}
+
+func (d *cassandraPersistence) getExecutionBatchConditionalUpdateFailure(
+	previous map[string]interface{},
+	iter *gocql.Iter,
+	requestRangeID int64,
+	numRequests int,
+) error {
+
+	// Only the RangeID tells the whole batch apart from its updates, for any other condition
+	// callers should fall back to individual updates to find out which one failed.
+	for {
+		if rowType, ok := previous["type"].(int); ok && rowType == rowTypeShard {
+			if actualRangeID, ok := previous["range_id"].(int64); ok && actualRangeID != requestRangeID {
+				return &p.ShardOwnershipLostError{
+					ShardID: d.shardID,
+					Msg: fmt.Sprintf("Failed to update %v workflow executions in batch.  Request RangeID: %v, Actual RangeID: %v",
+						numRequests, requestRangeID, actualRangeID),
+				}
+			}
+		}
+
+		previous = make(map[string]interface{})
+		if !iter.MapScan(previous) {
+			break
+		}
+	}
+
+	return &p.ConditionFailedError{
+		Msg: fmt.Sprintf("Failed to update %v workflow executions in batch. Request RangeID: %v",
+			numRequests, requestRangeID),
+	}
+}
+
+func (d *cassandraPersistence) prepareUpdateWorkflowExecutionBatch(
+	batch *gocql.Batch,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+
+	updateWorkflow := request.UpdateWorkflowMutation
+	shardID := d.shardID
+	executionInfo := updateWorkflow.ExecutionInfo
+
+	if err := applyWorkflowMutationBatch(batch, shardID, &updateWorkflow); err != nil {
+		return err
+	}
+
+	switch request.Mode {
+	case p.UpdateWorkflowModeBypassCurrent:
+		if request.NewWorkflowSnapshot != nil {
+			return &workflow.InternalServiceError{
+				Message: "UpdateWorkflowExecution operation failed. Cannot bypass current record with new workflow.",
+			}
+		}
+		if err := p.ValidateUpdateWorkflowModeState(
+			request.Mode,
+			executionInfo.State,
+		); err != nil {
+			return err
+		}
+		if err := d.assertNotCurrentExecution(
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+		); err != nil {
+			return err
+		}
+
+	case p.UpdateWorkflowModeUpdateCurrent:
+		if request.NewWorkflowSnapshot != nil {
+
+			newExecutionInfo := request.NewWorkflowSnapshot.ExecutionInfo
+			newReplicationState := request.NewWorkflowSnapshot.ReplicationState
+			newDomainID := newExecutionInfo.DomainID
+			newWorkflowID := newExecutionInfo.WorkflowID
+			newRunID := newExecutionInfo.RunID
+
+			if err := createOrUpdateCurrentExecution(batch,
+				p.CreateWorkflowModeContinueAsNew,
+				d.shardID,
+				newDomainID,
+				newWorkflowID,
+				newRunID,
+				newExecutionInfo.State,
+				newExecutionInfo.CloseStatus,
+				newExecutionInfo.CreateRequestID,
+				newReplicationState,
+				executionInfo.RunID,
+				0, // for continue as new, this is not used
+			); err != nil {
+				return err
+			}
+			if err := applyWorkflowSnapshotBatchAsNew(batch,
+				d.shardID,
+				request.NewWorkflowSnapshot,
+			); err != nil {
+				return err
+			}
+		} else {
+			startVersion := common.EmptyVersion
+			lastWriteVersion := common.EmptyVersion
+			if updateWorkflow.ReplicationState != nil {
+				startVersion = updateWorkflow.ReplicationState.StartVersion
+				lastWriteVersion = updateWorkflow.ReplicationState.LastWriteVersion
+			}
+			batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
+				executionInfo.RunID,
+				executionInfo.RunID,
+				executionInfo.CreateRequestID,
+				executionInfo.State,
+				executionInfo.CloseStatus,
+				startVersion,
+				lastWriteVersion,
+				lastWriteVersion,
+				executionInfo.State,
+				d.shardID,
+				rowTypeExecution,
+				executionInfo.DomainID,
+				executionInfo.WorkflowID,
+				permanentRunID,
+				defaultVisibilityTimestamp,
+				rowTypeExecutionTaskID,
+				executionInfo.RunID,
+			)
+		}
+
+	default:
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Unknown mode: %v", request.Mode),
+		}
 	}
 	return nil
 }
@@ -1787,6 +2034,32 @@ func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *p.DeleteC
 	return nil
 }
 
+func (d *cassandraPersistence) assertNotCurrentExecution(
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+
+	resp, err := d.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// allow bypassing no current record
+			return nil
+		}
+		return err
+	}
+
+	if resp.RunID == runID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Assertion on current record failed. Current run ID is not expected: %v", resp.RunID),
+		}
+	}
+	return nil
+}
+
 func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse,
 	error) {
 	query := d.session.Query(templateGetCurrentExecutionQuery,
@@ -1801,6 +2074,13 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	result := make(map[string]interface{})
 	if err := query.MapScan(result); err != nil {
 		if err == gocql.ErrNotFound {
+			moved, err := d.moveExecutionFromOldLayout("GetCurrentExecution", request.DomainID, request.WorkflowID, permanentRunID)
+			if err != nil {
+				return nil, err
+			}
+			if moved {
+				return d.GetCurrentExecution(request)
+			}
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v",
 					request.WorkflowID),
@@ -1828,6 +2108,32 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
+// moveExecutionFromOldLayout moves an execution missing from the shard while the number of history shards is changed,
+// and returns false if the old layout does not have the execution either
+func (d *cassandraPersistence) moveExecutionFromOldLayout(
+	operation string,
+	domainID string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+
+	if d.oldLayout == nil {
+		return false, nil
+	}
+	moved, err := d.oldLayout.moveExecution(d.session, d.shardID, domainID, workflowID, runID)
+	if err != nil {
+		if isThrottlingError(err) {
+			return false, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("%v operation failed to move execution from the old shard layout. Error: %v", operation, err),
+			}
+		}
+		return false, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed to move execution from the old shard layout. Error: %v", operation, err),
+		}
+	}
+	return moved, nil
+}
+
 func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
@@ -1942,7 +2248,10 @@ func (d *cassandraPersistence) CompleteTransferTask(request *p.CompleteTransferT
 }
 
 func (d *cassandraPersistence) RangeCompleteTransferTask(request *p.RangeCompleteTransferTaskRequest) error {
-	query := d.session.Query(templateRangeCompleteTransferTaskQuery,
+	// the tasks and the shard row are stored in the same partition,
+	// so the range delete is fenced by the range ID of the shard
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateRangeCompleteTransferTaskQuery,
 		d.shardID,
 		rowTypeTransferTask,
 		rowTypeTransferDomainID,
@@ -1953,7 +2262,27 @@ func (d *cassandraPersistence) RangeCompleteTransferTask(request *p.RangeComplet
 		request.InclusiveEndTaskID,
 	)
 
-	err := query.Exec()
+	// Verifies that the RangeID has not changed
+	batch.Query(templateUpdateLeaseQuery,
+		request.RangeID,
+		d.shardID,
+		rowTypeShard,
+		rowTypeShardDomainID,
+		rowTypeShardWorkflowID,
+		rowTypeShardRunID,
+		defaultVisibilityTimestamp,
+		rowTypeShardTaskID,
+		request.RangeID,
+	)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := d.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
 	if err != nil {
 		if isThrottlingError(err) {
 			return &workflow.ServiceBusyError{
@@ -1965,6 +2294,15 @@ func (d *cassandraPersistence) RangeCompleteTransferTask(request *p.RangeComplet
 		}
 	}
 
+	if !applied {
+		// the shard row holds the only condition of the batch
+		actualRangeID, _ := previous["range_id"].(int64)
+		return &p.ShardOwnershipLostError{
+			ShardID: d.shardID,
+			Msg: fmt.Sprintf("Failed to complete task range.  Request RangeID: %v, Actual RangeID: %v",
+				request.RangeID, actualRangeID),
+		}
+	}
 	return nil
 }
 
@@ -2022,7 +2360,10 @@ func (d *cassandraPersistence) CompleteTimerTask(request *p.CompleteTimerTaskReq
 func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTimerTaskRequest) error {
 	start := p.UnixNanoToDBTimestamp(request.InclusiveBeginTimestamp.UnixNano())
 	end := p.UnixNanoToDBTimestamp(request.ExclusiveEndTimestamp.UnixNano())
-	query := d.session.Query(templateRangeCompleteTimerTaskQuery,
+	// the tasks and the shard row are stored in the same partition,
+	// so the range delete is fenced by the range ID of the shard
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateRangeCompleteTimerTaskQuery,
 		d.shardID,
 		rowTypeTimerTask,
 		rowTypeTimerDomainID,
@@ -2032,7 +2373,27 @@ func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTi
 		end,
 	)
 
-	err := query.Exec()
+	// Verifies that the RangeID has not changed
+	batch.Query(templateUpdateLeaseQuery,
+		request.RangeID,
+		d.shardID,
+		rowTypeShard,
+		rowTypeShardDomainID,
+		rowTypeShardWorkflowID,
+		rowTypeShardRunID,
+		defaultVisibilityTimestamp,
+		rowTypeShardTaskID,
+		request.RangeID,
+	)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := d.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
 	if err != nil {
 		if isThrottlingError(err) {
 			return &workflow.ServiceBusyError{
@@ -2044,6 +2405,15 @@ func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTi
 		}
 	}
 
+	if !applied {
+		// the shard row holds the only condition of the batch
+		actualRangeID, _ := previous["range_id"].(int64)
+		return &p.ShardOwnershipLostError{
+			ShardID: d.shardID,
+			Msg: fmt.Sprintf("Failed to complete task range.  Request RangeID: %v, Actual RangeID: %v",
+				request.RangeID, actualRangeID),
+		}
+	}
 	return nil
 }
 
@@ -2064,6 +2434,7 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 	)
 	var rangeID, ackLevel int64
 	var tlDB map[string]interface{}
+	var partitionConfig *p.TaskListPartitionConfig
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
 		if err == gocql.ErrNotFound { // First time task list is used
@@ -2080,6 +2451,9 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 				0,
 				request.TaskListKind,
 				now,
+				int64(0),
+				0,
+				0,
 			)
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
@@ -2104,6 +2478,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		}
 		ackLevel = tlDB["ack_level"].(int64)
 		taskListKind := tlDB["kind"].(int)
+		partitionConfig = createTaskListPartitionConfig(tlDB)
+		partitionVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigColumns(partitionConfig)
 		query = d.session.Query(templateUpdateTaskListQuery,
 			rangeID+1,
 			request.DomainID,
@@ -2112,6 +2488,9 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			ackLevel,
 			taskListKind,
 			now,
+			partitionVersion,
+			numReadPartitions,
+			numWritePartitions,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
@@ -2147,6 +2526,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		AckLevel:    ackLevel,
 		Kind:        request.TaskListKind,
 		LastUpdated: now,
+
+		AdaptivePartitionConfig: partitionConfig,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -2154,8 +2535,9 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 // From TaskManager interface
 func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
 	tli := request.TaskListInfo
+	partitionVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigColumns(tli.AdaptivePartitionConfig)
 
-	if tli.Kind == p.TaskListKindSticky { // if task_list is sticky, then update with TTL
+	if tli.Kind == p.TaskListKindSticky || tli.Kind == p.TaskListKindWorker { // if task_list is sticky or worker specific, then update with TTL
 		query := d.session.Query(templateUpdateTaskListQueryWithTTL,
 			tli.DomainID,
 			&tli.Name,
@@ -2169,6 +2551,9 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 			tli.AckLevel,
 			tli.Kind,
 			time.Now(),
+			partitionVersion,
+			numReadPartitions,
+			numWritePartitions,
 			stickyTaskListTTL,
 		)
 		err := query.Exec()
@@ -2193,6 +2578,9 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 		tli.AckLevel,
 		tli.Kind,
 		time.Now(),
+		partitionVersion,
+		numReadPartitions,
+		numWritePartitions,
 		tli.DomainID,
 		&tli.Name,
 		tli.TaskType,
@@ -2266,6 +2654,7 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 	taskListType := request.TaskListInfo.TaskType
 	taskListKind := request.TaskListInfo.Kind
 	ackLevel := request.TaskListInfo.AckLevel
+	partitionVersion, numReadPartitions, numWritePartitions := taskListPartitionConfigColumns(request.TaskListInfo.AdaptivePartitionConfig)
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 
 	for _, task := range request.Tasks {
@@ -2311,6 +2700,9 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 		ackLevel,
 		taskListKind,
 		time.Now(),
+		partitionVersion,
+		numReadPartitions,
+		numWritePartitions,
 		domainID,
 		taskList,
 		taskListType,
@@ -56,6 +56,73 @@ func applyWorkflowMutationBatch(
 		return err
 	}
 
+	if err := updateMutableStateMaps(
+		batch,
+		workflowMutation,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	); err != nil {
+		return err
+	}
+
+	updateBufferedEvents(
+		batch,
+		workflowMutation.NewBufferedEvents,
+		workflowMutation.ClearBufferedEvents,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
+	// transfer / replication / timer tasks
+	return applyTasks(
+		batch,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+		workflowMutation.TransferTasks,
+		workflowMutation.ReplicationTasks,
+		workflowMutation.TimerTasks,
+	)
+}
+
+func updateMutableStateMaps(
+	batch *gocql.Batch,
+	workflowMutation *p.InternalWorkflowMutation,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+
+	if workflowMutation.SnapshotMutableState {
+		// the mutation carries the complete mutable state maps,
+		// compact them into the snapshot blob instead of the individual map entries
+		return updateMutableStateSnapshot(
+			batch,
+			workflowMutation,
+			shardID,
+			domainID,
+			workflowID,
+			runID,
+		)
+	}
+
+	if workflowMutation.HasMutableStateSnapshot {
+		updateMutableStateTombstones(
+			batch,
+			workflowMutation,
+			shardID,
+			domainID,
+			workflowID,
+			runID,
+		)
+	}
+
 	if err := updateActivityInfos(
 		batch,
 		workflowMutation.UpsertActivityInfos,
@@ -119,28 +186,7 @@ func applyWorkflowMutationBatch(
 		workflowID,
 		runID,
 	)
-
-	updateBufferedEvents(
-		batch,
-		workflowMutation.NewBufferedEvents,
-		workflowMutation.ClearBufferedEvents,
-		shardID,
-		domainID,
-		workflowID,
-		runID,
-	)
-
-	// transfer / replication / timer tasks
-	return applyTasks(
-		batch,
-		shardID,
-		domainID,
-		workflowID,
-		runID,
-		workflowMutation.TransferTasks,
-		workflowMutation.ReplicationTasks,
-		workflowMutation.TimerTasks,
-	)
+	return nil
 }
 
 func applyWorkflowSnapshotBatchAsReset(
@@ -169,6 +215,14 @@ func applyWorkflowSnapshotBatchAsReset(
 		return err
 	}
 
+	resetMutableStateSnapshot(
+		batch,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
 	if err := resetActivityInfos(
 		batch,
 		workflowSnapshot.ActivityInfos,
@@ -450,6 +504,15 @@ func createExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.QueryTypes,
+			executionInfo.LastDecisionBinaryChecksum,
+			executionInfo.Paused,
+			executionInfo.Priority,
+			executionInfo.TerminationProtected,
+			executionInfo.EventCount,
+			executionInfo.ActivityPayloadBytes,
+			executionInfo.SignalEventCount,
+			executionInfo.TimerCount,
 			executionInfo.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -523,6 +586,15 @@ func createExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.QueryTypes,
+			executionInfo.LastDecisionBinaryChecksum,
+			executionInfo.Paused,
+			executionInfo.Priority,
+			executionInfo.TerminationProtected,
+			executionInfo.EventCount,
+			executionInfo.ActivityPayloadBytes,
+			executionInfo.SignalEventCount,
+			executionInfo.TimerCount,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -631,6 +703,15 @@ func updateExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.QueryTypes,
+			executionInfo.LastDecisionBinaryChecksum,
+			executionInfo.Paused,
+			executionInfo.Priority,
+			executionInfo.TerminationProtected,
+			executionInfo.EventCount,
+			executionInfo.ActivityPayloadBytes,
+			executionInfo.SignalEventCount,
+			executionInfo.TimerCount,
 			executionInfo.NextEventID,
 			shardID,
 			rowTypeExecution,
@@ -705,6 +786,15 @@ func updateExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.QueryTypes,
+			executionInfo.LastDecisionBinaryChecksum,
+			executionInfo.Paused,
+			executionInfo.Priority,
+			executionInfo.TerminationProtected,
+			executionInfo.EventCount,
+			executionInfo.ActivityPayloadBytes,
+			executionInfo.SignalEventCount,
+			executionInfo.TimerCount,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -977,7 +1067,7 @@ func createTimerTasks(
 		case *p.WorkflowTimeoutTask:
 			// noop
 
-		case *p.DeleteHistoryEventTask:
+		case *p.DeleteHistoryEventTask, *p.DeleteVisibilityTask:
 			// noop
 
 		default:
@@ -1625,6 +1715,8 @@ func createShardInfo(
 			info.DomainNotificationVersion = v.(int64)
 		case "cluster_replication_level":
 			info.ClusterReplicationLevel = v.(map[string]int64)
+		case "max_observed_time":
+			info.MaxObservedTime = v.(time.Time)
 		}
 	}
 
@@ -1779,6 +1871,24 @@ func createWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "query_types":
+			info.QueryTypes = v.([]string)
+		case "last_decision_binary_checksum":
+			info.LastDecisionBinaryChecksum = v.(string)
+		case "paused":
+			info.Paused = v.(bool)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "termination_protected":
+			info.TerminationProtected = v.(bool)
+		case "event_count":
+			info.EventCount = v.(int64)
+		case "activity_payload_bytes":
+			info.ActivityPayloadBytes = v.(int64)
+		case "signal_event_count":
+			info.SignalEventCount = v.(int64)
+		case "timer_count":
+			info.TimerCount = v.(int64)
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
@@ -2292,6 +2402,40 @@ func createTaskInfo(
 	return info
 }
 
+// createTaskListPartitionConfig returns the partition config decided by the adaptive scaler, nil if the
+// scaler never scaled the task list
+func createTaskListPartitionConfig(
+	result map[string]interface{},
+) *p.TaskListPartitionConfig {
+
+	config := &p.TaskListPartitionConfig{}
+	for k, v := range result {
+		switch k {
+		case "adaptive_partition_version":
+			config.Version = v.(int64)
+		case "num_read_partitions":
+			config.NumReadPartitions = v.(int)
+		case "num_write_partitions":
+			config.NumWritePartitions = v.(int)
+		}
+	}
+	if config.Version == 0 {
+		return nil
+	}
+
+	return config
+}
+
+func taskListPartitionConfigColumns(
+	config *p.TaskListPartitionConfig,
+) (int64, int, int) {
+
+	if config == nil {
+		return 0, 0, 0
+	}
+	return config.Version, config.NumReadPartitions, config.NumWritePartitions
+}
+
 func createTimerTaskInfo(
 	result map[string]interface{},
 ) *p.TimerTaskInfo {
@@ -40,6 +40,11 @@ const (
 	templateEnqueueMessageQuery   = `INSERT INTO queue (queue_type, message_id, message_payload) VALUES(?, ?, ?) IF NOT EXISTS`
 	templateGetLastMessageIDQuery = `SELECT message_id FROM queue WHERE queue_type=? ORDER BY message_id DESC LIMIT 1`
 	templateGetMessagesQuery      = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? LIMIT ?`
+	templateDeleteMessagesQuery   = `DELETE FROM queue WHERE queue_type = ? and message_id < ?`
+
+	templateGetQueueMetadataQuery    = `SELECT cluster_ack_level, version FROM queue_metadata WHERE queue_type = ?`
+	templateInsertQueueMetadataQuery = `INSERT INTO queue_metadata (queue_type, cluster_ack_level, version) VALUES(?, ?, ?) IF NOT EXISTS`
+	templateUpdateQueueMetadataQuery = `UPDATE queue_metadata SET cluster_ack_level = ?, version = ? WHERE queue_type = ? IF version = ?`
 )
 
 type (
@@ -71,11 +76,17 @@ func newQueue(
 	retryPolicy.SetBackoffCoefficient(1.5)
 	retryPolicy.SetMaximumAttempts(5)
 
-	return &cassandraQueue{
+	queue := &cassandraQueue{
 		cassandraStore: cassandraStore{session: session, logger: logger},
 		logger:         logger,
 		queueType:      queueType,
-	}, nil
+	}
+	if err := queue.insertInitialQueueMetadataRecord(); err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	return queue, nil
 }
 
 func (q *cassandraQueue) EnqueueMessage(
@@ -172,6 +183,108 @@ func (q *cassandraQueue) DequeueMessages(
 	return result, nil
 }
 
+func (q *cassandraQueue) DeleteMessagesBefore(
+	messageID int,
+) error {
+	query := q.session.Query(templateDeleteMessagesQuery, q.queueType, messageID)
+	if err := query.Exec(); err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteMessagesBefore operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteMessagesBefore operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (q *cassandraQueue) UpdateAckLevel(
+	messageID int,
+	clusterName string,
+) error {
+	clusterAckLevels, version, err := q.getQueueMetadata()
+	if err != nil {
+		return err
+	}
+
+	// ack levels only move forward
+	if ackLevel, ok := clusterAckLevels[clusterName]; ok && ackLevel >= messageID {
+		return nil
+	}
+	clusterAckLevels[clusterName] = messageID
+
+	query := q.session.Query(templateUpdateQueueMetadataQuery,
+		clusterAckLevels,
+		version+1,
+		q.queueType,
+		version,
+	)
+	applied, err := query.ScanCAS()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("UpdateAckLevel operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateAckLevel operation failed. Error: %v", err),
+		}
+	}
+
+	if !applied {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("queue metadata for queue %v was updated concurrently", q.queueType),
+		}
+	}
+
+	return nil
+}
+
+func (q *cassandraQueue) GetAckLevels() (map[string]int, error) {
+	clusterAckLevels, _, err := q.getQueueMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterAckLevels, nil
+}
+
+func (q *cassandraQueue) getQueueMetadata() (map[string]int, int64, error) {
+	query := q.session.Query(templateGetQueueMetadataQuery, q.queueType)
+
+	var clusterAckLevels map[string]int
+	var version int64
+	if err := query.Scan(&clusterAckLevels, &version); err != nil {
+		if isThrottlingError(err) {
+			return nil, 0, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("Failed to get metadata for queue %v. Error: %v", q.queueType, err),
+			}
+		}
+		return nil, 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to get metadata for queue %v. Error: %v", q.queueType, err),
+		}
+	}
+
+	// if the map is empty, gocql returns nil
+	if clusterAckLevels == nil {
+		clusterAckLevels = make(map[string]int)
+	}
+
+	return clusterAckLevels, version, nil
+}
+
+func (q *cassandraQueue) insertInitialQueueMetadataRecord() error {
+	query := q.session.Query(templateInsertQueueMetadataQuery, q.queueType, map[string]int{}, 0)
+	if _, err := query.ScanCAS(); err != nil {
+		return fmt.Errorf("failed to insert initial queue metadata record: %v", err)
+	}
+
+	return nil
+}
+
 func (q *cassandraQueue) Close() error {
 	if q.session != nil {
 		q.session.Close()
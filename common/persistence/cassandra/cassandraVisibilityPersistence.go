@@ -56,21 +56,83 @@ const (
 		`AND start_time = ? ` +
 		`AND run_id = ?`
 
+	templateDeleteWorkflowExecutionClosed = `DELETE FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
+	templateDeleteWorkflowExecutionClosedV2 = `DELETE FROM closed_executions_v2 ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND close_time = ? ` +
+		`AND run_id = ?`
+
 	templateCreateWorkflowExecutionClosedWithTTL = `INSERT INTO closed_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, indexed_memo, history_archival_info, history_archival_encoding) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO closed_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, indexed_memo, history_archival_info, history_archival_encoding) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosedWithTTLV2 = `INSERT INTO closed_executions_v2 (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, indexed_memo, history_archival_info, history_archival_encoding) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
 	templateCreateWorkflowExecutionClosedV2 = `INSERT INTO closed_executions_v2 (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, indexed_memo, history_archival_info, history_archival_encoding) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// the history archival info is updated on the existing records only, and expires with them
+	templateUpdateHistoryArchivalInfoWithTTL = `UPDATE closed_executions USING TTL ? ` +
+		`SET history_archival_info = ?, history_archival_encoding = ? ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ? ` +
+		`IF EXISTS`
+
+	templateUpdateHistoryArchivalInfo = `UPDATE closed_executions ` +
+		`SET history_archival_info = ?, history_archival_encoding = ? ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ? ` +
+		`IF EXISTS`
+
+	templateUpdateHistoryArchivalInfoWithTTLV2 = `UPDATE closed_executions_v2 USING TTL ? ` +
+		`SET history_archival_info = ?, history_archival_encoding = ? ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND close_time = ? ` +
+		`AND run_id = ? ` +
+		`IF EXISTS`
+
+	templateUpdateHistoryArchivalInfoV2 = `UPDATE closed_executions_v2 ` +
+		`SET history_archival_info = ?, history_archival_encoding = ? ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND close_time = ? ` +
+		`AND run_id = ? ` +
+		`IF EXISTS`
+
+	// the templates below are used to write multiple closed executions in a batch,
+	// each with its own write timestamp
+	templateDeleteWorkflowExecutionStartedWithTimestamp = `DELETE FROM open_executions ` +
+		`USING TIMESTAMP ? ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
+	templateCreateWorkflowExecutionClosedWithTTLAndTimestamp = templateCreateWorkflowExecutionClosedWithTTL + ` AND TIMESTAMP ?`
+
+	templateCreateWorkflowExecutionClosedWithTimestamp = templateCreateWorkflowExecutionClosed + ` USING TIMESTAMP ?`
+
+	templateCreateWorkflowExecutionClosedWithTTLAndTimestampV2 = templateCreateWorkflowExecutionClosedWithTTLV2 + ` AND TIMESTAMP ?`
+
+	templateCreateWorkflowExecutionClosedWithTimestampV2 = templateCreateWorkflowExecutionClosedV2 + ` USING TIMESTAMP ?`
 
 	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding ` +
 		`FROM open_executions ` +
@@ -79,7 +141,7 @@ const (
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
@@ -94,7 +156,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -110,7 +172,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -118,7 +180,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -126,7 +188,15 @@ const (
 		`AND start_time <= ? ` +
 		`AND status = ? `
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsByMemo = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time >= ? ` +
+		`AND start_time <= ? ` +
+		`AND indexed_memo[?] = ? `
+
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -249,6 +319,9 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.HistoryLength,
 			request.Memo.Data,
 			string(request.Memo.GetEncoding()),
+			request.IndexedMemo,
+			request.HistoryArchivalInfo.Data,
+			string(request.HistoryArchivalInfo.GetEncoding()),
 		)
 		// duplicate write to v2 to order by close time
 		batch.Query(templateCreateWorkflowExecutionClosedV2,
@@ -264,6 +337,9 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.HistoryLength,
 			request.Memo.Data,
 			string(request.Memo.GetEncoding()),
+			request.IndexedMemo,
+			request.HistoryArchivalInfo.Data,
+			string(request.HistoryArchivalInfo.GetEncoding()),
 		)
 	} else {
 		batch.Query(templateCreateWorkflowExecutionClosedWithTTL,
@@ -279,6 +355,9 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.HistoryLength,
 			request.Memo.Data,
 			string(request.Memo.GetEncoding()),
+			request.IndexedMemo,
+			request.HistoryArchivalInfo.Data,
+			string(request.HistoryArchivalInfo.GetEncoding()),
 			retention,
 		)
 		// duplicate write to v2 to order by close time
@@ -295,21 +374,14 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 			request.HistoryLength,
 			request.Memo.Data,
 			string(request.Memo.GetEncoding()),
+			request.IndexedMemo,
+			request.HistoryArchivalInfo.Data,
+			string(request.HistoryArchivalInfo.GetEncoding()),
 			retention,
 		)
 	}
 
-	// RecordWorkflowExecutionStarted is using StartTimestamp as
-	// the timestamp to issue query to Cassandra
-	// due to the fact that cross DC using mutable state creation time as workflow start time
-	// and visibility using event time instead of last update time (#1501)
-	// CloseTimestamp can be before StartTimestamp, meaning using CloseTimestamp
-	// can cause the deletion of open visibility record to be ignored.
-	queryTimeStamp := request.CloseTimestamp
-	if queryTimeStamp < request.StartTimestamp {
-		queryTimeStamp = request.StartTimestamp + time.Second.Nanoseconds()
-	}
-	batch = batch.WithTimestamp(p.UnixNanoToDBTimestamp(queryTimeStamp))
+	batch = batch.WithTimestamp(p.UnixNanoToDBTimestamp(getWorkflowExecutionClosedQueryTimestamp(request)))
 	err := v.session.ExecuteBatch(batch)
 	if err != nil {
 		if isThrottlingError(err) {
@@ -324,12 +396,150 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 	return nil
 }
 
+func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosedBatch(
+	request *p.InternalRecordWorkflowExecutionClosedBatchRequest) error {
+	batch := v.session.NewBatch(gocql.LoggedBatch)
+
+	for _, closedRequest := range request.Requests {
+		timestamp := p.UnixNanoToDBTimestamp(getWorkflowExecutionClosedQueryTimestamp(closedRequest))
+
+		batch.Query(templateDeleteWorkflowExecutionStartedWithTimestamp,
+			timestamp,
+			closedRequest.DomainUUID,
+			domainPartition,
+			p.UnixNanoToDBTimestamp(closedRequest.StartTimestamp),
+			closedRequest.RunID,
+		)
+
+		retention := closedRequest.RetentionSeconds
+		if retention == 0 {
+			retention = defaultCloseTTLSeconds
+		}
+
+		args := []interface{}{
+			closedRequest.DomainUUID,
+			domainPartition,
+			closedRequest.WorkflowID,
+			closedRequest.RunID,
+			p.UnixNanoToDBTimestamp(closedRequest.StartTimestamp),
+			p.UnixNanoToDBTimestamp(closedRequest.ExecutionTimestamp),
+			p.UnixNanoToDBTimestamp(closedRequest.CloseTimestamp),
+			closedRequest.WorkflowTypeName,
+			closedRequest.Status,
+			closedRequest.HistoryLength,
+			closedRequest.Memo.Data,
+			string(closedRequest.Memo.GetEncoding()),
+			closedRequest.IndexedMemo,
+			closedRequest.HistoryArchivalInfo.Data,
+			string(closedRequest.HistoryArchivalInfo.GetEncoding()),
+		}
+		if retention > maxCassandraTTL {
+			args = append(args, timestamp)
+			batch.Query(templateCreateWorkflowExecutionClosedWithTimestamp, args...)
+			// duplicate write to v2 to order by close time
+			batch.Query(templateCreateWorkflowExecutionClosedWithTimestampV2, args...)
+		} else {
+			args = append(args, retention, timestamp)
+			batch.Query(templateCreateWorkflowExecutionClosedWithTTLAndTimestamp, args...)
+			// duplicate write to v2 to order by close time
+			batch.Query(templateCreateWorkflowExecutionClosedWithTTLAndTimestampV2, args...)
+		}
+	}
+
+	err := v.session.ExecuteBatch(batch)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("RecordWorkflowExecutionClosedBatch operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RecordWorkflowExecutionClosedBatch operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func (v *cassandraVisibilityPersistence) UpsertWorkflowExecution(
 	request *p.InternalUpsertWorkflowExecutionRequest) error {
 
 	return p.NewOperationNotSupportErrorForVis()
 }
 
+// UpdateHistoryArchivalInfo updates the closed records of the workflow execution located by its start and
+// close time, a record which does not exist, e.g. one expired by its TTL, is not recreated
+func (v *cassandraVisibilityPersistence) UpdateHistoryArchivalInfo(
+	request *p.InternalUpdateHistoryArchivalInfoRequest) error {
+
+	retention := request.RetentionSeconds
+	if retention == 0 {
+		retention = defaultCloseTTLSeconds
+	}
+	// the updated columns expire no later than the rest of the record
+	ttl := retention - int64(time.Since(time.Unix(0, request.CloseTimestamp))/time.Second)
+	if ttl <= 0 {
+		return nil
+	}
+
+	var queries []*gocql.Query
+	if retention > maxCassandraTTL {
+		queries = []*gocql.Query{
+			v.session.Query(templateUpdateHistoryArchivalInfo,
+				request.HistoryArchivalInfo.Data,
+				string(request.HistoryArchivalInfo.GetEncoding()),
+				request.DomainUUID,
+				domainPartition,
+				p.UnixNanoToDBTimestamp(request.StartTimestamp),
+				request.RunID,
+			),
+			v.session.Query(templateUpdateHistoryArchivalInfoV2,
+				request.HistoryArchivalInfo.Data,
+				string(request.HistoryArchivalInfo.GetEncoding()),
+				request.DomainUUID,
+				domainPartition,
+				p.UnixNanoToDBTimestamp(request.CloseTimestamp),
+				request.RunID,
+			),
+		}
+	} else {
+		queries = []*gocql.Query{
+			v.session.Query(templateUpdateHistoryArchivalInfoWithTTL,
+				ttl,
+				request.HistoryArchivalInfo.Data,
+				string(request.HistoryArchivalInfo.GetEncoding()),
+				request.DomainUUID,
+				domainPartition,
+				p.UnixNanoToDBTimestamp(request.StartTimestamp),
+				request.RunID,
+			),
+			v.session.Query(templateUpdateHistoryArchivalInfoWithTTLV2,
+				ttl,
+				request.HistoryArchivalInfo.Data,
+				string(request.HistoryArchivalInfo.GetEncoding()),
+				request.DomainUUID,
+				domainPartition,
+				p.UnixNanoToDBTimestamp(request.CloseTimestamp),
+				request.RunID,
+			),
+		}
+	}
+
+	// the conditional updates are on different tables, so they can not be batched
+	for _, query := range queries {
+		if _, err := query.MapScanCAS(make(map[string]interface{})); err != nil {
+			if isThrottlingError(err) {
+				return &workflow.ServiceBusyError{
+					Message: fmt.Sprintf("UpdateHistoryArchivalInfo operation failed. Error: %v", err),
+				}
+			}
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("UpdateHistoryArchivalInfo operation failed. Error: %v", err),
+			}
+		}
+	}
+	return nil
+}
+
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.Query(templateGetOpenWorkflowExecutions,
@@ -615,6 +825,48 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 	return response, nil
 }
 
+func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByMemo(
+	request *p.ListClosedWorkflowExecutionsByMemoRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query := v.session.Query(templateGetClosedWorkflowExecutionsByMemo,
+		request.DomainUUID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
+		p.UnixNanoToDBTimestamp(request.LatestStartTime),
+		request.MemoKey,
+		request.MemoValue).Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		// TODO: should return a bad request error if the token is invalid
+		return nil, &workflow.InternalServiceError{
+			Message: "ListClosedWorkflowExecutionsByMemo operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	response.Executions = make([]*p.VisibilityWorkflowExecutionInfo, 0)
+	wfexecution, has := readClosedWorkflowExecutionRecord(iter)
+	for has {
+		response.Executions = append(response.Executions, wfexecution)
+		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListClosedWorkflowExecutionsByMemo operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByMemo operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
 func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
@@ -655,8 +907,46 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
-// DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs
+// DeleteWorkflowExecution deletes the visibility records of the workflow execution located by its start
+// and close time. Without the start time it is a no-op, since deletes are auto-handled by cassandra TTLs.
 func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	if request.StartTimestamp == 0 {
+		return nil
+	}
+
+	batch := v.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateDeleteWorkflowExecutionStarted,
+		request.DomainID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.StartTimestamp),
+		request.RunID,
+	)
+	batch.Query(templateDeleteWorkflowExecutionClosed,
+		request.DomainID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.StartTimestamp),
+		request.RunID,
+	)
+	if request.CloseTimestamp != 0 {
+		batch.Query(templateDeleteWorkflowExecutionClosedV2,
+			request.DomainID,
+			domainPartition,
+			p.UnixNanoToDBTimestamp(request.CloseTimestamp),
+			request.RunID,
+		)
+	}
+
+	err := v.session.ExecuteBatch(batch)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
 	return nil
 }
 
@@ -705,7 +995,10 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*p.VisibilityWorkflowE
 	var historyLength int64
 	var memo []byte
 	var encoding string
-	if iter.Scan(&workflowID, &runID, &startTime, &executionTime, &closeTime, &typeName, &status, &historyLength, &memo, &encoding) {
+	var historyArchivalInfo []byte
+	var historyArchivalEncoding string
+	if iter.Scan(&workflowID, &runID, &startTime, &executionTime, &closeTime, &typeName, &status, &historyLength, &memo, &encoding,
+		&historyArchivalInfo, &historyArchivalEncoding) {
 		record := &p.VisibilityWorkflowExecutionInfo{
 			WorkflowID:    workflowID,
 			RunID:         runID.String(),
@@ -717,7 +1010,25 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*p.VisibilityWorkflowE
 			HistoryLength: historyLength,
 			Memo:          p.NewDataBlob(memo, common.EncodingType(encoding)),
 		}
+		if len(historyArchivalInfo) > 0 {
+			record.HistoryArchivalInfo = p.NewDataBlob(historyArchivalInfo, common.EncodingType(historyArchivalEncoding))
+		}
 		return record, true
 	}
 	return nil, false
 }
+
+// getWorkflowExecutionClosedQueryTimestamp returns the timestamp to write a closed execution with.
+// RecordWorkflowExecutionStarted is using StartTimestamp as
+// the timestamp to issue query to Cassandra
+// due to the fact that cross DC using mutable state creation time as workflow start time
+// and visibility using event time instead of last update time (#1501)
+// CloseTimestamp can be before StartTimestamp, meaning using CloseTimestamp
+// can cause the deletion of open visibility record to be ignored.
+func getWorkflowExecutionClosedQueryTimestamp(request *p.InternalRecordWorkflowExecutionClosedRequest) int64 {
+	queryTimeStamp := request.CloseTimestamp
+	if queryTimeStamp < request.StartTimestamp {
+		queryTimeStamp = request.StartTimestamp + time.Second.Nanoseconds()
+	}
+	return queryTimeStamp
+}
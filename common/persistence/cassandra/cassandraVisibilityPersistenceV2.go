@@ -31,14 +31,14 @@ import (
 )
 
 const (
-	templateGetClosedWorkflowExecutionsV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions_v2 ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND close_time >= ? ` +
 		`AND close_time <= ? `
 
-	templateGetClosedWorkflowExecutionsByTypeV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsByTypeV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions_v2 ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -46,7 +46,7 @@ const (
 		`AND close_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetClosedWorkflowExecutionsByIDV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsByIDV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions_v2 ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -54,13 +54,21 @@ const (
 		`AND close_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByStatusV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding ` +
+	templateGetClosedWorkflowExecutionsByStatusV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
 		`FROM closed_executions_v2 ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
 		`AND close_time >= ? ` +
 		`AND close_time <= ? ` +
 		`AND status = ? `
+
+	templateGetClosedWorkflowExecutionsByMemoV2 = `SELECT workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding, history_archival_info, history_archival_encoding ` +
+		`FROM closed_executions_v2 ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND close_time >= ? ` +
+		`AND close_time <= ? ` +
+		`AND indexed_memo[?] = ? `
 )
 
 type (
@@ -114,11 +122,21 @@ func (v *cassandraVisibilityPersistenceV2) RecordWorkflowExecutionClosed(
 	return v.persistence.RecordWorkflowExecutionClosed(request)
 }
 
+func (v *cassandraVisibilityPersistenceV2) RecordWorkflowExecutionClosedBatch(
+	request *p.InternalRecordWorkflowExecutionClosedBatchRequest) error {
+	return v.persistence.RecordWorkflowExecutionClosedBatch(request)
+}
+
 func (v *cassandraVisibilityPersistenceV2) UpsertWorkflowExecution(
 	request *p.InternalUpsertWorkflowExecutionRequest) error {
 	return v.persistence.UpsertWorkflowExecution(request)
 }
 
+func (v *cassandraVisibilityPersistenceV2) UpdateHistoryArchivalInfo(
+	request *p.InternalUpdateHistoryArchivalInfoRequest) error {
+	return v.persistence.UpdateHistoryArchivalInfo(request)
+}
+
 func (v *cassandraVisibilityPersistenceV2) ListOpenWorkflowExecutions(
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return v.persistence.ListOpenWorkflowExecutions(request)
@@ -318,3 +336,45 @@ func (v *cassandraVisibilityPersistenceV2) CountWorkflowExecutions(request *p.Co
 func (v *cassandraVisibilityPersistenceV2) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
 	return nil
 }
+
+func (v *cassandraVisibilityPersistenceV2) ListClosedWorkflowExecutionsByMemo(
+	request *p.ListClosedWorkflowExecutionsByMemoRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query := v.session.Query(templateGetClosedWorkflowExecutionsByMemoV2,
+		request.DomainUUID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.EarliestStartTime),
+		p.UnixNanoToDBTimestamp(request.LatestStartTime),
+		request.MemoKey,
+		request.MemoValue).Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		// TODO: should return a bad request error if the token is invalid
+		return nil, &workflow.InternalServiceError{
+			Message: "ListClosedWorkflowExecutionsByMemo operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	response.Executions = make([]*p.VisibilityWorkflowExecutionInfo, 0)
+	wfexecution, has := readClosedWorkflowExecutionRecord(iter)
+	for has {
+		response.Executions = append(response.Executions, wfexecution)
+		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListClosedWorkflowExecutionsByMemo operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListClosedWorkflowExecutionsByMemo operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
@@ -39,8 +39,9 @@ type (
 		execStoreFactory *executionStoreFactory
 	}
 	executionStoreFactory struct {
-		session *gocql.Session
-		logger  log.Logger
+		session   *gocql.Session
+		oldLayout *oldShardLayout
+		logger    log.Logger
 	}
 )
 
@@ -130,7 +131,7 @@ func (f *Factory) executionStoreFactory() (*executionStoreFactory, error) {
 		return f.execStoreFactory, nil
 	}
 
-	factory, err := newExecutionStoreFactory(f.cfg, f.logger)
+	factory, err := newExecutionStoreFactory(f.cfg, f.clusterName, f.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -139,9 +140,9 @@ func (f *Factory) executionStoreFactory() (*executionStoreFactory, error) {
 }
 
 // newExecutionStoreFactory is used to create an instance of ExecutionStoreFactory implementation
-func newExecutionStoreFactory(cfg config.Cassandra, logger log.Logger) (*executionStoreFactory, error) {
+func newExecutionStoreFactory(cfg config.Cassandra, clusterName string, logger log.Logger) (*executionStoreFactory, error) {
 	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
-	cluster.Keyspace = cfg.Keyspace
+	cluster.Keyspace = cfg.GetExecutionsKeyspace()
 	cluster.ProtoVersion = cassandraProtoVersion
 	cluster.Consistency = gocql.LocalQuorum
 	cluster.SerialConsistency = gocql.LocalSerial
@@ -153,18 +154,26 @@ func newExecutionStoreFactory(cfg config.Cassandra, logger log.Logger) (*executi
 	if err != nil {
 		return nil, err
 	}
-	return &executionStoreFactory{session: session, logger: logger}, nil
+	oldLayout, err := newOldShardLayout(cfg, clusterName, logger)
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	return &executionStoreFactory{session: session, oldLayout: oldLayout, logger: logger}, nil
 }
 
 func (f *executionStoreFactory) close() {
 	f.session.Close()
+	if f.oldLayout != nil {
+		f.oldLayout.close()
+	}
 }
 
 // new implements ExecutionStoreFactory interface
 func (f *executionStoreFactory) new(shardID int) (p.ExecutionStore, error) {
-	pmgr, err := NewWorkflowExecutionPersistence(shardID, f.session, f.logger)
-	if err != nil {
-		return nil, err
-	}
-	return pmgr, nil
+	return &cassandraPersistence{
+		cassandraStore: cassandraStore{session: f.session, logger: f.logger},
+		shardID:        shardID,
+		oldLayout:      f.oldLayout,
+	}, nil
 }
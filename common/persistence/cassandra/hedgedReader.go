@@ -0,0 +1,151 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// hedgedReaderWindowSize is the number of recent read latencies the hedge delay is computed from
+	hedgedReaderWindowSize = 1000
+	// hedgedReaderRefreshInterval is the number of reads after which the hedge delay is recomputed
+	hedgedReaderRefreshInterval = 100
+)
+
+type (
+	// hedgedReader issues a duplicate of a read which did not complete within a percentile
+	// of the recent read latencies, and returns the result of whichever read completes first
+	hedgedReader struct {
+		percentile float64
+		minDelay   time.Duration
+
+		sync.Mutex
+		latencies []time.Duration
+		next      int
+		recorded  int
+		delay     time.Duration
+	}
+
+	hedgedReadResult struct {
+		value interface{}
+		err   error
+	}
+
+	// hedgedReadOp is a read which is aborted once its context is cancelled, isHedge is set for the duplicate read
+	hedgedReadOp func(ctx context.Context, isHedge bool) (interface{}, error)
+)
+
+func newHedgedReader(cfg *config.HedgedReads) *hedgedReader {
+	if cfg == nil {
+		return nil
+	}
+	return &hedgedReader{
+		percentile: cfg.LatencyPercentile,
+		minDelay:   cfg.MinDelay,
+		latencies:  make([]time.Duration, hedgedReaderWindowSize),
+		delay:      cfg.MinDelay,
+	}
+}
+
+// read runs the operation, hedging it if it is slow, a nil reader runs the operation without hedging
+func (r *hedgedReader) read(op hedgedReadOp) (interface{}, error) {
+	if r == nil {
+		return op(context.Background(), false)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// abort the read which lost the race
+	defer cancel()
+
+	start := time.Now()
+	results := make(chan hedgedReadResult, 2)
+	launch := func(isHedge bool) {
+		go func() {
+			value, err := op(ctx, isHedge)
+			results <- hedgedReadResult{value: value, err: err}
+		}()
+	}
+
+	launch(false)
+	pending := 1
+	hedged := false
+	timer := time.NewTimer(r.getDelay())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			launch(true)
+			pending++
+			hedged = true
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				r.record(time.Since(start))
+				return result.value, nil
+			}
+			// a failed read is only retried by a hedge which is already in flight
+			if !hedged || pending == 0 {
+				return nil, result.err
+			}
+		}
+	}
+}
+
+func (r *hedgedReader) getDelay() time.Duration {
+	r.Lock()
+	defer r.Unlock()
+	return r.delay
+}
+
+func (r *hedgedReader) record(latency time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.latencies[r.next] = latency
+	r.next = (r.next + 1) % len(r.latencies)
+	r.recorded++
+	if r.recorded%hedgedReaderRefreshInterval != 0 {
+		return
+	}
+
+	size := r.recorded
+	if size > len(r.latencies) {
+		size = len(r.latencies)
+	}
+	sorted := make([]time.Duration, size)
+	copy(sorted, r.latencies[:size])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	index := int(float64(size) * r.percentile)
+	if index >= size {
+		index = size - 1
+	}
+	r.delay = sorted[index]
+	if r.delay < r.minDelay {
+		r.delay = r.minDelay
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	hedgedReaderSuite struct {
+		suite.Suite
+	}
+)
+
+func TestHedgedReaderSuite(t *testing.T) {
+	s := new(hedgedReaderSuite)
+	suite.Run(t, s)
+}
+
+func (s *hedgedReaderSuite) TestRead_NilReader() {
+	var reader *hedgedReader
+	s.Nil(newHedgedReader(nil))
+
+	value, err := reader.read(func(ctx context.Context, isHedge bool) (interface{}, error) {
+		s.False(isHedge)
+		return "primary", nil
+	})
+	s.NoError(err)
+	s.Equal("primary", value)
+}
+
+func (s *hedgedReaderSuite) TestRead_SlowPrimaryIsHedged() {
+	reader := newHedgedReader(&config.HedgedReads{LatencyPercentile: 0.9, MinDelay: 10 * time.Millisecond})
+
+	primaryCancelled := make(chan struct{})
+	value, err := reader.read(func(ctx context.Context, isHedge bool) (interface{}, error) {
+		if isHedge {
+			return "hedge", nil
+		}
+		<-ctx.Done()
+		close(primaryCancelled)
+		return nil, ctx.Err()
+	})
+	s.NoError(err)
+	s.Equal("hedge", value)
+
+	select {
+	case <-primaryCancelled:
+	case <-time.After(time.Second):
+		s.Fail("primary read was not cancelled")
+	}
+}
+
+func (s *hedgedReaderSuite) TestRead_PrimaryErrorIsNotHedged() {
+	reader := newHedgedReader(&config.HedgedReads{LatencyPercentile: 0.9, MinDelay: time.Second})
+
+	readErr := errors.New("read failed")
+	_, err := reader.read(func(ctx context.Context, isHedge bool) (interface{}, error) {
+		s.False(isHedge)
+		return nil, readErr
+	})
+	s.Equal(readErr, err)
+}
+
+func (s *hedgedReaderSuite) TestRecord_RecomputesDelay() {
+	reader := newHedgedReader(&config.HedgedReads{LatencyPercentile: 0.9, MinDelay: 5 * time.Millisecond})
+
+	for i := 1; i < hedgedReaderRefreshInterval; i++ {
+		reader.record(time.Duration(i) * time.Millisecond)
+	}
+	s.Equal(5*time.Millisecond, reader.getDelay())
+
+	reader.record(hedgedReaderRefreshInterval * time.Millisecond)
+	s.Equal(91*time.Millisecond, reader.getDelay())
+
+	for i := 0; i < hedgedReaderRefreshInterval; i++ {
+		reader.record(time.Millisecond)
+	}
+	s.Equal(81*time.Millisecond, reader.getDelay())
+}
@@ -0,0 +1,482 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	reshardPageSize = 1000
+	// reshardMaxRangeAttempts is the number of times a range is acquired on a shard whose owner renews it concurrently
+	reshardMaxRangeAttempts = 10
+
+	// cassandraJSONTimestampLayout is the format of timestamps in the rows returned by SELECT JSON
+	cassandraJSONTimestampLayout = "2006-01-02 15:04:05.000Z"
+
+	templateReadShardRowsQuery = `SELECT JSON * ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
+	templateReadExecutionRowQuery = `SELECT JSON * ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ?`
+
+	templateWriteShardRowQuery = `INSERT INTO executions JSON ?`
+
+	templateMoveShardRowQuery = templateWriteShardRowQuery + ` IF NOT EXISTS`
+
+	templateDeleteShardRowsQuery = `DELETE FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+)
+
+type (
+	// Resharder copies the executions table of a cluster into the keyspace of a cluster with
+	// a different number of history shards. Executions are moved to the shard their workflow ID
+	// maps to, transfer, timer and replication tasks follow their execution and are given new
+	// task IDs, as task IDs are only unique within the shard they were created in.
+	// Reshard copies the executions of a stopped cluster, shard rows are recreated with empty ack
+	// levels, so the copied tasks are processed again by the new shards, and the target keyspace
+	// must not contain any shards yet. ReshardOnline moves the executions left in the old layout
+	// of a running cluster which reads its old layout as configured by config.Resharding.
+	// Only Cassandra is supported. SQL stores keep the shard ID in each of their execution, info
+	// map and task tables, so there is no resharder for them and the number of history shards of
+	// a SQL cluster cannot be changed
+	Resharder struct {
+		source        *gocql.Session
+		target        *gocql.Session
+		oldNumShards  int
+		newNumShards  int
+		rangeSizeBits uint
+		logger        log.Logger
+		// lastTaskIDs holds the last task ID assigned in each new shard
+		lastTaskIDs []int64
+		// maxTaskIDs holds the end of the range acquired on each new shard by ReshardOnline
+		maxTaskIDs []int64
+	}
+
+	// oldShardLayout reads the shards and executions of the layout a running cluster is resharded from,
+	// executions are moved to the executions keyspace of the cluster on first access
+	oldShardLayout struct {
+		session            *gocql.Session
+		numShards          int
+		currentClusterName string
+		logger             log.Logger
+	}
+)
+
+// NewResharder returns a Resharder moving executions from oldNumShards to newNumShards shards,
+// rangeSizeBits must match the one history is configured with
+func NewResharder(
+	source *gocql.Session,
+	target *gocql.Session,
+	oldNumShards int,
+	newNumShards int,
+	rangeSizeBits uint,
+	logger log.Logger,
+) *Resharder {
+	return &Resharder{
+		source:        source,
+		target:        target,
+		oldNumShards:  oldNumShards,
+		newNumShards:  newNumShards,
+		rangeSizeBits: rangeSizeBits,
+		logger:        logger,
+		lastTaskIDs:   make([]int64, newNumShards),
+		maxTaskIDs:    make([]int64, newNumShards),
+	}
+}
+
+// Reshard copies the rows of all old shards and then creates the new shards
+func (r *Resharder) Reshard() error {
+	for shardID := 0; shardID < r.oldNumShards; shardID++ {
+		for _, rowType := range []int{rowTypeExecution, rowTypeTransferTask, rowTypeTimerTask, rowTypeReplicationTask} {
+			if err := r.copyRows(shardID, rowType); err != nil {
+				return err
+			}
+		}
+		r.logger.Info("Copied rows of shard.", tag.ShardID(shardID))
+	}
+
+	for shardID := 0; shardID < r.newNumShards; shardID++ {
+		store := &cassandraPersistence{cassandraStore: cassandraStore{session: r.target, logger: r.logger}, shardID: shardID}
+		// the range following the copied task IDs is acquired when the shard is loaded
+		err := store.CreateShard(&p.CreateShardRequest{ShardInfo: &p.ShardInfo{
+			ShardID: shardID,
+			RangeID: r.lastTaskIDs[shardID] >> r.rangeSizeBits,
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReshardOnline moves the rows left in the old shards into the new shards of a running cluster.
+// Executions already moved on access are kept. Tasks are given task IDs from a range acquired
+// on their new shard, which makes the owner of the shard reload it and read the moved tasks,
+// timers which are due fire as soon as they are moved. Moved rows are deleted from the old shards,
+// so the resharding can be resumed
+func (r *Resharder) ReshardOnline(currentClusterName string) error {
+	oldLayout := &oldShardLayout{
+		session:            r.source,
+		numShards:          r.oldNumShards,
+		currentClusterName: currentClusterName,
+		logger:             r.logger,
+	}
+	for shardID := 0; shardID < r.oldNumShards; shardID++ {
+		for _, rowType := range []int{rowTypeExecution, rowTypeTransferTask, rowTypeTimerTask, rowTypeReplicationTask} {
+			if err := r.moveRows(shardID, rowType, oldLayout); err != nil {
+				return err
+			}
+		}
+		r.logger.Info("Moved rows of shard.", tag.ShardID(shardID))
+	}
+	return nil
+}
+
+func (r *Resharder) copyRows(shardID int, rowType int) error {
+	iter := r.source.Query(templateReadShardRowsQuery, shardID, rowType).PageSize(reshardPageSize).Iter()
+	var row string
+	for iter.Scan(&row) {
+		newRow, _, err := reshardRow(row, rowType, r.newShardID, r.nextTaskID)
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if err := r.target.Query(templateWriteShardRowQuery, newRow).Exec(); err != nil {
+			iter.Close()
+			return fmt.Errorf("failed to write row of shard %v: %v", shardID, err)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return fmt.Errorf("failed to read rows of shard %v: %v", shardID, err)
+	}
+	return nil
+}
+
+func (r *Resharder) moveRows(shardID int, rowType int, oldLayout *oldShardLayout) error {
+	nextTaskID := func(newShardID int) (int64, error) {
+		return r.nextAcquiredTaskID(newShardID, oldLayout)
+	}
+
+	iter := r.source.Query(templateReadShardRowsQuery, shardID, rowType).PageSize(reshardPageSize).Iter()
+	var row string
+	for iter.Scan(&row) {
+		newRow, _, err := reshardRow(row, rowType, r.newShardID, nextTaskID)
+		if err == nil && rowType == rowTypeTimerTask {
+			newRow, err = rescheduleTimerRow(newRow, time.Now())
+		}
+		if err != nil {
+			iter.Close()
+			return err
+		}
+
+		if rowType == rowTypeExecution {
+			// executions moved on access may have been updated since
+			_, err = r.target.Query(templateMoveShardRowQuery, newRow).MapScanCAS(make(map[string]interface{}))
+		} else {
+			err = r.target.Query(templateWriteShardRowQuery, newRow).Exec()
+		}
+		if err != nil {
+			iter.Close()
+			return fmt.Errorf("failed to move row of shard %v: %v", shardID, err)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return fmt.Errorf("failed to read rows of shard %v: %v", shardID, err)
+	}
+
+	if err := r.source.Query(templateDeleteShardRowsQuery, shardID, rowType).Exec(); err != nil {
+		return fmt.Errorf("failed to delete moved rows of shard %v: %v", shardID, err)
+	}
+	return nil
+}
+
+func (r *Resharder) newShardID(workflowID string) int {
+	return common.WorkflowIDToHistoryShard(workflowID, r.newNumShards)
+}
+
+func (r *Resharder) nextTaskID(shardID int) (int64, error) {
+	r.lastTaskIDs[shardID]++
+	return r.lastTaskIDs[shardID], nil
+}
+
+// nextAcquiredTaskID returns the next task ID of a range acquired on a shard of the running cluster,
+// a shard missing from the target keyspace is created from the old layout first
+func (r *Resharder) nextAcquiredTaskID(shardID int, oldLayout *oldShardLayout) (int64, error) {
+	if r.lastTaskIDs[shardID] < r.maxTaskIDs[shardID] {
+		r.lastTaskIDs[shardID]++
+		return r.lastTaskIDs[shardID], nil
+	}
+
+	store := &cassandraPersistence{
+		cassandraStore:     cassandraStore{session: r.target, logger: r.logger},
+		shardID:            shardID,
+		currentClusterName: oldLayout.currentClusterName,
+		oldLayout:          oldLayout,
+	}
+	for attempt := 0; attempt < reshardMaxRangeAttempts; attempt++ {
+		resp, err := store.GetShard(&p.GetShardRequest{ShardID: shardID})
+		if err != nil {
+			return 0, err
+		}
+		shardInfo := resp.ShardInfo
+		previousRangeID := shardInfo.RangeID
+		shardInfo.RangeID++
+		err = store.UpdateShard(&p.UpdateShardRequest{ShardInfo: shardInfo, PreviousRangeID: previousRangeID})
+		if _, ok := err.(*p.ShardOwnershipLostError); ok {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		r.lastTaskIDs[shardID] = shardInfo.RangeID << r.rangeSizeBits
+		r.maxTaskIDs[shardID] = (shardInfo.RangeID+1)<<r.rangeSizeBits - 1
+		return r.lastTaskIDs[shardID], nil
+	}
+	return 0, fmt.Errorf("failed to acquire a range on shard %v", shardID)
+}
+
+func newOldShardLayout(cfg config.Cassandra, clusterName string, logger log.Logger) (*oldShardLayout, error) {
+	if cfg.Resharding == nil {
+		return nil, nil
+	}
+	cluster := NewCassandraCluster(cfg.Hosts, cfg.Port, cfg.User, cfg.Password, cfg.Datacenter)
+	cluster.Keyspace = cfg.Resharding.Keyspace
+	cluster.ProtoVersion = cassandraProtoVersion
+	cluster.Consistency = gocql.LocalQuorum
+	cluster.SerialConsistency = gocql.LocalSerial
+	cluster.Timeout = defaultSessionTimeout
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+	return &oldShardLayout{
+		session:            session,
+		numShards:          cfg.Resharding.NumHistoryShards,
+		currentClusterName: clusterName,
+		logger:             logger,
+	}, nil
+}
+
+// moveExecution moves an execution or current execution row of the old layout into the shard of the
+// executions keyspace, and returns false if the old layout does not have the row either
+func (l *oldShardLayout) moveExecution(
+	target *gocql.Session,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+
+	oldShardID := common.WorkflowIDToHistoryShard(workflowID, l.numShards)
+	var row string
+	err := l.session.Query(templateReadExecutionRowQuery,
+		oldShardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+	).Scan(&row)
+	if err == gocql.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	newRow, _, err := reshardRow(row, rowTypeExecution, func(string) int { return shardID }, nil)
+	if err != nil {
+		return false, err
+	}
+	// the row may have been moved and updated by a concurrent access
+	if _, err := target.Query(templateMoveShardRowQuery, newRow).MapScanCAS(make(map[string]interface{})); err != nil {
+		return false, err
+	}
+	if err := l.session.Query(templateDeleteWorkflowExecutionMutableStateQuery,
+		oldShardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID,
+	).Exec(); err != nil {
+		return false, err
+	}
+	l.logger.Debug("Moved execution from the old shard layout.",
+		tag.ShardID(shardID), tag.WorkflowID(workflowID), tag.WorkflowRunID(runID))
+	return true, nil
+}
+
+// newShardInfo returns the info of a shard missing from the executions keyspace. Its executions come
+// from any of the old shards, so its clock starts after the max observed time of all of them, and domain
+// change notifications are replayed from the lowest version any of them has handled
+func (l *oldShardLayout) newShardInfo(shardID int) (*p.ShardInfo, error) {
+	store := &cassandraPersistence{
+		cassandraStore:     cassandraStore{session: l.session, logger: l.logger},
+		shardID:            -1,
+		currentClusterName: l.currentClusterName,
+	}
+	shardInfo := &p.ShardInfo{ShardID: shardID}
+	for oldShardID := 0; oldShardID < l.numShards; oldShardID++ {
+		resp, err := store.GetShard(&p.GetShardRequest{ShardID: oldShardID})
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		oldShardInfo := resp.ShardInfo
+		if oldShardInfo.MaxObservedTime.After(shardInfo.MaxObservedTime) {
+			shardInfo.MaxObservedTime = oldShardInfo.MaxObservedTime
+		}
+		if oldShardID == 0 || oldShardInfo.DomainNotificationVersion < shardInfo.DomainNotificationVersion {
+			shardInfo.DomainNotificationVersion = oldShardInfo.DomainNotificationVersion
+		}
+	}
+	return shardInfo, nil
+}
+
+func (l *oldShardLayout) close() {
+	l.session.Close()
+}
+
+// reshardRow moves a row of the executions table, in its JSON form, to the shard its workflow
+// ID maps to, and returns the moved row and its new shard. Task rows are given the next task ID
+// of their new shard
+func reshardRow(
+	row string,
+	rowType int,
+	shardForWorkflow func(workflowID string) int,
+	nextTaskID func(shardID int) (int64, error),
+) (string, int, error) {
+
+	columns, err := decodeRow(row)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// task rows keep the workflow they belong to in the task itself
+	var task map[string]interface{}
+	workflowID, _ := columns["workflow_id"].(string)
+	switch rowType {
+	case rowTypeExecution:
+	case rowTypeTransferTask:
+		task, _ = columns["transfer"].(map[string]interface{})
+	case rowTypeTimerTask:
+		task, _ = columns["timer"].(map[string]interface{})
+	case rowTypeReplicationTask:
+		task, _ = columns["replication"].(map[string]interface{})
+	default:
+		return "", 0, fmt.Errorf("unsupported row type: %v", rowType)
+	}
+	if rowType != rowTypeExecution {
+		if task == nil {
+			return "", 0, fmt.Errorf("task row of type %v has no task", rowType)
+		}
+		workflowID, _ = task["workflow_id"].(string)
+	}
+	if workflowID == "" {
+		return "", 0, fmt.Errorf("row of type %v has no workflow ID", rowType)
+	}
+
+	shardID := shardForWorkflow(workflowID)
+	columns["shard_id"] = shardID
+	if task != nil {
+		taskID, err := nextTaskID(shardID)
+		if err != nil {
+			return "", 0, err
+		}
+		columns["task_id"] = taskID
+		task["task_id"] = taskID
+	}
+
+	newRow, err := json.Marshal(columns)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to encode row: %v", err)
+	}
+	return string(newRow), shardID, nil
+}
+
+// rescheduleTimerRow moves a timer task row, in its JSON form, which is due before now to now,
+// as the timer queue of a running shard does not read timers before its ack level
+func rescheduleTimerRow(row string, now time.Time) (string, error) {
+	columns, err := decodeRow(row)
+	if err != nil {
+		return "", err
+	}
+	timer, _ := columns["timer"].(map[string]interface{})
+	visibilityTimestamp, _ := columns["visibility_ts"].(string)
+	if timer == nil || visibilityTimestamp == "" {
+		return "", fmt.Errorf("timer task row has no visibility timestamp")
+	}
+	fireTime, err := time.Parse(cassandraJSONTimestampLayout, visibilityTimestamp)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse timer visibility timestamp: %v", err)
+	}
+	if !fireTime.Before(now) {
+		return row, nil
+	}
+
+	nowTimestamp := now.UTC().Format(cassandraJSONTimestampLayout)
+	columns["visibility_ts"] = nowTimestamp
+	timer["visibility_ts"] = nowTimestamp
+	newRow, err := json.Marshal(columns)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode row: %v", err)
+	}
+	return string(newRow), nil
+}
+
+func decodeRow(row string) (map[string]interface{}, error) {
+	columns := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader([]byte(row)))
+	// keep the precision of bigint columns
+	decoder.UseNumber()
+	if err := decoder.Decode(&columns); err != nil {
+		return nil, fmt.Errorf("failed to decode row: %v", err)
+	}
+	return columns, nil
+}
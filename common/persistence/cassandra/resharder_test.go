@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	resharderSuite struct {
+		suite.Suite
+	}
+)
+
+func TestResharderSuite(t *testing.T) {
+	s := new(resharderSuite)
+	suite.Run(t, s)
+}
+
+func (s *resharderSuite) TestReshardRow_Execution() {
+	row := `{"shard_id": 3, "type": 1, "workflow_id": "wid", "next_event_id": 9223372036854775807}`
+	newRow, shardID, err := reshardRow(row, rowTypeExecution, s.shardForWorkflow, func(int) (int64, error) {
+		s.Fail("execution rows are not given task IDs")
+		return 0, nil
+	})
+	s.NoError(err)
+	s.Equal(common.WorkflowIDToHistoryShard("wid", 16), shardID)
+
+	columns := s.decode(newRow)
+	s.Equal(json.Number("9223372036854775807"), columns["next_event_id"])
+	s.Equal(json.Number(strconv.Itoa(shardID)), columns["shard_id"])
+}
+
+func (s *resharderSuite) TestReshardRow_Task() {
+	row := `{"shard_id": 3, "type": 2, "workflow_id": "` + rowTypeTransferWorkflowID + `", "task_id": 1048577, ` +
+		`"transfer": {"workflow_id": "wid", "task_id": 1048577}}`
+	var taskShardID int
+	newRow, shardID, err := reshardRow(row, rowTypeTransferTask, s.shardForWorkflow, func(shardID int) (int64, error) {
+		taskShardID = shardID
+		return 5, nil
+	})
+	s.NoError(err)
+	s.Equal(common.WorkflowIDToHistoryShard("wid", 16), shardID)
+	s.Equal(shardID, taskShardID)
+
+	columns := s.decode(newRow)
+	s.Equal(json.Number("5"), columns["task_id"])
+	s.Equal(json.Number("5"), columns["transfer"].(map[string]interface{})["task_id"])
+	s.Equal(rowTypeTransferWorkflowID, columns["workflow_id"])
+}
+
+func (s *resharderSuite) TestReshardRow_Invalid() {
+	_, _, err := reshardRow(`{"type": 3, "workflow_id": "`+rowTypeTimerWorkflowID+`"}`, rowTypeTimerTask, s.shardForWorkflow, nil)
+	s.Error(err)
+
+	_, _, err = reshardRow(`{"type": 0}`, rowTypeShard, s.shardForWorkflow, nil)
+	s.Error(err)
+
+	_, _, err = reshardRow(`not json`, rowTypeExecution, s.shardForWorkflow, nil)
+	s.Error(err)
+}
+
+func (s *resharderSuite) TestReshardRow_TaskIDError() {
+	row := `{"shard_id": 3, "type": 3, "workflow_id": "` + rowTypeTimerWorkflowID + `", "task_id": 1, ` +
+		`"timer": {"workflow_id": "wid", "task_id": 1}}`
+	_, _, err := reshardRow(row, rowTypeTimerTask, s.shardForWorkflow, func(int) (int64, error) {
+		return 0, errors.New("range not acquired")
+	})
+	s.Error(err)
+}
+
+func (s *resharderSuite) TestRescheduleTimerRow() {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	row := `{"type": 3, "visibility_ts": "2020-01-02 03:00:00.000Z", ` +
+		`"timer": {"workflow_id": "wid", "visibility_ts": "2020-01-02 03:00:00.000Z"}}`
+	newRow, err := rescheduleTimerRow(row, now)
+	s.NoError(err)
+	columns := s.decode(newRow)
+	s.Equal("2020-01-02 03:04:05.000Z", columns["visibility_ts"])
+	s.Equal("2020-01-02 03:04:05.000Z", columns["timer"].(map[string]interface{})["visibility_ts"])
+
+	row = `{"type": 3, "visibility_ts": "2020-01-02 04:00:00.000Z", ` +
+		`"timer": {"workflow_id": "wid", "visibility_ts": "2020-01-02 04:00:00.000Z"}}`
+	newRow, err = rescheduleTimerRow(row, now)
+	s.NoError(err)
+	s.Equal(row, newRow)
+
+	_, err = rescheduleTimerRow(`{"type": 3, "timer": {"workflow_id": "wid"}}`, now)
+	s.Error(err)
+}
+
+func (s *resharderSuite) shardForWorkflow(workflowID string) int {
+	return common.WorkflowIDToHistoryShard(workflowID, 16)
+}
+
+func (s *resharderSuite) decode(row string) map[string]interface{} {
+	columns := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader([]byte(row)))
+	decoder.UseNumber()
+	s.NoError(decoder.Decode(&columns))
+	return columns
+}
@@ -18,6 +18,8 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination dataInterfaces_mock.go -self_package github.com/uber/cadence/common/persistence
+
 package persistence
 
 import (
@@ -59,15 +61,33 @@ const (
 	// Only applicable for CreateWorkflowExecution
 	CreateWorkflowModeWorkflowIDReuse
 	// Update current record only if workflow is open
-	// Only applicable for UpdateWorkflowExecution
+	// Only applicable for UpdateWorkflowExecution, used both by continue as new
+	// and by the TerminateIfRunning workflow ID reuse policy which closes the
+	// current run and creates the new run in the same update
 	CreateWorkflowModeContinueAsNew
 )
 
+// UpdateWorkflowMode update mode
+type UpdateWorkflowMode int
+
+// Update Workflow Execution Mode
+const (
+	// Update workflow, including current record
+	// NOTE: update on current record is a condition update
+	UpdateWorkflowModeUpdateCurrent UpdateWorkflowMode = iota
+	// Update workflow, without current record
+	// NOTE: current record CANNOT point to the workflow to be updated
+	UpdateWorkflowModeBypassCurrent
+)
+
 // Workflow execution states
 const (
 	WorkflowStateCreated = iota
 	WorkflowStateRunning
 	WorkflowStateCompleted
+	// WorkflowStateZombie is for executions which are not referenced by the current record
+	// and are not closed, e.g. a run left behind by replication conflict resolution
+	WorkflowStateZombie
 )
 
 // Workflow execution close status
@@ -91,6 +111,7 @@ const (
 const (
 	TaskListKindNormal = iota
 	TaskListKindSticky
+	TaskListKindWorker
 )
 
 // Transfer task types
@@ -121,6 +142,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeDeleteVisibility
 )
 
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
@@ -193,6 +215,11 @@ type (
 		Msg string
 	}
 
+	// DataLossError is returned when history data read from persistence is found to be corrupted
+	DataLossError struct {
+		Msg string
+	}
+
 	// ShardInfo describes a shard
 	ShardInfo struct {
 		ShardID                   int
@@ -209,6 +236,7 @@ type (
 		TimerFailoverLevels       map[string]TimerFailoverLevel    // uuid -> TimerFailoverLevel
 		ClusterReplicationLevel   map[string]int64                 // cluster -> last replicated taskID
 		DomainNotificationVersion int64
+		MaxObservedTime           time.Time // max clock observed by shard owners, floor of event timestamps
 	}
 
 	// TransferFailoverLevel contains corresponding start / end level
@@ -274,6 +302,11 @@ type (
 		AutoResetPoints                    *workflow.ResetPoints
 		Memo                               map[string][]byte
 		SearchAttributes                   map[string][]byte
+		QueryTypes                         []string
+		LastDecisionBinaryChecksum         string
+		Paused                             bool
+		Priority                           int32
+		TerminationProtected               bool
 		// for retry
 		Attempt            int32
 		HasRetryPolicy     bool
@@ -294,6 +327,14 @@ type (
 	// ExecutionStats is the statistics about workflow execution
 	ExecutionStats struct {
 		HistorySize int64
+		// EventCount is the number of events in the history
+		EventCount int64
+		// ActivityPayloadBytes is the size of the inputs scheduled to and the results returned by activities
+		ActivityPayloadBytes int64
+		// SignalEventCount is the number of signals recorded in the history
+		SignalEventCount int64
+		// TimerCount is the number of timers started by the workflow
+		TimerCount int64
 	}
 
 	// ReplicationState represents mutable state information for global domains.
@@ -367,6 +408,16 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// AdaptivePartitionConfig is the number of partitions decided by the adaptive scaler, it is
+		// only set on the root partition of the task lists scaled automatically
+		AdaptivePartitionConfig *TaskListPartitionConfig
+	}
+
+	// TaskListPartitionConfig is the number of read and write partitions of a task list
+	TaskListPartitionConfig struct {
+		Version            int64
+		NumReadPartitions  int
+		NumWritePartitions int
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -441,6 +492,14 @@ type (
 		Version             int64
 	}
 
+	// DeleteVisibilityTask identifies a timer task for deletion of the visibility record of completed execution,
+	// used when the visibility record is kept longer than the execution itself.
+	DeleteVisibilityTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// DecisionTimeoutTask identifies a timeout task.
 	DecisionTimeoutTask struct {
 		VisibilityTimestamp time.Time
@@ -578,6 +637,9 @@ type (
 		ExecutionStats      *ExecutionStats
 		ReplicationState    *ReplicationState
 		BufferedEvents      []*workflow.HistoryEvent
+		// Not written to database - derived from the mutable state snapshot of stores supporting it
+		HasMutableStateSnapshot bool
+		MutableStateDeltaSize   int
 	}
 
 	// ActivityInfo details.
@@ -732,6 +794,8 @@ type (
 	UpdateWorkflowExecutionRequest struct {
 		RangeID int64
 
+		Mode UpdateWorkflowMode
+
 		UpdateWorkflowMutation WorkflowMutation
 
 		NewWorkflowSnapshot *WorkflowSnapshot
@@ -739,6 +803,14 @@ type (
 		Encoding common.EncodingType // optional binary encoding type
 	}
 
+	// UpdateWorkflowExecutionBatchRequest is used to update multiple workflow executions of the shard in a single write,
+	// either all of the updates are applied or none of them
+	UpdateWorkflowExecutionBatchRequest struct {
+		RangeID int64
+
+		Requests []*UpdateWorkflowExecutionRequest
+	}
+
 	// ConflictResolveWorkflowExecutionRequest is used to reset workflow execution state for a single run
 	ConflictResolveWorkflowExecutionRequest struct {
 		RangeID int64
@@ -808,6 +880,11 @@ type (
 		NewBufferedEvents         []*workflow.HistoryEvent
 		ClearBufferedEvents       bool
 
+		// SnapshotMutableState indicates the upserts carry the complete mutable state maps,
+		// which are compacted into a single snapshot by stores supporting it
+		SnapshotMutableState    bool
+		HasMutableStateSnapshot bool
+
 		TransferTasks    []Task
 		ReplicationTasks []Task
 		TimerTasks       []Task
@@ -892,6 +969,7 @@ type (
 
 	// RangeCompleteTransferTaskRequest is used to complete a range of tasks in the transfer task queue
 	RangeCompleteTransferTaskRequest struct {
+		RangeID              int64
 		ExclusiveBeginTaskID int64
 		InclusiveEndTaskID   int64
 	}
@@ -903,6 +981,7 @@ type (
 
 	// RangeCompleteTimerTaskRequest is used to complete a range of tasks in the timer task queue
 	RangeCompleteTimerTaskRequest struct {
+		RangeID                 int64
 		InclusiveBeginTimestamp time.Time
 		ExclusiveEndTimestamp   time.Time
 	}
@@ -1100,12 +1179,17 @@ type (
 		VisibilityArchivalStatus workflow.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              workflow.BadBinaries
+		// KnownTaskLists is the set of task lists declared for the domain, empty means undeclared
+		KnownTaskLists []string
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
 	DomainReplicationConfig struct {
-		ActiveClusterName string
-		Clusters          []*ClusterReplicationConfig
+		ActiveClusterName        string
+		Clusters                 []*ClusterReplicationConfig
+		FailoverState            workflow.DomainFailoverState
+		PendingActiveClusterName string
+		PreviousFailoverVersion  int64
 	}
 
 	// ClusterReplicationConfig describes the cross DC cluster replication configuration
@@ -1152,6 +1236,7 @@ type (
 		Info                        *DomainInfo
 		Config                      *DomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
@@ -1240,6 +1325,11 @@ type (
 		MutableStateUpdateSessionStats *MutableStateUpdateSessionStats
 	}
 
+	// UpdateWorkflowExecutionBatchResponse is response for UpdateWorkflowExecutionBatchRequest
+	UpdateWorkflowExecutionBatchResponse struct {
+		Responses []*UpdateWorkflowExecutionResponse
+	}
+
 	// AppendHistoryNodesRequest is used to append a batch of history nodes
 	AppendHistoryNodesRequest struct {
 		// true if this is the first append request to the branch
@@ -1279,6 +1369,11 @@ type (
 		NextPageToken []byte
 		// The shard to get history branch data
 		ShardID *int
+		// optional: name of the domain the history belongs to, used to attribute corrupted history
+		DomainName string
+		// optional: ID of the domain and workflow the history belongs to, used to attribute slow or large reads
+		DomainID   string
+		WorkflowID string
 	}
 
 	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
@@ -1400,6 +1495,47 @@ type (
 		Branches []HistoryBranchDetail
 	}
 
+	// QuarantinedHistoryBranch is a history branch found to be corrupted when read
+	QuarantinedHistoryBranch struct {
+		TreeID   string
+		BranchID string
+		ShardID  int
+		// optional: name of the domain the history was read for
+		DomainName string
+		// event ID range of the read that found the corruption, min inclusive and max exclusive
+		MinEventID     int64
+		MaxEventID     int64
+		Reason         string
+		QuarantineTime time.Time
+	}
+
+	// QuarantineHistoryBranchRequest is used to record a corrupted history branch for the history scanner
+	QuarantineHistoryBranchRequest struct {
+		Branch QuarantinedHistoryBranch
+	}
+
+	// GetQuarantinedHistoryBranchesRequest is a request of GetQuarantinedHistoryBranches
+	GetQuarantinedHistoryBranchesRequest struct {
+		// pagination token
+		NextPageToken []byte
+		// maximum number of branches returned per page
+		PageSize int
+	}
+
+	// GetQuarantinedHistoryBranchesResponse is a response to GetQuarantinedHistoryBranches
+	GetQuarantinedHistoryBranchesResponse struct {
+		// pagination token
+		NextPageToken []byte
+		// quarantined branches of all trees
+		Branches []QuarantinedHistoryBranch
+	}
+
+	// DeleteQuarantinedHistoryBranchRequest is used to remove a history branch from quarantine
+	DeleteQuarantinedHistoryBranchRequest struct {
+		TreeID   string
+		BranchID string
+	}
+
 	// AppendHistoryEventsResponse is response for AppendHistoryEventsRequest
 	// Deprecated: uses V2 API-AppendHistoryNodesRequest
 	AppendHistoryEventsResponse struct {
@@ -1429,6 +1565,7 @@ type (
 		CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error)
 		GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 		UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+		UpdateWorkflowExecutionBatch(request *UpdateWorkflowExecutionBatchRequest) (*UpdateWorkflowExecutionBatchResponse, error)
 		ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error
 		ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
@@ -1528,6 +1665,12 @@ type (
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
 		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
+		// QuarantineHistoryBranch records a history branch found to be corrupted
+		QuarantineHistoryBranch(request *QuarantineHistoryBranchRequest) error
+		// GetQuarantinedHistoryBranches returns all quarantined history branches
+		GetQuarantinedHistoryBranches(request *GetQuarantinedHistoryBranchesRequest) (*GetQuarantinedHistoryBranchesResponse, error)
+		// DeleteQuarantinedHistoryBranch removes a history branch from quarantine
+		DeleteQuarantinedHistoryBranch(request *DeleteQuarantinedHistoryBranchRequest) error
 	}
 
 	// MetadataManager is used to manage metadata CRUD for domain entities
@@ -1546,7 +1689,12 @@ type (
 	// DomainReplicationQueue is used to publish and list domain replication tasks
 	DomainReplicationQueue interface {
 		Publish(message interface{}) error
+		PublishBatch(messages []interface{}) error
 		GetReplicationMessages(lastMessageID int, maxCount int) ([]*replicator.ReplicationTask, int, error)
+		UpdateAckLevel(lastProcessedMessageID int, clusterName string) error
+		GetAckLevels() (map[string]int, error)
+		// PurgeAckedMessages deletes the messages acked by all the given clusters
+		PurgeAckedMessages(clusterNames []string) error
 	}
 )
 
@@ -1582,6 +1730,10 @@ func (e *TransactionSizeLimitError) Error() string {
 	return e.Msg
 }
 
+func (e *DataLossError) Error() string {
+	return e.Msg
+}
+
 // IsTimeoutError check whether error is TimeoutError
 func IsTimeoutError(err error) bool {
 	_, ok := err.(*TimeoutError)
@@ -1798,6 +1950,41 @@ func (a *DeleteHistoryEventTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the delete visibility task
+func (a *DeleteVisibilityTask) GetType() int {
+	return TaskTypeDeleteVisibility
+}
+
+// GetVersion returns the version of the delete visibility task
+func (a *DeleteVisibilityTask) GetVersion() int64 {
+	return a.Version
+}
+
+// SetVersion returns the version of the delete visibility task
+func (a *DeleteVisibilityTask) SetVersion(version int64) {
+	a.Version = version
+}
+
+// GetTaskID returns the sequence ID of the delete visibility task
+func (a *DeleteVisibilityTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+// SetTaskID sets the sequence ID of the delete visibility task
+func (a *DeleteVisibilityTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (a *DeleteVisibilityTask) GetVisibilityTimestamp() time.Time {
+	return a.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (a *DeleteVisibilityTask) SetVisibilityTimestamp(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the timer task
 func (d *DecisionTimeoutTask) GetType() int {
 	return TaskTypeDecisionTimeout
@@ -0,0 +1,1411 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dataInterfaces.go
+
+// Package persistence is a generated GoMock package.
+package persistence
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	replicator "github.com/uber/cadence/.gen/go/replicator"
+	reflect "reflect"
+	time "time"
+)
+
+// MockTask is a mock of Task interface
+type MockTask struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskMockRecorder
+}
+
+// MockTaskMockRecorder is the mock recorder for MockTask
+type MockTaskMockRecorder struct {
+	mock *MockTask
+}
+
+// NewMockTask creates a new mock instance
+func NewMockTask(ctrl *gomock.Controller) *MockTask {
+	mock := &MockTask{ctrl: ctrl}
+	mock.recorder = &MockTaskMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTask) EXPECT() *MockTaskMockRecorder {
+	return m.recorder
+}
+
+// GetType mocks base method
+func (m *MockTask) GetType() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetType indicates an expected call of GetType
+func (mr *MockTaskMockRecorder) GetType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockTask)(nil).GetType))
+}
+
+// GetVersion mocks base method
+func (m *MockTask) GetVersion() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockTaskMockRecorder) GetVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockTask)(nil).GetVersion))
+}
+
+// SetVersion mocks base method
+func (m *MockTask) SetVersion(version int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetVersion", version)
+}
+
+// SetVersion indicates an expected call of SetVersion
+func (mr *MockTaskMockRecorder) SetVersion(version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVersion", reflect.TypeOf((*MockTask)(nil).SetVersion), version)
+}
+
+// GetTaskID mocks base method
+func (m *MockTask) GetTaskID() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskID")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetTaskID indicates an expected call of GetTaskID
+func (mr *MockTaskMockRecorder) GetTaskID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskID", reflect.TypeOf((*MockTask)(nil).GetTaskID))
+}
+
+// SetTaskID mocks base method
+func (m *MockTask) SetTaskID(id int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTaskID", id)
+}
+
+// SetTaskID indicates an expected call of SetTaskID
+func (mr *MockTaskMockRecorder) SetTaskID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskID", reflect.TypeOf((*MockTask)(nil).SetTaskID), id)
+}
+
+// GetVisibilityTimestamp mocks base method
+func (m *MockTask) GetVisibilityTimestamp() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibilityTimestamp")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetVisibilityTimestamp indicates an expected call of GetVisibilityTimestamp
+func (mr *MockTaskMockRecorder) GetVisibilityTimestamp() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityTimestamp", reflect.TypeOf((*MockTask)(nil).GetVisibilityTimestamp))
+}
+
+// SetVisibilityTimestamp mocks base method
+func (m *MockTask) SetVisibilityTimestamp(timestamp time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetVisibilityTimestamp", timestamp)
+}
+
+// SetVisibilityTimestamp indicates an expected call of SetVisibilityTimestamp
+func (mr *MockTaskMockRecorder) SetVisibilityTimestamp(timestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVisibilityTimestamp", reflect.TypeOf((*MockTask)(nil).SetVisibilityTimestamp), timestamp)
+}
+
+// MockCloseable is a mock of Closeable interface
+type MockCloseable struct {
+	ctrl     *gomock.Controller
+	recorder *MockCloseableMockRecorder
+}
+
+// MockCloseableMockRecorder is the mock recorder for MockCloseable
+type MockCloseableMockRecorder struct {
+	mock *MockCloseable
+}
+
+// NewMockCloseable creates a new mock instance
+func NewMockCloseable(ctrl *gomock.Controller) *MockCloseable {
+	mock := &MockCloseable{ctrl: ctrl}
+	mock.recorder = &MockCloseableMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCloseable) EXPECT() *MockCloseableMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockCloseable) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockCloseableMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockCloseable)(nil).Close))
+}
+
+// MockShardManager is a mock of ShardManager interface
+type MockShardManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockShardManagerMockRecorder
+}
+
+// MockShardManagerMockRecorder is the mock recorder for MockShardManager
+type MockShardManagerMockRecorder struct {
+	mock *MockShardManager
+}
+
+// NewMockShardManager creates a new mock instance
+func NewMockShardManager(ctrl *gomock.Controller) *MockShardManager {
+	mock := &MockShardManager{ctrl: ctrl}
+	mock.recorder = &MockShardManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockShardManager) EXPECT() *MockShardManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockShardManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockShardManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShardManager)(nil).Close))
+}
+
+// GetName mocks base method
+func (m *MockShardManager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName
+func (mr *MockShardManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockShardManager)(nil).GetName))
+}
+
+// CreateShard mocks base method
+func (m *MockShardManager) CreateShard(request *CreateShardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShard", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShard indicates an expected call of CreateShard
+func (mr *MockShardManagerMockRecorder) CreateShard(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShard", reflect.TypeOf((*MockShardManager)(nil).CreateShard), request)
+}
+
+// GetShard mocks base method
+func (m *MockShardManager) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShard", request)
+	ret0, _ := ret[0].(*GetShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShard indicates an expected call of GetShard
+func (mr *MockShardManagerMockRecorder) GetShard(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockShardManager)(nil).GetShard), request)
+}
+
+// UpdateShard mocks base method
+func (m *MockShardManager) UpdateShard(request *UpdateShardRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShard", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShard indicates an expected call of UpdateShard
+func (mr *MockShardManagerMockRecorder) UpdateShard(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShard", reflect.TypeOf((*MockShardManager)(nil).UpdateShard), request)
+}
+
+// MockExecutionManager is a mock of ExecutionManager interface
+type MockExecutionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutionManagerMockRecorder
+}
+
+// MockExecutionManagerMockRecorder is the mock recorder for MockExecutionManager
+type MockExecutionManagerMockRecorder struct {
+	mock *MockExecutionManager
+}
+
+// NewMockExecutionManager creates a new mock instance
+func NewMockExecutionManager(ctrl *gomock.Controller) *MockExecutionManager {
+	mock := &MockExecutionManager{ctrl: ctrl}
+	mock.recorder = &MockExecutionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockExecutionManager) EXPECT() *MockExecutionManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockExecutionManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockExecutionManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockExecutionManager)(nil).Close))
+}
+
+// GetName mocks base method
+func (m *MockExecutionManager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName
+func (mr *MockExecutionManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockExecutionManager)(nil).GetName))
+}
+
+// GetShardID mocks base method
+func (m *MockExecutionManager) GetShardID() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardID")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetShardID indicates an expected call of GetShardID
+func (mr *MockExecutionManagerMockRecorder) GetShardID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardID", reflect.TypeOf((*MockExecutionManager)(nil).GetShardID))
+}
+
+// CreateWorkflowExecution mocks base method
+func (m *MockExecutionManager) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflowExecution", request)
+	ret0, _ := ret[0].(*CreateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkflowExecution indicates an expected call of CreateWorkflowExecution
+func (mr *MockExecutionManagerMockRecorder) CreateWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).CreateWorkflowExecution), request)
+}
+
+// GetWorkflowExecution mocks base method
+func (m *MockExecutionManager) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecution", request)
+	ret0, _ := ret[0].(*GetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecution indicates an expected call of GetWorkflowExecution
+func (mr *MockExecutionManagerMockRecorder) GetWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).GetWorkflowExecution), request)
+}
+
+// UpdateWorkflowExecution mocks base method
+func (m *MockExecutionManager) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", request)
+	ret0, _ := ret[0].(*UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockExecutionManagerMockRecorder) UpdateWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).UpdateWorkflowExecution), request)
+}
+
+// UpdateWorkflowExecutionBatch mocks base method
+func (m *MockExecutionManager) UpdateWorkflowExecutionBatch(request *UpdateWorkflowExecutionBatchRequest) (*UpdateWorkflowExecutionBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecutionBatch", request)
+	ret0, _ := ret[0].(*UpdateWorkflowExecutionBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecutionBatch indicates an expected call of UpdateWorkflowExecutionBatch
+func (mr *MockExecutionManagerMockRecorder) UpdateWorkflowExecutionBatch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecutionBatch", reflect.TypeOf((*MockExecutionManager)(nil).UpdateWorkflowExecutionBatch), request)
+}
+
+// ConflictResolveWorkflowExecution mocks base method
+func (m *MockExecutionManager) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConflictResolveWorkflowExecution", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConflictResolveWorkflowExecution indicates an expected call of ConflictResolveWorkflowExecution
+func (mr *MockExecutionManagerMockRecorder) ConflictResolveWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConflictResolveWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).ConflictResolveWorkflowExecution), request)
+}
+
+// ResetWorkflowExecution mocks base method
+func (m *MockExecutionManager) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecution", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWorkflowExecution indicates an expected call of ResetWorkflowExecution
+func (mr *MockExecutionManagerMockRecorder) ResetWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).ResetWorkflowExecution), request)
+}
+
+// DeleteWorkflowExecution mocks base method
+func (m *MockExecutionManager) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution
+func (mr *MockExecutionManagerMockRecorder) DeleteWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).DeleteWorkflowExecution), request)
+}
+
+// DeleteCurrentWorkflowExecution mocks base method
+func (m *MockExecutionManager) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCurrentWorkflowExecution", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCurrentWorkflowExecution indicates an expected call of DeleteCurrentWorkflowExecution
+func (mr *MockExecutionManagerMockRecorder) DeleteCurrentWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCurrentWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).DeleteCurrentWorkflowExecution), request)
+}
+
+// GetCurrentExecution mocks base method
+func (m *MockExecutionManager) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentExecution", request)
+	ret0, _ := ret[0].(*GetCurrentExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentExecution indicates an expected call of GetCurrentExecution
+func (mr *MockExecutionManagerMockRecorder) GetCurrentExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentExecution", reflect.TypeOf((*MockExecutionManager)(nil).GetCurrentExecution), request)
+}
+
+// GetTransferTasks mocks base method
+func (m *MockExecutionManager) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferTasks", request)
+	ret0, _ := ret[0].(*GetTransferTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferTasks indicates an expected call of GetTransferTasks
+func (mr *MockExecutionManagerMockRecorder) GetTransferTasks(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferTasks", reflect.TypeOf((*MockExecutionManager)(nil).GetTransferTasks), request)
+}
+
+// CompleteTransferTask mocks base method
+func (m *MockExecutionManager) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTransferTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTransferTask indicates an expected call of CompleteTransferTask
+func (mr *MockExecutionManagerMockRecorder) CompleteTransferTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTransferTask", reflect.TypeOf((*MockExecutionManager)(nil).CompleteTransferTask), request)
+}
+
+// RangeCompleteTransferTask mocks base method
+func (m *MockExecutionManager) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeCompleteTransferTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeCompleteTransferTask indicates an expected call of RangeCompleteTransferTask
+func (mr *MockExecutionManagerMockRecorder) RangeCompleteTransferTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeCompleteTransferTask", reflect.TypeOf((*MockExecutionManager)(nil).RangeCompleteTransferTask), request)
+}
+
+// GetReplicationTasks mocks base method
+func (m *MockExecutionManager) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationTasks", request)
+	ret0, _ := ret[0].(*GetReplicationTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationTasks indicates an expected call of GetReplicationTasks
+func (mr *MockExecutionManagerMockRecorder) GetReplicationTasks(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationTasks", reflect.TypeOf((*MockExecutionManager)(nil).GetReplicationTasks), request)
+}
+
+// CompleteReplicationTask mocks base method
+func (m *MockExecutionManager) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteReplicationTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteReplicationTask indicates an expected call of CompleteReplicationTask
+func (mr *MockExecutionManagerMockRecorder) CompleteReplicationTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteReplicationTask", reflect.TypeOf((*MockExecutionManager)(nil).CompleteReplicationTask), request)
+}
+
+// GetTimerIndexTasks mocks base method
+func (m *MockExecutionManager) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimerIndexTasks", request)
+	ret0, _ := ret[0].(*GetTimerIndexTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimerIndexTasks indicates an expected call of GetTimerIndexTasks
+func (mr *MockExecutionManagerMockRecorder) GetTimerIndexTasks(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimerIndexTasks", reflect.TypeOf((*MockExecutionManager)(nil).GetTimerIndexTasks), request)
+}
+
+// CompleteTimerTask mocks base method
+func (m *MockExecutionManager) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTimerTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTimerTask indicates an expected call of CompleteTimerTask
+func (mr *MockExecutionManagerMockRecorder) CompleteTimerTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTimerTask", reflect.TypeOf((*MockExecutionManager)(nil).CompleteTimerTask), request)
+}
+
+// RangeCompleteTimerTask mocks base method
+func (m *MockExecutionManager) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeCompleteTimerTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeCompleteTimerTask indicates an expected call of RangeCompleteTimerTask
+func (mr *MockExecutionManagerMockRecorder) RangeCompleteTimerTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeCompleteTimerTask", reflect.TypeOf((*MockExecutionManager)(nil).RangeCompleteTimerTask), request)
+}
+
+// DeleteTask mocks base method
+func (m *MockExecutionManager) DeleteTask(request *DeleteTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask
+func (mr *MockExecutionManagerMockRecorder) DeleteTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockExecutionManager)(nil).DeleteTask), request)
+}
+
+// MockExecutionManagerFactory is a mock of ExecutionManagerFactory interface
+type MockExecutionManagerFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutionManagerFactoryMockRecorder
+}
+
+// MockExecutionManagerFactoryMockRecorder is the mock recorder for MockExecutionManagerFactory
+type MockExecutionManagerFactoryMockRecorder struct {
+	mock *MockExecutionManagerFactory
+}
+
+// NewMockExecutionManagerFactory creates a new mock instance
+func NewMockExecutionManagerFactory(ctrl *gomock.Controller) *MockExecutionManagerFactory {
+	mock := &MockExecutionManagerFactory{ctrl: ctrl}
+	mock.recorder = &MockExecutionManagerFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockExecutionManagerFactory) EXPECT() *MockExecutionManagerFactoryMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockExecutionManagerFactory) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockExecutionManagerFactoryMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockExecutionManagerFactory)(nil).Close))
+}
+
+// NewExecutionManager mocks base method
+func (m *MockExecutionManagerFactory) NewExecutionManager(shardID int) (ExecutionManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewExecutionManager", shardID)
+	ret0, _ := ret[0].(ExecutionManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewExecutionManager indicates an expected call of NewExecutionManager
+func (mr *MockExecutionManagerFactoryMockRecorder) NewExecutionManager(shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExecutionManager", reflect.TypeOf((*MockExecutionManagerFactory)(nil).NewExecutionManager), shardID)
+}
+
+// MockTaskManager is a mock of TaskManager interface
+type MockTaskManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskManagerMockRecorder
+}
+
+// MockTaskManagerMockRecorder is the mock recorder for MockTaskManager
+type MockTaskManagerMockRecorder struct {
+	mock *MockTaskManager
+}
+
+// NewMockTaskManager creates a new mock instance
+func NewMockTaskManager(ctrl *gomock.Controller) *MockTaskManager {
+	mock := &MockTaskManager{ctrl: ctrl}
+	mock.recorder = &MockTaskManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTaskManager) EXPECT() *MockTaskManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockTaskManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockTaskManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTaskManager)(nil).Close))
+}
+
+// GetName mocks base method
+func (m *MockTaskManager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName
+func (mr *MockTaskManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockTaskManager)(nil).GetName))
+}
+
+// LeaseTaskList mocks base method
+func (m *MockTaskManager) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaseTaskList", request)
+	ret0, _ := ret[0].(*LeaseTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaseTaskList indicates an expected call of LeaseTaskList
+func (mr *MockTaskManagerMockRecorder) LeaseTaskList(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaseTaskList", reflect.TypeOf((*MockTaskManager)(nil).LeaseTaskList), request)
+}
+
+// UpdateTaskList mocks base method
+func (m *MockTaskManager) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskList", request)
+	ret0, _ := ret[0].(*UpdateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskList indicates an expected call of UpdateTaskList
+func (mr *MockTaskManagerMockRecorder) UpdateTaskList(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskList", reflect.TypeOf((*MockTaskManager)(nil).UpdateTaskList), request)
+}
+
+// ListTaskList mocks base method
+func (m *MockTaskManager) ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskList", request)
+	ret0, _ := ret[0].(*ListTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskList indicates an expected call of ListTaskList
+func (mr *MockTaskManagerMockRecorder) ListTaskList(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskList", reflect.TypeOf((*MockTaskManager)(nil).ListTaskList), request)
+}
+
+// DeleteTaskList mocks base method
+func (m *MockTaskManager) DeleteTaskList(request *DeleteTaskListRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskList", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskList indicates an expected call of DeleteTaskList
+func (mr *MockTaskManagerMockRecorder) DeleteTaskList(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskList", reflect.TypeOf((*MockTaskManager)(nil).DeleteTaskList), request)
+}
+
+// CreateTasks mocks base method
+func (m *MockTaskManager) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTasks", request)
+	ret0, _ := ret[0].(*CreateTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTasks indicates an expected call of CreateTasks
+func (mr *MockTaskManagerMockRecorder) CreateTasks(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTasks", reflect.TypeOf((*MockTaskManager)(nil).CreateTasks), request)
+}
+
+// GetTasks mocks base method
+func (m *MockTaskManager) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasks", request)
+	ret0, _ := ret[0].(*GetTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasks indicates an expected call of GetTasks
+func (mr *MockTaskManagerMockRecorder) GetTasks(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockTaskManager)(nil).GetTasks), request)
+}
+
+// CompleteTask mocks base method
+func (m *MockTaskManager) CompleteTask(request *CompleteTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTask indicates an expected call of CompleteTask
+func (mr *MockTaskManagerMockRecorder) CompleteTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTask", reflect.TypeOf((*MockTaskManager)(nil).CompleteTask), request)
+}
+
+// CompleteTasksLessThan mocks base method
+func (m *MockTaskManager) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTasksLessThan", request)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTasksLessThan indicates an expected call of CompleteTasksLessThan
+func (mr *MockTaskManagerMockRecorder) CompleteTasksLessThan(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTasksLessThan", reflect.TypeOf((*MockTaskManager)(nil).CompleteTasksLessThan), request)
+}
+
+// MockHistoryManager is a mock of HistoryManager interface
+type MockHistoryManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryManagerMockRecorder
+}
+
+// MockHistoryManagerMockRecorder is the mock recorder for MockHistoryManager
+type MockHistoryManagerMockRecorder struct {
+	mock *MockHistoryManager
+}
+
+// NewMockHistoryManager creates a new mock instance
+func NewMockHistoryManager(ctrl *gomock.Controller) *MockHistoryManager {
+	mock := &MockHistoryManager{ctrl: ctrl}
+	mock.recorder = &MockHistoryManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockHistoryManager) EXPECT() *MockHistoryManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockHistoryManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockHistoryManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockHistoryManager)(nil).Close))
+}
+
+// GetName mocks base method
+func (m *MockHistoryManager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName
+func (mr *MockHistoryManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockHistoryManager)(nil).GetName))
+}
+
+// AppendHistoryEvents mocks base method
+func (m *MockHistoryManager) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryEvents", request)
+	ret0, _ := ret[0].(*AppendHistoryEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendHistoryEvents indicates an expected call of AppendHistoryEvents
+func (mr *MockHistoryManagerMockRecorder) AppendHistoryEvents(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryEvents", reflect.TypeOf((*MockHistoryManager)(nil).AppendHistoryEvents), request)
+}
+
+// GetWorkflowExecutionHistory mocks base method
+func (m *MockHistoryManager) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionHistory", request)
+	ret0, _ := ret[0].(*GetWorkflowExecutionHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionHistory indicates an expected call of GetWorkflowExecutionHistory
+func (mr *MockHistoryManagerMockRecorder) GetWorkflowExecutionHistory(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionHistory", reflect.TypeOf((*MockHistoryManager)(nil).GetWorkflowExecutionHistory), request)
+}
+
+// GetWorkflowExecutionHistoryByBatch mocks base method
+func (m *MockHistoryManager) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionHistoryByBatch", request)
+	ret0, _ := ret[0].(*GetWorkflowExecutionHistoryByBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionHistoryByBatch indicates an expected call of GetWorkflowExecutionHistoryByBatch
+func (mr *MockHistoryManagerMockRecorder) GetWorkflowExecutionHistoryByBatch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionHistoryByBatch", reflect.TypeOf((*MockHistoryManager)(nil).GetWorkflowExecutionHistoryByBatch), request)
+}
+
+// DeleteWorkflowExecutionHistory mocks base method
+func (m *MockHistoryManager) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecutionHistory", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecutionHistory indicates an expected call of DeleteWorkflowExecutionHistory
+func (mr *MockHistoryManagerMockRecorder) DeleteWorkflowExecutionHistory(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecutionHistory", reflect.TypeOf((*MockHistoryManager)(nil).DeleteWorkflowExecutionHistory), request)
+}
+
+// MockHistoryV2Manager is a mock of HistoryV2Manager interface
+type MockHistoryV2Manager struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryV2ManagerMockRecorder
+}
+
+// MockHistoryV2ManagerMockRecorder is the mock recorder for MockHistoryV2Manager
+type MockHistoryV2ManagerMockRecorder struct {
+	mock *MockHistoryV2Manager
+}
+
+// NewMockHistoryV2Manager creates a new mock instance
+func NewMockHistoryV2Manager(ctrl *gomock.Controller) *MockHistoryV2Manager {
+	mock := &MockHistoryV2Manager{ctrl: ctrl}
+	mock.recorder = &MockHistoryV2ManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockHistoryV2Manager) EXPECT() *MockHistoryV2ManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockHistoryV2Manager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockHistoryV2ManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockHistoryV2Manager)(nil).Close))
+}
+
+// GetName mocks base method
+func (m *MockHistoryV2Manager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName
+func (mr *MockHistoryV2ManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockHistoryV2Manager)(nil).GetName))
+}
+
+// AppendHistoryNodes mocks base method
+func (m *MockHistoryV2Manager) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryNodes", request)
+	ret0, _ := ret[0].(*AppendHistoryNodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendHistoryNodes indicates an expected call of AppendHistoryNodes
+func (mr *MockHistoryV2ManagerMockRecorder) AppendHistoryNodes(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryNodes", reflect.TypeOf((*MockHistoryV2Manager)(nil).AppendHistoryNodes), request)
+}
+
+// ReadHistoryBranch mocks base method
+func (m *MockHistoryV2Manager) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryBranch", request)
+	ret0, _ := ret[0].(*ReadHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryBranch indicates an expected call of ReadHistoryBranch
+func (mr *MockHistoryV2ManagerMockRecorder) ReadHistoryBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryBranch", reflect.TypeOf((*MockHistoryV2Manager)(nil).ReadHistoryBranch), request)
+}
+
+// ReadHistoryBranchByBatch mocks base method
+func (m *MockHistoryV2Manager) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadHistoryBranchByBatch", request)
+	ret0, _ := ret[0].(*ReadHistoryBranchByBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadHistoryBranchByBatch indicates an expected call of ReadHistoryBranchByBatch
+func (mr *MockHistoryV2ManagerMockRecorder) ReadHistoryBranchByBatch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadHistoryBranchByBatch", reflect.TypeOf((*MockHistoryV2Manager)(nil).ReadHistoryBranchByBatch), request)
+}
+
+// ReadRawHistoryBranch mocks base method
+func (m *MockHistoryV2Manager) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRawHistoryBranch", request)
+	ret0, _ := ret[0].(*ReadRawHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRawHistoryBranch indicates an expected call of ReadRawHistoryBranch
+func (mr *MockHistoryV2ManagerMockRecorder) ReadRawHistoryBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRawHistoryBranch", reflect.TypeOf((*MockHistoryV2Manager)(nil).ReadRawHistoryBranch), request)
+}
+
+// ForkHistoryBranch mocks base method
+func (m *MockHistoryV2Manager) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkHistoryBranch", request)
+	ret0, _ := ret[0].(*ForkHistoryBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkHistoryBranch indicates an expected call of ForkHistoryBranch
+func (mr *MockHistoryV2ManagerMockRecorder) ForkHistoryBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkHistoryBranch", reflect.TypeOf((*MockHistoryV2Manager)(nil).ForkHistoryBranch), request)
+}
+
+// CompleteForkBranch mocks base method
+func (m *MockHistoryV2Manager) CompleteForkBranch(request *CompleteForkBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteForkBranch", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteForkBranch indicates an expected call of CompleteForkBranch
+func (mr *MockHistoryV2ManagerMockRecorder) CompleteForkBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteForkBranch", reflect.TypeOf((*MockHistoryV2Manager)(nil).CompleteForkBranch), request)
+}
+
+// DeleteHistoryBranch mocks base method
+func (m *MockHistoryV2Manager) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHistoryBranch", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHistoryBranch indicates an expected call of DeleteHistoryBranch
+func (mr *MockHistoryV2ManagerMockRecorder) DeleteHistoryBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHistoryBranch", reflect.TypeOf((*MockHistoryV2Manager)(nil).DeleteHistoryBranch), request)
+}
+
+// GetHistoryTree mocks base method
+func (m *MockHistoryV2Manager) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryTree", request)
+	ret0, _ := ret[0].(*GetHistoryTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryTree indicates an expected call of GetHistoryTree
+func (mr *MockHistoryV2ManagerMockRecorder) GetHistoryTree(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTree", reflect.TypeOf((*MockHistoryV2Manager)(nil).GetHistoryTree), request)
+}
+
+// GetAllHistoryTreeBranches mocks base method
+func (m *MockHistoryV2Manager) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllHistoryTreeBranches", request)
+	ret0, _ := ret[0].(*GetAllHistoryTreeBranchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllHistoryTreeBranches indicates an expected call of GetAllHistoryTreeBranches
+func (mr *MockHistoryV2ManagerMockRecorder) GetAllHistoryTreeBranches(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllHistoryTreeBranches", reflect.TypeOf((*MockHistoryV2Manager)(nil).GetAllHistoryTreeBranches), request)
+}
+
+// QuarantineHistoryBranch mocks base method
+func (m *MockHistoryV2Manager) QuarantineHistoryBranch(request *QuarantineHistoryBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuarantineHistoryBranch", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QuarantineHistoryBranch indicates an expected call of QuarantineHistoryBranch
+func (mr *MockHistoryV2ManagerMockRecorder) QuarantineHistoryBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineHistoryBranch", reflect.TypeOf((*MockHistoryV2Manager)(nil).QuarantineHistoryBranch), request)
+}
+
+// GetQuarantinedHistoryBranches mocks base method
+func (m *MockHistoryV2Manager) GetQuarantinedHistoryBranches(request *GetQuarantinedHistoryBranchesRequest) (*GetQuarantinedHistoryBranchesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuarantinedHistoryBranches", request)
+	ret0, _ := ret[0].(*GetQuarantinedHistoryBranchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuarantinedHistoryBranches indicates an expected call of GetQuarantinedHistoryBranches
+func (mr *MockHistoryV2ManagerMockRecorder) GetQuarantinedHistoryBranches(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuarantinedHistoryBranches", reflect.TypeOf((*MockHistoryV2Manager)(nil).GetQuarantinedHistoryBranches), request)
+}
+
+// DeleteQuarantinedHistoryBranch mocks base method
+func (m *MockHistoryV2Manager) DeleteQuarantinedHistoryBranch(request *DeleteQuarantinedHistoryBranchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuarantinedHistoryBranch", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuarantinedHistoryBranch indicates an expected call of DeleteQuarantinedHistoryBranch
+func (mr *MockHistoryV2ManagerMockRecorder) DeleteQuarantinedHistoryBranch(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuarantinedHistoryBranch", reflect.TypeOf((*MockHistoryV2Manager)(nil).DeleteQuarantinedHistoryBranch), request)
+}
+
+// MockMetadataManager is a mock of MetadataManager interface
+type MockMetadataManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetadataManagerMockRecorder
+}
+
+// MockMetadataManagerMockRecorder is the mock recorder for MockMetadataManager
+type MockMetadataManagerMockRecorder struct {
+	mock *MockMetadataManager
+}
+
+// NewMockMetadataManager creates a new mock instance
+func NewMockMetadataManager(ctrl *gomock.Controller) *MockMetadataManager {
+	mock := &MockMetadataManager{ctrl: ctrl}
+	mock.recorder = &MockMetadataManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockMetadataManager) EXPECT() *MockMetadataManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockMetadataManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockMetadataManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMetadataManager)(nil).Close))
+}
+
+// GetName mocks base method
+func (m *MockMetadataManager) GetName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetName indicates an expected call of GetName
+func (mr *MockMetadataManagerMockRecorder) GetName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetName", reflect.TypeOf((*MockMetadataManager)(nil).GetName))
+}
+
+// CreateDomain mocks base method
+func (m *MockMetadataManager) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDomain", request)
+	ret0, _ := ret[0].(*CreateDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDomain indicates an expected call of CreateDomain
+func (mr *MockMetadataManagerMockRecorder) CreateDomain(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDomain", reflect.TypeOf((*MockMetadataManager)(nil).CreateDomain), request)
+}
+
+// GetDomain mocks base method
+func (m *MockMetadataManager) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomain", request)
+	ret0, _ := ret[0].(*GetDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomain indicates an expected call of GetDomain
+func (mr *MockMetadataManagerMockRecorder) GetDomain(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomain", reflect.TypeOf((*MockMetadataManager)(nil).GetDomain), request)
+}
+
+// UpdateDomain mocks base method
+func (m *MockMetadataManager) UpdateDomain(request *UpdateDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDomain", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDomain indicates an expected call of UpdateDomain
+func (mr *MockMetadataManagerMockRecorder) UpdateDomain(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockMetadataManager)(nil).UpdateDomain), request)
+}
+
+// DeleteDomain mocks base method
+func (m *MockMetadataManager) DeleteDomain(request *DeleteDomainRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomain", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain
+func (mr *MockMetadataManagerMockRecorder) DeleteDomain(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockMetadataManager)(nil).DeleteDomain), request)
+}
+
+// DeleteDomainByName mocks base method
+func (m *MockMetadataManager) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainByName", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomainByName indicates an expected call of DeleteDomainByName
+func (mr *MockMetadataManagerMockRecorder) DeleteDomainByName(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainByName", reflect.TypeOf((*MockMetadataManager)(nil).DeleteDomainByName), request)
+}
+
+// ListDomains mocks base method
+func (m *MockMetadataManager) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDomains", request)
+	ret0, _ := ret[0].(*ListDomainsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomains indicates an expected call of ListDomains
+func (mr *MockMetadataManagerMockRecorder) ListDomains(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockMetadataManager)(nil).ListDomains), request)
+}
+
+// GetMetadata mocks base method
+func (m *MockMetadataManager) GetMetadata() (*GetMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata")
+	ret0, _ := ret[0].(*GetMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata
+func (mr *MockMetadataManagerMockRecorder) GetMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataManager)(nil).GetMetadata))
+}
+
+// MockDomainReplicationQueue is a mock of DomainReplicationQueue interface
+type MockDomainReplicationQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainReplicationQueueMockRecorder
+}
+
+// MockDomainReplicationQueueMockRecorder is the mock recorder for MockDomainReplicationQueue
+type MockDomainReplicationQueueMockRecorder struct {
+	mock *MockDomainReplicationQueue
+}
+
+// NewMockDomainReplicationQueue creates a new mock instance
+func NewMockDomainReplicationQueue(ctrl *gomock.Controller) *MockDomainReplicationQueue {
+	mock := &MockDomainReplicationQueue{ctrl: ctrl}
+	mock.recorder = &MockDomainReplicationQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDomainReplicationQueue) EXPECT() *MockDomainReplicationQueueMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method
+func (m *MockDomainReplicationQueue) Publish(message interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockDomainReplicationQueueMockRecorder) Publish(message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockDomainReplicationQueue)(nil).Publish), message)
+}
+
+// PublishBatch mocks base method
+func (m *MockDomainReplicationQueue) PublishBatch(messages []interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishBatch", messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishBatch indicates an expected call of PublishBatch
+func (mr *MockDomainReplicationQueueMockRecorder) PublishBatch(messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBatch", reflect.TypeOf((*MockDomainReplicationQueue)(nil).PublishBatch), messages)
+}
+
+// GetReplicationMessages mocks base method
+func (m *MockDomainReplicationQueue) GetReplicationMessages(lastMessageID, maxCount int) ([]*replicator.ReplicationTask, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationMessages", lastMessageID, maxCount)
+	ret0, _ := ret[0].([]*replicator.ReplicationTask)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReplicationMessages indicates an expected call of GetReplicationMessages
+func (mr *MockDomainReplicationQueueMockRecorder) GetReplicationMessages(lastMessageID, maxCount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockDomainReplicationQueue)(nil).GetReplicationMessages), lastMessageID, maxCount)
+}
+
+// UpdateAckLevel mocks base method
+func (m *MockDomainReplicationQueue) UpdateAckLevel(lastProcessedMessageID int, clusterName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAckLevel", lastProcessedMessageID, clusterName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAckLevel indicates an expected call of UpdateAckLevel
+func (mr *MockDomainReplicationQueueMockRecorder) UpdateAckLevel(lastProcessedMessageID, clusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevel", reflect.TypeOf((*MockDomainReplicationQueue)(nil).UpdateAckLevel), lastProcessedMessageID, clusterName)
+}
+
+// GetAckLevels mocks base method
+func (m *MockDomainReplicationQueue) GetAckLevels() (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAckLevels")
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAckLevels indicates an expected call of GetAckLevels
+func (mr *MockDomainReplicationQueueMockRecorder) GetAckLevels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAckLevels", reflect.TypeOf((*MockDomainReplicationQueue)(nil).GetAckLevels))
+}
+
+// PurgeAckedMessages mocks base method
+func (m *MockDomainReplicationQueue) PurgeAckedMessages(clusterNames []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAckedMessages", clusterNames)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeAckedMessages indicates an expected call of PurgeAckedMessages
+func (mr *MockDomainReplicationQueueMockRecorder) PurgeAckedMessages(clusterNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAckedMessages", reflect.TypeOf((*MockDomainReplicationQueue)(nil).PurgeAckedMessages), clusterNames)
+}
@@ -23,17 +23,20 @@ package persistence
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
 )
 
 var _ DomainReplicationQueue = (*domainReplicationQueueImpl)(nil)
 
 // NewDomainReplicationQueue creates a new DomainReplicationQueue instance
-func NewDomainReplicationQueue(queue Queue) DomainReplicationQueue {
+func NewDomainReplicationQueue(queue Queue, logger log.Logger) DomainReplicationQueue {
 	return &domainReplicationQueueImpl{
 		queue:   queue,
+		logger:  logger,
 		encoder: codec.NewThriftRWEncoder(),
 	}
 }
@@ -41,6 +44,7 @@ func NewDomainReplicationQueue(queue Queue) DomainReplicationQueue {
 type (
 	domainReplicationQueueImpl struct {
 		queue   Queue
+		logger  log.Logger
 		encoder codec.BinaryEncoder
 	}
 )
@@ -58,6 +62,15 @@ func (q *domainReplicationQueueImpl) Publish(message interface{}) error {
 	return q.queue.EnqueueMessage(bytes)
 }
 
+func (q *domainReplicationQueueImpl) PublishBatch(messages []interface{}) error {
+	for _, message := range messages {
+		if err := q.Publish(message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (q *domainReplicationQueueImpl) GetReplicationMessages(
 	lastMessageID int,
 	maxCount int,
@@ -81,3 +94,46 @@ func (q *domainReplicationQueueImpl) GetReplicationMessages(
 
 	return replicationTasks, lastMessageID, nil
 }
+
+func (q *domainReplicationQueueImpl) UpdateAckLevel(
+	lastProcessedMessageID int,
+	clusterName string,
+) error {
+	return q.queue.UpdateAckLevel(lastProcessedMessageID, clusterName)
+}
+
+func (q *domainReplicationQueueImpl) GetAckLevels() (map[string]int, error) {
+	return q.queue.GetAckLevels()
+}
+
+// PurgeAckedMessages deletes the messages acked by every given cluster. Nothing is deleted while any of
+// the clusters has not acked a message yet, since all the messages may still be needed by that cluster.
+// The message at the lowest ack level itself is kept so the queue never becomes empty and message IDs
+// keep growing.
+func (q *domainReplicationQueueImpl) PurgeAckedMessages(clusterNames []string) error {
+	if len(clusterNames) == 0 {
+		return nil
+	}
+
+	ackLevelByCluster, err := q.GetAckLevels()
+	if err != nil {
+		return fmt.Errorf("failed to get ack levels: %v", err)
+	}
+
+	minAckLevel := math.MaxInt32
+	for _, clusterName := range clusterNames {
+		ackLevel, ok := ackLevelByCluster[clusterName]
+		if !ok {
+			return nil
+		}
+		if ackLevel < minAckLevel {
+			minAckLevel = ackLevel
+		}
+	}
+
+	if err := q.queue.DeleteMessagesBefore(minAckLevel); err != nil {
+		return fmt.Errorf("failed to purge messages: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	domainReplicationQueueSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		queue                  *fakeQueue
+		domainReplicationQueue DomainReplicationQueue
+	}
+
+	// fakeQueue keeps the ack levels in memory and records the purges
+	fakeQueue struct {
+		Queue
+		ackLevels        map[string]int
+		getAckLevelsErr  error
+		deletedBeforeIDs []int
+	}
+)
+
+func TestDomainReplicationQueueSuite(t *testing.T) {
+	suite.Run(t, new(domainReplicationQueueSuite))
+}
+
+func (s *domainReplicationQueueSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.queue = &fakeQueue{ackLevels: make(map[string]int)}
+	s.domainReplicationQueue = NewDomainReplicationQueue(s.queue, loggerimpl.NewNopLogger())
+}
+
+func (s *domainReplicationQueueSuite) TestPurgeAckedMessages_MinAckLevel() {
+	s.queue.ackLevels = map[string]int{"cluster-a": 10, "cluster-b": 5, "cluster-c": 20}
+	s.NoError(s.domainReplicationQueue.PurgeAckedMessages([]string{"cluster-a", "cluster-b", "cluster-c"}))
+	s.Equal([]int{5}, s.queue.deletedBeforeIDs)
+}
+
+func (s *domainReplicationQueueSuite) TestPurgeAckedMessages_IgnoresClustersNotGiven() {
+	// ack level of a cluster which was removed from the cluster metadata
+	s.queue.ackLevels = map[string]int{"cluster-a": 10, "removed-cluster": 1}
+	s.NoError(s.domainReplicationQueue.PurgeAckedMessages([]string{"cluster-a"}))
+	s.Equal([]int{10}, s.queue.deletedBeforeIDs)
+}
+
+func (s *domainReplicationQueueSuite) TestPurgeAckedMessages_ClusterWithoutAckLevel() {
+	// a cluster which has never acked may still need every message
+	s.queue.ackLevels = map[string]int{"cluster-a": 10}
+	s.NoError(s.domainReplicationQueue.PurgeAckedMessages([]string{"cluster-a", "new-cluster"}))
+	s.Empty(s.queue.deletedBeforeIDs)
+}
+
+func (s *domainReplicationQueueSuite) TestPurgeAckedMessages_NoClusters() {
+	s.queue.ackLevels = map[string]int{"cluster-a": 10}
+	s.NoError(s.domainReplicationQueue.PurgeAckedMessages(nil))
+	s.Empty(s.queue.deletedBeforeIDs)
+}
+
+func (s *domainReplicationQueueSuite) TestPurgeAckedMessages_GetAckLevelsError() {
+	s.queue.getAckLevelsErr = errors.New("some random error")
+	s.Error(s.domainReplicationQueue.PurgeAckedMessages([]string{"cluster-a"}))
+	s.Empty(s.queue.deletedBeforeIDs)
+}
+
+func (q *fakeQueue) GetAckLevels() (map[string]int, error) {
+	if q.getAckLevelsErr != nil {
+		return nil, q.getAckLevelsErr
+	}
+	return q.ackLevels, nil
+}
+
+func (q *fakeQueue) DeleteMessagesBefore(messageID int) error {
+	q.deletedBeforeIDs = append(q.deletedBeforeIDs, messageID)
+	return nil
+}
@@ -33,11 +33,11 @@ import (
 // NewESVisibilityManager create a visibility manager for ElasticSearch
 // In history, it only needs kafka producer for writing data;
 // In frontend, it only needs ES client and related config for reading data
-func NewESVisibilityManager(indexName string, esClient es.Client, config *config.VisibilityConfig,
+func NewESVisibilityManager(esConfig *es.Config, esClient es.Client, config *config.VisibilityConfig,
 	producer messaging.Producer, metricsClient metrics.Client, log log.Logger) p.VisibilityManager {
 
-	visibilityFromESStore := NewElasticSearchVisibilityStore(esClient, indexName, producer, config, log)
-	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log)
+	visibilityFromESStore := NewElasticSearchVisibilityStore(esClient, esConfig, producer, config, log)
+	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, nil, log)
 
 	if config != nil {
 		// wrap with rate limiter
@@ -40,7 +40,7 @@ var _ p.VisibilityManager = (*visibilityMetricsClient)(nil)
 func NewVisibilityMetricsClient(persistence p.VisibilityManager, metricClient metrics.Client, logger log.Logger) p.VisibilityManager {
 	return &visibilityMetricsClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: metrics.NewTaggedClient(metricClient, metrics.StoreTag(persistence.GetName())),
 		logger:       logger,
 	}
 }
@@ -77,6 +77,20 @@ func (p *visibilityMetricsClient) RecordWorkflowExecutionClosed(request *p.Recor
 	return err
 }
 
+func (p *visibilityMetricsClient) RecordWorkflowExecutionClosedBatch(request *p.RecordWorkflowExecutionClosedBatchRequest) error {
+	p.metricClient.IncCounter(metrics.ElasticsearchRecordWorkflowExecutionClosedBatchScope, metrics.ElasticsearchRequests)
+
+	sw := p.metricClient.StartTimer(metrics.ElasticsearchRecordWorkflowExecutionClosedBatchScope, metrics.ElasticsearchLatency)
+	err := p.persistence.RecordWorkflowExecutionClosedBatch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.ElasticsearchRecordWorkflowExecutionClosedBatchScope, err)
+	}
+
+	return err
+}
+
 func (p *visibilityMetricsClient) UpsertWorkflowExecution(request *p.UpsertWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.ElasticsearchUpsertWorkflowExecutionScope, metrics.ElasticsearchRequests)
 
@@ -91,6 +105,20 @@ func (p *visibilityMetricsClient) UpsertWorkflowExecution(request *p.UpsertWorkf
 	return err
 }
 
+func (p *visibilityMetricsClient) UpdateHistoryArchivalInfo(request *p.UpdateHistoryArchivalInfoRequest) error {
+	p.metricClient.IncCounter(metrics.ElasticsearchUpdateHistoryArchivalInfoScope, metrics.ElasticsearchRequests)
+
+	sw := p.metricClient.StartTimer(metrics.ElasticsearchUpdateHistoryArchivalInfoScope, metrics.ElasticsearchLatency)
+	err := p.persistence.UpdateHistoryArchivalInfo(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.ElasticsearchUpdateHistoryArchivalInfoScope, err)
+	}
+
+	return err
+}
+
 func (p *visibilityMetricsClient) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchListOpenWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
@@ -189,6 +217,20 @@ func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByStatus(request *
 	return response, err
 }
 
+func (p *visibilityMetricsClient) ListClosedWorkflowExecutionsByMemo(request *p.ListClosedWorkflowExecutionsByMemoRequest) (*p.ListWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByMemoScope, metrics.ElasticsearchRequests)
+
+	sw := p.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByMemoScope, metrics.ElasticsearchLatency)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByMemo(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.ElasticsearchListClosedWorkflowExecutionsByMemoScope, err)
+	}
+
+	return response, err
+}
+
 func (p *visibilityMetricsClient) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.GetClosedWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.ElasticsearchGetClosedWorkflowExecutionScope, metrics.ElasticsearchRequests)
 
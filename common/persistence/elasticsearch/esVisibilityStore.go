@@ -55,6 +55,7 @@ const (
 type (
 	esVisibilityStore struct {
 		esClient es.Client
+		esConfig *es.Config
 		index    string
 		producer messaging.Producer
 		logger   log.Logger
@@ -69,6 +70,9 @@ type (
 		TieBreaker string // runID
 		// for ES scroll API
 		ScrollID string
+		// slice of a sliced scroll the ScrollID belongs to
+		SliceID    int
+		SliceCount int
 	}
 
 	visibilityRecord struct {
@@ -83,6 +87,9 @@ type (
 		Memo          []byte
 		Encoding      string
 		Attr          map[string]interface{}
+
+		HistoryArchivalInfo     []byte
+		HistoryArchivalEncoding string
 	}
 )
 
@@ -93,10 +100,15 @@ var (
 )
 
 // NewElasticSearchVisibilityStore create a visibility store connecting to ElasticSearch
-func NewElasticSearchVisibilityStore(esClient es.Client, index string, producer messaging.Producer, config *config.VisibilityConfig, logger log.Logger) p.VisibilityStore {
+func NewElasticSearchVisibilityStore(esClient es.Client, esConfig *es.Config, producer messaging.Producer, config *config.VisibilityConfig, logger log.Logger) p.VisibilityStore {
+	if esConfig == nil {
+		// writers only publish to kafka and never search
+		esConfig = &es.Config{}
+	}
 	return &esVisibilityStore{
 		esClient: esClient,
-		index:    index,
+		esConfig: esConfig,
+		index:    esConfig.GetVisibilityIndex(),
 		producer: producer,
 		logger:   logger.WithTags(tag.ComponentESVisibilityManager),
 		config:   config,
@@ -128,22 +140,16 @@ func (v *esVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRe
 
 func (v *esVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
 	v.checkProducer()
-	msg := getVisibilityMessageForCloseExecution(
-		request.DomainUUID,
-		request.WorkflowID,
-		request.RunID,
-		request.WorkflowTypeName,
-		request.StartTimestamp,
-		request.ExecutionTimestamp,
-		request.CloseTimestamp,
-		request.Status,
-		request.HistoryLength,
-		request.TaskID,
-		request.Memo.Data,
-		request.Memo.GetEncoding(),
-		request.SearchAttributes,
-	)
-	return v.producer.Publish(msg)
+	return v.producer.Publish(getVisibilityMessageForCloseExecutionRequest(request))
+}
+
+func (v *esVisibilityStore) RecordWorkflowExecutionClosedBatch(request *p.InternalRecordWorkflowExecutionClosedBatchRequest) error {
+	v.checkProducer()
+	msgs := make([]interface{}, 0, len(request.Requests))
+	for _, closedRequest := range request.Requests {
+		msgs = append(msgs, getVisibilityMessageForCloseExecutionRequest(closedRequest))
+	}
+	return v.producer.PublishBatch(msgs)
 }
 
 func (v *esVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
@@ -163,6 +169,11 @@ func (v *esVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWor
 	return v.producer.Publish(msg)
 }
 
+func (v *esVisibilityStore) UpdateHistoryArchivalInfo(request *p.InternalUpdateHistoryArchivalInfoRequest) error {
+	v.checkProducer()
+	return v.producer.Publish(getVisibilityMessageForHistoryArchivalInfo(request))
+}
+
 func (v *esVisibilityStore) ListOpenWorkflowExecutions(
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	token, err := v.getNextPageToken(request.NextPageToken)
@@ -335,6 +346,13 @@ func (v *esVisibilityStore) ListClosedWorkflowExecutionsByStatus(
 	return v.getListWorkflowExecutionsResponse(searchResult.Hits, token, request.PageSize, isRecordValid)
 }
 
+func (v *esVisibilityStore) ListClosedWorkflowExecutionsByMemo(
+	request *p.ListClosedWorkflowExecutionsByMemoRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+
+	// memo is not indexed in ElasticSearch, search attributes should be used instead
+	return nil, &workflow.BadRequestError{Message: "Memo filter is not supported by ElasticSearch visibility, please use search attributes."}
+}
+
 func (v *esVisibilityStore) GetClosedWorkflowExecution(
 	request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
 
@@ -377,6 +395,7 @@ func (v *esVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteW
 		request.WorkflowID,
 		request.RunID,
 		request.TaskID,
+		request.StartTimestamp,
 	)
 	return v.producer.Publish(msg)
 }
@@ -417,11 +436,16 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 		return nil, err
 	}
 
+	if len(token.ScrollID) > 0 && (token.SliceID != request.SliceID || token.SliceCount != request.SliceCount) {
+		return nil, &workflow.BadRequestError{Message: "Next page token does not belong to the requested slice"}
+	}
+
 	ctx := context.Background()
 	var searchResult *elastic.SearchResult
 	var scrollService es.ScrollService
 	if len(token.ScrollID) == 0 { // first call
-		queryDSL, err := getESQueryDSLForScan(request)
+		var queryDSL string
+		queryDSL, err = getESQueryDSLForScan(request)
 		if err != nil {
 			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
 		}
@@ -440,7 +464,12 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 		}
 	}
 
-	return v.getScanWorkflowExecutionsResponse(searchResult.Hits, token, request.PageSize, searchResult.ScrollId, isLastPage)
+	nextToken := &esVisibilityPageToken{
+		ScrollID:   searchResult.ScrollId,
+		SliceID:    request.SliceID,
+		SliceCount: request.SliceCount,
+	}
+	return v.getScanWorkflowExecutionsResponse(searchResult.Hits, nextToken, request.PageSize, isLastPage)
 }
 
 func (v *esVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (
@@ -474,6 +503,7 @@ const (
 	dslFieldFrom        = "from"
 	dslFieldSize        = "size"
 	dslFieldMust        = "must"
+	dslFieldSlice       = "slice"
 
 	defaultDateTimeFormat = time.RFC3339 // used for converting UnixNano to string like 2018-02-15T16:16:36-08:00
 )
@@ -502,6 +532,9 @@ func getESQueryDSLForScan(request *p.ListWorkflowExecutionsRequestV2) (string, e
 
 	// remove not needed fields
 	dsl.Del(dslFieldSort)
+	if request.SliceCount > 1 {
+		dsl.Set(dslFieldSlice, fastjson.MustParse(fmt.Sprintf(`{"id":%d,"max":%d}`, request.SliceID, request.SliceCount)))
+	}
 	return dsl.String(), nil
 }
 
@@ -768,10 +801,11 @@ func (v *esVisibilityStore) getSearchResult(request *p.ListWorkflowExecutionsReq
 
 	ctx := context.Background()
 	params := &es.SearchParameters{
-		Index:    v.index,
-		Query:    boolQuery,
-		From:     token.From,
-		PageSize: request.PageSize,
+		Index:             v.getSearchIndex(request, isOpen),
+		Query:             boolQuery,
+		From:              token.From,
+		PageSize:          request.PageSize,
+		IgnoreUnavailable: v.esConfig.IsIndexRolloverEnabled(),
 	}
 	if isOpen {
 		params.Sorter = append(params.Sorter, elastic.NewFieldSort(es.StartTime).Desc())
@@ -787,8 +821,20 @@ func (v *esVisibilityStore) getSearchResult(request *p.ListWorkflowExecutionsReq
 	return v.esClient.Search(ctx, params)
 }
 
+// getSearchIndex narrows a search down to the rolled over indices covering the requested start time range,
+// closed executions are filtered by close time which does not bound their start time, so they search the alias
+func (v *esVisibilityStore) getSearchIndex(request *p.ListWorkflowExecutionsRequest, isOpen bool) string {
+	if !isOpen {
+		return v.index
+	}
+	return v.esConfig.GetVisibilityIndicesForStartTimeRange(
+		time.Unix(0, request.EarliestStartTime),
+		time.Unix(0, request.LatestStartTime),
+	)
+}
+
 func (v *esVisibilityStore) getScanWorkflowExecutionsResponse(searchHits *elastic.SearchHits,
-	token *esVisibilityPageToken, pageSize int, scrollID string, isLastPage bool) (
+	nextToken *esVisibilityPageToken, pageSize int, isLastPage bool) (
 	*p.InternalListWorkflowExecutionsResponse, error) {
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -802,7 +848,7 @@ func (v *esVisibilityStore) getScanWorkflowExecutionsResponse(searchHits *elasti
 	}
 
 	if numOfActualHits == pageSize && !isLastPage {
-		nextPageToken, err := v.serializePageToken(&esVisibilityPageToken{ScrollID: scrollID})
+		nextPageToken, err := v.serializePageToken(nextToken)
 		if err != nil {
 			return nil, err
 		}
@@ -902,6 +948,9 @@ func (v *esVisibilityStore) convertSearchResultToVisibilityRecord(hit *elastic.S
 		record.CloseTime = time.Unix(0, source.CloseTime)
 		record.Status = &source.CloseStatus
 		record.HistoryLength = source.HistoryLength
+		if len(source.HistoryArchivalInfo) > 0 {
+			record.HistoryArchivalInfo = p.NewDataBlob(source.HistoryArchivalInfo, common.EncodingType(source.HistoryArchivalEncoding))
+		}
 	}
 
 	return record
@@ -936,6 +985,26 @@ func getVisibilityMessage(domainID string, wid, rid string, workflowTypeName str
 	return msg
 }
 
+func getVisibilityMessageForCloseExecutionRequest(request *p.InternalRecordWorkflowExecutionClosedRequest) *indexer.Message {
+	msg := getVisibilityMessageForCloseExecution(
+		request.DomainUUID,
+		request.WorkflowID,
+		request.RunID,
+		request.WorkflowTypeName,
+		request.StartTimestamp,
+		request.ExecutionTimestamp,
+		request.CloseTimestamp,
+		request.Status,
+		request.HistoryLength,
+		request.TaskID,
+		request.Memo.Data,
+		request.Memo.GetEncoding(),
+		request.SearchAttributes,
+	)
+	addHistoryArchivalInfoFields(msg.Fields, request.HistoryArchivalInfo)
+	return msg
+}
+
 func getVisibilityMessageForCloseExecution(domainID string, wid, rid string, workflowTypeName string,
 	startTimeUnixNano int64, executionTimeUnixNano int64, endTimeUnixNano int64, closeStatus workflow.WorkflowExecutionCloseStatus,
 	historyLength int64, taskID int64, memo []byte, encoding common.EncodingType,
@@ -969,7 +1038,35 @@ func getVisibilityMessageForCloseExecution(domainID string, wid, rid string, wor
 	return msg
 }
 
-func getVisibilityMessageForDeletion(domainID, workflowID, runID string, docVersion int64) *indexer.Message {
+// getVisibilityMessageForHistoryArchivalInfo returns a message updating the history archival info of
+// an existing record, the start time locates the index of the record when indices are rolled over
+func getVisibilityMessageForHistoryArchivalInfo(request *p.InternalUpdateHistoryArchivalInfoRequest) *indexer.Message {
+	msgType := indexer.MessageTypeUpdate
+	fields := map[string]*indexer.Field{
+		es.StartTime: {Type: &es.FieldTypeInt, IntData: common.Int64Ptr(request.StartTimestamp)},
+	}
+	addHistoryArchivalInfoFields(fields, request.HistoryArchivalInfo)
+	return &indexer.Message{
+		MessageType: &msgType,
+		DomainID:    common.StringPtr(request.DomainUUID),
+		WorkflowID:  common.StringPtr(request.WorkflowID),
+		RunID:       common.StringPtr(request.RunID),
+		Fields:      fields,
+	}
+}
+
+func addHistoryArchivalInfoFields(fields map[string]*indexer.Field, historyArchivalInfo *p.DataBlob) {
+	if historyArchivalInfo == nil || len(historyArchivalInfo.Data) == 0 {
+		return
+	}
+	fields[es.HistoryArchivalInfo] = &indexer.Field{Type: &es.FieldTypeBinary, BinaryData: historyArchivalInfo.Data}
+	fields[es.HistoryArchivalEncoding] = &indexer.Field{
+		Type:       &es.FieldTypeString,
+		StringData: common.StringPtr(string(historyArchivalInfo.GetEncoding())),
+	}
+}
+
+func getVisibilityMessageForDeletion(domainID, workflowID, runID string, docVersion int64, startTimeUnixNano int64) *indexer.Message {
 	msgType := indexer.MessageTypeDelete
 	msg := &indexer.Message{
 		MessageType: &msgType,
@@ -978,6 +1075,12 @@ func getVisibilityMessageForDeletion(domainID, workflowID, runID string, docVers
 		RunID:       common.StringPtr(runID),
 		Version:     common.Int64Ptr(docVersion),
 	}
+	// the start time locates the rolled over index holding the record
+	if startTimeUnixNano != 0 {
+		msg.Fields = map[string]*indexer.Field{
+			es.StartTime: {Type: &es.FieldTypeInt, IntData: common.Int64Ptr(startTimeUnixNano)},
+		}
+	}
 	return msg
 }
 
@@ -29,6 +29,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/olivere/elastic"
 	"github.com/stretchr/testify/mock"
@@ -105,7 +106,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 	}
 
 	s.mockProducer = &mocks.KafkaProducer{}
-	mgr := NewElasticSearchVisibilityStore(s.mockESClient, testIndex, s.mockProducer, config, loggerimpl.NewNopLogger())
+	mgr := NewElasticSearchVisibilityStore(s.mockESClient, &es.Config{Indices: map[string]string{common.VisibilityAppName: testIndex}}, s.mockProducer, config, loggerimpl.NewNopLogger())
 	s.visibilityStore = mgr.(*esVisibilityStore)
 }
 
@@ -212,6 +213,33 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed_EmptyRequest() {
 	s.NoError(err)
 }
 
+func (s *ESVisibilitySuite) TestDeleteWorkflowExecution() {
+	request := &p.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:       "domainID",
+		WorkflowID:     "wid",
+		RunID:          "rid",
+		TaskID:         int64(111),
+		StartTimestamp: int64(123),
+	}
+	s.mockProducer.On("Publish", mock.MatchedBy(func(input *indexer.Message) bool {
+		return input.GetMessageType() == indexer.MessageTypeDelete &&
+			input.GetDomainID() == "domainID" &&
+			input.GetWorkflowID() == "wid" &&
+			input.GetRunID() == "rid" &&
+			input.GetVersion() == int64(111) &&
+			input.Fields[es.StartTime].GetIntData() == int64(123)
+	})).Return(nil).Once()
+	s.NoError(s.visibilityStore.DeleteWorkflowExecution(request))
+
+	// the start time is unknown
+	request.StartTimestamp = 0
+	s.mockProducer.On("Publish", mock.MatchedBy(func(input *indexer.Message) bool {
+		_, ok := input.Fields[es.StartTime]
+		return !ok
+	})).Return(nil).Once()
+	s.NoError(s.visibilityStore.DeleteWorkflowExecution(request))
+}
+
 func (s *ESVisibilitySuite) TestListOpenWorkflowExecutions() {
 	s.mockESClient.On("Search", mock.Anything, mock.MatchedBy(func(input *es.SearchParameters) bool {
 		source, _ := input.Query.Source()
@@ -492,6 +520,23 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 	s.visibilityStore.getSearchResult(request, token, matchQuery, isOpen)
 }
 
+func (s *ESVisibilitySuite) TestGetSearchIndex() {
+	request := &p.ListWorkflowExecutionsRequest{
+		EarliestStartTime: time.Date(2019, 8, 20, 10, 0, 0, 0, time.UTC).UnixNano(),
+		LatestStartTime:   time.Date(2019, 8, 21, 10, 0, 0, 0, time.UTC).UnixNano(),
+	}
+	s.Equal(testIndex, s.visibilityStore.getSearchIndex(request, true))
+	s.Equal(testIndex, s.visibilityStore.getSearchIndex(request, false))
+
+	s.visibilityStore.esConfig.IndexRollover = es.IndexRolloverDaily
+	s.Equal(testIndex+"-2019.08.20,"+testIndex+"-2019.08.21,"+testIndex+"-legacy", s.visibilityStore.getSearchIndex(request, true))
+	s.Equal(testIndex, s.visibilityStore.getSearchIndex(request, false))
+
+	request.EarliestStartTime = testEarliestTime
+	request.LatestStartTime = testLatestTime
+	s.Equal(testIndex, s.visibilityStore.getSearchIndex(request, true))
+}
+
 func (s *ESVisibilitySuite) TestGetListWorkflowExecutionsResponse() {
 	token := &esVisibilityPageToken{From: 0}
 
@@ -912,6 +957,40 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutions() {
 	s.True(strings.Contains(err.Error(), "ScanWorkflowExecutions failed"))
 }
 
+func (s *ESVisibilitySuite) TestScanWorkflowExecutions_Slices() {
+	s.mockESClient.On("ScrollFirstPage", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"slice":{"id":1,"max":3}`)
+	})).Return(testSearchResult, nil, nil).Once()
+
+	request := &p.ListWorkflowExecutionsRequestV2{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		PageSize:   10,
+		Query:      `CloseStatus = 5`,
+		SliceID:    1,
+		SliceCount: 3,
+	}
+	_, err := s.visibilityStore.ScanWorkflowExecutions(request)
+	s.NoError(err)
+
+	// token of another slice is rejected
+	tokenBytes, err := s.visibilityStore.serializePageToken(&esVisibilityPageToken{ScrollID: "scrollID-1", SliceID: 2, SliceCount: 3})
+	s.NoError(err)
+	request.NextPageToken = tokenBytes
+	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	s.Error(err)
+	_, ok := err.(*workflow.BadRequestError)
+	s.True(ok)
+
+	// token of the same slice continues the scroll
+	s.mockESClient.On("Scroll", mock.Anything, "scrollID-1").Return(testSearchResult, nil, nil).Once()
+	tokenBytes, err = s.visibilityStore.serializePageToken(&esVisibilityPageToken{ScrollID: "scrollID-1", SliceID: 1, SliceCount: 3})
+	s.NoError(err)
+	request.NextPageToken = tokenBytes
+	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	s.NoError(err)
+}
+
 func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.mockESClient.On("Count", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
 		s.True(strings.Contains(input, `{"match_phrase":{"CloseStatus":{"query":"5"}}}`))
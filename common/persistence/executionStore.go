@@ -21,6 +21,8 @@
 package persistence
 
 import (
+	"fmt"
+
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
@@ -76,6 +78,9 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 			SignalInfos:        response.State.SignalInfos,
 			SignalRequestedIDs: response.State.SignalRequestedIDs,
 			ReplicationState:   response.State.ReplicationState,
+
+			HasMutableStateSnapshot: response.State.HasMutableStateSnapshot,
+			MutableStateDeltaSize:   response.State.MutableStateDeltaSize,
 		},
 	}
 
@@ -171,9 +176,18 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
+		QueryTypes:                         info.QueryTypes,
+		LastDecisionBinaryChecksum:         info.LastDecisionBinaryChecksum,
+		Paused:                             info.Paused,
+		Priority:                           info.Priority,
+		TerminationProtected:               info.TerminationProtected,
 	}
 	newStats := &ExecutionStats{
-		HistorySize: info.HistorySize,
+		HistorySize:          info.HistorySize,
+		EventCount:           info.EventCount,
+		ActivityPayloadBytes: info.ActivityPayloadBytes,
+		SignalEventCount:     info.SignalEventCount,
+		TimerCount:           info.TimerCount,
 	}
 	return newInfo, newStats, nil
 }
@@ -313,6 +327,7 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 
 	newRequest := &InternalUpdateWorkflowExecutionRequest{
 		RangeID:                request.RangeID,
+		Mode:                   request.Mode,
 		UpdateWorkflowMutation: *serializedWorkflowMutation,
 		NewWorkflowSnapshot:    serializedNewWorkflowSnapshot,
 	}
@@ -321,6 +336,48 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 	return &UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: msuss}, err1
 }
 
+func (m *executionManagerImpl) UpdateWorkflowExecutionBatch(
+	request *UpdateWorkflowExecutionBatchRequest,
+) (*UpdateWorkflowExecutionBatchResponse, error) {
+
+	newRequest := &InternalUpdateWorkflowExecutionBatchRequest{
+		RangeID:  request.RangeID,
+		Requests: make([]*InternalUpdateWorkflowExecutionRequest, 0, len(request.Requests)),
+	}
+	response := &UpdateWorkflowExecutionBatchResponse{
+		Responses: make([]*UpdateWorkflowExecutionResponse, 0, len(request.Requests)),
+	}
+	for _, updateRequest := range request.Requests {
+		serializedWorkflowMutation, err := m.SerializeWorkflowMutation(&updateRequest.UpdateWorkflowMutation, updateRequest.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		var serializedNewWorkflowSnapshot *InternalWorkflowSnapshot
+		if updateRequest.NewWorkflowSnapshot != nil {
+			serializedNewWorkflowSnapshot, err = m.SerializeWorkflowSnapshot(updateRequest.NewWorkflowSnapshot, updateRequest.Encoding)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		internalRequest := &InternalUpdateWorkflowExecutionRequest{
+			RangeID:                request.RangeID,
+			Mode:                   updateRequest.Mode,
+			UpdateWorkflowMutation: *serializedWorkflowMutation,
+			NewWorkflowSnapshot:    serializedNewWorkflowSnapshot,
+		}
+		newRequest.Requests = append(newRequest.Requests, internalRequest)
+		response.Responses = append(response.Responses, &UpdateWorkflowExecutionResponse{
+			MutableStateUpdateSessionStats: m.statsComputer.computeMutableStateUpdateStats(internalRequest),
+		})
+	}
+
+	if err := m.persistence.UpdateWorkflowExecutionBatch(newRequest); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
 	infos []*ChildExecutionInfo,
 	encoding common.EncodingType,
@@ -487,9 +544,18 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		ExpirationSeconds:                  info.ExpirationSeconds,
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
+		QueryTypes:                         info.QueryTypes,
+		LastDecisionBinaryChecksum:         info.LastDecisionBinaryChecksum,
+		Paused:                             info.Paused,
+		Priority:                           info.Priority,
+		TerminationProtected:               info.TerminationProtected,
 
 		// attributes which are not related to mutable state
-		HistorySize: stats.HistorySize,
+		HistorySize:          stats.HistorySize,
+		EventCount:           stats.EventCount,
+		ActivityPayloadBytes: stats.ActivityPayloadBytes,
+		SignalEventCount:     stats.SignalEventCount,
+		TimerCount:           stats.TimerCount,
 	}, nil
 }
 
@@ -627,6 +693,9 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		NewBufferedEvents:         serializedNewBufferedEvents,
 		ClearBufferedEvents:       input.ClearBufferedEvents,
 
+		SnapshotMutableState:    input.SnapshotMutableState,
+		HasMutableStateSnapshot: input.HasMutableStateSnapshot,
+
 		TransferTasks:    input.TransferTasks,
 		ReplicationTasks: input.ReplicationTasks,
 		TimerTasks:       input.TimerTasks,
@@ -716,6 +785,17 @@ func (m *executionManagerImpl) CompleteTransferTask(
 func (m *executionManagerImpl) RangeCompleteTransferTask(
 	request *RangeCompleteTransferTaskRequest,
 ) error {
+
+	// the range delete cannot be undone, reject requests which would delete unexpected tasks
+	if request.RangeID <= 0 {
+		return &workflow.BadRequestError{Message: "RangeCompleteTransferTask requires the range ID of the shard owner."}
+	}
+	if request.ExclusiveBeginTaskID >= request.InclusiveEndTaskID {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("RangeCompleteTransferTask invalid task ID range (%v, %v].",
+				request.ExclusiveBeginTaskID, request.InclusiveEndTaskID),
+		}
+	}
 	return m.persistence.RangeCompleteTransferTask(request)
 }
 
@@ -748,6 +828,17 @@ func (m *executionManagerImpl) CompleteTimerTask(
 func (m *executionManagerImpl) RangeCompleteTimerTask(
 	request *RangeCompleteTimerTaskRequest,
 ) error {
+
+	// the range delete cannot be undone, reject requests which would delete unexpected tasks
+	if request.RangeID <= 0 {
+		return &workflow.BadRequestError{Message: "RangeCompleteTimerTask requires the range ID of the shard owner."}
+	}
+	if !request.InclusiveBeginTimestamp.Before(request.ExclusiveEndTimestamp) {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("RangeCompleteTimerTask invalid visibility timestamp range [%v, %v).",
+				request.InclusiveBeginTimestamp, request.ExclusiveEndTimestamp),
+		}
+	}
 	return m.persistence.RangeCompleteTimerTask(request)
 }
 
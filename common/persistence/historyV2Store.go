@@ -22,6 +22,7 @@ package persistence
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pborman/uuid"
 
@@ -322,6 +323,27 @@ func (m *historyV2ManagerImpl) GetAllHistoryTreeBranches(
 	return m.persistence.GetAllHistoryTreeBranches(request)
 }
 
+func (m *historyV2ManagerImpl) QuarantineHistoryBranch(
+	request *QuarantineHistoryBranchRequest,
+) error {
+
+	return m.persistence.QuarantineHistoryBranch(request)
+}
+
+func (m *historyV2ManagerImpl) GetQuarantinedHistoryBranches(
+	request *GetQuarantinedHistoryBranchesRequest,
+) (*GetQuarantinedHistoryBranchesResponse, error) {
+
+	return m.persistence.GetQuarantinedHistoryBranches(request)
+}
+
+func (m *historyV2ManagerImpl) DeleteQuarantinedHistoryBranch(
+	request *DeleteQuarantinedHistoryBranchRequest,
+) error {
+
+	return m.persistence.DeleteQuarantinedHistoryBranch(request)
+}
+
 func (m *historyV2ManagerImpl) readRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) ([]*DataBlob, *historyV2PagingToken, int, log.Logger, error) {
@@ -460,28 +482,15 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 		if err != nil {
 			return nil, nil, nil, 0, 0, err
 		}
-		if len(events) == 0 {
-			logger.Error("Empty events in a batch")
-			return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
-				Message: fmt.Sprintf("corrupted history event batch, empty events"),
-			}
+		if err := validateHistoryEventBatch(events); err != nil {
+			logger.Error("Corrupted event batch", tag.Error(err), tag.Counter(len(events)))
+			return nil, nil, nil, 0, 0, m.quarantineHistoryBranch(request, logger, err.Error())
 		}
 
 		firstEvent := events[0]           // first
 		eventCount := len(events)         // length
 		lastEvent := events[eventCount-1] // last
 
-		if firstEvent.GetVersion() != lastEvent.GetVersion() || firstEvent.GetEventId()+int64(eventCount-1) != lastEvent.GetEventId() {
-			// in a single batch, version should be the same, and ID should be continous
-			logger.Error("Corrupted event batch",
-				tag.FirstEventVersion(firstEvent.GetVersion()), tag.WorkflowFirstEventID(firstEvent.GetEventId()),
-				tag.LastEventVersion(lastEvent.GetVersion()), tag.WorkflowNextEventID(lastEvent.GetEventId()),
-				tag.Counter(eventCount))
-			return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
-				Message: fmt.Sprintf("corrupted history event batch, wrong version and IDs"),
-			}
-		}
-
 		if firstEvent.GetVersion() < token.LastEventVersion {
 			// version decrease means the this batch are all stale events, we should skip
 			logger.Info("Stale event batch with smaller version", tag.FirstEventVersion(firstEvent.GetVersion()), tag.TokenLastEventVersion(token.LastEventVersion))
@@ -503,9 +512,7 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 					tag.LastEventVersion(lastEvent.GetVersion()), tag.WorkflowNextEventID(lastEvent.GetEventId()),
 					tag.TokenLastEventVersion(token.LastEventVersion), tag.TokenLastEventID(token.LastEventID),
 					tag.Counter(eventCount))
-				return nil, nil, nil, 0, 0, &workflow.InternalServiceError{
-					Message: fmt.Sprintf("corrupted history event batch, eventID is not continouous"),
-				}
+				return nil, nil, nil, 0, 0, m.quarantineHistoryBranch(request, logger, "eventID is not continouous")
 			}
 		}
 
@@ -527,6 +534,46 @@ func (m *historyV2ManagerImpl) readHistoryBranch(
 	return historyEvents, historyEventBatches, nextPageToken, dataSize, lastFirstEventID, nil
 }
 
+// quarantineHistoryBranch records the corrupted history branch for the history scanner to process
+// and returns the DataLossError to surface, failing to record the branch is only logged
+func (m *historyV2ManagerImpl) quarantineHistoryBranch(
+	request *ReadHistoryBranchRequest,
+	logger log.Logger,
+	reason string,
+) error {
+
+	dataLossErr := &DataLossError{
+		Msg: fmt.Sprintf("corrupted history event batch, %v", reason),
+	}
+
+	var branch workflow.HistoryBranch
+	if err := m.thriftEncoder.Decode(request.BranchToken, &branch); err != nil {
+		logger.Error("Unable to quarantine corrupted history branch", tag.Error(err))
+		return dataLossErr
+	}
+	shardID, err := getShardID(request.ShardID)
+	if err != nil {
+		logger.Error("Unable to quarantine corrupted history branch", tag.Error(err))
+		return dataLossErr
+	}
+
+	if err := m.persistence.QuarantineHistoryBranch(&QuarantineHistoryBranchRequest{
+		Branch: QuarantinedHistoryBranch{
+			TreeID:         branch.GetTreeID(),
+			BranchID:       branch.GetBranchID(),
+			ShardID:        shardID,
+			DomainName:     request.DomainName,
+			MinEventID:     request.MinEventID,
+			MaxEventID:     request.MaxEventID,
+			Reason:         dataLossErr.Msg,
+			QuarantineTime: time.Now(),
+		},
+	}); err != nil {
+		logger.Error("Unable to quarantine corrupted history branch", tag.Error(err))
+	}
+	return dataLossErr
+}
+
 func (m *historyV2ManagerImpl) deserializeToken(
 	token []byte,
 	defaultLastEventID int64,
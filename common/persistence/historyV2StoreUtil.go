@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -186,3 +187,133 @@ func getShardID(shardID *int) (int, error) {
 	}
 	return *shardID, nil
 }
+
+// validateHistoryEventBatch checks a batch of history events read from persistence is well formed:
+// it is not empty, all events have the same version and continuous event IDs, and each event
+// carries the attributes of its event type
+func validateHistoryEventBatch(
+	events []*shared.HistoryEvent,
+) error {
+
+	if len(events) == 0 {
+		return errors.New("empty events")
+	}
+
+	firstEvent := events[0]
+	for index, event := range events {
+		if event.GetVersion() != firstEvent.GetVersion() {
+			return fmt.Errorf(
+				"wrong version, event %v has version %v while batch version is %v",
+				event.GetEventId(), event.GetVersion(), firstEvent.GetVersion(),
+			)
+		}
+		if event.GetEventId() != firstEvent.GetEventId()+int64(index) {
+			return fmt.Errorf(
+				"eventID is not continouous, expecting %v but got %v",
+				firstEvent.GetEventId()+int64(index), event.GetEventId(),
+			)
+		}
+		if event.EventType == nil || !hasHistoryEventAttributes(event) {
+			return fmt.Errorf("missing attributes of event %v with type %v", event.GetEventId(), event.EventType)
+		}
+	}
+	return nil
+}
+
+// hasHistoryEventAttributes returns whether the attributes matching the type of the history event are set
+func hasHistoryEventAttributes(
+	event *shared.HistoryEvent,
+) bool {
+
+	switch event.GetEventType() {
+	case shared.EventTypeWorkflowExecutionStarted:
+		return event.WorkflowExecutionStartedEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionCompleted:
+		return event.WorkflowExecutionCompletedEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionFailed:
+		return event.WorkflowExecutionFailedEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionTimedOut:
+		return event.WorkflowExecutionTimedOutEventAttributes != nil
+	case shared.EventTypeDecisionTaskScheduled:
+		return event.DecisionTaskScheduledEventAttributes != nil
+	case shared.EventTypeDecisionTaskStarted:
+		return event.DecisionTaskStartedEventAttributes != nil
+	case shared.EventTypeDecisionTaskCompleted:
+		return event.DecisionTaskCompletedEventAttributes != nil
+	case shared.EventTypeDecisionTaskTimedOut:
+		return event.DecisionTaskTimedOutEventAttributes != nil
+	case shared.EventTypeDecisionTaskFailed:
+		return event.DecisionTaskFailedEventAttributes != nil
+	case shared.EventTypeActivityTaskScheduled:
+		return event.ActivityTaskScheduledEventAttributes != nil
+	case shared.EventTypeActivityTaskStarted:
+		return event.ActivityTaskStartedEventAttributes != nil
+	case shared.EventTypeActivityTaskCompleted:
+		return event.ActivityTaskCompletedEventAttributes != nil
+	case shared.EventTypeActivityTaskFailed:
+		return event.ActivityTaskFailedEventAttributes != nil
+	case shared.EventTypeActivityTaskTimedOut:
+		return event.ActivityTaskTimedOutEventAttributes != nil
+	case shared.EventTypeActivityTaskCancelRequested:
+		return event.ActivityTaskCancelRequestedEventAttributes != nil
+	case shared.EventTypeRequestCancelActivityTaskFailed:
+		return event.RequestCancelActivityTaskFailedEventAttributes != nil
+	case shared.EventTypeActivityTaskCanceled:
+		return event.ActivityTaskCanceledEventAttributes != nil
+	case shared.EventTypeTimerStarted:
+		return event.TimerStartedEventAttributes != nil
+	case shared.EventTypeTimerFired:
+		return event.TimerFiredEventAttributes != nil
+	case shared.EventTypeCancelTimerFailed:
+		return event.CancelTimerFailedEventAttributes != nil
+	case shared.EventTypeTimerCanceled:
+		return event.TimerCanceledEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionCancelRequested:
+		return event.WorkflowExecutionCancelRequestedEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionCanceled:
+		return event.WorkflowExecutionCanceledEventAttributes != nil
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+		return event.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil
+	case shared.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		return event.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil
+	case shared.EventTypeExternalWorkflowExecutionCancelRequested:
+		return event.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil
+	case shared.EventTypeMarkerRecorded:
+		return event.MarkerRecordedEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionSignaled:
+		return event.WorkflowExecutionSignaledEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionTerminated:
+		return event.WorkflowExecutionTerminatedEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionContinuedAsNew:
+		return event.WorkflowExecutionContinuedAsNewEventAttributes != nil
+	case shared.EventTypeStartChildWorkflowExecutionInitiated:
+		return event.StartChildWorkflowExecutionInitiatedEventAttributes != nil
+	case shared.EventTypeStartChildWorkflowExecutionFailed:
+		return event.StartChildWorkflowExecutionFailedEventAttributes != nil
+	case shared.EventTypeChildWorkflowExecutionStarted:
+		return event.ChildWorkflowExecutionStartedEventAttributes != nil
+	case shared.EventTypeChildWorkflowExecutionCompleted:
+		return event.ChildWorkflowExecutionCompletedEventAttributes != nil
+	case shared.EventTypeChildWorkflowExecutionFailed:
+		return event.ChildWorkflowExecutionFailedEventAttributes != nil
+	case shared.EventTypeChildWorkflowExecutionCanceled:
+		return event.ChildWorkflowExecutionCanceledEventAttributes != nil
+	case shared.EventTypeChildWorkflowExecutionTimedOut:
+		return event.ChildWorkflowExecutionTimedOutEventAttributes != nil
+	case shared.EventTypeChildWorkflowExecutionTerminated:
+		return event.ChildWorkflowExecutionTerminatedEventAttributes != nil
+	case shared.EventTypeSignalExternalWorkflowExecutionInitiated:
+		return event.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil
+	case shared.EventTypeSignalExternalWorkflowExecutionFailed:
+		return event.SignalExternalWorkflowExecutionFailedEventAttributes != nil
+	case shared.EventTypeExternalWorkflowExecutionSignaled:
+		return event.ExternalWorkflowExecutionSignaledEventAttributes != nil
+	case shared.EventTypeUpsertWorkflowSearchAttributes:
+		return event.UpsertWorkflowSearchAttributesEventAttributes != nil
+	case shared.EventTypeWorkflowExecutionTerminationProtectionSet:
+		return event.WorkflowExecutionTerminationProtectionSetEventAttributes != nil
+	default:
+		// event types added after this server version have nothing to check against
+		return true
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	historyV2StoreUtilSuite struct {
+		suite.Suite
+	}
+)
+
+func TestHistoryV2StoreUtilSuite(t *testing.T) {
+	s := new(historyV2StoreUtilSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyV2StoreUtilSuite) TestValidateHistoryEventBatch_Valid() {
+	events := []*shared.HistoryEvent{
+		s.newMarkerEvent(5, 10),
+		s.newMarkerEvent(6, 10),
+		s.newMarkerEvent(7, 10),
+	}
+	s.NoError(validateHistoryEventBatch(events))
+}
+
+func (s *historyV2StoreUtilSuite) TestValidateHistoryEventBatch_Empty() {
+	s.Error(validateHistoryEventBatch(nil))
+}
+
+func (s *historyV2StoreUtilSuite) TestValidateHistoryEventBatch_NotContinuous() {
+	events := []*shared.HistoryEvent{
+		s.newMarkerEvent(5, 10),
+		s.newMarkerEvent(7, 10),
+	}
+	s.Error(validateHistoryEventBatch(events))
+}
+
+func (s *historyV2StoreUtilSuite) TestValidateHistoryEventBatch_VersionMismatch() {
+	events := []*shared.HistoryEvent{
+		s.newMarkerEvent(5, 10),
+		s.newMarkerEvent(6, 9),
+	}
+	s.Error(validateHistoryEventBatch(events))
+}
+
+func (s *historyV2StoreUtilSuite) TestValidateHistoryEventBatch_MissingAttributes() {
+	event := s.newMarkerEvent(5, 10)
+	event.MarkerRecordedEventAttributes = nil
+	s.Error(validateHistoryEventBatch([]*shared.HistoryEvent{event}))
+
+	event = s.newMarkerEvent(5, 10)
+	event.EventType = nil
+	s.Error(validateHistoryEventBatch([]*shared.HistoryEvent{event}))
+}
+
+func (s *historyV2StoreUtilSuite) newMarkerEvent(eventID int64, version int64) *shared.HistoryEvent {
+	return &shared.HistoryEvent{
+		EventId:                       common.Int64Ptr(eventID),
+		Version:                       common.Int64Ptr(version),
+		EventType:                     shared.EventTypeMarkerRecorded.Ptr(),
+		MarkerRecordedEventAttributes: &shared.MarkerRecordedEventAttributes{},
+	}
+}
@@ -99,6 +99,7 @@ func (m *metadataManagerImpl) UpdateDomain(request *UpdateDomainRequest) error {
 		Info:                        request.Info,
 		Config:                      &dc,
 		ReplicationConfig:           request.ReplicationConfig,
+		IsGlobalDomain:              request.IsGlobalDomain,
 		ConfigVersion:               request.ConfigVersion,
 		FailoverVersion:             request.FailoverVersion,
 		FailoverNotificationVersion: request.FailoverNotificationVersion,
@@ -163,6 +164,7 @@ func (m *metadataManagerImpl) serializeDomainConfig(c *DomainConfig) (InternalDo
 		VisibilityArchivalStatus: c.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    c.VisibilityArchivalURI,
 		BadBinaries:              badBinaries,
+		KnownTaskLists:           c.KnownTaskLists,
 	}, nil
 }
 
@@ -185,6 +187,7 @@ func (m *metadataManagerImpl) deserializeDomainConfig(ic *InternalDomainConfig)
 		VisibilityArchivalStatus: ic.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    ic.VisibilityArchivalURI,
 		BadBinaries:              *badBinaries,
+		KnownTaskLists:           ic.KnownTaskLists,
 	}, nil
 }
 
@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -164,6 +165,9 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if f.config.SlowQueryConfig != nil {
+		result = p.NewTaskPersistenceSlowQueryClient(result, f.config.SlowQueryConfig, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
@@ -214,6 +218,9 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if f.config.SlowQueryConfig != nil {
+		result = p.NewHistoryV2PersistenceSlowQueryClient(result, f.config.SlowQueryConfig, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewHistoryV2PersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
@@ -259,6 +266,9 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if f.config.SlowQueryConfig != nil {
+		result = p.NewWorkflowExecutionPersistenceSlowQueryClient(result, f.config.SlowQueryConfig, f.logger)
+	}
 	if f.metricsClient != nil {
 		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
@@ -277,7 +287,11 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 		store, err = cassandra.NewVisibilityPersistenceV2(store, f.getCassandraConfig(), f.logger)
 	}
 
-	result := p.NewVisibilityManagerImpl(store, f.logger)
+	var indexedMemoKeys dynamicconfig.StringPropertyFnWithDomainFilter
+	if visConfig != nil {
+		indexedMemoKeys = visConfig.IndexedMemoKeys
+	}
+	result := p.NewVisibilityManagerImpl(store, indexedMemoKeys, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -304,7 +318,7 @@ func (f *factoryImpl) NewDomainReplicationQueue() (p.DomainReplicationQueue, err
 		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
 
-	return p.NewDomainReplicationQueue(result), nil
+	return p.NewDomainReplicationQueue(result, f.logger), nil
 }
 
 // Close closes this factory
@@ -1016,7 +1016,16 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	memoKey := "memoKey"
 	memoVal := []byte("memoVal")
 	updatedInfo.Memo = map[string][]byte{memoKey: memoVal}
+	updatedInfo.QueryTypes = []string{"query1", "query2"}
+	updatedInfo.LastDecisionBinaryChecksum = "test-binary-checksum"
+	updatedInfo.Paused = true
+	updatedInfo.Priority = 2
+	updatedInfo.TerminationProtected = true
 	updatedStats.HistorySize = math.MaxInt64
+	updatedStats.EventCount = 5
+	updatedStats.ActivityPayloadBytes = 1024
+	updatedStats.SignalEventCount = 2
+	updatedStats.TimerCount = 1
 
 	err2 := s.UpdateWorkflowExecution(updatedInfo, updatedStats, []int64{int64(4)}, nil, int64(3), nil, nil, nil, nil, nil)
 	s.NoError(err2)
@@ -1054,6 +1063,10 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(updatedInfo.ClientImpl, info1.ClientImpl)
 	s.Equal(updatedInfo.SignalCount, info1.SignalCount)
 	s.EqualValues(updatedStats.HistorySize, state1.ExecutionStats.HistorySize)
+	s.Equal(updatedStats.EventCount, state1.ExecutionStats.EventCount)
+	s.Equal(updatedStats.ActivityPayloadBytes, state1.ExecutionStats.ActivityPayloadBytes)
+	s.Equal(updatedStats.SignalEventCount, state1.ExecutionStats.SignalEventCount)
+	s.Equal(updatedStats.TimerCount, state1.ExecutionStats.TimerCount)
 	s.Equal(updatedInfo.InitialInterval, info1.InitialInterval)
 	s.Equal(updatedInfo.BackoffCoefficient, info1.BackoffCoefficient)
 	s.Equal(updatedInfo.MaximumInterval, info1.MaximumInterval)
@@ -1067,6 +1080,11 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	memoVal1, ok := info1.Memo[memoKey]
 	s.True(ok)
 	s.Equal(memoVal, memoVal1)
+	s.Equal(updatedInfo.QueryTypes, info1.QueryTypes)
+	s.Equal(updatedInfo.LastDecisionBinaryChecksum, info1.LastDecisionBinaryChecksum)
+	s.Equal(updatedInfo.Paused, info1.Paused)
+	s.Equal(updatedInfo.Priority, info1.Priority)
+	s.Equal(updatedInfo.TerminationProtected, info1.TerminationProtected)
 
 	log.Infof("Workflow execution last updated: %v", info1.LastUpdatedTimestamp)
 
@@ -1965,6 +1983,24 @@ func (s *ExecutionManagerSuite) TestTransferTasksRangeComplete() {
 	s.Equal(int64(555), txTasks[4].Version)
 	s.Equal(int64(666), txTasks[5].Version)
 
+	err2 = s.ExecutionManager.RangeCompleteTransferTask(&p.RangeCompleteTransferTaskRequest{
+		RangeID:              s.ShardInfo.RangeID,
+		ExclusiveBeginTaskID: txTasks[5].TaskID,
+		InclusiveEndTaskID:   txTasks[0].TaskID - 1,
+	})
+	s.IsType(&gen.BadRequestError{}, err2)
+
+	err2 = s.ExecutionManager.RangeCompleteTransferTask(&p.RangeCompleteTransferTaskRequest{
+		RangeID:              s.ShardInfo.RangeID + 1,
+		ExclusiveBeginTaskID: txTasks[0].TaskID - 1,
+		InclusiveEndTaskID:   txTasks[5].TaskID,
+	})
+	s.IsType(&p.ShardOwnershipLostError{}, err2)
+
+	txTasks, err2 = s.GetTransferTasks(100, false)
+	s.NoError(err2)
+	s.Equal(6, len(txTasks), "expected tasks to be kept by a stale shard owner.")
+
 	err2 = s.RangeCompleteTransferTask(txTasks[0].TaskID-1, txTasks[5].TaskID)
 	s.NoError(err2)
 
@@ -2021,6 +2057,16 @@ func (s *ExecutionManagerSuite) TestTimerTasksComplete() {
 	s.Equal(int64(14), timerTasks[3].Version)
 	s.Equal(int64(15), timerTasks[4].Version)
 
+	err2 = s.ExecutionManager.RangeCompleteTimerTask(&p.RangeCompleteTimerTaskRequest{
+		RangeID:                 s.ShardInfo.RangeID + 1,
+		InclusiveBeginTimestamp: timerTasks[0].VisibilityTimestamp,
+		ExclusiveEndTimestamp:   timerTasks[4].VisibilityTimestamp.Add(1 * time.Second),
+	})
+	s.IsType(&p.ShardOwnershipLostError{}, err2)
+
+	err2 = s.RangeCompleteTimerTask(timerTasks[4].VisibilityTimestamp, timerTasks[0].VisibilityTimestamp)
+	s.IsType(&gen.BadRequestError{}, err2)
+
 	err2 = s.RangeCompleteTimerTask(timerTasks[0].VisibilityTimestamp, timerTasks[4].VisibilityTimestamp.Add(1*time.Second))
 	s.NoError(err2)
 
@@ -3841,7 +3887,11 @@ func copyWorkflowExecutionInfo(sourceInfo *p.WorkflowExecutionInfo) *p.WorkflowE
 
 func copyExecutionStats(sourceStats *p.ExecutionStats) *p.ExecutionStats {
 	return &p.ExecutionStats{
-		HistorySize: sourceStats.HistorySize,
+		HistorySize:          sourceStats.HistorySize,
+		EventCount:           sourceStats.EventCount,
+		ActivityPayloadBytes: sourceStats.ActivityPayloadBytes,
+		SignalEventCount:     sourceStats.SignalEventCount,
+		TimerCount:           sourceStats.TimerCount,
 	}
 }
 
@@ -221,7 +221,13 @@ func (s *HistoryPerfSuite) genRandomEvents(firstID, lastID int64) []*workflow.Hi
 
 	timestamp := time.Now().UnixNano()
 	for eid := firstID; eid < lastID; eid++ {
-		e := &workflow.HistoryEvent{EventId: common.Int64Ptr(eid), Version: common.Int64Ptr(timestamp), Timestamp: int64Ptr(timestamp)}
+		e := &workflow.HistoryEvent{
+			EventId:                       common.Int64Ptr(eid),
+			Version:                       common.Int64Ptr(timestamp),
+			Timestamp:                     int64Ptr(timestamp),
+			EventType:                     workflow.EventTypeMarkerRecorded.Ptr(),
+			MarkerRecordedEventAttributes: &workflow.MarkerRecordedEventAttributes{},
+		}
 		events = append(events, e)
 	}
 
@@ -694,7 +694,13 @@ func (s *HistoryV2PersistenceSuite) genRandomEvents(eventIDs []int64, version in
 
 	timestamp := time.Now().UnixNano()
 	for _, eid := range eventIDs {
-		e := &workflow.HistoryEvent{EventId: common.Int64Ptr(eid), Version: common.Int64Ptr(version), Timestamp: int64Ptr(timestamp)}
+		e := &workflow.HistoryEvent{
+			EventId:                       common.Int64Ptr(eid),
+			Version:                       common.Int64Ptr(version),
+			Timestamp:                     int64Ptr(timestamp),
+			EventType:                     workflow.EventTypeMarkerRecorded.Ptr(),
+			MarkerRecordedEventAttributes: &workflow.MarkerRecordedEventAttributes{},
+		}
 		events = append(events, e)
 	}
 
@@ -344,6 +344,47 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+// TestUpdateTaskListPartitionConfig test
+func (s *MatchingPersistenceSuite) TestUpdateTaskListPartitionConfig() {
+	domainID := uuid.New()
+	taskList := "partitioned-tasklist"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Nil(response.TaskListInfo.AdaptivePartitionConfig)
+
+	partitionConfig := &p.TaskListPartitionConfig{
+		Version:            1,
+		NumReadPartitions:  4,
+		NumWritePartitions: 3,
+	}
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID: domainID,
+			Name:     taskList,
+			TaskType: p.TaskListTypeDecision,
+			RangeID:  response.TaskListInfo.RangeID,
+			AckLevel: 0,
+			Kind:     p.TaskListKindNormal,
+
+			AdaptivePartitionConfig: partitionConfig,
+		},
+	})
+	s.NoError(err)
+
+	// the partition config survives the lease being stolen by another host
+	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Equal(partitionConfig, response.TaskListInfo.AdaptivePartitionConfig)
+}
+
 // TestLeaseAndUpdateTaskListSticky test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
@@ -885,10 +885,16 @@ func (m *MetadataPersistenceSuite) GetDomain(id, name string) (*p.GetDomainRespo
 // UpdateDomain helper
 func (m *MetadataPersistenceSuite) UpdateDomain(info *p.DomainInfo, config *p.DomainConfig, replicationConfig *p.DomainReplicationConfig,
 	configVersion int64, failoverVersion int64, dbVersion int64) error {
+	// updates keep whether the domain is global
+	resp, err := m.GetDomain(info.ID, "")
+	if err != nil {
+		return err
+	}
 	return m.MetadataManager.UpdateDomain(&p.UpdateDomainRequest{
 		Info:                info,
 		Config:              config,
 		ReplicationConfig:   replicationConfig,
+		IsGlobalDomain:      resp.IsGlobalDomain,
 		ConfigVersion:       configVersion,
 		FailoverVersion:     failoverVersion,
 		NotificationVersion: dbVersion,
@@ -1040,10 +1040,16 @@ func (m *MetadataPersistenceSuiteV2) GetDomain(id, name string) (*p.GetDomainRes
 // UpdateDomain helper method
 func (m *MetadataPersistenceSuiteV2) UpdateDomain(info *p.DomainInfo, config *p.DomainConfig, replicationConfig *p.DomainReplicationConfig,
 	configVersion int64, failoverVersion int64, failoverNotificationVersion int64, notificationVersion int64) error {
+	// updates keep whether the domain is global
+	resp, err := m.GetDomain(info.ID, "")
+	if err != nil {
+		return err
+	}
 	return m.MetadataManagerV2.UpdateDomain(&p.UpdateDomainRequest{
 		Info:                        info,
 		Config:                      config,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              resp.IsGlobalDomain,
 		ConfigVersion:               configVersion,
 		FailoverVersion:             failoverVersion,
 		FailoverNotificationVersion: failoverNotificationVersion,
@@ -1081,6 +1081,7 @@ func (s *TestBase) CompleteTransferTask(taskID int64) error {
 // RangeCompleteTransferTask is a utility method to complete a range of transfer tasks
 func (s *TestBase) RangeCompleteTransferTask(exclusiveBeginTaskID int64, inclusiveEndTaskID int64) error {
 	return s.ExecutionManager.RangeCompleteTransferTask(&p.RangeCompleteTransferTaskRequest{
+		RangeID:              s.ShardInfo.RangeID,
 		ExclusiveBeginTaskID: exclusiveBeginTaskID,
 		InclusiveEndTaskID:   inclusiveEndTaskID,
 	})
@@ -1132,6 +1133,7 @@ func (s *TestBase) CompleteTimerTask(ts time.Time, taskID int64) error {
 // RangeCompleteTimerTask is a utility method to complete a range of timer tasks
 func (s *TestBase) RangeCompleteTimerTask(inclusiveBeginTimestamp time.Time, exclusiveEndTimestamp time.Time) error {
 	return s.ExecutionManager.RangeCompleteTimerTask(&p.RangeCompleteTimerTaskRequest{
+		RangeID:                 s.ShardInfo.RangeID,
 		InclusiveBeginTimestamp: inclusiveBeginTimestamp,
 		ExclusiveEndTimestamp:   exclusiveEndTimestamp,
 	})
@@ -1373,6 +1375,16 @@ func (s *TestBase) GetReplicationMessages(lastMessageID int, maxCount int) ([]*r
 	return s.DomainReplicationQueue.GetReplicationMessages(lastMessageID, maxCount)
 }
 
+// UpdateDomainReplicationQueueAckLevel updates replication queue ack level
+func (s *TestBase) UpdateDomainReplicationQueueAckLevel(lastProcessedMessageID int, clusterName string) error {
+	return s.DomainReplicationQueue.UpdateAckLevel(lastProcessedMessageID, clusterName)
+}
+
+// GetAckLevels returns replication queue ack levels
+func (s *TestBase) GetAckLevels() (map[string]int, error) {
+	return s.DomainReplicationQueue.GetAckLevels()
+}
+
 // GenerateTransferTaskIDs helper
 func (g *TestTransferTaskIDGenerator) GenerateTransferTaskIDs(number int) ([]int64, error) {
 	result := []int64{}
@@ -101,3 +101,38 @@ func (s *QueuePersistenceSuite) TestDomainReplicationQueue() {
 	s.Equal(numMessages-1, lastRetrievedMessageID)
 
 }
+
+// TestQueueMetadataOperations tests queue metadata operations
+func (s *QueuePersistenceSuite) TestQueueMetadataOperations() {
+	clusterAckLevels, err := s.GetAckLevels()
+	s.Nil(err, "GetAckLevels failed.")
+	s.Len(clusterAckLevels, 0)
+
+	err = s.UpdateDomainReplicationQueueAckLevel(10, "test1")
+	s.Nil(err, "UpdateAckLevel failed.")
+
+	clusterAckLevels, err = s.GetAckLevels()
+	s.Nil(err, "GetAckLevels failed.")
+	s.Len(clusterAckLevels, 1)
+	s.Equal(10, clusterAckLevels["test1"])
+
+	err = s.UpdateDomainReplicationQueueAckLevel(20, "test1")
+	s.Nil(err, "UpdateAckLevel failed.")
+
+	clusterAckLevels, err = s.GetAckLevels()
+	s.Nil(err, "GetAckLevels failed.")
+	s.Len(clusterAckLevels, 1)
+	s.Equal(20, clusterAckLevels["test1"])
+
+	// ack level never moves backwards
+	err = s.UpdateDomainReplicationQueueAckLevel(25, "test2")
+	s.Nil(err, "UpdateAckLevel failed.")
+	err = s.UpdateDomainReplicationQueueAckLevel(15, "test1")
+	s.Nil(err, "UpdateAckLevel failed.")
+
+	clusterAckLevels, err = s.GetAckLevels()
+	s.Nil(err, "GetAckLevels failed.")
+	s.Len(clusterAckLevels, 2)
+	s.Equal(20, clusterAckLevels["test1"])
+	s.Equal(25, clusterAckLevels["test2"])
+}
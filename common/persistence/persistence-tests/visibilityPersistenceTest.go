@@ -611,9 +611,6 @@ func (s *VisibilityPersistenceSuite) TestMultipleUpserts() {
 
 // TestDelete test
 func (s *VisibilityPersistenceSuite) TestDelete() {
-	if s.VisibilityMgr.GetName() == "cassandra" {
-		s.T().Skip("this test is not applicable for cassandra")
-	}
 	nRows := 5
 	testDomainUUID := uuid.New()
 	startTime := time.Now().Add(time.Second * -5).UnixNano()
@@ -654,8 +651,10 @@ func (s *VisibilityPersistenceSuite) TestDelete() {
 	remaining := nRows
 	for _, row := range resp.Executions {
 		err4 := s.VisibilityMgr.DeleteWorkflowExecution(&p.VisibilityDeleteWorkflowExecutionRequest{
-			DomainID: testDomainUUID,
-			RunID:    row.GetExecution().GetRunId(),
+			DomainID:       testDomainUUID,
+			RunID:          row.GetExecution().GetRunId(),
+			StartTimestamp: row.GetStartTime(),
+			CloseTimestamp: row.GetCloseTime(),
 		})
 		s.Nil(err4)
 		remaining--
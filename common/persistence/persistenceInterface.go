@@ -61,6 +61,7 @@ type (
 		//The below three APIs are related to serialization/deserialization
 		GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*InternalGetWorkflowExecutionResponse, error)
 		UpdateWorkflowExecution(request *InternalUpdateWorkflowExecutionRequest) error
+		UpdateWorkflowExecutionBatch(request *InternalUpdateWorkflowExecutionBatchRequest) error
 		ConflictResolveWorkflowExecution(request *InternalConflictResolveWorkflowExecutionRequest) error
 		ResetWorkflowExecution(request *InternalResetWorkflowExecutionRequest) error
 
@@ -124,6 +125,12 @@ type (
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
 		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
+		// QuarantineHistoryBranch records a history branch found to be corrupted
+		QuarantineHistoryBranch(request *QuarantineHistoryBranchRequest) error
+		// GetQuarantinedHistoryBranches returns all quarantined history branches
+		GetQuarantinedHistoryBranches(request *GetQuarantinedHistoryBranchesRequest) (*GetQuarantinedHistoryBranchesResponse, error)
+		// DeleteQuarantinedHistoryBranch removes a history branch from quarantine
+		DeleteQuarantinedHistoryBranch(request *DeleteQuarantinedHistoryBranchRequest) error
 	}
 
 	// VisibilityStore is the store interface for visibility
@@ -132,7 +139,9 @@ type (
 		GetName() string
 		RecordWorkflowExecutionStarted(request *InternalRecordWorkflowExecutionStartedRequest) error
 		RecordWorkflowExecutionClosed(request *InternalRecordWorkflowExecutionClosedRequest) error
+		RecordWorkflowExecutionClosedBatch(request *InternalRecordWorkflowExecutionClosedBatchRequest) error
 		UpsertWorkflowExecution(request *InternalUpsertWorkflowExecutionRequest) error
+		UpdateHistoryArchivalInfo(request *InternalUpdateHistoryArchivalInfoRequest) error
 		ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*InternalListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*InternalListWorkflowExecutionsResponse, error)
 		ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*InternalListWorkflowExecutionsResponse, error)
@@ -140,6 +149,7 @@ type (
 		ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*InternalListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*InternalListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*InternalListWorkflowExecutionsResponse, error)
+		ListClosedWorkflowExecutionsByMemo(request *ListClosedWorkflowExecutionsByMemoRequest) (*InternalListWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*InternalGetClosedWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error
 		ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*InternalListWorkflowExecutionsResponse, error)
@@ -149,8 +159,15 @@ type (
 
 	// Queue is a store to enqueue and get messages
 	Queue interface {
+		GetName() string
 		EnqueueMessage(messagePayload []byte) error
 		DequeueMessages(lastMessageID int, maxCount int) ([]*QueueMessage, error)
+		// UpdateAckLevel records that the given cluster has processed all messages up to messageID
+		UpdateAckLevel(messageID int, clusterName string) error
+		// GetAckLevels returns the ack level of every cluster reading from the queue
+		GetAckLevels() (map[string]int, error)
+		// DeleteMessagesBefore removes all messages with an ID lower than messageID
+		DeleteMessagesBefore(messageID int) error
 	}
 
 	// QueueMessage is the message that stores in the queue
@@ -232,15 +249,24 @@ type (
 		MaximumAttempts    int32
 		NonRetriableErrors []string
 		// events V2 related
-		EventStoreVersion int32
-		BranchToken       []byte
-		CronSchedule      string
-		ExpirationSeconds int32
-		Memo              map[string][]byte
-		SearchAttributes  map[string][]byte
+		EventStoreVersion          int32
+		BranchToken                []byte
+		CronSchedule               string
+		ExpirationSeconds          int32
+		Memo                       map[string][]byte
+		SearchAttributes           map[string][]byte
+		QueryTypes                 []string
+		LastDecisionBinaryChecksum string
+		Paused                     bool
+		Priority                   int32
+		TerminationProtected       bool
 
 		// attributes which are not related to mutable state at all
-		HistorySize int64
+		HistorySize          int64
+		EventCount           int64
+		ActivityPayloadBytes int64
+		SignalEventCount     int64
+		TimerCount           int64
 	}
 
 	// InternalWorkflowMutableState indicates workflow related state for Persistence Interface
@@ -254,6 +280,9 @@ type (
 		ExecutionInfo       *InternalWorkflowExecutionInfo
 		ReplicationState    *ReplicationState
 		BufferedEvents      []*DataBlob
+		// Not written to database - derived from the mutable state snapshot columns
+		HasMutableStateSnapshot bool
+		MutableStateDeltaSize   int
 	}
 
 	// InternalActivityInfo details  for Persistence Interface
@@ -312,10 +341,20 @@ type (
 		ParentClosePolicy     workflow.ParentClosePolicy
 	}
 
+	// InternalUpdateWorkflowExecutionBatchRequest is used to update multiple workflow executions of the shard
+	// in a single write for Persistence Interface
+	InternalUpdateWorkflowExecutionBatchRequest struct {
+		RangeID int64
+
+		Requests []*InternalUpdateWorkflowExecutionRequest
+	}
+
 	// InternalUpdateWorkflowExecutionRequest is used to update a workflow execution for Persistence Interface
 	InternalUpdateWorkflowExecutionRequest struct {
 		RangeID int64
 
+		Mode UpdateWorkflowMode
+
 		UpdateWorkflowMutation InternalWorkflowMutation
 
 		NewWorkflowSnapshot *InternalWorkflowSnapshot
@@ -376,6 +415,11 @@ type (
 		NewBufferedEvents         *DataBlob
 		ClearBufferedEvents       bool
 
+		// SnapshotMutableState indicates the upserts carry the complete mutable state maps,
+		// which are compacted into a single snapshot by stores supporting it
+		SnapshotMutableState    bool
+		HasMutableStateSnapshot bool
+
 		TransferTasks    []Task
 		TimerTasks       []Task
 		ReplicationTasks []Task
@@ -548,6 +592,8 @@ type (
 		HistoryLength    int64
 		Memo             *DataBlob
 		SearchAttributes map[string]interface{}
+		// HistoryArchivalInfo is only set for closed executions whose history is archived
+		HistoryArchivalInfo *DataBlob
 	}
 
 	// InternalListWorkflowExecutionsResponse is response from ListWorkflowExecutions
@@ -579,19 +625,26 @@ type (
 
 	// InternalRecordWorkflowExecutionClosedRequest is request to RecordWorkflowExecutionClosed
 	InternalRecordWorkflowExecutionClosedRequest struct {
-		DomainUUID         string
-		WorkflowID         string
-		RunID              string
-		WorkflowTypeName   string
-		StartTimestamp     int64
-		ExecutionTimestamp int64
-		TaskID             int64
-		Memo               *DataBlob
-		SearchAttributes   map[string][]byte
-		CloseTimestamp     int64
-		Status             workflow.WorkflowExecutionCloseStatus
-		HistoryLength      int64
-		RetentionSeconds   int64
+		DomainUUID          string
+		WorkflowID          string
+		RunID               string
+		WorkflowTypeName    string
+		StartTimestamp      int64
+		ExecutionTimestamp  int64
+		TaskID              int64
+		Memo                *DataBlob
+		IndexedMemo         map[string]string // memo fields declared as indexed for the domain, used by basic visibility
+		SearchAttributes    map[string][]byte
+		CloseTimestamp      int64
+		Status              workflow.WorkflowExecutionCloseStatus
+		HistoryLength       int64
+		RetentionSeconds    int64
+		HistoryArchivalInfo *DataBlob
+	}
+
+	// InternalRecordWorkflowExecutionClosedBatchRequest is request to RecordWorkflowExecutionClosedBatch
+	InternalRecordWorkflowExecutionClosedBatchRequest struct {
+		Requests []*InternalRecordWorkflowExecutionClosedRequest
 	}
 
 	// InternalUpsertWorkflowExecutionRequest is request to UpsertWorkflowExecution
@@ -608,6 +661,17 @@ type (
 		SearchAttributes   map[string][]byte
 	}
 
+	// InternalUpdateHistoryArchivalInfoRequest is request to UpdateHistoryArchivalInfo
+	InternalUpdateHistoryArchivalInfoRequest struct {
+		DomainUUID          string
+		WorkflowID          string
+		RunID               string
+		StartTimestamp      int64
+		CloseTimestamp      int64
+		RetentionSeconds    int64
+		HistoryArchivalInfo *DataBlob
+	}
+
 	// InternalDomainConfig describes the domain configuration
 	InternalDomainConfig struct {
 		// NOTE: this retention is in days, not in seconds
@@ -620,6 +684,7 @@ type (
 		VisibilityArchivalStatus workflow.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
+		KnownTaskLists           []string
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
@@ -650,6 +715,7 @@ type (
 		Info                        *DomainInfo
 		Config                      *InternalDomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
@@ -697,6 +763,8 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeProto:
+		return common.EncodingTypeProto
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
 	default:
@@ -22,6 +22,7 @@ package persistence
 
 import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -90,7 +91,7 @@ var _ Queue = (*queuePersistenceClient)(nil)
 func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient metrics.Client, logger log.Logger) ShardManager {
 	return &shardPersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -99,7 +100,7 @@ func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient met
 func NewWorkflowExecutionPersistenceMetricsClient(persistence ExecutionManager, metricClient metrics.Client, logger log.Logger) ExecutionManager {
 	return &workflowExecutionPersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -108,7 +109,7 @@ func NewWorkflowExecutionPersistenceMetricsClient(persistence ExecutionManager,
 func NewTaskPersistenceMetricsClient(persistence TaskManager, metricClient metrics.Client, logger log.Logger) TaskManager {
 	return &taskPersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -117,7 +118,7 @@ func NewTaskPersistenceMetricsClient(persistence TaskManager, metricClient metri
 func NewHistoryPersistenceMetricsClient(persistence HistoryManager, metricClient metrics.Client, logger log.Logger) HistoryManager {
 	return &historyPersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -126,7 +127,7 @@ func NewHistoryPersistenceMetricsClient(persistence HistoryManager, metricClient
 func NewHistoryV2PersistenceMetricsClient(persistence HistoryV2Manager, metricClient metrics.Client, logger log.Logger) HistoryV2Manager {
 	return &historyV2PersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -135,7 +136,7 @@ func NewHistoryV2PersistenceMetricsClient(persistence HistoryV2Manager, metricCl
 func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricClient metrics.Client, logger log.Logger) MetadataManager {
 	return &metadataPersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -144,7 +145,7 @@ func NewMetadataPersistenceMetricsClient(persistence MetadataManager, metricClie
 func NewVisibilityPersistenceMetricsClient(persistence VisibilityManager, metricClient metrics.Client, logger log.Logger) VisibilityManager {
 	return &visibilityPersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -153,7 +154,7 @@ func NewVisibilityPersistenceMetricsClient(persistence VisibilityManager, metric
 func NewQueuePersistenceMetricsClient(persistence Queue, metricClient metrics.Client, logger log.Logger) Queue {
 	return &queuePersistenceClient{
 		persistence:  persistence,
-		metricClient: metricClient,
+		metricClient: newStoreTaggedMetricsClient(metricClient, persistence),
 		logger:       logger,
 	}
 }
@@ -206,20 +207,7 @@ func (p *shardPersistenceClient) UpdateShard(request *UpdateShardRequest) error
 }
 
 func (p *shardPersistenceClient) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *ShardAlreadyExistError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrShardExistsCounter)
-	case *ShardOwnershipLostError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrShardOwnershipLostCounter)
-	case *workflow.EntityNotExistsError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
-	case *workflow.ServiceBusyError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	default:
-		p.logger.Error("Operation failed with internal error.", tag.Error(err), tag.MetricScope(scope))
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	}
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err)
 }
 
 func (p *shardPersistenceClient) Close() {
@@ -257,6 +245,8 @@ func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWo
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionScope, err)
+	} else if response.MutableStateStats != nil {
+		recordPersistencePayloadSize(p.metricClient, metrics.PersistenceGetWorkflowExecutionScope, response.MutableStateStats.MutableStateSize)
 	}
 
 	return response, err
@@ -271,6 +261,22 @@ func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *Up
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionScope, err)
+	} else if resp.MutableStateUpdateSessionStats != nil {
+		recordPersistencePayloadSize(p.metricClient, metrics.PersistenceUpdateWorkflowExecutionScope, resp.MutableStateUpdateSessionStats.MutableStateSize)
+	}
+
+	return resp, err
+}
+
+func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecutionBatch(request *UpdateWorkflowExecutionBatchRequest) (*UpdateWorkflowExecutionBatchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionBatchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionBatchScope, metrics.PersistenceLatency)
+	resp, err := p.persistence.UpdateWorkflowExecutionBatch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionBatchScope, err)
 	}
 
 	return resp, err
@@ -472,28 +478,7 @@ func (p *workflowExecutionPersistenceClient) DeleteTask(request *DeleteTaskReque
 }
 
 func (p *workflowExecutionPersistenceClient) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *WorkflowExecutionAlreadyStartedError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrExecutionAlreadyStartedCounter)
-	case *workflow.EntityNotExistsError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
-	case *ShardOwnershipLostError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrShardOwnershipLostCounter)
-	case *ConditionFailedError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *CurrentWorkflowConditionFailedError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrCurrentWorkflowConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	case *workflow.ServiceBusyError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	default:
-		p.logger.Error("Operation failed with internal error.",
-			tag.Error(err), tag.MetricScope(scope), tag.ShardID(p.GetShardID()))
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	}
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err, tag.ShardID(p.GetShardID()))
 }
 
 func (p *workflowExecutionPersistenceClient) Close() {
@@ -608,20 +593,7 @@ func (p *taskPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (
 }
 
 func (p *taskPersistenceClient) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *ConditionFailedError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	case *workflow.ServiceBusyError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	default:
-		p.logger.Error("Operation failed with internal error.",
-			tag.Error(err), tag.MetricScope(scope))
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	}
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err)
 }
 
 func (p *taskPersistenceClient) Close() {
@@ -641,6 +613,8 @@ func (p *historyPersistenceClient) AppendHistoryEvents(request *AppendHistoryEve
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceAppendHistoryEventsScope, err)
+	} else {
+		recordPersistencePayloadSize(p.metricClient, metrics.PersistenceAppendHistoryEventsScope, resp.Size)
 	}
 
 	return resp, err
@@ -692,22 +666,7 @@ func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 }
 
 func (p *historyPersistenceClient) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *workflow.EntityNotExistsError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
-	case *ConditionFailedError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	case *workflow.ServiceBusyError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	default:
-		p.logger.Error("Operation failed with internal error.",
-			tag.Error(err), tag.MetricScope(scope))
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	}
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err)
 }
 
 func (p *historyPersistenceClient) Close() {
@@ -821,21 +780,7 @@ func (p *metadataPersistenceClient) Close() {
 }
 
 func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *workflow.DomainAlreadyExistsError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrDomainAlreadyExistsCounter)
-	case *workflow.EntityNotExistsError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
-	case *workflow.BadRequestError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBadRequestCounter)
-	case *workflow.ServiceBusyError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	default:
-		p.logger.Error("Operation failed with internal error.",
-			tag.Error(err), tag.MetricScope(scope))
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	}
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err)
 }
 
 func (p *visibilityPersistenceClient) GetName() string {
@@ -870,6 +815,20 @@ func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosed(request *Rec
 	return err
 }
 
+func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosedBatch(request *RecordWorkflowExecutionClosedBatchRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionClosedBatchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionClosedBatchScope, metrics.PersistenceLatency)
+	err := p.persistence.RecordWorkflowExecutionClosedBatch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRecordWorkflowExecutionClosedBatchScope, err)
+	}
+
+	return err
+}
+
 func (p *visibilityPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceRequests)
 
@@ -884,6 +843,20 @@ func (p *visibilityPersistenceClient) UpsertWorkflowExecution(request *UpsertWor
 	return err
 }
 
+func (p *visibilityPersistenceClient) UpdateHistoryArchivalInfo(request *UpdateHistoryArchivalInfoRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateHistoryArchivalInfoScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateHistoryArchivalInfoScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateHistoryArchivalInfo(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpdateHistoryArchivalInfoScope, err)
+	}
+
+	return err
+}
+
 func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceRequests)
 
@@ -982,6 +955,20 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(reque
 	return response, err
 }
 
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByMemo(request *ListClosedWorkflowExecutionsByMemoRequest) (*ListWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByMemoScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByMemoScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByMemo(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByMemoScope, err)
+	}
+
+	return response, err
+}
+
 func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceRequests)
 
@@ -1053,22 +1040,7 @@ func (p *visibilityPersistenceClient) CountWorkflowExecutions(request *CountWork
 }
 
 func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *ConditionFailedError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	case *workflow.EntityNotExistsError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
-	case *workflow.ServiceBusyError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	default:
-		p.logger.Error("Operation failed with internal error.",
-			tag.Error(err), tag.MetricScope(scope))
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	}
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err)
 }
 
 func (p *visibilityPersistenceClient) Close() {
@@ -1091,6 +1063,8 @@ func (p *historyV2PersistenceClient) AppendHistoryNodes(request *AppendHistoryNo
 	sw.Stop()
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceAppendHistoryNodesScope, err)
+	} else {
+		recordPersistencePayloadSize(p.metricClient, metrics.PersistenceAppendHistoryNodesScope, resp.Size)
 	}
 	return resp, err
 }
@@ -1102,7 +1076,9 @@ func (p *historyV2PersistenceClient) ReadHistoryBranch(request *ReadHistoryBranc
 	response, err := p.persistence.ReadHistoryBranch(request)
 	sw.Stop()
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
+		p.updateReadErrorMetric(metrics.PersistenceReadHistoryBranchScope, request.DomainName, err)
+	} else {
+		recordPersistencePayloadSize(p.metricClient, metrics.PersistenceReadHistoryBranchScope, response.Size)
 	}
 	return response, err
 }
@@ -1114,7 +1090,9 @@ func (p *historyV2PersistenceClient) ReadHistoryBranchByBatch(request *ReadHisto
 	response, err := p.persistence.ReadHistoryBranchByBatch(request)
 	sw.Stop()
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
+		p.updateReadErrorMetric(metrics.PersistenceReadHistoryBranchScope, request.DomainName, err)
+	} else {
+		recordPersistencePayloadSize(p.metricClient, metrics.PersistenceReadHistoryBranchScope, response.Size)
 	}
 	return response, err
 }
@@ -1126,7 +1104,9 @@ func (p *historyV2PersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBr
 	response, err := p.persistence.ReadRawHistoryBranch(request)
 	sw.Stop()
 	if err != nil {
-		p.updateErrorMetric(metrics.PersistenceReadHistoryBranchScope, err)
+		p.updateReadErrorMetric(metrics.PersistenceReadHistoryBranchScope, request.DomainName, err)
+	} else {
+		recordPersistencePayloadSize(p.metricClient, metrics.PersistenceReadHistoryBranchScope, response.Size)
 	}
 	return response, err
 }
@@ -1190,25 +1170,58 @@ func (p *historyV2PersistenceClient) GetHistoryTree(request *GetHistoryTreeReque
 	return response, err
 }
 
+// QuarantineHistoryBranch records a history branch found to be corrupted
+func (p *historyV2PersistenceClient) QuarantineHistoryBranch(request *QuarantineHistoryBranchRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceQuarantineHistoryBranchScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceQuarantineHistoryBranchScope, metrics.PersistenceLatency)
+	err := p.persistence.QuarantineHistoryBranch(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceQuarantineHistoryBranchScope, err)
+	}
+	return err
+}
+
+// GetQuarantinedHistoryBranches returns all quarantined history branches
+func (p *historyV2PersistenceClient) GetQuarantinedHistoryBranches(request *GetQuarantinedHistoryBranchesRequest) (*GetQuarantinedHistoryBranchesResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetQuarantinedHistoryBranchesScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetQuarantinedHistoryBranchesScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetQuarantinedHistoryBranches(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetQuarantinedHistoryBranchesScope, err)
+	}
+	return response, err
+}
+
+// DeleteQuarantinedHistoryBranch removes a history branch from quarantine
+func (p *historyV2PersistenceClient) DeleteQuarantinedHistoryBranch(request *DeleteQuarantinedHistoryBranchRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteQuarantinedHistoryBranchScope, metrics.PersistenceRequests)
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteQuarantinedHistoryBranchScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteQuarantinedHistoryBranch(request)
+	sw.Stop()
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteQuarantinedHistoryBranchScope, err)
+	}
+	return err
+}
+
 func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
-	switch err.(type) {
-	case *workflow.EntityNotExistsError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
-	case *ConditionFailedError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
-	case *TimeoutError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	case *workflow.ServiceBusyError:
-		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
-	default:
-		p.logger.Error("Operation failed with internal error.",
-			tag.Error(err), tag.MetricScope(scope))
-		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err)
+}
+
+// updateReadErrorMetric additionally counts corrupted history per domain, to find out the affected domains
+func (p *historyV2PersistenceClient) updateReadErrorMetric(scope int, domainName string, err error) {
+	p.updateErrorMetric(scope, err)
+	if _, ok := err.(*DataLossError); ok {
+		p.metricClient.Scope(scope, metrics.DomainTag(domainName)).IncCounter(metrics.PersistenceHistoryCorruptionCounter)
 	}
 }
 
+func (p *queuePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
 func (p *queuePersistenceClient) EnqueueMessage(message []byte) error {
 	p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceRequests)
 
@@ -1217,7 +1230,7 @@ func (p *queuePersistenceClient) EnqueueMessage(message []byte) error {
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceEnqueueMessageScope, err)
 	}
 
 	return err
@@ -1231,8 +1244,116 @@ func (p *queuePersistenceClient) DequeueMessages(lastMessageID int, maxCount int
 	sw.Stop()
 
 	if err != nil {
-		p.metricClient.IncCounter(metrics.PersistenceDequeueMessagesScope, metrics.PersistenceFailures)
+		p.updateErrorMetric(metrics.PersistenceDequeueMessagesScope, err)
+	}
+
+	return result, err
+}
+
+func (p *queuePersistenceClient) UpdateAckLevel(messageID int, clusterName string) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpdateAckLevelScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateAckLevelScope, metrics.PersistenceLatency)
+	err := p.persistence.UpdateAckLevel(messageID, clusterName)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpdateAckLevelScope, err)
+	}
+
+	return err
+}
+
+func (p *queuePersistenceClient) GetAckLevels() (map[string]int, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetAckLevelScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetAckLevelScope, metrics.PersistenceLatency)
+	result, err := p.persistence.GetAckLevels()
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetAckLevelScope, err)
 	}
 
 	return result, err
 }
+
+func (p *queuePersistenceClient) DeleteMessagesBefore(messageID int) error {
+	p.metricClient.IncCounter(metrics.PersistenceDeleteQueueMessagesScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteQueueMessagesScope, metrics.PersistenceLatency)
+	err := p.persistence.DeleteMessagesBefore(messageID)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceDeleteQueueMessagesScope, err)
+	}
+
+	return err
+}
+
+func (p *queuePersistenceClient) updateErrorMetric(scope int, err error) {
+	updatePersistenceErrorMetric(p.metricClient, p.logger, scope, err)
+}
+
+// newStoreTaggedMetricsClient tags all metrics emitted for the given
+// persistence with the name of its backing store
+func newStoreTaggedMetricsClient(metricClient metrics.Client, persistence interface{ GetName() string }) metrics.Client {
+	return metrics.NewTaggedClient(metricClient, metrics.StoreTag(persistence.GetName()))
+}
+
+// recordPersistencePayloadSize emits the size of the data written to or read from the store
+func recordPersistencePayloadSize(metricClient metrics.Client, scope int, size int) {
+	metricClient.Scope(scope).RecordHistogramValue(metrics.PersistencePayloadSize, float64(size))
+}
+
+// CountRetries wraps the IsRetryable handler passed to backoff.Retry for a persistence
+// operation, so that every retry attempt is counted under the given persistence scope
+func CountRetries(metricClient metrics.Client, scope int, isRetryable backoff.IsRetryable) backoff.IsRetryable {
+	return func(err error) bool {
+		// backoff.Retry only consults the handler when the policy allows another attempt
+		if !isRetryable(err) {
+			return false
+		}
+		metricClient.IncCounter(scope, metrics.PersistenceRetries)
+		return true
+	}
+}
+
+// updatePersistenceErrorMetric classifies the error returned by a persistence
+// operation and emits the matching error counters for the given scope
+func updatePersistenceErrorMetric(metricClient metrics.Client, logger log.Logger, scope int, err error, logTags ...tag.Tag) {
+	switch err.(type) {
+	case *ShardAlreadyExistError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrShardExistsCounter)
+	case *ShardOwnershipLostError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrShardOwnershipLostCounter)
+	case *ConditionFailedError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrConditionFailedCounter)
+	case *CurrentWorkflowConditionFailedError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrCurrentWorkflowConditionFailedCounter)
+	case *WorkflowExecutionAlreadyStartedError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrExecutionAlreadyStartedCounter)
+	case *workflow.EntityNotExistsError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrEntityNotExistsCounter)
+	case *workflow.DomainAlreadyExistsError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrDomainAlreadyExistsCounter)
+	case *workflow.BadRequestError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrBadRequestCounter)
+	case *TimeoutError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrTimeoutCounter)
+		metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *workflow.ServiceBusyError:
+		metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
+		metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *DataLossError:
+		logger.Error("Operation failed with data loss.",
+			append([]tag.Tag{tag.Error(err), tag.MetricScope(scope)}, logTags...)...)
+		metricClient.IncCounter(scope, metrics.PersistenceErrDataLossCounter)
+		metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	default:
+		logger.Error("Operation failed with internal error.",
+			append([]tag.Tag{tag.Error(err), tag.MetricScope(scope)}, logTags...)...)
+		metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	}
+}
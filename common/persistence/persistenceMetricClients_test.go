@@ -0,0 +1,69 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestCountRetries(t *testing.T) {
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(3)
+
+	retries := func(err error) (int, int64) {
+		scope := tally.NewTestScope("test", nil)
+		client := metrics.NewClient(scope, metrics.Common)
+		attempts := 0
+		op := func() error {
+			attempts++
+			return err
+		}
+		require.Equal(t, err, backoff.Retry(
+			op,
+			policy,
+			CountRetries(client, metrics.PersistenceUpdateWorkflowExecutionScope, common.IsPersistenceTransientError),
+		))
+
+		var counted int64
+		for _, counter := range scope.Snapshot().Counters() {
+			require.Equal(t, "test.persistence_retries", counter.Name())
+			require.Equal(t, "UpdateWorkflowExecution", counter.Tags()[metrics.OperationTagName])
+			counted += counter.Value()
+		}
+		return attempts, counted
+	}
+
+	attempts, counted := retries(&workflow.InternalServiceError{})
+	require.True(t, attempts > 1)
+	require.Equal(t, int64(attempts-1), counted)
+
+	attempts, counted = retries(&ConditionFailedError{})
+	require.Equal(t, 1, attempts)
+	require.Equal(t, int64(0), counted)
+}
@@ -234,6 +234,15 @@ func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecution(
 	return resp, err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecutionBatch(request *UpdateWorkflowExecutionBatchRequest) (*UpdateWorkflowExecutionBatchResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	resp, err := p.persistence.UpdateWorkflowExecutionBatch(request)
+	return resp, err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
@@ -584,6 +593,15 @@ func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosed(r
 	return err
 }
 
+func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosedBatch(request *RecordWorkflowExecutionClosedBatchRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RecordWorkflowExecutionClosedBatch(request)
+	return err
+}
+
 func (p *visibilityRateLimitedPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
@@ -593,6 +611,15 @@ func (p *visibilityRateLimitedPersistenceClient) UpsertWorkflowExecution(request
 	return err
 }
 
+func (p *visibilityRateLimitedPersistenceClient) UpdateHistoryArchivalInfo(request *UpdateHistoryArchivalInfoRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.UpdateHistoryArchivalInfo(request)
+	return err
+}
+
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -656,6 +683,15 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByS
 	return response, err
 }
 
+func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByMemo(request *ListClosedWorkflowExecutionsByMemoRequest) (*ListWorkflowExecutionsResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByMemo(request)
+	return response, err
+}
+
 func (p *visibilityRateLimitedPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -775,6 +811,34 @@ func (p *historyV2RateLimitedPersistenceClient) GetAllHistoryTreeBranches(reques
 	return response, err
 }
 
+func (p *historyV2RateLimitedPersistenceClient) QuarantineHistoryBranch(request *QuarantineHistoryBranchRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	err := p.persistence.QuarantineHistoryBranch(request)
+	return err
+}
+
+func (p *historyV2RateLimitedPersistenceClient) GetQuarantinedHistoryBranches(request *GetQuarantinedHistoryBranchesRequest) (*GetQuarantinedHistoryBranchesResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	response, err := p.persistence.GetQuarantinedHistoryBranches(request)
+	return response, err
+}
+
+func (p *historyV2RateLimitedPersistenceClient) DeleteQuarantinedHistoryBranch(request *DeleteQuarantinedHistoryBranchRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	err := p.persistence.DeleteQuarantinedHistoryBranch(request)
+	return err
+}
+
+func (p *queueRateLimitedPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
 func (p *queueRateLimitedPersistenceClient) EnqueueMessage(message []byte) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
@@ -790,3 +854,27 @@ func (p *queueRateLimitedPersistenceClient) DequeueMessages(lastMessageID int, m
 
 	return p.persistence.DequeueMessages(lastMessageID, maxCount)
 }
+
+func (p *queueRateLimitedPersistenceClient) UpdateAckLevel(messageID int, clusterName string) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.UpdateAckLevel(messageID, clusterName)
+}
+
+func (p *queueRateLimitedPersistenceClient) GetAckLevels() (map[string]int, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.GetAckLevels()
+}
+
+func (p *queueRateLimitedPersistenceClient) DeleteMessagesBefore(messageID int) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	return p.persistence.DeleteMessagesBefore(messageID)
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package persistence
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// workflowExecutionSlowQueryClient logs a sampled warning for execution manager
+	// operations which are slow or read / write large mutable states
+	workflowExecutionSlowQueryClient struct {
+		ExecutionManager
+		config *config.SlowQueryConfig
+		logger log.Logger
+	}
+
+	// historyV2SlowQueryClient logs a sampled warning for history manager
+	// operations which are slow or read / write large history batches
+	historyV2SlowQueryClient struct {
+		HistoryV2Manager
+		config *config.SlowQueryConfig
+		logger log.Logger
+	}
+
+	// taskSlowQueryClient logs a sampled warning for task manager operations which are slow
+	taskSlowQueryClient struct {
+		TaskManager
+		config *config.SlowQueryConfig
+		logger log.Logger
+	}
+)
+
+var _ ExecutionManager = (*workflowExecutionSlowQueryClient)(nil)
+var _ HistoryV2Manager = (*historyV2SlowQueryClient)(nil)
+var _ TaskManager = (*taskSlowQueryClient)(nil)
+
+// NewWorkflowExecutionPersistenceSlowQueryClient creates a client which logs slow or large execution operations
+func NewWorkflowExecutionPersistenceSlowQueryClient(persistence ExecutionManager, config *config.SlowQueryConfig, logger log.Logger) ExecutionManager {
+	return &workflowExecutionSlowQueryClient{
+		ExecutionManager: persistence,
+		config:           config,
+		logger:           logger.WithTags(tag.ShardID(persistence.GetShardID())),
+	}
+}
+
+// NewHistoryV2PersistenceSlowQueryClient creates a client which logs slow or large history operations
+func NewHistoryV2PersistenceSlowQueryClient(persistence HistoryV2Manager, config *config.SlowQueryConfig, logger log.Logger) HistoryV2Manager {
+	return &historyV2SlowQueryClient{
+		HistoryV2Manager: persistence,
+		config:           config,
+		logger:           logger,
+	}
+}
+
+// NewTaskPersistenceSlowQueryClient creates a client which logs slow task operations
+func NewTaskPersistenceSlowQueryClient(persistence TaskManager, config *config.SlowQueryConfig, logger log.Logger) TaskManager {
+	return &taskSlowQueryClient{
+		TaskManager: persistence,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+func (p *workflowExecutionSlowQueryClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	start := time.Now()
+	response, err := p.ExecutionManager.CreateWorkflowExecution(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationCreateWorkflowExecution, time.Since(start), 0,
+		executionInfoTags(request.NewWorkflowSnapshot.ExecutionInfo)...)
+	return response, err
+}
+
+func (p *workflowExecutionSlowQueryClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	start := time.Now()
+	response, err := p.ExecutionManager.GetWorkflowExecution(request)
+	size := 0
+	if err == nil && response.MutableStateStats != nil {
+		size = response.MutableStateStats.MutableStateSize
+	}
+	logSlowQuery(p.config, p.logger, tag.StoreOperationGetWorkflowExecution, time.Since(start), size,
+		tag.WorkflowDomainID(request.DomainID),
+		tag.WorkflowID(request.Execution.GetWorkflowId()),
+		tag.WorkflowRunID(request.Execution.GetRunId()))
+	return response, err
+}
+
+func (p *workflowExecutionSlowQueryClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	start := time.Now()
+	response, err := p.ExecutionManager.UpdateWorkflowExecution(request)
+	size := 0
+	if err == nil && response.MutableStateUpdateSessionStats != nil {
+		size = response.MutableStateUpdateSessionStats.MutableStateSize
+	}
+	logSlowQuery(p.config, p.logger, tag.StoreOperationUpdateWorkflowExecution, time.Since(start), size,
+		executionInfoTags(request.UpdateWorkflowMutation.ExecutionInfo)...)
+	return response, err
+}
+
+func (p *workflowExecutionSlowQueryClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	start := time.Now()
+	err := p.ExecutionManager.ConflictResolveWorkflowExecution(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationConflictResolveWorkflowExecution, time.Since(start), 0,
+		executionInfoTags(request.ResetWorkflowSnapshot.ExecutionInfo)...)
+	return err
+}
+
+func (p *workflowExecutionSlowQueryClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	start := time.Now()
+	err := p.ExecutionManager.ResetWorkflowExecution(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationResetWorkflowExecution, time.Since(start), 0,
+		executionInfoTags(request.NewWorkflowSnapshot.ExecutionInfo)...)
+	return err
+}
+
+func (p *historyV2SlowQueryClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	start := time.Now()
+	response, err := p.HistoryV2Manager.AppendHistoryNodes(request)
+	size := 0
+	if err == nil {
+		size = response.Size
+	}
+	logSlowQuery(p.config, p.logger, tag.StoreOperationAppendHistoryNodes, time.Since(start), size,
+		historyInfoTags(request.Info)...)
+	return response, err
+}
+
+func (p *historyV2SlowQueryClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	start := time.Now()
+	response, err := p.HistoryV2Manager.ReadHistoryBranch(request)
+	size := 0
+	if err == nil {
+		size = response.Size
+	}
+	logSlowQuery(p.config, p.logger, tag.StoreOperationReadHistoryBranch, time.Since(start), size,
+		readHistoryBranchTags(request)...)
+	return response, err
+}
+
+func (p *historyV2SlowQueryClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	start := time.Now()
+	response, err := p.HistoryV2Manager.ReadHistoryBranchByBatch(request)
+	size := 0
+	if err == nil {
+		size = response.Size
+	}
+	logSlowQuery(p.config, p.logger, tag.StoreOperationReadHistoryBranch, time.Since(start), size,
+		readHistoryBranchTags(request)...)
+	return response, err
+}
+
+func (p *taskSlowQueryClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	start := time.Now()
+	response, err := p.TaskManager.LeaseTaskList(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationLeaseTaskList, time.Since(start), 0,
+		taskListTags(request.DomainID, request.TaskList, request.TaskType)...)
+	return response, err
+}
+
+func (p *taskSlowQueryClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	start := time.Now()
+	response, err := p.TaskManager.UpdateTaskList(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationUpdateTaskList, time.Since(start), 0,
+		taskListInfoTags(request.TaskListInfo)...)
+	return response, err
+}
+
+func (p *taskSlowQueryClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	start := time.Now()
+	response, err := p.TaskManager.CreateTasks(request)
+	tags := taskListInfoTags(request.TaskListInfo)
+	if len(request.Tasks) == 1 {
+		tags = append(tags, tag.WorkflowID(request.Tasks[0].Execution.GetWorkflowId()))
+	}
+	logSlowQuery(p.config, p.logger, tag.StoreOperationCreateTask, time.Since(start), 0, tags...)
+	return response, err
+}
+
+func (p *taskSlowQueryClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	start := time.Now()
+	response, err := p.TaskManager.GetTasks(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationGetTasks, time.Since(start), 0,
+		taskListTags(request.DomainID, request.TaskList, request.TaskType)...)
+	return response, err
+}
+
+func (p *taskSlowQueryClient) CompleteTask(request *CompleteTaskRequest) error {
+	start := time.Now()
+	err := p.TaskManager.CompleteTask(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationCompleteTask, time.Since(start), 0,
+		taskListInfoTags(request.TaskList)...)
+	return err
+}
+
+func (p *taskSlowQueryClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	start := time.Now()
+	count, err := p.TaskManager.CompleteTasksLessThan(request)
+	logSlowQuery(p.config, p.logger, tag.StoreOperationCompleteTasksLessThan, time.Since(start), 0,
+		taskListTags(request.DomainID, request.TaskListName, request.TaskType)...)
+	return count, err
+}
+
+func executionInfoTags(executionInfo *WorkflowExecutionInfo) []tag.Tag {
+	if executionInfo == nil {
+		return nil
+	}
+	return []tag.Tag{
+		tag.WorkflowDomainID(executionInfo.DomainID),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID),
+	}
+}
+
+// historyInfoTags returns the workflow tags encoded in the garbage cleanup info of a history branch
+func historyInfoTags(info string) []tag.Tag {
+	domainID, workflowID, runID, err := SplitHistoryGarbageCleanupInfo(info)
+	if err != nil {
+		return []tag.Tag{tag.DetailInfo(info)}
+	}
+	return []tag.Tag{
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(workflowID),
+		tag.WorkflowRunID(runID),
+	}
+}
+
+func readHistoryBranchTags(request *ReadHistoryBranchRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainID(request.DomainID),
+		tag.WorkflowDomainName(request.DomainName),
+		tag.WorkflowID(request.WorkflowID),
+		tag.WorkflowEventID(request.MinEventID),
+	}
+}
+
+func taskListTags(domainID string, taskList string, taskType int) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowTaskListName(taskList),
+		tag.WorkflowTaskListType(taskType),
+	}
+}
+
+func taskListInfoTags(info *TaskListInfo) []tag.Tag {
+	if info == nil {
+		return nil
+	}
+	return taskListTags(info.DomainID, info.Name, info.TaskType)
+}
+
+// logSlowQuery logs a sampled warning if the operation took longer than the
+// configured latency threshold or read / wrote more than the configured size threshold
+func logSlowQuery(config *config.SlowQueryConfig, logger log.Logger, operation tag.Tag, latency time.Duration, size int, tags ...tag.Tag) {
+	if latency <= config.LatencyThreshold() && size <= config.SizeThreshold() {
+		return
+	}
+	if rand.Float64() >= config.LogSampleRate() {
+		return
+	}
+
+	logger.Warn("Slow or large persistence operation.",
+		append([]tag.Tag{operation, tag.StoreLatency(latency), tag.StorePayloadSize(size)}, tags...)...)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	slowQueryClientsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		logger *log.MockLogger
+	}
+
+	slowQueryTestExecutionManager struct {
+		ExecutionManager
+		mutableStateSize int
+	}
+
+	slowQueryTestHistoryV2Manager struct {
+		HistoryV2Manager
+		size int
+	}
+
+	slowQueryTestTaskManager struct {
+		TaskManager
+	}
+)
+
+func TestSlowQueryClientsSuite(t *testing.T) {
+	s := new(slowQueryClientsSuite)
+	suite.Run(t, s)
+}
+
+func (s *slowQueryClientsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = &log.MockLogger{}
+}
+
+func (s *slowQueryClientsSuite) TearDownTest() {
+	s.logger.AssertExpectations(s.T())
+}
+
+func (s *slowQueryClientsSuite) TestSlowGetTasks_Logged() {
+	client := NewTaskPersistenceSlowQueryClient(&slowQueryTestTaskManager{}, s.newConfig(-1, 1024, 1), s.logger)
+	s.expectWarn(
+		tag.StoreOperationGetTasks,
+		tag.WorkflowDomainID("domain-id"),
+		tag.WorkflowTaskListName("task-list"),
+		tag.WorkflowTaskListType(TaskListTypeActivity),
+	)
+
+	_, err := client.GetTasks(&GetTasksRequest{
+		DomainID: "domain-id",
+		TaskList: "task-list",
+		TaskType: TaskListTypeActivity,
+	})
+	s.NoError(err)
+}
+
+func (s *slowQueryClientsSuite) TestFastTaskOperation_NotLogged() {
+	client := NewTaskPersistenceSlowQueryClient(&slowQueryTestTaskManager{}, s.newConfig(time.Hour, 1024, 1), s.logger)
+
+	_, err := client.LeaseTaskList(&LeaseTaskListRequest{DomainID: "domain-id", TaskList: "task-list"})
+	s.NoError(err)
+	_, err = client.CreateTasks(&CreateTasksRequest{TaskListInfo: &TaskListInfo{DomainID: "domain-id", Name: "task-list"}})
+	s.NoError(err)
+}
+
+func (s *slowQueryClientsSuite) TestSlowOperation_NotSampled() {
+	client := NewTaskPersistenceSlowQueryClient(&slowQueryTestTaskManager{}, s.newConfig(-1, 1024, 0), s.logger)
+
+	_, err := client.UpdateTaskList(&UpdateTaskListRequest{TaskListInfo: &TaskListInfo{DomainID: "domain-id", Name: "task-list"}})
+	s.NoError(err)
+}
+
+func (s *slowQueryClientsSuite) TestLargeMutableStateUpdate_Logged() {
+	s.logger.On("WithTags", mock.Anything).Return(s.logger).Once()
+	client := NewWorkflowExecutionPersistenceSlowQueryClient(
+		&slowQueryTestExecutionManager{mutableStateSize: 2048},
+		s.newConfig(time.Hour, 1024, 1),
+		s.logger,
+	)
+	s.expectWarn(
+		tag.StoreOperationUpdateWorkflowExecution,
+		tag.StorePayloadSize(2048),
+		tag.WorkflowDomainID("domain-id"),
+		tag.WorkflowID("workflow-id"),
+		tag.WorkflowRunID("run-id"),
+	)
+
+	_, err := client.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: WorkflowMutation{
+			ExecutionInfo: &WorkflowExecutionInfo{
+				DomainID:   "domain-id",
+				WorkflowID: "workflow-id",
+				RunID:      "run-id",
+			},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *slowQueryClientsSuite) TestLargeHistoryRead_Logged() {
+	client := NewHistoryV2PersistenceSlowQueryClient(&slowQueryTestHistoryV2Manager{size: 2048}, s.newConfig(time.Hour, 1024, 1), s.logger)
+	s.expectWarn(
+		tag.StoreOperationReadHistoryBranch,
+		tag.StorePayloadSize(2048),
+		tag.WorkflowDomainID("domain-id"),
+		tag.WorkflowDomainName("domain"),
+		tag.WorkflowID("workflow-id"),
+	)
+
+	_, err := client.ReadHistoryBranch(&ReadHistoryBranchRequest{
+		MinEventID: 1,
+		DomainID:   "domain-id",
+		DomainName: "domain",
+		WorkflowID: "workflow-id",
+	})
+	s.NoError(err)
+}
+
+func (s *slowQueryClientsSuite) TestLargeHistoryAppend_Logged() {
+	client := NewHistoryV2PersistenceSlowQueryClient(&slowQueryTestHistoryV2Manager{size: 2048}, s.newConfig(time.Hour, 1024, 1), s.logger)
+	s.expectWarn(
+		tag.StoreOperationAppendHistoryNodes,
+		tag.WorkflowDomainID("domain-id"),
+		tag.WorkflowID("workflow:id"),
+		tag.WorkflowRunID("run-id"),
+	)
+
+	_, err := client.AppendHistoryNodes(&AppendHistoryNodesRequest{
+		Info: BuildHistoryGarbageCleanupInfo("domain-id", "workflow:id", "run-id"),
+	})
+	s.NoError(err)
+}
+
+func (s *slowQueryClientsSuite) newConfig(latency time.Duration, size int, sampleRate float64) *config.SlowQueryConfig {
+	return &config.SlowQueryConfig{
+		LatencyThreshold: dynamicconfig.GetDurationPropertyFn(latency),
+		SizeThreshold:    dynamicconfig.GetIntPropertyFn(size),
+		LogSampleRate:    dynamicconfig.GetFloatPropertyFn(sampleRate),
+	}
+}
+
+// expectWarn expects exactly one warning carrying all the given tags
+func (s *slowQueryClientsSuite) expectWarn(expected ...tag.Tag) {
+	s.logger.On("Warn", mock.Anything, mock.MatchedBy(func(tags []tag.Tag) bool {
+		for _, e := range expected {
+			found := false
+			for _, t := range tags {
+				if t.Field().Equals(e.Field()) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	})).Once()
+}
+
+func (m *slowQueryTestExecutionManager) GetShardID() int {
+	return 1
+}
+
+func (m *slowQueryTestExecutionManager) UpdateWorkflowExecution(*UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	return &UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &MutableStateUpdateSessionStats{MutableStateSize: m.mutableStateSize},
+	}, nil
+}
+
+func (m *slowQueryTestHistoryV2Manager) ReadHistoryBranch(*ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	return &ReadHistoryBranchResponse{Size: m.size}, nil
+}
+
+func (m *slowQueryTestHistoryV2Manager) AppendHistoryNodes(*AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	return &AppendHistoryNodesResponse{Size: m.size}, nil
+}
+
+func (m *slowQueryTestTaskManager) LeaseTaskList(*LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	return &LeaseTaskListResponse{}, nil
+}
+
+func (m *slowQueryTestTaskManager) UpdateTaskList(*UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	return &UpdateTaskListResponse{}, nil
+}
+
+func (m *slowQueryTestTaskManager) CreateTasks(*CreateTasksRequest) (*CreateTasksResponse, error) {
+	return &CreateTasksResponse{}, nil
+}
+
+func (m *slowQueryTestTaskManager) GetTasks(*GetTasksRequest) (*GetTasksResponse, error) {
+	return &GetTasksResponse{}, nil
+}
@@ -24,9 +24,12 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	protomapper "github.com/uber/cadence/common/mapper/proto"
 )
 
 type (
@@ -52,6 +55,10 @@ type (
 		// serialize/deserialize bad binaries
 		SerializeBadBinaries(event *workflow.BadBinaries, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeBadBinaries(data *DataBlob) (*workflow.BadBinaries, error)
+
+		// serialize/deserialize history archival info of visibility records
+		SerializeHistoryArchivalInfo(info *workflow.HistoryArchivalInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeHistoryArchivalInfo(data *DataBlob) (*workflow.HistoryArchivalInfo, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -154,6 +161,19 @@ func (t *serializerImpl) DeserializeVisibilityMemo(data *DataBlob) (*workflow.Me
 	return &memo, err
 }
 
+func (t *serializerImpl) SerializeHistoryArchivalInfo(info *workflow.HistoryArchivalInfo, encodingType common.EncodingType) (*DataBlob, error) {
+	if info == nil {
+		return nil, nil
+	}
+	return t.serialize(info, encodingType)
+}
+
+func (t *serializerImpl) DeserializeHistoryArchivalInfo(data *DataBlob) (*workflow.HistoryArchivalInfo, error) {
+	var info workflow.HistoryArchivalInfo
+	err := t.deserialize(data, &info)
+	return &info, err
+}
+
 func (t *serializerImpl) serialize(input interface{}, encodingType common.EncodingType) (*DataBlob, error) {
 	if input == nil {
 		return nil, nil
@@ -164,7 +184,9 @@ func (t *serializerImpl) serialize(input interface{}, encodingType common.Encodi
 
 	switch encodingType {
 	case common.EncodingTypeThriftRW:
-		data, err = t.thriftrwEncode(input)
+		data, err = t.binaryEncode(t.thriftrwEncoder, input)
+	case common.EncodingTypeProto:
+		data, err = t.protoEncode(input)
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		encodingType = common.EncodingTypeJSON
 		data, err = json.Marshal(input)
@@ -178,23 +200,48 @@ func (t *serializerImpl) serialize(input interface{}, encodingType common.Encodi
 	return NewDataBlob(data, encodingType), nil
 }
 
-func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
+func (t *serializerImpl) binaryEncode(encoder codec.BinaryEncoder, input interface{}) ([]byte, error) {
 	switch input.(type) {
 	case []*workflow.HistoryEvent:
-		return t.thriftrwEncoder.Encode(&workflow.History{Events: input.([]*workflow.HistoryEvent)})
+		return encoder.Encode(&workflow.History{Events: input.([]*workflow.HistoryEvent)})
 	case *workflow.HistoryEvent:
-		return t.thriftrwEncoder.Encode(input.(*workflow.HistoryEvent))
+		return encoder.Encode(input.(*workflow.HistoryEvent))
 	case *workflow.Memo:
-		return t.thriftrwEncoder.Encode(input.(*workflow.Memo))
+		return encoder.Encode(input.(*workflow.Memo))
 	case *workflow.ResetPoints:
-		return t.thriftrwEncoder.Encode(input.(*workflow.ResetPoints))
+		return encoder.Encode(input.(*workflow.ResetPoints))
 	case *workflow.BadBinaries:
-		return t.thriftrwEncoder.Encode(input.(*workflow.BadBinaries))
+		return encoder.Encode(input.(*workflow.BadBinaries))
+	case *workflow.HistoryArchivalInfo:
+		return encoder.Encode(input.(*workflow.HistoryArchivalInfo))
 	default:
 		return nil, nil
 	}
 }
 
+// protoEncode encodes the input as the matching message of the protobuf API, see proto/uber/cadence/api/v1.
+// Proto3 scalars carry no presence, so optional fields set to their zero value are read back as unset.
+func (t *serializerImpl) protoEncode(input interface{}) ([]byte, error) {
+	var message proto.Message
+	switch input := input.(type) {
+	case []*workflow.HistoryEvent:
+		message = &apiv1.History{Events: protomapper.FromHistoryEventArray(input)}
+	case *workflow.HistoryEvent:
+		message = protomapper.FromHistoryEvent(input)
+	case *workflow.Memo:
+		message = protomapper.FromMemo(input)
+	case *workflow.ResetPoints:
+		message = protomapper.FromResetPoints(input)
+	case *workflow.BadBinaries:
+		message = protomapper.FromBadBinaries(input)
+	case *workflow.HistoryArchivalInfo:
+		message = protomapper.FromHistoryArchivalInfo(input)
+	default:
+		return nil, nil
+	}
+	return proto.Marshal(message)
+}
+
 func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 	if data == nil {
 		return nil
@@ -206,7 +253,9 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 
 	switch data.GetEncoding() {
 	case common.EncodingTypeThriftRW:
-		err = t.thriftrwDecode(data.Data, target)
+		err = t.binaryDecode(t.thriftrwEncoder, data.Data, target)
+	case common.EncodingTypeProto:
+		err = t.protoDecode(data.Data, target)
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		err = json.Unmarshal(data.Data, target)
 	default:
@@ -219,35 +268,80 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 	return nil
 }
 
-func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
+func (t *serializerImpl) binaryDecode(encoder codec.BinaryEncoder, data []byte, target interface{}) error {
 	switch target.(type) {
 	case *[]*workflow.HistoryEvent:
 		history := workflow.History{Events: *target.(*[]*workflow.HistoryEvent)}
-		if err := t.thriftrwEncoder.Decode(data, &history); err != nil {
+		if err := encoder.Decode(data, &history); err != nil {
 			return err
 		}
 		*target.(*[]*workflow.HistoryEvent) = history.GetEvents()
 		return nil
 	case *workflow.HistoryEvent:
 		event := target.(*workflow.HistoryEvent)
-		return t.thriftrwEncoder.Decode(data, event)
+		return encoder.Decode(data, event)
 	case *workflow.Memo:
 		memo := target.(*workflow.Memo)
-		t.thriftrwEncoder.Decode(data, memo)
+		encoder.Decode(data, memo)
 		return nil
 	case *workflow.ResetPoints:
 		rp := target.(*workflow.ResetPoints)
-		t.thriftrwEncoder.Decode(data, rp)
+		encoder.Decode(data, rp)
 		return nil
 	case *workflow.BadBinaries:
 		rp := target.(*workflow.BadBinaries)
-		t.thriftrwEncoder.Decode(data, rp)
+		encoder.Decode(data, rp)
 		return nil
+	case *workflow.HistoryArchivalInfo:
+		info := target.(*workflow.HistoryArchivalInfo)
+		return encoder.Decode(data, info)
 	default:
 		return nil
 	}
 }
 
+func (t *serializerImpl) protoDecode(data []byte, target interface{}) error {
+	switch target := target.(type) {
+	case *[]*workflow.HistoryEvent:
+		var history apiv1.History
+		if err := proto.Unmarshal(data, &history); err != nil {
+			return err
+		}
+		*target = protomapper.ToHistoryEventArray(history.GetEvents())
+	case *workflow.HistoryEvent:
+		var event apiv1.HistoryEvent
+		if err := proto.Unmarshal(data, &event); err != nil {
+			return err
+		}
+		*target = *protomapper.ToHistoryEvent(&event)
+	case *workflow.Memo:
+		var memo apiv1.Memo
+		if err := proto.Unmarshal(data, &memo); err != nil {
+			return err
+		}
+		*target = *protomapper.ToMemo(&memo)
+	case *workflow.ResetPoints:
+		var rp apiv1.ResetPoints
+		if err := proto.Unmarshal(data, &rp); err != nil {
+			return err
+		}
+		*target = *protomapper.ToResetPoints(&rp)
+	case *workflow.BadBinaries:
+		var bb apiv1.BadBinaries
+		if err := proto.Unmarshal(data, &bb); err != nil {
+			return err
+		}
+		*target = *protomapper.ToBadBinaries(&bb)
+	case *workflow.HistoryArchivalInfo:
+		var info apiv1.HistoryArchivalInfo
+		if err := proto.Unmarshal(data, &info); err != nil {
+			return err
+		}
+		*target = *protomapper.ToHistoryArchivalInfo(&info)
+	}
+	return nil
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType common.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
@@ -110,6 +110,12 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 		},
 	}
 
+	historyArchivalInfo0 := &workflow.HistoryArchivalInfo{
+		State:        workflow.HistoryArchivalStateArchived.Ptr(),
+		URI:          common.StringPtr("test:///history/archival"),
+		ArchivedTime: common.Int64Ptr(456),
+	}
+
 	for i := 0; i < concurrency; i++ {
 
 		go func() {
@@ -136,6 +142,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.NotNil(dThrift)
 
+			dProto, err := serializer.SerializeEvent(event0, common.EncodingTypeProto)
+			s.Nil(err)
+			s.NotNil(dProto)
+
 			dEmpty, err := serializer.SerializeEvent(event0, common.EncodingType(""))
 			s.Nil(err)
 			s.NotNil(dEmpty)
@@ -159,6 +169,11 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.NotNil(dsThrift)
 
+			dsProto, err := serializer.SerializeBatchEvents(history0.Events, common.EncodingTypeProto)
+			s.Nil(err)
+			s.NotNil(dsProto)
+			s.True(len(dsProto.Data) < len(dsThrift.Data))
+
 			dsEmpty, err := serializer.SerializeBatchEvents(history0.Events, common.EncodingType(""))
 			s.Nil(err)
 			s.NotNil(dsEmpty)
@@ -182,6 +197,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.NotNil(mThrift)
 
+			mProto, err := serializer.SerializeVisibilityMemo(memo0, common.EncodingTypeProto)
+			s.Nil(err)
+			s.NotNil(mProto)
+
 			mEmpty, err := serializer.SerializeVisibilityMemo(memo0, common.EncodingType(""))
 			s.Nil(err)
 			s.NotNil(mEmpty)
@@ -204,6 +223,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.True(event0.Equals(event3))
 
+			event4, err := serializer.DeserializeEvent(dProto)
+			s.Nil(err)
+			s.True(event0.Equals(event4))
+
 			// deserialize batch events
 
 			dNilEvents, err := serializer.DeserializeBatchEvents(nilEvents)
@@ -225,6 +248,11 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.True(history0.Equals(history3))
 
+			events, err = serializer.DeserializeBatchEvents(dsProto)
+			history4 := &workflow.History{Events: events}
+			s.Nil(err)
+			s.True(history0.Equals(history4))
+
 			// deserialize visibility memo
 
 			dNilMemo, err := serializer.DeserializeVisibilityMemo(nilMemo)
@@ -242,6 +270,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.True(memo0.Equals(memo3))
 
+			memo4, err := serializer.DeserializeVisibilityMemo(mProto)
+			s.Nil(err)
+			s.True(memo0.Equals(memo4))
+
 			// serialize reset points
 
 			nilResetPoints, err := serializer.SerializeResetPoints(nil, common.EncodingTypeThriftRW)
@@ -261,6 +293,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.NotNil(resetPointsThrift)
 
+			resetPointsProto, err := serializer.SerializeResetPoints(resetPoints0, common.EncodingTypeProto)
+			s.Nil(err)
+			s.NotNil(resetPointsProto)
+
 			resetPointsEmpty, err := serializer.SerializeResetPoints(resetPoints0, common.EncodingType(""))
 			s.Nil(err)
 			s.NotNil(resetPointsEmpty)
@@ -287,6 +323,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.True(resetPoints3.Equals(resetPoints0))
 
+			resetPoints4, err := serializer.DeserializeResetPoints(resetPointsProto)
+			s.Nil(err)
+			s.True(resetPoints4.Equals(resetPoints0))
+
 			// serialize bad binaries
 
 			nilBadBinaries, err := serializer.SerializeBadBinaries(nil, common.EncodingTypeThriftRW)
@@ -306,6 +346,10 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.NotNil(badBinariesThrift)
 
+			badBinariesProto, err := serializer.SerializeBadBinaries(badBinaries0, common.EncodingTypeProto)
+			s.Nil(err)
+			s.NotNil(badBinariesProto)
+
 			badBinariesEmpty, err := serializer.SerializeBadBinaries(badBinaries0, common.EncodingType(""))
 			s.Nil(err)
 			s.NotNil(badBinariesEmpty)
@@ -331,6 +375,32 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			badBinaries3, err := serializer.DeserializeBadBinaries(badBinariesEmpty)
 			s.Nil(err)
 			s.True(badBinaries3.Equals(badBinaries0))
+
+			badBinaries4, err := serializer.DeserializeBadBinaries(badBinariesProto)
+			s.Nil(err)
+			s.True(badBinaries4.Equals(badBinaries0))
+
+			// serialize and deserialize history archival info
+
+			nilHistoryArchivalInfo, err := serializer.SerializeHistoryArchivalInfo(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilHistoryArchivalInfo)
+
+			historyArchivalInfoThrift, err := serializer.SerializeHistoryArchivalInfo(historyArchivalInfo0, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(historyArchivalInfoThrift)
+
+			historyArchivalInfo1, err := serializer.DeserializeHistoryArchivalInfo(historyArchivalInfoThrift)
+			s.Nil(err)
+			s.True(historyArchivalInfo1.Equals(historyArchivalInfo0))
+
+			historyArchivalInfoProto, err := serializer.SerializeHistoryArchivalInfo(historyArchivalInfo0, common.EncodingTypeProto)
+			s.Nil(err)
+			s.NotNil(historyArchivalInfoProto)
+
+			historyArchivalInfo2, err := serializer.DeserializeHistoryArchivalInfo(historyArchivalInfoProto)
+			s.Nil(err)
+			s.True(historyArchivalInfo2.Equals(historyArchivalInfo0))
 		}()
 	}
 
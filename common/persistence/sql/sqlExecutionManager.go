@@ -256,6 +256,15 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
 		SearchAttributes:                   info.GetSearchAttributes(),
 		Memo:                               info.GetMemo(),
+		QueryTypes:                         info.GetQueryTypes(),
+		LastDecisionBinaryChecksum:         info.GetLastDecisionBinaryChecksum(),
+		Paused:                             info.GetPaused(),
+		Priority:                           info.GetPriority(),
+		TerminationProtected:               info.GetTerminationProtected(),
+		EventCount:                         info.GetEventCount(),
+		ActivityPayloadBytes:               info.GetActivityPayloadBytes(),
+		SignalEventCount:                   info.GetSignalEventCount(),
+		TimerCount:                         info.GetTimerCount(),
 	}
 
 	if info.LastWriteEventID != nil {
@@ -409,6 +418,20 @@ func (m *sqlExecutionManager) UpdateWorkflowExecution(
 	})
 }
 
+func (m *sqlExecutionManager) UpdateWorkflowExecutionBatch(
+	request *p.InternalUpdateWorkflowExecutionBatchRequest,
+) error {
+
+	return m.txExecuteShardLocked("UpdateWorkflowExecutionBatch", request.RangeID, func(tx sqldb.Tx) error {
+		for _, updateRequest := range request.Requests {
+			if err := m.updateWorkflowExecutionTx(tx, updateRequest); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (m *sqlExecutionManager) updateWorkflowExecutionTx(
 	tx sqldb.Tx,
 	request *p.InternalUpdateWorkflowExecutionRequest,
@@ -426,69 +449,97 @@ func (m *sqlExecutionManager) updateWorkflowExecutionTx(
 		return err
 	}
 
-	if request.NewWorkflowSnapshot != nil {
-		newExecutionInfo := request.NewWorkflowSnapshot.ExecutionInfo
-		newReplicationState := request.NewWorkflowSnapshot.ReplicationState
-		newDomainID := sqldb.MustParseUUID(newExecutionInfo.DomainID)
-		newRunID := sqldb.MustParseUUID(newExecutionInfo.RunID)
-
-		if !bytes.Equal(domainID, newDomainID) {
+	switch request.Mode {
+	case p.UpdateWorkflowModeBypassCurrent:
+		if request.NewWorkflowSnapshot != nil {
 			return &workflow.InternalServiceError{
-				Message: fmt.Sprintf("UpdateWorkflowExecution. Cannot continue as new to another domain"),
+				Message: fmt.Sprintf("UpdateWorkflowExecution. Cannot bypass current record with new workflow"),
 			}
 		}
-
-		startVersion := common.EmptyVersion
-		lastWriteVersion := common.EmptyVersion
-		if newReplicationState != nil {
-			startVersion = newReplicationState.StartVersion
-			lastWriteVersion = newReplicationState.LastWriteVersion
+		if err := p.ValidateUpdateWorkflowModeState(
+			request.Mode,
+			executionInfo.State,
+		); err != nil {
+			return err
 		}
-
-		if err := assertRunIDAndUpdateCurrentExecution(tx,
+		if err := assertNotCurrentExecution(tx,
 			shardID,
 			domainID,
 			workflowID,
-			newRunID,
-			runID,
-			request.NewWorkflowSnapshot.ExecutionInfo.CreateRequestID,
-			request.NewWorkflowSnapshot.ExecutionInfo.State,
-			request.NewWorkflowSnapshot.ExecutionInfo.CloseStatus,
-			startVersion,
-			lastWriteVersion); err != nil {
-			return &workflow.InternalServiceError{
-				Message: fmt.Sprintf("UpdateWorkflowExecution. Failed to continue as new current execution. Error: %v", err),
-			}
-		}
-
-		if err := applyWorkflowSnapshotTxAsNew(tx, shardID, request.NewWorkflowSnapshot); err != nil {
+			runID); err != nil {
 			return err
 		}
 
-	} else {
-		executionInfo := updateWorkflow.ExecutionInfo
-		startVersion := common.EmptyVersion
-		lastWriteVersion := common.EmptyVersion
-		if updateWorkflow.ReplicationState != nil {
-			startVersion = updateWorkflow.ReplicationState.StartVersion
-			lastWriteVersion = updateWorkflow.ReplicationState.LastWriteVersion
-		}
-		// this is only to update the current record
-		if err := assertRunIDAndUpdateCurrentExecution(tx,
-			shardID,
-			domainID,
-			workflowID,
-			runID,
-			runID,
-			executionInfo.CreateRequestID,
-			executionInfo.State,
-			executionInfo.CloseStatus,
-			startVersion,
-			lastWriteVersion); err != nil {
-			return &workflow.InternalServiceError{
-				Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to update current execution. Error: %v", err),
+	case p.UpdateWorkflowModeUpdateCurrent:
+		if request.NewWorkflowSnapshot != nil {
+			newExecutionInfo := request.NewWorkflowSnapshot.ExecutionInfo
+			newReplicationState := request.NewWorkflowSnapshot.ReplicationState
+			newDomainID := sqldb.MustParseUUID(newExecutionInfo.DomainID)
+			newRunID := sqldb.MustParseUUID(newExecutionInfo.RunID)
+
+			if !bytes.Equal(domainID, newDomainID) {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("UpdateWorkflowExecution. Cannot continue as new to another domain"),
+				}
+			}
+
+			startVersion := common.EmptyVersion
+			lastWriteVersion := common.EmptyVersion
+			if newReplicationState != nil {
+				startVersion = newReplicationState.StartVersion
+				lastWriteVersion = newReplicationState.LastWriteVersion
+			}
+
+			if err := assertRunIDAndUpdateCurrentExecution(tx,
+				shardID,
+				domainID,
+				workflowID,
+				newRunID,
+				runID,
+				request.NewWorkflowSnapshot.ExecutionInfo.CreateRequestID,
+				request.NewWorkflowSnapshot.ExecutionInfo.State,
+				request.NewWorkflowSnapshot.ExecutionInfo.CloseStatus,
+				startVersion,
+				lastWriteVersion); err != nil {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("UpdateWorkflowExecution. Failed to continue as new current execution. Error: %v", err),
+				}
+			}
+
+			if err := applyWorkflowSnapshotTxAsNew(tx, shardID, request.NewWorkflowSnapshot); err != nil {
+				return err
+			}
+
+		} else {
+			executionInfo := updateWorkflow.ExecutionInfo
+			startVersion := common.EmptyVersion
+			lastWriteVersion := common.EmptyVersion
+			if updateWorkflow.ReplicationState != nil {
+				startVersion = updateWorkflow.ReplicationState.StartVersion
+				lastWriteVersion = updateWorkflow.ReplicationState.LastWriteVersion
+			}
+			// this is only to update the current record
+			if err := assertRunIDAndUpdateCurrentExecution(tx,
+				shardID,
+				domainID,
+				workflowID,
+				runID,
+				runID,
+				executionInfo.CreateRequestID,
+				executionInfo.State,
+				executionInfo.CloseStatus,
+				startVersion,
+				lastWriteVersion); err != nil {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Failed to update current execution. Error: %v", err),
+				}
 			}
 		}
+
+	default:
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution: unknown mode: %v", request.Mode),
+		}
 	}
 	return nil
 }
@@ -757,15 +808,17 @@ func (m *sqlExecutionManager) RangeCompleteTransferTask(
 	request *p.RangeCompleteTransferTaskRequest,
 ) error {
 
-	if _, err := m.db.DeleteFromTransferTasks(&sqldb.TransferTasksFilter{
-		ShardID:   m.shardID,
-		MinTaskID: &request.ExclusiveBeginTaskID,
-		MaxTaskID: &request.InclusiveEndTaskID}); err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("RangeCompleteTransferTask operation failed. Error: %v", err),
+	return m.txExecuteShardLocked("RangeCompleteTransferTask", request.RangeID, func(tx sqldb.Tx) error {
+		if _, err := tx.DeleteFromTransferTasks(&sqldb.TransferTasksFilter{
+			ShardID:   m.shardID,
+			MinTaskID: &request.ExclusiveBeginTaskID,
+			MaxTaskID: &request.InclusiveEndTaskID}); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("RangeCompleteTransferTask operation failed. Error: %v", err),
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 func (m *sqlExecutionManager) GetReplicationTasks(
@@ -962,14 +1015,16 @@ func (m *sqlExecutionManager) RangeCompleteTimerTask(
 
 	start := request.InclusiveBeginTimestamp
 	end := request.ExclusiveEndTimestamp
-	if _, err := m.db.DeleteFromTimerTasks(&sqldb.TimerTasksFilter{
-		ShardID:                m.shardID,
-		MinVisibilityTimestamp: &start,
-		MaxVisibilityTimestamp: &end,
-	}); err != nil {
-		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CompleteTimerTask operation failed. Error: %v", err),
+	return m.txExecuteShardLocked("RangeCompleteTimerTask", request.RangeID, func(tx sqldb.Tx) error {
+		if _, err := tx.DeleteFromTimerTasks(&sqldb.TimerTasksFilter{
+			ShardID:                m.shardID,
+			MinVisibilityTimestamp: &start,
+			MaxVisibilityTimestamp: &end,
+		}); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("RangeCompleteTimerTask operation failed. Error: %v", err),
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
@@ -937,7 +937,7 @@ func createTimerTasks(
 			case *p.WorkflowTimeoutTask:
 				// noop
 
-			case *p.DeleteHistoryEventTask:
+			case *p.DeleteHistoryEventTask, *p.DeleteVisibilityTask:
 				// noop
 
 			default:
@@ -987,6 +987,31 @@ func createTimerTasks(
 	return nil
 }
 
+func assertNotCurrentExecution(
+	tx sqldb.Tx,
+	shardID int,
+	domainID sqldb.UUID,
+	workflowID string,
+	runID sqldb.UUID,
+) error {
+
+	currentRow, err := lockCurrentExecutionIfExists(tx, shardID, domainID, workflowID)
+	if err != nil {
+		return err
+	}
+	if currentRow == nil {
+		// allow bypassing no current record
+		return nil
+	}
+	if bytes.Equal(currentRow.RunID, runID) {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"Assertion on current record failed. Current run ID is not expected: %v",
+			currentRow.RunID,
+		)}
+	}
+	return nil
+}
+
 func assertRunIDAndUpdateCurrentExecution(
 	tx sqldb.Tx,
 	shardID int,
@@ -1183,6 +1208,15 @@ func buildExecutionRow(
 		AutoResetPointsEncoding:                 common.StringPtr(string(executionInfo.AutoResetPoints.GetEncoding())),
 		SearchAttributes:                        executionInfo.SearchAttributes,
 		Memo:                                    executionInfo.Memo,
+		QueryTypes:                              executionInfo.QueryTypes,
+		LastDecisionBinaryChecksum:              &executionInfo.LastDecisionBinaryChecksum,
+		Paused:                                  &executionInfo.Paused,
+		Priority:                                &executionInfo.Priority,
+		TerminationProtected:                    &executionInfo.TerminationProtected,
+		EventCount:                              &executionInfo.EventCount,
+		ActivityPayloadBytes:                    &executionInfo.ActivityPayloadBytes,
+		SignalEventCount:                        &executionInfo.SignalEventCount,
+		TimerCount:                              &executionInfo.TimerCount,
 	}
 
 	completionEvent := executionInfo.CompletionEvent
@@ -40,6 +40,10 @@ type sqlHistoryV2Manager struct {
 	shardID int
 }
 
+var (
+	errHistoryQuarantineNotSupported = &shared.BadRequestError{Message: "History branch quarantine is not supported by SQL persistence."}
+)
+
 // newHistoryV2Persistence creates an instance of HistoryManager
 func newHistoryV2Persistence(
 	db sqldb.Interface,
@@ -536,6 +540,32 @@ func (m *sqlHistoryV2Manager) GetAllHistoryTreeBranches(
 	panic("not implemented yet")
 }
 
+// QuarantineHistoryBranch is not supported for SQL as the history scanner processing
+// quarantined branches only runs against Cassandra, the error is returned so that the
+// corrupted branch is reported by the caller instead of being considered quarantined
+func (m *sqlHistoryV2Manager) QuarantineHistoryBranch(
+	request *p.QuarantineHistoryBranchRequest,
+) error {
+
+	return errHistoryQuarantineNotSupported
+}
+
+// GetQuarantinedHistoryBranches is not supported for SQL as branches are never quarantined
+func (m *sqlHistoryV2Manager) GetQuarantinedHistoryBranches(
+	request *p.GetQuarantinedHistoryBranchesRequest,
+) (*p.GetQuarantinedHistoryBranchesResponse, error) {
+
+	return nil, errHistoryQuarantineNotSupported
+}
+
+// DeleteQuarantinedHistoryBranch is not supported for SQL as branches are never quarantined
+func (m *sqlHistoryV2Manager) DeleteQuarantinedHistoryBranch(
+	request *p.DeleteQuarantinedHistoryBranchRequest,
+) error {
+
+	return errHistoryQuarantineNotSupported
+}
+
 // GetHistoryTree returns all branch information of a tree
 func (m *sqlHistoryV2Manager) GetHistoryTree(
 	request *p.GetHistoryTreeRequest,
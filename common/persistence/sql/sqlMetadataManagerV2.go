@@ -114,12 +114,16 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateD
 		VisibilityArchivalURI:       &request.Config.VisibilityArchivalURI,
 		ActiveClusterName:           &request.ReplicationConfig.ActiveClusterName,
 		Clusters:                    clusters,
+		FailoverState:               common.Int32Ptr(int32(request.ReplicationConfig.FailoverState)),
+		PendingActiveClusterName:    &request.ReplicationConfig.PendingActiveClusterName,
+		PreviousFailoverVersion:     common.Int64Ptr(request.ReplicationConfig.PreviousFailoverVersion),
 		ConfigVersion:               common.Int64Ptr(request.ConfigVersion),
 		FailoverVersion:             common.Int64Ptr(request.FailoverVersion),
 		NotificationVersion:         common.Int64Ptr(metadata.NotificationVersion),
 		FailoverNotificationVersion: common.Int64Ptr(persistence.InitialFailoverNotificationVersion),
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		KnownTaskLists:              request.Config.KnownTaskLists,
 	}
 
 	blob, err := domainInfoToBlob(domainInfo)
@@ -236,10 +240,14 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(row *sqldb.DomainRow
 			VisibilityArchivalStatus: workflow.ArchivalStatus(domainInfo.GetVisibilityArchivalStatus()),
 			VisibilityArchivalURI:    domainInfo.GetVisibilityArchivalURI(),
 			BadBinaries:              badBinaries,
+			KnownTaskLists:           domainInfo.GetKnownTaskLists(),
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
-			ActiveClusterName: persistence.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
-			Clusters:          persistence.GetOrUseDefaultClusters(m.activeClusterName, clusters),
+			ActiveClusterName:        persistence.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
+			Clusters:                 persistence.GetOrUseDefaultClusters(m.activeClusterName, clusters),
+			FailoverState:            workflow.DomainFailoverState(domainInfo.GetFailoverState()),
+			PendingActiveClusterName: domainInfo.GetPendingActiveClusterName(),
+			PreviousFailoverVersion:  domainInfo.GetPreviousFailoverVersion(),
 		},
 		IsGlobalDomain:              row.IsGlobal,
 		FailoverVersion:             domainInfo.GetFailoverVersion(),
@@ -276,12 +284,16 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		VisibilityArchivalURI:       &request.Config.VisibilityArchivalURI,
 		ActiveClusterName:           &request.ReplicationConfig.ActiveClusterName,
 		Clusters:                    clusters,
+		FailoverState:               common.Int32Ptr(int32(request.ReplicationConfig.FailoverState)),
+		PendingActiveClusterName:    &request.ReplicationConfig.PendingActiveClusterName,
+		PreviousFailoverVersion:     common.Int64Ptr(request.ReplicationConfig.PreviousFailoverVersion),
 		ConfigVersion:               common.Int64Ptr(request.ConfigVersion),
 		FailoverVersion:             common.Int64Ptr(request.FailoverVersion),
 		NotificationVersion:         common.Int64Ptr(request.NotificationVersion),
 		FailoverNotificationVersion: common.Int64Ptr(request.FailoverNotificationVersion),
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		KnownTaskLists:              request.Config.KnownTaskLists,
 	}
 
 	blob, err := domainInfoToBlob(domainInfo)
@@ -295,6 +307,7 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 			ID:           sqldb.MustParseUUID(request.Info.ID),
 			Data:         blob.Data,
 			DataEncoding: string(blob.Encoding),
+			IsGlobal:     request.IsGlobalDomain,
 		})
 		if err != nil {
 			return err
@@ -85,6 +85,51 @@ func (q *sqlQueue) DequeueMessages(lastMessageID, maxCount int) ([]*persistence.
 	return messages, nil
 }
 
+func (q *sqlQueue) DeleteMessagesBefore(messageID int) error {
+	_, err := q.db.DeleteMessagesBefore(q.queueType, messageID)
+	if err != nil {
+		return &workflow.InternalServiceError{Message: fmt.Sprintf("DeleteMessagesBefore operation failed. Error %v", err)}
+	}
+	return nil
+}
+
+func (q *sqlQueue) UpdateAckLevel(messageID int, clusterName string) error {
+	err := q.txExecute("UpdateAckLevel", func(tx sqldb.Tx) error {
+		clusterAckLevels, err := tx.GetAckLevels(q.queueType, true)
+		if err != nil {
+			return fmt.Errorf("failed to get ack levels: %v", err)
+		}
+
+		if clusterAckLevels == nil {
+			return tx.InsertAckLevel(q.queueType, messageID, clusterName)
+		}
+
+		// ack levels only move forward
+		if ackLevel, ok := clusterAckLevels[clusterName]; ok && ackLevel >= messageID {
+			return nil
+		}
+
+		clusterAckLevels[clusterName] = messageID
+		return tx.UpdateAckLevels(q.queueType, clusterAckLevels)
+	})
+	if err != nil {
+		return &workflow.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
+func (q *sqlQueue) GetAckLevels() (map[string]int, error) {
+	clusterAckLevels, err := q.db.GetAckLevels(q.queueType, false)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("GetAckLevels operation failed. Error %v", err)}
+	}
+
+	if clusterAckLevels == nil {
+		clusterAckLevels = make(map[string]int)
+	}
+	return clusterAckLevels, nil
+}
+
 func newQueueRow(queueType int, messageID int, payload []byte) *sqldb.QueueRow {
 	return &sqldb.QueueRow{QueueType: queueType, MessageID: messageID, MessagePayload: payload}
 }
@@ -126,6 +126,7 @@ func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persi
 		ClusterTimerAckLevel:      timerAckLevel,
 		DomainNotificationVersion: shardInfo.GetDomainNotificationVersion(),
 		ClusterReplicationLevel:   shardInfo.ClusterReplicationLevel,
+		MaxObservedTime:           time.Unix(0, shardInfo.GetMaxObservedTimeNanos()),
 	}}
 
 	return resp, nil
@@ -221,6 +222,7 @@ func shardInfoToShardsRow(s persistence.ShardInfo) (*sqldb.ShardsRow, error) {
 		DomainNotificationVersion: common.Int64Ptr(s.DomainNotificationVersion),
 		Owner:                     &s.Owner,
 		ClusterReplicationLevel:   s.ClusterReplicationLevel,
+		MaxObservedTimeNanos:      common.Int64Ptr(s.MaxObservedTime.UnixNano()),
 	}
 
 	blob, err := shardInfoToBlob(shardInfo)
@@ -155,6 +155,8 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 			AckLevel:    ackLevel,
 			Kind:        request.TaskListKind,
 			LastUpdated: now,
+
+			AdaptivePartitionConfig: taskListPartitionConfigFromBlob(tlInfo),
 		}}
 		return nil
 	})
@@ -170,7 +172,12 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 		ExpiryTimeNanos:  common.Int64Ptr(0),
 		LastUpdatedNanos: common.TimeNowNanosPtr(),
 	}
-	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
+	if config := request.TaskListInfo.AdaptivePartitionConfig; config != nil {
+		tlInfo.AdaptivePartitionVersion = common.Int64Ptr(config.Version)
+		tlInfo.NumReadPartitions = common.Int32Ptr(int32(config.NumReadPartitions))
+		tlInfo.NumWritePartitions = common.Int32Ptr(int32(config.NumWritePartitions))
+	}
+	if request.TaskListInfo.Kind == persistence.TaskListKindSticky || request.TaskListInfo.Kind == persistence.TaskListKindWorker {
 		tlInfo.ExpiryTimeNanos = common.Int64Ptr(stickyTaskListTTL().UnixNano())
 		blob, err := taskListInfoToBlob(tlInfo)
 		if err != nil {
@@ -462,3 +469,16 @@ func lockTaskList(tx sqldb.Tx, shardID int, domainID sqldb.UUID, name string, ta
 func stickyTaskListTTL() time.Time {
 	return time.Now().Add(24 * time.Hour)
 }
+
+// taskListPartitionConfigFromBlob returns the partition config decided by the adaptive scaler, nil if
+// the scaler never scaled the task list
+func taskListPartitionConfigFromBlob(tlInfo *sqlblobs.TaskListInfo) *persistence.TaskListPartitionConfig {
+	if tlInfo.GetAdaptivePartitionVersion() == 0 {
+		return nil
+	}
+	return &persistence.TaskListPartitionConfig{
+		Version:            tlInfo.GetAdaptivePartitionVersion(),
+		NumReadPartitions:  int(tlInfo.GetNumReadPartitions()),
+		NumWritePartitions: int(tlInfo.GetNumWritePartitions()),
+	}
+}
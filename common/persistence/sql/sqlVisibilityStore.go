@@ -76,20 +76,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
-	closeTime := time.Unix(0, request.CloseTimestamp)
-	result, err := s.db.ReplaceIntoVisibility(&sqldb.VisibilityRow{
-		DomainID:         request.DomainUUID,
-		WorkflowID:       request.WorkflowID,
-		RunID:            request.RunID,
-		StartTime:        time.Unix(0, request.StartTimestamp),
-		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp),
-		WorkflowTypeName: request.WorkflowTypeName,
-		CloseTime:        &closeTime,
-		CloseStatus:      common.Int32Ptr(int32(request.Status)),
-		HistoryLength:    &request.HistoryLength,
-		Memo:             request.Memo.Data,
-		Encoding:         string(request.Memo.GetEncoding()),
-	})
+	result, err := s.db.ReplaceIntoVisibility(toClosedVisibilityRow(request))
 	if err != nil {
 		return err
 	}
@@ -100,6 +87,41 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 	if noRowsAffected > 2 { // either adds a new row or deletes old row and adds new row
 		return fmt.Errorf("RecordWorkflowExecutionClosed unexpected numRows (%v) updated", noRowsAffected)
 	}
+	return s.recordIndexedMemo(toVisibilityMemoRows(request))
+}
+
+func (s *sqlVisibilityStore) RecordWorkflowExecutionClosedBatch(request *p.InternalRecordWorkflowExecutionClosedBatchRequest) error {
+	if len(request.Requests) == 0 {
+		return nil
+	}
+
+	rows := make([]sqldb.VisibilityRow, 0, len(request.Requests))
+	var memoRows []sqldb.VisibilityMemoRow
+	for _, closedRequest := range request.Requests {
+		rows = append(rows, *toClosedVisibilityRow(closedRequest))
+		memoRows = append(memoRows, toVisibilityMemoRows(closedRequest)...)
+	}
+	result, err := s.db.ReplaceIntoVisibilityBatch(rows)
+	if err != nil {
+		return err
+	}
+	noRowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("RecordWorkflowExecutionClosedBatch rowsAffected error: %v", err)
+	}
+	if noRowsAffected > int64(2*len(rows)) { // either adds a new row or deletes old row and adds new row
+		return fmt.Errorf("RecordWorkflowExecutionClosedBatch unexpected numRows (%v) updated", noRowsAffected)
+	}
+	return s.recordIndexedMemo(memoRows)
+}
+
+func (s *sqlVisibilityStore) recordIndexedMemo(rows []sqldb.VisibilityMemoRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if _, err := s.db.ReplaceIntoVisibilityMemo(rows); err != nil {
+		return fmt.Errorf("recordIndexedMemo failed. Error: %v", err)
+	}
 	return nil
 }
 
@@ -107,6 +129,23 @@ func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWo
 	return p.NewOperationNotSupportErrorForVis()
 }
 
+// UpdateHistoryArchivalInfo updates the closed record of the workflow execution,
+// nothing is updated if the record does not exist
+func (s *sqlVisibilityStore) UpdateHistoryArchivalInfo(request *p.InternalUpdateHistoryArchivalInfoRequest) error {
+	_, err := s.db.UpdateVisibilityHistoryArchivalInfo(&sqldb.VisibilityRow{
+		DomainID:                request.DomainUUID,
+		RunID:                   request.RunID,
+		HistoryArchivalInfo:     request.HistoryArchivalInfo.Data,
+		HistoryArchivalEncoding: string(request.HistoryArchivalInfo.GetEncoding()),
+	})
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateHistoryArchivalInfo operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutions", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
 		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
@@ -214,6 +253,23 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.Lis
 		})
 }
 
+func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByMemo(request *p.ListClosedWorkflowExecutionsByMemoRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByMemo", request.NextPageToken, request.EarliestStartTime, request.LatestStartTime,
+		func(readLevel *visibilityPageToken) ([]sqldb.VisibilityRow, error) {
+			minStartTime := time.Unix(0, request.EarliestStartTime)
+			return s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
+				DomainID:     request.DomainUUID,
+				MinStartTime: &minStartTime,
+				MaxStartTime: &readLevel.Time,
+				Closed:       true,
+				RunID:        &readLevel.RunID,
+				MemoKey:      &request.MemoKey,
+				MemoValue:    &request.MemoValue,
+				PageSize:     &request.PageSize,
+			})
+		})
+}
+
 func (s *sqlVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
 	rows, err := s.db.SelectFromVisibility(&sqldb.VisibilityFilter{
@@ -239,11 +295,14 @@ func (s *sqlVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWork
 }
 
 func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
-	_, err := s.db.DeleteFromVisibility(&sqldb.VisibilityFilter{
+	filter := &sqldb.VisibilityFilter{
 		DomainID: request.DomainID,
 		RunID:    &request.RunID,
-	})
-	if err != nil {
+	}
+	if _, err := s.db.DeleteFromVisibilityMemo(filter); err != nil {
+		return &workflow.InternalServiceError{Message: err.Error()}
+	}
+	if _, err := s.db.DeleteFromVisibility(filter); err != nil {
 		return &workflow.InternalServiceError{Message: err.Error()}
 	}
 	return nil
@@ -278,6 +337,9 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWo
 		info.Status = &status
 		info.CloseTime = *row.CloseTime
 		info.HistoryLength = *row.HistoryLength
+		if len(row.HistoryArchivalInfo) > 0 {
+			info.HistoryArchivalInfo = p.NewDataBlob(row.HistoryArchivalInfo, common.EncodingType(row.HistoryArchivalEncoding))
+		}
 	}
 	return info
 }
@@ -335,3 +397,36 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+func toClosedVisibilityRow(request *p.InternalRecordWorkflowExecutionClosedRequest) *sqldb.VisibilityRow {
+	closeTime := time.Unix(0, request.CloseTimestamp)
+	return &sqldb.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        time.Unix(0, request.StartTimestamp),
+		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp),
+		WorkflowTypeName: request.WorkflowTypeName,
+		CloseTime:        &closeTime,
+		CloseStatus:      common.Int32Ptr(int32(request.Status)),
+		HistoryLength:    &request.HistoryLength,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+
+		HistoryArchivalInfo:     request.HistoryArchivalInfo.Data,
+		HistoryArchivalEncoding: string(request.HistoryArchivalInfo.GetEncoding()),
+	}
+}
+
+func toVisibilityMemoRows(request *p.InternalRecordWorkflowExecutionClosedRequest) []sqldb.VisibilityMemoRow {
+	rows := make([]sqldb.VisibilityMemoRow, 0, len(request.IndexedMemo))
+	for key, value := range request.IndexedMemo {
+		rows = append(rows, sqldb.VisibilityMemoRow{
+			DomainID:  request.DomainUUID,
+			RunID:     request.RunID,
+			MemoKey:   key,
+			MemoValue: value,
+		})
+	}
+	return rows
+}
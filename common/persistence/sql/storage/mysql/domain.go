@@ -35,7 +35,7 @@ const (
  VALUES(?, ?, ?, ?, ?)`
 
 	updateDomainQry = `UPDATE domains 
- SET name = ?, data = ?, data_encoding = ?
+ SET name = ?, data = ?, data_encoding = ?, is_global = ?
  WHERE shard_id=54321 AND id = ?`
 
 	getDomainPart = `SELECT id, name, is_global, data, data_encoding FROM domains`
@@ -63,7 +63,7 @@ func (mdb *DB) InsertIntoDomain(row *sqldb.DomainRow) (sql.Result, error) {
 
 // UpdateDomain updates a single row in domains table
 func (mdb *DB) UpdateDomain(row *sqldb.DomainRow) (sql.Result, error) {
-	return mdb.conn.Exec(updateDomainQry, row.Name, row.Data, row.DataEncoding, row.ID)
+	return mdb.conn.Exec(updateDomainQry, row.Name, row.Data, row.DataEncoding, row.IsGlobal, row.ID)
 }
 
 // SelectFromDomain reads one or more rows from domains table
@@ -22,6 +22,7 @@ package mysql
 
 import (
 	"database/sql"
+	"encoding/json"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
@@ -30,6 +31,12 @@ const (
 	templateEnqueueMessageQuery   = `INSERT INTO queue (queue_type, message_id, message_payload) VALUES(:queue_type, :message_id, :message_payload)`
 	templateGetLastMessageIDQuery = `SELECT message_id FROM queue WHERE message_id >= (SELECT message_id FROM queue WHERE queue_type=? ORDER BY message_id DESC LIMIT 1) FOR UPDATE`
 	templateGetMessagesQuery      = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? LIMIT ?`
+	templateDeleteMessagesQuery   = `DELETE FROM queue WHERE queue_type = ? and message_id < ?`
+
+	templateGetQueueMetadataQuery          = `SELECT data from queue_metadata WHERE queue_type = ?`
+	templateGetQueueMetadataForUpdateQuery = templateGetQueueMetadataQuery + ` FOR UPDATE`
+	templateInsertQueueMetadataQuery       = `INSERT INTO queue_metadata (queue_type, data) VALUES(?, ?)`
+	templateUpdateQueueMetadataQuery       = `UPDATE queue_metadata SET data = ? WHERE queue_type = ?`
 )
 
 // InsertIntoQueue inserts a new row into queue table
@@ -50,3 +57,56 @@ func (mdb *DB) GetMessagesFromQueue(queueType, lastMessageID, maxRows int) ([]sq
 	err := mdb.conn.Select(&rows, templateGetMessagesQuery, queueType, lastMessageID, maxRows)
 	return rows, err
 }
+
+// DeleteMessagesBefore deletes messages before messageID from the queue
+func (mdb *DB) DeleteMessagesBefore(queueType int, messageID int) (sql.Result, error) {
+	return mdb.conn.Exec(templateDeleteMessagesQuery, queueType, messageID)
+}
+
+// InsertAckLevel inserts ack level
+func (mdb *DB) InsertAckLevel(queueType int, messageID int, clusterName string) error {
+	clusterAckLevels := map[string]int{clusterName: messageID}
+	data, err := json.Marshal(clusterAckLevels)
+	if err != nil {
+		return err
+	}
+
+	_, err = mdb.conn.Exec(templateInsertQueueMetadataQuery, queueType, data)
+	return err
+}
+
+// UpdateAckLevels updates cluster ack levels
+func (mdb *DB) UpdateAckLevels(queueType int, clusterAckLevels map[string]int) error {
+	data, err := json.Marshal(clusterAckLevels)
+	if err != nil {
+		return err
+	}
+
+	_, err = mdb.conn.Exec(templateUpdateQueueMetadataQuery, data, queueType)
+	return err
+}
+
+// GetAckLevels returns ack levels for pulling clusters
+func (mdb *DB) GetAckLevels(queueType int, forUpdate bool) (map[string]int, error) {
+	queryStr := templateGetQueueMetadataQuery
+	if forUpdate {
+		queryStr = templateGetQueueMetadataForUpdateQuery
+	}
+
+	var data []byte
+	err := mdb.conn.Get(&data, queryStr, queueType)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var clusterAckLevels map[string]int
+	if err := json.Unmarshal(data, &clusterAckLevels); err != nil {
+		return nil, err
+	}
+
+	return clusterAckLevels, nil
+}
@@ -24,6 +24,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
@@ -34,8 +35,20 @@ const (
 		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, ` +
+		`history_archival_info, history_archival_encoding) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateCreateWorkflowExecutionClosedBatch = `REPLACE INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, ` +
+		`history_archival_info, history_archival_encoding) ` +
+		`VALUES `
+
+	templateCreateWorkflowExecutionClosedBatchValues = `(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpdateHistoryArchivalInfo = `UPDATE executions_visibility ` +
+		`SET history_archival_info = ?, history_archival_encoding = ? ` +
+		`WHERE domain_id = ? AND run_id = ? AND close_status IS NOT NULL`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -48,7 +61,7 @@ const (
 	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
-	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length, history_archival_info, history_archival_encoding
 		 FROM executions_visibility WHERE close_status IS NOT NULL `
 
 	templateGetOpenWorkflowExecutions = templateOpenSelect + templateConditions
@@ -65,12 +78,22 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, history_archival_info, history_archival_encoding
 		 FROM executions_visibility
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
+	templateGetClosedWorkflowExecutionsByMemo = templateClosedSelect +
+		`AND run_id IN (SELECT run_id FROM executions_visibility_memo WHERE domain_id = ? AND memo_key = ? AND memo_value = ?)` +
+		templateConditions
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateCreateWorkflowExecutionMemo = `REPLACE INTO executions_visibility_memo (domain_id, run_id, memo_key, memo_value) VALUES `
+
+	templateCreateWorkflowExecutionMemoValues = `(?, ?, ?, ?)`
+
+	templateDeleteWorkflowExecutionMemo = "DELETE FROM executions_visibility_memo WHERE domain_id=? AND run_id=?"
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -107,17 +130,72 @@ func (mdb *DB) ReplaceIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, erro
 			*row.CloseStatus,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.HistoryArchivalInfo,
+			row.HistoryArchivalEncoding)
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// ReplaceIntoVisibilityBatch replaces the existing rows if they exist or creates new rows in visibility table,
+// in a single statement
+func (mdb *DB) ReplaceIntoVisibilityBatch(rows []sqldb.VisibilityRow) (sql.Result, error) {
+	values := make([]string, 0, len(rows))
+	args := make([]interface{}, 0, len(rows)*13)
+	for _, row := range rows {
+		if row.CloseStatus == nil || row.CloseTime == nil || row.HistoryLength == nil {
+			return nil, errCloseParams
+		}
+		values = append(values, templateCreateWorkflowExecutionClosedBatchValues)
+		args = append(args,
+			row.DomainID,
+			row.WorkflowID,
+			row.RunID,
+			mdb.converter.ToMySQLDateTime(row.StartTime),
+			row.ExecutionTime,
+			row.WorkflowTypeName,
+			mdb.converter.ToMySQLDateTime(*row.CloseTime),
+			*row.CloseStatus,
+			*row.HistoryLength,
+			row.Memo,
+			row.Encoding,
+			row.HistoryArchivalInfo,
+			row.HistoryArchivalEncoding)
+	}
+	return mdb.conn.Exec(templateCreateWorkflowExecutionClosedBatch+strings.Join(values, ", "), args...)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *DB) DeleteFromVisibility(filter *sqldb.VisibilityFilter) (sql.Result, error) {
 	return mdb.conn.Exec(templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
 }
 
+// UpdateVisibilityHistoryArchivalInfo updates the history archival info of a closed row in visibility table
+func (mdb *DB) UpdateVisibilityHistoryArchivalInfo(row *sqldb.VisibilityRow) (sql.Result, error) {
+	return mdb.conn.Exec(templateUpdateHistoryArchivalInfo,
+		row.HistoryArchivalInfo,
+		row.HistoryArchivalEncoding,
+		row.DomainID,
+		row.RunID)
+}
+
+// ReplaceIntoVisibilityMemo replaces the existing rows if they exist or creates new rows in visibility memo table
+func (mdb *DB) ReplaceIntoVisibilityMemo(rows []sqldb.VisibilityMemoRow) (sql.Result, error) {
+	values := make([]string, 0, len(rows))
+	args := make([]interface{}, 0, len(rows)*4)
+	for _, row := range rows {
+		values = append(values, templateCreateWorkflowExecutionMemoValues)
+		args = append(args, row.DomainID, row.RunID, row.MemoKey, row.MemoValue)
+	}
+	return mdb.conn.Exec(templateCreateWorkflowExecutionMemo+strings.Join(values, ", "), args...)
+}
+
+// DeleteFromVisibilityMemo deletes the visibility memo rows of an execution
+func (mdb *DB) DeleteFromVisibilityMemo(filter *sqldb.VisibilityFilter) (sql.Result, error) {
+	return mdb.conn.Exec(templateDeleteWorkflowExecutionMemo, filter.DomainID, filter.RunID)
+}
+
 // SelectFromVisibility reads one or more rows from visibility table
 func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.VisibilityRow, error) {
 	var err error
@@ -173,6 +251,18 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 			*filter.RunID,
 			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
 			*filter.PageSize)
+	case filter.MinStartTime != nil && filter.MemoKey != nil && filter.MemoValue != nil:
+		err = mdb.conn.Select(&rows,
+			templateGetClosedWorkflowExecutionsByMemo,
+			filter.DomainID,
+			*filter.MemoKey,
+			*filter.MemoValue,
+			filter.DomainID,
+			mdb.converter.ToMySQLDateTime(*filter.MinStartTime),
+			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
+			*filter.PageSize)
 	case filter.MinStartTime != nil:
 		qry := templateGetOpenWorkflowExecutions
 		if filter.Closed {
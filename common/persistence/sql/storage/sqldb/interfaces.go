@@ -438,6 +438,17 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		// HistoryArchivalInfo is only set for closed executions whose history is archived
+		HistoryArchivalInfo     []byte
+		HistoryArchivalEncoding string
+	}
+
+	// VisibilityMemoRow represents a row in executions_visibility_memo table
+	VisibilityMemoRow struct {
+		DomainID  string
+		RunID     string
+		MemoKey   string
+		MemoValue string
 	}
 
 	// VisibilityFilter contains the column names within domain table that
@@ -449,6 +460,8 @@ type (
 		WorkflowID       *string
 		WorkflowTypeName *string
 		CloseStatus      *int32
+		MemoKey          *string
+		MemoValue        *string
 		MinStartTime     *time.Time
 		MaxStartTime     *time.Time
 		PageSize         *int
@@ -640,6 +653,8 @@ type (
 		InsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		// ReplaceIntoVisibilityBatch deletes old rows (if they exist) and inserts new rows into visibility table
+		ReplaceIntoVisibilityBatch(rows []VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {domainID, runID, closed=true}
@@ -648,12 +663,29 @@ type (
 		//     - domainID, minStartTime, maxStartTime, runID and pageSize where some or all of these may come from previous page token
 		//   - OPTIONALLY specify one of following params
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
+		//     - memoKey and memoValue (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+		// UpdateVisibilityHistoryArchivalInfo updates the history archival info of a closed row in visibility table
+		// Required row params - {domainID, runID, historyArchivalInfo, historyArchivalEncoding}
+		UpdateVisibilityHistoryArchivalInfo(row *VisibilityRow) (sql.Result, error)
+		// ReplaceIntoVisibilityMemo deletes old rows (if they exist) and inserts new rows into visibility memo table
+		ReplaceIntoVisibilityMemo(rows []VisibilityMemoRow) (sql.Result, error)
+		// DeleteFromVisibilityMemo deletes the indexed memo rows of an execution
+		// Required filter params - {domainID, runID}
+		DeleteFromVisibilityMemo(filter *VisibilityFilter) (sql.Result, error)
 
 		InsertIntoQueue(row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(queueType int) (int, error)
 		GetMessagesFromQueue(queueType, lastMessageID, maxRows int) ([]QueueRow, error)
+		// DeleteMessagesBefore deletes all messages of the queue with an ID lower than messageID
+		DeleteMessagesBefore(queueType int, messageID int) (sql.Result, error)
+		// InsertAckLevel inserts the queue metadata row holding the ack level of the given cluster
+		InsertAckLevel(queueType int, messageID int, clusterName string) error
+		// UpdateAckLevels overwrites the ack levels stored in the queue metadata row
+		UpdateAckLevels(queueType int, clusterAckLevels map[string]int) error
+		// GetAckLevels returns the ack levels of the queue, locking the metadata row if forUpdate is set
+		GetAckLevels(queueType int, forUpdate bool) (map[string]int, error)
 	}
 
 	// Tx defines the API for a SQL transaction
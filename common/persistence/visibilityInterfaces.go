@@ -18,6 +18,8 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination visibilityInterfaces_mock.go -self_package github.com/uber/cadence/common/persistence -aux_files github.com/uber/cadence/common/persistence=dataInterfaces.go
+
 package persistence
 
 import (
@@ -62,6 +64,14 @@ type (
 		TaskID             int64 // not persisted, used as condition update version for ES
 		Memo               *s.Memo
 		SearchAttributes   map[string][]byte
+		// HistoryArchivalInfo is the state of the history archival, nil if history is not archived
+		HistoryArchivalInfo *s.HistoryArchivalInfo
+	}
+
+	// RecordWorkflowExecutionClosedBatchRequest is used to add the records of multiple
+	// closed executions in a single call
+	RecordWorkflowExecutionClosedBatchRequest struct {
+		Requests []*RecordWorkflowExecutionClosedRequest
 	}
 
 	// UpsertWorkflowExecutionRequest is used to upsert workflow execution
@@ -100,6 +110,10 @@ type (
 		// Pass in empty slice for first page.
 		NextPageToken []byte
 		Query         string
+		// SliceID and SliceCount split a scan into SliceCount disjoint slices, a SliceCount
+		// of 0 or 1 scans all executions. Only honored by ScanWorkflowExecutions.
+		SliceID    int
+		SliceCount int
 	}
 
 	// ListWorkflowExecutionsResponse is the response to ListWorkflowExecutionsRequest
@@ -143,6 +157,14 @@ type (
 		Status s.WorkflowExecutionCloseStatus
 	}
 
+	// ListClosedWorkflowExecutionsByMemoRequest is used to list closed executions that
+	// have specific value for an indexed memo key
+	ListClosedWorkflowExecutionsByMemoRequest struct {
+		ListWorkflowExecutionsRequest
+		MemoKey   string
+		MemoValue string
+	}
+
 	// GetClosedWorkflowExecutionRequest is used retrieve the record for a specific execution
 	GetClosedWorkflowExecutionRequest struct {
 		DomainUUID string
@@ -155,12 +177,28 @@ type (
 		Execution *s.WorkflowExecutionInfo
 	}
 
+	// UpdateHistoryArchivalInfoRequest is used to record the outcome of the history archival
+	// of a closed execution whose record is retained after the history is archived
+	UpdateHistoryArchivalInfoRequest struct {
+		DomainUUID          string
+		Domain              string // not persisted, used as config filter key
+		Execution           s.WorkflowExecution
+		StartTimestamp      int64
+		CloseTimestamp      int64
+		RetentionSeconds    int64 // not persisted, used for cassandra ttl
+		HistoryArchivalInfo *s.HistoryArchivalInfo
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		DomainID   string
 		RunID      string
 		WorkflowID string
 		TaskID     int64
+		// StartTimestamp and CloseTimestamp locate the record in stores keyed by time, e.g. cassandra,
+		// which otherwise leave the record to be deleted by its TTL
+		StartTimestamp int64
+		CloseTimestamp int64
 	}
 
 	// VisibilityManager is used to manage the visibility store
@@ -169,7 +207,9 @@ type (
 		GetName() string
 		RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error
 		RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error
+		RecordWorkflowExecutionClosedBatch(request *RecordWorkflowExecutionClosedBatchRequest) error
 		UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error
+		UpdateHistoryArchivalInfo(request *UpdateHistoryArchivalInfoRequest) error
 		ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error)
@@ -177,6 +217,7 @@ type (
 		ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
+		ListClosedWorkflowExecutionsByMemo(request *ListClosedWorkflowExecutionsByMemoRequest) (*ListWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error
 		ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
@@ -116,13 +116,39 @@ func (p *visibilitySamplingClient) RecordWorkflowExecutionStarted(request *Recor
 }
 
 func (p *visibilitySamplingClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	if !p.allowRecordWorkflowExecutionClosed(request, metrics.PersistenceRecordWorkflowExecutionClosedScope) {
+		return nil
+	}
+	return p.persistence.RecordWorkflowExecutionClosed(request)
+}
+
+func (p *visibilitySamplingClient) RecordWorkflowExecutionClosedBatch(request *RecordWorkflowExecutionClosedBatchRequest) error {
+	var requests []*RecordWorkflowExecutionClosedRequest
+	for _, closedRequest := range request.Requests {
+		if p.allowRecordWorkflowExecutionClosed(closedRequest, metrics.PersistenceRecordWorkflowExecutionClosedBatchScope) {
+			requests = append(requests, closedRequest)
+		}
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+	return p.persistence.RecordWorkflowExecutionClosedBatch(&RecordWorkflowExecutionClosedBatchRequest{
+		Requests: requests,
+	})
+}
+
+func (p *visibilitySamplingClient) allowRecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest,
+	scope int,
+) bool {
+
 	domain := request.Domain
 	domainID := request.DomainUUID
 	priority := getRequestPriority(request)
 
 	rateLimiter := p.rateLimitersForClosed.getRateLimiter(domain, numOfPriorityForClosed, p.config.VisibilityClosedMaxQPS(domain))
 	if ok, _ := rateLimiter.GetToken(priority, 1); ok {
-		return p.persistence.RecordWorkflowExecutionClosed(request)
+		return true
 	}
 
 	p.logger.Info("Request for closed workflow is sampled",
@@ -132,8 +158,8 @@ func (p *visibilitySamplingClient) RecordWorkflowExecutionClosed(request *Record
 		tag.WorkflowID(request.Execution.GetWorkflowId()),
 		tag.WorkflowRunID(request.Execution.GetRunId()),
 	)
-	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceSampledCounter)
-	return nil
+	p.metricClient.IncCounter(scope, metrics.PersistenceSampledCounter)
+	return false
 }
 
 func (p *visibilitySamplingClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
@@ -156,6 +182,10 @@ func (p *visibilitySamplingClient) UpsertWorkflowExecution(request *UpsertWorkfl
 	return nil
 }
 
+func (p *visibilitySamplingClient) UpdateHistoryArchivalInfo(request *UpdateHistoryArchivalInfoRequest) error {
+	return p.persistence.UpdateHistoryArchivalInfo(request)
+}
+
 func (p *visibilitySamplingClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	domain := request.Domain
 
@@ -233,6 +263,17 @@ func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(request
 	return p.persistence.ListClosedWorkflowExecutionsByStatus(request)
 }
 
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByMemo(request *ListClosedWorkflowExecutionsByMemoRequest) (*ListWorkflowExecutionsResponse, error) {
+	domain := request.Domain
+
+	rateLimiter := p.rateLimitersForList.getRateLimiter(domain, numOfPriorityForList, p.config.VisibilityListMaxQPS(domain))
+	if ok, _ := rateLimiter.GetToken(0, 1); !ok {
+		return nil, ErrPersistenceLimitExceededForList
+	}
+
+	return p.persistence.ListClosedWorkflowExecutionsByMemo(request)
+}
+
 func (p *visibilitySamplingClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	return p.persistence.GetClosedWorkflowExecution(request)
 }
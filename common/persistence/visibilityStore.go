@@ -22,32 +22,48 @@ package persistence
 
 import (
 	"encoding/json"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	visibilityManagerImpl struct {
-		serializer  PayloadSerializer
-		persistence VisibilityStore
-		logger      log.Logger
+		serializer      PayloadSerializer
+		persistence     VisibilityStore
+		indexedMemoKeys dynamicconfig.StringPropertyFnWithDomainFilter
+		logger          log.Logger
 	}
 )
 
-// VisibilityEncoding is default encoding for visibility data
-const VisibilityEncoding = common.EncodingTypeThriftRW
+const (
+	// VisibilityEncoding is default encoding for visibility data
+	VisibilityEncoding = common.EncodingTypeThriftRW
+
+	// MaxIndexedMemoKeys is the max number of memo keys a domain can declare as indexed
+	MaxIndexedMemoKeys = 3
+	// MaxIndexedMemoValueLength is the max length of a memo value to be indexed
+	MaxIndexedMemoValueLength = 256
+)
 
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
-// NewVisibilityManagerImpl returns new VisibilityManager
-func NewVisibilityManagerImpl(persistence VisibilityStore, logger log.Logger) VisibilityManager {
+// NewVisibilityManagerImpl returns new VisibilityManager, indexedMemoKeys is optional
+func NewVisibilityManagerImpl(
+	persistence VisibilityStore,
+	indexedMemoKeys dynamicconfig.StringPropertyFnWithDomainFilter,
+	logger log.Logger,
+) VisibilityManager {
 	return &visibilityManagerImpl{
-		serializer:  NewPayloadSerializer(),
-		persistence: persistence,
-		logger:      logger,
+		serializer:      NewPayloadSerializer(),
+		persistence:     persistence,
+		indexedMemoKeys: indexedMemoKeys,
+		logger:          logger,
 	}
 }
 
@@ -76,22 +92,17 @@ func (v *visibilityManagerImpl) RecordWorkflowExecutionStarted(request *RecordWo
 }
 
 func (v *visibilityManagerImpl) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
-	req := &InternalRecordWorkflowExecutionClosedRequest{
-		DomainUUID:         request.DomainUUID,
-		WorkflowID:         request.Execution.GetWorkflowId(),
-		RunID:              request.Execution.GetRunId(),
-		WorkflowTypeName:   request.WorkflowTypeName,
-		StartTimestamp:     request.StartTimestamp,
-		ExecutionTimestamp: request.ExecutionTimestamp,
-		TaskID:             request.TaskID,
-		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
-		SearchAttributes:   request.SearchAttributes,
-		CloseTimestamp:     request.CloseTimestamp,
-		Status:             request.Status,
-		HistoryLength:      request.HistoryLength,
-		RetentionSeconds:   request.RetentionSeconds,
+	return v.persistence.RecordWorkflowExecutionClosed(v.toInternalRecordWorkflowExecutionClosedRequest(request))
+}
+
+func (v *visibilityManagerImpl) RecordWorkflowExecutionClosedBatch(request *RecordWorkflowExecutionClosedBatchRequest) error {
+	req := &InternalRecordWorkflowExecutionClosedBatchRequest{
+		Requests: make([]*InternalRecordWorkflowExecutionClosedRequest, 0, len(request.Requests)),
+	}
+	for _, closedRequest := range request.Requests {
+		req.Requests = append(req.Requests, v.toInternalRecordWorkflowExecutionClosedRequest(closedRequest))
 	}
-	return v.persistence.RecordWorkflowExecutionClosed(req)
+	return v.persistence.RecordWorkflowExecutionClosedBatch(req)
 }
 
 func (v *visibilityManagerImpl) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
@@ -109,6 +120,19 @@ func (v *visibilityManagerImpl) UpsertWorkflowExecution(request *UpsertWorkflowE
 	return v.persistence.UpsertWorkflowExecution(req)
 }
 
+func (v *visibilityManagerImpl) UpdateHistoryArchivalInfo(request *UpdateHistoryArchivalInfoRequest) error {
+	req := &InternalUpdateHistoryArchivalInfoRequest{
+		DomainUUID:          request.DomainUUID,
+		WorkflowID:          request.Execution.GetWorkflowId(),
+		RunID:               request.Execution.GetRunId(),
+		StartTimestamp:      request.StartTimestamp,
+		CloseTimestamp:      request.CloseTimestamp,
+		RetentionSeconds:    request.RetentionSeconds,
+		HistoryArchivalInfo: v.serializeHistoryArchivalInfo(request.HistoryArchivalInfo, request.DomainUUID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
+	}
+	return v.persistence.UpdateHistoryArchivalInfo(req)
+}
+
 func (v *visibilityManagerImpl) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	internalResp, err := v.persistence.ListOpenWorkflowExecutions(request)
 	if err != nil {
@@ -165,6 +189,14 @@ func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByStatus(request *Li
 	return v.convertInternalListResponse(internalResp), nil
 }
 
+func (v *visibilityManagerImpl) ListClosedWorkflowExecutionsByMemo(request *ListClosedWorkflowExecutionsByMemoRequest) (*ListWorkflowExecutionsResponse, error) {
+	internalResp, err := v.persistence.ListClosedWorkflowExecutionsByMemo(request)
+	if err != nil {
+		return nil, err
+	}
+	return v.convertInternalListResponse(internalResp), nil
+}
+
 func (v *visibilityManagerImpl) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	internalResp, err := v.persistence.GetClosedWorkflowExecution(request)
 	if err != nil {
@@ -282,6 +314,17 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 		convertedExecution.CloseTime = common.Int64Ptr(execution.CloseTime.UnixNano())
 		convertedExecution.CloseStatus = execution.Status
 		convertedExecution.HistoryLength = common.Int64Ptr(execution.HistoryLength)
+		if execution.HistoryArchivalInfo != nil && len(execution.HistoryArchivalInfo.Data) > 0 {
+			historyArchivalInfo, err := v.serializer.DeserializeHistoryArchivalInfo(execution.HistoryArchivalInfo)
+			if err != nil {
+				v.logger.Error("failed to deserialize history archival info",
+					tag.WorkflowID(execution.WorkflowID),
+					tag.WorkflowRunID(execution.RunID),
+					tag.Error(err))
+			} else {
+				convertedExecution.HistoryArchivalInfo = historyArchivalInfo
+			}
+		}
 	}
 
 	return convertedExecution
@@ -302,3 +345,80 @@ func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *shared.Memo, domai
 	}
 	return memo
 }
+
+func (v *visibilityManagerImpl) serializeHistoryArchivalInfo(info *shared.HistoryArchivalInfo, domainID, wID, rID string) *DataBlob {
+	blob, err := v.serializer.SerializeHistoryArchivalInfo(info, VisibilityEncoding)
+	if err != nil {
+		v.logger.WithTags(
+			tag.WorkflowDomainID(domainID),
+			tag.WorkflowID(wID),
+			tag.WorkflowRunID(rID),
+			tag.Error(err)).
+			Error("Unable to encode history archival info")
+	}
+	if blob == nil {
+		return &DataBlob{}
+	}
+	return blob
+}
+
+func (v *visibilityManagerImpl) toInternalRecordWorkflowExecutionClosedRequest(
+	request *RecordWorkflowExecutionClosedRequest,
+) *InternalRecordWorkflowExecutionClosedRequest {
+	return &InternalRecordWorkflowExecutionClosedRequest{
+		DomainUUID:         request.DomainUUID,
+		WorkflowID:         request.Execution.GetWorkflowId(),
+		RunID:              request.Execution.GetRunId(),
+		WorkflowTypeName:   request.WorkflowTypeName,
+		StartTimestamp:     request.StartTimestamp,
+		ExecutionTimestamp: request.ExecutionTimestamp,
+		TaskID:             request.TaskID,
+		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
+		IndexedMemo:        v.getIndexedMemo(request.Domain, request.Memo),
+		SearchAttributes:   request.SearchAttributes,
+		CloseTimestamp:     request.CloseTimestamp,
+		Status:             request.Status,
+		HistoryLength:      request.HistoryLength,
+		RetentionSeconds:   request.RetentionSeconds,
+		HistoryArchivalInfo: v.serializeHistoryArchivalInfo(
+			request.HistoryArchivalInfo, request.DomainUUID, request.Execution.GetWorkflowId(), request.Execution.GetRunId()),
+	}
+}
+
+// getIndexedMemo returns the memo fields declared as indexed for the domain,
+// values which are not valid utf8 or are too long are not indexed
+func (v *visibilityManagerImpl) getIndexedMemo(domain string, memo *shared.Memo) map[string]string {
+	if v.indexedMemoKeys == nil || memo == nil || len(memo.Fields) == 0 {
+		return nil
+	}
+
+	var indexedMemo map[string]string
+	for _, key := range GetIndexedMemoKeys(v.indexedMemoKeys(domain)) {
+		value, ok := memo.Fields[key]
+		if !ok || len(value) > MaxIndexedMemoValueLength || !utf8.Valid(value) {
+			continue
+		}
+		if indexedMemo == nil {
+			indexedMemo = make(map[string]string)
+		}
+		indexedMemo[key] = string(value)
+	}
+	return indexedMemo
+}
+
+// GetIndexedMemoKeys parses the comma separated indexed memo keys declared for a domain,
+// at most MaxIndexedMemoKeys keys are returned
+func GetIndexedMemoKeys(keys string) []string {
+	var result []string
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if len(result) == MaxIndexedMemoKeys {
+			break
+		}
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	visibilityStoreSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestVisibilityStoreSuite(t *testing.T) {
+	s := new(visibilityStoreSuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *visibilityStoreSuite) TestGetIndexedMemoKeys() {
+	s.Nil(GetIndexedMemoKeys(""))
+	s.Equal([]string{"a", "b"}, GetIndexedMemoKeys(" a, ,b,"))
+	s.Equal([]string{"a", "b", "c"}, GetIndexedMemoKeys("a,b,c,d"))
+}
+
+func (s *visibilityStoreSuite) TestGetIndexedMemo() {
+	v := &visibilityManagerImpl{
+		indexedMemoKeys: func(domain string) string {
+			return "key1,key2,key3"
+		},
+	}
+	memo := &workflow.Memo{
+		Fields: map[string][]byte{
+			"key1":     []byte("value1"),
+			"key2":     []byte(strings.Repeat("a", MaxIndexedMemoValueLength+1)),
+			"key3":     {0xff, 0xfe},
+			"notIndex": []byte("value"),
+		},
+	}
+	s.Equal(map[string]string{"key1": "value1"}, v.getIndexedMemo("domain", memo))
+	s.Nil(v.getIndexedMemo("domain", nil))
+
+	v.indexedMemoKeys = nil
+	s.Nil(v.getIndexedMemo("domain", memo))
+}
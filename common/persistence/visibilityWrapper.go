@@ -100,12 +100,55 @@ func (v *visibilityManagerWrapper) RecordWorkflowExecutionClosed(request *Record
 	}
 }
 
+func (v *visibilityManagerWrapper) RecordWorkflowExecutionClosedBatch(request *RecordWorkflowExecutionClosedBatchRequest) error {
+	switch v.advancedVisWritingMode() {
+	case common.AdvancedVisibilityWritingModeOff:
+		return v.visibilityManager.RecordWorkflowExecutionClosedBatch(request)
+	case common.AdvancedVisibilityWritingModeOn:
+		return v.esVisibilityManager.RecordWorkflowExecutionClosedBatch(request)
+	case common.AdvancedVisibilityWritingModeDual:
+		if err := v.esVisibilityManager.RecordWorkflowExecutionClosedBatch(request); err != nil {
+			return err
+		}
+		return v.visibilityManager.RecordWorkflowExecutionClosedBatch(request)
+	default:
+		return &shared.InternalServiceError{
+			Message: fmt.Sprintf("Unknown advanced visibility writing mode: %s", v.advancedVisWritingMode()),
+		}
+	}
+}
+
+// UpsertWorkflowExecution only writes to advanced visibility, the DB visibility stores do not index search
+// attributes of open executions and pick up the latest ones when the execution is closed
 func (v *visibilityManagerWrapper) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
-	if v.esVisibilityManager == nil { // return operation not support
-		return v.visibilityManager.UpsertWorkflowExecution(request)
+	switch v.advancedVisWritingMode() {
+	case common.AdvancedVisibilityWritingModeOff:
+		return nil
+	case common.AdvancedVisibilityWritingModeOn, common.AdvancedVisibilityWritingModeDual:
+		return v.esVisibilityManager.UpsertWorkflowExecution(request)
+	default:
+		return &shared.InternalServiceError{
+			Message: fmt.Sprintf("Unknown advanced visibility writing mode: %s", v.advancedVisWritingMode()),
+		}
 	}
+}
 
-	return v.esVisibilityManager.UpsertWorkflowExecution(request)
+func (v *visibilityManagerWrapper) UpdateHistoryArchivalInfo(request *UpdateHistoryArchivalInfoRequest) error {
+	switch v.advancedVisWritingMode() {
+	case common.AdvancedVisibilityWritingModeOff:
+		return v.visibilityManager.UpdateHistoryArchivalInfo(request)
+	case common.AdvancedVisibilityWritingModeOn:
+		return v.esVisibilityManager.UpdateHistoryArchivalInfo(request)
+	case common.AdvancedVisibilityWritingModeDual:
+		if err := v.esVisibilityManager.UpdateHistoryArchivalInfo(request); err != nil {
+			return err
+		}
+		return v.visibilityManager.UpdateHistoryArchivalInfo(request)
+	default:
+		return &shared.InternalServiceError{
+			Message: fmt.Sprintf("Unknown advanced visibility writing mode: %s", v.advancedVisWritingMode()),
+		}
+	}
 }
 
 func (v *visibilityManagerWrapper) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -143,6 +186,11 @@ func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByStatus(request
 	return manager.ListClosedWorkflowExecutionsByStatus(request)
 }
 
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByMemo(request *ListClosedWorkflowExecutionsByMemoRequest) (*ListWorkflowExecutionsResponse, error) {
+	// memo is only indexed by basic visibility
+	return v.visibilityManager.ListClosedWorkflowExecutionsByMemo(request)
+}
+
 func (v *visibilityManagerWrapper) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	manager := v.chooseVisibilityManagerForDomain(request.Domain)
 	return manager.GetClosedWorkflowExecution(request)
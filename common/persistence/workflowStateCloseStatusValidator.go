@@ -65,6 +65,20 @@ func ValidateUpdateWorkflowStateCloseStatus(state int, closeStatus int) error {
 	return nil
 }
 
+// ValidateUpdateWorkflowModeState validate workflow update mode & workflow state
+func ValidateUpdateWorkflowModeState(mode UpdateWorkflowMode, state int) error {
+	// workflow which bypasses the current record cannot be a running workflow
+	if mode == UpdateWorkflowModeBypassCurrent &&
+		state != WorkflowStateZombie &&
+		state != WorkflowStateCompleted {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Update workflow with invalid mode: %v or state: %v",
+				mode, state),
+		}
+	}
+	return nil
+}
+
 // ToThriftWorkflowExecutionCloseStatus convert persistence representation of close status to thrift representation
 func ToThriftWorkflowExecutionCloseStatus(
 	closeStatus int,
@@ -42,6 +42,35 @@ func TestNewRateLimiter(t *testing.T) {
 	assert.Equal(t, _minBurst, limiter.Burst())
 }
 
+func TestDynamicRateLimiter_Refresh(t *testing.T) {
+	rps := float64(10)
+	rl := NewDynamicRateLimiter(func() float64 { return rps })
+
+	// increases are only picked up by Allow after the ttl expires
+	rps = 20
+	rl.Allow()
+	assert.Equal(t, float64(10), rl.rl.Limit())
+
+	rl.Refresh()
+	assert.Equal(t, float64(20), rl.rl.Limit())
+}
+
+func TestMultiStageRateLimiter_Refresh(t *testing.T) {
+	rps := float64(10)
+	domainRPS := float64(5)
+	policy := NewMultiStageRateLimiter(
+		func() float64 { return rps },
+		func(domain string) float64 { return domainRPS },
+	)
+	policy.Allow(Info{Domain: defaultDomain})
+
+	rps = 20
+	domainRPS = 15
+	policy.Refresh()
+	assert.Equal(t, float64(20), policy.globalLimiter.rl.Limit())
+	assert.Equal(t, float64(15), policy.domainLimiters[defaultDomain].rl.Limit())
+}
+
 func BenchmarkRateLimiter(b *testing.B) {
 	rps := float64(defaultRps)
 	limiter := NewRateLimiter(&rps, 2*time.Minute, defaultRps)
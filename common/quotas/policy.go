@@ -92,3 +92,14 @@ func (d *MultiStageRateLimiter) Allow(info Info) bool {
 	}
 	return true
 }
+
+// Refresh applies the current values of the dynamic config to the global
+// and all the domain rate limiters immediately
+func (d *MultiStageRateLimiter) Refresh() {
+	d.globalLimiter.Refresh()
+	d.RLock()
+	defer d.RUnlock()
+	for _, limiter := range d.domainLimiters {
+		limiter.Refresh()
+	}
+}
@@ -81,6 +81,20 @@ func (rl *RateLimiter) UpdateMaxDispatch(maxDispatchPerSecond *float64) {
 	}
 }
 
+// SetMaxDispatch updates the max dispatch rate of the rate limiter right away,
+// bypassing the ttl that otherwise delays increases of the rate
+func (rl *RateLimiter) SetMaxDispatch(maxDispatchPerSecond *float64) {
+	if maxDispatchPerSecond == nil {
+		return
+	}
+	rl.Lock()
+	defer rl.Unlock()
+	if *maxDispatchPerSecond != *rl.maxDispatchPerSecond {
+		rl.maxDispatchPerSecond = maxDispatchPerSecond
+		rl.storeLimiter(maxDispatchPerSecond)
+	}
+}
+
 // Wait waits up till deadline for a rate limit token
 func (rl *RateLimiter) Wait(ctx context.Context) error {
 	limiter := rl.globalLimiter.Load().(*rate.Limiter)
@@ -169,3 +183,10 @@ func (d *DynamicRateLimiter) Reserve() *rate.Reservation {
 	d.rl.UpdateMaxDispatch(&rps)
 	return d.rl.Reserve()
 }
+
+// Refresh applies the current value of the dynamic config to the rate limiter
+// immediately, it is meant to be called when the config is known to have changed
+func (d *DynamicRateLimiter) Refresh() {
+	rps := float64(d.rps())
+	d.rl.SetMaxDispatch(&rps)
+}
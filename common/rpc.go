@@ -47,6 +47,9 @@ const (
 	// to enforce DCRedirection(auto-forwarding)
 	// Will be removed in the future: https://github.com/uber/cadence/issues/2304
 	EnforceDCRedirection = "cadence-enforce-dc-redirection"
+	// DCRedirectionHopsHeaderName refers to the name of the header that contains
+	// the number of times a request has been forwarded between clusters by DC redirection
+	DCRedirectionHopsHeaderName = "cadence-dc-redirection-hops"
 )
 
 type (
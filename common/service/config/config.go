@@ -65,6 +65,14 @@ type (
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 		// DomainDefaults is the default config for every domain
 		DomainDefaults DomainDefaults `yaml:"domainDefaults"`
+		// TaskToken is the config for signing task tokens
+		TaskToken TaskToken `yaml:"taskToken"`
+		// AdmissionControl is the config for the admission policies of workflow starts
+		AdmissionControl AdmissionControl `yaml:"admissionControl"`
+		// Metering is the config for exporting the per domain usage of the services
+		Metering Metering `yaml:"metering"`
+		// IDGenerator is the config for generating the IDs of workflow runs
+		IDGenerator IDGenerator `yaml:"idGenerator"`
 	}
 
 	// Service contains the service specific config items
@@ -75,6 +83,18 @@ type (
 		Metrics Metrics `yaml:"metrics"`
 		// PProf is the PProf configuration
 		PProf PProf `yaml:"pprof"`
+		// HTTPGateway is the HTTP JSON gateway configuration, only applies to frontend
+		HTTPGateway HTTPGateway `yaml:"httpGateway"`
+	}
+
+	// HTTPGateway contains the config items for the HTTP JSON gateway
+	HTTPGateway struct {
+		// Port is the port on which the gateway will bind to, the gateway is disabled if not set
+		Port int `yaml:"port"`
+		// BindOnLocalHost is true if localhost is the bind address
+		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
+		// APIKeys is the list of API keys accepted by the gateway
+		APIKeys []string `yaml:"apiKeys"`
 	}
 
 	// PProf contains the rpc config items
@@ -87,6 +107,10 @@ type (
 	RPC struct {
 		// Port is the port  on which the channel will bind to
 		Port int `yaml:"port"`
+		// GRPCPort is the port on which the gRPC listener will bind to, serving the same
+		// procedures as the channel, including the protobuf WorkflowService of the frontend,
+		// so that clients can migrate incrementally, 0 disables gRPC
+		GRPCPort int `yaml:"grpcPort"`
 		// BindOnLocalHost is true if localhost is the bind address
 		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
 		// BindOnIP can be used to bind service on specific ip (eg. `0.0.0.0`) -
@@ -97,6 +121,17 @@ type (
 		DisableLogging bool `yaml:"disableLogging"`
 		// LogLevel is the desired log level
 		LogLevel string `yaml:"logLevel"`
+		// Compression is the config for compressing responses of inbound calls
+		Compression RPCCompression `yaml:"compression"`
+	}
+
+	// RPCCompression contains the config for compressing rpc responses, responses are
+	// only compressed for callers which accept a supported codec via request header
+	RPCCompression struct {
+		// Enabled is true if responses should be compressed
+		Enabled bool `yaml:"enabled"`
+		// MinResponseSize is the min size in bytes of the response body to be compressed
+		MinResponseSize int `yaml:"minResponseSize"`
 	}
 
 	// Ringpop contains the ringpop config items
@@ -135,6 +170,8 @@ type (
 		VisibilityConfig *VisibilityConfig
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn
+		// SlowQueryConfig is config for logging slow and large persistence operations
+		SlowQueryConfig *SlowQueryConfig
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -165,6 +202,18 @@ type (
 		MaxQPS dynamicconfig.IntPropertyFn
 		// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 		ValidSearchAttributes dynamicconfig.MapPropertyFn
+		// IndexedMemoKeys is the comma separated memo keys of a domain that basic visibility indexes for filtering
+		IndexedMemoKeys dynamicconfig.StringPropertyFnWithDomainFilter
+	}
+
+	// SlowQueryConfig is config for logging slow and large persistence operations
+	SlowQueryConfig struct {
+		// LatencyThreshold is the latency above which an operation is logged
+		LatencyThreshold dynamicconfig.DurationPropertyFn
+		// SizeThreshold is the size in bytes of mutable state / history above which an operation is logged
+		SizeThreshold dynamicconfig.IntPropertyFn
+		// LogSampleRate is the rate at which slow or large operations are logged
+		LogSampleRate dynamicconfig.FloatPropertyFn
 	}
 
 	// Cassandra contains configuration to connect to Cassandra cluster
@@ -187,6 +236,30 @@ type (
 		MaxQPS int `yaml:"maxQPS"`
 		// MaxConns is the max number of connections to this datastore for a single keyspace
 		MaxConns int `yaml:"maxConns"`
+		// HistoryHedgedReads enables hedged reads of history nodes when set
+		HistoryHedgedReads *HedgedReads `yaml:"historyHedgedReads"`
+		// ExecutionsKeyspace is the keyspace of the shards and executions, defaults to Keyspace
+		ExecutionsKeyspace string `yaml:"executionsKeyspace"`
+		// Resharding enables reading shards and executions stored for a different number of history shards
+		Resharding *Resharding `yaml:"resharding"`
+	}
+
+	// HedgedReads is the configuration for duplicating slow reads to another coordinator
+	HedgedReads struct {
+		// LatencyPercentile is the percentile of recent read latencies after which a read is hedged, e.g. 0.95
+		LatencyPercentile float64 `yaml:"latencyPercentile"`
+		// MinDelay is the minimum time to wait before a read is hedged
+		MinDelay time.Duration `yaml:"minDelay"`
+	}
+
+	// Resharding is the configuration for moving executions to a different number of history shards while
+	// the cluster is running. Shards and executions missing from the executions keyspace are read from the
+	// old layout and moved to the executions keyspace on first access
+	Resharding struct {
+		// Keyspace holds the executions in the old layout, it must differ from the executions keyspace
+		Keyspace string `yaml:"keyspace" validate:"nonzero"`
+		// NumHistoryShards is the number of history shards of the old layout
+		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
 	}
 
 	// SQL is the configuration for connecting to a SQL backed datastore
@@ -255,6 +328,25 @@ type (
 		RPCName string `yaml:"rpcName"`
 		// Address indicate the remote service address(Host:Port). Host can be DNS name.
 		RPCAddress string `yaml:"rpcAddress"`
+		// PublicRPCAddress is the frontend address(Host:Port) of the cluster advertised to clients,
+		// e.g. so that SDKs can re-target the active cluster of a domain after a failover
+		PublicRPCAddress string `yaml:"publicRPCAddress"`
+		// RPCTimeout is the timeout of non long poll calls to the remote cluster, client default is used if not set
+		RPCTimeout time.Duration `yaml:"rpcTimeout"`
+		// RPCLongPollTimeout is the timeout of long poll calls to the remote cluster, client default is used if not set
+		RPCLongPollTimeout time.Duration `yaml:"rpcLongPollTimeout"`
+		// RPCConnectionPoolSize is the number of connections opened to the remote cluster, defaults to 1
+		RPCConnectionPoolSize int `yaml:"rpcConnectionPoolSize"`
+		// CircuitBreaker is the config of the circuit breaker guarding calls to the remote cluster
+		CircuitBreaker CircuitBreakerConfig `yaml:"circuitBreaker"`
+	}
+
+	// CircuitBreakerConfig contains the config for a circuit breaker
+	CircuitBreakerConfig struct {
+		// FailureThreshold is the number of consecutive failures which opens the circuit, defaults to 10
+		FailureThreshold int `yaml:"failureThreshold"`
+		// ResetTimeout is how long the circuit stays open before a probe call is allowed, defaults to 10s
+		ResetTimeout time.Duration `yaml:"resetTimeout"`
 	}
 
 	// ReplicationConsumerConfig contains config for replication consumer
@@ -286,6 +378,8 @@ type (
 	DCRedirectionPolicy struct {
 		Policy string `yaml:"policy"`
 		ToDC   string `yaml:"toDC"`
+		// ShadowPolicy is the policy evaluated, but not enforced, when Policy is "shadow"
+		ShadowPolicy string `yaml:"shadowPolicy"`
 	}
 
 	// Metrics contains the config items for metrics subsystem
@@ -337,6 +431,10 @@ type (
 	// HistoryArchiverProvider contains the config for all history archivers
 	HistoryArchiverProvider struct {
 		Filestore *FilestoreArchiver `yaml:"filestore"`
+		GCStorage *GCStorageArchiver `yaml:"gcstorage"`
+		AzureBlob *AzureBlobArchiver `yaml:"azureblob"`
+		// Encryption is the config for encrypting archived histories per domain
+		Encryption *ArchiverEncryption `yaml:"encryption"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -352,6 +450,9 @@ type (
 	// VisibilityArchiverProvider contains the config for all visibility archivers
 	VisibilityArchiverProvider struct {
 		Filestore *FilestoreArchiver `yaml:"filestore"`
+		AzureBlob *AzureBlobArchiver `yaml:"azureblob"`
+		// Encryption is the config for encrypting archived visibility records per domain
+		Encryption *ArchiverEncryption `yaml:"encryption"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -360,6 +461,52 @@ type (
 		DirMode  string `yaml:"dirMode"`
 	}
 
+	// GCStorageArchiver contains the config for the Google Cloud Storage archiver
+	GCStorageArchiver struct {
+		// CredentialsPath is the path of the service account key file, the application default credentials are used if empty
+		CredentialsPath string `yaml:"credentialsPath"`
+		// ChunkSize is the size in bytes of each request of a resumable upload, the client library default is used if 0
+		ChunkSize int `yaml:"chunkSize"`
+	}
+
+	// AzureBlobArchiver contains the config for the Azure Blob Storage archiver
+	AzureBlobArchiver struct {
+		// AccountName is the name of the storage account holding the containers of the archival URIs
+		AccountName string `yaml:"accountName"`
+		// AccountKey is the shared key of the storage account
+		AccountKey string `yaml:"accountKey"`
+		// Endpoint is the blob service endpoint, defaults to https://<accountName>.blob.core.windows.net
+		Endpoint string `yaml:"endpoint"`
+		// MaxTries is the maximum number of attempts of each request to the blob service, the SDK default is used if 0
+		MaxTries int32 `yaml:"maxTries"`
+		// BlockSize is the size in bytes of each block of an upload, blobs up to 256MB are uploaded in a single request if 0
+		BlockSize int64 `yaml:"blockSize"`
+	}
+
+	// ArchiverEncryption contains the config for encrypting archived blobs per domain
+	ArchiverEncryption struct {
+		// Keys maps key ID to the config of the key, a key must be kept as long as
+		// archived blobs encrypted with it are retained, even if no domain uses it anymore
+		Keys map[string]ArchiverEncryptionKey `yaml:"keys"`
+		// Domains maps domain name to the encryption config of its archived blobs,
+		// blobs of domains which are not listed are archived unencrypted
+		Domains map[string]ArchiverDomainEncryption `yaml:"domains"`
+	}
+
+	// ArchiverEncryptionKey contains the config for an archiver encryption key
+	ArchiverEncryptionKey struct {
+		// KeyFile is the path of the file containing the base64 encoded key
+		KeyFile string `yaml:"keyFile"`
+	}
+
+	// ArchiverDomainEncryption contains the encryption config of a domain's archived blobs
+	ArchiverDomainEncryption struct {
+		// Scheme is the encryption scheme, only "aes256-gcm" is supported
+		Scheme string `yaml:"scheme"`
+		// KeyID is the ID of the key in ArchiverEncryption.Keys new blobs are encrypted with
+		KeyID string `yaml:"keyID"`
+	}
+
 	// PublicClient is config for connecting to cadence frontend
 	PublicClient struct {
 		// HostPort is the host port to connect on. Host can be DNS name
@@ -368,6 +515,44 @@ type (
 		RefreshInterval time.Duration `yaml:"RefreshInterval"`
 	}
 
+	// TaskToken is the config for signing task tokens
+	TaskToken struct {
+		// SigningKey is the cluster secret the task tokens are signed with, tokens are not signed if empty
+		SigningKey string `yaml:"signingKey"`
+	}
+
+	// IDGenerator is the config for generating the IDs of workflow runs
+	IDGenerator struct {
+		// RunID is the type of run IDs, either "uuid" (the default) or "sortable" for
+		// UUID formatted IDs which sort by creation time
+		RunID string `yaml:"runID"`
+	}
+
+	// AdmissionControl is the config for the admission policies the frontend evaluates on
+	// StartWorkflowExecution and SignalWithStartWorkflowExecution
+	AdmissionControl struct {
+		// Policies is the list of compiled-in admission policies to evaluate, in order
+		Policies []AdmissionPolicy `yaml:"policies"`
+	}
+
+	// Metering contains the config for exporting usage records
+	Metering struct {
+		// Sink is the sink usage records are exported to, either "file" or "kafka",
+		// metering is disabled if not set. The kafka sink publishes to the topic of
+		// the "metering" kafka application
+		Sink string `yaml:"sink"`
+		// FilePath is the file the file sink appends usage records to
+		FilePath string `yaml:"filePath"`
+	}
+
+	// AdmissionPolicy is the config for an admission policy
+	AdmissionPolicy struct {
+		// Name is the name the policy is registered with
+		Name string `yaml:"name"`
+		// Options are passed to the policy when it is created
+		Options map[string]string `yaml:"options"`
+	}
+
 	// DomainDefaults is the default config for each domain
 	DomainDefaults struct {
 		// Archival is the default archival config for each domain
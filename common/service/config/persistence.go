@@ -67,10 +67,26 @@ func (c *Persistence) Validate() error {
 		if ds.SQL != nil && ds.SQL.NumShards == 0 {
 			ds.SQL.NumShards = 1
 		}
+		if ds.Cassandra != nil && ds.Cassandra.Resharding != nil {
+			if ds.Cassandra.Resharding.Keyspace == ds.Cassandra.GetExecutionsKeyspace() {
+				return fmt.Errorf("persistence config: datastore %v: resharding keyspace must differ from the executions keyspace", st)
+			}
+			if ds.Cassandra.Resharding.NumHistoryShards <= 0 {
+				return fmt.Errorf("persistence config: datastore %v: resharding numHistoryShards must be positive", st)
+			}
+		}
 	}
 	return nil
 }
 
+// GetExecutionsKeyspace returns the keyspace of the shards and executions
+func (c *Cassandra) GetExecutionsKeyspace() string {
+	if c.ExecutionsKeyspace != "" {
+		return c.ExecutionsKeyspace
+	}
+	return c.Keyspace
+}
+
 // IsAdvancedVisibilityConfigExist returns whether user specified advancedVisibilityStore in config
 func (c *Persistence) IsAdvancedVisibilityConfigExist() bool {
 	return len(c.AdvancedVisibilityStore) != 0
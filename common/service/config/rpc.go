@@ -24,9 +24,12 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/uber/cadence/common/compression"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -61,12 +64,38 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 		d.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
 	d.logger.Info("Created RPC dispatcher and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.GRPCPort != 0 {
+		inbounds = append(inbounds, d.createGRPCInbound())
+	}
+	var inboundMiddleware yarpc.InboundMiddleware
+	if d.config.Compression.Enabled {
+		minResponseSize := d.config.Compression.MinResponseSize
+		if minResponseSize <= 0 {
+			minResponseSize = compression.DefaultMinResponseSize
+		}
+		inboundMiddleware.Unary = compression.NewInboundMiddleware(minResponseSize)
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
-		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Name:              d.serviceName,
+		Inbounds:          inbounds,
+		InboundMiddleware: inboundMiddleware,
 	})
 }
 
+func (d *RPCFactory) createGRPCInbound() transport.Inbound {
+	if d.config.GRPCPort == d.config.Port {
+		d.logger.Fatal("Failed to create gRPC inbound, grpcPort and port must be different", tag.Port(d.config.GRPCPort))
+	}
+	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		d.logger.Fatal("Failed to listen on gRPC port", tag.Error(err))
+	}
+	d.logger.Info("Created gRPC inbound and listening", tag.Service(d.serviceName), tag.Address(hostAddress))
+	return grpc.NewTransport().NewInbound(listener)
+}
+
 // CreateDispatcherForOutbound creates a dispatcher for outbound connection
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
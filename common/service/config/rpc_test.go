@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/encoding/raw"
+	"go.uber.org/yarpc/transport/grpc"
+)
+
+func TestCreateDispatcher_GRPC(t *testing.T) {
+	rpcConfig := &RPC{
+		Port:            getFreePort(t),
+		GRPCPort:        getFreePort(t),
+		BindOnLocalHost: true,
+	}
+	dispatcher := rpcConfig.NewFactory("test-service", loggerimpl.NewNopLogger()).CreateDispatcher()
+	dispatcher.Register(raw.Procedure("echo", func(ctx context.Context, body []byte) ([]byte, error) {
+		return body, nil
+	}))
+	require.NoError(t, dispatcher.Start())
+	defer dispatcher.Stop()
+
+	clientDispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: "test-client",
+		Outbounds: yarpc.Outbounds{
+			"test-service": {
+				Unary: grpc.NewTransport().NewSingleOutbound(fmt.Sprintf("127.0.0.1:%v", rpcConfig.GRPCPort)),
+			},
+		},
+	})
+	require.NoError(t, clientDispatcher.Start())
+	defer clientDispatcher.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := raw.New(clientDispatcher.ClientConfig("test-service")).Call(ctx, "echo", []byte("hello"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), response)
+}
+
+func getFreePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
@@ -102,8 +102,12 @@ type BoolPropertyFnWithDomainFilter func(domain string) bool
 // BoolPropertyFnWithTaskListInfoFilters is a wrapper to get bool property from dynamic config with three filters: domain, taskList, taskType
 type BoolPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) bool
 
+// BoolPropertyFnWithShardIDFilter is a wrapper to get bool property from dynamic config with shardID as filter
+type BoolPropertyFnWithShardIDFilter func(shardID int) bool
+
 // GetProperty gets a interface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
+	c.register(key, ValueTypeUnknown, defaultValue)
 	return func() interface{} {
 		val, err := c.client.GetValue(key, defaultValue)
 		if err != nil {
@@ -125,6 +129,7 @@ func getFilterMap(opts ...FilterOption) map[Filter]interface{} {
 
 // GetIntProperty gets property and asserts that it's an integer
 func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
+	c.register(key, ValueTypeInt, defaultValue)
 	return func(opts ...FilterOption) int {
 		val, err := c.client.GetIntValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -137,6 +142,7 @@ func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
 
 // GetIntPropertyFilteredByDomain gets property with domain filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByDomain(key Key, defaultValue int) IntPropertyFnWithDomainFilter {
+	c.register(key, ValueTypeInt, defaultValue, DomainName)
 	return func(domain string) int {
 		val, err := c.client.GetIntValue(key, getFilterMap(DomainFilter(domain)), defaultValue)
 		if err != nil {
@@ -149,6 +155,7 @@ func (c *Collection) GetIntPropertyFilteredByDomain(key Key, defaultValue int) I
 
 // GetIntPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByTaskListInfo(key Key, defaultValue int) IntPropertyFnWithTaskListInfoFilters {
+	c.register(key, ValueTypeInt, defaultValue, DomainName, TaskListName, TaskType)
 	return func(domain string, taskList string, taskType int) int {
 		val, err := c.client.GetIntValue(
 			key,
@@ -165,6 +172,7 @@ func (c *Collection) GetIntPropertyFilteredByTaskListInfo(key Key, defaultValue
 
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatPropertyFn {
+	c.register(key, ValueTypeFloat, defaultValue)
 	return func(opts ...FilterOption) float64 {
 		val, err := c.client.GetFloatValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -177,6 +185,7 @@ func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatProp
 
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
+	c.register(key, ValueTypeDuration, defaultValue)
 	return func(opts ...FilterOption) time.Duration {
 		val, err := c.client.GetDurationValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -189,6 +198,7 @@ func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) Du
 
 // GetDurationPropertyFilteredByDomain gets property with domain filter and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByDomain(key Key, defaultValue time.Duration) DurationPropertyFnWithDomainFilter {
+	c.register(key, ValueTypeDuration, defaultValue, DomainName)
 	return func(domain string) time.Duration {
 		val, err := c.client.GetDurationValue(key, getFilterMap(DomainFilter(domain)), defaultValue)
 		if err != nil {
@@ -201,6 +211,7 @@ func (c *Collection) GetDurationPropertyFilteredByDomain(key Key, defaultValue t
 
 // GetDurationPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a duration
 func (c *Collection) GetDurationPropertyFilteredByTaskListInfo(key Key, defaultValue time.Duration) DurationPropertyFnWithTaskListInfoFilters {
+	c.register(key, ValueTypeDuration, defaultValue, DomainName, TaskListName, TaskType)
 	return func(domain string, taskList string, taskType int) time.Duration {
 		val, err := c.client.GetDurationValue(
 			key,
@@ -217,6 +228,7 @@ func (c *Collection) GetDurationPropertyFilteredByTaskListInfo(key Key, defaultV
 
 // GetBoolProperty gets property and asserts that it's an bool
 func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn {
+	c.register(key, ValueTypeBool, defaultValue)
 	return func(opts ...FilterOption) bool {
 		val, err := c.client.GetBoolValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -229,6 +241,7 @@ func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn
 
 // GetStringProperty gets property and asserts that it's an string
 func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPropertyFn {
+	c.register(key, ValueTypeString, defaultValue)
 	return func(opts ...FilterOption) string {
 		val, err := c.client.GetStringValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -241,6 +254,7 @@ func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPrope
 
 // GetMapProperty gets property and asserts that it's a map
 func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}) MapPropertyFn {
+	c.register(key, ValueTypeMap, defaultValue)
 	return func(opts ...FilterOption) map[string]interface{} {
 		val, err := c.client.GetMapValue(key, getFilterMap(opts...), defaultValue)
 		if err != nil {
@@ -275,6 +289,7 @@ func mapToString(inputMap map[string]interface{}) string {
 
 // GetStringPropertyFnWithDomainFilter gets property with domain filter and asserts that its domain
 func (c *Collection) GetStringPropertyFnWithDomainFilter(key Key, defaultValue string) StringPropertyFnWithDomainFilter {
+	c.register(key, ValueTypeString, defaultValue, DomainName)
 	return func(domain string) string {
 		val, err := c.client.GetStringValue(key, getFilterMap(DomainFilter(domain)), defaultValue)
 		if err != nil {
@@ -287,6 +302,7 @@ func (c *Collection) GetStringPropertyFnWithDomainFilter(key Key, defaultValue s
 
 // GetBoolPropertyFnWithDomainFilter gets property with domain filter and asserts that its domain
 func (c *Collection) GetBoolPropertyFnWithDomainFilter(key Key, defaultValue bool) BoolPropertyFnWithDomainFilter {
+	c.register(key, ValueTypeBool, defaultValue, DomainName)
 	return func(domain string) bool {
 		val, err := c.client.GetBoolValue(key, getFilterMap(DomainFilter(domain)), defaultValue)
 		if err != nil {
@@ -299,6 +315,7 @@ func (c *Collection) GetBoolPropertyFnWithDomainFilter(key Key, defaultValue boo
 
 // GetBoolPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's an bool
 func (c *Collection) GetBoolPropertyFilteredByTaskListInfo(key Key, defaultValue bool) BoolPropertyFnWithTaskListInfoFilters {
+	c.register(key, ValueTypeBool, defaultValue, DomainName, TaskListName, TaskType)
 	return func(domain string, taskList string, taskType int) bool {
 		val, err := c.client.GetBoolValue(
 			key,
@@ -312,3 +329,16 @@ func (c *Collection) GetBoolPropertyFilteredByTaskListInfo(key Key, defaultValue
 		return val
 	}
 }
+
+// GetBoolPropertyFilteredByShardID gets property with shardID filter and asserts that it's a bool
+func (c *Collection) GetBoolPropertyFilteredByShardID(key Key, defaultValue bool) BoolPropertyFnWithShardIDFilter {
+	c.register(key, ValueTypeBool, defaultValue, ShardID)
+	return func(shardID int) bool {
+		val, err := c.client.GetBoolValue(key, getFilterMap(ShardIDFilter(shardID)), defaultValue)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		c.logValue(key, val, defaultValue)
+		return val
+	}
+}
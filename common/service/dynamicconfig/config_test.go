@@ -160,6 +160,15 @@ func (s *configSuite) TestGetIntPropertyFilteredByDomain() {
 	s.Equal(50, value(domain))
 }
 
+func (s *configSuite) TestGetBoolPropertyFilteredByShardID() {
+	key := testGetBoolPropertyFilteredByShardIDKey
+	shardID := 1
+	value := s.cln.GetBoolPropertyFilteredByShardID(key, true)
+	s.Equal(true, value(shardID))
+	s.client.SetValue(key, false)
+	s.Equal(false, value(shardID))
+}
+
 func (s *configSuite) TestGetStringPropertyFnWithDomainFilter() {
 	key := DefaultEventEncoding
 	domain := "testDomain"
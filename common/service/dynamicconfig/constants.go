@@ -48,6 +48,7 @@ var keys = map[Key]string{
 	testGetIntPropertyFilteredByTaskListInfoKey:      "testGetIntPropertyFilteredByTaskListInfoKey",
 	testGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
 	testGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
+	testGetBoolPropertyFilteredByShardIDKey:          "testGetBoolPropertyFilteredByShardIDKey",
 
 	// system settings
 	EnableGlobalDomain:                  "system.enableGlobalDomain",
@@ -61,11 +62,29 @@ var keys = map[Key]string{
 	VisibilityArchivalStatus:            "system.visibilityArchivalStatus",
 	EnableReadFromVisibilityArchival:    "system.enableReadFromVisibilityArchival",
 	EnableDomainNotActiveAutoForwarding: "system.enableDomainNotActiveAutoForwarding",
+	DCRedirectionForwardNonWorkerAPIs:   "system.dcRedirectionForwardNonWorkerAPIs",
 	TransactionSizeLimit:                "system.transactionSizeLimit",
 	MinRetentionDays:                    "system.minRetentionDays",
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
 	EnableBatcher:                       "worker.enableBatcher",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
+	ActivityTypeMetricsAllowlist:        "system.activityTypeMetricsAllowlist",
+	WorkflowTypeMetricsAllowlist:        "system.workflowTypeMetricsAllowlist",
+	DomainProcessingPaused:              "system.domainProcessingPaused",
+	VisibilityTieringAgeDays:            "system.visibilityTieringAgeDays",
+	VisibilityIndexedMemoKeys:           "system.visibilityIndexedMemoKeys",
+	ExecutionsReportTopK:                "system.executionsReportTopK",
+	MeteringExportInterval:              "system.meteringExportInterval",
+	PersistenceSlowQueryThreshold:       "system.persistenceSlowQueryThreshold",
+	PersistenceLargeRowSizeThreshold:    "system.persistenceLargeRowSizeThreshold",
+	PersistenceSlowQueryLogSampleRate:   "system.persistenceSlowQueryLogSampleRate",
+	RejectPollsOnPassiveDomain:          "system.rejectPollsOnPassiveDomain",
+	EnableLoadShedding:                  "system.enableLoadShedding",
+	OverloadLatencyThreshold:            "system.overloadLatencyThreshold",
+	OverloadErrorRatioThreshold:         "system.overloadErrorRatioThreshold",
+	OverloadEvaluationInterval:          "system.overloadEvaluationInterval",
+	OverloadGlobalLowPriorityRPS:        "system.overloadGlobalLowPriorityRPS",
+	EnforceKnownTaskLists:               "system.enforceKnownTaskLists",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -76,24 +95,44 @@ var keys = map[Key]string{
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
+	LocalActivityMarkerCountLimitPerDecision: "limit.localActivityMarkerCountPerDecision",
+	LocalActivityMarkerSizeLimitPerDecision:  "limit.localActivityMarkerSizePerDecision",
+
 	// frontend settings
-	FrontendPersistenceMaxQPS:         "frontend.persistenceMaxQPS",
-	FrontendVisibilityMaxPageSize:     "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:      "frontend.visibilityListMaxQPS",
-	FrontendESVisibilityListMaxQPS:    "frontend.esVisibilityListMaxQPS",
-	FrontendMaxBadBinaries:            "frontend.maxBadBinaries",
-	FrontendESIndexMaxResultWindow:    "frontend.esIndexMaxResultWindow",
-	FrontendHistoryMaxPageSize:        "frontend.historyMaxPageSize",
-	FrontendRPS:                       "frontend.rps",
-	FrontendDomainRPS:                 "frontend.domainrps",
-	FrontendHistoryMgrNumConns:        "frontend.historyMgrNumConns",
-	DisableListVisibilityByFilter:     "frontend.disableListVisibilityByFilter",
-	FrontendThrottledLogRPS:           "frontend.throttledLogRPS",
-	EnableClientVersionCheck:          "frontend.enableClientVersionCheck",
-	ValidSearchAttributes:             "frontend.validSearchAttributes",
-	SearchAttributesNumberOfKeysLimit: "frontend.searchAttributesNumberOfKeysLimit",
-	SearchAttributesSizeOfValueLimit:  "frontend.searchAttributesSizeOfValueLimit",
-	SearchAttributesTotalSizeLimit:    "frontend.searchAttributesTotalSizeLimit",
+	FrontendPersistenceMaxQPS:                  "frontend.persistenceMaxQPS",
+	FrontendVisibilityMaxPageSize:              "frontend.visibilityMaxPageSize",
+	FrontendVisibilityListMaxQPS:               "frontend.visibilityListMaxQPS",
+	FrontendESVisibilityListMaxQPS:             "frontend.esVisibilityListMaxQPS",
+	FrontendMaxBadBinaries:                     "frontend.maxBadBinaries",
+	FrontendESIndexMaxResultWindow:             "frontend.esIndexMaxResultWindow",
+	FrontendESQueryMaxPageSize:                 "frontend.esQueryMaxPageSize",
+	FrontendESQueryMaxBooleanClauses:           "frontend.esQueryMaxBooleanClauses",
+	FrontendESQueryMaxTimeRange:                "frontend.esQueryMaxTimeRange",
+	FrontendHistoryMaxPageSize:                 "frontend.historyMaxPageSize",
+	FrontendExecutionChainMaxRuns:              "frontend.executionChainMaxRuns",
+	FrontendBatchDescribeMaxExecutions:         "frontend.batchDescribeMaxExecutions",
+	FrontendBatchDescribeConcurrency:           "frontend.batchDescribeConcurrency",
+	FrontendRPS:                                "frontend.rps",
+	FrontendDomainRPS:                          "frontend.domainrps",
+	FrontendHistoryMgrNumConns:                 "frontend.historyMgrNumConns",
+	DisableListVisibilityByFilter:              "frontend.disableListVisibilityByFilter",
+	FrontendThrottledLogRPS:                    "frontend.throttledLogRPS",
+	EnableClientVersionCheck:                   "frontend.enableClientVersionCheck",
+	ValidSearchAttributes:                      "frontend.validSearchAttributes",
+	SearchAttributesNumberOfKeysLimit:          "frontend.searchAttributesNumberOfKeysLimit",
+	SearchAttributesSizeOfValueLimit:           "frontend.searchAttributesSizeOfValueLimit",
+	SearchAttributesTotalSizeLimit:             "frontend.searchAttributesTotalSizeLimit",
+	FrontendFailoverReadinessMaxReplicationLag: "frontend.failoverReadinessMaxReplicationLag",
+	FrontendDCRedirectionMaxHops:               "frontend.dcRedirectionMaxHops",
+	FrontendDCRedirectionMaxRetryAttempts:      "frontend.dcRedirectionMaxRetryAttempts",
+	FrontendEnableHeartbeatBatching:            "frontend.enableHeartbeatBatching",
+	FrontendHeartbeatBatchingWindow:            "frontend.heartbeatBatchingWindow",
+	FrontendHeartbeatBatchMaxSize:              "frontend.heartbeatBatchMaxSize",
+	FrontendMaxConcurrentPollsPerHost:          "frontend.maxConcurrentPollsPerHost",
+	FrontendMaxConcurrentPollsPerDomain:        "frontend.maxConcurrentPollsPerDomain",
+	FrontendEnforceTaskTokenSignature:          "frontend.enforceTaskTokenSignature",
+	FrontendAllowedAPIs:                        "frontend.allowedAPIs",
+	FrontendDeniedAPIs:                         "frontend.deniedAPIs",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -101,6 +140,7 @@ var keys = map[Key]string{
 	MatchingMinTaskThrottlingBurstSize:      "matching.minTaskThrottlingBurstSize",
 	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
 	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
+	MatchingIncompatiblePollBackoff:         "matching.incompatiblePollBackoff",
 	MatchingEnableSyncMatch:                 "matching.enableSyncMatch",
 	MatchingUpdateAckInterval:               "matching.updateAckInterval",
 	MatchingIdleTasklistCheckInterval:       "matching.idleTasklistCheckInterval",
@@ -115,6 +155,22 @@ var keys = map[Key]string{
 	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
+	MatchingWorkerTaskListLivenessTimeout:   "matching.workerTaskListLivenessTimeout",
+	MatchingNumTaskPriorities:               "matching.numTaskPriorities",
+	MatchingTaskPriorityDispatchRatio:       "matching.taskPriorityDispatchRatio",
+	MatchingEnableWorkflowAffinity:          "matching.enableWorkflowAffinity",
+	MatchingWorkflowAffinityCacheSize:       "matching.workflowAffinityCacheSize",
+	MatchingTaskListDrained:                 "matching.taskListDrained",
+	MatchingStaleWorkerThreshold:            "matching.staleWorkerThreshold",
+	MatchingEnableAdaptiveScaler:            "matching.enableAdaptiveScaler",
+	MatchingAdaptiveScalerUpdateInterval:    "matching.adaptiveScalerUpdateInterval",
+	MatchingPartitionUpscaleRPS:             "matching.partitionUpscaleRPS",
+	MatchingPartitionDownscaleRPS:           "matching.partitionDownscaleRPS",
+	MatchingUpscaleSustainedPeriod:          "matching.partitionUpscaleSustainedPeriod",
+	MatchingDownscaleSustainedPeriod:        "matching.partitionDownscaleSustainedPeriod",
+	MatchingAdaptiveScalerMinPartitions:     "matching.adaptiveScalerMinPartitions",
+	MatchingAdaptiveScalerMaxPartitions:     "matching.adaptiveScalerMaxPartitions",
+	MatchingPartitionConfigRefreshInterval:  "matching.partitionConfigRefreshInterval",
 
 	// history settings
 	HistoryRPS:                                            "history.rps",
@@ -122,13 +178,19 @@ var keys = map[Key]string{
 	HistoryVisibilityOpenMaxQPS:                           "history.historyVisibilityOpenMaxQPS",
 	HistoryVisibilityClosedMaxQPS:                         "history.historyVisibilityClosedMaxQPS",
 	HistoryLongPollExpirationInterval:                     "history.longPollExpirationInterval",
+	HistoryMaxActivityCancellationWait:                    "history.maxActivityCancellationWait",
 	HistoryCacheInitialSize:                               "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                             "history.historyMaxAutoResetPoints",
 	HistoryCacheMaxSize:                                   "history.cacheMaxSize",
 	HistoryCacheTTL:                                       "history.cacheTTL",
+	HistoryCacheShardCount:                                "history.cacheShardCount",
 	EventsCacheInitialSize:                                "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                    "history.eventsCacheMaxSize",
+	EventsCacheMaxSizeInBytes:                             "history.eventsCacheMaxSizeInBytes",
 	EventsCacheTTL:                                        "history.eventsCacheTTL",
+	EventsCacheShardCount:                                 "history.eventsCacheShardCount",
+	DomainCacheMemoryLowWatermarkInBytes:                  "history.domainCacheMemoryLowWatermarkInBytes",
+	DomainCacheMemoryHighWatermarkInBytes:                 "history.domainCacheMemoryHighWatermarkInBytes",
 	AcquireShardInterval:                                  "history.acquireShardInterval",
 	StandbyClusterDelay:                                   "history.standbyClusterDelay",
 	TimerTaskBatchSize:                                    "history.timerTaskBatchSize",
@@ -145,6 +207,10 @@ var keys = map[Key]string{
 	TimerProcessorMaxPollInterval:                         "history.timerProcessorMaxPollInterval",
 	TimerProcessorMaxPollIntervalJitterCoefficient:        "history.timerProcessorMaxPollIntervalJitterCoefficient",
 	TimerProcessorMaxTimeShift:                            "history.timerProcessorMaxTimeShift",
+	TimerProcessorEnableTimerWheel:                        "history.timerProcessorEnableTimerWheel",
+	TimerProcessorTimerWheelTick:                          "history.timerProcessorTimerWheelTick",
+	TimerProcessorLookAheadWindow:                         "history.timerProcessorLookAheadWindow",
+	EnableTimerTaskCoalescing:                             "history.enableTimerTaskCoalescing",
 	TimerProcessorHistoryArchivalSizeLimit:                "history.timerProcessorHistoryArchivalSizeLimit",
 	TimerProcessorArchivalTimeLimit:                       "history.TimerProcessorArchivalTimeLimit",
 	TransferTaskBatchSize:                                 "history.transferTaskBatchSize",
@@ -169,6 +235,7 @@ var keys = map[Key]string{
 	ReplicatorProcessorMaxPollIntervalJitterCoefficient:   "history.replicatorProcessorMaxPollIntervalJitterCoefficient",
 	ReplicatorProcessorUpdateAckInterval:                  "history.replicatorProcessorUpdateAckInterval",
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: "history.replicatorProcessorUpdateAckIntervalJitterCoefficient",
+	ReplicationTaskProcessorParallelism:                   "history.replicationTaskProcessorParallelism",
 	ExecutionMgrNumConns:                                  "history.executionMgrNumConns",
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
@@ -184,10 +251,24 @@ var keys = map[Key]string{
 	ArchiveRequestRPS:                                     "history.archiveRequestRPS",
 	EmitShardDiffLog:                                      "history.emitShardDiffLog",
 	HistoryThrottledLogRPS:                                "history.throttledLogRPS",
+	HistoryHeartbeatBatchConcurrency:                      "history.heartbeatBatchConcurrency",
 	StickyTTL:                                             "history.stickyTTL",
 	DecisionHeartbeatTimeout:                              "history.decisionHeartbeatTimeout",
+	EnableDecisionBinaryChecksumCheck:                     "history.enableDecisionBinaryChecksumCheck",
+	CompatibleDecisionBinaryChecksums:                     "history.compatibleDecisionBinaryChecksums",
+	NoPollersWarningThreshold:                             "history.noPollersWarningThreshold",
+	NoPollersWarningTTL:                                   "history.noPollersWarningTTL",
 	ParentClosePolicyThreshold:                            "history.parentClosePolicyThreshold",
 	NumParentClosePolicySystemWorkflows:                   "history.numParentClosePolicySystemWorkflows",
+	EnableMutableStateSnapshot:                            "history.enableMutableStateSnapshot",
+	MutableStateSnapshotThreshold:                         "history.mutableStateSnapshotThreshold",
+	EnableShardUpdateBatching:                             "history.enableShardUpdateBatching",
+	ShardUpdateBatchingWindow:                             "history.shardUpdateBatchingWindow",
+	ShardUpdateBatchMaxSize:                               "history.shardUpdateBatchMaxSize",
+	EnableVisibilityCloseBatching:                         "history.enableVisibilityCloseBatching",
+	VisibilityCloseBatchingWindow:                         "history.visibilityCloseBatchingWindow",
+	VisibilityCloseBatchMaxSize:                           "history.visibilityCloseBatchMaxSize",
+	VisibilityRetention:                                   "history.visibilityRetention",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
 	WorkerReplicatorMetaTaskConcurrency:             "worker.replicatorMetaTaskConcurrency",
@@ -212,6 +293,7 @@ var keys = map[Key]string{
 	WorkerTimeLimitPerArchivalIteration:             "worker.TimeLimitPerArchivalIteration",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	WorkerHandoverCheckInterval:                     "worker.handoverCheckInterval",
 }
 
 const (
@@ -230,6 +312,7 @@ const (
 	testGetIntPropertyFilteredByTaskListInfoKey
 	testGetDurationPropertyFilteredByTaskListInfoKey
 	testGetBoolPropertyFilteredByTaskListInfoKey
+	testGetBoolPropertyFilteredByShardIDKey
 
 	// EnableGlobalDomain is key for enable global domain
 	EnableGlobalDomain
@@ -241,7 +324,7 @@ const (
 	EnableReadFromClosedExecutionV2
 	// AdvancedVisibilityWritingMode is key for how to write to advanced visibility
 	AdvancedVisibilityWritingMode
-	// EmitShardDiffLog whether emit the shard diff log
+	// EmitShardDiffLog whether emit the shard diff log, it can be enabled for a single shard
 	EmitShardDiffLog
 	// EnableReadVisibilityFromES is key for enable read from elastic search
 	EnableReadVisibilityFromES
@@ -258,12 +341,60 @@ const (
 	// EnableDomainNotActiveAutoForwarding whether enabling DC auto forwarding to active cluster
 	// for signal / start / signal with start API if domain is not active
 	EnableDomainNotActiveAutoForwarding
+	// DCRedirectionForwardNonWorkerAPIs whether DC auto forwarding to active cluster applies to all the APIs
+	// other than the worker APIs (poll / respond / heartbeat), which are always served by the current cluster
+	DCRedirectionForwardNonWorkerAPIs
 	// TransactionSizeLimit is the largest allowed transaction size to persistence
 	TransactionSizeLimit
 	// MinRetentionDays is the minimal allowed retention days for domain
 	MinRetentionDays
 	// MaxDecisionStartToCloseSeconds is the minimal allowed decision start to close timeout in seconds
 	MaxDecisionStartToCloseSeconds
+	// ActivityTypeMetricsAllowlist is the comma separated list of activity types of a domain
+	// for which per activity type metrics are emitted
+	ActivityTypeMetricsAllowlist
+	// WorkflowTypeMetricsAllowlist is the comma separated list of workflow types of a domain
+	// for which per workflow type latency metrics are emitted
+	WorkflowTypeMetricsAllowlist
+	// DomainProcessingPaused is whether dispatching of decision / activity tasks and firing of timers
+	// is paused for a domain, new signals / starts are still accepted and backlogged
+	DomainProcessingPaused
+	// VisibilityTieringAgeDays is the age in days after which closed visibility records of a domain are moved
+	// from the visibility DB to the visibility archival store of the domain, 0 disables tiering
+	VisibilityTieringAgeDays
+	// VisibilityIndexedMemoKeys is the comma separated list of memo keys of a domain which are indexed
+	// in basic visibility on workflow close so closed workflows can be listed by memo value
+	VisibilityIndexedMemoKeys
+	// ExecutionsReportTopK is the number of heaviest open workflows of a domain reported by the
+	// executions reporter of the worker service, 0 excludes the domain from the report
+	ExecutionsReportTopK
+	// MeteringExportInterval is the interval at which the per domain usage metered by a host is exported
+	MeteringExportInterval
+	// PersistenceSlowQueryThreshold is the latency above which a persistence operation is logged as slow
+	PersistenceSlowQueryThreshold
+	// PersistenceLargeRowSizeThreshold is the size in bytes of mutable state / history read or written
+	// by a persistence operation above which the operation is logged
+	PersistenceLargeRowSizeThreshold
+	// PersistenceSlowQueryLogSampleRate is the rate at which slow or large persistence operations are logged
+	PersistenceSlowQueryLogSampleRate
+	// RejectPollsOnPassiveDomain whether polls for a global domain which is passive in the current cluster
+	// fail immediately with DomainNotActiveError instead of waiting for tasks which will never arrive
+	RejectPollsOnPassiveDomain
+	// EnableLoadShedding whether low priority operations are shed while persistence is overloaded
+	EnableLoadShedding
+	// OverloadLatencyThreshold is the average persistence latency above which persistence is considered overloaded
+	OverloadLatencyThreshold
+	// OverloadErrorRatioThreshold is the ratio of failed persistence operations above which
+	// persistence is considered overloaded
+	OverloadErrorRatioThreshold
+	// OverloadEvaluationInterval is the interval at which the persistence health score is re-evaluated
+	OverloadEvaluationInterval
+	// OverloadGlobalLowPriorityRPS is the cluster wide rate of low priority operations admitted while
+	// persistence is overloaded, split across the hosts of each service, 0 to shed them by probability
+	OverloadGlobalLowPriorityRPS
+	// EnforceKnownTaskLists is whether workflow starts, activities and child workflows of a domain
+	// targeting a task list not declared in the domain's known task lists are rejected
+	EnforceKnownTaskLists
 
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
@@ -277,6 +408,10 @@ const (
 	HistoryCountLimitError
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn
+	// LocalActivityMarkerCountLimitPerDecision is the max number of local activity markers recorded by one decision
+	LocalActivityMarkerCountLimitPerDecision
+	// LocalActivityMarkerSizeLimitPerDecision is the max total size of local activity marker details recorded by one decision
+	LocalActivityMarkerSizeLimitPerDecision
 
 	// MaxIDLengthLimit is the length limit for various IDs, including: Domain, TaskList, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
@@ -294,8 +429,21 @@ const (
 	FrontendESVisibilityListMaxQPS
 	// FrontendESIndexMaxResultWindow is ElasticSearch index setting max_result_window
 	FrontendESIndexMaxResultWindow
+	// FrontendESQueryMaxPageSize is the max page size of a domain for listing workflows from ElasticSearch
+	FrontendESQueryMaxPageSize
+	// FrontendESQueryMaxBooleanClauses is the max number of conditions in a visibility query of a domain, 0 means no limit
+	FrontendESQueryMaxBooleanClauses
+	// FrontendESQueryMaxTimeRange is the max time range on StartTime, CloseTime or ExecutionTime
+	// a visibility query of a domain can span, 0 means no limit
+	FrontendESQueryMaxTimeRange
 	// FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page
 	FrontendHistoryMaxPageSize
+	// FrontendExecutionChainMaxRuns is the max number of runs one page of ListWorkflowExecutionChain inspects
+	FrontendExecutionChainMaxRuns
+	// FrontendBatchDescribeMaxExecutions is the max number of executions in one BatchDescribeWorkflowExecutions request
+	FrontendBatchDescribeMaxExecutions
+	// FrontendBatchDescribeConcurrency is the number of executions BatchDescribeWorkflowExecutions describes concurrently
+	FrontendBatchDescribeConcurrency
 	// FrontendRPS is workflow rate limit per second
 	FrontendRPS
 	// FrontendDomainRPS is workflow domain rate limit per second
@@ -316,6 +464,36 @@ const (
 	SearchAttributesSizeOfValueLimit
 	// SearchAttributesTotalSizeLimit is the size limit of the whole map
 	SearchAttributesTotalSizeLimit
+	// FrontendFailoverReadinessMaxReplicationLag is the max replication lag, in task IDs, of any shard
+	// for a domain to be considered ready for failover
+	FrontendFailoverReadinessMaxReplicationLag
+	// FrontendDCRedirectionMaxHops is the max number of times a request can be forwarded between clusters
+	// by DC redirection, requests which would exceed it are rejected to prevent redirect loops
+	FrontendDCRedirectionMaxHops
+	// FrontendDCRedirectionMaxRetryAttempts is the max number of retries of a forwarded call when the remote
+	// cluster returns a retryable error
+	FrontendDCRedirectionMaxRetryAttempts
+	// FrontendEnableHeartbeatBatching is whether activity heartbeats to the same history shard are sent in batches
+	FrontendEnableHeartbeatBatching
+	// FrontendHeartbeatBatchingWindow is how long a heartbeat waits for other heartbeats to batch with
+	FrontendHeartbeatBatchingWindow
+	// FrontendHeartbeatBatchMaxSize is the max number of heartbeats in a batch
+	FrontendHeartbeatBatchMaxSize
+	// FrontendMaxConcurrentPollsPerHost is the max number of outstanding decision / activity task polls
+	// on a frontend host, polls above it fail with ServiceBusyError, 0 means no limit
+	FrontendMaxConcurrentPollsPerHost
+	// FrontendMaxConcurrentPollsPerDomain is the max number of outstanding decision / activity task polls
+	// of a domain on a frontend host, polls above it fail with ServiceBusyError, 0 means no limit
+	FrontendMaxConcurrentPollsPerDomain
+	// FrontendEnforceTaskTokenSignature is whether unsigned task tokens are rejected when a task token
+	// signing key is configured, it should only be enabled once all hosts sign the tokens they issue
+	FrontendEnforceTaskTokenSignature
+	// FrontendAllowedAPIs is the comma separated list of APIs a domain may call, an entry may be
+	// suffixed with ":<caller>" to only allow that caller, all APIs are allowed if empty
+	FrontendAllowedAPIs
+	// FrontendDeniedAPIs is the comma separated list of APIs a domain may not call, an entry may be
+	// suffixed with ":<caller>" to only deny that caller, it takes precedence over FrontendAllowedAPIs
+	FrontendDeniedAPIs
 
 	// key for matching
 
@@ -329,6 +507,9 @@ const (
 	MatchingGetTasksBatchSize
 	// MatchingLongPollExpirationInterval is the long poll expiration interval in the matching service
 	MatchingLongPollExpirationInterval
+	// MatchingIncompatiblePollBackoff is how long a decision poll is held after it picked up a decision
+	// task its binary checksum is incompatible with, before returning an empty response
+	MatchingIncompatiblePollBackoff
 	// MatchingEnableSyncMatch is to enable sync match
 	MatchingEnableSyncMatch
 	// MatchingUpdateAckInterval is the interval for update ack
@@ -357,6 +538,44 @@ const (
 	MatchingForwarderMaxRatePerSecond
 	// MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingWorkerTaskListLivenessTimeout is the duration without polls after which a worker specific task list is considered dead
+	MatchingWorkerTaskListLivenessTimeout
+	// MatchingNumTaskPriorities is the number of task priorities a task list dispatches, tasks of each priority
+	// above the default one are kept in their own sub-queue. At most 8 priorities are supported
+	MatchingNumTaskPriorities
+	// MatchingTaskPriorityDispatchRatio is how many times more often tasks of a priority are dispatched than
+	// tasks of the priority below it when both have a backlog
+	MatchingTaskPriorityDispatchRatio
+	// MatchingEnableWorkflowAffinity is whether decision tasks of normal task lists are preferably dispatched
+	// to a poller of the worker which recently processed the same workflow
+	MatchingEnableWorkflowAffinity
+	// MatchingWorkflowAffinityCacheSize is the max number of workflows a task list remembers the last worker of
+	MatchingWorkflowAffinityCacheSize
+	// MatchingTaskListDrained is whether dispatching of a task list is stopped, its tasks stay in the
+	// backlog and its pollers receive empty responses, e.g. while a bad worker deployment is rolled back
+	MatchingTaskListDrained
+	// MatchingStaleWorkerThreshold is the duration without polls after which a worker of a task list is considered stale
+	MatchingStaleWorkerThreshold
+	// MatchingEnableAdaptiveScaler is whether the number of read and write partitions of a task list is adjusted
+	// automatically to its traffic, the static numTasklistReadPartitions and numTasklistWritePartitions are used otherwise
+	MatchingEnableAdaptiveScaler
+	// MatchingAdaptiveScalerUpdateInterval is the interval at which the adaptive scaler evaluates the traffic of a task list
+	MatchingAdaptiveScalerUpdateInterval
+	// MatchingPartitionUpscaleRPS is the rate of tasks per partition above which a task list gets more partitions
+	MatchingPartitionUpscaleRPS
+	// MatchingPartitionDownscaleRPS is the rate of tasks per partition below which a task list gets less partitions
+	MatchingPartitionDownscaleRPS
+	// MatchingUpscaleSustainedPeriod is how long the rate must stay above the upscale threshold before partitions are added
+	MatchingUpscaleSustainedPeriod
+	// MatchingDownscaleSustainedPeriod is how long the rate must stay below the downscale threshold before partitions are removed
+	MatchingDownscaleSustainedPeriod
+	// MatchingAdaptiveScalerMinPartitions is the min number of partitions the adaptive scaler assigns to a task list
+	MatchingAdaptiveScalerMinPartitions
+	// MatchingAdaptiveScalerMaxPartitions is the max number of partitions the adaptive scaler assigns to a task list
+	MatchingAdaptiveScalerMaxPartitions
+	// MatchingPartitionConfigRefreshInterval is the interval at which the partition counts decided by the adaptive
+	// scaler are refreshed by the hosts routing requests to task list partitions
+	MatchingPartitionConfigRefreshInterval
 
 	// key for history
 
@@ -370,18 +589,30 @@ const (
 	HistoryVisibilityClosedMaxQPS
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	HistoryLongPollExpirationInterval
+	// HistoryMaxActivityCancellationWait is the max time an activity heartbeat waits for a pending cancellation
+	HistoryMaxActivityCancellationWait
 	// HistoryCacheInitialSize is initial size of history cache
 	HistoryCacheInitialSize
 	// HistoryCacheMaxSize is max size of history cache
 	HistoryCacheMaxSize
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
+	// HistoryCacheShardCount is the number of independently locked partitions of history cache
+	HistoryCacheShardCount
 	// EventsCacheInitialSize is initial size of events cache
 	EventsCacheInitialSize
 	// EventsCacheMaxSize is max size of events cache
 	EventsCacheMaxSize
+	// EventsCacheMaxSizeInBytes is max total size in bytes of the events in events cache, 0 means no limit
+	EventsCacheMaxSizeInBytes
 	// EventsCacheTTL is TTL of events cache
 	EventsCacheTTL
+	// EventsCacheShardCount is the number of independently locked partitions of events cache
+	EventsCacheShardCount
+	// DomainCacheMemoryLowWatermarkInBytes is the domain cache memory usage domain eviction brings the cache back to
+	DomainCacheMemoryLowWatermarkInBytes
+	// DomainCacheMemoryHighWatermarkInBytes is the domain cache memory usage which triggers domain eviction, 0 means no limit
+	DomainCacheMemoryHighWatermarkInBytes
 	// AcquireShardInterval is interval that timer used to acquire shard
 	AcquireShardInterval
 	// StandbyClusterDelay is the atrificial delay added to standby cluster's view of active cluster's time
@@ -414,6 +645,16 @@ const (
 	TimerProcessorMaxPollIntervalJitterCoefficient
 	// TimerProcessorMaxTimeShift is the max shift timer processor can have
 	TimerProcessorMaxTimeShift
+	// TimerProcessorEnableTimerWheel is whether timer processor loads timer tasks ahead of their fire time
+	// into an in memory timer wheel, and fires them from there without reading persistence
+	TimerProcessorEnableTimerWheel
+	// TimerProcessorTimerWheelTick is the duration of a slot of the timer wheel, only read on shard load
+	TimerProcessorTimerWheelTick
+	// TimerProcessorLookAheadWindow is how far ahead of time timer tasks are loaded into the timer wheel
+	TimerProcessorLookAheadWindow
+	// EnableTimerTaskCoalescing is whether a user timer or activity timeout reuses an earlier outstanding timer task
+	// of the same workflow instead of creating its own
+	EnableTimerTaskCoalescing
 	// TimerProcessorHistoryArchivalSizeLimit is the max history size for inline archival
 	TimerProcessorHistoryArchivalSizeLimit
 	// TimerProcessorArchivalTimeLimit is the upper time limit for inline history archival
@@ -462,6 +703,8 @@ const (
 	ReplicatorProcessorUpdateAckInterval
 	// ReplicatorProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient
+	// ReplicationTaskProcessorParallelism is the max number of executions a shard applies replication tasks for concurrently
+	ReplicationTaskProcessorParallelism
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	ExecutionMgrNumConns
 	// HistoryMgrNumConns is persistence connections number for HistoryManager
@@ -474,7 +717,8 @@ const (
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for newly written history events, thriftrw, proto or json,
+	// events are always read back with the encoding they were written with
 	DefaultEventEncoding
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
@@ -500,10 +744,46 @@ const (
 
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	HistoryThrottledLogRPS
+	// HistoryHeartbeatBatchConcurrency is the max number of heartbeats of a batch recorded concurrently
+	HistoryHeartbeatBatchConcurrency
 	// StickyTTL is to expire a sticky tasklist if no update more than this duration
 	StickyTTL
 	// DecisionHeartbeatTimeout for decision heartbeat
 	DecisionHeartbeatTimeout
+	// EnableDecisionBinaryChecksumCheck is whether decisions of a workflow are only dispatched to pollers
+	// with a binary checksum compatible with the one of the worker which completed the last decision
+	EnableDecisionBinaryChecksumCheck
+	// CompatibleDecisionBinaryChecksums is the semicolon separated groups of comma separated binary checksums
+	// which are compatible with each other, e.g. "v1,v1.1;v2,v2.1"
+	CompatibleDecisionBinaryChecksums
+	// NoPollersWarningThreshold is the number of schedule to start timeouts on an activity task list
+	// without pollers, after which pending activities on that task list are reported as having no pollers
+	NoPollersWarningThreshold
+	// NoPollersWarningTTL is how long a no pollers warning for an activity task list is kept
+	NoPollersWarningTTL
+	// EnableMutableStateSnapshot is whether to periodically compact the mutable state maps
+	// of an execution into a single snapshot, for stores supporting it
+	EnableMutableStateSnapshot
+	// MutableStateSnapshotThreshold is the number of mutable state entries written since the last snapshot
+	// after which a new snapshot is written
+	MutableStateSnapshotThreshold
+	// EnableShardUpdateBatching is whether to group the concurrent workflow updates of a shard,
+	// together with their transfer and timer tasks, into a single persistence write
+	EnableShardUpdateBatching
+	// ShardUpdateBatchingWindow is how long a workflow update waits for others of the same shard to be batched with
+	ShardUpdateBatchingWindow
+	// ShardUpdateBatchMaxSize is the max number of workflow updates written in a single batch
+	ShardUpdateBatchMaxSize
+	// EnableVisibilityCloseBatching is whether to group the closed execution records of all shards
+	// into batched writes to the visibility store
+	EnableVisibilityCloseBatching
+	// VisibilityCloseBatchingWindow is how long a closed execution record waits for others to be batched with
+	VisibilityCloseBatchingWindow
+	// VisibilityCloseBatchMaxSize is the max number of closed execution records written in a single batch
+	VisibilityCloseBatchMaxSize
+	// VisibilityRetention is how long the visibility record of a closed workflow is kept. It only takes effect
+	// when longer than the domain retention, in which case the record outlives the deleted or archived history
+	VisibilityRetention
 
 	// key for worker
 
@@ -553,6 +833,8 @@ const (
 	WorkerThrottledLogRPS
 	// ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner
 	ScannerPersistenceMaxQPS
+	// WorkerHandoverCheckInterval is the interval at which domains in handover state are checked for failover readiness
+	WorkerHandoverCheckInterval
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
@@ -566,7 +848,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f >= lastFilterTypeForTest {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -577,6 +859,7 @@ var filters = []string{
 	"domainName",
 	"taskListName",
 	"taskType",
+	"shardID",
 }
 
 const (
@@ -587,6 +870,8 @@ const (
 	TaskListName
 	// TaskType is the task type (0:Decision, 1:Activity)
 	TaskType
+	// ShardID is the history shard id
+	ShardID
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -615,3 +900,10 @@ func TaskTypeFilter(taskType int) FilterOption {
 		filterMap[TaskType] = taskType
 	}
 }
+
+// ShardIDFilter filters by shard id
+func ShardIDFilter(shardID int) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[ShardID] = shardID
+	}
+}
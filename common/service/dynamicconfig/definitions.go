@@ -0,0 +1,292 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+var (
+	domainFilters   = []Filter{DomainName}
+	taskListFilters = []Filter{DomainName, TaskListName, TaskType}
+	shardFilters    = []Filter{ShardID}
+)
+
+// keyDefinitions contains the value type of every dynamic config key and the filters its values can be
+// constrained by. A key has to be read with the getter matching its definition, e.g. a key with the task list
+// filters is read by GetIntPropertyFilteredByTaskListInfo.
+var keyDefinitions = map[Key]KeyDefinition{
+	// tests keys
+	testGetPropertyKey:                               {Type: ValueTypeUnknown},
+	testGetIntPropertyKey:                            {Type: ValueTypeInt},
+	testGetFloat64PropertyKey:                        {Type: ValueTypeFloat},
+	testGetDurationPropertyKey:                       {Type: ValueTypeDuration},
+	testGetBoolPropertyKey:                           {Type: ValueTypeBool},
+	testGetStringPropertyKey:                         {Type: ValueTypeString},
+	testGetMapPropertyKey:                            {Type: ValueTypeMap},
+	testGetIntPropertyFilteredByDomainKey:            {Type: ValueTypeInt, Filters: domainFilters},
+	testGetDurationPropertyFilteredByDomainKey:       {Type: ValueTypeDuration, Filters: domainFilters},
+	testGetIntPropertyFilteredByTaskListInfoKey:      {Type: ValueTypeInt, Filters: taskListFilters},
+	testGetDurationPropertyFilteredByTaskListInfoKey: {Type: ValueTypeDuration, Filters: taskListFilters},
+	testGetBoolPropertyFilteredByTaskListInfoKey:     {Type: ValueTypeBool, Filters: taskListFilters},
+	testGetBoolPropertyFilteredByShardIDKey:          {Type: ValueTypeBool, Filters: shardFilters},
+
+	// system settings
+	EnableGlobalDomain:                  {Type: ValueTypeBool},
+	EnableNewKafkaClient:                {Type: ValueTypeBool},
+	EnableVisibilitySampling:            {Type: ValueTypeBool},
+	EnableReadFromClosedExecutionV2:     {Type: ValueTypeBool},
+	AdvancedVisibilityWritingMode:       {Type: ValueTypeString},
+	EnableReadVisibilityFromES:          {Type: ValueTypeBool, Filters: domainFilters},
+	HistoryArchivalStatus:               {Type: ValueTypeString},
+	EnableReadFromHistoryArchival:       {Type: ValueTypeBool},
+	VisibilityArchivalStatus:            {Type: ValueTypeString},
+	EnableReadFromVisibilityArchival:    {Type: ValueTypeBool},
+	EnableDomainNotActiveAutoForwarding: {Type: ValueTypeBool, Filters: domainFilters},
+	DCRedirectionForwardNonWorkerAPIs:   {Type: ValueTypeBool, Filters: domainFilters},
+	TransactionSizeLimit:                {Type: ValueTypeInt},
+	MinRetentionDays:                    {Type: ValueTypeInt},
+	MaxDecisionStartToCloseSeconds:      {Type: ValueTypeInt, Filters: domainFilters},
+	EnableBatcher:                       {Type: ValueTypeBool},
+	EnableParentClosePolicyWorker:       {Type: ValueTypeBool},
+	ActivityTypeMetricsAllowlist:        {Type: ValueTypeString, Filters: domainFilters},
+	WorkflowTypeMetricsAllowlist:        {Type: ValueTypeString, Filters: domainFilters},
+	DomainProcessingPaused:              {Type: ValueTypeBool, Filters: domainFilters},
+	VisibilityTieringAgeDays:            {Type: ValueTypeInt, Filters: domainFilters},
+	VisibilityIndexedMemoKeys:           {Type: ValueTypeString, Filters: domainFilters},
+	ExecutionsReportTopK:                {Type: ValueTypeInt, Filters: domainFilters},
+	MeteringExportInterval:              {Type: ValueTypeDuration},
+	PersistenceSlowQueryThreshold:       {Type: ValueTypeDuration},
+	PersistenceLargeRowSizeThreshold:    {Type: ValueTypeInt},
+	PersistenceSlowQueryLogSampleRate:   {Type: ValueTypeFloat},
+	RejectPollsOnPassiveDomain:          {Type: ValueTypeBool, Filters: domainFilters},
+	EnableLoadShedding:                  {Type: ValueTypeBool},
+	OverloadLatencyThreshold:            {Type: ValueTypeDuration},
+	OverloadErrorRatioThreshold:         {Type: ValueTypeFloat},
+	OverloadEvaluationInterval:          {Type: ValueTypeDuration},
+	OverloadGlobalLowPriorityRPS:        {Type: ValueTypeInt},
+	EnforceKnownTaskLists:               {Type: ValueTypeBool, Filters: domainFilters},
+
+	// size limit
+	BlobSizeLimitError:     {Type: ValueTypeInt, Filters: domainFilters},
+	BlobSizeLimitWarn:      {Type: ValueTypeInt, Filters: domainFilters},
+	HistorySizeLimitError:  {Type: ValueTypeInt, Filters: domainFilters},
+	HistorySizeLimitWarn:   {Type: ValueTypeInt, Filters: domainFilters},
+	HistoryCountLimitError: {Type: ValueTypeInt, Filters: domainFilters},
+	HistoryCountLimitWarn:  {Type: ValueTypeInt, Filters: domainFilters},
+	MaxIDLengthLimit:       {Type: ValueTypeInt},
+
+	LocalActivityMarkerCountLimitPerDecision: {Type: ValueTypeInt, Filters: domainFilters},
+	LocalActivityMarkerSizeLimitPerDecision:  {Type: ValueTypeInt, Filters: domainFilters},
+
+	// frontend settings
+	FrontendPersistenceMaxQPS:                  {Type: ValueTypeInt},
+	FrontendVisibilityMaxPageSize:              {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendVisibilityListMaxQPS:               {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendESVisibilityListMaxQPS:             {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendMaxBadBinaries:                     {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendESIndexMaxResultWindow:             {Type: ValueTypeInt},
+	FrontendESQueryMaxPageSize:                 {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendESQueryMaxBooleanClauses:           {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendESQueryMaxTimeRange:                {Type: ValueTypeDuration, Filters: domainFilters},
+	FrontendHistoryMaxPageSize:                 {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendExecutionChainMaxRuns:              {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendBatchDescribeMaxExecutions:         {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendBatchDescribeConcurrency:           {Type: ValueTypeInt},
+	FrontendRPS:                                {Type: ValueTypeInt},
+	FrontendDomainRPS:                          {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendHistoryMgrNumConns:                 {Type: ValueTypeInt},
+	DisableListVisibilityByFilter:              {Type: ValueTypeBool, Filters: domainFilters},
+	FrontendThrottledLogRPS:                    {Type: ValueTypeInt},
+	EnableClientVersionCheck:                   {Type: ValueTypeBool},
+	ValidSearchAttributes:                      {Type: ValueTypeMap},
+	SearchAttributesNumberOfKeysLimit:          {Type: ValueTypeInt, Filters: domainFilters},
+	SearchAttributesSizeOfValueLimit:           {Type: ValueTypeInt, Filters: domainFilters},
+	SearchAttributesTotalSizeLimit:             {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendFailoverReadinessMaxReplicationLag: {Type: ValueTypeInt},
+	FrontendDCRedirectionMaxHops:               {Type: ValueTypeInt},
+	FrontendDCRedirectionMaxRetryAttempts:      {Type: ValueTypeInt},
+	FrontendEnableHeartbeatBatching:            {Type: ValueTypeBool},
+	FrontendHeartbeatBatchingWindow:            {Type: ValueTypeDuration},
+	FrontendHeartbeatBatchMaxSize:              {Type: ValueTypeInt},
+	FrontendMaxConcurrentPollsPerHost:          {Type: ValueTypeInt},
+	FrontendMaxConcurrentPollsPerDomain:        {Type: ValueTypeInt, Filters: domainFilters},
+	FrontendEnforceTaskTokenSignature:          {Type: ValueTypeBool},
+	FrontendAllowedAPIs:                        {Type: ValueTypeString, Filters: domainFilters},
+	FrontendDeniedAPIs:                         {Type: ValueTypeString, Filters: domainFilters},
+
+	// matching settings
+	MatchingRPS:                             {Type: ValueTypeInt},
+	MatchingPersistenceMaxQPS:               {Type: ValueTypeInt},
+	MatchingMinTaskThrottlingBurstSize:      {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingGetTasksBatchSize:               {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingLongPollExpirationInterval:      {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingIncompatiblePollBackoff:         {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingEnableSyncMatch:                 {Type: ValueTypeBool, Filters: taskListFilters},
+	MatchingUpdateAckInterval:               {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingIdleTasklistCheckInterval:       {Type: ValueTypeDuration, Filters: taskListFilters},
+	MaxTasklistIdleTime:                     {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingOutstandingTaskAppendsThreshold: {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingMaxTaskBatchSize:                {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingMaxTaskDeleteBatchSize:          {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingThrottledLogRPS:                 {Type: ValueTypeInt},
+	MatchingNumTasklistWritePartitions:      {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingNumTasklistReadPartitions:       {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingForwarderMaxOutstandingPolls:    {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingForwarderMaxOutstandingTasks:    {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingForwarderMaxRatePerSecond:       {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingForwarderMaxChildrenPerNode:     {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingWorkerTaskListLivenessTimeout:   {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingNumTaskPriorities:               {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingTaskPriorityDispatchRatio:       {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingEnableWorkflowAffinity:          {Type: ValueTypeBool, Filters: taskListFilters},
+	MatchingWorkflowAffinityCacheSize:       {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingTaskListDrained:                 {Type: ValueTypeBool, Filters: taskListFilters},
+	MatchingStaleWorkerThreshold:            {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingEnableAdaptiveScaler:            {Type: ValueTypeBool, Filters: taskListFilters},
+	MatchingAdaptiveScalerUpdateInterval:    {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingPartitionUpscaleRPS:             {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingPartitionDownscaleRPS:           {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingUpscaleSustainedPeriod:          {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingDownscaleSustainedPeriod:        {Type: ValueTypeDuration, Filters: taskListFilters},
+	MatchingAdaptiveScalerMinPartitions:     {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingAdaptiveScalerMaxPartitions:     {Type: ValueTypeInt, Filters: taskListFilters},
+	MatchingPartitionConfigRefreshInterval:  {Type: ValueTypeDuration},
+
+	// history settings
+	HistoryRPS:                                            {Type: ValueTypeInt},
+	HistoryPersistenceMaxQPS:                              {Type: ValueTypeInt},
+	HistoryVisibilityOpenMaxQPS:                           {Type: ValueTypeInt, Filters: domainFilters},
+	HistoryVisibilityClosedMaxQPS:                         {Type: ValueTypeInt, Filters: domainFilters},
+	HistoryLongPollExpirationInterval:                     {Type: ValueTypeDuration, Filters: domainFilters},
+	HistoryMaxActivityCancellationWait:                    {Type: ValueTypeDuration, Filters: domainFilters},
+	HistoryCacheInitialSize:                               {Type: ValueTypeInt},
+	HistoryMaxAutoResetPoints:                             {Type: ValueTypeInt, Filters: domainFilters},
+	HistoryCacheMaxSize:                                   {Type: ValueTypeInt},
+	HistoryCacheTTL:                                       {Type: ValueTypeDuration},
+	HistoryCacheShardCount:                                {Type: ValueTypeInt},
+	EventsCacheInitialSize:                                {Type: ValueTypeInt},
+	EventsCacheMaxSize:                                    {Type: ValueTypeInt},
+	EventsCacheMaxSizeInBytes:                             {Type: ValueTypeInt},
+	EventsCacheTTL:                                        {Type: ValueTypeDuration},
+	EventsCacheShardCount:                                 {Type: ValueTypeInt},
+	DomainCacheMemoryLowWatermarkInBytes:                  {Type: ValueTypeInt},
+	DomainCacheMemoryHighWatermarkInBytes:                 {Type: ValueTypeInt},
+	AcquireShardInterval:                                  {Type: ValueTypeDuration},
+	StandbyClusterDelay:                                   {Type: ValueTypeDuration},
+	TimerTaskBatchSize:                                    {Type: ValueTypeInt},
+	TimerTaskWorkerCount:                                  {Type: ValueTypeInt},
+	TimerTaskMaxRetryCount:                                {Type: ValueTypeInt},
+	TimerProcessorGetFailureRetryCount:                    {Type: ValueTypeInt},
+	TimerProcessorCompleteTimerFailureRetryCount:          {Type: ValueTypeInt},
+	TimerProcessorUpdateShardTaskCount:                    {Type: ValueTypeInt},
+	TimerProcessorUpdateAckInterval:                       {Type: ValueTypeDuration},
+	TimerProcessorUpdateAckIntervalJitterCoefficient:      {Type: ValueTypeFloat},
+	TimerProcessorCompleteTimerInterval:                   {Type: ValueTypeDuration},
+	TimerProcessorFailoverMaxPollRPS:                      {Type: ValueTypeInt},
+	TimerProcessorMaxPollRPS:                              {Type: ValueTypeInt},
+	TimerProcessorMaxPollInterval:                         {Type: ValueTypeDuration},
+	TimerProcessorMaxPollIntervalJitterCoefficient:        {Type: ValueTypeFloat},
+	TimerProcessorMaxTimeShift:                            {Type: ValueTypeDuration},
+	TimerProcessorEnableTimerWheel:                        {Type: ValueTypeBool},
+	TimerProcessorTimerWheelTick:                          {Type: ValueTypeDuration},
+	TimerProcessorLookAheadWindow:                         {Type: ValueTypeDuration},
+	EnableTimerTaskCoalescing:                             {Type: ValueTypeBool, Filters: domainFilters},
+	TimerProcessorHistoryArchivalSizeLimit:                {Type: ValueTypeInt},
+	TimerProcessorArchivalTimeLimit:                       {Type: ValueTypeDuration},
+	TransferTaskBatchSize:                                 {Type: ValueTypeInt},
+	TransferProcessorFailoverMaxPollRPS:                   {Type: ValueTypeInt},
+	TransferProcessorMaxPollRPS:                           {Type: ValueTypeInt},
+	TransferTaskWorkerCount:                               {Type: ValueTypeInt},
+	TransferTaskMaxRetryCount:                             {Type: ValueTypeInt},
+	TransferProcessorCompleteTransferFailureRetryCount:    {Type: ValueTypeInt},
+	TransferProcessorUpdateShardTaskCount:                 {Type: ValueTypeInt},
+	TransferProcessorMaxPollInterval:                      {Type: ValueTypeDuration},
+	TransferProcessorMaxPollIntervalJitterCoefficient:     {Type: ValueTypeFloat},
+	TransferProcessorUpdateAckInterval:                    {Type: ValueTypeDuration},
+	TransferProcessorUpdateAckIntervalJitterCoefficient:   {Type: ValueTypeFloat},
+	TransferProcessorCompleteTransferInterval:             {Type: ValueTypeDuration},
+	TransferProcessorVisibilityArchivalTimeLimit:          {Type: ValueTypeDuration},
+	ReplicatorTaskBatchSize:                               {Type: ValueTypeInt},
+	ReplicatorTaskWorkerCount:                             {Type: ValueTypeInt},
+	ReplicatorTaskMaxRetryCount:                           {Type: ValueTypeInt},
+	ReplicatorProcessorMaxPollRPS:                         {Type: ValueTypeInt},
+	ReplicatorProcessorUpdateShardTaskCount:               {Type: ValueTypeInt},
+	ReplicatorProcessorMaxPollInterval:                    {Type: ValueTypeDuration},
+	ReplicatorProcessorMaxPollIntervalJitterCoefficient:   {Type: ValueTypeFloat},
+	ReplicatorProcessorUpdateAckInterval:                  {Type: ValueTypeDuration},
+	ReplicatorProcessorUpdateAckIntervalJitterCoefficient: {Type: ValueTypeFloat},
+	ReplicationTaskProcessorParallelism:                   {Type: ValueTypeInt},
+	ExecutionMgrNumConns:                                  {Type: ValueTypeInt},
+	HistoryMgrNumConns:                                    {Type: ValueTypeInt},
+	MaximumBufferedEventsBatch:                            {Type: ValueTypeInt},
+	MaximumSignalsPerExecution:                            {Type: ValueTypeInt, Filters: domainFilters},
+	ShardUpdateMinInterval:                                {Type: ValueTypeDuration},
+	ShardSyncMinInterval:                                  {Type: ValueTypeDuration},
+	DefaultEventEncoding:                                  {Type: ValueTypeString, Filters: domainFilters},
+	EnableAdminProtection:                                 {Type: ValueTypeBool},
+	AdminOperationToken:                                   {Type: ValueTypeString},
+	EnableEventsV2:                                        {Type: ValueTypeBool, Filters: domainFilters},
+	EnableParentClosePolicy:                               {Type: ValueTypeBool, Filters: domainFilters},
+	NumArchiveSystemWorkflows:                             {Type: ValueTypeInt},
+	ArchiveRequestRPS:                                     {Type: ValueTypeInt},
+	EmitShardDiffLog:                                      {Type: ValueTypeBool, Filters: shardFilters},
+	HistoryThrottledLogRPS:                                {Type: ValueTypeInt},
+	HistoryHeartbeatBatchConcurrency:                      {Type: ValueTypeInt},
+	StickyTTL:                                             {Type: ValueTypeDuration, Filters: domainFilters},
+	DecisionHeartbeatTimeout:                              {Type: ValueTypeDuration, Filters: domainFilters},
+	EnableDecisionBinaryChecksumCheck:                     {Type: ValueTypeBool, Filters: domainFilters},
+	CompatibleDecisionBinaryChecksums:                     {Type: ValueTypeString, Filters: domainFilters},
+	NoPollersWarningThreshold:                             {Type: ValueTypeInt},
+	NoPollersWarningTTL:                                   {Type: ValueTypeDuration},
+	ParentClosePolicyThreshold:                            {Type: ValueTypeInt, Filters: domainFilters},
+	NumParentClosePolicySystemWorkflows:                   {Type: ValueTypeInt},
+	EnableMutableStateSnapshot:                            {Type: ValueTypeBool, Filters: domainFilters},
+	MutableStateSnapshotThreshold:                         {Type: ValueTypeInt, Filters: domainFilters},
+	EnableShardUpdateBatching:                             {Type: ValueTypeBool},
+	ShardUpdateBatchingWindow:                             {Type: ValueTypeDuration},
+	ShardUpdateBatchMaxSize:                               {Type: ValueTypeInt},
+	EnableVisibilityCloseBatching:                         {Type: ValueTypeBool},
+	VisibilityCloseBatchingWindow:                         {Type: ValueTypeDuration},
+	VisibilityCloseBatchMaxSize:                           {Type: ValueTypeInt},
+	VisibilityRetention:                                   {Type: ValueTypeDuration, Filters: domainFilters},
+
+	WorkerPersistenceMaxQPS:                         {Type: ValueTypeInt},
+	WorkerReplicatorMetaTaskConcurrency:             {Type: ValueTypeInt},
+	WorkerReplicatorTaskConcurrency:                 {Type: ValueTypeInt},
+	WorkerReplicatorMessageConcurrency:              {Type: ValueTypeInt},
+	WorkerReplicatorActivityBufferRetryCount:        {Type: ValueTypeInt},
+	WorkerReplicatorHistoryBufferRetryCount:         {Type: ValueTypeInt},
+	WorkerReplicationTaskMaxRetryCount:              {Type: ValueTypeInt},
+	WorkerReplicationTaskMaxRetryDuration:           {Type: ValueTypeDuration},
+	WorkerIndexerConcurrency:                        {Type: ValueTypeInt},
+	WorkerESProcessorNumOfWorkers:                   {Type: ValueTypeInt},
+	WorkerESProcessorBulkActions:                    {Type: ValueTypeInt},
+	WorkerESProcessorBulkSize:                       {Type: ValueTypeInt},
+	WorkerESProcessorFlushInterval:                  {Type: ValueTypeDuration},
+	EnableArchivalCompression:                       {Type: ValueTypeBool},
+	WorkerHistoryPageSize:                           {Type: ValueTypeInt},
+	WorkerTargetArchivalBlobSize:                    {Type: ValueTypeInt},
+	WorkerArchiverConcurrency:                       {Type: ValueTypeInt},
+	WorkerArchivalsPerIteration:                     {Type: ValueTypeInt},
+	WorkerDeterministicConstructionCheckProbability: {Type: ValueTypeFloat},
+	WorkerBlobIntegrityCheckProbability:             {Type: ValueTypeFloat},
+	WorkerTimeLimitPerArchivalIteration:             {Type: ValueTypeDuration},
+	WorkerThrottledLogRPS:                           {Type: ValueTypeInt},
+	ScannerPersistenceMaxQPS:                        {Type: ValueTypeInt},
+	WorkerHandoverCheckInterval:                     {Type: ValueTypeDuration},
+}
@@ -25,6 +25,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"reflect"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -34,6 +37,8 @@ import (
 )
 
 var _ Client = (*fileBasedClient)(nil)
+var _ OverrideLister = (*fileBasedClient)(nil)
+var _ UpdateNotifier = (*fileBasedClient)(nil)
 
 const (
 	minPollInterval = time.Second * 5
@@ -59,6 +64,9 @@ type fileBasedClient struct {
 	config          *FileBasedClientConfig
 	doneCh          chan struct{}
 	logger          log.Logger
+
+	callbacksLock sync.Mutex
+	callbacks     []func(changedKeys []string)
 }
 
 // NewFileBasedClient creates a file based client.
@@ -184,6 +192,26 @@ func (fc *fileBasedClient) GetDurationValue(
 	return durationVal, nil
 }
 
+// RegisterUpdateCallback registers a callback called after an update of the config file changed some values
+func (fc *fileBasedClient) RegisterUpdateCallback(callback func(changedKeys []string)) {
+	fc.callbacksLock.Lock()
+	defer fc.callbacksLock.Unlock()
+	fc.callbacks = append(fc.callbacks, callback)
+}
+
+// ListOverrides returns all the values set for the key in the config file
+func (fc *fileBasedClient) ListOverrides(name Key) ([]*ValueOverride, error) {
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	var overrides []*ValueOverride
+	for _, constrainedValue := range values[keys[name]] {
+		overrides = append(overrides, &ValueOverride{
+			Value:       constrainedValue.Value,
+			Constraints: constrainedValue.Constraints,
+		})
+	}
+	return overrides, nil
+}
+
 func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 	keyName := keys[name]
 	currentValues := make(map[string][]*constrainedValue)
@@ -253,11 +281,57 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 		}
 	}
 
+	oldValues, _ := fc.values.Load().(map[string][]*constrainedValue)
 	fc.values.Store(newValues)
 	fc.logger.Info("Updated dynamic config")
+
+	// the initial load of the config file is not a change
+	if oldValues == nil {
+		return nil
+	}
+	changedKeys := getChangedKeys(oldValues, newValues)
+	if len(changedKeys) == 0 {
+		return nil
+	}
+	for _, key := range changedKeys {
+		fc.logger.Info("Dynamic config value changed",
+			tag.Key(key), tag.Value(formatConstrainedValues(newValues[key])))
+	}
+	fc.callbacksLock.Lock()
+	callbacks := fc.callbacks
+	fc.callbacksLock.Unlock()
+	for _, callback := range callbacks {
+		callback(changedKeys)
+	}
+	notifyWatchers()
 	return nil
 }
 
+// getChangedKeys returns the sorted names of the keys whose values differ between the two configs
+func getChangedKeys(oldValues, newValues map[string][]*constrainedValue) []string {
+	var changedKeys []string
+	for key, values := range newValues {
+		if !reflect.DeepEqual(values, oldValues[key]) {
+			changedKeys = append(changedKeys, key)
+		}
+	}
+	for key := range oldValues {
+		if _, ok := newValues[key]; !ok {
+			changedKeys = append(changedKeys, key)
+		}
+	}
+	sort.Strings(changedKeys)
+	return changedKeys
+}
+
+func formatConstrainedValues(values []*constrainedValue) string {
+	formatted := make([]constrainedValue, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, *value)
+	}
+	return fmt.Sprintf("%v", formatted)
+}
+
 func (fc *fileBasedClient) getValueWithFilters(key Key, filters map[Filter]interface{}, defaultValue interface{}) (interface{}, error) {
 	keyName := keys[key]
 	values := fc.values.Load().(map[string][]*constrainedValue)
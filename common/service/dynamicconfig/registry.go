@@ -0,0 +1,203 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/log/tag"
+)
+
+// ValueType is the type of the value of a dynamic config key
+type ValueType int
+
+const (
+	// ValueTypeUnknown is the type of keys read as an untyped property
+	ValueTypeUnknown ValueType = iota
+	// ValueTypeInt is the type of int keys
+	ValueTypeInt
+	// ValueTypeFloat is the type of float keys
+	ValueTypeFloat
+	// ValueTypeBool is the type of bool keys
+	ValueTypeBool
+	// ValueTypeString is the type of string keys
+	ValueTypeString
+	// ValueTypeMap is the type of map keys
+	ValueTypeMap
+	// ValueTypeDuration is the type of duration keys
+	ValueTypeDuration
+)
+
+var valueTypes = []string{
+	"unknown",
+	"int",
+	"float",
+	"bool",
+	"string",
+	"map",
+	"duration",
+}
+
+func (t ValueType) String() string {
+	if t < ValueTypeUnknown || int(t) >= len(valueTypes) {
+		return valueTypes[ValueTypeUnknown]
+	}
+	return valueTypes[t]
+}
+
+type (
+	// KeyDefinition describes a dynamic config key, with the type of its value and the filters its values
+	// can be constrained by. Keys read without fixed filters, e.g. by GetIntProperty, have no filters.
+	KeyDefinition struct {
+		Key     Key
+		Type    ValueType
+		Filters []Filter
+	}
+
+	// PropertyDescription describes the value of a dynamic config key applied for a set of filters
+	PropertyDescription struct {
+		*KeyDefinition
+		// DefaultValue is the default value the key is read with by the services running in this process,
+		// it is nil if none of them reads the key
+		DefaultValue interface{}
+		// Value is the value applied for the filters, it is nil if the key is neither set in the
+		// dynamic config source nor read by the services running in this process
+		Value interface{}
+		// IsOverridden is true if Value comes from the dynamic config source rather than the default value
+		IsOverridden bool
+		// Overrides are all the values set for the key in the dynamic config source
+		Overrides []*ValueOverride
+	}
+
+	// ValueOverride is a value of a dynamic config key set in the dynamic config source
+	ValueOverride struct {
+		Value       interface{}
+		Constraints map[string]interface{}
+	}
+
+	// OverrideLister is implemented by the dynamic config clients able to list the values set for a key
+	OverrideLister interface {
+		ListOverrides(name Key) ([]*ValueOverride, error)
+	}
+)
+
+// defaultValues contains the default value of every key read by the services running in this process
+var defaultValues sync.Map
+
+// GetKeyDefinitions returns the definitions of all the dynamic config keys, sorted by name
+func GetKeyDefinitions() []*KeyDefinition {
+	definitions := make([]*KeyDefinition, 0, len(keyDefinitions))
+	for key, definition := range keyDefinitions {
+		definitions = append(definitions, &KeyDefinition{
+			Key:     key,
+			Type:    definition.Type,
+			Filters: definition.Filters,
+		})
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Key.String() < definitions[j].Key.String()
+	})
+	return definitions
+}
+
+// DescribeProperties returns the values of the keys whose name starts with keyPrefix, applied for the
+// given filters. Each key is evaluated with the subset of the filters it can be constrained by.
+func (c *Collection) DescribeProperties(keyPrefix string, filters map[Filter]interface{}) []*PropertyDescription {
+	var descriptions []*PropertyDescription
+	for _, definition := range GetKeyDefinitions() {
+		if !strings.HasPrefix(definition.Key.String(), keyPrefix) {
+			continue
+		}
+
+		keyFilters := make(map[Filter]interface{})
+		for _, filter := range definition.Filters {
+			if value, ok := filters[filter]; ok {
+				keyFilters[filter] = value
+			}
+		}
+		defaultValue, _ := defaultValues.Load(definition.Key)
+		value, err := c.getValue(definition, defaultValue, keyFilters)
+		description := &PropertyDescription{
+			KeyDefinition: definition,
+			DefaultValue:  defaultValue,
+			Value:         value,
+			IsOverridden:  err == nil,
+		}
+		if !description.IsOverridden {
+			description.Value = defaultValue
+		}
+		if lister, ok := c.client.(OverrideLister); ok {
+			if description.Overrides, err = lister.ListOverrides(definition.Key); err != nil {
+				c.logger.Warn("Failed to list dynamic config overrides", tag.Key(definition.Key.String()), tag.Error(err))
+			}
+		}
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+// getValue returns the value of the key set in the dynamic config source, the zero value of the key type
+// is used as the default value of the keys not read by the services running in this process
+func (c *Collection) getValue(
+	definition *KeyDefinition,
+	defaultValue interface{},
+	filters map[Filter]interface{},
+) (interface{}, error) {
+	switch definition.Type {
+	case ValueTypeInt:
+		v, _ := defaultValue.(int)
+		return c.client.GetIntValue(definition.Key, filters, v)
+	case ValueTypeFloat:
+		v, _ := defaultValue.(float64)
+		return c.client.GetFloatValue(definition.Key, filters, v)
+	case ValueTypeBool:
+		v, _ := defaultValue.(bool)
+		return c.client.GetBoolValue(definition.Key, filters, v)
+	case ValueTypeString:
+		v, _ := defaultValue.(string)
+		return c.client.GetStringValue(definition.Key, filters, v)
+	case ValueTypeMap:
+		v, _ := defaultValue.(map[string]interface{})
+		return c.client.GetMapValue(definition.Key, filters, v)
+	case ValueTypeDuration:
+		v, _ := defaultValue.(time.Duration)
+		return c.client.GetDurationValue(definition.Key, filters, v)
+	default:
+		return c.client.GetValueWithFilters(definition.Key, filters, defaultValue)
+	}
+}
+
+// register records the default value a key is read with, a key read with different default values
+// by the services of this process keeps the first one. Reading a key with a type or filters different
+// from its definition is logged, as the values set for it would not be applied as expected.
+func (c *Collection) register(key Key, valueType ValueType, defaultValue interface{}, filters ...Filter) {
+	defaultValues.LoadOrStore(key, defaultValue)
+
+	definition, ok := keyDefinitions[key]
+	if !ok || definition.Type != valueType || !reflect.DeepEqual(definition.Filters, filters) {
+		c.logger.Warn("Dynamic config key is read with a type or filters different from its definition",
+			tag.Key(key.String()), tag.Value(valueType.String()))
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log"
+)
+
+type registrySuite struct {
+	suite.Suite
+	*require.Assertions
+	doneCh chan struct{}
+	cln    *Collection
+}
+
+func TestRegistrySuite(t *testing.T) {
+	s := new(registrySuite)
+	suite.Run(t, s)
+}
+
+func (s *registrySuite) SetupSuite() {
+	s.doneCh = make(chan struct{})
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     "config/testConfig.yaml",
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), s.doneCh)
+	s.Require().NoError(err)
+	s.cln = NewCollection(client, log.NewNoop())
+}
+
+func (s *registrySuite) TearDownSuite() {
+	close(s.doneCh)
+}
+
+func (s *registrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *registrySuite) TestGetKeyDefinitions() {
+	definitions := make(map[Key]*KeyDefinition)
+	previous := ""
+	for _, definition := range GetKeyDefinitions() {
+		s.True(previous < definition.Key.String())
+		previous = definition.Key.String()
+		definitions[definition.Key] = definition
+	}
+
+	// every key has a definition
+	for key := range keys {
+		if key != unknownKey {
+			s.Contains(definitions, key, "%v has no definition", key)
+		}
+	}
+	s.Len(definitions, len(keys)-1)
+
+	s.Equal(&KeyDefinition{
+		Key:     MatchingIncompatiblePollBackoff,
+		Type:    ValueTypeDuration,
+		Filters: []Filter{DomainName, TaskListName, TaskType},
+	}, definitions[MatchingIncompatiblePollBackoff])
+	s.Equal(&KeyDefinition{
+		Key:     EmitShardDiffLog,
+		Type:    ValueTypeBool,
+		Filters: []Filter{ShardID},
+	}, definitions[EmitShardDiffLog])
+}
+
+func (s *registrySuite) TestDescribeProperties() {
+	s.cln.GetBoolPropertyFilteredByShardID(testGetBoolPropertyFilteredByShardIDKey, true)
+
+	descriptions := s.cln.DescribeProperties("testGetBoolPropertyFilteredByShardID", nil)
+	s.Len(descriptions, 1)
+	s.Equal(testGetBoolPropertyFilteredByShardIDKey, descriptions[0].Key)
+	s.Equal(true, descriptions[0].DefaultValue)
+	s.Equal(false, descriptions[0].Value)
+	s.True(descriptions[0].IsOverridden)
+	s.Equal([]*ValueOverride{
+		{Value: false, Constraints: map[string]interface{}{}},
+		{Value: true, Constraints: map[string]interface{}{"shardID": 1}},
+	}, descriptions[0].Overrides)
+
+	// filters the key is not constrained by are ignored
+	descriptions = s.cln.DescribeProperties("testGetBoolPropertyFilteredByShardID", map[Filter]interface{}{
+		ShardID:    1,
+		DomainName: "samples-domain",
+	})
+	s.Len(descriptions, 1)
+	s.Equal(true, descriptions[0].Value)
+}
+
+func (s *registrySuite) TestDescribeProperties_NotOverridden() {
+	s.cln.GetIntPropertyFilteredByTaskListInfo(testGetIntPropertyFilteredByTaskListInfoKey, 10)
+
+	descriptions := s.cln.DescribeProperties(keys[testGetIntPropertyFilteredByTaskListInfoKey], nil)
+	s.Len(descriptions, 1)
+	s.Equal(10, descriptions[0].Value)
+	s.False(descriptions[0].IsOverridden)
+	s.Empty(descriptions[0].Overrides)
+}
+
+func (s *registrySuite) TestDescribeProperties_NotRead() {
+	// keys not read by this process are described with their overrides only
+	descriptions := s.cln.DescribeProperties(keys[MatchingIncompatiblePollBackoff], nil)
+	s.Len(descriptions, 1)
+	s.Equal(ValueTypeDuration, descriptions[0].Type)
+	s.Nil(descriptions[0].DefaultValue)
+	s.Nil(descriptions[0].Value)
+	s.False(descriptions[0].IsOverridden)
+}
+
+func TestValueTypeString(t *testing.T) {
+	require.Equal(t, "duration", ValueTypeDuration.String())
+	require.Equal(t, "unknown", ValueType(-1).String())
+	require.Equal(t, "unknown", ValueType(len(valueTypes)).String())
+}
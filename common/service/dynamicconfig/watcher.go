@@ -0,0 +1,145 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+type (
+	// Watcher calls a callback whenever an update of the dynamic config changes the value it watches.
+	// Values are evaluated again after every update of the clients supporting update notifications,
+	// so components react to changes within the poll interval of the client instead of only sampling
+	// values when they use them.
+	Watcher struct {
+		id       int64
+		value    func() interface{}
+		onChange func(newValue interface{})
+
+		sync.Mutex
+		lastValue interface{}
+	}
+
+	// UpdateNotifier is implemented by the dynamic config clients able to notify updates of their values
+	UpdateNotifier interface {
+		// RegisterUpdateCallback registers a callback called with the names of the keys whose values changed
+		RegisterUpdateCallback(callback func(changedKeys []string))
+	}
+)
+
+var watchers = struct {
+	sync.Mutex
+	nextID   int64
+	watchers map[int64]*Watcher
+}{
+	watchers: make(map[int64]*Watcher),
+}
+
+// Watch starts watching the value returned by value and calls onChange with the new value whenever it changes.
+// Values are compared with reflect.DeepEqual. The watcher must be stopped once the component using it is stopped.
+func Watch(value func() interface{}, onChange func(newValue interface{})) *Watcher {
+	w := &Watcher{
+		value:     value,
+		onChange:  onChange,
+		lastValue: value(),
+	}
+	watchers.Lock()
+	defer watchers.Unlock()
+	watchers.nextID++
+	w.id = watchers.nextID
+	watchers.watchers[w.id] = w
+	return w
+}
+
+// WatchIntProperty watches an int property
+func WatchIntProperty(property IntPropertyFn, onChange func(newValue int), opts ...FilterOption) *Watcher {
+	return Watch(
+		func() interface{} { return property(opts...) },
+		func(newValue interface{}) { onChange(newValue.(int)) },
+	)
+}
+
+// WatchFloat64Property watches a float property
+func WatchFloat64Property(property FloatPropertyFn, onChange func(newValue float64), opts ...FilterOption) *Watcher {
+	return Watch(
+		func() interface{} { return property(opts...) },
+		func(newValue interface{}) { onChange(newValue.(float64)) },
+	)
+}
+
+// WatchDurationProperty watches a duration property
+func WatchDurationProperty(property DurationPropertyFn, onChange func(newValue time.Duration), opts ...FilterOption) *Watcher {
+	return Watch(
+		func() interface{} { return property(opts...) },
+		func(newValue interface{}) { onChange(newValue.(time.Duration)) },
+	)
+}
+
+// WatchBoolProperty watches a bool property
+func WatchBoolProperty(property BoolPropertyFn, onChange func(newValue bool), opts ...FilterOption) *Watcher {
+	return Watch(
+		func() interface{} { return property(opts...) },
+		func(newValue interface{}) { onChange(newValue.(bool)) },
+	)
+}
+
+// Stop stops the watcher, onChange is not called anymore once Stop returns
+func (w *Watcher) Stop() {
+	watchers.Lock()
+	delete(watchers.watchers, w.id)
+	watchers.Unlock()
+
+	// wait for a running onChange callback
+	w.Lock()
+	w.onChange = nil
+	w.Unlock()
+}
+
+func (w *Watcher) refresh() {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.onChange == nil {
+		return
+	}
+	newValue := w.value()
+	if reflect.DeepEqual(newValue, w.lastValue) {
+		return
+	}
+	w.lastValue = newValue
+	w.onChange(newValue)
+}
+
+// notifyWatchers evaluates the values of all the watchers again after an update of the dynamic config
+func notifyWatchers() {
+	watchers.Lock()
+	current := make([]*Watcher, 0, len(watchers.watchers))
+	for _, w := range watchers.watchers {
+		current = append(current, w)
+	}
+	watchers.Unlock()
+
+	for _, w := range current {
+		w.refresh()
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log"
+)
+
+type watcherSuite struct {
+	suite.Suite
+	*require.Assertions
+	client *inMemoryClient
+	cln    *Collection
+}
+
+func TestWatcherSuite(t *testing.T) {
+	s := new(watcherSuite)
+	suite.Run(t, s)
+}
+
+func (s *watcherSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.client = newInMemoryClient()
+	s.cln = NewCollection(s.client, log.NewNoop())
+}
+
+func (s *watcherSuite) TestWatch() {
+	key := testGetIntPropertyKey
+	value := s.cln.GetIntProperty(key, 10)
+	var changes []int
+	watcher := WatchIntProperty(value, func(newValue int) {
+		changes = append(changes, newValue)
+	})
+
+	notifyWatchers()
+	s.Empty(changes)
+
+	s.client.SetValue(key, 50)
+	notifyWatchers()
+	s.Equal([]int{50}, changes)
+
+	// values are only reported once
+	notifyWatchers()
+	s.Equal([]int{50}, changes)
+
+	watcher.Stop()
+	s.client.SetValue(key, 100)
+	notifyWatchers()
+	s.Equal([]int{50}, changes)
+}
+
+func (s *watcherSuite) TestWatch_Filtered() {
+	key := testGetDurationPropertyKey
+	value := s.cln.GetDurationPropertyFilteredByDomain(key, time.Second)
+	var changes []time.Duration
+	watcher := Watch(
+		func() interface{} { return value("samples-domain") },
+		func(newValue interface{}) { changes = append(changes, newValue.(time.Duration)) },
+	)
+	defer watcher.Stop()
+
+	s.client.SetValue(key, time.Minute)
+	notifyWatchers()
+	s.Equal([]time.Duration{time.Minute}, changes)
+}
+
+func (s *watcherSuite) TestFileBasedClient_UpdateNotification() {
+	dir, err := ioutil.TempDir("", "dynamicconfig")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	content, err := ioutil.ReadFile("config/testConfig.yaml")
+	s.NoError(err)
+	configPath := filepath.Join(dir, "config.yaml")
+	s.NoError(ioutil.WriteFile(configPath, content, fileMode))
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     configPath,
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), doneCh)
+	s.NoError(err)
+
+	var changedKeys [][]string
+	client.(UpdateNotifier).RegisterUpdateCallback(func(keys []string) {
+		changedKeys = append(changedKeys, keys)
+	})
+	cln := NewCollection(client, log.NewNoop())
+	value := cln.GetIntProperty(testGetIntPropertyKey, 0)
+	s.Equal(1000, value())
+	var changes []int
+	watcher := WatchIntProperty(value, func(newValue int) {
+		changes = append(changes, newValue)
+	})
+	defer watcher.Stop()
+
+	s.NoError(client.(*fileBasedClient).UpdateValue(testGetIntPropertyKey, 2000))
+	s.Equal([][]string{{keys[testGetIntPropertyKey]}}, changedKeys)
+	s.Equal([]int{2000}, changes)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -75,9 +76,14 @@ type (
 		DynamicConfig       dynamicconfig.Client
 		DispatcherProvider  client.DispatcherProvider
 		DCRedirectionPolicy config.DCRedirectionPolicy
+		HTTPGateway         config.HTTPGateway
 		PublicClient        workflowserviceclient.Interface
 		ArchivalMetadata    archiver.ArchivalMetadata
 		ArchiverProvider    provider.ArchiverProvider
+		TaskTokenConfig     config.TaskToken
+		AdmissionControl    config.AdmissionControl
+		MeteringConfig      config.Metering
+		IDGeneratorConfig   config.IDGenerator
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -113,6 +119,7 @@ type (
 		dispatcherProvider     client.DispatcherProvider
 		archivalMetadata       archiver.ArchivalMetadata
 		archiverProvider       provider.ArchiverProvider
+		meter                  metering.Meter
 	}
 )
 
@@ -153,6 +160,26 @@ func New(params *BootstrapParams) Service {
 	} else {
 		sVice.hostName = hostName
 	}
+
+	if notifier, ok := params.DynamicConfig.(dynamicconfig.UpdateNotifier); ok && params.MetricsClient != nil {
+		notifier.RegisterUpdateCallback(func(changedKeys []string) {
+			params.MetricsClient.AddCounter(metrics.DynamicConfigScope, metrics.DynamicConfigChangesCounter, int64(len(changedKeys)))
+		})
+	}
+
+	sink, err := metering.NewSink(params.MeteringConfig, params.MessagingClient)
+	if err != nil {
+		sVice.logger.WithTags(tag.Error(err)).Fatal("Error creating metering sink")
+	}
+	sVice.meter = metering.NewMeter(
+		params.Name,
+		sVice.hostName,
+		sink,
+		sVice.dynamicCollection.GetDurationProperty(dynamicconfig.MeteringExportInterval, time.Minute),
+		sVice.timeSource,
+		params.MetricsClient,
+		params.Logger,
+	)
 	return sVice
 }
 
@@ -202,10 +229,13 @@ func (h *serviceImpl) Start() {
 	}
 	h.hostInfo = hostInfo
 
+	h.meter.Start()
+
 	h.clientBean, err = client.NewClientBean(
 		client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient, h.dynamicCollection, h.numberOfHistoryShards, h.logger),
 		h.dispatcherProvider,
 		h.clusterMetadata,
+		h.metricsClient,
 	)
 	if err != nil {
 		h.logger.WithTags(tag.Error(err)).Fatal("fail to initialize client bean")
@@ -231,6 +261,7 @@ func (h *serviceImpl) Stop() {
 		h.dispatcher.Stop()
 	}
 
+	h.meter.Stop()
 	h.runtimeMetricsReporter.Stop()
 }
 
@@ -276,6 +307,11 @@ func (h *serviceImpl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
+// GetMeter returns the meter recording per domain usage of the service
+func (h *serviceImpl) GetMeter() metering.Meter {
+	return h.meter
+}
+
 func (h *serviceImpl) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return h.archivalMetadata
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"go.uber.org/yarpc"
 	"go.uber.org/zap"
@@ -153,6 +154,11 @@ func (s *serviceTestBase) GetMessagingClient() messaging.Client {
 	return s.messagingClient
 }
 
+// GetMeter returns the meter recording per domain usage of the service
+func (s *serviceTestBase) GetMeter() metering.Meter {
+	return metering.NewNoopMeter()
+}
+
 // GetArchivalMetadata returns the cluster level archival metadata
 func (s *serviceTestBase) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return s.archivalMetadata
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"go.uber.org/yarpc"
 )
@@ -67,6 +68,9 @@ type (
 		// GetMessagingClient returns the messaging client against Kafka
 		GetMessagingClient() messaging.Client
 
+		// GetMeter returns the meter recording per domain usage of the service
+		GetMeter() metering.Meter
+
 		GetArchivalMetadata() archiver.ArchivalMetadata
 
 		GetArchiverProvider() provider.ArchiverProvider
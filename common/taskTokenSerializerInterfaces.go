@@ -37,6 +37,8 @@ type (
 		ScheduleID      int64  `json:"scheduleId"`
 		ScheduleAttempt int64  `json:"scheduleAttempt"`
 		ActivityID      string `json:"activityId"`
+		// Version is the version of the token format, 0 for tokens issued before tokens were versioned
+		Version int32 `json:"version"`
 	}
 
 	// QueryTaskToken identifies a query task
@@ -44,5 +46,7 @@ type (
 		DomainID string `json:"domainId"`
 		TaskList string `json:"taskList"`
 		TaskID   string `json:"taskId"`
+		// Version is the version of the token format, 0 for tokens issued before tokens were versioned
+		Version int32 `json:"version"`
 	}
 )
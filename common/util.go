@@ -63,6 +63,10 @@ const (
 	adminServiceOperationMaxInterval        = 5 * time.Second
 	adminServiceOperationExpirationInterval = 15 * time.Second
 
+	dcRedirectionOperationInitialInterval    = 50 * time.Millisecond
+	dcRedirectionOperationMaxInterval        = 1 * time.Second
+	dcRedirectionOperationExpirationInterval = 5 * time.Second
+
 	retryKafkaOperationInitialInterval    = 50 * time.Millisecond
 	retryKafkaOperationMaxInterval        = 10 * time.Second
 	retryKafkaOperationExpirationInterval = 30 * time.Second
@@ -163,6 +167,16 @@ func CreateAdminServiceRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
+// CreateDCRedirectionRetryPolicy creates a retry policy for calls forwarded to a remote cluster
+func CreateDCRedirectionRetryPolicy(maxAttempts int) backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(dcRedirectionOperationInitialInterval)
+	policy.SetMaximumInterval(dcRedirectionOperationMaxInterval)
+	policy.SetExpirationInterval(dcRedirectionOperationExpirationInterval)
+	policy.SetMaximumAttempts(maxAttempts)
+
+	return policy
+}
+
 // CreateKafkaOperationRetryPolicy creates a retry policy for kafka operation
 func CreateKafkaOperationRetryPolicy() backoff.RetryPolicy {
 	policy := backoff.NewExponentialRetryPolicy(retryKafkaOperationInitialInterval)
@@ -205,6 +219,8 @@ func IsServiceNonRetryableError(err error) bool {
 		return true
 	case *workflow.CancellationAlreadyRequestedError:
 		return true
+	case *workflow.PreconditionFailedError:
+		return true
 	case *yarpcerrors.Status:
 		rpcErr := err.(*yarpcerrors.Status)
 		if rpcErr.Code() != yarpcerrors.CodeDeadlineExceeded {
@@ -231,6 +247,10 @@ func IsWhitelistServiceTransientError(err error) bool {
 		return true
 	case *h.ShardOwnershipLostError:
 		return true
+	case *m.TaskDispatchFailedError:
+		// a rate limited dispatch is as transient as service busy, the other
+		// reasons last longer and are left to the caller to delay the task
+		return err.(*m.TaskDispatchFailedError).GetReason() == m.TaskDispatchFailureReasonRateLimited
 	case *yarpcerrors.Status:
 		// We only selectively retry the following yarpc errors client can safe retry with a backoff
 		if yarpcerrors.IsDeadlineExceeded(err) ||
@@ -245,6 +265,12 @@ func IsWhitelistServiceTransientError(err error) bool {
 	return false
 }
 
+// IsRemoteClusterUnavailableError checks if the error indicates the remote cluster cannot be reached,
+// as opposed to the remote cluster rejecting the request or the caller timing out a slow request
+func IsRemoteClusterUnavailableError(err error) bool {
+	return yarpcerrors.IsUnavailable(err)
+}
+
 // WorkflowIDToHistoryShard is used to map workflowID to a shardID
 func WorkflowIDToHistoryShard(workflowID string, numberOfShards int) int {
 	hash := farm.Fingerprint32([]byte(workflowID))
@@ -510,3 +536,37 @@ func GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist bool) stri
 	}
 	return AdvancedVisibilityWritingModeOff
 }
+
+// IsActivityTypeMetricsAllowlisted returns true if the activity type is in the given comma
+// separated allowlist, per activity type metrics are only emitted for such activity types
+func IsActivityTypeMetricsAllowlisted(allowlist string, activityType string) bool {
+	return isAllowlisted(allowlist, activityType)
+}
+
+// IsWorkflowTypeMetricsAllowlisted returns true if the workflow type is in the given comma
+// separated allowlist, per workflow type latency metrics are only emitted for such workflow types
+func IsWorkflowTypeMetricsAllowlisted(allowlist string, workflowType string) bool {
+	return isAllowlisted(allowlist, workflowType)
+}
+
+func isAllowlisted(allowlist string, value string) bool {
+	if len(allowlist) == 0 || len(value) == 0 {
+		return false
+	}
+	for _, allowed := range strings.Split(allowlist, ",") {
+		if strings.TrimSpace(allowed) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBuiltInQueryType returns true if the query type is answered by the server directly from mutable state
+func IsBuiltInQueryType(queryType string) bool {
+	switch queryType {
+	case QueryTypeQueryTypes, QueryTypeOpenSessions, QueryTypeStartTime, QueryTypePendingActivities:
+		return true
+	default:
+		return false
+	}
+}
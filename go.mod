@@ -3,6 +3,8 @@ module github.com/uber/cadence
 go 1.12
 
 require (
+	cloud.google.com/go/storage v1.0.0
+	github.com/Azure/azure-storage-blob-go v0.8.0
 	github.com/DataDog/zstd v1.4.0 // indirect
 	github.com/Shopify/sarama v1.23.0
 	github.com/anmitsu/go-shlex v0.0.0-20161002113705-648efa622239 // indirect
@@ -25,8 +27,10 @@ require (
 	github.com/go-sql-driver/mysql v1.4.1
 	github.com/gocql/gocql v0.0.0-20171220143535-56a164ee9f31
 	github.com/gogo/googleapis v1.2.0 // indirect
+	github.com/gogo/protobuf v1.2.1
 	github.com/gogo/status v1.1.0 // indirect
 	github.com/golang/mock v1.3.1
+	github.com/golang/snappy v0.0.1
 	github.com/google/uuid v1.1.1
 	github.com/hailocab/go-hostpool v0.0.0-20160125115350-e80d13ce29ed // indirect
 	github.com/hashicorp/go-version v1.2.0
@@ -76,7 +80,7 @@ require (
 	go.uber.org/cadence v0.9.1-0.20190819173509-c3cd9f8f9745
 	go.uber.org/config v1.3.1
 	go.uber.org/dig v1.7.0 // indirect
-	go.uber.org/fx v1.9.0 // indirect
+	go.uber.org/fx v1.9.0
 	go.uber.org/goleak v0.10.0 // indirect
 	go.uber.org/multierr v1.1.0
 	go.uber.org/net/metrics v1.1.0 // indirect
@@ -85,11 +89,10 @@ require (
 	go.uber.org/yarpc v1.39.0
 	go.uber.org/zap v1.10.0
 	golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4 // indirect
-	golang.org/x/lint v0.0.0-20190409202823-959b441ac422 // indirect
 	golang.org/x/net v0.0.0-20190628185345-da137c7871d7
 	golang.org/x/sys v0.0.0-20190626221950-04f50cda93cb // indirect
 	golang.org/x/time v0.0.0-20190308202827-9d24e82272b4
-	golang.org/x/tools v0.0.0-20190703183924-abb7e64e8926 // indirect
+	google.golang.org/api v0.9.0
 	google.golang.org/appengine v1.6.1 // indirect
 	google.golang.org/grpc v1.22.1 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
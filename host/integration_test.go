@@ -119,6 +119,75 @@ func (s *integrationSuite) TestStartWorkflowExecution() {
 	s.Nil(we2)
 }
 
+func (s *integrationSuite) TestStartWorkflowExecution_IDReusePolicy() {
+	id := "integration-start-workflow-id-reuse-policy-test"
+	wt := "integration-start-workflow-id-reuse-policy-test-type"
+	tl := "integration-start-workflow-id-reuse-policy-test-tasklist"
+	identity := "worker1"
+
+	newRequest := func(policy workflow.WorkflowIdReusePolicy) *workflow.StartWorkflowExecutionRequest {
+		return &workflow.StartWorkflowExecutionRequest{
+			RequestId:                           common.StringPtr(uuid.New()),
+			Domain:                              common.StringPtr(s.domainName),
+			WorkflowId:                          common.StringPtr(id),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(wt)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			Identity:                            common.StringPtr(identity),
+			WorkflowIdReusePolicy:               policy.Ptr(),
+		}
+	}
+	describe := func(runID string) *workflow.DescribeWorkflowExecutionResponse {
+		resp, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+			Domain: common.StringPtr(s.domainName),
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(id),
+				RunId:      common.StringPtr(runID),
+			},
+		})
+		s.Nil(err)
+		return resp
+	}
+
+	we0, err := s.engine.StartWorkflowExecution(createContext(), newRequest(workflow.WorkflowIdReusePolicyAllowDuplicate))
+	s.Nil(err)
+
+	// the running workflow is terminated and the new run started in its place
+	request := newRequest(workflow.WorkflowIdReusePolicyTerminateIfRunning)
+	we1, err := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err)
+	s.NotEqual(we0.GetRunId(), we1.GetRunId())
+	s.Equal(workflow.WorkflowExecutionCloseStatusTerminated, describe(we0.GetRunId()).WorkflowExecutionInfo.GetCloseStatus())
+	s.Nil(describe(we1.GetRunId()).WorkflowExecutionInfo.CloseStatus)
+
+	// retry of the same start request does not terminate the run it started
+	we2, err := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err)
+	s.Equal(we1.GetRunId(), we2.GetRunId())
+	s.Nil(describe(we1.GetRunId()).WorkflowExecutionInfo.CloseStatus)
+
+	err = s.engine.TerminateWorkflowExecution(createContext(), &workflow.TerminateWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we1.RunId,
+		},
+		Identity: common.StringPtr(identity),
+	})
+	s.Nil(err)
+
+	// the workflow ID cannot be reused once closed
+	_, err = s.engine.StartWorkflowExecution(createContext(), newRequest(workflow.WorkflowIdReusePolicyRejectDuplicate))
+	s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+
+	// with no running workflow the new run is started as with AllowDuplicate
+	we3, err := s.engine.StartWorkflowExecution(createContext(), newRequest(workflow.WorkflowIdReusePolicyTerminateIfRunning))
+	s.Nil(err)
+	s.NotEqual(we1.GetRunId(), we3.GetRunId())
+	s.Equal(workflow.WorkflowExecutionCloseStatusTerminated, describe(we1.GetRunId()).WorkflowExecutionInfo.GetCloseStatus())
+}
+
 func (s *integrationSuite) TestTerminateWorkflow() {
 	id := "integration-terminate-workflow-test"
 	wt := "integration-terminate-workflow-test-type"
@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/overload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
@@ -70,6 +71,7 @@ type Cadence interface {
 	GetFrontendClient() workflowserviceclient.Interface
 	FrontendAddress() string
 	GetFrontendService() service.Service
+	GetTaskAuditor() *history.TaskAuditor
 }
 
 type (
@@ -78,6 +80,7 @@ type (
 		frontendHandler        *frontend.WorkflowHandler
 		matchingHandler        *matching.Handler
 		historyHandlers        []*history.Handler
+		taskAuditor            *history.TaskAuditor
 		logger                 log.Logger
 		clusterMetadata        cluster.Metadata
 		persistenceConfig      config.Persistence
@@ -114,6 +117,8 @@ type (
 		NumHistoryHosts        int
 		HistoryCountLimitError int
 		HistoryCountLimitWarn  int
+		// EnableTaskAuditing verifies that no transfer or timer task is lost, see history.TaskAuditor
+		EnableTaskAuditing bool
 	}
 
 	// CadenceParams contains everything needed to bootstrap Cadence
@@ -151,6 +156,10 @@ type (
 
 // NewCadence returns an instance that hosts full cadence in one process
 func NewCadence(params *CadenceParams) Cadence {
+	var taskAuditor *history.TaskAuditor
+	if params.HistoryConfig.EnableTaskAuditing {
+		taskAuditor = history.NewTaskAuditor()
+	}
 	return &cadenceImpl{
 		logger:                 params.Logger,
 		clusterMetadata:        params.ClusterMetadata,
@@ -175,6 +184,7 @@ func NewCadence(params *CadenceParams) Cadence {
 		archiverProvider:       params.ArchiverProvider,
 		historyConfig:          params.HistoryConfig,
 		workerConfig:           params.WorkerConfig,
+		taskAuditor:            taskAuditor,
 	}
 }
 
@@ -239,7 +249,11 @@ func (c *cadenceImpl) Stop() {
 }
 
 func (c *cadenceImpl) FrontendAddress() string {
-	switch c.clusterNo {
+	return frontendAddress(c.clusterNo)
+}
+
+func frontendAddress(clusterNo int) string {
+	switch clusterNo {
 	case 0:
 		return "127.0.0.1:7104"
 	case 1:
@@ -389,6 +403,11 @@ func (c *cadenceImpl) GetFrontendService() service.Service {
 	return c.frontEndService
 }
 
+// GetTaskAuditor returns the task auditor shared by the history hosts, nil if task auditing is not enabled
+func (c *cadenceImpl) GetTaskAuditor() *history.TaskAuditor {
+	return c.taskAuditor
+}
+
 func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.WaitGroup) {
 	params := new(service.BootstrapParams)
 	params.DCRedirectionPolicy = config.DCRedirectionPolicy{}
@@ -419,12 +438,12 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 
 	c.frontEndService = service.New(params)
 
-	c.adminHandler = frontend.NewAdminHandler(
-		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr, params)
-	c.adminHandler.RegisterHandler()
-
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, c.logger)
 	frontendConfig := frontend.NewConfig(dc, c.historyConfig.NumHistoryShards, c.esConfig != nil)
+
+	c.adminHandler = frontend.NewAdminHandler(
+		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr, replicationMessageSink, params, frontendConfig)
+	c.adminHandler.RegisterHandler()
 	domainCache := cache.NewDomainCache(c.metadataMgr, c.clusterMetadata, c.frontEndService.GetMetricsClient(), c.logger)
 
 	historyArchiverBootstrapContainer := &carchiver.HistoryBootstrapContainer{
@@ -455,7 +474,15 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 		c.visibilityMgr,
 		replicationMessageSink,
 		c.domainReplicationQueue,
-		domainCache)
+		domainCache,
+		overload.NewController(&overload.Config{
+			EnableLoadShedding:   frontendConfig.EnableLoadShedding,
+			LatencyThreshold:     frontendConfig.OverloadLatencyThreshold,
+			ErrorRatioThreshold:  frontendConfig.OverloadErrorRatioThreshold,
+			EvaluationInterval:   frontendConfig.OverloadEvaluationInterval,
+			GlobalLowPriorityRPS: frontendConfig.OverloadGlobalLowPriorityRPS,
+		}, overload.NewServiceMemberCountFn(c.frontEndService, common.FrontendServiceName),
+			c.frontEndService.GetMetricsClient(), c.logger))
 	dcRedirectionHandler := frontend.NewDCRedirectionHandler(c.frontendHandler, params.DCRedirectionPolicy)
 	dcRedirectionHandler.RegisterHandler()
 
@@ -510,6 +537,7 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 		historyConfig.EnableEventsV2 = dynamicconfig.GetBoolPropertyFnFilteredByDomain(enableEventsV2)
 		historyConfig.DecisionHeartbeatTimeout = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Second * 5)
 		historyConfig.TimerProcessorHistoryArchivalSizeLimit = dynamicconfig.GetIntPropertyFn(5 * 1024)
+		historyConfig.TaskAuditor = c.taskAuditor
 		if c.workerConfig.EnableIndexer {
 			historyConfig.AdvancedVisibilityWritingMode = dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeDual)
 		}
@@ -540,8 +568,15 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 			c.logger.Fatal("Failed to register archiver bootstrap container for history service", tag.Error(err))
 		}
 
+		overloadController := overload.NewController(&overload.Config{
+			EnableLoadShedding:   historyConfig.EnableLoadShedding,
+			LatencyThreshold:     historyConfig.OverloadLatencyThreshold,
+			ErrorRatioThreshold:  historyConfig.OverloadErrorRatioThreshold,
+			EvaluationInterval:   historyConfig.OverloadEvaluationInterval,
+			GlobalLowPriorityRPS: historyConfig.OverloadGlobalLowPriorityRPS,
+		}, overload.NewServiceMemberCountFn(service, common.HistoryServiceName), service.GetMetricsClient(), c.logger)
 		handler := history.NewHandler(service, historyConfig, c.shardMgr, c.metadataMgr,
-			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory, domainCache, params.PublicClient)
+			c.visibilityMgr, c.historyMgr, c.historyV2Mgr, c.executionMgrFactory, domainCache, params.PublicClient, overloadController)
 		handler.RegisterHandler()
 
 		service.Start()
@@ -707,7 +742,8 @@ func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, servic
 		c.esClient,
 		c.esConfig,
 		c.logger,
-		service.GetMetricsClient())
+		service.GetMetricsClient(),
+		service.GetMeter())
 	if err := c.indexer.Start(); err != nil {
 		c.indexer.Stop()
 		c.logger.Fatal("Fail to start indexer when start worker", tag.Error(err))
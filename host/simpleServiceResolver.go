@@ -53,3 +53,7 @@ func (s *simpleResolver) AddListener(name string, notifyChannel chan<- *membersh
 func (s *simpleResolver) RemoveListener(name string) error {
 	return nil
 }
+
+func (s *simpleResolver) MemberCount() int {
+	return len(s.hosts)
+}
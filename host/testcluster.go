@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/persistence/persistence-tests"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/history"
 	"go.uber.org/zap"
 )
 
@@ -96,6 +97,10 @@ const defaultTestValueOfESIndexMaxResultWindow = 5
 
 // NewCluster creates and sets up the test cluster
 func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, error) {
+	return newCluster(options, getMessagingClient(options.MessagingClientConfig, logger), logger)
+}
+
+func newCluster(options *TestClusterConfig, messagingClient messaging.Client, logger log.Logger) (*TestCluster, error) {
 
 	clusterMetadata := cluster.GetTestClusterMetadata(
 		options.ClusterMetadata.EnableGlobalDomain,
@@ -119,7 +124,6 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 	testBase.Setup()
 	setupShards(testBase, options.HistoryConfig.NumHistoryShards, logger)
 	archiverBase := newArchiverBase(options.EnableArchival, logger)
-	messagingClient := getMessagingClient(options.MessagingClientConfig, logger)
 	var esClient elasticsearch.Client
 	var esVisibilityMgr persistence.VisibilityManager
 	advancedVisibilityWritingMode := dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOff)
@@ -131,7 +135,6 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 			return nil, err
 		}
 
-		indexName := options.ESConfig.Indices[common.VisibilityAppName]
 		visProducer, err := messagingClient.NewProducer(common.VisibilityAppName)
 		if err != nil {
 			return nil, err
@@ -141,8 +144,8 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 			ESIndexMaxResultWindow: dynamicconfig.GetIntPropertyFn(defaultTestValueOfESIndexMaxResultWindow),
 			ValidSearchAttributes:  dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 		}
-		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, indexName, visProducer, visConfig, logger)
-		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, logger)
+		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, options.ESConfig, visProducer, visConfig, logger)
+		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, nil, logger)
 	}
 	visibilityMgr := persistence.NewVisibilityManagerWrapper(testBase.VisibilityMgr, esVisibilityMgr,
 		dynamicconfig.GetBoolPropertyFnFilteredByDomain(options.WorkerConfig.EnableIndexer), advancedVisibilityWritingMode)
@@ -266,3 +269,8 @@ func (tc *TestCluster) GetFrontendClient() FrontendClient {
 func (tc *TestCluster) GetAdminClient() AdminClient {
 	return tc.host.GetAdminClient()
 }
+
+// GetTaskAuditor returns the task auditor of the history hosts, nil if task auditing is not enabled
+func (tc *TestCluster) GetTaskAuditor() *history.TaskAuditor {
+	return tc.host.GetTaskAuditor()
+}
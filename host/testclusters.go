@@ -0,0 +1,133 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// TestClusters is a set of in-process clusters for cross DC integration tests, the clusters
+	// replicate to each other through an in memory broker instead of Kafka
+	TestClusters struct {
+		names    []string
+		clusters map[string]*TestCluster
+		broker   *messaging.InMemoryBroker
+	}
+)
+
+const (
+	// maxTestClusters is the max number of clusters in a test environment, see frontendAddress
+	maxTestClusters = 4
+
+	testClustersFailoverVersionIncrement = 10
+)
+
+// NewTestClustersConfig returns the configs of clusters with the given names replicating to each other,
+// the first cluster is the master cluster and the initial failover version of each cluster is its index
+func NewTestClustersConfig(clusterNames ...string) []*TestClusterConfig {
+	clusterInformation := make(map[string]config.ClusterInformation, len(clusterNames))
+	for i, name := range clusterNames {
+		clusterInformation[name] = config.ClusterInformation{
+			Enabled:                true,
+			InitialFailoverVersion: int64(i),
+			RPCName:                common.FrontendServiceName,
+			RPCAddress:             frontendAddress(i),
+		}
+	}
+
+	configs := make([]*TestClusterConfig, 0, len(clusterNames))
+	for i, name := range clusterNames {
+		configs = append(configs, &TestClusterConfig{
+			ClusterNo: i,
+			ClusterMetadata: config.ClusterMetadata{
+				EnableGlobalDomain:       true,
+				ReplicationConsumer:      &config.ReplicationConsumerConfig{Type: config.ReplicationConsumerTypeKafka},
+				FailoverVersionIncrement: testClustersFailoverVersionIncrement,
+				MasterClusterName:        clusterNames[0],
+				CurrentClusterName:       name,
+				ClusterInformation:       clusterInformation,
+			},
+			Persistence: persistencetests.TestBaseOptions{
+				DBName: "integration_" + name,
+			},
+			HistoryConfig: &HistoryConfig{
+				NumHistoryShards: 1,
+				NumHistoryHosts:  1,
+			},
+			WorkerConfig: &WorkerConfig{
+				EnableReplicator: true,
+			},
+		})
+	}
+	return configs
+}
+
+// NewTestClusters creates and starts a cluster for each of the configs, the messaging client config
+// of the configs is ignored as all clusters share the same in memory broker
+func NewTestClusters(options []*TestClusterConfig, logger log.Logger) (*TestClusters, error) {
+	if len(options) > maxTestClusters {
+		return nil, fmt.Errorf("at most %v test clusters are supported", maxTestClusters)
+	}
+
+	tc := &TestClusters{
+		clusters: make(map[string]*TestCluster, len(options)),
+		broker:   messaging.NewInMemoryBroker(),
+	}
+	for _, option := range options {
+		name := option.ClusterMetadata.CurrentClusterName
+		clusterLogger := logger.WithTags(tag.ClusterName(name))
+		cluster, err := newCluster(option, messaging.NewInMemoryClient(tc.broker, name, clusterLogger), clusterLogger)
+		if err != nil {
+			tc.TearDown()
+			return nil, err
+		}
+		tc.names = append(tc.names, name)
+		tc.clusters[name] = cluster
+	}
+	return tc, nil
+}
+
+// GetCluster returns the cluster with the given name, nil if there is no such cluster
+func (tc *TestClusters) GetCluster(name string) *TestCluster {
+	return tc.clusters[name]
+}
+
+// GetClusterNames returns the names of the clusters in the order of their configs
+func (tc *TestClusters) GetClusterNames() []string {
+	return tc.names
+}
+
+// TearDown tears down all the clusters
+func (tc *TestClusters) TearDown() {
+	for _, name := range tc.names {
+		tc.clusters[name].TearDownCluster()
+	}
+	tc.names = nil
+	tc.clusters = make(map[string]*TestCluster)
+}
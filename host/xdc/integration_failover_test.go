@@ -57,6 +57,7 @@ type (
 		// not merely log an error
 		*require.Assertions
 		suite.Suite
+		clusters       *host.TestClusters
 		cluster1       *host.TestCluster
 		cluster2       *host.TestCluster
 		logger         log.Logger
@@ -109,13 +110,10 @@ func (s *integrationClustersTestSuite) SetupSuite() {
 	var clusterConfigs []*host.TestClusterConfig
 	s.Require().NoError(yaml.Unmarshal(confContent, &clusterConfigs))
 
-	c, err := host.NewCluster(clusterConfigs[0], s.logger.WithTags(tag.ClusterName(clusterName[0])))
+	s.clusters, err = host.NewTestClusters(clusterConfigs, s.logger)
 	s.Require().NoError(err)
-	s.cluster1 = c
-
-	c, err = host.NewCluster(clusterConfigs[1], s.logger.WithTags(tag.ClusterName(clusterName[1])))
-	s.Require().NoError(err)
-	s.cluster2 = c
+	s.cluster1 = s.clusters.GetCluster(clusterName[0])
+	s.cluster2 = s.clusters.GetCluster(clusterName[1])
 }
 
 func (s *integrationClustersTestSuite) SetupTest() {
@@ -124,8 +122,7 @@ func (s *integrationClustersTestSuite) SetupTest() {
 }
 
 func (s *integrationClustersTestSuite) TearDownSuite() {
-	s.cluster1.TearDownCluster()
-	s.cluster2.TearDownCluster()
+	s.clusters.TearDown()
 }
 
 func (s *integrationClustersTestSuite) TestDomainFailover() {
@@ -0,0 +1,135 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build !race
+// need to run xdc tests with race detector off because of ringpop bug causing data race issue
+
+package xdc
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/host"
+	"go.uber.org/zap"
+)
+
+type (
+	// testClustersSuite runs clusters created programmatically, their replication tasks go through
+	// the in memory broker of the test clusters
+	testClustersSuite struct {
+		*require.Assertions
+		suite.Suite
+		clusters *host.TestClusters
+		logger   log.Logger
+	}
+)
+
+func TestTestClustersSuite(t *testing.T) {
+	flag.Parse()
+	suite.Run(t, new(testClustersSuite))
+}
+
+func (s *testClustersSuite) SetupSuite() {
+	zapLogger, err := zap.NewDevelopment()
+	s.Require().NoError(err)
+	s.logger = loggerimpl.NewLogger(zapLogger)
+
+	configs := host.NewTestClustersConfig(clusterName...)
+	for _, config := range configs {
+		config.Persistence.DBName = "integration_in_memory_" + config.ClusterMetadata.CurrentClusterName
+	}
+	s.clusters, err = host.NewTestClusters(configs, s.logger)
+	s.Require().NoError(err)
+}
+
+func (s *testClustersSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *testClustersSuite) TearDownSuite() {
+	s.clusters.TearDown()
+}
+
+func (s *testClustersSuite) TestReplication() {
+	s.Equal(clusterName, s.clusters.GetClusterNames())
+	client1 := s.clusters.GetCluster(clusterName[0]).GetFrontendClient() // active
+	client2 := s.clusters.GetCluster(clusterName[1]).GetFrontendClient() // standby
+
+	domainName := "test-in-memory-replication-" + common.GenerateRandomString(5)
+	err := client1.RegisterDomain(createContext(), &workflow.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domainName),
+		IsGlobalDomain:                         common.BoolPtr(true),
+		Clusters:                               clusterReplicationConfig,
+		ActiveClusterName:                      common.StringPtr(clusterName[0]),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(1),
+	})
+	s.NoError(err)
+
+	// the domain is replicated to the standby cluster
+	descReq := &workflow.DescribeDomainRequest{Name: common.StringPtr(domainName)}
+	s.True(s.eventually(func() bool {
+		_, err := client2.DescribeDomain(createContext(), descReq)
+		return err == nil
+	}))
+	time.Sleep(cacheRefreshInterval)
+
+	we, err := client1.StartWorkflowExecution(createContext(), &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(domainName),
+		WorkflowId:                          common.StringPtr("integration-in-memory-replication-test"),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("integration-in-memory-replication-test-type")},
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr("integration-in-memory-replication-test-tasklist")},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr("worker1"),
+	})
+	s.NoError(err)
+
+	// the history of the workflow is replicated to the standby cluster
+	s.True(s.eventually(func() bool {
+		resp, err := client2.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+			Domain: common.StringPtr(domainName),
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr("integration-in-memory-replication-test"),
+				RunId:      we.RunId,
+			},
+		})
+		return err == nil && resp.WorkflowExecutionInfo.GetHistoryLength() > 0
+	}))
+}
+
+func (s *testClustersSuite) eventually(condition func() bool) bool {
+	for i := 0; i < 30; i++ {
+		if condition() {
+			return true
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return false
+}
@@ -61,6 +61,27 @@ service AdminService {
       3: shared.AccessDeniedError     accessDeniedError,
     )
 
+  /**
+  * DescribeQueue returns the ack levels of a transfer, timer or replication queue of a shard
+  **/
+  shared.DescribeQueueResponse DescribeQueue(1: shared.DescribeQueueRequest request)
+    throws (
+      1: shared.BadRequestError       badRequestError,
+      2: shared.InternalServiceError  internalServiceError,
+      3: shared.AccessDeniedError     accessDeniedError,
+    )
+
+  /**
+  * ResetQueueState moves the ack level of a queue of a shard, so that the tasks after the new ack level are
+  * processed again by the queue processor. Moving the ack level forward skips tasks and requires force.
+  **/
+  void ResetQueueState(1: shared.ResetQueueStateRequest request)
+    throws (
+      1: shared.BadRequestError       badRequestError,
+      2: shared.InternalServiceError  internalServiceError,
+      3: shared.AccessDeniedError     accessDeniedError,
+    )
+
 
   /**
   * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
@@ -83,6 +104,127 @@ service AdminService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * AddOperatorAnnotation records an operator note on a running workflow execution. The note is kept in the
+  * OperatorAnnotations memo field of the execution rather than in its history, so workflow replay is not affected.
+  **/
+  void AddOperatorAnnotation(1: shared.AddOperatorAnnotationRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+    )
+
+  /**
+  * CheckFailoverReadiness verifies whether a domain can be safely failed over to the target cluster.
+  **/
+  CheckFailoverReadinessResponse CheckFailoverReadiness(1: CheckFailoverReadinessRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DescribeShardDistribution returns the history hosts owning each history shard of the cluster.
+  **/
+  DescribeShardDistributionResponse DescribeShardDistribution(1: DescribeShardDistributionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DeleteWorkflowExecution removes the mutable state, current execution record, history and visibility records
+  * of a workflow execution. Only closed executions are deleted unless force is set, in which case running or
+  * corrupted executions are deleted as well and failures of individual steps are skipped.
+  **/
+  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReindexWorkflowExecution re-sends the start fields, memo and search attributes of a running workflow
+  * execution to visibility, used to backfill executions started before advanced visibility was enabled.
+  **/
+  void ReindexWorkflowExecution(1: ReindexWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UndeprecateDomain reverts a DeprecateDomain call, updating the status of a deprecated domain back to REGISTERED
+  * so that new workflow executions can be started in it again.
+  **/
+  void UndeprecateDomain(1: UndeprecateDomainRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * SetTerminationProtection sets or clears the termination protected flag of a running workflow execution.
+  * A termination protected workflow execution can only be terminated with the force flag.
+  **/
+  void SetTerminationProtection(1: SetTerminationProtectionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ListWorkers returns the workers which polled the decision and activity tasklists of the given name recently,
+  * with their client versions and last poll times. A worker is stale if it has not polled for a while.
+  **/
+  ListWorkersResponse ListWorkers(1: ListWorkersRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DescribeDynamicConfig returns the dynamic config keys with their types and the values applied for the given
+  * domain, tasklist, task type and shard. Default values are only returned for the keys read by the services of
+  * the frontend host serving the request.
+  **/
+  DescribeDynamicConfigResponse DescribeDynamicConfig(1: DescribeDynamicConfigRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MigrateDomain starts the system workflow which moves a local domain to another cluster: the domain is
+  * promoted to a global domain, its existing workflow executions are replicated and checked for parity,
+  * the domain fails over to the target cluster and is converted back into a local domain there.
+  **/
+  MigrateDomainResponse MigrateDomain(1: MigrateDomainRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -116,3 +258,116 @@ struct GetWorkflowExecutionRawHistoryResponse {
 struct AddSearchAttributeRequest {
   10: optional map<string, shared.IndexedValueType> searchAttribute
 }
+
+enum FailoverReadinessCheckStatus {
+  PASSED,
+  FAILED,
+  UNVERIFIED,
+}
+
+struct FailoverReadinessCheck {
+  10: optional string name
+  20: optional FailoverReadinessCheckStatus status
+  30: optional string details
+}
+
+struct CheckFailoverReadinessRequest {
+  10: optional string domain
+  20: optional string targetCluster
+}
+
+struct CheckFailoverReadinessResponse {
+  10: optional bool ready
+  20: optional list<FailoverReadinessCheck> checks
+}
+
+struct DescribeShardDistributionRequest {
+}
+
+struct DescribeShardDistributionResponse {
+  10: optional i32 numberOfShards
+  20: optional list<HistoryHostShards> hosts
+  30: optional i32 minShardsPerHost
+  40: optional i32 maxShardsPerHost
+}
+
+struct HistoryHostShards {
+  10: optional string address
+  20: optional list<i32> shardIDs
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional bool force
+  40: optional string reason
+  50: optional string identity
+}
+
+struct ReindexWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+}
+
+struct UndeprecateDomainRequest {
+  10: optional string name
+}
+
+struct SetTerminationProtectionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional bool terminationProtected
+  40: optional string identity
+}
+
+struct ListWorkersRequest {
+  10: optional string domain
+  20: optional shared.TaskList taskList
+}
+
+struct ListWorkersResponse {
+  10: optional list<shared.WorkerInfo> workers
+  // onlyStaleWorkers is true if workers polled the tasklists but none of them is polling anymore
+  20: optional bool onlyStaleWorkers
+}
+
+struct DescribeDynamicConfigRequest {
+  // keyPrefix limits the response to the keys whose name starts with it
+  10: optional string keyPrefix
+  20: optional string domain
+  30: optional string taskList
+  40: optional shared.TaskListType taskType
+  50: optional i32 shardID
+}
+
+struct DescribeDynamicConfigResponse {
+  10: optional list<DynamicConfigEntry> entries
+}
+
+struct DynamicConfigEntry {
+  10: optional string name
+  20: optional string valueType
+  30: optional string defaultValue
+  // value is the value applied for the filters of the request which the key can be constrained by
+  40: optional string value
+  // isOverridden is true if value comes from the dynamic config source rather than the default value
+  50: optional bool isOverridden
+  60: optional list<string> filters
+  70: optional list<DynamicConfigOverride> overrides
+}
+
+struct DynamicConfigOverride {
+  10: optional string value
+  20: optional map<string, string> constraints
+}
+
+struct MigrateDomainRequest {
+  10: optional string domain
+  20: optional string targetCluster
+  30: optional string identity
+}
+
+struct MigrateDomainResponse {
+  10: optional string workflowId
+  20: optional string runId
+}
@@ -379,6 +379,7 @@ service WorkflowService {
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.PreconditionFailedError preconditionFailedError,
     )
 
   /**
@@ -430,6 +431,35 @@ service WorkflowService {
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution. While paused, pending decision / activity tasks
+  * are not dispatched and timers are deferred, signals are still accepted. Use 'ResumeWorkflowExecution' to resume.
+  **/
+  void PauseWorkflowExecution(1: shared.PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * ResumeWorkflowExecution resumes a workflow execution paused by 'PauseWorkflowExecution'.
+  **/
+  void ResumeWorkflowExecution(1: shared.ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
   **/
@@ -513,6 +543,19 @@ service WorkflowService {
       3: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * GetSearchAttributesSchema returns all legal search attribute keys, together with the search attribute
+  * count and size limits of the given domain, so that clients can validate search attributes before use
+  **/
+  shared.GetSearchAttributesSchemaResponse GetSearchAttributesSchema(1: shared.GetSearchAttributesSchemaRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)
   * as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'
@@ -576,6 +619,62 @@ service WorkflowService {
       6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * BatchDescribeWorkflowExecutions returns the summary of a batch of workflow executions of one domain, including
+  * the close event of the closed ones. Summaries are returned in the order of the requested executions, an execution
+  * which cannot be described has the error of its summary set instead of failing the whole batch.
+  **/
+  shared.BatchDescribeWorkflowExecutionsResponse BatchDescribeWorkflowExecutions(1: shared.BatchDescribeWorkflowExecutionsRequest batchRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.LimitExceededError limitExceededError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * BatchOperationWorkflow starts a batch job which terminates, cancels or signals all workflow executions of a domain
+  * matching a visibility query. The job runs as a system workflow, its progress is returned by DescribeBatchOperation.
+  **/
+  shared.BatchOperationWorkflowResponse BatchOperationWorkflow(1: shared.BatchOperationWorkflowRequest batchRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * DescribeBatchOperation returns the state and progress of a batch job started by BatchOperationWorkflow.
+  **/
+  shared.DescribeBatchOperationResponse DescribeBatchOperation(1: shared.DescribeBatchOperationRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * ListWorkflowExecutionChain returns all runs in the continue-as-new / retry / cron / reset chain the specified
+  * workflow execution belongs to, newest first. If runId is not set, the chain of the current run is returned.
+  * Runs are returned in pages, a page may hold fewer runs than pageSize while nextPageToken is still set.
+  **/
+  shared.ListWorkflowExecutionChainResponse ListWorkflowExecutionChain(1: shared.ListWorkflowExecutionChainRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.LimitExceededError limitExceededError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * DescribeTaskList returns information about the target tasklist, right now this API returns the
   * pollers which polled this tasklist in last few minutes.
@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+namespace java com.uber.cadence.cassandrablobs
+
+// blobs stored by the cassandra persistence, timestamps are encoded as unix nanos
+
+struct ActivityInfo {
+  10: optional i64 (js.type = "Long") version
+  12: optional i64 (js.type = "Long") scheduleID
+  14: optional i64 (js.type = "Long") scheduledEventBatchID
+  16: optional binary scheduledEvent
+  18: optional string scheduledEventEncoding
+  20: optional i64 (js.type = "Long") scheduledTimeNanos
+  22: optional i64 (js.type = "Long") startedID
+  24: optional binary startedEvent
+  26: optional string startedEventEncoding
+  28: optional i64 (js.type = "Long") startedTimeNanos
+  30: optional string activityID
+  32: optional string requestID
+  34: optional binary details
+  36: optional i32 scheduleToStartTimeoutSeconds
+  38: optional i32 scheduleToCloseTimeoutSeconds
+  40: optional i32 startToCloseTimeoutSeconds
+  42: optional i32 heartbeatTimeoutSeconds
+  44: optional bool cancelRequested
+  46: optional i64 (js.type = "Long") cancelRequestID
+  48: optional i64 (js.type = "Long") lastHeartbeatUpdatedTimeNanos
+  50: optional i32 timerTaskStatus
+  52: optional i32 attempt
+  54: optional string domainID
+  56: optional string startedIdentity
+  58: optional string taskList
+  60: optional bool hasRetryPolicy
+  62: optional i32 retryInitialIntervalSeconds
+  64: optional i32 retryMaximumIntervalSeconds
+  66: optional i32 retryMaximumAttempts
+  68: optional i64 (js.type = "Long") retryExpirationTimeNanos
+  70: optional double retryBackoffCoefficient
+  72: optional list<string> retryNonRetryableErrors
+  74: optional string retryLastFailureReason
+  76: optional string retryLastWorkerIdentity
+  78: optional binary retryLastFailureDetails
+}
+
+struct TimerInfo {
+  10: optional i64 (js.type = "Long") version
+  12: optional string timerID
+  14: optional i64 (js.type = "Long") startedID
+  16: optional i64 (js.type = "Long") expiryTimeNanos
+  18: optional i64 (js.type = "Long") taskID
+}
+
+struct ChildExecutionInfo {
+  10: optional i64 (js.type = "Long") version
+  12: optional i64 (js.type = "Long") initiatedID
+  14: optional i64 (js.type = "Long") initiatedEventBatchID
+  16: optional binary initiatedEvent
+  18: optional string initiatedEventEncoding
+  20: optional i64 (js.type = "Long") startedID
+  22: optional string startedWorkflowID
+  24: optional string startedRunID
+  26: optional binary startedEvent
+  28: optional string startedEventEncoding
+  30: optional string createRequestID
+  32: optional string domainName
+  34: optional string workflowTypeName
+  36: optional i32 parentClosePolicy
+}
+
+struct RequestCancelInfo {
+  10: optional i64 (js.type = "Long") version
+  12: optional i64 (js.type = "Long") initiatedID
+  14: optional i64 (js.type = "Long") initiatedEventBatchID
+  16: optional string cancelRequestID
+}
+
+struct SignalInfo {
+  10: optional i64 (js.type = "Long") version
+  12: optional i64 (js.type = "Long") initiatedID
+  14: optional i64 (js.type = "Long") initiatedEventBatchID
+  16: optional string requestID
+  18: optional string name
+  20: optional binary input
+  22: optional binary control
+}
+
+// MutableStateSnapshot is the compacted form of the mutable state maps of an execution
+struct MutableStateSnapshot {
+  10: optional list<ActivityInfo> activityInfos
+  12: optional list<TimerInfo> timerInfos
+  14: optional list<ChildExecutionInfo> childExecutionInfos
+  16: optional list<RequestCancelInfo> requestCancelInfos
+  18: optional list<SignalInfo> signalInfos
+  20: optional list<string> signalRequestedIDs
+}
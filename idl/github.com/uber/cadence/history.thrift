@@ -32,6 +32,11 @@ exception ShardOwnershipLostError {
   20: optional string owner
 }
 
+exception IncompatibleBinaryChecksumError {
+  10: optional string message
+  20: optional string requiredBinaryChecksum
+}
+
 struct ParentExecutionInfo {
   10: optional string domainUUID
   15: optional string domain
@@ -120,6 +125,26 @@ struct RecordActivityTaskHeartbeatRequest {
   20: optional shared.RecordActivityTaskHeartbeatRequest heartbeatRequest
 }
 
+struct RecordActivityTaskHeartbeatBatchRequest {
+  10: optional list<RecordActivityTaskHeartbeatRequest> requests
+}
+
+struct RecordActivityTaskHeartbeatResult {
+  10: optional shared.RecordActivityTaskHeartbeatResponse response
+  20: optional shared.BadRequestError badRequestError
+  30: optional shared.InternalServiceError internalServiceError
+  40: optional shared.EntityNotExistsError entityNotExistError
+  50: optional ShardOwnershipLostError shardOwnershipLostError
+  60: optional shared.DomainNotActiveError domainNotActiveError
+  70: optional shared.LimitExceededError limitExceededError
+  80: optional shared.ServiceBusyError serviceBusyError
+}
+
+struct RecordActivityTaskHeartbeatBatchResponse {
+  // results are in the same order as the requests of the batch
+  10: optional list<RecordActivityTaskHeartbeatResult> results
+}
+
 struct RespondActivityTaskCompletedRequest {
   10: optional string domainUUID
   20: optional shared.RespondActivityTaskCompletedRequest completeRequest
@@ -144,6 +169,13 @@ struct RecordActivityTaskStartedRequest {
   50: optional shared.PollForActivityTaskRequest pollRequest
 }
 
+struct RecordActivityTaskWorkerUnavailableRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution workflowExecution
+  30: optional i64 (js.type = "Long") scheduleId
+  40: optional string taskList
+}
+
 struct RecordActivityTaskStartedResponse {
   20: optional shared.HistoryEvent scheduledEvent
   30: optional i64 (js.type = "Long") startedTimestamp
@@ -186,6 +218,11 @@ struct SignalWorkflowExecutionRequest {
   40: optional bool childWorkflowOnly
 }
 
+struct AddOperatorAnnotationRequest {
+  10: optional string domainUUID
+  20: optional shared.AddOperatorAnnotationRequest annotationRequest
+}
+
 struct SignalWithStartWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest
@@ -202,6 +239,16 @@ struct TerminateWorkflowExecutionRequest {
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseWorkflowExecutionRequest pauseRequest
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResumeWorkflowExecutionRequest resumeRequest
+}
+
 struct ResetWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.ResetWorkflowExecutionRequest resetRequest
@@ -300,6 +347,57 @@ struct QueryWorkflowResponse {
   10: optional binary queryResult
 }
 
+struct GetReplicationStatusRequest {
+  10: optional list<i32> shardIDs
+  // when set, maxReadLevel only covers the replication tasks of this domain
+  20: optional string domainUUID
+}
+
+struct ShardReplicationStatus {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") maxReadLevel
+  30: optional i64 (js.type = "Long") replicationAckLevel
+  40: optional map<string, i64> clusterReplicationLevel
+}
+
+struct GetReplicationStatusResponse {
+  10: optional list<ShardReplicationStatus> shards
+}
+
+struct DetectZombieWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct DetectZombieWorkflowExecutionResponse {
+  10: optional bool isZombie
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional bool force
+  40: optional string reason
+  50: optional string identity
+}
+
+struct ReindexWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct ReplicateWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct SetTerminationProtectionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional bool terminationProtected
+  40: optional string identity
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -358,7 +456,9 @@ service HistoryService {
   /**
   * RecordDecisionTaskStarted is called by the Matchingservice before it hands a decision task to the application worker in response to
   * a PollForDecisionTask call. It records in the history the event that the decision task has started. It will return 'EventAlreadyStartedError',
-  * if the workflow's execution history already includes a record of the event starting.
+  * if the workflow's execution history already includes a record of the event starting. It will return
+  * 'IncompatibleBinaryChecksumError' if the poller's binary checksum is not compatible with the binary checksum
+  * of the worker which completed the last decision of the workflow.
   **/
   RecordDecisionTaskStartedResponse RecordDecisionTaskStarted(1: RecordDecisionTaskStartedRequest addRequest)
     throws (
@@ -370,6 +470,7 @@ service HistoryService {
       6: shared.DomainNotActiveError domainNotActiveError,
       7: shared.LimitExceededError limitExceededError,
       8: shared.ServiceBusyError serviceBusyError,
+      9: IncompatibleBinaryChecksumError incompatibleBinaryChecksumError,
     )
 
   /**
@@ -389,6 +490,22 @@ service HistoryService {
       8: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * RecordActivityTaskWorkerUnavailable is called by the Matchingservice when the worker polling a worker specific
+  * task list is no longer alive. It times out the scheduled activity, if it is not started yet, so the workflow can
+  * reschedule it on another worker.
+  **/
+  void RecordActivityTaskWorkerUnavailable(1: RecordActivityTaskWorkerUnavailableRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * RespondDecisionTaskCompleted is called by application worker to complete a DecisionTask handed as a result of
   * 'PollForDecisionTask' API call.  Completing a DecisionTask will result in new events for the workflow execution and
@@ -441,6 +558,17 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * RecordActivityTaskHeartbeatBatch records the heartbeats of multiple activities owned by the same history host
+  * in a single call.  The outcome of each heartbeat is reported individually in the response.
+  **/
+  RecordActivityTaskHeartbeatBatchResponse RecordActivityTaskHeartbeatBatch(1: RecordActivityTaskHeartbeatBatchRequest batchRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
   * result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
@@ -508,6 +636,21 @@ service HistoryService {
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.LimitExceededError limitExceededError,
+      8: shared.PreconditionFailedError preconditionFailedError,
+    )
+
+  /**
+  * AddOperatorAnnotation records an operator note in the memo of a running workflow execution.
+  * No history event is written and no decision task is created for the execution.
+  **/
+  void AddOperatorAnnotation(1: AddOperatorAnnotationRequest annotationRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.ServiceBusyError serviceBusyError,
     )
 
   /**
@@ -559,6 +702,36 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution, pending decision / activity tasks are not
+  * dispatched and timers are deferred until the workflow execution is resumed.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResumeWorkflowExecution resumes a paused workflow execution, pending decision / activity tasks are dispatched
+  * again and deferred timers are fired.
+  **/
+  void ResumeWorkflowExecution(1: ResumeWorkflowExecutionRequest resumeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * ResetWorkflowExecution reset an existing workflow execution by a firstEventID of a existing event batch
   * in the history and immediately terminating the current execution instance.
@@ -727,6 +900,26 @@ service HistoryService {
      2: shared.InternalServiceError internalServiceError,
      3: shared.AccessDeniedError accessDeniedError,
      )
+
+  /**
+  * DescribeQueue returns the ack levels of a transfer, timer or replication queue of a shard
+  **/
+  shared.DescribeQueueResponse DescribeQueue(1: shared.DescribeQueueRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ResetQueueState moves the ack level of a queue of a shard owned by the host and reloads the shard
+  **/
+  void ResetQueueState(1: shared.ResetQueueStateRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+    )
   replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
     throws (
       1: shared.BadRequestError badRequestError,
@@ -749,4 +942,83 @@ service HistoryService {
 	  6: shared.ServiceBusyError serviceBusyError,
 	  7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
 	)
+
+  /**
+  * GetReplicationStatus returns the replication read and ack levels of the requested shards
+  **/
+  GetReplicationStatusResponse GetReplicationStatus(1: GetReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DetectZombieWorkflowExecution checks whether the workflow execution is a zombie, i.e. it is neither
+  * referenced by the current execution record nor closed, and converts it to zombie state if so
+  **/
+  DetectZombieWorkflowExecutionResponse DetectZombieWorkflowExecution(1: DetectZombieWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DeleteWorkflowExecution removes the mutable state, current execution record, history and visibility records
+  * of a closed workflow execution. With force set, running or corrupted executions are deleted as well and
+  * failures of individual steps are skipped.
+  **/
+  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReindexWorkflowExecution generates a visibility upsert task for a running workflow execution, so that its
+  * start fields, memo and search attributes are re-sent to visibility
+  **/
+  void ReindexWorkflowExecution(1: ReindexWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * SetTerminationProtection sets or clears the termination protected flag of a running workflow execution.
+  * A termination protected workflow execution can only be terminated with the force flag.
+  **/
+  void SetTerminationProtection(1: SetTerminationProtectionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReplicateWorkflowExecution starts replicating a workflow execution which was started before its domain
+  * was promoted to a global domain. The history recorded so far is sent to the other clusters of the domain
+  * and the events written from then on are replicated as usual.
+  **/
+  void ReplicateWorkflowExecution(1: ReplicateWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.DomainNotActiveError domainNotActiveError,
+    )
 }
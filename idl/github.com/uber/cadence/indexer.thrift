@@ -25,6 +25,7 @@ include "shared.thrift"
 enum MessageType {
   Index
   Delete
+  Update
 }
 
 enum FieldType {
@@ -22,6 +22,17 @@ include "shared.thrift"
 
 namespace java com.uber.cadence.matching
 
+enum TaskDispatchFailureReason {
+  RATE_LIMITED,
+  TASK_LIST_DRAINED,
+  NO_POLLERS,
+}
+
+exception TaskDispatchFailedError {
+  10: optional string message
+  20: optional TaskDispatchFailureReason reason
+}
+
 struct PollForDecisionTaskRequest {
   10: optional string domainUUID
   15: optional string pollerID
@@ -62,6 +73,7 @@ struct AddDecisionTaskRequest {
   40: optional i64 (js.type = "Long") scheduleId
   50: optional i32 scheduleToStartTimeoutSeconds
   60: optional string forwardedFrom
+  70: optional i32 priority
 }
 
 struct AddActivityTaskRequest {
@@ -72,6 +84,7 @@ struct AddActivityTaskRequest {
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
   70: optional string forwardedFrom
+  80: optional i32 priority
 }
 
 struct QueryWorkflowRequest {
@@ -100,6 +113,16 @@ struct DescribeTaskListRequest {
   20: optional shared.DescribeTaskListRequest descRequest
 }
 
+struct ListTaskListWorkersRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+}
+
+struct ListTaskListWorkersResponse {
+  10: optional list<shared.WorkerInfo> workers
+}
+
 /**
 * MatchingService API is exposed to provide support for polling from long running applications.
 * Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
@@ -143,6 +166,7 @@ service MatchingService {
       3: shared.ServiceBusyError serviceBusyError,
       4: shared.LimitExceededError limitExceededError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: TaskDispatchFailedError taskDispatchFailedError,
     )
 
   /**
@@ -156,6 +180,7 @@ service MatchingService {
       3: shared.ServiceBusyError serviceBusyError,
       4: shared.LimitExceededError limitExceededError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: TaskDispatchFailedError taskDispatchFailedError,
     )
 
   /**
@@ -210,4 +235,16 @@ service MatchingService {
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
       )
+
+  /**
+  * ListTaskListWorkers returns the workers which polled the target tasklist recently, with their client versions
+  * and last poll times.
+  **/
+  ListTaskListWorkersResponse ListTaskListWorkers(1: ListTaskListWorkersRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.EntityNotExistsError entityNotExistError,
+        4: shared.ServiceBusyError serviceBusyError,
+      )
 }
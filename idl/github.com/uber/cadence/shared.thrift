@@ -59,6 +59,7 @@ exception DomainNotActiveError {
   2: required string domainName
   3: required string currentCluster
   4: required string activeCluster
+  5: optional string activeClusterAddress
 }
 
 exception LimitExceededError {
@@ -83,6 +84,12 @@ exception ClientVersionNotSupportedError {
   3: required string supportedVersions
 }
 
+exception PreconditionFailedError {
+  1: required string message
+  // nextEventId is the current next event ID of the workflow execution
+  2: optional i64 (js.type = "Long") nextEventId
+}
+
 enum WorkflowIdReusePolicy {
   /*
    * allow start a workflow execution using the same workflow ID,
@@ -99,6 +106,12 @@ enum WorkflowIdReusePolicy {
    * do not allow start a workflow execution using the same workflow ID at all
    */
   RejectDuplicate,
+  /*
+   * if a workflow is running using the same workflow ID, terminate it and start
+   * a new one in the same transaction, otherwise behaves as AllowDuplicate.
+   * signal with start still signals the running workflow.
+   */
+  TerminateIfRunning,
 }
 
 enum DomainStatus {
@@ -107,6 +120,12 @@ enum DomainStatus {
   DELETED,
 }
 
+enum DomainFailoverState {
+  ACTIVE,
+  HANDOVER,
+  SWITCHED,
+}
+
 enum TimeoutType {
   START_TO_CLOSE,
   SCHEDULE_TO_START,
@@ -184,6 +203,7 @@ enum EventType {
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
   UpsertWorkflowSearchAttributes,
+  WorkflowExecutionTerminationProtectionSet,
 }
 
 enum DecisionTaskFailedCause {
@@ -250,6 +270,7 @@ enum PendingActivityState {
   SCHEDULED,
   STARTED,
   CANCEL_REQUESTED,
+  NO_POLLERS,
 }
 
 enum HistoryEventFilterType {
@@ -260,6 +281,7 @@ enum HistoryEventFilterType {
 enum TaskListKind {
   NORMAL,
   STICKY,
+  WORKER,
 }
 
 enum ArchivalStatus {
@@ -344,6 +366,28 @@ struct WorkflowExecutionInfo {
   100: optional Memo memo
   101: optional SearchAttributes searchAttributes
   110: optional ResetPoints autoResetPoints
+  120: optional HistoryArchivalInfo historyArchivalInfo
+}
+
+enum HistoryArchivalState {
+  PENDING,
+  ARCHIVED,
+  FAILED,
+}
+
+struct HistoryArchivalInfo {
+  10: optional HistoryArchivalState state
+  20: optional string uri
+  30: optional i64 (js.type = "Long") archivedTime
+  40: optional string failureReason
+}
+
+struct WorkflowExecutionStats {
+  10: optional i64 (js.type = "Long") historySize
+  20: optional i64 (js.type = "Long") eventCount
+  30: optional i64 (js.type = "Long") activityPayloadBytes
+  40: optional i64 (js.type = "Long") signalEventCount
+  50: optional i64 (js.type = "Long") timerCount
 }
 
 struct WorkflowExecutionConfiguration {
@@ -370,6 +414,7 @@ struct ScheduleActivityTaskDecisionAttributes {
   60: optional i32 heartbeatTimeoutSeconds
   70: optional RetryPolicy retryPolicy
   80: optional Header header
+  90: optional i32 delayStartSeconds
 }
 
 struct RequestCancelActivityTaskDecisionAttributes {
@@ -506,6 +551,8 @@ struct WorkflowExecutionStartedEventAttributes {
   121: optional SearchAttributes searchAttributes
   130: optional ResetPoints prevAutoResetPoints
   140: optional Header header
+  150: optional i32 priority
+  160: optional bool terminationProtected
 }
 
 struct ResetPoints{
@@ -612,6 +659,7 @@ struct ActivityTaskScheduledEventAttributes {
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   110: optional RetryPolicy retryPolicy
   120: optional Header header
+  130: optional i32 delayStartSeconds
 }
 
 struct ActivityTaskStartedEventAttributes {
@@ -619,6 +667,8 @@ struct ActivityTaskStartedEventAttributes {
   20: optional string identity
   30: optional string requestId
   40: optional i32 attempt
+  50: optional string lastFailureReason
+  60: optional binary lastFailureDetails
 }
 
 struct ActivityTaskCompletedEventAttributes {
@@ -772,6 +822,11 @@ struct UpsertWorkflowSearchAttributesEventAttributes {
   20: optional SearchAttributes searchAttributes
 }
 
+struct WorkflowExecutionTerminationProtectionSetEventAttributes {
+  10: optional bool terminationProtected
+  20: optional string identity
+}
+
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
   10:  optional string domain
   20:  optional string workflowId
@@ -903,6 +958,7 @@ struct HistoryEvent {
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
   450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
+  460: optional WorkflowExecutionTerminationProtectionSetEventAttributes workflowExecutionTerminationProtectionSetEventAttributes
 }
 
 struct History {
@@ -918,6 +974,11 @@ struct WorkflowTypeFilter {
   10: optional string name
 }
 
+struct MemoFilter {
+  10: optional string key
+  20: optional binary value
+}
+
 struct StartTimeFilter {
   10: optional i64 (js.type = "Long") earliestTime
   20: optional i64 (js.type = "Long") latestTime
@@ -941,6 +1002,7 @@ struct DomainConfiguration {
   90: optional string historyArchivalURI
   100: optional ArchivalStatus visibilityArchivalStatus
   110: optional string visibilityArchivalURI
+  120: optional list<string> knownTaskLists
 }
 
 struct BadBinaries{
@@ -962,11 +1024,15 @@ struct UpdateDomainInfo {
 
 struct ClusterReplicationConfiguration {
  10: optional string clusterName
+ // initialFailoverVersion of the cluster, only set in responses
+ 20: optional i64 (js.type = "Long") initialFailoverVersion
 }
 
 struct DomainReplicationConfiguration {
  10: optional string activeClusterName
  20: optional list<ClusterReplicationConfiguration> clusters
+ 30: optional DomainFailoverState failoverState
+ 40: optional string pendingActiveClusterName
 }
 
 struct RegisterDomainRequest {
@@ -1008,6 +1074,8 @@ struct DescribeDomainResponse {
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  // previousFailoverVersion is the failover version before the last failover of the domain
+  60: optional i64 (js.type = "Long") previousFailoverVersion
 }
 
 struct UpdateDomainRequest {
@@ -1017,6 +1085,15 @@ struct UpdateDomainRequest {
  40: optional DomainReplicationConfiguration replicationConfiguration
  50: optional string securityToken
  60: optional string deleteBadBinary
+ // when set together with replicationConfiguration.activeClusterName, the domain enters handover
+ // state and writes are rejected until replication drains, after which the target becomes active
+ 70: optional bool gracefulFailover
+ // converts a local domain into a global domain, replicationConfiguration.clusters may add the clusters
+ // it is replicated to. Only the domain and workflows started after the conversion are replicated
+ 80: optional bool promoteToGlobalDomain
+ // converts a global domain back into a local domain of its active cluster, the other clusters keep the
+ // domain as it was replicated to them last
+ 90: optional bool demoteToLocalDomain
 }
 
 struct UpdateDomainResponse {
@@ -1049,6 +1126,8 @@ struct StartWorkflowExecutionRequest {
   140: optional Memo memo
   141: optional SearchAttributes searchAttributes
   150: optional Header header
+  160: optional i32 priority
+  170: optional bool terminationProtected
 }
 
 struct StartWorkflowExecutionResponse {
@@ -1094,6 +1173,7 @@ struct RespondDecisionTaskCompletedRequest {
   70: optional bool forceCreateNewDecisionTask
   80: optional string binaryChecksum
   90: optional list<WorkflowQueryResult> queryResults
+  100: optional list<string> queryTypes
 }
 
 struct RespondDecisionTaskCompletedResponse {
@@ -1137,6 +1217,7 @@ struct RecordActivityTaskHeartbeatRequest {
   10: optional binary taskToken
   20: optional binary details
   30: optional string identity
+  40: optional i32 cancellationWaitSeconds
 }
 
 struct RecordActivityTaskHeartbeatByIDRequest {
@@ -1146,6 +1227,7 @@ struct RecordActivityTaskHeartbeatByIDRequest {
   40: optional string activityID
   50: optional binary details
   60: optional string identity
+  70: optional i32 cancellationWaitSeconds
 }
 
 struct RecordActivityTaskHeartbeatResponse {
@@ -1213,6 +1295,8 @@ struct GetWorkflowExecutionHistoryRequest {
   40: optional binary nextPageToken
   50: optional bool waitForNewEvent
   60: optional HistoryEventFilterType HistoryEventFilterType
+  // when set, paging continues into the history of the new run once a WorkflowExecutionContinuedAsNew event is reached
+  70: optional bool followContinuedAsNew
 }
 
 struct GetWorkflowExecutionHistoryResponse {
@@ -1229,6 +1313,10 @@ struct SignalWorkflowExecutionRequest {
   50: optional string identity
   60: optional string requestId
   70: optional binary control
+  // expectedNextEventId rejects the signal with PreconditionFailedError if the next event ID
+  // of the workflow execution is different, i.e. the workflow advanced past the observed point,
+  // or if events received during an in-flight decision are still buffered
+  80: optional i64 (js.type = "Long") expectedNextEventId
 }
 
 struct SignalWithStartWorkflowExecutionRequest {
@@ -1250,6 +1338,7 @@ struct SignalWithStartWorkflowExecutionRequest {
   160: optional Memo memo
   161: optional SearchAttributes searchAttributes
   170: optional Header header
+  180: optional bool terminationProtected
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1258,6 +1347,22 @@ struct TerminateWorkflowExecutionRequest {
   30: optional string reason
   40: optional binary details
   50: optional string identity
+  // force is required to terminate a termination protected workflow execution
+  60: optional bool force
+  70: optional string securityToken
+}
+
+struct PauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct ResumeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string identity
 }
 
 struct ResetWorkflowExecutionRequest {
@@ -1294,6 +1399,7 @@ struct ListClosedWorkflowExecutionsRequest {
   50: optional WorkflowExecutionFilter executionFilter
   60: optional WorkflowTypeFilter typeFilter
   70: optional WorkflowExecutionCloseStatus statusFilter
+  80: optional MemoFilter memoFilter
 }
 
 struct ListClosedWorkflowExecutionsResponse {
@@ -1306,6 +1412,10 @@ struct ListWorkflowExecutionsRequest {
   20: optional i32 pageSize
   30: optional binary nextPageToken
   40: optional string query
+  // sliceID and sliceCount are only honored by ScanWorkflowExecutions, they split the scan into
+  // sliceCount disjoint slices which can be read in parallel, the nextPageToken is bound to its slice
+  50: optional i32 sliceID
+  60: optional i32 sliceCount
 }
 
 struct ListWorkflowExecutionsResponse {
@@ -1338,6 +1448,17 @@ struct GetSearchAttributesResponse {
   10: optional map<string, IndexedValueType> keys
 }
 
+struct GetSearchAttributesSchemaRequest {
+  10: optional string domain
+}
+
+struct GetSearchAttributesSchemaResponse {
+  10: optional map<string, IndexedValueType> keys
+  20: optional i32 numberOfKeysLimit
+  30: optional i32 sizeOfValueLimit
+  40: optional i32 totalSizeLimit
+}
+
 struct QueryWorkflowRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1403,6 +1524,7 @@ struct PendingActivityInfo {
   110: optional string lastFailureReason
   120: optional string lastWorkerIdentity
   130: optional binary lastFailureDetails
+  140: optional i64 (js.type = "Long") nextRetryTimestamp
 }
 
 struct PendingChildExecutionInfo {
@@ -1418,6 +1540,101 @@ struct DescribeWorkflowExecutionResponse {
   20: optional WorkflowExecutionInfo workflowExecutionInfo
   30: optional list<PendingActivityInfo> pendingActivities
   40: optional list<PendingChildExecutionInfo> pendingChildren
+  50: optional bool paused
+  60: optional WorkflowExecutionStats executionStats
+  70: optional bool terminationProtected
+}
+
+struct BatchDescribeWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional list<WorkflowExecution> executions
+}
+
+struct WorkflowExecutionSummary {
+  10: optional WorkflowExecution execution
+  20: optional WorkflowExecutionInfo executionInfo
+  // closeEvent is the last event of the execution, only set if the execution is closed
+  30: optional HistoryEvent closeEvent
+  // error is set if the execution could not be described
+  40: optional string error
+}
+
+struct BatchDescribeWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionSummary> executions
+}
+
+enum BatchOperationType {
+  Terminate,
+  Cancel,
+  Signal,
+}
+
+enum BatchOperationState {
+  Running,
+  Completed,
+  Failed,
+}
+
+struct BatchOperationWorkflowRequest {
+  10: optional string domain
+  // query selects the workflow executions of the domain to operate on, in the visibility query syntax
+  20: optional string query
+  30: optional BatchOperationType operationType
+  40: optional string reason
+  50: optional string identity
+  // requestId is used as the jobID if set, so that retrying the request does not start a second job
+  60: optional string requestId
+  // signalName and signalInput are only used by signal operations
+  70: optional string signalName
+  80: optional binary signalInput
+  // rps is the max number of executions processed per second, the batcher default is used if not set
+  90: optional i32 rps
+}
+
+struct BatchOperationWorkflowResponse {
+  10: optional string jobID
+}
+
+struct DescribeBatchOperationRequest {
+  10: optional string domain
+  20: optional string jobID
+}
+
+struct DescribeBatchOperationResponse {
+  10: optional string jobID
+  20: optional BatchOperationState state
+  30: optional BatchOperationType operationType
+  40: optional string query
+  50: optional string reason
+  60: optional string identity
+  70: optional i64 startTime
+  80: optional i64 closeTime
+  // totalEstimate is the number of executions matching the query when the job started
+  90: optional i64 totalEstimate
+  100: optional i64 successCount
+  110: optional i64 errorCount
+  // failureReason is set if the job failed, was canceled, terminated or timed out
+  120: optional string failureReason
+}
+
+struct ListWorkflowExecutionChainRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional i32 pageSize
+  40: optional binary nextPageToken
+}
+
+struct WorkflowExecutionChainEntry {
+  10: optional WorkflowExecutionInfo executionInfo
+  20: optional string continuedExecutionRunId
+  30: optional ContinueAsNewInitiator initiator
+  40: optional string originalExecutionRunId
+}
+
+struct ListWorkflowExecutionChainResponse {
+  10: optional string firstExecutionRunId
+  20: optional list<WorkflowExecutionChainEntry> executions
+  30: optional binary nextPageToken
 }
 
 struct DescribeTaskListRequest {
@@ -1430,6 +1647,13 @@ struct DescribeTaskListRequest {
 struct DescribeTaskListResponse {
   10: optional list<PollerInfo> pollers
   20: optional TaskListStatus taskListStatus
+  30: optional TaskListPartitionConfig partitionConfig
+}
+
+struct TaskListPartitionConfig {
+  10: optional i64 (js.type = "Long") version
+  20: optional i32 numReadPartitions
+  30: optional i32 numWritePartitions
 }
 
 struct TaskListStatus {
@@ -1462,12 +1686,55 @@ struct CloseShardRequest {
   10: optional i32               shardID
 }
 
+enum QueueType {
+  TRANSFER,
+  TIMER,
+  REPLICATION,
+}
+
+struct DescribeQueueRequest {
+  10: optional i32                      shardID
+  20: optional QueueType                type
+}
+
+struct DescribeQueueResponse {
+  // ack levels of timer queue are in unix nanoseconds, ack levels of other queues are task IDs
+  10: optional i64 (js.type = "Long")   ackLevel
+  20: optional map<string, i64>         clusterAckLevels
+}
+
+struct ResetQueueStateRequest {
+  10: optional i32                      shardID
+  20: optional QueueType                type
+  // empty cluster name resets the ack level of the shard instead of the one of a cluster
+  30: optional string                   clusterName
+  40: optional i64 (js.type = "Long")   ackLevel
+  // force allows moving the ack level forward, which skips the tasks before the new ack level
+  50: optional bool                     force
+}
+
+struct AddOperatorAnnotationRequest {
+  10: optional string            domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string            message
+  40: optional string            identity
+}
+
 struct DescribeHistoryHostResponse{
   10: optional i32                  numberOfShards
   20: optional list<i32>            shardIDs
   30: optional DomainCacheInfo      domainCache
   40: optional string               shardControllerStatus
   50: optional string               address
+  60: optional list<HistoryShardInfo> shards
+}
+
+struct HistoryShardInfo {
+  10: optional i32                    shardID
+  20: optional i32                    workflowCacheSize
+  30: optional i64 (js.type = "Long") transferAckLevel
+  40: optional i64 (js.type = "Long") timerAckLevel
+  50: optional i64 (js.type = "Long") replicationAckLevel
 }
 
 struct DomainCacheInfo{
@@ -1493,6 +1760,18 @@ struct PollerInfo {
   30: optional double ratePerSecond
 }
 
+struct WorkerInfo {
+  10: optional string identity
+  20: optional TaskListType taskListType
+  30: optional string clientLibraryVersion
+  40: optional string clientFeatureVersion
+  50: optional string clientImpl
+  // Unix Nano
+  60: optional i64 (js.type = "Long") lastPollTime
+  // stale is true if the worker has not polled the tasklist for a while
+  70: optional bool stale
+}
+
 struct RetryPolicy {
   // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.
   10: optional i32 initialIntervalInSeconds
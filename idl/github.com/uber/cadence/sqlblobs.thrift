@@ -33,6 +33,7 @@ struct ShardInfo {
   36: optional map<string, i64> clusterTimerAckLevel
   38: optional string owner
   40: optional map<string, i64> clusterReplicationLevel
+  42: optional i64 (js.type = "Long") maxObservedTimeNanos
 }
 
 struct DomainInfo {
@@ -57,6 +58,10 @@ struct DomainInfo {
   44: optional string historyArchivalURI
   46: optional i16 visibilityArchivalStatus
   48: optional string visibilityArchivalURI
+  50: optional i32 failoverState
+  52: optional string pendingActiveClusterName
+  54: optional list<string> knownTaskLists
+  56: optional i64 previousFailoverVersion
 }
 
 struct HistoryTreeInfo {
@@ -129,6 +134,15 @@ struct WorkflowExecutionInfo {
   116: optional string autoResetPointsEncoding
   118: optional map<string, binary> searchAttributes
   120: optional map<string, binary> memo
+  122: optional list<string> queryTypes
+  124: optional string lastDecisionBinaryChecksum
+  126: optional bool paused
+  128: optional i32 priority
+  130: optional i64 (js.type = "Long") eventCount
+  132: optional i64 (js.type = "Long") activityPayloadBytes
+  134: optional i64 (js.type = "Long") signalEventCount
+  136: optional i64 (js.type = "Long") timerCount
+  138: optional bool terminationProtected
 }
 
 struct ActivityInfo {
@@ -216,6 +230,9 @@ struct TaskListInfo {
   12: optional i64 (js.type = "Long") ackLevel
   14: optional i64 (js.type = "Long") expiryTimeNanos
   16: optional i64 (js.type = "Long") lastUpdatedNanos
+  18: optional i64 (js.type = "Long") adaptivePartitionVersion
+  20: optional i32 numReadPartitions
+  22: optional i32 numWritePartitions
 }
 
 struct TransferTaskInfo {
@@ -253,3 +253,9 @@ CREATE TABLE queue (
   message_payload BLOB NOT NULL,
   PRIMARY KEY(queue_type, message_id)
 );
+
+CREATE TABLE queue_metadata (
+  queue_type INT NOT NULL,
+  data BLOB NOT NULL,
+  PRIMARY KEY(queue_type)
+);
@@ -10,6 +10,8 @@ CREATE TABLE executions_visibility (
   history_length       BIGINT,
   memo                 BLOB,
   encoding             VARCHAR(64) NOT NULL,
+  history_archival_info     BLOB,
+  history_archival_encoding VARCHAR(64) NOT NULL DEFAULT '',
 
   PRIMARY KEY  (domain_id, run_id)
 );
@@ -17,3 +19,14 @@ CREATE TABLE executions_visibility (
 CREATE INDEX by_type_start_time ON executions_visibility (domain_id, workflow_type_name, close_status, start_time DESC, run_id);
 CREATE INDEX by_workflow_id_start_time ON executions_visibility (domain_id, workflow_id, close_status, start_time DESC, run_id);
 CREATE INDEX by_status_by_close_time ON executions_visibility (domain_id, close_status, start_time DESC, run_id);
+
+CREATE TABLE executions_visibility_memo (
+  domain_id            CHAR(64) NOT NULL,
+  run_id               CHAR(64) NOT NULL,
+  memo_key             VARCHAR(255) NOT NULL,
+  memo_value           VARCHAR(255) NOT NULL,
+
+  PRIMARY KEY  (domain_id, run_id, memo_key)
+);
+
+CREATE INDEX by_memo ON executions_visibility_memo (domain_id, memo_key, memo_value, run_id);
@@ -0,0 +1,10 @@
+CREATE TABLE executions_visibility_memo (
+  domain_id            CHAR(64) NOT NULL,
+  run_id               CHAR(64) NOT NULL,
+  memo_key             VARCHAR(255) NOT NULL,
+  memo_value           VARCHAR(255) NOT NULL,
+
+  PRIMARY KEY  (domain_id, run_id, memo_key)
+);
+
+CREATE INDEX by_memo ON executions_visibility_memo (domain_id, memo_key, memo_value, run_id);
@@ -0,0 +1,2 @@
+ALTER TABLE executions_visibility ADD history_archival_info BLOB;
+ALTER TABLE executions_visibility ADD history_archival_encoding VARCHAR(64) NOT NULL DEFAULT '';
@@ -22,8 +22,10 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/olivere/elastic"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -35,16 +37,22 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	h "github.com/uber/cadence/.gen/go/history"
 	hist "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
+	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	historyService "github.com/uber/cadence/service/history"
 )
@@ -58,15 +66,29 @@ type (
 		numberOfHistoryShards int
 		service.Service
 		history       history.Client
+		matching      matching.Client
 		domainCache   cache.DomainCache
+		domainHandler domain.Handler
 		metricsClient metrics.Client
 		historyMgr    persistence.HistoryManager
 		historyV2Mgr  persistence.HistoryV2Manager
 		startWG       sync.WaitGroup
 		params        *service.BootstrapParams
+		config        *Config
 	}
 )
 
+const (
+	failoverReadinessCheckDomainReplicationConfig = "DomainReplicationConfig"
+	failoverReadinessCheckTargetClusterDomain     = "TargetClusterDomain"
+	failoverReadinessCheckReplicationLag          = "ReplicationLag"
+	failoverReadinessCheckDLQ                     = "DLQ"
+
+	// a migration waits for replication to catch up before failing over, so it is not bounded by a short timeout
+	migrationWorkflowTimeoutSeconds = 7 * 24 * 60 * 60
+	migrationDecisionTimeoutSeconds = 10
+)
+
 // NewAdminHandler creates a thrift handler for the cadence admin service
 func NewAdminHandler(
 	sVice service.Service,
@@ -74,7 +96,9 @@ func NewAdminHandler(
 	metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager,
 	historyV2Mgr persistence.HistoryV2Manager,
+	replicationMessageSink messaging.Producer,
 	params *service.BootstrapParams,
+	config *Config,
 ) *AdminHandler {
 	handler := &AdminHandler{
 		status:                common.DaemonStatusInitialized,
@@ -84,6 +108,17 @@ func NewAdminHandler(
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
 		params:                params,
+		config:                config,
+		domainHandler: domain.NewHandler(
+			config.MinRetentionDays(),
+			config.MaxBadBinaries,
+			sVice.GetLogger(),
+			metadataMgr,
+			sVice.GetClusterMetadata(),
+			domain.NewDomainReplicator(replicationMessageSink, sVice.GetLogger()),
+			sVice.GetArchivalMetadata(),
+			sVice.GetArchiverProvider(),
+		),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -104,6 +139,12 @@ func (adh *AdminHandler) Start() error {
 	adh.domainCache.Start()
 
 	adh.history = adh.GetClientBean().GetHistoryClient()
+	matchingRawClient, err := adh.GetClientBean().GetMatchingClient(adh.domainCache.GetDomainName)
+	if err != nil {
+		return err
+	}
+	adh.matching = matching.NewRetryableClient(matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
+		common.IsWhitelistServiceTransientError)
 	adh.metricsClient = adh.Service.GetMetricsClient()
 	adh.startWG.Done()
 	return nil
@@ -151,12 +192,15 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.
 	// update elasticsearch mapping, new added field will not be able to remove or update
 	index := adh.params.ESConfig.GetVisibilityIndex()
 	for k, v := range searchAttr {
-		valueType := convertIndexedValueTypeToESDataType(v)
+		valueType := es.ConvertIndexedValueTypeToESDataType(v)
 		if len(valueType) == 0 {
 			return &gen.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", v)}
 		}
 		err := adh.params.ESClient.PutMapping(ctx, index, definition.Attr, k, valueType)
-		if elastic.IsNotFound(err) {
+		if elastic.IsNotFound(err) && adh.params.ESConfig.IsIndexRolloverEnabled() {
+			// no rolled over index exists yet, the indexer adds the mapping when it creates one
+			err = nil
+		} else if elastic.IsNotFound(err) {
 			err = adh.params.ESClient.CreateIndex(ctx, index)
 			if err != nil {
 				return &gen.InternalServiceError{Message: fmt.Sprintf("Failed to create ES index, err: %v", err)}
@@ -232,6 +276,47 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *gen.CloseShard
 	return err
 }
 
+// DescribeQueue returns the ack levels of a queue of a shard
+func (adh *AdminHandler) DescribeQueue(
+	ctx context.Context,
+	request *gen.DescribeQueueRequest,
+) (resp *gen.DescribeQueueResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDescribeQueueScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardID == nil || request.Type == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	resp, err := adh.history.DescribeQueue(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ResetQueueState moves the ack level of a queue of a shard, so that the tasks after it are processed again
+func (adh *AdminHandler) ResetQueueState(
+	ctx context.Context,
+	request *gen.ResetQueueStateRequest,
+) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminResetQueueStateScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardID == nil || request.Type == nil || request.AckLevel == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.history.ResetQueueState(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // DescribeHistoryHost returns information about the internal states of a history host
 func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.DescribeHistoryHostRequest) (resp *gen.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -420,6 +505,688 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	return result, nil
 }
 
+// AddOperatorAnnotation records an operator note in the OperatorAnnotations memo field of a running workflow
+// execution, keeping it out of the history replayed by workflow code
+func (adh *AdminHandler) AddOperatorAnnotation(
+	ctx context.Context,
+	request *gen.AddOperatorAnnotationRequest,
+) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminAddOperatorAnnotationScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetMessage() == "" {
+		return adh.error(errAnnotationMessageNotSet, scope)
+	}
+	if len(request.GetMessage()) > adh.config.BlobSizeLimitError(request.GetDomain()) {
+		return adh.error(errAnnotationMessageTooLarge, scope)
+	}
+	if len(request.GetIdentity()) > adh.config.MaxIDLengthLimit() {
+		return adh.error(errIdentityTooLong, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.AddOperatorAnnotation(ctx, &hist.AddOperatorAnnotationRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		AnnotationRequest: request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DeleteWorkflowExecution removes the mutable state, current execution record, history and visibility records
+// of a workflow execution, running or corrupted executions are only deleted when force is set
+func (adh *AdminHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *admin.DeleteWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDeleteWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.Execution.GetRunId() == "" {
+		return adh.error(errRunIDNotSet, scope)
+	}
+	if request.GetReason() == "" {
+		return adh.error(errReasonNotSet, scope)
+	}
+	if len(request.GetIdentity()) > adh.config.MaxIDLengthLimit() {
+		return adh.error(errIdentityTooLong, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	adh.GetLogger().Warn("Deleting workflow execution on operator request.",
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(request.Execution.GetWorkflowId()),
+		tag.WorkflowRunID(request.Execution.GetRunId()),
+		tag.OperatorIdentity(request.GetIdentity()),
+		tag.OperatorReason(request.GetReason()),
+		tag.Bool(request.GetForce()))
+
+	err = adh.history.DeleteWorkflowExecution(ctx, &hist.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+		Force:      common.BoolPtr(request.GetForce()),
+		Reason:     common.StringPtr(request.GetReason()),
+		Identity:   common.StringPtr(request.GetIdentity()),
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ReindexWorkflowExecution re-sends the start fields, memo and search attributes of a running workflow
+// execution to visibility
+func (adh *AdminHandler) ReindexWorkflowExecution(
+	ctx context.Context,
+	request *admin.ReindexWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminReindexWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.ReindexWorkflowExecution(ctx, &hist.ReindexWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// SetTerminationProtection sets or clears the termination protection of a running workflow execution
+func (adh *AdminHandler) SetTerminationProtection(
+	ctx context.Context,
+	request *admin.SetTerminationProtectionRequest,
+) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminSetTerminationProtectionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.SetTerminationProtection(ctx, &hist.SetTerminationProtectionRequest{
+		DomainUUID:           common.StringPtr(domainID),
+		Execution:            request.Execution,
+		TerminationProtected: common.BoolPtr(request.GetTerminationProtected()),
+		Identity:             request.Identity,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UndeprecateDomain updates the status of a deprecated domain back to registered
+func (adh *AdminHandler) UndeprecateDomain(
+	ctx context.Context,
+	request *admin.UndeprecateDomainRequest,
+) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminUndeprecateDomainScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetName() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+
+	if err := adh.domainHandler.UndeprecateDomain(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// CheckFailoverReadiness verifies whether a domain can be safely failed over to the target cluster
+func (adh *AdminHandler) CheckFailoverReadiness(
+	ctx context.Context,
+	request *admin.CheckFailoverReadinessRequest,
+) (resp *admin.CheckFailoverReadinessResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminCheckFailoverReadinessScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	targetCluster := request.GetTargetCluster()
+	if targetCluster == "" {
+		return nil, adh.error(&gen.BadRequestError{Message: "TargetCluster is not set on request."}, scope)
+	}
+	clusterInfo, ok := adh.GetClusterMetadata().GetAllClusterInfo()[targetCluster]
+	if !ok || !clusterInfo.Enabled {
+		return nil, adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Cluster %v is not an enabled cluster.", targetCluster)}, scope)
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	checks := []*admin.FailoverReadinessCheck{
+		adh.checkDomainReplicationConfig(domainEntry, targetCluster),
+		adh.checkTargetClusterDomain(ctx, domainEntry, targetCluster),
+		adh.checkReplicationLag(ctx, domainEntry, targetCluster),
+		{
+			// neither the kafka nor the RPC replication consumer exposes its DLQ backlog per domain,
+			// report the check so the domain is never reported ready while the DLQ may hold its tasks
+			Name:    common.StringPtr(failoverReadinessCheckDLQ),
+			Status:  admin.FailoverReadinessCheckStatusUnverified.Ptr(),
+			Details: common.StringPtr("Replication DLQ backlog of the domain's shards cannot be inspected, verify it is drained before failing over."),
+		},
+	}
+
+	return &admin.CheckFailoverReadinessResponse{
+		Ready:  common.BoolPtr(isFailoverReady(checks)),
+		Checks: checks,
+	}, nil
+}
+
+// DescribeShardDistribution returns the history host owning each history shard and the resulting balance
+func (adh *AdminHandler) DescribeShardDistribution(
+	ctx context.Context,
+	request *admin.DescribeShardDistributionRequest,
+) (resp *admin.DescribeShardDistributionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDescribeShardDistributionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resolver, err := adh.GetMembershipMonitor().GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	shardsByHost := make(map[string][]int32)
+	for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+		host, err := resolver.Lookup(string(rune(shardID)))
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		shardsByHost[host.GetAddress()] = append(shardsByHost[host.GetAddress()], int32(shardID))
+	}
+
+	return newShardDistributionResponse(adh.numberOfHistoryShards, shardsByHost), nil
+}
+
+// ListWorkers returns the workers which polled the decision and activity tasklists of the given name recently
+func (adh *AdminHandler) ListWorkers(
+	ctx context.Context,
+	request *admin.ListWorkersRequest,
+) (resp *admin.ListWorkersResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminListWorkersScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.TaskList == nil || request.TaskList.GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	var workers []*gen.WorkerInfo
+	for _, taskListType := range []gen.TaskListType{gen.TaskListTypeDecision, gen.TaskListTypeActivity} {
+		response, err := adh.matching.ListTaskListWorkers(ctx, &m.ListTaskListWorkersRequest{
+			DomainUUID:   common.StringPtr(domainID),
+			TaskList:     request.TaskList,
+			TaskListType: taskListType.Ptr(),
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		workers = append(workers, response.Workers...)
+	}
+
+	return newListWorkersResponse(workers), nil
+}
+
+// newListWorkersResponse sorts the workers by identity and reports whether all of them are stale
+func newListWorkersResponse(
+	workers []*gen.WorkerInfo,
+) *admin.ListWorkersResponse {
+
+	sort.Slice(workers, func(i, j int) bool {
+		if workers[i].GetIdentity() != workers[j].GetIdentity() {
+			return workers[i].GetIdentity() < workers[j].GetIdentity()
+		}
+		return workers[i].GetTaskListType() < workers[j].GetTaskListType()
+	})
+	onlyStaleWorkers := len(workers) > 0
+	for _, worker := range workers {
+		if !worker.GetStale() {
+			onlyStaleWorkers = false
+			break
+		}
+	}
+	return &admin.ListWorkersResponse{
+		Workers:          workers,
+		OnlyStaleWorkers: common.BoolPtr(onlyStaleWorkers),
+	}
+}
+
+// DescribeDynamicConfig returns the dynamic config keys with the values applied for the request filters
+func (adh *AdminHandler) DescribeDynamicConfig(
+	ctx context.Context,
+	request *admin.DescribeDynamicConfigRequest,
+) (resp *admin.DescribeDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDescribeDynamicConfigScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	filters := make(map[dynamicconfig.Filter]interface{})
+	if request.Domain != nil {
+		filters[dynamicconfig.DomainName] = request.GetDomain()
+	}
+	if request.TaskList != nil {
+		filters[dynamicconfig.TaskListName] = request.GetTaskList()
+	}
+	if request.TaskType != nil {
+		filters[dynamicconfig.TaskType] = int(request.GetTaskType())
+	}
+	if request.ShardID != nil {
+		filters[dynamicconfig.ShardID] = int(request.GetShardID())
+	}
+
+	dc := dynamicconfig.NewCollection(adh.params.DynamicConfig, adh.GetLogger())
+	return newDescribeDynamicConfigResponse(dc.DescribeProperties(request.GetKeyPrefix(), filters)), nil
+}
+
+// MigrateDomain starts the system workflow which moves a local domain of the current cluster to the target cluster.
+// The domain is promoted to a global domain first, so the migration has to be started from the master cluster.
+func (adh *AdminHandler) MigrateDomain(
+	ctx context.Context,
+	request *admin.MigrateDomainRequest,
+) (resp *admin.MigrateDomainResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminMigrateDomainScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	clusterMetadata := adh.GetClusterMetadata()
+	currentCluster := clusterMetadata.GetCurrentClusterName()
+	targetCluster := request.GetTargetCluster()
+	if targetCluster == "" {
+		return nil, adh.error(&gen.BadRequestError{Message: "TargetCluster is not set on request."}, scope)
+	}
+	clusterInfo, ok := clusterMetadata.GetAllClusterInfo()[targetCluster]
+	if !ok || !clusterInfo.Enabled || targetCluster == currentCluster {
+		return nil, adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Cluster %v is not an enabled remote cluster.", targetCluster)}, scope)
+	}
+	if !clusterMetadata.IsGlobalDomainEnabled() || !clusterMetadata.IsMasterCluster() {
+		return nil, adh.error(&gen.BadRequestError{Message: "Domains can only be migrated from the master cluster."}, scope)
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	// a global domain active in the current cluster is left behind by a migration which did not complete
+	if domainEntry.GetReplicationConfig().ActiveClusterName != currentCluster {
+		return nil, adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Domain %v is not active in the current cluster.", request.GetDomain())}, scope)
+	}
+
+	systemDomainID, err := adh.domainCache.GetDomainID(common.SystemLocalDomainName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	input, err := json.Marshal(domain.MigrationParams{
+		DomainName:    request.GetDomain(),
+		SourceCluster: currentCluster,
+		TargetCluster: targetCluster,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	workflowID := domain.MigrationWorkflowID(request.GetDomain())
+	startResp, err := adh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(systemDomainID, &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(common.SystemLocalDomainName),
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr(domain.MigrationWFTypeName)},
+		TaskList:                            &gen.TaskList{Name: common.StringPtr(domain.MigrationTaskListName)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(migrationWorkflowTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(migrationDecisionTimeoutSeconds),
+		Identity:                            request.Identity,
+		RequestId:                           common.StringPtr(uuid.New()),
+		WorkflowIdReusePolicy:               gen.WorkflowIdReusePolicyAllowDuplicate.Ptr(),
+	}))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &admin.MigrateDomainResponse{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      startResp.RunId,
+	}, nil
+}
+
+func newDescribeDynamicConfigResponse(
+	descriptions []*dynamicconfig.PropertyDescription,
+) *admin.DescribeDynamicConfigResponse {
+
+	resp := &admin.DescribeDynamicConfigResponse{
+		Entries: make([]*admin.DynamicConfigEntry, 0, len(descriptions)),
+	}
+	for _, description := range descriptions {
+		filters := make([]string, 0, len(description.Filters))
+		for _, filter := range description.Filters {
+			filters = append(filters, filter.String())
+		}
+		var overrides []*admin.DynamicConfigOverride
+		for _, override := range description.Overrides {
+			constraints := make(map[string]string, len(override.Constraints))
+			for name, value := range override.Constraints {
+				constraints[name] = formatDynamicConfigValue(value)
+			}
+			overrides = append(overrides, &admin.DynamicConfigOverride{
+				Value:       common.StringPtr(formatDynamicConfigValue(override.Value)),
+				Constraints: constraints,
+			})
+		}
+		entry := &admin.DynamicConfigEntry{
+			Name:         common.StringPtr(description.Key.String()),
+			ValueType:    common.StringPtr(description.Type.String()),
+			IsOverridden: common.BoolPtr(description.IsOverridden),
+			Filters:      filters,
+			Overrides:    overrides,
+		}
+		// the default value is only known for the keys read by the services of this host
+		if description.DefaultValue != nil {
+			entry.DefaultValue = common.StringPtr(formatDynamicConfigValue(description.DefaultValue))
+		}
+		if description.Value != nil {
+			entry.Value = common.StringPtr(formatDynamicConfigValue(description.Value))
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+	return resp
+}
+
+// formatDynamicConfigValue prints durations and strings as they are written in the dynamic config source,
+// other values are printed as json
+func formatDynamicConfigValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Duration:
+		return v.String()
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+func newShardDistributionResponse(
+	numberOfShards int,
+	shardsByHost map[string][]int32,
+) *admin.DescribeShardDistributionResponse {
+
+	addresses := make([]string, 0, len(shardsByHost))
+	for address := range shardsByHost {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	resp := &admin.DescribeShardDistributionResponse{
+		NumberOfShards: common.Int32Ptr(int32(numberOfShards)),
+		Hosts:          make([]*admin.HistoryHostShards, 0, len(addresses)),
+	}
+	minShards, maxShards := int32(0), int32(0)
+	for i, address := range addresses {
+		shardIDs := shardsByHost[address]
+		numShards := int32(len(shardIDs))
+		if i == 0 || numShards < minShards {
+			minShards = numShards
+		}
+		if numShards > maxShards {
+			maxShards = numShards
+		}
+		resp.Hosts = append(resp.Hosts, &admin.HistoryHostShards{
+			Address:  common.StringPtr(address),
+			ShardIDs: shardIDs,
+		})
+	}
+	resp.MinShardsPerHost = common.Int32Ptr(minShards)
+	resp.MaxShardsPerHost = common.Int32Ptr(maxShards)
+	return resp
+}
+
+func (adh *AdminHandler) checkDomainReplicationConfig(
+	domainEntry *cache.DomainCacheEntry,
+	targetCluster string,
+) *admin.FailoverReadinessCheck {
+
+	replicationConfig := domainEntry.GetReplicationConfig()
+	var details string
+	switch {
+	case !domainEntry.IsGlobalDomain():
+		details = "Domain is not a global domain."
+	case replicationConfig.ActiveClusterName == targetCluster:
+		details = fmt.Sprintf("Domain is already active in cluster %v.", targetCluster)
+	default:
+		for _, cluster := range replicationConfig.Clusters {
+			if cluster.ClusterName == targetCluster {
+				return newFailoverReadinessCheck(failoverReadinessCheckDomainReplicationConfig, true, "")
+			}
+		}
+		details = fmt.Sprintf("Cluster %v is not in the domain replication config.", targetCluster)
+	}
+	return newFailoverReadinessCheck(failoverReadinessCheckDomainReplicationConfig, false, details)
+}
+
+func (adh *AdminHandler) checkTargetClusterDomain(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	targetCluster string,
+) *admin.FailoverReadinessCheck {
+
+	remoteClient := adh.GetClientBean().GetRemoteFrontendClient(targetCluster)
+	resp, err := remoteClient.DescribeDomain(ctx, &gen.DescribeDomainRequest{
+		Name: common.StringPtr(domainEntry.GetInfo().Name),
+	})
+	if err != nil {
+		details := fmt.Sprintf("Failed to describe domain in cluster %v: %v", targetCluster, err)
+		return newFailoverReadinessCheck(failoverReadinessCheckTargetClusterDomain, false, details)
+	}
+	if resp.GetFailoverVersion() != domainEntry.GetFailoverVersion() {
+		details := fmt.Sprintf(
+			"Domain failover version in cluster %v is %v, expected %v.",
+			targetCluster,
+			resp.GetFailoverVersion(),
+			domainEntry.GetFailoverVersion(),
+		)
+		return newFailoverReadinessCheck(failoverReadinessCheckTargetClusterDomain, false, details)
+	}
+	return newFailoverReadinessCheck(failoverReadinessCheckTargetClusterDomain, true, "")
+}
+
+// checkReplicationLag only accounts for the replication tasks of the domain,
+// a shard with no pending task for the domain reports no lag
+func (adh *AdminHandler) checkReplicationLag(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	targetCluster string,
+) *admin.FailoverReadinessCheck {
+
+	shardIDs := make([]int32, adh.numberOfHistoryShards)
+	for i := range shardIDs {
+		shardIDs[i] = int32(i)
+	}
+	resp, err := adh.history.GetReplicationStatus(ctx, &h.GetReplicationStatusRequest{
+		ShardIDs:   shardIDs,
+		DomainUUID: common.StringPtr(domainEntry.GetInfo().ID),
+	})
+	if err != nil {
+		details := fmt.Sprintf("Failed to get shard replication status: %v", err)
+		return newFailoverReadinessCheck(failoverReadinessCheckReplicationLag, false, details)
+	}
+
+	consumerConfig := adh.GetClusterMetadata().GetReplicationConsumerConfig()
+	isRPCReplication := consumerConfig != nil && consumerConfig.Type == config.ReplicationConsumerTypeRPC
+	maxLag := int64(adh.config.FailoverReadinessMaxReplicationLag())
+	var laggingShards []int32
+	for _, status := range resp.Shards {
+		if getShardReplicationLag(status, targetCluster, isRPCReplication) > maxLag {
+			laggingShards = append(laggingShards, status.GetShardID())
+		}
+	}
+	if len(laggingShards) > 0 {
+		details := fmt.Sprintf("Shards %v have replication lag above %v for the domain.", laggingShards, maxLag)
+		return newFailoverReadinessCheck(failoverReadinessCheckReplicationLag, false, details)
+	}
+	return newFailoverReadinessCheck(failoverReadinessCheckReplicationLag, true, "")
+}
+
+// getShardReplicationLag returns how far, in task IDs, the target cluster is behind the shard
+func getShardReplicationLag(
+	status *h.ShardReplicationStatus,
+	targetCluster string,
+	isRPCReplication bool,
+) int64 {
+
+	ackLevel := status.GetReplicationAckLevel()
+	if isRPCReplication {
+		// with RPC replication, the target cluster pulls tasks and reports its own level,
+		// a cluster which never pulled starts from -1
+		ackLevel = -1
+		if level, ok := status.ClusterReplicationLevel[targetCluster]; ok {
+			ackLevel = level
+		}
+	}
+	if lag := status.GetMaxReadLevel() - ackLevel; lag > 0 {
+		return lag
+	}
+	return 0
+}
+
+// isFailoverReady returns true only if every check passed, an unverified check blocks the failover as well
+func isFailoverReady(checks []*admin.FailoverReadinessCheck) bool {
+	for _, check := range checks {
+		if check.GetStatus() != admin.FailoverReadinessCheckStatusPassed {
+			return false
+		}
+	}
+	return true
+}
+
+func newFailoverReadinessCheck(name string, passed bool, details string) *admin.FailoverReadinessCheck {
+	status := admin.FailoverReadinessCheckStatusPassed
+	if !passed {
+		status = admin.FailoverReadinessCheckStatusFailed
+	}
+	return &admin.FailoverReadinessCheck{
+		Name:    common.StringPtr(name),
+		Status:  status.Ptr(),
+		Details: common.StringPtr(details),
+	}
+}
+
 // startRequestProfile initiates recording of request metrics
 func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	adh.startWG.Wait()
@@ -444,22 +1211,3 @@ func (adh *AdminHandler) error(err error, scope int) error {
 		return &gen.InternalServiceError{Message: err.Error()}
 	}
 }
-
-func convertIndexedValueTypeToESDataType(valueType gen.IndexedValueType) string {
-	switch valueType {
-	case gen.IndexedValueTypeString:
-		return "text"
-	case gen.IndexedValueTypeKeyword:
-		return "keyword"
-	case gen.IndexedValueTypeInt:
-		return "long"
-	case gen.IndexedValueTypeDouble:
-		return "double"
-	case gen.IndexedValueTypeBool:
-		return "boolean"
-	case gen.IndexedValueTypeDatetime:
-		return "date"
-	default:
-		return ""
-	}
-}
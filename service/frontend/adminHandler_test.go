@@ -22,47 +22,188 @@ package frontend
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
-func Test_ConvertIndexedValueTypeToESDataType(t *testing.T) {
+func Test_GetShardReplicationLag(t *testing.T) {
+	status := &history.ShardReplicationStatus{
+		ShardID:             common.Int32Ptr(1),
+		MaxReadLevel:        common.Int64Ptr(100),
+		ReplicationAckLevel: common.Int64Ptr(80),
+		ClusterReplicationLevel: map[string]int64{
+			"standby": 95,
+		},
+	}
+
 	tests := []struct {
-		input    shared.IndexedValueType
-		expected string
+		targetCluster    string
+		isRPCReplication bool
+		expected         int64
 	}{
 		{
-			input:    shared.IndexedValueTypeString,
-			expected: "text",
+			targetCluster:    "standby",
+			isRPCReplication: false,
+			expected:         20,
 		},
 		{
-			input:    shared.IndexedValueTypeKeyword,
-			expected: "keyword",
+			targetCluster:    "standby",
+			isRPCReplication: true,
+			expected:         5,
 		},
 		{
-			input:    shared.IndexedValueTypeInt,
-			expected: "long",
+			targetCluster:    "other",
+			isRPCReplication: true,
+			expected:         101,
 		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, getShardReplicationLag(status, test.targetCluster, test.isRPCReplication))
+	}
+
+	status.ReplicationAckLevel = common.Int64Ptr(200)
+	require.Equal(t, int64(0), getShardReplicationLag(status, "standby", false))
+}
+
+func Test_IsFailoverReady(t *testing.T) {
+	check := func(status admin.FailoverReadinessCheckStatus) *admin.FailoverReadinessCheck {
+		return &admin.FailoverReadinessCheck{Status: status.Ptr()}
+	}
+
+	require.True(t, isFailoverReady([]*admin.FailoverReadinessCheck{
+		check(admin.FailoverReadinessCheckStatusPassed),
+		check(admin.FailoverReadinessCheckStatusPassed),
+	}))
+	require.False(t, isFailoverReady([]*admin.FailoverReadinessCheck{
+		check(admin.FailoverReadinessCheckStatusPassed),
+		check(admin.FailoverReadinessCheckStatusFailed),
+	}))
+	require.False(t, isFailoverReady([]*admin.FailoverReadinessCheck{
+		check(admin.FailoverReadinessCheckStatusPassed),
+		check(admin.FailoverReadinessCheckStatusUnverified),
+	}))
+}
+
+func Test_NewShardDistributionResponse(t *testing.T) {
+	resp := newShardDistributionResponse(5, map[string][]int32{
+		"host-b:7934": {1, 3, 4},
+		"host-a:7934": {0, 2},
+	})
+	require.Equal(t, int32(5), resp.GetNumberOfShards())
+	require.Equal(t, int32(2), resp.GetMinShardsPerHost())
+	require.Equal(t, int32(3), resp.GetMaxShardsPerHost())
+	require.Equal(t, []*admin.HistoryHostShards{
+		{Address: common.StringPtr("host-a:7934"), ShardIDs: []int32{0, 2}},
+		{Address: common.StringPtr("host-b:7934"), ShardIDs: []int32{1, 3, 4}},
+	}, resp.Hosts)
+
+	resp = newShardDistributionResponse(0, map[string][]int32{})
+	require.Equal(t, int32(0), resp.GetMinShardsPerHost())
+	require.Equal(t, int32(0), resp.GetMaxShardsPerHost())
+	require.Empty(t, resp.Hosts)
+}
+
+func Test_NewListWorkersResponse(t *testing.T) {
+	worker := func(identity string, taskListType shared.TaskListType, stale bool) *shared.WorkerInfo {
+		return &shared.WorkerInfo{
+			Identity:     common.StringPtr(identity),
+			TaskListType: taskListType.Ptr(),
+			Stale:        common.BoolPtr(stale),
+		}
+	}
+
+	resp := newListWorkersResponse([]*shared.WorkerInfo{
+		worker("worker-b", shared.TaskListTypeDecision, true),
+		worker("worker-a", shared.TaskListTypeActivity, false),
+		worker("worker-a", shared.TaskListTypeDecision, true),
+	})
+	require.False(t, resp.GetOnlyStaleWorkers())
+	require.Equal(t, []*shared.WorkerInfo{
+		worker("worker-a", shared.TaskListTypeDecision, true),
+		worker("worker-a", shared.TaskListTypeActivity, false),
+		worker("worker-b", shared.TaskListTypeDecision, true),
+	}, resp.Workers)
+
+	resp = newListWorkersResponse([]*shared.WorkerInfo{
+		worker("worker-a", shared.TaskListTypeDecision, true),
+	})
+	require.True(t, resp.GetOnlyStaleWorkers())
+
+	resp = newListWorkersResponse(nil)
+	require.False(t, resp.GetOnlyStaleWorkers())
+}
+
+func Test_NewDescribeDynamicConfigResponse(t *testing.T) {
+	resp := newDescribeDynamicConfigResponse([]*dynamicconfig.PropertyDescription{
 		{
-			input:    shared.IndexedValueTypeDouble,
-			expected: "double",
+			KeyDefinition: &dynamicconfig.KeyDefinition{
+				Key:     dynamicconfig.FrontendDomainRPS,
+				Type:    dynamicconfig.ValueTypeInt,
+				Filters: []dynamicconfig.Filter{dynamicconfig.DomainName},
+			},
+			DefaultValue: 1200,
+			Value:        100,
+			IsOverridden: true,
+			Overrides: []*dynamicconfig.ValueOverride{
+				{Value: 100, Constraints: map[string]interface{}{"domainName": "samples-domain"}},
+			},
 		},
 		{
-			input:    shared.IndexedValueTypeBool,
-			expected: "boolean",
+			KeyDefinition: &dynamicconfig.KeyDefinition{
+				Key:  dynamicconfig.OverloadLatencyThreshold,
+				Type: dynamicconfig.ValueTypeDuration,
+			},
+			DefaultValue: 200 * time.Millisecond,
+			Value:        200 * time.Millisecond,
 		},
 		{
-			input:    shared.IndexedValueTypeDatetime,
-			expected: "date",
+			KeyDefinition: &dynamicconfig.KeyDefinition{
+				Key:     dynamicconfig.MatchingIncompatiblePollBackoff,
+				Type:    dynamicconfig.ValueTypeDuration,
+				Filters: []dynamicconfig.Filter{dynamicconfig.DomainName, dynamicconfig.TaskListName, dynamicconfig.TaskType},
+			},
 		},
+	})
+	require.Equal(t, []*admin.DynamicConfigEntry{
 		{
-			input:    shared.IndexedValueType(-1),
-			expected: "",
+			Name:         common.StringPtr("frontend.domainrps"),
+			ValueType:    common.StringPtr("int"),
+			DefaultValue: common.StringPtr("1200"),
+			Value:        common.StringPtr("100"),
+			IsOverridden: common.BoolPtr(true),
+			Filters:      []string{"domainName"},
+			Overrides: []*admin.DynamicConfigOverride{
+				{Value: common.StringPtr("100"), Constraints: map[string]string{"domainName": "samples-domain"}},
+			},
 		},
-	}
+		{
+			Name:         common.StringPtr(dynamicconfig.OverloadLatencyThreshold.String()),
+			ValueType:    common.StringPtr("duration"),
+			DefaultValue: common.StringPtr("200ms"),
+			Value:        common.StringPtr("200ms"),
+			IsOverridden: common.BoolPtr(false),
+			Filters:      []string{},
+		},
+		{
+			Name:         common.StringPtr(dynamicconfig.MatchingIncompatiblePollBackoff.String()),
+			ValueType:    common.StringPtr("duration"),
+			IsOverridden: common.BoolPtr(false),
+			Filters:      []string{"domainName", "taskListName", "taskType"},
+		},
+	}, resp.Entries)
+}
 
-	for _, test := range tests {
-		require.Equal(t, test.expected, convertIndexedValueTypeToESDataType(test.input))
-	}
+func Test_FormatDynamicConfigValue(t *testing.T) {
+	require.Equal(t, "samples-domain", formatDynamicConfigValue("samples-domain"))
+	require.Equal(t, "1m0s", formatDynamicConfigValue(time.Minute))
+	require.Equal(t, "true", formatDynamicConfigValue(true))
+	require.Equal(t, "0.5", formatDynamicConfigValue(0.5))
+	require.Equal(t, `{"key":1}`, formatDynamicConfigValue(map[string]interface{}{"key": 1}))
 }
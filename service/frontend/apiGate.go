@@ -0,0 +1,95 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"strings"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	apiGateWildcard        = "*"
+	apiGateCallerDelimiter = ":"
+)
+
+type (
+	// apiGate rejects calls to APIs disabled for a domain by dynamic config, the allow and deny lists are
+	// comma separated API names, an entry may be suffixed with ":<caller>" to only match calls of that
+	// yarpc caller and "*" matches every API
+	apiGate struct {
+		allowedAPIs dynamicconfig.StringPropertyFnWithDomainFilter
+		deniedAPIs  dynamicconfig.StringPropertyFnWithDomainFilter
+	}
+)
+
+func newAPIGate(
+	allowedAPIs dynamicconfig.StringPropertyFnWithDomainFilter,
+	deniedAPIs dynamicconfig.StringPropertyFnWithDomainFilter,
+) *apiGate {
+	return &apiGate{
+		allowedAPIs: allowedAPIs,
+		deniedAPIs:  deniedAPIs,
+	}
+}
+
+// check returns AccessDeniedError if the api is on the deny list of the domain, or if the domain
+// has an allow list which the api is not on, the deny list takes precedence over the allow list
+func (g *apiGate) check(api string, domain string, caller string) error {
+	if matchAPIList(g.deniedAPIs(domain), api, caller) {
+		return newAPIAccessDeniedError(api, domain, caller)
+	}
+	allowedAPIs := g.allowedAPIs(domain)
+	if strings.TrimSpace(allowedAPIs) != "" && !matchAPIList(allowedAPIs, api, caller) {
+		return newAPIAccessDeniedError(api, domain, caller)
+	}
+	return nil
+}
+
+func matchAPIList(list string, api string, caller string) bool {
+	for _, entry := range strings.Split(list, ",") {
+		entryAPI := strings.TrimSpace(entry)
+		entryCaller := ""
+		if idx := strings.Index(entryAPI, apiGateCallerDelimiter); idx >= 0 {
+			entryCaller = strings.TrimSpace(entryAPI[idx+1:])
+			entryAPI = strings.TrimSpace(entryAPI[:idx])
+		}
+		if entryAPI == "" {
+			continue
+		}
+		if entryAPI != apiGateWildcard && entryAPI != api {
+			continue
+		}
+		if entryCaller != "" && entryCaller != caller {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func newAPIAccessDeniedError(api string, domain string, caller string) error {
+	return &gen.AccessDeniedError{
+		Message: fmt.Sprintf("%v is disabled for domain %v and caller %v.", api, domain, caller),
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gen "github.com/uber/cadence/.gen/go/shared"
+)
+
+func TestAPIGate(t *testing.T) {
+	lists := map[string][2]string{
+		"no-lists":     {"", ""},
+		"denied":       {"", "TerminateWorkflowExecution, ResetWorkflowExecution"},
+		"denied-all":   {"", "*"},
+		"allowed":      {"StartWorkflowExecution,SignalWorkflowExecution", ""},
+		"both":         {"*", "TerminateWorkflowExecution"},
+		"denied-batch": {"", "TerminateWorkflowExecution:cadence-batcher"},
+		"allowed-cli":  {"ResetWorkflowExecution:cadence-cli", ""},
+	}
+	gate := newAPIGate(
+		func(domain string) string { return lists[domain][0] },
+		func(domain string) string { return lists[domain][1] },
+	)
+
+	testCases := []struct {
+		api     string
+		domain  string
+		caller  string
+		allowed bool
+	}{
+		{"TerminateWorkflowExecution", "no-lists", "cadence-cli", true},
+		{"TerminateWorkflowExecution", "denied", "cadence-cli", false},
+		{"ResetWorkflowExecution", "denied", "cadence-cli", false},
+		{"StartWorkflowExecution", "denied", "cadence-cli", true},
+		{"StartWorkflowExecution", "denied-all", "cadence-cli", false},
+		{"StartWorkflowExecution", "allowed", "cadence-cli", true},
+		{"TerminateWorkflowExecution", "allowed", "cadence-cli", false},
+		{"StartWorkflowExecution", "both", "cadence-cli", true},
+		{"TerminateWorkflowExecution", "both", "cadence-cli", false},
+		{"TerminateWorkflowExecution", "denied-batch", "cadence-batcher", false},
+		{"TerminateWorkflowExecution", "denied-batch", "cadence-cli", true},
+		{"ResetWorkflowExecution", "allowed-cli", "cadence-cli", true},
+		{"ResetWorkflowExecution", "allowed-cli", "cadence-batcher", false},
+	}
+	for _, tc := range testCases {
+		err := gate.check(tc.api, tc.domain, tc.caller)
+		if tc.allowed {
+			assert.NoError(t, err, "%v %v %v", tc.api, tc.domain, tc.caller)
+		} else {
+			assert.IsType(t, &gen.AccessDeniedError{}, err, "%v %v %v", tc.api, tc.domain, tc.caller)
+		}
+	}
+}
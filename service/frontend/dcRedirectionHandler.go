@@ -22,14 +22,20 @@ package frontend
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
 	"github.com/uber/cadence/.gen/go/health"
 	"github.com/uber/cadence/.gen/go/health/metaserver"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
@@ -37,6 +43,8 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
 )
 
 var _ workflowserviceserver.Interface = (*DCRedirectionHandlerImpl)(nil)
@@ -57,9 +65,20 @@ type (
 		frontendHandler    workflowserviceserver.Interface
 		clientBeanProvider clientBeanProvider
 
+		remoteClientsLock     sync.RWMutex
+		remoteFrontendClients map[string]*remoteFrontendClient
+
 		startFn func() error
 		stopFn  func()
 	}
+
+	// remoteFrontendClient is the retryable client of a remote cluster, along with
+	// the client and the max retry attempts it was built with
+	remoteFrontendClient struct {
+		client          frontend.Client
+		maxAttempts     int
+		retryableClient frontend.Client
+	}
 )
 
 // NewDCRedirectionHandler creates a thrift handler for the cadence service, frontend
@@ -69,27 +88,31 @@ func NewDCRedirectionHandler(wfHandler *WorkflowHandler, policy config.DCRedirec
 		wfHandler.config,
 		wfHandler.domainCache,
 		policy,
+		wfHandler.metricsClient,
+		wfHandler.GetThrottledLogger(),
 	)
 
 	return &DCRedirectionHandlerImpl{
-		currentClusterName: wfHandler.GetClusterMetadata().GetCurrentClusterName(),
-		timeSource:         clock.NewRealTimeSource(),
-		domainCache:        wfHandler.domainCache,
-		metricsClient:      wfHandler.metricsClient,
-		config:             wfHandler.config,
-		redirectionPolicy:  dcRedirectionPolicy,
-		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
-		service:            wfHandler.Service,
-		frontendHandler:    wfHandler,
-		clientBeanProvider: func() client.Bean { return wfHandler.Service.GetClientBean() },
-		startFn:            func() error { return wfHandler.Start() },
-		stopFn:             func() { wfHandler.Stop() },
+		currentClusterName:    wfHandler.GetClusterMetadata().GetCurrentClusterName(),
+		timeSource:            clock.NewRealTimeSource(),
+		domainCache:           wfHandler.domainCache,
+		metricsClient:         wfHandler.metricsClient,
+		config:                wfHandler.config,
+		redirectionPolicy:     dcRedirectionPolicy,
+		tokenSerializer:       common.NewJSONTaskTokenSerializer(),
+		service:               wfHandler.Service,
+		frontendHandler:       wfHandler,
+		clientBeanProvider:    func() client.Bean { return wfHandler.Service.GetClientBean() },
+		remoteFrontendClients: make(map[string]*remoteFrontendClient),
+		startFn:               func() error { return wfHandler.Start() },
+		stopFn:                func() { wfHandler.Stop() },
 	}
 }
 
 // RegisterHandler register this handler, must be called before Start()
 func (handler *DCRedirectionHandlerImpl) RegisterHandler() {
 	handler.service.GetDispatcher().Register(workflowserviceserver.New(handler))
+	handler.service.GetDispatcher().Register(apiv1.BuildWorkflowServiceYARPCProcedures(newGRPCHandler(handler)))
 	handler.service.GetDispatcher().Register(metaserver.New(handler))
 }
 
@@ -214,8 +237,9 @@ func (handler *DCRedirectionHandlerImpl) DescribeTaskList(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.DescribeTaskList(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.DescribeTaskList(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.DescribeTaskList(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -244,8 +268,103 @@ func (handler *DCRedirectionHandlerImpl) DescribeWorkflowExecution(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.DescribeWorkflowExecution(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.DescribeWorkflowExecution(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.DescribeWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// BatchDescribeWorkflowExecutions API call
+func (handler *DCRedirectionHandlerImpl) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	request *shared.BatchDescribeWorkflowExecutionsRequest,
+) (resp *shared.BatchDescribeWorkflowExecutionsResponse, retError error) {
+
+	var apiName = "BatchDescribeWorkflowExecutions"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBatchDescribeWorkflowExecutionsScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.BatchDescribeWorkflowExecutions(ctx, request)
+		default:
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.BatchDescribeWorkflowExecutions(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// BatchOperationWorkflow API call, batch jobs always run in the current cluster
+func (handler *DCRedirectionHandlerImpl) BatchOperationWorkflow(
+	ctx context.Context,
+	request *shared.BatchOperationWorkflowRequest,
+) (resp *shared.BatchOperationWorkflowResponse, retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBatchOperationWorkflowScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.BatchOperationWorkflow(ctx, request)
+}
+
+// DescribeBatchOperation API call, batch jobs always run in the current cluster
+func (handler *DCRedirectionHandlerImpl) DescribeBatchOperation(
+	ctx context.Context,
+	request *shared.DescribeBatchOperationRequest,
+) (resp *shared.DescribeBatchOperationResponse, retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeBatchOperationScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.DescribeBatchOperation(ctx, request)
+}
+
+// ListWorkflowExecutionChain API call
+func (handler *DCRedirectionHandlerImpl) ListWorkflowExecutionChain(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionChainRequest,
+) (resp *shared.ListWorkflowExecutionChainResponse, retError error) {
+
+	var apiName = "ListWorkflowExecutionChain"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListWorkflowExecutionChainScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.ListWorkflowExecutionChain(ctx, request)
+		default:
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListWorkflowExecutionChain(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -274,8 +393,9 @@ func (handler *DCRedirectionHandlerImpl) GetWorkflowExecutionHistory(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.GetWorkflowExecutionHistory(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.GetWorkflowExecutionHistory(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.GetWorkflowExecutionHistory(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -304,8 +424,9 @@ func (handler *DCRedirectionHandlerImpl) ListArchivedWorkflowExecutions(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.ListArchivedWorkflowExecutions(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.ListArchivedWorkflowExecutions(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListArchivedWorkflowExecutions(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -334,8 +455,9 @@ func (handler *DCRedirectionHandlerImpl) ListClosedWorkflowExecutions(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.ListClosedWorkflowExecutions(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.ListClosedWorkflowExecutions(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListClosedWorkflowExecutions(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -364,8 +486,9 @@ func (handler *DCRedirectionHandlerImpl) ListOpenWorkflowExecutions(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.ListOpenWorkflowExecutions(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.ListOpenWorkflowExecutions(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListOpenWorkflowExecutions(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -394,8 +517,9 @@ func (handler *DCRedirectionHandlerImpl) ListWorkflowExecutions(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.ListWorkflowExecutions(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.ListWorkflowExecutions(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListWorkflowExecutions(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -423,8 +547,9 @@ func (handler *DCRedirectionHandlerImpl) ScanWorkflowExecutions(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.ScanWorkflowExecutions(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.ScanWorkflowExecutions(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ScanWorkflowExecutions(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -453,8 +578,9 @@ func (handler *DCRedirectionHandlerImpl) CountWorkflowExecutions(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.CountWorkflowExecutions(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.CountWorkflowExecutions(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.CountWorkflowExecutions(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -477,6 +603,22 @@ func (handler *DCRedirectionHandlerImpl) GetSearchAttributes(
 	return handler.frontendHandler.GetSearchAttributes(ctx)
 }
 
+// GetSearchAttributesSchema API call
+func (handler *DCRedirectionHandlerImpl) GetSearchAttributesSchema(
+	ctx context.Context,
+	request *shared.GetSearchAttributesSchemaRequest,
+) (resp *shared.GetSearchAttributesSchemaResponse, retError error) {
+
+	var cluster = handler.currentClusterName
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionGetSearchAttributesSchemaScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.GetSearchAttributesSchema(ctx, request)
+}
+
 // PollForActivityTask API call
 func (handler *DCRedirectionHandlerImpl) PollForActivityTask(
 	ctx context.Context,
@@ -498,8 +640,9 @@ func (handler *DCRedirectionHandlerImpl) PollForActivityTask(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.PollForActivityTask(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.PollForActivityTask(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.PollForActivityTask(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -528,8 +671,9 @@ func (handler *DCRedirectionHandlerImpl) PollForDecisionTask(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.PollForDecisionTask(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.PollForDecisionTask(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.PollForDecisionTask(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -558,8 +702,9 @@ func (handler *DCRedirectionHandlerImpl) QueryWorkflow(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.QueryWorkflow(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.QueryWorkflow(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.QueryWorkflow(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -593,8 +738,9 @@ func (handler *DCRedirectionHandlerImpl) RecordActivityTaskHeartbeat(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.RecordActivityTaskHeartbeat(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.RecordActivityTaskHeartbeat(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.RecordActivityTaskHeartbeat(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -623,8 +769,9 @@ func (handler *DCRedirectionHandlerImpl) RecordActivityTaskHeartbeatByID(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.RecordActivityTaskHeartbeatByID(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.RecordActivityTaskHeartbeatByID(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.RecordActivityTaskHeartbeatByID(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -653,8 +800,9 @@ func (handler *DCRedirectionHandlerImpl) RequestCancelWorkflowExecution(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RequestCancelWorkflowExecution(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RequestCancelWorkflowExecution(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RequestCancelWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -683,8 +831,9 @@ func (handler *DCRedirectionHandlerImpl) ResetStickyTaskList(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.ResetStickyTaskList(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.ResetStickyTaskList(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ResetStickyTaskList(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -713,8 +862,9 @@ func (handler *DCRedirectionHandlerImpl) ResetWorkflowExecution(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.ResetWorkflowExecution(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.ResetWorkflowExecution(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ResetWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -748,8 +898,9 @@ func (handler *DCRedirectionHandlerImpl) RespondActivityTaskCanceled(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondActivityTaskCanceled(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondActivityTaskCanceled(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondActivityTaskCanceled(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -778,8 +929,9 @@ func (handler *DCRedirectionHandlerImpl) RespondActivityTaskCanceledByID(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondActivityTaskCanceledByID(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondActivityTaskCanceledByID(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondActivityTaskCanceledByID(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -813,8 +965,9 @@ func (handler *DCRedirectionHandlerImpl) RespondActivityTaskCompleted(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondActivityTaskCompleted(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondActivityTaskCompleted(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondActivityTaskCompleted(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -843,8 +996,9 @@ func (handler *DCRedirectionHandlerImpl) RespondActivityTaskCompletedByID(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondActivityTaskCompletedByID(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondActivityTaskCompletedByID(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondActivityTaskCompletedByID(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -878,8 +1032,9 @@ func (handler *DCRedirectionHandlerImpl) RespondActivityTaskFailed(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondActivityTaskFailed(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondActivityTaskFailed(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondActivityTaskFailed(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -908,8 +1063,9 @@ func (handler *DCRedirectionHandlerImpl) RespondActivityTaskFailedByID(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondActivityTaskFailedByID(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondActivityTaskFailedByID(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondActivityTaskFailedByID(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -943,8 +1099,9 @@ func (handler *DCRedirectionHandlerImpl) RespondDecisionTaskCompleted(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.RespondDecisionTaskCompleted(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.RespondDecisionTaskCompleted(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.RespondDecisionTaskCompleted(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -978,8 +1135,9 @@ func (handler *DCRedirectionHandlerImpl) RespondDecisionTaskFailed(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondDecisionTaskFailed(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondDecisionTaskFailed(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondDecisionTaskFailed(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -1013,8 +1171,9 @@ func (handler *DCRedirectionHandlerImpl) RespondQueryTaskCompleted(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.RespondQueryTaskCompleted(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.RespondQueryTaskCompleted(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.RespondQueryTaskCompleted(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -1043,8 +1202,9 @@ func (handler *DCRedirectionHandlerImpl) SignalWithStartWorkflowExecution(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.SignalWithStartWorkflowExecution(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.SignalWithStartWorkflowExecution(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.SignalWithStartWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -1073,8 +1233,9 @@ func (handler *DCRedirectionHandlerImpl) SignalWorkflowExecution(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.SignalWorkflowExecution(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.SignalWorkflowExecution(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.SignalWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -1102,8 +1263,9 @@ func (handler *DCRedirectionHandlerImpl) StartWorkflowExecution(
 		case targetDC == handler.currentClusterName:
 			resp, err = handler.frontendHandler.StartWorkflowExecution(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			resp, err = remoteClient.StartWorkflowExecution(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.StartWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -1132,8 +1294,71 @@ func (handler *DCRedirectionHandlerImpl) TerminateWorkflowExecution(
 		case targetDC == handler.currentClusterName:
 			err = handler.frontendHandler.TerminateWorkflowExecution(ctx, request)
 		default:
-			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
-			err = remoteClient.TerminateWorkflowExecution(ctx, request)
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.TerminateWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
+		}
+		return err
+	})
+
+	return err
+}
+
+// PauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "PauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
+		}
+		return err
+	})
+
+	return err
+}
+
+// ResumeWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResumeWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "ResumeWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionResumeWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.ResumeWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.getRemoteFrontendClient(targetDC)
+			err = remoteClient.ResumeWorkflowExecution(ctx, request, handler.forwardingCallOptions(ctx)...)
+			err = handler.translateRemoteError(err, targetDC, scope)
 		}
 		return err
 	})
@@ -1157,6 +1382,85 @@ func (handler *DCRedirectionHandlerImpl) GetDomainReplicationMessages(
 	return handler.frontendHandler.GetDomainReplicationMessages(ctx, request)
 }
 
+// forwardingCallOptions returns the call options of a request forwarded to a remote cluster
+func (handler *DCRedirectionHandlerImpl) forwardingCallOptions(
+	ctx context.Context,
+) []yarpc.CallOption {
+
+	hops := getDCRedirectionHops(ctx) + 1
+	return []yarpc.CallOption{yarpc.WithHeader(common.DCRedirectionHopsHeaderName, strconv.Itoa(hops))}
+}
+
+// getRemoteFrontendClient returns the client of the target cluster, which retries on retryable remote errors,
+// the retryable client is cached per cluster and only rebuilt when the max retry attempts change
+func (handler *DCRedirectionHandlerImpl) getRemoteFrontendClient(
+	targetDC string,
+) frontend.Client {
+
+	remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+	maxAttempts := handler.config.DCRedirectionMaxRetryAttempts()
+	if maxAttempts <= 0 {
+		return remoteClient
+	}
+
+	handler.remoteClientsLock.RLock()
+	cached, ok := handler.remoteFrontendClients[targetDC]
+	handler.remoteClientsLock.RUnlock()
+	if ok && cached.client == remoteClient && cached.maxAttempts == maxAttempts {
+		return cached.retryableClient
+	}
+
+	scope := handler.metricsClient.Scope(metrics.DCRedirectionRemoteClientScope, metrics.TargetClusterTag(targetDC))
+	isRetryable := func(err error) bool {
+		if err == frontend.ErrCircuitBreakerOpen {
+			// retrying would only hold on to the caller until the breaker lets a probe through
+			return false
+		}
+		switch err.(type) {
+		case *shared.ServiceBusyError, *h.ShardOwnershipLostError:
+			scope.IncCounter(metrics.CadenceDcRedirectionClientRetries)
+			return true
+		}
+		return false
+	}
+	cached = &remoteFrontendClient{
+		client:          remoteClient,
+		maxAttempts:     maxAttempts,
+		retryableClient: frontend.NewRetryableClient(remoteClient, common.CreateDCRedirectionRetryPolicy(maxAttempts), isRetryable),
+	}
+
+	handler.remoteClientsLock.Lock()
+	handler.remoteFrontendClients[targetDC] = cached
+	handler.remoteClientsLock.Unlock()
+	return cached.retryableClient
+}
+
+// translateRemoteError converts errors which only make sense between clusters into errors meaningful to the caller
+func (handler *DCRedirectionHandlerImpl) translateRemoteError(
+	err error,
+	targetDC string,
+	scope metrics.Scope,
+) error {
+
+	var translated error
+	switch err := err.(type) {
+	case *h.ShardOwnershipLostError:
+		translated = &shared.ServiceBusyError{Message: fmt.Sprintf("Cluster %v is rebalancing its shards.", targetDC)}
+	case *yarpcerrors.Status:
+		switch {
+		case yarpcerrors.IsUnavailable(err):
+			translated = &shared.ServiceBusyError{Message: fmt.Sprintf("Cluster %v is unavailable.", targetDC)}
+		case yarpcerrors.IsDeadlineExceeded(err):
+			translated = &shared.InternalServiceError{Message: fmt.Sprintf("Request forwarded to cluster %v timed out.", targetDC)}
+		}
+	}
+	if translated == nil {
+		return err
+	}
+	scope.Tagged(metrics.TargetClusterTag(targetDC)).IncCounter(metrics.CadenceDcRedirectionClientTranslatedErrors)
+	return translated
+}
+
 func (handler *DCRedirectionHandlerImpl) beforeCall(
 	scope int,
 ) (metrics.Scope, time.Time) {
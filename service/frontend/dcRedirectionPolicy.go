@@ -23,11 +23,15 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service/config"
 	"go.uber.org/yarpc"
 )
@@ -43,8 +47,13 @@ const (
 	// 3. SignalWorkflowExecution
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
+	// or, if enabled for the domain, all the APIs except for the worker APIs
 	// please also reference selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs
+	// and selectedAPIsForwardingRedirectionPolicyWorkerAPIs
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
+	// DCRedirectionPolicyShadow means no redirection, but the target cluster of the shadow policy
+	// is evaluated for each call and reported, so that the shadow policy can be validated before enabling it
+	DCRedirectionPolicyShadow = "shadow"
 )
 
 type (
@@ -66,6 +75,15 @@ type (
 		config             *Config
 		domainCache        cache.DomainCache
 	}
+
+	// ShadowRedirectionPolicy is a DC redirection policy which executes all calls in the current cluster,
+	// while reporting the target cluster the shadow policy would have picked for each call
+	ShadowRedirectionPolicy struct {
+		currentClusterName string
+		shadowPolicy       DCRedirectionPolicy
+		metricsClient      metrics.Client
+		logger             log.Logger
+	}
 )
 
 // selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs contains a list of APIs which can be redirected
@@ -77,9 +95,30 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"TerminateWorkflowExecution":       {},
 }
 
+// selectedAPIsForwardingRedirectionPolicyWorkerAPIs contains a list of APIs used by workers,
+// which are never redirected since workers poll the task lists of the current cluster
+var selectedAPIsForwardingRedirectionPolicyWorkerAPIs = map[string]struct{}{
+	"PollForActivityTask":              {},
+	"PollForDecisionTask":              {},
+	"RecordActivityTaskHeartbeat":      {},
+	"RecordActivityTaskHeartbeatByID":  {},
+	"RespondActivityTaskCanceled":      {},
+	"RespondActivityTaskCanceledByID":  {},
+	"RespondActivityTaskCompleted":     {},
+	"RespondActivityTaskCompletedByID": {},
+	"RespondActivityTaskFailed":        {},
+	"RespondActivityTaskFailedByID":    {},
+	"RespondDecisionTaskCompleted":     {},
+	"RespondDecisionTaskFailed":        {},
+	"RespondQueryTaskCompleted":        {},
+	"ResetStickyTaskList":              {},
+	"DescribeTaskList":                 {},
+}
+
 // RedirectionPolicyGenerator generate corresponding redirection policy
 func RedirectionPolicyGenerator(clusterMetadata cluster.Metadata, config *Config,
-	domainCache cache.DomainCache, policy config.DCRedirectionPolicy) DCRedirectionPolicy {
+	domainCache cache.DomainCache, policy config.DCRedirectionPolicy,
+	metricsClient metrics.Client, logger log.Logger) DCRedirectionPolicy {
 	switch policy.Policy {
 	case DCRedirectionPolicyDefault:
 		// default policy, noop
@@ -89,6 +128,21 @@ func RedirectionPolicyGenerator(clusterMetadata cluster.Metadata, config *Config
 	case DCRedirectionPolicySelectedAPIsForwarding:
 		currentClusterName := clusterMetadata.GetCurrentClusterName()
 		return NewSelectedAPIsForwardingPolicy(currentClusterName, config, domainCache)
+	case DCRedirectionPolicyShadow:
+		shadowPolicy := policy
+		shadowPolicy.Policy = policy.ShadowPolicy
+		if shadowPolicy.Policy == DCRedirectionPolicyDefault {
+			shadowPolicy.Policy = DCRedirectionPolicySelectedAPIsForwarding
+		}
+		if shadowPolicy.Policy == DCRedirectionPolicyShadow {
+			panic("DC redirection policy cannot shadow itself")
+		}
+		return NewShadowRedirectionPolicy(
+			clusterMetadata.GetCurrentClusterName(),
+			RedirectionPolicyGenerator(clusterMetadata, config, domainCache, shadowPolicy, metricsClient, logger),
+			metricsClient,
+			logger,
+		)
 	default:
 		panic(fmt.Sprintf("Unknown DC redirection policy %v", policy.Policy))
 	}
@@ -141,12 +195,25 @@ func (policy *SelectedAPIsForwardingRedirectionPolicy) WithDomainNameRedirect(ct
 func (policy *SelectedAPIsForwardingRedirectionPolicy) withRedirect(ctx context.Context, domainEntry *cache.DomainCacheEntry, apiName string, call func(string) error) error {
 	targetDC, enableDomainNotActiveForwarding := policy.getTargetClusterAndIsDomainNotActiveAutoForwarding(ctx, domainEntry, apiName)
 
-	err := call(targetDC)
+	err := policy.callWithHopLimit(ctx, targetDC, call)
 
 	targetDC, ok := policy.isDomainNotActiveError(err)
 	if !ok || !enableDomainNotActiveForwarding {
 		return err
 	}
+	return policy.callWithHopLimit(ctx, targetDC, call)
+}
+
+// callWithHopLimit rejects the call if it would forward a request which already reached the max number of hops,
+// this prevents redirect loops when clusters disagree on which cluster is active
+func (policy *SelectedAPIsForwardingRedirectionPolicy) callWithHopLimit(ctx context.Context, targetDC string, call func(string) error) error {
+	if targetDC != policy.currentClusterName {
+		if hops := getDCRedirectionHops(ctx); hops >= policy.config.DCRedirectionMaxHops() {
+			return &shared.InternalServiceError{
+				Message: fmt.Sprintf("Request has been forwarded %v times, exceeding the DC redirection hop limit.", hops),
+			}
+		}
+	}
 	return call(targetDC)
 }
 
@@ -175,11 +242,88 @@ func (policy *SelectedAPIsForwardingRedirectionPolicy) getTargetClusterAndIsDoma
 		return policy.currentClusterName, false
 	}
 
-	_, ok := selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs[apiName]
-	if !ok {
+	if !policy.isAPIForwarded(domainEntry.GetInfo().Name, apiName) {
 		// do not do dc redirection if API is not whitelisted
 		return policy.currentClusterName, false
 	}
 
 	return domainEntry.GetReplicationConfig().ActiveClusterName, true
 }
+
+func (policy *SelectedAPIsForwardingRedirectionPolicy) isAPIForwarded(domainName string, apiName string) bool {
+	if _, ok := selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs[apiName]; ok {
+		return true
+	}
+	if _, ok := selectedAPIsForwardingRedirectionPolicyWorkerAPIs[apiName]; ok {
+		return false
+	}
+	return policy.config.DCRedirectionForwardNonWorkerAPIs(domainName)
+}
+
+// NewShadowRedirectionPolicy creates a DC redirection policy which executes all calls in the current cluster,
+// while reporting the target cluster of the given shadow policy
+func NewShadowRedirectionPolicy(
+	currentClusterName string,
+	shadowPolicy DCRedirectionPolicy,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *ShadowRedirectionPolicy {
+	return &ShadowRedirectionPolicy{
+		currentClusterName: currentClusterName,
+		shadowPolicy:       shadowPolicy,
+		metricsClient:      metricsClient,
+		logger:             logger,
+	}
+}
+
+// WithDomainIDRedirect executes the API call in the current cluster, reporting the redirection of the shadow policy
+func (policy *ShadowRedirectionPolicy) WithDomainIDRedirect(ctx context.Context, domainID string, apiName string, call func(string) error) error {
+	var shadowTargetDC string
+	err := policy.shadowPolicy.WithDomainIDRedirect(ctx, domainID, apiName, policy.dryRun(&shadowTargetDC))
+	policy.report(apiName, tag.WorkflowDomainID(domainID), shadowTargetDC, err)
+	return call(policy.currentClusterName)
+}
+
+// WithDomainNameRedirect executes the API call in the current cluster, reporting the redirection of the shadow policy
+func (policy *ShadowRedirectionPolicy) WithDomainNameRedirect(ctx context.Context, domainName string, apiName string, call func(string) error) error {
+	var shadowTargetDC string
+	err := policy.shadowPolicy.WithDomainNameRedirect(ctx, domainName, apiName, policy.dryRun(&shadowTargetDC))
+	policy.report(apiName, tag.WorkflowDomainName(domainName), shadowTargetDC, err)
+	return call(policy.currentClusterName)
+}
+
+// dryRun returns a call which only records the target cluster picked by the shadow policy
+func (policy *ShadowRedirectionPolicy) dryRun(targetDC *string) func(string) error {
+	return func(target string) error {
+		*targetDC = target
+		return nil
+	}
+}
+
+func (policy *ShadowRedirectionPolicy) report(apiName string, domainTag tag.Tag, shadowTargetDC string, err error) {
+	scope := policy.metricsClient.Scope(metrics.DCRedirectionShadowScope)
+	if err != nil {
+		scope.IncCounter(metrics.CadenceDcRedirectionShadowFailures)
+		policy.logger.Warn("Failed to evaluate shadow DC redirection policy.",
+			tag.Name(apiName), domainTag, tag.Error(err))
+		return
+	}
+
+	scope = scope.Tagged(metrics.TargetClusterTag(shadowTargetDC))
+	scope.IncCounter(metrics.CadenceDcRedirectionShadowRequests)
+	if shadowTargetDC != policy.currentClusterName {
+		scope.IncCounter(metrics.CadenceDcRedirectionShadowRedirects)
+		policy.logger.Info("Shadow DC redirection policy would redirect call.",
+			tag.Name(apiName), domainTag, tag.SourceCluster(policy.currentClusterName), tag.TargetCluster(shadowTargetDC))
+	}
+}
+
+// getDCRedirectionHops returns the number of times the inbound request has been forwarded between clusters
+func getDCRedirectionHops(ctx context.Context) int {
+	call := yarpc.CallFromContext(ctx)
+	hops, err := strconv.Atoi(call.Header(common.DCRedirectionHopsHeaderName))
+	if err != nil {
+		return 0
+	}
+	return hops
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/shared"
@@ -55,6 +56,15 @@ type (
 		mockClusterMetadata    *mocks.ClusterMetadata
 		policy                 *SelectedAPIsForwardingRedirectionPolicy
 	}
+
+	shadowDCRedirectionPolicySuite struct {
+		suite.Suite
+		currentClusterName     string
+		alternativeClusterName string
+		testScope              tally.TestScope
+		mockShadowPolicy       *MockDCRedirectionPolicy
+		policy                 *ShadowRedirectionPolicy
+	}
 )
 
 func TestNoopDCRedirectionPolicySuite(t *testing.T) {
@@ -263,6 +273,63 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_G
 	s.Equal(2*len(selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs), callCount)
 }
 
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_ForwardingNonWorkerAPIs() {
+	s.setupGlobalDomainWithTwoReplicationCluster(true, false)
+
+	targetClusters := map[string]string{}
+	callFn := func(apiName string) func(string) error {
+		return func(targetCluster string) error {
+			targetClusters[apiName] = targetCluster
+			return nil
+		}
+	}
+	apiNames := []string{"DescribeWorkflowExecution", "PollForDecisionTask", "RespondActivityTaskCompleted", "StartWorkflowExecution"}
+
+	for _, apiName := range apiNames {
+		err := s.policy.WithDomainNameRedirect(context.Background(), s.domainName, apiName, callFn(apiName))
+		s.Nil(err)
+	}
+	s.Equal(map[string]string{
+		"DescribeWorkflowExecution":    s.currentClusterName,
+		"PollForDecisionTask":          s.currentClusterName,
+		"RespondActivityTaskCompleted": s.currentClusterName,
+		"StartWorkflowExecution":       s.alternativeClusterName,
+	}, targetClusters)
+
+	s.mockConfig.DCRedirectionForwardNonWorkerAPIs = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	for _, apiName := range apiNames {
+		err := s.policy.WithDomainNameRedirect(context.Background(), s.domainName, apiName, callFn(apiName))
+		s.Nil(err)
+	}
+	s.Equal(map[string]string{
+		"DescribeWorkflowExecution":    s.alternativeClusterName,
+		"PollForDecisionTask":          s.currentClusterName,
+		"RespondActivityTaskCompleted": s.currentClusterName,
+		"StartWorkflowExecution":       s.alternativeClusterName,
+	}, targetClusters)
+}
+
+func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_HopLimitExceeded() {
+	s.setupGlobalDomainWithTwoReplicationCluster(true, false)
+	s.mockConfig.DCRedirectionMaxHops = dynamicconfig.GetIntPropertyFn(0)
+
+	callCount := 0
+	callFn := func(targetCluster string) error {
+		callCount++
+		return nil
+	}
+
+	for apiName := range selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs {
+		err := s.policy.WithDomainIDRedirect(context.Background(), s.domainID, apiName, callFn)
+		s.IsType(&shared.InternalServiceError{}, err)
+
+		err = s.policy.WithDomainNameRedirect(context.Background(), s.domainName, apiName, callFn)
+		s.IsType(&shared.InternalServiceError{}, err)
+	}
+
+	s.Equal(0, callCount)
+}
+
 func (s *selectedAPIsForwardingRedirectionPolicySuite) TestGetTargetDataCenter_GlobalDomain_Forwarding_CurrentClusterToAlternativeCluster() {
 	s.setupGlobalDomainWithTwoReplicationCluster(true, true)
 
@@ -388,3 +455,84 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) setupGlobalDomainWithTwoR
 	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: s.domainName}).Return(domainRecord, nil)
 	s.mockConfig.EnableDomainNotActiveAutoForwarding = dynamicconfig.GetBoolPropertyFnFilteredByDomain(forwardingEnabled)
 }
+
+func TestShadowDCRedirectionPolicySuite(t *testing.T) {
+	s := new(shadowDCRedirectionPolicySuite)
+	suite.Run(t, s)
+}
+
+func (s *shadowDCRedirectionPolicySuite) SetupTest() {
+	s.currentClusterName = cluster.TestCurrentClusterName
+	s.alternativeClusterName = cluster.TestAlternativeClusterName
+	s.testScope = tally.NewTestScope("test", nil)
+	s.mockShadowPolicy = &MockDCRedirectionPolicy{}
+	s.policy = NewShadowRedirectionPolicy(
+		s.currentClusterName,
+		s.mockShadowPolicy,
+		metrics.NewClient(s.testScope, metrics.Frontend),
+		loggerimpl.NewDevelopmentForTest(s.Suite),
+	)
+}
+
+func (s *shadowDCRedirectionPolicySuite) TearDownTest() {
+	s.mockShadowPolicy.AssertExpectations(s.T())
+}
+
+func (s *shadowDCRedirectionPolicySuite) TestWithDomainRedirect_ShadowRedirects() {
+	domainName := "some random domain name"
+	domainID := "some random domain ID"
+	apiName := "any random API name"
+	shadowRedirect := func(domain string, apiName string, call func(string) error) error {
+		return call(s.alternativeClusterName)
+	}
+	s.mockShadowPolicy.On("WithDomainIDRedirect", domainID, apiName, mock.Anything).Return(shadowRedirect).Once()
+	s.mockShadowPolicy.On("WithDomainNameRedirect", domainName, apiName, mock.Anything).Return(shadowRedirect).Once()
+
+	callCount := 0
+	callFn := func(targetCluster string) error {
+		callCount++
+		s.Equal(s.currentClusterName, targetCluster)
+		return nil
+	}
+
+	err := s.policy.WithDomainIDRedirect(context.Background(), domainID, apiName, callFn)
+	s.Nil(err)
+
+	err = s.policy.WithDomainNameRedirect(context.Background(), domainName, apiName, callFn)
+	s.Nil(err)
+
+	s.Equal(2, callCount)
+	s.Equal(int64(2), s.counterValue("test.cadence_shadow_requests_redirection"))
+	s.Equal(int64(2), s.counterValue("test.cadence_shadow_redirects_redirection"))
+}
+
+func (s *shadowDCRedirectionPolicySuite) TestWithDomainRedirect_ShadowFailure() {
+	domainName := "some random domain name"
+	apiName := "any random API name"
+	s.mockShadowPolicy.On("WithDomainNameRedirect", domainName, apiName, mock.Anything).
+		Return(&shared.EntityNotExistsError{}).Once()
+
+	callCount := 0
+	callFn := func(targetCluster string) error {
+		callCount++
+		s.Equal(s.currentClusterName, targetCluster)
+		return &shared.BadRequestError{}
+	}
+
+	err := s.policy.WithDomainNameRedirect(context.Background(), domainName, apiName, callFn)
+	s.IsType(&shared.BadRequestError{}, err)
+
+	s.Equal(1, callCount)
+	s.Equal(int64(1), s.counterValue("test.cadence_shadow_errors_redirection"))
+	s.Equal(int64(0), s.counterValue("test.cadence_shadow_requests_redirection"))
+}
+
+func (s *shadowDCRedirectionPolicySuite) counterValue(name string) int64 {
+	var value int64
+	for _, counter := range s.testScope.Snapshot().Counters() {
+		if counter.Name() == name {
+			value += counter.Value()
+		}
+	}
+	return value
+}
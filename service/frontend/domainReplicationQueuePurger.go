@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	domainReplicationQueuePurgeInterval = 5 * time.Minute
+	// domainReplicationQueuePurgerKey is looked up in the frontend membership ring to pick the host purging the queue
+	domainReplicationQueuePurgerKey = "domain-replication-queue-purger"
+)
+
+type (
+	// domainReplicationQueuePurger periodically deletes the domain replication messages acked by every
+	// remote cluster, only the frontend host owning domainReplicationQueuePurgerKey purges the queue
+	domainReplicationQueuePurger struct {
+		queue           persistence.DomainReplicationQueue
+		clusterMetadata cluster.Metadata
+		isOwner         func() bool
+		logger          log.Logger
+		status          int32
+		done            chan struct{}
+	}
+)
+
+func newDomainReplicationQueuePurger(
+	queue persistence.DomainReplicationQueue,
+	clusterMetadata cluster.Metadata,
+	isOwner func() bool,
+	logger log.Logger,
+) *domainReplicationQueuePurger {
+	return &domainReplicationQueuePurger{
+		queue:           queue,
+		clusterMetadata: clusterMetadata,
+		isOwner:         isOwner,
+		logger:          logger,
+		status:          common.DaemonStatusInitialized,
+		done:            make(chan struct{}),
+	}
+}
+
+// isDomainReplicationQueuePurgerOwner returns whether the frontend host owns the purging of the domain replication queue
+func isDomainReplicationQueuePurgerOwner(svc service.Service) bool {
+	owner, err := svc.GetMembershipMonitor().Lookup(common.FrontendServiceName, domainReplicationQueuePurgerKey)
+	return err == nil && owner.Identity() == svc.GetHostInfo().Identity()
+}
+
+func (p *domainReplicationQueuePurger) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go p.purgeProcessor()
+}
+
+func (p *domainReplicationQueuePurger) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(p.done)
+}
+
+func (p *domainReplicationQueuePurger) purgeProcessor() {
+	ticker := time.NewTicker(domainReplicationQueuePurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			if err := p.purge(); err != nil {
+				p.logger.Warn("Failed to purge acked domain replication messages.", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (p *domainReplicationQueuePurger) purge() error {
+	if !p.isOwner() {
+		return nil
+	}
+	return p.queue.PurgeAckedMessages(p.getRemoteClusters())
+}
+
+// getRemoteClusters returns the enabled clusters pulling domain replication messages from the current cluster
+func (p *domainReplicationQueuePurger) getRemoteClusters() []string {
+	var remoteClusters []string
+	for clusterName, clusterInfo := range p.clusterMetadata.GetAllClusterInfo() {
+		if clusterInfo.Enabled && clusterName != p.clusterMetadata.GetCurrentClusterName() {
+			remoteClusters = append(remoteClusters, clusterName)
+		}
+	}
+	sort.Strings(remoteClusters)
+	return remoteClusters
+}
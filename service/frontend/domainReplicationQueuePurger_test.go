@@ -0,0 +1,87 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	domainReplicationQueuePurgerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller          *gomock.Controller
+		mockQueue           *persistence.MockDomainReplicationQueue
+		mockClusterMetadata *mocks.ClusterMetadata
+		isOwner             bool
+		purger              *domainReplicationQueuePurger
+	}
+)
+
+func TestDomainReplicationQueuePurgerSuite(t *testing.T) {
+	s := new(domainReplicationQueuePurgerSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainReplicationQueuePurgerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockQueue = persistence.NewMockDomainReplicationQueue(s.controller)
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return("active")
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(map[string]config.ClusterInformation{
+		"active":   {Enabled: true},
+		"standby":  {Enabled: true},
+		"other":    {Enabled: true},
+		"disabled": {Enabled: false},
+	})
+	s.isOwner = true
+	s.purger = newDomainReplicationQueuePurger(
+		s.mockQueue,
+		s.mockClusterMetadata,
+		func() bool { return s.isOwner },
+		loggerimpl.NewNopLogger(),
+	)
+}
+
+func (s *domainReplicationQueuePurgerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *domainReplicationQueuePurgerSuite) TestPurge_Owner() {
+	s.mockQueue.EXPECT().PurgeAckedMessages([]string{"other", "standby"}).Return(nil).Times(1)
+	s.NoError(s.purger.purge())
+}
+
+func (s *domainReplicationQueuePurgerSuite) TestPurge_NotOwner() {
+	s.isOwner = false
+	s.NoError(s.purger.purge())
+}
@@ -0,0 +1,415 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common/mapper/proto"
+)
+
+var _ apiv1.WorkflowServiceYARPCServer = (*grpcHandler)(nil)
+
+type (
+	// grpcHandler serves the protobuf WorkflowService by converting each request to thrift, calling
+	// the thrift handler and converting the result back
+	grpcHandler struct {
+		handler workflowserviceserver.Interface
+	}
+)
+
+func newGRPCHandler(handler workflowserviceserver.Interface) *grpcHandler {
+	return &grpcHandler{handler: handler}
+}
+
+// RegisterDomain converts the protobuf request and calls RegisterDomain on the thrift handler
+func (g *grpcHandler) RegisterDomain(ctx context.Context, request *apiv1.RegisterDomainRequest) (*apiv1.RegisterDomainResponse, error) {
+	if err := g.handler.RegisterDomain(ctx, proto.ToRegisterDomainRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RegisterDomainResponse{}, nil
+}
+
+// DescribeDomain converts the protobuf request and calls DescribeDomain on the thrift handler
+func (g *grpcHandler) DescribeDomain(ctx context.Context, request *apiv1.DescribeDomainRequest) (*apiv1.DescribeDomainResponse, error) {
+	response, err := g.handler.DescribeDomain(ctx, proto.ToDescribeDomainRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeDomainResponse(response), nil
+}
+
+// ListDomains converts the protobuf request and calls ListDomains on the thrift handler
+func (g *grpcHandler) ListDomains(ctx context.Context, request *apiv1.ListDomainsRequest) (*apiv1.ListDomainsResponse, error) {
+	response, err := g.handler.ListDomains(ctx, proto.ToListDomainsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListDomainsResponse(response), nil
+}
+
+// UpdateDomain converts the protobuf request and calls UpdateDomain on the thrift handler
+func (g *grpcHandler) UpdateDomain(ctx context.Context, request *apiv1.UpdateDomainRequest) (*apiv1.UpdateDomainResponse, error) {
+	response, err := g.handler.UpdateDomain(ctx, proto.ToUpdateDomainRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromUpdateDomainResponse(response), nil
+}
+
+// DeprecateDomain converts the protobuf request and calls DeprecateDomain on the thrift handler
+func (g *grpcHandler) DeprecateDomain(ctx context.Context, request *apiv1.DeprecateDomainRequest) (*apiv1.DeprecateDomainResponse, error) {
+	if err := g.handler.DeprecateDomain(ctx, proto.ToDeprecateDomainRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.DeprecateDomainResponse{}, nil
+}
+
+// StartWorkflowExecution converts the protobuf request and calls StartWorkflowExecution on the thrift handler
+func (g *grpcHandler) StartWorkflowExecution(ctx context.Context, request *apiv1.StartWorkflowExecutionRequest) (*apiv1.StartWorkflowExecutionResponse, error) {
+	response, err := g.handler.StartWorkflowExecution(ctx, proto.ToStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromStartWorkflowExecutionResponse(response), nil
+}
+
+// GetWorkflowExecutionHistory converts the protobuf request and calls GetWorkflowExecutionHistory on the thrift handler
+func (g *grpcHandler) GetWorkflowExecutionHistory(ctx context.Context, request *apiv1.GetWorkflowExecutionHistoryRequest) (*apiv1.GetWorkflowExecutionHistoryResponse, error) {
+	response, err := g.handler.GetWorkflowExecutionHistory(ctx, proto.ToGetWorkflowExecutionHistoryRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+// PollForDecisionTask converts the protobuf request and calls PollForDecisionTask on the thrift handler
+func (g *grpcHandler) PollForDecisionTask(ctx context.Context, request *apiv1.PollForDecisionTaskRequest) (*apiv1.PollForDecisionTaskResponse, error) {
+	response, err := g.handler.PollForDecisionTask(ctx, proto.ToPollForDecisionTaskRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromPollForDecisionTaskResponse(response), nil
+}
+
+// RespondDecisionTaskCompleted converts the protobuf request and calls RespondDecisionTaskCompleted on the thrift handler
+func (g *grpcHandler) RespondDecisionTaskCompleted(ctx context.Context, request *apiv1.RespondDecisionTaskCompletedRequest) (*apiv1.RespondDecisionTaskCompletedResponse, error) {
+	response, err := g.handler.RespondDecisionTaskCompleted(ctx, proto.ToRespondDecisionTaskCompletedRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRespondDecisionTaskCompletedResponse(response), nil
+}
+
+// RespondDecisionTaskFailed converts the protobuf request and calls RespondDecisionTaskFailed on the thrift handler
+func (g *grpcHandler) RespondDecisionTaskFailed(ctx context.Context, request *apiv1.RespondDecisionTaskFailedRequest) (*apiv1.RespondDecisionTaskFailedResponse, error) {
+	if err := g.handler.RespondDecisionTaskFailed(ctx, proto.ToRespondDecisionTaskFailedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondDecisionTaskFailedResponse{}, nil
+}
+
+// PollForActivityTask converts the protobuf request and calls PollForActivityTask on the thrift handler
+func (g *grpcHandler) PollForActivityTask(ctx context.Context, request *apiv1.PollForActivityTaskRequest) (*apiv1.PollForActivityTaskResponse, error) {
+	response, err := g.handler.PollForActivityTask(ctx, proto.ToPollForActivityTaskRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromPollForActivityTaskResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeat converts the protobuf request and calls RecordActivityTaskHeartbeat on the thrift handler
+func (g *grpcHandler) RecordActivityTaskHeartbeat(ctx context.Context, request *apiv1.RecordActivityTaskHeartbeatRequest) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+	response, err := g.handler.RecordActivityTaskHeartbeat(ctx, proto.ToRecordActivityTaskHeartbeatRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeatByID converts the protobuf request and calls RecordActivityTaskHeartbeatByID on the thrift handler
+func (g *grpcHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, request *apiv1.RecordActivityTaskHeartbeatByIDRequest) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+	response, err := g.handler.RecordActivityTaskHeartbeatByID(ctx, proto.ToRecordActivityTaskHeartbeatByIDRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RespondActivityTaskCompleted converts the protobuf request and calls RespondActivityTaskCompleted on the thrift handler
+func (g *grpcHandler) RespondActivityTaskCompleted(ctx context.Context, request *apiv1.RespondActivityTaskCompletedRequest) (*apiv1.RespondActivityTaskCompletedResponse, error) {
+	if err := g.handler.RespondActivityTaskCompleted(ctx, proto.ToRespondActivityTaskCompletedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedResponse{}, nil
+}
+
+// RespondActivityTaskCompletedByID converts the protobuf request and calls RespondActivityTaskCompletedByID on the thrift handler
+func (g *grpcHandler) RespondActivityTaskCompletedByID(ctx context.Context, request *apiv1.RespondActivityTaskCompletedByIDRequest) (*apiv1.RespondActivityTaskCompletedByIDResponse, error) {
+	if err := g.handler.RespondActivityTaskCompletedByID(ctx, proto.ToRespondActivityTaskCompletedByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDResponse{}, nil
+}
+
+// RespondActivityTaskFailed converts the protobuf request and calls RespondActivityTaskFailed on the thrift handler
+func (g *grpcHandler) RespondActivityTaskFailed(ctx context.Context, request *apiv1.RespondActivityTaskFailedRequest) (*apiv1.RespondActivityTaskFailedResponse, error) {
+	if err := g.handler.RespondActivityTaskFailed(ctx, proto.ToRespondActivityTaskFailedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedResponse{}, nil
+}
+
+// RespondActivityTaskFailedByID converts the protobuf request and calls RespondActivityTaskFailedByID on the thrift handler
+func (g *grpcHandler) RespondActivityTaskFailedByID(ctx context.Context, request *apiv1.RespondActivityTaskFailedByIDRequest) (*apiv1.RespondActivityTaskFailedByIDResponse, error) {
+	if err := g.handler.RespondActivityTaskFailedByID(ctx, proto.ToRespondActivityTaskFailedByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskFailedByIDResponse{}, nil
+}
+
+// RespondActivityTaskCanceled converts the protobuf request and calls RespondActivityTaskCanceled on the thrift handler
+func (g *grpcHandler) RespondActivityTaskCanceled(ctx context.Context, request *apiv1.RespondActivityTaskCanceledRequest) (*apiv1.RespondActivityTaskCanceledResponse, error) {
+	if err := g.handler.RespondActivityTaskCanceled(ctx, proto.ToRespondActivityTaskCanceledRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledResponse{}, nil
+}
+
+// RespondActivityTaskCanceledByID converts the protobuf request and calls RespondActivityTaskCanceledByID on the thrift handler
+func (g *grpcHandler) RespondActivityTaskCanceledByID(ctx context.Context, request *apiv1.RespondActivityTaskCanceledByIDRequest) (*apiv1.RespondActivityTaskCanceledByIDResponse, error) {
+	if err := g.handler.RespondActivityTaskCanceledByID(ctx, proto.ToRespondActivityTaskCanceledByIDRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDResponse{}, nil
+}
+
+// RequestCancelWorkflowExecution converts the protobuf request and calls RequestCancelWorkflowExecution on the thrift handler
+func (g *grpcHandler) RequestCancelWorkflowExecution(ctx context.Context, request *apiv1.RequestCancelWorkflowExecutionRequest) (*apiv1.RequestCancelWorkflowExecutionResponse, error) {
+	if err := g.handler.RequestCancelWorkflowExecution(ctx, proto.ToRequestCancelWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+// SignalWorkflowExecution converts the protobuf request and calls SignalWorkflowExecution on the thrift handler
+func (g *grpcHandler) SignalWorkflowExecution(ctx context.Context, request *apiv1.SignalWorkflowExecutionRequest) (*apiv1.SignalWorkflowExecutionResponse, error) {
+	if err := g.handler.SignalWorkflowExecution(ctx, proto.ToSignalWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.SignalWorkflowExecutionResponse{}, nil
+}
+
+// SignalWithStartWorkflowExecution converts the protobuf request and calls SignalWithStartWorkflowExecution on the thrift handler
+func (g *grpcHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *apiv1.SignalWithStartWorkflowExecutionRequest) (*apiv1.StartWorkflowExecutionResponse, error) {
+	response, err := g.handler.SignalWithStartWorkflowExecution(ctx, proto.ToSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromStartWorkflowExecutionResponse(response), nil
+}
+
+// ResetWorkflowExecution converts the protobuf request and calls ResetWorkflowExecution on the thrift handler
+func (g *grpcHandler) ResetWorkflowExecution(ctx context.Context, request *apiv1.ResetWorkflowExecutionRequest) (*apiv1.ResetWorkflowExecutionResponse, error) {
+	response, err := g.handler.ResetWorkflowExecution(ctx, proto.ToResetWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromResetWorkflowExecutionResponse(response), nil
+}
+
+// TerminateWorkflowExecution converts the protobuf request and calls TerminateWorkflowExecution on the thrift handler
+func (g *grpcHandler) TerminateWorkflowExecution(ctx context.Context, request *apiv1.TerminateWorkflowExecutionRequest) (*apiv1.TerminateWorkflowExecutionResponse, error) {
+	if err := g.handler.TerminateWorkflowExecution(ctx, proto.ToTerminateWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// PauseWorkflowExecution converts the protobuf request and calls PauseWorkflowExecution on the thrift handler
+func (g *grpcHandler) PauseWorkflowExecution(ctx context.Context, request *apiv1.PauseWorkflowExecutionRequest) (*apiv1.PauseWorkflowExecutionResponse, error) {
+	if err := g.handler.PauseWorkflowExecution(ctx, proto.ToPauseWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.PauseWorkflowExecutionResponse{}, nil
+}
+
+// ResumeWorkflowExecution converts the protobuf request and calls ResumeWorkflowExecution on the thrift handler
+func (g *grpcHandler) ResumeWorkflowExecution(ctx context.Context, request *apiv1.ResumeWorkflowExecutionRequest) (*apiv1.ResumeWorkflowExecutionResponse, error) {
+	if err := g.handler.ResumeWorkflowExecution(ctx, proto.ToResumeWorkflowExecutionRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.ResumeWorkflowExecutionResponse{}, nil
+}
+
+// ListOpenWorkflowExecutions converts the protobuf request and calls ListOpenWorkflowExecutions on the thrift handler
+func (g *grpcHandler) ListOpenWorkflowExecutions(ctx context.Context, request *apiv1.ListOpenWorkflowExecutionsRequest) (*apiv1.ListOpenWorkflowExecutionsResponse, error) {
+	response, err := g.handler.ListOpenWorkflowExecutions(ctx, proto.ToListOpenWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListOpenWorkflowExecutionsResponse(response), nil
+}
+
+// ListClosedWorkflowExecutions converts the protobuf request and calls ListClosedWorkflowExecutions on the thrift handler
+func (g *grpcHandler) ListClosedWorkflowExecutions(ctx context.Context, request *apiv1.ListClosedWorkflowExecutionsRequest) (*apiv1.ListClosedWorkflowExecutionsResponse, error) {
+	response, err := g.handler.ListClosedWorkflowExecutions(ctx, proto.ToListClosedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListClosedWorkflowExecutionsResponse(response), nil
+}
+
+// ListWorkflowExecutions converts the protobuf request and calls ListWorkflowExecutions on the thrift handler
+func (g *grpcHandler) ListWorkflowExecutions(ctx context.Context, request *apiv1.ListWorkflowExecutionsRequest) (*apiv1.ListWorkflowExecutionsResponse, error) {
+	response, err := g.handler.ListWorkflowExecutions(ctx, proto.ToListWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListWorkflowExecutionsResponse(response), nil
+}
+
+// ListArchivedWorkflowExecutions converts the protobuf request and calls ListArchivedWorkflowExecutions on the thrift handler
+func (g *grpcHandler) ListArchivedWorkflowExecutions(ctx context.Context, request *apiv1.ListArchivedWorkflowExecutionsRequest) (*apiv1.ListArchivedWorkflowExecutionsResponse, error) {
+	response, err := g.handler.ListArchivedWorkflowExecutions(ctx, proto.ToListArchivedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListArchivedWorkflowExecutionsResponse(response), nil
+}
+
+// ScanWorkflowExecutions converts the protobuf request and calls ScanWorkflowExecutions on the thrift handler
+func (g *grpcHandler) ScanWorkflowExecutions(ctx context.Context, request *apiv1.ListWorkflowExecutionsRequest) (*apiv1.ListWorkflowExecutionsResponse, error) {
+	response, err := g.handler.ScanWorkflowExecutions(ctx, proto.ToListWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListWorkflowExecutionsResponse(response), nil
+}
+
+// CountWorkflowExecutions converts the protobuf request and calls CountWorkflowExecutions on the thrift handler
+func (g *grpcHandler) CountWorkflowExecutions(ctx context.Context, request *apiv1.CountWorkflowExecutionsRequest) (*apiv1.CountWorkflowExecutionsResponse, error) {
+	response, err := g.handler.CountWorkflowExecutions(ctx, proto.ToCountWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromCountWorkflowExecutionsResponse(response), nil
+}
+
+// GetSearchAttributes converts the protobuf request and calls GetSearchAttributes on the thrift handler
+func (g *grpcHandler) GetSearchAttributes(ctx context.Context, request *apiv1.GetSearchAttributesRequest) (*apiv1.GetSearchAttributesResponse, error) {
+	response, err := g.handler.GetSearchAttributes(ctx)
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromGetSearchAttributesResponse(response), nil
+}
+
+// GetSearchAttributesSchema converts the protobuf request and calls GetSearchAttributesSchema on the thrift handler
+func (g *grpcHandler) GetSearchAttributesSchema(ctx context.Context, request *apiv1.GetSearchAttributesSchemaRequest) (*apiv1.GetSearchAttributesSchemaResponse, error) {
+	response, err := g.handler.GetSearchAttributesSchema(ctx, proto.ToGetSearchAttributesSchemaRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromGetSearchAttributesSchemaResponse(response), nil
+}
+
+// RespondQueryTaskCompleted converts the protobuf request and calls RespondQueryTaskCompleted on the thrift handler
+func (g *grpcHandler) RespondQueryTaskCompleted(ctx context.Context, request *apiv1.RespondQueryTaskCompletedRequest) (*apiv1.RespondQueryTaskCompletedResponse, error) {
+	if err := g.handler.RespondQueryTaskCompleted(ctx, proto.ToRespondQueryTaskCompletedRequest(request)); err != nil {
+		return nil, proto.FromError(err)
+	}
+	return &apiv1.RespondQueryTaskCompletedResponse{}, nil
+}
+
+// ResetStickyTaskList converts the protobuf request and calls ResetStickyTaskList on the thrift handler
+func (g *grpcHandler) ResetStickyTaskList(ctx context.Context, request *apiv1.ResetStickyTaskListRequest) (*apiv1.ResetStickyTaskListResponse, error) {
+	response, err := g.handler.ResetStickyTaskList(ctx, proto.ToResetStickyTaskListRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromResetStickyTaskListResponse(response), nil
+}
+
+// QueryWorkflow converts the protobuf request and calls QueryWorkflow on the thrift handler
+func (g *grpcHandler) QueryWorkflow(ctx context.Context, request *apiv1.QueryWorkflowRequest) (*apiv1.QueryWorkflowResponse, error) {
+	response, err := g.handler.QueryWorkflow(ctx, proto.ToQueryWorkflowRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromQueryWorkflowResponse(response), nil
+}
+
+// DescribeWorkflowExecution converts the protobuf request and calls DescribeWorkflowExecution on the thrift handler
+func (g *grpcHandler) DescribeWorkflowExecution(ctx context.Context, request *apiv1.DescribeWorkflowExecutionRequest) (*apiv1.DescribeWorkflowExecutionResponse, error) {
+	response, err := g.handler.DescribeWorkflowExecution(ctx, proto.ToDescribeWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeWorkflowExecutionResponse(response), nil
+}
+
+// BatchDescribeWorkflowExecutions converts the protobuf request and calls BatchDescribeWorkflowExecutions on the thrift handler
+func (g *grpcHandler) BatchDescribeWorkflowExecutions(ctx context.Context, request *apiv1.BatchDescribeWorkflowExecutionsRequest) (*apiv1.BatchDescribeWorkflowExecutionsResponse, error) {
+	response, err := g.handler.BatchDescribeWorkflowExecutions(ctx, proto.ToBatchDescribeWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromBatchDescribeWorkflowExecutionsResponse(response), nil
+}
+
+// BatchOperationWorkflow converts the protobuf request and calls BatchOperationWorkflow on the thrift handler
+func (g *grpcHandler) BatchOperationWorkflow(ctx context.Context, request *apiv1.BatchOperationWorkflowRequest) (*apiv1.BatchOperationWorkflowResponse, error) {
+	response, err := g.handler.BatchOperationWorkflow(ctx, proto.ToBatchOperationWorkflowRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromBatchOperationWorkflowResponse(response), nil
+}
+
+// DescribeBatchOperation converts the protobuf request and calls DescribeBatchOperation on the thrift handler
+func (g *grpcHandler) DescribeBatchOperation(ctx context.Context, request *apiv1.DescribeBatchOperationRequest) (*apiv1.DescribeBatchOperationResponse, error) {
+	response, err := g.handler.DescribeBatchOperation(ctx, proto.ToDescribeBatchOperationRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeBatchOperationResponse(response), nil
+}
+
+// ListWorkflowExecutionChain converts the protobuf request and calls ListWorkflowExecutionChain on the thrift handler
+func (g *grpcHandler) ListWorkflowExecutionChain(ctx context.Context, request *apiv1.ListWorkflowExecutionChainRequest) (*apiv1.ListWorkflowExecutionChainResponse, error) {
+	response, err := g.handler.ListWorkflowExecutionChain(ctx, proto.ToListWorkflowExecutionChainRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromListWorkflowExecutionChainResponse(response), nil
+}
+
+// DescribeTaskList converts the protobuf request and calls DescribeTaskList on the thrift handler
+func (g *grpcHandler) DescribeTaskList(ctx context.Context, request *apiv1.DescribeTaskListRequest) (*apiv1.DescribeTaskListResponse, error) {
+	response, err := g.handler.DescribeTaskList(ctx, proto.ToDescribeTaskListRequest(request))
+	if err != nil {
+		return nil, proto.FromError(err)
+	}
+	return proto.FromDescribeTaskListResponse(response), nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/grpc"
+	"go.uber.org/yarpc/yarpcerrors"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/mapper/proto"
+)
+
+type testWorkflowHandler struct {
+	workflowserviceserver.Interface
+
+	updateRequest *shared.UpdateDomainRequest
+}
+
+func (h *testWorkflowHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+	return &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(request.GetWorkflowId() + "-run")}, nil
+}
+
+func (h *testWorkflowHandler) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+) (*shared.UpdateDomainResponse, error) {
+	h.updateRequest = request
+	return &shared.UpdateDomainResponse{}, nil
+}
+
+func (h *testWorkflowHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+	return nil, &shared.DomainNotActiveError{
+		Message:        "domain not active",
+		DomainName:     request.GetDomain(),
+		CurrentCluster: "standby",
+		ActiveCluster:  "active",
+	}
+}
+
+func (h *testWorkflowHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+) error {
+	return nil
+}
+
+func TestGRPCHandler(t *testing.T) {
+	handler := &testWorkflowHandler{}
+	client, stop := startGRPCHandler(t, handler)
+	defer stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	startResponse, err := client.StartWorkflowExecution(ctx, &apiv1.StartWorkflowExecutionRequest{
+		Domain:     "domain",
+		WorkflowId: "workflowID",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "workflowID-run", startResponse.RunId)
+
+	_, err = client.SignalWorkflowExecution(ctx, &apiv1.SignalWorkflowExecutionRequest{Domain: "domain"})
+	require.NoError(t, err)
+
+	_, err = client.UpdateDomain(ctx, &apiv1.UpdateDomainRequest{
+		Name:        "domain",
+		UpdatedInfo: &apiv1.UpdateDomainInfo{Description: &types.StringValue{}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &shared.UpdateDomainRequest{
+		Name:        common.StringPtr("domain"),
+		UpdatedInfo: &shared.UpdateDomainInfo{Description: common.StringPtr("")},
+	}, handler.updateRequest)
+}
+
+func TestGRPCHandler_Error(t *testing.T) {
+	client, stop := startGRPCHandler(t, &testWorkflowHandler{})
+	defer stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.DescribeWorkflowExecution(ctx, &apiv1.DescribeWorkflowExecutionRequest{Domain: "domain"})
+	require.Error(t, err)
+	require.Equal(t, yarpcerrors.CodeFailedPrecondition, yarpcerrors.FromError(err).Code())
+	require.Equal(t, &shared.DomainNotActiveError{
+		Message:        "domain not active",
+		DomainName:     "domain",
+		CurrentCluster: "standby",
+		ActiveCluster:  "active",
+	}, proto.ToError(err))
+}
+
+func startGRPCHandler(
+	t *testing.T,
+	handler workflowserviceserver.Interface,
+) (apiv1.WorkflowServiceYARPCClient, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	transport := grpc.NewTransport()
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name:     "cadence-frontend",
+		Inbounds: yarpc.Inbounds{transport.NewInbound(listener)},
+	})
+	dispatcher.Register(apiv1.BuildWorkflowServiceYARPCProcedures(newGRPCHandler(handler)))
+	require.NoError(t, dispatcher.Start())
+
+	clientDispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: "test-client",
+		Outbounds: yarpc.Outbounds{
+			"cadence-frontend": {Unary: transport.NewSingleOutbound(listener.Addr().String())},
+		},
+	})
+	require.NoError(t, clientDispatcher.Start())
+	stop := func() {
+		clientDispatcher.Stop()
+		dispatcher.Stop()
+	}
+	return apiv1.NewWorkflowServiceYARPCClient(clientDispatcher.ClientConfig("cadence-frontend")), stop
+}
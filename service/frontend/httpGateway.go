@@ -0,0 +1,343 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// HTTPGatewayAPIKeyHeaderName is the request header carrying the API key for the HTTP gateway
+	HTTPGatewayAPIKeyHeaderName = "X-Cadence-Api-Key"
+	// HTTPGatewayPathPrefix is the path prefix of the HTTP gateway, followed by the operation name
+	HTTPGatewayPathPrefix = "/api/v1/"
+
+	httpGatewayMaxRequestSize      = 4 * 1024 * 1024
+	httpGatewayRequestTimeout      = 30 * time.Second
+	httpGatewayReadHeaderTimeout   = 10 * time.Second
+	httpGatewayShutdownTimeout     = 5 * time.Second
+	httpGatewayBearerPrefix        = "Bearer "
+	httpGatewayContentTypeJSON     = "application/json"
+	httpGatewayContentTypeHeader   = "Content-Type"
+	httpGatewayAuthorizationHeader = "Authorization"
+)
+
+type (
+	// HTTPGateway exposes a subset of the WorkflowService APIs as HTTP JSON endpoints, so
+	// clients without a Thrift / TChannel stack can reach Cadence. Each endpoint is a POST to
+	// HTTPGatewayPathPrefix + operation name, with the JSON encoded thrift request as body.
+	HTTPGateway struct {
+		status     int32
+		handler    workflowserviceserver.Interface
+		config     config.HTTPGateway
+		logger     log.Logger
+		apiKeys    [][]byte
+		operations map[string]httpGatewayOperation
+		server     *http.Server
+	}
+
+	httpGatewayOperation func(ctx context.Context, decode func(request interface{}) error) (interface{}, error)
+
+	httpGatewayError struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+)
+
+// NewHTTPGateway creates a new HTTP gateway which forwards requests to the given handler
+func NewHTTPGateway(
+	handler workflowserviceserver.Interface,
+	gatewayConfig config.HTTPGateway,
+	logger log.Logger,
+) *HTTPGateway {
+
+	g := &HTTPGateway{
+		status:  common.DaemonStatusInitialized,
+		handler: handler,
+		config:  gatewayConfig,
+		logger:  logger,
+	}
+	for _, key := range gatewayConfig.APIKeys {
+		g.apiKeys = append(g.apiKeys, []byte(key))
+	}
+	g.operations = g.createOperations()
+	return g
+}
+
+// Start starts listening for HTTP requests
+func (g *HTTPGateway) Start() error {
+	if !atomic.CompareAndSwapInt32(&g.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+	if len(g.apiKeys) == 0 {
+		return fmt.Errorf("http gateway requires at least one API key")
+	}
+
+	host := ""
+	if g.config.BindOnLocalHost {
+		host = "127.0.0.1"
+	}
+	address := fmt.Sprintf("%v:%v", host, g.config.Port)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(HTTPGatewayPathPrefix, g)
+	g.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: httpGatewayReadHeaderTimeout,
+	}
+	go func() {
+		if err := g.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			g.logger.Error("HTTP gateway stopped serving", tag.Error(err))
+		}
+	}()
+	g.logger.Info("HTTP gateway started", tag.Address(address))
+	return nil
+}
+
+// Stop stops the HTTP gateway
+func (g *HTTPGateway) Stop() {
+	if !atomic.CompareAndSwapInt32(&g.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	if g.server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), httpGatewayShutdownTimeout)
+	defer cancel()
+	if err := g.server.Shutdown(ctx); err != nil {
+		g.logger.Warn("HTTP gateway failed to shutdown gracefully", tag.Error(err))
+	}
+	g.logger.Info("HTTP gateway stopped")
+}
+
+// ServeHTTP implements http.Handler
+func (g *HTTPGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		g.writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "only POST is supported")
+		return
+	}
+	if !g.isAuthorized(r) {
+		g.writeError(w, http.StatusUnauthorized, "Unauthorized", "missing or invalid API key")
+		return
+	}
+
+	operationName := strings.TrimPrefix(r.URL.Path, HTTPGatewayPathPrefix)
+	operation, ok := g.operations[operationName]
+	if !ok {
+		g.writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("unknown operation %v", operationName))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), httpGatewayRequestTimeout)
+	defer cancel()
+
+	decode := func(request interface{}) error {
+		decoder := json.NewDecoder(io.LimitReader(r.Body, httpGatewayMaxRequestSize))
+		if err := decoder.Decode(request); err != nil && err != io.EOF {
+			return &gen.BadRequestError{Message: fmt.Sprintf("unable to decode request: %v", err)}
+		}
+		return nil
+	}
+
+	response, err := operation(ctx, decode)
+	if err != nil {
+		status, errType := httpGatewayErrorStatus(err)
+		g.writeError(w, status, errType, httpGatewayErrorMessage(err))
+		return
+	}
+
+	w.Header().Set(httpGatewayContentTypeHeader, httpGatewayContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		g.logger.Warn("HTTP gateway failed to write response", tag.Error(err))
+	}
+}
+
+func (g *HTTPGateway) isAuthorized(r *http.Request) bool {
+	key := r.Header.Get(HTTPGatewayAPIKeyHeaderName)
+	if key == "" {
+		authorization := r.Header.Get(httpGatewayAuthorizationHeader)
+		if strings.HasPrefix(authorization, httpGatewayBearerPrefix) {
+			key = strings.TrimPrefix(authorization, httpGatewayBearerPrefix)
+		}
+	}
+	if key == "" {
+		return false
+	}
+
+	authorized := false
+	for _, apiKey := range g.apiKeys {
+		// go through all keys in constant time to not leak which key matched
+		if subtle.ConstantTimeCompare(apiKey, []byte(key)) == 1 {
+			authorized = true
+		}
+	}
+	return authorized
+}
+
+func (g *HTTPGateway) writeError(
+	w http.ResponseWriter,
+	status int,
+	errType string,
+	message string,
+) {
+
+	w.Header().Set(httpGatewayContentTypeHeader, httpGatewayContentTypeJSON)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(&httpGatewayError{Type: errType, Message: message}); err != nil {
+		g.logger.Warn("HTTP gateway failed to write error response", tag.Error(err))
+	}
+}
+
+func (g *HTTPGateway) createOperations() map[string]httpGatewayOperation {
+	return map[string]httpGatewayOperation{
+		"StartWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.StartWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return g.handler.StartWorkflowExecution(ctx, request)
+		},
+		"SignalWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.SignalWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			if err := g.handler.SignalWorkflowExecution(ctx, request); err != nil {
+				return nil, err
+			}
+			return struct{}{}, nil
+		},
+		"SignalWithStartWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.SignalWithStartWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return g.handler.SignalWithStartWorkflowExecution(ctx, request)
+		},
+		"QueryWorkflow": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.QueryWorkflowRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return g.handler.QueryWorkflow(ctx, request)
+		},
+		"DescribeWorkflowExecution": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.DescribeWorkflowExecutionRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return g.handler.DescribeWorkflowExecution(ctx, request)
+		},
+		"ListOpenWorkflowExecutions": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.ListOpenWorkflowExecutionsRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return g.handler.ListOpenWorkflowExecutions(ctx, request)
+		},
+		"ListClosedWorkflowExecutions": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.ListClosedWorkflowExecutionsRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return g.handler.ListClosedWorkflowExecutions(ctx, request)
+		},
+		"ListWorkflowExecutions": func(ctx context.Context, decode func(interface{}) error) (interface{}, error) {
+			request := &gen.ListWorkflowExecutionsRequest{}
+			if err := decode(request); err != nil {
+				return nil, err
+			}
+			return g.handler.ListWorkflowExecutions(ctx, request)
+		},
+	}
+}
+
+func httpGatewayErrorStatus(err error) (int, string) {
+	switch err.(type) {
+	case *gen.BadRequestError:
+		return http.StatusBadRequest, "BadRequestError"
+	case *gen.QueryFailedError:
+		return http.StatusBadRequest, "QueryFailedError"
+	case *gen.ClientVersionNotSupportedError:
+		return http.StatusBadRequest, "ClientVersionNotSupportedError"
+	case *gen.EntityNotExistsError:
+		return http.StatusNotFound, "EntityNotExistsError"
+	case *gen.WorkflowExecutionAlreadyStartedError:
+		return http.StatusConflict, "WorkflowExecutionAlreadyStartedError"
+	case *gen.DomainNotActiveError:
+		return http.StatusConflict, "DomainNotActiveError"
+	case *gen.LimitExceededError:
+		return http.StatusTooManyRequests, "LimitExceededError"
+	case *gen.ServiceBusyError:
+		return http.StatusTooManyRequests, "ServiceBusyError"
+	case *gen.InternalServiceError:
+		return http.StatusInternalServerError, "InternalServiceError"
+	default:
+		if err == context.DeadlineExceeded {
+			return http.StatusGatewayTimeout, "Timeout"
+		}
+		return http.StatusInternalServerError, "InternalServiceError"
+	}
+}
+
+func httpGatewayErrorMessage(err error) string {
+	switch err := err.(type) {
+	case *gen.BadRequestError:
+		return err.Message
+	case *gen.QueryFailedError:
+		return err.Message
+	case *gen.EntityNotExistsError:
+		return err.Message
+	case *gen.WorkflowExecutionAlreadyStartedError:
+		return err.GetMessage()
+	case *gen.DomainNotActiveError:
+		return err.Message
+	case *gen.LimitExceededError:
+		return err.Message
+	case *gen.ServiceBusyError:
+		return err.Message
+	case *gen.InternalServiceError:
+		return err.Message
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	httpGatewaySuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller  *gomock.Controller
+		mockHandler *MockWorkflowHandler
+		gateway     *HTTPGateway
+	}
+)
+
+const (
+	testHTTPGatewayAPIKey = "test-api-key"
+)
+
+func TestHTTPGatewaySuite(t *testing.T) {
+	s := new(httpGatewaySuite)
+	suite.Run(t, s)
+}
+
+func (s *httpGatewaySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockHandler = NewMockWorkflowHandler(s.controller)
+	s.gateway = NewHTTPGateway(s.mockHandler, config.HTTPGateway{
+		APIKeys: []string{"other-api-key", testHTTPGatewayAPIKey},
+	}, loggerimpl.NewDevelopmentForTest(s.Suite))
+}
+
+func (s *httpGatewaySuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *httpGatewaySuite) TestStartWithoutAPIKeys() {
+	gateway := NewHTTPGateway(s.mockHandler, config.HTTPGateway{}, loggerimpl.NewDevelopmentForTest(s.Suite))
+	s.Error(gateway.Start())
+}
+
+func (s *httpGatewaySuite) TestUnauthorized() {
+	recorder := s.serve(http.MethodPost, "StartWorkflowExecution", nil, "")
+	s.Equal(http.StatusUnauthorized, recorder.Code)
+
+	recorder = s.serve(http.MethodPost, "StartWorkflowExecution", nil, "invalid-api-key")
+	s.Equal(http.StatusUnauthorized, recorder.Code)
+}
+
+func (s *httpGatewaySuite) TestMethodNotAllowed() {
+	recorder := s.serve(http.MethodGet, "StartWorkflowExecution", nil, testHTTPGatewayAPIKey)
+	s.Equal(http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func (s *httpGatewaySuite) TestUnknownOperation() {
+	recorder := s.serve(http.MethodPost, "TerminateWorkflowExecution", nil, testHTTPGatewayAPIKey)
+	s.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (s *httpGatewaySuite) TestInvalidRequestBody() {
+	req := httptest.NewRequest(http.MethodPost, HTTPGatewayPathPrefix+"StartWorkflowExecution", bytes.NewBufferString("{"))
+	req.Header.Set(HTTPGatewayAPIKeyHeaderName, testHTTPGatewayAPIKey)
+	recorder := httptest.NewRecorder()
+	s.gateway.ServeHTTP(recorder, req)
+	s.Equal(http.StatusBadRequest, recorder.Code)
+	s.Equal("BadRequestError", s.decodeError(recorder).Type)
+}
+
+func (s *httpGatewaySuite) TestStartWorkflowExecution() {
+	request := &gen.StartWorkflowExecutionRequest{
+		Domain:       common.StringPtr("test-domain"),
+		WorkflowId:   common.StringPtr("test-workflow-id"),
+		WorkflowType: &gen.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+		TaskList:     &gen.TaskList{Name: common.StringPtr("test-task-list")},
+		Input:        []byte(`{"key": "value"}`),
+	}
+	s.mockHandler.EXPECT().StartWorkflowExecution(gomock.Any(), request).Return(&gen.StartWorkflowExecutionResponse{
+		RunId: common.StringPtr("test-run-id"),
+	}, nil).Times(1)
+
+	recorder := s.serve(http.MethodPost, "StartWorkflowExecution", request, testHTTPGatewayAPIKey)
+	s.Equal(http.StatusOK, recorder.Code)
+	response := &gen.StartWorkflowExecutionResponse{}
+	s.NoError(json.Unmarshal(recorder.Body.Bytes(), response))
+	s.Equal("test-run-id", response.GetRunId())
+}
+
+func (s *httpGatewaySuite) TestSignalWorkflowExecution_BearerToken() {
+	request := &gen.SignalWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		SignalName: common.StringPtr("test-signal"),
+	}
+	s.mockHandler.EXPECT().SignalWorkflowExecution(gomock.Any(), request).Return(nil).Times(1)
+
+	body, err := json.Marshal(request)
+	s.NoError(err)
+	req := httptest.NewRequest(http.MethodPost, HTTPGatewayPathPrefix+"SignalWorkflowExecution", bytes.NewBuffer(body))
+	req.Header.Set("Authorization", "Bearer "+testHTTPGatewayAPIKey)
+	recorder := httptest.NewRecorder()
+	s.gateway.ServeHTTP(recorder, req)
+	s.Equal(http.StatusOK, recorder.Code)
+}
+
+func (s *httpGatewaySuite) TestDescribeWorkflowExecution_Error() {
+	s.mockHandler.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil, &gen.EntityNotExistsError{Message: "workflow not found"},
+	).Times(1)
+
+	recorder := s.serve(http.MethodPost, "DescribeWorkflowExecution", &gen.DescribeWorkflowExecutionRequest{}, testHTTPGatewayAPIKey)
+	s.Equal(http.StatusNotFound, recorder.Code)
+	gatewayErr := s.decodeError(recorder)
+	s.Equal("EntityNotExistsError", gatewayErr.Type)
+	s.Equal("workflow not found", gatewayErr.Message)
+}
+
+func (s *httpGatewaySuite) serve(
+	method string,
+	operation string,
+	request interface{},
+	apiKey string,
+) *httptest.ResponseRecorder {
+
+	var body []byte
+	if request != nil {
+		var err error
+		body, err = json.Marshal(request)
+		s.NoError(err)
+	}
+	req := httptest.NewRequest(method, HTTPGatewayPathPrefix+operation, bytes.NewBuffer(body))
+	if apiKey != "" {
+		req.Header.Set(HTTPGatewayAPIKeyHeaderName, apiKey)
+	}
+	recorder := httptest.NewRecorder()
+	s.gateway.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func (s *httpGatewaySuite) decodeError(recorder *httptest.ResponseRecorder) *httpGatewayError {
+	gatewayErr := &httpGatewayError{}
+	s.NoError(json.Unmarshal(recorder.Body.Bytes(), gatewayErr))
+	return gatewayErr
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// pollLimiter caps the number of long polls outstanding on a frontend host,
+	// both in total and per domain, a limit of 0 or less means no limit
+	pollLimiter struct {
+		maxPerHost   dynamicconfig.IntPropertyFn
+		maxPerDomain dynamicconfig.IntPropertyFnWithDomainFilter
+
+		sync.Mutex
+		outstanding       int
+		domainOutstanding map[string]int
+	}
+)
+
+func newPollLimiter(
+	maxPerHost dynamicconfig.IntPropertyFn,
+	maxPerDomain dynamicconfig.IntPropertyFnWithDomainFilter,
+) *pollLimiter {
+	return &pollLimiter{
+		maxPerHost:        maxPerHost,
+		maxPerDomain:      maxPerDomain,
+		domainOutstanding: make(map[string]int),
+	}
+}
+
+// acquire reserves a poll slot for the domain, returning false if either the host or the
+// domain limit is reached, every successful acquire must be followed by a release
+func (l *pollLimiter) acquire(domain string) bool {
+	maxPerHost := l.maxPerHost()
+	maxPerDomain := l.maxPerDomain(domain)
+
+	l.Lock()
+	defer l.Unlock()
+
+	if maxPerHost > 0 && l.outstanding >= maxPerHost {
+		return false
+	}
+	if maxPerDomain > 0 && l.domainOutstanding[domain] >= maxPerDomain {
+		return false
+	}
+	l.outstanding++
+	l.domainOutstanding[domain]++
+	return true
+}
+
+// release frees a poll slot previously acquired for the domain
+func (l *pollLimiter) release(domain string) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.outstanding--
+	l.domainOutstanding[domain]--
+	if l.domainOutstanding[domain] <= 0 {
+		delete(l.domainOutstanding, domain)
+	}
+}
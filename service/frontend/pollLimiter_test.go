@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	pollLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestPollLimiterSuite(t *testing.T) {
+	s := new(pollLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *pollLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *pollLimiterSuite) TestNoLimit() {
+	limiter := newPollLimiter(dynamicconfig.GetIntPropertyFn(0), dynamicconfig.GetIntPropertyFilteredByDomain(0))
+	for i := 0; i < 100; i++ {
+		s.True(limiter.acquire("some random domain"))
+	}
+}
+
+func (s *pollLimiterSuite) TestDomainLimit() {
+	limiter := newPollLimiter(dynamicconfig.GetIntPropertyFn(0), dynamicconfig.GetIntPropertyFilteredByDomain(2))
+	s.True(limiter.acquire("domain-a"))
+	s.True(limiter.acquire("domain-a"))
+	s.False(limiter.acquire("domain-a"))
+	s.True(limiter.acquire("domain-b"))
+
+	limiter.release("domain-a")
+	s.True(limiter.acquire("domain-a"))
+}
+
+func (s *pollLimiterSuite) TestHostLimit() {
+	limiter := newPollLimiter(dynamicconfig.GetIntPropertyFn(2), dynamicconfig.GetIntPropertyFilteredByDomain(0))
+	s.True(limiter.acquire("domain-a"))
+	s.True(limiter.acquire("domain-b"))
+	s.False(limiter.acquire("domain-c"))
+
+	limiter.release("domain-b")
+	s.True(limiter.acquire("domain-c"))
+	s.Equal(map[string]int{"domain-a": 1, "domain-c": 1}, limiter.domainOutstanding)
+}
@@ -21,7 +21,10 @@
 package frontend
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/admission"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
@@ -29,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/overload"
 	"github.com/uber/cadence/common/persistence"
 	espersistence "github.com/uber/cadence/common/persistence/elasticsearch"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
@@ -39,21 +43,31 @@ import (
 
 // Config represents configuration for cadence-frontend service
 type Config struct {
-	NumHistoryShards                int
-	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
-	VisibilityMaxPageSize           dynamicconfig.IntPropertyFnWithDomainFilter
-	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
-	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
-	VisibilityListMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
-	EnableReadVisibilityFromES      dynamicconfig.BoolPropertyFnWithDomainFilter
-	ESVisibilityListMaxQPS          dynamicconfig.IntPropertyFnWithDomainFilter
-	ESIndexMaxResultWindow          dynamicconfig.IntPropertyFn
-	HistoryMaxPageSize              dynamicconfig.IntPropertyFnWithDomainFilter
-	RPS                             dynamicconfig.IntPropertyFn
-	DomainRPS                       dynamicconfig.IntPropertyFnWithDomainFilter
-	MaxIDLengthLimit                dynamicconfig.IntPropertyFn
-	EnableClientVersionCheck        dynamicconfig.BoolPropertyFn
-	MinRetentionDays                dynamicconfig.IntPropertyFn
+	NumHistoryShards                  int
+	PersistenceMaxQPS                 dynamicconfig.IntPropertyFn
+	VisibilityMaxPageSize             dynamicconfig.IntPropertyFnWithDomainFilter
+	VisibilityTieringAgeDays          dynamicconfig.IntPropertyFnWithDomainFilter
+	EnableVisibilitySampling          dynamicconfig.BoolPropertyFn
+	EnableReadFromClosedExecutionV2   dynamicconfig.BoolPropertyFn
+	PersistenceSlowQueryThreshold     dynamicconfig.DurationPropertyFn
+	PersistenceLargeRowSizeThreshold  dynamicconfig.IntPropertyFn
+	PersistenceSlowQueryLogSampleRate dynamicconfig.FloatPropertyFn
+	VisibilityListMaxQPS              dynamicconfig.IntPropertyFnWithDomainFilter
+	EnableReadVisibilityFromES        dynamicconfig.BoolPropertyFnWithDomainFilter
+	ESVisibilityListMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
+	ESIndexMaxResultWindow            dynamicconfig.IntPropertyFn
+	ESQueryMaxPageSize                dynamicconfig.IntPropertyFnWithDomainFilter
+	ESQueryMaxBooleanClauses          dynamicconfig.IntPropertyFnWithDomainFilter
+	ESQueryMaxTimeRange               dynamicconfig.DurationPropertyFnWithDomainFilter
+	HistoryMaxPageSize                dynamicconfig.IntPropertyFnWithDomainFilter
+	ExecutionChainMaxRuns             dynamicconfig.IntPropertyFnWithDomainFilter
+	BatchDescribeMaxExecutions        dynamicconfig.IntPropertyFnWithDomainFilter
+	BatchDescribeConcurrency          dynamicconfig.IntPropertyFn
+	RPS                               dynamicconfig.IntPropertyFn
+	DomainRPS                         dynamicconfig.IntPropertyFnWithDomainFilter
+	MaxIDLengthLimit                  dynamicconfig.IntPropertyFn
+	EnableClientVersionCheck          dynamicconfig.BoolPropertyFn
+	MinRetentionDays                  dynamicconfig.IntPropertyFn
 
 	// Persistence settings
 	HistoryMgrNumConns dynamicconfig.IntPropertyFn
@@ -73,12 +87,49 @@ type Config struct {
 
 	// Domain specific config
 	EnableDomainNotActiveAutoForwarding dynamicconfig.BoolPropertyFnWithDomainFilter
+	// DCRedirectionForwardNonWorkerAPIs extends the auto forwarding to all the non worker APIs
+	DCRedirectionForwardNonWorkerAPIs dynamicconfig.BoolPropertyFnWithDomainFilter
+	// RejectPollsOnPassiveDomain fails polls for a domain which is not active in the current cluster
+	RejectPollsOnPassiveDomain dynamicconfig.BoolPropertyFnWithDomainFilter
+	// EnforceKnownTaskLists rejects starts targeting a task list not declared by the domain
+	EnforceKnownTaskLists dynamicconfig.BoolPropertyFnWithDomainFilter
+	// DCRedirectionMaxHops is the max number of times a request can be forwarded between clusters
+	DCRedirectionMaxHops dynamicconfig.IntPropertyFn
+	// DCRedirectionMaxRetryAttempts is the max number of retries of a forwarded call on retryable remote errors
+	DCRedirectionMaxRetryAttempts dynamicconfig.IntPropertyFn
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// FailoverReadinessMaxReplicationLag is the max replication lag of a shard for a domain to be ready for failover
+	FailoverReadinessMaxReplicationLag dynamicconfig.IntPropertyFn
+
+	// MaxConcurrentPollsPerHost is the max number of outstanding task polls on this host
+	MaxConcurrentPollsPerHost dynamicconfig.IntPropertyFn
+	// MaxConcurrentPollsPerDomain is the max number of outstanding task polls of a domain on this host
+	MaxConcurrentPollsPerDomain dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// AllowedAPIs is the list of APIs a domain may call, all APIs are allowed if empty
+	AllowedAPIs dynamicconfig.StringPropertyFnWithDomainFilter
+	// DeniedAPIs is the list of APIs a domain may not call
+	DeniedAPIs dynamicconfig.StringPropertyFnWithDomainFilter
+
+	// Load shedding settings
+	EnableLoadShedding           dynamicconfig.BoolPropertyFn
+	OverloadLatencyThreshold     dynamicconfig.DurationPropertyFn
+	OverloadErrorRatioThreshold  dynamicconfig.FloatPropertyFn
+	OverloadEvaluationInterval   dynamicconfig.DurationPropertyFn
+	OverloadGlobalLowPriorityRPS dynamicconfig.IntPropertyFn
+
+	// TaskTokenSigningKey is the key task tokens are signed with, tokens are not signed if empty
+	TaskTokenSigningKey []byte
+	// EnforceTaskTokenSignature is whether unsigned task tokens are rejected
+	EnforceTaskTokenSignature dynamicconfig.BoolPropertyFn
+	// AdmissionPolicy decides whether workflow starts are admitted, all starts are admitted if nil
+	AdmissionPolicy admission.Policy
 }
 
 // NewConfig returns new service config with default values
@@ -87,13 +138,23 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		NumHistoryShards:                    numHistoryShards,
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.FrontendPersistenceMaxQPS, 2000),
 		VisibilityMaxPageSize:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
+		VisibilityTieringAgeDays:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.VisibilityTieringAgeDays, 0),
 		EnableVisibilitySampling:            dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
+		PersistenceSlowQueryThreshold:       dc.GetDurationProperty(dynamicconfig.PersistenceSlowQueryThreshold, time.Second),
+		PersistenceLargeRowSizeThreshold:    dc.GetIntProperty(dynamicconfig.PersistenceLargeRowSizeThreshold, 2*1024*1024),
+		PersistenceSlowQueryLogSampleRate:   dc.GetFloat64Property(dynamicconfig.PersistenceSlowQueryLogSampleRate, 0.1),
 		VisibilityListMaxQPS:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityListMaxQPS, 1),
 		EnableReadVisibilityFromES:          dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
 		ESVisibilityListMaxQPS:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendESVisibilityListMaxQPS, 3),
 		ESIndexMaxResultWindow:              dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
+		ESQueryMaxPageSize:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendESQueryMaxPageSize, 10000),
+		ESQueryMaxBooleanClauses:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendESQueryMaxBooleanClauses, 100),
+		ESQueryMaxTimeRange:                 dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendESQueryMaxTimeRange, 0),
 		HistoryMaxPageSize:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
+		ExecutionChainMaxRuns:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendExecutionChainMaxRuns, 100),
+		BatchDescribeMaxExecutions:          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendBatchDescribeMaxExecutions, 100),
+		BatchDescribeConcurrency:            dc.GetIntProperty(dynamicconfig.FrontendBatchDescribeConcurrency, 10),
 		RPS:                                 dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
 		DomainRPS:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainRPS, 1200),
 		MaxIDLengthLimit:                    dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
@@ -106,12 +167,28 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		BlobSizeLimitWarn:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 256*1024),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		EnableDomainNotActiveAutoForwarding: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableDomainNotActiveAutoForwarding, false),
+		DCRedirectionForwardNonWorkerAPIs:   dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DCRedirectionForwardNonWorkerAPIs, false),
+		RejectPollsOnPassiveDomain:          dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.RejectPollsOnPassiveDomain, false),
+		EnforceKnownTaskLists:               dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnforceKnownTaskLists, false),
 		EnableClientVersionCheck:            dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, false),
 		ValidSearchAttributes:               dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 		SearchAttributesNumberOfKeysLimit:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		MinRetentionDays:                    dc.GetIntProperty(dynamicconfig.MinRetentionDays, 1),
+		FailoverReadinessMaxReplicationLag:  dc.GetIntProperty(dynamicconfig.FrontendFailoverReadinessMaxReplicationLag, 100),
+		MaxConcurrentPollsPerHost:           dc.GetIntProperty(dynamicconfig.FrontendMaxConcurrentPollsPerHost, 0),
+		MaxConcurrentPollsPerDomain:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxConcurrentPollsPerDomain, 0),
+		EnableLoadShedding:                  dc.GetBoolProperty(dynamicconfig.EnableLoadShedding, false),
+		OverloadLatencyThreshold:            dc.GetDurationProperty(dynamicconfig.OverloadLatencyThreshold, 200*time.Millisecond),
+		OverloadErrorRatioThreshold:         dc.GetFloat64Property(dynamicconfig.OverloadErrorRatioThreshold, 0.1),
+		OverloadEvaluationInterval:          dc.GetDurationProperty(dynamicconfig.OverloadEvaluationInterval, 10*time.Second),
+		OverloadGlobalLowPriorityRPS:        dc.GetIntProperty(dynamicconfig.OverloadGlobalLowPriorityRPS, 0),
+		DCRedirectionMaxHops:                dc.GetIntProperty(dynamicconfig.FrontendDCRedirectionMaxHops, 1),
+		DCRedirectionMaxRetryAttempts:       dc.GetIntProperty(dynamicconfig.FrontendDCRedirectionMaxRetryAttempts, 3),
+		EnforceTaskTokenSignature:           dc.GetBoolProperty(dynamicconfig.FrontendEnforceTaskTokenSignature, false),
+		AllowedAPIs:                         dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.FrontendAllowedAPIs, ""),
+		DeniedAPIs:                          dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.FrontendDeniedAPIs, ""),
 	}
 }
 
@@ -126,6 +203,12 @@ type Service struct {
 func NewService(params *service.BootstrapParams) common.Daemon {
 	isAdvancedVisExistInConfig := len(params.PersistenceConfig.AdvancedVisibilityStore) != 0
 	config := NewConfig(dynamicconfig.NewCollection(params.DynamicConfig, params.Logger), params.PersistenceConfig.NumHistoryShards, isAdvancedVisExistInConfig)
+	config.TaskTokenSigningKey = []byte(params.TaskTokenConfig.SigningKey)
+	admissionPolicy, err := admission.NewPolicy(params.AdmissionControl, params.Logger)
+	if err != nil {
+		params.Logger.Fatal("failed to create admission policy", tag.Error(err))
+	}
+	config.AdmissionPolicy = admissionPolicy
 	params.ThrottledLogger = loggerimpl.NewThrottledLogger(params.Logger, config.ThrottledLogRPS)
 	params.UpdateLoggerWithServiceName(common.FrontendServiceName)
 	return &Service{
@@ -153,7 +236,24 @@ func (s *Service) Start() {
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pConfig.SlowQueryConfig = &config.SlowQueryConfig{
+		LatencyThreshold: s.config.PersistenceSlowQueryThreshold,
+		SizeThreshold:    s.config.PersistenceLargeRowSizeThreshold,
+		LogSampleRate:    s.config.PersistenceSlowQueryLogSampleRate,
+	}
+	overloadController := overload.NewController(&overload.Config{
+		EnableLoadShedding:   s.config.EnableLoadShedding,
+		LatencyThreshold:     s.config.OverloadLatencyThreshold,
+		ErrorRatioThreshold:  s.config.OverloadErrorRatioThreshold,
+		EvaluationInterval:   s.config.OverloadEvaluationInterval,
+		GlobalLowPriorityRPS: s.config.OverloadGlobalLowPriorityRPS,
+	}, overload.NewServiceMemberCountFn(base, common.FrontendServiceName), base.GetMetricsClient(), log)
+	pFactory := persistencefactory.New(
+		&pConfig,
+		params.ClusterMetadata.GetCurrentClusterName(),
+		overload.NewPersistenceMetricsClient(base.GetMetricsClient(), overloadController),
+		log,
+	)
 
 	metadata, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
 	if err != nil {
@@ -167,14 +267,13 @@ func (s *Service) Start() {
 
 	var visibilityFromES persistence.VisibilityManager
 	if params.ESConfig != nil {
-		visibilityIndexName := params.ESConfig.Indices[common.VisibilityAppName]
 		visibilityConfigForES := &config.VisibilityConfig{
 			MaxQPS:                 s.config.PersistenceMaxQPS,
 			VisibilityListMaxQPS:   s.config.ESVisibilityListMaxQPS,
 			ESIndexMaxResultWindow: s.config.ESIndexMaxResultWindow,
 			ValidSearchAttributes:  s.config.ValidSearchAttributes,
 		}
-		visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
+		visibilityFromES = espersistence.NewESVisibilityManager(params.ESConfig, params.ESClient, visibilityConfigForES,
 			nil, base.GetMetricsClient(), log)
 	}
 	visibility := persistence.NewVisibilityManagerWrapper(
@@ -216,6 +315,7 @@ func (s *Service) Start() {
 
 	var replicationMessageSink messaging.Producer
 	var domainReplicationQueue persistence.DomainReplicationQueue
+	var domainReplicationQueuePurger *domainReplicationQueuePurger
 	clusterMetadata := base.GetClusterMetadata()
 	if clusterMetadata.IsGlobalDomainEnabled() {
 		consumerConfig := clusterMetadata.GetReplicationConsumerConfig()
@@ -225,6 +325,12 @@ func (s *Service) Start() {
 				log.Fatal("Failed to create domain replication queue", tag.Error(err))
 			}
 			replicationMessageSink = domainReplicationQueue
+			domainReplicationQueuePurger = newDomainReplicationQueuePurger(
+				domainReplicationQueue,
+				clusterMetadata,
+				func() bool { return isDomainReplicationQueuePurgerOwner(base) },
+				base.GetLogger(),
+			)
 		} else {
 			replicationMessageSink, err = base.GetMessagingClient().NewProducerWithClusterName(
 				base.GetClusterMetadata().GetCurrentClusterName())
@@ -245,15 +351,20 @@ func (s *Service) Start() {
 		visibility,
 		replicationMessageSink,
 		domainReplicationQueue,
-		domainCache)
+		domainCache,
+		overloadController)
 	dcRedirectionHandler := NewDCRedirectionHandler(wfHandler, params.DCRedirectionPolicy)
 	dcRedirectionHandler.RegisterHandler()
 
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, s.params)
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, replicationMessageSink, s.params, s.config)
 	adminHandler.RegisterHandler()
 
 	// must start base service first
 	base.Start()
+	overloadController.Start()
+	if domainReplicationQueuePurger != nil {
+		domainReplicationQueuePurger.Start()
+	}
 	err = dcRedirectionHandler.Start()
 	if err != nil {
 		log.Fatal("DC redirection handler failed to start", tag.Error(err))
@@ -263,12 +374,27 @@ func (s *Service) Start() {
 		log.Fatal("Admin handler failed to start", tag.Error(err))
 	}
 
+	var httpGateway *HTTPGateway
+	if params.HTTPGateway.Port != 0 {
+		httpGateway = NewHTTPGateway(dcRedirectionHandler, params.HTTPGateway, log)
+		if err := httpGateway.Start(); err != nil {
+			log.Fatal("HTTP gateway failed to start", tag.Error(err))
+		}
+	}
+
 	// base (service is not started in frontend or admin handler) in case of race condition in yarpc registration function
 
 	log.Info("started", tag.Service(common.FrontendServiceName))
 
 	<-s.stopC
 
+	if httpGateway != nil {
+		httpGateway.Stop()
+	}
+	if domainReplicationQueuePurger != nil {
+		domainReplicationQueuePurger.Stop()
+	}
+	overloadController.Stop()
 	base.Stop()
 }
 
@@ -0,0 +1,188 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// tieredListTokenVersion is the version of tieredListToken, tokens of other versions are rejected
+	tieredListTokenVersion = 1
+)
+
+type (
+	// tieredListToken is the next page token of closed workflow listings for domains
+	// whose old visibility records are tiered out of the visibility DB, records are
+	// first listed from the DB and then from the visibility archival store
+	tieredListToken struct {
+		Version  int    `json:"version"`
+		ColdTier bool   `json:"coldTier"`
+		Token    []byte `json:"token"`
+	}
+
+	listClosedFromDBFn func(persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error)
+)
+
+var errInvalidTieredListToken = &gen.BadRequestError{Message: "Invalid NextPageToken."}
+
+// getVisibilityTieringCutoff returns the domain entry and the close time before which closed
+// visibility records of the domain are tiered out of the DB, or false if tiering is disabled.
+// Only closed records of the DB visibility store are tiered, so open workflow listings, and
+// listings and counts served by advanced visibility, never need to read the archival store.
+func (wh *WorkflowHandler) getVisibilityTieringCutoff(domain string) (*cache.DomainCacheEntry, int64, bool) {
+	ageDays := wh.config.VisibilityTieringAgeDays(domain)
+	if ageDays <= 0 || wh.config.EnableReadVisibilityFromES(domain) {
+		return nil, 0, false
+	}
+
+	visibilityConfig := wh.GetArchivalMetadata().GetVisibilityConfig()
+	if !visibilityConfig.ClusterConfiguredForArchival() || !visibilityConfig.ReadEnabled() {
+		return nil, 0, false
+	}
+
+	entry, err := wh.domainCache.GetDomain(domain)
+	if err != nil || entry.GetConfig().VisibilityArchivalStatus != gen.ArchivalStatusEnabled {
+		return nil, 0, false
+	}
+
+	cutoff := time.Now().Add(-time.Duration(ageDays) * 24 * time.Hour).UnixNano()
+	return entry, cutoff, true
+}
+
+// listClosedWorkflowExecutionsTiered lists closed workflow executions from the visibility DB,
+// and once the DB is exhausted, from the visibility archival store for the part of the
+// requested time range older than the tiering cutoff.
+// All records still in the DB are listed, including the ones older than the cutoff which are
+// not tiered yet. The tierer deletes a record from the DB right after archiving it, so a record
+// is only listed twice if its deletion failed and is not yet retried by the next tiering run.
+func (wh *WorkflowHandler) listClosedWorkflowExecutionsTiered(
+	ctx context.Context,
+	listRequest *gen.ListClosedWorkflowExecutionsRequest,
+	baseReq persistence.ListWorkflowExecutionsRequest,
+	entry *cache.DomainCacheEntry,
+	cutoff int64,
+	listFromDB listClosedFromDBFn,
+) (*gen.ListClosedWorkflowExecutionsResponse, error) {
+
+	token := &tieredListToken{Version: tieredListTokenVersion}
+	if len(listRequest.NextPageToken) != 0 {
+		if err := json.Unmarshal(listRequest.NextPageToken, token); err != nil || token.Version != tieredListTokenVersion {
+			return nil, errInvalidTieredListToken
+		}
+	}
+
+	includesColdTier := listRequest.StartTimeFilter.GetEarliestTime() < cutoff
+	if !token.ColdTier {
+		baseReq.NextPageToken = token.Token
+		persistenceResp, err := listFromDB(baseReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &gen.ListClosedWorkflowExecutionsResponse{
+			Executions: persistenceResp.Executions,
+		}
+		if len(persistenceResp.NextPageToken) != 0 {
+			resp.NextPageToken, err = json.Marshal(&tieredListToken{Version: tieredListTokenVersion, Token: persistenceResp.NextPageToken})
+		} else if includesColdTier {
+			resp.NextPageToken, err = json.Marshal(&tieredListToken{Version: tieredListTokenVersion, ColdTier: true})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	if !includesColdTier {
+		return nil, errInvalidTieredListToken
+	}
+
+	URI, err := archiver.NewURI(entry.GetConfig().VisibilityArchivalURI)
+	if err != nil {
+		return nil, err
+	}
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	archiverResponse, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
+		DomainID:      entry.GetInfo().ID,
+		PageSize:      int(listRequest.GetMaximumPageSize()),
+		NextPageToken: token.Token,
+		Query:         buildColdTierQuery(listRequest, cutoff),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// special handling of ExecutionTime for cron or retry
+	for _, execution := range archiverResponse.Executions {
+		if execution.GetExecutionTime() == 0 {
+			execution.ExecutionTime = common.Int64Ptr(execution.GetStartTime())
+		}
+	}
+
+	resp := &gen.ListClosedWorkflowExecutionsResponse{
+		Executions: archiverResponse.Executions,
+	}
+	if len(archiverResponse.NextPageToken) != 0 {
+		resp.NextPageToken, err = json.Marshal(&tieredListToken{Version: tieredListTokenVersion, ColdTier: true, Token: archiverResponse.NextPageToken})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// buildColdTierQuery translates a closed workflow listing into an archived visibility query.
+// Records are tiered out of the DB by close time, so the time filter is applied to close time.
+func buildColdTierQuery(listRequest *gen.ListClosedWorkflowExecutionsRequest, cutoff int64) string {
+	latestTime := common.MinInt64(listRequest.StartTimeFilter.GetLatestTime(), cutoff-1)
+	query := fmt.Sprintf("CloseTime >= %v and CloseTime <= %v", listRequest.StartTimeFilter.GetEarliestTime(), latestTime)
+
+	if listRequest.ExecutionFilter != nil {
+		query += fmt.Sprintf(" and WorkflowID = '%v'", escapeQueryValue(listRequest.ExecutionFilter.GetWorkflowId()))
+		if listRequest.ExecutionFilter.GetRunId() != "" {
+			query += fmt.Sprintf(" and RunID = '%v'", escapeQueryValue(listRequest.ExecutionFilter.GetRunId()))
+		}
+	} else if listRequest.TypeFilter != nil {
+		query += fmt.Sprintf(" and WorkflowType = '%v'", escapeQueryValue(listRequest.TypeFilter.GetName()))
+	} else if listRequest.StatusFilter != nil {
+		status := strings.Replace(strings.ToLower(listRequest.GetStatusFilter().String()), "_", "", -1)
+		query += fmt.Sprintf(" and CloseStatus = '%v'", status)
+	}
+	return query
+}
+
+func escapeQueryValue(value string) string {
+	return strings.Replace(value, "'", "\\'", -1)
+}
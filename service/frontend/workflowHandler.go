@@ -27,10 +27,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
@@ -41,29 +43,50 @@ import (
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	gen "github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/api/v1"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/admission"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/elasticsearch/validator"
+	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/overload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 const (
 	getDomainReplicationMessageBatchSize = 100
 	defaultLastMessageID                 = -1
+
+	batchOperationDecisionTimeoutSeconds = 10
+	// memo and search attributes of batch jobs, the same as the ones set by the CLI
+	batchOperationMemoReason         = "Reason"
+	batchOperationMemoQuery          = "Query"
+	batchOperationMemoType           = "BatchType"
+	batchOperationSearchAttrDomain   = "CustomDomain"
+	batchOperationSearchAttrOperator = "Operator"
 )
 
+var batchOperationTypes = map[gen.BatchOperationType]string{
+	gen.BatchOperationTypeTerminate: batch.BatchTypeTerminate,
+	gen.BatchOperationTypeCancel:    batch.BatchTypeCancel,
+	gen.BatchOperationTypeSignal:    batch.BatchTypeSignal,
+}
+
 var _ workflowserviceserver.Interface = (*WorkflowHandler)(nil)
 
 type (
@@ -80,9 +103,13 @@ type (
 		tokenSerializer           common.TaskTokenSerializer
 		metricsClient             metrics.Client
 		startWG                   sync.WaitGroup
-		rateLimiter               quotas.Policy
+		rateLimiter               *quotas.MultiStageRateLimiter
+		rpsWatcher                *dynamicconfig.Watcher
+		pollLimiter               *pollLimiter
+		overloadController        overload.Controller
 		config                    *Config
 		versionChecker            *versionChecker
+		apiGate                   *apiGate
 		domainHandler             domain.Handler
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
@@ -100,6 +127,17 @@ type (
 		EventStoreVersion int32
 		BranchToken       []byte
 		ReplicationInfo   map[string]*gen.ReplicationInfo
+		// IsNewRun is set when paging moved to the run continued from the previously returned one,
+		// whose history is not queried yet
+		IsNewRun bool
+		// IsFollowingContinuedAsNew is set once paging followed a continue as new into another run
+		IsFollowingContinuedAsNew bool
+	}
+
+	executionChainPageToken struct {
+		FirstRunID string
+		// LatestStartTime is the inclusive upper bound on the start time of the runs of the next page
+		LatestStartTime int64
 	}
 
 	domainGetter interface {
@@ -115,6 +153,7 @@ var (
 	errTaskListNotSet                             = &gen.BadRequestError{Message: "TaskList is not set on request."}
 	errTaskListTypeNotSet                         = &gen.BadRequestError{Message: "TaskListType is not set on request."}
 	errExecutionNotSet                            = &gen.BadRequestError{Message: "Execution is not set on request."}
+	errExecutionsNotSet                           = &gen.BadRequestError{Message: "Executions is not set on request."}
 	errWorkflowIDNotSet                           = &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotSet                                = &gen.BadRequestError{Message: "RunId is not set on request."}
 	errActivityIDNotSet                           = &gen.BadRequestError{Message: "ActivityID is not set on request."}
@@ -129,7 +168,17 @@ var (
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidPriority                            = &gen.BadRequestError{Message: "Priority cannot be negative."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
+	errAnnotationMessageNotSet                    = &gen.BadRequestError{Message: "Annotation message is not set on request."}
+	errReasonNotSet                               = &gen.BadRequestError{Message: "Reason is not set on request."}
+	errInvalidSlice                               = &gen.BadRequestError{Message: "A valid SliceID in [0, SliceCount) is not set on request."}
+	errDomainDeprecated                           = &gen.BadRequestError{Message: "Domain is deprecated, new workflow executions cannot be started."}
+	errBatchOperationTypeNotSet                   = &gen.BadRequestError{Message: "OperationType is not set on request."}
+	errSignalNameNotSet                           = &gen.BadRequestError{Message: "SignalName is not set on request."}
+	errJobIDNotSet                                = &gen.BadRequestError{Message: "JobID is not set on request."}
+	errBatchOperationNotFound                     = &gen.EntityNotExistsError{Message: "Batch operation not found."}
+	errBatchOperationAdvancedVisibility           = &gen.BadRequestError{Message: "Batch operation requires advanced visibility, which is not enabled for the domain."}
 
 	// err for archival
 	errHistoryHasPassedRetentionPeriod = &gen.BadRequestError{Message: "Requested workflow history has passed retention period."}
@@ -145,6 +194,13 @@ var (
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
 
+	errAnnotationMessageTooLarge = &gen.BadRequestError{Message: "Annotation message size exceeds limit."}
+
+	errInvalidExpectedNextEventID = &gen.BadRequestError{Message: "ExpectedNextEventId is invalid."}
+
+	errTooManyOutstandingPolls = &gen.ServiceBusyError{Message: "Too many outstanding polls to the cadence frontend host."}
+	errPersistenceOverloaded   = &gen.ServiceBusyError{Message: "Persistence is overloaded, low priority request is rejected."}
+
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
 
@@ -159,6 +215,7 @@ func NewWorkflowHandler(
 	replicationMessageSink messaging.Producer,
 	domainReplicationQueue persistence.DomainReplicationQueue,
 	domainCache cache.DomainCache,
+	overloadController overload.Controller,
 ) *WorkflowHandler {
 	handler := &WorkflowHandler{
 		Service:         sVice,
@@ -167,7 +224,7 @@ func NewWorkflowHandler(
 		historyMgr:      historyMgr,
 		historyV2Mgr:    historyV2Mgr,
 		visibilityMgr:   visibilityMgr,
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		tokenSerializer: common.NewSignedJSONTaskTokenSerializer(config.TaskTokenSigningKey, config.EnforceTaskTokenSignature),
 		metricsClient:   sVice.GetMetricsClient(),
 		domainCache:     domainCache,
 		rateLimiter: quotas.NewMultiStageRateLimiter(
@@ -178,7 +235,10 @@ func NewWorkflowHandler(
 				return float64(config.DomainRPS(domain))
 			},
 		),
-		versionChecker: &versionChecker{checkVersion: config.EnableClientVersionCheck()},
+		overloadController: overloadController,
+		pollLimiter:        newPollLimiter(config.MaxConcurrentPollsPerHost, config.MaxConcurrentPollsPerDomain),
+		versionChecker:     &versionChecker{checkVersion: config.EnableClientVersionCheck()},
+		apiGate:            newAPIGate(config.AllowedAPIs, config.DeniedAPIs),
 		domainHandler: domain.NewHandler(
 			config.MinRetentionDays(),
 			config.MaxBadBinaries,
@@ -189,7 +249,11 @@ func NewWorkflowHandler(
 			sVice.GetArchivalMetadata(),
 			sVice.GetArchiverProvider(),
 		),
-		visibilityQueryValidator: validator.NewQueryValidator(config.ValidSearchAttributes),
+		visibilityQueryValidator: validator.NewQueryValidator(
+			config.ValidSearchAttributes,
+			config.ESQueryMaxBooleanClauses,
+			config.ESQueryMaxTimeRange,
+		),
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			sVice.GetLogger(),
 			config.ValidSearchAttributes,
@@ -208,6 +272,7 @@ func NewWorkflowHandler(
 // if DCRedirectionHandler is also used, use RegisterHandler in DCRedirectionHandler instead
 func (wh *WorkflowHandler) RegisterHandler() {
 	wh.Service.GetDispatcher().Register(workflowserviceserver.New(wh))
+	wh.Service.GetDispatcher().Register(apiv1.BuildWorkflowServiceYARPCProcedures(newGRPCHandler(wh)))
 	wh.Service.GetDispatcher().Register(metaserver.New(wh))
 }
 
@@ -223,12 +288,17 @@ func (wh *WorkflowHandler) Start() error {
 	wh.matchingRawClient = matchingRawClient
 	wh.matching = matching.NewRetryableClient(wh.matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
+	// apply rps changes right away, increases of the rate would only be picked up after a minute otherwise
+	wh.rpsWatcher = dynamicconfig.WatchIntProperty(wh.config.RPS, func(int) { wh.rateLimiter.Refresh() })
 	wh.startWG.Done()
 	return nil
 }
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
+	if wh.rpsWatcher != nil {
+		wh.rpsWatcher.Stop()
+	}
 	wh.domainCache.Stop()
 	wh.metadataMgr.Close()
 	wh.visibilityMgr.Close()
@@ -240,7 +310,8 @@ func (wh *WorkflowHandler) Stop() {
 func (wh *WorkflowHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
 	wh.startWG.Wait()
 	wh.GetLogger().Debug("Frontend health check endpoint reached.")
-	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("frontend good")}
+	msg := fmt.Sprintf("frontend good, persistence health score %.2f", wh.overloadController.HealthScore())
+	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr(msg)}
 	return hs, nil
 }
 
@@ -360,6 +431,10 @@ func (wh *WorkflowHandler) UpdateDomain(
 		return nil, errDomainNotSet
 	}
 
+	if err := wh.checkAPIAccess(ctx, "UpdateDomain", updateRequest.GetName()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	resp, err := wh.domainHandler.UpdateDomain(ctx, updateRequest)
 	if err != nil {
 		return resp, wh.error(err, scope)
@@ -369,7 +444,7 @@ func (wh *WorkflowHandler) UpdateDomain(
 
 // DeprecateDomain us used to update status of a registered domain to DEPRECATED. Once the domain is deprecated
 // it cannot be used to start new workflow executions.  Existing workflow executions will continue to run on
-// deprecated domains.
+// deprecated domains. The deprecation can be reverted with the admin UndeprecateDomain API.
 func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest *gen.DeprecateDomainRequest) (retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
@@ -392,6 +467,10 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 		return errDomainNotSet
 	}
 
+	if err := wh.checkAPIAccess(ctx, "DeprecateDomain", deprecateRequest.GetName()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	err := wh.domainHandler.DeprecateDomain(ctx, deprecateRequest)
 	if err != nil {
 		return wh.error(err, scope)
@@ -443,10 +522,20 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
-	domainID, err := wh.domainCache.GetDomainID(pollRequest.GetDomain())
+	domainEntry, err := wh.domainCache.GetDomain(pollRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	domainID := domainEntry.GetInfo().ID
+
+	if err := wh.checkPassiveDomainPoll(domainEntry); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if !wh.pollLimiter.acquire(domainEntry.GetInfo().Name) {
+		return nil, wh.error(errTooManyOutstandingPolls, scope)
+	}
+	defer wh.pollLimiter.release(domainEntry.GetInfo().Name)
 
 	pollerID := uuid.New()
 	op := func() error {
@@ -534,6 +623,14 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	if err := wh.checkBadBinary(domainEntry, pollRequest.GetBinaryChecksum()); err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if err := wh.checkPassiveDomainPoll(domainEntry); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if !wh.pollLimiter.acquire(domainEntry.GetInfo().Name) {
+		return nil, wh.error(errTooManyOutstandingPolls, scope)
+	}
+	defer wh.pollLimiter.release(domainEntry.GetInfo().Name)
 
 	pollerID := uuid.New()
 	var matchingResp *m.PollForDecisionTaskResponse
@@ -590,6 +687,20 @@ func (wh *WorkflowHandler) checkBadBinary(domainEntry *cache.DomainCacheEntry, b
 	return nil
 }
 
+// checkPassiveDomainPoll fails the poll if the domain is not active in the current cluster and rejecting
+// such polls is enabled, so that workers learn about the active cluster instead of polling forever
+func (wh *WorkflowHandler) checkPassiveDomainPoll(domainEntry *cache.DomainCacheEntry) error {
+	domainName := domainEntry.GetInfo().Name
+	if domainEntry.IsDomainActive() || !wh.config.RejectPollsOnPassiveDomain(domainName) {
+		return nil
+	}
+	return ce.NewDomainNotActiveError(
+		domainName,
+		wh.GetClusterMetadata().GetCurrentClusterName(),
+		domainEntry.GetReplicationConfig().ActiveClusterName,
+	)
+}
+
 func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error, domainID string, taskListType int32,
 	taskList *gen.TaskList, pollerID string) error {
 	// First check if this err is due to context cancellation.  This means client connection to frontend is closed.
@@ -722,6 +833,10 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow(nil)
 
+	if err := wh.checkAPIAccess(ctx, "RecordActivityTaskHeartbeatByID", heartbeatRequest.GetDomain()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.Service.GetLogger().Debug("Received RecordActivityTaskHeartbeatByID")
 	domainID, err := wh.domainCache.GetDomainID(heartbeatRequest.GetDomain())
 	if err != nil {
@@ -791,9 +906,10 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 		resp = &gen.RecordActivityTaskHeartbeatResponse{CancelRequested: common.BoolPtr(true)}
 	} else {
 		req := &gen.RecordActivityTaskHeartbeatRequest{
-			TaskToken: token,
-			Details:   heartbeatRequest.Details,
-			Identity:  heartbeatRequest.Identity,
+			TaskToken:               token,
+			Details:                 heartbeatRequest.Details,
+			Identity:                heartbeatRequest.Identity,
+			CancellationWaitSeconds: heartbeatRequest.CancellationWaitSeconds,
 		}
 
 		resp, err = wh.history.RecordActivityTaskHeartbeat(ctx, &h.RecordActivityTaskHeartbeatRequest{
@@ -915,6 +1031,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow(nil)
 
+	if err := wh.checkAPIAccess(ctx, "RespondActivityTaskCompletedByID", completeRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(completeRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -1099,6 +1219,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow(nil)
 
+	if err := wh.checkAPIAccess(ctx, "RespondActivityTaskFailedByID", failedRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(failedRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -1283,6 +1407,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow(nil)
 
+	if err := wh.checkAPIAccess(ctx, "RespondActivityTaskCanceledByID", cancelRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(cancelRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -1614,6 +1742,15 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errDomainTooLong, scope)
 	}
 
+	if err := wh.checkAPIAccess(ctx, "StartWorkflowExecution", domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	startRequest, err := wh.admitStartWorkflowExecution(ctx, startRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if startRequest.GetWorkflowId() == "" {
 		return nil, wh.error(errWorkflowIDNotSet, scope)
 	}
@@ -1654,6 +1791,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
 	}
 
+	if startRequest.GetPriority() < 0 {
+		return nil, wh.error(errInvalidPriority, scope)
+	}
+
 	if startRequest.GetRequestId() == "" {
 		return nil, wh.error(errRequestIDNotSet, scope)
 	}
@@ -1667,14 +1808,22 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 
 	wh.Service.GetLogger().Debug("Start workflow execution request domain", tag.WorkflowDomainName(domainName))
-	domainID, err := wh.domainCache.GetDomainID(domainName)
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeprecated {
+		return nil, wh.error(errDomainDeprecated, scope)
+	}
+	domainID := domainEntry.GetInfo().ID
 
 	// add domain tag to scope, so further metrics will have the domain tag
 	scope = scope.Tagged(metrics.DomainTag(domainName))
 
+	if err := wh.validateKnownTaskList(domainName, startRequest.TaskList, scope); err != nil {
+		return nil, err
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
 	actualSize := len(startRequest.Input)
@@ -1757,6 +1906,13 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	if enableArchivalRead && historyArchived {
 		return wh.getArchivedHistory(ctx, getRequest, domainID, scope)
 	}
+	// the workflow execution can be deleted after the above check, in which case
+	// the first page of history is read from archival instead of returning not exists error
+	isFirstPage := getRequest.NextPageToken == nil
+	shouldReadArchivedHistory := func(err error) bool {
+		_, ok := err.(*gen.EntityNotExistsError)
+		return ok && enableArchivalRead && isFirstPage && getRequest.Execution.GetRunId() != ""
+	}
 
 	// this function return the following 5 things,
 	// 1. the workflow run ID
@@ -1795,14 +1951,24 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		if err != nil {
 			return nil, wh.error(errInvalidNextPageToken, scope)
 		}
-		if execution.RunId != nil && execution.GetRunId() != token.RunID {
+		if execution.RunId != nil && execution.GetRunId() != token.RunID && !token.IsFollowingContinuedAsNew {
 			return nil, wh.error(errNextPageTokenRunIDMismatch, scope)
 		}
 
 		execution.RunId = common.StringPtr(token.RunID)
 
-		// we need to update the current next event ID and whether workflow is running
-		if len(token.PersistenceToken) == 0 && isLongPoll && token.IsWorkflowRunning {
+		if token.IsNewRun {
+			// the previous page ended with the continue as new event of the previous run
+			token.EventStoreVersion, token.BranchToken, _, lastFirstEventID, nextEventID, isWorkflowRunning, err = queryHistory(domainID, execution, common.FirstEventID)
+			if err != nil {
+				return nil, wh.error(err, scope)
+			}
+			token.FirstEventID = common.FirstEventID
+			token.NextEventID = nextEventID
+			token.IsWorkflowRunning = isWorkflowRunning
+			token.IsNewRun = false
+		} else if len(token.PersistenceToken) == 0 && isLongPoll && token.IsWorkflowRunning {
+			// we need to update the current next event ID and whether workflow is running
 			if !isCloseEventOnly {
 				queryNextEventID = token.NextEventID
 			}
@@ -1820,6 +1986,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		}
 		token.EventStoreVersion, token.BranchToken, runID, lastFirstEventID, nextEventID, isWorkflowRunning, err = queryHistory(domainID, execution, queryNextEventID)
 		if err != nil {
+			if shouldReadArchivedHistory(err) {
+				return wh.getArchivedHistory(ctx, getRequest, domainID, scope)
+			}
 			return nil, wh.error(err, scope)
 		}
 
@@ -1839,6 +2008,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			history, _, err = wh.getHistory(
 				scope,
 				domainID,
+				getRequest.GetDomain(),
 				*execution,
 				lastFirstEventID,
 				nextEventID,
@@ -1849,6 +2019,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 				token.BranchToken,
 			)
 			if err != nil {
+				if shouldReadArchivedHistory(err) {
+					return wh.getArchivedHistory(ctx, getRequest, domainID, scope)
+				}
 				return nil, wh.error(err, scope)
 			}
 			// since getHistory func will not return empty history, so the below is safe
@@ -1872,6 +2045,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			history, token.PersistenceToken, err = wh.getHistory(
 				scope,
 				domainID,
+				getRequest.GetDomain(),
 				*execution,
 				token.FirstEventID,
 				token.NextEventID,
@@ -1882,6 +2056,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 				token.BranchToken,
 			)
 			if err != nil {
+				if shouldReadArchivedHistory(err) {
+					return wh.getArchivedHistory(ctx, getRequest, domainID, scope)
+				}
 				return nil, wh.error(err, scope)
 			}
 
@@ -1889,7 +2066,11 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			// and do something clever
 			if len(token.PersistenceToken) == 0 && (!token.IsWorkflowRunning || !isLongPoll) {
 				// meaning, there is no more history to be returned
-				token = nil
+				if getRequest.GetFollowContinuedAsNew() && !token.IsWorkflowRunning {
+					token = getContinuedAsNewHistoryToken(history)
+				} else {
+					token = nil
+				}
 			}
 		}
 	}
@@ -1928,10 +2109,18 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return wh.error(createServiceBusyError(), scope)
 	}
 
+	if isBatchRequest(signalRequest.GetIdentity()) && wh.overloadController.ShouldShed() {
+		return wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if signalRequest.GetDomain() == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkAPIAccess(ctx, "SignalWorkflowExecution", signalRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	if len(signalRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errDomainTooLong, scope)
 	}
@@ -1952,6 +2141,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return wh.error(errRequestIDTooLong, scope)
 	}
 
+	if signalRequest.IsSetExpectedNextEventId() && signalRequest.GetExpectedNextEventId() <= common.FirstEventID {
+		return wh.error(errInvalidExpectedNextEventID, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(signalRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -2018,6 +2211,15 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(errDomainTooLong, scope)
 	}
 
+	if err := wh.checkAPIAccess(ctx, "SignalWithStartWorkflowExecution", domainName); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	signalWithStartRequest, err := wh.admitSignalWithStartWorkflowExecution(ctx, signalWithStartRequest)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if signalWithStartRequest.GetWorkflowId() == "" {
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowId is not set on request."}, scope)
 	}
@@ -2072,10 +2274,18 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
-	domainID, err := wh.domainCache.GetDomainID(domainName)
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if domainEntry.GetInfo().Status == persistence.DomainStatusDeprecated {
+		return nil, wh.error(errDomainDeprecated, scope)
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	if err := wh.validateKnownTaskList(domainName, signalWithStartRequest.TaskList, scope); err != nil {
+		return nil, err
+	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
@@ -2145,14 +2355,29 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 		return wh.error(createServiceBusyError(), scope)
 	}
 
+	if isBatchRequest(terminateRequest.GetIdentity()) && wh.overloadController.ShouldShed() {
+		return wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if terminateRequest.GetDomain() == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkAPIAccess(ctx, "TerminateWorkflowExecution", terminateRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(terminateRequest.WorkflowExecution, scope); err != nil {
 		return err
 	}
 
+	if terminateRequest.GetForce() {
+		// forcing the termination of a protected workflow execution requires elevated permission
+		if err := wh.checkPermission(terminateRequest.SecurityToken); err != nil {
+			return wh.error(err, scope)
+		}
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(terminateRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -2169,6 +2394,99 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution, pending decision / activity tasks
+// are not dispatched and timers are deferred until the execution is resumed.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *gen.PauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(pauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.PauseWorkflowExecution(ctx, &h.PauseWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a workflow execution paused by PauseWorkflowExecution.
+func (wh *WorkflowHandler) ResumeWorkflowExecution(
+	ctx context.Context,
+	resumeRequest *gen.ResumeWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendResumeWorkflowExecutionScope, resumeRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if resumeRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(resumeRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if resumeRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(resumeRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(resumeRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.ResumeWorkflowExecution(ctx, &h.ResumeWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		ResumeRequest: resumeRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -2196,6 +2514,10 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkAPIAccess(ctx, "ResetWorkflowExecution", resetRequest.GetDomain()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(resetRequest.WorkflowExecution, scope); err != nil {
 		return nil, err
 	}
@@ -2238,10 +2560,18 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(createServiceBusyError(), scope)
 	}
 
+	if isBatchRequest(cancelRequest.GetIdentity()) && wh.overloadController.ShouldShed() {
+		return wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if cancelRequest.GetDomain() == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkAPIAccess(ctx, "RequestCancelWorkflowExecution", cancelRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(cancelRequest.WorkflowExecution, scope); err != nil {
 		return err
 	}
@@ -2284,6 +2614,10 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
+	if wh.overloadController.ShouldShed() {
+		return nil, wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if listRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2315,7 +2649,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
 
 	if wh.isListRequestPageSizeTooLarge(listRequest.GetMaximumPageSize(), listRequest.GetDomain()) {
 		return nil, wh.error(&gen.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.getESMaxPageSize(listRequest.GetDomain()))}, scope)
 	}
 
 	domain := listRequest.GetDomain()
@@ -2393,6 +2727,10 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
+	if wh.overloadController.ShouldShed() {
+		return nil, wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if listRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2475,6 +2813,10 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
+	if wh.overloadController.ShouldShed() {
+		return nil, wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if listRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2502,11 +2844,17 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 	if listRequest.StatusFilter != nil {
 		filterCount++
 	}
+	if listRequest.MemoFilter != nil {
+		if listRequest.MemoFilter.GetKey() == "" {
+			return nil, wh.error(&gen.BadRequestError{Message: "Key in MemoFilter is required"}, scope)
+		}
+		filterCount++
+	}
 
 	if filterCount > 1 {
 		return nil, wh.error(&gen.BadRequestError{
-			Message: "Only one of ExecutionFilter, TypeFilter or StatusFilter is allowed"}, scope)
-	} // If ExecutionFilter is provided with one of TypeFilter or StatusFilter, use ExecutionFilter and ignore other filter
+			Message: "Only one of ExecutionFilter, TypeFilter, StatusFilter or MemoFilter is allowed"}, scope)
+	} // If ExecutionFilter is provided with one of TypeFilter, StatusFilter or MemoFilter, use ExecutionFilter and ignore other filter
 
 	if listRequest.GetMaximumPageSize() <= 0 {
 		listRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain())))
@@ -2514,7 +2862,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 
 	if wh.isListRequestPageSizeTooLarge(listRequest.GetMaximumPageSize(), listRequest.GetDomain()) {
 		return nil, wh.error(&gen.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.getESMaxPageSize(listRequest.GetDomain()))}, scope)
 	}
 
 	domain := listRequest.GetDomain()
@@ -2532,45 +2880,63 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 		LatestStartTime:   listRequest.StartTimeFilter.GetLatestTime(),
 	}
 
-	var persistenceResp *persistence.ListWorkflowExecutionsResponse
-	if listRequest.ExecutionFilter != nil {
-		if wh.config.DisableListVisibilityByFilter(domain) {
-			err = errNoPermission
-		} else {
-			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByWorkflowID(
+	listFromDB := func(baseReq persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+		if listRequest.ExecutionFilter != nil {
+			wh.Service.GetLogger().Info("List closed workflow with filter",
+				tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByID)
+			if wh.config.DisableListVisibilityByFilter(domain) {
+				return nil, errNoPermission
+			}
+			return wh.visibilityMgr.ListClosedWorkflowExecutionsByWorkflowID(
 				&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 					ListWorkflowExecutionsRequest: baseReq,
 					WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowId(),
 				})
-		}
-		wh.Service.GetLogger().Info("List closed workflow with filter",
-			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByID)
-	} else if listRequest.TypeFilter != nil {
-		if wh.config.DisableListVisibilityByFilter(domain) {
-			err = errNoPermission
-		} else {
-			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+		} else if listRequest.TypeFilter != nil {
+			wh.Service.GetLogger().Info("List closed workflow with filter",
+				tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByType)
+			if wh.config.DisableListVisibilityByFilter(domain) {
+				return nil, errNoPermission
+			}
+			return wh.visibilityMgr.ListClosedWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				WorkflowTypeName:              listRequest.TypeFilter.GetName(),
 			})
-		}
-		wh.Service.GetLogger().Info("List closed workflow with filter",
-			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByType)
-	} else if listRequest.StatusFilter != nil {
-		if wh.config.DisableListVisibilityByFilter(domain) {
-			err = errNoPermission
-		} else {
-			persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutionsByStatus(&persistence.ListClosedWorkflowExecutionsByStatusRequest{
+		} else if listRequest.StatusFilter != nil {
+			wh.Service.GetLogger().Info("List closed workflow with filter",
+				tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByStatus)
+			if wh.config.DisableListVisibilityByFilter(domain) {
+				return nil, errNoPermission
+			}
+			return wh.visibilityMgr.ListClosedWorkflowExecutionsByStatus(&persistence.ListClosedWorkflowExecutionsByStatusRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				Status:                        listRequest.GetStatusFilter(),
 			})
+		} else if listRequest.MemoFilter != nil {
+			wh.Service.GetLogger().Info("List closed workflow with filter",
+				tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByMemo)
+			if wh.config.DisableListVisibilityByFilter(domain) {
+				return nil, errNoPermission
+			}
+			return wh.visibilityMgr.ListClosedWorkflowExecutionsByMemo(&persistence.ListClosedWorkflowExecutionsByMemoRequest{
+				ListWorkflowExecutionsRequest: baseReq,
+				MemoKey:                       listRequest.MemoFilter.GetKey(),
+				MemoValue:                     string(listRequest.MemoFilter.GetValue()),
+			})
 		}
-		wh.Service.GetLogger().Info("List closed workflow with filter",
-			tag.WorkflowDomainName(listRequest.GetDomain()), tag.WorkflowListWorkflowFilterByStatus)
-	} else {
-		persistenceResp, err = wh.visibilityMgr.ListClosedWorkflowExecutions(&baseReq)
+		return wh.visibilityMgr.ListClosedWorkflowExecutions(&baseReq)
 	}
 
+	// indexed memo is only kept in the visibility DB, so memo filters never reach the archival store
+	if entry, cutoff, ok := wh.getVisibilityTieringCutoff(domain); ok && listRequest.MemoFilter == nil {
+		resp, err = wh.listClosedWorkflowExecutionsTiered(ctx, listRequest, baseReq, entry, cutoff, listFromDB)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		return resp, nil
+	}
+
+	persistenceResp, err := listFromDB(baseReq)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2603,6 +2969,10 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
+	if wh.overloadController.ShouldShed() {
+		return nil, wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if listRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2613,7 +2983,7 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(
 
 	if wh.isListRequestPageSizeTooLarge(listRequest.GetPageSize(), listRequest.GetDomain()) {
 		return nil, wh.error(&gen.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.getESMaxPageSize(listRequest.GetDomain()))}, scope)
 	}
 
 	if err := wh.visibilityQueryValidator.ValidateListRequestForQuery(listRequest); err != nil {
@@ -2666,6 +3036,10 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
+	if wh.overloadController.ShouldShed() {
+		return nil, wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if listRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2676,7 +3050,13 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(
 
 	if wh.isListRequestPageSizeTooLarge(listRequest.GetPageSize(), listRequest.GetDomain()) {
 		return nil, wh.error(&gen.BadRequestError{
-			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.getESMaxPageSize(listRequest.GetDomain()))}, scope)
+	}
+
+	if listRequest.GetSliceCount() < 0 || listRequest.GetSliceID() < 0 ||
+		(listRequest.GetSliceCount() > 1 && listRequest.GetSliceID() >= listRequest.GetSliceCount()) ||
+		(listRequest.GetSliceCount() <= 1 && listRequest.GetSliceID() != 0) {
+		return nil, wh.error(errInvalidSlice, scope)
 	}
 
 	if err := wh.visibilityQueryValidator.ValidateListRequestForQuery(listRequest); err != nil {
@@ -2695,6 +3075,8 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(
 		PageSize:      int(listRequest.GetPageSize()),
 		NextPageToken: listRequest.NextPageToken,
 		Query:         listRequest.GetQuery(),
+		SliceID:       int(listRequest.GetSliceID()),
+		SliceCount:    int(listRequest.GetSliceCount()),
 	}
 	persistenceResp, err := wh.visibilityMgr.ScanWorkflowExecutions(req)
 	if err != nil {
@@ -2729,6 +3111,10 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
+	if wh.overloadController.ShouldShed() {
+		return nil, wh.error(errPersistenceOverloaded, scope)
+	}
+
 	if countRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
@@ -2777,6 +3163,43 @@ func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context) (resp *gen.G
 	return resp, nil
 }
 
+// GetSearchAttributesSchema return valid indexed keys together with the search attributes limits of the domain
+func (wh *WorkflowHandler) GetSearchAttributesSchema(
+	ctx context.Context,
+	request *gen.GetSearchAttributesSchemaRequest,
+) (resp *gen.GetSearchAttributesSchemaResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendGetSearchAttributesSchemaScope, request)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	domain := request.GetDomain()
+	if domain == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if _, err := wh.domainCache.GetDomain(domain); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	keys := wh.config.ValidSearchAttributes()
+	resp = &gen.GetSearchAttributesSchemaResponse{
+		Keys:              wh.convertIndexedKeyToThrift(keys),
+		NumberOfKeysLimit: common.Int32Ptr(int32(wh.config.SearchAttributesNumberOfKeysLimit(domain))),
+		SizeOfValueLimit:  common.Int32Ptr(int32(wh.config.SearchAttributesSizeOfValueLimit(domain))),
+		TotalSizeLimit:    common.Int32Ptr(int32(wh.config.SearchAttributesTotalSizeLimit(domain))),
+	}
+	return resp, nil
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(
 	ctx context.Context,
@@ -2890,7 +3313,8 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		response.GetClientImpl(),
 	)
 	queryRequest.Execution.RunId = response.Execution.RunId
-	if clientFeature.SupportConsistentQuery() {
+	// built-in queries are answered by history service from mutable state, regardless of client feature
+	if clientFeature.SupportConsistentQuery() || common.IsBuiltInQueryType(queryRequest.Query.GetQueryType()) {
 		req := &h.QueryWorkflowRequest{
 			DomainUUID: common.StringPtr(domainID),
 			Execution:  queryRequest.GetExecution(),
@@ -3019,27 +3443,447 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 	return response, nil
 }
 
-// DescribeTaskList returns information about the target tasklist, right now this API returns the
-// pollers which polled this tasklist in last few minutes. If includeTaskListStatus field is true,
-// it will also return status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
-func (wh *WorkflowHandler) DescribeTaskList(
+// ListWorkflowExecutionChain returns all runs in the continue-as-new / retry / cron / reset chain
+// the given workflow execution belongs to, newest first. Candidate runs are listed from visibility
+// by workflowID, newest first, and matched against the chain using the links recorded in their started
+// events. One page inspects at most pageSize candidates, and paging stops at the first run of the chain.
+func (wh *WorkflowHandler) ListWorkflowExecutionChain(
 	ctx context.Context,
-	request *gen.DescribeTaskListRequest,
-) (resp *gen.DescribeTaskListResponse, retError error) {
+	listRequest *gen.ListWorkflowExecutionChainRequest,
+) (resp *gen.ListWorkflowExecutionChainResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeTaskListScope, request)
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendListWorkflowExecutionChainScope, listRequest)
 	defer sw.Stop()
 
 	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	if request == nil {
+	if listRequest == nil {
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request); !ok {
+	if ok := wh.allow(listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	domain := listRequest.GetDomain()
+	if domain == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+	domainID, err := wh.domainCache.GetDomainID(domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(listRequest.Execution, scope); err != nil {
+		return nil, err
+	}
+
+	pageSize := int(listRequest.GetPageSize())
+	if maxPageSize := wh.config.ExecutionChainMaxRuns(domain); pageSize <= 0 || pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// started event attributes already loaded in this call, keyed by run ID
+	loaded := make(map[string]*gen.WorkflowExecutionStartedEventAttributes)
+	token := &executionChainPageToken{}
+	if listRequest.NextPageToken != nil {
+		if err := json.Unmarshal(listRequest.NextPageToken, token); err != nil || token.FirstRunID == "" {
+			return nil, wh.error(errInvalidNextPageToken, scope)
+		}
+	} else {
+		startedAttributes, runID, err := wh.getWorkflowStartedEventAttributes(ctx, scope, domainID, domain, listRequest.Execution)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		loaded[runID] = startedAttributes
+		token.FirstRunID = startedAttributes.GetFirstExecutionRunId()
+		if token.FirstRunID == "" {
+			token.FirstRunID = runID
+		}
+		token.LatestStartTime = time.Now().UnixNano()
+	}
+
+	candidates, hasMore, err := wh.listExecutionsByWorkflowID(
+		domain,
+		domainID,
+		listRequest.Execution.GetWorkflowId(),
+		token.LatestStartTime,
+		pageSize,
+	)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	entries := []*gen.WorkflowExecutionChainEntry{}
+	for _, info := range candidates {
+		runID := info.Execution.GetRunId()
+		attributes, ok := loaded[runID]
+		if !ok {
+			attributes, _, err = wh.getWorkflowStartedEventAttributes(ctx, scope, domainID, domain, info.Execution)
+			if err != nil {
+				if _, ok := err.(*gen.EntityNotExistsError); ok {
+					// run is already deleted by retention, its visibility record will follow shortly
+					continue
+				}
+				return nil, wh.error(err, scope)
+			}
+		}
+		if runID != token.FirstRunID && attributes.GetFirstExecutionRunId() != token.FirstRunID {
+			continue
+		}
+		entries = append(entries, &gen.WorkflowExecutionChainEntry{
+			ExecutionInfo:           info,
+			ContinuedExecutionRunId: attributes.ContinuedExecutionRunId,
+			Initiator:               attributes.Initiator,
+			OriginalExecutionRunId:  attributes.OriginalExecutionRunId,
+		})
+		if runID == token.FirstRunID {
+			// runs started before the first run cannot belong to the chain
+			hasMore = false
+			break
+		}
+	}
+
+	resp = &gen.ListWorkflowExecutionChainResponse{
+		FirstExecutionRunId: common.StringPtr(token.FirstRunID),
+		Executions:          entries,
+	}
+	if hasMore && len(candidates) > 0 {
+		token.LatestStartTime = candidates[len(candidates)-1].GetStartTime() - 1
+		if resp.NextPageToken, err = json.Marshal(token); err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+	return resp, nil
+}
+
+// BatchDescribeWorkflowExecutions returns the summary of a batch of workflow executions of one domain,
+// including the close event of the closed ones. Executions are described concurrently, an execution which
+// cannot be described has the error of its summary set instead of failing the whole batch.
+func (wh *WorkflowHandler) BatchDescribeWorkflowExecutions(
+	ctx context.Context,
+	batchRequest *gen.BatchDescribeWorkflowExecutionsRequest,
+) (resp *gen.BatchDescribeWorkflowExecutionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendBatchDescribeWorkflowExecutionsScope, batchRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if batchRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(batchRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	domain := batchRequest.GetDomain()
+	if domain == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+	domainID, err := wh.domainCache.GetDomainID(domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	executions := batchRequest.GetExecutions()
+	if len(executions) == 0 {
+		return nil, wh.error(errExecutionsNotSet, scope)
+	}
+	if maxExecutions := wh.config.BatchDescribeMaxExecutions(domain); len(executions) > maxExecutions {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Number of executions %v exceeds limit %v.", len(executions), maxExecutions),
+		}, scope)
+	}
+	for _, execution := range executions {
+		if err := wh.validateExecutionAndEmitMetrics(execution, scope); err != nil {
+			return nil, err
+		}
+	}
+
+	concurrency := wh.config.BatchDescribeConcurrency()
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	semaphore := make(chan struct{}, concurrency)
+	summaries := make([]*gen.WorkflowExecutionSummary, len(executions))
+	var wg sync.WaitGroup
+	for i, execution := range executions {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, execution *gen.WorkflowExecution) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			summaries[i] = wh.describeExecutionSummary(ctx, scope, domainID, domain, execution)
+		}(i, execution)
+	}
+	wg.Wait()
+
+	return &gen.BatchDescribeWorkflowExecutionsResponse{
+		Executions: summaries,
+	}, nil
+}
+
+// BatchOperationWorkflow starts a batch job terminating, canceling or signaling the workflow executions of a domain
+// which match a visibility query. The job is run by the batcher system workflow in the local system domain.
+func (wh *WorkflowHandler) BatchOperationWorkflow(
+	ctx context.Context,
+	batchRequest *gen.BatchOperationWorkflowRequest,
+) (resp *gen.BatchOperationWorkflowResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendBatchOperationWorkflowScope, batchRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if batchRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(batchRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	domain := batchRequest.GetDomain()
+	if domain == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+	if err := wh.checkAPIAccess(ctx, "BatchOperationWorkflow", domain); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if _, err := wh.domainCache.GetDomainID(domain); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if batchRequest.GetQuery() == "" {
+		return nil, wh.error(errQueryNotSet, scope)
+	}
+	// the batch job scans and counts the workflows matching the query, which only advanced visibility supports
+	if !wh.config.EnableReadVisibilityFromES(domain) {
+		return nil, wh.error(errBatchOperationAdvancedVisibility, scope)
+	}
+	if err := wh.visibilityQueryValidator.ValidateCountRequestForQuery(&gen.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domain),
+		Query:  batchRequest.Query,
+	}); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if batchRequest.GetReason() == "" {
+		return nil, wh.error(errReasonNotSet, scope)
+	}
+	if batchRequest.OperationType == nil {
+		return nil, wh.error(errBatchOperationTypeNotSet, scope)
+	}
+	batchType, ok := batchOperationTypes[batchRequest.GetOperationType()]
+	if !ok {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Unknown OperationType %v.", batchRequest.GetOperationType()),
+		}, scope)
+	}
+	if batchType == batch.BatchTypeSignal {
+		if batchRequest.GetSignalName() == "" {
+			return nil, wh.error(errSignalNameNotSet, scope)
+		}
+		if len(batchRequest.GetSignalName()) > wh.config.MaxIDLengthLimit() {
+			return nil, wh.error(errSignalNameTooLong, scope)
+		}
+	}
+	if len(batchRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errIdentityTooLong, scope)
+	}
+	if len(batchRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errRequestIDTooLong, scope)
+	}
+
+	systemDomainID, err := wh.domainCache.GetDomainID(common.SystemLocalDomainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	jobID := batchRequest.GetRequestId()
+	if jobID == "" {
+		jobID = uuid.New()
+	}
+	input, err := json.Marshal(batch.BatchParams{
+		DomainName: domain,
+		Query:      batchRequest.GetQuery(),
+		Reason:     batchRequest.GetReason(),
+		BatchType:  batchType,
+		SignalParams: batch.SignalParams{
+			SignalName: batchRequest.GetSignalName(),
+			Input:      batchRequest.SignalInput,
+		},
+		RPS: int(batchRequest.GetRps()),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	startRequest := &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(common.SystemLocalDomainName),
+		WorkflowId:                          common.StringPtr(jobID),
+		WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr(batch.BatchWFTypeName)},
+		TaskList:                            &gen.TaskList{Name: common.StringPtr(batch.BatcherTaskListName)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(batch.InfiniteDuration.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(batchOperationDecisionTimeoutSeconds),
+		Identity:                            batchRequest.Identity,
+		RequestId:                           common.StringPtr(jobID),
+		Memo: &gen.Memo{Fields: map[string][]byte{
+			batchOperationMemoReason: encodeBatchOperationString(batchRequest.GetReason()),
+			batchOperationMemoQuery:  encodeBatchOperationString(batchRequest.GetQuery()),
+			batchOperationMemoType:   encodeBatchOperationString(batchType),
+		}},
+		SearchAttributes: &gen.SearchAttributes{IndexedFields: map[string][]byte{
+			batchOperationSearchAttrDomain:   encodeBatchOperationString(domain),
+			batchOperationSearchAttrOperator: encodeBatchOperationString(batchRequest.GetIdentity()),
+		}},
+	}
+	if _, err := wh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(systemDomainID, startRequest)); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &gen.BatchOperationWorkflowResponse{
+		JobID: common.StringPtr(jobID),
+	}, nil
+}
+
+// DescribeBatchOperation returns the state and progress of a batch job of the domain. The progress of a running
+// job is the last heartbeat of the batch activity and the progress of a completed job is the batch workflow result.
+func (wh *WorkflowHandler) DescribeBatchOperation(
+	ctx context.Context,
+	describeRequest *gen.DescribeBatchOperationRequest,
+) (resp *gen.DescribeBatchOperationResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeBatchOperationScope, describeRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if describeRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(describeRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	domain := describeRequest.GetDomain()
+	if domain == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+	if err := wh.checkAPIAccess(ctx, "DescribeBatchOperation", domain); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if describeRequest.GetJobID() == "" {
+		return nil, wh.error(errJobIDNotSet, scope)
+	}
+
+	systemDomainID, err := wh.domainCache.GetDomainID(common.SystemLocalDomainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	describeResponse, err := wh.history.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(systemDomainID),
+		Request: &gen.DescribeWorkflowExecutionRequest{
+			Domain:    common.StringPtr(common.SystemLocalDomainName),
+			Execution: &gen.WorkflowExecution{WorkflowId: describeRequest.JobID},
+		},
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	executionInfo := describeResponse.WorkflowExecutionInfo
+	searchAttributes := executionInfo.GetSearchAttributes().GetIndexedFields()
+	if executionInfo.GetType().GetName() != batch.BatchWFTypeName ||
+		decodeBatchOperationString(searchAttributes[batchOperationSearchAttrDomain]) != domain {
+		return nil, wh.error(errBatchOperationNotFound, scope)
+	}
+
+	memo := executionInfo.GetMemo().GetFields()
+	resp = &gen.DescribeBatchOperationResponse{
+		JobID:     describeRequest.JobID,
+		Query:     common.StringPtr(decodeBatchOperationString(memo[batchOperationMemoQuery])),
+		Reason:    common.StringPtr(decodeBatchOperationString(memo[batchOperationMemoReason])),
+		Identity:  common.StringPtr(decodeBatchOperationString(searchAttributes[batchOperationSearchAttrOperator])),
+		StartTime: executionInfo.StartTime,
+		CloseTime: executionInfo.CloseTime,
+	}
+	batchType := decodeBatchOperationString(memo[batchOperationMemoType])
+	for operationType, name := range batchOperationTypes {
+		if name == batchType {
+			resp.OperationType = operationType.Ptr()
+		}
+	}
+
+	var progress []byte
+	if executionInfo.CloseStatus == nil {
+		resp.State = gen.BatchOperationStateRunning.Ptr()
+		if len(describeResponse.PendingActivities) > 0 {
+			progress = describeResponse.PendingActivities[0].HeartbeatDetails
+		}
+	} else {
+		closeEvent, err := wh.getCloseEvent(ctx, scope, systemDomainID, common.SystemLocalDomainName, executionInfo.Execution)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		if executionInfo.GetCloseStatus() == gen.WorkflowExecutionCloseStatusCompleted {
+			resp.State = gen.BatchOperationStateCompleted.Ptr()
+			progress = closeEvent.GetWorkflowExecutionCompletedEventAttributes().GetResult()
+		} else {
+			resp.State = gen.BatchOperationStateFailed.Ptr()
+			resp.FailureReason = common.StringPtr(getBatchOperationFailureReason(executionInfo.GetCloseStatus(), closeEvent))
+		}
+	}
+
+	if len(progress) > 0 {
+		var hbd batch.HeartBeatDetails
+		if err := json.Unmarshal(progress, &hbd); err != nil {
+			return nil, wh.error(err, scope)
+		}
+		resp.TotalEstimate = common.Int64Ptr(hbd.TotalEstimate)
+		resp.SuccessCount = common.Int64Ptr(int64(hbd.SuccessCount))
+		resp.ErrorCount = common.Int64Ptr(int64(hbd.ErrorCount))
+	}
+	return resp, nil
+}
+
+// DescribeTaskList returns information about the target tasklist, right now this API returns the
+// pollers which polled this tasklist in last few minutes. If includeTaskListStatus field is true,
+// it will also return status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+func (wh *WorkflowHandler) DescribeTaskList(
+	ctx context.Context,
+	request *gen.DescribeTaskListRequest,
+) (resp *gen.DescribeTaskListResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeTaskListScope, request)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(request); !ok {
 		return nil, wh.error(createServiceBusyError(), scope)
 	}
 
@@ -3080,6 +3924,7 @@ func (wh *WorkflowHandler) DescribeTaskList(
 func (wh *WorkflowHandler) getHistory(
 	scope metrics.Scope,
 	domainID string,
+	domainName string,
 	execution gen.WorkflowExecution,
 	firstEventID, nextEventID int64,
 	pageSize int32,
@@ -3101,6 +3946,9 @@ func (wh *WorkflowHandler) getHistory(
 			PageSize:      int(pageSize),
 			NextPageToken: nextPageToken,
 			ShardID:       common.IntPtr(shardID),
+			DomainName:    domainName,
+			DomainID:      domainID,
+			WorkflowID:    execution.GetWorkflowId(),
 		})
 		if err != nil {
 			return nil, nil, err
@@ -3134,6 +3982,173 @@ func (wh *WorkflowHandler) getHistory(
 	return executionHistory, nextPageToken, nil
 }
 
+// getWorkflowStartedEventAttributes returns the started event attributes and run ID of the given execution,
+// if run ID is not set the current run is used
+func (wh *WorkflowHandler) getWorkflowStartedEventAttributes(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	domainName string,
+	execution *gen.WorkflowExecution,
+) (*gen.WorkflowExecutionStartedEventAttributes, string, error) {
+
+	response, err := wh.history.GetMutableState(ctx, &h.GetMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  execution,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	history, _, err := wh.getHistory(
+		scope,
+		domainID,
+		domainName,
+		*response.Execution,
+		common.FirstEventID,
+		common.FirstEventID+1,
+		1,
+		nil,
+		nil,
+		response.GetEventStoreVersion(),
+		response.BranchToken,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(history.Events) == 0 || history.Events[0].WorkflowExecutionStartedEventAttributes == nil {
+		return nil, "", &gen.InternalServiceError{Message: "Failed to load workflow execution started event."}
+	}
+	return history.Events[0].WorkflowExecutionStartedEventAttributes, response.Execution.GetRunId(), nil
+}
+
+// describeExecutionSummary describes a single execution of BatchDescribeWorkflowExecutions,
+// the close event is loaded only if the execution is closed
+func (wh *WorkflowHandler) describeExecutionSummary(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	domainName string,
+	execution *gen.WorkflowExecution,
+) *gen.WorkflowExecutionSummary {
+
+	summary := &gen.WorkflowExecutionSummary{
+		Execution: execution,
+	}
+	setError := func(err error) *gen.WorkflowExecutionSummary {
+		summary.Error = common.StringPtr(err.Error())
+		return summary
+	}
+
+	describeResponse, err := wh.history.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &gen.DescribeWorkflowExecutionRequest{
+			Domain:    common.StringPtr(domainName),
+			Execution: execution,
+		},
+	})
+	if err != nil {
+		return setError(err)
+	}
+	executionInfo := describeResponse.WorkflowExecutionInfo
+	summary.ExecutionInfo = executionInfo
+	if executionInfo.Execution != nil {
+		summary.Execution = executionInfo.Execution
+	}
+	if executionInfo.CloseStatus == nil {
+		return summary
+	}
+
+	if summary.CloseEvent, err = wh.getCloseEvent(ctx, scope, domainID, domainName, summary.Execution); err != nil {
+		return setError(err)
+	}
+	return summary
+}
+
+// getCloseEvent returns the last event of a closed workflow execution
+func (wh *WorkflowHandler) getCloseEvent(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	domainName string,
+	execution *gen.WorkflowExecution,
+) (*gen.HistoryEvent, error) {
+
+	response, err := wh.history.GetMutableState(ctx, &h.GetMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	history, _, err := wh.getHistory(
+		scope,
+		domainID,
+		domainName,
+		*response.Execution,
+		response.GetLastFirstEventId(),
+		response.GetNextEventId(),
+		int32(wh.config.HistoryMaxPageSize(domainName)),
+		nil,
+		nil,
+		response.GetEventStoreVersion(),
+		response.BranchToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// since getHistory func will not return empty history, so the below is safe
+	return history.Events[len(history.Events)-1], nil
+}
+
+// listExecutionsByWorkflowID lists at most maxRuns open and closed runs of the given workflowID started no later
+// than latestStartTime from visibility, newest first, returns whether more runs are left
+func (wh *WorkflowHandler) listExecutionsByWorkflowID(
+	domain string,
+	domainID string,
+	workflowID string,
+	latestStartTime int64,
+	maxRuns int,
+) ([]*gen.WorkflowExecutionInfo, bool, error) {
+
+	listFns := []func(*persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error){
+		wh.visibilityMgr.ListOpenWorkflowExecutionsByWorkflowID,
+		wh.visibilityMgr.ListClosedWorkflowExecutionsByWorkflowID,
+	}
+
+	// both lists are ordered by start time, so the newest maxRuns of their first
+	// maxRuns+1 runs are the newest maxRuns overall
+	var executions []*gen.WorkflowExecutionInfo
+	hasMore := false
+	for _, listFn := range listFns {
+		resp, err := listFn(&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+			ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+				DomainUUID:        domainID,
+				Domain:            domain,
+				EarliestStartTime: 0,
+				LatestStartTime:   latestStartTime,
+				PageSize:          maxRuns + 1,
+			},
+			WorkflowID: workflowID,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		executions = append(executions, resp.Executions...)
+		if len(resp.NextPageToken) != 0 {
+			hasMore = true
+		}
+	}
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].GetStartTime() > executions[j].GetStartTime()
+	})
+	if len(executions) > maxRuns {
+		executions = executions[:maxRuns]
+		hasMore = true
+	}
+	return executions, hasMore, nil
+}
+
 func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) log.Logger {
 	logger := wh.Service.GetLogger()
 	task, err := wh.tokenSerializer.Deserialize(taskToken)
@@ -3184,11 +4199,16 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope) error {
 		// NOTE: For internal error, we won't return thrift error from cadence-frontend.
 		// Because in uber internal metrics, thrift errors are counted as user errors
 		return fmt.Errorf("cadence internal error, msg: %v", err.Message)
+	case *persistence.DataLossError:
+		wh.Service.GetLogger().Error("Data loss error", tag.Error(err))
+		scope.IncCounter(metrics.CadenceFailures)
+		return fmt.Errorf("cadence data loss error, msg: %v", err.Msg)
 	case *gen.BadRequestError:
 		scope.IncCounter(metrics.CadenceErrBadRequestCounter)
 		return err
 	case *gen.DomainNotActiveError:
 		scope.IncCounter(metrics.CadenceErrBadRequestCounter)
+		cluster.SetActiveClusterAddress(err, wh.GetClusterMetadata())
 		return err
 	case *gen.ServiceBusyError:
 		scope.IncCounter(metrics.CadenceErrServiceBusyCounter)
@@ -3214,6 +4234,12 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope) error {
 	case *gen.ClientVersionNotSupportedError:
 		scope.IncCounter(metrics.CadenceErrClientVersionNotSupportedCounter)
 		return err
+	case *gen.AccessDeniedError:
+		scope.IncCounter(metrics.CadenceErrAccessDeniedCounter)
+		return err
+	case *gen.PreconditionFailedError:
+		scope.IncCounter(metrics.CadenceErrPreconditionFailedCounter)
+		return err
 	case *yarpcerrors.Status:
 		if err.Code() == yarpcerrors.CodeDeadlineExceeded {
 			scope.IncCounter(metrics.CadenceErrContextTimeoutCounter)
@@ -3244,6 +4270,23 @@ func (wh *WorkflowHandler) validateTaskList(t *gen.TaskList, scope metrics.Scope
 	return nil
 }
 
+// validateKnownTaskList rejects task lists not declared by the domain when enforcement is enabled for it
+func (wh *WorkflowHandler) validateKnownTaskList(domainName string, t *gen.TaskList, scope metrics.Scope) error {
+	if !wh.config.EnforceKnownTaskLists(domainName) {
+		return nil
+	}
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	if !domainEntry.IsTaskListKnown(t.GetName()) {
+		return wh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Task list %v is not a known task list of domain %v.", t.GetName(), domainName),
+		}, scope)
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateExecutionAndEmitMetrics(w *gen.WorkflowExecution, scope metrics.Scope) error {
 	err := validateExecution(w)
 	if err != nil {
@@ -3252,6 +4295,56 @@ func (wh *WorkflowHandler) validateExecutionAndEmitMetrics(w *gen.WorkflowExecut
 	return nil
 }
 
+// admitStartWorkflowExecution evaluates the admission policy on the request and returns the request to
+// process, which is replaced if the policy mutated it
+func (wh *WorkflowHandler) admitStartWorkflowExecution(
+	ctx context.Context,
+	request *gen.StartWorkflowExecutionRequest,
+) (*gen.StartWorkflowExecutionRequest, error) {
+
+	if wh.config.AdmissionPolicy == nil {
+		return request, nil
+	}
+	result, err := wh.config.AdmissionPolicy.AdmitStartWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	switch result.Decision {
+	case admission.DecisionDeny:
+		return nil, newAdmissionDeniedError(result.Reason)
+	case admission.DecisionMutate:
+		return result.StartRequest, nil
+	}
+	return request, nil
+}
+
+// admitSignalWithStartWorkflowExecution evaluates the admission policy on the request and returns the request
+// to process, which is replaced if the policy mutated it
+func (wh *WorkflowHandler) admitSignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *gen.SignalWithStartWorkflowExecutionRequest,
+) (*gen.SignalWithStartWorkflowExecutionRequest, error) {
+
+	if wh.config.AdmissionPolicy == nil {
+		return request, nil
+	}
+	result, err := wh.config.AdmissionPolicy.AdmitSignalWithStartWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	switch result.Decision {
+	case admission.DecisionDeny:
+		return nil, newAdmissionDeniedError(result.Reason)
+	case admission.DecisionMutate:
+		return result.SignalWithStartRequest, nil
+	}
+	return request, nil
+}
+
+func newAdmissionDeniedError(reason string) error {
+	return &gen.BadRequestError{Message: fmt.Sprintf("Request is denied by admission policy: %v", reason)}
+}
+
 func validateExecution(w *gen.WorkflowExecution) error {
 	if w == nil {
 		return errExecutionNotSet
@@ -3312,6 +4405,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		history, persistenceToken, err = wh.getHistory(
 			scope,
 			domainID,
+			domain.GetInfo().Name,
 			*matchingResp.WorkflowExecution,
 			firstEventID,
 			nextEventID,
@@ -3358,6 +4452,23 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 	return resp, nil
 }
 
+// getContinuedAsNewHistoryToken returns the token to page into the run continued from the run whose
+// history ends with the given events, nil if the run was not continued as new
+func getContinuedAsNewHistoryToken(history *gen.History) *getHistoryContinuationToken {
+	if len(history.Events) == 0 {
+		return nil
+	}
+	lastEvent := history.Events[len(history.Events)-1]
+	if lastEvent.GetEventType() != gen.EventTypeWorkflowExecutionContinuedAsNew {
+		return nil
+	}
+	return &getHistoryContinuationToken{
+		RunID:                     lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId(),
+		IsNewRun:                  true,
+		IsFollowingContinuedAsNew: true,
+	}
+}
+
 func deserializeHistoryToken(bytes []byte) (*getHistoryContinuationToken, error) {
 	token := &getHistoryContinuationToken{}
 	err := json.Unmarshal(bytes, token)
@@ -3373,6 +4484,39 @@ func serializeHistoryToken(token *getHistoryContinuationToken) ([]byte, error) {
 	return bytes, err
 }
 
+// encodeBatchOperationString encodes memo and search attribute values of batch jobs the way the go client does
+func encodeBatchOperationString(value string) []byte {
+	data, _ := json.Marshal(value)
+	return data
+}
+
+func decodeBatchOperationString(data []byte) string {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return string(data)
+	}
+	return value
+}
+
+func getBatchOperationFailureReason(
+	closeStatus gen.WorkflowExecutionCloseStatus,
+	closeEvent *gen.HistoryEvent,
+) string {
+
+	switch closeEvent.GetEventType() {
+	case gen.EventTypeWorkflowExecutionFailed:
+		return closeEvent.WorkflowExecutionFailedEventAttributes.GetReason()
+	case gen.EventTypeWorkflowExecutionTerminated:
+		return closeEvent.WorkflowExecutionTerminatedEventAttributes.GetReason()
+	}
+	return closeStatus.String()
+}
+
+// isBatchRequest returns whether the request is sent by a batch operation of the batcher
+func isBatchRequest(identity string) bool {
+	return identity == batch.BatchWFTypeName
+}
+
 func createServiceBusyError() *gen.ServiceBusyError {
 	err := &gen.ServiceBusyError{}
 	err.Message = "Too many outstanding requests to the cadence service"
@@ -3461,7 +4605,21 @@ func (wh *WorkflowHandler) convertIndexedKeyToThrift(keys map[string]interface{}
 
 func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, domain string) bool {
 	return wh.config.EnableReadVisibilityFromES(domain) &&
-		pageSize > int32(wh.config.ESIndexMaxResultWindow())
+		pageSize > int32(wh.getESMaxPageSize(domain))
+}
+
+// getESMaxPageSize returns the max page size of the domain, which never exceeds the max result window of the index
+func (wh *WorkflowHandler) getESMaxPageSize(domain string) int {
+	maxPageSize := wh.config.ESIndexMaxResultWindow()
+	if domainMaxPageSize := wh.config.ESQueryMaxPageSize(domain); domainMaxPageSize < maxPageSize {
+		maxPageSize = domainMaxPageSize
+	}
+	return maxPageSize
+}
+
+// checkAPIAccess returns AccessDeniedError if the api is disabled for the domain or the calling service
+func (wh *WorkflowHandler) checkAPIAccess(ctx context.Context, api string, domain string) error {
+	return wh.apiGate.check(api, domain, yarpc.CallFromContext(ctx).Caller())
 }
 
 func (wh *WorkflowHandler) allow(d domainGetter) bool {
@@ -3519,18 +4677,40 @@ func (wh *WorkflowHandler) GetDomainReplicationMessages(
 		return nil, wh.error(errors.New("domain replication queue not enabled for cluster"), scope)
 	}
 
-	// TODO: Set it to last ack level for the cluster.
 	lastMessageID := defaultLastMessageID
 	if request.IsSetLastRetrivedMessageId() {
 		lastMessageID = int(request.GetLastRetrivedMessageId())
 	}
 
+	if lastMessageID == defaultLastMessageID && request.IsSetClusterName() {
+		// the pulling cluster has no checkpoint of its own, resume from its ack level
+		clusterAckLevels, err := wh.domainReplicationQueue.GetAckLevels()
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+
+		if ackLevel, ok := clusterAckLevels[request.GetClusterName()]; ok {
+			lastMessageID = ackLevel
+		}
+	}
+
 	replicationTasks, lastMessageID, err := wh.domainReplicationQueue.GetReplicationMessages(
 		lastMessageID, getDomainReplicationMessageBatchSize)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
+	if request.IsSetLastProcessedMessageId() && request.IsSetClusterName() &&
+		request.GetLastProcessedMessageId() != defaultLastMessageID {
+		err := wh.domainReplicationQueue.UpdateAckLevel(int(request.GetLastProcessedMessageId()), request.GetClusterName())
+		if err != nil {
+			wh.GetLogger().Warn("Failed to update domain replication queue ack level.",
+				tag.TaskID(request.GetLastProcessedMessageId()),
+				tag.ClusterName(request.GetClusterName()),
+				tag.Error(err))
+		}
+	}
+
 	return &replicator.GetDomainReplicationMessagesResponse{
 		Messages: &replicator.ReplicationMessages{
 			ReplicationTasks:      replicationTasks,
@@ -178,6 +178,21 @@ func (mr *MockWorkflowHandlerMockRecorder) GetSearchAttributes(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockWorkflowHandler)(nil).GetSearchAttributes), ctx)
 }
 
+// GetSearchAttributesSchema mocks base method
+func (m *MockWorkflowHandler) GetSearchAttributesSchema(ctx context.Context, Request *shared.GetSearchAttributesSchemaRequest) (*shared.GetSearchAttributesSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchAttributesSchema", ctx, Request)
+	ret0, _ := ret[0].(*shared.GetSearchAttributesSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchAttributesSchema indicates an expected call of GetSearchAttributesSchema
+func (mr *MockWorkflowHandlerMockRecorder) GetSearchAttributesSchema(ctx, Request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributesSchema", reflect.TypeOf((*MockWorkflowHandler)(nil).GetSearchAttributesSchema), ctx, Request)
+}
+
 // GetWorkflowExecutionHistory mocks base method
 func (m *MockWorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, GetRequest *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +268,66 @@ func (mr *MockWorkflowHandlerMockRecorder) ListOpenWorkflowExecutions(ctx, ListR
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenWorkflowExecutions", reflect.TypeOf((*MockWorkflowHandler)(nil).ListOpenWorkflowExecutions), ctx, ListRequest)
 }
 
+// BatchDescribeWorkflowExecutions mocks base method
+func (m *MockWorkflowHandler) BatchDescribeWorkflowExecutions(ctx context.Context, BatchRequest *shared.BatchDescribeWorkflowExecutionsRequest) (*shared.BatchDescribeWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDescribeWorkflowExecutions", ctx, BatchRequest)
+	ret0, _ := ret[0].(*shared.BatchDescribeWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchOperationWorkflow mocks base method
+func (m *MockWorkflowHandler) BatchOperationWorkflow(ctx context.Context, BatchRequest *shared.BatchOperationWorkflowRequest) (*shared.BatchOperationWorkflowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchOperationWorkflow", ctx, BatchRequest)
+	ret0, _ := ret[0].(*shared.BatchOperationWorkflowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBatchOperation mocks base method
+func (m *MockWorkflowHandler) DescribeBatchOperation(ctx context.Context, DescribeRequest *shared.DescribeBatchOperationRequest) (*shared.DescribeBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBatchOperation", ctx, DescribeRequest)
+	ret0, _ := ret[0].(*shared.DescribeBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowExecutionChain mocks base method
+func (m *MockWorkflowHandler) ListWorkflowExecutionChain(ctx context.Context, ListRequest *shared.ListWorkflowExecutionChainRequest) (*shared.ListWorkflowExecutionChainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowExecutionChain", ctx, ListRequest)
+	ret0, _ := ret[0].(*shared.ListWorkflowExecutionChainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDescribeWorkflowExecutions indicates an expected call of BatchDescribeWorkflowExecutions
+func (mr *MockWorkflowHandlerMockRecorder) BatchDescribeWorkflowExecutions(ctx, BatchRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDescribeWorkflowExecutions", reflect.TypeOf((*MockWorkflowHandler)(nil).BatchDescribeWorkflowExecutions), ctx, BatchRequest)
+}
+
+// BatchOperationWorkflow indicates an expected call of BatchOperationWorkflow
+func (mr *MockWorkflowHandlerMockRecorder) BatchOperationWorkflow(ctx, BatchRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchOperationWorkflow", reflect.TypeOf((*MockWorkflowHandler)(nil).BatchOperationWorkflow), ctx, BatchRequest)
+}
+
+// DescribeBatchOperation indicates an expected call of DescribeBatchOperation
+func (mr *MockWorkflowHandlerMockRecorder) DescribeBatchOperation(ctx, DescribeRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBatchOperation", reflect.TypeOf((*MockWorkflowHandler)(nil).DescribeBatchOperation), ctx, DescribeRequest)
+}
+
+// ListWorkflowExecutionChain indicates an expected call of ListWorkflowExecutionChain
+func (mr *MockWorkflowHandlerMockRecorder) ListWorkflowExecutionChain(ctx, ListRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutionChain", reflect.TypeOf((*MockWorkflowHandler)(nil).ListWorkflowExecutionChain), ctx, ListRequest)
+}
+
 // ListWorkflowExecutions mocks base method
 func (m *MockWorkflowHandler) ListWorkflowExecutions(ctx context.Context, ListRequest *shared.ListWorkflowExecutionsRequest) (*shared.ListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +343,20 @@ func (mr *MockWorkflowHandlerMockRecorder) ListWorkflowExecutions(ctx, ListReque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutions", reflect.TypeOf((*MockWorkflowHandler)(nil).ListWorkflowExecutions), ctx, ListRequest)
 }
 
+// PauseWorkflowExecution mocks base method
+func (m *MockWorkflowHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, PauseRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockWorkflowHandlerMockRecorder) PauseWorkflowExecution(ctx, PauseRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).PauseWorkflowExecution), ctx, PauseRequest)
+}
+
 // PollForActivityTask mocks base method
 func (m *MockWorkflowHandler) PollForActivityTask(ctx context.Context, PollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -528,6 +617,20 @@ func (mr *MockWorkflowHandlerMockRecorder) RespondQueryTaskCompleted(ctx, Comple
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockWorkflowHandler)(nil).RespondQueryTaskCompleted), ctx, CompleteRequest)
 }
 
+// ResumeWorkflowExecution mocks base method
+func (m *MockWorkflowHandler) ResumeWorkflowExecution(ctx context.Context, ResumeRequest *shared.ResumeWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", ctx, ResumeRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution
+func (mr *MockWorkflowHandlerMockRecorder) ResumeWorkflowExecution(ctx, ResumeRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).ResumeWorkflowExecution), ctx, ResumeRequest)
+}
+
 // ScanWorkflowExecutions mocks base method
 func (m *MockWorkflowHandler) ScanWorkflowExecutions(ctx context.Context, ListRequest *shared.ListWorkflowExecutionsRequest) (*shared.ListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
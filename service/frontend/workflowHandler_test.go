@@ -22,8 +22,10 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,14 +35,17 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
 	"github.com/uber/cadence/.gen/go/shared"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/admission"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/domain"
@@ -49,9 +54,12 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/overload"
 	"github.com/uber/cadence/common/persistence"
 	cs "github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	dc "github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/yarpc"
 )
 
 const (
@@ -147,7 +155,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockService.GetLogger(),
 	)
 	return NewWorkflowHandler(s.mockService, config, s.mockMetadataMgr, s.mockHistoryMgr,
-		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, nil, domainCache)
+		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, nil, domainCache, s.newOverloadController(config))
 }
 
 func (s *workflowHandlerSuite) getWorkflowHandlerHelper() *WorkflowHandler {
@@ -231,6 +239,27 @@ func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 	assert.Equal(s.T(), errNoPermission, err)
 }
 
+func (s *workflowHandlerSuite) TestTerminateWorkflowExecution_Force_NoPermission() {
+	config := s.newConfig()
+	config.EnableAdminProtection = dc.GetBoolPropertyFn(true)
+	config.AdminOperationToken = dc.GetStringPropertyFn("token")
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	err := wh.TerminateWorkflowExecution(context.Background(), &shared.TerminateWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.testDomain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		Force:         common.BoolPtr(true),
+		SecurityToken: common.StringPtr("wrong token"),
+	})
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errNoPermission, err)
+}
+
 func (s *workflowHandlerSuite) TestPollForTask_Failed_ContextTimeoutTooShort() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -258,6 +287,105 @@ func (s *workflowHandlerSuite) TestPollForTask_Failed_ContextTimeoutTooShort() {
 	assert.Equal(s.T(), common.ErrContextTimeoutTooShort, err)
 }
 
+func (s *workflowHandlerSuite) TestPollForTask_Failed_DomainNotActive() {
+	wh := s.getWorkflowHandlerHelper()
+	wh.config.RejectPollsOnPassiveDomain = dc.GetBoolPropertyFnFilteredByDomain(true)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	alternativeClusterInfo := cluster.TestAllClusterInfo[cluster.TestAlternativeClusterName]
+	alternativeClusterInfo.PublicRPCAddress = "cadence-standby.example.com:7933"
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(map[string]config.ClusterInformation{
+		cluster.TestCurrentClusterName:     cluster.TestAllClusterInfo[cluster.TestCurrentClusterName],
+		cluster.TestAlternativeClusterName: alternativeClusterInfo,
+	})
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.testDomainID, Name: s.testDomain},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+		s.mockClusterMetadata,
+	)
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(domainEntry, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.MinLongPollTimeout*2)
+	defer cancel()
+	taskList := &shared.TaskList{Name: common.StringPtr("test-task-list")}
+
+	_, err := wh.PollForDecisionTask(ctx, &shared.PollForDecisionTaskRequest{
+		Domain:   common.StringPtr(s.testDomain),
+		TaskList: taskList,
+	})
+	s.IsType(&shared.DomainNotActiveError{}, err)
+	s.Equal(cluster.TestAlternativeClusterName, err.(*shared.DomainNotActiveError).ActiveCluster)
+	s.Equal(alternativeClusterInfo.PublicRPCAddress, err.(*shared.DomainNotActiveError).GetActiveClusterAddress())
+
+	_, err = wh.PollForActivityTask(ctx, &shared.PollForActivityTaskRequest{
+		Domain:   common.StringPtr(s.testDomain),
+		TaskList: taskList,
+	})
+	s.IsType(&shared.DomainNotActiveError{}, err)
+	s.Equal(cluster.TestCurrentClusterName, err.(*shared.DomainNotActiveError).CurrentCluster)
+}
+
+func (s *workflowHandlerSuite) TestLoadShedding() {
+	wh := s.getWorkflowHandlerHelper()
+	wh.overloadController = &sheddingOverloadController{}
+
+	_, err := wh.ListOpenWorkflowExecutions(context.Background(), &shared.ListOpenWorkflowExecutionsRequest{
+		Domain: common.StringPtr(s.testDomain),
+	})
+	s.Equal(errPersistenceOverloaded, err)
+
+	_, err = wh.CountWorkflowExecutions(context.Background(), &shared.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(s.testDomain),
+	})
+	s.Equal(errPersistenceOverloaded, err)
+
+	err = wh.TerminateWorkflowExecution(context.Background(), &shared.TerminateWorkflowExecutionRequest{
+		Domain:   common.StringPtr(s.testDomain),
+		Identity: common.StringPtr(batch.BatchWFTypeName),
+	})
+	s.Equal(errPersistenceOverloaded, err)
+
+	// requests which are not sent by batch operations are not shed
+	err = wh.TerminateWorkflowExecution(context.Background(), &shared.TerminateWorkflowExecutionRequest{})
+	s.Equal(errDomainNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDeniedAPIs() {
+	config := s.newConfig()
+	config.DeniedAPIs = dc.GetStringPropertyFnFilteredByDomain("TerminateWorkflowExecution,ResetWorkflowExecution,DescribeBatchOperation")
+	wh := s.getWorkflowHandler(config)
+	wh.startWG.Done()
+
+	err := wh.TerminateWorkflowExecution(context.Background(), &shared.TerminateWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.testDomain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+		},
+	})
+	s.IsType(&shared.AccessDeniedError{}, err)
+
+	_, err = wh.ResetWorkflowExecution(context.Background(), &shared.ResetWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.testDomain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+		},
+	})
+	s.IsType(&shared.AccessDeniedError{}, err)
+
+	_, err = wh.DescribeBatchOperation(context.Background(), &shared.DescribeBatchOperationRequest{
+		Domain: common.StringPtr(s.testDomain),
+		JobID:  common.StringPtr("job-id"),
+	})
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -289,6 +417,103 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet
 	assert.Equal(s.T(), errRequestIDNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_AdmissionDenied() {
+	admissionPolicy, err := admission.NewPolicy(config.AdmissionControl{
+		Policies: []config.AdmissionPolicy{{
+			Name:    admission.WorkflowIDPatternPolicyName,
+			Options: map[string]string{"pattern": "^order-"},
+		}},
+	}, s.logger)
+	s.NoError(err)
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.AdmissionPolicy = admissionPolicy
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err = wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_UnknownTaskList() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.EnforceKnownTaskLists = dc.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.mockDomainCache
+	wh.startWG.Done()
+
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain, ID: s.testDomainID},
+		&persistence.DomainConfig{KnownTaskLists: []string{"task-list"}},
+		"",
+		nil,
+	), nil)
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(s.testDomain),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-lsit"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_DomainDeprecated() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = s.mockDomainCache
+	wh.startWG.Done()
+
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain, ID: s.testDomainID, Status: persistence.DomainStatusDeprecated},
+		&persistence.DomainConfig{},
+		"",
+		nil,
+	), nil)
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(s.testDomain),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Equal(errDomainDeprecated, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -491,11 +716,37 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	assert.Equal(s.T(), errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidPriority() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+		Priority:                            common.Int32Ptr(-1),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidPriority, err)
+}
+
 func (s *workflowHandlerSuite) getWorkflowHandlerWithParams(mService cs.Service, config *Config,
 	mMetadataManager persistence.MetadataManager) *WorkflowHandler {
 	domainCache := cache.NewDomainCache(mMetadataManager, mService.GetClusterMetadata(), mService.GetMetricsClient(), mService.GetLogger())
 	return NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
-		s.mockVisibilityMgr, s.mockProducer, nil, domainCache)
+		s.mockVisibilityMgr, s.mockProducer, nil, domainCache, s.newOverloadController(config))
 }
 
 func (s *workflowHandlerSuite) TestRegisterDomain_Failure_InvalidArchivalURI() {
@@ -659,6 +910,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalDisabled() {
 		&domain.ArchivalState{Status: shared.ArchivalStatusDisabled, URI: ""},
 	)
 	mMetadataManager.On("GetDomain", mock.Anything).Return(getDomainResp, nil)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
 	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider)
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -686,6 +938,7 @@ func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalEnabled() {
 		&domain.ArchivalState{Status: shared.ArchivalStatusEnabled, URI: testVisibilityArchivalURI},
 	)
 	mMetadataManager.On("GetDomain", mock.Anything).Return(getDomainResp, nil)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
 	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider)
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager)
 	wh.metricsClient = wh.Service.GetMetricsClient()
@@ -1129,9 +1382,135 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetFirstPage() {
 	s.True(resp.GetArchived())
 }
 
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory_ArchivedFallback_ExecutionDeleted() {
+	config := s.newConfig()
+	mMetadataManager := &mocks.MetadataManager{}
+	getDomainResp := persistenceGetDomainResponse(
+		&domain.ArchivalState{Status: shared.ArchivalStatusEnabled, URI: testHistoryArchivalURI},
+		&domain.ArchivalState{Status: shared.ArchivalStatusDisabled, URI: ""},
+	)
+	mMetadataManager.On("GetDomain", mock.Anything).Return(getDomainResp, nil)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", testHistoryArchivalURI))
+	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider)
+	mHistoryArchiver := &archiver.HistoryArchiverMock{}
+	history := &gen.History{
+		Events: []*gen.HistoryEvent{
+			&gen.HistoryEvent{EventId: common.Int64Ptr(1)},
+			&gen.HistoryEvent{EventId: common.Int64Ptr(2)},
+		},
+	}
+	mHistoryArchiver.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.GetHistoryResponse{
+		HistoryBatches: []*gen.History{history},
+	}, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(mHistoryArchiver, nil)
+
+	// execution still exists when checking for archived history, but is deleted before history is read
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	gomock.InOrder(
+		mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&h.GetMutableStateResponse{}, nil).Times(1),
+		mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, &shared.EntityNotExistsError{Message: "workflow execution not found"}).Times(1),
+	)
+
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.history = mockHistoryClient
+	wh.startWG.Done()
+
+	request := getHistoryRequest(nil)
+	request.Domain = common.StringPtr("test-name")
+	request.Execution.RunId = common.StringPtr(uuid.New())
+	resp, err := wh.GetWorkflowExecutionHistory(context.Background(), request)
+	s.NoError(err)
+	s.NotNil(resp)
+	s.Equal(history, resp.History)
+	s.True(resp.GetArchived())
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory_FollowContinuedAsNew() {
+	wh := s.getWorkflowHandlerHelper()
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	wh.history = mockHistoryClient
+
+	firstRunID := uuid.New()
+	secondRunID := uuid.New()
+	firstBranchToken := []byte("first-branch")
+	secondBranchToken := []byte("second-branch")
+	s.mockDomainCache.On("GetDomainID", s.testDomain).Return(s.testDomainID, nil)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
+	gomock.InOrder(
+		mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&h.GetMutableStateResponse{
+			Execution:         &shared.WorkflowExecution{WorkflowId: common.StringPtr(testWorkflowID), RunId: common.StringPtr(firstRunID)},
+			EventStoreVersion: common.Int32Ptr(persistence.EventStoreVersionV2),
+			BranchToken:       firstBranchToken,
+			NextEventId:       common.Int64Ptr(3),
+			IsWorkflowRunning: common.BoolPtr(false),
+		}, nil),
+		// archival check of the run set on the request by the previous page
+		mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&h.GetMutableStateResponse{}, nil),
+		mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *h.GetMutableStateRequest, _ ...interface{}) (*h.GetMutableStateResponse, error) {
+				s.Equal(secondRunID, request.Execution.GetRunId())
+				return &h.GetMutableStateResponse{
+					Execution:         request.Execution,
+					EventStoreVersion: common.Int32Ptr(persistence.EventStoreVersionV2),
+					BranchToken:       secondBranchToken,
+					NextEventId:       common.Int64Ptr(2),
+					IsWorkflowRunning: common.BoolPtr(false),
+				}, nil
+			}),
+	)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return string(request.BranchToken) == string(firstBranchToken)
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(1),
+				EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			},
+			{
+				EventId:   common.Int64Ptr(2),
+				EventType: shared.EventTypeWorkflowExecutionContinuedAsNew.Ptr(),
+				WorkflowExecutionContinuedAsNewEventAttributes: &shared.WorkflowExecutionContinuedAsNewEventAttributes{
+					NewExecutionRunId: common.StringPtr(secondRunID),
+				},
+			},
+		},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return string(request.BranchToken) == string(secondBranchToken)
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(1),
+				EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			},
+		},
+	}, nil).Once()
+
+	request := &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:               common.StringPtr(s.testDomain),
+		Execution:            &shared.WorkflowExecution{WorkflowId: common.StringPtr(testWorkflowID)},
+		FollowContinuedAsNew: common.BoolPtr(true),
+	}
+	resp, err := wh.GetWorkflowExecutionHistory(context.Background(), request)
+	s.NoError(err)
+	s.Len(resp.History.Events, 2)
+	s.NotNil(resp.NextPageToken)
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = wh.GetWorkflowExecutionHistory(context.Background(), request)
+	s.NoError(err)
+	s.Len(resp.History.Events, 1)
+	s.Nil(resp.NextPageToken)
+}
+
 func (s *workflowHandlerSuite) TestGetHistory() {
 	config := s.newConfig()
 	domainID := uuid.New()
+	domainName := "test-domain"
 	firstEventID := int64(100)
 	nextEventID := int64(101)
 	we := gen.WorkflowExecution{
@@ -1146,6 +1525,9 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 		PageSize:      0,
 		NextPageToken: []byte{},
 		ShardID:       common.IntPtr(shardID),
+		DomainName:    domainName,
+		DomainID:      domainID,
+		WorkflowID:    we.GetWorkflowId(),
 	}
 	s.mockHistoryV2Mgr.On("ReadHistoryBranch", req).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents: []*workflow.HistoryEvent{
@@ -1162,7 +1544,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager)
 	wh.metricsClient = wh.Service.GetMetricsClient()
 	scope := wh.metricsClient.Scope(0)
-	history, token, err := wh.getHistory(scope, domainID, we, firstEventID, nextEventID, 0, []byte{}, nil, persistence.EventStoreVersionV2, []byte{})
+	history, token, err := wh.getHistory(scope, domainID, domainName, we, firstEventID, nextEventID, 0, []byte{}, nil, persistence.EventStoreVersionV2, []byte{})
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
 	s.NoError(err)
@@ -1271,6 +1653,140 @@ func (s *workflowHandlerSuite) TestListArchivedVisibility_Success() {
 	s.NoError(err)
 }
 
+func (s *workflowHandlerSuite) TestListClosedWorkflowExecutions_VisibilityTiering() {
+	config := s.newConfig()
+	config.VisibilityTieringAgeDays = dc.GetIntPropertyFilteredByDomain(7)
+	mMetadataManager := &mocks.MetadataManager{}
+	getDomainResp := persistenceGetDomainResponse(
+		&domain.ArchivalState{Status: shared.ArchivalStatusEnabled, URI: testHistoryArchivalURI},
+		&domain.ArchivalState{Status: shared.ArchivalStatusEnabled, URI: testVisibilityArchivalURI},
+	)
+	mMetadataManager.On("GetDomain", mock.Anything).Return(getDomainResp, nil)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+
+	now := time.Now()
+	recentExecution := &shared.WorkflowExecutionInfo{CloseTime: common.Int64Ptr(now.UnixNano())}
+	oldExecution := &shared.WorkflowExecutionInfo{CloseTime: common.Int64Ptr(now.Add(-8 * 24 * time.Hour).UnixNano())}
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{recentExecution, oldExecution},
+	}, nil).Once()
+	archivedExecution := &shared.WorkflowExecutionInfo{StartTime: common.Int64Ptr(1), CloseTime: common.Int64Ptr(2)}
+	mVisibilityArchiver := &archiver.VisibilityArchiverMock{}
+	mVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.QueryVisibilityRequest) bool {
+		return request.DomainID == getDomainResp.Info.ID && strings.HasPrefix(request.Query, "CloseTime >= 0 and CloseTime <= ")
+	})).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*shared.WorkflowExecutionInfo{archivedExecution},
+	}, nil).Once()
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(mVisibilityArchiver, nil)
+	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider)
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	listRequest := &shared.ListClosedWorkflowExecutionsRequest{
+		Domain: common.StringPtr(getDomainResp.Info.Name),
+		StartTimeFilter: &shared.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(now.UnixNano()),
+		},
+	}
+	resp, err := wh.ListClosedWorkflowExecutions(context.Background(), listRequest)
+	s.NoError(err)
+	// records older than the cutoff which are not tiered yet are still listed from the DB
+	s.Equal([]*shared.WorkflowExecutionInfo{recentExecution, oldExecution}, resp.Executions)
+	s.NotEmpty(resp.NextPageToken)
+
+	listRequest.NextPageToken = resp.NextPageToken
+	resp, err = wh.ListClosedWorkflowExecutions(context.Background(), listRequest)
+	s.NoError(err)
+	s.Equal([]*shared.WorkflowExecutionInfo{archivedExecution}, resp.Executions)
+	s.Equal(int64(1), archivedExecution.GetExecutionTime())
+	s.Empty(resp.NextPageToken)
+	mVisibilityArchiver.AssertExpectations(s.T())
+
+	// page tokens of other versions are rejected
+	listRequest.NextPageToken = []byte(`{"version":0,"coldTier":true}`)
+	_, err = wh.ListClosedWorkflowExecutions(context.Background(), listRequest)
+	s.Equal(errInvalidTieredListToken, err)
+}
+
+func (s *workflowHandlerSuite) TestListWorkflowExecutions_VisibilityTiering_NotApplicable() {
+	config := s.newConfig()
+	config.VisibilityTieringAgeDays = dc.GetIntPropertyFilteredByDomain(7)
+	mMetadataManager := &mocks.MetadataManager{}
+	getDomainResp := persistenceGetDomainResponse(
+		&domain.ArchivalState{Status: shared.ArchivalStatusEnabled, URI: testHistoryArchivalURI},
+		&domain.ArchivalState{Status: shared.ArchivalStatusEnabled, URI: testVisibilityArchivalURI},
+	)
+	mMetadataManager.On("GetDomain", mock.Anything).Return(getDomainResp, nil)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider)
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	now := time.Now()
+	startTimeFilter := &shared.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(0),
+		LatestTime:   common.Int64Ptr(now.UnixNano()),
+	}
+
+	// open records are never tiered
+	openExecution := &shared.WorkflowExecutionInfo{StartTime: common.Int64Ptr(now.Add(-8 * 24 * time.Hour).UnixNano())}
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{openExecution},
+	}, nil).Once()
+	openResp, err := wh.ListOpenWorkflowExecutions(context.Background(), &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(getDomainResp.Info.Name),
+		StartTimeFilter: startTimeFilter,
+	})
+	s.NoError(err)
+	s.Equal([]*shared.WorkflowExecutionInfo{openExecution}, openResp.Executions)
+	s.Empty(openResp.NextPageToken)
+
+	// listings and counts served by advanced visibility are not tiered
+	config.EnableReadVisibilityFromES = dc.GetBoolPropertyFnFilteredByDomain(true)
+	closedExecution := &shared.WorkflowExecutionInfo{CloseTime: common.Int64Ptr(now.Add(-8 * 24 * time.Hour).UnixNano())}
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{closedExecution},
+	}, nil).Once()
+	closedResp, err := wh.ListClosedWorkflowExecutions(context.Background(), &shared.ListClosedWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(getDomainResp.Info.Name),
+		StartTimeFilter: startTimeFilter,
+	})
+	s.NoError(err)
+	s.Equal([]*shared.WorkflowExecutionInfo{closedExecution}, closedResp.Executions)
+	s.Empty(closedResp.NextPageToken)
+
+	s.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything).Return(&persistence.CountWorkflowExecutionsResponse{Count: 5}, nil).Once()
+	countResp, err := wh.CountWorkflowExecutions(context.Background(), &shared.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(getDomainResp.Info.Name),
+	})
+	s.NoError(err)
+	s.Equal(int64(5), countResp.GetCount())
+	s.mockArchiverProvider.AssertNotCalled(s.T(), "GetVisibilityArchiver", mock.Anything, mock.Anything)
+}
+
+func (s *workflowHandlerSuite) TestBuildColdTierQuery() {
+	listRequest := &shared.ListClosedWorkflowExecutionsRequest{
+		StartTimeFilter: &shared.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(10),
+			LatestTime:   common.Int64Ptr(1000),
+		},
+		StatusFilter: shared.WorkflowExecutionCloseStatusContinuedAsNew.Ptr(),
+	}
+	s.Equal("CloseTime >= 10 and CloseTime <= 99 and CloseStatus = 'continuedasnew'", buildColdTierQuery(listRequest, 100))
+
+	listRequest.StatusFilter = nil
+	listRequest.ExecutionFilter = &shared.WorkflowExecutionFilter{WorkflowId: common.StringPtr("it's")}
+	s.Equal("CloseTime >= 10 and CloseTime <= 1000 and WorkflowID = 'it\\'s'", buildColdTierQuery(listRequest, 2000))
+}
+
 func (s *workflowHandlerSuite) TestGetSearchAttributes() {
 	wh := s.getWorkflowHandlerHelper()
 
@@ -1280,6 +1796,27 @@ func (s *workflowHandlerSuite) TestGetSearchAttributes() {
 	s.NotNil(resp)
 }
 
+func (s *workflowHandlerSuite) TestGetSearchAttributesSchema() {
+	wh := s.getWorkflowHandlerHelper()
+
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain, ID: s.testDomainID}, &persistence.DomainConfig{}, "", nil,
+	), nil)
+
+	ctx := context.Background()
+	resp, err := wh.GetSearchAttributesSchema(ctx, &shared.GetSearchAttributesSchemaRequest{
+		Domain: common.StringPtr(s.testDomain),
+	})
+	s.NoError(err)
+	s.Equal(len(s.config.ValidSearchAttributes()), len(resp.GetKeys()))
+	s.Equal(int32(s.config.SearchAttributesNumberOfKeysLimit(s.testDomain)), resp.GetNumberOfKeysLimit())
+	s.Equal(int32(s.config.SearchAttributesSizeOfValueLimit(s.testDomain)), resp.GetSizeOfValueLimit())
+	s.Equal(int32(s.config.SearchAttributesTotalSizeLimit(s.testDomain)), resp.GetTotalSizeLimit())
+
+	_, err = wh.GetSearchAttributesSchema(ctx, &shared.GetSearchAttributesSchemaRequest{})
+	s.Equal(errDomainNotSet, err)
+}
+
 func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	wh := s.getWorkflowHandlerHelper()
 
@@ -1336,6 +1873,34 @@ func (s *workflowHandlerSuite) TestScantWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestScanWorkflowExecutions_Slices() {
+	wh := s.getWorkflowHandlerHelper()
+
+	s.mockDomainCache.On("GetDomainID", mock.Anything).Return(s.testDomainID, nil)
+	s.mockVisibilityMgr.On("ScanWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequestV2) bool {
+		return request.SliceID == 2 && request.SliceCount == 4
+	})).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	listRequest := &shared.ListWorkflowExecutionsRequest{
+		Domain:     common.StringPtr(s.testDomain),
+		Query:      common.StringPtr("WorkflowID = 'wid'"),
+		SliceID:    common.Int32Ptr(2),
+		SliceCount: common.Int32Ptr(4),
+	}
+	ctx := context.Background()
+	_, err := wh.ScanWorkflowExecutions(ctx, listRequest)
+	s.NoError(err)
+
+	listRequest.SliceID = common.Int32Ptr(4)
+	_, err = wh.ScanWorkflowExecutions(ctx, listRequest)
+	s.Equal(errInvalidSlice, err)
+
+	listRequest.SliceID = common.Int32Ptr(1)
+	listRequest.SliceCount = nil
+	_, err = wh.ScanWorkflowExecutions(ctx, listRequest)
+	s.Equal(errInvalidSlice, err)
+}
+
 func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	wh := s.getWorkflowHandlerHelper()
 
@@ -1359,6 +1924,429 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestListWorkflowExecutionChain() {
+	wh := s.getWorkflowHandlerHelper()
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	wh.history = mockHistoryClient
+
+	firstRunID := uuid.New()
+	secondRunID := uuid.New()
+	currentRunID := uuid.New()
+	otherRunID := uuid.New()
+	startedAttributes := map[string]*shared.WorkflowExecutionStartedEventAttributes{
+		firstRunID: {
+			FirstExecutionRunId: common.StringPtr(firstRunID),
+		},
+		secondRunID: {
+			FirstExecutionRunId:     common.StringPtr(firstRunID),
+			ContinuedExecutionRunId: common.StringPtr(firstRunID),
+			Initiator:               shared.ContinueAsNewInitiatorCronSchedule.Ptr(),
+		},
+		currentRunID: {
+			FirstExecutionRunId:     common.StringPtr(firstRunID),
+			ContinuedExecutionRunId: common.StringPtr(secondRunID),
+			Initiator:               shared.ContinueAsNewInitiatorRetryPolicy.Ptr(),
+		},
+		otherRunID: {
+			FirstExecutionRunId: common.StringPtr(otherRunID),
+		},
+	}
+	executionInfo := func(runID string, startTime int64) *shared.WorkflowExecutionInfo {
+		return &shared.WorkflowExecutionInfo{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(testWorkflowID),
+				RunId:      common.StringPtr(runID),
+			},
+			StartTime: common.Int64Ptr(startTime),
+		}
+	}
+
+	s.mockDomainCache.On("GetDomainID", s.testDomain).Return(s.testDomainID, nil)
+	mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *h.GetMutableStateRequest, _ ...yarpc.CallOption) (*h.GetMutableStateResponse, error) {
+			runID := request.Execution.GetRunId()
+			if runID == "" {
+				runID = currentRunID
+			}
+			return &h.GetMutableStateResponse{
+				Execution: &shared.WorkflowExecution{
+					WorkflowId: request.Execution.WorkflowId,
+					RunId:      common.StringPtr(runID),
+				},
+				EventStoreVersion: common.Int32Ptr(persistence.EventStoreVersionV2),
+				BranchToken:       []byte(runID),
+			}, nil
+		}).Times(3)
+	// the started event of every run of the chain is read exactly once, runs older than the first one are not read
+	for _, runID := range []string{currentRunID, secondRunID, firstRunID} {
+		branchToken := runID
+		s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+			return string(request.BranchToken) == branchToken
+		})).Return(&persistence.ReadHistoryBranchResponse{
+			HistoryEvents: []*shared.HistoryEvent{
+				{
+					EventId:                                 common.Int64Ptr(common.FirstEventID),
+					EventType:                               shared.EventTypeWorkflowExecutionStarted.Ptr(),
+					WorkflowExecutionStartedEventAttributes: startedAttributes[runID],
+				},
+			},
+		}, nil).Once()
+	}
+	latestStartTime := func(startTime int64) interface{} {
+		return mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) bool {
+			return request.PageSize == 3 && (startTime == 0 || request.LatestStartTime == startTime)
+		})
+	}
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByWorkflowID", latestStartTime(0)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{executionInfo(currentRunID, 300)},
+	}, nil).Once()
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutionsByWorkflowID", latestStartTime(0)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			executionInfo(secondRunID, 200),
+			executionInfo(firstRunID, 100),
+			executionInfo(otherRunID, 50),
+		},
+		NextPageToken: []byte("next"),
+	}, nil).Once()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByWorkflowID", latestStartTime(199)).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutionsByWorkflowID", latestStartTime(199)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			executionInfo(firstRunID, 100),
+			executionInfo(otherRunID, 50),
+		},
+	}, nil).Once()
+
+	request := &shared.ListWorkflowExecutionChainRequest{
+		Domain: common.StringPtr(s.testDomain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+		},
+		PageSize: common.Int32Ptr(2),
+	}
+	resp, err := wh.ListWorkflowExecutionChain(context.Background(), request)
+	s.NoError(err)
+	s.Equal(firstRunID, resp.GetFirstExecutionRunId())
+	s.NotEmpty(resp.NextPageToken)
+	s.Len(resp.Executions, 2)
+	s.Equal(currentRunID, resp.Executions[0].ExecutionInfo.Execution.GetRunId())
+	s.Equal(secondRunID, resp.Executions[0].GetContinuedExecutionRunId())
+	s.Equal(shared.ContinueAsNewInitiatorRetryPolicy, resp.Executions[0].GetInitiator())
+	s.Equal(secondRunID, resp.Executions[1].ExecutionInfo.Execution.GetRunId())
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = wh.ListWorkflowExecutionChain(context.Background(), request)
+	s.NoError(err)
+	s.Equal(firstRunID, resp.GetFirstExecutionRunId())
+	s.Empty(resp.NextPageToken)
+	s.Len(resp.Executions, 1)
+	s.Equal(firstRunID, resp.Executions[0].ExecutionInfo.Execution.GetRunId())
+	s.False(resp.Executions[0].IsSetContinuedExecutionRunId())
+
+	request.NextPageToken = []byte("invalid")
+	_, err = wh.ListWorkflowExecutionChain(context.Background(), request)
+	s.Equal(errInvalidNextPageToken, err)
+}
+
+func (s *workflowHandlerSuite) TestBatchDescribeWorkflowExecutions() {
+	wh := s.getWorkflowHandlerHelper()
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	wh.history = mockHistoryClient
+
+	openRunID := uuid.New()
+	closedRunID := uuid.New()
+	missingRunID := uuid.New()
+	execution := func(runID string) *shared.WorkflowExecution {
+		return &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+			RunId:      common.StringPtr(runID),
+		}
+	}
+	closeEvent := &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(6),
+		EventType: shared.EventTypeWorkflowExecutionCompleted.Ptr(),
+	}
+
+	s.mockDomainCache.On("GetDomainID", s.testDomain).Return(s.testDomainID, nil)
+	mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *h.DescribeWorkflowExecutionRequest, _ ...yarpc.CallOption) (*shared.DescribeWorkflowExecutionResponse, error) {
+			info := &shared.WorkflowExecutionInfo{
+				Execution: request.Request.Execution,
+			}
+			switch request.Request.Execution.GetRunId() {
+			case missingRunID:
+				return nil, &shared.EntityNotExistsError{Message: "workflow execution not found"}
+			case closedRunID:
+				info.CloseStatus = shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+			}
+			return &shared.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: info}, nil
+		}).Times(3)
+	mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&h.GetMutableStateResponse{
+		Execution:         execution(closedRunID),
+		LastFirstEventId:  common.Int64Ptr(5),
+		NextEventId:       common.Int64Ptr(7),
+		EventStoreVersion: common.Int32Ptr(persistence.EventStoreVersionV2),
+		BranchToken:       []byte(closedRunID),
+	}, nil).Times(1)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return string(request.BranchToken) == closedRunID && request.MinEventID == 5 && request.MaxEventID == 7
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(5),
+				EventType: shared.EventTypeDecisionTaskCompleted.Ptr(),
+			},
+			closeEvent,
+		},
+	}, nil).Once()
+
+	resp, err := wh.BatchDescribeWorkflowExecutions(context.Background(), &shared.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     common.StringPtr(s.testDomain),
+		Executions: []*shared.WorkflowExecution{execution(openRunID), execution(closedRunID), execution(missingRunID)},
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 3)
+	s.Equal(openRunID, resp.Executions[0].Execution.GetRunId())
+	s.NotNil(resp.Executions[0].ExecutionInfo)
+	s.Nil(resp.Executions[0].CloseEvent)
+	s.False(resp.Executions[0].IsSetError())
+	s.Equal(closedRunID, resp.Executions[1].Execution.GetRunId())
+	s.Equal(closeEvent, resp.Executions[1].CloseEvent)
+	s.False(resp.Executions[1].IsSetError())
+	s.Equal(missingRunID, resp.Executions[2].Execution.GetRunId())
+	s.Nil(resp.Executions[2].ExecutionInfo)
+	s.True(resp.Executions[2].IsSetError())
+}
+
+func (s *workflowHandlerSuite) TestBatchDescribeWorkflowExecutions_InvalidRequest() {
+	wh := s.getWorkflowHandlerHelper()
+	s.mockDomainCache.On("GetDomainID", s.testDomain).Return(s.testDomainID, nil)
+
+	_, err := wh.BatchDescribeWorkflowExecutions(context.Background(), &shared.BatchDescribeWorkflowExecutionsRequest{
+		Domain: common.StringPtr(s.testDomain),
+	})
+	s.Equal(errExecutionsNotSet, err)
+
+	executions := make([]*shared.WorkflowExecution, 101)
+	for i := range executions {
+		executions[i] = &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+	}
+	_, err = wh.BatchDescribeWorkflowExecutions(context.Background(), &shared.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     common.StringPtr(s.testDomain),
+		Executions: executions,
+	})
+	s.IsType(&shared.BadRequestError{}, err)
+
+	executions = []*shared.WorkflowExecution{{RunId: common.StringPtr(uuid.New())}}
+	_, err = wh.BatchDescribeWorkflowExecutions(context.Background(), &shared.BatchDescribeWorkflowExecutionsRequest{
+		Domain:     common.StringPtr(s.testDomain),
+		Executions: executions,
+	})
+	s.Equal(errWorkflowIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestBatchOperationWorkflow() {
+	wh := s.getWorkflowHandlerHelper()
+	wh.config.EnableReadVisibilityFromES = dc.GetBoolPropertyFnFilteredByDomain(true)
+	// signal input is passed through as is, even when it is not valid UTF-8
+	signalInput := []byte{0xff, 0xfe, 0x00}
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	wh.history = mockHistoryClient
+	systemDomainID := uuid.New()
+
+	s.mockDomainCache.On("GetDomainID", s.testDomain).Return(s.testDomainID, nil)
+	s.mockDomainCache.On("GetDomainID", common.SystemLocalDomainName).Return(systemDomainID, nil)
+	mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *h.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
+			s.Equal(systemDomainID, request.GetDomainUUID())
+			startRequest := request.StartRequest
+			s.Equal("job-id", startRequest.GetWorkflowId())
+			s.Equal(batch.BatchWFTypeName, startRequest.WorkflowType.GetName())
+			s.Equal(batch.BatcherTaskListName, startRequest.TaskList.GetName())
+			s.Equal(`"test-domain"`, string(startRequest.SearchAttributes.IndexedFields["CustomDomain"]))
+			s.Equal(`"test-operator"`, string(startRequest.SearchAttributes.IndexedFields["Operator"]))
+			s.Equal(`"test-reason"`, string(startRequest.Memo.Fields["Reason"]))
+
+			var params batch.BatchParams
+			s.NoError(json.Unmarshal(startRequest.Input, &params))
+			s.Equal(s.testDomain, params.DomainName)
+			s.Equal("WorkflowType = 'test-type'", params.Query)
+			s.Equal(batch.BatchTypeSignal, params.BatchType)
+			s.Equal("test-signal", params.SignalParams.SignalName)
+			s.Equal(signalInput, params.SignalParams.Input)
+			return &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}, nil
+		}).Times(1)
+
+	resp, err := wh.BatchOperationWorkflow(context.Background(), &shared.BatchOperationWorkflowRequest{
+		Domain:        common.StringPtr(s.testDomain),
+		Query:         common.StringPtr("WorkflowType = 'test-type'"),
+		OperationType: shared.BatchOperationTypeSignal.Ptr(),
+		Reason:        common.StringPtr("test-reason"),
+		Identity:      common.StringPtr("test-operator"),
+		RequestId:     common.StringPtr("job-id"),
+		SignalName:    common.StringPtr("test-signal"),
+		SignalInput:   signalInput,
+	})
+	s.NoError(err)
+	s.Equal("job-id", resp.GetJobID())
+}
+
+func (s *workflowHandlerSuite) TestBatchOperationWorkflow_InvalidRequest() {
+	wh := s.getWorkflowHandlerHelper()
+	enableReadVisibilityFromES := true
+	wh.config.EnableReadVisibilityFromES = func(string) bool { return enableReadVisibilityFromES }
+	s.mockDomainCache.On("GetDomainID", s.testDomain).Return(s.testDomainID, nil)
+
+	request := func() *shared.BatchOperationWorkflowRequest {
+		return &shared.BatchOperationWorkflowRequest{
+			Domain:        common.StringPtr(s.testDomain),
+			Query:         common.StringPtr("WorkflowType = 'test-type'"),
+			OperationType: shared.BatchOperationTypeSignal.Ptr(),
+			Reason:        common.StringPtr("test-reason"),
+			SignalName:    common.StringPtr("test-signal"),
+		}
+	}
+
+	invalidRequest := request()
+	invalidRequest.Query = nil
+	_, err := wh.BatchOperationWorkflow(context.Background(), invalidRequest)
+	s.Equal(errQueryNotSet, err)
+
+	invalidRequest = request()
+	invalidRequest.Reason = nil
+	_, err = wh.BatchOperationWorkflow(context.Background(), invalidRequest)
+	s.Equal(errReasonNotSet, err)
+
+	invalidRequest = request()
+	invalidRequest.OperationType = nil
+	_, err = wh.BatchOperationWorkflow(context.Background(), invalidRequest)
+	s.Equal(errBatchOperationTypeNotSet, err)
+
+	invalidRequest = request()
+	invalidRequest.SignalName = nil
+	_, err = wh.BatchOperationWorkflow(context.Background(), invalidRequest)
+	s.Equal(errSignalNameNotSet, err)
+
+	invalidRequest = request()
+	invalidRequest.Query = common.StringPtr("UnknownKey = 'value'")
+	_, err = wh.BatchOperationWorkflow(context.Background(), invalidRequest)
+	s.IsType(&shared.BadRequestError{}, err)
+
+	enableReadVisibilityFromES = false
+	_, err = wh.BatchOperationWorkflow(context.Background(), request())
+	s.Equal(errBatchOperationAdvancedVisibility, err)
+}
+
+func (s *workflowHandlerSuite) TestDescribeBatchOperation_Running() {
+	wh := s.getWorkflowHandlerHelper()
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	wh.history = mockHistoryClient
+	systemDomainID := uuid.New()
+
+	heartbeatDetails, err := json.Marshal(batch.HeartBeatDetails{TotalEstimate: 10, SuccessCount: 3, ErrorCount: 1})
+	s.NoError(err)
+	s.mockDomainCache.On("GetDomainID", common.SystemLocalDomainName).Return(systemDomainID, nil)
+	mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: s.newBatchOperationExecutionInfo(s.testDomain),
+		PendingActivities: []*shared.PendingActivityInfo{
+			{HeartbeatDetails: heartbeatDetails},
+		},
+	}, nil).Times(1)
+
+	resp, err := wh.DescribeBatchOperation(context.Background(), &shared.DescribeBatchOperationRequest{
+		Domain: common.StringPtr(s.testDomain),
+		JobID:  common.StringPtr("job-id"),
+	})
+	s.NoError(err)
+	s.Equal(shared.BatchOperationStateRunning, resp.GetState())
+	s.Equal(shared.BatchOperationTypeTerminate, resp.GetOperationType())
+	s.Equal("test-reason", resp.GetReason())
+	s.Equal("test-operator", resp.GetIdentity())
+	s.Equal(int64(10), resp.GetTotalEstimate())
+	s.Equal(int64(3), resp.GetSuccessCount())
+	s.Equal(int64(1), resp.GetErrorCount())
+}
+
+func (s *workflowHandlerSuite) TestDescribeBatchOperation_Failed() {
+	wh := s.getWorkflowHandlerHelper()
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	wh.history = mockHistoryClient
+	systemDomainID := uuid.New()
+
+	executionInfo := s.newBatchOperationExecutionInfo(s.testDomain)
+	executionInfo.CloseStatus = shared.WorkflowExecutionCloseStatusTerminated.Ptr()
+	s.mockDomainCache.On("GetDomainID", common.SystemLocalDomainName).Return(systemDomainID, nil)
+	mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: executionInfo,
+	}, nil).Times(1)
+	mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&h.GetMutableStateResponse{
+		Execution:         executionInfo.Execution,
+		LastFirstEventId:  common.Int64Ptr(5),
+		NextEventId:       common.Int64Ptr(6),
+		EventStoreVersion: common.Int32Ptr(persistence.EventStoreVersionV2),
+		BranchToken:       []byte("job-id"),
+	}, nil).Times(1)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(5),
+				EventType: shared.EventTypeWorkflowExecutionTerminated.Ptr(),
+				WorkflowExecutionTerminatedEventAttributes: &shared.WorkflowExecutionTerminatedEventAttributes{
+					Reason: common.StringPtr("stopped by operator"),
+				},
+			},
+		},
+	}, nil).Once()
+
+	resp, err := wh.DescribeBatchOperation(context.Background(), &shared.DescribeBatchOperationRequest{
+		Domain: common.StringPtr(s.testDomain),
+		JobID:  common.StringPtr("job-id"),
+	})
+	s.NoError(err)
+	s.Equal(shared.BatchOperationStateFailed, resp.GetState())
+	s.Equal("stopped by operator", resp.GetFailureReason())
+	s.False(resp.IsSetSuccessCount())
+}
+
+func (s *workflowHandlerSuite) TestDescribeBatchOperation_OtherDomain() {
+	wh := s.getWorkflowHandlerHelper()
+	mockHistoryClient := historyservicetest.NewMockClient(s.controller)
+	wh.history = mockHistoryClient
+
+	s.mockDomainCache.On("GetDomainID", common.SystemLocalDomainName).Return(uuid.New(), nil)
+	mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: s.newBatchOperationExecutionInfo("other-domain"),
+	}, nil).Times(1)
+
+	_, err := wh.DescribeBatchOperation(context.Background(), &shared.DescribeBatchOperationRequest{
+		Domain: common.StringPtr(s.testDomain),
+		JobID:  common.StringPtr("job-id"),
+	})
+	s.Equal(errBatchOperationNotFound, err)
+}
+
+func (s *workflowHandlerSuite) newBatchOperationExecutionInfo(domain string) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("job-id"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		Type:      &shared.WorkflowType{Name: common.StringPtr(batch.BatchWFTypeName)},
+		StartTime: common.Int64Ptr(time.Now().UnixNano()),
+		Memo: &shared.Memo{Fields: map[string][]byte{
+			"Reason":    []byte(`"test-reason"`),
+			"Query":     []byte(`"WorkflowType = 'test-type'"`),
+			"BatchType": []byte(`"terminate"`),
+		}},
+		SearchAttributes: &shared.SearchAttributes{IndexedFields: map[string][]byte{
+			"CustomDomain": []byte(`"` + domain + `"`),
+			"Operator":     []byte(`"test-operator"`),
+		}},
+	}
+}
+
 func (s *workflowHandlerSuite) TestConvertIndexedKeyToThrift() {
 	wh := s.getWorkflowHandlerHelper()
 	m := map[string]interface{}{
@@ -1411,6 +2399,16 @@ func (s *workflowHandlerSuite) newConfig() *Config {
 	return NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), numHistoryShards, false)
 }
 
+func (s *workflowHandlerSuite) newOverloadController(config *Config) overload.Controller {
+	return overload.NewController(&overload.Config{
+		EnableLoadShedding:   config.EnableLoadShedding,
+		LatencyThreshold:     config.OverloadLatencyThreshold,
+		ErrorRatioThreshold:  config.OverloadErrorRatioThreshold,
+		EvaluationInterval:   config.OverloadEvaluationInterval,
+		GlobalLowPriorityRPS: config.OverloadGlobalLowPriorityRPS,
+	}, func() int { return 1 }, s.mockMetricClient, s.logger)
+}
+
 func updateRequest(
 	historyArchivalURI *string,
 	historyArchivalStatus *shared.ArchivalStatus,
@@ -1508,3 +2506,12 @@ func listArchivedWorkflowExecutionsTestRequest() *shared.ListArchivedWorkflowExe
 		Query:    common.StringPtr("some random query string"),
 	}
 }
+
+// sheddingOverloadController is an overload controller which sheds all low priority operations
+type sheddingOverloadController struct {
+	overload.Controller
+}
+
+func (c *sheddingOverloadController) ShouldShed() bool {
+	return true
+}
@@ -30,8 +30,8 @@ type MockEventsCache struct {
 	mock.Mock
 }
 
-// getEvent is mock implementation for getEvent of EventsCache
-func (_m *MockEventsCache) getEvent(domainID, workflowID, runID string, firstEventID, eventID int64, eventStoreVersion int32,
+// GetEvent is mock implementation for GetEvent of EventsCache
+func (_m *MockEventsCache) GetEvent(domainID, workflowID, runID string, firstEventID, eventID int64, eventStoreVersion int32,
 	branchToken []byte) (*shared.HistoryEvent, error) {
 	ret := _m.Called(domainID, workflowID, runID, firstEventID, eventID, eventStoreVersion, branchToken)
 
@@ -54,12 +54,12 @@ func (_m *MockEventsCache) getEvent(domainID, workflowID, runID string, firstEve
 	return r0, r1
 }
 
-// putEvent is mock implementation for putEvent of EventsCache
-func (_m *MockEventsCache) putEvent(domainID, workflowID, runID string, eventID int64, event *shared.HistoryEvent) {
+// PutEvent is mock implementation for PutEvent of EventsCache
+func (_m *MockEventsCache) PutEvent(domainID, workflowID, runID string, eventID int64, event *shared.HistoryEvent) {
 	_m.Called(domainID, workflowID, runID, eventID, event)
 }
 
-// deleteEvent is mock implementation for deleteEvent of EventsCache
-func (_m *MockEventsCache) deleteEvent(domainID, workflowID, runID string, eventID int64) {
+// DeleteEvent is mock implementation for DeleteEvent of EventsCache
+func (_m *MockEventsCache) DeleteEvent(domainID, workflowID, runID string, eventID int64) {
 	_m.Called(domainID, workflowID, runID, eventID)
 }
@@ -93,6 +93,85 @@ func (_m *MockHistoryEngine) DescribeMutableState(ctx context.Context, request *
 	return r0, r1
 }
 
+// DetectZombieWorkflowExecution is mock implementation for DetectZombieWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DetectZombieWorkflowExecution(ctx context.Context, request *gohistory.DetectZombieWorkflowExecutionRequest) (*gohistory.DetectZombieWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.DetectZombieWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.DetectZombieWorkflowExecutionRequest) *gohistory.DetectZombieWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.DetectZombieWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.DetectZombieWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWorkflowExecution is mock implementation for DeleteWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DeleteWorkflowExecution(ctx context.Context, request *gohistory.DeleteWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReindexWorkflowExecution is mock implementation for ReindexWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ReindexWorkflowExecution(ctx context.Context, request *gohistory.ReindexWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ReindexWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTerminationProtection is mock implementation for SetTerminationProtection of HistoryEngine
+func (_m *MockHistoryEngine) SetTerminationProtection(ctx context.Context, request *gohistory.SetTerminationProtectionRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.SetTerminationProtectionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecution is mock implementation for ReplicateWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ReplicateWorkflowExecution(ctx context.Context, request *gohistory.ReplicateWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ReplicateWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // QueryWorkflow is mock implementation for QueryWorkflow of HistoryEngine
 func (_m *MockHistoryEngine) QueryWorkflow(ctx context.Context, request *gohistory.QueryWorkflowRequest) (*gohistory.QueryWorkflowResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -296,6 +375,20 @@ func (_m *MockHistoryEngine) RespondActivityTaskFailed(ctx context.Context, requ
 	return r0
 }
 
+// RecordActivityTaskWorkerUnavailable is mock implementation for RecordActivityTaskWorkerUnavailable of HistoryEngine
+func (_m *MockHistoryEngine) RecordActivityTaskWorkerUnavailable(ctx context.Context, request *gohistory.RecordActivityTaskWorkerUnavailableRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RecordActivityTaskWorkerUnavailableRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RespondActivityTaskCanceled is mock implementation for RespondActivityTaskCanceled of HistoryEngine
 func (_m *MockHistoryEngine) RespondActivityTaskCanceled(ctx context.Context, request *gohistory.RespondActivityTaskCanceledRequest) error {
 	ret := _m.Called(request)
@@ -361,6 +454,20 @@ func (_m *MockHistoryEngine) SignalWorkflowExecution(ctx context.Context, reques
 	return r0
 }
 
+// AddOperatorAnnotation is mock implementation for AddOperatorAnnotation of HistoryEngine
+func (_m *MockHistoryEngine) AddOperatorAnnotation(ctx context.Context, request *gohistory.AddOperatorAnnotationRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.AddOperatorAnnotationRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SignalWithStartWorkflowExecution is mock implementation for SignalWithStartWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) SignalWithStartWorkflowExecution(ctx context.Context, request *gohistory.SignalWithStartWorkflowExecutionRequest) (
 	*shared.StartWorkflowExecutionResponse, error) {
@@ -413,6 +520,34 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(ctx context.Context, req
 	return r0
 }
 
+// PauseWorkflowExecution is mock implementation for PauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) PauseWorkflowExecution(ctx context.Context, request *gohistory.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution is mock implementation for ResumeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResumeWorkflowExecution(ctx context.Context, request *gohistory.ResumeWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ResumeWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) ResetWorkflowExecution(ctx context.Context, request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(request)
@@ -562,3 +697,42 @@ func (_m *MockHistoryEngine) GetReplicationMessages(ctx context.Context, taskID
 
 	return r0, r1
 }
+
+// GetReplicationStatus is mock implementation for GetReplicationStatus of HistoryEngine
+func (_m *MockHistoryEngine) GetReplicationStatus(ctx context.Context, domainID string) (*gohistory.ShardReplicationStatus, error) {
+	ret := _m.Called(ctx, domainID)
+
+	var r0 *gohistory.ShardReplicationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gohistory.ShardReplicationStatus); ok {
+		r0 = rf(ctx, domainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.ShardReplicationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeShard is mock implementation for DescribeShard of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShard() *shared.HistoryShardInfo {
+	ret := _m.Called()
+
+	var r0 *shared.HistoryShardInfo
+	if rf, ok := ret.Get(0).(func() *shared.HistoryShardInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryShardInfo)
+		}
+	}
+
+	return r0
+}
@@ -685,6 +685,20 @@ func (_m *mockMutableState) AddFailWorkflowEvent(_a0 int64, _a1 *shared.FailWork
 	return r0, r1
 }
 
+// AddOperatorAnnotation provides a mock function with given fields: message, identity, now
+func (_m *mockMutableState) AddOperatorAnnotation(message string, identity string, now time.Time) error {
+	ret := _m.Called(message, identity, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) error); ok {
+		r0 = rf(message, identity, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddRecordMarkerEvent provides a mock function with given fields: _a0, _a1
 func (_m *mockMutableState) AddRecordMarkerEvent(_a0 int64, _a1 *shared.RecordMarkerDecisionAttributes) (*shared.HistoryEvent, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1071,6 +1085,29 @@ func (_m *mockMutableState) AddWorkflowExecutionCanceledEvent(_a0 int64, _a1 *sh
 	return r0, r1
 }
 
+// AddWorkflowExecutionTerminationProtectionSetEvent provides a mock function with given fields: protected, identity
+func (_m *mockMutableState) AddWorkflowExecutionTerminationProtectionSetEvent(protected bool, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(protected, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(bool, string) *shared.HistoryEvent); ok {
+		r0 = rf(protected, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(bool, string) error); ok {
+		r1 = rf(protected, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddWorkflowExecutionSignaled provides a mock function with given fields: signalName, input, identity
 func (_m *mockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*shared.HistoryEvent, error) {
 	ret := _m.Called(signalName, input, identity)
@@ -1159,6 +1196,48 @@ func (_m *mockMutableState) ClearStickyness() {
 	_m.Called()
 }
 
+// ConvertToZombie provides a mock function with given fields: now
+func (_m *mockMutableState) ConvertToZombie(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PauseWorkflowExecution provides a mock function with given fields:
+func (_m *mockMutableState) PauseWorkflowExecution() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeWorkflowExecution provides a mock function with given fields: now
+func (_m *mockMutableState) ResumeWorkflowExecution(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CopyToPersistence provides a mock function with given fields:
 func (_m *mockMutableState) CopyToPersistence() *persistence.WorkflowMutableState {
 	ret := _m.Called()
@@ -2078,6 +2157,34 @@ func (_m *mockMutableState) IsStickyTaskListEnabled() bool {
 	return r0
 }
 
+// IsWorkflowExecutionPaused provides a mock function with given fields:
+func (_m *mockMutableState) IsWorkflowExecutionPaused() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsWorkflowExecutionTerminationProtected provides a mock function with given fields:
+func (_m *mockMutableState) IsWorkflowExecutionTerminationProtected() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsWorkflowExecutionRunning provides a mock function with given fields:
 func (_m *mockMutableState) IsWorkflowExecutionRunning() bool {
 	ret := _m.Called()
@@ -2678,6 +2785,11 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionFailedEvent(_a0 int64, _a1
 	return r0
 }
 
+// ReplicateWorkflowExecutionTerminationProtectionSetEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionTerminationProtectionSetEvent(_a0 *shared.HistoryEvent) {
+	_m.Called(_a0)
+}
+
 // ReplicateWorkflowExecutionSignaled provides a mock function with given fields: _a0
 func (_m *mockMutableState) ReplicateWorkflowExecutionSignaled(_a0 *shared.HistoryEvent) error {
 	ret := _m.Called(_a0)
@@ -2753,6 +2865,20 @@ func (_m *mockMutableState) SetHistoryTree(treeID string) error {
 	return r0
 }
 
+// StartReplication provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) StartReplication(_a0 int64, _a1 cache.ReplicationPolicy) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, cache.ReplicationPolicy) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateActivity provides a mock function with given fields: _a0
 func (_m *mockMutableState) UpdateActivity(_a0 *persistence.ActivityInfo) error {
 	ret := _m.Called(_a0)
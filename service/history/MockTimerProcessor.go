@@ -31,6 +31,7 @@ type MockTimerProcessor struct {
 }
 
 var _ timerProcessor = (*MockTimerProcessor)(nil)
+var _ taskParker = (*MockTimerProcessor)(nil)
 
 // notifyNewTimers is mock implementation for notifyNewTimers of timerProcessor
 func (_m *MockTimerProcessor) notifyNewTimers(timerTask []persistence.Task) {
@@ -63,6 +64,16 @@ func (_m *MockTimerProcessor) complete(timerTask queueTaskInfo) {
 	_m.Called(timerTask)
 }
 
+// park is mock implementation for park of taskParker
+func (_m *MockTimerProcessor) park(timerTask queueTaskInfo) {
+	_m.Called(timerTask)
+}
+
+// unpark is mock implementation for unpark of taskParker
+func (_m *MockTimerProcessor) unpark(timerTask queueTaskInfo) {
+	_m.Called(timerTask)
+}
+
 // getTaskFilter is mock implementation for process of timerProcessor
 func (_m *MockTimerProcessor) getTaskFilter() queueTaskFilter {
 	ret := _m.Called()
@@ -49,6 +49,43 @@ func (_m *MockTimerQueueAckMgr) getFinishedChan() <-chan struct{} {
 	return r0
 }
 
+// readTimerWheelTasks is mock implementation for readTimerWheelTasks of TimerQueueAckMgr
+func (_m *MockTimerQueueAckMgr) readTimerWheelTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, bool) {
+	ret := _m.Called()
+
+	var r0 []*persistence.TimerTaskInfo
+	if rf, ok := ret.Get(0).(func() []*persistence.TimerTaskInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*persistence.TimerTaskInfo)
+		}
+	}
+
+	var r1 *persistence.TimerTaskInfo
+	if rf, ok := ret.Get(1).(func() *persistence.TimerTaskInfo); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*persistence.TimerTaskInfo)
+		}
+	}
+
+	var r2 bool
+	if rf, ok := ret.Get(2).(func() bool); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
+
+// notifyNewTimer is mock implementation for notifyNewTimer of TimerQueueAckMgr
+func (_m *MockTimerQueueAckMgr) notifyNewTimer(newTime time.Time) {
+	_m.Called(newTime)
+}
+
 // readTimerTasks is mock implementation for readTimerTasks of TimerQueueAckMgr
 func (_m *MockTimerQueueAckMgr) readTimerTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, bool, error) {
 	ret := _m.Called()
@@ -92,6 +129,14 @@ func (_m *MockTimerQueueAckMgr) completeTimerTask(timerTask *persistence.TimerTa
 	_m.Called(timerTask)
 }
 
+func (_m *MockTimerQueueAckMgr) parkTimerTask(timerTask *persistence.TimerTaskInfo) {
+	_m.Called(timerTask)
+}
+
+func (_m *MockTimerQueueAckMgr) unparkTimerTask(timerTask *persistence.TimerTaskInfo) {
+	_m.Called(timerTask)
+}
+
 func (_m *MockTimerQueueAckMgr) getAckLevel() TimerSequenceID {
 	ret := _m.Called()
 
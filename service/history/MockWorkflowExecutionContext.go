@@ -118,20 +118,22 @@ func (_m *mockWorkflowExecutionContext) unlock() {
 	_m.Called()
 }
 
-func (_m *mockWorkflowExecutionContext) getHistorySize() int64 {
+func (_m *mockWorkflowExecutionContext) getExecutionStats() *persistence.ExecutionStats {
 	ret := _m.Called()
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
+	var r0 *persistence.ExecutionStats
+	if rf, ok := ret.Get(0).(func() *persistence.ExecutionStats); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ExecutionStats)
+		}
 	}
 
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) setHistorySize(_a0 int64) {
+func (_m *mockWorkflowExecutionContext) setExecutionStats(_a0 *persistence.ExecutionStats) {
 	_m.Called(_a0)
 }
 
@@ -179,11 +181,11 @@ func (_m *mockWorkflowExecutionContext) loadExecutionStats() (*persistence.Execu
 	return r0, r1
 }
 
-func (_m *mockWorkflowExecutionContext) conflictResolveWorkflowExecution(_a0 time.Time, _a1 string, _a2 int64, _a3 int, _a4 mutableState, _a5 int64) (mutableState, error) {
+func (_m *mockWorkflowExecutionContext) conflictResolveWorkflowExecution(_a0 time.Time, _a1 string, _a2 int64, _a3 int, _a4 mutableState, _a5 *persistence.ExecutionStats) (mutableState, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 mutableState
-	if rf, ok := ret.Get(0).(func(time.Time, string, int64, int, mutableState, int64) mutableState); ok {
+	if rf, ok := ret.Get(0).(func(time.Time, string, int64, int, mutableState, *persistence.ExecutionStats) mutableState); ok {
 		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		if ret.Get(0) != nil {
@@ -192,7 +194,7 @@ func (_m *mockWorkflowExecutionContext) conflictResolveWorkflowExecution(_a0 tim
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(time.Time, string, int64, int, mutableState, int64) error); ok {
+	if rf, ok := ret.Get(1).(func(time.Time, string, int64, int, mutableState, *persistence.ExecutionStats) error); ok {
 		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r1 = ret.Error(1)
@@ -201,10 +203,10 @@ func (_m *mockWorkflowExecutionContext) conflictResolveWorkflowExecution(_a0 tim
 	return r0, r1
 }
 
-func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 mutableState, _a1 bool, _a2, _a3 persistence.Task, _a4 mutableState, _a5 int64, _a6, _a7, _a8, _a9 []persistence.Task, _a10 string, _a11 int64) error {
+func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 mutableState, _a1 bool, _a2, _a3 persistence.Task, _a4 mutableState, _a5 *persistence.ExecutionStats, _a6, _a7, _a8, _a9 []persistence.Task, _a10 string, _a11 int64) error {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a9, _a10, _a11)
 	var r0 error
-	if rf, ok := ret.Get(1).(func(mutableState, bool, persistence.Task, persistence.Task, mutableState, int64, []persistence.Task, []persistence.Task, []persistence.Task, []persistence.Task, string, int64) error); ok {
+	if rf, ok := ret.Get(1).(func(mutableState, bool, persistence.Task, persistence.Task, mutableState, *persistence.ExecutionStats, []persistence.Task, []persistence.Task, []persistence.Task, []persistence.Task, string, int64) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6, _a7, _a8, _a9, _a10, _a11)
 	} else {
 		r0 = ret.Error(1)
@@ -213,12 +215,12 @@ func (_m *mockWorkflowExecutionContext) resetWorkflowExecution(_a0 mutableState,
 	return r0
 }
 
-func (_m *mockWorkflowExecutionContext) createWorkflowExecution(_a0 *persistence.WorkflowSnapshot, _a1 int64, _a2 time.Time, _a3 int, _a4 string, _a5 int64) error {
+func (_m *mockWorkflowExecutionContext) createWorkflowExecution(_a0 *persistence.WorkflowSnapshot, _a1 *persistence.ExecutionStats, _a2 time.Time, _a3 int, _a4 string, _a5 int64) error {
 
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*persistence.WorkflowSnapshot, int64, time.Time, int, string, int64) error); ok {
+	if rf, ok := ret.Get(0).(func(*persistence.WorkflowSnapshot, *persistence.ExecutionStats, time.Time, int, string, int64) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
 	} else {
 		r0 = ret.Error(0)
@@ -279,6 +281,19 @@ func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithNewAsPassive(
 	return r0
 }
 
+func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionAsZombie(_a0 time.Time) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 func (_m *mockWorkflowExecutionContext) updateWorkflowExecutionWithNew(_a0 time.Time, _a1 workflowExecutionContext, _a2 mutableState, _a3 transactionPolicy, _a4 *transactionPolicy) error {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// activity type used by client side sessions to create a session
+	sessionCreationActivityType = "internalSessionCreationActivity"
+)
+
+type (
+	openSessionInfo struct {
+		SessionID string                        `json:"sessionID"`
+		TaskList  string                        `json:"taskList"`
+		HostName  string                        `json:"hostName,omitempty"`
+		State     workflow.PendingActivityState `json:"state"`
+		StartTime *time.Time                    `json:"startTime,omitempty"`
+	}
+)
+
+// answerBuiltInQuery answers the built-in query directly from mutable state, the result is JSON encoded
+func (e *historyEngineImpl) answerBuiltInQuery(
+	domainID string,
+	msBuilder mutableState,
+	queryType string,
+) ([]byte, error) {
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	switch queryType {
+	case common.QueryTypeQueryTypes:
+		queryTypes := executionInfo.QueryTypes
+		if queryTypes == nil {
+			queryTypes = []string{}
+		}
+		return json.Marshal(queryTypes)
+	case common.QueryTypeStartTime:
+		return json.Marshal(executionInfo.StartTimestamp)
+	case common.QueryTypePendingActivities:
+		pendingActivities := []*workflow.PendingActivityInfo{}
+		for _, ai := range msBuilder.GetPendingActivityInfos() {
+			p, err := e.getPendingActivityInfo(domainID, msBuilder, ai)
+			if err != nil {
+				return nil, err
+			}
+			pendingActivities = append(pendingActivities, p)
+		}
+		return json.Marshal(pendingActivities)
+	case common.QueryTypeOpenSessions:
+		openSessions := []*openSessionInfo{}
+		for _, ai := range msBuilder.GetPendingActivityInfos() {
+			p, err := e.getPendingActivityInfo(domainID, msBuilder, ai)
+			if err != nil {
+				return nil, err
+			}
+			if p.ActivityType.GetName() != sessionCreationActivityType {
+				continue
+			}
+			session := &openSessionInfo{
+				SessionID: ai.ActivityID,
+				TaskList:  ai.TaskList,
+				HostName:  ai.StartedIdentity,
+				State:     p.GetState(),
+			}
+			if ai.StartedID != common.EmptyEventID {
+				startTime := ai.StartedTime
+				session.StartTime = &startTime
+			}
+			openSessions = append(openSessions, session)
+		}
+		return json.Marshal(openSessions)
+	default:
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("%v is not a built-in query type", queryType)}
+	}
+}
@@ -87,8 +87,8 @@ func (r *conflictResolverImpl) reset(
 	var resetMutableStateBuilder *mutableStateBuilder
 	var sBuilder stateBuilder
 	var history []*shared.HistoryEvent
-	var totalSize int64
 	var err error
+	stats := &persistence.ExecutionStats{}
 
 	eventsToApply := replayNextEventID - common.FirstEventID
 	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
@@ -135,7 +135,7 @@ func (r *conflictResolverImpl) reset(
 			r.logError("Conflict resolution err applying events.", err)
 			return nil, err
 		}
-		totalSize += int64(size)
+		updateExecutionStats(stats, history, int64(size))
 	}
 
 	if resetMutableStateBuilder == nil {
@@ -174,7 +174,7 @@ func (r *conflictResolverImpl) reset(
 		prevLastWriteVersion,
 		prevState,
 		resetMutableStateBuilder,
-		totalSize,
+		stats,
 	)
 	if err != nil {
 		r.logError("Conflict resolution err reset workflow.", err)
@@ -193,6 +193,8 @@ func (r *conflictResolverImpl) getHistory(domainID string, execution shared.Work
 			PageSize:      defaultHistoryPageSize,
 			NextPageToken: nextPageToken,
 			ShardID:       common.IntPtr(r.shard.GetShardID()),
+			DomainID:      domainID,
+			WorkflowID:    execution.GetWorkflowId(),
 		})
 		if err != nil {
 			return nil, 0, 0, nil, err
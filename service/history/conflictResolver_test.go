@@ -265,6 +265,8 @@ func (s *conflictResolverSuite) TestReset() {
 		PageSize:      defaultHistoryPageSize,
 		NextPageToken: nil,
 		ShardID:       common.IntPtr(s.mockShard.GetShardID()),
+		DomainID:      domainID,
+		WorkflowID:    execution.GetWorkflowId(),
 	}).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents:    []*shared.HistoryEvent{event1, event2},
 		NextPageToken:    nil,
@@ -325,6 +327,7 @@ func (s *conflictResolverSuite) TestReset() {
 				ExecutionInfo: executionInfo,
 				ExecutionStats: &persistence.ExecutionStats{
 					HistorySize: historySize,
+					EventCount:  nextEventID - common.FirstEventID,
 				},
 				ReplicationState: &persistence.ReplicationState{
 					CurrentVersion:   event1.GetVersion(),
@@ -367,7 +370,7 @@ func (s *conflictResolverSuite) TestReset() {
 	s.mockDomainCache.On("GetDomainByID", mock.Anything).Return(cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{ID: domainID}, &persistence.DomainConfig{}, "", nil,
 	), nil)
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 
 	_, err := s.conflictResolver.reset(prevRunID, prevLastWriteVersion, prevState, createRequestID, nextEventID-1, executionInfo, s.mockContext.updateCondition)
 	s.Nil(err)
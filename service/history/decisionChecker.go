@@ -34,12 +34,14 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	decisionAttrValidator struct {
 		domainCache               cache.DomainCache
 		maxIDLengthLimit          int
+		enforceKnownTaskLists     dynamicconfig.BoolPropertyFnWithDomainFilter
 		searchAttributesValidator *validator.SearchAttributesValidator
 	}
 
@@ -71,8 +73,9 @@ func newDecisionAttrValidator(
 	logger log.Logger,
 ) *decisionAttrValidator {
 	return &decisionAttrValidator{
-		domainCache:      domainCache,
-		maxIDLengthLimit: config.MaxIDLengthLimit(),
+		domainCache:           domainCache,
+		maxIDLengthLimit:      config.MaxIDLengthLimit(),
+		enforceKnownTaskLists: config.EnforceKnownTaskLists,
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			logger,
 			config.ValidSearchAttributes,
@@ -204,6 +207,10 @@ func (v *decisionAttrValidator) validateActivityScheduleAttributes(
 		return err
 	}
 
+	if err := v.validateKnownTaskList(targetDomainID, attributes.TaskList.GetName()); err != nil {
+		return err
+	}
+
 	if attributes.GetActivityId() == "" {
 		return &workflow.BadRequestError{Message: "ActivityId is not set on decision."}
 	}
@@ -234,6 +241,13 @@ func (v *decisionAttrValidator) validateActivityScheduleAttributes(
 		return &workflow.BadRequestError{Message: "A valid timeout may not be negative."}
 	}
 
+	if attributes.GetDelayStartSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "DelayStartSeconds may not be negative."}
+	}
+	if attributes.GetDelayStartSeconds() > wfTimeout {
+		attributes.DelayStartSeconds = common.Int32Ptr(wfTimeout)
+	}
+
 	// ensure activity timeout never larger than workflow timeout
 	if attributes.GetScheduleToCloseTimeoutSeconds() > wfTimeout {
 		attributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(wfTimeout)
@@ -465,6 +479,7 @@ func (v *decisionAttrValidator) validateSignalExternalWorkflowExecutionAttribute
 func (v *decisionAttrValidator) validateUpsertWorkflowSearchAttributes(
 	domainName string,
 	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes,
+	currentSearchAttributes map[string][]byte,
 ) error {
 
 	if attributes == nil {
@@ -479,7 +494,11 @@ func (v *decisionAttrValidator) validateUpsertWorkflowSearchAttributes(
 		return &workflow.BadRequestError{Message: "IndexedFields is empty on decision."}
 	}
 
-	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
+	return v.searchAttributesValidator.ValidateUpsertSearchAttributes(
+		currentSearchAttributes,
+		attributes.GetSearchAttributes(),
+		domainName,
+	)
 }
 
 func (v *decisionAttrValidator) validateContinueAsNewWorkflowExecutionAttributes(
@@ -507,6 +526,10 @@ func (v *decisionAttrValidator) validateContinueAsNewWorkflowExecutionAttributes
 	}
 	attributes.TaskList = taskList
 
+	if err := v.validateKnownTaskList(executionInfo.DomainID, taskList.GetName()); err != nil {
+		return err
+	}
+
 	// Inherit workflow timeout from previous execution if not provided on decision
 	if attributes.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
 		attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(executionInfo.WorkflowTimeout)
@@ -582,6 +605,10 @@ func (v *decisionAttrValidator) validateStartChildExecutionAttributes(
 	}
 	attributes.TaskList = taskList
 
+	if err := v.validateKnownTaskList(targetDomainID, taskList.GetName()); err != nil {
+		return err
+	}
+
 	// Inherit workflow timeout from parent workflow execution if not provided on decision
 	if attributes.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
 		attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(parentInfo.WorkflowTimeout)
@@ -628,6 +655,25 @@ func (v *decisionAttrValidator) validatedTaskList(
 	return taskList, nil
 }
 
+// validateKnownTaskList rejects task lists not declared by the domain when enforcement is enabled for it
+func (v *decisionAttrValidator) validateKnownTaskList(
+	domainID string,
+	taskList string,
+) error {
+
+	domainEntry, err := v.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+	domainName := domainEntry.GetInfo().Name
+	if !v.enforceKnownTaskLists(domainName) || domainEntry.IsTaskListKnown(taskList) {
+		return nil
+	}
+	return &workflow.BadRequestError{
+		Message: fmt.Sprintf("task list %v is not a known task list of domain %v", taskList, domainName),
+	}
+}
+
 func (v *decisionAttrValidator) validateCrossDomainCall(
 	domainID string,
 	targetDomainID string,
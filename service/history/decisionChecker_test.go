@@ -69,6 +69,7 @@ func (s *decisionAttrValidatorSuite) SetupTest() {
 		SearchAttributesNumberOfKeysLimit: dynamicconfig.GetIntPropertyFilteredByDomain(100),
 		SearchAttributesSizeOfValueLimit:  dynamicconfig.GetIntPropertyFilteredByDomain(2 * 1024),
 		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFilteredByDomain(40 * 1024),
+		EnforceKnownTaskLists:             dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
 	}
 	s.validator = newDecisionAttrValidator(
 		s.mockDomainCache,
@@ -130,19 +131,19 @@ func (s *decisionAttrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes(
 	domainName := "testDomain"
 	var attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes
 
-	err := s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	err := s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes, nil)
 	s.EqualError(err, "BadRequestError{Message: UpsertWorkflowSearchAttributesDecisionAttributes is not set on decision.}")
 
 	attributes = &workflow.UpsertWorkflowSearchAttributesDecisionAttributes{}
-	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes, nil)
 	s.EqualError(err, "BadRequestError{Message: SearchAttributes is not set on decision.}")
 
 	attributes.SearchAttributes = &workflow.SearchAttributes{}
-	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes, nil)
 	s.EqualError(err, "BadRequestError{Message: IndexedFields is empty on decision.}")
 
 	attributes.SearchAttributes.IndexedFields = map[string][]byte{"CustomKeywordField": []byte(`bytes`)}
-	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes, nil)
 	s.Nil(err)
 }
 
@@ -534,3 +535,22 @@ func (s *decisionAttrValidatorSuite) TestValidateTaskListName() {
 		})
 	}
 }
+
+func (s *decisionAttrValidatorSuite) TestValidateKnownTaskList() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		&persistence.DomainConfig{KnownTaskLists: []string{"tl-1"}},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.On("GetDomainByID", s.testDomainID).Return(domainEntry, nil)
+
+	err := s.validator.validateKnownTaskList(s.testDomainID, "tl-2")
+	s.NoError(err)
+
+	s.validator.enforceKnownTaskLists = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	err = s.validator.validateKnownTaskList(s.testDomainID, "tl-1")
+	s.NoError(err)
+	err = s.validator.validateKnownTaskList(s.testDomainID, "tl-2")
+	s.IsType(&workflow.BadRequestError{}, err)
+}
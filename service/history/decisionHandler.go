@@ -23,6 +23,7 @@ package history
 import (
 	ctx "context"
 	"fmt"
+	"strings"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
@@ -153,8 +154,12 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 	requestID := req.GetRequestId()
 
 	var resp *h.RecordDecisionTaskStartedResponse
+	var decisionStarted bool
+	var workflowType string
+	var scheduleToStartLatency time.Duration
 	err = handler.historyEngine.updateWorkflowExecutionWithAction(ctx, domainID, execution,
 		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			decisionStarted = false
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
 			}
@@ -193,12 +198,26 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 				return nil, &h.EventAlreadyStartedError{Message: "Decision task already started."}
 			}
 
+			if msBuilder.IsWorkflowExecutionPaused() {
+				// the task is dropped by matching and dispatched again once the workflow is resumed
+				return nil, ErrWorkflowPaused
+			}
+
+			if handler.config.EnableDecisionBinaryChecksumCheck(domainEntry.GetInfo().Name) {
+				if err := handler.checkBinaryChecksumCompatible(domainEntry, msBuilder, req.PollRequest); err != nil {
+					return nil, err
+				}
+			}
+
 			_, decision, err = msBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, req.PollRequest)
 			if err != nil {
 				// Unable to add DecisionTaskStarted event to history
 				return nil, &workflow.InternalServiceError{Message: "Unable to add DecisionTaskStarted event to history."}
 			}
 
+			decisionStarted = true
+			workflowType = msBuilder.GetExecutionInfo().WorkflowTypeName
+			scheduleToStartLatency = time.Duration(decision.StartedTimestamp - decision.ScheduledTimestamp)
 			resp = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, decision, req.PollRequest.GetIdentity())
 			return updateAction, nil
 		})
@@ -206,6 +225,16 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 	if err != nil {
 		return nil, err
 	}
+	if decisionStarted {
+		emitWorkflowTypeLatency(
+			handler.metricsClient,
+			handler.config,
+			domainEntry.GetInfo().Name,
+			workflowType,
+			metrics.DecisionScheduleToStartLatency,
+			scheduleToStartLatency,
+		)
+	}
 	return resp, nil
 }
 
@@ -379,6 +408,9 @@ Update_History_Loop:
 		executionInfo.ClientLibraryVersion = clientLibVersion
 		executionInfo.ClientFeatureVersion = clientFeatureVersion
 		executionInfo.ClientImpl = clientImpl
+		if request.QueryTypes != nil {
+			executionInfo.QueryTypes = request.QueryTypes
+		}
 
 		binChecksum := request.GetBinaryChecksum()
 		if _, ok := domainEntry.GetConfig().BadBinaries.Binaries[binChecksum]; ok {
@@ -547,6 +579,17 @@ Update_History_Loop:
 			return nil, updateErr
 		}
 
+		if !decisionHeartbeatTimeout {
+			emitWorkflowTypeLatency(
+				handler.metricsClient,
+				handler.config,
+				domainEntry.GetInfo().Name,
+				executionInfo.WorkflowTypeName,
+				metrics.DecisionStartToCloseLatency,
+				time.Duration(completedEvent.GetTimestamp()-currentDecision.StartedTimestamp),
+			)
+		}
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, &workflow.EntityNotExistsError{
@@ -568,6 +611,60 @@ Update_History_Loop:
 	return nil, ErrMaxAttemptsExceeded
 }
 
+func (handler *decisionHandlerImpl) checkBinaryChecksumCompatible(
+	domainEntry *cache.DomainCacheEntry,
+	msBuilder mutableState,
+	pollRequest *workflow.PollForDecisionTaskRequest,
+) error {
+
+	lastChecksum := msBuilder.GetExecutionInfo().LastDecisionBinaryChecksum
+	pollerChecksum := pollRequest.GetBinaryChecksum()
+	if _, ok := domainEntry.GetConfig().BadBinaries.Binaries[lastChecksum]; ok {
+		// the worker which completed the last decision is marked as bad, any worker can take over
+		return nil
+	}
+
+	compatibleChecksums := handler.config.CompatibleDecisionBinaryChecksums(domainEntry.GetInfo().Name)
+	if isBinaryChecksumCompatible(lastChecksum, pollerChecksum, compatibleChecksums) {
+		return nil
+	}
+
+	handler.throttledLogger.Info("Decision task rejected for incompatible binary checksum.",
+		tag.WorkflowDomainID(domainEntry.GetInfo().ID),
+		tag.WorkflowID(msBuilder.GetExecutionInfo().WorkflowID),
+		tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+		tag.WorkflowBinaryChecksum(pollerChecksum),
+	)
+	return &h.IncompatibleBinaryChecksumError{
+		Message: common.StringPtr(fmt.Sprintf(
+			"Binary checksum %v is not compatible with binary checksum %v of the last decision.", pollerChecksum, lastChecksum,
+		)),
+		RequiredBinaryChecksum: common.StringPtr(lastChecksum),
+	}
+}
+
+// isBinaryChecksumCompatible returns whether a poller with the given binary checksum can process the next decision of
+// a workflow whose last decision was completed by the given binary checksum. Workers which do not report a binary
+// checksum are always considered compatible.
+func isBinaryChecksumCompatible(lastChecksum, pollerChecksum, compatibleChecksums string) bool {
+	if lastChecksum == "" || pollerChecksum == "" || lastChecksum == pollerChecksum {
+		return true
+	}
+
+	for _, group := range strings.Split(compatibleChecksums, ";") {
+		var hasLast, hasPoller bool
+		for _, checksum := range strings.Split(group, ",") {
+			checksum = strings.TrimSpace(checksum)
+			hasLast = hasLast || checksum == lastChecksum
+			hasPoller = hasPoller || checksum == pollerChecksum
+		}
+		if hasLast && hasPoller {
+			return true
+		}
+	}
+	return false
+}
+
 func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 	domainID string,
 	msBuilder mutableState,
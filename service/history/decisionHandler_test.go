@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	decisionHandlerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestDecisionHandlerSuite(t *testing.T) {
+	s := new(decisionHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *decisionHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *decisionHandlerSuite) TestIsBinaryChecksumCompatible() {
+	testCases := []struct {
+		lastChecksum        string
+		pollerChecksum      string
+		compatibleChecksums string
+		compatible          bool
+	}{
+		// workers which do not report a binary checksum are always compatible
+		{lastChecksum: "", pollerChecksum: "v2", compatibleChecksums: "", compatible: true},
+		{lastChecksum: "v1", pollerChecksum: "", compatibleChecksums: "", compatible: true},
+		{lastChecksum: "v1", pollerChecksum: "v1", compatibleChecksums: "", compatible: true},
+		{lastChecksum: "v1", pollerChecksum: "v2", compatibleChecksums: "", compatible: false},
+		{lastChecksum: "v1", pollerChecksum: "v2", compatibleChecksums: "v1,v2", compatible: true},
+		{lastChecksum: "v2", pollerChecksum: "v1", compatibleChecksums: " v1 , v2 ", compatible: true},
+		{lastChecksum: "v1", pollerChecksum: "v3", compatibleChecksums: "v1,v2", compatible: false},
+		// both checksums have to be in the same group
+		{lastChecksum: "v1", pollerChecksum: "v3", compatibleChecksums: "v1,v2;v3,v4", compatible: false},
+		{lastChecksum: "v3", pollerChecksum: "v4", compatibleChecksums: "v1,v2;v3,v4", compatible: true},
+		// checksums are matched exactly
+		{lastChecksum: "v1", pollerChecksum: "v10", compatibleChecksums: "v1,v1x", compatible: false},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.compatible, isBinaryChecksumCompatible(tc.lastChecksum, tc.pollerChecksum, tc.compatibleChecksums),
+			"last: %v, poller: %v, compatible: %v", tc.lastChecksum, tc.pollerChecksum, tc.compatibleChecksums)
+	}
+}
@@ -22,8 +22,7 @@ package history
 
 import (
 	"fmt"
-
-	"github.com/pborman/uuid"
+	"time"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -34,6 +33,11 @@ import (
 	"github.com/uber/cadence/common/metrics"
 )
 
+const (
+	// localActivityMarkerName is the marker name client libraries use to record local activity results
+	localActivityMarkerName = "LocalActivity"
+)
+
 type (
 	timerBuilderProvider func() *timerBuilder
 
@@ -55,6 +59,8 @@ type (
 		continueAsNewBuilder              mutableState
 		stopProcessing                    bool // should stop processing any more decisions
 		mutableState                      mutableState
+		localActivityMarkerCount          int
+		localActivityMarkerSize           int
 
 		// validation
 		attrValidator    *decisionAttrValidator
@@ -603,7 +609,7 @@ func (handler *decisionTaskHandlerImpl) handleDecisionRequestCancelExternalWorkf
 		return err
 	}
 
-	cancelRequestID := uuid.New()
+	cancelRequestID := handler.config.IDGenerator.RequestID()
 	_, _, err := handler.mutableState.AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
 		handler.decisionTaskCompletedID, cancelRequestID, attr,
 	)
@@ -637,10 +643,46 @@ func (handler *decisionTaskHandlerImpl) handleDecisionRecordMarker(
 		return err
 	}
 
+	if attr.GetMarkerName() == localActivityMarkerName {
+		if err := handler.handleLocalActivityMarker(attr); err != nil || handler.stopProcessing {
+			return err
+		}
+	}
+
 	_, err = handler.mutableState.AddRecordMarkerEvent(handler.decisionTaskCompletedID, attr)
 	return err
 }
 
+func (handler *decisionTaskHandlerImpl) handleLocalActivityMarker(
+	attr *workflow.RecordMarkerDecisionAttributes,
+) error {
+
+	domainName := handler.domainEntry.GetInfo().Name
+	size := len(attr.Details)
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domainName),
+	)
+	scope.IncCounter(metrics.LocalActivityMarkerCounter)
+	scope.RecordTimer(metrics.LocalActivityMarkerSize, time.Duration(size))
+
+	handler.localActivityMarkerCount++
+	handler.localActivityMarkerSize += size
+	if handler.localActivityMarkerCount > handler.config.LocalActivityMarkerCountLimitPerDecision(domainName) ||
+		handler.localActivityMarkerSize > handler.config.LocalActivityMarkerSizeLimitPerDecision(domainName) {
+		scope.IncCounter(metrics.LocalActivityMarkerLimitExceededCounter)
+		return handler.handlerFailDecision(
+			workflow.DecisionTaskFailedCauseBadRecordMarkerAttributes,
+			fmt.Sprintf(
+				"Local activity markers exceed per decision limit, count: %v, size: %v.",
+				handler.localActivityMarkerCount,
+				handler.localActivityMarkerSize,
+			),
+		)
+	}
+	return nil
+}
+
 func (handler *decisionTaskHandlerImpl) handleDecisionContinueAsNewWorkflow(
 	attr *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes,
 ) error {
@@ -778,7 +820,7 @@ func (handler *decisionTaskHandlerImpl) handleDecisionStartChildWorkflow(
 		}
 	}
 
-	requestID := uuid.New()
+	requestID := handler.config.IDGenerator.RequestID()
 	_, _, err = handler.mutableState.AddStartChildWorkflowExecutionInitiatedEvent(
 		handler.decisionTaskCompletedID, requestID, attr,
 	)
@@ -829,7 +871,7 @@ func (handler *decisionTaskHandlerImpl) handleDecisionSignalExternalWorkflow(
 		return err
 	}
 
-	signalRequestID := uuid.New() // for deduplicate
+	signalRequestID := handler.config.IDGenerator.RequestID() // for deduplicate
 	_, _, err = handler.mutableState.AddSignalExternalWorkflowExecutionInitiatedEvent(
 		handler.decisionTaskCompletedID, signalRequestID, attr,
 	)
@@ -862,6 +904,7 @@ func (handler *decisionTaskHandlerImpl) handleDecisionUpsertWorkflowSearchAttrib
 			return handler.attrValidator.validateUpsertWorkflowSearchAttributes(
 				domainName,
 				attr,
+				handler.mutableState.GetExecutionInfo().SearchAttributes,
 			)
 		},
 		workflow.DecisionTaskFailedCauseBadSearchAttributes,
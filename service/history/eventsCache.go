@@ -21,11 +21,13 @@
 package history
 
 import (
+	"hash/fnv"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -33,11 +35,12 @@ import (
 )
 
 type (
-	eventsCache interface {
-		getEvent(domainID, workflowID, runID string, firstEventID, eventID int64, eventStoreVersion int32,
+	// EventsCache caches the history events used to build mutable state
+	EventsCache interface {
+		GetEvent(domainID, workflowID, runID string, firstEventID, eventID int64, eventStoreVersion int32,
 			branchToken []byte) (*shared.HistoryEvent, error)
-		putEvent(domainID, workflowID, runID string, eventID int64, event *shared.HistoryEvent)
-		deleteEvent(domainID, workflowID, runID string, eventID int64)
+		PutEvent(domainID, workflowID, runID string, eventID int64, event *shared.HistoryEvent)
+		DeleteEvent(domainID, workflowID, runID string, eventID int64)
 	}
 
 	eventsCacheImpl struct {
@@ -62,20 +65,26 @@ var (
 	errEventNotFoundInBatch = &shared.InternalServiceError{Message: "History event not found within expected batch"}
 )
 
-var _ eventsCache = (*eventsCacheImpl)(nil)
+var _ EventsCache = (*eventsCacheImpl)(nil)
 
-func newEventsCache(shardCtx ShardContext) eventsCache {
+func newEventsCache(shardCtx ShardContext) EventsCache {
 	config := shardCtx.GetConfig()
 	shardID := common.IntPtr(shardCtx.GetShardID())
 	return newEventsCacheWithOptions(config.EventsCacheInitialSize(), config.EventsCacheMaxSize(), config.EventsCacheTTL(),
-		shardCtx.GetHistoryManager(), shardCtx.GetHistoryV2Manager(), false, shardCtx.GetLogger(), shardCtx.GetMetricsClient(), shardID)
+		config.EventsCacheMaxSizeInBytes(), config.EventsCacheShardCount(), shardCtx.GetHistoryManager(),
+		shardCtx.GetHistoryV2Manager(), false, shardCtx.GetLogger(), shardCtx.GetMetricsClient(), shardID)
 }
 
-func newEventsCacheWithOptions(initialSize, maxSize int, ttl time.Duration, eventsMgr persistence.HistoryManager,
-	eventsV2Mgr persistence.HistoryV2Manager, disabled bool, logger log.Logger, metrics metrics.Client, shardID *int) *eventsCacheImpl {
+func newEventsCacheWithOptions(initialSize, maxSize int, ttl time.Duration, maxSizeInBytes, shardCount int,
+	eventsMgr persistence.HistoryManager, eventsV2Mgr persistence.HistoryV2Manager, disabled bool, logger log.Logger,
+	metrics metrics.Client, shardID *int) *eventsCacheImpl {
 	opts := &cache.Options{}
 	opts.InitialCapacity = initialSize
 	opts.TTL = ttl
+	opts.MaxBytes = maxSizeInBytes
+	opts.SizeFunc = newEventSizeFunc()
+	opts.Shards = shardCount
+	opts.KeyHashFunc = hashEventKey
 
 	return &eventsCacheImpl{
 		Cache:         cache.New(maxSize, opts),
@@ -97,7 +106,28 @@ func newEventKey(domainID, workflowID, runID string, eventID int64) eventKey {
 	}
 }
 
-func (e *eventsCacheImpl) getEvent(domainID, workflowID, runID string, firstEventID, eventID int64, eventStoreVersion int32,
+// hashEventKey spreads the events of a workflow across the partitions of the cache
+func hashEventKey(key interface{}) uint32 {
+	eventKey := key.(eventKey)
+	hash := fnv.New32a()
+	hash.Write([]byte(eventKey.runID))
+	return hash.Sum32() + uint32(eventKey.eventID)
+}
+
+// newEventSizeFunc returns the function estimating the size of a cached event by its serialized size,
+// it is only invoked when the events cache is limited by size in bytes
+func newEventSizeFunc() cache.SizeFunc {
+	encoder := codec.NewThriftRWEncoder()
+	return func(value interface{}) int {
+		data, err := encoder.Encode(value.(*shared.HistoryEvent))
+		if err != nil {
+			return 0
+		}
+		return len(data)
+	}
+}
+
+func (e *eventsCacheImpl) GetEvent(domainID, workflowID, runID string, firstEventID, eventID int64, eventStoreVersion int32,
 	branchToken []byte) (*shared.HistoryEvent, error) {
 	e.metricsClient.IncCounter(metrics.EventsCacheGetEventScope, metrics.CacheRequests)
 	sw := e.metricsClient.StartTimer(metrics.EventsCacheGetEventScope, metrics.CacheLatency)
@@ -129,7 +159,7 @@ func (e *eventsCacheImpl) getEvent(domainID, workflowID, runID string, firstEven
 	return event, nil
 }
 
-func (e *eventsCacheImpl) putEvent(domainID, workflowID, runID string, eventID int64, event *shared.HistoryEvent) {
+func (e *eventsCacheImpl) PutEvent(domainID, workflowID, runID string, eventID int64, event *shared.HistoryEvent) {
 	e.metricsClient.IncCounter(metrics.EventsCachePutEventScope, metrics.CacheRequests)
 	sw := e.metricsClient.StartTimer(metrics.EventsCachePutEventScope, metrics.CacheLatency)
 	defer sw.Stop()
@@ -138,7 +168,7 @@ func (e *eventsCacheImpl) putEvent(domainID, workflowID, runID string, eventID i
 	e.Put(key, event)
 }
 
-func (e *eventsCacheImpl) deleteEvent(domainID, workflowID, runID string, eventID int64) {
+func (e *eventsCacheImpl) DeleteEvent(domainID, workflowID, runID string, eventID int64) {
 	e.metricsClient.IncCounter(metrics.EventsCacheDeleteEventScope, metrics.CacheRequests)
 	sw := e.metricsClient.StartTimer(metrics.EventsCacheDeleteEventScope, metrics.CacheLatency)
 	defer sw.Stop()
@@ -162,6 +192,8 @@ func (e *eventsCacheImpl) getHistoryEventFromStore(domainID, workflowID, runID s
 			PageSize:      1,
 			NextPageToken: nil,
 			ShardID:       e.shardID,
+			DomainID:      domainID,
+			WorkflowID:    workflowID,
 		})
 
 		if err != nil {
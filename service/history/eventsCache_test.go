@@ -80,7 +80,7 @@ func (s *eventsCacheSuite) TearDownTest() {
 }
 
 func (s *eventsCacheSuite) newTestEventsCache() *eventsCacheImpl {
-	return newEventsCacheWithOptions(16, 32, time.Minute, s.mockEventsMgr, s.mockEventsV2Mgr, false, s.logger,
+	return newEventsCacheWithOptions(16, 32, time.Minute, 0, 4, s.mockEventsMgr, s.mockEventsV2Mgr, false, s.logger,
 		metrics.NewClient(tally.NoopScope, metrics.History), common.IntPtr(10))
 }
 
@@ -95,8 +95,8 @@ func (s *eventsCacheSuite) TestEventsCacheHitSuccess() {
 		ActivityTaskStartedEventAttributes: &shared.ActivityTaskStartedEventAttributes{},
 	}
 
-	s.cache.putEvent(domainID, workflowID, runID, eventID, event)
-	actualEvent, err := s.cache.getEvent(domainID, workflowID, runID, eventID, eventID, 0, nil)
+	s.cache.PutEvent(domainID, workflowID, runID, eventID, event)
+	actualEvent, err := s.cache.GetEvent(domainID, workflowID, runID, eventID, eventID, 0, nil)
 	s.Nil(err)
 	s.Equal(event, actualEvent)
 }
@@ -131,8 +131,8 @@ func (s *eventsCacheSuite) TestEventsCacheMissSuccess() {
 		LastFirstEventID: event2ID,
 	}, nil)
 
-	s.cache.putEvent(domainID, workflowID, runID, event1ID, event1)
-	actualEvent, err := s.cache.getEvent(domainID, workflowID, runID, event2ID, event2ID, 0, nil)
+	s.cache.PutEvent(domainID, workflowID, runID, event1ID, event1)
+	actualEvent, err := s.cache.GetEvent(domainID, workflowID, runID, event2ID, event2ID, 0, nil)
 	s.Nil(err)
 	s.Equal(event2, actualEvent)
 }
@@ -185,8 +185,8 @@ func (s *eventsCacheSuite) TestEventsCacheMissMultiEventsBatchSuccess() {
 		LastFirstEventID: event1.GetEventId(),
 	}, nil)
 
-	s.cache.putEvent(domainID, workflowID, runID, event2.GetEventId(), event2)
-	actualEvent, err := s.cache.getEvent(domainID, workflowID, runID, event1.GetEventId(), event6.GetEventId(), 0, nil)
+	s.cache.PutEvent(domainID, workflowID, runID, event2.GetEventId(), event2)
+	actualEvent, err := s.cache.GetEvent(domainID, workflowID, runID, event1.GetEventId(), event6.GetEventId(), 0, nil)
 	s.Nil(err)
 	s.Equal(event6, actualEvent)
 }
@@ -233,14 +233,16 @@ func (s *eventsCacheSuite) TestEventsCacheMissMultiEventsBatchV2Success() {
 		PageSize:      1,
 		NextPageToken: nil,
 		ShardID:       common.IntPtr(10),
+		DomainID:      domainID,
+		WorkflowID:    workflowID,
 	}).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents:    []*shared.HistoryEvent{event1, event2, event3, event4, event5, event6},
 		NextPageToken:    nil,
 		LastFirstEventID: event1.GetEventId(),
 	}, nil)
 
-	s.cache.putEvent(domainID, workflowID, runID, event2.GetEventId(), event2)
-	actualEvent, err := s.cache.getEvent(domainID, workflowID, runID, event1.GetEventId(), event6.GetEventId(),
+	s.cache.PutEvent(domainID, workflowID, runID, event2.GetEventId(), event2)
+	actualEvent, err := s.cache.GetEvent(domainID, workflowID, runID, event1.GetEventId(), event6.GetEventId(),
 		persistence.EventStoreVersionV2, []byte("store_token"))
 	s.Nil(err)
 	s.Equal(event6, actualEvent)
@@ -261,7 +263,7 @@ func (s *eventsCacheSuite) TestEventsCacheMissFailure() {
 		NextPageToken: nil,
 	}).Return(nil, expectedErr)
 
-	actualEvent, err := s.cache.getEvent(domainID, workflowID, runID, int64(11), int64(14), 0, nil)
+	actualEvent, err := s.cache.GetEvent(domainID, workflowID, runID, int64(11), int64(14), 0, nil)
 	s.Nil(actualEvent)
 	s.Equal(expectedErr, err)
 }
@@ -279,9 +281,11 @@ func (s *eventsCacheSuite) TestEventsCacheMissV2Failure() {
 		PageSize:      1,
 		NextPageToken: nil,
 		ShardID:       common.IntPtr(10),
+		DomainID:      domainID,
+		WorkflowID:    workflowID,
 	}).Return(nil, expectedErr)
 
-	actualEvent, err := s.cache.getEvent(domainID, workflowID, runID, int64(11), int64(14),
+	actualEvent, err := s.cache.GetEvent(domainID, workflowID, runID, int64(11), int64(14),
 		persistence.EventStoreVersionV2, []byte("store_token"))
 	s.Nil(actualEvent)
 	s.Equal(expectedErr, err)
@@ -309,16 +313,18 @@ func (s *eventsCacheSuite) TestEventsCacheDisableSuccess() {
 		PageSize:      1,
 		NextPageToken: nil,
 		ShardID:       common.IntPtr(10),
+		DomainID:      domainID,
+		WorkflowID:    workflowID,
 	}).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents:    []*shared.HistoryEvent{event2},
 		NextPageToken:    nil,
 		LastFirstEventID: event2.GetEventId(),
 	}, nil)
 
-	s.cache.putEvent(domainID, workflowID, runID, event1.GetEventId(), event1)
-	s.cache.putEvent(domainID, workflowID, runID, event2.GetEventId(), event2)
+	s.cache.PutEvent(domainID, workflowID, runID, event1.GetEventId(), event1)
+	s.cache.PutEvent(domainID, workflowID, runID, event2.GetEventId(), event2)
 	s.cache.disabled = true
-	actualEvent, err := s.cache.getEvent(domainID, workflowID, runID, event2.GetEventId(), event2.GetEventId(),
+	actualEvent, err := s.cache.GetEvent(domainID, workflowID, runID, event2.GetEventId(), event2.GetEventId(),
 		persistence.EventStoreVersionV2, []byte("store_token"))
 	s.Nil(err)
 	s.Equal(event2, actualEvent)
@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// newExecutionStats creates the execution stats of a workflow whose first batch of events has been persisted
+func newExecutionStats(
+	events []*workflow.HistoryEvent,
+	historySize int64,
+) *persistence.ExecutionStats {
+
+	stats := &persistence.ExecutionStats{}
+	updateExecutionStats(stats, events, historySize)
+	return stats
+}
+
+// copyExecutionStats returns a copy of the execution stats, so the stats
+// can be updated without being visible before the transaction is persisted
+func copyExecutionStats(
+	stats *persistence.ExecutionStats,
+) *persistence.ExecutionStats {
+
+	if stats == nil {
+		return &persistence.ExecutionStats{}
+	}
+	statsCopy := *stats
+	return &statsCopy
+}
+
+// updateExecutionStats accounts a batch of events persisted to history in the execution stats
+func updateExecutionStats(
+	stats *persistence.ExecutionStats,
+	events []*workflow.HistoryEvent,
+	historySize int64,
+) {
+
+	stats.HistorySize += historySize
+	stats.EventCount += int64(len(events))
+	for _, event := range events {
+		switch event.GetEventType() {
+		case workflow.EventTypeActivityTaskScheduled:
+			stats.ActivityPayloadBytes += int64(len(event.ActivityTaskScheduledEventAttributes.GetInput()))
+		case workflow.EventTypeActivityTaskCompleted:
+			stats.ActivityPayloadBytes += int64(len(event.ActivityTaskCompletedEventAttributes.GetResult()))
+		case workflow.EventTypeActivityTaskFailed:
+			stats.ActivityPayloadBytes += int64(len(event.ActivityTaskFailedEventAttributes.GetDetails()))
+		case workflow.EventTypeWorkflowExecutionSignaled:
+			stats.SignalEventCount++
+		case workflow.EventTypeTimerStarted:
+			stats.TimerCount++
+		}
+	}
+}
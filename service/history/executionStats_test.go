@@ -0,0 +1,80 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestUpdateExecutionStats(t *testing.T) {
+	a := assert.New(t)
+
+	stats := newExecutionStats([]*workflow.HistoryEvent{
+		{EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionStarted)},
+		{EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionSignaled)},
+		{EventType: common.EventTypePtr(workflow.EventTypeDecisionTaskScheduled)},
+	}, 100)
+	a.Equal(&persistence.ExecutionStats{
+		HistorySize:      100,
+		EventCount:       3,
+		SignalEventCount: 1,
+	}, stats)
+
+	updated := copyExecutionStats(stats)
+	updateExecutionStats(updated, []*workflow.HistoryEvent{
+		{
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskScheduled),
+			ActivityTaskScheduledEventAttributes: &workflow.ActivityTaskScheduledEventAttributes{
+				Input: []byte("input"),
+			},
+		},
+		{
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+			ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+				Result: []byte("result"),
+			},
+		},
+		{
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskFailed),
+			ActivityTaskFailedEventAttributes: &workflow.ActivityTaskFailedEventAttributes{
+				Details: []byte("details"),
+			},
+		},
+		{EventType: common.EventTypePtr(workflow.EventTypeTimerStarted)},
+		{EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionSignaled)},
+	}, 50)
+	a.Equal(&persistence.ExecutionStats{
+		HistorySize:          150,
+		EventCount:           8,
+		ActivityPayloadBytes: int64(len("input") + len("result") + len("details")),
+		SignalEventCount:     2,
+		TimerCount:           1,
+	}, updated)
+
+	// the original stats are not changed until the copy is applied
+	a.Equal(int64(100), stats.HistorySize)
+	a.Equal(&persistence.ExecutionStats{}, copyExecutionStats(nil))
+}
@@ -36,11 +36,13 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/overload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
@@ -73,10 +75,15 @@ type (
 		rateLimiter             quotas.Limiter
 		replicationTaskFetchers *ReplicationTaskFetchers
 		domainReplicator        replicator.DomainReplicator
+		overloadController      overload.Controller
 		service.Service
 	}
 )
 
+const (
+	domainCacheWatermarkCallbackID = 0
+)
+
 var _ historyserviceserver.Interface = (*Handler)(nil)
 var _ EngineFactory = (*Handler)(nil)
 
@@ -88,8 +95,11 @@ var (
 	errRunIDNotValid           = &gen.BadRequestError{Message: "RunID is not valid UUID."}
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
+	errQueueTypeNotSet         = &gen.BadRequestError{Message: "Queue type not set on request."}
+	errAckLevelNotSet          = &gen.BadRequestError{Message: "Ack level not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
+	errRequestNotSet           = &gen.BadRequestError{Message: "Request is nil."}
 )
 
 // NewHandler creates a thrift handler for the history service
@@ -104,6 +114,7 @@ func NewHandler(
 	executionMgrFactory persistence.ExecutionManagerFactory,
 	domainCache cache.DomainCache,
 	publicClient workflowserviceclient.Interface,
+	overloadController overload.Controller,
 ) *Handler {
 	domainReplicator := replicator.NewDomainReplicator(metadataMgr, sVice.GetLogger())
 
@@ -123,8 +134,9 @@ func NewHandler(
 				return float64(config.RPS())
 			},
 		),
-		publicClient:     publicClient,
-		domainReplicator: domainReplicator,
+		publicClient:       publicClient,
+		domainReplicator:   domainReplicator,
+		overloadController: overloadController,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -144,6 +156,14 @@ func (h *Handler) Start() error {
 
 	h.domainCache = cache.NewDomainCache(h.metadataMgr, h.GetClusterMetadata(), h.GetMetricsClient(), h.GetLogger())
 	h.domainCache.Start()
+	if highWatermark := h.config.DomainCacheMemoryHighWatermarkInBytes(); highWatermark > 0 {
+		h.domainCache.RegisterMemoryWatermarkCallback(
+			domainCacheWatermarkCallbackID,
+			int64(h.config.DomainCacheMemoryLowWatermarkInBytes()),
+			int64(highWatermark),
+			h.onDomainCacheMemoryWatermark,
+		)
+	}
 
 	matchingClient, err := h.GetClientBean().GetMatchingClient(h.domainCache.GetDomainName)
 	if err != nil {
@@ -168,7 +188,7 @@ func (h *Handler) Start() error {
 	}
 	h.hServiceResolver = hServiceResolver
 
-	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
+	if h.GetClusterMetadata().IsGlobalDomainEnabled() && !isRPCReplicationEnabled(h.GetClusterMetadata()) {
 		var err error
 		h.publisher, err = h.GetMessagingClient().NewProducerWithClusterName(h.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
@@ -199,6 +219,7 @@ func (h *Handler) Start() error {
 // Stop stops the handler
 func (h *Handler) Stop() {
 	h.replicationTaskFetchers.Stop()
+	h.domainCache.UnregisterMemoryWatermarkCallback(domainCacheWatermarkCallbackID)
 	h.domainCache.Stop()
 	h.controller.Stop()
 	h.shardManager.Close()
@@ -213,6 +234,15 @@ func (h *Handler) Stop() {
 	h.historyEventNotifier.Stop()
 }
 
+func (h *Handler) onDomainCacheMemoryWatermark(level cache.WatermarkLevel, memoryUsage int64) {
+	switch level {
+	case cache.WatermarkLevelHigh:
+		h.GetLogger().Warn("Domain cache memory usage exceeded high watermark, evicting domains.", tag.Number(memoryUsage))
+	case cache.WatermarkLevelLow:
+		h.GetLogger().Info("Domain cache memory usage fell below low watermark.", tag.Number(memoryUsage))
+	}
+}
+
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
 	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient,
@@ -223,7 +253,8 @@ func (h *Handler) CreateEngine(context ShardContext) Engine {
 func (h *Handler) Health(ctx context.Context) (*health.HealthStatus, error) {
 	h.startWG.Wait()
 	h.GetLogger().Debug("History health check endpoint reached.")
-	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("history good")}
+	msg := fmt.Sprintf("history good, persistence health score %.2f", h.overloadController.HealthScore())
+	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr(msg)}
 	return hs, nil
 }
 
@@ -273,6 +304,50 @@ func (h *Handler) RecordActivityTaskHeartbeat(ctx context.Context,
 	return response, nil
 }
 
+// RecordActivityTaskHeartbeatBatch - Record Activity Task Heart beats of multiple activities.
+func (h *Handler) RecordActivityTaskHeartbeatBatch(ctx context.Context,
+	batchRequest *hist.RecordActivityTaskHeartbeatBatchRequest) (resp *hist.RecordActivityTaskHeartbeatBatchResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRecordActivityTaskHeartbeatBatchScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if batchRequest == nil {
+		return nil, h.error(errRequestNotSet, scope, "", "")
+	}
+
+	// each heartbeat goes through the same validation, throttling and metrics as an individual call
+	requests := batchRequest.GetRequests()
+	results := make([]*hist.RecordActivityTaskHeartbeatResult, len(requests))
+	indexCh := make(chan int, len(requests))
+	for i := range requests {
+		indexCh <- i
+	}
+	close(indexCh)
+
+	concurrency := common.MinInt(h.config.HeartbeatBatchConcurrency(), len(requests))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for worker := 0; worker < concurrency; worker++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexCh {
+				response, err := h.RecordActivityTaskHeartbeat(ctx, requests[i])
+				results[i] = newRecordActivityTaskHeartbeatResult(response, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return &hist.RecordActivityTaskHeartbeatBatchResponse{Results: results}, nil
+}
+
 // RecordActivityTaskStarted - Record Activity Task started.
 func (h *Handler) RecordActivityTaskStarted(ctx context.Context,
 	recordRequest *hist.RecordActivityTaskStartedRequest) (resp *hist.RecordActivityTaskStartedResponse, retError error) {
@@ -308,6 +383,41 @@ func (h *Handler) RecordActivityTaskStarted(ctx context.Context,
 	return response, nil
 }
 
+// RecordActivityTaskWorkerUnavailable - Record the worker of an activity task is no longer available.
+func (h *Handler) RecordActivityTaskWorkerUnavailable(ctx context.Context,
+	request *hist.RecordActivityTaskWorkerUnavailableRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRecordActivityTaskWorkerUnavailableScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	workflowExecution := request.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, workflowID)
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, workflowID)
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.RecordActivityTaskWorkerUnavailable(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // RecordDecisionTaskStarted - Record Decision Task started.
 func (h *Handler) RecordDecisionTaskStarted(ctx context.Context,
 	recordRequest *hist.RecordDecisionTaskStartedRequest) (resp *hist.RecordDecisionTaskStartedResponse, retError error) {
@@ -668,6 +778,7 @@ func (h *Handler) DescribeHistoryHost(ctx context.Context,
 		},
 		ShardControllerStatus: &status,
 		Address:               common.StringPtr(h.GetHostInfo().GetAddress()),
+		Shards:                h.controller.shardInfos(),
 	}
 	return resp, nil
 }
@@ -699,6 +810,97 @@ func (h *Handler) CloseShard(
 	return nil
 }
 
+// DescribeQueue returns the persisted ack levels of a queue of the shard
+func (h *Handler) DescribeQueue(
+	ctx context.Context,
+	request *gen.DescribeQueueRequest,
+) (resp *gen.DescribeQueueResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeQueueScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+	if request.Type == nil {
+		return nil, h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	shardResp, err := h.shardManager.GetShard(&persistence.GetShardRequest{ShardID: int(request.GetShardID())})
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	resp, err = describeQueueState(shardResp.ShardInfo, request.GetType())
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// ResetQueueState moves the ack level of a queue of the shard, the shard is closed before the update so that
+// the queue processors do not overwrite the new ack level, and reloaded afterwards to process the tasks again
+func (h *Handler) ResetQueueState(
+	ctx context.Context,
+	request *gen.ResetQueueStateRequest,
+) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResetQueueStateScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+	if request.Type == nil {
+		return h.error(errQueueTypeNotSet, scope, "", "")
+	}
+	if request.AckLevel == nil {
+		return h.error(errAckLevelNotSet, scope, "", "")
+	}
+
+	shardID := int(request.GetShardID())
+	// only the owner of the shard may update the shard record, the ownership is checked by loading the shard
+	// before closing it, so that the queue processors cannot overwrite the new ack level
+	if _, err := h.controller.getEngineForShard(shardID); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	h.controller.removeEngineForShard(shardID)
+
+	shardResp, err := h.shardManager.GetShard(&persistence.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+	shardInfo := shardResp.ShardInfo
+	if err := resetQueueState(shardInfo, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	if err := h.shardManager.UpdateShard(&persistence.UpdateShardRequest{
+		ShardInfo:       shardInfo,
+		PreviousRangeID: shardInfo.RangeID,
+	}); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	h.GetLogger().Info("Queue state is reset.",
+		tag.ShardID(shardID),
+		tag.QueueType(request.GetType().String()),
+		tag.ClusterName(request.GetClusterName()),
+		tag.AckLevel(request.GetAckLevel()),
+		tag.Bool(request.GetForce()),
+	)
+
+	if _, err := h.controller.getEngineForShard(shardID); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *Handler) DescribeMutableState(ctx context.Context,
 	request *hist.DescribeMutableStateRequest) (resp *hist.DescribeMutableStateResponse, retError error) {
@@ -729,6 +931,198 @@ func (h *Handler) DescribeMutableState(ctx context.Context,
 	return resp, nil
 }
 
+// DetectZombieWorkflowExecution - converts the workflow execution to zombie if it is no longer current and not closed
+func (h *Handler) DetectZombieWorkflowExecution(ctx context.Context,
+	request *hist.DetectZombieWorkflowExecutionRequest) (resp *hist.DetectZombieWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDetectZombieWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	if workflowExecution == nil {
+		return nil, h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if workflowID == "" {
+		return nil, h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+	if uuid.Parse(workflowExecution.GetRunId()) == nil {
+		return nil, h.error(errRunIDNotValid, scope, domainID, workflowID)
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.DetectZombieWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+	return resp, nil
+}
+
+// DeleteWorkflowExecution - removes the history, visibility records, current execution record and mutable state
+// of a workflow execution
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context,
+	request *hist.DeleteWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	if workflowExecution == nil {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+	if uuid.Parse(workflowExecution.GetRunId()) == nil {
+		return h.error(errRunIDNotValid, scope, domainID, workflowID)
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
+// ReindexWorkflowExecution - generates a visibility upsert task for a running workflow execution
+func (h *Handler) ReindexWorkflowExecution(ctx context.Context,
+	request *hist.ReindexWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReindexWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	if workflowExecution == nil {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ReindexWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
+// SetTerminationProtection - sets or clears the termination protection of a running workflow execution
+func (h *Handler) SetTerminationProtection(ctx context.Context,
+	request *hist.SetTerminationProtectionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistorySetTerminationProtectionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	if workflowExecution == nil {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.SetTerminationProtection(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
+// ReplicateWorkflowExecution - starts replicating a workflow execution started before its domain was promoted
+func (h *Handler) ReplicateWorkflowExecution(ctx context.Context,
+	request *hist.ReplicateWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReplicateWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	if workflowExecution == nil {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ReplicateWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
 // GetMutableState - returns the id of the next event in the execution's history
 func (h *Handler) GetMutableState(ctx context.Context,
 	getRequest *hist.GetMutableStateRequest) (resp *hist.GetMutableStateResponse, retError error) {
@@ -873,6 +1267,42 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// AddOperatorAnnotation records an operator note in the memo of a running workflow execution. No history event
+// is written and no decision task is created for the execution.
+func (h *Handler) AddOperatorAnnotation(ctx context.Context,
+	wrappedRequest *hist.AddOperatorAnnotationRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryAddOperatorAnnotationScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.AnnotationRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.AddOperatorAnnotation(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -984,6 +1414,77 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution, pending decision / activity tasks are not
+// dispatched and timers are deferred until the execution is resumed.
+func (h *Handler) PauseWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.PauseWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// ResumeWorkflowExecution resumes a paused workflow execution.
+func (h *Handler) ResumeWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.ResumeWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResumeWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.ResumeRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.ResumeWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *Handler) ResetWorkflowExecution(ctx context.Context,
@@ -1378,6 +1879,40 @@ func (h *Handler) GetReplicationMessages(
 	return &r.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
 }
 
+// GetReplicationStatus returns the replication read and ack levels of the requested shards
+func (h *Handler) GetReplicationStatus(
+	ctx context.Context,
+	request *hist.GetReplicationStatusRequest,
+) (resp *hist.GetReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationStatusScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, "", "")
+	}
+
+	resp = &hist.GetReplicationStatusResponse{}
+	for _, shardID := range request.ShardIDs {
+		engine, err := h.controller.getEngineForShard(int(shardID))
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+
+		status, err := engine.GetReplicationStatus(ctx, request.GetDomainUUID())
+		if err != nil {
+			return nil, h.error(err, scope, "", "")
+		}
+		resp.Shards = append(resp.Shards, status)
+	}
+
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -1403,6 +1938,11 @@ func (h *Handler) convertError(err error) error {
 	case *persistence.TransactionSizeLimitError:
 		err := err.(*persistence.TransactionSizeLimitError)
 		return &gen.BadRequestError{Message: err.Msg}
+	case *persistence.DataLossError:
+		err := err.(*persistence.DataLossError)
+		return &gen.InternalServiceError{Message: err.Msg}
+	case *gen.DomainNotActiveError:
+		cluster.SetActiveClusterAddress(err.(*gen.DomainNotActiveError), h.GetClusterMetadata())
 	}
 
 	return err
@@ -1419,6 +1959,8 @@ func (h *Handler) updateErrorMetric(scope int, domainID, workflowID string, err
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrShardOwnershipLostCounter)
 	case *hist.EventAlreadyStartedError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrEventAlreadyStartedCounter)
+	case *hist.IncompatibleBinaryChecksumError:
+		h.metricsClient.IncCounter(scope, metrics.CadenceErrIncompatibleBinaryChecksumCounter)
 	case *gen.BadRequestError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
 	case *gen.DomainNotActiveError:
@@ -1429,6 +1971,8 @@ func (h *Handler) updateErrorMetric(scope int, domainID, workflowID string, err
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
 	case *gen.CancellationAlreadyRequestedError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrCancellationAlreadyRequestedCounter)
+	case *gen.PreconditionFailedError:
+		h.metricsClient.IncCounter(scope, metrics.CadenceErrPreconditionFailedCounter)
 	case *gen.LimitExceededError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrLimitExceededCounter)
 	case *gen.RetryTaskError:
@@ -1489,3 +2033,32 @@ func validateTaskToken(token *common.TaskToken) error {
 	}
 	return nil
 }
+
+func newRecordActivityTaskHeartbeatResult(
+	response *gen.RecordActivityTaskHeartbeatResponse,
+	err error,
+) *hist.RecordActivityTaskHeartbeatResult {
+
+	result := &hist.RecordActivityTaskHeartbeatResult{}
+	switch err := err.(type) {
+	case nil:
+		result.Response = response
+	case *gen.BadRequestError:
+		result.BadRequestError = err
+	case *gen.InternalServiceError:
+		result.InternalServiceError = err
+	case *gen.EntityNotExistsError:
+		result.EntityNotExistError = err
+	case *hist.ShardOwnershipLostError:
+		result.ShardOwnershipLostError = err
+	case *gen.DomainNotActiveError:
+		result.DomainNotActiveError = err
+	case *gen.LimitExceededError:
+		result.LimitExceededError = err
+	case *gen.ServiceBusyError:
+		result.ServiceBusyError = err
+	default:
+		result.InternalServiceError = &gen.InternalServiceError{Message: err.Error()}
+	}
+	return result
+}
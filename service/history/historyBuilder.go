@@ -135,8 +135,9 @@ func (b *historyBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 }
 
 func (b *historyBuilder) AddActivityTaskStartedEvent(scheduleEventID int64, attempt int32, requestID string,
-	identity string) *workflow.HistoryEvent {
-	event := b.newActivityTaskStartedEvent(scheduleEventID, attempt, requestID, identity)
+	identity string, lastFailureReason string, lastFailureDetails []byte) *workflow.HistoryEvent {
+	event := b.newActivityTaskStartedEvent(scheduleEventID, attempt, requestID, identity, lastFailureReason,
+		lastFailureDetails)
 
 	return b.addEventToHistory(event)
 }
@@ -382,6 +383,13 @@ func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionTerminationProtectionSetEvent(
+	protected bool, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionTerminationProtectionSetEvent(protected, identity)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID int64,
 	attributes *workflow.StartChildWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID, attributes)
@@ -497,6 +505,8 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.OriginalExecutionRunId = common.StringPtr(originalRunID)
 	attributes.Memo = request.Memo
 	attributes.SearchAttributes = request.SearchAttributes
+	attributes.Priority = request.Priority
+	attributes.TerminationProtected = request.TerminationProtected
 
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
@@ -583,19 +593,24 @@ func (b *historyBuilder) newActivityTaskScheduledEvent(decisionTaskCompletedEven
 	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(common.Int32Default(scheduleAttributes.HeartbeatTimeoutSeconds))
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	attributes.RetryPolicy = scheduleAttributes.RetryPolicy
+	attributes.DelayStartSeconds = scheduleAttributes.DelayStartSeconds
 	historyEvent.ActivityTaskScheduledEventAttributes = attributes
 
 	return historyEvent
 }
 
 func (b *historyBuilder) newActivityTaskStartedEvent(scheduledEventID int64, attempt int32, requestID string,
-	identity string) *workflow.HistoryEvent {
+	identity string, lastFailureReason string, lastFailureDetails []byte) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeActivityTaskStarted)
 	attributes := &workflow.ActivityTaskStartedEventAttributes{}
 	attributes.ScheduledEventId = common.Int64Ptr(scheduledEventID)
 	attributes.Attempt = common.Int32Ptr(attempt)
 	attributes.Identity = common.StringPtr(identity)
 	attributes.RequestId = common.StringPtr(requestID)
+	if lastFailureReason != "" {
+		attributes.LastFailureReason = common.StringPtr(lastFailureReason)
+		attributes.LastFailureDetails = lastFailureDetails
+	}
 	historyEvent.ActivityTaskStartedEventAttributes = attributes
 
 	return historyEvent
@@ -685,6 +700,17 @@ func (b *historyBuilder) newWorkflowExecutionSignaledEvent(
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionTerminationProtectionSetEvent(
+	protected bool, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionTerminationProtectionSet)
+	attributes := &workflow.WorkflowExecutionTerminationProtectionSetEventAttributes{}
+	attributes.TerminationProtected = common.BoolPtr(protected)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionTerminationProtectionSetEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionTerminatedEvent(
 	reason string, details []byte, identity string) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionTerminated)
@@ -22,6 +22,7 @@ package history
 
 import (
 	"context"
+	"hash/fnv"
 	"sync/atomic"
 
 	"github.com/pborman/uuid"
@@ -61,6 +62,8 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	opts.InitialCapacity = config.HistoryCacheInitialSize()
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
+	opts.Shards = config.HistoryCacheShardCount()
+	opts.KeyHashFunc = hashWorkflowIdentifier
 
 	return &historyCache{
 		Cache:            cache.New(config.HistoryCacheMaxSize(), opts),
@@ -72,6 +75,14 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	}
 }
 
+func hashWorkflowIdentifier(key interface{}) uint32 {
+	identifier := key.(definition.WorkflowIdentifier)
+	hash := fnv.New32a()
+	hash.Write([]byte(identifier.WorkflowID))
+	hash.Write([]byte(identifier.RunID))
+	return hash.Sum32()
+}
+
 func (c *historyCache) getOrCreateCurrentWorkflowExecution(
 	ctx context.Context,
 	domainID string,
@@ -276,7 +287,11 @@ func (c *historyCache) getCurrentExecutionWithRetry(
 		return err
 	}
 
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.Retry(
+		op,
+		persistenceOperationRetryPolicy,
+		persistence.CountRetries(c.metricsClient, metrics.PersistenceGetCurrentExecutionScope, common.IsPersistenceTransientError),
+	)
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.HistoryCacheGetCurrentExecutionScope, metrics.CacheFailures)
 		return nil, err
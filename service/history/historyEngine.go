@@ -29,6 +29,7 @@ import (
 
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	r "github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
@@ -55,6 +56,9 @@ const (
 	activityCancellationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancellationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancellationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	workflowIDReuseTerminateReason            = "TerminateIfRunning WorkflowIdReusePolicy"
+	// activityCancellationWaitTailroom is the time left to reply to a heartbeat waiting for cancellation before its deadline
+	activityCancellationWaitTailroom = time.Second
 )
 
 type (
@@ -68,6 +72,7 @@ type (
 		historyV2Mgr              persistence.HistoryV2Manager
 		executionManager          persistence.ExecutionManager
 		visibilityMgr             persistence.VisibilityManager
+		matchingClient            matching.Client
 		txProcessor               transferQueueProcessor
 		timerProcessor            timerQueueProcessor
 		taskAllocator             taskAllocator
@@ -84,6 +89,7 @@ type (
 		resetor                   workflowResetor
 		replicationTaskProcessors []*ReplicationTaskProcessor
 		publicClient              workflowserviceclient.Interface
+		noPollersTracker          *noPollersTracker
 	}
 )
 
@@ -94,6 +100,8 @@ var (
 	ErrTaskDiscarded = errors.New("passive task pending for too long")
 	// ErrTaskRetry is the error indicating that the timer / transfer task should be retried.
 	ErrTaskRetry = errors.New("passive task should retry due to condition in mutable state is not met")
+	// ErrDomainProcessingPaused is the error indicating that the timer task is held back since processing of its domain is paused.
+	ErrDomainProcessingPaused = errors.New("processing of the domain is paused")
 	// ErrDuplicate is exported temporarily for integration test
 	ErrDuplicate = errors.New("Duplicate task, completing it")
 	// ErrConflict is exported temporarily for integration test
@@ -106,6 +114,10 @@ var (
 	ErrActivityTaskNotFound = &workflow.EntityNotExistsError{Message: "Activity task not found."}
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+	// ErrWorkflowPaused is the error to indicate workflow execution is paused, the task is dispatched again once resumed
+	ErrWorkflowPaused = &workflow.EntityNotExistsError{Message: "Workflow execution is paused."}
+	// ErrWorkflowTerminationProtected is the error to indicate workflow execution can only be terminated by force
+	ErrWorkflowTerminationProtected = &workflow.BadRequestError{Message: "Workflow execution is termination protected, force is required to terminate it."}
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "Workflow parent does not match."}
 	// ErrDeserializingToken is the error to indicate task token is invalid
@@ -160,6 +172,7 @@ func NewEngineWithShardContext(
 		historyV2Mgr:         historyV2Manager,
 		executionManager:     executionManager,
 		visibilityMgr:        visibilityMgr,
+		matchingClient:       matching,
 		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
 		historyCache:         historyCache,
 		logger:               logger.WithTags(tag.ComponentHistoryEngine),
@@ -175,17 +188,18 @@ func NewEngineWithShardContext(
 			shard.GetConfig().ArchiveRequestRPS,
 			shard.GetService().GetArchiverProvider(),
 		),
-		publicClient: publicClient,
+		publicClient:     publicClient,
+		noPollersTracker: newNoPollersTracker(config, matching, logger.WithTags(tag.ComponentHistoryEngine)),
 	}
 
 	historyEngImpl.txProcessor = newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, logger)
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(shard, historyEngImpl, matching, logger)
 
-	// Only start the replicator processor if valid publisher is passed in
-	if publisher != nil {
+	// Only create the replicator processor if a valid publisher is passed in or replication tasks are pulled over RPC
+	if publisher != nil || isRPCReplicationEnabled(shard.GetClusterMetadata()) {
 		replicatorProcessor := newReplicatorQueueProcessor(shard, historyEngImpl.historyCache, publisher, executionManager, historyManager, historyV2Manager, logger)
 		historyEngImpl.replicatorProcessor = replicatorProcessor
-		historyEngImpl.replicator = newHistoryReplicator(shard, clock.NewRealTimeSource(), historyEngImpl, historyCache, shard.GetDomainCache(), historyManager, historyV2Manager,
+		historyEngImpl.replicator = newHistoryReplicator(shard, shard.GetTimeSource(), historyEngImpl, historyCache, shard.GetDomainCache(), historyManager, historyV2Manager,
 			logger)
 	}
 	historyEngImpl.resetor = newWorkflowResetor(historyEngImpl)
@@ -203,6 +217,13 @@ func NewEngineWithShardContext(
 	return historyEngImpl
 }
 
+// isRPCReplicationEnabled returns true if replication tasks are pulled by remote clusters over RPC
+// instead of being published to kafka
+func isRPCReplicationEnabled(clusterMetadata cluster.Metadata) bool {
+	return clusterMetadata.IsGlobalDomainEnabled() &&
+		clusterMetadata.GetReplicationConsumerConfig().Type == config.ReplicationConsumerTypeRPC
+}
+
 // Start will spin up all the components needed to start serving this shard.
 // Make sure all the components are loaded lazily so start can return immediately.  This is important because
 // ShardController calls start sequentially for all the shards for a given host during startup.
@@ -214,6 +235,7 @@ func (e *historyEngineImpl) Start() {
 
 	e.txProcessor.Start()
 	e.timerProcessor.Start()
+	e.noPollersTracker.Start()
 
 	clusterMetadata := e.shard.GetClusterMetadata()
 	if e.replicatorProcessor != nil && clusterMetadata.GetReplicationConsumerConfig().Type != config.ReplicationConsumerTypeRPC {
@@ -232,6 +254,7 @@ func (e *historyEngineImpl) Stop() {
 
 	e.txProcessor.Stop()
 	e.timerProcessor.Stop()
+	e.noPollersTracker.Stop()
 	if e.replicatorProcessor != nil {
 		e.replicatorProcessor.Stop()
 	}
@@ -402,7 +425,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 
 	execution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(workflowID),
-		RunId:      common.StringPtr(uuid.New()),
+		RunId:      common.StringPtr(e.config.IDGenerator.RunID()),
 	}
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
 	msBuilder := e.createMutableState(clusterMetadata, domainEntry)
@@ -439,6 +462,18 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 
 	context := newWorkflowExecutionContext(domainID, execution, e.shard, e.executionManager, e.logger)
 
+	if request.GetWorkflowIdReusePolicy() == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+		runID, err := e.terminateIfRunningAndStart(ctx, domainEntry, context, msBuilder, request)
+		if err != nil {
+			return nil, err
+		}
+		if runID != "" {
+			return &workflow.StartWorkflowExecutionResponse{
+				RunId: common.StringPtr(runID),
+			}, nil
+		}
+	}
+
 	now := e.timeSource.Now()
 	newWorkflow, newWorkflowEventsSeq, err := msBuilder.CloseTransactionAsSnapshot(
 		now,
@@ -451,13 +486,14 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	stats := newExecutionStats(newWorkflowEventsSeq[0].Events, historySize)
 
 	// create as brand new
 	createMode := persistence.CreateWorkflowModeBrandNew
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	err = context.createWorkflowExecution(
-		newWorkflow, historySize, now,
+		newWorkflow, stats, now,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
 	if err != nil {
@@ -493,7 +529,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 				return nil, err
 			}
 			err = context.createWorkflowExecution(
-				newWorkflow, historySize, now,
+				newWorkflow, stats, now,
 				createMode, prevRunID, prevLastWriteVersion,
 			)
 		}
@@ -502,6 +538,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	meterHistoryEvents(e.shard.GetService().GetMeter(), domainID, newWorkflowEventsSeq[0].Events, historySize)
 	return &workflow.StartWorkflowExecutionResponse{
 		RunId: execution.RunId,
 	}, nil
@@ -599,6 +636,23 @@ func (e *historyEngineImpl) QueryWorkflow(
 		release(err)
 		return nil, err
 	}
+	// built-in queries are answered directly from mutable state without dispatching to worker
+	if queryType := request.GetQuery().GetQueryType(); common.IsBuiltInQueryType(queryType) {
+		result, err := e.answerBuiltInQuery(request.GetDomainUUID(), msBuilder, queryType)
+		release(err)
+		if err != nil {
+			return nil, err
+		}
+		return &h.QueryWorkflowResponse{QueryResult: result}, nil
+	}
+	// a closed workflow will never schedule another decision task to carry the query, so a query-only
+	// decision task is dispatched to its tasklist instead and answered by replaying the final history
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		execution := *context.getExecution()
+		taskList := msBuilder.GetExecutionInfo().TaskList
+		release(nil)
+		return e.queryClosedWorkflow(ctx, domainCache.GetInfo().Name, request, execution, taskList)
+	}
 	queryRegistry := msBuilder.GetQueryRegistry()
 	release(nil)
 	queryID, _, queryTermCh := queryRegistry.bufferQuery(request.GetQuery())
@@ -688,6 +742,31 @@ retryLoop:
 	return nil, &workflow.InternalServiceError{Message: "query entered unexpected state, this should be impossible"}
 }
 
+func (e *historyEngineImpl) queryClosedWorkflow(
+	ctx ctx.Context,
+	domainName string,
+	request *h.QueryWorkflowRequest,
+	execution workflow.WorkflowExecution,
+	taskList string,
+) (*h.QueryWorkflowResponse, error) {
+
+	response, err := e.matchingClient.QueryWorkflow(ctx, &m.QueryWorkflowRequest{
+		DomainUUID: request.DomainUUID,
+		TaskList:   &workflow.TaskList{Name: common.StringPtr(taskList)},
+		QueryRequest: &workflow.QueryWorkflowRequest{
+			Domain:    common.StringPtr(domainName),
+			Execution: &execution,
+			Query:     request.Query,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &h.QueryWorkflowResponse{
+		QueryResult: response.QueryResult,
+	}, nil
+}
+
 func (e *historyEngineImpl) getMutableState(
 	ctx ctx.Context,
 	domainID string,
@@ -775,6 +854,262 @@ func (e *historyEngineImpl) DescribeMutableState(
 	return
 }
 
+// DetectZombieWorkflowExecution converts the workflow execution to zombie if it is still running
+// but is no longer referenced by the current execution record
+func (e *historyEngineImpl) DetectZombieWorkflowExecution(
+	ctx ctx.Context,
+	request *h.DetectZombieWorkflowExecutionRequest,
+) (retResp *h.DetectZombieWorkflowExecutionResponse, retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		return nil, err
+	}
+
+	switch msBuilder.GetExecutionInfo().State {
+	case persistence.WorkflowStateZombie:
+		return &h.DetectZombieWorkflowExecutionResponse{IsZombie: common.BoolPtr(true)}, nil
+	case persistence.WorkflowStateCompleted:
+		return &h.DetectZombieWorkflowExecutionResponse{IsZombie: common.BoolPtr(false)}, nil
+	}
+
+	resp, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowId(),
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return &h.DetectZombieWorkflowExecutionResponse{IsZombie: common.BoolPtr(false)}, nil
+		}
+		return nil, err
+	}
+	if resp.RunID == execution.GetRunId() {
+		return &h.DetectZombieWorkflowExecutionResponse{IsZombie: common.BoolPtr(false)}, nil
+	}
+
+	now := e.timeSource.Now()
+	if err := msBuilder.ConvertToZombie(now); err != nil {
+		return nil, err
+	}
+	if err := context.updateWorkflowExecutionAsZombie(now); err != nil {
+		return nil, err
+	}
+
+	e.logger.Warn("Converted workflow execution to zombie.",
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(execution.GetRunId()),
+	)
+	e.metricsClient.IncCounter(metrics.HistoryDetectZombieWorkflowExecutionScope, metrics.HistoryZombieWorkflowCounter)
+	return &h.DetectZombieWorkflowExecutionResponse{IsZombie: common.BoolPtr(true)}, nil
+}
+
+// DeleteWorkflowExecution removes the history, visibility records, current execution record and mutable state
+// of a workflow execution. With force set, running executions and executions whose mutable state can not be
+// loaded are deleted as well, and failures of individual steps are logged and skipped.
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx ctx.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+	force := request.GetForce()
+	logger := e.logger.WithTags(
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(execution.GetRunId()),
+		tag.ShardID(e.shard.GetShardID()),
+		tag.OperatorIdentity(request.GetIdentity()),
+		tag.OperatorReason(request.GetReason()),
+	)
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+	// calling clear here to force accesses of mutable state to read database
+	// if this is not called then callers will get mutable state even though its been removed from database
+	defer context.clear()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		if !force {
+			return err
+		}
+		logger.Warn("Unable to load mutable state of workflow execution to delete.", tag.Error(err))
+		msBuilder = nil
+	}
+	if msBuilder != nil && msBuilder.IsWorkflowExecutionRunning() && !force {
+		return &workflow.BadRequestError{Message: "Workflow execution is still running, set force to delete it."}
+	}
+
+	// mutable state is deleted last so a failed deletion can be retried, it is the only pointer to the history branch
+	steps := []struct {
+		name string
+		op   func() error
+	}{
+		{
+			name: "history",
+			op: func() error {
+				if msBuilder == nil {
+					return &workflow.InternalServiceError{Message: "Unable to locate history without mutable state."}
+				}
+				if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
+					return persistence.DeleteWorkflowExecutionHistoryV2(
+						e.historyV2Mgr, msBuilder.GetCurrentBranch(), common.IntPtr(e.shard.GetShardID()), logger)
+				}
+				return e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+					DomainID:  domainID,
+					Execution: execution,
+				})
+			},
+		},
+		{
+			name: "visibility",
+			op: func() error {
+				// the task ID orders the deletion after all earlier visibility updates of the execution
+				taskID, err := e.shard.GenerateTransferTaskID()
+				if err != nil {
+					return err
+				}
+				startTimestamp, closeTimestamp := e.getVisibilityTimestamps(domainID, execution, msBuilder, logger)
+				return e.visibilityMgr.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+					DomainID:       domainID,
+					WorkflowID:     execution.GetWorkflowId(),
+					RunID:          execution.GetRunId(),
+					TaskID:         taskID,
+					StartTimestamp: startTimestamp,
+					CloseTimestamp: closeTimestamp,
+				})
+			},
+		},
+		{
+			name: "current execution",
+			op: func() error {
+				return e.executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+					DomainID:   domainID,
+					WorkflowID: execution.GetWorkflowId(),
+					RunID:      execution.GetRunId(),
+				})
+			},
+		},
+		{
+			name: "mutable state",
+			op: func() error {
+				return e.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+					DomainID:   domainID,
+					WorkflowID: execution.GetWorkflowId(),
+					RunID:      execution.GetRunId(),
+				})
+			},
+		},
+	}
+	for _, step := range steps {
+		if err := step.op(); err != nil {
+			if !force {
+				return err
+			}
+			logger.Warn(fmt.Sprintf("Failed to delete %v of workflow execution, skipping.", step.name), tag.Error(err))
+		}
+	}
+
+	logger.Warn("Workflow execution deleted by operator.", tag.Bool(force))
+	return nil
+}
+
+// getVisibilityTimestamps returns the start and close time which locate the visibility records of an
+// execution. The start time is kept in mutable state, the close time of a closed execution and the start
+// time of an execution without mutable state are looked up in visibility
+func (e *historyEngineImpl) getVisibilityTimestamps(
+	domainID string,
+	execution workflow.WorkflowExecution,
+	msBuilder mutableState,
+	logger log.Logger,
+) (int64, int64) {
+
+	var startTimestamp int64
+	if msBuilder != nil {
+		startTimestamp = msBuilder.GetExecutionInfo().StartTimestamp.UnixNano()
+		if msBuilder.IsWorkflowExecutionRunning() {
+			return startTimestamp, 0
+		}
+	}
+
+	// the domain name is only used as a config filter key
+	var domainName string
+	if domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID); err == nil {
+		domainName = domainEntry.GetInfo().Name
+	}
+	response, err := e.visibilityMgr.GetClosedWorkflowExecution(&persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Domain:     domainName,
+		Execution:  execution,
+	})
+	if err != nil {
+		logger.Warn("Unable to look up the visibility record of workflow execution to delete.", tag.Error(err))
+		return startTimestamp, 0
+	}
+	return response.Execution.GetStartTime(), response.Execution.GetCloseTime()
+}
+
+// ReindexWorkflowExecution generates a visibility upsert task for a running workflow execution, the task
+// re-sends the start fields, memo and search attributes of the execution to visibility
+func (e *historyEngineImpl) ReindexWorkflowExecution(
+	ctx ctx.Context,
+	request *h.ReindexWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false,
+		func(msBuilder mutableState, tBuilder *timerBuilder) error {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			msBuilder.AddTransferTasks(&persistence.UpsertWorkflowSearchAttributesTask{
+				// TaskID is set by shard
+				VisibilityTimestamp: e.shard.GetTimeSource().Now(),
+				Version:             msBuilder.GetCurrentVersion(),
+			})
+			return nil
+		})
+}
+
 func (e *historyEngineImpl) toMutableStateJSON(msb mutableState) (*string, error) {
 	ms := msb.CopyToPersistence()
 
@@ -842,6 +1177,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		return nil, err1
 	}
 	executionInfo := msBuilder.GetExecutionInfo()
+	stats := context.getExecutionStats()
 
 	result := &workflow.DescribeWorkflowExecutionResponse{
 		ExecutionConfiguration: &workflow.WorkflowExecutionConfiguration{
@@ -861,6 +1197,15 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			Memo:             &workflow.Memo{Fields: executionInfo.Memo},
 			SearchAttributes: &workflow.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
 		},
+		Paused:               common.BoolPtr(executionInfo.Paused),
+		TerminationProtected: common.BoolPtr(executionInfo.TerminationProtected),
+		ExecutionStats: &workflow.WorkflowExecutionStats{
+			HistorySize:          common.Int64Ptr(stats.HistorySize),
+			EventCount:           common.Int64Ptr(stats.EventCount),
+			ActivityPayloadBytes: common.Int64Ptr(stats.ActivityPayloadBytes),
+			SignalEventCount:     common.Int64Ptr(stats.SignalEventCount),
+			TimerCount:           common.Int64Ptr(stats.TimerCount),
+		},
 	}
 
 	// TODO: we need to consider adding execution time to mutable state
@@ -889,49 +1234,14 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			return nil, &workflow.InternalServiceError{Message: "Unable to get workflow completion event."}
 		}
 		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(completionEvent.GetTimestamp())
+		result.WorkflowExecutionInfo.HistoryArchivalInfo = e.getHistoryArchivalInfo(domainID, *result.WorkflowExecutionInfo.Execution)
 	}
 
 	if len(msBuilder.GetPendingActivityInfos()) > 0 {
 		for _, ai := range msBuilder.GetPendingActivityInfos() {
-			p := &workflow.PendingActivityInfo{
-				ActivityID: common.StringPtr(ai.ActivityID),
-			}
-			state := workflow.PendingActivityStateScheduled
-			if ai.CancelRequested {
-				state = workflow.PendingActivityStateCancelRequested
-			} else if ai.StartedID != common.EmptyEventID {
-				state = workflow.PendingActivityStateStarted
-			}
-			p.State = &state
-			lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano()
-			if lastHeartbeatUnixNano > 0 {
-				p.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
-				p.HeartbeatDetails = ai.Details
-			}
-			// TODO: move to mutable state instead of loading it from event
-			scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
-			if !ok {
-				return nil, &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
-			}
-			p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
-			if state == workflow.PendingActivityStateScheduled {
-				p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
-			} else {
-				p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
-			}
-			if ai.HasRetryPolicy {
-				p.Attempt = common.Int32Ptr(ai.Attempt)
-				p.ExpirationTimestamp = common.Int64Ptr(ai.ExpirationTime.UnixNano())
-				if ai.MaximumAttempts != 0 {
-					p.MaximumAttempts = common.Int32Ptr(ai.MaximumAttempts)
-				}
-				if ai.LastFailureReason != "" {
-					p.LastFailureReason = common.StringPtr(ai.LastFailureReason)
-					p.LastFailureDetails = ai.LastFailureDetails
-				}
-				if ai.LastWorkerIdentity != "" {
-					p.LastWorkerIdentity = common.StringPtr(ai.LastWorkerIdentity)
-				}
+			p, err := e.getPendingActivityInfo(domainID, msBuilder, ai)
+			if err != nil {
+				return nil, err
 			}
 			result.PendingActivities = append(result.PendingActivities, p)
 		}
@@ -953,6 +1263,94 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	return result, nil
 }
 
+// getHistoryArchivalInfo returns the history archival info recorded in the visibility record of a closed workflow,
+// nil is returned if the record is not written yet or the history of the workflow is not going to be archived
+func (e *historyEngineImpl) getHistoryArchivalInfo(
+	domainID string,
+	execution workflow.WorkflowExecution,
+) *workflow.HistoryArchivalInfo {
+
+	// the domain name is only used as a config filter key
+	var domainName string
+	if domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainID); err == nil {
+		domainName = domainEntry.GetInfo().Name
+	}
+	response, err := e.visibilityMgr.GetClosedWorkflowExecution(&persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Domain:     domainName,
+		Execution:  execution,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			e.logger.Warn("Unable to look up the history archival info of workflow execution.",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()),
+				tag.Error(err))
+		}
+		return nil
+	}
+	if response == nil || response.Execution == nil {
+		return nil
+	}
+	return response.Execution.HistoryArchivalInfo
+}
+
+func (e *historyEngineImpl) getPendingActivityInfo(
+	domainID string,
+	msBuilder mutableState,
+	ai *persistence.ActivityInfo,
+) (*workflow.PendingActivityInfo, error) {
+
+	p := &workflow.PendingActivityInfo{
+		ActivityID: common.StringPtr(ai.ActivityID),
+	}
+	state := workflow.PendingActivityStateScheduled
+	if ai.CancelRequested {
+		state = workflow.PendingActivityStateCancelRequested
+	} else if ai.StartedID != common.EmptyEventID {
+		state = workflow.PendingActivityStateStarted
+	} else if e.noPollersTracker.hasNoPollers(domainID, ai.TaskList, workflow.TaskListTypeActivity) {
+		state = workflow.PendingActivityStateNoPollers
+	}
+	p.State = &state
+	lastHeartbeatUnixNano := ai.LastHeartBeatUpdatedTime.UnixNano()
+	if lastHeartbeatUnixNano > 0 {
+		p.LastHeartbeatTimestamp = common.Int64Ptr(lastHeartbeatUnixNano)
+		p.HeartbeatDetails = ai.Details
+	}
+	// TODO: move to mutable state instead of loading it from event
+	scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
+	if !ok {
+		return nil, &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+	}
+	p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
+	if state == workflow.PendingActivityStateScheduled || state == workflow.PendingActivityStateNoPollers {
+		p.ScheduledTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
+	} else {
+		p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+	}
+	if ai.HasRetryPolicy {
+		p.Attempt = common.Int32Ptr(ai.Attempt)
+		p.ExpirationTimestamp = common.Int64Ptr(ai.ExpirationTime.UnixNano())
+		if ai.MaximumAttempts != 0 {
+			p.MaximumAttempts = common.Int32Ptr(ai.MaximumAttempts)
+		}
+		if ai.LastFailureReason != "" {
+			p.LastFailureReason = common.StringPtr(ai.LastFailureReason)
+			p.LastFailureDetails = ai.LastFailureDetails
+		}
+		if ai.LastWorkerIdentity != "" {
+			p.LastWorkerIdentity = common.StringPtr(ai.LastWorkerIdentity)
+		}
+		if ai.Attempt > 0 && ai.StartedID == common.EmptyEventID && ai.ScheduledTime.After(e.shard.GetTimeSource().Now()) {
+			// activity is backing off before its next attempt is dispatched
+			p.NextRetryTimestamp = common.Int64Ptr(ai.ScheduledTime.UnixNano())
+		}
+	}
+	return p, nil
+}
+
 func (e *historyEngineImpl) RecordActivityTaskStarted(
 	ctx ctx.Context,
 	request *h.RecordActivityTaskStartedRequest,
@@ -974,6 +1372,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	}
 
 	response := &h.RecordActivityTaskStartedResponse{}
+	activityStarted := false
 	err = e.updateWorkflowExecution(ctx, domainID, execution, false,
 		func(msBuilder mutableState, tBuilder *timerBuilder) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
@@ -1021,6 +1420,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &h.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if msBuilder.IsWorkflowExecutionPaused() {
+				// the task is dropped by matching and dispatched again once the workflow is resumed
+				return ErrWorkflowPaused
+			}
+
 			if _, err := msBuilder.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -1033,6 +1437,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 
 			response.WorkflowType = msBuilder.GetWorkflowType()
 			response.WorkflowDomain = common.StringPtr(domainName)
+			activityStarted = true
 
 			return nil
 		})
@@ -1041,9 +1446,76 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 		return nil, err
 	}
 
+	if activityStarted {
+		scheduleToStartLatency := time.Duration(response.GetStartedTimestamp() - response.GetScheduledTimestampOfThisAttempt())
+		activityType := response.ScheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType.GetName()
+		if common.IsActivityTypeMetricsAllowlisted(e.config.ActivityTypeMetricsAllowlist(domainName), activityType) {
+			e.metricsClient.Scope(metrics.HistoryRecordActivityTaskStartedScope).
+				Tagged(metrics.DomainTag(domainName), metrics.ActivityTypeTag(activityType)).
+				RecordTimer(metrics.ActivityScheduleToStartLatency, scheduleToStartLatency)
+		}
+		emitWorkflowTypeLatency(
+			e.metricsClient,
+			e.config,
+			domainName,
+			response.WorkflowType.GetName(),
+			metrics.ActivityScheduleToStartLatency,
+			scheduleToStartLatency,
+		)
+	}
+
 	return response, err
 }
 
+// RecordActivityTaskWorkerUnavailable times out an activity which is not yet started, when the
+// worker owning the worker specific task list of the activity is no longer alive
+func (e *historyEngineImpl) RecordActivityTaskWorkerUnavailable(
+	ctx ctx.Context,
+	request *h.RecordActivityTaskWorkerUnavailableRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, true,
+		func(msBuilder mutableState, tBuilder *timerBuilder) error {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			scheduleID := request.GetScheduleId()
+			ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
+
+			// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
+			// some extreme cassandra failure cases.
+			if !isRunning && scheduleID >= msBuilder.GetNextEventID() {
+				e.metricsClient.IncCounter(metrics.HistoryRecordActivityTaskWorkerUnavailableScope, metrics.StaleMutableStateCounter)
+				return ErrStaleState
+			}
+
+			// activity already started or completed, or is dispatched to another task list,
+			// it is OK to drop the task at this point
+			if !isRunning || ai.StartedID != common.EmptyEventID || ai.TaskList != request.GetTaskList() {
+				return ErrActivityTaskNotFound
+			}
+
+			if _, err := msBuilder.AddActivityTaskTimedOutEvent(
+				scheduleID, ai.StartedID, workflow.TimeoutTypeScheduleToStart, ai.Details,
+			); err != nil {
+				return err
+			}
+			return nil
+		})
+}
+
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(
 	ctx ctx.Context,
@@ -1099,8 +1571,10 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
+	var latencyRecorder *activityLatencyRecorder
+	err = e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
 		func(msBuilder mutableState, tBuilder *timerBuilder) error {
+			latencyRecorder = nil
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
 			}
@@ -1126,12 +1600,21 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 				return ErrActivityTaskNotFound
 			}
 
-			if _, err := msBuilder.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, request); err != nil {
+			latencyRecorder = newActivityLatencyRecorder(e.metricsClient, e.config, domainEntry.GetInfo().Name, msBuilder, ai)
+			event, err := msBuilder.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, request)
+			if err != nil {
 				// Unable to add ActivityTaskCompleted event to history
 				return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
 			}
+			latencyRecorder.setCloseEvent(event)
 			return nil
 		})
+	if err != nil {
+		return err
+	}
+
+	latencyRecorder.emit()
+	return nil
 }
 
 // RespondActivityTaskFailed completes an activity task failure.
@@ -1157,8 +1640,10 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecutionWithAction(ctx, domainID, workflowExecution,
+	var latencyRecorder *activityLatencyRecorder
+	err = e.updateWorkflowExecutionWithAction(ctx, domainID, workflowExecution,
 		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			latencyRecorder = nil
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
 			}
@@ -1191,15 +1676,24 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 			}
 			if !ok {
 				// no more retry, and we want to record the failure event
-				if _, err := msBuilder.AddActivityTaskFailedEvent(scheduleID, ai.StartedID, request); err != nil {
+				latencyRecorder = newActivityLatencyRecorder(e.metricsClient, e.config, domainEntry.GetInfo().Name, msBuilder, ai)
+				event, err := msBuilder.AddActivityTaskFailedEvent(scheduleID, ai.StartedID, request)
+				if err != nil {
 					// Unable to add ActivityTaskFailed event to history
 					return nil, &workflow.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
 				}
+				latencyRecorder.setCloseEvent(event)
 				postActions.createDecision = true
 			}
 
 			return postActions, nil
 		})
+	if err != nil {
+		return err
+	}
+
+	latencyRecorder.emit()
+	return nil
 }
 
 // RespondActivityTaskCanceled completes an activity task failure.
@@ -1225,8 +1719,10 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
+	var latencyRecorder *activityLatencyRecorder
+	err = e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
 		func(msBuilder mutableState, tBuilder *timerBuilder) error {
+			latencyRecorder = nil
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
 			}
@@ -1252,18 +1748,26 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 				return ErrActivityTaskNotFound
 			}
 
-			if _, err := msBuilder.AddActivityTaskCanceledEvent(
+			latencyRecorder = newActivityLatencyRecorder(e.metricsClient, e.config, domainEntry.GetInfo().Name, msBuilder, ai)
+			event, err := msBuilder.AddActivityTaskCanceledEvent(
 				scheduleID,
 				ai.StartedID,
 				ai.CancelRequestID,
 				request.Details,
-				common.StringDefault(request.Identity)); err != nil {
+				common.StringDefault(request.Identity))
+			if err != nil {
 				// Unable to add ActivityTaskCanceled event to history
 				return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskCanceled event to history."}
 			}
-
+			latencyRecorder.setCloseEvent(event)
 			return nil
 		})
+	if err != nil {
+		return err
+	}
+
+	latencyRecorder.emit()
+	return nil
 
 }
 
@@ -1294,6 +1798,7 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	}
 
 	var cancelRequested bool
+	var scheduleID, attempt int64
 	err = e.updateWorkflowExecution(ctx, domainID, workflowExecution, false,
 		func(msBuilder mutableState, tBuilder *timerBuilder) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
@@ -1301,7 +1806,7 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 				return ErrWorkflowCompleted
 			}
 
-			scheduleID := token.ScheduleID
+			scheduleID = token.ScheduleID
 			if scheduleID == common.EmptyEventID { // client call RecordActivityHeartbeatByID, so get scheduleID by activityID
 				scheduleID, err0 = getScheduleID(token.ActivityID, msBuilder)
 				if err0 != nil {
@@ -1325,6 +1830,8 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 			}
 
 			cancelRequested = ai.CancelRequested
+			attempt = int64(ai.Attempt)
+			workflowExecution.RunId = common.StringPtr(msBuilder.GetExecutionInfo().RunID)
 
 			e.logger.Debug(fmt.Sprintf("Activity HeartBeat: scheduleEventID: %v, ActivityInfo: %+v, CancelRequested: %v",
 				scheduleID, ai, cancelRequested))
@@ -1339,9 +1846,95 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 		return &workflow.RecordActivityTaskHeartbeatResponse{}, err
 	}
 
+	waitTimeout := time.Duration(request.GetCancellationWaitSeconds()) * time.Second
+	if maxWait := e.config.MaxActivityCancellationWait(domainEntry.GetInfo().Name); waitTimeout > maxWait {
+		waitTimeout = maxWait
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		// reply before the caller gives up on the heartbeat
+		if remaining := time.Until(deadline) - activityCancellationWaitTailroom; remaining < waitTimeout {
+			waitTimeout = remaining
+		}
+	}
+	if !cancelRequested && waitTimeout > 0 {
+		cancelRequested, err = e.waitForActivityCancellation(ctx, domainID, workflowExecution, scheduleID, attempt, waitTimeout)
+		if err != nil {
+			return &workflow.RecordActivityTaskHeartbeatResponse{}, err
+		}
+	}
+
 	return &workflow.RecordActivityTaskHeartbeatResponse{CancelRequested: common.BoolPtr(cancelRequested)}, nil
 }
 
+// waitForActivityCancellation blocks until the activity is requested to be canceled, stops running, or the
+// timeout expires, so that tight-loop activities learn about cancellation without heartbeating more often.
+// The heartbeat has already been recorded at this point, so expiring the wait is not an error.
+func (e *historyEngineImpl) waitForActivityCancellation(
+	ctx ctx.Context,
+	domainID string,
+	execution workflow.WorkflowExecution,
+	scheduleID int64,
+	attempt int64,
+	timeout time.Duration,
+) (bool, error) {
+
+	identifier := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowId(), execution.GetRunId())
+	subscriberID, channel, err := e.historyEventNotifier.WatchHistoryEvent(identifier)
+	if err != nil {
+		return false, err
+	}
+	defer e.historyEventNotifier.UnwatchHistoryEvent(identifier, subscriberID)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		// check after subscribing so that a cancellation recorded in between is not missed
+		cancelRequested, isRunning, err := e.getActivityCancelRequested(ctx, domainID, execution, scheduleID, attempt)
+		if err != nil || cancelRequested || !isRunning {
+			return cancelRequested, err
+		}
+
+		select {
+		case event := <-channel:
+			if !event.isWorkflowRunning {
+				return false, nil
+			}
+		case <-timer.C:
+			return false, nil
+		case <-ctx.Done():
+			return false, nil
+		}
+	}
+}
+
+func (e *historyEngineImpl) getActivityCancelRequested(
+	ctx ctx.Context,
+	domainID string,
+	execution workflow.WorkflowExecution,
+	scheduleID int64,
+	attempt int64,
+) (cancelRequested bool, isRunning bool, retError error) {
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return false, false, err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		return false, false, err
+	}
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		return false, false, nil
+	}
+	ai, ok := msBuilder.GetActivityInfo(scheduleID)
+	if !ok || ai.StartedID == common.EmptyEventID || int64(ai.Attempt) != attempt {
+		return false, false, nil
+	}
+	return ai.CancelRequested, true, nil
+}
+
 // RequestCancelWorkflowExecution records request cancellation event for workflow execution
 func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 	ctx ctx.Context,
@@ -1453,10 +2046,35 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 		}
 
 		// deduplicate by request id for signal decision
-		if requestID := request.GetRequestId(); requestID != "" {
-			if msBuilder.IsSignalRequested(requestID) {
-				return postActions, nil
+		requestID := request.GetRequestId()
+		if requestID != "" && msBuilder.IsSignalRequested(requestID) {
+			return postActions, nil
+		}
+
+		// the precondition is checked after deduplication so a retried signal which was already applied succeeds
+		if request.IsSetExpectedNextEventId() && msBuilder.HasBufferedEvents() {
+			// buffered events get their event IDs only once the in-flight decision completes, so the next
+			// event ID does not account for them and the precondition cannot be checked until then
+			return nil, &workflow.PreconditionFailedError{
+				Message: fmt.Sprintf(
+					"Workflow execution has events buffered after next event ID %v, retry after the in-flight decision completes.",
+					msBuilder.GetNextEventID(),
+				),
+				NextEventId: common.Int64Ptr(msBuilder.GetNextEventID()),
+			}
+		}
+		if request.IsSetExpectedNextEventId() && request.GetExpectedNextEventId() != msBuilder.GetNextEventID() {
+			return nil, &workflow.PreconditionFailedError{
+				Message: fmt.Sprintf(
+					"Workflow execution next event ID %v does not match expected next event ID %v.",
+					msBuilder.GetNextEventID(),
+					request.GetExpectedNextEventId(),
+				),
+				NextEventId: common.Int64Ptr(msBuilder.GetNextEventID()),
 			}
+		}
+
+		if requestID != "" {
 			msBuilder.AddSignalRequested(requestID)
 		}
 
@@ -1471,6 +2089,40 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	})
 }
 
+func (e *historyEngineImpl) AddOperatorAnnotation(
+	ctx ctx.Context,
+	annotationRequest *h.AddOperatorAnnotationRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(annotationRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := annotationRequest.AnnotationRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+		if !msBuilder.IsWorkflowExecutionRunning() {
+			return nil, ErrWorkflowCompleted
+		}
+
+		if err := msBuilder.AddOperatorAnnotation(
+			request.GetMessage(),
+			request.GetIdentity(),
+			e.shard.GetTimeSource().Now()); err != nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to add operator annotation to workflow execution."}
+		}
+
+		// the annotation is not meant for the workflow, so no decision is scheduled for it
+		return &updateWorkflowAction{}, nil
+	})
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx ctx.Context,
 	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest,
@@ -1578,7 +2230,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	execution = workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(workflowID),
-		RunId:      common.StringPtr(uuid.New()),
+		RunId:      common.StringPtr(e.config.IDGenerator.RunID()),
 	}
 
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
@@ -1654,6 +2306,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	stats := newExecutionStats(newWorkflowEventsSeq[0].Events, historySize)
 
 	createMode := persistence.CreateWorkflowModeBrandNew
 	prevRunID := ""
@@ -1664,7 +2317,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		prevLastWriteVersion = prevMutableState.GetLastWriteVersion()
 	}
 	err = context.createWorkflowExecution(
-		newWorkflow, historySize, now,
+		newWorkflow, stats, now,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
 
@@ -1681,6 +2334,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	meterHistoryEvents(e.shard.GetService().GetMeter(), domainID, newWorkflowEventsSeq[0].Events, historySize)
 	return &workflow.StartWorkflowExecutionResponse{
 		RunId: execution.RunId,
 	}, nil
@@ -1738,6 +2392,10 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 				return ErrWorkflowCompleted
 			}
 
+			if msBuilder.IsWorkflowExecutionTerminationProtected() && !request.GetForce() {
+				return ErrWorkflowTerminationProtected
+			}
+
 			if _, err := msBuilder.AddWorkflowExecutionTerminatedEvent(
 				request.GetReason(),
 				request.GetDetails(),
@@ -1750,6 +2408,174 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// SetTerminationProtection sets or clears the termination protection of an existing workflow execution,
+// a protected execution can only be terminated when the termination is forced.
+func (e *historyEngineImpl) SetTerminationProtection(
+	ctx ctx.Context,
+	request *h.SetTerminationProtectionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			if msBuilder.IsWorkflowExecutionTerminationProtected() == request.GetTerminationProtected() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionTerminationProtectionSetEvent(
+				request.GetTerminationProtected(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, err
+			}
+			e.logger.Info(
+				fmt.Sprintf("Workflow execution termination protection set to %v by %v.", request.GetTerminationProtected(), request.GetIdentity()),
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+			)
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// ReplicateWorkflowExecution starts replicating a running workflow execution which was started before its
+// domain was promoted to a global domain. Executions started after the promotion are already replicated.
+func (e *historyEngineImpl) ReplicateWorkflowExecution(
+	ctx ctx.Context,
+	request *h.ReplicateWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	if !domainEntry.IsGlobalDomain() {
+		return &workflow.BadRequestError{Message: "Workflow executions of a local domain are not replicated."}
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if msBuilder.GetReplicationState() != nil {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := msBuilder.StartReplication(
+				domainEntry.GetFailoverVersion(),
+				domainEntry.GetReplicationPolicy(),
+			); err != nil {
+				return nil, err
+			}
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// PauseWorkflowExecution pauses an existing workflow execution, pending decision / activity tasks are not
+// dispatched and timers are deferred until the execution is resumed.
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx ctx.Context,
+	pauseRequest *h.PauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			if msBuilder.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := msBuilder.PauseWorkflowExecution(); err != nil {
+				return nil, err
+			}
+			e.logger.Info(
+				fmt.Sprintf("Workflow execution paused by %v, reason: %v.", request.GetIdentity(), request.GetReason()),
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+			)
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// ResumeWorkflowExecution resumes a paused workflow execution, the pending decision / activity tasks
+// are dispatched again and the deferred timers are fired.
+func (e *historyEngineImpl) ResumeWorkflowExecution(
+	ctx ctx.Context,
+	resumeRequest *h.ResumeWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(resumeRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := resumeRequest.ResumeRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			if !msBuilder.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := msBuilder.ResumeWorkflowExecution(e.shard.GetTimeSource().Now()); err != nil {
+				return nil, err
+			}
+			e.logger.Info(
+				fmt.Sprintf("Workflow execution resumed by %v.", request.GetIdentity()),
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+			)
+			return &updateWorkflowAction{}, nil
+		})
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx ctx.Context,
@@ -1938,13 +2764,17 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 
 func (e *historyEngineImpl) DeleteExecutionFromVisibility(
 	task *persistence.TimerTaskInfo,
+	startTimestamp int64,
+	closeTimestamp int64,
 ) error {
 
 	request := &persistence.VisibilityDeleteWorkflowExecutionRequest{
-		DomainID:   task.DomainID,
-		WorkflowID: task.WorkflowID,
-		RunID:      task.RunID,
-		TaskID:     task.TaskID,
+		DomainID:       task.DomainID,
+		WorkflowID:     task.WorkflowID,
+		RunID:          task.RunID,
+		TaskID:         task.TaskID,
+		StartTimestamp: startTimestamp,
+		CloseTimestamp: closeTimestamp,
 	}
 	return e.visibilityMgr.DeleteWorkflowExecution(request) // delete from db
 }
@@ -2058,6 +2888,21 @@ func getWorkflowHistoryCleanupTasksFromShard(
 	return &persistence.CloseExecutionTask{}, deleteTask, nil
 }
 
+// getVisibilityRetention returns how long the visibility record of a closed workflow is kept,
+// or zero if the record is deleted together with the workflow execution at the domain retention
+func getVisibilityRetention(
+	config *Config,
+	domainName string,
+	retention time.Duration,
+) time.Duration {
+
+	visibilityRetention := config.VisibilityRetention(domainName)
+	if visibilityRetention <= retention {
+		return 0
+	}
+	return visibilityRetention
+}
+
 func createDeleteHistoryEventTimerTask(
 	tBuilder *timerBuilder,
 	retentionInDays int32,
@@ -2106,7 +2951,7 @@ func (e *historyEngineImpl) getTimerBuilder(
 ) *timerBuilder {
 
 	log := e.logger.WithTags(tag.WorkflowID(we.GetWorkflowId()), tag.WorkflowRunID(we.GetRunId()))
-	return newTimerBuilder(log, clock.NewRealTimeSource())
+	return newTimerBuilder(log, e.shard.GetTimeSource())
 }
 
 func (e *historyEngineImpl) NotifyNewHistoryEvent(
@@ -2286,6 +3131,7 @@ func getStartRequest(
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
+		TerminationProtected:                request.TerminationProtected,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
@@ -2308,6 +3154,91 @@ func setTaskInfo(
 	}
 }
 
+// terminateIfRunningAndStart terminates the current run of the workflow, if it is running, and starts the new run
+// in the same transaction, returns the run ID of the started workflow or empty if there is no running workflow
+// so that the new run is created as usual
+func (e *historyEngineImpl) terminateIfRunningAndStart(
+	ctx ctx.Context,
+	domainEntry *cache.DomainCacheEntry,
+	newContext workflowExecutionContext,
+	newMutableState mutableState,
+	request *workflow.StartWorkflowExecutionRequest,
+) (retRunID string, retError error) {
+
+	domainID := domainEntry.GetInfo().ID
+	resp, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: request.GetWorkflowId(),
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return "", nil
+		}
+		return "", err
+	}
+	if resp.State == persistence.WorkflowStateCompleted {
+		return "", nil
+	}
+	currentRunID := resp.RunID
+
+	currentContext, currentRelease, err := e.historyCache.getOrCreateWorkflowExecution(
+		ctx,
+		domainID,
+		workflow.WorkflowExecution{
+			WorkflowId: request.WorkflowId,
+			RunId:      common.StringPtr(currentRunID),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	defer func() { currentRelease(retError) }()
+
+	currentMutableState, err := currentContext.loadWorkflowExecution()
+	if err != nil {
+		return "", err
+	}
+	if !currentMutableState.IsWorkflowExecutionRunning() {
+		return "", nil
+	}
+
+	currentExecutionInfo := currentMutableState.GetExecutionInfo()
+	if currentExecutionInfo.CreateRequestID == request.GetRequestId() {
+		// duplicate start request of the running workflow
+		return currentRunID, nil
+	}
+
+	if currentMutableState.GetLastWriteVersion() > newMutableState.GetCurrentVersion() {
+		clusterMetadata := e.shard.GetService().GetClusterMetadata()
+		return "", ce.NewDomainNotActiveError(
+			domainEntry.GetInfo().Name,
+			clusterMetadata.GetCurrentClusterName(),
+			clusterMetadata.ClusterNameForFailoverVersion(currentMutableState.GetLastWriteVersion()),
+		)
+	}
+
+	if currentMutableState.IsWorkflowExecutionTerminationProtected() {
+		return "", ErrWorkflowTerminationProtected
+	}
+
+	if _, err := currentMutableState.AddWorkflowExecutionTerminatedEvent(
+		workflowIDReuseTerminateReason,
+		[]byte(fmt.Sprintf("terminated by new run with request ID: %v", request.GetRequestId())),
+		request.GetIdentity(),
+	); err != nil {
+		return "", &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+	}
+
+	if err := currentContext.updateWorkflowExecutionWithNewAsActive(
+		e.timeSource.Now(),
+		newContext,
+		newMutableState,
+	); err != nil {
+		return "", err
+	}
+	return newMutableState.GetExecutionInfo().RunID, nil
+}
+
 // for startWorkflowExecution & signalWithStart to handle workflow reuse policy
 func (e *historyEngineImpl) applyWorkflowIDReusePolicyForSigWithStart(
 	prevExecutionInfo *persistence.WorkflowExecutionInfo,
@@ -2355,7 +3286,8 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(
 			msg := "Workflow execution already finished successfully. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: allow duplicate workflow ID if last run failed."
 			return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowId(), prevRunID)
 		}
-	case workflow.WorkflowIdReusePolicyAllowDuplicate:
+	case workflow.WorkflowIdReusePolicyAllowDuplicate,
+		workflow.WorkflowIdReusePolicyTerminateIfRunning:
 		// as long as workflow not running, so this case has no check
 	case workflow.WorkflowIdReusePolicyRejectDuplicate:
 		msg := "Workflow execution already finished. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: reject duplicate workflow ID."
@@ -2389,3 +3321,81 @@ func (e *historyEngineImpl) GetReplicationMessages(ctx ctx.Context, taskID int64
 	e.logger.Debug("Successfully fetched replication messages.", tag.Counter(len(replicationMessages.ReplicationTasks)))
 	return replicationMessages, nil
 }
+
+// GetReplicationStatus returns the replication read and ack levels of the shard, when domainID is not empty
+// the max read level is the ID of the last replication task of the domain not yet acked by every cluster
+func (e *historyEngineImpl) GetReplicationStatus(ctx ctx.Context, domainID string) (*h.ShardReplicationStatus, error) {
+	currentClusterName := e.clusterMetadata.GetCurrentClusterName()
+	replicationAckLevel := e.shard.GetReplicatorAckLevel()
+	minAckLevel := replicationAckLevel
+	clusterReplicationLevel := make(map[string]int64)
+	for clusterName, info := range e.clusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled || clusterName == currentClusterName {
+			continue
+		}
+		level := e.shard.GetClusterReplicationLevel(clusterName)
+		clusterReplicationLevel[clusterName] = level
+		if level < minAckLevel {
+			minAckLevel = level
+		}
+	}
+
+	maxReadLevel := e.shard.GetTransferMaxReadLevel()
+	if domainID != "" {
+		var err error
+		maxReadLevel, err = e.getDomainReplicationReadLevel(domainID, minAckLevel, maxReadLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &h.ShardReplicationStatus{
+		ShardID:                 common.Int32Ptr(int32(e.shard.GetShardID())),
+		MaxReadLevel:            common.Int64Ptr(maxReadLevel),
+		ReplicationAckLevel:     common.Int64Ptr(replicationAckLevel),
+		ClusterReplicationLevel: clusterReplicationLevel,
+	}, nil
+}
+
+// getDomainReplicationReadLevel returns the ID of the last replication task of the domain in (readLevel, maxReadLevel],
+// or readLevel if the domain has no replication task in that range
+func (e *historyEngineImpl) getDomainReplicationReadLevel(
+	domainID string,
+	readLevel int64,
+	maxReadLevel int64,
+) (int64, error) {
+
+	domainReadLevel := readLevel
+	var pageToken []byte
+	for {
+		response, err := e.executionManager.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:     readLevel,
+			MaxReadLevel:  maxReadLevel,
+			BatchSize:     e.config.ReplicatorTaskBatchSize(),
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, task := range response.Tasks {
+			if task.DomainID == domainID && task.TaskID > domainReadLevel {
+				domainReadLevel = task.TaskID
+			}
+		}
+		pageToken = response.NextPageToken
+		if len(pageToken) == 0 {
+			return domainReadLevel, nil
+		}
+	}
+}
+
+// DescribeShard returns the in-memory state of the shard owned by this engine
+func (e *historyEngineImpl) DescribeShard() *workflow.HistoryShardInfo {
+	return &workflow.HistoryShardInfo{
+		ShardID:             common.Int32Ptr(int32(e.shard.GetShardID())),
+		WorkflowCacheSize:   common.Int32Ptr(int32(e.historyCache.Size())),
+		TransferAckLevel:    common.Int64Ptr(e.shard.GetTransferAckLevel()),
+		TimerAckLevel:       common.Int64Ptr(e.shard.GetTimerAckLevel().UnixNano()),
+		ReplicationAckLevel: common.Int64Ptr(e.shard.GetReplicatorAckLevel()),
+	}
+}
@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 )
 
@@ -128,7 +129,7 @@ func (s *engine2Suite) SetupTest() {
 		&p.DomainInfo{ID: validDomainID}, &p.DomainConfig{}, "", nil,
 	), nil)
 	s.mockEventsCache = &MockEventsCache{}
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything).Return()
 
 	mockShard := &shardContextImpl{
@@ -841,6 +842,120 @@ func (s *engine2Suite) TestRecordDecisionTaskSuccess() {
 	s.Equal(int64(3), *response.StartedEventId)
 }
 
+func (s *engine2Suite) TestRecordDecisionTaskStarted_IncompatibleBinaryChecksum() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	tl := "testTaskList"
+	identity := "testIdentity"
+	s.config.EnableDecisionBinaryChecksumCheck = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	defer func() {
+		s.config.EnableDecisionBinaryChecksumCheck = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	}()
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	msBuilder.GetExecutionInfo().LastDecisionBinaryChecksum = "v1"
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	response, err := s.historyEngine.RecordDecisionTaskStarted(context.Background(), &h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(tl),
+			},
+			Identity:       common.StringPtr(identity),
+			BinaryChecksum: common.StringPtr("v2"),
+		},
+	})
+
+	s.Nil(response)
+	s.IsType(&h.IncompatibleBinaryChecksumError{}, err)
+	s.Equal("v1", err.(*h.IncompatibleBinaryChecksumError).GetRequiredBinaryChecksum())
+}
+
+func (s *engine2Suite) TestRecordDecisionTaskStarted_CompatibleBinaryChecksum() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	tl := "testTaskList"
+	identity := "testIdentity"
+	s.config.EnableDecisionBinaryChecksumCheck = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	s.config.CompatibleDecisionBinaryChecksums = func(domain string) string { return "v0;v1,v1.1" }
+	defer func() {
+		s.config.EnableDecisionBinaryChecksumCheck = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+		s.config.CompatibleDecisionBinaryChecksums = func(domain string) string { return "" }
+	}()
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	msBuilder.GetExecutionInfo().LastDecisionBinaryChecksum = "v1"
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	response, err := s.historyEngine.RecordDecisionTaskStarted(context.Background(), &h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(tl),
+			},
+			Identity:       common.StringPtr(identity),
+			BinaryChecksum: common.StringPtr("v1.1"),
+		},
+	})
+
+	s.Nil(err)
+	s.NotNil(response)
+	s.Equal(int64(3), *response.StartedEventId)
+}
+
 func (s *engine2Suite) TestRecordActivityTaskStartedIfNoExecution() {
 	domainID := validDomainID
 	workflowExecution := &workflow.WorkflowExecution{
@@ -930,7 +1045,7 @@ func (s *engine2Suite) TestRecordActivityTaskStartedSuccess() {
 		nil,
 	)
 
-	s.mockEventsCache.On("getEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+	s.mockEventsCache.On("GetEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
 		decisionCompletedEvent.GetEventId(), scheduledEvent.GetEventId(), mock.Anything, mock.Anything).Return(scheduledEvent, nil)
 	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &h.RecordActivityTaskStartedRequest{
 		DomainUUID:        common.StringPtr(domainID),
@@ -950,6 +1065,60 @@ func (s *engine2Suite) TestRecordActivityTaskStartedSuccess() {
 	s.Equal(scheduledEvent, response.ScheduledEvent)
 }
 
+func (s *engine2Suite) TestRecordActivityTaskStartedWorkflowPaused() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	s.NoError(msBuilder.PauseWorkflowExecution())
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	s.mockEventsCache.On("GetEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		decisionCompletedEvent.GetEventId(), scheduledEvent.GetEventId(), mock.Anything, mock.Anything).Return(scheduledEvent, nil)
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &h.RecordActivityTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(5),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(tl),
+			},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.Nil(response)
+	s.Equal(ErrWorkflowPaused, err)
+}
+
 func (s *engine2Suite) TestRequestCancelWorkflowExecutionSuccess() {
 	domainID := validDomainID
 	workflowExecution := workflow.WorkflowExecution{
@@ -1045,6 +1214,121 @@ func (s *engine2Suite) TestRequestCancelWorkflowExecutionFail() {
 	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
+func (s *engine2Suite) TestTerminateWorkflowExecution_TerminationProtected() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	_, err := msBuilder.AddWorkflowExecutionTerminationProtectionSetEvent(true, identity)
+	s.NoError(err)
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	// the mutable state is reloaded after the rejected termination
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Twice()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+	terminateRequest := &workflow.TerminateWorkflowExecutionRequest{
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: workflowExecution.WorkflowId,
+			RunId:      workflowExecution.RunId,
+		},
+		Reason:   common.StringPtr("reason"),
+		Identity: common.StringPtr("identity"),
+	}
+	err = s.historyEngine.TerminateWorkflowExecution(context.Background(), &h.TerminateWorkflowExecutionRequest{
+		DomainUUID:       common.StringPtr(domainID),
+		TerminateRequest: terminateRequest,
+	})
+	s.Equal(ErrWorkflowTerminationProtected, err)
+
+	s.mockEventsCache.On("GetEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(3),
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: workflow.EventTypeWorkflowExecutionTerminated.Ptr(),
+		WorkflowExecutionTerminatedEventAttributes: &workflow.WorkflowExecutionTerminatedEventAttributes{},
+	}, nil).Once()
+	terminateRequest.Force = common.BoolPtr(true)
+	err = s.historyEngine.TerminateWorkflowExecution(context.Background(), &h.TerminateWorkflowExecutionRequest{
+		DomainUUID:       common.StringPtr(domainID),
+		TerminateRequest: terminateRequest,
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, workflowExecution)
+	s.False(executionBuilder.IsWorkflowExecutionRunning())
+}
+
+func (s *engine2Suite) TestSetTerminationProtection() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+	request := &h.SetTerminationProtectionRequest{
+		DomainUUID:           common.StringPtr(domainID),
+		Execution:            &workflowExecution,
+		TerminationProtected: common.BoolPtr(true),
+		Identity:             common.StringPtr(identity),
+	}
+	s.Nil(s.historyEngine.SetTerminationProtection(context.Background(), request))
+	executionBuilder := s.getBuilder(domainID, workflowExecution)
+	s.True(executionBuilder.IsWorkflowExecutionTerminationProtected())
+
+	// setting the same protection again does not update the workflow execution
+	s.Nil(s.historyEngine.SetTerminationProtection(context.Background(), request))
+}
+
 func (s *engine2Suite) createExecutionStartedState(we workflow.WorkflowExecution, tl, identity string,
 	startDecision bool) mutableState {
 	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
@@ -1277,6 +1561,139 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 	s.Nil(resp)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_TerminateIfRunning() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, taskList, identity, false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&p.GetCurrentExecutionResponse{
+		StartRequestID: "oldRequestID",
+		RunID:          validRunID,
+		State:          p.WorkflowStateRunning,
+		CloseStatus:    p.WorkflowCloseStatusNone,
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Times(2)
+	s.mockEventsCache.On("GetEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(3),
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: workflow.EventTypeWorkflowExecutionTerminated.Ptr(),
+		WorkflowExecutionTerminatedEventAttributes: &workflow.WorkflowExecutionTerminatedEventAttributes{},
+	}, nil).Once()
+	s.mockExecutionMgr.On(
+		"UpdateWorkflowExecution",
+		mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+			return request.Mode == p.UpdateWorkflowModeUpdateCurrent &&
+				request.UpdateWorkflowMutation.ExecutionInfo.RunID == validRunID &&
+				request.UpdateWorkflowMutation.ExecutionInfo.CloseStatus == p.WorkflowCloseStatusTerminated &&
+				request.NewWorkflowSnapshot != nil &&
+				request.NewWorkflowSnapshot.ExecutionInfo.State == p.WorkflowStateRunning
+		}),
+	).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          workflowExecution.WorkflowId,
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr("newRequestID"),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.NotEqual(validRunID, resp.GetRunId())
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "CreateWorkflowExecution", mock.Anything)
+
+	executionBuilder := s.getBuilder(domainID, workflowExecution)
+	s.False(executionBuilder.IsWorkflowExecutionRunning())
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_TerminateIfRunning_Dedup() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	taskList := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, taskList, identity, false)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.CreateRequestID = "oldRequestID"
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&p.GetCurrentExecutionResponse{
+		StartRequestID: "oldRequestID",
+		RunID:          validRunID,
+		State:          p.WorkflowStateRunning,
+		CloseStatus:    p.WorkflowCloseStatusNone,
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&p.GetDomainResponse{
+			Info:   &p.DomainInfo{ID: domainID},
+			Config: &p.DomainConfig{Retention: 1},
+			ReplicationConfig: &p.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*p.ClusterReplicationConfig{
+					&p.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: p.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          workflowExecution.WorkflowId,
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("workflowType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr("oldRequestID"),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyTerminateIfRunning.Ptr(),
+		},
+	})
+	s.Nil(err)
+	s.Equal(validRunID, resp.GetRunId())
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	domainID := validDomainID
 	workflowID := "workflowID"
@@ -1290,9 +1707,10 @@ func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 		workflow.WorkflowIdReusePolicyAllowDuplicateFailedOnly,
 		workflow.WorkflowIdReusePolicyAllowDuplicate,
 		workflow.WorkflowIdReusePolicyRejectDuplicate,
+		workflow.WorkflowIdReusePolicyTerminateIfRunning,
 	}
 
-	expecedErrs := []bool{true, false, true}
+	expecedErrs := []bool{true, false, true, false}
 
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Times(len(expecedErrs))
 	s.mockExecutionMgr.On(
@@ -1324,6 +1742,14 @@ func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	)
 
 	for index, option := range options {
+		if option == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+			s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&p.GetCurrentExecutionResponse{
+				StartRequestID: "oldRequestID",
+				RunID:          runID,
+				State:          p.WorkflowStateCompleted,
+				CloseStatus:    p.WorkflowCloseStatusCompleted,
+			}, nil).Once()
+		}
 		if !expecedErrs[index] {
 			s.mockExecutionMgr.On(
 				"CreateWorkflowExecution",
@@ -18,6 +18,8 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -destination historyEngineInterfaces_mock.go -self_package github.com/uber/cadence/service/history github.com/uber/cadence/service/history Engine,EngineFactory,ReplicatorQueueProcessor
+
 package history
 
 import (
@@ -54,6 +56,7 @@ type (
 		DescribeWorkflowExecution(ctx context.Context, request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
 		RecordDecisionTaskStarted(ctx context.Context, request *h.RecordDecisionTaskStartedRequest) (*h.RecordDecisionTaskStartedResponse, error)
 		RecordActivityTaskStarted(ctx context.Context, request *h.RecordActivityTaskStartedRequest) (*h.RecordActivityTaskStartedResponse, error)
+		RecordActivityTaskWorkerUnavailable(ctx context.Context, request *h.RecordActivityTaskWorkerUnavailableRequest) error
 		RespondDecisionTaskCompleted(ctx context.Context, request *h.RespondDecisionTaskCompletedRequest) (*h.RespondDecisionTaskCompletedResponse, error)
 		RespondDecisionTaskFailed(ctx context.Context, request *h.RespondDecisionTaskFailedRequest) error
 		RespondActivityTaskCompleted(ctx context.Context, request *h.RespondActivityTaskCompletedRequest) error
@@ -62,9 +65,12 @@ type (
 		RecordActivityTaskHeartbeat(ctx context.Context, request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *h.RequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *h.SignalWorkflowExecutionRequest) error
+		AddOperatorAnnotation(ctx context.Context, request *h.AddOperatorAnnotationRequest) error
 		SignalWithStartWorkflowExecution(ctx context.Context, request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *h.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error
+		ResumeWorkflowExecution(ctx context.Context, request *h.ResumeWorkflowExecutionRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *h.RecordChildExecutionCompletedRequest) error
@@ -74,6 +80,13 @@ type (
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
 		GetReplicationMessages(ctx context.Context, taskID int64) (*replicator.ReplicationMessages, error)
 		QueryWorkflow(ctx context.Context, request *h.QueryWorkflowRequest) (*h.QueryWorkflowResponse, error)
+		GetReplicationStatus(ctx context.Context, domainID string) (*h.ShardReplicationStatus, error)
+		DescribeShard() *workflow.HistoryShardInfo
+		DetectZombieWorkflowExecution(ctx context.Context, request *h.DetectZombieWorkflowExecutionRequest) (*h.DetectZombieWorkflowExecutionResponse, error)
+		DeleteWorkflowExecution(ctx context.Context, request *h.DeleteWorkflowExecutionRequest) error
+		ReindexWorkflowExecution(ctx context.Context, request *h.ReindexWorkflowExecutionRequest) error
+		SetTerminationProtection(ctx context.Context, request *h.SetTerminationProtectionRequest) error
+		ReplicateWorkflowExecution(ctx context.Context, request *h.ReplicateWorkflowExecutionRequest) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -122,6 +135,13 @@ type (
 		getTaskFilter() queueTaskFilter
 	}
 
+	// taskParker is implemented by the task executors whose tasks can be held back while processing of their
+	// domain is paused, the ack level moves past parked tasks so that they do not hold back the rest of the queue
+	taskParker interface {
+		park(task queueTaskInfo)
+		unpark(task queueTaskInfo)
+	}
+
 	processor interface {
 		taskExecutor
 		readTasks(readLevel int64) ([]queueTaskInfo, bool, error)
@@ -156,7 +176,11 @@ type (
 	timerQueueAckMgr interface {
 		getFinishedChan() <-chan struct{}
 		readTimerTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, bool, error)
+		readTimerWheelTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, bool)
+		notifyNewTimer(newTime time.Time)
 		completeTimerTask(timerTask *persistence.TimerTaskInfo)
+		parkTimerTask(timerTask *persistence.TimerTaskInfo)
+		unparkTimerTask(timerTask *persistence.TimerTaskInfo)
 		getAckLevel() TimerSequenceID
 		getReadLevel() TimerSequenceID
 		updateAckLevel()
@@ -0,0 +1,791 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uber/cadence/service/history (interfaces: Engine,EngineFactory,ReplicatorQueueProcessor)
+
+// Package history is a generated GoMock package.
+package history
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	history "github.com/uber/cadence/.gen/go/history"
+	replicator "github.com/uber/cadence/.gen/go/replicator"
+	shared "github.com/uber/cadence/.gen/go/shared"
+	persistence "github.com/uber/cadence/common/persistence"
+	reflect "reflect"
+)
+
+// MockEngine is a mock of Engine interface
+type MockEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockEngineMockRecorder
+}
+
+// MockEngineMockRecorder is the mock recorder for MockEngine
+type MockEngineMockRecorder struct {
+	mock *MockEngine
+}
+
+// NewMockEngine creates a new mock instance
+func NewMockEngine(ctrl *gomock.Controller) *MockEngine {
+	mock := &MockEngine{ctrl: ctrl}
+	mock.recorder = &MockEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
+	return m.recorder
+}
+
+// AddOperatorAnnotation mocks base method
+func (m *MockEngine) AddOperatorAnnotation(arg0 context.Context, arg1 *history.AddOperatorAnnotationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOperatorAnnotation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOperatorAnnotation indicates an expected call of AddOperatorAnnotation
+func (mr *MockEngineMockRecorder) AddOperatorAnnotation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOperatorAnnotation", reflect.TypeOf((*MockEngine)(nil).AddOperatorAnnotation), arg0, arg1)
+}
+
+// DeleteWorkflowExecution mocks base method
+func (m *MockEngine) DeleteWorkflowExecution(arg0 context.Context, arg1 *history.DeleteWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution
+func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
+
+// DescribeMutableState mocks base method
+func (m *MockEngine) DescribeMutableState(arg0 context.Context, arg1 *history.DescribeMutableStateRequest) (*history.DescribeMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*history.DescribeMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMutableState indicates an expected call of DescribeMutableState
+func (mr *MockEngineMockRecorder) DescribeMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockEngine)(nil).DescribeMutableState), arg0, arg1)
+}
+
+// DescribeShard mocks base method
+func (m *MockEngine) DescribeShard() *shared.HistoryShardInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShard")
+	ret0, _ := ret[0].(*shared.HistoryShardInfo)
+	return ret0
+}
+
+// DescribeShard indicates an expected call of DescribeShard
+func (mr *MockEngineMockRecorder) DescribeShard() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShard", reflect.TypeOf((*MockEngine)(nil).DescribeShard))
+}
+
+// DescribeWorkflowExecution mocks base method
+func (m *MockEngine) DescribeWorkflowExecution(arg0 context.Context, arg1 *history.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*shared.DescribeWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowExecution indicates an expected call of DescribeWorkflowExecution
+func (mr *MockEngineMockRecorder) DescribeWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowExecution), arg0, arg1)
+}
+
+// DetectZombieWorkflowExecution mocks base method
+func (m *MockEngine) DetectZombieWorkflowExecution(arg0 context.Context, arg1 *history.DetectZombieWorkflowExecutionRequest) (*history.DetectZombieWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectZombieWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*history.DetectZombieWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectZombieWorkflowExecution indicates an expected call of DetectZombieWorkflowExecution
+func (mr *MockEngineMockRecorder) DetectZombieWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectZombieWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DetectZombieWorkflowExecution), arg0, arg1)
+}
+
+// GetMutableState mocks base method
+func (m *MockEngine) GetMutableState(arg0 context.Context, arg1 *history.GetMutableStateRequest) (*history.GetMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*history.GetMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutableState indicates an expected call of GetMutableState
+func (mr *MockEngineMockRecorder) GetMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockEngine)(nil).GetMutableState), arg0, arg1)
+}
+
+// GetReplicationMessages mocks base method
+func (m *MockEngine) GetReplicationMessages(arg0 context.Context, arg1 int64) (*replicator.ReplicationMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationMessages", arg0, arg1)
+	ret0, _ := ret[0].(*replicator.ReplicationMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationMessages indicates an expected call of GetReplicationMessages
+func (mr *MockEngineMockRecorder) GetReplicationMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), arg0, arg1)
+}
+
+// GetReplicationStatus mocks base method
+func (m *MockEngine) GetReplicationStatus(arg0 context.Context, arg1 string) (*history.ShardReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*history.ShardReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus
+func (mr *MockEngineMockRecorder) GetReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockEngine)(nil).GetReplicationStatus), arg0, arg1)
+}
+
+// NotifyNewHistoryEvent mocks base method
+func (m *MockEngine) NotifyNewHistoryEvent(arg0 *historyEventNotification) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewHistoryEvent", arg0)
+}
+
+// NotifyNewHistoryEvent indicates an expected call of NotifyNewHistoryEvent
+func (mr *MockEngineMockRecorder) NotifyNewHistoryEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewHistoryEvent", reflect.TypeOf((*MockEngine)(nil).NotifyNewHistoryEvent), arg0)
+}
+
+// NotifyNewReplicationTasks mocks base method
+func (m *MockEngine) NotifyNewReplicationTasks(arg0 []persistence.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewReplicationTasks", arg0)
+}
+
+// NotifyNewReplicationTasks indicates an expected call of NotifyNewReplicationTasks
+func (mr *MockEngineMockRecorder) NotifyNewReplicationTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewReplicationTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewReplicationTasks), arg0)
+}
+
+// NotifyNewTimerTasks mocks base method
+func (m *MockEngine) NotifyNewTimerTasks(arg0 []persistence.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewTimerTasks", arg0)
+}
+
+// NotifyNewTimerTasks indicates an expected call of NotifyNewTimerTasks
+func (mr *MockEngineMockRecorder) NotifyNewTimerTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTimerTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTimerTasks), arg0)
+}
+
+// NotifyNewTransferTasks mocks base method
+func (m *MockEngine) NotifyNewTransferTasks(arg0 []persistence.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewTransferTasks", arg0)
+}
+
+// NotifyNewTransferTasks indicates an expected call of NotifyNewTransferTasks
+func (mr *MockEngineMockRecorder) NotifyNewTransferTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTransferTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTransferTasks), arg0)
+}
+
+// PauseWorkflowExecution mocks base method
+func (m *MockEngine) PauseWorkflowExecution(arg0 context.Context, arg1 *history.PauseWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution
+func (mr *MockEngineMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
+// QueryWorkflow mocks base method
+func (m *MockEngine) QueryWorkflow(arg0 context.Context, arg1 *history.QueryWorkflowRequest) (*history.QueryWorkflowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(*history.QueryWorkflowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryWorkflow indicates an expected call of QueryWorkflow
+func (mr *MockEngineMockRecorder) QueryWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockEngine)(nil).QueryWorkflow), arg0, arg1)
+}
+
+// RecordActivityTaskHeartbeat mocks base method
+func (m *MockEngine) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *history.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivityTaskHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*shared.RecordActivityTaskHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordActivityTaskHeartbeat indicates an expected call of RecordActivityTaskHeartbeat
+func (mr *MockEngineMockRecorder) RecordActivityTaskHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivityTaskHeartbeat", reflect.TypeOf((*MockEngine)(nil).RecordActivityTaskHeartbeat), arg0, arg1)
+}
+
+// RecordActivityTaskStarted mocks base method
+func (m *MockEngine) RecordActivityTaskStarted(arg0 context.Context, arg1 *history.RecordActivityTaskStartedRequest) (*history.RecordActivityTaskStartedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivityTaskStarted", arg0, arg1)
+	ret0, _ := ret[0].(*history.RecordActivityTaskStartedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordActivityTaskStarted indicates an expected call of RecordActivityTaskStarted
+func (mr *MockEngineMockRecorder) RecordActivityTaskStarted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivityTaskStarted", reflect.TypeOf((*MockEngine)(nil).RecordActivityTaskStarted), arg0, arg1)
+}
+
+// RecordActivityTaskWorkerUnavailable mocks base method
+func (m *MockEngine) RecordActivityTaskWorkerUnavailable(arg0 context.Context, arg1 *history.RecordActivityTaskWorkerUnavailableRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivityTaskWorkerUnavailable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordActivityTaskWorkerUnavailable indicates an expected call of RecordActivityTaskWorkerUnavailable
+func (mr *MockEngineMockRecorder) RecordActivityTaskWorkerUnavailable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivityTaskWorkerUnavailable", reflect.TypeOf((*MockEngine)(nil).RecordActivityTaskWorkerUnavailable), arg0, arg1)
+}
+
+// RecordChildExecutionCompleted mocks base method
+func (m *MockEngine) RecordChildExecutionCompleted(arg0 context.Context, arg1 *history.RecordChildExecutionCompletedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordChildExecutionCompleted", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordChildExecutionCompleted indicates an expected call of RecordChildExecutionCompleted
+func (mr *MockEngineMockRecorder) RecordChildExecutionCompleted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockEngine)(nil).RecordChildExecutionCompleted), arg0, arg1)
+}
+
+// RecordDecisionTaskStarted mocks base method
+func (m *MockEngine) RecordDecisionTaskStarted(arg0 context.Context, arg1 *history.RecordDecisionTaskStartedRequest) (*history.RecordDecisionTaskStartedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDecisionTaskStarted", arg0, arg1)
+	ret0, _ := ret[0].(*history.RecordDecisionTaskStartedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordDecisionTaskStarted indicates an expected call of RecordDecisionTaskStarted
+func (mr *MockEngineMockRecorder) RecordDecisionTaskStarted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDecisionTaskStarted", reflect.TypeOf((*MockEngine)(nil).RecordDecisionTaskStarted), arg0, arg1)
+}
+
+// ReindexWorkflowExecution mocks base method
+func (m *MockEngine) ReindexWorkflowExecution(arg0 context.Context, arg1 *history.ReindexWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReindexWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReindexWorkflowExecution indicates an expected call of ReindexWorkflowExecution
+func (mr *MockEngineMockRecorder) ReindexWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ReindexWorkflowExecution), arg0, arg1)
+}
+
+// RemoveSignalMutableState mocks base method
+func (m *MockEngine) RemoveSignalMutableState(arg0 context.Context, arg1 *history.RemoveSignalMutableStateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSignalMutableState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSignalMutableState indicates an expected call of RemoveSignalMutableState
+func (mr *MockEngineMockRecorder) RemoveSignalMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSignalMutableState", reflect.TypeOf((*MockEngine)(nil).RemoveSignalMutableState), arg0, arg1)
+}
+
+// ReplicateEvents mocks base method
+func (m *MockEngine) ReplicateEvents(arg0 context.Context, arg1 *history.ReplicateEventsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateEvents indicates an expected call of ReplicateEvents
+func (mr *MockEngineMockRecorder) ReplicateEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateEvents", reflect.TypeOf((*MockEngine)(nil).ReplicateEvents), arg0, arg1)
+}
+
+// ReplicateRawEvents mocks base method
+func (m *MockEngine) ReplicateRawEvents(arg0 context.Context, arg1 *history.ReplicateRawEventsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateRawEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateRawEvents indicates an expected call of ReplicateRawEvents
+func (mr *MockEngineMockRecorder) ReplicateRawEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateRawEvents", reflect.TypeOf((*MockEngine)(nil).ReplicateRawEvents), arg0, arg1)
+}
+
+// ReplicateWorkflowExecution mocks base method
+func (m *MockEngine) ReplicateWorkflowExecution(arg0 context.Context, arg1 *history.ReplicateWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecution indicates an expected call of ReplicateWorkflowExecution
+func (mr *MockEngineMockRecorder) ReplicateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ReplicateWorkflowExecution), arg0, arg1)
+}
+
+// RequestCancelWorkflowExecution mocks base method
+func (m *MockEngine) RequestCancelWorkflowExecution(arg0 context.Context, arg1 *history.RequestCancelWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestCancelWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestCancelWorkflowExecution indicates an expected call of RequestCancelWorkflowExecution
+func (mr *MockEngineMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RequestCancelWorkflowExecution), arg0, arg1)
+}
+
+// ResetStickyTaskList mocks base method
+func (m *MockEngine) ResetStickyTaskList(arg0 context.Context, arg1 *history.ResetStickyTaskListRequest) (*history.ResetStickyTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetStickyTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*history.ResetStickyTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetStickyTaskList indicates an expected call of ResetStickyTaskList
+func (mr *MockEngineMockRecorder) ResetStickyTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetStickyTaskList", reflect.TypeOf((*MockEngine)(nil).ResetStickyTaskList), arg0, arg1)
+}
+
+// ResetWorkflowExecution mocks base method
+func (m *MockEngine) ResetWorkflowExecution(arg0 context.Context, arg1 *history.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*shared.ResetWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecution indicates an expected call of ResetWorkflowExecution
+func (mr *MockEngineMockRecorder) ResetWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ResetWorkflowExecution), arg0, arg1)
+}
+
+// RespondActivityTaskCanceled mocks base method
+func (m *MockEngine) RespondActivityTaskCanceled(arg0 context.Context, arg1 *history.RespondActivityTaskCanceledRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondActivityTaskCanceled", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondActivityTaskCanceled indicates an expected call of RespondActivityTaskCanceled
+func (mr *MockEngineMockRecorder) RespondActivityTaskCanceled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskCanceled", reflect.TypeOf((*MockEngine)(nil).RespondActivityTaskCanceled), arg0, arg1)
+}
+
+// RespondActivityTaskCompleted mocks base method
+func (m *MockEngine) RespondActivityTaskCompleted(arg0 context.Context, arg1 *history.RespondActivityTaskCompletedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondActivityTaskCompleted", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondActivityTaskCompleted indicates an expected call of RespondActivityTaskCompleted
+func (mr *MockEngineMockRecorder) RespondActivityTaskCompleted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskCompleted", reflect.TypeOf((*MockEngine)(nil).RespondActivityTaskCompleted), arg0, arg1)
+}
+
+// RespondActivityTaskFailed mocks base method
+func (m *MockEngine) RespondActivityTaskFailed(arg0 context.Context, arg1 *history.RespondActivityTaskFailedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondActivityTaskFailed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondActivityTaskFailed indicates an expected call of RespondActivityTaskFailed
+func (mr *MockEngineMockRecorder) RespondActivityTaskFailed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondActivityTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondActivityTaskFailed), arg0, arg1)
+}
+
+// RespondDecisionTaskCompleted mocks base method
+func (m *MockEngine) RespondDecisionTaskCompleted(arg0 context.Context, arg1 *history.RespondDecisionTaskCompletedRequest) (*history.RespondDecisionTaskCompletedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondDecisionTaskCompleted", arg0, arg1)
+	ret0, _ := ret[0].(*history.RespondDecisionTaskCompletedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondDecisionTaskCompleted indicates an expected call of RespondDecisionTaskCompleted
+func (mr *MockEngineMockRecorder) RespondDecisionTaskCompleted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskCompleted", reflect.TypeOf((*MockEngine)(nil).RespondDecisionTaskCompleted), arg0, arg1)
+}
+
+// RespondDecisionTaskFailed mocks base method
+func (m *MockEngine) RespondDecisionTaskFailed(arg0 context.Context, arg1 *history.RespondDecisionTaskFailedRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondDecisionTaskFailed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondDecisionTaskFailed indicates an expected call of RespondDecisionTaskFailed
+func (mr *MockEngineMockRecorder) RespondDecisionTaskFailed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDecisionTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondDecisionTaskFailed), arg0, arg1)
+}
+
+// ResumeWorkflowExecution mocks base method
+func (m *MockEngine) ResumeWorkflowExecution(arg0 context.Context, arg1 *history.ResumeWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution
+func (mr *MockEngineMockRecorder) ResumeWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ResumeWorkflowExecution), arg0, arg1)
+}
+
+// ScheduleDecisionTask mocks base method
+func (m *MockEngine) ScheduleDecisionTask(arg0 context.Context, arg1 *history.ScheduleDecisionTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDecisionTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleDecisionTask indicates an expected call of ScheduleDecisionTask
+func (mr *MockEngineMockRecorder) ScheduleDecisionTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDecisionTask", reflect.TypeOf((*MockEngine)(nil).ScheduleDecisionTask), arg0, arg1)
+}
+
+// SetTerminationProtection mocks base method
+func (m *MockEngine) SetTerminationProtection(arg0 context.Context, arg1 *history.SetTerminationProtectionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTerminationProtection", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTerminationProtection indicates an expected call of SetTerminationProtection
+func (mr *MockEngineMockRecorder) SetTerminationProtection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTerminationProtection", reflect.TypeOf((*MockEngine)(nil).SetTerminationProtection), arg0, arg1)
+}
+
+// SignalWithStartWorkflowExecution mocks base method
+func (m *MockEngine) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *history.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalWithStartWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*shared.StartWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalWithStartWorkflowExecution indicates an expected call of SignalWithStartWorkflowExecution
+func (mr *MockEngineMockRecorder) SignalWithStartWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWithStartWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).SignalWithStartWorkflowExecution), arg0, arg1)
+}
+
+// SignalWorkflowExecution mocks base method
+func (m *MockEngine) SignalWorkflowExecution(arg0 context.Context, arg1 *history.SignalWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalWorkflowExecution indicates an expected call of SignalWorkflowExecution
+func (mr *MockEngineMockRecorder) SignalWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).SignalWorkflowExecution), arg0, arg1)
+}
+
+// Start mocks base method
+func (m *MockEngine) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start
+func (mr *MockEngineMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockEngine)(nil).Start))
+}
+
+// StartWorkflowExecution mocks base method
+func (m *MockEngine) StartWorkflowExecution(arg0 context.Context, arg1 *history.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*shared.StartWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWorkflowExecution indicates an expected call of StartWorkflowExecution
+func (mr *MockEngineMockRecorder) StartWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).StartWorkflowExecution), arg0, arg1)
+}
+
+// Stop mocks base method
+func (m *MockEngine) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockEngineMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockEngine)(nil).Stop))
+}
+
+// SyncActivity mocks base method
+func (m *MockEngine) SyncActivity(arg0 context.Context, arg1 *history.SyncActivityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncActivity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncActivity indicates an expected call of SyncActivity
+func (mr *MockEngineMockRecorder) SyncActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncActivity", reflect.TypeOf((*MockEngine)(nil).SyncActivity), arg0, arg1)
+}
+
+// SyncShardStatus mocks base method
+func (m *MockEngine) SyncShardStatus(arg0 context.Context, arg1 *history.SyncShardStatusRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncShardStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncShardStatus indicates an expected call of SyncShardStatus
+func (mr *MockEngineMockRecorder) SyncShardStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncShardStatus", reflect.TypeOf((*MockEngine)(nil).SyncShardStatus), arg0, arg1)
+}
+
+// TerminateWorkflowExecution mocks base method
+func (m *MockEngine) TerminateWorkflowExecution(arg0 context.Context, arg1 *history.TerminateWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateWorkflowExecution indicates an expected call of TerminateWorkflowExecution
+func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), arg0, arg1)
+}
+
+// MockEngineFactory is a mock of EngineFactory interface
+type MockEngineFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockEngineFactoryMockRecorder
+}
+
+// MockEngineFactoryMockRecorder is the mock recorder for MockEngineFactory
+type MockEngineFactoryMockRecorder struct {
+	mock *MockEngineFactory
+}
+
+// NewMockEngineFactory creates a new mock instance
+func NewMockEngineFactory(ctrl *gomock.Controller) *MockEngineFactory {
+	mock := &MockEngineFactory{ctrl: ctrl}
+	mock.recorder = &MockEngineFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEngineFactory) EXPECT() *MockEngineFactoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEngine mocks base method
+func (m *MockEngineFactory) CreateEngine(arg0 ShardContext) Engine {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEngine", arg0)
+	ret0, _ := ret[0].(Engine)
+	return ret0
+}
+
+// CreateEngine indicates an expected call of CreateEngine
+func (mr *MockEngineFactoryMockRecorder) CreateEngine(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEngine", reflect.TypeOf((*MockEngineFactory)(nil).CreateEngine), arg0)
+}
+
+// MockReplicatorQueueProcessor is a mock of ReplicatorQueueProcessor interface
+type MockReplicatorQueueProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplicatorQueueProcessorMockRecorder
+}
+
+// MockReplicatorQueueProcessorMockRecorder is the mock recorder for MockReplicatorQueueProcessor
+type MockReplicatorQueueProcessorMockRecorder struct {
+	mock *MockReplicatorQueueProcessor
+}
+
+// NewMockReplicatorQueueProcessor creates a new mock instance
+func NewMockReplicatorQueueProcessor(ctrl *gomock.Controller) *MockReplicatorQueueProcessor {
+	mock := &MockReplicatorQueueProcessor{ctrl: ctrl}
+	mock.recorder = &MockReplicatorQueueProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReplicatorQueueProcessor) EXPECT() *MockReplicatorQueueProcessorMockRecorder {
+	return m.recorder
+}
+
+// Start mocks base method
+func (m *MockReplicatorQueueProcessor) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start
+func (mr *MockReplicatorQueueProcessorMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).Start))
+}
+
+// Stop mocks base method
+func (m *MockReplicatorQueueProcessor) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockReplicatorQueueProcessorMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).Stop))
+}
+
+// getTasks mocks base method
+func (m *MockReplicatorQueueProcessor) getTasks(arg0 int64) (*replicator.ReplicationMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "getTasks", arg0)
+	ret0, _ := ret[0].(*replicator.ReplicationMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// getTasks indicates an expected call of getTasks
+func (mr *MockReplicatorQueueProcessorMockRecorder) getTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getTasks", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).getTasks), arg0)
+}
+
+// notifyNewTask mocks base method
+func (m *MockReplicatorQueueProcessor) notifyNewTask() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "notifyNewTask")
+}
+
+// notifyNewTask indicates an expected call of notifyNewTask
+func (mr *MockReplicatorQueueProcessorMockRecorder) notifyNewTask() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "notifyNewTask", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).notifyNewTask))
+}
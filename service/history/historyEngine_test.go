@@ -36,6 +36,7 @@ import (
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	m "github.com/uber/cadence/.gen/go/matching"
 	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
@@ -43,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/messaging"
@@ -51,7 +53,9 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
+	"go.uber.org/yarpc"
 )
 
 type (
@@ -83,7 +87,7 @@ type (
 		mockTimerProcessor       *MockTimerQueueProcessor
 
 		shardClosedCh chan int
-		eventsCache   eventsCache
+		eventsCache   EventsCache
 		config        *Config
 		logger        log.Logger
 	}
@@ -183,6 +187,8 @@ func (s *engineSuite) SetupTest() {
 		executionManager:     s.mockExecutionMgr,
 		historyMgr:           s.mockHistoryMgr,
 		historyV2Mgr:         s.mockHistoryV2Mgr,
+		visibilityMgr:        s.mockVisibilityMgr,
+		matchingClient:       s.mockMatchingClient,
 		historyCache:         historyCache,
 		logger:               s.logger,
 		metricsClient:        metricsClient,
@@ -193,6 +199,7 @@ func (s *engineSuite) SetupTest() {
 		txProcessor:          s.mockTxProcessor,
 		replicatorProcessor:  s.mockReplicationProcessor,
 		timerProcessor:       s.mockTimerProcessor,
+		noPollersTracker:     newNoPollersTracker(NewDynamicConfigForTest(), s.mockMatchingClient, s.logger),
 	}
 	mockShard.SetEngine(h)
 	h.decisionHandler = newDecisionHandler(h)
@@ -514,6 +521,200 @@ func (s *engineSuite) TestQueryWorkflow() {
 	waitGroup.Wait()
 }
 
+func (s *engineSuite) TestQueryWorkflow_ClosedWorkflow() {
+	domainID := validDomainID
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-closed-workflow-query"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID, Name: "testDomain"},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.GetEventId(), nil, identity)
+	addCompleteWorkflowEvent(msBuilder, decisionCompletedEvent.GetEventId(), []byte("result"))
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *m.QueryWorkflowRequest, _ ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
+			s.Equal(domainID, request.GetDomainUUID())
+			s.Equal(tasklist, request.TaskList.GetName())
+			s.Equal("testDomain", request.QueryRequest.GetDomain())
+			s.Equal(execution, *request.QueryRequest.Execution)
+			s.Equal("query1", request.QueryRequest.Query.GetQueryType())
+			return &workflow.QueryWorkflowResponse{QueryResult: []byte{1, 2, 3}}, nil
+		}).Times(1)
+
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), &history.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &execution,
+		Query:      &workflow.WorkflowQuery{QueryType: common.StringPtr("query1")},
+	})
+	s.NoError(err)
+	s.Equal([]byte{1, 2, 3}, resp.GetQueryResult())
+	s.False(msBuilder.HasScheduledInMemoryDecisionTask())
+}
+
+func (s *engineSuite) TestQueryWorkflow_BuiltInQuery() {
+	domainID := validDomainID
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-built-in-query"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.GetEventId(), nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1", "activity_type1", tasklist, []byte("input1"), 100, 10, 5)
+	sessionScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "session1",
+		sessionCreationActivityType, "sessionTaskList", nil, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, sessionScheduledEvent.GetEventId(), "sessionHost")
+	msBuilder.GetExecutionInfo().QueryTypes = []string{"query1", "query2"}
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID, Name: "testDomain"},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	query := func(queryType string) []byte {
+		resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), &history.QueryWorkflowRequest{
+			DomainUUID: common.StringPtr(domainID),
+			Execution:  &execution,
+			Query:      &workflow.WorkflowQuery{QueryType: common.StringPtr(queryType)},
+		})
+		s.NoError(err)
+		return resp.GetQueryResult()
+	}
+
+	var queryTypes []string
+	s.NoError(json.Unmarshal(query(common.QueryTypeQueryTypes), &queryTypes))
+	s.Equal([]string{"query1", "query2"}, queryTypes)
+
+	var startTime time.Time
+	s.NoError(json.Unmarshal(query(common.QueryTypeStartTime), &startTime))
+	s.True(msBuilder.GetExecutionInfo().StartTimestamp.Equal(startTime))
+
+	var pendingActivities []*workflow.PendingActivityInfo
+	s.NoError(json.Unmarshal(query(common.QueryTypePendingActivities), &pendingActivities))
+	s.Equal(2, len(pendingActivities))
+
+	var openSessions []*openSessionInfo
+	s.NoError(json.Unmarshal(query(common.QueryTypeOpenSessions), &openSessions))
+	s.Equal(1, len(openSessions))
+	s.Equal("session1", openSessions[0].SessionID)
+	s.Equal("sessionTaskList", openSessions[0].TaskList)
+	s.Equal("sessionHost", openSessions[0].HostName)
+	s.Equal(workflow.PendingActivityStateStarted, openSessions[0].State)
+	s.NotNil(openSessions[0].StartTime)
+}
+
+func (s *engineSuite) TestGetPendingActivityInfo_RetryBackoff() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-pending-activity-retry"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.GetEventId(), nil, identity)
+	_, ai := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1", "activity_type1", tasklist, []byte("input1"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, ai.ScheduleID, identity)
+
+	ai.HasRetryPolicy = true
+	ai.InitialInterval = 10
+	ai.BackoffCoefficient = 2
+	ai.MaximumAttempts = 3
+	ai.ExpirationTime = time.Now().Add(time.Minute)
+	details := []byte("heartbeat details")
+	msBuilder.UpdateActivityProgress(ai, &workflow.RecordActivityTaskHeartbeatRequest{Details: details})
+
+	retried, err := msBuilder.RetryActivity(ai, "some random reason", nil)
+	s.NoError(err)
+	s.True(retried)
+
+	p, err := s.mockHistoryEngine.getPendingActivityInfo(validDomainID, msBuilder, ai)
+	s.NoError(err)
+	s.Equal(workflow.PendingActivityStateScheduled, p.GetState())
+	s.Equal(int32(1), p.GetAttempt())
+	s.Equal(ai.ScheduledTime.UnixNano(), p.GetNextRetryTimestamp())
+	s.Equal(details, p.HeartbeatDetails)
+	s.Equal("some random reason", p.GetLastFailureReason())
+}
+
+func (s *engineSuite) TestActivityTaskStartedEvent_LastFailure() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-activity-started-last-failure"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.GetEventId(), nil, identity)
+	_, ai := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1", "activity_type1", tasklist, []byte("input1"), 100, 10, 5)
+	ai.HasRetryPolicy = true
+	ai.InitialInterval = 1
+	ai.BackoffCoefficient = 1
+	ai.ExpirationTime = time.Now().Add(time.Minute)
+
+	_, err := msBuilder.AddActivityTaskStartedEvent(ai, ai.ScheduleID, uuid.New(), identity)
+	s.NoError(err)
+	retried, err := msBuilder.RetryActivity(ai, "some random reason", []byte("some random details"))
+	s.NoError(err)
+	s.True(retried)
+
+	// started event of the final attempt carries the failure of the previous attempt
+	_, err = msBuilder.AddActivityTaskStartedEvent(ai, ai.ScheduleID, uuid.New(), identity)
+	s.NoError(err)
+	_, err = msBuilder.AddActivityTaskCompletedEvent(ai.ScheduleID, ai.StartedID, &workflow.RespondActivityTaskCompletedRequest{
+		Identity: common.StringPtr(identity),
+	})
+	s.NoError(err)
+	history := msBuilder.GetHistoryBuilder().GetHistory().GetEvents()
+	startedEvent := history[len(history)-2]
+	s.Equal(workflow.EventTypeActivityTaskStarted, startedEvent.GetEventType())
+	s.Equal(int32(1), startedEvent.ActivityTaskStartedEventAttributes.GetAttempt())
+	s.Equal("some random reason", startedEvent.ActivityTaskStartedEventAttributes.GetLastFailureReason())
+	s.Equal([]byte("some random details"), startedEvent.ActivityTaskStartedEventAttributes.LastFailureDetails)
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedInvalidToken() {
 	domainID := validDomainID
 	invalidToken, _ := json.Marshal("bad token")
@@ -1896,6 +2097,77 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 	s.False(executionBuilder.HasPendingDecision())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedLocalActivityMarkerLimitExceeded() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	s.mockHistoryEngine.config.LocalActivityMarkerCountLimitPerDecision = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	var decisions []*workflow.Decision
+	for i := 0; i < 3; i++ {
+		decisions = append(decisions, &workflow.Decision{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeRecordMarker),
+			RecordMarkerDecisionAttributes: &workflow.RecordMarkerDecisionAttributes{
+				MarkerName: common.StringPtr(localActivityMarkerName),
+				Details:    []byte("local activity result"),
+			},
+		})
+	}
+
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	// decision is failed and none of the markers are recorded
+	s.Equal(int64(1), executionBuilder.GetExecutionInfo().DecisionAttempt)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.True(executionBuilder.HasPendingDecision())
+	for _, event := range executionBuilder.GetHistoryBuilder().GetHistory().GetEvents() {
+		s.NotEqual(workflow.EventTypeMarkerRecorded, event.GetEventType())
+	}
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedSignalExternalWorkflowSuccess() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -2891,45 +3163,31 @@ func (s *engineSuite) TestRespondActivityTaskCompletedSuccess() {
 	s.Equal(common.EmptyEventID, di.StartedID)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
+func (s *engineSuite) TestRecordActivityTaskWorkerUnavailable() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr(validRunID),
 	}
 	tl := "testTaskList"
-
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	activityResult := []byte("activity result")
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: *we.WorkflowId,
-		ScheduleID: common.EmptyEventID,
-		ActivityID: activityID,
-	})
 
 	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
 		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
-	decisionScheduledEvent := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.ScheduleID, tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.ScheduleID,
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		*decisionStartedEvent.EventId, nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
-		activityType, tl, activityInput, 100, 10, 5)
-	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: *we.RunId}
 
-	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
-
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
 		&persistence.GetDomainResponse{
 			Info:   &persistence.DomainInfo{ID: domainID},
@@ -2944,33 +3202,44 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 		},
 		nil,
 	)
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &history.RespondActivityTaskCompletedRequest{
-		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
-			TaskToken: taskToken,
-			Result:    activityResult,
-			Identity:  &identity,
-		},
+
+	err := s.mockHistoryEngine.RecordActivityTaskWorkerUnavailable(context.Background(), &history.RecordActivityTaskWorkerUnavailableRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &we,
+		ScheduleId:        activityScheduledEvent.EventId,
+		TaskList:          common.StringPtr(tl),
 	})
 	s.Nil(err, s.printHistory(msBuilder))
 	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
-	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
-
+	_, isRunning := executionBuilder.GetActivityInfo(*activityScheduledEvent.EventId)
+	s.False(isRunning)
+	s.Equal(int64(8), executionBuilder.GetExecutionInfo().NextEventID)
 	s.True(executionBuilder.HasPendingDecision())
-	di, ok := executionBuilder.GetDecisionInfo(int64(8))
-	s.True(ok)
-	s.Equal(int32(100), di.DecisionTimeout)
-	s.Equal(int64(8), di.ScheduleID)
-	s.Equal(common.EmptyEventID, di.StartedID)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
+func (s *engineSuite) TestRecordActivityTaskWorkerUnavailable_TaskListMismatch() {
 	domainID := validDomainID
-	invalidToken, _ := json.Marshal("bad token")
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
 	identity := "testIdentity"
 
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
 		&persistence.GetDomainResponse{
 			Info:   &persistence.DomainInfo{ID: domainID},
@@ -2985,30 +3254,51 @@ func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
 		},
 		nil,
 	)
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &history.RespondActivityTaskFailedRequest{
-		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
-			TaskToken: invalidToken,
-			Identity:  &identity,
-		},
-	})
 
-	s.NotNil(err)
-	s.IsType(&workflow.BadRequestError{}, err)
+	err := s.mockHistoryEngine.RecordActivityTaskWorkerUnavailable(context.Background(), &history.RecordActivityTaskWorkerUnavailableRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &we,
+		ScheduleId:        activityScheduledEvent.EventId,
+		TaskList:          common.StringPtr("someOtherTaskList"),
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
+func (s *engineSuite) TestRespondActivityTaskCompleted_WorkflowTypeLatencyMetrics() {
 	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		RunID:      validRunID,
-		ScheduleID: 2,
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 5,
 	})
 	identity := "testIdentity"
 
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
 		&persistence.GetDomainResponse{
-			Info:   &persistence.DomainInfo{ID: domainID},
+			Info:   &persistence.DomainInfo{ID: domainID, Name: "test-domain"},
 			Config: &persistence.DomainConfig{Retention: 1},
 			ReplicationConfig: &persistence.DomainReplicationConfig{
 				ActiveClusterName: cluster.TestCurrentClusterName,
@@ -3020,7 +3310,166 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
 		},
 		nil,
 	)
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
+
+	scope := tally.NewTestScope("test", nil)
+	s.mockHistoryEngine.metricsClient = metrics.NewClient(scope, metrics.History)
+	s.mockHistoryEngine.config.WorkflowTypeMetricsAllowlist = func(domain string) string {
+		if domain == "test-domain" {
+			return "otherType, wType"
+		}
+		return ""
+	}
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    []byte("activity result"),
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+
+	emitted := make(map[string]bool)
+	for _, timer := range scope.Snapshot().Timers() {
+		if timer.Tags()["workflow_type"] == "wType" && timer.Tags()["domain"] == "test-domain" {
+			emitted[timer.Name()] = true
+		}
+	}
+	s.True(emitted["test.activity_start_to_close_latency"])
+	s.True(emitted["test.activity_end_to_end_latency"])
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		ScheduleID: common.EmptyEventID,
+		ActivityID: activityID,
+	})
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	decisionScheduledEvent := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: *we.RunId}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result:    activityResult,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(9), executionBuilder.GetExecutionInfo().NextEventID)
+	s.Equal(int64(3), executionBuilder.GetExecutionInfo().LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+
+	s.True(executionBuilder.HasPendingDecision())
+	di, ok := executionBuilder.GetDecisionInfo(int64(8))
+	s.True(ok)
+	s.Equal(int32(100), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(common.EmptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
+	domainID := validDomainID
+	invalidToken, _ := json.Marshal("bad token")
+	identity := "testIdentity"
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: invalidToken,
+			Identity:  &identity,
+		},
+	})
+
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
+	domainID := validDomainID
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      validRunID,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
 		&workflow.EntityNotExistsError{}).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &history.RespondActivityTaskFailedRequest{
@@ -3817,6 +4266,99 @@ func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
 	s.False(executionBuilder.HasPendingDecision())
 }
 
+func (s *engineSuite) TestRecordActivityTaskHeartBeat_WaitForCancellation() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 0)
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Times(3)
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	request := &history.RecordActivityTaskHeartbeatRequest{
+		DomainUUID: common.StringPtr(domainID),
+		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
+			TaskToken:               taskToken,
+			Identity:                &identity,
+			CancellationWaitSeconds: common.Int32Ptr(10),
+		},
+	}
+
+	// no cancellation arrives, the wait is capped by the dynamic config
+	s.mockHistoryEngine.config.MaxActivityCancellationWait = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Millisecond)
+	response, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), request)
+	s.Nil(err)
+	s.False(response.GetCancelRequested())
+
+	// no cancellation arrives, the wait is capped by the deadline of the request
+	s.mockHistoryEngine.config.MaxActivityCancellationWait = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), activityCancellationWaitTailroom+100*time.Millisecond)
+	response, err = s.mockHistoryEngine.RecordActivityTaskHeartbeat(ctx, request)
+	s.Nil(err)
+	s.False(response.GetCancelRequested())
+	s.Nil(ctx.Err())
+	cancel()
+
+	// cancellation requested while the heartbeat is waiting
+	notifier := &watchSignalingNotifier{
+		historyEventNotifier: s.mockHistoryEngine.historyEventNotifier,
+		watchedCh:            make(chan struct{}, 1),
+	}
+	s.mockHistoryEngine.historyEventNotifier = notifier
+	go func() {
+		<-notifier.watchedCh
+		context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(domainID, we)
+		s.Nil(err)
+		msBuilder, err := context.loadWorkflowExecution()
+		s.Nil(err)
+		ai, ok := msBuilder.GetActivityInfo(*activityScheduledEvent.EventId)
+		s.True(ok)
+		ai.CancelRequested = true
+		nextEventID := msBuilder.GetNextEventID()
+		release(nil)
+		s.mockHistoryEngine.historyEventNotifier.NotifyNewHistoryEvent(newHistoryEventNotification(
+			domainID, &we, nextEventID-1, nextEventID, common.EmptyEventID, true, persistence.WorkflowCloseStatusNone,
+		))
+	}()
+	response, err = s.mockHistoryEngine.RecordActivityTaskHeartbeat(context.Background(), request)
+	s.Nil(err)
+	s.True(response.GetCancelRequested())
+}
+
 func (s *engineSuite) TestRecordActivityTaskHeartBeatByIDSuccess() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -5158,6 +5700,220 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestAddOperatorAnnotation() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	message := "restarted the downstream service"
+	annotationRequest := &history.AddOperatorAnnotationRequest{
+		DomainUUID: common.StringPtr(domainID),
+		AnnotationRequest: &workflow.AddOperatorAnnotationRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Message:           common.StringPtr(message),
+			Identity:          common.StringPtr(identity),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.ScheduleID+1, nil, identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Twice()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	err := s.mockHistoryEngine.AddOperatorAnnotation(context.Background(), annotationRequest)
+	s.Nil(err)
+	err = s.mockHistoryEngine.AddOperatorAnnotation(context.Background(), annotationRequest)
+	s.Nil(err)
+
+	// the annotation is kept in the memo, no history event is written for it
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything)
+	var annotations []operatorAnnotation
+	s.NoError(json.Unmarshal(updateRequest.UpdateWorkflowMutation.ExecutionInfo.Memo[operatorAnnotationsMemoKey], &annotations))
+	s.Equal(2, len(annotations))
+	for _, annotation := range annotations {
+		s.Equal(message, annotation.Message)
+		s.Equal(identity, annotation.Identity)
+		s.NotZero(annotation.Timestamp)
+	}
+	// an annotation must not schedule a decision, only push the memo to visibility
+	s.Equal(common.EmptyEventID, updateRequest.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+	s.Equal(1, len(updateRequest.UpdateWorkflowMutation.TransferTasks))
+	s.Equal(p.TransferTaskTypeUpsertWorkflowSearchAttributes, updateRequest.UpdateWorkflowMutation.TransferTasks[0].GetType())
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: validDomainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(validDomainID),
+		Execution:  &we,
+		Reason:     common.StringPtr("corrupted"),
+		Identity:   common.StringPtr(identity),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_ForceWithoutMutableState() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{Message: "mutable state not found"}).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:              &persistence.DomainInfo{ID: validDomainID, Name: "domain"},
+			Config:            &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+			TableVersion:      persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	// without mutable state the visibility records are located by the times kept in visibility
+	startTime := time.Now().Add(-time.Hour).UnixNano()
+	closeTime := time.Now().UnixNano()
+	s.mockVisibilityMgr.On("GetClosedWorkflowExecution", &p.GetClosedWorkflowExecutionRequest{
+		DomainUUID: validDomainID,
+		Domain:     "domain",
+		Execution:  we,
+	}).Return(&p.GetClosedWorkflowExecutionResponse{
+		Execution: &workflow.WorkflowExecutionInfo{
+			Execution: &we,
+			StartTime: common.Int64Ptr(startTime),
+			CloseTime: common.Int64Ptr(closeTime),
+		},
+	}, nil).Once()
+	s.mockVisibilityMgr.On("DeleteWorkflowExecution", mock.MatchedBy(func(request *p.VisibilityDeleteWorkflowExecutionRequest) bool {
+		return request.DomainID == validDomainID && request.RunID == validRunID &&
+			request.StartTimestamp == startTime && request.CloseTimestamp == closeTime
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   validDomainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		DomainID:   validDomainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+
+	request := &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(validDomainID),
+		Execution:  &we,
+		Reason:     common.StringPtr("corrupted"),
+		Identity:   common.StringPtr("testIdentity"),
+	}
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), request)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+
+	request.Force = common.BoolPtr(true)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{Message: "mutable state not found"}).Once()
+	err = s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), request)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestReindexWorkflowExecution() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		transferTasks := request.UpdateWorkflowMutation.TransferTasks
+		if len(transferTasks) != 1 {
+			return false
+		}
+		_, ok := transferTasks[0].(*p.UpsertWorkflowSearchAttributesTask)
+		return ok && len(request.UpdateWorkflowMutation.NewBufferedEvents) == 0
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: validDomainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	err := s.mockHistoryEngine.ReindexWorkflowExecution(context.Background(), &history.ReindexWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(validDomainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &history.SignalWorkflowExecutionRequest{}
@@ -5271,6 +6027,169 @@ func (s *engineSuite) TestSignalWorkflowExecution_Failed() {
 	s.EqualError(err, "EntityNotExistsError{Message: Workflow execution already completed.}")
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_ExpectedNextEventID() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:              common.StringPtr(domainID),
+			WorkflowExecution:   &we,
+			Identity:            common.StringPtr(identity),
+			SignalName:          common.StringPtr("my signal name"),
+			Input:               []byte("test input"),
+			ExpectedNextEventId: common.Int64Ptr(msBuilder.GetNextEventID()),
+		},
+	}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_ExpectedNextEventIDMismatch() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:              common.StringPtr(domainID),
+			WorkflowExecution:   &we,
+			Identity:            common.StringPtr(identity),
+			SignalName:          common.StringPtr("my signal name"),
+			Input:               []byte("test input"),
+			ExpectedNextEventId: common.Int64Ptr(msBuilder.GetNextEventID() - 1),
+		},
+	}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.IsType(&workflow.PreconditionFailedError{}, err)
+	s.Equal(msBuilder.GetNextEventID(), err.(*workflow.PreconditionFailedError).GetNextEventId())
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_ExpectedNextEventIDBufferedEvents() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	// a signal received while the decision is in flight is buffered without advancing the next event ID
+	ms.BufferedEvents = []*workflow.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(common.BufferedEventID),
+			EventType: workflow.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &workflow.WorkflowExecutionSignaledEventAttributes{
+				SignalName: common.StringPtr("earlier signal"),
+				Identity:   common.StringPtr(identity),
+			},
+		},
+	}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:              common.StringPtr(domainID),
+			WorkflowExecution:   &we,
+			Identity:            common.StringPtr(identity),
+			SignalName:          common.StringPtr("my signal name"),
+			Input:               []byte("test input"),
+			ExpectedNextEventId: common.Int64Ptr(msBuilder.GetNextEventID()),
+		},
+	}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.IsType(&workflow.PreconditionFailedError{}, err)
+	s.Equal(msBuilder.GetNextEventID(), err.(*workflow.PreconditionFailedError).GetNextEventId())
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &history.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
@@ -5553,7 +6472,7 @@ func addCompleteWorkflowEvent(builder mutableState, decisionCompletedEventID int
 	return event
 }
 
-func newMutableStateBuilderWithEventV2(shard ShardContext, eventsCache eventsCache,
+func newMutableStateBuilderWithEventV2(shard ShardContext, eventsCache EventsCache,
 	logger log.Logger, runID string) *mutableStateBuilder {
 
 	msBuilder := newMutableStateBuilder(shard, eventsCache, logger, "")
@@ -5562,7 +6481,7 @@ func newMutableStateBuilderWithEventV2(shard ShardContext, eventsCache eventsCac
 	return msBuilder
 }
 
-func newMutableStateBuilderWithReplicationStateWithEventV2(shard ShardContext, eventsCache eventsCache,
+func newMutableStateBuilderWithReplicationStateWithEventV2(shard ShardContext, eventsCache EventsCache,
 	logger log.Logger, version int64, runID string) *mutableStateBuilder {
 
 	msBuilder := newMutableStateBuilderWithReplicationState(shard, eventsCache, logger, version, cache.ReplicationPolicyOneCluster, "")
@@ -5668,6 +6587,8 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		AutoResetPoints:                    sourceInfo.AutoResetPoints,
 		Memo:                               sourceInfo.Memo,
 		SearchAttributes:                   sourceInfo.SearchAttributes,
+		QueryTypes:                         sourceInfo.QueryTypes,
+		LastDecisionBinaryChecksum:         sourceInfo.LastDecisionBinaryChecksum,
 		Attempt:                            sourceInfo.Attempt,
 		HasRetryPolicy:                     sourceInfo.HasRetryPolicy,
 		InitialInterval:                    sourceInfo.InitialInterval,
@@ -5679,6 +6600,8 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		EventStoreVersion:                  sourceInfo.EventStoreVersion,
 		BranchToken:                        sourceInfo.BranchToken,
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
+		Paused:                             sourceInfo.Paused,
+		TerminationProtected:               sourceInfo.TerminationProtected,
 	}
 }
 
@@ -5841,3 +6764,66 @@ func copyReplicationState(source *persistence.ReplicationState) *persistence.Rep
 		LastReplicationInfo: lastReplicationInfo,
 	}
 }
+
+// watchSignalingNotifier lets tests know when a caller has subscribed to history events
+type watchSignalingNotifier struct {
+	historyEventNotifier
+	watchedCh chan struct{}
+}
+
+func (n *watchSignalingNotifier) WatchHistoryEvent(
+	identifier definition.WorkflowIdentifier,
+) (string, chan *historyEventNotification, error) {
+	subscriberID, channel, err := n.historyEventNotifier.WatchHistoryEvent(identifier)
+	n.watchedCh <- struct{}{}
+	return subscriberID, channel, err
+}
+
+func (s *engineSuite) TestGetReplicationStatus_Domain() {
+	domainID := validDomainID
+	mockShard := s.mockHistoryEngine.shard.(*shardContextImpl)
+	mockShard.shardInfo.ReplicationAckLevel = 10
+	mockShard.transferMaxReadLevel = 50
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 50,
+		BatchSize:    s.mockHistoryEngine.config.ReplicatorTaskBatchSize(),
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: domainID, TaskID: 20},
+			{DomainID: "other-domain", TaskID: 30},
+		},
+		NextPageToken: []byte{1},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:     10,
+		MaxReadLevel:  50,
+		BatchSize:     s.mockHistoryEngine.config.ReplicatorTaskBatchSize(),
+		NextPageToken: []byte{1},
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: domainID, TaskID: 35},
+			{DomainID: "other-domain", TaskID: 50},
+		},
+	}, nil).Once()
+
+	status, err := s.mockHistoryEngine.GetReplicationStatus(context.Background(), domainID)
+	s.Nil(err)
+	s.Equal(int64(35), status.GetMaxReadLevel())
+	s.Equal(int64(10), status.GetReplicationAckLevel())
+
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: "other-domain", TaskID: 30},
+		},
+	}, nil).Once()
+	status, err = s.mockHistoryEngine.GetReplicationStatus(context.Background(), domainID)
+	s.Nil(err)
+	s.Equal(int64(10), status.GetMaxReadLevel())
+
+	status, err = s.mockHistoryEngine.GetReplicationStatus(context.Background(), "")
+	s.Nil(err)
+	s.Equal(int64(50), status.GetMaxReadLevel())
+	s.mockExecutionMgr.AssertExpectations(s.T())
+}
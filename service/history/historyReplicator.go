@@ -375,6 +375,10 @@ func (r *historyReplicator) ApplyEvents(
 		r.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.EmptyReplicationEventsCounter)
 		return nil
 	}
+	// keep the shard clock ahead of events served by the source cluster
+	lastEvent := request.History.Events[len(request.History.Events)-1]
+	r.shard.ObserveTime(time.Unix(0, lastEvent.GetTimestamp()))
+
 	domainID, err := validateDomainUUID(request.DomainUUID)
 	if err != nil {
 		return err
@@ -781,6 +785,7 @@ func (r *historyReplicator) replicateWorkflowStarted(
 	if err != nil {
 		return err
 	}
+	stats := newExecutionStats(workflowEventsSeq[0].Events, historySize)
 	// TODO add a check here guarantee that no replication tasks will be persisted
 	newWorkflow.ReplicationTasks = nil
 
@@ -805,7 +810,7 @@ func (r *historyReplicator) replicateWorkflowStarted(
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
 	err = context.createWorkflowExecution(
-		newWorkflow, historySize, now,
+		newWorkflow, stats, now,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
 	if err == nil {
@@ -838,7 +843,7 @@ func (r *historyReplicator) replicateWorkflowStarted(
 		prevRunID = currentRunID
 		prevLastWriteVersion = currentLastWriteVersion
 		return context.createWorkflowExecution(
-			newWorkflow, historySize, now,
+			newWorkflow, stats, now,
 			createMode, prevRunID, prevLastWriteVersion,
 		)
 	}
@@ -912,11 +917,46 @@ func (r *historyReplicator) replicateWorkflowStarted(
 	prevRunID = currentRunID
 	prevLastWriteVersion = currentLastWriteVersion
 	return context.createWorkflowExecution(
-		newWorkflow, historySize, now,
+		newWorkflow, stats, now,
 		createMode, prevRunID, prevLastWriteVersion,
 	)
 }
 
+func (r *historyReplicator) conflictResolutionConvertToZombieIfNotCurrent(
+	context workflowExecutionContext,
+	msBuilder mutableState,
+	logger log.Logger,
+) (bool, error) {
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	resp, err := r.shard.GetExecutionManager().GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   executionInfo.DomainID,
+		WorkflowID: executionInfo.WorkflowID,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return false, nil
+		}
+		logError(logger, "Conflict resolution error getting current workflow.", err)
+		return false, err
+	}
+	if resp.RunID == executionInfo.RunID {
+		return false, nil
+	}
+
+	logger.Warn("Conflict resolution self workflow running but not current, converting to zombie.")
+	now := r.shard.GetTimeSource().Now()
+	if err := msBuilder.ConvertToZombie(now); err != nil {
+		return false, err
+	}
+	if err := context.updateWorkflowExecutionAsZombie(now); err != nil {
+		logError(logger, "Conflict resolution error converting workflow to zombie.", err)
+		return false, err
+	}
+	r.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.HistoryZombieWorkflowCounter)
+	return true, nil
+}
+
 func (r *historyReplicator) conflictResolutionTerminateCurrentRunningIfNotSelf(
 	ctx ctx.Context,
 	msBuilder mutableState,
@@ -1136,6 +1176,15 @@ func (r *historyReplicator) resetMutableState(
 
 	r.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.HistoryConflictsCounter)
 
+	// handling edge case when this workflow is still running, but is no longer the current workflow,
+	// conflict resolution will never succeed, convert this workflow to zombie and drop the events
+	if msBuilder.IsWorkflowExecutionRunning() {
+		isZombie, err := r.conflictResolutionConvertToZombieIfNotCurrent(context, msBuilder, logger)
+		if err != nil || isZombie {
+			return nil, err
+		}
+	}
+
 	// handling edge case when resetting a workflow, and this workflow has done continue as new
 	// we need to terminate the continue as new-ed workflow
 	currentRunID, currentLastWriteVersion, currentState, err := r.conflictResolutionTerminateCurrentRunningIfNotSelf(
@@ -1413,7 +1462,7 @@ func (r *historyReplicator) persistWorkflowMutation(
 		}
 	}
 
-	now := clock.NewRealTimeSource().Now() // this is on behalf of active logic
+	now := r.shard.GetTimeSource().Now() // this is on behalf of active logic
 	return context.updateWorkflowExecutionAsActive(now)
 }
 
@@ -1947,6 +1947,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 		CloseStatus:    persistence.WorkflowCloseStatusNone,
 	}
 	msBuilderIn.On("GetExecutionInfo").Return(exeInfo)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
 	msBuilderIn.On("IsWorkflowExecutionRunning").Return(currentState != persistence.WorkflowStateCompleted)
 	msBuilderIn.On("GetLastWriteVersion").Return(currentLastWriteVersion)
 	msBuilderIn.On("GetUpdateCondition").Return(updateCondition)
@@ -2017,6 +2018,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 		CloseStatus:    persistence.WorkflowCloseStatusNone,
 	}
 	msBuilderIn.On("GetExecutionInfo").Return(exeInfo)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
 	msBuilderIn.On("IsWorkflowExecutionRunning").Return(currentState != persistence.WorkflowStateCompleted)
 	msBuilderIn.On("GetLastWriteVersion").Return(currentLastWriteVersion)
 	msBuilderIn.On("GetUpdateCondition").Return(updateCondition)
@@ -2115,6 +2117,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 		CloseStatus:    persistence.WorkflowCloseStatusNone,
 	}
 	msBuilderIn.On("GetExecutionInfo").Return(exeInfo)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
 	msBuilderIn.On("IsWorkflowExecutionRunning").Return(currentState != persistence.WorkflowStateCompleted)
 	msBuilderIn.On("GetLastWriteVersion").Return(currentLastWriteVersion)
 	msBuilderIn.On("GetUpdateCondition").Return(updateCondition)
@@ -2134,6 +2137,57 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 	s.Nil(err)
 }
 
+func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGreaterThanCurrent_CurrentWasActive_ReplicationInfoVersionEqual_Zombie() {
+	runID := uuid.New()
+	currentRunID := uuid.New()
+
+	currentLastWriteVersion := int64(10)
+	currentLastEventID := int64(98)
+	incomingVersion := currentLastWriteVersion + 10
+	incomingReplicationInfoLastWriteVersion := currentLastWriteVersion
+	incomingReplicationInfoLastEventID := currentLastEventID - 10
+
+	prevActiveCluster := cluster.TestCurrentClusterName
+	context := &mockWorkflowExecutionContext{}
+	defer context.AssertExpectations(s.T())
+	msBuilderIn := &mockMutableState{}
+	defer msBuilderIn.AssertExpectations(s.T())
+
+	request := &h.ReplicateEventsRequest{
+		Version: common.Int64Ptr(incomingVersion),
+		ReplicationInfo: map[string]*shared.ReplicationInfo{
+			prevActiveCluster: {
+				Version:     common.Int64Ptr(incomingReplicationInfoLastWriteVersion),
+				LastEventId: common.Int64Ptr(incomingReplicationInfoLastEventID),
+			},
+		},
+		History: &shared.History{Events: []*shared.HistoryEvent{
+			{Timestamp: common.Int64Ptr(time.Now().UnixNano())},
+		}},
+	}
+	msBuilderIn.On("GetReplicationState").Return(&persistence.ReplicationState{
+		LastWriteVersion: currentLastWriteVersion,
+		LastWriteEventID: currentLastEventID,
+	})
+	msBuilderIn.On("HasBufferedEvents").Return(false)
+	exeInfo := &persistence.WorkflowExecutionInfo{
+		StartTimestamp: time.Now(),
+		RunID:          runID,
+		State:          persistence.WorkflowStateRunning,
+		CloseStatus:    persistence.WorkflowCloseStatusNone,
+	}
+	msBuilderIn.On("GetExecutionInfo").Return(exeInfo)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{RunID: currentRunID}, nil).Once()
+	msBuilderIn.On("IsWorkflowExecutionRunning").Return(true)
+	msBuilderIn.On("ConvertToZombie", mock.Anything).Return(nil).Once()
+	context.On("updateWorkflowExecutionAsZombie", mock.Anything).Return(nil).Once()
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", currentLastWriteVersion).Return(prevActiveCluster)
+
+	msBuilderOut, err := s.historyReplicator.ApplyOtherEventsVersionChecking(ctx.Background(), context, msBuilderIn, request, s.logger)
+	s.Nil(msBuilderOut)
+	s.Nil(err)
+}
+
 func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGreaterThanCurrent_CurrentWasActive_ReplicationInfoVersionEqual_Corrputed() {
 	currentLastWriteVersion := int64(10)
 	currentLastEventID := int64(98)
@@ -2278,6 +2332,7 @@ func (s *historyReplicatorSuite) TestApplyOtherEventsVersionChecking_IncomingGre
 		CloseStatus:                  persistence.WorkflowCloseStatusNone,
 	}
 	msBuilderIn.On("GetExecutionInfo").Return(exeInfo)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
 	newDecision := &decisionInfo{
 		Version:    currentLastWriteVersion,
 		ScheduleID: currentLastEventID + 2,
@@ -2485,7 +2540,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_BrandNew() {
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -2597,7 +2652,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_ISE() {
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -2704,7 +2759,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_SameRunID() {
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -2834,7 +2889,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -2963,7 +3018,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -3092,7 +3147,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -3223,7 +3278,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -3381,7 +3436,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -3553,7 +3608,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -3721,7 +3776,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -3868,7 +3923,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
@@ -4032,7 +4087,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 	msBuilder.On("GetExecutionInfo").Return(executionInfo)
 	newWorkflowSnapshot := &persistence.WorkflowSnapshot{
 		ExecutionInfo:    executionInfo,
-		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize)},
+		ExecutionStats:   &persistence.ExecutionStats{HistorySize: int64(historySize), EventCount: int64(len(history.Events))},
 		ReplicationState: replicationState,
 		TransferTasks:    transferTasks,
 		TimerTasks:       timerTasks,
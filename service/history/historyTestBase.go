@@ -73,7 +73,7 @@ type (
 		executionMgr           persistence.ExecutionManager
 		domainCache            cache.DomainCache
 		clusterMetadata        cluster.Metadata
-		eventsCache            eventsCache
+		eventsCache            EventsCache
 		engine                 Engine
 
 		config                    *Config
@@ -177,7 +177,7 @@ func (s *TestShardContext) GetClusterMetadata() cluster.Metadata {
 }
 
 // GetEventsCache test implementation
-func (s *TestShardContext) GetEventsCache() eventsCache {
+func (s *TestShardContext) GetEventsCache() EventsCache {
 	return s.eventsCache
 }
 
@@ -459,6 +459,18 @@ func (s *TestShardContext) GetTimerMaxReadLevel(cluster string) time.Time {
 	return s.timerMaxReadLevelMap[cluster]
 }
 
+// RangeCompleteTransferTask test implementation
+func (s *TestShardContext) RangeCompleteTransferTask(request *persistence.RangeCompleteTransferTaskRequest) error {
+	request.RangeID = s.shardInfo.RangeID
+	return s.executionMgr.RangeCompleteTransferTask(request)
+}
+
+// RangeCompleteTimerTask test implementation
+func (s *TestShardContext) RangeCompleteTimerTask(request *persistence.RangeCompleteTimerTaskRequest) error {
+	request.RangeID = s.shardInfo.RangeID
+	return s.executionMgr.RangeCompleteTimerTask(request)
+}
+
 // ConflictResolveWorkflowExecution test implementation
 func (s *TestShardContext) ConflictResolveWorkflowExecution(request *persistence.ConflictResolveWorkflowExecutionRequest) error {
 	return s.executionMgr.ConflictResolveWorkflowExecution(request)
@@ -519,6 +531,10 @@ func (s *TestShardContext) GetTimeSource() clock.TimeSource {
 	return clock.NewRealTimeSource()
 }
 
+// ObserveTime test implementation
+func (s *TestShardContext) ObserveTime(observed time.Time) {
+}
+
 // SetCurrentTime test implementation
 func (s *TestShardContext) SetCurrentTime(cluster string, currentTime time.Time) {
 	s.Lock()
@@ -21,10 +21,10 @@
 package history
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -43,6 +43,11 @@ const (
 
 	mutableStateInvalidHistoryActionMsg         = "invalid history builder state for action"
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v"
+
+	// operatorAnnotationsMemoKey is the memo field holding the operator annotations of a workflow execution
+	operatorAnnotationsMemoKey = "OperatorAnnotations"
+	// maxOperatorAnnotations is the number of most recent operator annotations kept for a workflow execution
+	maxOperatorAnnotations = 20
 )
 
 var (
@@ -51,12 +56,19 @@ var (
 )
 
 type (
+	operatorAnnotation struct {
+		Message   string `json:"message"`
+		Identity  string `json:"identity"`
+		Timestamp int64  `json:"timestamp"`
+	}
+
 	mutableStateBuilder struct {
 		pendingActivityInfoIDs          map[int64]*persistence.ActivityInfo    // Schedule Event ID -> Activity Info.
 		pendingActivityInfoByActivityID map[string]int64                       // Activity ID -> Schedule Event ID of the activity.
 		updateActivityInfos             map[*persistence.ActivityInfo]struct{} // Modified activities from last update.
 		deleteActivityInfos             map[int64]struct{}                     // Deleted activities from last update.
 		syncActivityTasks               map[int64]struct{}                     // Activity to be sync to remote
+		heartbeatActivityInfos          map[*persistence.ActivityInfo]struct{} // Activities only modified by heartbeats from last update.
 
 		pendingTimerInfoIDs map[string]*persistence.TimerInfo   // User Timer ID -> Timer Info.
 		updateTimerInfos    map[*persistence.TimerInfo]struct{} // Modified timers from last update.
@@ -91,8 +103,15 @@ type (
 		hasBufferedEventsInPersistence bool
 		// indicates the next event ID in DB, for condition update
 		condition int64
+		// indicates whether the mutable state maps are compacted into a snapshot in persistence
+		hasMutableStateSnapshot bool
+		// number of mutable state entries written since the last snapshot
+		mutableStateDeltaSize int
 		// indicate whether can do replication
 		replicationPolicy cache.ReplicationPolicy
+		// earliest fire time of the outstanding timer tasks of the timers / activities deleted in the current transaction
+		outstandingUserTimerTaskTime     time.Time
+		outstandingActivityTimerTaskTime time.Time
 
 		insertTransferTasks    []persistence.Task
 		insertReplicationTasks []persistence.Task
@@ -104,7 +123,7 @@ type (
 
 		shard           ShardContext
 		clusterMetadata cluster.Metadata
-		eventsCache     eventsCache
+		eventsCache     EventsCache
 		config          *Config
 		timeSource      clock.TimeSource
 		logger          log.Logger
@@ -116,7 +135,7 @@ var _ mutableState = (*mutableStateBuilder)(nil)
 
 func newMutableStateBuilder(
 	shard ShardContext,
-	eventsCache eventsCache,
+	eventsCache EventsCache,
 	logger log.Logger,
 	domainName string,
 ) *mutableStateBuilder {
@@ -126,6 +145,7 @@ func newMutableStateBuilder(
 		pendingActivityInfoByActivityID: make(map[string]int64),
 		deleteActivityInfos:             make(map[int64]struct{}),
 		syncActivityTasks:               make(map[int64]struct{}),
+		heartbeatActivityInfos:          make(map[*persistence.ActivityInfo]struct{}),
 
 		pendingTimerInfoIDs: make(map[string]*persistence.TimerInfo),
 		updateTimerInfos:    make(map[*persistence.TimerInfo]struct{}),
@@ -173,7 +193,7 @@ func newMutableStateBuilder(
 		LastProcessedEvent: common.EmptyEventID,
 	}
 	s.hBuilder = newHistoryBuilder(s, logger)
-	s.taskGenerator = newMutableStateTaskGenerator(shard.GetDomainCache(), s.logger, s)
+	s.taskGenerator = newMutableStateTaskGenerator(shard.GetDomainCache(), shard.GetConfig(), s.logger, s)
 	s.decisionTaskManager = newMutableStateDecisionTaskManager(s)
 
 	return s
@@ -181,7 +201,7 @@ func newMutableStateBuilder(
 
 func newMutableStateBuilderWithReplicationState(
 	shard ShardContext,
-	eventsCache eventsCache,
+	eventsCache EventsCache,
 	logger log.Logger,
 	version int64,
 	replicationPolicy cache.ReplicationPolicy,
@@ -258,6 +278,8 @@ func (e *mutableStateBuilder) Load(
 
 	e.hasBufferedEventsInPersistence = len(e.bufferedEvents) > 0
 	e.condition = state.ExecutionInfo.NextEventID
+	e.hasMutableStateSnapshot = state.HasMutableStateSnapshot
+	e.mutableStateDeltaSize = state.MutableStateDeltaSize
 }
 
 func (e *mutableStateBuilder) GetEventStoreVersion() int32 {
@@ -407,6 +429,42 @@ func (e *mutableStateBuilder) UpdateReplicationPolicy(
 	e.replicationPolicy = replicationPolicy
 }
 
+// StartReplication creates the replication state of a workflow execution which was started before its
+// domain was promoted to a global domain, together with a replication task sending the history recorded
+// so far. The events of that history carry the empty version and are replicated with the given version.
+func (e *mutableStateBuilder) StartReplication(
+	version int64,
+	replicationPolicy cache.ReplicationPolicy,
+) error {
+
+	if e.replicationState != nil {
+		return &workflow.InternalServiceError{Message: "workflow execution is already replicated"}
+	}
+
+	lastEventID := e.GetNextEventID() - 1
+	e.replicationState = &persistence.ReplicationState{
+		StartVersion:        version,
+		CurrentVersion:      version,
+		LastWriteVersion:    version,
+		LastWriteEventID:    lastEventID,
+		LastReplicationInfo: make(map[string]*persistence.ReplicationInfo),
+	}
+	e.replicationPolicy = replicationPolicy
+	if !e.canReplicateEvents() {
+		return nil
+	}
+
+	e.insertReplicationTasks = append(e.insertReplicationTasks, &persistence.HistoryReplicationTask{
+		FirstEventID:        common.FirstEventID,
+		NextEventID:         lastEventID + 1,
+		Version:             version,
+		LastReplicationInfo: e.replicationState.LastReplicationInfo,
+		EventStoreVersion:   e.GetEventStoreVersion(),
+		BranchToken:         e.GetCurrentBranch(),
+	})
+	return nil
+}
+
 func (e *mutableStateBuilder) UpdateReplicationStateVersion(
 	version int64,
 	forceUpdate bool,
@@ -754,7 +812,7 @@ func (e *mutableStateBuilder) GetActivityScheduledEvent(
 		return ai.ScheduledEvent, true
 	}
 
-	scheduledEvent, err := e.eventsCache.getEvent(
+	scheduledEvent, err := e.eventsCache.GetEvent(
 		e.executionInfo.DomainID,
 		e.executionInfo.WorkflowID,
 		e.executionInfo.RunID,
@@ -826,7 +884,7 @@ func (e *mutableStateBuilder) GetChildExecutionInitiatedEvent(
 		return ci.InitiatedEvent, true
 	}
 
-	initiatedEvent, err := e.eventsCache.getEvent(
+	initiatedEvent, err := e.eventsCache.GetEvent(
 		e.executionInfo.DomainID,
 		e.executionInfo.WorkflowID,
 		e.executionInfo.RunID,
@@ -920,7 +978,7 @@ func (e *mutableStateBuilder) GetCompletionEvent() (*workflow.HistoryEvent, bool
 	// Completion EventID is always one less than NextEventID after workflow is completed
 	completionEventID := e.executionInfo.NextEventID - 1
 	firstEventID := e.executionInfo.CompletionEventBatchID
-	completionEvent, err := e.eventsCache.getEvent(
+	completionEvent, err := e.eventsCache.GetEvent(
 		e.executionInfo.DomainID,
 		e.executionInfo.WorkflowID,
 		e.executionInfo.RunID,
@@ -939,7 +997,7 @@ func (e *mutableStateBuilder) GetCompletionEvent() (*workflow.HistoryEvent, bool
 // GetStartEvent retrieves the workflow start event from mutable state
 func (e *mutableStateBuilder) GetStartEvent() (*workflow.HistoryEvent, bool) {
 
-	startEvent, err := e.eventsCache.getEvent(
+	startEvent, err := e.eventsCache.GetEvent(
 		e.executionInfo.DomainID,
 		e.executionInfo.WorkflowID,
 		e.executionInfo.RunID,
@@ -989,7 +1047,7 @@ func (e *mutableStateBuilder) writeEventToCache(
 	// load it from database
 	// For completion event: store it within events cache so we can communicate the result to parent execution
 	// during the processing of DeleteTransferTask without loading this event from database
-	e.eventsCache.putEvent(
+	e.eventsCache.PutEvent(
 		e.executionInfo.DomainID,
 		e.executionInfo.WorkflowID,
 		e.executionInfo.RunID,
@@ -1013,6 +1071,9 @@ func (e *mutableStateBuilder) UpdateActivityProgress(
 	ai.Version = e.GetCurrentVersion()
 	ai.Details = request.Details
 	ai.LastHeartBeatUpdatedTime = e.timeSource.Now()
+	if _, ok := e.updateActivityInfos[ai]; !ok {
+		e.heartbeatActivityInfos[ai] = struct{}{}
+	}
 	e.updateActivityInfos[ai] = struct{}{}
 	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
 }
@@ -1075,6 +1136,11 @@ func (e *mutableStateBuilder) DeleteActivity(
 		return errors.NewInternalFailureError(errorMsg)
 	}
 	delete(e.pendingActivityInfoIDs, scheduleEventID)
+	for _, td := range getActivityTimers(a) {
+		if td.TaskCreated {
+			e.outstandingActivityTimerTaskTime = earliestTime(e.outstandingActivityTimerTaskTime, td.TimerSequenceID.VisibilityTimestamp)
+		}
+	}
 
 	_, ok = e.pendingActivityInfoByActivityID[a.ActivityID]
 	if !ok {
@@ -1112,6 +1178,9 @@ func (e *mutableStateBuilder) DeleteUserTimer(
 	timerID string,
 ) {
 
+	if ti, ok := e.pendingTimerInfoIDs[timerID]; ok && isUserTimerTaskCreated(ti) {
+		e.outstandingUserTimerTaskTime = earliestTime(e.outstandingUserTimerTaskTime, ti.ExpiryTime)
+	}
 	delete(e.pendingTimerInfoIDs, timerID)
 	e.deleteTimerInfos[timerID] = struct{}{}
 }
@@ -1240,7 +1309,113 @@ func (e *mutableStateBuilder) GetPreviousStartedEventID() int64 {
 }
 
 func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
-	return e.executionInfo.State != persistence.WorkflowStateCompleted
+	switch e.executionInfo.State {
+	case persistence.WorkflowStateCompleted, persistence.WorkflowStateZombie:
+		return false
+	default:
+		return true
+	}
+}
+
+// ConvertToZombie marks a running workflow which is no longer referenced by the current
+// execution record as zombie, the workflow will not be processed any more and will be
+// deleted at retention
+func (e *mutableStateBuilder) ConvertToZombie(now time.Time) error {
+	if !e.IsWorkflowExecutionRunning() {
+		return ErrWorkflowFinished
+	}
+
+	e.executionInfo.State = persistence.WorkflowStateZombie
+	return e.taskGenerator.generateWorkflowCleanupTasks(
+		e.unixNanoToTime(now.UnixNano()),
+	)
+}
+
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
+// PauseWorkflowExecution pauses a running workflow, pending decision / activity tasks are not
+// started and user timers / activity timeouts are not fired until the workflow is resumed
+func (e *mutableStateBuilder) PauseWorkflowExecution() error {
+	if !e.IsWorkflowExecutionRunning() {
+		return ErrWorkflowFinished
+	}
+
+	e.executionInfo.Paused = true
+	return nil
+}
+
+func (e *mutableStateBuilder) IsWorkflowExecutionTerminationProtected() bool {
+	return e.executionInfo.TerminationProtected
+}
+
+// AddWorkflowExecutionTerminationProtectionSetEvent sets or clears the termination protection of a running
+// workflow, a protected workflow can only be terminated when the termination is forced. The change is recorded
+// as an event so that standby clusters apply it as well.
+func (e *mutableStateBuilder) AddWorkflowExecutionTerminationProtectionSetEvent(
+	protected bool,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionTerminationProtectionSet
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionTerminationProtectionSetEvent(protected, identity)
+	e.ReplicateWorkflowExecutionTerminationProtectionSetEvent(event)
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminationProtectionSetEvent(
+	event *workflow.HistoryEvent,
+) {
+
+	attributes := event.WorkflowExecutionTerminationProtectionSetEventAttributes
+	e.executionInfo.TerminationProtected = attributes.GetTerminationProtected()
+}
+
+// ResumeWorkflowExecution resumes a paused workflow, the decision / activity tasks dropped and
+// the timer tasks skipped while the workflow was paused are generated again
+func (e *mutableStateBuilder) ResumeWorkflowExecution(now time.Time) error {
+	if !e.IsWorkflowExecutionRunning() {
+		return ErrWorkflowFinished
+	}
+
+	e.executionInfo.Paused = false
+	now = e.unixNanoToTime(now.UnixNano())
+
+	if decision, ok := e.GetPendingDecision(); ok && decision.StartedID == common.EmptyEventID {
+		if err := e.taskGenerator.generateDecisionScheduleTasks(now, decision.ScheduleID); err != nil {
+			return err
+		}
+	}
+
+	for _, ai := range e.pendingActivityInfoIDs {
+		if ai.StartedID == common.EmptyEventID && !ai.ScheduledTime.After(now) {
+			// activities waiting for a retry are dispatched by the activity retry timer
+			event, ok := e.GetActivityScheduledEvent(ai.ScheduleID)
+			if !ok {
+				return &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+			}
+			if err := e.taskGenerator.generateActivityTransferTasks(now, event); err != nil {
+				return err
+			}
+		}
+		if ai.TimerTaskStatus != TimerTaskStatusNone {
+			ai.TimerTaskStatus = TimerTaskStatusNone
+			e.updateActivityInfos[ai] = struct{}{}
+		}
+	}
+
+	for _, ti := range e.pendingTimerInfoIDs {
+		if ti.TaskID != TimerTaskStatusNone {
+			ti.TaskID = TimerTaskStatusNone
+			e.updateTimerInfos[ti] = struct{}{}
+		}
+	}
+	return nil
 }
 
 func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
@@ -1314,7 +1489,7 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	}
 
 	createRequest := &workflow.StartWorkflowExecutionRequest{
-		RequestId:                           common.StringPtr(uuid.New()),
+		RequestId:                           common.StringPtr(e.config.IDGenerator.RequestID()),
 		Domain:                              common.StringPtr(domainEntry.GetInfo().Name),
 		WorkflowId:                          execution.WorkflowId,
 		TaskList:                            tl,
@@ -1327,6 +1502,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		CronSchedule:                        attributes.CronSchedule,
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
+		Priority:                            common.Int32Ptr(previousExecutionInfo.Priority),
+		TerminationProtected:                common.BoolPtr(previousExecutionInfo.TerminationProtected),
 	}
 
 	req := &h.StartWorkflowExecutionRequest{
@@ -1480,6 +1657,8 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.DecisionTimeout = 0
 
 	e.executionInfo.CronSchedule = event.GetCronSchedule()
+	e.executionInfo.Priority = event.GetPriority()
+	e.executionInfo.TerminationProtected = event.GetTerminationProtected()
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -1768,7 +1947,7 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(
 	event := e.hBuilder.AddActivityTaskScheduledEvent(decisionCompletedEventID, attributes)
 
 	// Write the event to cache only on active cluster for processing on activity started or retried
-	e.eventsCache.putEvent(
+	e.eventsCache.PutEvent(
 		e.executionInfo.DomainID,
 		e.executionInfo.WorkflowID,
 		e.executionInfo.RunID,
@@ -1801,7 +1980,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		Version:                  event.GetVersion(),
 		ScheduleID:               scheduleEventID,
 		ScheduledEventBatchID:    firstEventID,
-		ScheduledTime:            time.Unix(0, *event.Timestamp),
+		ScheduledTime:            time.Unix(0, *event.Timestamp).Add(time.Duration(attributes.GetDelayStartSeconds()) * time.Second),
 		StartedID:                common.EmptyEventID,
 		StartedTime:              time.Time{},
 		ActivityID:               common.StringDefault(attributes.ActivityId),
@@ -1841,7 +2020,8 @@ func (e *mutableStateBuilder) addTransientActivityStartedEvent(
 
 	if ai, ok := e.GetActivityInfo(scheduleEventID); ok && ai.StartedID == common.TransientEventID {
 		// activity task was started (as transient event), we need to add it now.
-		event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, ai.RequestID, ai.StartedIdentity)
+		event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, ai.RequestID, ai.StartedIdentity,
+			ai.LastFailureReason, ai.LastFailureDetails)
 		if !ai.StartedTime.IsZero() {
 			// overwrite started event time to the one recorded in ActivityInfo
 			event.Timestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
@@ -1864,7 +2044,8 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(
 	}
 
 	if !ai.HasRetryPolicy {
-		event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, identity)
+		event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, identity,
+			ai.LastFailureReason, ai.LastFailureDetails)
 		if err := e.ReplicateActivityTaskStartedEvent(event); err != nil {
 			return nil, err
 		}
@@ -1898,6 +2079,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskStartedEvent(
 	ai.StartedID = event.GetEventId()
 	ai.RequestID = attributes.GetRequestId()
 	ai.StartedTime = time.Unix(0, event.GetTimestamp())
+	ai.StartedIdentity = attributes.GetIdentity()
 	ai.LastHeartBeatUpdatedTime = ai.StartedTime
 	e.updateActivityInfos[ai] = struct{}{}
 	return nil
@@ -2883,6 +3065,48 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(
 	return nil
 }
 
+// AddOperatorAnnotation records an operator note in the memo of the workflow execution instead of its history,
+// so that workflow code replaying the history never sees it. Notes are only kept in the mutable state and
+// visibility records of the cluster where they are added, as no event is replicated for them.
+func (e *mutableStateBuilder) AddOperatorAnnotation(
+	message string,
+	identity string,
+	now time.Time,
+) error {
+
+	opTag := tag.WorkflowActionOperatorAnnotation
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	var annotations []operatorAnnotation
+	if data, ok := e.executionInfo.Memo[operatorAnnotationsMemoKey]; ok {
+		if err := json.Unmarshal(data, &annotations); err != nil {
+			e.logger.Warn("Unable to decode operator annotations, dropping them.", tag.Error(err))
+			annotations = nil
+		}
+	}
+	annotations = append(annotations, operatorAnnotation{
+		Message:   message,
+		Identity:  identity,
+		Timestamp: now.UnixNano(),
+	})
+	if len(annotations) > maxOperatorAnnotations {
+		annotations = annotations[len(annotations)-maxOperatorAnnotations:]
+	}
+
+	data, err := json.Marshal(annotations)
+	if err != nil {
+		return err
+	}
+	e.executionInfo.Memo = mergeMapOfByteArray(e.executionInfo.Memo, map[string][]byte{
+		operatorAnnotationsMemoKey: data,
+	})
+
+	// the upsert task pushes the updated memo to visibility
+	return e.taskGenerator.generateWorkflowSearchAttrTasks(now)
+}
+
 func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	firstEventID int64,
 	decisionCompletedEventID int64,
@@ -2898,7 +3122,7 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	}
 
 	var err error
-	newRunID := uuid.New()
+	newRunID := e.config.IDGenerator.RunID()
 	newExecution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(e.executionInfo.WorkflowID),
 		RunId:      common.StringPtr(newRunID),
@@ -3020,7 +3244,7 @@ func (e *mutableStateBuilder) AddStartChildWorkflowExecutionInitiatedEvent(
 
 	event := e.hBuilder.AddStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID, attributes)
 	// Write the event to cache only on active cluster
-	e.eventsCache.putEvent(e.executionInfo.DomainID, e.executionInfo.WorkflowID, e.executionInfo.RunID,
+	e.eventsCache.PutEvent(e.executionInfo.DomainID, e.executionInfo.WorkflowID, e.executionInfo.RunID,
 		event.GetEventId(), event)
 
 	ci, err := e.ReplicateStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID, event, createRequestID)
@@ -3527,6 +3751,7 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 
 		Condition: e.condition,
 	}
+	e.prepareMutableStateSnapshot(workflowMutation)
 
 	if err := e.cleanupTransaction(transactionPolicy); err != nil {
 		return nil, nil, err
@@ -3594,6 +3819,9 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 
 		Condition: e.condition,
 	}
+	// a full workflow snapshot overwrites the mutable state maps and drops any mutable state snapshot
+	e.hasMutableStateSnapshot = false
+	e.mutableStateDeltaSize = e.getPendingMutableStateSize()
 
 	if err := e.cleanupTransaction(transactionPolicy); err != nil {
 		return nil, nil, err
@@ -3601,6 +3829,54 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 	return workflowSnapshot, workflowEventsSeq, nil
 }
 
+func (e *mutableStateBuilder) prepareMutableStateSnapshot(
+	workflowMutation *persistence.WorkflowMutation,
+) {
+
+	workflowMutation.HasMutableStateSnapshot = e.hasMutableStateSnapshot
+
+	// heartbeats only overwrite the progress of an existing activity, they do not grow the mutable state
+	e.mutableStateDeltaSize += len(workflowMutation.UpsertActivityInfos) - len(e.heartbeatActivityInfos) +
+		len(workflowMutation.DeleteActivityInfos) +
+		len(workflowMutation.UpserTimerInfos) + len(workflowMutation.DeleteTimerInfos) +
+		len(workflowMutation.UpsertChildExecutionInfos) + len(workflowMutation.UpsertRequestCancelInfos) +
+		len(workflowMutation.UpsertSignalInfos) + len(workflowMutation.UpsertSignalRequestedIDs)
+	if workflowMutation.DeleteChildExecutionInfo != nil {
+		e.mutableStateDeltaSize++
+	}
+	if workflowMutation.DeleteRequestCancelInfo != nil {
+		e.mutableStateDeltaSize++
+	}
+	if workflowMutation.DeleteSignalInfo != nil {
+		e.mutableStateDeltaSize++
+	}
+	if workflowMutation.DeleteSignalRequestedID != "" {
+		e.mutableStateDeltaSize++
+	}
+
+	if !e.config.EnableMutableStateSnapshot(e.domainName) ||
+		e.mutableStateDeltaSize < e.config.MutableStateSnapshotThreshold(e.domainName) {
+		return
+	}
+
+	// carry the complete mutable state maps, so persistence can compact them into a new snapshot
+	workflowMutation.UpsertActivityInfos = convertPendingActivityInfos(e.pendingActivityInfoIDs)
+	workflowMutation.UpserTimerInfos = convertPendingTimerInfos(e.pendingTimerInfoIDs)
+	workflowMutation.UpsertChildExecutionInfos = convertPendingChildExecutionInfos(e.pendingChildExecutionInfoIDs)
+	workflowMutation.UpsertRequestCancelInfos = convertPendingRequestCancelInfos(e.pendingRequestCancelInfoIDs)
+	workflowMutation.UpsertSignalInfos = convertPendingSignalInfos(e.pendingSignalInfoIDs)
+	workflowMutation.UpsertSignalRequestedIDs = convertSignalRequestedIDs(e.pendingSignalRequestedIDs)
+	workflowMutation.SnapshotMutableState = true
+
+	e.hasMutableStateSnapshot = true
+	e.mutableStateDeltaSize = 0
+}
+
+func (e *mutableStateBuilder) getPendingMutableStateSize() int {
+	return len(e.pendingActivityInfoIDs) + len(e.pendingTimerInfoIDs) + len(e.pendingChildExecutionInfoIDs) +
+		len(e.pendingRequestCancelInfoIDs) + len(e.pendingSignalInfoIDs) + len(e.pendingSignalRequestedIDs)
+}
+
 func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 	now time.Time,
 	transactionPolicy transactionPolicy,
@@ -3611,6 +3887,11 @@ func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 		return nil
 	}
 
+	if e.isTimerTaskCoalescingEnabled() {
+		e.coalesceUserTimerTask(now)
+		e.coalesceActivityTimerTask(now)
+	}
+
 	if err := e.taskGenerator.generateActivityTimerTasks(
 		e.unixNanoToTime(now.UnixNano()),
 	); err != nil {
@@ -3622,6 +3903,64 @@ func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 	)
 }
 
+// coalesceUserTimerTask marks the first user timer as covered by the outstanding timer task
+// of a deleted timer, if that task fires no later than the first user timer
+func (e *mutableStateBuilder) coalesceUserTimerTask(
+	now time.Time,
+) {
+
+	outstandingTaskTime := e.outstandingUserTimerTaskTime
+	if outstandingTaskTime.IsZero() || !outstandingTaskTime.After(now) {
+		return
+	}
+
+	userTimers := newTimerBuilder(e.logger, e.timeSource).GetUserTimers(e)
+	if len(userTimers) == 0 || userTimers[0].TaskCreated ||
+		userTimers[0].TimerSequenceID.VisibilityTimestamp.Before(outstandingTaskTime) {
+		return
+	}
+
+	ti := e.pendingTimerInfoIDs[userTimers[0].TimerID]
+	ti.TaskID = TimerTaskStatusCoalesced
+	e.UpdateUserTimer(ti.TimerID, ti)
+}
+
+// coalesceActivityTimerTask marks the first activity timeout as covered by the outstanding timer task
+// of a deleted activity, if that task fires no later than the first activity timeout
+func (e *mutableStateBuilder) coalesceActivityTimerTask(
+	now time.Time,
+) {
+
+	outstandingTaskTime := e.outstandingActivityTimerTaskTime
+	if outstandingTaskTime.IsZero() || !outstandingTaskTime.After(now) {
+		return
+	}
+
+	activityTimers := newTimerBuilder(e.logger, e.timeSource).GetActivityTimers(e)
+	if len(activityTimers) == 0 || activityTimers[0].TaskCreated ||
+		activityTimers[0].TimerSequenceID.VisibilityTimestamp.Before(outstandingTaskTime) {
+		return
+	}
+
+	ai := e.pendingActivityInfoIDs[activityTimers[0].ActivityID]
+	ai.TimerTaskStatus |= getActivityTimerCoalescedStatus(activityTimers[0].TimeoutType)
+	e.updateActivityInfos[ai] = struct{}{}
+}
+
+// isTimerTaskCoalescingEnabled returns whether the timer tasks can be coalesced,
+// only local domains are allowed, since the standby cluster does not create the timer tasks of coalesced timers
+func (e *mutableStateBuilder) isTimerTaskCoalescingEnabled() bool {
+	if !e.config.EnableTimerTaskCoalescing(e.domainName) {
+		return false
+	}
+
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(e.executionInfo.DomainID)
+	if err != nil {
+		return false
+	}
+	return !domainEntry.IsGlobalDomain()
+}
+
 func (e *mutableStateBuilder) prepareTransaction(
 	now time.Time,
 	transactionPolicy transactionPolicy,
@@ -3680,9 +4019,12 @@ func (e *mutableStateBuilder) cleanupTransaction(
 	e.updateActivityInfos = make(map[*persistence.ActivityInfo]struct{})
 	e.deleteActivityInfos = make(map[int64]struct{})
 	e.syncActivityTasks = make(map[int64]struct{})
+	e.heartbeatActivityInfos = make(map[*persistence.ActivityInfo]struct{})
 
 	e.updateTimerInfos = make(map[*persistence.TimerInfo]struct{})
 	e.deleteTimerInfos = make(map[string]struct{})
+	e.outstandingUserTimerTaskTime = time.Time{}
+	e.outstandingActivityTimerTaskTime = time.Time{}
 
 	e.updateChildExecutionInfos = make(map[*persistence.ChildExecutionInfo]struct{})
 	e.deleteChildExecutionInfo = nil
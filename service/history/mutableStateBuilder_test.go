@@ -25,7 +25,9 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/shared"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -33,7 +35,9 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -68,6 +72,7 @@ func (s *mutableStateSuite) SetupTest() {
 		closeCh:                   make(chan int, 100),
 		config:                    NewDynamicConfigForTest(),
 		logger:                    s.logger,
+		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
 		timeSource:                clock.NewRealTimeSource(),
 	}
 	s.mockEventsCache = &MockEventsCache{}
@@ -490,6 +495,314 @@ func (s *mutableStateSuite) TestMergeMapOfByteArray() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestCoalesceUserTimerTask() {
+	now := time.Now()
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(10)},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"tid1": {TimerID: "tid1", StartedID: 5, TaskID: TimerTaskStatusCreated, ExpiryTime: now.Add(10 * time.Second)},
+			"tid2": {TimerID: "tid2", StartedID: 6, TaskID: TimerTaskStatusNone, ExpiryTime: now.Add(20 * time.Second)},
+		},
+	})
+
+	// no outstanding timer task of a deleted timer
+	s.msBuilder.coalesceUserTimerTask(now)
+	_, ti := s.msBuilder.GetUserTimer("tid2")
+	s.Equal(int64(TimerTaskStatusNone), ti.TaskID)
+
+	// the timer task of the deleted timer fires before the next timer
+	s.msBuilder.DeleteUserTimer("tid1")
+	s.msBuilder.coalesceUserTimerTask(now)
+	_, ti = s.msBuilder.GetUserTimer("tid2")
+	s.Equal(int64(TimerTaskStatusCoalesced), ti.TaskID)
+	s.Nil(newTimerBuilder(s.logger, clock.NewRealTimeSource()).GetUserTimerTaskIfNeeded(s.msBuilder))
+}
+
+func (s *mutableStateSuite) TestCoalesceUserTimerTask_OutstandingTaskFired() {
+	now := time.Now()
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(10)},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"tid1": {TimerID: "tid1", StartedID: 5, TaskID: TimerTaskStatusCreated, ExpiryTime: now.Add(-time.Second)},
+			"tid2": {TimerID: "tid2", StartedID: 6, TaskID: TimerTaskStatusNone, ExpiryTime: now.Add(20 * time.Second)},
+		},
+	})
+
+	s.msBuilder.DeleteUserTimer("tid1")
+	s.msBuilder.coalesceUserTimerTask(now)
+	_, ti := s.msBuilder.GetUserTimer("tid2")
+	s.Equal(int64(TimerTaskStatusNone), ti.TaskID)
+	s.NotNil(newTimerBuilder(s.logger, clock.NewRealTimeSource()).GetUserTimerTaskIfNeeded(s.msBuilder))
+}
+
+func (s *mutableStateSuite) TestCoalesceActivityTimerTask() {
+	now := time.Now()
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(10)},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {
+				ScheduleID:             5,
+				ActivityID:             "aid1",
+				StartedID:              common.EmptyEventID,
+				ScheduledTime:          now,
+				ScheduleToStartTimeout: 10,
+				ScheduleToCloseTimeout: 100,
+				TimerTaskStatus:        TimerTaskStatusCreatedScheduleToStart,
+			},
+			6: {
+				ScheduleID:             6,
+				ActivityID:             "aid2",
+				StartedID:              common.EmptyEventID,
+				ScheduledTime:          now,
+				ScheduleToStartTimeout: 20,
+				ScheduleToCloseTimeout: 100,
+				TimerTaskStatus:        TimerTaskStatusNone,
+			},
+		},
+	})
+
+	s.NoError(s.msBuilder.DeleteActivity(5))
+	s.msBuilder.coalesceActivityTimerTask(now)
+	ai, ok := s.msBuilder.GetActivityInfo(6)
+	s.True(ok)
+	s.Equal(int32(TimerTaskStatusCoalescedScheduleToStart), ai.TimerTaskStatus)
+	s.Nil(newTimerBuilder(s.logger, clock.NewRealTimeSource()).GetActivityTimerTaskIfNeeded(s.msBuilder))
+}
+
+func (s *mutableStateSuite) TestPrepareMutableStateSnapshot_Disabled() {
+	s.loadMutableStateForSnapshot(false, 2)
+
+	mutation := &persistence.WorkflowMutation{
+		UpserTimerInfos:     []*persistence.TimerInfo{{TimerID: "tid1"}},
+		DeleteActivityInfos: []int64{6},
+	}
+	s.msBuilder.prepareMutableStateSnapshot(mutation)
+	s.False(mutation.SnapshotMutableState)
+	s.False(mutation.HasMutableStateSnapshot)
+	s.Equal(2, s.msBuilder.mutableStateDeltaSize)
+}
+
+func (s *mutableStateSuite) TestPrepareMutableStateSnapshot_Threshold() {
+	s.loadMutableStateForSnapshot(true, 3)
+
+	mutation := &persistence.WorkflowMutation{
+		UpserTimerInfos: []*persistence.TimerInfo{{TimerID: "tid1"}},
+	}
+	s.msBuilder.prepareMutableStateSnapshot(mutation)
+	s.False(mutation.SnapshotMutableState)
+	s.Equal(1, s.msBuilder.mutableStateDeltaSize)
+
+	signalID := int64(7)
+	mutation = &persistence.WorkflowMutation{
+		UpsertSignalRequestedIDs: []string{"signal"},
+		DeleteSignalInfo:         &signalID,
+	}
+	s.msBuilder.prepareMutableStateSnapshot(mutation)
+	s.True(mutation.SnapshotMutableState)
+	s.False(mutation.HasMutableStateSnapshot)
+	s.Len(mutation.UpsertActivityInfos, 1)
+	s.Len(mutation.UpserTimerInfos, 1)
+	s.Len(mutation.UpsertSignalRequestedIDs, 0)
+	s.Equal(0, s.msBuilder.mutableStateDeltaSize)
+
+	mutation = &persistence.WorkflowMutation{}
+	s.msBuilder.prepareMutableStateSnapshot(mutation)
+	s.False(mutation.SnapshotMutableState)
+	s.True(mutation.HasMutableStateSnapshot)
+}
+
+func (s *mutableStateSuite) TestPrepareMutableStateSnapshot_HeartbeatNotCounted() {
+	s.loadMutableStateForSnapshot(true, 1)
+
+	ai, ok := s.msBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.msBuilder.UpdateActivityProgress(ai, &workflow.RecordActivityTaskHeartbeatRequest{Details: []byte("details")})
+	mutation := &persistence.WorkflowMutation{
+		UpsertActivityInfos: convertUpdateActivityInfos(s.msBuilder.updateActivityInfos),
+	}
+	s.msBuilder.prepareMutableStateSnapshot(mutation)
+	s.False(mutation.SnapshotMutableState)
+	s.Equal(0, s.msBuilder.mutableStateDeltaSize)
+	s.NoError(s.msBuilder.cleanupTransaction(transactionPolicyActive))
+
+	// an activity also modified by other means than a heartbeat is counted
+	s.NoError(s.msBuilder.UpdateActivity(ai))
+	s.msBuilder.UpdateActivityProgress(ai, &workflow.RecordActivityTaskHeartbeatRequest{Details: []byte("details")})
+	mutation = &persistence.WorkflowMutation{
+		UpsertActivityInfos: convertUpdateActivityInfos(s.msBuilder.updateActivityInfos),
+	}
+	s.msBuilder.prepareMutableStateSnapshot(mutation)
+	s.True(mutation.SnapshotMutableState)
+}
+
+func (s *mutableStateSuite) loadMutableStateForSnapshot(enabled bool, threshold int) {
+	s.mockShard.config.EnableMutableStateSnapshot = dynamicconfig.GetBoolPropertyFnFilteredByDomain(enabled)
+	s.mockShard.config.MutableStateSnapshotThreshold = dynamicconfig.GetIntPropertyFilteredByDomain(threshold)
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(10)},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {ScheduleID: 5, ActivityID: "5"},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"tid2": {TimerID: "tid2", StartedID: 6},
+		},
+	})
+}
+
+func (s *mutableStateSuite) TestPauseResumeWorkflowExecution() {
+	now := time.Now()
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(10)},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{
+			5: {
+				ScheduleID:             5,
+				ActivityID:             "aid1",
+				StartedID:              6,
+				ScheduledTime:          now,
+				StartedTime:            now,
+				StartToCloseTimeout:    10,
+				ScheduleToCloseTimeout: 100,
+				TimerTaskStatus:        TimerTaskStatusCreatedStartToClose,
+			},
+		},
+		TimerInfos: map[string]*persistence.TimerInfo{
+			"tid1": {TimerID: "tid1", StartedID: 7, TaskID: TimerTaskStatusCreated, ExpiryTime: now.Add(10 * time.Second)},
+		},
+	})
+
+	s.NoError(s.msBuilder.PauseWorkflowExecution())
+	s.True(s.msBuilder.IsWorkflowExecutionPaused())
+
+	// timer tasks skipped while paused are created again on resume
+	s.NoError(s.msBuilder.ResumeWorkflowExecution(now))
+	s.False(s.msBuilder.IsWorkflowExecutionPaused())
+	ai, ok := s.msBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.Equal(int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+	_, ti := s.msBuilder.GetUserTimer("tid1")
+	s.Equal(int64(TimerTaskStatusNone), ti.TaskID)
+	s.NotNil(newTimerBuilder(s.logger, clock.NewRealTimeSource()).GetUserTimerTaskIfNeeded(s.msBuilder))
+	s.NotNil(newTimerBuilder(s.logger, clock.NewRealTimeSource()).GetActivityTimerTaskIfNeeded(s.msBuilder))
+
+	s.msBuilder.GetExecutionInfo().State = persistence.WorkflowStateCompleted
+	s.Equal(ErrWorkflowFinished, s.msBuilder.PauseWorkflowExecution())
+}
+
+func (s *mutableStateSuite) TestAddWorkflowExecutionTerminationProtectionSetEvent() {
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   validDomainID,
+			WorkflowID: "wId",
+			RunID:      validRunID,
+			State:      persistence.WorkflowStateRunning,
+		},
+	})
+
+	event, err := s.msBuilder.AddWorkflowExecutionTerminationProtectionSetEvent(true, "some random identity")
+	s.NoError(err)
+	s.Equal(workflow.EventTypeWorkflowExecutionTerminationProtectionSet, event.GetEventType())
+	s.True(event.WorkflowExecutionTerminationProtectionSetEventAttributes.GetTerminationProtected())
+	s.True(s.msBuilder.IsWorkflowExecutionTerminationProtected())
+	_, err = s.msBuilder.AddWorkflowExecutionTerminationProtectionSetEvent(false, "some random identity")
+	s.NoError(err)
+	s.False(s.msBuilder.IsWorkflowExecutionTerminationProtected())
+
+	s.msBuilder.GetExecutionInfo().State = persistence.WorkflowStateCompleted
+	_, err = s.msBuilder.AddWorkflowExecutionTerminationProtectionSetEvent(true, "some random identity")
+	s.Equal(ErrWorkflowFinished, err)
+}
+
+func (s *mutableStateSuite) TestStartReplication() {
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:    validDomainID,
+			WorkflowID:  "wId",
+			RunID:       validRunID,
+			State:       persistence.WorkflowStateRunning,
+			NextEventID: int64(10),
+		},
+	})
+	s.Nil(s.msBuilder.GetReplicationState())
+
+	version := int64(1)
+	s.NoError(s.msBuilder.StartReplication(version, cache.ReplicationPolicyMultiCluster))
+	replicationState := s.msBuilder.GetReplicationState()
+	s.NotNil(replicationState)
+	s.Equal(version, replicationState.StartVersion)
+	s.Equal(version, replicationState.CurrentVersion)
+	s.Equal(version, replicationState.LastWriteVersion)
+	s.Equal(int64(9), replicationState.LastWriteEventID)
+
+	// the history recorded so far is replicated
+	s.Len(s.msBuilder.insertReplicationTasks, 1)
+	task := s.msBuilder.insertReplicationTasks[0].(*persistence.HistoryReplicationTask)
+	s.Equal(common.FirstEventID, task.FirstEventID)
+	s.Equal(int64(10), task.NextEventID)
+	s.Equal(version, task.Version)
+
+	s.Error(s.msBuilder.StartReplication(version, cache.ReplicationPolicyMultiCluster))
+}
+
+func (s *mutableStateSuite) TestAddActivityTaskScheduledEvent_Delayed() {
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:    validDomainID,
+			WorkflowID:  "some random workflow ID",
+			RunID:       uuid.New(),
+			NextEventID: int64(5),
+		},
+		ActivityInfos: map[int64]*persistence.ActivityInfo{},
+	})
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	event, ai, err := s.msBuilder.AddActivityTaskScheduledEvent(4, &shared.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    common.StringPtr("aid1"),
+		ActivityType:                  &shared.ActivityType{Name: common.StringPtr("some random activity type")},
+		TaskList:                      &shared.TaskList{Name: common.StringPtr("some random tasklist")},
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(90),
+		DelayStartSeconds:             common.Int32Ptr(30),
+	})
+	s.NoError(err)
+	s.Equal(int32(30), event.ActivityTaskScheduledEventAttributes.GetDelayStartSeconds())
+	scheduledTime := time.Unix(0, event.GetTimestamp()).Add(30 * time.Second)
+	s.Equal(scheduledTime, ai.ScheduledTime)
+
+	// the activity task is only pushed to matching once the delay elapses
+	s.Empty(s.msBuilder.insertTransferTasks)
+	s.Equal(1, len(s.msBuilder.insertTimerTasks))
+	retryTask, ok := s.msBuilder.insertTimerTasks[0].(*persistence.ActivityRetryTimerTask)
+	s.True(ok)
+	s.Equal(ai.ScheduleID, retryTask.EventID)
+	s.Equal(int32(0), retryTask.Attempt)
+	s.Equal(scheduledTime, retryTask.VisibilityTimestamp)
+}
+
+func (s *mutableStateSuite) TestCreateNewHistoryEvent_MonotonicTimestamp() {
+	physicalTimeSource := clock.NewEventTimeSource().Update(time.Now())
+	shardClock := clock.NewHybridLogicalClock(physicalTimeSource, 2*time.Hour)
+	s.mockShard.timeSource = shardClock
+	s.msBuilder = newMutableStateBuilder(s.mockShard, s.mockEventsCache, s.logger, "")
+	s.msBuilder.Load(&persistence.WorkflowMutableState{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(5)},
+	})
+
+	event1 := s.msBuilder.CreateNewHistoryEvent(shared.EventTypeMarkerRecorded)
+	s.Equal(physicalTimeSource.Now().UnixNano(), event1.GetTimestamp())
+
+	// physical clock regresses
+	physicalTimeSource.Update(physicalTimeSource.Now().Add(-time.Minute))
+	event2 := s.msBuilder.CreateNewHistoryEvent(shared.EventTypeMarkerRecorded)
+	s.True(event2.GetTimestamp() > event1.GetTimestamp())
+
+	// shard observed a later clock, e.g. from the previous shard owner before failover
+	observedTime := physicalTimeSource.Now().Add(time.Hour)
+	s.mockShard.ObserveTime(observedTime)
+	event3 := s.msBuilder.CreateNewHistoryEvent(shared.EventTypeMarkerRecorded)
+	s.True(event3.GetTimestamp() > observedTime.UnixNano())
+}
+
 func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicated(version int64, runID string) (*shared.HistoryEvent, *shared.HistoryEvent) {
 	domainID := validDomainID
 	execution := shared.WorkflowExecution{
@@ -557,7 +870,7 @@ func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicat
 	}
 	eventID++
 
-	s.mockEventsCache.On("putEvent", domainID, execution.GetWorkflowId(), execution.GetRunId(),
+	s.mockEventsCache.On("PutEvent", domainID, execution.GetWorkflowId(), execution.GetRunId(),
 		workflowStartEvent.GetEventId(), workflowStartEvent).Return(nil).Once()
 	err := s.msBuilder.ReplicateWorkflowExecutionStartedEvent(
 		cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: domainID}, &persistence.DomainConfig{}, "", nil),
@@ -785,7 +785,9 @@ func (m *mutableStateDecisionTaskManagerImpl) afterAddDecisionTaskCompletedEvent
 	event *workflow.HistoryEvent,
 	maxResetPoints int,
 ) {
-	m.msb.executionInfo.LastProcessedEvent = event.GetDecisionTaskCompletedEventAttributes().GetStartedEventId()
+	attributes := event.GetDecisionTaskCompletedEventAttributes()
+	m.msb.executionInfo.LastProcessedEvent = attributes.GetStartedEventId()
+	m.msb.executionInfo.LastDecisionBinaryChecksum = attributes.GetBinaryChecksum()
 	m.msb.addBinaryCheckSumIfNotExists(event, maxResetPoints)
 }
 
@@ -40,6 +40,9 @@ type (
 		generateWorkflowCloseTasks(
 			now time.Time,
 		) error
+		generateWorkflowCleanupTasks(
+			now time.Time,
+		) error
 		generateRecordWorkflowStartedTasks(
 			now time.Time,
 		) error
@@ -92,6 +95,7 @@ type (
 
 	mutableStateTaskGeneratorImpl struct {
 		domainCache cache.DomainCache
+		config      *Config
 		logger      log.Logger
 
 		mutableState mutableState
@@ -104,12 +108,14 @@ var _ mutableStateTaskGenerator = (*mutableStateTaskGeneratorImpl)(nil)
 
 func newMutableStateTaskGenerator(
 	domainCache cache.DomainCache,
+	config *Config,
 	logger log.Logger,
 	mutableState mutableState,
 ) *mutableStateTaskGeneratorImpl {
 
 	return &mutableStateTaskGeneratorImpl{
 		domainCache: domainCache,
+		config:      config,
 		logger:      logger,
 
 		mutableState: mutableState,
@@ -147,7 +153,6 @@ func (r *mutableStateTaskGeneratorImpl) generateWorkflowCloseTasks(
 ) error {
 
 	currentVersion := r.mutableState.GetCurrentVersion()
-	executionInfo := r.mutableState.GetExecutionInfo()
 
 	r.mutableState.AddTransferTasks(&persistence.CloseExecutionTask{
 		// TaskID is set by shard
@@ -155,7 +160,18 @@ func (r *mutableStateTaskGeneratorImpl) generateWorkflowCloseTasks(
 		Version:             currentVersion,
 	})
 
+	return r.generateWorkflowCleanupTasks(now)
+}
+
+func (r *mutableStateTaskGeneratorImpl) generateWorkflowCleanupTasks(
+	now time.Time,
+) error {
+
+	currentVersion := r.mutableState.GetCurrentVersion()
+	executionInfo := r.mutableState.GetExecutionInfo()
+
 	retentionInDays := defaultWorkflowRetentionInDays
+	var visibilityRetention time.Duration
 	domainEntry, err := r.domainCache.GetDomainByID(executionInfo.DomainID)
 	switch err.(type) {
 	case nil:
@@ -167,11 +183,21 @@ func (r *mutableStateTaskGeneratorImpl) generateWorkflowCloseTasks(
 	}
 
 	retentionDuration := time.Duration(retentionInDays) * time.Hour * 24
+	if domainEntry != nil {
+		visibilityRetention = getVisibilityRetention(r.config, domainEntry.GetInfo().Name, retentionDuration)
+	}
 	r.mutableState.AddTimerTasks(&persistence.DeleteHistoryEventTask{
 		// TaskID is set by shard
 		VisibilityTimestamp: now.Add(retentionDuration),
 		Version:             currentVersion,
 	})
+	if visibilityRetention > 0 {
+		r.mutableState.AddTimerTasks(&persistence.DeleteVisibilityTask{
+			// TaskID is set by shard
+			VisibilityTimestamp: now.Add(visibilityRetention),
+			Version:             currentVersion,
+		})
+	}
 
 	return nil
 }
@@ -310,6 +336,11 @@ func (r *mutableStateTaskGeneratorImpl) generateActivityTransferTasks(
 		}
 	}
 
+	if activityInfo.Attempt == 0 && attr.GetDelayStartSeconds() > 0 {
+		// delayed activity is dispatched by the activity retry timer once the delay elapses
+		return r.generateActivityRetryTasks(activityScheduleID)
+	}
+
 	targetDomainID, err := r.getTargetDomainID(activityTargetDomain)
 	if err != nil {
 		return err
@@ -40,7 +40,7 @@ type (
 
 	mutableStateTaskRefresherImpl struct {
 		domainCache cache.DomainCache
-		eventsCache eventsCache
+		eventsCache EventsCache
 		logger      log.Logger
 
 		mutableState  mutableState
@@ -50,7 +50,8 @@ type (
 
 func newMutableStateTaskRefresher(
 	domainCache cache.DomainCache,
-	eventsCache eventsCache,
+	config *Config,
+	eventsCache EventsCache,
 	logger log.Logger,
 	mutableState mutableState,
 ) *mutableStateTaskRefresherImpl {
@@ -63,6 +64,7 @@ func newMutableStateTaskRefresher(
 		mutableState: mutableState,
 		taskGenerator: newMutableStateTaskGenerator(
 			domainCache,
+			config,
 			logger,
 			mutableState,
 		),
@@ -71,6 +73,11 @@ func newMutableStateTaskRefresher(
 
 func (r *mutableStateTaskRefresherImpl) refreshTasks(now time.Time) error {
 
+	// zombie workflow only needs to be cleaned up at retention
+	if r.mutableState.GetExecutionInfo().State == persistence.WorkflowStateZombie {
+		return r.taskGenerator.generateWorkflowCleanupTasks(now)
+	}
+
 	if err := r.refreshTasksForWorkflowStart(now); err != nil {
 		return err
 	}
@@ -214,7 +221,7 @@ Loop:
 			continue Loop
 		}
 
-		scheduleEvent, err := r.eventsCache.getEvent(
+		scheduleEvent, err := r.eventsCache.GetEvent(
 			executionInfo.DomainID,
 			executionInfo.WorkflowID,
 			executionInfo.RunID,
@@ -283,7 +290,7 @@ Loop:
 			continue Loop
 		}
 
-		scheduleEvent, err := r.eventsCache.getEvent(
+		scheduleEvent, err := r.eventsCache.GetEvent(
 			executionInfo.DomainID,
 			executionInfo.WorkflowID,
 			executionInfo.RunID,
@@ -315,7 +322,7 @@ func (r *mutableStateTaskRefresherImpl) refreshTasksForRequestCancelExternalWork
 	pendingRequestCancelInfos := r.mutableState.GetPendingRequestCancelExternalInfos()
 
 	for _, requestCancelInfo := range pendingRequestCancelInfos {
-		initiateEvent, err := r.eventsCache.getEvent(
+		initiateEvent, err := r.eventsCache.GetEvent(
 			executionInfo.DomainID,
 			executionInfo.WorkflowID,
 			executionInfo.RunID,
@@ -347,7 +354,7 @@ func (r *mutableStateTaskRefresherImpl) refreshTasksForSignalExternalWorkflow(
 	pendingSignalInfos := r.mutableState.GetPendingSignalExternalInfos()
 
 	for _, signalInfo := range pendingSignalInfos {
-		initiateEvent, err := r.eventsCache.getEvent(
+		initiateEvent, err := r.eventsCache.GetEvent(
 			executionInfo.DomainID,
 			executionInfo.WorkflowID,
 			executionInfo.RunID,
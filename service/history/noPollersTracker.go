@@ -0,0 +1,223 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	ctx "context"
+	"sync/atomic"
+	"time"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	noPollersTrackerInitialSize = 64
+	noPollersTrackerMaxSize     = 4096
+	noPollersCheckQueueSize     = 1024
+
+	describeTaskListTimeout = 2 * time.Second
+	// describeTaskListCacheTTL bounds how often the pollers of a single task list are described,
+	// no matter how many schedule to start timeouts happen on it
+	describeTaskListCacheTTL = 30 * time.Second
+)
+
+type (
+	// noPollersTracker tracks activity and decision task lists which repeatedly hit schedule to start
+	// timeouts while having no pollers. The pollers are checked asynchronously, outside of the
+	// workflow lock, and the matching describe results are cached per task list.
+	// Activity task lists without pollers are surfaced by DescribeWorkflowExecution as pending
+	// activity state no pollers, decision task lists are surfaced by logs and metrics only.
+	noPollersTracker struct {
+		status         int32
+		config         *Config
+		matchingClient matching.Client
+		logger         log.Logger
+		cache          cache.Cache
+		pollersCache   cache.Cache
+		checkCh        chan *noPollersCheck
+		shutdownCh     chan struct{}
+	}
+
+	noPollersTrackerKey struct {
+		domainID     string
+		taskList     string
+		taskListType workflow.TaskListType
+	}
+
+	noPollersCheck struct {
+		key         noPollersTrackerKey
+		metricScope metrics.Scope
+	}
+)
+
+func newNoPollersTracker(
+	config *Config,
+	matchingClient matching.Client,
+	logger log.Logger,
+) *noPollersTracker {
+
+	return &noPollersTracker{
+		status:         common.DaemonStatusInitialized,
+		config:         config,
+		matchingClient: matchingClient,
+		logger:         logger,
+		cache: cache.New(noPollersTrackerMaxSize, &cache.Options{
+			InitialCapacity: noPollersTrackerInitialSize,
+			TTL:             config.NoPollersWarningTTL(),
+		}),
+		pollersCache: cache.New(noPollersTrackerMaxSize, &cache.Options{
+			InitialCapacity: noPollersTrackerInitialSize,
+			TTL:             describeTaskListCacheTTL,
+		}),
+		checkCh:    make(chan *noPollersCheck, noPollersCheckQueueSize),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func (t *noPollersTracker) Start() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go t.checkLoop()
+}
+
+func (t *noPollersTracker) Stop() {
+	if !atomic.CompareAndSwapInt32(&t.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(t.shutdownCh)
+}
+
+// checkTaskListPollers queues a check of the task list pollers after a schedule to start timeout,
+// it never blocks the caller and drops the check if the queue is full
+func (t *noPollersTracker) checkTaskListPollers(
+	domainID string,
+	taskList string,
+	taskListType workflow.TaskListType,
+	metricScope metrics.Scope,
+) {
+
+	check := &noPollersCheck{
+		key:         noPollersTrackerKey{domainID: domainID, taskList: taskList, taskListType: taskListType},
+		metricScope: metricScope,
+	}
+	select {
+	case t.checkCh <- check:
+	default:
+	}
+}
+
+func (t *noPollersTracker) checkLoop() {
+	for {
+		select {
+		case <-t.shutdownCh:
+			return
+		case check := <-t.checkCh:
+			t.processCheck(check)
+		}
+	}
+}
+
+func (t *noPollersTracker) processCheck(
+	check *noPollersCheck,
+) {
+
+	hasPollers, err := t.hasPollers(check.key)
+	if err != nil {
+		// best effort only, the timeout itself is processed regardless
+		return
+	}
+
+	count := t.recordScheduleToStartTimeout(check.key, hasPollers)
+	if count >= t.config.NoPollersWarningThreshold() {
+		check.metricScope.IncCounter(metrics.ScheduleToStartTimeoutNoPollersCounter)
+		t.logger.Warn("Tasks repeatedly hit schedule to start timeout on task list without pollers",
+			tag.WorkflowDomainID(check.key.domainID),
+			tag.WorkflowTaskListName(check.key.taskList),
+			tag.WorkflowTaskListType(int(check.key.taskListType)),
+			tag.Counter(count))
+	}
+}
+
+// hasPollers describes the task list in matching, the result is cached per task list
+func (t *noPollersTracker) hasPollers(
+	key noPollersTrackerKey,
+) (bool, error) {
+
+	if value := t.pollersCache.Get(key); value != nil {
+		return value.(bool), nil
+	}
+
+	describeCtx, cancel := ctx.WithTimeout(ctx.Background(), describeTaskListTimeout)
+	defer cancel()
+	resp, err := t.matchingClient.DescribeTaskList(describeCtx, &m.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(key.domainID),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(key.taskList)},
+			TaskListType: common.TaskListTypePtr(key.taskListType),
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	hasPollers := len(resp.Pollers) > 0
+	t.pollersCache.Put(key, hasPollers)
+	return hasPollers, nil
+}
+
+// recordScheduleToStartTimeout records a schedule to start timeout on the given task list
+// and returns the number of consecutive timeouts seen without pollers
+func (t *noPollersTracker) recordScheduleToStartTimeout(
+	key noPollersTrackerKey,
+	hasPollers bool,
+) int {
+
+	if hasPollers {
+		t.cache.Delete(key)
+		return 0
+	}
+
+	count := 1
+	if value := t.cache.Get(key); value != nil {
+		count += value.(int)
+	}
+	t.cache.Put(key, count)
+	return count
+}
+
+// hasNoPollers returns true if the task list repeatedly hit schedule to start timeouts without pollers
+func (t *noPollersTracker) hasNoPollers(
+	domainID string,
+	taskList string,
+	taskListType workflow.TaskListType,
+) bool {
+
+	value := t.cache.Get(noPollersTrackerKey{domainID: domainID, taskList: taskList, taskListType: taskListType})
+	return value != nil && value.(int) >= t.config.NoPollersWarningThreshold()
+}
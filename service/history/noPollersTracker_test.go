@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	noPollersTrackerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		controller         *gomock.Controller
+		mockMatchingClient *matchingservicetest.MockClient
+		metricScope        metrics.Scope
+		tracker            *noPollersTracker
+	}
+)
+
+func TestNoPollersTrackerSuite(t *testing.T) {
+	s := new(noPollersTrackerSuite)
+	suite.Run(t, s)
+}
+
+func (s *noPollersTrackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockMatchingClient = matchingservicetest.NewMockClient(s.controller)
+	s.metricScope = metrics.NewClient(tally.NoopScope, metrics.History).Scope(metrics.TimerActiveTaskActivityTimeoutScope)
+	config := NewDynamicConfigForTest()
+	config.NoPollersWarningThreshold = dynamicconfig.GetIntPropertyFn(2)
+	config.NoPollersWarningTTL = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.tracker = newNoPollersTracker(config, s.mockMatchingClient, loggerimpl.NewNopLogger())
+}
+
+func (s *noPollersTrackerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *noPollersTrackerSuite) TestRecordScheduleToStartTimeout_NoPollers() {
+	key := noPollersTrackerKey{
+		domainID:     "some random domain ID",
+		taskList:     "some random task list",
+		taskListType: workflow.TaskListTypeActivity,
+	}
+
+	s.False(s.tracker.hasNoPollers(key.domainID, key.taskList, key.taskListType))
+	s.Equal(1, s.tracker.recordScheduleToStartTimeout(key, false))
+	s.False(s.tracker.hasNoPollers(key.domainID, key.taskList, key.taskListType))
+	s.Equal(2, s.tracker.recordScheduleToStartTimeout(key, false))
+	s.True(s.tracker.hasNoPollers(key.domainID, key.taskList, key.taskListType))
+	s.False(s.tracker.hasNoPollers(key.domainID, "some other task list", key.taskListType))
+	s.False(s.tracker.hasNoPollers("some other domain ID", key.taskList, key.taskListType))
+	s.False(s.tracker.hasNoPollers(key.domainID, key.taskList, workflow.TaskListTypeDecision))
+}
+
+func (s *noPollersTrackerSuite) TestRecordScheduleToStartTimeout_HasPollers() {
+	key := noPollersTrackerKey{
+		domainID:     "some random domain ID",
+		taskList:     "some random task list",
+		taskListType: workflow.TaskListTypeActivity,
+	}
+
+	s.tracker.recordScheduleToStartTimeout(key, false)
+	s.tracker.recordScheduleToStartTimeout(key, false)
+	s.True(s.tracker.hasNoPollers(key.domainID, key.taskList, key.taskListType))
+
+	s.Equal(0, s.tracker.recordScheduleToStartTimeout(key, true))
+	s.False(s.tracker.hasNoPollers(key.domainID, key.taskList, key.taskListType))
+}
+
+func (s *noPollersTrackerSuite) TestProcessCheck_DescribeCachedPerTaskList() {
+	domainID := "some random domain ID"
+	taskList := "some random task list"
+
+	s.mockMatchingClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(&workflow.DescribeTaskListResponse{}, nil).Times(2)
+	check := &noPollersCheck{
+		key:         noPollersTrackerKey{domainID: domainID, taskList: taskList, taskListType: workflow.TaskListTypeActivity},
+		metricScope: s.metricScope,
+	}
+	s.tracker.processCheck(check)
+	s.tracker.processCheck(check)
+	s.True(s.tracker.hasNoPollers(domainID, taskList, workflow.TaskListTypeActivity))
+
+	// decision task list with the same name is described separately
+	check = &noPollersCheck{
+		key:         noPollersTrackerKey{domainID: domainID, taskList: taskList, taskListType: workflow.TaskListTypeDecision},
+		metricScope: s.metricScope,
+	}
+	s.tracker.processCheck(check)
+	s.False(s.tracker.hasNoPollers(domainID, taskList, workflow.TaskListTypeDecision))
+}
+
+func (s *noPollersTrackerSuite) TestProcessCheck_DescribeFailed() {
+	domainID := "some random domain ID"
+	taskList := "some random task list"
+
+	s.mockMatchingClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(nil, errors.New("some random error")).Times(2)
+	check := &noPollersCheck{
+		key:         noPollersTrackerKey{domainID: domainID, taskList: taskList, taskListType: workflow.TaskListTypeActivity},
+		metricScope: s.metricScope,
+	}
+	s.tracker.processCheck(check)
+	s.tracker.processCheck(check)
+	s.False(s.tracker.hasNoPollers(domainID, taskList, workflow.TaskListTypeActivity))
+}
+
+func (s *noPollersTrackerSuite) TestCheckTaskListPollers_NonBlocking() {
+	// tracker is not started, the checks beyond the queue size are dropped instead of blocking the caller
+	for i := 0; i < noPollersCheckQueueSize+1; i++ {
+		s.tracker.checkTaskListPollers("some random domain ID", "some random task list", workflow.TaskListTypeActivity, s.metricScope)
+	}
+	s.Equal(noPollersCheckQueueSize, len(s.tracker.checkCh))
+}
@@ -59,7 +59,7 @@ type (
 		processor     processor
 		logger        log.Logger
 		metricsClient metrics.Client
-		rateLimiter   quotas.Limiter // Read rate limiter
+		rateLimiter   *quotas.DynamicRateLimiter // Read rate limiter
 		ackMgr        queueAckMgr
 		taskProcessor *taskProcessor
 
@@ -69,6 +69,9 @@ type (
 		status     int32
 		shutdownWG sync.WaitGroup
 		shutdownCh chan struct{}
+
+		configChangedCh chan struct{}
+		configWatchers  []*dynamicconfig.Watcher
 	}
 )
 
@@ -105,14 +108,15 @@ func newQueueProcessorBase(
 				return float64(options.MaxPollRPS())
 			},
 		),
-		status:        common.DaemonStatusInitialized,
-		notifyCh:      make(chan struct{}, 1),
-		shutdownCh:    make(chan struct{}),
-		metricsClient: shard.GetMetricsClient(),
-		logger:        logger,
-		ackMgr:        queueAckMgr,
-		lastPollTime:  time.Time{},
-		taskProcessor: taskProcessor,
+		status:          common.DaemonStatusInitialized,
+		notifyCh:        make(chan struct{}, 1),
+		shutdownCh:      make(chan struct{}),
+		configChangedCh: make(chan struct{}, 1),
+		metricsClient:   shard.GetMetricsClient(),
+		logger:          logger,
+		ackMgr:          queueAckMgr,
+		lastPollTime:    time.Time{},
+		taskProcessor:   taskProcessor,
 	}
 
 	return p
@@ -127,6 +131,11 @@ func (p *queueProcessorBase) Start() {
 	defer p.logger.Info("", tag.LifeCycleStarted, tag.ComponentTransferQueue)
 
 	p.taskProcessor.start()
+	p.configWatchers = []*dynamicconfig.Watcher{
+		dynamicconfig.WatchIntProperty(p.options.MaxPollRPS, func(int) { p.rateLimiter.Refresh() }),
+		dynamicconfig.WatchDurationProperty(p.options.MaxPollInterval, func(time.Duration) { p.notifyConfigChanged() }),
+		dynamicconfig.WatchDurationProperty(p.options.UpdateAckInterval, func(time.Duration) { p.notifyConfigChanged() }),
+	}
 	p.shutdownWG.Add(1)
 	p.notifyNewTask()
 	go p.processorPump()
@@ -140,6 +149,9 @@ func (p *queueProcessorBase) Stop() {
 	p.logger.Info("", tag.LifeCycleStopping, tag.ComponentTransferQueue)
 	defer p.logger.Info("", tag.LifeCycleStopped, tag.ComponentTransferQueue)
 
+	for _, watcher := range p.configWatchers {
+		watcher.Stop()
+	}
 	close(p.shutdownCh)
 	p.retryTasks()
 
@@ -157,6 +169,13 @@ func (p *queueProcessorBase) notifyNewTask() {
 	}
 }
 
+func (p *queueProcessorBase) notifyConfigChanged() {
+	select {
+	case p.configChangedCh <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
 func (p *queueProcessorBase) processorPump() {
 	defer p.shutdownWG.Done()
 
@@ -196,6 +215,16 @@ processorPumpLoop:
 				p.options.UpdateAckIntervalJitterCoefficient(),
 			))
 			p.ackMgr.updateQueueAckLevel()
+		case <-p.configChangedCh:
+			// apply the new intervals right away instead of waiting for the timers set with the old ones
+			resetTimer(pollTimer, backoff.JitDuration(
+				p.options.MaxPollInterval(),
+				p.options.MaxPollIntervalJitterCoefficient(),
+			))
+			resetTimer(updateAckTimer, backoff.JitDuration(
+				p.options.UpdateAckInterval(),
+				p.options.UpdateAckIntervalJitterCoefficient(),
+			))
 		}
 	}
 
@@ -257,3 +286,14 @@ func (p *queueProcessorBase) retryTasks() {
 func (p *queueProcessorBase) complete(task queueTaskInfo) {
 	p.ackMgr.completeQueueTask(task.GetTaskID())
 }
+
+// resetTimer resets a timer which may be running or already fired
+func resetTimer(timer *time.Timer, d time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	timer.Reset(d)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// describeQueueState returns the ack levels of the given queue type in the shard info,
+// ack levels of timer queue are converted to unix nanoseconds
+func describeQueueState(
+	shardInfo *persistence.ShardInfo,
+	queueType workflow.QueueType,
+) (*workflow.DescribeQueueResponse, error) {
+
+	response := &workflow.DescribeQueueResponse{
+		ClusterAckLevels: make(map[string]int64),
+	}
+	switch queueType {
+	case workflow.QueueTypeTransfer:
+		response.AckLevel = common.Int64Ptr(shardInfo.TransferAckLevel)
+		for cluster, ackLevel := range shardInfo.ClusterTransferAckLevel {
+			response.ClusterAckLevels[cluster] = ackLevel
+		}
+	case workflow.QueueTypeTimer:
+		response.AckLevel = common.Int64Ptr(shardInfo.TimerAckLevel.UnixNano())
+		for cluster, ackLevel := range shardInfo.ClusterTimerAckLevel {
+			response.ClusterAckLevels[cluster] = ackLevel.UnixNano()
+		}
+	case workflow.QueueTypeReplication:
+		response.AckLevel = common.Int64Ptr(shardInfo.ReplicationAckLevel)
+		for cluster, ackLevel := range shardInfo.ClusterReplicationLevel {
+			response.ClusterAckLevels[cluster] = ackLevel
+		}
+	default:
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown queue type: %v.", queueType)}
+	}
+	return response, nil
+}
+
+// resetQueueState sets the ack level of the requested queue in the shard info. An empty cluster name resets the
+// ack level of the shard along with the ack levels of every cluster, as the queue processors start from the
+// latter. Moving an ack level forward skips tasks, so it is rejected unless the request is forced.
+func resetQueueState(
+	shardInfo *persistence.ShardInfo,
+	request *workflow.ResetQueueStateRequest,
+) error {
+
+	cluster := request.GetClusterName()
+	ackLevel := request.GetAckLevel()
+	force := request.GetForce()
+	switch request.GetType() {
+	case workflow.QueueTypeTransfer:
+		if shardInfo.ClusterTransferAckLevel == nil {
+			shardInfo.ClusterTransferAckLevel = make(map[string]int64)
+		}
+		levels := shardInfo.ClusterTransferAckLevel
+		if err := validateAckLevelMove(shardInfo.TransferAckLevel, levels, cluster, ackLevel, force); err != nil {
+			return err
+		}
+		if cluster == "" {
+			shardInfo.TransferAckLevel = ackLevel
+			for cluster := range levels {
+				levels[cluster] = ackLevel
+			}
+			return nil
+		}
+		levels[cluster] = ackLevel
+	case workflow.QueueTypeTimer:
+		if shardInfo.ClusterTimerAckLevel == nil {
+			shardInfo.ClusterTimerAckLevel = make(map[string]time.Time)
+		}
+		levels := make(map[string]int64, len(shardInfo.ClusterTimerAckLevel))
+		for cluster, level := range shardInfo.ClusterTimerAckLevel {
+			levels[cluster] = level.UnixNano()
+		}
+		if err := validateAckLevelMove(shardInfo.TimerAckLevel.UnixNano(), levels, cluster, ackLevel, force); err != nil {
+			return err
+		}
+		if cluster == "" {
+			shardInfo.TimerAckLevel = time.Unix(0, ackLevel)
+			for cluster := range shardInfo.ClusterTimerAckLevel {
+				shardInfo.ClusterTimerAckLevel[cluster] = time.Unix(0, ackLevel)
+			}
+			return nil
+		}
+		shardInfo.ClusterTimerAckLevel[cluster] = time.Unix(0, ackLevel)
+	case workflow.QueueTypeReplication:
+		if shardInfo.ClusterReplicationLevel == nil {
+			shardInfo.ClusterReplicationLevel = make(map[string]int64)
+		}
+		levels := shardInfo.ClusterReplicationLevel
+		if err := validateAckLevelMove(shardInfo.ReplicationAckLevel, levels, cluster, ackLevel, force); err != nil {
+			return err
+		}
+		if cluster == "" {
+			shardInfo.ReplicationAckLevel = ackLevel
+			for cluster := range levels {
+				levels[cluster] = ackLevel
+			}
+			return nil
+		}
+		levels[cluster] = ackLevel
+	default:
+		return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown queue type: %v.", request.GetType())}
+	}
+	return nil
+}
+
+// validateAckLevelMove rejects moving any of the replaced ack levels forward unless forced, a cluster without
+// an ack level of its own starts from the ack level of the shard
+func validateAckLevelMove(
+	shardAckLevel int64,
+	clusterAckLevels map[string]int64,
+	cluster string,
+	ackLevel int64,
+	force bool,
+) error {
+
+	if force {
+		return nil
+	}
+
+	current := []int64{shardAckLevel}
+	if cluster == "" {
+		for _, level := range clusterAckLevels {
+			current = append(current, level)
+		}
+	} else if level, ok := clusterAckLevels[cluster]; ok {
+		current = []int64{level}
+	}
+	for _, level := range current {
+		if ackLevel > level {
+			return &workflow.BadRequestError{Message: fmt.Sprintf(
+				"Ack level %v is ahead of current ack level %v, moving it forward skips tasks and requires force.",
+				ackLevel, level,
+			)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	queueStateSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestQueueStateSuite(t *testing.T) {
+	s := new(queueStateSuite)
+	suite.Run(t, s)
+}
+
+func (s *queueStateSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *queueStateSuite) TestDescribeQueueState() {
+	timerAckLevel := time.Unix(0, 1000)
+	shardInfo := &persistence.ShardInfo{
+		TransferAckLevel:        10,
+		ClusterTransferAckLevel: map[string]int64{"active": 12, "standby": 10},
+		TimerAckLevel:           timerAckLevel,
+		ClusterTimerAckLevel:    map[string]time.Time{"active": timerAckLevel.Add(time.Second)},
+		ReplicationAckLevel:     20,
+		ClusterReplicationLevel: map[string]int64{"standby": 21},
+	}
+
+	resp, err := describeQueueState(shardInfo, workflow.QueueTypeTransfer)
+	s.NoError(err)
+	s.Equal(int64(10), resp.GetAckLevel())
+	s.Equal(map[string]int64{"active": 12, "standby": 10}, resp.ClusterAckLevels)
+
+	resp, err = describeQueueState(shardInfo, workflow.QueueTypeTimer)
+	s.NoError(err)
+	s.Equal(int64(1000), resp.GetAckLevel())
+	s.Equal(map[string]int64{"active": int64(time.Second) + 1000}, resp.ClusterAckLevels)
+
+	resp, err = describeQueueState(shardInfo, workflow.QueueTypeReplication)
+	s.NoError(err)
+	s.Equal(int64(20), resp.GetAckLevel())
+	s.Equal(map[string]int64{"standby": 21}, resp.ClusterAckLevels)
+
+	_, err = describeQueueState(shardInfo, workflow.QueueType(100))
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *queueStateSuite) TestResetQueueState() {
+	shardInfo := &persistence.ShardInfo{
+		TransferAckLevel:        10,
+		ClusterTransferAckLevel: map[string]int64{"active": 12},
+		TimerAckLevel:           time.Unix(0, 3000),
+		ReplicationAckLevel:     10,
+	}
+
+	s.NoError(resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:        workflow.QueueTypeTransfer.Ptr(),
+		ClusterName: common.StringPtr("active"),
+		AckLevel:    common.Int64Ptr(5),
+	}))
+	s.Equal(int64(10), shardInfo.TransferAckLevel)
+	s.Equal(int64(5), shardInfo.ClusterTransferAckLevel["active"])
+
+	s.NoError(resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:     workflow.QueueTypeTransfer.Ptr(),
+		AckLevel: common.Int64Ptr(4),
+	}))
+	s.Equal(int64(4), shardInfo.TransferAckLevel)
+	s.Equal(int64(4), shardInfo.ClusterTransferAckLevel["active"])
+
+	s.NoError(resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:        workflow.QueueTypeTimer.Ptr(),
+		ClusterName: common.StringPtr("standby"),
+		AckLevel:    common.Int64Ptr(2000),
+	}))
+	s.Equal(time.Unix(0, 2000), shardInfo.ClusterTimerAckLevel["standby"])
+
+	s.NoError(resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:        workflow.QueueTypeReplication.Ptr(),
+		ClusterName: common.StringPtr("standby"),
+		AckLevel:    common.Int64Ptr(7),
+	}))
+	s.Equal(int64(7), shardInfo.ClusterReplicationLevel["standby"])
+
+	s.NoError(resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:     workflow.QueueTypeTimer.Ptr(),
+		AckLevel: common.Int64Ptr(1000),
+	}))
+	s.Equal(time.Unix(0, 1000), shardInfo.TimerAckLevel)
+	s.Equal(time.Unix(0, 1000), shardInfo.ClusterTimerAckLevel["standby"])
+
+	err := resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:     workflow.QueueType(100).Ptr(),
+		AckLevel: common.Int64Ptr(7),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *queueStateSuite) TestResetQueueState_MoveForward() {
+	shardInfo := &persistence.ShardInfo{
+		TransferAckLevel:        10,
+		ClusterTransferAckLevel: map[string]int64{"active": 12, "standby": 8},
+	}
+
+	// the ack level of the standby cluster would move forward
+	err := resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:     workflow.QueueTypeTransfer.Ptr(),
+		AckLevel: common.Int64Ptr(9),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+	s.Equal(int64(10), shardInfo.TransferAckLevel)
+	s.Equal(int64(8), shardInfo.ClusterTransferAckLevel["standby"])
+
+	err = resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:        workflow.QueueTypeTransfer.Ptr(),
+		ClusterName: common.StringPtr("active"),
+		AckLevel:    common.Int64Ptr(13),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	// a cluster without an ack level of its own starts from the ack level of the shard
+	err = resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:        workflow.QueueTypeTransfer.Ptr(),
+		ClusterName: common.StringPtr("other"),
+		AckLevel:    common.Int64Ptr(11),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	s.NoError(resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:        workflow.QueueTypeTransfer.Ptr(),
+		ClusterName: common.StringPtr("active"),
+		AckLevel:    common.Int64Ptr(11),
+	}))
+	s.Equal(int64(11), shardInfo.ClusterTransferAckLevel["active"])
+
+	s.NoError(resetQueueState(shardInfo, &workflow.ResetQueueStateRequest{
+		Type:     workflow.QueueTypeTransfer.Ptr(),
+		AckLevel: common.Int64Ptr(20),
+		Force:    common.BoolPtr(true),
+	}))
+	s.Equal(int64(20), shardInfo.TransferAckLevel)
+	s.Equal(map[string]int64{"active": 20, "standby": 20}, shardInfo.ClusterTransferAckLevel)
+}
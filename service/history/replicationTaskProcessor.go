@@ -22,6 +22,7 @@ package history
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -60,6 +61,7 @@ type (
 		historyEngine          Engine
 		sourceCluster          string
 		domainReplicator       replicator.DomainReplicator
+		config                 *Config
 		metricsClient          metrics.Client
 		logger                 log.Logger
 		retryPolicy            backoff.RetryPolicy
@@ -104,6 +106,7 @@ func NewReplicationTaskProcessor(
 		historyEngine:        historyEngine,
 		sourceCluster:        replicationTaskFetcher.GetSourceCluster(),
 		domainReplicator:     domainReplicator,
+		config:               shard.GetConfig(),
 		metricsClient:        metricsClient,
 		logger:               shard.GetLogger(),
 		retryPolicy:          retryPolicy,
@@ -140,31 +143,16 @@ func (p *ReplicationTaskProcessor) processorLoop() {
 		p.logger.Info("Closing replication task processor.", tag.ReadLevel(p.lastRetrievedMessageID))
 	}()
 
-Loop:
+	respChan := p.sendFetchMessageRequest()
 	for {
-		// for each iteration, do close check first
 		select {
 		case <-p.done:
 			return
-		default:
-		}
-
-		respChan := make(chan *r.ReplicationMessages, 1)
-		// TODO: when we support prefetching, LastRetrivedMessageId can be different than LastProcessedMessageId
-		p.requestChan <- &request{
-			token: &r.ReplicationToken{
-				ShardID:                common.Int32Ptr(int32(p.shard.GetShardID())),
-				LastRetrivedMessageId:  common.Int64Ptr(p.lastRetrievedMessageID),
-				LastProcessedMessageId: common.Int64Ptr(p.lastProcessedMessageID),
-			},
-			respChan: respChan,
-		}
-
-		select {
 		case response, ok := <-respChan:
 			if !ok {
 				p.logger.Debug("Fetch replication messages chan closed.")
-				continue Loop
+				respChan = p.sendFetchMessageRequest()
+				continue
 			}
 
 			p.logger.Debug("Got fetch replication messages response.",
@@ -179,28 +167,121 @@ Loop:
 			if len(response.ReplicationTasks) == 0 {
 				backoffDuration := p.noTaskBackoffRetrier.NextBackOff()
 				time.Sleep(backoffDuration)
+				respChan = p.sendFetchMessageRequest()
 				continue
 			}
 
-			for _, replicationTask := range response.ReplicationTasks {
-				p.processTask(replicationTask)
-			}
+			// the next batch is fetched while the current batch is being applied,
+			// the processed level is only moved forward once the current batch is applied
+			p.lastRetrievedMessageID = response.GetLastRetrivedMessageId()
+			respChan = p.sendFetchMessageRequest()
+
+			sw := scope.StartTimer(metrics.ReplicationTasksBatchLatency)
+			p.processTasks(response.ReplicationTasks)
+			sw.Stop()
 
 			p.lastProcessedMessageID = response.GetLastRetrivedMessageId()
-			p.lastRetrievedMessageID = response.GetLastRetrivedMessageId()
-			err := p.shard.UpdateClusterReplicationLevel(p.sourceCluster, p.lastRetrievedMessageID)
+			err := p.shard.UpdateClusterReplicationLevel(p.sourceCluster, p.lastProcessedMessageID)
 			if err != nil {
 				p.logger.Error("Error updating replication level for shard", tag.Error(err), tag.OperationFailed)
 			}
 
 			scope.UpdateGauge(metrics.LastRetrievedMessageID, float64(p.lastRetrievedMessageID))
 			p.noTaskBackoffRetrier.Reset()
-		case <-p.done:
-			return
 		}
 	}
 }
 
+func (p *ReplicationTaskProcessor) sendFetchMessageRequest() <-chan *r.ReplicationMessages {
+	respChan := make(chan *r.ReplicationMessages, 1)
+	p.requestChan <- &request{
+		token: &r.ReplicationToken{
+			ShardID:                common.Int32Ptr(int32(p.shard.GetShardID())),
+			LastRetrivedMessageId:  common.Int64Ptr(p.lastRetrievedMessageID),
+			LastProcessedMessageId: common.Int64Ptr(p.lastProcessedMessageID),
+		},
+		respChan: respChan,
+	}
+	return respChan
+}
+
+// processTasks applies a batch of replication tasks. Tasks of the same workflow are applied in order,
+// while tasks of different workflows are applied concurrently. Tasks which do not belong to a workflow,
+// i.e. domain and sync shard tasks, are applied after all tasks before them and before all tasks after them.
+func (p *ReplicationTaskProcessor) processTasks(replicationTasks []*r.ReplicationTask) {
+	var taskGroups [][]*r.ReplicationTask
+	groupIndex := make(map[string]int)
+	for _, replicationTask := range replicationTasks {
+		key, ok := getReplicationTaskExecutionKey(replicationTask)
+		if !ok {
+			p.processTaskGroups(taskGroups)
+			taskGroups = nil
+			groupIndex = make(map[string]int)
+			p.processTask(replicationTask)
+			continue
+		}
+
+		if idx, ok := groupIndex[key]; ok {
+			taskGroups[idx] = append(taskGroups[idx], replicationTask)
+		} else {
+			groupIndex[key] = len(taskGroups)
+			taskGroups = append(taskGroups, []*r.ReplicationTask{replicationTask})
+		}
+	}
+	p.processTaskGroups(taskGroups)
+}
+
+func (p *ReplicationTaskProcessor) processTaskGroups(taskGroups [][]*r.ReplicationTask) {
+	if len(taskGroups) == 0 {
+		return
+	}
+	p.metricsClient.Scope(
+		metrics.ReplicationTaskFetcherScope,
+		metrics.TargetClusterTag(p.sourceCluster),
+	).RecordTimer(metrics.ReplicationTaskExecutionGroups, time.Duration(len(taskGroups)))
+
+	parallelism := p.config.ReplicationTaskProcessorParallelism()
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	semaphore := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for _, taskGroup := range taskGroups {
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(taskGroup []*r.ReplicationTask) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			for _, replicationTask := range taskGroup {
+				p.processTask(replicationTask)
+			}
+		}(taskGroup)
+	}
+	wg.Wait()
+}
+
+// getReplicationTaskExecutionKey returns the key of the workflow the task belongs to, runs of the same
+// workflow share the key as they are not independent of each other
+func getReplicationTaskExecutionKey(replicationTask *r.ReplicationTask) (string, bool) {
+	var domainID, workflowID string
+	switch replicationTask.GetTaskType() {
+	case r.ReplicationTaskTypeHistory:
+		attr := replicationTask.HistoryTaskAttributes
+		domainID, workflowID = attr.GetDomainId(), attr.GetWorkflowId()
+	case r.ReplicationTaskTypeSyncActivity:
+		attr := replicationTask.SyncActicvityTaskAttributes
+		domainID, workflowID = attr.GetDomainId(), attr.GetWorkflowId()
+	case r.ReplicationTaskTypeHistoryMetadata:
+		attr := replicationTask.HistoryMetadataTaskAttributes
+		domainID, workflowID = attr.GetDomainId(), attr.GetWorkflowId()
+	default:
+		return "", false
+	}
+	return domainID + "/" + workflowID, true
+}
+
 func (p *ReplicationTaskProcessor) processTask(replicationTask *r.ReplicationTask) {
 	err := backoff.Retry(func() error {
 		return p.processTaskOnce(replicationTask)
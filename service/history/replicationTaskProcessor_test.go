@@ -0,0 +1,167 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	r "github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/replicator"
+)
+
+type (
+	replicationTaskProcessorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		mockHistoryEngine    *MockHistoryEngine
+		mockDomainReplicator *replicator.MockDomainReplicator
+
+		lock    sync.Mutex
+		applied []string
+
+		processor *ReplicationTaskProcessor
+	}
+)
+
+func TestReplicationTaskProcessorSuite(t *testing.T) {
+	s := new(replicationTaskProcessorSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationTaskProcessorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockHistoryEngine = &MockHistoryEngine{}
+	s.mockDomainReplicator = &replicator.MockDomainReplicator{}
+	s.applied = nil
+
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumAttempts(1)
+	s.processor = &ReplicationTaskProcessor{
+		historyEngine:    s.mockHistoryEngine,
+		sourceCluster:    "some random source cluster",
+		domainReplicator: s.mockDomainReplicator,
+		config: &Config{
+			ReplicationTaskProcessorParallelism: dynamicconfig.GetIntPropertyFn(4),
+		},
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.History),
+		logger:        loggerimpl.NewDevelopmentForTest(s.Suite),
+		retryPolicy:   retryPolicy,
+	}
+}
+
+func (s *replicationTaskProcessorSuite) TearDownTest() {
+	s.mockHistoryEngine.AssertExpectations(s.T())
+	s.mockDomainReplicator.AssertExpectations(s.T())
+}
+
+func (s *replicationTaskProcessorSuite) TestProcessTasks_OrderedPerWorkflow() {
+	s.mockHistoryEngine.On("ReplicateEvents", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		request := args.Get(0).(*h.ReplicateEventsRequest)
+		s.recordApplied(request.WorkflowExecution.GetWorkflowId(), request.GetFirstEventId())
+	})
+	s.mockDomainReplicator.On("HandleReceivingTask", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		s.recordApplied("domain", 0)
+	})
+
+	s.processor.processTasks([]*r.ReplicationTask{
+		s.newHistoryTask("wf1", 1),
+		s.newHistoryTask("wf2", 1),
+		s.newHistoryTask("wf1", 2),
+		{
+			TaskType:             r.ReplicationTaskTypeDomain.Ptr(),
+			DomainTaskAttributes: &r.DomainTaskAttributes{},
+		},
+		s.newHistoryTask("wf2", 2),
+		s.newHistoryTask("wf1", 3),
+	})
+
+	s.Len(s.applied, 6)
+	index := make(map[string]int)
+	for idx, applied := range s.applied {
+		index[applied] = idx
+	}
+	s.True(index["wf1-1"] < index["wf1-2"])
+	s.True(index["wf1-2"] < index["domain"])
+	s.True(index["wf2-1"] < index["domain"])
+	s.True(index["domain"] < index["wf2-2"])
+	s.True(index["domain"] < index["wf1-3"])
+}
+
+func (s *replicationTaskProcessorSuite) TestProcessTasks_WorkflowsAppliedConcurrently() {
+	wf2Applied := make(chan struct{})
+	s.mockHistoryEngine.On("ReplicateEvents", mock.MatchedBy(func(request *h.ReplicateEventsRequest) bool {
+		return request.WorkflowExecution.GetWorkflowId() == "wf1"
+	})).Return(nil).Run(func(args mock.Arguments) {
+		// wf1 can only be applied once wf2 is, which requires wf2 to be applied concurrently
+		select {
+		case <-wf2Applied:
+			s.recordApplied("wf1", 1)
+		case <-time.After(5 * time.Second):
+		}
+	})
+	s.mockHistoryEngine.On("ReplicateEvents", mock.MatchedBy(func(request *h.ReplicateEventsRequest) bool {
+		return request.WorkflowExecution.GetWorkflowId() == "wf2"
+	})).Return(nil).Run(func(args mock.Arguments) {
+		s.recordApplied("wf2", 1)
+		close(wf2Applied)
+	})
+
+	s.processor.processTasks([]*r.ReplicationTask{
+		s.newHistoryTask("wf1", 1),
+		s.newHistoryTask("wf2", 1),
+	})
+	s.Equal([]string{"wf2-1", "wf1-1"}, s.applied)
+}
+
+func (s *replicationTaskProcessorSuite) newHistoryTask(workflowID string, firstEventID int64) *r.ReplicationTask {
+	return &r.ReplicationTask{
+		TaskType: r.ReplicationTaskTypeHistory.Ptr(),
+		HistoryTaskAttributes: &r.HistoryTaskAttributes{
+			DomainId:     common.StringPtr(validDomainID),
+			WorkflowId:   common.StringPtr(workflowID),
+			RunId:        common.StringPtr(validRunID),
+			FirstEventId: common.Int64Ptr(firstEventID),
+		},
+	}
+}
+
+func (s *replicationTaskProcessorSuite) recordApplied(name string, firstEventID int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if firstEventID > 0 {
+		name = fmt.Sprintf("%v-%v", name, firstEventID)
+	}
+	s.applied = append(s.applied, name)
+}
@@ -28,7 +28,6 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
-	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -338,6 +337,11 @@ func GenerateReplicationTask(
 			return nil, err
 		}
 		for _, event := range history.Events {
+			if event.GetVersion() == common.EmptyVersion {
+				// recorded before the domain was promoted to a global domain,
+				// the replication task carries the version the execution is replicated with
+				event.Version = common.Int64Ptr(task.Version)
+			}
 			if task.Version != event.GetVersion() {
 				return nil, nil
 			}
@@ -420,7 +424,7 @@ func (p *replicatorQueueProcessorImpl) updateAckLevel(ackLevel int64) error {
 
 	// this is a hack, since there is not dedicated ticker on the queue processor
 	// to periodically send out sync shard message, put it here
-	now := clock.NewRealTimeSource().Now()
+	now := p.shard.GetTimeSource().Now()
 	if p.lastShardSyncTimestamp.Add(p.shard.GetConfig().ShardSyncMinInterval()).Before(now) {
 		syncStatusTask := &replicator.ReplicationTask{
 			TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeSyncShardStatus),
@@ -512,6 +516,8 @@ func PaginateHistory(historyMgr persistence.HistoryManager, historyV2Mgr persist
 			PageSize:      pageSize,
 			NextPageToken: tokenIn,
 			ShardID:       shardID,
+			DomainID:      domainID,
+			WorkflowID:    workflowID,
 		}
 		if byBatch {
 			response, err := historyV2Mgr.ReadHistoryBranchByBatch(req)
@@ -474,6 +474,8 @@ func (s *replicatorQueueProcessorSuite) TestPaginateHistoryWithShardID() {
 		PageSize:      pageSize,
 		NextPageToken: []byte{},
 		ShardID:       shardID,
+		DomainID:      domainID,
+		WorkflowID:    workflowID,
 	}
 	s.mockHistoryV2Mgr.On("ReadHistoryBranch", req).Return(&persistence.ReadHistoryBranchResponse{
 		HistoryEvents: []*workflow.HistoryEvent{
@@ -27,9 +27,11 @@ import (
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/idgenerator"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/overload"
 	"github.com/uber/cadence/common/persistence"
 	espersistence "github.com/uber/cadence/common/persistence/elasticsearch"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
@@ -41,30 +43,52 @@ import (
 // Config represents configuration for cadence-history service
 type Config struct {
 	NumberOfShards int
-
-	RPS                             dynamicconfig.IntPropertyFn
-	MaxIDLengthLimit                dynamicconfig.IntPropertyFn
-	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
-	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
-	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
-	VisibilityOpenMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
-	VisibilityClosedMaxQPS          dynamicconfig.IntPropertyFnWithDomainFilter
-	AdvancedVisibilityWritingMode   dynamicconfig.StringPropertyFn
-	EmitShardDiffLog                dynamicconfig.BoolPropertyFn
-	MaxAutoResetPoints              dynamicconfig.IntPropertyFnWithDomainFilter
-	ThrottledLogRPS                 dynamicconfig.IntPropertyFn
+	// TaskAuditor is only set by integration tests to verify that no transfer or timer task is lost
+	TaskAuditor *TaskAuditor
+	// IDGenerator generates the IDs of new workflow runs and of the requests the service sends
+	IDGenerator idgenerator.Generator
+
+	RPS                               dynamicconfig.IntPropertyFn
+	MaxIDLengthLimit                  dynamicconfig.IntPropertyFn
+	PersistenceMaxQPS                 dynamicconfig.IntPropertyFn
+	EnableVisibilitySampling          dynamicconfig.BoolPropertyFn
+	EnableReadFromClosedExecutionV2   dynamicconfig.BoolPropertyFn
+	VisibilityIndexedMemoKeys         dynamicconfig.StringPropertyFnWithDomainFilter
+	PersistenceSlowQueryThreshold     dynamicconfig.DurationPropertyFn
+	PersistenceLargeRowSizeThreshold  dynamicconfig.IntPropertyFn
+	PersistenceSlowQueryLogSampleRate dynamicconfig.FloatPropertyFn
+	EnableLoadShedding                dynamicconfig.BoolPropertyFn
+	OverloadLatencyThreshold          dynamicconfig.DurationPropertyFn
+	OverloadErrorRatioThreshold       dynamicconfig.FloatPropertyFn
+	OverloadEvaluationInterval        dynamicconfig.DurationPropertyFn
+	OverloadGlobalLowPriorityRPS      dynamicconfig.IntPropertyFn
+	VisibilityOpenMaxQPS              dynamicconfig.IntPropertyFnWithDomainFilter
+	VisibilityClosedMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
+	AdvancedVisibilityWritingMode     dynamicconfig.StringPropertyFn
+	EmitShardDiffLog                  dynamicconfig.BoolPropertyFnWithShardIDFilter
+	MaxAutoResetPoints                dynamicconfig.IntPropertyFnWithDomainFilter
+	ThrottledLogRPS                   dynamicconfig.IntPropertyFn
+	HeartbeatBatchConcurrency         dynamicconfig.IntPropertyFn
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
 	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
 	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	HistoryCacheShardCount  dynamicconfig.IntPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
-	EventsCacheInitialSize dynamicconfig.IntPropertyFn
-	EventsCacheMaxSize     dynamicconfig.IntPropertyFn
-	EventsCacheTTL         dynamicconfig.DurationPropertyFn
+	EventsCacheInitialSize    dynamicconfig.IntPropertyFn
+	EventsCacheMaxSize        dynamicconfig.IntPropertyFn
+	EventsCacheMaxSizeInBytes dynamicconfig.IntPropertyFn
+	EventsCacheTTL            dynamicconfig.DurationPropertyFn
+	EventsCacheShardCount     dynamicconfig.IntPropertyFn
+
+	// DomainCache memory settings
+	// Change of these configs require host restart
+	DomainCacheMemoryLowWatermarkInBytes  dynamicconfig.IntPropertyFn
+	DomainCacheMemoryHighWatermarkInBytes dynamicconfig.IntPropertyFn
 
 	// ShardController settings
 	RangeSizeBits        uint
@@ -87,6 +111,12 @@ type Config struct {
 	TimerProcessorMaxPollInterval                    dynamicconfig.DurationPropertyFn
 	TimerProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
 	TimerProcessorMaxTimeShift                       dynamicconfig.DurationPropertyFn
+	TimerProcessorEnableTimerWheel                   dynamicconfig.BoolPropertyFn
+	TimerProcessorTimerWheelTick                     dynamicconfig.DurationPropertyFn
+	TimerProcessorLookAheadWindow                    dynamicconfig.DurationPropertyFn
+	EnableTimerTaskCoalescing                        dynamicconfig.BoolPropertyFnWithDomainFilter
+	DomainProcessingPaused                           dynamicconfig.BoolPropertyFnWithDomainFilter
+	EnforceKnownTaskLists                            dynamicconfig.BoolPropertyFnWithDomainFilter
 	TimerProcessorHistoryArchivalSizeLimit           dynamicconfig.IntPropertyFn
 	TimerProcessorArchivalTimeLimit                  dynamicconfig.DurationPropertyFn
 
@@ -115,6 +145,9 @@ type Config struct {
 	ReplicatorProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	ReplicatorProcessorFetchTasksBatchSize                dynamicconfig.IntPropertyFn
 
+	// ReplicationTaskProcessor settings
+	ReplicationTaskProcessorParallelism dynamicconfig.IntPropertyFn
+
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
 	HistoryMgrNumConns   dynamicconfig.IntPropertyFn
@@ -131,6 +164,8 @@ type Config struct {
 	// Time to hold a poll request before returning an empty response
 	// right now only used by GetMutableState
 	LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithDomainFilter
+	// MaxActivityCancellationWait caps how long an activity heartbeat may wait for a cancellation request
+	MaxActivityCancellationWait dynamicconfig.DurationPropertyFnWithDomainFilter
 
 	// encoding the history events
 	EventEncodingType dynamicconfig.StringPropertyFnWithDomainFilter
@@ -158,6 +193,10 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// Local activity marker limits per decision
+	LocalActivityMarkerCountLimitPerDecision dynamicconfig.IntPropertyFnWithDomainFilter
+	LocalActivityMarkerSizeLimitPerDecision  dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
@@ -173,6 +212,40 @@ type Config struct {
 	DecisionHeartbeatTimeout dynamicconfig.DurationPropertyFnWithDomainFilter
 	// MaxDecisionStartToCloseSeconds is the StartToCloseSeconds for decision
 	MaxDecisionStartToCloseSeconds dynamicconfig.IntPropertyFnWithDomainFilter
+	// EnableDecisionBinaryChecksumCheck is whether decisions are only dispatched to pollers with compatible binary checksums
+	EnableDecisionBinaryChecksumCheck dynamicconfig.BoolPropertyFnWithDomainFilter
+	// CompatibleDecisionBinaryChecksums is the semicolon separated groups of compatible binary checksums
+	CompatibleDecisionBinaryChecksums dynamicconfig.StringPropertyFnWithDomainFilter
+
+	// EnableMutableStateSnapshot is whether to periodically compact the mutable state maps into a snapshot
+	EnableMutableStateSnapshot dynamicconfig.BoolPropertyFnWithDomainFilter
+	// MutableStateSnapshotThreshold is the number of mutable state entries written since the last snapshot
+	// after which a new snapshot is written
+	MutableStateSnapshotThreshold dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// VisibilityCloseBatching settings
+	EnableVisibilityCloseBatching dynamicconfig.BoolPropertyFn
+	VisibilityCloseBatchingWindow dynamicconfig.DurationPropertyFn
+	VisibilityCloseBatchMaxSize   dynamicconfig.IntPropertyFn
+	// VisibilityRetention is how long the visibility record of a closed workflow is kept,
+	// it only takes effect when longer than the domain retention
+	VisibilityRetention dynamicconfig.DurationPropertyFnWithDomainFilter
+
+	// ShardUpdateBatching settings
+	EnableShardUpdateBatching dynamicconfig.BoolPropertyFn
+	ShardUpdateBatchingWindow dynamicconfig.DurationPropertyFn
+	ShardUpdateBatchMaxSize   dynamicconfig.IntPropertyFn
+
+	// ActivityTypeMetricsAllowlist is the comma separated list of activity types for which per activity type metrics are emitted
+	ActivityTypeMetricsAllowlist dynamicconfig.StringPropertyFnWithDomainFilter
+	// WorkflowTypeMetricsAllowlist is the comma separated list of workflow types for which per workflow type latency metrics are emitted
+	WorkflowTypeMetricsAllowlist dynamicconfig.StringPropertyFnWithDomainFilter
+
+	// NoPollersWarningThreshold is the number of schedule to start timeouts without pollers
+	// after which an activity task list is reported as having no pollers
+	NoPollersWarningThreshold dynamicconfig.IntPropertyFn
+	// NoPollersWarningTTL is how long a no pollers warning for an activity task list is kept
+	NoPollersWarningTTL dynamicconfig.DurationPropertyFn
 }
 
 const (
@@ -183,23 +256,38 @@ const (
 func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isAdvancedVisConfigExist bool) *Config {
 	cfg := &Config{
 		NumberOfShards:                                        numberOfShards,
+		IDGenerator:                                           idgenerator.NewUUIDGenerator(),
 		RPS:                                                   dc.GetIntProperty(dynamicconfig.HistoryRPS, 3000),
 		MaxIDLengthLimit:                                      dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		PersistenceMaxQPS:                                     dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 9000),
 		EnableVisibilitySampling:                              dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:                       dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
+		VisibilityIndexedMemoKeys:                             dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.VisibilityIndexedMemoKeys, ""),
+		PersistenceSlowQueryThreshold:                         dc.GetDurationProperty(dynamicconfig.PersistenceSlowQueryThreshold, time.Second),
+		PersistenceLargeRowSizeThreshold:                      dc.GetIntProperty(dynamicconfig.PersistenceLargeRowSizeThreshold, 2*1024*1024),
+		PersistenceSlowQueryLogSampleRate:                     dc.GetFloat64Property(dynamicconfig.PersistenceSlowQueryLogSampleRate, 0.1),
+		EnableLoadShedding:                                    dc.GetBoolProperty(dynamicconfig.EnableLoadShedding, false),
+		OverloadLatencyThreshold:                              dc.GetDurationProperty(dynamicconfig.OverloadLatencyThreshold, 200*time.Millisecond),
+		OverloadErrorRatioThreshold:                           dc.GetFloat64Property(dynamicconfig.OverloadErrorRatioThreshold, 0.1),
+		OverloadEvaluationInterval:                            dc.GetDurationProperty(dynamicconfig.OverloadEvaluationInterval, 10*time.Second),
+		OverloadGlobalLowPriorityRPS:                          dc.GetIntProperty(dynamicconfig.OverloadGlobalLowPriorityRPS, 0),
 		VisibilityOpenMaxQPS:                                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityOpenMaxQPS, 300),
 		VisibilityClosedMaxQPS:                                dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityClosedMaxQPS, 300),
 		MaxAutoResetPoints:                                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryMaxAutoResetPoints, defaultHistoryMaxAutoResetPoints),
 		MaxDecisionStartToCloseSeconds:                        dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseSeconds, 240),
 		AdvancedVisibilityWritingMode:                         dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
-		EmitShardDiffLog:                                      dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
+		EmitShardDiffLog:                                      dc.GetBoolPropertyFilteredByShardID(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:                               dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                                   dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
 		HistoryCacheTTL:                                       dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		HistoryCacheShardCount:                                dc.GetIntProperty(dynamicconfig.HistoryCacheShardCount, 8),
 		EventsCacheInitialSize:                                dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                                    dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
+		EventsCacheMaxSizeInBytes:                             dc.GetIntProperty(dynamicconfig.EventsCacheMaxSizeInBytes, 0),
 		EventsCacheTTL:                                        dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
+		EventsCacheShardCount:                                 dc.GetIntProperty(dynamicconfig.EventsCacheShardCount, 8),
+		DomainCacheMemoryLowWatermarkInBytes:                  dc.GetIntProperty(dynamicconfig.DomainCacheMemoryLowWatermarkInBytes, 48*1024*1024),
+		DomainCacheMemoryHighWatermarkInBytes:                 dc.GetIntProperty(dynamicconfig.DomainCacheMemoryHighWatermarkInBytes, 0),
 		RangeSizeBits:                                         20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                                  dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		StandbyClusterDelay:                                   dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, 5*time.Minute),
@@ -216,6 +304,12 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		TimerProcessorMaxPollInterval:                         dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxPollInterval, 5*time.Minute),
 		TimerProcessorMaxPollIntervalJitterCoefficient:        dc.GetFloat64Property(dynamicconfig.TimerProcessorMaxPollIntervalJitterCoefficient, 0.15),
 		TimerProcessorMaxTimeShift:                            dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxTimeShift, 1*time.Second),
+		TimerProcessorEnableTimerWheel:                        dc.GetBoolProperty(dynamicconfig.TimerProcessorEnableTimerWheel, false),
+		TimerProcessorTimerWheelTick:                          dc.GetDurationProperty(dynamicconfig.TimerProcessorTimerWheelTick, 10*time.Millisecond),
+		TimerProcessorLookAheadWindow:                         dc.GetDurationProperty(dynamicconfig.TimerProcessorLookAheadWindow, 30*time.Second),
+		EnableTimerTaskCoalescing:                             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableTimerTaskCoalescing, false),
+		DomainProcessingPaused:                                dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DomainProcessingPaused, false),
+		EnforceKnownTaskLists:                                 dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnforceKnownTaskLists, false),
 		TimerProcessorHistoryArchivalSizeLimit:                dc.GetIntProperty(dynamicconfig.TimerProcessorHistoryArchivalSizeLimit, 500*1024),
 		TimerProcessorArchivalTimeLimit:                       dc.GetDurationProperty(dynamicconfig.TimerProcessorArchivalTimeLimit, 1*time.Second),
 		TransferTaskBatchSize:                                 dc.GetIntProperty(dynamicconfig.TransferTaskBatchSize, 100),
@@ -239,6 +333,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		ReplicatorProcessorUpdateAckInterval:                  dc.GetDurationProperty(dynamicconfig.ReplicatorProcessorUpdateAckInterval, 5*time.Second),
 		ReplicatorProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicatorProcessorUpdateAckIntervalJitterCoefficient, 0.15),
 		ReplicatorProcessorFetchTasksBatchSize:                dc.GetIntProperty(dynamicconfig.ReplicatorTaskBatchSize, 25),
+		ReplicationTaskProcessorParallelism:                   dc.GetIntProperty(dynamicconfig.ReplicationTaskProcessorParallelism, 8),
 		ExecutionMgrNumConns:                                  dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns, 50),
 		HistoryMgrNumConns:                                    dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:                            dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
@@ -248,6 +343,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
+		MaxActivityCancellationWait:         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryMaxActivityCancellationWait, time.Second*5),
 		EventEncodingType:                   dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.DefaultEventEncoding, string(common.EncodingTypeThriftRW)),
 		EnableEventsV2:                      dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableEventsV2, true),
 		EnableParentClosePolicy:             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableParentClosePolicy, true),
@@ -265,7 +361,11 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
-		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
+		LocalActivityMarkerCountLimitPerDecision: dc.GetIntPropertyFilteredByDomain(dynamicconfig.LocalActivityMarkerCountLimitPerDecision, 1000),
+		LocalActivityMarkerSizeLimitPerDecision:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.LocalActivityMarkerSizeLimitPerDecision, 8*1024*1024),
+
+		ThrottledLogRPS:           dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
+		HeartbeatBatchConcurrency: dc.GetIntProperty(dynamicconfig.HistoryHeartbeatBatchConcurrency, 8),
 
 		ValidSearchAttributes:             dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 		SearchAttributesNumberOfKeysLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
@@ -273,6 +373,22 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		StickyTTL:                         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.StickyTTL, time.Hour*24*365),
 		DecisionHeartbeatTimeout:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.DecisionHeartbeatTimeout, time.Minute*30),
+		EnableDecisionBinaryChecksumCheck: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableDecisionBinaryChecksumCheck, false),
+		CompatibleDecisionBinaryChecksums: dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.CompatibleDecisionBinaryChecksums, ""),
+		EnableMutableStateSnapshot:        dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableMutableStateSnapshot, false),
+		MutableStateSnapshotThreshold:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.MutableStateSnapshotThreshold, 1000),
+		EnableShardUpdateBatching:         dc.GetBoolProperty(dynamicconfig.EnableShardUpdateBatching, false),
+		ShardUpdateBatchingWindow:         dc.GetDurationProperty(dynamicconfig.ShardUpdateBatchingWindow, 5*time.Millisecond),
+		ShardUpdateBatchMaxSize:           dc.GetIntProperty(dynamicconfig.ShardUpdateBatchMaxSize, 10),
+		EnableVisibilityCloseBatching:     dc.GetBoolProperty(dynamicconfig.EnableVisibilityCloseBatching, false),
+		VisibilityCloseBatchingWindow:     dc.GetDurationProperty(dynamicconfig.VisibilityCloseBatchingWindow, 50*time.Millisecond),
+		VisibilityCloseBatchMaxSize:       dc.GetIntProperty(dynamicconfig.VisibilityCloseBatchMaxSize, 20),
+		VisibilityRetention:               dc.GetDurationPropertyFilteredByDomain(dynamicconfig.VisibilityRetention, 0),
+
+		ActivityTypeMetricsAllowlist: dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.ActivityTypeMetricsAllowlist, ""),
+		WorkflowTypeMetricsAllowlist: dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.WorkflowTypeMetricsAllowlist, ""),
+		NoPollersWarningThreshold:    dc.GetIntProperty(dynamicconfig.NoPollersWarningThreshold, 3),
+		NoPollersWarningTTL:          dc.GetDurationProperty(dynamicconfig.NoPollersWarningTTL, 10*time.Minute),
 	}
 
 	return cfg
@@ -297,6 +413,11 @@ func NewService(params *service.BootstrapParams) common.Daemon {
 		params.PersistenceConfig.NumHistoryShards,
 		params.PersistenceConfig.DefaultStoreType(),
 		params.PersistenceConfig.IsAdvancedVisibilityConfigExist())
+	idGenerator, err := idgenerator.NewGenerator(params.IDGeneratorConfig)
+	if err != nil {
+		params.Logger.Fatal("failed to create id generator", tag.Error(err))
+	}
+	config.IDGenerator = idGenerator
 	params.ThrottledLogger = loggerimpl.NewThrottledLogger(params.Logger, config.ThrottledLogRPS)
 	params.UpdateLoggerWithServiceName(common.HistoryServiceName)
 	return &Service{
@@ -327,8 +448,30 @@ func (s *Service) Start() {
 		VisibilityClosedMaxQPS:          s.config.VisibilityClosedMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
+		IndexedMemoKeys:                 s.config.VisibilityIndexedMemoKeys,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+	pConfig.SlowQueryConfig = &config.SlowQueryConfig{
+		LatencyThreshold: s.config.PersistenceSlowQueryThreshold,
+		SizeThreshold:    s.config.PersistenceLargeRowSizeThreshold,
+		LogSampleRate:    s.config.PersistenceSlowQueryLogSampleRate,
+	}
+	overloadController := overload.NewController(&overload.Config{
+		EnableLoadShedding:   s.config.EnableLoadShedding,
+		LatencyThreshold:     s.config.OverloadLatencyThreshold,
+		ErrorRatioThreshold:  s.config.OverloadErrorRatioThreshold,
+		EvaluationInterval:   s.config.OverloadEvaluationInterval,
+		GlobalLowPriorityRPS: s.config.OverloadGlobalLowPriorityRPS,
+	}, overload.NewServiceMemberCountFn(base, common.HistoryServiceName), s.metricsClient, log)
+	// background task processing is slowed down while persistence is overloaded
+	s.config.ReplicatorProcessorMaxPollRPS = overload.ScaledIntPropertyFn(overloadController, s.config.ReplicatorProcessorMaxPollRPS)
+	s.config.TransferProcessorFailoverMaxPollRPS = overload.ScaledIntPropertyFn(overloadController, s.config.TransferProcessorFailoverMaxPollRPS)
+	s.config.TimerProcessorFailoverMaxPollRPS = overload.ScaledIntPropertyFn(overloadController, s.config.TimerProcessorFailoverMaxPollRPS)
+	pFactory := persistencefactory.New(
+		&pConfig,
+		params.ClusterMetadata.GetCurrentClusterName(),
+		overload.NewPersistenceMetricsClient(s.metricsClient, overloadController),
+		log,
+	)
 
 	shardMgr, err := pFactory.NewShardManager()
 	if err != nil {
@@ -351,7 +494,7 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatal("Creating visibility producer failed", tag.Error(err))
 		}
-		esVisibility = espersistence.NewESVisibilityManager("", nil, nil, visibilityProducer,
+		esVisibility = espersistence.NewESVisibilityManager(nil, nil, nil, visibilityProducer,
 			s.metricsClient, log)
 	}
 	visibility = persistence.NewVisibilityManagerWrapper(
@@ -360,6 +503,7 @@ func (s *Service) Start() {
 		dynamicconfig.GetBoolPropertyFnFilteredByDomain(false), // history visibility never read
 		s.config.AdvancedVisibilityWritingMode,
 	)
+	visibility = newVisibilityCloseBatcher(visibility, s.config)
 
 	history, err := pFactory.NewHistoryManager()
 	if err != nil {
@@ -392,11 +536,12 @@ func (s *Service) Start() {
 		log.Fatal("Failed to register archiver bootstrap container", tag.Error(err))
 	}
 
-	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, pFactory, domainCache, params.PublicClient)
+	handler := NewHandler(base, s.config, shardMgr, metadata, visibility, history, historyV2, pFactory, domainCache, params.PublicClient, overloadController)
 	handler.RegisterHandler()
 
 	// must start base service first
 	base.Start()
+	overloadController.Start()
 	err = handler.Start()
 	if err != nil {
 		log.Fatal("History handler failed to start", tag.Error(err))
@@ -405,6 +550,7 @@ func (s *Service) Start() {
 	log.Info("started", tag.Service(common.HistoryServiceName))
 
 	<-s.stopC
+	overloadController.Stop()
 	base.Stop()
 }
 
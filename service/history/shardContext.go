@@ -18,6 +18,8 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination shardContext_mock.go -self_package github.com/uber/cadence/service/history
+
 package history
 
 import (
@@ -32,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -50,11 +53,12 @@ type (
 		GetDomainCache() cache.DomainCache
 		GetClusterMetadata() cluster.Metadata
 		GetConfig() *Config
-		GetEventsCache() eventsCache
+		GetEventsCache() EventsCache
 		GetLogger() log.Logger
 		GetThrottledLogger() log.Logger
 		GetMetricsClient() metrics.Client
 		GetTimeSource() clock.TimeSource
+		ObserveTime(observed time.Time)
 
 		GetEngine() Engine
 		SetEngine(Engine)
@@ -100,6 +104,8 @@ type (
 		UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error)
 		ConflictResolveWorkflowExecution(request *persistence.ConflictResolveWorkflowExecutionRequest) error
 		ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error
+		RangeCompleteTransferTask(request *persistence.RangeCompleteTransferTaskRequest) error
+		RangeCompleteTimerTask(request *persistence.RangeCompleteTimerTaskRequest) error
 		AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error)
 		AppendHistoryV2Events(request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error)
 	}
@@ -115,7 +121,7 @@ type (
 		historyV2Mgr     persistence.HistoryV2Manager
 		executionManager persistence.ExecutionManager
 		domainCache      cache.DomainCache
-		eventsCache      eventsCache
+		eventsCache      EventsCache
 		closeCh          chan<- int
 		isClosed         bool
 		config           *Config
@@ -124,6 +130,7 @@ type (
 		metricsClient    metrics.Client
 		timeSource       clock.TimeSource
 		engine           Engine
+		updateBatcher    *shardUpdateBatcher
 
 		sync.RWMutex
 		lastUpdated               time.Time
@@ -144,6 +151,8 @@ const (
 	logWarnTransferLevelDiff = 3000000 // 3 million
 	logWarnTimerLevelDiff    = time.Duration(30 * time.Minute)
 	historySizeLogThreshold  = 10 * 1024 * 1024
+	// shardClockMaxDrift is how far ahead of the physical clock an observed timestamp may move the shard clock
+	shardClockMaxDrift = time.Minute
 )
 
 func (s *shardContextImpl) GetShardID() int {
@@ -514,6 +523,10 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
 
+	if s.config.EnableShardUpdateBatching() {
+		return s.updateBatcher.update(domainEntry, request)
+	}
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -545,6 +558,13 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	}
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
+	return s.updateWorkflowExecutionWithRetryLocked(request)
+}
+
+func (s *shardContextImpl) updateWorkflowExecutionWithRetryLocked(
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+
 Update_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
@@ -590,6 +610,141 @@ Update_Loop:
 	return nil, ErrMaxAttemptsExceeded
 }
 
+func (s *shardContextImpl) updateWorkflowExecutionBatch(
+	updates []*shardUpdate,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	transferMaxReadLevel := int64(0)
+	// assign IDs for the tasks of all updates in the batch
+	// Must be done under the shard lock to ensure transfer tasks are written to persistence in increasing
+	// ID order
+	var pendingUpdates []*shardUpdate
+	for _, update := range updates {
+		workflowID := update.request.UpdateWorkflowMutation.ExecutionInfo.WorkflowID
+		update.err = s.allocateTaskIDsLocked(
+			update.domainEntry,
+			workflowID,
+			update.request.UpdateWorkflowMutation.TransferTasks,
+			update.request.UpdateWorkflowMutation.ReplicationTasks,
+			update.request.UpdateWorkflowMutation.TimerTasks,
+			&transferMaxReadLevel,
+		)
+		if update.err == nil && update.request.NewWorkflowSnapshot != nil {
+			update.err = s.allocateTaskIDsLocked(
+				update.domainEntry,
+				workflowID,
+				update.request.NewWorkflowSnapshot.TransferTasks,
+				update.request.NewWorkflowSnapshot.ReplicationTasks,
+				update.request.NewWorkflowSnapshot.TimerTasks,
+				&transferMaxReadLevel,
+			)
+		}
+		if update.err == nil {
+			pendingUpdates = append(pendingUpdates, update)
+		}
+	}
+	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
+
+	// updates of the same workflow cannot be applied in the same batch,
+	// since their conditions on the current execution record may conflict
+	var batchUpdates []*shardUpdate
+	var individualUpdates []*shardUpdate
+	workflows := make(map[definition.WorkflowIdentifier]struct{})
+	for _, update := range pendingUpdates {
+		executionInfo := update.request.UpdateWorkflowMutation.ExecutionInfo
+		workflow := definition.NewWorkflowIdentifier(executionInfo.DomainID, executionInfo.WorkflowID, "")
+		if _, ok := workflows[workflow]; ok {
+			individualUpdates = append(individualUpdates, update)
+			continue
+		}
+		workflows[workflow] = struct{}{}
+		batchUpdates = append(batchUpdates, update)
+	}
+
+	if len(batchUpdates) == 1 {
+		individualUpdates = append(batchUpdates, individualUpdates...)
+	} else if len(batchUpdates) > 1 {
+		requests := make([]*persistence.UpdateWorkflowExecutionRequest, 0, len(batchUpdates))
+		for _, update := range batchUpdates {
+			requests = append(requests, update.request)
+		}
+		resp, err := s.updateWorkflowExecutionBatchWithRetryLocked(requests)
+		switch err.(type) {
+		case nil:
+			for i, update := range batchUpdates {
+				update.response = resp.Responses[i]
+			}
+		case *persistence.ShardOwnershipLostError:
+			// the shard is lost, none of the updates can be applied by this host
+			for _, update := range batchUpdates {
+				update.err = err
+			}
+		default:
+			// none of the updates may have been applied, e.g. the condition of one of them failed,
+			// so apply them individually to find out which one failed. An update applied by a batch
+			// which timed out fails its own condition, which callers already handle by reloading.
+			individualUpdates = append(batchUpdates, individualUpdates...)
+		}
+	}
+
+	for _, update := range individualUpdates {
+		update.response, update.err = s.updateWorkflowExecutionWithRetryLocked(update.request)
+	}
+}
+
+func (s *shardContextImpl) updateWorkflowExecutionBatchWithRetryLocked(
+	requests []*persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionBatchResponse, error) {
+
+Update_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		currentRangeID := s.getRangeID()
+		for _, request := range requests {
+			request.RangeID = currentRangeID
+		}
+		resp, err := s.executionManager.UpdateWorkflowExecutionBatch(&persistence.UpdateWorkflowExecutionBatchRequest{
+			RangeID:  currentRangeID,
+			Requests: requests,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *persistence.ConditionFailedError,
+				*shared.ServiceBusyError,
+				*shared.LimitExceededError:
+				// No special handling required for these errors
+			case *persistence.ShardOwnershipLostError:
+				{
+					// RangeID might have been renewed by the same host while this update was in flight
+					// Retry the operation if we still have the shard ownership
+					if currentRangeID != s.getRangeID() {
+						continue Update_Loop
+					} else {
+						// Shard is stolen, trigger shutdown of history engine
+						s.closeShard()
+					}
+				}
+			default:
+				{
+					// We have no idea if the write failed or will eventually make it to
+					// persistence. Increment RangeID to guarantee that subsequent reads
+					// will either see that write, or know for certain that it failed.
+					err1 := s.renewRangeLocked(false)
+					if err1 != nil {
+						s.closeShard()
+					}
+				}
+			}
+		}
+
+		return resp, err
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
 func (s *shardContextImpl) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
 
 	domainID := request.NewWorkflowSnapshot.ExecutionInfo.DomainID
@@ -867,10 +1022,43 @@ func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistor
 	return size, err0
 }
 
+func (s *shardContextImpl) RangeCompleteTransferTask(request *persistence.RangeCompleteTransferTaskRequest) error {
+	// No need to lock context here, the range ID only fences the delete against a stolen shard
+	currentRangeID := atomic.LoadInt64(&s.rangeID)
+	request.RangeID = currentRangeID
+	err := s.executionManager.RangeCompleteTransferTask(request)
+	s.closeShardIfOwnershipLost(currentRangeID, err)
+	return err
+}
+
+func (s *shardContextImpl) RangeCompleteTimerTask(request *persistence.RangeCompleteTimerTaskRequest) error {
+	// No need to lock context here, the range ID only fences the delete against a stolen shard
+	currentRangeID := atomic.LoadInt64(&s.rangeID)
+	request.RangeID = currentRangeID
+	err := s.executionManager.RangeCompleteTimerTask(request)
+	s.closeShardIfOwnershipLost(currentRangeID, err)
+	return err
+}
+
+func (s *shardContextImpl) closeShardIfOwnershipLost(rangeID int64, err error) {
+	if _, ok := err.(*persistence.ShardOwnershipLostError); !ok {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	// RangeID might have been renewed by the same host while the request was in flight,
+	// the caller retries with the renewed range ID in this case
+	if rangeID == s.getRangeID() {
+		// Shard is stolen, trigger shutdown of history engine
+		s.closeShard()
+	}
+}
+
 func (s *shardContextImpl) GetConfig() *Config {
 	return s.config
 }
-func (s *shardContextImpl) GetEventsCache() eventsCache {
+func (s *shardContextImpl) GetEventsCache() EventsCache {
 	return s.eventsCache
 }
 
@@ -930,6 +1118,7 @@ func (s *shardContextImpl) updateRangeIfNeededLocked() error {
 }
 
 func (s *shardContextImpl) renewRangeLocked(isStealing bool) error {
+	s.updateMaxObservedTimeLocked()
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	updatedShardInfo.RangeID++
 	if isStealing {
@@ -982,6 +1171,7 @@ func (s *shardContextImpl) updateShardInfoLocked() error {
 	if s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
 		return nil
 	}
+	s.updateMaxObservedTimeLocked()
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	s.emitShardInfoMetricsLogsLocked()
 
@@ -1036,7 +1226,7 @@ func (s *shardContextImpl) emitShardInfoMetricsLogsLocked() {
 	transferFailoverInProgress := len(s.shardInfo.TransferFailoverLevels)
 	timerFailoverInProgress := len(s.shardInfo.TimerFailoverLevels)
 
-	if s.config.EmitShardDiffLog() &&
+	if s.config.EmitShardDiffLog(s.shardID) &&
 		(logWarnTransferLevelDiff < diffTransferLevel ||
 			logWarnTimerLevelDiff < diffTimerLevel ||
 			logWarnTransferLevelDiff < transferLag ||
@@ -1060,6 +1250,9 @@ func (s *shardContextImpl) emitShardInfoMetricsLogsLocked() {
 
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTransferFailoverInProgressTimer, time.Duration(transferFailoverInProgress))
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTimerFailoverInProgressTimer, time.Duration(timerFailoverInProgress))
+	if hlc, ok := s.timeSource.(*clock.HybridLogicalClock); ok {
+		s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoClockSkewTimer, hlc.Skew())
+	}
 }
 
 func (s *shardContextImpl) allocateTaskIDsLocked(
@@ -1152,6 +1345,21 @@ func (s *shardContextImpl) GetTimeSource() clock.TimeSource {
 	return s.timeSource
 }
 
+// ObserveTime merges a timestamp observed from another host, e.g. of replicated history events, into the shard clock
+func (s *shardContextImpl) ObserveTime(observed time.Time) {
+	if hlc, ok := s.timeSource.(*clock.HybridLogicalClock); ok && !hlc.Update(observed) {
+		s.throttledLogger.Warn("Observed time drifts too far ahead of shard clock.",
+			tag.ShardID(s.shardID),
+			tag.Timestamp(observed))
+	}
+}
+
+func (s *shardContextImpl) updateMaxObservedTimeLocked() {
+	if hlc, ok := s.timeSource.(*clock.HybridLogicalClock); ok && !hlc.Last().IsZero() {
+		s.shardInfo.MaxObservedTime = hlc.Last()
+	}
+}
+
 func (s *shardContextImpl) SetCurrentTime(cluster string, currentTime time.Time) {
 	s.Lock()
 	defer s.Unlock()
@@ -1243,6 +1451,11 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 		}
 	}
 
+	// timestamps served by the previous shard owner are never regressed, even if they run ahead of the
+	// physical clock of this host, the drift check only applies to timestamps observed at runtime
+	timeSource := clock.NewHybridLogicalClock(shardItem.service.GetTimeSource(), shardClockMaxDrift)
+	timeSource.Seed(shardInfo.MaxObservedTime)
+
 	context := &shardContextImpl{
 		shardItem:                 shardItem,
 		shardID:                   shardItem.shardID,
@@ -1257,12 +1470,22 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 		closeCh:                   closeCh,
 		metricsClient:             shardItem.metricsClient,
 		config:                    shardItem.config,
-		timeSource:                shardItem.service.GetTimeSource(),
+		timeSource:                timeSource,
 		standbyClusterCurrentTime: standbyClusterCurrentTime,
 		timerMaxReadLevelMap:      timerMaxReadLevelMap, // use ack to init read level
 	}
 	context.logger = shardItem.logger
+	context.updateBatcher = newShardUpdateBatcher(
+		context.updateWorkflowExecutionBatch,
+		shardItem.config.ShardUpdateBatchingWindow,
+		shardItem.config.ShardUpdateBatchMaxSize,
+	)
 	context.throttledLogger = shardItem.throttledLogger
+	if timeSource.Skew() > shardClockMaxDrift {
+		context.logger.Warn("Shard clock seeded too far ahead of physical clock.",
+			tag.ShardID(shardItem.shardID),
+			tag.Timestamp(shardInfo.MaxObservedTime))
+	}
 	context.eventsCache = newEventsCache(context)
 
 	err1 := context.renewRangeLocked(true)
@@ -1308,6 +1531,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 		ClusterTimerAckLevel:      clusterTimerAckLevel,
 		DomainNotificationVersion: shardInfo.DomainNotificationVersion,
 		ClusterReplicationLevel:   clusterReplicationLevel,
+		MaxObservedTime:           shardInfo.MaxObservedTime,
 	}
 
 	return shardInfoCopy
@@ -0,0 +1,779 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shardContext.go
+
+// Package history is a generated GoMock package.
+package history
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	shared "github.com/uber/cadence/.gen/go/shared"
+	cache "github.com/uber/cadence/common/cache"
+	clock "github.com/uber/cadence/common/clock"
+	cluster "github.com/uber/cadence/common/cluster"
+	log "github.com/uber/cadence/common/log"
+	metrics "github.com/uber/cadence/common/metrics"
+	persistence "github.com/uber/cadence/common/persistence"
+	service "github.com/uber/cadence/common/service"
+	reflect "reflect"
+	time "time"
+)
+
+// MockShardContext is a mock of ShardContext interface
+type MockShardContext struct {
+	ctrl     *gomock.Controller
+	recorder *MockShardContextMockRecorder
+}
+
+// MockShardContextMockRecorder is the mock recorder for MockShardContext
+type MockShardContextMockRecorder struct {
+	mock *MockShardContext
+}
+
+// NewMockShardContext creates a new mock instance
+func NewMockShardContext(ctrl *gomock.Controller) *MockShardContext {
+	mock := &MockShardContext{ctrl: ctrl}
+	mock.recorder = &MockShardContextMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockShardContext) EXPECT() *MockShardContextMockRecorder {
+	return m.recorder
+}
+
+// GetShardID mocks base method
+func (m *MockShardContext) GetShardID() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardID")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetShardID indicates an expected call of GetShardID
+func (mr *MockShardContextMockRecorder) GetShardID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardID", reflect.TypeOf((*MockShardContext)(nil).GetShardID))
+}
+
+// GetService mocks base method
+func (m *MockShardContext) GetService() service.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService")
+	ret0, _ := ret[0].(service.Service)
+	return ret0
+}
+
+// GetService indicates an expected call of GetService
+func (mr *MockShardContextMockRecorder) GetService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockShardContext)(nil).GetService))
+}
+
+// GetExecutionManager mocks base method
+func (m *MockShardContext) GetExecutionManager() persistence.ExecutionManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionManager")
+	ret0, _ := ret[0].(persistence.ExecutionManager)
+	return ret0
+}
+
+// GetExecutionManager indicates an expected call of GetExecutionManager
+func (mr *MockShardContextMockRecorder) GetExecutionManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionManager", reflect.TypeOf((*MockShardContext)(nil).GetExecutionManager))
+}
+
+// GetHistoryManager mocks base method
+func (m *MockShardContext) GetHistoryManager() persistence.HistoryManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryManager")
+	ret0, _ := ret[0].(persistence.HistoryManager)
+	return ret0
+}
+
+// GetHistoryManager indicates an expected call of GetHistoryManager
+func (mr *MockShardContextMockRecorder) GetHistoryManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryManager", reflect.TypeOf((*MockShardContext)(nil).GetHistoryManager))
+}
+
+// GetHistoryV2Manager mocks base method
+func (m *MockShardContext) GetHistoryV2Manager() persistence.HistoryV2Manager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryV2Manager")
+	ret0, _ := ret[0].(persistence.HistoryV2Manager)
+	return ret0
+}
+
+// GetHistoryV2Manager indicates an expected call of GetHistoryV2Manager
+func (mr *MockShardContextMockRecorder) GetHistoryV2Manager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryV2Manager", reflect.TypeOf((*MockShardContext)(nil).GetHistoryV2Manager))
+}
+
+// GetDomainCache mocks base method
+func (m *MockShardContext) GetDomainCache() cache.DomainCache {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainCache")
+	ret0, _ := ret[0].(cache.DomainCache)
+	return ret0
+}
+
+// GetDomainCache indicates an expected call of GetDomainCache
+func (mr *MockShardContextMockRecorder) GetDomainCache() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainCache", reflect.TypeOf((*MockShardContext)(nil).GetDomainCache))
+}
+
+// GetClusterMetadata mocks base method
+func (m *MockShardContext) GetClusterMetadata() cluster.Metadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterMetadata")
+	ret0, _ := ret[0].(cluster.Metadata)
+	return ret0
+}
+
+// GetClusterMetadata indicates an expected call of GetClusterMetadata
+func (mr *MockShardContextMockRecorder) GetClusterMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterMetadata", reflect.TypeOf((*MockShardContext)(nil).GetClusterMetadata))
+}
+
+// GetConfig mocks base method
+func (m *MockShardContext) GetConfig() *Config {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig")
+	ret0, _ := ret[0].(*Config)
+	return ret0
+}
+
+// GetConfig indicates an expected call of GetConfig
+func (mr *MockShardContextMockRecorder) GetConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockShardContext)(nil).GetConfig))
+}
+
+// GetEventsCache mocks base method
+func (m *MockShardContext) GetEventsCache() EventsCache {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsCache")
+	ret0, _ := ret[0].(EventsCache)
+	return ret0
+}
+
+// GetEventsCache indicates an expected call of GetEventsCache
+func (mr *MockShardContextMockRecorder) GetEventsCache() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsCache", reflect.TypeOf((*MockShardContext)(nil).GetEventsCache))
+}
+
+// GetLogger mocks base method
+func (m *MockShardContext) GetLogger() log.Logger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogger")
+	ret0, _ := ret[0].(log.Logger)
+	return ret0
+}
+
+// GetLogger indicates an expected call of GetLogger
+func (mr *MockShardContextMockRecorder) GetLogger() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogger", reflect.TypeOf((*MockShardContext)(nil).GetLogger))
+}
+
+// GetThrottledLogger mocks base method
+func (m *MockShardContext) GetThrottledLogger() log.Logger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThrottledLogger")
+	ret0, _ := ret[0].(log.Logger)
+	return ret0
+}
+
+// GetThrottledLogger indicates an expected call of GetThrottledLogger
+func (mr *MockShardContextMockRecorder) GetThrottledLogger() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThrottledLogger", reflect.TypeOf((*MockShardContext)(nil).GetThrottledLogger))
+}
+
+// GetMetricsClient mocks base method
+func (m *MockShardContext) GetMetricsClient() metrics.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricsClient")
+	ret0, _ := ret[0].(metrics.Client)
+	return ret0
+}
+
+// GetMetricsClient indicates an expected call of GetMetricsClient
+func (mr *MockShardContextMockRecorder) GetMetricsClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsClient", reflect.TypeOf((*MockShardContext)(nil).GetMetricsClient))
+}
+
+// GetTimeSource mocks base method
+func (m *MockShardContext) GetTimeSource() clock.TimeSource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeSource")
+	ret0, _ := ret[0].(clock.TimeSource)
+	return ret0
+}
+
+// GetTimeSource indicates an expected call of GetTimeSource
+func (mr *MockShardContextMockRecorder) GetTimeSource() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeSource", reflect.TypeOf((*MockShardContext)(nil).GetTimeSource))
+}
+
+// ObserveTime mocks base method
+func (m *MockShardContext) ObserveTime(observed time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveTime", observed)
+}
+
+// ObserveTime indicates an expected call of ObserveTime
+func (mr *MockShardContextMockRecorder) ObserveTime(observed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveTime", reflect.TypeOf((*MockShardContext)(nil).ObserveTime), observed)
+}
+
+// GetEngine mocks base method
+func (m *MockShardContext) GetEngine() Engine {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEngine")
+	ret0, _ := ret[0].(Engine)
+	return ret0
+}
+
+// GetEngine indicates an expected call of GetEngine
+func (mr *MockShardContextMockRecorder) GetEngine() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEngine", reflect.TypeOf((*MockShardContext)(nil).GetEngine))
+}
+
+// SetEngine mocks base method
+func (m *MockShardContext) SetEngine(arg0 Engine) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetEngine", arg0)
+}
+
+// SetEngine indicates an expected call of SetEngine
+func (mr *MockShardContextMockRecorder) SetEngine(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEngine", reflect.TypeOf((*MockShardContext)(nil).SetEngine), arg0)
+}
+
+// GenerateTransferTaskID mocks base method
+func (m *MockShardContext) GenerateTransferTaskID() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTransferTaskID")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateTransferTaskID indicates an expected call of GenerateTransferTaskID
+func (mr *MockShardContextMockRecorder) GenerateTransferTaskID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTransferTaskID", reflect.TypeOf((*MockShardContext)(nil).GenerateTransferTaskID))
+}
+
+// GenerateTransferTaskIDs mocks base method
+func (m *MockShardContext) GenerateTransferTaskIDs(number int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateTransferTaskIDs", number)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateTransferTaskIDs indicates an expected call of GenerateTransferTaskIDs
+func (mr *MockShardContextMockRecorder) GenerateTransferTaskIDs(number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateTransferTaskIDs", reflect.TypeOf((*MockShardContext)(nil).GenerateTransferTaskIDs), number)
+}
+
+// GetTransferMaxReadLevel mocks base method
+func (m *MockShardContext) GetTransferMaxReadLevel() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferMaxReadLevel")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetTransferMaxReadLevel indicates an expected call of GetTransferMaxReadLevel
+func (mr *MockShardContextMockRecorder) GetTransferMaxReadLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferMaxReadLevel", reflect.TypeOf((*MockShardContext)(nil).GetTransferMaxReadLevel))
+}
+
+// UpdateTimerMaxReadLevel mocks base method
+func (m *MockShardContext) UpdateTimerMaxReadLevel(cluster string) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimerMaxReadLevel", cluster)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// UpdateTimerMaxReadLevel indicates an expected call of UpdateTimerMaxReadLevel
+func (mr *MockShardContextMockRecorder) UpdateTimerMaxReadLevel(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimerMaxReadLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateTimerMaxReadLevel), cluster)
+}
+
+// SetCurrentTime mocks base method
+func (m *MockShardContext) SetCurrentTime(cluster string, currentTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCurrentTime", cluster, currentTime)
+}
+
+// SetCurrentTime indicates an expected call of SetCurrentTime
+func (mr *MockShardContextMockRecorder) SetCurrentTime(cluster, currentTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentTime", reflect.TypeOf((*MockShardContext)(nil).SetCurrentTime), cluster, currentTime)
+}
+
+// GetCurrentTime mocks base method
+func (m *MockShardContext) GetCurrentTime(cluster string) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentTime", cluster)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetCurrentTime indicates an expected call of GetCurrentTime
+func (mr *MockShardContextMockRecorder) GetCurrentTime(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentTime", reflect.TypeOf((*MockShardContext)(nil).GetCurrentTime), cluster)
+}
+
+// GetTimerMaxReadLevel mocks base method
+func (m *MockShardContext) GetTimerMaxReadLevel(cluster string) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimerMaxReadLevel", cluster)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetTimerMaxReadLevel indicates an expected call of GetTimerMaxReadLevel
+func (mr *MockShardContextMockRecorder) GetTimerMaxReadLevel(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimerMaxReadLevel", reflect.TypeOf((*MockShardContext)(nil).GetTimerMaxReadLevel), cluster)
+}
+
+// GetTransferAckLevel mocks base method
+func (m *MockShardContext) GetTransferAckLevel() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferAckLevel")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetTransferAckLevel indicates an expected call of GetTransferAckLevel
+func (mr *MockShardContextMockRecorder) GetTransferAckLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferAckLevel", reflect.TypeOf((*MockShardContext)(nil).GetTransferAckLevel))
+}
+
+// UpdateTransferAckLevel mocks base method
+func (m *MockShardContext) UpdateTransferAckLevel(ackLevel int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferAckLevel", ackLevel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransferAckLevel indicates an expected call of UpdateTransferAckLevel
+func (mr *MockShardContextMockRecorder) UpdateTransferAckLevel(ackLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferAckLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateTransferAckLevel), ackLevel)
+}
+
+// GetTransferClusterAckLevel mocks base method
+func (m *MockShardContext) GetTransferClusterAckLevel(cluster string) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferClusterAckLevel", cluster)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetTransferClusterAckLevel indicates an expected call of GetTransferClusterAckLevel
+func (mr *MockShardContextMockRecorder) GetTransferClusterAckLevel(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferClusterAckLevel", reflect.TypeOf((*MockShardContext)(nil).GetTransferClusterAckLevel), cluster)
+}
+
+// UpdateTransferClusterAckLevel mocks base method
+func (m *MockShardContext) UpdateTransferClusterAckLevel(cluster string, ackLevel int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferClusterAckLevel", cluster, ackLevel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransferClusterAckLevel indicates an expected call of UpdateTransferClusterAckLevel
+func (mr *MockShardContextMockRecorder) UpdateTransferClusterAckLevel(cluster, ackLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferClusterAckLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateTransferClusterAckLevel), cluster, ackLevel)
+}
+
+// GetReplicatorAckLevel mocks base method
+func (m *MockShardContext) GetReplicatorAckLevel() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicatorAckLevel")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetReplicatorAckLevel indicates an expected call of GetReplicatorAckLevel
+func (mr *MockShardContextMockRecorder) GetReplicatorAckLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicatorAckLevel", reflect.TypeOf((*MockShardContext)(nil).GetReplicatorAckLevel))
+}
+
+// UpdateReplicatorAckLevel mocks base method
+func (m *MockShardContext) UpdateReplicatorAckLevel(ackLevel int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReplicatorAckLevel", ackLevel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReplicatorAckLevel indicates an expected call of UpdateReplicatorAckLevel
+func (mr *MockShardContextMockRecorder) UpdateReplicatorAckLevel(ackLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReplicatorAckLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateReplicatorAckLevel), ackLevel)
+}
+
+// GetClusterReplicationLevel mocks base method
+func (m *MockShardContext) GetClusterReplicationLevel(cluster string) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterReplicationLevel", cluster)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetClusterReplicationLevel indicates an expected call of GetClusterReplicationLevel
+func (mr *MockShardContextMockRecorder) GetClusterReplicationLevel(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterReplicationLevel", reflect.TypeOf((*MockShardContext)(nil).GetClusterReplicationLevel), cluster)
+}
+
+// UpdateClusterReplicationLevel mocks base method
+func (m *MockShardContext) UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterReplicationLevel", cluster, lastTaskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterReplicationLevel indicates an expected call of UpdateClusterReplicationLevel
+func (mr *MockShardContextMockRecorder) UpdateClusterReplicationLevel(cluster, lastTaskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterReplicationLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateClusterReplicationLevel), cluster, lastTaskID)
+}
+
+// GetTimerAckLevel mocks base method
+func (m *MockShardContext) GetTimerAckLevel() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimerAckLevel")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetTimerAckLevel indicates an expected call of GetTimerAckLevel
+func (mr *MockShardContextMockRecorder) GetTimerAckLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimerAckLevel", reflect.TypeOf((*MockShardContext)(nil).GetTimerAckLevel))
+}
+
+// UpdateTimerAckLevel mocks base method
+func (m *MockShardContext) UpdateTimerAckLevel(ackLevel time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimerAckLevel", ackLevel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimerAckLevel indicates an expected call of UpdateTimerAckLevel
+func (mr *MockShardContextMockRecorder) UpdateTimerAckLevel(ackLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimerAckLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateTimerAckLevel), ackLevel)
+}
+
+// GetTimerClusterAckLevel mocks base method
+func (m *MockShardContext) GetTimerClusterAckLevel(cluster string) time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimerClusterAckLevel", cluster)
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetTimerClusterAckLevel indicates an expected call of GetTimerClusterAckLevel
+func (mr *MockShardContextMockRecorder) GetTimerClusterAckLevel(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimerClusterAckLevel", reflect.TypeOf((*MockShardContext)(nil).GetTimerClusterAckLevel), cluster)
+}
+
+// UpdateTimerClusterAckLevel mocks base method
+func (m *MockShardContext) UpdateTimerClusterAckLevel(cluster string, ackLevel time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimerClusterAckLevel", cluster, ackLevel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimerClusterAckLevel indicates an expected call of UpdateTimerClusterAckLevel
+func (mr *MockShardContextMockRecorder) UpdateTimerClusterAckLevel(cluster, ackLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimerClusterAckLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateTimerClusterAckLevel), cluster, ackLevel)
+}
+
+// UpdateTransferFailoverLevel mocks base method
+func (m *MockShardContext) UpdateTransferFailoverLevel(failoverID string, level persistence.TransferFailoverLevel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferFailoverLevel", failoverID, level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransferFailoverLevel indicates an expected call of UpdateTransferFailoverLevel
+func (mr *MockShardContextMockRecorder) UpdateTransferFailoverLevel(failoverID, level interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferFailoverLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateTransferFailoverLevel), failoverID, level)
+}
+
+// DeleteTransferFailoverLevel mocks base method
+func (m *MockShardContext) DeleteTransferFailoverLevel(failoverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransferFailoverLevel", failoverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransferFailoverLevel indicates an expected call of DeleteTransferFailoverLevel
+func (mr *MockShardContextMockRecorder) DeleteTransferFailoverLevel(failoverID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransferFailoverLevel", reflect.TypeOf((*MockShardContext)(nil).DeleteTransferFailoverLevel), failoverID)
+}
+
+// GetAllTransferFailoverLevels mocks base method
+func (m *MockShardContext) GetAllTransferFailoverLevels() map[string]persistence.TransferFailoverLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTransferFailoverLevels")
+	ret0, _ := ret[0].(map[string]persistence.TransferFailoverLevel)
+	return ret0
+}
+
+// GetAllTransferFailoverLevels indicates an expected call of GetAllTransferFailoverLevels
+func (mr *MockShardContextMockRecorder) GetAllTransferFailoverLevels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTransferFailoverLevels", reflect.TypeOf((*MockShardContext)(nil).GetAllTransferFailoverLevels))
+}
+
+// UpdateTimerFailoverLevel mocks base method
+func (m *MockShardContext) UpdateTimerFailoverLevel(failoverID string, level persistence.TimerFailoverLevel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimerFailoverLevel", failoverID, level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimerFailoverLevel indicates an expected call of UpdateTimerFailoverLevel
+func (mr *MockShardContextMockRecorder) UpdateTimerFailoverLevel(failoverID, level interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimerFailoverLevel", reflect.TypeOf((*MockShardContext)(nil).UpdateTimerFailoverLevel), failoverID, level)
+}
+
+// DeleteTimerFailoverLevel mocks base method
+func (m *MockShardContext) DeleteTimerFailoverLevel(failoverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimerFailoverLevel", failoverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTimerFailoverLevel indicates an expected call of DeleteTimerFailoverLevel
+func (mr *MockShardContextMockRecorder) DeleteTimerFailoverLevel(failoverID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimerFailoverLevel", reflect.TypeOf((*MockShardContext)(nil).DeleteTimerFailoverLevel), failoverID)
+}
+
+// GetAllTimerFailoverLevels mocks base method
+func (m *MockShardContext) GetAllTimerFailoverLevels() map[string]persistence.TimerFailoverLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTimerFailoverLevels")
+	ret0, _ := ret[0].(map[string]persistence.TimerFailoverLevel)
+	return ret0
+}
+
+// GetAllTimerFailoverLevels indicates an expected call of GetAllTimerFailoverLevels
+func (mr *MockShardContextMockRecorder) GetAllTimerFailoverLevels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTimerFailoverLevels", reflect.TypeOf((*MockShardContext)(nil).GetAllTimerFailoverLevels))
+}
+
+// GetDomainNotificationVersion mocks base method
+func (m *MockShardContext) GetDomainNotificationVersion() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainNotificationVersion")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetDomainNotificationVersion indicates an expected call of GetDomainNotificationVersion
+func (mr *MockShardContextMockRecorder) GetDomainNotificationVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainNotificationVersion", reflect.TypeOf((*MockShardContext)(nil).GetDomainNotificationVersion))
+}
+
+// UpdateDomainNotificationVersion mocks base method
+func (m *MockShardContext) UpdateDomainNotificationVersion(domainNotificationVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDomainNotificationVersion", domainNotificationVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDomainNotificationVersion indicates an expected call of UpdateDomainNotificationVersion
+func (mr *MockShardContextMockRecorder) UpdateDomainNotificationVersion(domainNotificationVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainNotificationVersion", reflect.TypeOf((*MockShardContext)(nil).UpdateDomainNotificationVersion), domainNotificationVersion)
+}
+
+// CreateWorkflowExecution mocks base method
+func (m *MockShardContext) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflowExecution", request)
+	ret0, _ := ret[0].(*persistence.CreateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkflowExecution indicates an expected call of CreateWorkflowExecution
+func (mr *MockShardContextMockRecorder) CreateWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflowExecution", reflect.TypeOf((*MockShardContext)(nil).CreateWorkflowExecution), request)
+}
+
+// UpdateWorkflowExecution mocks base method
+func (m *MockShardContext) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", request)
+	ret0, _ := ret[0].(*persistence.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockShardContextMockRecorder) UpdateWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockShardContext)(nil).UpdateWorkflowExecution), request)
+}
+
+// ConflictResolveWorkflowExecution mocks base method
+func (m *MockShardContext) ConflictResolveWorkflowExecution(request *persistence.ConflictResolveWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConflictResolveWorkflowExecution", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConflictResolveWorkflowExecution indicates an expected call of ConflictResolveWorkflowExecution
+func (mr *MockShardContextMockRecorder) ConflictResolveWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConflictResolveWorkflowExecution", reflect.TypeOf((*MockShardContext)(nil).ConflictResolveWorkflowExecution), request)
+}
+
+// ResetWorkflowExecution mocks base method
+func (m *MockShardContext) ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecution", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWorkflowExecution indicates an expected call of ResetWorkflowExecution
+func (mr *MockShardContextMockRecorder) ResetWorkflowExecution(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecution", reflect.TypeOf((*MockShardContext)(nil).ResetWorkflowExecution), request)
+}
+
+// RangeCompleteTransferTask mocks base method
+func (m *MockShardContext) RangeCompleteTransferTask(request *persistence.RangeCompleteTransferTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeCompleteTransferTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeCompleteTransferTask indicates an expected call of RangeCompleteTransferTask
+func (mr *MockShardContextMockRecorder) RangeCompleteTransferTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeCompleteTransferTask", reflect.TypeOf((*MockShardContext)(nil).RangeCompleteTransferTask), request)
+}
+
+// RangeCompleteTimerTask mocks base method
+func (m *MockShardContext) RangeCompleteTimerTask(request *persistence.RangeCompleteTimerTaskRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeCompleteTimerTask", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeCompleteTimerTask indicates an expected call of RangeCompleteTimerTask
+func (mr *MockShardContextMockRecorder) RangeCompleteTimerTask(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeCompleteTimerTask", reflect.TypeOf((*MockShardContext)(nil).RangeCompleteTimerTask), request)
+}
+
+// AppendHistoryEvents mocks base method
+func (m *MockShardContext) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryEvents", request)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendHistoryEvents indicates an expected call of AppendHistoryEvents
+func (mr *MockShardContextMockRecorder) AppendHistoryEvents(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryEvents", reflect.TypeOf((*MockShardContext)(nil).AppendHistoryEvents), request)
+}
+
+// AppendHistoryV2Events mocks base method
+func (m *MockShardContext) AppendHistoryV2Events(request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendHistoryV2Events", request, domainID, execution)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendHistoryV2Events indicates an expected call of AppendHistoryV2Events
+func (mr *MockShardContextMockRecorder) AppendHistoryV2Events(request, domainID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryV2Events", reflect.TypeOf((*MockShardContext)(nil).AppendHistoryV2Events), request, domainID, execution)
+}
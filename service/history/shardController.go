@@ -26,6 +26,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
@@ -130,6 +131,9 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 	if err != nil {
 		return nil, err
 	}
+	if config.TaskAuditor != nil {
+		executionMgr = config.TaskAuditor.newExecutionManager(executionMgr)
+	}
 
 	return &historyShardsItem{
 		service:         svc,
@@ -391,6 +395,25 @@ func (c *shardController) shardIDs() []int32 {
 	return ids
 }
 
+func (c *shardController) shardInfos() []*workflow.HistoryShardInfo {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	infos := []*workflow.HistoryShardInfo{}
+	for _, item := range items {
+		item.RLock()
+		if item.status == historyShardsItemStatusStarted {
+			infos = append(infos, item.engine.DescribeShard())
+		}
+		item.RUnlock()
+	}
+	return infos
+}
+
 func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine, error) {
 	i.RLock()
 	if i.status == historyShardsItemStatusStarted {
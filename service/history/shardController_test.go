@@ -277,6 +277,46 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 	}
 }
 
+func (s *shardControllerSuite) TestAcquireShardSeedsClock() {
+	s.config.NumberOfShards = 1
+	shardID := 0
+	// the previous shard owner served timestamps further ahead than the shard clock max drift
+	maxObservedTime := time.Now().Add(time.Hour)
+
+	var shardContext ShardContext
+	mockExecutionMgr := &mmocks.ExecutionManager{}
+	s.mockExecutionMgrFactory.On("NewExecutionManager", shardID).Return(mockExecutionMgr, nil).Once()
+	mockEngine := &MockHistoryEngine{}
+	mockEngine.On("Start").Return().Once()
+	s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil).Twice()
+	s.mockEngineFactory.On("CreateEngine", mock.Anything).Return(mockEngine).Run(func(args mock.Arguments) {
+		shardContext = args.Get(0).(ShardContext)
+	}).Once()
+	s.mockShardManager.On("GetShard", &persistence.GetShardRequest{ShardID: shardID}).Return(
+		&persistence.GetShardResponse{
+			ShardInfo: &persistence.ShardInfo{
+				ShardID:                 shardID,
+				Owner:                   s.hostInfo.Identity(),
+				RangeID:                 5,
+				MaxObservedTime:         maxObservedTime,
+				ClusterTransferAckLevel: map[string]int64{},
+				ClusterTimerAckLevel:    map[string]time.Time{},
+				ClusterReplicationLevel: map[string]int64{},
+			},
+		}, nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.RangeID == 6 && request.ShardInfo.MaxObservedTime.Equal(maxObservedTime)
+	})).Return(nil).Once()
+
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestSingleDCClusterInfo)
+	s.controller.acquireShards()
+
+	s.NotNil(s.controller.getEngineForShard(shardID))
+	s.NotNil(shardContext)
+	s.True(shardContext.GetTimeSource().Now().After(maxObservedTime))
+}
+
 func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 	numShards := 2
 	s.config.NumberOfShards = numShards
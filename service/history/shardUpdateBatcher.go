@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// shardUpdateBatcher groups the workflow updates of a shard arriving within a small window,
+	// so their mutable states and tasks are written to persistence together
+	shardUpdateBatcher struct {
+		flushFn      func(updates []*shardUpdate)
+		window       dynamicconfig.DurationPropertyFn
+		maxBatchSize dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		batch *shardUpdateBatch
+	}
+
+	shardUpdateBatch struct {
+		updates   []*shardUpdate
+		flushOnce sync.Once
+		doneCh    chan struct{}
+	}
+
+	shardUpdate struct {
+		domainEntry *cache.DomainCacheEntry
+		request     *persistence.UpdateWorkflowExecutionRequest
+		response    *persistence.UpdateWorkflowExecutionResponse
+		err         error
+	}
+)
+
+func newShardUpdateBatcher(
+	flushFn func(updates []*shardUpdate),
+	window dynamicconfig.DurationPropertyFn,
+	maxBatchSize dynamicconfig.IntPropertyFn,
+) *shardUpdateBatcher {
+
+	return &shardUpdateBatcher{
+		flushFn:      flushFn,
+		window:       window,
+		maxBatchSize: maxBatchSize,
+	}
+}
+
+func (b *shardUpdateBatcher) update(
+	domainEntry *cache.DomainCacheEntry,
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+
+	update := &shardUpdate{
+		domainEntry: domainEntry,
+		request:     request,
+	}
+
+	b.Lock()
+	batch := b.batch
+	if batch == nil {
+		batch = &shardUpdateBatch{
+			doneCh: make(chan struct{}),
+		}
+		b.batch = batch
+		time.AfterFunc(b.window(), func() { b.flush(batch) })
+	}
+	batch.updates = append(batch.updates, update)
+	full := len(batch.updates) >= b.maxBatchSize()
+	if full {
+		b.batch = nil
+	}
+	b.Unlock()
+
+	if full {
+		b.flush(batch)
+	}
+	<-batch.doneCh
+	return update.response, update.err
+}
+
+func (b *shardUpdateBatcher) flush(
+	batch *shardUpdateBatch,
+) {
+
+	batch.flushOnce.Do(func() {
+		b.Lock()
+		if b.batch == batch {
+			b.batch = nil
+		}
+		b.Unlock()
+
+		b.flushFn(batch.updates)
+		close(batch.doneCh)
+	})
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	shardUpdateBatcherSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		sync.Mutex
+		flushed [][]*shardUpdate
+	}
+)
+
+func TestShardUpdateBatcherSuite(t *testing.T) {
+	s := new(shardUpdateBatcherSuite)
+	suite.Run(t, s)
+}
+
+func (s *shardUpdateBatcherSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.flushed = nil
+}
+
+func (s *shardUpdateBatcherSuite) flush(updates []*shardUpdate) {
+	s.Lock()
+	s.flushed = append(s.flushed, updates)
+	s.Unlock()
+
+	for i, update := range updates {
+		if i%2 == 0 {
+			update.response = &persistence.UpdateWorkflowExecutionResponse{}
+		} else {
+			update.err = errors.New("some random error")
+		}
+	}
+}
+
+func (s *shardUpdateBatcherSuite) TestUpdate_SingleUpdate() {
+	batcher := newShardUpdateBatcher(
+		s.flush,
+		dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+		dynamicconfig.GetIntPropertyFn(10),
+	)
+
+	request := &persistence.UpdateWorkflowExecutionRequest{}
+	resp, err := batcher.update(nil, request)
+	s.NoError(err)
+	s.NotNil(resp)
+	s.Equal(1, len(s.flushed))
+	s.Equal(request, s.flushed[0][0].request)
+}
+
+func (s *shardUpdateBatcherSuite) TestUpdate_BatchedWithinWindow() {
+	batcher := newShardUpdateBatcher(
+		s.flush,
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetIntPropertyFn(4),
+	)
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var numErrors int
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := batcher.update(nil, &persistence.UpdateWorkflowExecutionRequest{})
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				numErrors++
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the window is long enough that only a full batch triggers a flush
+	s.Equal(2, len(s.flushed))
+	s.Equal(4, len(s.flushed[0]))
+	s.Equal(4, len(s.flushed[1]))
+	s.Equal(4, numErrors)
+}
+
+func (s *shardUpdateBatcherSuite) TestUpdateWorkflowExecutionBatch_BatchFailed_FallbackToIndividualUpdates() {
+	mockExecutionMgr := &mocks.ExecutionManager{}
+	defer mockExecutionMgr.AssertExpectations(s.T())
+	shard := s.newTestShardContext(mockExecutionMgr)
+
+	mockExecutionMgr.On("UpdateWorkflowExecutionBatch", mock.Anything).
+		Return(nil, &persistence.ConditionFailedError{}).Once()
+	mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).
+		Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+	mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).
+		Return(nil, &persistence.ConditionFailedError{}).Once()
+
+	updates := []*shardUpdate{
+		newTestShardUpdate("workflow-1"),
+		newTestShardUpdate("workflow-2"),
+	}
+	shard.updateWorkflowExecutionBatch(updates)
+	s.NoError(updates[0].err)
+	s.NotNil(updates[0].response)
+	s.IsType(&persistence.ConditionFailedError{}, updates[1].err)
+}
+
+func (s *shardUpdateBatcherSuite) TestUpdateWorkflowExecutionBatch_ShardOwnershipLost() {
+	mockExecutionMgr := &mocks.ExecutionManager{}
+	defer mockExecutionMgr.AssertExpectations(s.T())
+	shard := s.newTestShardContext(mockExecutionMgr)
+	// the shard is already closed, so losing it does not stop the engine
+	shard.isClosed = true
+
+	mockExecutionMgr.On("UpdateWorkflowExecutionBatch", mock.Anything).
+		Return(nil, &persistence.ShardOwnershipLostError{}).Once()
+
+	updates := []*shardUpdate{
+		newTestShardUpdate("workflow-1"),
+		newTestShardUpdate("workflow-2"),
+	}
+	shard.updateWorkflowExecutionBatch(updates)
+	for _, update := range updates {
+		s.IsType(&persistence.ShardOwnershipLostError{}, update.err)
+	}
+	mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+}
+
+func (s *shardUpdateBatcherSuite) newTestShardContext(
+	executionMgr persistence.ExecutionManager,
+) *shardContextImpl {
+
+	return &shardContextImpl{
+		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1, TransferAckLevel: 0},
+		transferSequenceNumber:    1,
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   make(chan int, 100),
+		config:                    NewDynamicConfigForTest(),
+		logger:                    loggerimpl.NewDevelopmentForTest(s.Suite),
+		executionManager:          executionMgr,
+		clusterMetadata:           cluster.GetTestClusterMetadata(false, false),
+	}
+}
+
+func newTestShardUpdate(workflowID string) *shardUpdate {
+	return &shardUpdate{
+		request: &persistence.UpdateWorkflowExecutionRequest{
+			UpdateWorkflowMutation: persistence.WorkflowMutation{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:   validDomainID,
+					WorkflowID: workflowID,
+				},
+			},
+		},
+	}
+}
@@ -524,6 +524,9 @@ func (b *stateBuilderImpl) applyEvents(
 			b.msBuilder.ReplicateUpsertWorkflowSearchAttributesEvent(event)
 			b.transferTasks = append(b.transferTasks, b.scheduleUpsertSearchAttributesTask())
 
+		case shared.EventTypeWorkflowExecutionTerminationProtectionSet:
+			b.msBuilder.ReplicateWorkflowExecutionTerminationProtectionSetEvent(event)
+
 		case shared.EventTypeWorkflowExecutionContinuedAsNew:
 			if len(newRunHistory) == 0 {
 				return nil, nil, nil, errors.NewInternalFailureError(ErrMessageNewRunHistorySizeZero)
@@ -745,12 +748,13 @@ func (b *stateBuilderImpl) scheduleWorkflowTimerTask(
 	return timerTasks
 }
 
-func (b *stateBuilderImpl) scheduleDeleteHistoryTimerTask(
+func (b *stateBuilderImpl) scheduleDeleteHistoryTimerTasks(
 	event *shared.HistoryEvent,
 	domainID string,
 	workflowID string,
-) (persistence.Task, error) {
+) ([]persistence.Task, error) {
 	var retentionInDays int32
+	var visibilityRetention time.Duration
 	domainEntry, err := b.shard.GetDomainCache().GetDomainByID(domainID)
 	if err != nil {
 		if _, ok := err.(*shared.EntityNotExistsError); !ok {
@@ -758,8 +762,20 @@ func (b *stateBuilderImpl) scheduleDeleteHistoryTimerTask(
 		}
 	} else {
 		retentionInDays = domainEntry.GetRetentionDays(workflowID)
+		visibilityRetention = getVisibilityRetention(
+			b.shard.GetConfig(),
+			domainEntry.GetInfo().Name,
+			time.Duration(retentionInDays)*time.Hour*24,
+		)
+	}
+	timerBuilder := b.getTimerBuilder(event)
+	timerTasks := []persistence.Task{
+		timerBuilder.createDeleteHistoryEventTimerTask(time.Duration(retentionInDays) * time.Hour * 24),
+	}
+	if visibilityRetention > 0 {
+		timerTasks = append(timerTasks, timerBuilder.createDeleteVisibilityTimerTask(visibilityRetention))
 	}
-	return b.getTimerBuilder(event).createDeleteHistoryEventTimerTask(time.Duration(retentionInDays) * time.Hour * 24), nil
+	return timerTasks, nil
 }
 
 func (b *stateBuilderImpl) appendTasksForFinishedExecutions(
@@ -768,11 +784,11 @@ func (b *stateBuilderImpl) appendTasksForFinishedExecutions(
 	workflowID string,
 ) error {
 	b.transferTasks = append(b.transferTasks, b.scheduleDeleteHistoryTransferTask())
-	timerTask, err := b.scheduleDeleteHistoryTimerTask(event, domainID, workflowID)
+	timerTasks, err := b.scheduleDeleteHistoryTimerTasks(event, domainID, workflowID)
 	if err != nil {
 		return err
 	}
-	b.timerTasks = append(b.timerTasks, timerTask)
+	b.timerTasks = append(b.timerTasks, timerTasks...)
 	return nil
 }
 
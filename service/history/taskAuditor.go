@@ -0,0 +1,249 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// TaskAuditor keeps track of the transfer and timer tasks persisted by the history service and of the
+	// tasks acked by the queue processors. It is meant for integration tests only, to verify that no task
+	// is lost across shard movements.
+	TaskAuditor struct {
+		sync.Mutex
+		tasks map[taskAuditKey]*taskAuditRecord
+	}
+
+	taskAuditKey struct {
+		shardID int
+		taskID  int64
+	}
+
+	taskAuditRecord struct {
+		category            string
+		taskType            int
+		visibilityTimestamp time.Time
+		acks                int
+	}
+
+	// auditingExecutionManager records the tasks of successful workflow execution writes with the task auditor
+	auditingExecutionManager struct {
+		persistence.ExecutionManager
+		auditor *TaskAuditor
+	}
+)
+
+const (
+	taskAuditCategoryTransfer = "transfer"
+	taskAuditCategoryTimer    = "timer"
+	maxReportedLostTasks      = 10
+)
+
+// NewTaskAuditor creates a new task auditor, set it on the history config to enable the task auditing
+func NewTaskAuditor() *TaskAuditor {
+	return &TaskAuditor{
+		tasks: make(map[taskAuditKey]*taskAuditRecord),
+	}
+}
+
+// Verify returns an error if any of the persisted tasks due before the given time has not been acked
+func (a *TaskAuditor) Verify(dueBefore time.Time) error {
+	a.Lock()
+	defer a.Unlock()
+
+	var lost []taskAuditKey
+	for key, record := range a.tasks {
+		if record.acks == 0 && record.visibilityTimestamp.Before(dueBefore) {
+			lost = append(lost, key)
+		}
+	}
+	if len(lost) == 0 {
+		return nil
+	}
+
+	sort.Slice(lost, func(i, j int) bool {
+		if lost[i].shardID != lost[j].shardID {
+			return lost[i].shardID < lost[j].shardID
+		}
+		return lost[i].taskID < lost[j].taskID
+	})
+	details := ""
+	for i, key := range lost {
+		if i == maxReportedLostTasks {
+			details += ", ..."
+			break
+		}
+		if i > 0 {
+			details += ", "
+		}
+		record := a.tasks[key]
+		details += fmt.Sprintf("%v task %v of type %v on shard %v", record.category, key.taskID, record.taskType, key.shardID)
+	}
+	return fmt.Errorf("%v tasks are not acked: %v", len(lost), details)
+}
+
+// RedeliveredCount returns the number of persisted tasks acked more than once. Redelivery is expected
+// after shard movements, as ack levels are persisted periodically.
+func (a *TaskAuditor) RedeliveredCount() int {
+	a.Lock()
+	defer a.Unlock()
+
+	count := 0
+	for _, record := range a.tasks {
+		if record.acks > 1 {
+			count++
+		}
+	}
+	return count
+}
+
+func (a *TaskAuditor) recordPersisted(shardID int, category string, tasks []persistence.Task) {
+	if len(tasks) == 0 {
+		return
+	}
+
+	a.Lock()
+	defer a.Unlock()
+
+	for _, task := range tasks {
+		key := taskAuditKey{shardID: shardID, taskID: task.GetTaskID()}
+		if _, ok := a.tasks[key]; !ok {
+			a.tasks[key] = &taskAuditRecord{
+				category:            category,
+				taskType:            task.GetType(),
+				visibilityTimestamp: task.GetVisibilityTimestamp(),
+			}
+		}
+	}
+}
+
+func (a *TaskAuditor) recordAcked(shardID int, task queueTaskInfo) {
+	switch task.(type) {
+	case *persistence.TransferTaskInfo, *persistence.TimerTaskInfo:
+	default:
+		return
+	}
+
+	a.Lock()
+	defer a.Unlock()
+
+	key := taskAuditKey{shardID: shardID, taskID: task.GetTaskID()}
+	record, ok := a.tasks[key]
+	if !ok {
+		// the write of the task failed with an unknown outcome, but made it to persistence
+		record = &taskAuditRecord{taskType: task.GetTaskType()}
+		a.tasks[key] = record
+	}
+	record.acks++
+}
+
+func (a *TaskAuditor) recordSnapshot(shardID int, snapshot *persistence.WorkflowSnapshot) {
+	a.recordPersisted(shardID, taskAuditCategoryTransfer, snapshot.TransferTasks)
+	a.recordPersisted(shardID, taskAuditCategoryTimer, snapshot.TimerTasks)
+}
+
+func (a *TaskAuditor) recordMutation(shardID int, mutation *persistence.WorkflowMutation) {
+	a.recordPersisted(shardID, taskAuditCategoryTransfer, mutation.TransferTasks)
+	a.recordPersisted(shardID, taskAuditCategoryTimer, mutation.TimerTasks)
+}
+
+func (a *TaskAuditor) recordUpdate(shardID int, request *persistence.UpdateWorkflowExecutionRequest) {
+	a.recordMutation(shardID, &request.UpdateWorkflowMutation)
+	if request.NewWorkflowSnapshot != nil {
+		a.recordSnapshot(shardID, request.NewWorkflowSnapshot)
+	}
+}
+
+func (a *TaskAuditor) newExecutionManager(executionMgr persistence.ExecutionManager) persistence.ExecutionManager {
+	return &auditingExecutionManager{
+		ExecutionManager: executionMgr,
+		auditor:          a,
+	}
+}
+
+func (m *auditingExecutionManager) CreateWorkflowExecution(
+	request *persistence.CreateWorkflowExecutionRequest,
+) (*persistence.CreateWorkflowExecutionResponse, error) {
+
+	resp, err := m.ExecutionManager.CreateWorkflowExecution(request)
+	if err == nil {
+		m.auditor.recordSnapshot(m.GetShardID(), &request.NewWorkflowSnapshot)
+	}
+	return resp, err
+}
+
+func (m *auditingExecutionManager) UpdateWorkflowExecution(
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+
+	resp, err := m.ExecutionManager.UpdateWorkflowExecution(request)
+	if err == nil {
+		m.auditor.recordUpdate(m.GetShardID(), request)
+	}
+	return resp, err
+}
+
+func (m *auditingExecutionManager) UpdateWorkflowExecutionBatch(
+	request *persistence.UpdateWorkflowExecutionBatchRequest,
+) (*persistence.UpdateWorkflowExecutionBatchResponse, error) {
+
+	resp, err := m.ExecutionManager.UpdateWorkflowExecutionBatch(request)
+	if err == nil {
+		for _, updateRequest := range request.Requests {
+			m.auditor.recordUpdate(m.GetShardID(), updateRequest)
+		}
+	}
+	return resp, err
+}
+
+func (m *auditingExecutionManager) ConflictResolveWorkflowExecution(
+	request *persistence.ConflictResolveWorkflowExecutionRequest,
+) error {
+
+	err := m.ExecutionManager.ConflictResolveWorkflowExecution(request)
+	if err == nil {
+		m.auditor.recordSnapshot(m.GetShardID(), &request.ResetWorkflowSnapshot)
+		if request.CurrentWorkflowMutation != nil {
+			m.auditor.recordMutation(m.GetShardID(), request.CurrentWorkflowMutation)
+		}
+	}
+	return err
+}
+
+func (m *auditingExecutionManager) ResetWorkflowExecution(
+	request *persistence.ResetWorkflowExecutionRequest,
+) error {
+
+	err := m.ExecutionManager.ResetWorkflowExecution(request)
+	if err == nil {
+		m.auditor.recordSnapshot(m.GetShardID(), &request.NewWorkflowSnapshot)
+		if request.CurrentWorkflowMutation != nil {
+			m.auditor.recordMutation(m.GetShardID(), request.CurrentWorkflowMutation)
+		}
+	}
+	return err
+}
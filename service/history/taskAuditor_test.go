@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	taskAuditorSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestTaskAuditorSuite(t *testing.T) {
+	s := new(taskAuditorSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskAuditorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *taskAuditorSuite) TestVerify() {
+	now := time.Now()
+	auditor := NewTaskAuditor()
+	auditor.recordSnapshot(1, &persistence.WorkflowSnapshot{
+		TransferTasks: []persistence.Task{
+			&persistence.DecisionTask{TaskID: 10, VisibilityTimestamp: now},
+		},
+		TimerTasks: []persistence.Task{
+			&persistence.UserTimerTask{TaskID: 11, VisibilityTimestamp: now.Add(time.Hour)},
+		},
+	})
+	auditor.recordMutation(2, &persistence.WorkflowMutation{
+		TransferTasks: []persistence.Task{
+			&persistence.ActivityTask{TaskID: 10, VisibilityTimestamp: now},
+		},
+	})
+
+	err := auditor.Verify(now.Add(time.Second))
+	s.Error(err)
+	s.Contains(err.Error(), "2 tasks are not acked")
+
+	auditor.recordAcked(1, &persistence.TransferTaskInfo{TaskID: 10})
+	auditor.recordAcked(2, &persistence.TransferTaskInfo{TaskID: 10})
+	auditor.recordAcked(2, &persistence.TransferTaskInfo{TaskID: 10})
+	// replication tasks are not audited
+	auditor.recordAcked(2, &persistence.ReplicationTaskInfo{TaskID: 12})
+	s.NoError(auditor.Verify(now.Add(time.Second)))
+	s.Equal(1, auditor.RedeliveredCount())
+
+	err = auditor.Verify(now.Add(2 * time.Hour))
+	s.Error(err)
+	s.Contains(err.Error(), "timer task 11")
+}
+
+func (s *taskAuditorSuite) TestExecutionManager() {
+	executionMgr := &mocks.ExecutionManager{}
+	auditor := NewTaskAuditor()
+	auditingMgr := auditor.newExecutionManager(executionMgr)
+
+	request := &persistence.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			TransferTasks: []persistence.Task{&persistence.DecisionTask{TaskID: 10}},
+		},
+	}
+	executionMgr.On("GetShardID").Return(1)
+	executionMgr.On("UpdateWorkflowExecution", request).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+	_, err := auditingMgr.UpdateWorkflowExecution(request)
+	s.NoError(err)
+	s.Error(auditor.Verify(time.Now()))
+
+	auditor.recordAcked(1, &persistence.TransferTaskInfo{TaskID: 10})
+	s.NoError(auditor.Verify(time.Now()))
+}
@@ -25,6 +25,7 @@ import (
 	"sync"
 	"time"
 
+	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
@@ -36,6 +37,14 @@ import (
 	"github.com/uber/cadence/common/persistence"
 )
 
+var (
+	taskDispatchRateLimitedRetryDelay = 200 * time.Millisecond
+	taskDispatchDrainedRetryDelay     = 10 * time.Second
+	taskDispatchNoPollersRetryDelay   = time.Second
+
+	parkedTaskCheckInterval = 5 * time.Second
+)
+
 type (
 	taskProcessorOptions struct {
 		queueSize   int
@@ -64,6 +73,15 @@ type (
 		workerNotificationChans []chan struct{}
 		// duplicate numOfWorker from config.TimerTaskWorkerCount for dynamic config works correctly
 		numOfWorker int
+
+		// domainID -> tasks held back until processing of the domain is resumed
+		parkedTasksLock sync.Mutex
+		parkedTasks     map[string][]*taskInfo
+
+		// tasks whose dispatch delay has elapsed, waiting to be re-submitted
+		delayedTasksLock   sync.Mutex
+		delayedTasks       []*taskInfo
+		delayedTasksNotify chan struct{}
 	}
 )
 
@@ -93,6 +111,8 @@ func newTaskProcessor(
 		workerNotificationChans: workerNotificationChans,
 		retryPolicy:             common.CreatePersistanceRetryPolicy(),
 		numOfWorker:             options.workerCount,
+		parkedTasks:             make(map[string][]*taskInfo),
+		delayedTasksNotify:      make(chan struct{}, 1),
 	}
 
 	return base
@@ -104,11 +124,15 @@ func (t *taskProcessor) start() {
 		notificationChan := t.workerNotificationChans[i]
 		go t.taskWorker(notificationChan)
 	}
+	t.shutdownWG.Add(1)
+	go t.parkedTaskPump()
 	t.logger.Info("Timer queue task processor started.")
 }
 
 func (t *taskProcessor) stop() {
 	close(t.shutdownCh)
+	// parked and delayed tasks are re-submitted by the pump, it has to exit before the tasks channel is closed
+	t.shutdownWG.Wait()
 	close(t.tasksCh)
 	if success := common.AwaitWaitGroup(&t.workerWG, time.Minute); !success {
 		t.logger.Warn("Timer queue task processor timedout on shutdown.")
@@ -195,6 +219,12 @@ FilterLoop:
 		return t.handleTaskError(scope, startTime, notificationChan, err, logger)
 	}
 	retryCondition := func(err error) bool {
+		if err == ErrDomainProcessingPaused {
+			return false
+		}
+		if _, ok := err.(*m.TaskDispatchFailedError); ok {
+			return false
+		}
 		select {
 		case <-t.shutdownCh:
 			return false
@@ -214,11 +244,116 @@ FilterLoop:
 				t.ackTaskOnce(task, scope, shouldProcessTask, startTime, attempt)
 				return
 			}
+			// a paused domain is not a processing failure, the task is parked instead of holding on
+			// to the worker and is processed again once processing of the domain is resumed
+			if err == ErrDomainProcessingPaused {
+				t.parkTask(task)
+				return
+			}
+			// matching refused the task, the task is re-submitted after a delay instead of
+			// holding on to the worker while matching recovers
+			if dispatchErr, ok := err.(*m.TaskDispatchFailedError); ok {
+				t.delayTask(task, getTaskDispatchRetryDelay(dispatchErr))
+				return
+			}
 			incAttempt()
 		}
 	}
 }
 
+func (t *taskProcessor) parkTask(
+	task *taskInfo,
+) {
+
+	t.parkedTasksLock.Lock()
+	defer t.parkedTasksLock.Unlock()
+
+	domainID := task.task.GetDomainID()
+	t.parkedTasks[domainID] = append(t.parkedTasks[domainID], task)
+	if parker, ok := task.processor.(taskParker); ok {
+		parker.park(task.task)
+	}
+}
+
+// unparkResumedTasks returns the parked tasks of the domains whose processing is resumed
+func (t *taskProcessor) unparkResumedTasks() []*taskInfo {
+	t.parkedTasksLock.Lock()
+	defer t.parkedTasksLock.Unlock()
+
+	var resumedTasks []*taskInfo
+	for domainID, tasks := range t.parkedTasks {
+		domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+		if err != nil {
+			// the domain is checked again on the next round
+			continue
+		}
+		if t.config.DomainProcessingPaused(domainEntry.GetInfo().Name) {
+			continue
+		}
+
+		delete(t.parkedTasks, domainID)
+		for _, task := range tasks {
+			if parker, ok := task.processor.(taskParker); ok {
+				parker.unpark(task.task)
+			}
+		}
+		resumedTasks = append(resumedTasks, tasks...)
+	}
+	return resumedTasks
+}
+
+// delayTask re-submits the task once the delay elapses
+func (t *taskProcessor) delayTask(
+	task *taskInfo,
+	delay time.Duration,
+) {
+
+	time.AfterFunc(delay, func() {
+		t.delayedTasksLock.Lock()
+		t.delayedTasks = append(t.delayedTasks, task)
+		t.delayedTasksLock.Unlock()
+
+		select {
+		case t.delayedTasksNotify <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// drainDelayedTasks returns the tasks whose delay has elapsed
+func (t *taskProcessor) drainDelayedTasks() []*taskInfo {
+	t.delayedTasksLock.Lock()
+	defer t.delayedTasksLock.Unlock()
+
+	tasks := t.delayedTasks
+	t.delayedTasks = nil
+	return tasks
+}
+
+func (t *taskProcessor) parkedTaskPump() {
+	defer t.shutdownWG.Done()
+
+	ticker := time.NewTicker(parkedTaskCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		var tasks []*taskInfo
+		select {
+		case <-t.shutdownCh:
+			return
+		case <-ticker.C:
+			tasks = t.unparkResumedTasks()
+		case <-t.delayedTasksNotify:
+			tasks = t.drainDelayedTasks()
+		}
+		for _, task := range tasks {
+			if shutdown := t.addTask(task); shutdown {
+				return
+			}
+		}
+	}
+}
+
 func (t *taskProcessor) processTaskOnce(
 	notificationChan <-chan struct{},
 	task *taskInfo,
@@ -254,6 +389,7 @@ func (t *taskProcessor) handleTaskError(
 	}
 
 	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		t.metricsClient.IncCounter(scope, metrics.TaskDropped)
 		return nil
 	}
 
@@ -264,6 +400,17 @@ func (t *taskProcessor) handleTaskError(
 		return err
 	}
 
+	if err == ErrDomainProcessingPaused {
+		t.metricsClient.IncCounter(scope, metrics.TaskDomainPausedCounter)
+		return err
+	}
+
+	// this is a transient error, the task is delayed by the caller
+	if dispatchErr, ok := err.(*m.TaskDispatchFailedError); ok {
+		t.metricsClient.IncCounter(scope, getTaskDispatchFailedCounter(dispatchErr))
+		return err
+	}
+
 	if err == ErrTaskDiscarded {
 		t.metricsClient.IncCounter(scope, metrics.TaskDiscarded)
 		err = nil
@@ -273,6 +420,7 @@ func (t *taskProcessor) handleTaskError(
 	if _, ok := err.(*workflow.DomainNotActiveError); ok {
 		if t.timeSource.Now().Sub(startTime) > cache.DomainCacheRefreshInterval {
 			t.metricsClient.IncCounter(scope, metrics.TaskNotActiveCounter)
+			t.metricsClient.IncCounter(scope, metrics.TaskDropped)
 			return nil
 		}
 
@@ -283,6 +431,7 @@ func (t *taskProcessor) handleTaskError(
 
 	if _, ok := err.(*persistence.CurrentWorkflowConditionFailedError); ok {
 		logger.Error("More than 2 workflow are running.", tag.Error(err), tag.LifeCycleProcessingFailed)
+		t.metricsClient.IncCounter(scope, metrics.TaskDropped)
 		return nil
 	}
 
@@ -290,6 +439,36 @@ func (t *taskProcessor) handleTaskError(
 	return err
 }
 
+func getTaskDispatchFailedCounter(
+	err *m.TaskDispatchFailedError,
+) int {
+
+	switch err.GetReason() {
+	case m.TaskDispatchFailureReasonTaskListDrained:
+		return metrics.TaskDispatchDrainedCounter
+	case m.TaskDispatchFailureReasonNoPollers:
+		return metrics.TaskDispatchNoPollersCounter
+	default:
+		return metrics.TaskDispatchRateLimitedCounter
+	}
+}
+
+// getTaskDispatchRetryDelay returns how long a task matching failed to dispatch is held back,
+// the delay depends on how long the failure reason is expected to last
+func getTaskDispatchRetryDelay(
+	err *m.TaskDispatchFailedError,
+) time.Duration {
+
+	switch err.GetReason() {
+	case m.TaskDispatchFailureReasonTaskListDrained:
+		return taskDispatchDrainedRetryDelay
+	case m.TaskDispatchFailureReasonNoPollers:
+		return taskDispatchNoPollersRetryDelay
+	default:
+		return taskDispatchRateLimitedRetryDelay
+	}
+}
+
 func (t *taskProcessor) ackTaskOnce(
 	task *taskInfo,
 	scope int,
@@ -299,6 +478,9 @@ func (t *taskProcessor) ackTaskOnce(
 ) {
 
 	task.processor.complete(task.task)
+	if t.config.TaskAuditor != nil {
+		t.config.TaskAuditor.recordAcked(t.shard.GetShardID(), task.task)
+	}
 	if reportMetrics {
 		t.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
 		t.metricsClient.RecordTimer(scope, metrics.TaskLatency, time.Since(startTime))
@@ -307,6 +489,8 @@ func (t *taskProcessor) ackTaskOnce(
 			metrics.TaskQueueLatency,
 			time.Since(task.task.GetVisibilityTimestamp()),
 		)
+	} else {
+		t.metricsClient.IncCounter(scope, metrics.TaskSkipped)
 	}
 }
 
@@ -27,7 +27,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -198,6 +200,62 @@ func (s *taskProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessErrNoErr()
 	)
 }
 
+func (s *taskProcessorSuite) TestProcessTaskAndAck_DomainPaused_Parked() {
+	task := &persistence.TimerTaskInfo{DomainID: "some random domain ID", TaskID: 12345, VisibilityTimestamp: time.Now()}
+	var taskFilter queueTaskFilter = func(timer queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
+	s.mockProcessor.On("process", task, true).Return(s.scope, ErrDomainProcessingPaused).Once()
+	s.mockProcessor.On("park", task).Once()
+	s.taskProcessor.processTaskAndAck(
+		s.notificationChan,
+		&taskInfo{
+			processor: s.mockProcessor,
+			task:      task,
+		},
+	)
+	s.Len(s.taskProcessor.parkedTasks[task.DomainID], 1)
+}
+
+func (s *taskProcessorSuite) TestUnparkResumedTasks() {
+	pausedDomainID := "paused domain ID"
+	resumedDomainID := "resumed domain ID"
+	domainCache := &cache.DomainCacheMock{}
+	defer domainCache.AssertExpectations(s.T())
+	s.mockShard.(*shardContextImpl).domainCache = domainCache
+	for _, domainID := range []string{pausedDomainID, resumedDomainID} {
+		domainCache.On("GetDomainByID", domainID).Return(cache.NewLocalDomainCacheEntryForTest(
+			&persistence.DomainInfo{ID: domainID, Name: domainID + " name"},
+			&persistence.DomainConfig{Retention: 1},
+			cluster.TestCurrentClusterName,
+			nil,
+		), nil)
+	}
+	s.taskProcessor.config.DomainProcessingPaused = func(domain string) bool {
+		return domain == pausedDomainID+" name"
+	}
+
+	pausedTask := &taskInfo{
+		processor: s.mockProcessor,
+		task:      &persistence.TimerTaskInfo{DomainID: pausedDomainID, TaskID: 1},
+	}
+	resumedTask := &taskInfo{
+		processor: s.mockProcessor,
+		task:      &persistence.TimerTaskInfo{DomainID: resumedDomainID, TaskID: 2},
+	}
+	s.mockProcessor.On("park", pausedTask.task).Once()
+	s.mockProcessor.On("park", resumedTask.task).Once()
+	s.taskProcessor.parkTask(pausedTask)
+	s.taskProcessor.parkTask(resumedTask)
+
+	s.mockProcessor.On("unpark", resumedTask.task).Once()
+	s.Equal([]*taskInfo{resumedTask}, s.taskProcessor.unparkResumedTasks())
+	s.Len(s.taskProcessor.parkedTasks, 1)
+	s.Len(s.taskProcessor.parkedTasks[pausedDomainID], 1)
+	s.Empty(s.taskProcessor.unparkResumedTasks())
+}
+
 func (s *taskProcessorSuite) TestHandleTaskError_EntityNotExists() {
 	err := &workflow.EntityNotExistsError{}
 	s.Nil(s.taskProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
@@ -234,6 +292,54 @@ func (s *taskProcessorSuite) TestHandleTaskError_DomainNotActiveError() {
 	s.Equal(err, s.taskProcessor.handleTaskError(s.scope, startTime, s.notificationChan, err, s.logger))
 }
 
+func (s *taskProcessorSuite) TestHandleTaskError_TaskDispatchFailedError() {
+	err := &m.TaskDispatchFailedError{
+		Message: common.StringPtr("Matching host rps exceeded"),
+		Reason:  m.TaskDispatchFailureReasonRateLimited.Ptr(),
+	}
+	s.Equal(err, s.taskProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
+}
+
+func (s *taskProcessorSuite) TestProcessTaskAndAck_TaskDispatchFailed_Delayed() {
+	task := &persistence.TimerTaskInfo{TaskID: 12345, VisibilityTimestamp: time.Now()}
+	var taskFilter queueTaskFilter = func(timer queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+	err := &m.TaskDispatchFailedError{
+		Message: common.StringPtr("Matching host rps exceeded"),
+		Reason:  m.TaskDispatchFailureReasonRateLimited.Ptr(),
+	}
+	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
+	s.mockProcessor.On("process", task, true).Return(s.scope, err).Once()
+	s.taskProcessor.processTaskAndAck(
+		s.notificationChan,
+		&taskInfo{
+			processor: s.mockProcessor,
+			task:      task,
+		},
+	)
+
+	// the task is neither acked nor retried by the worker, it shows up once the delay elapses
+	select {
+	case <-s.taskProcessor.delayedTasksNotify:
+	case <-time.After(10 * taskDispatchRateLimitedRetryDelay):
+		s.Fail("task is not re-submitted after the dispatch delay")
+	}
+	delayedTasks := s.taskProcessor.drainDelayedTasks()
+	s.Len(delayedTasks, 1)
+	s.Equal(task, delayedTasks[0].task)
+	s.Empty(s.taskProcessor.drainDelayedTasks())
+}
+
+func (s *taskProcessorSuite) TestGetTaskDispatchRetryDelay() {
+	err := &m.TaskDispatchFailedError{Reason: m.TaskDispatchFailureReasonRateLimited.Ptr()}
+	s.Equal(taskDispatchRateLimitedRetryDelay, getTaskDispatchRetryDelay(err))
+	err.Reason = m.TaskDispatchFailureReasonNoPollers.Ptr()
+	s.Equal(taskDispatchNoPollersRetryDelay, getTaskDispatchRetryDelay(err))
+	err.Reason = m.TaskDispatchFailureReasonTaskListDrained.Ptr()
+	s.Equal(taskDispatchDrainedRetryDelay, getTaskDispatchRetryDelay(err))
+}
+
 func (s *taskProcessorSuite) TestHandleTaskError_CurrentWorkflowConditionFailedError() {
 	err := &persistence.CurrentWorkflowConditionFailedError{}
 	s.Nil(s.taskProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
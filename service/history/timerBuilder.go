@@ -38,6 +38,9 @@ import (
 const (
 	TimerTaskStatusNone = iota
 	TimerTaskStatusCreated
+	// TimerTaskStatusCoalesced indicates the timer is covered by an earlier timer task
+	// of the same workflow, which creates the timer task for it when fired
+	TimerTaskStatusCoalesced
 )
 
 // Activity Timer task status
@@ -46,6 +49,10 @@ const (
 	TimerTaskStatusCreatedScheduleToStart
 	TimerTaskStatusCreatedScheduleToClose
 	TimerTaskStatusCreatedHeartbeat
+	TimerTaskStatusCoalescedStartToClose
+	TimerTaskStatusCoalescedScheduleToStart
+	TimerTaskStatusCoalescedScheduleToClose
+	TimerTaskStatusCoalescedHeartbeat
 )
 
 type (
@@ -186,7 +193,7 @@ func (tb *timerBuilder) AddUserTimer(ti *persistence.TimerInfo, msBuilder mutabl
 	timer := &timerDetails{
 		TimerSequenceID: TimerSequenceID{VisibilityTimestamp: ti.ExpiryTime, TaskID: seqNum},
 		TimerID:         ti.TimerID,
-		TaskCreated:     ti.TaskID == TimerTaskStatusCreated}
+		TaskCreated:     isUserTimerTaskCreated(ti)}
 	tb.insertTimer(timer)
 	tb.logger.Debug(fmt.Sprintf("Added User Timeout for timer ID: %s", ti.TimerID))
 }
@@ -265,7 +272,7 @@ func (tb *timerBuilder) loadUserTimers(msBuilder mutableState) {
 			TimerSequenceID: TimerSequenceID{VisibilityTimestamp: v.ExpiryTime, TaskID: seqNum},
 			EventID:         v.StartedID,
 			TimerID:         v.TimerID,
-			TaskCreated:     v.TaskID == TimerTaskStatusCreated}
+			TaskCreated:     isUserTimerTaskCreated(v)}
 		tb.userTimers = append(tb.userTimers, td)
 	}
 	sort.Sort(tb.userTimers)
@@ -276,65 +283,72 @@ func (tb *timerBuilder) loadActivityTimers(msBuilder mutableState) {
 	tb.pendingActivityTimers = msBuilder.GetPendingActivityInfos()
 	tb.activityTimers = make(timers, 0, len(tb.pendingActivityTimers))
 	for _, v := range tb.pendingActivityTimers {
-		if v.ScheduleID != common.EmptyEventID {
-			scheduleToCloseExpiry := v.ScheduledTime.Add(time.Duration(v.ScheduleToCloseTimeout) * time.Second)
-			if !v.ExpirationTime.IsZero() && v.ExpirationTime.Before(scheduleToCloseExpiry) {
-				// expire before scheduleToClose timeout
-				scheduleToCloseExpiry = v.ExpirationTime
-			}
+		tb.activityTimers = append(tb.activityTimers, getActivityTimers(v)...)
+	}
+	sort.Sort(tb.activityTimers)
+	tb.isLoadedActivityTimers = true
+}
+
+// getActivityTimers returns the pending timeouts of an activity
+func getActivityTimers(ai *persistence.ActivityInfo) timers {
+	var activityTimers timers
+	if ai.ScheduleID != common.EmptyEventID {
+		scheduleToCloseExpiry := ai.ScheduledTime.Add(time.Duration(ai.ScheduleToCloseTimeout) * time.Second)
+		if !ai.ExpirationTime.IsZero() && ai.ExpirationTime.Before(scheduleToCloseExpiry) {
+			// expire before scheduleToClose timeout
+			scheduleToCloseExpiry = ai.ExpirationTime
+		}
+		td := &timerDetails{
+			TimerSequenceID: TimerSequenceID{VisibilityTimestamp: scheduleToCloseExpiry},
+			ActivityID:      ai.ScheduleID,
+			EventID:         ai.ScheduleID,
+			Attempt:         ai.Attempt,
+			TimeoutSec:      ai.ScheduleToCloseTimeout,
+			TimeoutType:     w.TimeoutTypeScheduleToClose,
+			TaskCreated:     (ai.TimerTaskStatus & (TimerTaskStatusCreatedScheduleToClose | TimerTaskStatusCoalescedScheduleToClose)) != 0}
+		activityTimers = append(activityTimers, td)
+
+		if ai.StartedID != common.EmptyEventID {
+			startToCloseExpiry := ai.StartedTime.Add(time.Duration(ai.StartToCloseTimeout) * time.Second)
 			td := &timerDetails{
-				TimerSequenceID: TimerSequenceID{VisibilityTimestamp: scheduleToCloseExpiry},
-				ActivityID:      v.ScheduleID,
-				EventID:         v.ScheduleID,
-				Attempt:         v.Attempt,
-				TimeoutSec:      v.ScheduleToCloseTimeout,
-				TimeoutType:     w.TimeoutTypeScheduleToClose,
-				TaskCreated:     (v.TimerTaskStatus & TimerTaskStatusCreatedScheduleToClose) != 0}
-			tb.activityTimers = append(tb.activityTimers, td)
-
-			if v.StartedID != common.EmptyEventID {
-				startToCloseExpiry := v.StartedTime.Add(time.Duration(v.StartToCloseTimeout) * time.Second)
-				td := &timerDetails{
-					TimerSequenceID: TimerSequenceID{VisibilityTimestamp: startToCloseExpiry},
-					ActivityID:      v.ScheduleID,
-					EventID:         v.ScheduleID,
-					Attempt:         v.Attempt,
-					TimeoutType:     w.TimeoutTypeStartToClose,
-					TimeoutSec:      v.StartToCloseTimeout,
-					TaskCreated:     (v.TimerTaskStatus & TimerTaskStatusCreatedStartToClose) != 0}
-				tb.activityTimers = append(tb.activityTimers, td)
-				if v.HeartbeatTimeout > 0 {
-					lastHeartBeatTS := v.LastHeartBeatUpdatedTime
-					if lastHeartBeatTS.Before(v.StartedTime) {
-						lastHeartBeatTS = v.StartedTime
-					}
-					heartBeatExpiry := lastHeartBeatTS.Add(time.Duration(v.HeartbeatTimeout) * time.Second)
-					td := &timerDetails{
-						TimerSequenceID: TimerSequenceID{VisibilityTimestamp: heartBeatExpiry},
-						ActivityID:      v.ScheduleID,
-						EventID:         v.ScheduleID,
-						Attempt:         v.Attempt,
-						TimeoutType:     w.TimeoutTypeHeartbeat,
-						TimeoutSec:      v.HeartbeatTimeout,
-						TaskCreated:     (v.TimerTaskStatus & TimerTaskStatusCreatedHeartbeat) != 0}
-					tb.activityTimers = append(tb.activityTimers, td)
+				TimerSequenceID: TimerSequenceID{VisibilityTimestamp: startToCloseExpiry},
+				ActivityID:      ai.ScheduleID,
+				EventID:         ai.ScheduleID,
+				Attempt:         ai.Attempt,
+				TimeoutType:     w.TimeoutTypeStartToClose,
+				TimeoutSec:      ai.StartToCloseTimeout,
+				TaskCreated:     (ai.TimerTaskStatus & (TimerTaskStatusCreatedStartToClose | TimerTaskStatusCoalescedStartToClose)) != 0}
+			activityTimers = append(activityTimers, td)
+			if ai.HeartbeatTimeout > 0 {
+				lastHeartBeatTS := ai.LastHeartBeatUpdatedTime
+				if lastHeartBeatTS.Before(ai.StartedTime) {
+					lastHeartBeatTS = ai.StartedTime
 				}
-			} else {
-				scheduleToStartExpiry := v.ScheduledTime.Add(time.Duration(v.ScheduleToStartTimeout) * time.Second)
+				heartBeatExpiry := lastHeartBeatTS.Add(time.Duration(ai.HeartbeatTimeout) * time.Second)
 				td := &timerDetails{
-					TimerSequenceID: TimerSequenceID{VisibilityTimestamp: scheduleToStartExpiry},
-					ActivityID:      v.ScheduleID,
-					EventID:         v.ScheduleID,
-					Attempt:         v.Attempt,
-					TimeoutSec:      v.ScheduleToStartTimeout,
-					TimeoutType:     w.TimeoutTypeScheduleToStart,
-					TaskCreated:     (v.TimerTaskStatus & TimerTaskStatusCreatedScheduleToStart) != 0}
-				tb.activityTimers = append(tb.activityTimers, td)
+					TimerSequenceID: TimerSequenceID{VisibilityTimestamp: heartBeatExpiry},
+					ActivityID:      ai.ScheduleID,
+					EventID:         ai.ScheduleID,
+					Attempt:         ai.Attempt,
+					TimeoutType:     w.TimeoutTypeHeartbeat,
+					TimeoutSec:      ai.HeartbeatTimeout,
+					TaskCreated:     (ai.TimerTaskStatus & (TimerTaskStatusCreatedHeartbeat | TimerTaskStatusCoalescedHeartbeat)) != 0}
+				activityTimers = append(activityTimers, td)
 			}
+		} else {
+			scheduleToStartExpiry := ai.ScheduledTime.Add(time.Duration(ai.ScheduleToStartTimeout) * time.Second)
+			td := &timerDetails{
+				TimerSequenceID: TimerSequenceID{VisibilityTimestamp: scheduleToStartExpiry},
+				ActivityID:      ai.ScheduleID,
+				EventID:         ai.ScheduleID,
+				Attempt:         ai.Attempt,
+				TimeoutSec:      ai.ScheduleToStartTimeout,
+				TimeoutType:     w.TimeoutTypeScheduleToStart,
+				TaskCreated:     (ai.TimerTaskStatus & (TimerTaskStatusCreatedScheduleToStart | TimerTaskStatusCoalescedScheduleToStart)) != 0}
+			activityTimers = append(activityTimers, td)
 		}
 	}
-	sort.Sort(tb.activityTimers)
-	tb.isLoadedActivityTimers = true
+	return activityTimers
 }
 
 func (tb *timerBuilder) createDeleteHistoryEventTimerTask(d time.Duration) *persistence.DeleteHistoryEventTask {
@@ -344,6 +358,13 @@ func (tb *timerBuilder) createDeleteHistoryEventTimerTask(d time.Duration) *pers
 	}
 }
 
+func (tb *timerBuilder) createDeleteVisibilityTimerTask(d time.Duration) *persistence.DeleteVisibilityTask {
+	expiryTime := tb.timeSource.Now().Add(d)
+	return &persistence.DeleteVisibilityTask{
+		VisibilityTimestamp: expiryTime,
+	}
+}
+
 // createDecisionTimeoutTask - Creates a decision timeout task.
 func (tb *timerBuilder) createDecisionTimeoutTask(fireTimeOut int32, eventID, attempt int64,
 	timeoutType w.TimeoutType) *persistence.DecisionTimeoutTask {
@@ -443,6 +464,18 @@ func compareTimerIDLess(first *TimerSequenceID, second *TimerSequenceID) bool {
 	return false
 }
 
+// isUserTimerTaskCreated returns whether the user timer is covered by an outstanding timer task
+func isUserTimerTaskCreated(ti *persistence.TimerInfo) bool {
+	return ti.TaskID == TimerTaskStatusCreated || ti.TaskID == TimerTaskStatusCoalesced
+}
+
+func earliestTime(first time.Time, second time.Time) time.Time {
+	if first.IsZero() || second.Before(first) {
+		return second
+	}
+	return first
+}
+
 func getActivityTimerStatus(timeoutType w.TimeoutType) int32 {
 	switch timeoutType {
 	case w.TimeoutTypeHeartbeat:
@@ -456,3 +489,17 @@ func getActivityTimerStatus(timeoutType w.TimeoutType) int32 {
 	}
 	panic("invalid timeout type")
 }
+
+func getActivityTimerCoalescedStatus(timeoutType w.TimeoutType) int32 {
+	switch timeoutType {
+	case w.TimeoutTypeHeartbeat:
+		return TimerTaskStatusCoalescedHeartbeat
+	case w.TimeoutTypeScheduleToStart:
+		return TimerTaskStatusCoalescedScheduleToStart
+	case w.TimeoutTypeScheduleToClose:
+		return TimerTaskStatusCoalescedScheduleToClose
+	case w.TimeoutTypeStartToClose:
+		return TimerTaskStatusCoalescedStartToClose
+	}
+	panic("invalid timeout type")
+}
@@ -79,7 +79,7 @@ func (s *timerBuilderProcessorSuite) SetupTest() {
 		timeSource:                clock.NewRealTimeSource(),
 	}
 	s.mockEventsCache = &MockEventsCache{}
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 }
 
 func (s *timerBuilderProcessorSuite) TestTimerBuilderSingleUserTimer() {
@@ -62,7 +62,9 @@ type (
 		sync.Mutex
 		// outstanding timer task -> finished (true)
 		outstandingTasks map[TimerSequenceID]bool
-		// timer task ack level
+		// outstanding timer tasks held back until processing of their domain is resumed
+		parkedTasks map[TimerSequenceID]struct{}
+		// timer task ack level, it may be ahead of outstanding tasks which were parked
 		ackLevel TimerSequenceID
 		// timer task read level, used by failover
 		readLevel TimerSequenceID
@@ -70,6 +72,13 @@ type (
 		minQueryLevel time.Time
 		maxQueryLevel time.Time
 		pageToken     []byte
+		// timer tasks loaded ahead of their fire time, only when not in failover mode
+		timerWheel *timerWheel
+		// all timer tasks before the horizon are either in the timer wheel or loaded,
+		// unless a new timer task before the horizon is created after the timer wheel is filled
+		timerWheelHorizon       time.Time
+		timerWheelStale         bool
+		timerWheelLookAheadTask *persistence.TimerTaskInfo
 
 		clusterName string
 	}
@@ -122,6 +131,7 @@ func newTimerQueueAckMgr(
 		updateTimerAckLevel: updateTimerAckLevel,
 		timerQueueShutdown:  func() error { return nil },
 		outstandingTasks:    make(map[TimerSequenceID]bool),
+		parkedTasks:         make(map[TimerSequenceID]struct{}),
 		ackLevel:            ackLevel,
 		readLevel:           ackLevel,
 		minQueryLevel:       ackLevel.VisibilityTimestamp,
@@ -130,6 +140,8 @@ func newTimerQueueAckMgr(
 		isReadFinished:      false,
 		finishedChan:        nil,
 		clusterName:         clusterName,
+		timerWheel:          newTimerWheel(shard.GetConfig().TimerProcessorTimerWheelTick(), shard.GetTimeSource().Now()),
+		timerWheelStale:     true,
 	}
 
 	return timerQueueAckMgrImpl
@@ -160,6 +172,7 @@ func newTimerQueueFailoverAckMgr(
 		updateTimerAckLevel: updateTimerAckLevel,
 		timerQueueShutdown:  timerQueueShutdown,
 		outstandingTasks:    make(map[TimerSequenceID]bool),
+		parkedTasks:         make(map[TimerSequenceID]struct{}),
 		ackLevel:            ackLevel,
 		readLevel:           ackLevel,
 		minQueryLevel:       ackLevel.VisibilityTimestamp,
@@ -176,6 +189,53 @@ func (t *timerQueueAckMgrImpl) getFinishedChan() <-chan struct{} {
 	return t.finishedChan
 }
 
+// readTimerWheelTasks returns the due timer tasks loaded in the timer wheel, with the next timer task to wait on.
+// It returns false if the timer tasks have to be read from persistence instead.
+func (t *timerQueueAckMgrImpl) readTimerWheelTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, bool) {
+	if !t.isTimerWheelEnabled() {
+		return nil, nil, false
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.timeNow()
+	if t.timerWheelStale || len(t.pageToken) != 0 || !now.Before(t.timerWheelHorizon) {
+		return nil, nil, false
+	}
+
+	filteredTasks := []*persistence.TimerTaskInfo{}
+	for _, task := range t.timerWheel.advance(now) {
+		timerSequenceID := TimerSequenceID{VisibilityTimestamp: task.VisibilityTimestamp, TaskID: task.TaskID}
+		if _, isLoaded := t.outstandingTasks[timerSequenceID]; isLoaded {
+			continue
+		}
+		t.outstandingTasks[timerSequenceID] = false
+		filteredTasks = append(filteredTasks, task)
+	}
+	t.metricsClient.AddCounter(t.scope, metrics.TimerWheelFiredCounter, int64(len(filteredTasks)))
+
+	lookAheadTask := t.timerWheel.peek()
+	if lookAheadTask == nil {
+		lookAheadTask = t.timerWheelLookAheadTask
+	}
+	return filteredTasks, lookAheadTask, true
+}
+
+// notifyNewTimer marks the timer wheel as stale if the new timer task falls within the range it covers
+func (t *timerQueueAckMgrImpl) notifyNewTimer(newTime time.Time) {
+	if t.timerWheel == nil {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if newTime.Before(t.timerWheelHorizon) {
+		t.timerWheelStale = true
+	}
+}
+
 func (t *timerQueueAckMgrImpl) readTimerTasks() ([]*persistence.TimerTaskInfo, *persistence.TimerTaskInfo, bool, error) {
 	if t.maxQueryLevel == t.minQueryLevel {
 		t.maxQueryLevel = t.shard.UpdateTimerMaxReadLevel(t.clusterName)
@@ -246,7 +306,12 @@ TaskFilterLoop:
 	t.Unlock()
 
 	// only do lookahead when not in failover mode
-	if len(t.pageToken) == 0 && lookAheadTask == nil && !t.isFailover {
+	if len(t.pageToken) == 0 && t.isTimerWheelEnabled() {
+		lookAheadTask, err = t.fillTimerWheel(lookAheadTask)
+		if err != nil {
+			return filteredTasks, nil, true, nil
+		}
+	} else if len(t.pageToken) == 0 && lookAheadTask == nil && !t.isFailover {
 		lookAheadTask, err = t.readLookAheadTask()
 		if err != nil {
 			// NOTE do not return nil filtered task
@@ -265,7 +330,14 @@ TaskFilterLoop:
 
 // read lookAheadTask from s.GetTimerMaxReadLevel to poll interval from there.
 func (t *timerQueueAckMgrImpl) readLookAheadTask() (*persistence.TimerTaskInfo, error) {
-	minQueryLevel := t.maxQueryLevel
+	return t.readNextTimerTask(t.maxQueryLevel)
+}
+
+// read the first timer task from the given level
+func (t *timerQueueAckMgrImpl) readNextTimerTask(
+	minQueryLevel time.Time,
+) (*persistence.TimerTaskInfo, error) {
+
 	maxQueryLevel := maximumTime
 
 	var tasks []*persistence.TimerTaskInfo
@@ -280,12 +352,106 @@ func (t *timerQueueAckMgrImpl) readLookAheadTask() (*persistence.TimerTaskInfo,
 	return nil, nil
 }
 
+// fillTimerWheel loads the timer tasks within the look ahead window into the timer wheel,
+// and returns the next timer task to wait on
+func (t *timerQueueAckMgrImpl) fillTimerWheel(
+	lookAheadTask *persistence.TimerTaskInfo,
+) (*persistence.TimerTaskInfo, error) {
+
+	t.Lock()
+	minQueryLevel := t.maxQueryLevel
+	now := t.timeNow()
+	maxQueryLevel := now.Add(t.config.TimerProcessorLookAheadWindow())
+	// new timer tasks created from now on are notified, so the timer wheel can be refilled;
+	// the provisional horizon covers the whole range being read, so a new timer task created
+	// while the range is read marks the timer wheel as stale, even if the read missed it
+	t.timerWheelStale = false
+	t.timerWheel.reset(now)
+	t.timerWheelHorizon = maxQueryLevel
+	t.timerWheelLookAheadTask = nil
+	t.Unlock()
+
+	var tasks []*persistence.TimerTaskInfo
+	var pageToken []byte
+	var err error
+	if minQueryLevel.Before(maxQueryLevel) {
+		tasks, pageToken, err = t.getTimerTasks(minQueryLevel, maxQueryLevel, t.config.TimerTaskBatchSize(), nil)
+		if err != nil {
+			t.Lock()
+			t.timerWheelStale = true
+			t.Unlock()
+			return nil, err
+		}
+	}
+
+	horizon := maxQueryLevel
+	if len(pageToken) != 0 {
+		// tasks with the same timestamp as the last one may be on the next page
+		horizon = tasks[len(tasks)-1].VisibilityTimestamp
+	}
+
+	var nextTask *persistence.TimerTaskInfo
+	if len(pageToken) == 0 {
+		// the task after the horizon is not loaded into the timer wheel,
+		// but the timer gate waits on it to trigger the next read
+		nextTask, err = t.readNextTimerTask(horizon)
+		if err != nil {
+			t.Lock()
+			t.timerWheelStale = true
+			t.Unlock()
+			return nil, err
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	for _, task := range tasks {
+		t.timerWheel.add(task)
+	}
+	t.timerWheelHorizon = horizon
+	t.timerWheelLookAheadTask = nextTask
+
+	if next := t.timerWheel.peek(); next != nil {
+		nextTask = next
+	}
+	if lookAheadTask == nil || (nextTask != nil && nextTask.VisibilityTimestamp.Before(lookAheadTask.VisibilityTimestamp)) {
+		lookAheadTask = nextTask
+	}
+	return lookAheadTask, nil
+}
+
+func (t *timerQueueAckMgrImpl) isTimerWheelEnabled() bool {
+	return t.timerWheel != nil && t.config.TimerProcessorEnableTimerWheel()
+}
+
 func (t *timerQueueAckMgrImpl) completeTimerTask(timerTask *persistence.TimerTaskInfo) {
 	timerSequenceID := TimerSequenceID{VisibilityTimestamp: timerTask.VisibilityTimestamp, TaskID: timerTask.TaskID}
 	t.Lock()
 	defer t.Unlock()
 
 	t.outstandingTasks[timerSequenceID] = true
+	delete(t.parkedTasks, timerSequenceID)
+}
+
+// parkTimerTask lets the ack level move past a timer task which is held back until processing of its domain
+// is resumed, the ack level persisted does not move past the task until it is completed
+func (t *timerQueueAckMgrImpl) parkTimerTask(timerTask *persistence.TimerTaskInfo) {
+	timerSequenceID := TimerSequenceID{VisibilityTimestamp: timerTask.VisibilityTimestamp, TaskID: timerTask.TaskID}
+	t.Lock()
+	defer t.Unlock()
+
+	if acked, ok := t.outstandingTasks[timerSequenceID]; ok && !acked {
+		t.parkedTasks[timerSequenceID] = struct{}{}
+	}
+}
+
+func (t *timerQueueAckMgrImpl) unparkTimerTask(timerTask *persistence.TimerTaskInfo) {
+	timerSequenceID := TimerSequenceID{VisibilityTimestamp: timerTask.VisibilityTimestamp, TaskID: timerTask.TaskID}
+	t.Lock()
+	defer t.Unlock()
+
+	delete(t.parkedTasks, timerSequenceID)
 }
 
 func (t *timerQueueAckMgrImpl) getReadLevel() TimerSequenceID {
@@ -297,7 +463,19 @@ func (t *timerQueueAckMgrImpl) getReadLevel() TimerSequenceID {
 func (t *timerQueueAckMgrImpl) getAckLevel() TimerSequenceID {
 	t.Lock()
 	defer t.Unlock()
-	return t.ackLevel
+	return t.persistedAckLevelLocked()
+}
+
+// persistedAckLevelLocked returns the ack level which is safe to persist, it does not pass the outstanding
+// tasks the ack level moved past while they were parked, so that they are loaded again after a shard movement
+func (t *timerQueueAckMgrImpl) persistedAckLevelLocked() TimerSequenceID {
+	ackLevel := t.ackLevel
+	for timerSequenceID, acked := range t.outstandingTasks {
+		if !acked && compareTimerIDLess(&timerSequenceID, &ackLevel) {
+			ackLevel = TimerSequenceID{VisibilityTimestamp: timerSequenceID.VisibilityTimestamp}
+		}
+	}
+	return ackLevel
 }
 
 func (t *timerQueueAckMgrImpl) updateAckLevel() {
@@ -330,16 +508,24 @@ func (t *timerQueueAckMgrImpl) updateAckLevel() {
 
 MoveAckLevelLoop:
 	for _, current := range sequenceIDs {
+		if t.isTimerWheelEnabled() && !current.VisibilityTimestamp.Before(t.minQueryLevel) {
+			// timer tasks fired from the timer wheel may be ahead of the tasks not read yet
+			break MoveAckLevelLoop
+		}
 		acked := outstandingTasks[current]
 		if acked {
-			ackLevel = current
+			// tasks which were parked may complete after the ack level moved past them
+			if compareTimerIDLess(&ackLevel, &current) {
+				ackLevel = current
+			}
 			delete(outstandingTasks, current)
 			t.logger.Debug(fmt.Sprintf("Moving timer ack level to %v.", ackLevel))
-		} else {
+		} else if _, parked := t.parkedTasks[current]; !parked {
 			break MoveAckLevelLoop
 		}
 	}
 	t.ackLevel = ackLevel
+	ackLevel = t.persistedAckLevelLocked()
 
 	if t.isFailover && t.isReadFinished && len(outstandingTasks) == 0 {
 		t.Unlock()
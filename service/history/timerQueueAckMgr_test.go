@@ -512,6 +512,76 @@ func (s *timerQueueAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 	s.Equal(timer3.VisibilityTimestamp, s.mockShard.GetTimerClusterAckLevel(s.clusterName))
 }
 
+func (s *timerQueueAckMgrSuite) TestReadParkCompleteUpdateTimerTasks() {
+	domainID := "some random domain ID"
+
+	// create 3 timers, timer1 < timer2 < timer3 < now
+	timer1 := &persistence.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          "some random workflow ID",
+		RunID:               uuid.New(),
+		VisibilityTimestamp: time.Now().Add(-5 * time.Second),
+		TaskID:              int64(59),
+		TaskType:            1,
+		TimeoutType:         2,
+		EventID:             int64(28),
+		ScheduleAttempt:     0,
+	}
+	timer2 := &persistence.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          "some random workflow ID",
+		RunID:               uuid.New(),
+		VisibilityTimestamp: timer1.VisibilityTimestamp.Add(1 * time.Second),
+		TaskID:              timer1.TaskID + 1,
+		TaskType:            1,
+		TimeoutType:         2,
+		EventID:             int64(29),
+		ScheduleAttempt:     0,
+	}
+	timer3 := &persistence.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          "some random workflow ID",
+		RunID:               uuid.New(),
+		VisibilityTimestamp: timer2.VisibilityTimestamp.Add(1 * time.Second),
+		TaskID:              timer2.TaskID + 1,
+		TaskType:            1,
+		TimeoutType:         2,
+		EventID:             int64(30),
+		ScheduleAttempt:     0,
+	}
+	response := &persistence.GetTimerIndexTasksResponse{
+		Timers:        []*persistence.TimerTaskInfo{timer1, timer2, timer3},
+		NextPageToken: nil,
+	}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(response, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(&persistence.GetTimerIndexTasksResponse{}, nil).Once()
+	filteredTasks, _, _, err := s.timerQueueAckMgr.readTimerTasks()
+	s.Nil(err)
+	s.Equal([]*persistence.TimerTaskInfo{timer1, timer2, timer3}, filteredTasks)
+
+	// the ack level moves past the parked timer, the ack level persisted does not
+	s.mockShardMgr.On("UpdateShard", mock.Anything).Return(nil).Once()
+	s.timerQueueAckMgr.parkTimerTask(timer1)
+	s.timerQueueAckMgr.completeTimerTask(timer2)
+	s.timerQueueAckMgr.completeTimerTask(timer3)
+	s.timerQueueAckMgr.updateAckLevel()
+	s.Equal(TimerSequenceID{VisibilityTimestamp: timer3.VisibilityTimestamp, TaskID: timer3.TaskID}, s.timerQueueAckMgr.ackLevel)
+	s.Equal(TimerSequenceID{VisibilityTimestamp: timer1.VisibilityTimestamp}, s.timerQueueAckMgr.getAckLevel())
+	s.Equal(timer1.VisibilityTimestamp, s.mockShard.GetTimerClusterAckLevel(s.clusterName))
+	s.Len(s.timerQueueAckMgr.outstandingTasks, 1)
+
+	// the timer is resumed and completed
+	s.mockShardMgr.On("UpdateShard", mock.Anything).Return(nil).Once()
+	s.timerQueueAckMgr.unparkTimerTask(timer1)
+	s.timerQueueAckMgr.completeTimerTask(timer1)
+	s.timerQueueAckMgr.updateAckLevel()
+	s.Equal(TimerSequenceID{VisibilityTimestamp: timer3.VisibilityTimestamp, TaskID: timer3.TaskID}, s.timerQueueAckMgr.getAckLevel())
+	s.Equal(timer3.VisibilityTimestamp, s.mockShard.GetTimerClusterAckLevel(s.clusterName))
+	s.Empty(s.timerQueueAckMgr.outstandingTasks)
+	s.Empty(s.timerQueueAckMgr.parkedTasks)
+}
+
 func (s *timerQueueAckMgrSuite) TestReadLookAheadTask() {
 	s.mockClusterMetadata.On("GetCurrentClusterName").Return(s.clusterName)
 	level := s.mockShard.UpdateTimerMaxReadLevel(s.clusterName)
@@ -834,3 +904,112 @@ func (s *timerQueueFailoverAckMgrSuite) TestReadCompleteUpdateTimerTasks() {
 		s.Fail("timer queue ack mgr finished chan should be fired")
 	}
 }
+
+func (s *timerQueueAckMgrSuite) TestReadTimerWheelTasks() {
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(s.clusterName)
+	s.mockShard.config.TimerProcessorEnableTimerWheel = dynamicconfig.GetBoolPropertyFn(true)
+	level := s.mockShard.UpdateTimerMaxReadLevel(s.clusterName)
+	now := level
+	s.timerQueueAckMgr.timeNow = func() time.Time { return now }
+	s.timerQueueAckMgr.minQueryLevel = level.Add(-time.Second)
+	s.timerQueueAckMgr.maxQueryLevel = level
+
+	// timer wheel is not filled yet
+	_, _, ok := s.timerQueueAckMgr.readTimerWheelTasks()
+	s.False(ok)
+
+	timer := &persistence.TimerTaskInfo{
+		DomainID:            "some random domain ID",
+		WorkflowID:          "some random workflow ID",
+		RunID:               uuid.New(),
+		VisibilityTimestamp: level.Add(100 * time.Millisecond),
+		TaskID:              int64(59),
+		TaskType:            1,
+		TimeoutType:         2,
+		EventID:             int64(28),
+		ScheduleAttempt:     0,
+		Version:             int64(79),
+	}
+	emptyResponse := &persistence.GetTimerIndexTasksResponse{}
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: level.Add(-time.Second),
+		MaxTimestamp: level,
+		BatchSize:    s.mockShard.config.TimerTaskBatchSize(),
+	}).Return(emptyResponse, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: level,
+		MaxTimestamp: now.Add(s.mockShard.config.TimerProcessorLookAheadWindow()),
+		BatchSize:    s.mockShard.config.TimerTaskBatchSize(),
+	}).Return(&persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timer}}, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: now.Add(s.mockShard.config.TimerProcessorLookAheadWindow()),
+		MaxTimestamp: maximumTime,
+		BatchSize:    1,
+	}).Return(emptyResponse, nil).Once()
+
+	filteredTasks, lookAheadTask, moreTasks, err := s.timerQueueAckMgr.readTimerTasks()
+	s.Nil(err)
+	s.Empty(filteredTasks)
+	s.Equal(timer, lookAheadTask)
+	s.False(moreTasks)
+
+	// timer tasks are now fired from memory, without reading from persistence
+	filteredTasks, lookAheadTask, ok = s.timerQueueAckMgr.readTimerWheelTasks()
+	s.True(ok)
+	s.Empty(filteredTasks)
+	s.Equal(timer, lookAheadTask)
+
+	now = timer.VisibilityTimestamp
+	filteredTasks, lookAheadTask, ok = s.timerQueueAckMgr.readTimerWheelTasks()
+	s.True(ok)
+	s.Equal([]*persistence.TimerTaskInfo{timer}, filteredTasks)
+	s.Nil(lookAheadTask)
+	timerSequenceID := TimerSequenceID{VisibilityTimestamp: timer.VisibilityTimestamp, TaskID: timer.TaskID}
+	s.False(s.timerQueueAckMgr.outstandingTasks[timerSequenceID])
+
+	// new timer within the range covered by the timer wheel
+	s.timerQueueAckMgr.notifyNewTimer(now.Add(time.Second))
+	_, _, ok = s.timerQueueAckMgr.readTimerWheelTasks()
+	s.False(ok)
+}
+
+func (s *timerQueueAckMgrSuite) TestReadTimerWheelTasks_NewTimerDuringFill() {
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(s.clusterName)
+	s.mockShard.config.TimerProcessorEnableTimerWheel = dynamicconfig.GetBoolPropertyFn(true)
+	level := s.mockShard.UpdateTimerMaxReadLevel(s.clusterName)
+	now := level
+	s.timerQueueAckMgr.timeNow = func() time.Time { return now }
+	s.timerQueueAckMgr.minQueryLevel = level.Add(-time.Second)
+	s.timerQueueAckMgr.maxQueryLevel = level
+
+	emptyResponse := &persistence.GetTimerIndexTasksResponse{}
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: level.Add(-time.Second),
+		MaxTimestamp: level,
+		BatchSize:    s.mockShard.config.TimerTaskBatchSize(),
+	}).Return(emptyResponse, nil).Once()
+	// a new timer task within the look ahead window is created after the persistence read
+	// of the timer wheel range, but before the timer wheel is filled
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: level,
+		MaxTimestamp: now.Add(s.mockShard.config.TimerProcessorLookAheadWindow()),
+		BatchSize:    s.mockShard.config.TimerTaskBatchSize(),
+	}).Return(emptyResponse, nil).Run(func(_ mock.Arguments) {
+		s.timerQueueAckMgr.notifyNewTimer(level.Add(time.Second))
+	}).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &persistence.GetTimerIndexTasksRequest{
+		MinTimestamp: now.Add(s.mockShard.config.TimerProcessorLookAheadWindow()),
+		MaxTimestamp: maximumTime,
+		BatchSize:    1,
+	}).Return(emptyResponse, nil).Once()
+
+	filteredTasks, lookAheadTask, moreTasks, err := s.timerQueueAckMgr.readTimerTasks()
+	s.Nil(err)
+	s.Empty(filteredTasks)
+	s.Nil(lookAheadTask)
+	s.False(moreTasks)
+
+	// the new timer task is not in the timer wheel, so timer tasks have to be read from persistence
+	_, _, ok := s.timerQueueAckMgr.readTimerWheelTasks()
+	s.False(ok)
+}
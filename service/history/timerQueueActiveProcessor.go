@@ -240,6 +240,26 @@ func (t *timerQueueActiveProcessorImpl) complete(
 	t.timerQueueProcessorBase.complete(timerTask)
 }
 
+func (t *timerQueueActiveProcessorImpl) park(
+	qTask queueTaskInfo,
+) {
+	timerTask, ok := qTask.(*persistence.TimerTaskInfo)
+	if !ok {
+		return
+	}
+	t.timerQueueProcessorBase.timerQueueAckMgr.parkTimerTask(timerTask)
+}
+
+func (t *timerQueueActiveProcessorImpl) unpark(
+	qTask queueTaskInfo,
+) {
+	timerTask, ok := qTask.(*persistence.TimerTaskInfo)
+	if !ok {
+		return
+	}
+	t.timerQueueProcessorBase.timerQueueAckMgr.unparkTimerTask(timerTask)
+}
+
 func (t *timerQueueActiveProcessorImpl) process(
 	qTask queueTaskInfo,
 	shouldProcessTask bool,
@@ -249,6 +269,16 @@ func (t *timerQueueActiveProcessorImpl) process(
 		return metrics.TimerActiveQueueProcessorScope, errUnexpectedQueueTask
 	}
 
+	if shouldProcessTask {
+		paused, err := t.isDomainProcessingPaused(timerTask)
+		if err != nil {
+			return metrics.TimerActiveQueueProcessorScope, err
+		}
+		if paused {
+			return metrics.TimerActiveQueueProcessorScope, ErrDomainProcessingPaused
+		}
+	}
+
 	var err error
 	switch timerTask.TaskType {
 	case persistence.TaskTypeUserTimer:
@@ -293,6 +323,12 @@ func (t *timerQueueActiveProcessorImpl) process(
 		}
 		return metrics.TimerActiveTaskDeleteHistoryEventScope, err
 
+	case persistence.TaskTypeDeleteVisibility:
+		if shouldProcessTask {
+			err = t.timerQueueProcessorBase.processDeleteVisibility(timerTask)
+		}
+		return metrics.TimerActiveTaskDeleteVisibilityScope, err
+
 	default:
 		return metrics.TimerActiveQueueProcessorScope, errUnknownTimerTask
 	}
@@ -315,6 +351,9 @@ func (t *timerQueueActiveProcessorImpl) processExpiredUserTimer(
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
+	} else if msBuilder.IsWorkflowExecutionPaused() {
+		// user timers are created again once the workflow is resumed
+		return nil
 	}
 	tBuilder := t.historyService.getTimerBuilder(context.getExecution())
 
@@ -339,6 +378,11 @@ ExpireUserTimers:
 			// See if we have next timer in list to be created.
 			if !td.TaskCreated {
 				updateState = true
+			} else if ti.TaskID == TimerTaskStatusCoalesced {
+				// the next timer was covered by this timer task, create its own timer task
+				ti.TaskID = TimerTaskStatusNone
+				msBuilder.UpdateUserTimer(ti.TimerID, ti)
+				updateState = true
 			}
 			break ExpireUserTimers
 		}
@@ -370,11 +414,15 @@ func (t *timerQueueActiveProcessorImpl) processActivityTimeout(
 		return err
 	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
 		return nil
+	} else if msBuilder.IsWorkflowExecutionPaused() {
+		// activity timers are created again once the workflow is resumed
+		return nil
 	}
 	tBuilder := t.historyService.getTimerBuilder(context.getExecution())
 
 	updateHistory := false
 	updateState := false
+	var noPollersTaskLists []string
 	ai, running := msBuilder.GetActivityInfo(task.EventID)
 	if running {
 		// If current one is HB task then we may need to create the next heartbeat timer.  Clear the create flag for this
@@ -403,8 +451,16 @@ ExpireActivityTimers:
 
 		if isExpired := tBuilder.IsTimerExpired(td, referenceTime); !isExpired {
 			// See if we have next timer in list to be created.
+			coalescedStatus := getActivityTimerCoalescedStatus(td.TimeoutType)
 			if !td.TaskCreated {
 				updateState = true
+			} else if ai.TimerTaskStatus&coalescedStatus != 0 {
+				// the next timeout was covered by this timer task, create its own timer task
+				ai.TimerTaskStatus = ai.TimerTaskStatus &^ coalescedStatus
+				if err := msBuilder.UpdateActivity(ai); err != nil {
+					return err
+				}
+				updateState = true
 			}
 			break ExpireActivityTimers
 		}
@@ -451,6 +507,7 @@ ExpireActivityTimers:
 		case workflow.TimeoutTypeScheduleToClose:
 			{
 				metricScopeWithDomainTag.IncCounter(metrics.ScheduleToCloseTimeoutCounter)
+				t.emitActivityTypeTimeoutCounter(msBuilder, ai, metrics.ScheduleToCloseTimeoutCounter)
 				if _, err := msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, ai.Details); err != nil {
 					return err
 				}
@@ -460,6 +517,7 @@ ExpireActivityTimers:
 		case workflow.TimeoutTypeStartToClose:
 			{
 				metricScopeWithDomainTag.IncCounter(metrics.StartToCloseTimeoutCounter)
+				t.emitActivityTypeTimeoutCounter(msBuilder, ai, metrics.StartToCloseTimeoutCounter)
 				if ai.StartedID != common.EmptyEventID {
 					if _, err := msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, ai.Details); err != nil {
 						return err
@@ -471,6 +529,7 @@ ExpireActivityTimers:
 		case workflow.TimeoutTypeHeartbeat:
 			{
 				metricScopeWithDomainTag.IncCounter(metrics.HeartbeatTimeoutCounter)
+				t.emitActivityTypeTimeoutCounter(msBuilder, ai, metrics.HeartbeatTimeoutCounter)
 				if _, err := msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, ai.Details); err != nil {
 					return err
 				}
@@ -480,7 +539,9 @@ ExpireActivityTimers:
 		case workflow.TimeoutTypeScheduleToStart:
 			{
 				metricScopeWithDomainTag.IncCounter(metrics.ScheduleToStartTimeoutCounter)
+				t.emitActivityTypeTimeoutCounter(msBuilder, ai, metrics.ScheduleToStartTimeoutCounter)
 				if ai.StartedID == common.EmptyEventID {
+					noPollersTaskLists = append(noPollersTaskLists, ai.TaskList)
 					if _, err := msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID, timeoutType, ai.Details); err != nil {
 						return err
 					}
@@ -494,7 +555,25 @@ ExpireActivityTimers:
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
 		scheduleNewDecision := updateHistory && !msBuilder.HasPendingDecision()
-		return t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision)
+		if err := t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision); err != nil {
+			return err
+		}
+		if len(noPollersTaskLists) > 0 {
+			metricScopeWithDomainTag, err := t.getMetricScopeWithDomainTag(
+				metrics.TimerActiveTaskActivityTimeoutScope,
+				msBuilder.GetExecutionInfo().DomainID)
+			if err != nil {
+				return err
+			}
+			for _, taskList := range noPollersTaskLists {
+				t.historyService.noPollersTracker.checkTaskListPollers(
+					msBuilder.GetExecutionInfo().DomainID,
+					taskList,
+					workflow.TaskListTypeActivity,
+					metricScopeWithDomainTag,
+				)
+			}
+		}
 	}
 	return nil
 }
@@ -539,6 +618,7 @@ func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(
 	}
 
 	scheduleNewDecision := false
+	noPollersTaskList := ""
 	switch task.TimeoutType {
 	case int(workflow.TimeoutTypeStartToClose):
 		metricScopeWithDomainTag.IncCounter(metrics.StartToCloseTimeoutCounter)
@@ -551,11 +631,17 @@ func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(
 		metricScopeWithDomainTag.IncCounter(metrics.ScheduleToStartTimeoutCounter)
 		// check if scheduled decision still pending and not started yet
 		if decision.Attempt == task.ScheduleAttempt && decision.StartedID == common.EmptyEventID {
+			// decision schedule to start timeout is only set for sticky task list, which is cleared by the timeout
+			taskList := msBuilder.GetExecutionInfo().StickyTaskList
+			if taskList == "" {
+				taskList = msBuilder.GetExecutionInfo().TaskList
+			}
 			_, err := msBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(scheduleID)
 			if err != nil {
 				// unable to add DecisionTaskTimeout event to history
 				return &workflow.InternalServiceError{Message: "unable to add DecisionTaskScheduleToStartTimeout event to history."}
 			}
+			noPollersTaskList = taskList
 
 			// reschedule decision, which will be on its original task list
 			scheduleNewDecision = true
@@ -565,7 +651,17 @@ func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(
 	if scheduleNewDecision {
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		return t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision)
+		if err := t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision); err != nil {
+			return err
+		}
+		if noPollersTaskList != "" {
+			t.historyService.noPollersTracker.checkTaskListPollers(
+				msBuilder.GetExecutionInfo().DomainID,
+				noPollersTaskList,
+				workflow.TaskListTypeDecision,
+				metricScopeWithDomainTag,
+			)
+		}
 	}
 	return nil
 }
@@ -668,6 +764,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 		Name: &ai.TaskList,
 	}
 	scheduleToStartTimeout := ai.ScheduleToStartTimeout
+	priority := msBuilder.GetExecutionInfo().Priority
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -678,6 +775,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 		TaskList:                      taskList,
 		ScheduleId:                    &scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(priority),
 	})
 }
 
@@ -816,6 +914,36 @@ func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	return nil
 }
 
+// emitActivityTypeTimeoutCounter emits the activity timeout counter tagged by activity type,
+// if the activity type is allowlisted for per activity type metrics
+func (t *timerQueueActiveProcessorImpl) emitActivityTypeTimeoutCounter(
+	msBuilder mutableState,
+	ai *persistence.ActivityInfo,
+	counter int,
+) {
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(msBuilder.GetExecutionInfo().DomainID)
+	if err != nil {
+		return
+	}
+	domainName := domainEntry.GetInfo().Name
+	allowlist := t.config.ActivityTypeMetricsAllowlist(domainName)
+	if len(allowlist) == 0 {
+		return
+	}
+	scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
+	if !ok {
+		return
+	}
+	activityType := scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType.GetName()
+	if !common.IsActivityTypeMetricsAllowlisted(allowlist, activityType) {
+		return
+	}
+	t.metricsClient.Scope(metrics.TimerActiveTaskActivityTimeoutScope).
+		Tagged(metrics.DomainTag(domainName), metrics.ActivityTypeTag(activityType)).
+		IncCounter(counter)
+}
+
 func (t *timerQueueActiveProcessorImpl) getMetricScopeWithDomainTag(scope int, domainID string) (metrics.Scope, error) {
 	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
 	if err != nil {
@@ -824,3 +952,26 @@ func (t *timerQueueActiveProcessorImpl) getMetricScopeWithDomainTag(scope int, d
 	return t.metricsClient.Scope(metrics.TimerActiveTaskDecisionTimeoutScope).
 		Tagged(metrics.DomainTag(domainEntry.GetInfo().Name)), nil
 }
+
+// isDomainProcessingPaused returns true if the timer task would lead to new decision / activity tasks
+// while processing of its domain is paused
+func (t *timerQueueActiveProcessorImpl) isDomainProcessingPaused(
+	timerTask *persistence.TimerTaskInfo,
+) (bool, error) {
+
+	switch timerTask.TaskType {
+	case persistence.TaskTypeUserTimer,
+		persistence.TaskTypeActivityTimeout,
+		persistence.TaskTypeDecisionTimeout,
+		persistence.TaskTypeActivityRetryTimer,
+		persistence.TaskTypeWorkflowBackoffTimer:
+	default:
+		return false, nil
+	}
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(timerTask.DomainID)
+	if err != nil {
+		return false, err
+	}
+	return t.config.DomainProcessingPaused(domainEntry.GetInfo().Name), nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	timerQueueActiveProcessorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockShard        *MockShardContext
+		mockDomainCache  *cache.DomainCacheMock
+		mockMutableState *mockMutableState
+		scope            tally.TestScope
+		processor        *timerQueueActiveProcessorImpl
+	}
+)
+
+func TestTimerQueueActiveProcessorSuite(t *testing.T) {
+	suite.Run(t, new(timerQueueActiveProcessorSuite))
+}
+
+func (s *timerQueueActiveProcessorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockShard = NewMockShardContext(s.controller)
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockMutableState = &mockMutableState{}
+	s.scope = tally.NewTestScope("test", nil)
+
+	s.mockShard.EXPECT().GetDomainCache().Return(s.mockDomainCache).AnyTimes()
+	s.mockDomainCache.On("GetDomainByID", "domain-id").Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "domain-id", Name: "domain"},
+		&persistence.DomainConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{DomainID: "domain-id"})
+	s.mockMutableState.On("GetActivityScheduledEvent", int64(5)).Return(&shared.HistoryEvent{
+		ActivityTaskScheduledEventAttributes: &shared.ActivityTaskScheduledEventAttributes{
+			ActivityType: &shared.ActivityType{Name: common.StringPtr("activity")},
+		},
+	}, true)
+
+	config := NewDynamicConfigForTest()
+	config.ActivityTypeMetricsAllowlist = dynamicconfig.GetStringPropertyFnFilteredByDomain("other,activity")
+	s.processor = &timerQueueActiveProcessorImpl{
+		shard:         s.mockShard,
+		metricsClient: metrics.NewClient(s.scope, metrics.History),
+		config:        config,
+	}
+}
+
+func (s *timerQueueActiveProcessorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *timerQueueActiveProcessorSuite) TestEmitActivityTypeTimeoutCounter_Allowlisted() {
+	s.processor.emitActivityTypeTimeoutCounter(
+		s.mockMutableState,
+		&persistence.ActivityInfo{ScheduleID: 5},
+		metrics.ScheduleToCloseTimeoutCounter,
+	)
+
+	counters := s.scope.Snapshot().Counters()
+	s.Len(counters, 1)
+	for _, counter := range counters {
+		s.Equal("test.schedule_to_close_timeout", counter.Name())
+		s.Equal(int64(1), counter.Value())
+		s.Equal("domain", counter.Tags()["domain"])
+		s.Equal("activity", counter.Tags()["activity_type"])
+	}
+}
+
+func (s *timerQueueActiveProcessorSuite) TestEmitActivityTypeTimeoutCounter_NotAllowlisted() {
+	s.processor.config.ActivityTypeMetricsAllowlist = dynamicconfig.GetStringPropertyFnFilteredByDomain("other")
+	s.processor.emitActivityTypeTimeoutCounter(
+		s.mockMutableState,
+		&persistence.ActivityInfo{ScheduleID: 5},
+		metrics.ScheduleToCloseTimeoutCounter,
+	)
+	s.Empty(s.scope.Snapshot().Counters())
+}
+
+func (s *timerQueueActiveProcessorSuite) TestEmitActivityTypeTimeoutCounter_EmptyAllowlist() {
+	s.processor.config.ActivityTypeMetricsAllowlist = dynamicconfig.GetStringPropertyFnFilteredByDomain("")
+	s.processor.emitActivityTypeTimeoutCounter(
+		s.mockMutableState,
+		&persistence.ActivityInfo{ScheduleID: 5},
+		metrics.ScheduleToCloseTimeoutCounter,
+	)
+	s.Empty(s.scope.Snapshot().Counters())
+	s.mockMutableState.AssertNotCalled(s.T(), "GetActivityScheduledEvent", int64(5))
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -230,7 +231,7 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 	taskList := "user-timer-update-times-out"
 
 	builder := newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything).Return().Once()
 	startRequest := &workflow.StartWorkflowExecutionRequest{
 		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
@@ -299,13 +300,44 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 	s.stopProcessor()
 }
 
+func (s *timerQueueProcessor2Suite) TestDomainProcessingPaused() {
+	domainProcessingPaused := s.config.DomainProcessingPaused
+	defer func() { s.config.DomainProcessingPaused = domainProcessingPaused }()
+	s.config.DomainProcessingPaused = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID:            s.domainID,
+		WorkflowID:          "wid",
+		RunID:               validRunID,
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeUserTimer,
+		VisibilityTimestamp: time.Now(),
+	}
+
+	// timers which would lead to new decision / activity tasks are held back
+	_, err := s.timerQueueActiveProcessor.process(timerTask, true)
+	s.Equal(ErrDomainProcessingPaused, err)
+
+	// workflow timeout does not dispatch new tasks and is still processed
+	timerTask.TaskType = persistence.TaskTypeWorkflowTimeout
+	paused, err := s.timerQueueActiveProcessor.isDomainProcessingPaused(timerTask)
+	s.NoError(err)
+	s.False(paused)
+
+	s.config.DomainProcessingPaused = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	timerTask.TaskType = persistence.TaskTypeUserTimer
+	paused, err = s.timerQueueActiveProcessor.isDomainProcessingPaused(timerTask)
+	s.NoError(err)
+	s.False(paused)
+}
+
 func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow-timesout-test"),
 		RunId: common.StringPtr(validRunID)}
 	taskList := "task-workflow-times-out"
 
 	builder := newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything).Return().Once()
 	startRequest := &workflow.StartWorkflowExecutionRequest{
 		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
@@ -349,8 +381,8 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 		// Done.
 		waitCh <- struct{}{}
 	}).Once()
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
-	s.mockEventsCache.On("getEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&workflow.HistoryEvent{}, nil).Once()
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
+	s.mockEventsCache.On("GetEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&workflow.HistoryEvent{}, nil).Once()
 
 	// Start timer Processor.
 	emptyResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}
@@ -382,7 +414,7 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout_Cron() {
 	schedule := "@every 30s"
 
 	builder := newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 		mock.Anything).Return().Once()
 	startRequest := &workflow.StartWorkflowExecutionRequest{
 		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
@@ -427,14 +459,14 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout_Cron() {
 		// Done.
 		waitCh <- struct{}{}
 	}).Once()
-	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Times(2)
+	s.mockEventsCache.On("PutEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Times(2)
 	startedEvent := &workflow.HistoryEvent{
 		WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
 			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(0),
 			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(0),
 		},
 	}
-	s.mockEventsCache.On("getEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(startedEvent, nil).Times(4)
+	s.mockEventsCache.On("GetEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(startedEvent, nil).Times(4)
 
 	// Start timer Processor.
 	emptyResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}
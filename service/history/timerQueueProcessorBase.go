@@ -65,7 +65,8 @@ type (
 		timerQueueAckMgr timerQueueAckMgr
 		timerGate        TimerGate
 		timeSource       clock.TimeSource
-		rateLimiter      quotas.Limiter
+		rateLimiter      *quotas.DynamicRateLimiter
+		maxPollRPS       dynamicconfig.IntPropertyFn
 		retryPolicy      backoff.RetryPolicy
 		lastPollTime     time.Time
 		taskProcessor    *taskProcessor
@@ -74,6 +75,9 @@ type (
 		newTimerCh  chan struct{}
 		newTimeLock sync.Mutex
 		newTime     time.Time
+
+		configChangedCh chan struct{}
+		configWatchers  []*dynamicconfig.Watcher
 	}
 )
 
@@ -108,6 +112,8 @@ func newTimerQueueProcessorBase(
 		timerGate:        timerGate,
 		timeSource:       shard.GetTimeSource(),
 		newTimerCh:       make(chan struct{}, 1),
+		configChangedCh:  make(chan struct{}, 1),
+		maxPollRPS:       maxPollRPS,
 		lastPollTime:     time.Time{},
 		taskProcessor:    taskProcessor,
 		rateLimiter: quotas.NewDynamicRateLimiter(
@@ -127,6 +133,11 @@ func (t *timerQueueProcessorBase) Start() {
 	}
 
 	t.taskProcessor.start()
+	t.configWatchers = []*dynamicconfig.Watcher{
+		dynamicconfig.WatchIntProperty(t.maxPollRPS, func(int) { t.rateLimiter.Refresh() }),
+		dynamicconfig.WatchDurationProperty(t.config.TimerProcessorMaxPollInterval, func(time.Duration) { t.notifyConfigChanged() }),
+		dynamicconfig.WatchDurationProperty(t.config.TimerProcessorUpdateAckInterval, func(time.Duration) { t.notifyConfigChanged() }),
+	}
 	t.shutdownWG.Add(1)
 	// notify a initial scan
 	t.notifyNewTimer(time.Time{})
@@ -140,6 +151,9 @@ func (t *timerQueueProcessorBase) Stop() {
 		return
 	}
 
+	for _, watcher := range t.configWatchers {
+		watcher.Stop()
+	}
 	t.timerGate.Close()
 	close(t.shutdownCh)
 	t.retryTasks()
@@ -152,6 +166,13 @@ func (t *timerQueueProcessorBase) Stop() {
 	t.logger.Info("Timer queue processor stopped.")
 }
 
+func (t *timerQueueProcessorBase) notifyConfigChanged() {
+	select {
+	case t.configChangedCh <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
 func (t *timerQueueProcessorBase) processorPump() {
 	defer t.shutdownWG.Done()
 
@@ -234,10 +255,17 @@ func (t *timerQueueProcessorBase) notifyNewTimers(
 			} else {
 				t.metricsClient.IncCounter(metrics.TimerStandbyTaskWorkflowBackoffTimerScope, metrics.NewTimerCounter)
 			}
+		case persistence.TaskTypeDeleteVisibility:
+			if isActive {
+				t.metricsClient.IncCounter(metrics.TimerActiveTaskDeleteVisibilityScope, metrics.NewTimerCounter)
+			} else {
+				t.metricsClient.IncCounter(metrics.TimerStandbyTaskDeleteVisibilityScope, metrics.NewTimerCounter)
+			}
 			// TODO add default
 		}
 	}
 
+	t.timerQueueAckMgr.notifyNewTimer(newTime)
 	t.notifyNewTimer(newTime)
 }
 
@@ -272,11 +300,12 @@ func (t *timerQueueProcessorBase) internalProcessor() error {
 	defer updateAckTimer.Stop()
 
 	for {
-		// Wait until one of four things occurs:
+		// Wait until one of five things occurs:
 		// 1. we get notified of a new message
 		// 2. the timer gate fires (message scheduled to be delivered)
 		// 3. shutdown was triggered.
 		// 4. updating ack level
+		// 5. the poll or update ack intervals changed
 		//
 		select {
 		case <-t.shutdownCh:
@@ -316,6 +345,16 @@ func (t *timerQueueProcessorBase) internalProcessor() error {
 				t.config.TimerProcessorUpdateAckIntervalJitterCoefficient(),
 			))
 			t.timerQueueAckMgr.updateAckLevel()
+		case <-t.configChangedCh:
+			// apply the new intervals right away instead of waiting for the timers set with the old ones
+			resetTimer(pollTimer, backoff.JitDuration(
+				t.config.TimerProcessorMaxPollInterval(),
+				t.config.TimerProcessorMaxPollIntervalJitterCoefficient(),
+			))
+			resetTimer(updateAckTimer, backoff.JitDuration(
+				t.config.TimerProcessorUpdateAckInterval(),
+				t.config.TimerProcessorUpdateAckIntervalJitterCoefficient(),
+			))
 		case <-t.newTimerCh:
 			t.newTimeLock.Lock()
 			newTime := t.newTime
@@ -329,6 +368,14 @@ func (t *timerQueueProcessorBase) internalProcessor() error {
 }
 
 func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerTaskInfo, error) {
+	// timer tasks loaded ahead of time are fired without reading persistence
+	if timerTasks, lookAheadTask, ok := t.timerQueueAckMgr.readTimerWheelTasks(); ok {
+		if shutdown := t.fanoutTimerTasks(timerTasks); shutdown {
+			return nil, nil
+		}
+		return lookAheadTask, nil
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), loadTimerTaskThrottleRetryDelay)
 	if err := t.rateLimiter.Wait(ctx); err != nil {
 		cancel()
@@ -344,28 +391,40 @@ func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerT
 		return nil, err
 	}
 
+	if shutdown := t.fanoutTimerTasks(timerTasks); shutdown {
+		return nil, nil
+	}
+
+	if !moreTasks {
+		return lookAheadTask, nil
+	}
+
+	t.notifyNewTimer(time.Time{}) // re-enqueue the event
+	return nil, nil
+}
+
+func (t *timerQueueProcessorBase) fanoutTimerTasks(
+	timerTasks []*persistence.TimerTaskInfo,
+) bool {
+
+	now := t.timeSource.Now()
 	for _, task := range timerTasks {
+		t.metricsClient.RecordTimer(t.scope, metrics.TimerTaskFireLatency, now.Sub(task.VisibilityTimestamp))
 		if shutdown := t.taskProcessor.addTask(
 			&taskInfo{
 				processor: t.timerProcessor,
 				task:      task,
 			},
 		); shutdown {
-			return nil, nil
+			return true
 		}
 		select {
 		case <-t.shutdownCh:
-			return nil, nil
+			return true
 		default:
 		}
 	}
-
-	if !moreTasks {
-		return lookAheadTask, nil
-	}
-
-	t.notifyNewTimer(time.Time{}) // re-enqueue the event
-	return nil, nil
+	return false
 }
 
 func (t *timerQueueProcessorBase) retryTasks() {
@@ -419,6 +478,12 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(
 	if err != nil {
 		return err
 	}
+
+	// zombie workflow never completed, there is nothing worth archiving
+	if msBuilder.GetExecutionInfo().State == persistence.WorkflowStateZombie {
+		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
+		return t.deleteWorkflow(task, context, msBuilder, domainCacheEntry)
+	}
 	clusterConfiguredForHistoryArchival := t.shard.GetService().GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
 	domainConfiguredForHistoryArchival := domainCacheEntry.GetConfig().HistoryArchivalStatus == workflow.ArchivalStatusEnabled
 	archiveHistory := clusterConfiguredForHistoryArchival && domainConfiguredForHistoryArchival
@@ -434,13 +499,14 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(
 	}
 
 	t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteCount)
-	return t.deleteWorkflow(task, context, msBuilder)
+	return t.deleteWorkflow(task, context, msBuilder, domainCacheEntry)
 }
 
 func (t *timerQueueProcessorBase) deleteWorkflow(
 	task *persistence.TimerTaskInfo,
 	context workflowExecutionContext,
 	msBuilder mutableState,
+	domainCacheEntry *cache.DomainCacheEntry,
 ) error {
 
 	if err := t.deleteCurrentWorkflowExecution(task); err != nil {
@@ -455,7 +521,7 @@ func (t *timerQueueProcessorBase) deleteWorkflow(
 		return err
 	}
 
-	if err := t.deleteWorkflowVisibility(task); err != nil {
+	if err := t.deleteWorkflowVisibilityWithExecution(task, msBuilder, domainCacheEntry); err != nil {
 		return err
 	}
 	// calling clear here to force accesses of mutable state to read database
@@ -495,6 +561,19 @@ func (t *timerQueueProcessorBase) archiveWorkflow(
 		req.ArchiveRequest.CloseFailoverVersion = msBuilder.GetLastWriteVersion()
 		req.ArchiveRequest.URI = domainCacheEntry.GetConfig().HistoryArchivalURI
 		req.ArchiveRequest.Targets = append(req.ArchiveRequest.Targets, archiver.ArchiveTargetHistory)
+
+		// the visibility record outlives the history, record the outcome of history archival in it
+		retention := time.Duration(domainCacheEntry.GetRetentionDays(task.WorkflowID)) * time.Hour * 24
+		visibilityRetention := getVisibilityRetention(t.config, domainCacheEntry.GetInfo().Name, retention)
+		if visibilityRetention > 0 {
+			completionEvent, ok := msBuilder.GetCompletionEvent()
+			if !ok {
+				return &workflow.InternalServiceError{Message: "Unable to get workflow completion event."}
+			}
+			req.ArchiveRequest.VisibilityRetentionSeconds = int64(visibilityRetention / time.Second)
+			req.ArchiveRequest.StartTimestamp = msBuilder.GetExecutionInfo().StartTimestamp.UnixNano()
+			req.ArchiveRequest.CloseTimestamp = completionEvent.GetTimestamp()
+		}
 	}
 
 	if archiveVisibility {
@@ -525,6 +604,9 @@ func (t *timerQueueProcessorBase) archiveWorkflow(
 	if err != nil {
 		return err
 	}
+	if archiveHistory && resp.HistoryArchivedInline && req.ArchiveRequest.VisibilityRetentionSeconds > 0 {
+		t.recordHistoryArchived(req.ArchiveRequest)
+	}
 
 	if err := t.deleteCurrentWorkflowExecution(task); err != nil {
 		return err
@@ -543,7 +625,7 @@ func (t *timerQueueProcessorBase) archiveWorkflow(
 	}
 	// delete visibility record here regardless if it's been archived inline or not
 	// since the entire record is included as part of the archive request.
-	if err := t.deleteWorkflowVisibility(task); err != nil {
+	if err := t.deleteWorkflowVisibilityWithExecution(task, msBuilder, domainCacheEntry); err != nil {
 		return err
 	}
 	// calling clear here to force accesses of mutable state to read database
@@ -552,6 +634,36 @@ func (t *timerQueueProcessorBase) archiveWorkflow(
 	return nil
 }
 
+func (t *timerQueueProcessorBase) recordHistoryArchived(
+	request *archiver.ArchiveRequest,
+) {
+
+	err := t.historyService.visibilityMgr.UpdateHistoryArchivalInfo(&persistence.UpdateHistoryArchivalInfoRequest{
+		DomainUUID: request.DomainID,
+		Domain:     request.DomainName,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.WorkflowID),
+			RunId:      common.StringPtr(request.RunID),
+		},
+		StartTimestamp:   request.StartTimestamp,
+		CloseTimestamp:   request.CloseTimestamp,
+		RetentionSeconds: request.VisibilityRetentionSeconds,
+		HistoryArchivalInfo: &workflow.HistoryArchivalInfo{
+			State:        workflow.HistoryArchivalStateArchived.Ptr(),
+			URI:          common.StringPtr(request.URI),
+			ArchivedTime: common.Int64Ptr(t.shard.GetTimeSource().Now().UnixNano()),
+		},
+	})
+	if err != nil {
+		// the history is archived regardless, only the record of it is left pending
+		t.logger.Warn("failed to record history archival outcome in visibility",
+			tag.WorkflowDomainID(request.DomainID),
+			tag.WorkflowID(request.WorkflowID),
+			tag.WorkflowRunID(request.RunID),
+			tag.Error(err))
+	}
+}
+
 func (t *timerQueueProcessorBase) deleteWorkflowExecution(
 	task *persistence.TimerTaskInfo,
 ) error {
@@ -563,7 +675,11 @@ func (t *timerQueueProcessorBase) deleteWorkflowExecution(
 			RunID:      task.RunID,
 		})
 	}
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	return backoff.Retry(
+		op,
+		persistenceOperationRetryPolicy,
+		persistence.CountRetries(t.metricsClient, metrics.PersistenceDeleteWorkflowExecutionScope, common.IsPersistenceTransientError),
+	)
 }
 
 func (t *timerQueueProcessorBase) deleteCurrentWorkflowExecution(
@@ -577,7 +693,11 @@ func (t *timerQueueProcessorBase) deleteCurrentWorkflowExecution(
 			RunID:      task.RunID,
 		})
 	}
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	return backoff.Retry(
+		op,
+		persistenceOperationRetryPolicy,
+		persistence.CountRetries(t.metricsClient, metrics.PersistenceDeleteCurrentWorkflowExecutionScope, common.IsPersistenceTransientError),
+	)
 }
 
 func (t *timerQueueProcessorBase) deleteWorkflowHistory(
@@ -606,12 +726,45 @@ func (t *timerQueueProcessorBase) deleteWorkflowHistory(
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
+func (t *timerQueueProcessorBase) processDeleteVisibility(
+	task *persistence.TimerTaskInfo,
+) error {
+
+	// the workflow execution is already deleted at this point, only the visibility record is left
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+	startTimestamp, closeTimestamp := t.historyService.getVisibilityTimestamps(task.DomainID, execution, nil, t.logger)
+	return t.deleteWorkflowVisibility(task, startTimestamp, closeTimestamp)
+}
+
+// deleteWorkflowVisibilityWithExecution deletes the visibility record as part of the workflow execution cleanup,
+// unless the record is retained longer and deleted by a separate delete visibility timer task.
+// Note that the decision is made using the current visibility retention, so changing it while closed workflows
+// are pending cleanup may delete their records earlier, or leave them to the TTL of the visibility store.
+func (t *timerQueueProcessorBase) deleteWorkflowVisibilityWithExecution(
+	task *persistence.TimerTaskInfo,
+	msBuilder mutableState,
+	domainCacheEntry *cache.DomainCacheEntry,
+) error {
+
+	retention := time.Duration(domainCacheEntry.GetRetentionDays(task.WorkflowID)) * time.Hour * 24
+	if getVisibilityRetention(t.config, domainCacheEntry.GetInfo().Name, retention) > 0 {
+		return nil
+	}
+	// the start time locates the record, the close time is left out to avoid a visibility lookup
+	return t.deleteWorkflowVisibility(task, msBuilder.GetExecutionInfo().StartTimestamp.UnixNano(), 0)
+}
+
 func (t *timerQueueProcessorBase) deleteWorkflowVisibility(
 	task *persistence.TimerTaskInfo,
+	startTimestamp int64,
+	closeTimestamp int64,
 ) error {
 
 	op := func() error {
-		return t.historyService.DeleteExecutionFromVisibility(task)
+		return t.historyService.DeleteExecutionFromVisibility(task, startTimestamp, closeTimestamp)
 	}
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
@@ -635,6 +788,8 @@ func (t *timerQueueProcessorBase) getTimerTaskType(
 		return "ActivityRetryTimerTask"
 	case persistence.TaskTypeWorkflowBackoffTimer:
 		return "WorkflowBackoffTimerTask"
+	case persistence.TaskTypeDeleteVisibility:
+		return "DeleteVisibility"
 	}
 	return "UnKnown"
 }
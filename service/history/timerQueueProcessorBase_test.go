@@ -159,15 +159,89 @@ func (s *timerQueueProcessorBaseSuite) TestDeleteWorkflow_NoErr() {
 	}
 	ctx := newWorkflowExecutionContext(task.DomainID, executionInfo, s.mockShard, s.mockExecutionManager, log.NewNoop())
 	mockMutableState := &mockMutableState{}
+	startTime := time.Now().Add(-time.Hour)
 	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
 	s.mockExecutionManager.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
 	s.mockHistoryV2Manager.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
-	s.mockVisibilityManager.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockVisibilityManager.On("DeleteWorkflowExecution", mock.MatchedBy(func(request *persistence.VisibilityDeleteWorkflowExecutionRequest) bool {
+		return request.StartTimestamp == startTime.UnixNano() && request.CloseTimestamp == 0
+	})).Return(nil).Once()
+	mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{StartTimestamp: startTime}).Once()
 	mockMutableState.On("GetEventStoreVersion").Return(int32(persistence.EventStoreVersionV2)).Once()
 	mockMutableState.On("GetCurrentBranch").Return([]byte{1, 2, 3}).Once()
 	mockMutableState.On("GetLastWriteVersion").Return(int64(1234))
 
-	err := s.timerQueueProcessor.deleteWorkflow(task, ctx, mockMutableState)
+	domainCacheEntry := cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{}, &persistence.DomainConfig{}, false, nil, 0, nil)
+	err := s.timerQueueProcessor.deleteWorkflow(task, ctx, mockMutableState, domainCacheEntry)
+	s.NoError(err)
+}
+
+func (s *timerQueueProcessorBaseSuite) TestDeleteWorkflow_VisibilityRetained() {
+	s.mockShard.GetConfig().VisibilityRetention = dynamicconfig.GetDurationPropertyFnFilteredByDomain(30 * 24 * time.Hour)
+	task := &persistence.TimerTaskInfo{
+		TaskID:              12345,
+		VisibilityTimestamp: time.Now(),
+	}
+	executionInfo := workflow.WorkflowExecution{
+		WorkflowId: &task.WorkflowID,
+		RunId:      &task.RunID,
+	}
+	ctx := newWorkflowExecutionContext(task.DomainID, executionInfo, s.mockShard, s.mockExecutionManager, log.NewNoop())
+	mockMutableState := &mockMutableState{}
+	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionManager.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockHistoryV2Manager.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
+	mockMutableState.On("GetEventStoreVersion").Return(int32(persistence.EventStoreVersionV2)).Once()
+	mockMutableState.On("GetCurrentBranch").Return([]byte{1, 2, 3}).Once()
+	mockMutableState.On("GetLastWriteVersion").Return(int64(1234))
+
+	domainCacheEntry := cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{},
+		&persistence.DomainConfig{Retention: 7},
+		false,
+		nil,
+		0,
+		nil,
+	)
+	err := s.timerQueueProcessor.deleteWorkflow(task, ctx, mockMutableState, domainCacheEntry)
+	s.NoError(err)
+	s.mockVisibilityManager.AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+}
+
+func (s *timerQueueProcessorBaseSuite) TestProcessDeleteVisibility() {
+	task := &persistence.TimerTaskInfo{
+		DomainID:            validDomainID,
+		WorkflowID:          "some random workflow ID",
+		RunID:               validRunID,
+		TaskID:              12345,
+		TaskType:            persistence.TaskTypeDeleteVisibility,
+		VisibilityTimestamp: time.Now(),
+	}
+	startTimestamp := time.Now().Add(-2 * time.Hour).UnixNano()
+	closeTimestamp := time.Now().Add(-time.Hour).UnixNano()
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: task.DomainID}).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockVisibilityManager.On("GetClosedWorkflowExecution", &persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: task.DomainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		},
+	}).Return(&persistence.GetClosedWorkflowExecutionResponse{
+		Execution: &workflow.WorkflowExecutionInfo{
+			StartTime: common.Int64Ptr(startTimestamp),
+			CloseTime: common.Int64Ptr(closeTimestamp),
+		},
+	}, nil).Once()
+	s.mockVisibilityManager.On("DeleteWorkflowExecution", &persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:       task.DomainID,
+		WorkflowID:     task.WorkflowID,
+		RunID:          task.RunID,
+		TaskID:         task.TaskID,
+		StartTimestamp: startTimestamp,
+		CloseTimestamp: closeTimestamp,
+	}).Return(nil).Once()
+
+	err := s.timerQueueProcessor.processDeleteVisibility(task)
 	s.NoError(err)
 }
 
@@ -183,6 +257,7 @@ func (s *timerQueueProcessorBaseSuite) TestArchiveHistory_NoErr_InlineArchivalFa
 	mockMutableState.On("GetCurrentBranch").Return([]byte{1, 2, 3}).Once()
 	mockMutableState.On("GetLastWriteVersion").Return(int64(1234)).Once()
 	mockMutableState.On("GetNextEventID").Return(int64(101)).Once()
+	mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{StartTimestamp: time.Now().Add(-time.Hour)}).Once()
 
 	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
 	s.mockExecutionManager.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
@@ -236,7 +311,7 @@ func (s *timerQueueProcessorBaseSuite) TestArchiveVisibility_SendSignalNoErr() {
 		WorkflowTypeName: "some random workflow type name",
 		StartTimestamp:   time.Now().Add(-time.Hour),
 		CloseStatus:      1,
-	}).Once()
+	}).Twice()
 
 	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
 	s.mockExecutionManager.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
@@ -87,6 +87,7 @@ func (s *timerQueueProcessorSuite) SetupTest() {
 	s.controller = gomock.NewController(s.T())
 
 	s.mockMatchingClient = matchingservicetest.NewMockClient(s.controller)
+	s.mockMatchingClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(&workflow.DescribeTaskListResponse{}, nil).AnyTimes()
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 
 	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
@@ -118,6 +119,7 @@ func (s *timerQueueProcessorSuite) SetupTest() {
 		txProcessor:          s.mockTxProcessor,
 		replicatorProcessor:  s.mockReplicationProcessor,
 		timerProcessor:       s.mockTimerProcessor,
+		noPollersTracker:     newNoPollersTracker(s.ShardContext.GetConfig(), s.mockMatchingClient, s.logger),
 	}
 	s.ShardContext.SetEngine(s.engineImpl)
 	s.engineImpl.historyEventNotifier = newHistoryEventNotifier(clock.NewRealTimeSource(), metrics.NewClient(tally.NoopScope, metrics.History), func(string) int { return 0 })
@@ -219,6 +219,10 @@ func (t *timerQueueStandbyProcessorImpl) process(
 		// guarantee the processing of workflow execution history deletion
 		return metrics.TimerStandbyTaskDeleteHistoryEventScope, t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
 
+	case persistence.TaskTypeDeleteVisibility:
+		// guarantee the processing of workflow visibility record deletion
+		return metrics.TimerStandbyTaskDeleteVisibilityScope, t.timerQueueProcessorBase.processDeleteVisibility(timerTask)
+
 	default:
 		return metrics.TimerStandbyQueueProcessorScope, errUnknownTimerTask
 	}
@@ -362,6 +366,7 @@ func (t *timerQueueStandbyProcessorImpl) processActivityTimeout(
 		msBuilder.AddTimerTasks(newTimerTasks...)
 		err := context.updateWorkflowExecutionAsPassive(now)
 		if err == nil {
+			t.metricsClient.AddCounter(metrics.TimerStandbyTaskActivityTimeoutScope, metrics.TaskRegenerated, int64(len(newTimerTasks)))
 			t.notifyNewTimers(newTimerTasks)
 		}
 		return err
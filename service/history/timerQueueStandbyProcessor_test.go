@@ -608,7 +608,7 @@ func (s *timerQueueStandbyProcessorSuite) TestProcessActivityTimeout_Multiple_Ca
 		s.Equal(&persistence.UpdateWorkflowExecutionRequest{
 			UpdateWorkflowMutation: persistence.WorkflowMutation{
 				ExecutionInfo:             msBuilder.executionInfo,
-				ExecutionStats:            &persistence.ExecutionStats{},
+				ExecutionStats:            &persistence.ExecutionStats{EventCount: msBuilder.GetNextEventID() - common.FirstEventID},
 				ReplicationState:          msBuilder.replicationState,
 				TransferTasks:             nil,
 				ReplicationTasks:          nil,
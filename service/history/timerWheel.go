@@ -0,0 +1,206 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	timerWheelSlotBits = 6
+	timerWheelSlots    = 1 << timerWheelSlotBits
+	timerWheelSlotMask = timerWheelSlots - 1
+	timerWheelLevels   = 4
+)
+
+type (
+	// timerWheel is a hierarchical timing wheel holding the timer tasks loaded ahead of their fire time.
+	// Level 0 has one slot per tick, each higher level has one slot per full rotation of the level below.
+	// A task is kept at the lowest level sharing the same parent slot with the current tick,
+	// so tasks at a lower level, or in an earlier slot of the same level, always fire first.
+	timerWheel struct {
+		tick        time.Duration
+		currentTick int64
+		levels      [timerWheelLevels][timerWheelSlots][]*persistence.TimerTaskInfo
+		overflow    []*persistence.TimerTaskInfo
+		size        int
+	}
+)
+
+func newTimerWheel(
+	tick time.Duration,
+	now time.Time,
+) *timerWheel {
+
+	w := &timerWheel{
+		tick: tick,
+	}
+	w.currentTick = w.toTick(now)
+	return w
+}
+
+func (w *timerWheel) len() int {
+	return w.size
+}
+
+func (w *timerWheel) add(
+	task *persistence.TimerTaskInfo,
+) {
+
+	w.size++
+	w.insert(task)
+}
+
+// advance moves the wheel to the given time and returns the tasks due by then
+func (w *timerWheel) advance(
+	now time.Time,
+) []*persistence.TimerTaskInfo {
+
+	target := w.toTick(now)
+	var dueTasks []*persistence.TimerTaskInfo
+
+	for w.size > 0 && w.currentTick < target {
+		slot := &w.levels[0][w.currentTick&timerWheelSlotMask]
+		dueTasks = append(dueTasks, *slot...)
+		w.size -= len(*slot)
+		*slot = nil
+
+		w.currentTick++
+		w.cascade()
+	}
+	if w.currentTick < target {
+		// the wheel is empty, jump directly to the target tick
+		w.currentTick = target
+	}
+
+	// the tasks of the current tick may be due later within the tick
+	slot := &w.levels[0][w.currentTick&timerWheelSlotMask]
+	var pendingTasks []*persistence.TimerTaskInfo
+	for _, task := range *slot {
+		if task.VisibilityTimestamp.After(now) {
+			pendingTasks = append(pendingTasks, task)
+		} else {
+			dueTasks = append(dueTasks, task)
+		}
+	}
+	w.size -= len(*slot) - len(pendingTasks)
+	*slot = pendingTasks
+
+	return dueTasks
+}
+
+// peek returns the next task to fire, or nil if the wheel is empty
+func (w *timerWheel) peek() *persistence.TimerTaskInfo {
+	if w.size == 0 {
+		return nil
+	}
+
+	for level := 0; level < timerWheelLevels; level++ {
+		// slots of a level before the current one are always empty
+		current := int((w.currentTick >> uint(timerWheelSlotBits*level)) & timerWheelSlotMask)
+		for index := current; index < timerWheelSlots; index++ {
+			if slot := w.levels[level][index]; len(slot) > 0 {
+				return earliestTimerTask(slot)
+			}
+		}
+	}
+	return earliestTimerTask(w.overflow)
+}
+
+// reset drops all the tasks and moves the wheel to the given time
+func (w *timerWheel) reset(
+	now time.Time,
+) {
+
+	for level := range w.levels {
+		for index := range w.levels[level] {
+			w.levels[level][index] = nil
+		}
+	}
+	w.overflow = nil
+	w.size = 0
+	w.currentTick = w.toTick(now)
+}
+
+// cascade redistributes the tasks of the higher level slots the current tick just entered
+func (w *timerWheel) cascade() {
+	if w.currentTick&((1<<uint(timerWheelSlotBits*timerWheelLevels))-1) == 0 {
+		overflow := w.overflow
+		w.overflow = nil
+		for _, task := range overflow {
+			w.insert(task)
+		}
+	}
+
+	for level := timerWheelLevels - 1; level > 0; level-- {
+		if w.currentTick&((1<<uint(timerWheelSlotBits*level))-1) != 0 {
+			continue
+		}
+		slot := &w.levels[level][(w.currentTick>>uint(timerWheelSlotBits*level))&timerWheelSlotMask]
+		tasks := *slot
+		*slot = nil
+		for _, task := range tasks {
+			w.insert(task)
+		}
+	}
+}
+
+func (w *timerWheel) insert(
+	task *persistence.TimerTaskInfo,
+) {
+
+	tick := w.toTick(task.VisibilityTimestamp)
+	if tick < w.currentTick {
+		tick = w.currentTick
+	}
+
+	for level := 0; level < timerWheelLevels; level++ {
+		parentShift := uint(timerWheelSlotBits * (level + 1))
+		if tick>>parentShift == w.currentTick>>parentShift {
+			index := (tick >> uint(timerWheelSlotBits*level)) & timerWheelSlotMask
+			w.levels[level][index] = append(w.levels[level][index], task)
+			return
+		}
+	}
+	w.overflow = append(w.overflow, task)
+}
+
+func (w *timerWheel) toTick(
+	t time.Time,
+) int64 {
+
+	return t.UnixNano() / int64(w.tick)
+}
+
+func earliestTimerTask(
+	tasks []*persistence.TimerTaskInfo,
+) *persistence.TimerTaskInfo {
+
+	var earliest *persistence.TimerTaskInfo
+	for _, task := range tasks {
+		if earliest == nil || task.VisibilityTimestamp.Before(earliest.VisibilityTimestamp) {
+			earliest = task
+		}
+	}
+	return earliest
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	timerWheelSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		now   time.Time
+		wheel *timerWheel
+	}
+)
+
+func TestTimerWheelSuite(t *testing.T) {
+	s := new(timerWheelSuite)
+	suite.Run(t, s)
+}
+
+func (s *timerWheelSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.now = time.Unix(0, 0).Add(time.Hour)
+	s.wheel = newTimerWheel(10*time.Millisecond, s.now)
+}
+
+func (s *timerWheelSuite) newTask(delay time.Duration, taskID int64) *persistence.TimerTaskInfo {
+	return &persistence.TimerTaskInfo{
+		VisibilityTimestamp: s.now.Add(delay),
+		TaskID:              taskID,
+	}
+}
+
+func (s *timerWheelSuite) TestAdvance_AcrossLevels() {
+	// one task per level of the wheel, plus one overflowing all levels
+	delays := []time.Duration{
+		25 * time.Millisecond,
+		5 * time.Second,
+		10 * time.Minute,
+		5 * time.Hour,
+		100 * time.Hour,
+	}
+	for i := len(delays) - 1; i >= 0; i-- {
+		s.wheel.add(s.newTask(delays[i], int64(i)))
+	}
+	s.Equal(len(delays), s.wheel.len())
+
+	for i, delay := range delays {
+		s.Equal(int64(i), s.wheel.peek().TaskID)
+		s.Empty(s.wheel.advance(s.now.Add(delay - time.Millisecond)))
+
+		tasks := s.wheel.advance(s.now.Add(delay))
+		s.Equal(1, len(tasks))
+		s.Equal(int64(i), tasks[0].TaskID)
+		s.Equal(len(delays)-i-1, s.wheel.len())
+	}
+	s.Nil(s.wheel.peek())
+}
+
+func (s *timerWheelSuite) TestAdvance_WithinTick() {
+	s.wheel.add(s.newTask(3*time.Millisecond, 1))
+	s.wheel.add(s.newTask(7*time.Millisecond, 2))
+
+	tasks := s.wheel.advance(s.now.Add(5 * time.Millisecond))
+	s.Equal(1, len(tasks))
+	s.Equal(int64(1), tasks[0].TaskID)
+	s.Equal(int64(2), s.wheel.peek().TaskID)
+
+	tasks = s.wheel.advance(s.now.Add(time.Second))
+	s.Equal(1, len(tasks))
+	s.Equal(int64(2), tasks[0].TaskID)
+	s.Equal(0, s.wheel.len())
+}
+
+func (s *timerWheelSuite) TestAdd_PastTask() {
+	s.wheel.advance(s.now.Add(time.Second))
+	s.wheel.add(s.newTask(0, 1))
+
+	tasks := s.wheel.advance(s.now.Add(time.Second))
+	s.Equal(1, len(tasks))
+	s.Equal(int64(1), tasks[0].TaskID)
+}
+
+func (s *timerWheelSuite) TestReset() {
+	s.wheel.add(s.newTask(time.Second, 1))
+	s.wheel.add(s.newTask(time.Hour, 2))
+	s.wheel.reset(s.now.Add(time.Minute))
+
+	s.Equal(0, s.wheel.len())
+	s.Nil(s.wheel.peek())
+	s.Empty(s.wheel.advance(s.now.Add(2 * time.Hour)))
+}
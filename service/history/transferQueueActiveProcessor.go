@@ -26,6 +26,7 @@ import (
 
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
@@ -342,10 +343,12 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	tasklist := getActivityTaskList(msBuilder, task)
+	priority := msBuilder.GetExecutionInfo().Priority
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, tasklist, timeout, priority)
 }
 
 func (t *transferQueueActiveProcessorImpl) processDecisionTask(
@@ -403,11 +406,59 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(
 		tasklist.Kind = common.TaskListKindPtr(workflow.TaskListKindSticky)
 		decisionTimeout = executionInfo.StickyScheduleToStartTimeout
 	}
+	priority := executionInfo.Priority
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(task, tasklist, decisionTimeout)
+	err = t.pushDecision(task, tasklist, decisionTimeout, priority)
+	if dispatchErr, ok := err.(*m.TaskDispatchFailedError); ok &&
+		tasklist.GetKind() == workflow.TaskListKindSticky &&
+		dispatchErr.GetReason() == m.TaskDispatchFailureReasonNoPollers {
+		// the sticky worker stopped polling, time out the sticky decision right away instead of waiting
+		// for the sticky schedule to start timeout
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskDecisionScope, metrics.TaskDispatchNoPollersCounter)
+		return t.timeoutStickyDecision(task)
+	}
+	return err
+}
+
+// timeoutStickyDecision records the schedule to start timeout of a sticky decision which is not started yet,
+// this clears the stickiness of the workflow and schedules a new decision on the normal task list, the same way
+// as the sticky schedule to start timer does
+func (t *transferQueueActiveProcessorImpl) timeoutStickyDecision(
+	task *persistence.TransferTaskInfo,
+) (retError error) {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+	context, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(task.DomainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
+		return nil
+	}
+	decision, found := msBuilder.GetDecisionInfo(task.ScheduleID)
+	if !found || decision.StartedID != common.EmptyEventID || msBuilder.GetExecutionInfo().StickyTaskList != task.TaskList {
+		// the decision is started or timed out already
+		return nil
+	}
+
+	if _, err := msBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(task.ScheduleID); err != nil {
+		return &workflow.InternalServiceError{Message: "unable to add DecisionTaskScheduleToStartTimeout event to history."}
+	}
+	if err := scheduleDecision(msBuilder); err != nil {
+		return err
+	}
+	return context.updateWorkflowExecutionAsActive(t.shard.GetTimeSource().Now())
 }
 
 func (t *transferQueueActiveProcessorImpl) processCloseExecution(
@@ -569,6 +620,8 @@ func (t *transferQueueActiveProcessorImpl) processParentClosePolicy(domainName,
 						},
 						Reason:   common.StringPtr("by parent close policy"),
 						Identity: common.StringPtr(identityHistoryService),
+						// the parent close policy is chosen when the child is started, it overrides the termination protection
+						Force: common.BoolPtr(true),
 					},
 				})
 			case workflow.ParentClosePolicyRequestCancel:
@@ -938,6 +991,8 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 				CronSchedule:          attributes.CronSchedule,
 				Memo:                  attributes.Memo,
 				SearchAttributes:      attributes.SearchAttributes,
+				// child workflows are dispatched with the priority of their parent
+				Priority: common.Int32Ptr(msBuilder.GetExecutionInfo().Priority),
 			},
 			ParentExecutionInfo: &h.ParentExecutionInfo{
 				DomainUUID: common.StringPtr(domainID),
@@ -50,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 )
 
@@ -281,7 +282,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessActivityTask_Success() {
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.EXPECT().AddActivityTask(nil, s.createAddActivityTaskRequest(transferTask, ai)).Return(nil).Times(1)
+	s.mockMatchingClient.EXPECT().AddActivityTask(nil, s.createAddActivityTaskRequest(transferTask, ai, msBuilder)).Return(nil).Times(1)
 
 	_, err = s.transferQueueActiveProcessor.process(transferTask, true)
 	s.Nil(err)
@@ -513,6 +514,87 @@ func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_Sticky_NonFi
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_Sticky_NoPollers() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+	stickyTaskListName := "some random sticky task list"
+	stickyTaskListTimeout := int32(233)
+
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := msBuilder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	event := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "some random identity")
+	// set the sticky tasklist attr
+	executionInfo := msBuilder.GetExecutionInfo()
+	executionInfo.StickyTaskList = stickyTaskListName
+	executionInfo.StickyScheduleToStartTimeout = stickyTaskListTimeout
+	executionInfo.LastUpdatedTimestamp = s.mockShard.GetTimeSource().Now()
+
+	// make another round of decision
+	taskID := int64(59)
+	di = addDecisionTaskScheduledEvent(msBuilder)
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(s.mockClusterMetadata.GetCurrentClusterName())
+	msBuilder.UpdateReplicationStateLastEventID(s.version, di.ScheduleID)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		TaskID:     taskID,
+		TaskList:   stickyTaskListName,
+		TaskType:   persistence.TransferTaskTypeDecisionTask,
+		ScheduleID: di.ScheduleID,
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockMatchingClient.EXPECT().AddDecisionTask(nil, s.createAddDecisionTaskRequest(transferTask, msBuilder)).Return(&matching.TaskDispatchFailedError{
+		Reason: matching.TaskDispatchFailureReasonNoPollers.Ptr(),
+	}).Times(1)
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err = s.transferQueueActiveProcessor.process(transferTask, true)
+	s.Nil(err)
+
+	// the sticky decision is timed out, which clears stickiness and schedules a decision on the normal task list
+	s.Equal("", updateRequest.UpdateWorkflowMutation.ExecutionInfo.StickyTaskList)
+	s.Equal(di.ScheduleID+2, updateRequest.UpdateWorkflowMutation.ExecutionInfo.DecisionScheduleID)
+	decisionTasks := 0
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		if decisionTask, ok := task.(*p.DecisionTask); ok {
+			s.Equal(taskListName, decisionTask.TaskList)
+			decisionTasks++
+		}
+	}
+	s.Equal(1, decisionTasks)
+}
+
 func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_DecisionNotSticky_MutableStateSticky() {
 
 	execution := workflow.WorkflowExecution{
@@ -694,6 +776,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_HasParent(
 		CompletedExecution: &execution,
 		CompletionEvent:    event,
 	}).Return(nil).Times(1)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
 	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
 
 	_, err = s.transferQueueActiveProcessor.process(transferTask, true)
@@ -748,12 +831,38 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent()
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
 	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
 
 	_, err = s.transferQueueActiveProcessor.process(transferTask, true)
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveProcessorSuite) TestGetPendingHistoryArchivalInfo() {
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig(
+		"enabled",
+		dynamicconfig.GetStringPropertyFn("enabled"),
+		dynamicconfig.GetBoolPropertyFn(true),
+		"disabled",
+		"random URI",
+	))
+
+	s.Nil(getPendingHistoryArchivalInfo(s.mockShard, s.domainEntry))
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus: workflow.ArchivalStatusEnabled,
+			HistoryArchivalURI:    "test:///history/archival",
+		},
+		"",
+		nil,
+	)
+	info := getPendingHistoryArchivalInfo(s.mockShard, domainEntry)
+	s.Equal(workflow.HistoryArchivalStatePending, info.GetState())
+	s.Equal("test:///history/archival", info.GetURI())
+}
+
 func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_HasFewChildren() {
 
 	execution := workflow.WorkflowExecution{
@@ -882,6 +991,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_H
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
 	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
 	s.mockHistoryClient.EXPECT().RequestCancelWorkflowExecution(nil, gomock.Any()).Return(nil).Times(1)
 	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(nil, gomock.Any()).Return(nil).Times(1)
@@ -976,6 +1086,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent_H
 
 	persistenceMutableState := createMutableState(msBuilder)
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewDisabledArchvialConfig())
 	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
 	s.mockParentClosePolicyClient.On("SendParentClosePolicyRequest", mock.Anything).Return(nil).Times(1)
 
@@ -1900,7 +2011,7 @@ func (s *transferQueueActiveProcessorSuite) TestCopySearchAttributes() {
 }
 
 func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(task *persistence.TransferTaskInfo,
-	ai *persistence.ActivityInfo) *matching.AddActivityTaskRequest {
+	ai *persistence.ActivityInfo, msBuilder mutableState) *matching.AddActivityTaskRequest {
 	execution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
 		RunId:      common.StringPtr(task.RunID),
@@ -1914,6 +2025,7 @@ func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(ai.ScheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(msBuilder.GetExecutionInfo().Priority),
 	}
 }
 
@@ -1937,6 +2049,7 @@ func (s *transferQueueActiveProcessorSuite) createAddDecisionTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(timeout),
+		Priority:                      common.Int32Ptr(executionInfo.Priority),
 	}
 }
 
@@ -2040,6 +2153,7 @@ func (s *transferQueueActiveProcessorSuite) createChildWorkflowExecutionRequest(
 			// Use the same request ID to dedupe StartWorkflowExecution calls
 			RequestId:             common.StringPtr(ci.CreateRequestID),
 			WorkflowIdReusePolicy: attributes.WorkflowIdReusePolicy,
+			Priority:              common.Int32Ptr(msBuilder.GetExecutionInfo().Priority),
 		},
 		ParentExecutionInfo: &history.ParentExecutionInfo{
 			DomainUUID:  common.StringPtr(task.DomainID),
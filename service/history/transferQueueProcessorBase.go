@@ -27,6 +27,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
@@ -116,7 +117,9 @@ func (t *transferQueueProcessorBase) queueShutdown() error {
 
 func (t *transferQueueProcessorBase) pushActivity(
 	task *persistence.TransferTaskInfo,
+	tasklist *workflow.TaskList,
 	activityScheduleToStartTimeout int32,
+	priority int32,
 ) error {
 
 	if task.TaskType != persistence.TransferTaskTypeActivityTask {
@@ -130,18 +133,36 @@ func (t *transferQueueProcessorBase) pushActivity(
 			WorkflowId: common.StringPtr(task.WorkflowID),
 			RunId:      common.StringPtr(task.RunID),
 		},
-		TaskList:                      &workflow.TaskList{Name: &task.TaskList},
+		TaskList:                      tasklist,
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(priority),
 	})
 
 	return err
 }
 
+// getActivityTaskList returns the task list the activity is dispatched to,
+// the task list kind is only recorded in the activity scheduled event
+func getActivityTaskList(
+	msBuilder mutableState,
+	task *persistence.TransferTaskInfo,
+) *workflow.TaskList {
+
+	tasklist := &workflow.TaskList{Name: common.StringPtr(task.TaskList)}
+	if scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(task.ScheduleID); ok {
+		if scheduledTaskList := scheduledEvent.ActivityTaskScheduledEventAttributes.TaskList; scheduledTaskList != nil {
+			tasklist.Kind = scheduledTaskList.Kind
+		}
+	}
+	return tasklist
+}
+
 func (t *transferQueueProcessorBase) pushDecision(
 	task *persistence.TransferTaskInfo,
 	tasklist *workflow.TaskList,
 	decisionScheduleToStartTimeout int32,
+	priority int32,
 ) error {
 
 	if task.TaskType != persistence.TransferTaskTypeDecisionTask {
@@ -157,6 +178,7 @@ func (t *transferQueueProcessorBase) pushDecision(
 		TaskList:                      tasklist,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(priority),
 	})
 	return err
 }
@@ -265,6 +287,7 @@ func (t *transferQueueProcessorBase) recordWorkflowClosed(
 	domain := defaultDomainName
 	isSampledEnabled := false
 	wid := execution.GetWorkflowId()
+	var historyArchivalInfo *workflow.HistoryArchivalInfo
 
 	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
 	if err != nil {
@@ -276,7 +299,13 @@ func (t *transferQueueProcessorBase) recordWorkflowClosed(
 		// retention in domain config is in days, convert to seconds
 		retentionSeconds = int64(domainEntry.GetRetentionDays(execution.GetWorkflowId())) * int64(secondsInDay)
 		domain = domainEntry.GetInfo().Name
+		// the record is kept beyond the execution if the visibility retention is longer
+		visibilityRetention := getVisibilityRetention(t.shard.GetConfig(), domain, time.Duration(retentionSeconds)*time.Second)
+		if visibilityRetention > 0 {
+			retentionSeconds = int64(visibilityRetention / time.Second)
+		}
 		isSampledEnabled = domainEntry.IsSampledForLongerRetentionEnabled(wid)
+		historyArchivalInfo = getPendingHistoryArchivalInfo(t.shard, domainEntry)
 	}
 
 	// if sampled for longer retention is enabled, only record those sampled events
@@ -285,22 +314,43 @@ func (t *transferQueueProcessorBase) recordWorkflowClosed(
 	}
 
 	return t.visibilityMgr.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{
-		DomainUUID:         domainID,
-		Domain:             domain,
-		Execution:          execution,
-		WorkflowTypeName:   workflowTypeName,
-		StartTimestamp:     startTimeUnixNano,
-		ExecutionTimestamp: executionTimeUnixNano,
-		CloseTimestamp:     endTimeUnixNano,
-		Status:             closeStatus,
-		HistoryLength:      historyLength,
-		RetentionSeconds:   retentionSeconds,
-		TaskID:             taskID,
-		Memo:               visibilityMemo,
-		SearchAttributes:   searchAttributes,
+		DomainUUID:          domainID,
+		Domain:              domain,
+		Execution:           execution,
+		WorkflowTypeName:    workflowTypeName,
+		StartTimestamp:      startTimeUnixNano,
+		ExecutionTimestamp:  executionTimeUnixNano,
+		CloseTimestamp:      endTimeUnixNano,
+		Status:              closeStatus,
+		HistoryLength:       historyLength,
+		RetentionSeconds:    retentionSeconds,
+		TaskID:              taskID,
+		Memo:                visibilityMemo,
+		SearchAttributes:    searchAttributes,
+		HistoryArchivalInfo: historyArchivalInfo,
 	})
 }
 
+// getPendingHistoryArchivalInfo returns the archival info recorded for a closed workflow whose history
+// will be archived once the retention period is over, nil if the history is not going to be archived
+func getPendingHistoryArchivalInfo(
+	shard ShardContext,
+	domainEntry *cache.DomainCacheEntry,
+) *workflow.HistoryArchivalInfo {
+
+	archivalMetadata := shard.GetService().GetArchivalMetadata()
+	if archivalMetadata == nil || !archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() {
+		return nil
+	}
+	if domainEntry.GetConfig().HistoryArchivalStatus != workflow.ArchivalStatusEnabled {
+		return nil
+	}
+	return &workflow.HistoryArchivalInfo{
+		State: workflow.HistoryArchivalStatePending.Ptr(),
+		URI:   common.StringPtr(domainEntry.GetConfig().HistoryArchivalURI),
+	}
+}
+
 // Argument startEvent is to save additional call of msBuilder.GetStartEvent
 func getWorkflowExecutionTimestamp(
 	msBuilder mutableState,
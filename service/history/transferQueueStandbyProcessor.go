@@ -208,6 +208,8 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 ) error {
 
 	var activityScheduleToStartTimeout *int32
+	var activityTaskList *workflow.TaskList
+	var priority int32
 	processTaskIfClosed := false
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		activityInfo, isPending := msBuilder.GetActivityInfo(transferTask.ScheduleID)
@@ -230,6 +232,8 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 			}
 
 			activityScheduleToStartTimeout = common.Int32Ptr(common.MinInt32(activityInfo.ScheduleToStartTimeout, common.MaxTaskTimeout))
+			activityTaskList = getActivityTaskList(msBuilder, transferTask)
+			priority = msBuilder.GetExecutionInfo().Priority
 			return nil
 		}
 
@@ -240,7 +244,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 		}
 
 		timeout := common.MinInt32(*activityScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushActivity(transferTask, timeout)
+		err := t.pushActivity(transferTask, activityTaskList, timeout, priority)
 		return err
 	})
 }
@@ -251,6 +255,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 
 	var decisionScheduleToStartTimeout *int32
 	var tasklist *workflow.TaskList
+	var priority int32
 	processTaskIfClosed := false
 
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
@@ -279,6 +284,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 
 			decisionScheduleToStartTimeout = common.Int32Ptr(decisionTimeout)
 			tasklist = &workflow.TaskList{Name: &transferTask.TaskList}
+			priority = executionInfo.Priority
 			return nil
 		}
 
@@ -289,7 +295,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 		}
 
 		timeout := common.MinInt32(*decisionScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushDecision(transferTask, tasklist, timeout)
+		err := t.pushDecision(transferTask, tasklist, timeout, priority)
 		return err
 	})
 }
@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// visibilityCloseBatcher groups the closed execution records written by the transfer queue processors
+	// of all shards within a small window, so they are written to the visibility store together
+	visibilityCloseBatcher struct {
+		persistence.VisibilityManager
+
+		enabled      dynamicconfig.BoolPropertyFn
+		window       dynamicconfig.DurationPropertyFn
+		maxBatchSize dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		batch *visibilityCloseBatch
+	}
+
+	visibilityCloseBatch struct {
+		records   []*visibilityCloseRecord
+		flushOnce sync.Once
+		doneCh    chan struct{}
+	}
+
+	visibilityCloseRecord struct {
+		request *persistence.RecordWorkflowExecutionClosedRequest
+		err     error
+	}
+)
+
+var _ persistence.VisibilityManager = (*visibilityCloseBatcher)(nil)
+
+func newVisibilityCloseBatcher(
+	visibilityMgr persistence.VisibilityManager,
+	config *Config,
+) persistence.VisibilityManager {
+
+	return &visibilityCloseBatcher{
+		VisibilityManager: visibilityMgr,
+		enabled:           config.EnableVisibilityCloseBatching,
+		window:            config.VisibilityCloseBatchingWindow,
+		maxBatchSize:      config.VisibilityCloseBatchMaxSize,
+	}
+}
+
+// RecordWorkflowExecutionClosed waits for the closed execution record to be written as part of a batch
+func (b *visibilityCloseBatcher) RecordWorkflowExecutionClosed(
+	request *persistence.RecordWorkflowExecutionClosedRequest,
+) error {
+
+	if !b.enabled() {
+		return b.VisibilityManager.RecordWorkflowExecutionClosed(request)
+	}
+
+	record := &visibilityCloseRecord{
+		request: request,
+	}
+
+	b.Lock()
+	batch := b.batch
+	if batch == nil {
+		batch = &visibilityCloseBatch{
+			doneCh: make(chan struct{}),
+		}
+		b.batch = batch
+		time.AfterFunc(b.window(), func() { b.flush(batch) })
+	}
+	batch.records = append(batch.records, record)
+	full := len(batch.records) >= b.maxBatchSize()
+	if full {
+		b.batch = nil
+	}
+	b.Unlock()
+
+	if full {
+		b.flush(batch)
+	}
+	<-batch.doneCh
+	return record.err
+}
+
+func (b *visibilityCloseBatcher) flush(
+	batch *visibilityCloseBatch,
+) {
+
+	batch.flushOnce.Do(func() {
+		b.Lock()
+		if b.batch == batch {
+			b.batch = nil
+		}
+		b.Unlock()
+
+		b.write(batch.records)
+		close(batch.doneCh)
+	})
+}
+
+func (b *visibilityCloseBatcher) write(
+	records []*visibilityCloseRecord,
+) {
+
+	if len(records) == 1 {
+		records[0].err = b.VisibilityManager.RecordWorkflowExecutionClosed(records[0].request)
+		return
+	}
+
+	request := &persistence.RecordWorkflowExecutionClosedBatchRequest{
+		Requests: make([]*persistence.RecordWorkflowExecutionClosedRequest, 0, len(records)),
+	}
+	for _, record := range records {
+		request.Requests = append(request.Requests, record.request)
+	}
+	err := b.VisibilityManager.RecordWorkflowExecutionClosedBatch(request)
+	switch err.(type) {
+	case nil:
+		return
+	case *workflow.ServiceBusyError:
+		// writing the records one by one would only add to the load
+		for _, record := range records {
+			record.err = err
+		}
+	default:
+		// fall back to individual writes, so a single bad record does not fail the others
+		for _, record := range records {
+			record.err = b.VisibilityManager.RecordWorkflowExecutionClosed(record.request)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	visibilityCloseBatcherSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		mockVisibilityMgr *mocks.VisibilityManager
+		batcher           *visibilityCloseBatcher
+	}
+)
+
+func TestVisibilityCloseBatcherSuite(t *testing.T) {
+	s := new(visibilityCloseBatcherSuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityCloseBatcherSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.batcher = &visibilityCloseBatcher{
+		VisibilityManager: s.mockVisibilityMgr,
+		enabled:           dynamicconfig.GetBoolPropertyFn(true),
+		window:            dynamicconfig.GetDurationPropertyFn(time.Minute),
+		maxBatchSize:      dynamicconfig.GetIntPropertyFn(4),
+	}
+}
+
+func (s *visibilityCloseBatcherSuite) TearDownTest() {
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+}
+
+func (s *visibilityCloseBatcherSuite) recordClosed(num int) []error {
+	var wg sync.WaitGroup
+	errs := make([]error, num)
+	for i := 0; i < num; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = s.batcher.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{})
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func (s *visibilityCloseBatcherSuite) TestRecordWorkflowExecutionClosed_Disabled() {
+	s.batcher.enabled = dynamicconfig.GetBoolPropertyFn(false)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Times(2)
+
+	for _, err := range s.recordClosed(2) {
+		s.NoError(err)
+	}
+}
+
+func (s *visibilityCloseBatcherSuite) TestRecordWorkflowExecutionClosed_SingleRecord() {
+	s.batcher.window = dynamicconfig.GetDurationPropertyFn(time.Millisecond)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
+
+	for _, err := range s.recordClosed(1) {
+		s.NoError(err)
+	}
+}
+
+func (s *visibilityCloseBatcherSuite) TestRecordWorkflowExecutionClosed_Batched() {
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosedBatch", mock.MatchedBy(
+		func(request *persistence.RecordWorkflowExecutionClosedBatchRequest) bool {
+			return len(request.Requests) == 4
+		},
+	)).Return(nil).Twice()
+
+	// the window is long enough that only a full batch triggers a flush
+	for _, err := range s.recordClosed(8) {
+		s.NoError(err)
+	}
+}
+
+func (s *visibilityCloseBatcherSuite) TestRecordWorkflowExecutionClosed_BatchFailed_FallBack() {
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosedBatch", mock.Anything).Return(errors.New("some random error")).Once()
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Times(4)
+
+	for _, err := range s.recordClosed(4) {
+		s.NoError(err)
+	}
+}
+
+func (s *visibilityCloseBatcherSuite) TestRecordWorkflowExecutionClosed_BatchFailed_ServiceBusy() {
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosedBatch", mock.Anything).Return(persistence.ErrPersistenceLimitExceeded).Once()
+
+	for _, err := range s.recordClosed(4) {
+		s.IsType(&workflow.ServiceBusyError{}, err)
+	}
+}
@@ -18,6 +18,8 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination workflowExecutionContext_mock.go -self_package github.com/uber/cadence/service/history
+
 package history
 
 import (
@@ -32,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/locks"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -50,8 +53,8 @@ type (
 		lock(ctx context.Context) error
 		unlock()
 
-		getHistorySize() int64
-		setHistorySize(size int64)
+		getExecutionStats() *persistence.ExecutionStats
+		setExecutionStats(stats *persistence.ExecutionStats)
 
 		persistFirstWorkflowEvents(
 			workflowEvents *persistence.WorkflowEvents,
@@ -62,7 +65,7 @@ type (
 
 		createWorkflowExecution(
 			newWorkflow *persistence.WorkflowSnapshot,
-			historySize int64,
+			stats *persistence.ExecutionStats,
 			now time.Time,
 			createMode int,
 			prevRunID string,
@@ -74,7 +77,7 @@ type (
 			prevLastWriteVersion int64,
 			prevState int,
 			resetMutableState mutableState,
-			resetHistorySize int64,
+			resetStats *persistence.ExecutionStats,
 		) (mutableState, error)
 		updateWorkflowExecutionAsActive(
 			now time.Time,
@@ -92,6 +95,9 @@ type (
 			newContext workflowExecutionContext,
 			newMutableState mutableState,
 		) error
+		updateWorkflowExecutionAsZombie(
+			now time.Time,
+		) error
 		updateWorkflowExecutionWithNew(
 			now time.Time,
 			newContext workflowExecutionContext,
@@ -106,7 +112,7 @@ type (
 			closeTask persistence.Task,
 			cleanupTask persistence.Task,
 			newMutableState mutableState,
-			newHistorySize int64,
+			newStats *persistence.ExecutionStats,
 			newTransferTasks []persistence.Task,
 			newTimerTasks []persistence.Task,
 			currentReplicationTasks []persistence.Task,
@@ -204,12 +210,12 @@ func (c *workflowExecutionContextImpl) getDomainName() string {
 	return domainEntry.GetInfo().Name
 }
 
-func (c *workflowExecutionContextImpl) getHistorySize() int64 {
-	return c.stats.HistorySize
+func (c *workflowExecutionContextImpl) getExecutionStats() *persistence.ExecutionStats {
+	return c.stats
 }
 
-func (c *workflowExecutionContextImpl) setHistorySize(size int64) {
-	c.stats.HistorySize = size
+func (c *workflowExecutionContextImpl) setExecutionStats(stats *persistence.ExecutionStats) {
+	c.stats = stats
 }
 
 func (c *workflowExecutionContextImpl) loadExecutionStats() (*persistence.ExecutionStats, error) {
@@ -253,6 +259,10 @@ func (c *workflowExecutionContextImpl) loadWorkflowExecutionInternal() error {
 	)
 	c.msBuilder.Load(response.State)
 	c.stats = response.State.ExecutionStats
+	if c.stats.EventCount == 0 {
+		// executions persisted before event counting was introduced
+		c.stats.EventCount = response.State.ExecutionInfo.NextEventID - common.FirstEventID
+	}
 	c.updateCondition = response.State.ExecutionInfo.NextEventID
 
 	// finally emit execution and session stats
@@ -286,7 +296,7 @@ func (c *workflowExecutionContextImpl) updateVersion() error {
 
 func (c *workflowExecutionContextImpl) createWorkflowExecution(
 	newWorkflow *persistence.WorkflowSnapshot,
-	historySize int64,
+	stats *persistence.ExecutionStats,
 	now time.Time,
 	createMode int,
 	prevRunID string,
@@ -302,9 +312,7 @@ func (c *workflowExecutionContextImpl) createWorkflowExecution(
 		NewWorkflowSnapshot: *newWorkflow,
 	}
 
-	createRequest.NewWorkflowSnapshot.ExecutionStats = &persistence.ExecutionStats{
-		HistorySize: historySize,
-	}
+	createRequest.NewWorkflowSnapshot.ExecutionStats = stats
 
 	_, err := c.createWorkflowExecutionWithRetry(createRequest)
 	if err != nil {
@@ -325,7 +333,7 @@ func (c *workflowExecutionContextImpl) conflictResolveWorkflowExecution(
 	prevLastWriteVersion int64,
 	prevState int,
 	resetMutableState mutableState,
-	resetHistorySize int64,
+	resetStats *persistence.ExecutionStats,
 ) (mutableState, error) {
 
 	// this only resets one mutableState for a workflow
@@ -342,9 +350,7 @@ func (c *workflowExecutionContextImpl) conflictResolveWorkflowExecution(
 		}
 	}
 
-	resetWorkflow.ExecutionStats = &persistence.ExecutionStats{
-		HistorySize: resetHistorySize,
-	}
+	resetWorkflow.ExecutionStats = resetStats
 
 	if err := c.shard.ConflictResolveWorkflowExecution(&persistence.ConflictResolveWorkflowExecutionRequest{
 		// previous workflow information
@@ -423,12 +429,47 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNewAsPassive(
 	)
 }
 
+func (c *workflowExecutionContextImpl) updateWorkflowExecutionAsZombie(
+	now time.Time,
+) error {
+
+	// zombie workflow is not referenced by the current record,
+	// so the current record must not be touched
+	return c.updateWorkflowExecutionWithMode(
+		now,
+		nil,
+		nil,
+		transactionPolicyPassive,
+		nil,
+		persistence.UpdateWorkflowModeBypassCurrent,
+	)
+}
+
 func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 	now time.Time,
 	newContext workflowExecutionContext,
 	newMutableState mutableState,
 	currentWorkflowTransactionPolicy transactionPolicy,
 	newWorkflowTransactionPolicy *transactionPolicy,
+) error {
+
+	return c.updateWorkflowExecutionWithMode(
+		now,
+		newContext,
+		newMutableState,
+		currentWorkflowTransactionPolicy,
+		newWorkflowTransactionPolicy,
+		persistence.UpdateWorkflowModeUpdateCurrent,
+	)
+}
+
+func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithMode(
+	now time.Time,
+	newContext workflowExecutionContext,
+	newMutableState mutableState,
+	currentWorkflowTransactionPolicy transactionPolicy,
+	newWorkflowTransactionPolicy *transactionPolicy,
+	updateMode persistence.UpdateWorkflowMode,
 ) (retError error) {
 
 	defer func() {
@@ -441,20 +482,24 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 	if err != nil {
 		return err
 	}
-	currentWorkflowSize := c.getHistorySize()
+	currentWorkflowStats := copyExecutionStats(c.getExecutionStats())
+	var currentEvents []*workflow.HistoryEvent
+	currentEventsSize := int64(0)
 	for _, workflowEvents := range workflowEventsSeq {
 		eventsSize, err := c.persistNonFirstWorkflowEvents(workflowEvents)
 		if err != nil {
 			return err
 		}
-		currentWorkflowSize += eventsSize
-	}
-	c.setHistorySize(currentWorkflowSize)
-	currentWorkflow.ExecutionStats = &persistence.ExecutionStats{
-		HistorySize: currentWorkflowSize,
+		updateExecutionStats(currentWorkflowStats, workflowEvents.Events, eventsSize)
+		currentEvents = append(currentEvents, workflowEvents.Events...)
+		currentEventsSize += eventsSize
 	}
+	c.setExecutionStats(currentWorkflowStats)
+	currentWorkflow.ExecutionStats = copyExecutionStats(currentWorkflowStats)
 
 	var newWorkflow *persistence.WorkflowSnapshot
+	var newEvents []*workflow.HistoryEvent
+	newEventsSize := int64(0)
 	if newContext != nil && newMutableState != nil && newWorkflowTransactionPolicy != nil {
 
 		defer func() {
@@ -467,16 +512,16 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 		if err != nil {
 			return err
 		}
-		newWorkflowSizeSize := newContext.getHistorySize()
+		newWorkflowStats := copyExecutionStats(newContext.getExecutionStats())
 		eventsSize, err := c.persistFirstWorkflowEvents(workflowEventsSeq[0])
 		if err != nil {
 			return err
 		}
-		newWorkflowSizeSize += eventsSize
-		newContext.setHistorySize(newWorkflowSizeSize)
-		newWorkflow.ExecutionStats = &persistence.ExecutionStats{
-			HistorySize: newWorkflowSizeSize,
-		}
+		updateExecutionStats(newWorkflowStats, workflowEventsSeq[0].Events, eventsSize)
+		newContext.setExecutionStats(newWorkflowStats)
+		newEvents = workflowEventsSeq[0].Events
+		newEventsSize = eventsSize
+		newWorkflow.ExecutionStats = copyExecutionStats(newWorkflowStats)
 	}
 
 	if err := c.mergeContinueAsNewReplicationTasks(
@@ -491,6 +536,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 		UpdateWorkflowMutation: *currentWorkflow,
 		NewWorkflowSnapshot:    newWorkflow,
 		// Encoding, this is set by shard context
+		Mode: updateMode,
 	})
 	if err != nil {
 		return err
@@ -499,6 +545,15 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 	// TODO remove updateCondition in favor of condition in mutable state
 	c.updateCondition = currentWorkflow.ExecutionInfo.NextEventID
 
+	// only bill the events written by this cluster, replicated events are billed by the active cluster
+	meter := c.shard.GetService().GetMeter()
+	if currentWorkflowTransactionPolicy == transactionPolicyActive {
+		meterHistoryEvents(meter, c.domainID, currentEvents, currentEventsSize)
+	}
+	if newWorkflow != nil && *newWorkflowTransactionPolicy == transactionPolicyActive {
+		meterHistoryEvents(meter, newWorkflow.ExecutionInfo.DomainID, newEvents, newEventsSize)
+	}
+
 	// for any change in the workflow, send a event
 	c.engine.NotifyNewHistoryEvent(newHistoryEventNotification(
 		c.domainID,
@@ -531,24 +586,86 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 	emitWorkflowHistoryStats(
 		c.metricsClient,
 		domainName,
-		int(c.stats.HistorySize),
-		int(c.msBuilder.GetNextEventID()-1),
+		c.stats,
 	)
 	emitSessionUpdateStats(
 		c.metricsClient,
 		domainName,
 		resp.MutableStateUpdateSessionStats,
 	)
+	c.emitWriteVersionStats(currentWorkflowTransactionPolicy)
 	// emit workflow completion stats if any
 	if currentWorkflow.ExecutionInfo.State == persistence.WorkflowStateCompleted {
 		if event, ok := c.msBuilder.GetCompletionEvent(); ok {
 			emitWorkflowCompletionStats(c.metricsClient, domainName, event)
+			executionInfo := c.msBuilder.GetExecutionInfo()
+			emitWorkflowTypeLatency(
+				c.metricsClient,
+				c.shard.GetConfig(),
+				domainName,
+				executionInfo.WorkflowTypeName,
+				metrics.WorkflowEndToEndLatency,
+				time.Duration(event.GetTimestamp()-executionInfo.StartTimestamp.UnixNano()),
+			)
 		}
 	}
 
 	return nil
 }
 
+// emitWriteVersionStats detects writes of a global domain workflow whose failover version does not match the
+// failover version of the domain, or active writes in a cluster which is not active for the domain, which
+// indicates misrouted traffic or split brain between clusters. Passive writes apply events replicated from
+// the active cluster, which lag behind a failover of the domain, so they are never considered stale.
+func (c *workflowExecutionContextImpl) emitWriteVersionStats(
+	transactionPolicy transactionPolicy,
+) {
+
+	writeVersion := c.msBuilder.GetCurrentVersion()
+	if writeVersion == common.EmptyVersion {
+		return
+	}
+	domainEntry, err := c.shard.GetDomainCache().GetDomainByID(c.domainID)
+	if err != nil || !domainEntry.IsGlobalDomain() {
+		return
+	}
+
+	domainVersion := domainEntry.GetFailoverVersion()
+	activeWriteOnPassiveDomain := transactionPolicy == transactionPolicyActive && !domainEntry.IsDomainActive()
+	// the version of a closed workflow is no longer updated, so a lower version is expected
+	staleVersionWrite := transactionPolicy == transactionPolicyActive && writeVersion < domainVersion &&
+		c.msBuilder.IsWorkflowExecutionRunning()
+	futureVersionWrite := writeVersion > domainVersion
+	if !activeWriteOnPassiveDomain && !staleVersionWrite && !futureVersionWrite {
+		return
+	}
+
+	writeCluster := c.shard.GetService().GetClusterMetadata().ClusterNameForFailoverVersion(writeVersion)
+	scope := c.metricsClient.Scope(
+		metrics.WorkflowContextScope,
+		metrics.DomainTag(domainEntry.GetInfo().Name),
+		metrics.WriteClusterTag(writeCluster),
+	)
+	logger := c.logger.WithTags(
+		tag.WorkflowDomainName(domainEntry.GetInfo().Name),
+		tag.ClusterName(writeCluster),
+		tag.CurrentVersion(writeVersion),
+		tag.FailoverVersion(domainVersion),
+	)
+	if activeWriteOnPassiveDomain {
+		scope.IncCounter(metrics.ActiveWriteOnPassiveDomainCounter)
+		logger.Warn("Mutable state is written as active while the domain is not active in current cluster.")
+	}
+	if staleVersionWrite {
+		scope.IncCounter(metrics.StaleVersionWriteCounter)
+		logger.Warn("Mutable state is written with a failover version lower than the domain failover version.")
+	}
+	if futureVersionWrite {
+		scope.IncCounter(metrics.FutureVersionWriteCounter)
+		logger.Warn("Mutable state is written with a failover version higher than the domain failover version.")
+	}
+}
+
 func (c *workflowExecutionContextImpl) notifyTasks(
 	transferTasks []persistence.Task,
 	replicationTasks []persistence.Task,
@@ -706,7 +823,7 @@ func (c *workflowExecutionContextImpl) appendHistoryEventsWithRetry(
 	err := backoff.Retry(
 		op,
 		persistenceOperationRetryPolicy,
-		common.IsPersistenceTransientError,
+		persistence.CountRetries(c.metricsClient, metrics.PersistenceAppendHistoryEventsScope, common.IsPersistenceTransientError),
 	)
 	return int64(resp), err
 }
@@ -727,11 +844,35 @@ func (c *workflowExecutionContextImpl) appendHistoryV2EventsWithRetry(
 	err := backoff.Retry(
 		op,
 		persistenceOperationRetryPolicy,
-		common.IsPersistenceTransientError,
+		persistence.CountRetries(c.metricsClient, metrics.PersistenceAppendHistoryNodesScope, common.IsPersistenceTransientError),
 	)
 	return int64(resp), err
 }
 
+// meterHistoryEvents records the billable usage of the history events against the domain, it is only called
+// once the events are committed by an active transaction, so replicated and failed writes are not billed
+func meterHistoryEvents(
+	meter metering.Meter,
+	domainID string,
+	events []*workflow.HistoryEvent,
+	size int64,
+) {
+
+	for _, event := range events {
+		switch event.GetEventType() {
+		case workflow.EventTypeWorkflowExecutionStarted:
+			meter.Add(domainID, metering.CounterWorkflowStarts, 1)
+		case workflow.EventTypeDecisionTaskCompleted:
+			meter.Add(domainID, metering.CounterDecisions, 1)
+		case workflow.EventTypeActivityTaskScheduled:
+			meter.Add(domainID, metering.CounterActivities, 1)
+		case workflow.EventTypeWorkflowExecutionSignaled:
+			meter.Add(domainID, metering.CounterSignals, 1)
+		}
+	}
+	meter.Add(domainID, metering.CounterHistoryBytes, size)
+}
+
 func (c *workflowExecutionContextImpl) createWorkflowExecutionWithRetry(
 	request *persistence.CreateWorkflowExecutionRequest,
 ) (*persistence.CreateWorkflowExecutionResponse, error) {
@@ -746,7 +887,7 @@ func (c *workflowExecutionContextImpl) createWorkflowExecutionWithRetry(
 	err := backoff.Retry(
 		op,
 		persistenceOperationRetryPolicy,
-		common.IsPersistenceTransientError,
+		persistence.CountRetries(c.metricsClient, metrics.PersistenceCreateWorkflowExecutionScope, common.IsPersistenceTransientError),
 	)
 	switch err.(type) {
 	case nil:
@@ -780,7 +921,7 @@ func (c *workflowExecutionContextImpl) getWorkflowExecutionWithRetry(
 	err := backoff.Retry(
 		op,
 		persistenceOperationRetryPolicy,
-		common.IsPersistenceTransientError,
+		persistence.CountRetries(c.metricsClient, metrics.PersistenceGetWorkflowExecutionScope, common.IsPersistenceTransientError),
 	)
 	switch err.(type) {
 	case nil:
@@ -811,7 +952,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithRetry(
 
 	err := backoff.Retry(
 		op, persistenceOperationRetryPolicy,
-		common.IsPersistenceTransientError,
+		persistence.CountRetries(c.metricsClient, metrics.PersistenceUpdateWorkflowExecutionScope, common.IsPersistenceTransientError),
 	)
 	switch err.(type) {
 	case nil:
@@ -840,7 +981,7 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(
 	closeTask persistence.Task,
 	cleanupTask persistence.Task,
 	newMutableState mutableState,
-	newHistorySize int64,
+	newStats *persistence.ExecutionStats,
 	newTransferTasks []persistence.Task,
 	newTimerTasks []persistence.Task,
 	currReplicationTasks []persistence.Task,
@@ -886,17 +1027,18 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(
 		var size int64
 		// TODO workflow execution reset logic generates replication tasks in its own business logic
 		currentExecutionInfo := currMutableState.GetExecutionInfo()
+		currentEvents := hBuilder.GetHistory().GetEvents()
 		size, retError = c.persistNonFirstWorkflowEvents(&persistence.WorkflowEvents{
 			DomainID:    currentExecutionInfo.DomainID,
 			WorkflowID:  currentExecutionInfo.WorkflowID,
 			RunID:       currentExecutionInfo.RunID,
 			BranchToken: currMutableState.GetCurrentBranch(),
-			Events:      hBuilder.GetHistory().GetEvents(),
+			Events:      currentEvents,
 		})
 		if retError != nil {
 			return
 		}
-		c.stats.HistorySize += int64(size)
+		updateExecutionStats(c.stats, currentEvents, size)
 	}
 
 	// TODO refactor resetWorkflowExecution to use CloseTransactionAsSnapshot
@@ -920,11 +1062,9 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(
 		if err != nil {
 			return err
 		}
-		newHistorySize += eventsSize
-	}
-	resetWorkflow.ExecutionStats = &persistence.ExecutionStats{
-		HistorySize: newHistorySize,
+		updateExecutionStats(newStats, workflowEvents.Events, eventsSize)
 	}
+	resetWorkflow.ExecutionStats = newStats
 	resetWorkflow.TransferTasks = newTransferTasks
 	resetWorkflow.ReplicationTasks = newReplicationTasks
 	resetWorkflow.TimerTasks = newTimerTasks
@@ -951,10 +1091,8 @@ func (c *workflowExecutionContextImpl) resetWorkflowExecution(
 
 	if updateCurr {
 		resetWFReq.CurrentWorkflowMutation = &persistence.WorkflowMutation{
-			ExecutionInfo: currMutableState.GetExecutionInfo(),
-			ExecutionStats: &persistence.ExecutionStats{
-				HistorySize: c.stats.HistorySize,
-			},
+			ExecutionInfo:    currMutableState.GetExecutionInfo(),
+			ExecutionStats:   copyExecutionStats(c.stats),
 			ReplicationState: currMutableState.GetReplicationState(),
 
 			UpsertActivityInfos:       []*persistence.ActivityInfo{},
@@ -0,0 +1,377 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metering"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	workflowExecutionContextSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		mockDomainCache  *cache.DomainCacheMock
+		mockMutableState *mockMutableState
+		mockExecutionMgr *mocks.ExecutionManager
+		mockHistoryV2Mgr *mocks.HistoryV2Manager
+		mockEngine       *MockHistoryEngine
+		clusterMetadata  cluster.Metadata
+		metricsScope     tally.TestScope
+		meter            *testMeter
+
+		context *workflowExecutionContextImpl
+	}
+
+	// meteredService is a test service recording the usage metered against it
+	meteredService struct {
+		service.Service
+		meter metering.Meter
+	}
+
+	// testMeter records the usage added per domain and counter, and the number of additions per counter
+	testMeter struct {
+		usage map[string]map[metering.Counter]int64
+		adds  map[metering.Counter]int
+	}
+)
+
+func TestWorkflowExecutionContextSuite(t *testing.T) {
+	s := new(workflowExecutionContextSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowExecutionContextSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	logger := loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.mockDomainCache = &cache.DomainCacheMock{}
+	s.mockMutableState = &mockMutableState{}
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+	s.mockEngine = &MockHistoryEngine{}
+	s.clusterMetadata = cluster.GetTestClusterMetadata(true, true)
+	s.metricsScope = tally.NewTestScope("test", nil)
+	s.meter = newTestMeter()
+	metricsClient := metrics.NewClient(s.metricsScope, metrics.History)
+
+	shard := &shardContextImpl{
+		service: &meteredService{
+			Service: service.NewTestService(s.clusterMetadata, nil, metricsClient, nil, nil, nil),
+			meter:   s.meter,
+		},
+		clusterMetadata:           s.clusterMetadata,
+		shardInfo:                 &persistence.ShardInfo{ShardID: 1, RangeID: 1},
+		transferSequenceNumber:    1,
+		maxTransferSequenceNumber: 100000,
+		executionManager:          s.mockExecutionMgr,
+		historyV2Mgr:              s.mockHistoryV2Mgr,
+		domainCache:               s.mockDomainCache,
+		config:                    NewDynamicConfigForTest(),
+		logger:                    logger,
+		throttledLogger:           logger,
+		metricsClient:             metricsClient,
+		timeSource:                clock.NewRealTimeSource(),
+	}
+	s.context = &workflowExecutionContextImpl{
+		domainID:      validDomainID,
+		shard:         shard,
+		engine:        s.mockEngine,
+		logger:        logger,
+		metricsClient: metricsClient,
+		msBuilder:     s.mockMutableState,
+		stats:         &persistence.ExecutionStats{},
+	}
+}
+
+func (s *workflowExecutionContextSuite) TearDownTest() {
+	s.mockDomainCache.AssertExpectations(s.T())
+	s.mockMutableState.AssertExpectations(s.T())
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+	s.mockEngine.AssertExpectations(s.T())
+}
+
+func (s *workflowExecutionContextSuite) TestEmitWriteVersionStats_Consistent() {
+	s.setupDomain(cluster.TestCurrentClusterName, cluster.TestCurrentClusterInitialFailoverVersion)
+	s.mockMutableState.On("GetCurrentVersion").Return(cluster.TestCurrentClusterInitialFailoverVersion)
+
+	s.context.emitWriteVersionStats(transactionPolicyActive)
+	s.Empty(s.emittedCounters())
+}
+
+func (s *workflowExecutionContextSuite) TestEmitWriteVersionStats_ActiveWriteOnPassiveDomain() {
+	version := cluster.TestAlternativeClusterInitialFailoverVersion
+	s.setupDomain(cluster.TestAlternativeClusterName, version)
+	s.mockMutableState.On("GetCurrentVersion").Return(version)
+
+	s.context.emitWriteVersionStats(transactionPolicyActive)
+	s.Equal(map[string]string{
+		"test.active_write_on_passive_domain": cluster.TestAlternativeClusterName,
+	}, s.emittedCounters())
+
+	// passive writes are expected while the domain is not active
+	s.resetMetrics()
+	s.context.emitWriteVersionStats(transactionPolicyPassive)
+	s.Empty(s.emittedCounters())
+}
+
+func (s *workflowExecutionContextSuite) TestEmitWriteVersionStats_StaleVersion() {
+	domainVersion := cluster.TestCurrentClusterInitialFailoverVersion + cluster.TestFailoverVersionIncrement
+	s.setupDomain(cluster.TestCurrentClusterName, domainVersion)
+	s.mockMutableState.On("GetCurrentVersion").Return(cluster.TestCurrentClusterInitialFailoverVersion)
+	s.mockMutableState.On("IsWorkflowExecutionRunning").Return(true).Once()
+
+	s.context.emitWriteVersionStats(transactionPolicyActive)
+	s.Equal(map[string]string{
+		"test.stale_version_write": cluster.TestCurrentClusterName,
+	}, s.emittedCounters())
+
+	// the version of closed workflows is not updated anymore
+	s.mockMutableState.On("IsWorkflowExecutionRunning").Return(false).Once()
+	s.resetMetrics()
+	s.context.emitWriteVersionStats(transactionPolicyActive)
+	s.Empty(s.emittedCounters())
+
+	// replicated events lag behind a failover of the domain
+	s.resetMetrics()
+	s.context.emitWriteVersionStats(transactionPolicyPassive)
+	s.Empty(s.emittedCounters())
+}
+
+func (s *workflowExecutionContextSuite) TestEmitWriteVersionStats_FutureVersion() {
+	s.setupDomain(cluster.TestCurrentClusterName, cluster.TestCurrentClusterInitialFailoverVersion)
+	s.mockMutableState.On("GetCurrentVersion").Return(cluster.TestAlternativeClusterInitialFailoverVersion)
+
+	s.context.emitWriteVersionStats(transactionPolicyPassive)
+	s.Equal(map[string]string{
+		"test.future_version_write": cluster.TestAlternativeClusterName,
+	}, s.emittedCounters())
+}
+
+func (s *workflowExecutionContextSuite) TestUpdateWorkflowExecution_ActiveMetersCommittedEvents() {
+	s.setupDomain(cluster.TestCurrentClusterName, cluster.TestCurrentClusterInitialFailoverVersion)
+	s.setupCurrentWorkflowTransaction(transactionPolicyActive, []*persistence.WorkflowEvents{
+		s.newWorkflowEvents("current-run", 100, workflow.EventTypeDecisionTaskCompleted, workflow.EventTypeActivityTaskScheduled),
+		s.newWorkflowEvents("current-run", 50, workflow.EventTypeWorkflowExecutionSignaled),
+	})
+	newContext, newMutableState := s.setupNewWorkflowTransaction(transactionPolicyActive)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+	s.setupCommitNotification()
+
+	s.NoError(s.context.updateWorkflowExecutionWithNewAsActive(time.Now(), newContext, newMutableState))
+	s.Equal(map[metering.Counter]int64{
+		metering.CounterWorkflowStarts: 1,
+		metering.CounterDecisions:      1,
+		metering.CounterActivities:     1,
+		metering.CounterSignals:        1,
+		metering.CounterHistoryBytes:   175,
+	}, s.meter.usage[validDomainID])
+	// the events of the current and the new workflow are metered once each
+	s.Equal(map[metering.Counter]int{
+		metering.CounterWorkflowStarts: 1,
+		metering.CounterDecisions:      1,
+		metering.CounterActivities:     1,
+		metering.CounterSignals:        1,
+		metering.CounterHistoryBytes:   2,
+	}, s.meter.adds)
+}
+
+func (s *workflowExecutionContextSuite) TestUpdateWorkflowExecution_FailedWriteNotMetered() {
+	s.setupDomain(cluster.TestCurrentClusterName, cluster.TestCurrentClusterInitialFailoverVersion)
+	s.setupCurrentWorkflowTransaction(transactionPolicyActive, []*persistence.WorkflowEvents{
+		s.newWorkflowEvents("current-run", 100, workflow.EventTypeDecisionTaskCompleted, workflow.EventTypeActivityTaskScheduled),
+	})
+	newContext, newMutableState := s.setupNewWorkflowTransaction(transactionPolicyActive)
+	// the history events are appended, but the mutable state write fails
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil, &persistence.ConditionFailedError{}).Once()
+
+	s.Equal(ErrConflict, s.context.updateWorkflowExecutionWithNewAsActive(time.Now(), newContext, newMutableState))
+	s.Empty(s.meter.usage)
+}
+
+func (s *workflowExecutionContextSuite) TestUpdateWorkflowExecution_PassiveNotMetered() {
+	s.setupDomain(cluster.TestAlternativeClusterName, cluster.TestAlternativeClusterInitialFailoverVersion)
+	s.setupCurrentWorkflowTransaction(transactionPolicyPassive, []*persistence.WorkflowEvents{
+		s.newWorkflowEvents("current-run", 100, workflow.EventTypeDecisionTaskCompleted, workflow.EventTypeActivityTaskScheduled),
+	})
+	newContext, newMutableState := s.setupNewWorkflowTransaction(transactionPolicyPassive)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Once()
+	s.setupCommitNotification()
+
+	// replicated events are metered by the active cluster
+	s.NoError(s.context.updateWorkflowExecutionWithNewAsPassive(time.Now(), newContext, newMutableState))
+	s.Empty(s.meter.usage)
+}
+
+func (s *workflowExecutionContextSuite) setupCurrentWorkflowTransaction(
+	policy transactionPolicy,
+	workflowEventsSeq []*persistence.WorkflowEvents,
+) {
+
+	mutation := &persistence.WorkflowMutation{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   validDomainID,
+			WorkflowID: "some random workflow ID",
+			RunID:      "current-run",
+		},
+	}
+	s.mockMutableState.On("CloseTransactionAsMutation", mock.Anything, policy).Return(mutation, workflowEventsSeq, nil).Once()
+}
+
+func (s *workflowExecutionContextSuite) setupNewWorkflowTransaction(
+	policy transactionPolicy,
+) (*workflowExecutionContextImpl, *mockMutableState) {
+
+	snapshot := &persistence.WorkflowSnapshot{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:   validDomainID,
+			WorkflowID: "some random workflow ID",
+			RunID:      "new-run",
+		},
+	}
+	newMutableState := &mockMutableState{}
+	newMutableState.On("CloseTransactionAsSnapshot", mock.Anything, policy).Return(snapshot, []*persistence.WorkflowEvents{
+		s.newWorkflowEvents("new-run", 25, workflow.EventTypeWorkflowExecutionStarted),
+	}, nil).Once()
+	newContext := &workflowExecutionContextImpl{
+		domainID:      validDomainID,
+		metricsClient: s.context.metricsClient,
+		msBuilder:     newMutableState,
+		stats:         &persistence.ExecutionStats{},
+	}
+	return newContext, newMutableState
+}
+
+// newWorkflowEvents returns a batch of events whose history append reports the given size
+func (s *workflowExecutionContextSuite) newWorkflowEvents(
+	runID string,
+	size int,
+	eventTypes ...workflow.EventType,
+) *persistence.WorkflowEvents {
+
+	branchToken := []byte(fmt.Sprintf("%v-%v", runID, size))
+	var events []*workflow.HistoryEvent
+	for i, eventType := range eventTypes {
+		events = append(events, &workflow.HistoryEvent{
+			EventId:   common.Int64Ptr(int64(i + 1)),
+			EventType: common.EventTypePtr(eventType),
+		})
+	}
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		return string(request.BranchToken) == string(branchToken)
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: size}, nil).Once()
+
+	return &persistence.WorkflowEvents{
+		DomainID:    validDomainID,
+		WorkflowID:  "some random workflow ID",
+		RunID:       runID,
+		BranchToken: branchToken,
+		Events:      events,
+	}
+}
+
+func (s *workflowExecutionContextSuite) setupCommitNotification() {
+	s.mockMutableState.On("GetLastFirstEventID").Return(int64(1))
+	s.mockMutableState.On("GetNextEventID").Return(int64(2))
+	s.mockMutableState.On("GetPreviousStartedEventID").Return(common.EmptyEventID)
+	s.mockMutableState.On("IsWorkflowExecutionRunning").Return(true)
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+	s.mockMutableState.On("GetCurrentVersion").Return(common.EmptyVersion)
+	s.mockEngine.On("NotifyNewHistoryEvent", mock.Anything).Return()
+	s.mockEngine.On("NotifyNewTransferTasks", mock.Anything).Return()
+	s.mockEngine.On("NotifyNewReplicationTasks", mock.Anything).Return()
+	s.mockEngine.On("NotifyNewTimerTasks", mock.Anything).Return()
+}
+
+func (s *workflowExecutionContextSuite) setupDomain(activeCluster string, failoverVersion int64) {
+	domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: validDomainID, Name: "some random domain name"},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: activeCluster,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		failoverVersion,
+		s.clusterMetadata,
+	)
+	s.mockDomainCache.On("GetDomainByID", validDomainID).Return(domainEntry, nil)
+}
+
+// emittedCounters returns the write cluster tag of the counters emitted for the test domain, keyed by counter name
+func (s *workflowExecutionContextSuite) resetMetrics() {
+	s.metricsScope = tally.NewTestScope("test", nil)
+	s.context.metricsClient = metrics.NewClient(s.metricsScope, metrics.History)
+}
+
+func (s *workflowExecutionContextSuite) emittedCounters() map[string]string {
+	emitted := make(map[string]string)
+	for _, counter := range s.metricsScope.Snapshot().Counters() {
+		if counter.Tags()["domain"] == "some random domain name" && counter.Value() > 0 {
+			emitted[counter.Name()] = counter.Tags()["write_cluster"]
+		}
+	}
+	return emitted
+}
+
+// GetMeter returns the meter recording the usage of the test
+func (s *meteredService) GetMeter() metering.Meter {
+	return s.meter
+}
+
+func newTestMeter() *testMeter {
+	return &testMeter{
+		usage: make(map[string]map[metering.Counter]int64),
+		adds:  make(map[metering.Counter]int),
+	}
+}
+
+func (m *testMeter) Start() {}
+
+func (m *testMeter) Stop() {}
+
+func (m *testMeter) Add(domainID string, counter metering.Counter, delta int64) {
+	if _, ok := m.usage[domainID]; !ok {
+		m.usage[domainID] = make(map[metering.Counter]int64)
+	}
+	m.usage[domainID][counter] += delta
+	m.adds[counter]++
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -32,15 +33,17 @@ import (
 func emitWorkflowHistoryStats(
 	metricsClient metrics.Client,
 	domainName string,
-	historySize int,
-	historyCount int,
+	stats *persistence.ExecutionStats,
 ) {
 
 	sizeScope := metricsClient.Scope(metrics.ExecutionSizeStatsScope, metrics.DomainTag(domainName))
 	countScope := metricsClient.Scope(metrics.ExecutionCountStatsScope, metrics.DomainTag(domainName))
 
-	sizeScope.RecordTimer(metrics.HistorySize, time.Duration(historySize))
-	countScope.RecordTimer(metrics.HistoryCount, time.Duration(historyCount))
+	sizeScope.RecordTimer(metrics.HistorySize, time.Duration(stats.HistorySize))
+	sizeScope.RecordTimer(metrics.ActivityPayloadSize, time.Duration(stats.ActivityPayloadBytes))
+	countScope.RecordTimer(metrics.HistoryCount, time.Duration(stats.EventCount))
+	countScope.RecordTimer(metrics.SignalEventCount, time.Duration(stats.SignalEventCount))
+	countScope.RecordTimer(metrics.TimerCount, time.Duration(stats.TimerCount))
 }
 
 func emitWorkflowExecutionStats(
@@ -132,3 +135,104 @@ func emitWorkflowCompletionStats(
 		scope.IncCounter(metrics.WorkflowTerminateCount)
 	}
 }
+
+type (
+	// activityLatencyRecorder records the latencies of an activity being closed, it needs to be
+	// created before the close event is added to mutable state, and the latencies should only be
+	// emitted once the close event is persisted
+	activityLatencyRecorder struct {
+		metricsClient      metrics.Client
+		config             *Config
+		domainName         string
+		workflowType       string
+		scheduledTimestamp int64
+		startedTimestamp   int64
+		closeTimestamp     int64
+	}
+)
+
+// newActivityLatencyRecorder returns nil if per workflow type metrics are not enabled for the workflow
+func newActivityLatencyRecorder(
+	metricsClient metrics.Client,
+	config *Config,
+	domainName string,
+	msBuilder mutableState,
+	ai *persistence.ActivityInfo,
+) *activityLatencyRecorder {
+
+	workflowType := msBuilder.GetExecutionInfo().WorkflowTypeName
+	if !isWorkflowTypeMetricsEnabled(config, domainName, workflowType) {
+		return nil
+	}
+
+	// the scheduled event is used instead of activity info scheduled time, as
+	// the latter is the scheduled time of the current attempt
+	scheduledTimestamp := ai.ScheduledTime.UnixNano()
+	if scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID); ok {
+		scheduledTimestamp = scheduledEvent.GetTimestamp()
+	}
+	return &activityLatencyRecorder{
+		metricsClient:      metricsClient,
+		config:             config,
+		domainName:         domainName,
+		workflowType:       workflowType,
+		scheduledTimestamp: scheduledTimestamp,
+		startedTimestamp:   ai.StartedTime.UnixNano(),
+	}
+}
+
+func (r *activityLatencyRecorder) setCloseEvent(
+	event *workflow.HistoryEvent,
+) {
+
+	if r == nil {
+		return
+	}
+	r.closeTimestamp = event.GetTimestamp()
+}
+
+func (r *activityLatencyRecorder) emit() {
+	if r == nil || r.closeTimestamp == 0 {
+		return
+	}
+
+	scope := r.metricsClient.Scope(
+		metrics.WorkflowTypeLatencyStatsScope,
+		metrics.DomainTag(r.domainName),
+		metrics.WorkflowTypeTag(r.workflowType),
+	)
+	scope.RecordTimer(metrics.ActivityStartToCloseLatency, time.Duration(r.closeTimestamp-r.startedTimestamp))
+	scope.RecordTimer(metrics.ActivityEndToEndLatency, time.Duration(r.closeTimestamp-r.scheduledTimestamp))
+}
+
+func isWorkflowTypeMetricsEnabled(
+	config *Config,
+	domainName string,
+	workflowType string,
+) bool {
+
+	return common.IsWorkflowTypeMetricsAllowlisted(config.WorkflowTypeMetricsAllowlist(domainName), workflowType)
+}
+
+// emitWorkflowTypeLatency records the latency tagged by domain and workflow type, the
+// workflow type tag is only emitted for workflow types allowlisted in dynamic config
+func emitWorkflowTypeLatency(
+	metricsClient metrics.Client,
+	config *Config,
+	domainName string,
+	workflowType string,
+	metric int,
+	latency time.Duration,
+) {
+
+	if !isWorkflowTypeMetricsEnabled(config, domainName, workflowType) {
+		return
+	}
+
+	scope := metricsClient.Scope(
+		metrics.WorkflowTypeLatencyStatsScope,
+		metrics.DomainTag(domainName),
+		metrics.WorkflowTypeTag(workflowType),
+	)
+	scope.RecordTimer(metric, latency)
+}
@@ -25,7 +25,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -84,7 +83,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(
 		return nil, retError
 	}
 
-	resetNewRunID := uuid.New()
+	resetNewRunID := w.eng.config.IDGenerator.RunID()
 	response := &workflow.ResetWorkflowExecutionResponse{
 		RunId: common.StringPtr(resetNewRunID),
 	}
@@ -102,7 +101,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(
 		return response, retError
 	}
 
-	newMutableState, newHistorySize, newTransferTasks, newTimerTasks, retError := w.buildNewMutableStateForReset(
+	newMutableState, newStats, newTransferTasks, newTimerTasks, retError := w.buildNewMutableStateForReset(
 		ctx, domainEntry, baseMutableState, currMutableState,
 		request.GetReason(), request.GetDecisionFinishEventId(), request.GetRequestId(), resetNewRunID,
 	)
@@ -133,7 +132,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(
 	// finally, write to persistence
 	retError = currContext.resetWorkflowExecution(
 		currMutableState, currTerminated, currCloseTask, currCleanupTask,
-		newMutableState, newHistorySize, newTransferTasks, newTimerTasks,
+		newMutableState, newStats, newTransferTasks, newTimerTasks,
 		currReplicationTasks, newReplicationTasks, baseMutableState.GetExecutionInfo().RunID,
 		baseMutableState.GetNextEventID(),
 	)
@@ -246,14 +245,14 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	resetReason string,
 	resetDecisionCompletedEventID int64,
 	requestedID, newRunID string,
-) (newMutableState mutableState, newHistorySize int64, newTransferTasks, newTimerTasks []persistence.Task, retError error) {
+) (newMutableState mutableState, newStats *persistence.ExecutionStats, newTransferTasks, newTimerTasks []persistence.Task, retError error) {
 
 	domainID := baseMutableState.GetExecutionInfo().DomainID
 	workflowID := baseMutableState.GetExecutionInfo().WorkflowID
 	baseRunID := baseMutableState.GetExecutionInfo().RunID
 
 	// replay history to reset point(exclusive) to rebuild mutableState
-	forkEventVersion, wfTimeoutSecs, receivedSignals, continueRunID, newStateBuilder, historySize, retError := w.replayHistoryEvents(
+	forkEventVersion, wfTimeoutSecs, receivedSignals, continueRunID, newStateBuilder, stats, retError := w.replayHistoryEvents(
 		domainEntry, resetDecisionCompletedEventID, requestedID, baseMutableState, newRunID,
 	)
 	if retError != nil {
@@ -271,7 +270,7 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 		return
 	}
 
-	newHistorySize = historySize
+	newStats = stats
 
 	retError = w.validateResetWorkflowAfterReplay(newMutableState)
 	if retError != nil {
@@ -559,7 +558,7 @@ func (w *workflowResetorImpl) replayHistoryEvents(
 	requestID string,
 	prevMutableState mutableState,
 	newRunID string,
-) (forkEventVersion, wfTimeoutSecs int64, receivedSignalsAfterReset []*workflow.HistoryEvent, continueRunID string, sBuilder stateBuilder, newHistorySize int64, retError error) {
+) (forkEventVersion, wfTimeoutSecs int64, receivedSignalsAfterReset []*workflow.HistoryEvent, continueRunID string, sBuilder stateBuilder, newStats *persistence.ExecutionStats, retError error) {
 
 	prevExecution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(prevMutableState.GetExecutionInfo().WorkflowID),
@@ -578,6 +577,7 @@ func (w *workflowResetorImpl) replayHistoryEvents(
 	}
 	var resetMutableState *mutableStateBuilder
 	var lastBatch []*workflow.HistoryEvent
+	newStats = &persistence.ExecutionStats{}
 
 	for {
 		var readResp *persistence.ReadHistoryBranchByBatchResponse
@@ -644,8 +644,9 @@ func (w *workflowResetorImpl) replayHistoryEvents(
 			if retError != nil {
 				return
 			}
+			updateExecutionStats(newStats, history, 0)
 		}
-		newHistorySize += int64(readResp.Size)
+		newStats.HistorySize += int64(readResp.Size)
 		if len(readResp.NextPageToken) > 0 {
 			readReq.NextPageToken = readResp.NextPageToken
 		} else {
@@ -709,7 +710,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 ) error {
 	var currContext workflowExecutionContext
 	var baseMutableState, currMutableState, newMsBuilder mutableState
-	var newHistorySize int64
+	var newStats *persistence.ExecutionStats
 	var newRunTransferTasks, newRunTimerTasks []persistence.Task
 
 	resetAttr, retError := validateResetReplicationTask(request)
@@ -759,7 +760,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 		}
 	}
 	// before changing mutable state
-	newMsBuilder, newHistorySize, newRunTransferTasks, newRunTimerTasks, retError = w.replicateResetEvent(baseMutableState, &baseExecution, historyAfterReset, resetAttr.GetForkEventVersion())
+	newMsBuilder, newStats, newRunTransferTasks, newRunTimerTasks, retError = w.replicateResetEvent(baseMutableState, &baseExecution, historyAfterReset, resetAttr.GetForkEventVersion())
 	if retError != nil {
 		return retError
 	}
@@ -795,7 +796,7 @@ func (w *workflowResetorImpl) ApplyResetEvent(
 		nil,
 		nil,
 		newMsBuilder,
-		newHistorySize,
+		newStats,
 		newRunTransferTasks,
 		newRunTimerTasks,
 		nil,
@@ -817,7 +818,7 @@ func (w *workflowResetorImpl) replicateResetEvent(
 	baseExecution *workflow.WorkflowExecution,
 	newRunHistory []*workflow.HistoryEvent,
 	forkEventVersion int64,
-) (newMsBuilder mutableState, newHistorySize int64, transferTasks, timerTasks []persistence.Task, retError error) {
+) (newMsBuilder mutableState, newStats *persistence.ExecutionStats, transferTasks, timerTasks []persistence.Task, retError error) {
 	domainID := baseMutableState.GetExecutionInfo().DomainID
 	workflowID := baseMutableState.GetExecutionInfo().WorkflowID
 	firstEvent := newRunHistory[0]
@@ -825,7 +826,7 @@ func (w *workflowResetorImpl) replicateResetEvent(
 	decisionFinishEventID := firstEvent.GetEventId()
 	resetAttr := firstEvent.GetDecisionTaskFailedEventAttributes()
 
-	requestID := uuid.New()
+	requestID := w.eng.config.IDGenerator.RequestID()
 	var sBuilder stateBuilder
 	var wfTimeoutSecs int64
 
@@ -837,6 +838,7 @@ func (w *workflowResetorImpl) replicateResetEvent(
 	// replay old history from beginning of the baseRun upto decisionFinishEventID(exclusive)
 	var nextPageToken []byte
 	var lastEvent *workflow.HistoryEvent
+	newStats = &persistence.ExecutionStats{}
 	readReq := &persistence.ReadHistoryBranchRequest{
 		BranchToken:   baseMutableState.GetCurrentBranch(),
 		MinEventID:    common.FirstEventID,
@@ -874,8 +876,9 @@ func (w *workflowResetorImpl) replicateResetEvent(
 			if retError != nil {
 				return
 			}
+			updateExecutionStats(newStats, events, 0)
 		}
-		newHistorySize += int64(readResp.Size)
+		newStats.HistorySize += int64(readResp.Size)
 		if len(readResp.NextPageToken) > 0 {
 			readReq.NextPageToken = readResp.NextPageToken
 		} else {
@@ -0,0 +1,288 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// adaptiveScaler adjusts the number of read and write partitions of a task list to its traffic.
+	// It runs on the root partition, which estimates the traffic of the whole task list from its own
+	// share of the traffic since the load balancer spreads tasks and polls evenly across partitions.
+	// The partition config is persisted with the root partition, the hosts routing requests to the
+	// task list refresh it from there so they all converge to the same number of partitions.
+	//
+	// Partitions are added once the rate per partition stays above PartitionUpscaleRPS for
+	// UpscaleSustainedPeriod and removed once it stays below PartitionDownscaleRPS for
+	// DownscaleSustainedPeriod. Write partitions are removed first, the read partitions follow
+	// at least one DownscaleSustainedPeriod later, once every removed partition drained its backlog.
+	adaptiveScaler struct {
+		config            *taskListConfig
+		db                *taskListDB
+		pollerHistory     *pollerHistory
+		describePartition describePartitionFn
+		logger            log.Logger
+		scope             func() metrics.Scope
+
+		addCount      int64
+		dispatchCount int64
+
+		lastEvaluation time.Time
+		overloadSince  time.Time
+		underloadSince time.Time
+		// writeDownscaleTime is when the write partitions were last removed, zero once the read partitions followed
+		writeDownscaleTime time.Time
+
+		status     int32
+		shutdownCh chan struct{}
+	}
+
+	// describePartitionFn describes a non root partition of the task list, including its backlog status
+	describePartitionFn func(partition int) (*workflow.DescribeTaskListResponse, error)
+)
+
+func newAdaptiveScaler(
+	config *taskListConfig,
+	db *taskListDB,
+	pollerHistory *pollerHistory,
+	describePartition describePartitionFn,
+	logger log.Logger,
+	scope func() metrics.Scope,
+) *adaptiveScaler {
+	return &adaptiveScaler{
+		config:            config,
+		db:                db,
+		pollerHistory:     pollerHistory,
+		describePartition: describePartition,
+		logger:            logger,
+		scope:             scope,
+		status:            common.DaemonStatusInitialized,
+		shutdownCh:        make(chan struct{}),
+	}
+}
+
+func (s *adaptiveScaler) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	s.lastEvaluation = time.Now()
+	go s.run()
+}
+
+func (s *adaptiveScaler) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(s.shutdownCh)
+}
+
+// recordAdd counts a task added to the root partition by a producer
+func (s *adaptiveScaler) recordAdd() {
+	atomic.AddInt64(&s.addCount, 1)
+}
+
+// recordDispatch counts a task dispatched to a poller by the root partition
+func (s *adaptiveScaler) recordDispatch() {
+	atomic.AddInt64(&s.dispatchCount, 1)
+}
+
+// partitionConfig returns the current partitions of the task list, the static ones until the scaler changed them
+func (s *adaptiveScaler) partitionConfig() *persistence.TaskListPartitionConfig {
+	if config := s.db.PartitionConfig(); config != nil {
+		return config
+	}
+	return &persistence.TaskListPartitionConfig{
+		NumReadPartitions:  s.config.NumReadPartitions(),
+		NumWritePartitions: s.config.NumWritePartitions(),
+	}
+}
+
+func (s *adaptiveScaler) run() {
+	timer := time.NewTimer(s.config.AdaptiveScalerUpdateInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case now := <-timer.C:
+			s.evaluate(now)
+			timer.Reset(s.config.AdaptiveScalerUpdateInterval())
+		}
+	}
+}
+
+func (s *adaptiveScaler) evaluate(now time.Time) {
+	elapsed := now.Sub(s.lastEvaluation).Seconds()
+	s.lastEvaluation = now
+	addCount := atomic.SwapInt64(&s.addCount, 0)
+	dispatchCount := atomic.SwapInt64(&s.dispatchCount, 0)
+	if elapsed <= 0 || !s.config.EnableAdaptiveScaler() {
+		s.overloadSince = time.Time{}
+		s.underloadSince = time.Time{}
+		return
+	}
+
+	current := s.partitionConfig()
+	partitionQPS := math.Max(float64(addCount), float64(dispatchCount)) / elapsed
+	estimatedQPS := partitionQPS * float64(current.NumWritePartitions)
+	scope := s.scope()
+	scope.UpdateGauge(metrics.EstimatedAddTaskQPSGauge, estimatedQPS)
+	scope.UpdateGauge(metrics.TaskListReadPartitionsGauge, float64(current.NumReadPartitions))
+	scope.UpdateGauge(metrics.TaskListWritePartitionsGauge, float64(current.NumWritePartitions))
+
+	numWritePartitions := current.NumWritePartitions
+	switch {
+	case partitionQPS > float64(s.config.PartitionUpscaleRPS()):
+		s.underloadSince = time.Time{}
+		if s.overloadSince.IsZero() {
+			s.overloadSince = now
+		}
+		if now.Sub(s.overloadSince) >= s.config.UpscaleSustainedPeriod() {
+			numWritePartitions = s.targetPartitions(estimatedQPS)
+			// a partition without pollers never dispatches its tasks, there is no point in having more
+			// partitions than pollers
+			if numWritePartitions > current.NumWritePartitions {
+				pollers, err := s.countPollers(current.NumReadPartitions)
+				if err != nil {
+					s.logger.Warn("Failed to count task list pollers, skip upscale", tag.Error(err))
+					numWritePartitions = current.NumWritePartitions
+				} else if numWritePartitions > pollers {
+					numWritePartitions = common.MaxInt(current.NumWritePartitions, pollers)
+				}
+			}
+		}
+	case partitionQPS < float64(s.config.PartitionDownscaleRPS()):
+		s.overloadSince = time.Time{}
+		if s.underloadSince.IsZero() {
+			s.underloadSince = now
+		}
+		if now.Sub(s.underloadSince) >= s.config.DownscaleSustainedPeriod() {
+			numWritePartitions = common.MinInt(current.NumWritePartitions, s.targetPartitions(estimatedQPS))
+		}
+	default:
+		s.overloadSince = time.Time{}
+		s.underloadSince = time.Time{}
+	}
+	numWritePartitions = s.clampPartitions(numWritePartitions)
+
+	numReadPartitions := current.NumReadPartitions
+	switch {
+	case numWritePartitions > numReadPartitions:
+		numReadPartitions = numWritePartitions
+	case numWritePartitions < current.NumWritePartitions:
+		// keep reading from the removed partitions until their backlog is drained
+		s.writeDownscaleTime = now
+	case numReadPartitions > numWritePartitions && !s.writeDownscaleTime.IsZero() &&
+		now.Sub(s.writeDownscaleTime) >= s.config.DownscaleSustainedPeriod() &&
+		s.isBacklogDrained(numWritePartitions, numReadPartitions):
+		numReadPartitions = numWritePartitions
+		s.writeDownscaleTime = time.Time{}
+	}
+
+	if numReadPartitions == current.NumReadPartitions && numWritePartitions == current.NumWritePartitions {
+		return
+	}
+	s.updatePartitionConfig(current, &persistence.TaskListPartitionConfig{
+		Version:            current.Version + 1,
+		NumReadPartitions:  numReadPartitions,
+		NumWritePartitions: numWritePartitions,
+	}, estimatedQPS)
+}
+
+func (s *adaptiveScaler) updatePartitionConfig(
+	current *persistence.TaskListPartitionConfig,
+	config *persistence.TaskListPartitionConfig,
+	estimatedQPS float64,
+) {
+	scope := s.scope()
+	if err := s.db.UpdatePartitionConfig(config); err != nil {
+		scope.IncCounter(metrics.PartitionUpdateFailedCounter)
+		s.logger.Error("Failed to update task list partitions", tag.Error(err))
+		return
+	}
+	if config.NumWritePartitions > current.NumWritePartitions || config.NumReadPartitions > current.NumReadPartitions {
+		scope.IncCounter(metrics.PartitionUpscaleCounter)
+	} else {
+		scope.IncCounter(metrics.PartitionDownscaleCounter)
+	}
+	// scaling restarts from the new partitions
+	s.overloadSince = time.Time{}
+	s.underloadSince = time.Time{}
+	s.logger.Info("Task list partitions updated",
+		tag.WorkflowTaskListPartitionVersion(config.Version),
+		tag.WorkflowTaskListReadPartitions(config.NumReadPartitions),
+		tag.WorkflowTaskListWritePartitions(config.NumWritePartitions),
+		tag.Value(estimatedQPS))
+}
+
+// countPollers returns the number of pollers of the given read partitions, a poller is counted once for
+// each partition it polls since each partition needs its own pollers to dispatch its tasks
+func (s *adaptiveScaler) countPollers(numPartitions int) (int, error) {
+	pollers := len(s.pollerHistory.getAllPollerInfo())
+	for partition := 1; partition < numPartitions; partition++ {
+		resp, err := s.describePartition(partition)
+		if err != nil {
+			return 0, err
+		}
+		pollers += len(resp.Pollers)
+	}
+	return pollers, nil
+}
+
+// isBacklogDrained returns true if every partition in [fromPartition, toPartition) has no backlog,
+// i.e. all the tasks it read are acked and there is no task left to read
+func (s *adaptiveScaler) isBacklogDrained(fromPartition int, toPartition int) bool {
+	for partition := common.MaxInt(1, fromPartition); partition < toPartition; partition++ {
+		resp, err := s.describePartition(partition)
+		if err != nil {
+			s.logger.Warn("Failed to describe task list partition, keep reading from it", tag.Error(err))
+			return false
+		}
+		status := resp.TaskListStatus
+		if status == nil || status.GetAckLevel() != status.GetReadLevel() || status.GetBacklogCountHint() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// targetPartitions returns the number of partitions needed to keep the rate per partition below the upscale threshold
+func (s *adaptiveScaler) targetPartitions(estimatedQPS float64) int {
+	upscaleRPS := float64(common.MaxInt(1, s.config.PartitionUpscaleRPS()))
+	return int(math.Ceil(estimatedQPS / upscaleRPS))
+}
+
+func (s *adaptiveScaler) clampPartitions(partitions int) int {
+	minPartitions := s.config.AdaptiveScalerMinPartitions()
+	maxPartitions := common.MaxInt(minPartitions, s.config.AdaptiveScalerMaxPartitions())
+	return common.MaxInt(minPartitions, common.MinInt(maxPartitions, partitions))
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+// testPartitions fakes the non root partitions of a task list, which are drained and have no pollers by default
+type testPartitions map[int]*workflow.DescribeTaskListResponse
+
+func (p testPartitions) describe(partition int) (*workflow.DescribeTaskListResponse, error) {
+	if resp, ok := p[partition]; ok {
+		return resp, nil
+	}
+	return &workflow.DescribeTaskListResponse{
+		TaskListStatus: &workflow.TaskListStatus{
+			AckLevel:         common.Int64Ptr(10),
+			ReadLevel:        common.Int64Ptr(10),
+			BacklogCountHint: common.Int64Ptr(0),
+		},
+	}, nil
+}
+
+func createTestAdaptiveScaler(t *testing.T) *adaptiveScaler {
+	cfg := defaultTestConfig()
+	cfg.EnableAdaptiveScaler = func(string, string, int) bool { return true }
+	cfg.PartitionUpscaleRPS = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(100)
+	cfg.PartitionDownscaleRPS = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(50)
+	cfg.UpscaleSustainedPeriod = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+	cfg.DownscaleSustainedPeriod = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(2 * time.Minute)
+	cfg.AdaptiveScalerMinPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+	cfg.AdaptiveScalerMaxPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(4)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	require.NotNil(t, tlm.scaler)
+	_, err := tlm.db.RenewLease()
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		tlm.pollerHistory.updatePollerInfo(pollerIdentity(string(rune('a'+i))), nil)
+	}
+	tlm.scaler.describePartition = testPartitions{}.describe
+	return tlm.scaler
+}
+
+// evaluateRate records tasks at the given rate for the given period and evaluates the scaler
+func evaluateRate(s *adaptiveScaler, now time.Time, period time.Duration, qps int) time.Time {
+	s.lastEvaluation = now
+	s.addCount = int64(qps * int(period.Seconds()))
+	now = now.Add(period)
+	s.evaluate(now)
+	return now
+}
+
+func TestAdaptiveScaler_Upscale(t *testing.T) {
+	s := createTestAdaptiveScaler(t)
+	require.Equal(t, 1, s.partitionConfig().NumWritePartitions)
+
+	now := evaluateRate(s, time.Now(), time.Minute, 250)
+	require.Nil(t, s.db.PartitionConfig(), "upscale only after the sustained period")
+
+	now = evaluateRate(s, now, time.Minute, 250)
+	config := s.db.PartitionConfig()
+	require.NotNil(t, config)
+	require.Equal(t, int64(1), config.Version)
+	require.Equal(t, 3, config.NumWritePartitions)
+	require.Equal(t, 3, config.NumReadPartitions)
+	require.Equal(t, 3, s.config.NumWritePartitions())
+	require.Equal(t, 3, s.config.NumReadPartitions())
+
+	// 3 partitions at 250 rps each need 8 partitions, bounded by the max of 4
+	now = evaluateRate(s, now, time.Minute, 250)
+	evaluateRate(s, now, time.Minute, 250)
+	config = s.db.PartitionConfig()
+	require.Equal(t, int64(2), config.Version)
+	require.Equal(t, 4, config.NumWritePartitions)
+	require.Equal(t, 4, config.NumReadPartitions)
+}
+
+func TestAdaptiveScaler_UpscaleBoundedByPollers(t *testing.T) {
+	s := createTestAdaptiveScaler(t)
+	s.pollerHistory = newPollerHistory()
+	s.pollerHistory.updatePollerInfo(pollerIdentity("a"), nil)
+	s.pollerHistory.updatePollerInfo(pollerIdentity("b"), nil)
+
+	now := evaluateRate(s, time.Now(), time.Minute, 350)
+	evaluateRate(s, now, time.Minute, 350)
+	config := s.db.PartitionConfig()
+	require.NotNil(t, config)
+	require.Equal(t, 2, config.NumWritePartitions)
+	require.Equal(t, 2, config.NumReadPartitions)
+}
+
+func TestAdaptiveScaler_UpscaleBoundedByPollersOfAllPartitions(t *testing.T) {
+	s := createTestAdaptiveScaler(t)
+	s.config.AdaptiveScalerMaxPartitions = func() int { return 2 }
+	now := evaluateRate(s, time.Now(), time.Minute, 350)
+	now = evaluateRate(s, now, time.Minute, 350)
+	require.Equal(t, 2, s.db.PartitionConfig().NumWritePartitions)
+
+	// the root partition has a single poller, the other partition has two of its own
+	s.config.AdaptiveScalerMaxPartitions = func() int { return 8 }
+	s.pollerHistory = newPollerHistory()
+	s.pollerHistory.updatePollerInfo(pollerIdentity("a"), nil)
+	s.describePartition = testPartitions{
+		1: {Pollers: []*workflow.PollerInfo{{Identity: common.StringPtr("b")}, {Identity: common.StringPtr("c")}}},
+	}.describe
+	now = evaluateRate(s, now, time.Minute, 350)
+	evaluateRate(s, now, time.Minute, 350)
+	config := s.db.PartitionConfig()
+	require.Equal(t, 3, config.NumWritePartitions)
+	require.Equal(t, 3, config.NumReadPartitions)
+}
+
+func TestAdaptiveScaler_Downscale(t *testing.T) {
+	s := createTestAdaptiveScaler(t)
+	now := evaluateRate(s, time.Now(), time.Minute, 350)
+	now = evaluateRate(s, now, time.Minute, 350)
+	require.Equal(t, 4, s.db.PartitionConfig().NumWritePartitions)
+
+	// 4 partitions at 20 rps each need a single partition
+	now = evaluateRate(s, now, time.Minute, 20)
+	require.Equal(t, 4, s.db.PartitionConfig().NumWritePartitions, "downscale only after the sustained period")
+	now = evaluateRate(s, now, 2*time.Minute, 20)
+	config := s.db.PartitionConfig()
+	require.Equal(t, 1, config.NumWritePartitions)
+	require.Equal(t, 4, config.NumReadPartitions, "read partitions are kept until the backlog is drained")
+
+	now = evaluateRate(s, now, time.Minute, 60)
+	require.Equal(t, 4, s.db.PartitionConfig().NumReadPartitions)
+
+	// a removed partition still has a backlog
+	s.describePartition = testPartitions{
+		3: {TaskListStatus: &workflow.TaskListStatus{
+			AckLevel:         common.Int64Ptr(10),
+			ReadLevel:        common.Int64Ptr(12),
+			BacklogCountHint: common.Int64Ptr(2),
+		}},
+	}.describe
+	now = evaluateRate(s, now, time.Minute, 60)
+	require.Equal(t, 4, s.db.PartitionConfig().NumReadPartitions, "read partitions are kept until every backlog is drained")
+
+	s.describePartition = testPartitions{}.describe
+	evaluateRate(s, now, time.Minute, 60)
+	config = s.db.PartitionConfig()
+	require.Equal(t, int64(3), config.Version)
+	require.Equal(t, 1, config.NumWritePartitions)
+	require.Equal(t, 1, config.NumReadPartitions)
+}
+
+func TestAdaptiveScaler_Disabled(t *testing.T) {
+	s := createTestAdaptiveScaler(t)
+	s.config.EnableAdaptiveScaler = func() bool { return false }
+	now := evaluateRate(s, time.Now(), time.Minute, 350)
+	evaluateRate(s, now, time.Minute, 350)
+	require.Nil(t, s.db.PartitionConfig())
+	require.Equal(t, 1, s.config.NumWritePartitions())
+}
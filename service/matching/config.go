@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/idgenerator"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -35,6 +36,11 @@ type (
 		EnableSyncMatch   dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 		RPS               dynamicconfig.IntPropertyFn
 
+		// Logging of slow persistence operations
+		PersistenceSlowQueryThreshold     dynamicconfig.DurationPropertyFn
+		PersistenceLargeRowSizeThreshold  dynamicconfig.IntPropertyFn
+		PersistenceSlowQueryLogSampleRate dynamicconfig.FloatPropertyFn
+
 		// taskListManager configuration
 		RangeSize                    int64
 		GetTasksBatchSize            dynamicconfig.IntPropertyFnWithTaskListInfoFilters
@@ -48,16 +54,58 @@ type (
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+		// Time without polls after which a worker specific task list is considered dead
+		WorkerTaskListLivenessTimeout dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// Number of task priorities and the ratio at which backlog of adjacent priorities is dispatched
+		NumTaskPriorities         dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		TaskPriorityDispatchRatio dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+		// Whether decision tasks prefer pollers of the worker which recently processed the same workflow
+		EnableWorkflowAffinity    dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		WorkflowAffinityCacheSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+		// Whether dispatching of the task list is stopped and its pollers receive empty responses
+		TaskListDrained dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+
+		// Time without polls after which a worker of a task list is considered stale
+		StaleWorkerThreshold dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// Adaptive scaling of the number of partitions of a task list
+		EnableAdaptiveScaler           dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		AdaptiveScalerUpdateInterval   dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleRPS            dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleRPS          dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		UpscaleSustainedPeriod         dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		DownscaleSustainedPeriod       dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		AdaptiveScalerMinPartitions    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		AdaptiveScalerMaxPartitions    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionConfigRefreshInterval dynamicconfig.DurationPropertyFn
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		// Time to hold a decision poll request which picked up a task it is incompatible with, so that
+		// the task is left to compatible pollers instead of being picked up again by the same poller
+		IncompatiblePollBackoff dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
+
+		// ActivityTypeMetricsAllowlist is the comma separated list of activity types for which per activity type metrics are emitted
+		ActivityTypeMetricsAllowlist dynamicconfig.StringPropertyFnWithDomainFilter
+
+		// DomainProcessingPaused is whether task dispatch is paused for a domain
+		DomainProcessingPaused dynamicconfig.BoolPropertyFnWithDomainFilter
+
+		// TaskTokenSigningKey is the key task tokens are signed with, tokens are not signed if empty
+		TaskTokenSigningKey []byte
+		// IDGenerator generates the IDs of query tasks and of the requests the service sends
+		IDGenerator idgenerator.Generator
 	}
 
 	forwarderConfig struct {
@@ -84,32 +132,78 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// Time without polls after which a worker specific task list is considered dead
+		WorkerTaskListLivenessTimeout func() time.Duration
+		// Number of task priorities and the ratio at which backlog of adjacent priorities is dispatched
+		NumTaskPriorities         func() int
+		TaskPriorityDispatchRatio func() int
+		// Whether decision tasks prefer pollers of the worker which recently processed the same workflow
+		EnableWorkflowAffinity    func() bool
+		WorkflowAffinityCacheSize func() int
+		// Whether task dispatch is paused for the domain of the task list
+		DomainProcessingPaused func() bool
+		// Whether dispatching of the task list is stopped and its pollers receive empty responses
+		Drained func() bool
+		// Time without polls after which a worker of the task list is considered stale
+		StaleWorkerThreshold func() time.Duration
+		// Adaptive scaling of the number of partitions of the task list
+		EnableAdaptiveScaler         func() bool
+		AdaptiveScalerUpdateInterval func() time.Duration
+		PartitionUpscaleRPS          func() int
+		PartitionDownscaleRPS        func() int
+		UpscaleSustainedPeriod       func() time.Duration
+		DownscaleSustainedPeriod     func() time.Duration
+		AdaptiveScalerMinPartitions  func() int
+		AdaptiveScalerMaxPartitions  func() int
 	}
 )
 
 // NewConfig returns new service config with default values
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
-		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
-		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
-		RangeSize:                       100000,
-		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
-		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
-		IdleTasklistCheckInterval:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval, 5*time.Minute),
-		MaxTasklistIdleTime:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime, 5*time.Minute),
-		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
-		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
-		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
-		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
-		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
-		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
-		NumTasklistWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
-		NumTasklistReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
-		ForwarderMaxOutstandingPolls:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
-		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
-		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
-		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
+		PersistenceMaxQPS:                 dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
+		EnableSyncMatch:                   dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
+		RPS:                               dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
+		PersistenceSlowQueryThreshold:     dc.GetDurationProperty(dynamicconfig.PersistenceSlowQueryThreshold, time.Second),
+		PersistenceLargeRowSizeThreshold:  dc.GetIntProperty(dynamicconfig.PersistenceLargeRowSizeThreshold, 2*1024*1024),
+		PersistenceSlowQueryLogSampleRate: dc.GetFloat64Property(dynamicconfig.PersistenceSlowQueryLogSampleRate, 0.1),
+		RangeSize:                         100000,
+		IDGenerator:                       idgenerator.NewUUIDGenerator(),
+		GetTasksBatchSize:                 dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
+		UpdateAckInterval:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
+		IdleTasklistCheckInterval:         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval, 5*time.Minute),
+		MaxTasklistIdleTime:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime, 5*time.Minute),
+		LongPollExpirationInterval:        dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
+		MinTaskThrottlingBurstSize:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
+		MaxTaskDeleteBatchSize:            dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
+		IncompatiblePollBackoff:           dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIncompatiblePollBackoff, time.Second),
+		OutstandingTaskAppendsThreshold:   dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
+		MaxTaskBatchSize:                  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		ThrottledLogRPS:                   dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
+		NumTasklistWritePartitions:        dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+		NumTasklistReadPartitions:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
+		ForwarderMaxOutstandingPolls:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
+		ForwarderMaxOutstandingTasks:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
+		ForwarderMaxRatePerSecond:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
+		ForwarderMaxChildrenPerNode:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
+		WorkerTaskListLivenessTimeout:     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingWorkerTaskListLivenessTimeout, 30*time.Second),
+		NumTaskPriorities:                 dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTaskPriorities, 1),
+		TaskPriorityDispatchRatio:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskPriorityDispatchRatio, 2),
+		EnableWorkflowAffinity:            dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableWorkflowAffinity, false),
+		WorkflowAffinityCacheSize:         dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingWorkflowAffinityCacheSize, 1000),
+		TaskListDrained:                   dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskListDrained, false),
+		StaleWorkerThreshold:              dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingStaleWorkerThreshold, 3*time.Minute),
+		EnableAdaptiveScaler:              dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableAdaptiveScaler, false),
+		AdaptiveScalerUpdateInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerUpdateInterval, 15*time.Second),
+		PartitionUpscaleRPS:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleRPS, 200),
+		PartitionDownscaleRPS:             dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleRPS, 100),
+		UpscaleSustainedPeriod:            dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpscaleSustainedPeriod, time.Minute),
+		DownscaleSustainedPeriod:          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingDownscaleSustainedPeriod, 2*time.Minute),
+		AdaptiveScalerMinPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerMinPartitions, 1),
+		AdaptiveScalerMaxPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingAdaptiveScalerMaxPartitions, 10),
+		PartitionConfigRefreshInterval:    dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval, 10*time.Second),
+		ActivityTypeMetricsAllowlist:      dc.GetStringPropertyFnWithDomainFilter(dynamicconfig.ActivityTypeMetricsAllowlist, ""),
+		DomainProcessingPaused:            dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DomainProcessingPaused, false),
 	}
 }
 
@@ -160,6 +254,56 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistReadPartitions(domain, taskListName, taskType))
 		},
+		WorkerTaskListLivenessTimeout: func() time.Duration {
+			return config.WorkerTaskListLivenessTimeout(domain, taskListName, taskType)
+		},
+		NumTaskPriorities: func() int {
+			return common.MinInt(maxTaskPriorities, common.MaxInt(1, config.NumTaskPriorities(domain, taskListName, taskType)))
+		},
+		TaskPriorityDispatchRatio: func() int {
+			return common.MaxInt(1, config.TaskPriorityDispatchRatio(domain, taskListName, taskType))
+		},
+		EnableWorkflowAffinity: func() bool {
+			return config.EnableWorkflowAffinity(domain, taskListName, taskType)
+		},
+		WorkflowAffinityCacheSize: func() int {
+			return common.MaxInt(1, config.WorkflowAffinityCacheSize(domain, taskListName, taskType))
+		},
+		DomainProcessingPaused: func() bool {
+			return config.DomainProcessingPaused(domain)
+		},
+		Drained: func() bool {
+			// all the partitions of a task list are drained together
+			return config.TaskListDrained(domain, id.baseName, taskType)
+		},
+		StaleWorkerThreshold: func() time.Duration {
+			return config.StaleWorkerThreshold(domain, id.baseName, taskType)
+		},
+		// all the partitions of a task list are scaled together, the config is keyed by the name of the task list
+		EnableAdaptiveScaler: func() bool {
+			return config.EnableAdaptiveScaler(domain, id.baseName, taskType)
+		},
+		AdaptiveScalerUpdateInterval: func() time.Duration {
+			return config.AdaptiveScalerUpdateInterval(domain, id.baseName, taskType)
+		},
+		PartitionUpscaleRPS: func() int {
+			return config.PartitionUpscaleRPS(domain, id.baseName, taskType)
+		},
+		PartitionDownscaleRPS: func() int {
+			return config.PartitionDownscaleRPS(domain, id.baseName, taskType)
+		},
+		UpscaleSustainedPeriod: func() time.Duration {
+			return config.UpscaleSustainedPeriod(domain, id.baseName, taskType)
+		},
+		DownscaleSustainedPeriod: func() time.Duration {
+			return config.DownscaleSustainedPeriod(domain, id.baseName, taskType)
+		},
+		AdaptiveScalerMinPartitions: func() int {
+			return common.MaxInt(1, config.AdaptiveScalerMinPartitions(domain, id.baseName, taskType))
+		},
+		AdaptiveScalerMaxPartitions: func() int {
+			return common.MaxInt(1, config.AdaptiveScalerMaxPartitions(domain, id.baseName, taskType))
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domain, taskListName, taskType)
@@ -40,6 +40,9 @@ type (
 		ackLevel     int64
 		store        persistence.TaskManager
 		logger       log.Logger
+
+		// partitionConfig is the partition config decided by the adaptive scaler, nil if it never scaled the task list
+		partitionConfig *persistence.TaskListPartitionConfig
 	}
 	taskListState struct {
 		rangeID  int64
@@ -92,6 +95,7 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
+	db.partitionConfig = resp.TaskListInfo.AdaptivePartitionConfig
 	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
 }
 
@@ -107,6 +111,8 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 			AckLevel: ackLevel,
 			RangeID:  db.rangeID,
 			Kind:     db.taskListKind,
+
+			AdaptivePartitionConfig: db.partitionConfig,
 		},
 	})
 	if err == nil {
@@ -115,6 +121,35 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// PartitionConfig returns the persistence view of the partition config decided by the adaptive scaler
+func (db *taskListDB) PartitionConfig() *persistence.TaskListPartitionConfig {
+	db.Lock()
+	defer db.Unlock()
+	return db.partitionConfig
+}
+
+// UpdatePartitionConfig persists the partition config decided by the adaptive scaler
+func (db *taskListDB) UpdatePartitionConfig(partitionConfig *persistence.TaskListPartitionConfig) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID: db.domainID,
+			Name:     db.taskListName,
+			TaskType: db.taskType,
+			AckLevel: db.ackLevel,
+			RangeID:  db.rangeID,
+			Kind:     db.taskListKind,
+
+			AdaptivePartitionConfig: partitionConfig,
+		},
+	})
+	if err == nil {
+		db.partitionConfig = partitionConfig
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -127,6 +162,8 @@ func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persist
 			AckLevel: db.ackLevel,
 			RangeID:  db.rangeID,
 			Kind:     db.taskListKind,
+
+			AdaptivePartitionConfig: db.partitionConfig,
 		},
 		Tasks: tasks,
 	})
@@ -148,6 +148,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleId:                    &task.event.ScheduleID,
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			ForwardedFrom:                 &fwdr.taskListID.name,
+			Priority:                      &task.priority,
 		})
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &gen.AddActivityTaskRequest{
@@ -161,6 +162,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleId:                    &task.event.ScheduleID,
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			ForwardedFrom:                 &fwdr.taskListID.name,
+			Priority:                      &task.priority,
 		})
 	default:
 		return errInvalidTaskListType
@@ -279,8 +281,13 @@ func (fwdr *Forwarder) refreshTokenC(value *atomic.Value, curr *int32, maxLimit
 }
 
 func (fwdr *Forwarder) handleErr(err error) error {
-	if _, ok := err.(*shared.ServiceBusyError); ok {
+	switch err := err.(type) {
+	case *shared.ServiceBusyError:
 		return errForwarderSlowDown
+	case *gen.TaskDispatchFailedError:
+		if err.GetReason() == gen.TaskDispatchFailureReasonRateLimited {
+			return errForwarderSlowDown
+		}
 	}
 	return err
 }
@@ -92,6 +92,7 @@ func (t *ForwarderTestSuite) TestForwardDecisionTask() {
 
 	taskInfo := t.newTaskInfo()
 	task := newInternalTask(taskInfo, nil, "", false)
+	task.priority = 2
 	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	t.NotNil(request)
 	t.Equal(t.taskList.Parent(20), request.TaskList.GetName())
@@ -102,6 +103,7 @@ func (t *ForwarderTestSuite) TestForwardDecisionTask() {
 	t.Equal(taskInfo.ScheduleID, request.GetScheduleId())
 	t.Equal(taskInfo.ScheduleToStartTimeout, request.GetScheduleToStartTimeoutSeconds())
 	t.Equal(t.taskList.name, request.GetForwardedFrom())
+	t.Equal(int32(2), request.GetPriority())
 }
 
 func (t *ForwarderTestSuite) TestForwardActivityTask() {
@@ -116,6 +118,7 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 
 	taskInfo := t.newTaskInfo()
 	task := newInternalTask(taskInfo, nil, "", false)
+	task.priority = 2
 	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	t.NotNil(request)
 	t.Equal(t.taskList.Parent(20), request.TaskList.GetName())
@@ -127,6 +130,7 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.Equal(taskInfo.ScheduleID, request.GetScheduleId())
 	t.Equal(taskInfo.ScheduleToStartTimeout, request.GetScheduleToStartTimeoutSeconds())
 	t.Equal(t.taskList.name, request.GetForwardedFrom())
+	t.Equal(int32(2), request.GetPriority())
 }
 
 func (t *ForwarderTestSuite) TestForwardTaskRateExceeded() {
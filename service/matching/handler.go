@@ -56,6 +56,10 @@ type Handler struct {
 
 var (
 	errMatchingHostThrottle = &gen.ServiceBusyError{Message: "Matching host rps exceeded"}
+	errAddTaskThrottle      = &m.TaskDispatchFailedError{
+		Message: common.StringPtr("Matching host rps exceeded"),
+		Reason:  m.TaskDispatchFailureReasonRateLimited.Ptr(),
+	}
 )
 
 // NewHandler creates a thrift handler for the history service
@@ -141,7 +145,7 @@ func (h *Handler) AddActivityTask(ctx context.Context, addRequest *m.AddActivity
 	}
 
 	if ok := h.rateLimiter.Allow(); !ok {
-		return h.handleErr(errMatchingHostThrottle, scope)
+		return h.handleErr(errAddTaskThrottle, scope)
 	}
 
 	syncMatch, err := h.engine.AddActivityTask(ctx, addRequest)
@@ -165,7 +169,7 @@ func (h *Handler) AddDecisionTask(ctx context.Context, addRequest *m.AddDecision
 	}
 
 	if ok := h.rateLimiter.Allow(); !ok {
-		return h.handleErr(errMatchingHostThrottle, scope)
+		return h.handleErr(errAddTaskThrottle, scope)
 	}
 
 	syncMatch, err := h.engine.AddDecisionTask(ctx, addRequest)
@@ -299,6 +303,22 @@ func (h *Handler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskL
 	return response, h.handleErr(err, scope)
 }
 
+// ListTaskListWorkers returns the workers which polled the target tasklist recently, with their client versions
+// and last poll times.
+func (h *Handler) ListTaskListWorkers(ctx context.Context, request *m.ListTaskListWorkersRequest) (resp *m.ListTaskListWorkersResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingListTaskListWorkersScope
+	sw := h.startRequestProfile("ListTaskListWorkers", scope)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	response, err := h.engine.ListTaskListWorkers(ctx, request)
+	return response, h.handleErr(err, scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
@@ -333,6 +353,9 @@ func (h *Handler) handleErr(err error, scope int) error {
 	case *gen.DomainNotActiveError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrDomainNotActiveCounter)
 		return err
+	case *m.TaskDispatchFailedError:
+		h.metricsClient.IncCounter(scope, metrics.CadenceErrTaskDispatchFailedCounter)
+		return err
 	default:
 		h.metricsClient.IncCounter(scope, metrics.CadenceFailures)
 		return &gen.InternalServiceError{Message: err.Error()}
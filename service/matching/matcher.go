@@ -44,6 +44,10 @@ type TaskMatcher struct {
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter *quotas.RateLimiter
 
+	// affinity prefers pollers of the worker which recently processed the workflow of a
+	// decision task, nil when the task list does not dispatch decision tasks of normal task lists
+	affinity *workflowAffinity
+
 	fwdr          *Forwarder
 	scope         func() metrics.Scope // domain metric scope
 	numPartitions func() int           // number of task list partitions
@@ -94,15 +98,13 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 		}
 	}
 
+	if tm.offerPreferred(task) {
+		return tm.awaitResponse(task)
+	}
+
 	select {
 	case tm.taskC <- task: // poller picked up the task
-		if task.responseC != nil {
-			// if there is a response channel, block until resp is received
-			// and return error if the response contains error
-			err = <-task.responseC
-			return true, err
-		}
-		return false, nil
+		return tm.awaitResponse(task)
 	default:
 		// no poller waiting for tasks, try forwarding this task to the
 		// root partition if available
@@ -173,6 +175,9 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
+	if tm.offerPreferred(task) {
+		return nil
+	}
 	select {
 	case tm.taskC <- task:
 		return nil
@@ -217,27 +222,35 @@ forLoop:
 // On success, the returned task could be a query task or a regular task
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context) (*internalTask, error) {
+	identity, _ := ctx.Value(identityKey).(string)
+	preferredC, unregister := tm.registerPoller(pollerIdentity(identity))
+	defer unregister()
+
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC); err == nil {
-		return task, nil
+	task, err := tm.pollNonBlocking(ctx, tm.taskC, preferredC, tm.queryTaskC)
+	if err != nil {
+		// there is no local poller available to pickup this task. Now block waiting
+		// either for a local poller or a forwarding token to be available. When a
+		// forwarding token becomes available, send this poll to a parent partition
+		task, err = tm.pollOrForward(ctx, tm.taskC, preferredC, tm.queryTaskC)
 	}
-	// there is no local poller available to pickup this task. Now block waiting
-	// either for a local poller or a forwarding token to be available. When a
-	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC)
+	if err == nil && preferredC != nil && !task.isQuery() {
+		tm.affinity.record(task, pollerIdentity(identity))
+	}
+	return task, err
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*internalTask, error) {
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, nil, tm.queryTaskC); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctx, nil, nil, tm.queryTaskC)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -262,6 +275,7 @@ func (tm *TaskMatcher) Rate() float64 {
 func (tm *TaskMatcher) pollOrForward(
 	ctx context.Context,
 	taskC <-chan *internalTask,
+	preferredC <-chan *internalTask,
 	queryTaskC <-chan *internalTask,
 ) (*internalTask, error) {
 	select {
@@ -271,6 +285,13 @@ func (tm *TaskMatcher) pollOrForward(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessCounter)
 		return task, nil
+	case task := <-preferredC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncCounter)
+		}
+		tm.scope().IncCounter(metrics.AffinityMatchCounter)
+		tm.scope().IncCounter(metrics.PollSuccessCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncCounter)
 		tm.scope().IncCounter(metrics.PollSuccessCounter)
@@ -284,13 +305,14 @@ func (tm *TaskMatcher) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, taskC, queryTaskC)
+		return tm.poll(ctx, taskC, preferredC, queryTaskC)
 	}
 }
 
 func (tm *TaskMatcher) poll(
 	ctx context.Context,
 	taskC <-chan *internalTask,
+	preferredC <-chan *internalTask,
 	queryTaskC <-chan *internalTask,
 ) (*internalTask, error) {
 	select {
@@ -300,6 +322,13 @@ func (tm *TaskMatcher) poll(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessCounter)
 		return task, nil
+	case task := <-preferredC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncCounter)
+		}
+		tm.scope().IncCounter(metrics.AffinityMatchCounter)
+		tm.scope().IncCounter(metrics.PollSuccessCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncCounter)
 		tm.scope().IncCounter(metrics.PollSuccessCounter)
@@ -313,6 +342,7 @@ func (tm *TaskMatcher) poll(
 func (tm *TaskMatcher) pollNonBlocking(
 	ctx context.Context,
 	taskC <-chan *internalTask,
+	preferredC <-chan *internalTask,
 	queryTaskC <-chan *internalTask,
 ) (*internalTask, error) {
 	select {
@@ -322,6 +352,13 @@ func (tm *TaskMatcher) pollNonBlocking(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessCounter)
 		return task, nil
+	case task := <-preferredC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncCounter)
+		}
+		tm.scope().IncCounter(metrics.AffinityMatchCounter)
+		tm.scope().IncCounter(metrics.PollSuccessCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncCounter)
 		tm.scope().IncCounter(metrics.PollSuccessCounter)
@@ -331,6 +368,44 @@ func (tm *TaskMatcher) pollNonBlocking(
 	}
 }
 
+// awaitResponse returns whether a task picked up by a poller was sync matched
+func (tm *TaskMatcher) awaitResponse(task *internalTask) (bool, error) {
+	if task.responseC != nil {
+		// if there is a response channel, block until resp is received
+		// and return error if the response contains error
+		err := <-task.responseC
+		return true, err
+	}
+	return false, nil
+}
+
+// offerPreferred offers the task to a waiting poller of the worker which recently processed
+// its workflow without blocking, returns true if such a poller picked up the task
+func (tm *TaskMatcher) offerPreferred(task *internalTask) bool {
+	if tm.affinity == nil {
+		return false
+	}
+	preferredC := tm.affinity.preferredTaskC(task)
+	if preferredC == nil {
+		return false
+	}
+	select {
+	case preferredC <- task:
+		return true
+	default:
+		return false
+	}
+}
+
+// registerPoller returns the channel the poller receives tasks of workflows recently processed by
+// its worker on, nil when the poller does not identify itself or affinity is not used
+func (tm *TaskMatcher) registerPoller(identity pollerIdentity) (<-chan *internalTask, func()) {
+	if tm.affinity == nil || identity == "" {
+		return nil, func() {}
+	}
+	return tm.affinity.register(identity)
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return noopForwarderTokenC
@@ -385,6 +385,50 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+func (t *MatcherTestSuite) TestAffinityMatch() {
+	// force disable remote forwarding
+	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.PollReqTokenC()
+
+	t.cfg.EnableWorkflowAffinity = func() bool { return true }
+	t.matcher.affinity = newWorkflowAffinity(t.cfg)
+
+	poll := func(identity string) <-chan *internalTask {
+		resultC := make(chan *internalTask, 1)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), identityKey, identity), time.Second)
+			defer cancel()
+			task, err := t.matcher.Poll(ctx)
+			if err != nil {
+				task = nil
+			}
+			resultC <- task
+		}()
+		return resultC
+	}
+
+	// the first decision task of the workflow is picked up by worker-a
+	info := t.newTaskInfo()
+	resultC := poll("worker-a")
+	time.Sleep(10 * time.Millisecond)
+	t.NoError(t.matcher.MustOffer(context.Background(), newInternalTask(info, nil, "", false)))
+	t.NotNil(<-resultC)
+
+	// worker-b polls first, but the next decision task of the workflow goes to worker-a
+	resultB := poll("worker-b")
+	time.Sleep(10 * time.Millisecond)
+	resultA := poll("worker-a")
+	time.Sleep(10 * time.Millisecond)
+	t.NoError(t.matcher.MustOffer(context.Background(), newInternalTask(info, nil, "", false)))
+	task := <-resultA
+	t.NotNil(task)
+	t.Equal(info.WorkflowID, task.event.WorkflowID)
+
+	// tasks of other workflows are matched with any poller
+	t.NoError(t.matcher.MustOffer(context.Background(), newInternalTask(t.newTaskInfo(), nil, "", false)))
+	t.NotNil(<-resultB)
+}
+
 func (t *MatcherTestSuite) newDomainCache() cache.DomainCache {
 	entry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: "test-domain"},
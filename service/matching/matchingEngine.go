@@ -29,7 +29,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -64,6 +63,8 @@ type matchingEngineImpl struct {
 	// unblock QueryWorkflow() call.
 	queryTaskMap map[string]chan *queryResult
 	domainCache  cache.DomainCache
+	// partitionConfigs returns the partitions of the task lists whose root partition is owned by another host
+	partitionConfigs matching.PartitionConfigProvider
 }
 
 type pollerIDCtxKey string
@@ -101,10 +102,10 @@ func NewEngine(taskManager persistence.TaskManager,
 	domainCache cache.DomainCache,
 ) Engine {
 
-	return &matchingEngineImpl{
+	e := &matchingEngineImpl{
 		taskManager:     taskManager,
 		historyService:  historyService,
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		tokenSerializer: common.NewSignedJSONTaskTokenSerializer(config.TaskTokenSigningKey, nil),
 		taskLists:       make(map[taskListID]taskListManager),
 		logger:          logger.WithTags(tag.ComponentMatchingEngine),
 		metricsClient:   metricsClient,
@@ -113,6 +114,20 @@ func NewEngine(taskManager persistence.TaskManager,
 		queryTaskMap:    make(map[string]chan *queryResult),
 		domainCache:     domainCache,
 	}
+	e.partitionConfigs = matching.NewPartitionConfigProvider(
+		&matching.PartitionConfigProviderConfig{
+			EnableAdaptiveScaler:           config.EnableAdaptiveScaler,
+			NumTasklistReadPartitions:      config.NumTasklistReadPartitions,
+			NumTasklistWritePartitions:     config.NumTasklistWritePartitions,
+			PartitionConfigRefreshInterval: config.PartitionConfigRefreshInterval,
+		},
+		domainCache.GetDomainName,
+		func(ctx context.Context, domainID string, taskList workflow.TaskList, taskListType int) (*workflow.TaskListPartitionConfig, error) {
+			return matching.DescribePartitionConfig(ctx, matchingClient, domainID, taskList, taskListType)
+		},
+		e.logger,
+	)
+	return e
 }
 
 func (e *matchingEngineImpl) Start() {
@@ -234,6 +249,7 @@ func (e *matchingEngineImpl) AddDecisionTask(ctx context.Context, addRequest *m.
 		execution:     addRequest.Execution,
 		taskInfo:      taskInfo,
 		forwardedFrom: addRequest.GetForwardedFrom(),
+		priority:      addRequest.GetPriority(),
 	})
 }
 
@@ -272,6 +288,7 @@ func (e *matchingEngineImpl) AddActivityTask(ctx context.Context, addRequest *m.
 		execution:     addRequest.Execution,
 		taskInfo:      taskInfo,
 		forwardedFrom: addRequest.GetForwardedFrom(),
+		priority:      addRequest.GetPriority(),
 	})
 }
 
@@ -367,6 +384,12 @@ pollLoop:
 				e.logger.Debug(fmt.Sprintf("Duplicated decision task taskList=%v, taskID=%v",
 					taskListName, task.event.TaskID))
 				task.finish(nil)
+			case *h.IncompatibleBinaryChecksumError:
+				// the task is written back to the backlog for a compatible poller, hold this poller back
+				// before returning an empty response so that it does not pick up the same task right away
+				task.finish(err)
+				e.backoffIncompatiblePoller(ctx, task.domainName, taskListName)
+				return emptyPollForDecisionTaskResponse, nil
 			default:
 				task.finish(err)
 			}
@@ -461,7 +484,7 @@ query_loop:
 		if err != nil {
 			return nil, err
 		}
-		taskID := uuid.New()
+		taskID := e.config.IDGenerator.TaskID()
 		result, err := tlMgr.DispatchQueryTask(ctx, taskID, queryRequest)
 		if err != nil {
 			return nil, err
@@ -601,6 +624,26 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
 }
 
+func (e *matchingEngineImpl) ListTaskListWorkers(ctx context.Context, request *m.ListTaskListWorkersRequest) (*m.ListTaskListWorkersResponse, error) {
+	domainID := request.GetDomainUUID()
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == workflow.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskList, err := newTaskListID(domainID, request.TaskList.GetName(), taskListType)
+	if err != nil {
+		return nil, err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, common.TaskListKindPtr(request.TaskList.GetKind()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &m.ListTaskListWorkersResponse{
+		Workers: tlMgr.GetAllWorkerInfo(),
+	}, nil
+}
+
 // Loads a task from persistence and wraps it in a task context
 func (e *matchingEngineImpl) getTask(
 	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *workflow.TaskListKind,
@@ -681,6 +724,7 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 		scope := e.metricsClient.Scope(metrics.MatchingPollForActivityTaskScope)
 		scope.Tagged(metrics.DomainTag(task.domainName)).RecordTimer(metrics.AsyncMatchLatency, time.Since(task.event.CreatedTime))
 	}
+	e.emitActivityTypeMatchStats(task, attributes.ActivityType.GetName())
 
 	response := &workflow.PollForActivityTaskResponse{}
 	response.ActivityId = attributes.ActivityId
@@ -721,7 +765,7 @@ func (e *matchingEngineImpl) recordDecisionTaskStarted(
 		WorkflowExecution: task.workflowExecution(),
 		ScheduleId:        &task.event.ScheduleID,
 		TaskId:            &task.event.TaskID,
-		RequestId:         common.StringPtr(uuid.New()),
+		RequestId:         common.StringPtr(e.config.IDGenerator.RequestID()),
 		PollRequest:       pollReq,
 	}
 	var resp *h.RecordDecisionTaskStartedResponse
@@ -732,7 +776,7 @@ func (e *matchingEngineImpl) recordDecisionTaskStarted(
 	}
 	err := backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
 		switch err.(type) {
-		case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
+		case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError, *h.IncompatibleBinaryChecksumError:
 			return false
 		}
 		return true
@@ -740,6 +784,21 @@ func (e *matchingEngineImpl) recordDecisionTaskStarted(
 	return resp, err
 }
 
+// backoffIncompatiblePoller holds a decision poller which picked up a task it is incompatible with,
+// until the backoff expires or the poll request is done
+func (e *matchingEngineImpl) backoffIncompatiblePoller(
+	ctx context.Context,
+	domainName string,
+	taskListName string,
+) {
+	timer := time.NewTimer(e.config.IncompatiblePollBackoff(domainName, taskListName, persistence.TaskListTypeDecision))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
 func (e *matchingEngineImpl) recordActivityTaskStarted(
 	ctx context.Context,
 	pollReq *workflow.PollForActivityTaskRequest,
@@ -750,7 +809,7 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 		WorkflowExecution: task.workflowExecution(),
 		ScheduleId:        &task.event.ScheduleID,
 		TaskId:            &task.event.TaskID,
-		RequestId:         common.StringPtr(uuid.New()),
+		RequestId:         common.StringPtr(e.config.IDGenerator.RequestID()),
 		PollRequest:       pollReq,
 	}
 	var resp *h.RecordActivityTaskStartedResponse
@@ -769,6 +828,48 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	return resp, err
 }
 
+func (e *matchingEngineImpl) recordActivityTaskWorkerUnavailable(
+	ctx context.Context,
+	task *persistence.TaskInfo,
+	taskListName string,
+) error {
+	request := &h.RecordActivityTaskWorkerUnavailableRequest{
+		DomainUUID: &task.DomainID,
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: &task.WorkflowID,
+			RunId:      &task.RunID,
+		},
+		ScheduleId: &task.ScheduleID,
+		TaskList:   common.StringPtr(taskListName),
+	}
+	op := func() error {
+		return e.historyService.RecordActivityTaskWorkerUnavailable(ctx, request)
+	}
+	return backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
+		switch err.(type) {
+		case *workflow.EntityNotExistsError:
+			return false
+		}
+		return true
+	})
+}
+
+// emitActivityTypeMatchStats emits the poll success, sync match and async match latency metrics
+// tagged by activity type, if the activity type is allowlisted for per activity type metrics
+func (e *matchingEngineImpl) emitActivityTypeMatchStats(task *internalTask, activityType string) {
+	if !common.IsActivityTypeMetricsAllowlisted(e.config.ActivityTypeMetricsAllowlist(task.domainName), activityType) {
+		return
+	}
+	scope := e.metricsClient.Scope(metrics.MatchingPollForActivityTaskScope).
+		Tagged(metrics.DomainTag(task.domainName), metrics.ActivityTypeTag(activityType))
+	scope.IncCounter(metrics.PollSuccessCounter)
+	if task.responseC != nil {
+		scope.IncCounter(metrics.PollSuccessWithSyncCounter)
+		return
+	}
+	scope.RecordTimer(metrics.AsyncMatchLatency, time.Since(task.event.CreatedTime))
+}
+
 func (e *matchingEngineImpl) emitForwardedFromStats(scope int, isTaskForwarded bool, pollForwardedFrom string) {
 	isPollForwarded := len(pollForwardedFrom) > 0
 	switch {
@@ -571,6 +571,144 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	s.True(expectedRange <= s.taskManager.getTaskListManager(tlID).rangeID)
 }
 
+func (s *matchingEngineSuite) TestDomainProcessingPaused() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	paused := int32(1)
+	s.matchingEngine.config.DomainProcessingPaused = func(domain string) bool {
+		return atomic.LoadInt32(&paused) == 1
+	}
+
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+	taskList := &workflow.TaskList{Name: &tl}
+	identity := "nobody"
+	activityID := "activityId1"
+
+	s.historyClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *gohistory.RecordActivityTaskStartedRequest) (*gohistory.RecordActivityTaskStartedResponse, error) {
+			return &gohistory.RecordActivityTaskStartedResponse{
+				ScheduledEvent: newActivityTaskScheduledEvent(*taskRequest.ScheduleId, 0,
+					&workflow.ScheduleActivityTaskDecisionAttributes{
+						ActivityId:                    &activityID,
+						TaskList:                      &workflow.TaskList{Name: taskList.Name},
+						ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity1")},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(50),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+						HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+					}),
+				StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			}, nil
+		}).Times(1)
+
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), &matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflowExecution,
+		ScheduleId:                    common.Int64Ptr(1),
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	pollRequest := &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: &identity,
+		},
+	}
+
+	// the task stays in the backlog while processing is paused
+	result, err := s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+	s.NoError(err)
+	s.Empty(result.TaskToken)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	atomic.StoreInt32(&paused, 0)
+	s.True(s.awaitCondition(func() bool {
+		result, err = s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+		s.NoError(err)
+		return len(result.TaskToken) != 0
+	}, time.Second))
+	s.EqualValues(activityID, result.GetActivityId())
+}
+
+func (s *matchingEngineSuite) TestTaskListDrained() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	drained := int32(1)
+	s.matchingEngine.config.TaskListDrained = func(domain string, taskList string, taskType int) bool {
+		return atomic.LoadInt32(&drained) == 1
+	}
+
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+	taskList := &workflow.TaskList{Name: &tl}
+	identity := "nobody"
+	activityID := "activityId1"
+
+	s.historyClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *gohistory.RecordActivityTaskStartedRequest) (*gohistory.RecordActivityTaskStartedResponse, error) {
+			return &gohistory.RecordActivityTaskStartedResponse{
+				ScheduledEvent: newActivityTaskScheduledEvent(*taskRequest.ScheduleId, 0,
+					&workflow.ScheduleActivityTaskDecisionAttributes{
+						ActivityId:                    &activityID,
+						TaskList:                      &workflow.TaskList{Name: taskList.Name},
+						ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity1")},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(50),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+						HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+					}),
+				StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			}, nil
+		}).Times(1)
+
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), &matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflowExecution,
+		ScheduleId:                    common.Int64Ptr(1),
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	pollRequest := &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: &identity,
+		},
+	}
+
+	// the task stays in the backlog while the task list is drained
+	result, err := s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+	s.NoError(err)
+	s.Empty(result.TaskToken)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	atomic.StoreInt32(&drained, 0)
+	s.True(s.awaitCondition(func() bool {
+		result, err = s.matchingEngine.PollForActivityTask(s.callContext, pollRequest)
+		s.NoError(err)
+		return len(result.TaskToken) != 0
+	}, time.Second))
+	s.EqualValues(activityID, result.GetActivityId())
+}
+
 func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	// Set a short long poll expiration so we don't have to wait too long for 0 throttling cases
 	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(50 * time.Millisecond)
@@ -885,6 +1023,7 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					Version:    common.TaskTokenVersion,
 				}
 				resultToken, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
 				s.NoError(err)
@@ -1005,6 +1144,7 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					Version:    common.TaskTokenVersion,
 				}
 				resultToken, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1182,6 +1322,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					Version:    common.TaskTokenVersion,
 				}
 				resultToken, err := engine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1318,6 +1459,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					Version:    common.TaskTokenVersion,
 				}
 				resultToken, err := engine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1394,6 +1536,50 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestPollForDecisionTask_IncompatibleBinaryChecksum() {
+	backoff := 200 * time.Millisecond
+	s.matchingEngine.config.IncompatiblePollBackoff = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(backoff)
+
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeDecision)
+	taskList := &workflow.TaskList{Name: &tl}
+
+	scheduleID := int64(0)
+	_, err := s.matchingEngine.AddDecisionTask(context.Background(), &matching.AddDecisionTaskRequest{
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &workflowExecution,
+		ScheduleId:                    &scheduleID,
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	s.historyClient.EXPECT().RecordDecisionTaskStarted(gomock.Any(), gomock.Any()).Return(
+		nil, &gohistory.IncompatibleBinaryChecksumError{RequiredBinaryChecksum: common.StringPtr("v1")}).Times(1)
+
+	start := time.Now()
+	resp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList:       taskList,
+			Identity:       common.StringPtr("nobody"),
+			BinaryChecksum: common.StringPtr("v2"),
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, resp)
+	// the poller is held back instead of picking up the same task again right away
+	s.True(time.Since(start) >= backoff)
+	// the task is written back to the backlog for a compatible poller
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+}
+
 func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1524,6 +1710,39 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch_ReadBatchDone() {
 	s.NoError(err)
 }
 
+func (s *matchingEngineSuite) TestEmitActivityTypeMatchStats() {
+	scope := tally.NewTestScope("test", nil)
+	s.matchingEngine.metricsClient = metrics.NewClient(scope, metrics.Matching)
+	s.matchingEngine.config.ActivityTypeMetricsAllowlist = dynamicconfig.GetStringPropertyFnFilteredByDomain("other,activity")
+
+	syncMatchTask := newInternalTask(&persistence.TaskInfo{CreatedTime: time.Now()}, nil, "", true)
+	syncMatchTask.domainName = "domain"
+	s.matchingEngine.emitActivityTypeMatchStats(syncMatchTask, "activity")
+	asyncMatchTask := newInternalTask(&persistence.TaskInfo{CreatedTime: time.Now()}, nil, "", false)
+	asyncMatchTask.domainName = "domain"
+	s.matchingEngine.emitActivityTypeMatchStats(asyncMatchTask, "activity")
+	s.matchingEngine.emitActivityTypeMatchStats(asyncMatchTask, "not-allowlisted")
+
+	snapshot := scope.Snapshot()
+	counters := make(map[string]int64)
+	for _, counter := range snapshot.Counters() {
+		s.Equal("domain", counter.Tags()["domain"])
+		s.Equal("activity", counter.Tags()["activity_type"])
+		counters[counter.Name()] += counter.Value()
+	}
+	s.Equal(map[string]int64{"test.poll_success": 2, "test.poll_success_sync": 1}, counters)
+	// timers are also emitted with the domain tag set to all
+	var latencies []time.Duration
+	for _, timer := range snapshot.Timers() {
+		s.Equal("test.asyncmatch_latency", timer.Name())
+		s.Equal("activity", timer.Tags()["activity_type"])
+		if timer.Tags()["domain"] == "domain" {
+			latencies = append(latencies, timer.Values()...)
+		}
+	}
+	s.Len(latencies, 1)
+}
+
 func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 	runID := uuid.New()
 	workflowID := uuid.New()
@@ -0,0 +1,70 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"math"
+)
+
+type (
+	// priorityDispatcher picks the priority sub-queue of a task list which dispatches the next
+	// backlog task. Sub-queues with buffered tasks are picked in proportion to their weight
+	// (stride scheduling), the weight of a priority is ratio times the weight of the priority
+	// below it. This makes sure tasks of a higher priority are dispatched more often while lower
+	// priorities are never starved. Not safe for concurrent use, it is only used by the dispatch
+	// loop of the task list
+	priorityDispatcher struct {
+		ratio func() int
+		pass  []float64 // virtual time of each priority
+		vtime float64   // virtual time of the last pick
+	}
+)
+
+func newPriorityDispatcher(numPriorities int, ratio func() int) *priorityDispatcher {
+	return &priorityDispatcher{
+		ratio: ratio,
+		pass:  make([]float64, numPriorities),
+	}
+}
+
+// next returns the priority to dispatch the next task from among the ready ones,
+// returns -1 when no priority is ready
+func (d *priorityDispatcher) next(ready func(priority int) bool) int {
+	next := -1
+	// ties are broken in favor of the higher priority
+	for priority := len(d.pass) - 1; priority >= 0; priority-- {
+		if !ready(priority) {
+			continue
+		}
+		if d.pass[priority] < d.vtime {
+			// a priority does not accumulate turns while it has nothing to dispatch
+			d.pass[priority] = d.vtime
+		}
+		if next == -1 || d.pass[priority] < d.pass[next] {
+			next = priority
+		}
+	}
+	if next == -1 {
+		return -1
+	}
+	d.vtime = d.pass[next]
+	d.pass[next] += math.Pow(float64(d.ratio()), -float64(next))
+	return next
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityDispatcherWeights(t *testing.T) {
+	d := newPriorityDispatcher(3, func() int { return 2 })
+	counts := make([]int, 3)
+	for i := 0; i < 70; i++ {
+		counts[d.next(func(int) bool { return true })]++
+	}
+	require.Equal(t, []int{10, 20, 40}, counts)
+}
+
+func TestPriorityDispatcherReady(t *testing.T) {
+	d := newPriorityDispatcher(2, func() int { return 4 })
+	require.Equal(t, -1, d.next(func(int) bool { return false }))
+
+	// only the default priority has tasks to dispatch for a while
+	for i := 0; i < 10; i++ {
+		require.Equal(t, 0, d.next(func(priority int) bool { return priority == 0 }))
+	}
+
+	// the higher priority does not catch up on the turns it had nothing to dispatch,
+	// both share the dispatch according to their weights from now on
+	counts := make([]int, 2)
+	for i := 0; i < 50; i++ {
+		counts[d.next(func(int) bool { return true })]++
+	}
+	require.InDelta(t, 10, counts[0], 1)
+	require.InDelta(t, 40, counts[1], 1)
+}
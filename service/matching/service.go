@@ -22,10 +22,12 @@ package matching
 
 import (
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/idgenerator"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -39,6 +41,12 @@ type Service struct {
 // NewService builds a new cadence-matching service
 func NewService(params *service.BootstrapParams) common.Daemon {
 	config := NewConfig(dynamicconfig.NewCollection(params.DynamicConfig, params.Logger))
+	config.TaskTokenSigningKey = []byte(params.TaskTokenConfig.SigningKey)
+	idGenerator, err := idgenerator.NewGenerator(params.IDGeneratorConfig)
+	if err != nil {
+		params.Logger.Fatal("failed to create id generator", tag.Error(err))
+	}
+	config.IDGenerator = idGenerator
 	params.ThrottledLogger = loggerimpl.NewThrottledLogger(params.Logger, config.ThrottledLogRPS)
 	params.UpdateLoggerWithServiceName(common.MatchingServiceName)
 	return &Service{
@@ -60,6 +68,11 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.SlowQueryConfig = &config.SlowQueryConfig{
+		LatencyThreshold: s.config.PersistenceSlowQueryThreshold,
+		SizeThreshold:    s.config.PersistenceLargeRowSizeThreshold,
+		LogSampleRate:    s.config.PersistenceSlowQueryLogSampleRate,
+	}
 	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
 
 	taskPersistence, err := pFactory.NewTaskManager()
@@ -0,0 +1,468 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/yarpc"
+
+	gohistory "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingserviceclient"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	arrivalUniform arrivalDistribution = iota
+	arrivalPoisson
+	arrivalBurst
+)
+
+type (
+	arrivalDistribution int
+
+	// simulationConfig describes a synthetic load profile for a single activity task list
+	simulationConfig struct {
+		name string
+		// duration for which producers generate load
+		duration time.Duration
+		// max time to wait for the backlog to drain once producers stop
+		drainTimeout time.Duration
+
+		writePartitions int
+		readPartitions  int
+
+		producers   int
+		producerRPS float64
+		arrival     arrivalDistribution
+		burstSize   int
+
+		pollers        int
+		processingTime time.Duration
+		dispatchRPS    float64
+
+		forwarderMaxOutstandingPolls int
+		forwarderMaxOutstandingTasks int
+		forwarderMaxRatePerSecond    int
+		forwarderMaxChildrenPerNode  int
+	}
+
+	// simulationReport is the outcome of a single simulation run
+	simulationReport struct {
+		name           string
+		elapsed        time.Duration
+		produced       int64
+		dispatched     int64
+		backlog        int64
+		syncMatched    int64
+		addErrors      int64
+		forwardedTasks int64
+		forwardedPolls int64
+		latencyP50     time.Duration
+		latencyP90     time.Duration
+		latencyP99     time.Duration
+		latencyMax     time.Duration
+	}
+
+	simulation struct {
+		config   simulationConfig
+		engine   *matchingEngineImpl
+		domainID string
+		taskList qualifiedTaskListName
+
+		nextScheduleID int64
+		addTimes       sync.Map // scheduleID -> time.Time
+
+		produced       int64
+		dispatched     int64
+		syncMatched    int64
+		addErrors      int64
+		forwardedTasks int64
+		forwardedPolls int64
+
+		sync.Mutex
+		latencies []time.Duration
+	}
+
+	// simulationMatchingClient loops forwarded requests back into the engine under
+	// simulation, so that partition forwarding is exercised without a network hop
+	simulationMatchingClient struct {
+		matchingserviceclient.Interface
+		sim *simulation
+	}
+)
+
+var simulationFlags struct {
+	enabled  bool
+	duration time.Duration
+}
+
+func init() {
+	flag.BoolVar(&simulationFlags.enabled, "matchingSimulation", false, "run matching simulation load profiles")
+	flag.DurationVar(&simulationFlags.duration, "matchingSimulationDuration", 10*time.Second, "duration of each matching simulation load profile")
+}
+
+// simulationProfiles are the load profiles exercised by TestMatchingSimulation
+func simulationProfiles(duration time.Duration) []simulationConfig {
+	base := simulationConfig{
+		duration:                     duration,
+		drainTimeout:                 30 * time.Second,
+		writePartitions:              1,
+		readPartitions:               1,
+		producers:                    4,
+		producerRPS:                  50,
+		arrival:                      arrivalUniform,
+		pollers:                      8,
+		processingTime:               10 * time.Millisecond,
+		dispatchRPS:                  _defaultTaskDispatchRPS,
+		forwarderMaxOutstandingPolls: 1,
+		forwarderMaxOutstandingTasks: 1,
+		forwarderMaxRatePerSecond:    10,
+		forwarderMaxChildrenPerNode:  20,
+	}
+
+	single := base
+	single.name = "single-partition-uniform"
+
+	partitioned := base
+	partitioned.name = "partitioned-poisson"
+	partitioned.writePartitions = 4
+	partitioned.readPartitions = 4
+	partitioned.arrival = arrivalPoisson
+
+	bursty := partitioned
+	bursty.name = "partitioned-burst"
+	bursty.arrival = arrivalBurst
+	bursty.burstSize = 50
+
+	unforwarded := partitioned
+	unforwarded.name = "partitioned-no-forwarding"
+	unforwarded.forwarderMaxRatePerSecond = 0
+
+	fewPollers := partitioned
+	fewPollers.name = "partitioned-poller-starved"
+	fewPollers.pollers = 2
+
+	rateLimited := partitioned
+	rateLimited.name = "partitioned-rate-limited"
+	rateLimited.dispatchRPS = 100
+
+	return []simulationConfig{single, partitioned, bursty, unforwarded, fewPollers, rateLimited}
+}
+
+// TestMatchingSimulation runs all load profiles and logs a report for each one.
+// It is skipped unless -matchingSimulation is passed to the test binary.
+func TestMatchingSimulation(t *testing.T) {
+	if !simulationFlags.enabled {
+		t.Skip("matching simulation is disabled, pass -matchingSimulation to enable")
+	}
+
+	for _, config := range simulationProfiles(simulationFlags.duration) {
+		config := config
+		t.Run(config.name, func(t *testing.T) {
+			report := runSimulation(t, config)
+			t.Log(report.String())
+		})
+	}
+}
+
+func TestMatchingSimulationDrainsAllTasks(t *testing.T) {
+	config := simulationProfiles(500 * time.Millisecond)[1]
+	config.drainTimeout = 10 * time.Second
+
+	report := runSimulation(t, config)
+	t.Log(report.String())
+
+	require.True(t, report.produced > 0)
+	require.Equal(t, int64(0), report.addErrors)
+	require.Equal(t, report.produced, report.dispatched)
+	require.Equal(t, int64(0), report.backlog)
+}
+
+func runSimulation(t *testing.T, config simulationConfig) *simulationReport {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	logger := loggerimpl.NewNopLogger()
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("domainName"), nil)
+
+	historyClient := historyservicetest.NewMockClient(controller)
+	historyClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *gohistory.RecordActivityTaskStartedRequest) (*gohistory.RecordActivityTaskStartedResponse, error) {
+			return &gohistory.RecordActivityTaskStartedResponse{
+				ScheduledEvent: newActivityTaskScheduledEvent(request.GetScheduleId(), 0,
+					&workflow.ScheduleActivityTaskDecisionAttributes{
+						// activityID carries the scheduleID so that pollers can compute the dispatch latency
+						ActivityId:                    common.StringPtr(strconv.FormatInt(request.GetScheduleId(), 10)),
+						ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("simulation")},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(50),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+						HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+					}),
+			}, nil
+		}).AnyTimes()
+
+	taskList, err := newTaskListName("simulation-tl")
+	require.NoError(t, err)
+
+	sim := &simulation{
+		config:   config,
+		domainID: "simulation-domain",
+		taskList: taskList,
+	}
+	sim.engine = newMatchingEngine(config.engineConfig(), newTestTaskManager(logger), historyClient, logger, domainCache)
+	sim.engine.metricsClient = metrics.NewClient(tally.NoopScope, metrics.Matching)
+	sim.engine.matchingClient = &simulationMatchingClient{sim: sim}
+	sim.engine.Start()
+	defer sim.engine.Stop()
+
+	return sim.run()
+}
+
+func (c simulationConfig) engineConfig() *Config {
+	config := defaultTestConfig()
+	config.RangeSize = 100
+	config.NumTasklistWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(c.writePartitions)
+	config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(c.readPartitions)
+	config.ForwarderMaxOutstandingPolls = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(c.forwarderMaxOutstandingPolls)
+	config.ForwarderMaxOutstandingTasks = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(c.forwarderMaxOutstandingTasks)
+	config.ForwarderMaxRatePerSecond = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(c.forwarderMaxRatePerSecond)
+	config.ForwarderMaxChildrenPerNode = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(c.forwarderMaxChildrenPerNode)
+	return config
+}
+
+func (s *simulation) run() *simulationReport {
+	startTime := time.Now()
+
+	pollCtx, cancelPollers := context.WithCancel(context.Background())
+	var pollerWG sync.WaitGroup
+	for i := 0; i < s.config.pollers; i++ {
+		pollerWG.Add(1)
+		go func() {
+			defer pollerWG.Done()
+			s.poller(pollCtx)
+		}()
+	}
+
+	var producerWG sync.WaitGroup
+	for i := 0; i < s.config.producers; i++ {
+		producerWG.Add(1)
+		go func() {
+			defer producerWG.Done()
+			s.producer(startTime.Add(s.config.duration))
+		}()
+	}
+	producerWG.Wait()
+
+	drainDeadline := time.Now().Add(s.config.drainTimeout)
+	for atomic.LoadInt64(&s.dispatched) < s.expectedDispatches() && time.Now().Before(drainDeadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancelPollers()
+	pollerWG.Wait()
+
+	return s.report(time.Since(startTime))
+}
+
+func (s *simulation) expectedDispatches() int64 {
+	return atomic.LoadInt64(&s.produced) - atomic.LoadInt64(&s.addErrors)
+}
+
+func (s *simulation) producer(deadline time.Time) {
+	for time.Now().Before(deadline) {
+		count := 1
+		if s.config.arrival == arrivalBurst {
+			count = s.config.burstSize
+		}
+		for i := 0; i < count; i++ {
+			s.addTask()
+		}
+		time.Sleep(s.nextArrival(count))
+	}
+}
+
+func (s *simulation) nextArrival(count int) time.Duration {
+	interval := float64(time.Second) / s.config.producerRPS
+	switch s.config.arrival {
+	case arrivalPoisson:
+		return time.Duration(rand.ExpFloat64() * interval)
+	case arrivalBurst:
+		return time.Duration(float64(count) * interval)
+	default:
+		return time.Duration(interval)
+	}
+}
+
+func (s *simulation) addTask() {
+	scheduleID := atomic.AddInt64(&s.nextScheduleID, 1)
+	s.addTimes.Store(scheduleID, time.Now())
+	atomic.AddInt64(&s.produced, 1)
+
+	partition := s.taskList.mkName(rand.Intn(s.config.writePartitions))
+	syncMatch, err := s.engine.AddActivityTask(context.Background(), &matching.AddActivityTaskRequest{
+		SourceDomainUUID: common.StringPtr(s.domainID),
+		DomainUUID:       common.StringPtr(s.domainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("simulation-wf"),
+			RunId:      common.StringPtr("simulation-run"),
+		},
+		ScheduleId:                    common.Int64Ptr(scheduleID),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(partition)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	if err != nil {
+		atomic.AddInt64(&s.addErrors, 1)
+		return
+	}
+	if syncMatch {
+		atomic.AddInt64(&s.syncMatched, 1)
+	}
+}
+
+func (s *simulation) poller(ctx context.Context) {
+	for ctx.Err() == nil {
+		partition := s.taskList.mkName(rand.Intn(s.config.readPartitions))
+		resp, err := s.engine.PollForActivityTask(ctx, &matching.PollForActivityTaskRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			PollerID:   common.StringPtr(fmt.Sprintf("poller-%v", rand.Int63())),
+			PollRequest: &workflow.PollForActivityTaskRequest{
+				TaskList:         &workflow.TaskList{Name: common.StringPtr(partition)},
+				Identity:         common.StringPtr("simulation"),
+				TaskListMetadata: &workflow.TaskListMetadata{MaxTasksPerSecond: common.Float64Ptr(s.config.dispatchRPS)},
+			},
+		})
+		if err != nil || resp == nil || len(resp.TaskToken) == 0 {
+			continue
+		}
+		s.recordDispatch(resp.GetActivityId())
+		time.Sleep(s.config.processingTime)
+	}
+}
+
+func (s *simulation) recordDispatch(activityID string) {
+	scheduleID, err := strconv.ParseInt(activityID, 10, 64)
+	if err != nil {
+		return
+	}
+	addTime, ok := s.addTimes.Load(scheduleID)
+	if !ok {
+		return
+	}
+	atomic.AddInt64(&s.dispatched, 1)
+	s.Lock()
+	s.latencies = append(s.latencies, time.Since(addTime.(time.Time)))
+	s.Unlock()
+}
+
+func (s *simulation) report(elapsed time.Duration) *simulationReport {
+	s.Lock()
+	latencies := append([]time.Duration(nil), s.latencies...)
+	s.Unlock()
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	report := &simulationReport{
+		name:           s.config.name,
+		elapsed:        elapsed,
+		produced:       atomic.LoadInt64(&s.produced),
+		dispatched:     atomic.LoadInt64(&s.dispatched),
+		syncMatched:    atomic.LoadInt64(&s.syncMatched),
+		addErrors:      atomic.LoadInt64(&s.addErrors),
+		forwardedTasks: atomic.LoadInt64(&s.forwardedTasks),
+		forwardedPolls: atomic.LoadInt64(&s.forwardedPolls),
+		latencyP50:     percentile(latencies, 50),
+		latencyP90:     percentile(latencies, 90),
+		latencyP99:     percentile(latencies, 99),
+	}
+	report.backlog = report.produced - report.addErrors - report.dispatched
+	if len(latencies) > 0 {
+		report.latencyMax = latencies[len(latencies)-1]
+	}
+	return report
+}
+
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+func (r *simulationReport) syncMatchRate() float64 {
+	if r.produced == 0 {
+		return 0
+	}
+	return float64(r.syncMatched) / float64(r.produced) * 100
+}
+
+func (r *simulationReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "simulation %v (elapsed %v)\n", r.name, r.elapsed.Round(time.Millisecond))
+	fmt.Fprintf(&b, "  produced=%v dispatched=%v backlog=%v addErrors=%v\n", r.produced, r.dispatched, r.backlog, r.addErrors)
+	fmt.Fprintf(&b, "  syncMatch=%.2f%% forwardedTasks=%v forwardedPolls=%v\n", r.syncMatchRate(), r.forwardedTasks, r.forwardedPolls)
+	fmt.Fprintf(&b, "  latency p50=%v p90=%v p99=%v max=%v", r.latencyP50, r.latencyP90, r.latencyP99, r.latencyMax)
+	return b.String()
+}
+
+func (c *simulationMatchingClient) AddActivityTask(
+	ctx context.Context,
+	request *matching.AddActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+	atomic.AddInt64(&c.sim.forwardedTasks, 1)
+	_, err := c.sim.engine.AddActivityTask(ctx, request)
+	return err
+}
+
+func (c *simulationMatchingClient) PollForActivityTask(
+	ctx context.Context,
+	request *matching.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (*workflow.PollForActivityTaskResponse, error) {
+	atomic.AddInt64(&c.sim.forwardedPolls, 1)
+	return c.sim.engine.PollForActivityTask(ctx, request)
+}
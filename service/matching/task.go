@@ -54,6 +54,7 @@ type (
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint int64
+		priority         int32 // priority the task was added with, preserved when the task is forwarded
 	}
 )
 
@@ -24,10 +24,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/matching"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -50,6 +52,7 @@ type (
 		execution     *s.WorkflowExecution
 		taskInfo      *persistence.TaskInfo
 		forwardedFrom string
+		priority      int32
 	}
 
 	taskListManager interface {
@@ -72,6 +75,8 @@ type (
 		DispatchQueryTask(ctx context.Context, taskID string, request *matching.QueryWorkflowRequest) ([]byte, error)
 		CancelPoller(pollerID string)
 		GetAllPollerInfo() []*s.PollerInfo
+		// GetAllWorkerInfo returns the workers which polled the task list in the last hour
+		GetAllWorkerInfo() []*s.WorkerInfo
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse
 		String() string
@@ -96,6 +101,8 @@ type (
 		domainScopeValue atomic.Value // domain tagged metric scope
 		// pollerHistory stores poller which poll from this tasklist in last few minutes
 		pollerHistory *pollerHistory
+		// workerRegistry stores the versions and last poll times of the workers of this tasklist
+		workerRegistry *workerRegistry
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
 		// particular tasklist.  PollerID generated by frontend is used as the key and
 		// CancelFunc is the value.  This is used to cancel the context to unblock any
@@ -103,6 +110,24 @@ type (
 		// prevent tasks being dispatched to zombie pollers.
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
+		// outstandingPollers and lastPollTime (unix nanos) are used to track liveness of
+		// the worker polling a worker specific task list
+		outstandingPollers int32
+		lastPollTime       int64
+		// drained is 1 while dispatching of the task list is stopped through dynamic config
+		drained int32
+
+		// priorityQueues persist and read the tasks of each priority above the default one, they
+		// share the matcher and pollers of the task list owning them, whose taskReader dispatches
+		// their backlog in the order picked by the priority dispatcher
+		priorityQueues []*taskListManagerImpl
+		dispatcher     *priorityDispatcher
+		numPriorities  int                  // number of priorities new tasks are added to, sub-queues above it are only drained
+		owner          *taskListManagerImpl // non-nil for a priority sub-queue
+		priority       int32                // priority of the tasks of a priority sub-queue
+
+		// scaler adjusts the number of partitions of the task list, only set on the root partition of normal task lists
+		scaler *adaptiveScaler
 
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
@@ -113,6 +138,10 @@ type (
 const (
 	// maxSyncMatchWaitTime is the max amount of time that we are willing to wait for a sync match to happen
 	maxSyncMatchWaitTime = 200 * time.Millisecond
+	// describePartitionTimeout is the timeout of describing a partition of the task list for the adaptive scaler
+	describePartitionTimeout = 2 * time.Second
+	// maxTaskPriorities is the max number of task priorities a task list dispatches
+	maxTaskPriorities = 8
 )
 
 var _ taskListManager = (*taskListManagerImpl)(nil)
@@ -133,7 +162,55 @@ func newTaskListManager(
 		taskListKind = common.TaskListKindPtr(s.TaskListKindNormal)
 	}
 
-	db := newTaskListDB(e.taskManager, taskList.domainID, taskList.name, taskList.taskType, int(*taskListKind), e.logger)
+	tlMgr := newTaskListManagerImpl(e, taskList, *taskListKind, taskListConfig)
+	if *taskListKind == s.TaskListKindNormal {
+		tlMgr.initPartitionConfig()
+	}
+	var fwdr *Forwarder
+	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
+		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient, tlMgr.domainScope)
+	}
+	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.domainScope)
+	if *taskListKind == s.TaskListKindNormal && taskList.taskType == persistence.TaskListTypeDecision {
+		// a sticky miss lands on the normal task list, where decision tasks prefer the worker
+		// which most likely still has the workflow cached
+		tlMgr.matcher.affinity = newWorkflowAffinity(taskListConfig)
+	}
+
+	if *taskListKind == s.TaskListKindNormal {
+		tlMgr.numPriorities = taskListConfig.NumTaskPriorities()
+		// the sub-queues of priorities above the configured number are still loaded while they have
+		// a backlog, otherwise lowering the number of priorities strands their tasks
+		numQueues := tlMgr.numPriorities
+		for priority := numQueues; priority < maxTaskPriorities; priority++ {
+			if hasBacklog(e, taskList.priorityQueueID(priority)) {
+				numQueues = priority + 1
+			}
+		}
+		if numQueues > 1 {
+			tlMgr.dispatcher = newPriorityDispatcher(numQueues, taskListConfig.TaskPriorityDispatchRatio)
+		}
+		for priority := 1; priority < numQueues; priority++ {
+			pq := newTaskListManagerImpl(e, taskList.priorityQueueID(priority), *taskListKind, taskListConfig)
+			pq.owner = tlMgr
+			pq.priority = int32(priority)
+			pq.matcher = tlMgr.matcher
+			pq.pollerHistory = tlMgr.pollerHistory
+			pq.workerRegistry = tlMgr.workerRegistry
+			tlMgr.priorityQueues = append(tlMgr.priorityQueues, pq)
+		}
+	}
+	return tlMgr, nil
+}
+
+func newTaskListManagerImpl(
+	e *matchingEngineImpl,
+	taskList *taskListID,
+	taskListKind s.TaskListKind,
+	taskListConfig *taskListConfig,
+) *taskListManagerImpl {
+
+	db := newTaskListDB(e.taskManager, taskList.domainID, taskList.name, taskList.taskType, int(taskListKind), e.logger)
 	tlMgr := &taskListManagerImpl{
 		domainCache:   e.domainCache,
 		metricsClient: e.metricsClient,
@@ -147,21 +224,18 @@ func newTaskListManager(
 		taskGC:              newTaskGC(db, taskListConfig),
 		config:              taskListConfig,
 		pollerHistory:       newPollerHistory(),
+		workerRegistry:      newWorkerRegistry(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
-		taskListKind:        int(*taskListKind),
+		taskListKind:        int(taskListKind),
+		lastPollTime:        time.Now().UnixNano(),
 	}
 	tlMgr.domainNameValue.Store("")
 	tlMgr.domainScopeValue.Store(e.metricsClient.Scope(metrics.MatchingTaskListMgrScope, metrics.DomainUnknownTag()))
 	tlMgr.tryInitDomainNameAndScope()
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr)
-	var fwdr *Forwarder
-	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
-		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient, tlMgr.domainScope)
-	}
-	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.domainScope)
 	tlMgr.startWG.Add(1)
-	return tlMgr, nil
+	return tlMgr
 }
 
 // Starts reading pump for the given task list.
@@ -179,7 +253,15 @@ func (c *taskListManagerImpl) Start() error {
 	c.taskAckManager.setAckLevel(state.ackLevel)
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
+	if c.scaler != nil {
+		c.scaler.Start()
+	}
 
+	for _, pq := range c.priorityQueues {
+		if err := pq.Start(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -189,8 +271,19 @@ func (c *taskListManagerImpl) Stop() {
 		return
 	}
 	close(c.shutdownCh)
+	if c.scaler != nil {
+		c.scaler.Stop()
+	}
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	// a task list and its priority sub-queues are always stopped together
+	for _, pq := range c.priorityQueues {
+		pq.Stop()
+	}
+	if c.owner != nil {
+		c.owner.Stop()
+		return
+	}
 	c.engine.removeTaskListManager(c.taskListID)
 	c.engine.removeTaskListManager(c.taskListID)
 	c.logger.Info("", tag.LifeCycleStopped)
@@ -201,6 +294,25 @@ func (c *taskListManagerImpl) Stop() {
 // be written to database and later asynchronously matched with a poller
 func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams) (bool, error) {
 	c.startWG.Wait()
+	if c.owner == nil {
+		c.checkStaleWorkers()
+	}
+	if c.scaler != nil && params.forwardedFrom == "" {
+		c.scaler.recordAdd()
+	}
+	if c.taskListKind == int(s.TaskListKindSticky) && c.workerRegistry.hasOnlyStaleWorkers(c.config.StaleWorkerThreshold()) {
+		// the sticky worker stopped polling, the caller dispatches the task to the normal task list instead
+		return false, createTaskDispatchFailedError("No poller on the sticky TaskList", matching.TaskDispatchFailureReasonNoPollers)
+	}
+	if pq := c.priorityQueue(params.priority); pq != nil {
+		syncMatch, err := pq.AddTask(ctx, params)
+		if err == nil {
+			// the write to the sub-queue keeps the task list from being considered idle
+			c.taskReader.Signal()
+		}
+		return syncMatch, err
+	}
+
 	var syncMatch bool
 	_, err := c.executeWithRetry(func() (interface{}, error) {
 
@@ -216,6 +328,10 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 		}
 
 		syncMatch, err = c.trySyncMatch(ctx, params)
+		if _, ok := err.(*h.IncompatibleBinaryChecksumError); ok {
+			// the poller which picked up the task cannot process it, persist the task for a compatible poller
+			syncMatch = false
+		}
 		if syncMatch {
 			return &persistence.CreateTasksResponse{}, err
 		}
@@ -273,8 +389,11 @@ func (c *taskListManagerImpl) GetTask(
 	if err != nil {
 		return nil, err
 	}
+	if c.scaler != nil && !task.isQuery() {
+		c.scaler.recordDispatch()
+	}
 	task.domainName = c.domainName()
-	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
+	task.backlogCountHint = c.backlogCountHint()
 	return task, nil
 }
 
@@ -300,9 +419,17 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		}()
 	}
 
+	atomic.AddInt32(&c.outstandingPollers, 1)
+	atomic.StoreInt64(&c.lastPollTime, time.Now().UnixNano())
+	defer func() {
+		atomic.StoreInt64(&c.lastPollTime, time.Now().UnixNano())
+		atomic.AddInt32(&c.outstandingPollers, -1)
+	}()
+
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
 		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		c.workerRegistry.recordPoll(pollerIdentity(identity), newWorkerVersion(ctx))
 	}
 
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
@@ -317,18 +444,60 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// value. Last poller wins if different pollers provide different values
 	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
 
-	if domainEntry.GetDomainNotActiveErr() != nil {
+	// a drained task list holds its backlog, pollers wait until the long poll expires and get an empty response
+	if c.isDrained() {
+		c.domainScope().IncCounter(metrics.DrainedPollCounter)
+		<-childCtx.Done()
+		return nil, ErrNoTasks
+	}
+
+	// when the domain is not active or its processing is paused, only queries are dispatched,
+	// tasks stay in the backlog until they can be matched again
+	if domainEntry.GetDomainNotActiveErr() != nil || c.config.DomainProcessingPaused() {
 		return c.matcher.PollForQuery(childCtx)
 	}
 
 	return c.matcher.Poll(childCtx)
 }
 
+// isDrained returns whether dispatching of the task list is stopped, changes of the drain status are
+// logged and reported through the tasklist_drained gauge
+func (c *taskListManagerImpl) isDrained() bool {
+	drained := c.config.Drained()
+	value := int32(0)
+	if drained {
+		value = 1
+	}
+	if atomic.SwapInt32(&c.drained, value) != value {
+		c.domainScope().Tagged(metrics.TaskListTag(c.taskListID.baseName)).UpdateGauge(metrics.TaskListDrainedGauge, float64(value))
+		c.logger.Info("Task list drain status changed.", tag.Value(drained))
+	}
+	return drained
+}
+
 // GetAllPollerInfo returns all pollers that polled from this tasklist in last few minutes
 func (c *taskListManagerImpl) GetAllPollerInfo() []*s.PollerInfo {
 	return c.pollerHistory.getAllPollerInfo()
 }
 
+// checkStaleWorkers emits a metric if the workers of a normal task list all stopped polling,
+// e.g. because a deployment left no healthy worker behind
+func (c *taskListManagerImpl) checkStaleWorkers() {
+	if c.taskListKind != int(s.TaskListKindNormal) || !c.workerRegistry.hasOnlyStaleWorkers(c.config.StaleWorkerThreshold()) {
+		return
+	}
+	c.domainScope().Tagged(metrics.TaskListTag(c.taskListID.baseName)).IncCounter(metrics.StaleWorkersOnlyTaskCounter)
+}
+
+// GetAllWorkerInfo returns the workers which polled this tasklist in the last hour
+func (c *taskListManagerImpl) GetAllWorkerInfo() []*s.WorkerInfo {
+	taskListType := s.TaskListTypeDecision
+	if c.taskListID.taskType == persistence.TaskListTypeActivity {
+		taskListType = s.TaskListTypeActivity
+	}
+	return c.workerRegistry.getAllWorkerInfo(taskListType, c.config.StaleWorkerThreshold())
+}
+
 func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	c.outstandingPollsLock.Lock()
 	cancel, ok := c.outstandingPollsMap[pollerID]
@@ -344,6 +513,15 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 // (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse {
 	response := &s.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
+	if c.scaler != nil && c.config.EnableAdaptiveScaler() {
+		if config := c.db.PartitionConfig(); config != nil {
+			response.PartitionConfig = &s.TaskListPartitionConfig{
+				Version:            common.Int64Ptr(config.Version),
+				NumReadPartitions:  common.Int32Ptr(int32(config.NumReadPartitions)),
+				NumWritePartitions: common.Int32Ptr(int32(config.NumWritePartitions)),
+			}
+		}
+	}
 	if !includeTaskListStatus {
 		return response
 	}
@@ -352,7 +530,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 	response.TaskListStatus = &s.TaskListStatus{
 		ReadLevel:        common.Int64Ptr(c.taskAckManager.getReadLevel()),
 		AckLevel:         common.Int64Ptr(c.taskAckManager.getAckLevel()),
-		BacklogCountHint: common.Int64Ptr(c.taskAckManager.getBacklogCountHint()),
+		BacklogCountHint: common.Int64Ptr(c.backlogCountHint()),
 		RatePerSecond:    common.Float64Ptr(c.matcher.Rate()),
 		TaskIDBlock: &s.TaskIDBlock{
 			StartID: common.Int64Ptr(taskIDBlock.start),
@@ -363,6 +541,59 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 	return response
 }
 
+// describePartition describes a non root partition of the task list, which may be owned by another host
+func (c *taskListManagerImpl) describePartition(partition int) (*s.DescribeTaskListResponse, error) {
+	taskListType := s.TaskListTypeDecision
+	if c.taskListID.taskType == persistence.TaskListTypeActivity {
+		taskListType = s.TaskListTypeActivity
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), describePartitionTimeout)
+	defer cancel()
+	return c.engine.matchingClient.DescribeTaskList(ctx, &matching.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(c.taskListID.domainID),
+		DescRequest: &s.DescribeTaskListRequest{
+			TaskList:              &s.TaskList{Name: common.StringPtr(c.taskListID.mkName(partition)), Kind: s.TaskListKindNormal.Ptr()},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: common.BoolPtr(true),
+		},
+	})
+}
+
+// initPartitionConfig makes the number of partitions of the task list follow the adaptive scaler when it
+// is enabled. The root partition runs the scaler and reads the partition config it persisted, the other
+// partitions refresh it from the root partition.
+func (c *taskListManagerImpl) initPartitionConfig() {
+	staticNumReadPartitions := c.config.NumReadPartitions
+	staticNumWritePartitions := c.config.NumWritePartitions
+	if c.taskListID.IsRoot() {
+		c.scaler = newAdaptiveScaler(c.config, c.db, c.pollerHistory, c.describePartition, c.logger, c.domainScope)
+		c.config.NumReadPartitions = func() int {
+			if config := c.db.PartitionConfig(); config != nil && c.config.EnableAdaptiveScaler() {
+				return config.NumReadPartitions
+			}
+			return staticNumReadPartitions()
+		}
+		c.config.NumWritePartitions = func() int {
+			if config := c.db.PartitionConfig(); config != nil && c.config.EnableAdaptiveScaler() {
+				return config.NumWritePartitions
+			}
+			return staticNumWritePartitions()
+		}
+		return
+	}
+
+	if c.engine.partitionConfigs == nil {
+		return
+	}
+	taskList := s.TaskList{Name: common.StringPtr(c.taskListID.baseName), Kind: s.TaskListKindNormal.Ptr()}
+	c.config.NumReadPartitions = func() int {
+		return c.engine.partitionConfigs.GetNumberOfReadPartitions(c.taskListID.domainID, taskList, c.taskListID.taskType)
+	}
+	c.config.NumWritePartitions = func() int {
+		return c.engine.partitionConfigs.GetNumberOfWritePartitions(c.taskListID.domainID, taskList, c.taskListID.taskType)
+	}
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.taskType == persistence.TaskListTypeActivity {
@@ -423,10 +654,18 @@ func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
 		return
 	}
 	c.domainScope().IncCounter(metrics.LeaseRequestCounter)
-	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.Retry(
+		op,
+		persistenceOperationRetryPolicy,
+		persistence.CountRetries(c.metricsClient, metrics.PersistenceLeaseTaskListScope, common.IsPersistenceTransientError),
+	)
 	if err != nil {
 		c.domainScope().IncCounter(metrics.LeaseFailureCounter)
-		c.engine.unloadTaskList(c.taskListID)
+		if c.owner != nil {
+			c.Stop()
+		} else {
+			c.engine.unloadTaskList(c.taskListID)
+		}
 		return newState, err
 	}
 	return newState, nil
@@ -491,6 +730,7 @@ func (c *taskListManagerImpl) executeWithRetry(
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
 	childCtx, cancel := c.newChildContext(ctx, maxSyncMatchWaitTime, time.Second)
 	task := newInternalTask(params.taskInfo, c.completeTask, params.forwardedFrom, true)
+	task.priority = params.priority
 	matched, err := c.matcher.Offer(childCtx, task)
 	cancel()
 	return matched, err
@@ -523,12 +763,73 @@ func (c *taskListManagerImpl) newChildContext(
 	return context.WithTimeout(parent, timeout)
 }
 
+// priorityQueue returns the sub-queue for tasks of the given priority, priorities
+// above the highest configured one are capped to it. Returns nil for the default
+// priority or when the task list has a single priority
+func (c *taskListManagerImpl) priorityQueue(priority int32) *taskListManagerImpl {
+	if priority <= 0 || c.numPriorities <= 1 {
+		return nil
+	}
+	if int(priority) >= c.numPriorities {
+		priority = int32(c.numPriorities - 1)
+	}
+	return c.priorityQueues[priority-1]
+}
+
+// hasBacklog returns true if tasks are persisted for the given task list, it is used to find
+// the priority sub-queues left with a backlog after the number of priorities was lowered
+func hasBacklog(e *matchingEngineImpl, taskList *taskListID) bool {
+	resp, err := e.taskManager.GetTasks(&persistence.GetTasksRequest{
+		DomainID:     taskList.domainID,
+		TaskList:     taskList.name,
+		TaskType:     taskList.taskType,
+		ReadLevel:    0,
+		MaxReadLevel: common.Int64Ptr(math.MaxInt64),
+		BatchSize:    1,
+	})
+	if err != nil {
+		e.logger.Warn("Failed to check the backlog of a priority sub-queue.",
+			tag.WorkflowTaskListName(taskList.name), tag.Error(err))
+		return false
+	}
+	return len(resp.Tasks) > 0
+}
+
+// backlogCountHint returns the approximate number of tasks in the backlog of
+// this task list including the backlog of its priority sub-queues
+func (c *taskListManagerImpl) backlogCountHint() int64 {
+	count := c.taskAckManager.getBacklogCountHint()
+	for _, pq := range c.priorityQueues {
+		count += pq.taskAckManager.getBacklogCountHint()
+	}
+	return count
+}
+
 func (c *taskListManagerImpl) isFowardingAllowed(taskList *taskListID, kind s.TaskListKind) bool {
-	return !taskList.IsRoot() && kind != s.TaskListKindSticky
+	return !taskList.IsRoot() && kind != s.TaskListKindSticky && kind != s.TaskListKindWorker
+}
+
+// isWorkerActivityTaskList returns true if this is an activity task list polled by a single specific worker
+func (c *taskListManagerImpl) isWorkerActivityTaskList() bool {
+	return c.taskListKind == int(s.TaskListKindWorker) && c.taskListID.taskType == persistence.TaskListTypeActivity
+}
+
+// isWorkerUnavailable returns true if this is a worker specific activity task list of an active domain
+// which has had no outstanding polls for longer than the liveness timeout
+func (c *taskListManagerImpl) isWorkerUnavailable() bool {
+	if !c.isWorkerActivityTaskList() || atomic.LoadInt32(&c.outstandingPollers) > 0 {
+		return false
+	}
+	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
+	if err != nil || domainEntry.GetDomainNotActiveErr() != nil {
+		return false
+	}
+	lastPollTime := time.Unix(0, atomic.LoadInt64(&c.lastPollTime))
+	return time.Since(lastPollTime) > c.config.WorkerTaskListLivenessTimeout()
 }
 
-func createServiceBusyError(msg string) *s.ServiceBusyError {
-	return &s.ServiceBusyError{Message: msg}
+func createTaskDispatchFailedError(msg string, reason matching.TaskDispatchFailureReason) *matching.TaskDispatchFailedError {
+	return &matching.TaskDispatchFailedError{Message: common.StringPtr(msg), Reason: reason.Ptr()}
 }
 
 func (c *taskListManagerImpl) domainScope() metrics.Scope {
@@ -29,6 +29,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
@@ -112,6 +113,46 @@ func TestIsTaskAddedRecently(t *testing.T) {
 	require.False(t, tlm.taskReader.isTaskAddedRecently(time.Time{}))
 }
 
+func TestIsWorkerUnavailable(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.WorkerTaskListLivenessTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	time.Sleep(20 * time.Millisecond)
+	require.False(t, tlm.isWorkerUnavailable(), "normal task list is never considered unavailable")
+
+	tlm.taskListKind = int(workflow.TaskListKindWorker)
+	require.True(t, tlm.isWorkerUnavailable())
+
+	atomic.AddInt32(&tlm.outstandingPollers, 1)
+	require.False(t, tlm.isWorkerUnavailable(), "outstanding poll keeps worker alive")
+	atomic.AddInt32(&tlm.outstandingPollers, -1)
+
+	atomic.StoreInt64(&tlm.lastPollTime, time.Now().UnixNano())
+	require.False(t, tlm.isWorkerUnavailable(), "recent poll keeps worker alive")
+	time.Sleep(20 * time.Millisecond)
+	require.True(t, tlm.isWorkerUnavailable())
+}
+
+func TestAddTask_StickyWorkerStale(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.StaleWorkerThreshold = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	tlm.taskListKind = int(workflow.TaskListKindSticky)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	tlm.workerRegistry.recordPoll(pollerIdentity("worker"), workerVersion{})
+	time.Sleep(20 * time.Millisecond)
+
+	_, err := tlm.AddTask(context.Background(), addTaskParams{
+		execution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		taskInfo:  &persistence.TaskInfo{DomainID: "domain", WorkflowID: "wid", RunID: "rid", ScheduleID: 1},
+	})
+	dispatchErr, ok := err.(*matching.TaskDispatchFailedError)
+	require.True(t, ok)
+	require.Equal(t, matching.TaskDispatchFailureReasonNoPollers, dispatchErr.GetReason())
+}
+
 func TestDescribeTaskList(t *testing.T) {
 	startTaskID := int64(1)
 	taskCount := int64(3)
@@ -169,6 +210,86 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Zero(t, taskListStatus.GetBacklogCountHint())
 }
 
+func TestPriorityTaskDispatch(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.NumTaskPriorities = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	require.Len(t, tlm.priorityQueues, 2)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	addTask := func(scheduleID int64, priority int32) {
+		_, err := tlm.AddTask(context.Background(), addTaskParams{
+			execution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+			taskInfo:  &persistence.TaskInfo{DomainID: "domain", WorkflowID: "wid", RunID: "rid", ScheduleID: scheduleID},
+			priority:  priority,
+		})
+		require.NoError(t, err)
+	}
+	for scheduleID := int64(1); scheduleID <= 5; scheduleID++ {
+		addTask(scheduleID, 0)
+	}
+	// priorities above the highest one are capped to it
+	addTask(10, 5)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	pqID := newTestTaskListID("domain", tlm.priorityQueues[1].taskListID.name, persistence.TaskListTypeActivity)
+	require.Equal(t, 1, tm.getTaskCount(pqID))
+
+	// wait for the task to be loaded by the sub-queue
+	for i := 0; i < 100 && len(tlm.priorityQueues[1].taskReader.taskBuffer) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var polled []int64
+	for i := 0; i < 6; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+time.Second)
+		task, err := tlm.GetTask(ctx, nil)
+		cancel()
+		require.NoError(t, err)
+		polled = append(polled, task.event.ScheduleID)
+		task.finish(nil)
+	}
+	// the high priority task is dispatched ahead of the backlog of the default priority
+	require.Contains(t, polled[:2], int64(10))
+	require.Equal(t, 0, tm.getTaskCount(pqID))
+}
+
+func TestPriorityQueuesDrainedAfterLowered(t *testing.T) {
+	cfg := defaultTestConfig()
+	cfg.NumTaskPriorities = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	tlm := createTestTaskListManagerWithConfig(cfg)
+	require.NoError(t, tlm.Start())
+	_, err := tlm.AddTask(context.Background(), addTaskParams{
+		execution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		taskInfo:  &persistence.TaskInfo{DomainID: "domain", WorkflowID: "wid", RunID: "rid", ScheduleID: 10},
+		priority:  2,
+	})
+	require.NoError(t, err)
+	tlm.Stop()
+
+	// the sub-queue of priority 2 keeps being dispatched until its backlog is drained
+	cfg.NumTaskPriorities = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	mgr, err := newTaskListManager(tlm.engine, tlm.taskListID, common.TaskListKindPtr(workflow.TaskListKindNormal), cfg)
+	require.NoError(t, err)
+	tlm = mgr.(*taskListManagerImpl)
+	require.Len(t, tlm.priorityQueues, 2)
+	// new tasks are only added to the configured priorities
+	require.Equal(t, tlm.priorityQueues[0], tlm.priorityQueue(2))
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+time.Second)
+	defer cancel()
+	task, err := tlm.GetTask(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), task.event.ScheduleID)
+	task.finish(nil)
+
+	// the number of priorities is unchanged for task lists without stranded backlog
+	cfg.NumTaskPriorities = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+	require.Empty(t, createTestTaskListManagerWithConfig(cfg).priorityQueues)
+}
+
 func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
 	// mimic tlm.Start() but avoid calling notifyEvent
 	tlm.startWG.Done()
@@ -25,6 +25,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -48,6 +49,9 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		// Used as signal to notify the dispatcher of a task list with priority sub-queues of
+		// tasks buffered by any of the sub-queues
+		dispatchNotifyC chan struct{}
 	}
 )
 
@@ -58,6 +62,7 @@ func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 		cancelCtx:           ctx,
 		cancelFunc:          cancel,
 		notifyC:             make(chan struct{}, 1),
+		dispatchNotifyC:     make(chan struct{}, 1),
 		dispatcherShutdownC: make(chan struct{}),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
@@ -67,7 +72,10 @@ func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 
 func (tr *taskReader) Start() {
 	tr.Signal()
-	go tr.dispatchBufferedTasks()
+	if tr.tlMgr.owner == nil {
+		// buffered tasks of a priority sub-queue are dispatched by the task list owning it
+		go tr.dispatchBufferedTasks()
+	}
 	go tr.getTasksPump()
 }
 
@@ -85,6 +93,11 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks() {
+	if len(tr.tlMgr.priorityQueues) > 0 {
+		tr.dispatchPrioritizedTasks()
+		return
+	}
+
 dispatchLoop:
 	for {
 		select {
@@ -93,19 +106,8 @@ dispatchLoop:
 				break dispatchLoop
 			}
 			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
-					break dispatchLoop
-				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottleCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+			if !tr.dispatchBufferedTask(task) {
+				break dispatchLoop
 			}
 		case <-tr.dispatcherShutdownC:
 			break dispatchLoop
@@ -113,6 +115,99 @@ dispatchLoop:
 	}
 }
 
+// dispatchPrioritizedTasks dispatches the tasks buffered by this task list and by its
+// priority sub-queues, the priority dispatcher picks the buffer of the next task
+func (tr *taskReader) dispatchPrioritizedTasks() {
+	readers := []*taskReader{tr}
+	for _, pq := range tr.tlMgr.priorityQueues {
+		readers = append(readers, pq.taskReader)
+	}
+	ready := func(priority int) bool {
+		return len(readers[priority].taskBuffer) > 0
+	}
+
+	for {
+		priority := tr.tlMgr.dispatcher.next(ready)
+		if priority == -1 {
+			select {
+			case <-tr.dispatchNotifyC:
+				continue
+			case <-tr.dispatcherShutdownC:
+				return
+			}
+		}
+
+		reader := readers[priority]
+		taskInfo, ok := <-reader.taskBuffer
+		if !ok {
+			return
+		}
+		task := newInternalTask(taskInfo, reader.tlMgr.completeTask, "", false)
+		task.priority = reader.tlMgr.priority
+		if !tr.dispatchBufferedTask(task) {
+			return
+		}
+	}
+}
+
+// dispatchBufferedTask dispatches a task loaded from persistence to a poller,
+// returns false when the task list is shutting down
+func (tr *taskReader) dispatchBufferedTask(task *internalTask) bool {
+	for {
+		if tr.tlMgr.isWorkerUnavailable() {
+			tr.failWorkerUnavailableTask(task.event.TaskInfo)
+			return true
+		}
+		err := tr.dispatchTask(task)
+		if err == nil {
+			return true
+		}
+		if err == context.Canceled {
+			tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+			return false
+		}
+		if err == context.DeadlineExceeded {
+			// dispatch to a worker specific task list timed out, re-check worker liveness
+			continue
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.scope().IncCounter(metrics.BufferThrottleCounter)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		runtime.Gosched()
+	}
+}
+
+// dispatchTask dispatches a buffered task to a poller. Dispatch to a worker specific
+// activity task list is bounded by the liveness timeout, so that the task can be
+// failed back to history once the worker goes away
+func (tr *taskReader) dispatchTask(task *internalTask) error {
+	if !tr.tlMgr.isWorkerActivityTaskList() {
+		return tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+	}
+	ctx, cancel := context.WithTimeout(tr.cancelCtx, tr.tlMgr.config.WorkerTaskListLivenessTimeout())
+	defer cancel()
+	err := tr.tlMgr.DispatchTask(ctx, task)
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// failWorkerUnavailableTask notifies history that the worker polling this task list is
+// gone, so the activity times out instead of waiting on a task list nobody polls
+func (tr *taskReader) failWorkerUnavailableTask(taskInfo *persistence.TaskInfo) {
+	err := tr.tlMgr.engine.recordActivityTaskWorkerUnavailable(tr.cancelCtx, taskInfo, tr.tlMgr.taskListID.name)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			tr.logger().Error("taskReader: failed to record worker unavailable", tag.Error(err))
+			tr.tlMgr.completeTask(taskInfo, err)
+			return
+		}
+	}
+	tr.scope().IncCounter(metrics.WorkerUnavailableTaskCounter)
+	tr.tlMgr.completeTask(taskInfo, nil)
+}
+
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
 	defer close(tr.taskBuffer)
@@ -224,6 +319,10 @@ func (tr *taskReader) isTaskExpired(t *persistence.TaskInfo, now time.Time) bool
 }
 
 func (tr *taskReader) isIdle(lastWriteTime time.Time) bool {
+	if tr.tlMgr.owner != nil {
+		// priority sub-queues are unloaded along with the task list owning them
+		return false
+	}
 	return !tr.isTaskAddedRecently(lastWriteTime) && len(tr.tlMgr.GetAllPollerInfo()) == 0
 }
 
@@ -254,6 +353,7 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	for {
 		select {
 		case tr.taskBuffer <- task:
+			tr.notifyDispatcher()
 			return true
 		case <-idleTimer.C:
 			if tr.isIdle(lastWriteTime) {
@@ -266,6 +366,17 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	}
 }
 
+func (tr *taskReader) notifyDispatcher() {
+	dispatcher := tr
+	if tr.tlMgr.owner != nil {
+		dispatcher = tr.tlMgr.owner.taskReader
+	}
+	select {
+	case dispatcher.dispatchNotifyC <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
 func (tr *taskReader) persistAckLevel() error {
 	return tr.tlMgr.db.UpdateState(tr.tlMgr.taskAckManager.getAckLevel())
 }
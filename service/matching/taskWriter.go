@@ -24,6 +24,7 @@ import (
 	"errors"
 	"sync/atomic"
 
+	"github.com/uber/cadence/.gen/go/matching"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -117,7 +118,12 @@ func (w *taskWriter) appendTask(execution *s.WorkflowExecution,
 			return nil, errShutdown
 		}
 	default: // channel is full, throttle
-		return nil, createServiceBusyError("Too many outstanding appends to the TaskList")
+		reason := matching.TaskDispatchFailureReasonRateLimited
+		if w.config.Drained() {
+			// the backlog of a drained task list is not consumed, the caller should back off longer
+			reason = matching.TaskDispatchFailureReasonTaskListDrained
+		}
+		return nil, createTaskDispatchFailedError("Too many outstanding appends to the TaskList", reason)
 	}
 }
 
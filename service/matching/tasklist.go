@@ -134,6 +134,22 @@ func newTaskListID(domainID, taskListName string, taskType int) (*taskListID, er
 	}, nil
 }
 
+// priorityQueueID returns the id of the sub-queue which persists the tasks of the
+// given priority for this task list. Sub-queues have internal names of the form
+//
+//     /__cadence_sys/[task-list-name]/priority/[priority]
+func (tid *taskListID) priorityQueueID(priority int) *taskListID {
+	return &taskListID{
+		qualifiedTaskListName: qualifiedTaskListName{
+			name:      fmt.Sprintf("%v%v/priority/%v", taskListPartitionPrefix, tid.name, priority),
+			baseName:  tid.baseName,
+			partition: tid.partition,
+		},
+		domainID: tid.domainID,
+		taskType: tid.taskType,
+	}
+}
+
 func (tid *taskListID) String() string {
 	var b bytes.Buffer
 	b.WriteString("[")
@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"go.uber.org/yarpc"
+)
+
+const (
+	workerRegistryInitMaxSize = 1000
+	workerRegistryTTL         = time.Hour
+)
+
+type (
+	// workerVersion is the client version a worker reports in the headers of its polls
+	workerVersion struct {
+		clientLibraryVersion string
+		clientFeatureVersion string
+		clientImpl           string
+	}
+
+	// workerRegistry keeps track of the workers which polled a task list in the last hour, unlike
+	// pollerHistory it remembers workers long after they stopped polling, so that task lists left
+	// with only stale workers, e.g. after a bad deployment, can be detected
+	workerRegistry struct {
+		// worker identity -> workerVersion, the create time of an entry is the last poll time of the worker
+		workers cache.Cache
+		// lastPollTime is the last poll time of any worker in unix nanos, 0 if no worker polled yet
+		lastPollTime int64
+	}
+)
+
+// newWorkerVersion returns the client version of the worker which sent the poll request of the given context,
+// the headers are set by the client library and forwarded by frontend
+func newWorkerVersion(ctx context.Context) workerVersion {
+	call := yarpc.CallFromContext(ctx)
+	return workerVersion{
+		clientLibraryVersion: call.Header(common.LibraryVersionHeaderName),
+		clientFeatureVersion: call.Header(common.FeatureVersionHeaderName),
+		clientImpl:           call.Header(common.ClientImplHeaderName),
+	}
+}
+
+func newWorkerRegistry() *workerRegistry {
+	return &workerRegistry{
+		workers: cache.New(workerRegistryInitMaxSize, &cache.Options{TTL: workerRegistryTTL}),
+	}
+}
+
+func (r *workerRegistry) recordPoll(identity pollerIdentity, version workerVersion) {
+	r.workers.Put(identity, &version)
+	atomic.StoreInt64(&r.lastPollTime, time.Now().UnixNano())
+}
+
+// hasOnlyStaleWorkers returns whether workers polled the task list, but none of them in the given threshold
+func (r *workerRegistry) hasOnlyStaleWorkers(staleThreshold time.Duration) bool {
+	lastPollTime := atomic.LoadInt64(&r.lastPollTime)
+	return lastPollTime != 0 && time.Since(time.Unix(0, lastPollTime)) > staleThreshold
+}
+
+func (r *workerRegistry) getAllWorkerInfo(taskListType shared.TaskListType, staleThreshold time.Duration) []*shared.WorkerInfo {
+	var result []*shared.WorkerInfo
+
+	now := time.Now()
+	ite := r.workers.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		key := entry.Key().(pollerIdentity)
+		value := entry.Value().(*workerVersion)
+		lastPollTime := entry.CreateTime()
+		result = append(result, &shared.WorkerInfo{
+			Identity:             common.StringPtr(string(key)),
+			TaskListType:         taskListType.Ptr(),
+			ClientLibraryVersion: common.StringPtr(value.clientLibraryVersion),
+			ClientFeatureVersion: common.StringPtr(value.clientFeatureVersion),
+			ClientImpl:           common.StringPtr(value.clientImpl),
+			LastPollTime:         common.Int64Ptr(lastPollTime.UnixNano()),
+			Stale:                common.BoolPtr(now.Sub(lastPollTime) > staleThreshold),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+func TestWorkerRegistry(t *testing.T) {
+	r := newWorkerRegistry()
+	require.False(t, r.hasOnlyStaleWorkers(time.Minute))
+	require.Empty(t, r.getAllWorkerInfo(shared.TaskListTypeDecision, time.Minute))
+
+	version := workerVersion{
+		clientLibraryVersion: "0.9.0",
+		clientFeatureVersion: "1.0.0",
+		clientImpl:           "uber-go",
+	}
+	r.recordPoll(pollerIdentity("worker-1"), version)
+	r.recordPoll(pollerIdentity("worker-2"), version)
+	version.clientLibraryVersion = "0.10.0"
+	r.recordPoll(pollerIdentity("worker-1"), version)
+
+	require.False(t, r.hasOnlyStaleWorkers(time.Minute))
+	workers := r.getAllWorkerInfo(shared.TaskListTypeActivity, time.Minute)
+	require.Len(t, workers, 2)
+	versions := make(map[string]string)
+	for _, worker := range workers {
+		require.Equal(t, shared.TaskListTypeActivity, worker.GetTaskListType())
+		require.Equal(t, "uber-go", worker.GetClientImpl())
+		require.False(t, worker.GetStale())
+		versions[worker.GetIdentity()] = worker.GetClientLibraryVersion()
+	}
+	require.Equal(t, map[string]string{"worker-1": "0.10.0", "worker-2": "0.9.0"}, versions)
+
+	time.Sleep(10 * time.Millisecond)
+	require.True(t, r.hasOnlyStaleWorkers(time.Millisecond))
+	for _, worker := range r.getAllWorkerInfo(shared.TaskListTypeActivity, time.Millisecond) {
+		require.True(t, worker.GetStale())
+	}
+}
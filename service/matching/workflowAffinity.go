@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+)
+
+const (
+	workflowAffinityTTL = 10 * time.Minute
+)
+
+type (
+	// workflowAffinity keeps track of the worker which last processed a decision task of a workflow,
+	// so that the next decision task of the workflow can be preferably matched with a poller of the
+	// same worker, which most likely still has the workflow in its cache
+	workflowAffinity struct {
+		enabled func() bool
+		// workflow ID -> pollerIdentity
+		recent cache.Cache
+
+		sync.Mutex
+		// pollers which are currently waiting for a task, by identity
+		pollers map[pollerIdentity]*affinityPollers
+	}
+
+	// affinityPollers is the channel shared by the outstanding pollers of a worker
+	affinityPollers struct {
+		taskC chan *internalTask
+		count int
+	}
+)
+
+func newWorkflowAffinity(config *taskListConfig) *workflowAffinity {
+	return &workflowAffinity{
+		enabled: config.EnableWorkflowAffinity,
+		recent:  cache.New(config.WorkflowAffinityCacheSize(), &cache.Options{TTL: workflowAffinityTTL}),
+		pollers: make(map[pollerIdentity]*affinityPollers),
+	}
+}
+
+// register returns the channel a poller of the worker with the given identity receives preferred tasks
+// on, the returned func must be called once the poller stops waiting for a task
+func (a *workflowAffinity) register(identity pollerIdentity) (<-chan *internalTask, func()) {
+	a.Lock()
+	defer a.Unlock()
+
+	pollers, ok := a.pollers[identity]
+	if !ok {
+		pollers = &affinityPollers{taskC: make(chan *internalTask)}
+		a.pollers[identity] = pollers
+	}
+	pollers.count++
+
+	return pollers.taskC, func() {
+		a.Lock()
+		defer a.Unlock()
+		pollers.count--
+		if pollers.count == 0 {
+			delete(a.pollers, identity)
+		}
+	}
+}
+
+// record remembers the worker which the task was dispatched to
+func (a *workflowAffinity) record(task *internalTask, identity pollerIdentity) {
+	if workflowID := task.workflowExecution().GetWorkflowId(); workflowID != "" {
+		a.recent.Put(workflowID, identity)
+	}
+}
+
+// preferredTaskC returns the channel of the pollers of the worker which last processed the workflow
+// of the task, returns nil when the worker is unknown or none of its pollers are waiting
+func (a *workflowAffinity) preferredTaskC(task *internalTask) chan<- *internalTask {
+	workflowID := task.workflowExecution().GetWorkflowId()
+	if workflowID == "" || !a.enabled() {
+		return nil
+	}
+	identity, ok := a.recent.Get(workflowID).(pollerIdentity)
+	if !ok {
+		return nil
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if pollers, ok := a.pollers[identity]; ok {
+		return pollers.taskC
+	}
+	return nil
+}
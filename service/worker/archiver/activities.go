@@ -38,6 +38,8 @@ const (
 	uploadHistoryActivityFnName     = "uploadHistoryActivity"
 	deleteHistoryActivityFnName     = "deleteHistoryActivity"
 	archiveVisibilityActivityFnName = "archiveVisibilityActivity"
+
+	recordHistoryArchivalInfoActivityFnName = "recordHistoryArchivalInfoActivity"
 )
 
 var (
@@ -188,3 +190,19 @@ func archiveVisibilityActivity(ctx context.Context, request ArchiveRequest) (err
 	logger.Error(carchiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason("got retryable error from visibility archiver"), tag.Error(err))
 	return err
 }
+
+func recordHistoryArchivalInfoActivity(ctx context.Context, request ArchiveRequest, info shared.HistoryArchivalInfo) error {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	return container.VisibilityManager.UpdateHistoryArchivalInfo(&persistence.UpdateHistoryArchivalInfoRequest{
+		DomainUUID: request.DomainID,
+		Domain:     request.DomainName,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.WorkflowID),
+			RunId:      common.StringPtr(request.RunID),
+		},
+		StartTimestamp:      request.StartTimestamp,
+		CloseTimestamp:      request.CloseTimestamp,
+		RetentionSeconds:    request.VisibilityRetentionSeconds,
+		HistoryArchivalInfo: &info,
+	})
+}
@@ -68,6 +68,10 @@ type (
 		CloseFailoverVersion int64
 		URI                  string // should be historyURI, but keep the existing name for backward compatibility
 
+		// VisibilityRetentionSeconds is set if the visibility record outlives the history,
+		// in which case the outcome of history archival is recorded in it
+		VisibilityRetentionSeconds int64
+
 		// visibility archival
 		WorkflowTypeName   string
 		StartTimestamp     int64
@@ -52,14 +52,15 @@ type (
 
 	// BootstrapContainer contains everything need for bootstrapping
 	BootstrapContainer struct {
-		PublicClient     workflowserviceclient.Interface
-		MetricsClient    metrics.Client
-		Logger           log.Logger
-		HistoryManager   persistence.HistoryManager
-		HistoryV2Manager persistence.HistoryV2Manager
-		DomainCache      cache.DomainCache
-		Config           *Config
-		ArchiverProvider provider.ArchiverProvider
+		PublicClient      workflowserviceclient.Interface
+		MetricsClient     metrics.Client
+		Logger            log.Logger
+		HistoryManager    persistence.HistoryManager
+		HistoryV2Manager  persistence.HistoryV2Manager
+		VisibilityManager persistence.VisibilityManager
+		DomainCache       cache.DomainCache
+		Config            *Config
+		ArchiverProvider  provider.ArchiverProvider
 	}
 
 	// Config for ClientWorker
@@ -95,6 +96,7 @@ func init() {
 	activity.RegisterWithOptions(uploadHistoryActivity, activity.RegisterOptions{Name: uploadHistoryActivityFnName})
 	activity.RegisterWithOptions(deleteHistoryActivity, activity.RegisterOptions{Name: deleteHistoryActivityFnName})
 	activity.RegisterWithOptions(archiveVisibilityActivity, activity.RegisterOptions{Name: archiveVisibilityActivityFnName})
+	activity.RegisterWithOptions(recordHistoryArchivalInfoActivity, activity.RegisterOptions{Name: recordHistoryArchivalInfoActivityFnName})
 }
 
 // NewClientWorker returns a new ClientWorker
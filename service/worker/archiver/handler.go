@@ -23,6 +23,8 @@ package archiver
 import (
 	"time"
 
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -30,6 +32,12 @@ import (
 	"go.uber.org/cadence/workflow"
 )
 
+const (
+	// recordHistoryArchivalOutcomeChangeID is the version change of recording the outcome of history archival
+	// in the visibility record of the workflow, which is retained if visibility retention is longer than the domain's
+	recordHistoryArchivalOutcomeChangeID = "record-history-archival-outcome"
+)
+
 type (
 	// Handler is used to process archival requests
 	Handler interface {
@@ -145,9 +153,9 @@ func (h *handler) handleHistoryRequest(ctx workflow.Context, request *ArchiveReq
 	}
 	actCtx := workflow.WithActivityOptions(ctx, ao)
 	uploadSW := h.metricsClient.StartTimer(metrics.ArchiverScope, metrics.ArchiverUploadWithRetriesLatency)
-	err := workflow.ExecuteActivity(actCtx, uploadHistoryActivityFnName, *request).Get(actCtx, nil)
-	if err != nil {
-		logger.Error("failed to archive history, will move on to deleting history without archiving", tag.Error(err))
+	uploadErr := workflow.ExecuteActivity(actCtx, uploadHistoryActivityFnName, *request).Get(actCtx, nil)
+	if uploadErr != nil {
+		logger.Error("failed to archive history, will move on to deleting history without archiving", tag.Error(uploadErr))
 		h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverUploadFailedAllRetriesCount)
 	} else {
 		h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount)
@@ -163,9 +171,27 @@ func (h *handler) handleHistoryRequest(ctx workflow.Context, request *ArchiveReq
 			NonRetriableErrorReasons: deleteHistoryActivityNonRetryableErrors,
 		},
 	}
-	deleteSW := h.metricsClient.StartTimer(metrics.ArchiverScope, metrics.ArchiverDeleteWithRetriesLatency)
 	localActCtx := workflow.WithLocalActivityOptions(ctx, lao)
-	err = workflow.ExecuteLocalActivity(localActCtx, deleteHistoryActivity, *request).Get(localActCtx, nil)
+	if request.VisibilityRetentionSeconds > 0 &&
+		workflow.GetVersion(ctx, recordHistoryArchivalOutcomeChangeID, workflow.DefaultVersion, 1) != workflow.DefaultVersion {
+		info := shared.HistoryArchivalInfo{
+			URI: common.StringPtr(request.URI),
+		}
+		if uploadErr != nil {
+			info.State = shared.HistoryArchivalStateFailed.Ptr()
+			info.FailureReason = common.StringPtr(uploadErr.Error())
+		} else {
+			info.State = shared.HistoryArchivalStateArchived.Ptr()
+			info.ArchivedTime = common.Int64Ptr(workflow.Now(ctx).UnixNano())
+		}
+		err := workflow.ExecuteLocalActivity(localActCtx, recordHistoryArchivalInfoActivity, *request, info).Get(localActCtx, nil)
+		if err != nil {
+			logger.Error("failed to record history archival outcome in visibility", tag.Error(err))
+		}
+	}
+
+	deleteSW := h.metricsClient.StartTimer(metrics.ArchiverScope, metrics.ArchiverDeleteWithRetriesLatency)
+	err := workflow.ExecuteLocalActivity(localActCtx, deleteHistoryActivity, *request).Get(localActCtx, nil)
 	if err != nil {
 		logger.Error("deleting history failed, this means zombie histories are left", tag.Error(err))
 		h.metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverDeleteFailedAllRetriesCount)
@@ -29,6 +29,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	cshared "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
@@ -115,6 +116,71 @@ func (s *handlerSuite) TestHandleHistoryRequest_UploadSuccess() {
 	s.NoError(env.GetWorkflowError())
 }
 
+func (s *handlerSuite) TestHandleHistoryRequest_RecordArchivalOutcome() {
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount).Once()
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverDeleteSuccessCount).Once()
+
+	var recorded []cshared.HistoryArchivalInfo
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(recordHistoryArchivalInfoActivityFnName, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ ArchiveRequest, info cshared.HistoryArchivalInfo) error {
+			recorded = append(recorded, info)
+			return nil
+		})
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleHistoryRequestWorkflow, ArchiveRequest{URI: "test:///history/archival", VisibilityRetentionSeconds: 3600})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	s.Len(recorded, 1)
+	s.Equal(cshared.HistoryArchivalStateArchived, recorded[0].GetState())
+	s.Equal("test:///history/archival", recorded[0].GetURI())
+	s.NotZero(recorded[0].GetArchivedTime())
+}
+
+func (s *handlerSuite) TestHandleHistoryRequest_RecordArchivalOutcome_UploadFails() {
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadFailedAllRetriesCount).Once()
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverDeleteSuccessCount).Once()
+	handlerTestLogger.On("Error", mock.Anything, mock.Anything).Once()
+
+	var recorded []cshared.HistoryArchivalInfo
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(errors.New("some random error"))
+	env.OnActivity(recordHistoryArchivalInfoActivityFnName, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ ArchiveRequest, info cshared.HistoryArchivalInfo) error {
+			recorded = append(recorded, info)
+			return nil
+		})
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleHistoryRequestWorkflow, ArchiveRequest{URI: "test:///history/archival", VisibilityRetentionSeconds: 3600})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	s.Len(recorded, 1)
+	s.Equal(cshared.HistoryArchivalStateFailed, recorded[0].GetState())
+	s.NotEmpty(recorded[0].GetFailureReason())
+	s.Nil(recorded[0].ArchivedTime)
+}
+
+func (s *handlerSuite) TestHandleHistoryRequest_RecordArchivalOutcome_OldVersion() {
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount).Once()
+	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverDeleteSuccessCount).Once()
+
+	env := s.NewTestWorkflowEnvironment()
+	// replaying a history recorded before the outcome was recorded
+	env.OnGetVersion(recordHistoryArchivalOutcomeChangeID, workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnActivity(uploadHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(deleteHistoryActivityFnName, mock.Anything, mock.Anything).Return(nil)
+	env.ExecuteWorkflow(handleHistoryRequestWorkflow, ArchiveRequest{URI: "test:///history/archival", VisibilityRetentionSeconds: 3600})
+
+	env.AssertExpectations(s.T())
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+}
+
 func (s *handlerSuite) TestHandleHistoryRequest_DeleteFails_NonRetryableError() {
 	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverUploadSuccessCount).Once()
 	handlerTestMetrics.On("IncCounter", metrics.ArchiverScope, metrics.ArchiverDeleteFailedAllRetriesCount).Once()
@@ -29,6 +29,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -41,14 +42,8 @@ import (
 
 const (
 	batcherContextKey = "batcherContext"
-	// BatcherTaskListName is the tasklist name
-	BatcherTaskListName = "cadence-sys-batcher-tasklist"
-	// BatchWFTypeName is the workflow type
-	BatchWFTypeName   = "cadence-sys-batch-workflow"
 	batchActivityName = "cadence-sys-batch-activity"
-	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
-	InfiniteDuration = 20 * 365 * 24 * time.Hour
-	pageSize         = 1000
+	pageSize          = 1000
 
 	// DefaultRPS is the default RPS
 	DefaultRPS = 50
@@ -60,91 +55,12 @@ const (
 	DefaultActivityHeartBeatTimeout = time.Second * 10
 )
 
-const (
-	// BatchTypeTerminate is batch type for terminating workflows
-	BatchTypeTerminate = "terminate"
-	// BatchTypeCancel is the batch type for canceling workflows
-	BatchTypeCancel = "cancel"
-	// BatchTypeSignal is batch type for signaling workflows
-	BatchTypeSignal = "signal"
-)
-
-// AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
-
 type (
-	// TerminateParams is the parameters for terminating workflow
-	TerminateParams struct {
-		// this indicates whether to terminate children workflow. Default to true.
-		// TODO https://github.com/uber/cadence/issues/2159
-		// Ideally default should be childPolicy of the workflow. But it's currently totally broken.
-		TerminateChildren *bool
-	}
-
-	// CancelParams is the parameters for canceling workflow
-	CancelParams struct {
-		// this indicates whether to cancel children workflow. Default to true.
-		// TODO https://github.com/uber/cadence/issues/2159
-		// Ideally default should be childPolicy of the workflow. But it's currently totally broken.
-		CancelChildren *bool
-	}
-
-	// SignalParams is the parameters for signaling workflow
-	SignalParams struct {
-		SignalName string
-		Input      string
-	}
-
-	// BatchParams is the parameters for batch operation workflow
-	BatchParams struct {
-		// Target domain to execute batch operation
-		DomainName string
-		// To get the target workflows for processing
-		Query string
-		// Reason for the operation
-		Reason string
-		// Supporting: reset,terminate
-		BatchType string
-
-		// Below are all optional
-		// TerminateParams is params only for BatchTypeTerminate
-		TerminateParams TerminateParams
-		// CancelParams is params only for BatchTypeCancel
-		CancelParams CancelParams
-		// SignalParams is params only for BatchTypeSignal
-		SignalParams SignalParams
-		// RPS of processing. Default to DefaultRPS
-		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
-		RPS int
-		// Number of goroutines running in parallel to process
-		Concurrency int
-		// Number of attempts for each workflow to process in case of retryable error before giving up
-		AttemptsOnRetryableError int
-		// timeout for activity heartbeat
-		ActivityHeartBeatTimeout time.Duration
-		// errors that will not retry which consumes AttemptsOnRetryableError. Default to empty
-		NonRetryableErrors []string
-		// internal conversion for NonRetryableErrors
-		_nonRetryableErrors map[string]struct{}
-	}
-
-	// HeartBeatDetails is the struct for heartbeat details
-	HeartBeatDetails struct {
-		PageToken   []byte
-		CurrentPage int
-		// This is just an estimation for visibility
-		TotalEstimate int64
-		// Number of workflows processed successfully
-		SuccessCount int
-		// Number of workflows that give up due to errors.
-		ErrorCount int
-	}
-
 	taskDetail struct {
 		execution shared.WorkflowExecution
 		attempts  int
 		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
-		hbd HeartBeatDetails
+		hbd batch.HeartBeatDetails
 	}
 )
 
@@ -153,36 +69,36 @@ var (
 		InitialInterval:    10 * time.Second,
 		BackoffCoefficient: 1.7,
 		MaximumInterval:    5 * time.Minute,
-		ExpirationInterval: InfiniteDuration,
+		ExpirationInterval: batch.InfiniteDuration,
 	}
 
 	batchActivityOptions = workflow.ActivityOptions{
 		ScheduleToStartTimeout: 5 * time.Minute,
-		StartToCloseTimeout:    InfiniteDuration,
+		StartToCloseTimeout:    batch.InfiniteDuration,
 		RetryPolicy:            &batchActivityRetryPolicy,
 	}
 )
 
 func init() {
-	workflow.RegisterWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
+	workflow.RegisterWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: batch.BatchWFTypeName})
 	activity.RegisterWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityName})
 }
 
 // BatchWorkflow is the workflow that runs a batch job of resetting workflows
-func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDetails, error) {
+func BatchWorkflow(ctx workflow.Context, batchParams batch.BatchParams) (batch.HeartBeatDetails, error) {
 	batchParams = setDefaultParams(batchParams)
 	err := validateParams(batchParams)
 	if err != nil {
-		return HeartBeatDetails{}, err
+		return batch.HeartBeatDetails{}, err
 	}
 	batchActivityOptions.HeartbeatTimeout = batchParams.ActivityHeartBeatTimeout
 	opt := workflow.WithActivityOptions(ctx, batchActivityOptions)
-	var result HeartBeatDetails
+	var result batch.HeartBeatDetails
 	err = workflow.ExecuteActivity(opt, batchActivityName, batchParams).Get(ctx, &result)
 	return result, err
 }
 
-func validateParams(params BatchParams) error {
+func validateParams(params batch.BatchParams) error {
 	if params.BatchType == "" ||
 		params.Reason == "" ||
 		params.DomainName == "" ||
@@ -190,21 +106,21 @@ func validateParams(params BatchParams) error {
 		return fmt.Errorf("must provide required parameters: BatchType/Reason/DomainName/Query")
 	}
 	switch params.BatchType {
-	case BatchTypeSignal:
+	case batch.BatchTypeSignal:
 		if params.SignalParams.SignalName == "" {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel:
+	case batch.BatchTypeCancel:
 		fallthrough
-	case BatchTypeTerminate:
+	case batch.BatchTypeTerminate:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
 	}
 }
 
-func setDefaultParams(params BatchParams) BatchParams {
+func setDefaultParams(params batch.BatchParams) batch.BatchParams {
 	if params.RPS <= 0 {
 		params.RPS = DefaultRPS
 	}
@@ -217,12 +133,6 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.ActivityHeartBeatTimeout <= 0 {
 		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
 	}
-	if len(params.NonRetryableErrors) > 0 {
-		params._nonRetryableErrors = make(map[string]struct{}, len(params.NonRetryableErrors))
-		for _, estr := range params.NonRetryableErrors {
-			params._nonRetryableErrors[estr] = struct{}{}
-		}
-	}
 	if params.TerminateParams.TerminateChildren == nil {
 		params.TerminateParams.TerminateChildren = common.BoolPtr(true)
 	}
@@ -230,11 +140,11 @@ func setDefaultParams(params BatchParams) BatchParams {
 }
 
 // BatchActivity is activity for processing batch operation
-func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
+func BatchActivity(ctx context.Context, batchParams batch.BatchParams) (batch.HeartBeatDetails, error) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
 
-	hbd := HeartBeatDetails{}
+	hbd := batch.HeartBeatDetails{}
 	startOver := true
 	if activity.HasHeartbeatDetails(ctx) {
 		if err := activity.GetHeartbeatDetails(ctx, &hbd); err == nil {
@@ -252,7 +162,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 			Query:  common.StringPtr(batchParams.Query),
 		})
 		if err != nil {
-			return HeartBeatDetails{}, err
+			return batch.HeartBeatDetails{}, err
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
@@ -274,7 +184,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 			Query:         common.StringPtr(batchParams.Query),
 		})
 		if err != nil {
-			return HeartBeatDetails{}, err
+			return batch.HeartBeatDetails{}, err
 		}
 		batchCount := len(resp.Executions)
 		if batchCount <= 0 {
@@ -306,7 +216,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 					break Loop
 				}
 			case <-ctx.Done():
-				return HeartBeatDetails{}, ctx.Err()
+				return batch.HeartBeatDetails{}, ctx.Err()
 			}
 		}
 
@@ -326,13 +236,17 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 
 func startTaskProcessor(
 	ctx context.Context,
-	batchParams BatchParams,
+	batchParams batch.BatchParams,
 	taskCh chan taskDetail,
 	respCh chan error,
 	limiter *rate.Limiter,
 	client frontend.Client,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
+	nonRetryableErrors := make(map[string]struct{}, len(batchParams.NonRetryableErrors))
+	for _, estr := range batchParams.NonRetryableErrors {
+		nonRetryableErrors[estr] = struct{}{}
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -348,7 +262,7 @@ func startTaskProcessor(
 			}
 
 			switch batchParams.BatchType {
-			case BatchTypeTerminate:
+			case batch.BatchTypeTerminate:
 				err = processTask(ctx, limiter, task, batchParams, client,
 					batchParams.TerminateParams.TerminateChildren,
 					func(workflowID, runID string) error {
@@ -358,11 +272,13 @@ func startTaskProcessor(
 								WorkflowId: common.StringPtr(workflowID),
 								RunId:      common.StringPtr(runID),
 							},
-							Reason:   common.StringPtr(batchParams.Reason),
-							Identity: common.StringPtr(BatchWFTypeName),
+							Reason:        common.StringPtr(batchParams.Reason),
+							Identity:      common.StringPtr(batch.BatchWFTypeName),
+							Force:         common.BoolPtr(batchParams.TerminateParams.Force),
+							SecurityToken: getForceSecurityToken(batcher, batchParams.TerminateParams.Force),
 						}, yarpcCallOptions...)
 					})
-			case BatchTypeCancel:
+			case batch.BatchTypeCancel:
 				err = processTask(ctx, limiter, task, batchParams, client,
 					batchParams.CancelParams.CancelChildren,
 					func(workflowID, runID string) error {
@@ -372,11 +288,11 @@ func startTaskProcessor(
 								WorkflowId: common.StringPtr(workflowID),
 								RunId:      common.StringPtr(runID),
 							},
-							Identity:  common.StringPtr(BatchWFTypeName),
+							Identity:  common.StringPtr(batch.BatchWFTypeName),
 							RequestId: common.StringPtr(requestID),
 						}, yarpcCallOptions...)
 					})
-			case BatchTypeSignal:
+			case batch.BatchTypeSignal:
 				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
 					func(workflowID, runID string) error {
 						return client.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{
@@ -385,10 +301,10 @@ func startTaskProcessor(
 								WorkflowId: common.StringPtr(workflowID),
 								RunId:      common.StringPtr(runID),
 							},
-							Identity:   common.StringPtr(BatchWFTypeName),
+							Identity:   common.StringPtr(batch.BatchWFTypeName),
 							RequestId:  common.StringPtr(requestID),
 							SignalName: common.StringPtr(batchParams.SignalParams.SignalName),
-							Input:      []byte(batchParams.SignalParams.Input),
+							Input:      batchParams.SignalParams.Input,
 						}, yarpcCallOptions...)
 					})
 			}
@@ -396,7 +312,7 @@ func startTaskProcessor(
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
-				_, ok := batchParams._nonRetryableErrors[err.Error()]
+				_, ok := nonRetryableErrors[err.Error()]
 				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
 					respCh <- err
 				} else {
@@ -416,7 +332,7 @@ func processTask(
 	ctx context.Context,
 	limiter *rate.Limiter,
 	task taskDetail,
-	batchParams BatchParams,
+	batchParams batch.BatchParams,
 	client frontend.Client,
 	applyOnChild *bool,
 	procFn func(string, string) error,
@@ -490,3 +406,12 @@ func getActivityLogger(ctx context.Context) log.Logger {
 		tag.WorkflowDomainName(wfInfo.WorkflowDomain),
 	)
 }
+
+// getForceSecurityToken returns the security token permitting a forced termination. The token comes from
+// the worker config rather than the batch params, so that it is never written to the history of the batch.
+func getForceSecurityToken(batcher *Batcher, force bool) *string {
+	if !force {
+		return nil
+	}
+	return common.StringPtr(batcher.cfg.AdminOperationToken())
+}
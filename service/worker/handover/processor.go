@@ -0,0 +1,223 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handover
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	requestTimeout         = 10 * time.Second
+	listDomainsPageSize    = 100
+	checkJitterCoefficient = 0.2
+)
+
+type (
+	// Config contains the configuration for the handover processor
+	Config struct {
+		CheckInterval       dynamicconfig.DurationPropertyFn
+		AdminOperationToken dynamicconfig.StringPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the handover processor
+	BootstrapParams struct {
+		// Config contains the configuration for handover processor
+		Config Config
+		// ClusterMetadata is the metadata of the current cluster
+		ClusterMetadata cluster.Metadata
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+	}
+
+	// Processor is the background sub-system that finishes graceful domain failovers. It periodically
+	// checks the domains which are active in current cluster and are in handover state, and once
+	// the target cluster passes the failover readiness checks, fails the domain over to it.
+	Processor struct {
+		status             int32
+		config             Config
+		currentClusterName string
+		clientBean         client.Bean
+		metricsClient      metrics.Client
+		logger             log.Logger
+		done               chan struct{}
+	}
+)
+
+// New returns a new instance of handover processor
+func New(params *BootstrapParams) *Processor {
+	return &Processor{
+		status:             common.DaemonStatusInitialized,
+		config:             params.Config,
+		currentClusterName: params.ClusterMetadata.GetCurrentClusterName(),
+		clientBean:         params.ClientBean,
+		metricsClient:      params.MetricsClient,
+		logger:             params.Logger.WithTags(tag.ComponentHandover),
+		done:               make(chan struct{}),
+	}
+}
+
+// Start starts the handover processor
+func (p *Processor) Start() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go p.processorLoop()
+	p.logger.Info("handover processor started")
+}
+
+// Stop stops the handover processor
+func (p *Processor) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(p.done)
+	p.logger.Info("handover processor stopped")
+}
+
+func (p *Processor) processorLoop() {
+	timer := time.NewTimer(p.getWaitDuration())
+
+	for {
+		select {
+		case <-timer.C:
+			p.checkDomains()
+			timer.Reset(p.getWaitDuration())
+		case <-p.done:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+func (p *Processor) checkDomains() {
+	var handoverDomains []*shared.DescribeDomainResponse
+	var nextPageToken []byte
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		response, err := p.clientBean.GetFrontendClient().ListDomains(ctx, &shared.ListDomainsRequest{
+			PageSize:      common.Int32Ptr(listDomainsPageSize),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			p.metricsClient.IncCounter(metrics.HandoverProcessorScope, metrics.HandoverFailures)
+			p.logger.Error("Failed to list domains", tag.Error(err))
+			return
+		}
+
+		for _, domain := range response.Domains {
+			if p.isHandoverDomain(domain) {
+				handoverDomains = append(handoverDomains, domain)
+			}
+		}
+
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	p.metricsClient.UpdateGauge(metrics.HandoverProcessorScope, metrics.HandoverDomainsCount, float64(len(handoverDomains)))
+	for _, domain := range handoverDomains {
+		if err := p.handleDomain(domain); err != nil {
+			p.metricsClient.IncCounter(metrics.HandoverProcessorScope, metrics.HandoverFailures)
+			p.logger.Error("Failed to complete domain handover",
+				tag.WorkflowDomainName(domain.DomainInfo.GetName()),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+func (p *Processor) isHandoverDomain(domain *shared.DescribeDomainResponse) bool {
+	replicationConfig := domain.ReplicationConfiguration
+	return domain.GetIsGlobalDomain() &&
+		replicationConfig != nil &&
+		replicationConfig.GetFailoverState() == shared.DomainFailoverStateHandover &&
+		replicationConfig.GetActiveClusterName() == p.currentClusterName
+}
+
+func (p *Processor) handleDomain(domain *shared.DescribeDomainResponse) error {
+	domainName := domain.DomainInfo.GetName()
+	targetCluster := domain.ReplicationConfiguration.GetPendingActiveClusterName()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	readiness, err := p.clientBean.GetRemoteAdminClient(p.currentClusterName).CheckFailoverReadiness(
+		ctx,
+		&admin.CheckFailoverReadinessRequest{
+			Domain:        common.StringPtr(domainName),
+			TargetCluster: common.StringPtr(targetCluster),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if !readiness.GetReady() {
+		p.logger.Debug("Domain is not ready to finish handover",
+			tag.WorkflowDomainName(domainName),
+			tag.ClusterName(targetCluster),
+		)
+		return nil
+	}
+
+	// failing over to the pending active cluster moves the domain from handover to switched state
+	_, err = p.clientBean.GetFrontendClient().UpdateDomain(ctx, &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(targetCluster),
+		},
+		SecurityToken: common.StringPtr(p.config.AdminOperationToken()),
+	})
+	if err != nil {
+		return err
+	}
+
+	p.metricsClient.IncCounter(metrics.HandoverProcessorScope, metrics.HandoverCompletedCount)
+	p.logger.Info("Domain handover completed",
+		tag.WorkflowDomainName(domainName),
+		tag.ClusterName(targetCluster),
+	)
+	return nil
+}
+
+func (p *Processor) getWaitDuration() time.Duration {
+	return backoff.JitDuration(p.config.CheckInterval(), checkJitterCoefficient)
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handover
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	testCurrentCluster = "active"
+	testTargetCluster  = "standby"
+	testSecurityToken  = "token"
+)
+
+type processorSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	controller         *gomock.Controller
+	mockClientBean     *client.MockClientBean
+	mockFrontendClient *workflowservicetest.MockClient
+	mockAdminClient    *adminservicetest.MockClient
+	scope              tally.TestScope
+	processor          *Processor
+}
+
+func TestProcessorSuite(t *testing.T) {
+	suite.Run(t, new(processorSuite))
+}
+
+func (s *processorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockClientBean = &client.MockClientBean{}
+	s.mockFrontendClient = workflowservicetest.NewMockClient(s.controller)
+	s.mockAdminClient = adminservicetest.NewMockClient(s.controller)
+	s.mockClientBean.On("GetFrontendClient").Return(s.mockFrontendClient)
+	s.mockClientBean.On("GetRemoteAdminClient", testCurrentCluster).Return(s.mockAdminClient)
+
+	clusterMetadata := &mocks.ClusterMetadata{}
+	clusterMetadata.On("GetCurrentClusterName").Return(testCurrentCluster)
+	s.scope = tally.NewTestScope("test", nil)
+	s.processor = New(&BootstrapParams{
+		Config: Config{
+			CheckInterval:       dynamicconfig.GetDurationPropertyFn(time.Hour),
+			AdminOperationToken: dynamicconfig.GetStringPropertyFn(testSecurityToken),
+		},
+		ClusterMetadata: clusterMetadata,
+		ClientBean:      s.mockClientBean,
+		MetricsClient:   metrics.NewClient(s.scope, metrics.Worker),
+		Logger:          loggerimpl.NewNopLogger(),
+	})
+}
+
+func (s *processorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *processorSuite) TestStartStop() {
+	s.processor.Start()
+	s.processor.Start()
+	s.Equal(common.DaemonStatusStarted, s.processor.status)
+
+	s.processor.Stop()
+	s.processor.Stop()
+	s.Equal(common.DaemonStatusStopped, s.processor.status)
+}
+
+func (s *processorSuite) TestIsHandoverDomain() {
+	s.True(s.processor.isHandoverDomain(s.newDomain("handover", true, shared.DomainFailoverStateHandover, testCurrentCluster)))
+	s.False(s.processor.isHandoverDomain(s.newDomain("local", false, shared.DomainFailoverStateHandover, testCurrentCluster)))
+	s.False(s.processor.isHandoverDomain(s.newDomain("switched", true, shared.DomainFailoverStateSwitched, testCurrentCluster)))
+	s.False(s.processor.isHandoverDomain(s.newDomain("remote", true, shared.DomainFailoverStateHandover, testTargetCluster)))
+	s.False(s.processor.isHandoverDomain(&shared.DescribeDomainResponse{IsGlobalDomain: common.BoolPtr(true)}))
+}
+
+func (s *processorSuite) TestCheckDomains_Ready() {
+	nextPageToken := []byte("next")
+	s.mockFrontendClient.EXPECT().ListDomains(gomock.Any(), &shared.ListDomainsRequest{
+		PageSize: common.Int32Ptr(listDomainsPageSize),
+	}).Return(&shared.ListDomainsResponse{
+		Domains: []*shared.DescribeDomainResponse{
+			s.newDomain("local", false, shared.DomainFailoverStateHandover, testCurrentCluster),
+			s.newDomain("handover", true, shared.DomainFailoverStateHandover, testCurrentCluster),
+		},
+		NextPageToken: nextPageToken,
+	}, nil)
+	s.mockFrontendClient.EXPECT().ListDomains(gomock.Any(), &shared.ListDomainsRequest{
+		PageSize:      common.Int32Ptr(listDomainsPageSize),
+		NextPageToken: nextPageToken,
+	}).Return(&shared.ListDomainsResponse{
+		Domains: []*shared.DescribeDomainResponse{
+			s.newDomain("switched", true, shared.DomainFailoverStateSwitched, testCurrentCluster),
+			s.newDomain("remote", true, shared.DomainFailoverStateHandover, testTargetCluster),
+		},
+	}, nil)
+	s.expectReadiness("handover").Return(&admin.CheckFailoverReadinessResponse{Ready: common.BoolPtr(true)}, nil)
+	s.expectUpdateDomain("handover").Return(&shared.UpdateDomainResponse{}, nil)
+
+	s.processor.checkDomains()
+	s.Equal(float64(1), s.gaugeValue("handover_domains"))
+	s.Equal(int64(1), s.counterValue("handover_completed"))
+	s.Equal(int64(0), s.counterValue("handover_errors"))
+}
+
+func (s *processorSuite) TestCheckDomains_NotReady() {
+	s.expectListDomains(s.newDomain("handover", true, shared.DomainFailoverStateHandover, testCurrentCluster))
+	s.expectReadiness("handover").Return(&admin.CheckFailoverReadinessResponse{Ready: common.BoolPtr(false)}, nil)
+
+	s.processor.checkDomains()
+	s.Equal(float64(1), s.gaugeValue("handover_domains"))
+	s.Equal(int64(0), s.counterValue("handover_completed"))
+	s.Equal(int64(0), s.counterValue("handover_errors"))
+}
+
+func (s *processorSuite) TestCheckDomains_ListDomainsFailed() {
+	s.mockFrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(nil, &shared.InternalServiceError{})
+
+	s.processor.checkDomains()
+	s.Equal(int64(1), s.counterValue("handover_errors"))
+	s.Empty(s.scope.Snapshot().Gauges())
+}
+
+func (s *processorSuite) TestCheckDomains_ReadinessFailed() {
+	s.expectListDomains(
+		s.newDomain("failed", true, shared.DomainFailoverStateHandover, testCurrentCluster),
+		s.newDomain("handover", true, shared.DomainFailoverStateHandover, testCurrentCluster),
+	)
+	s.expectReadiness("failed").Return(nil, errors.New("readiness check failed"))
+	s.expectReadiness("handover").Return(&admin.CheckFailoverReadinessResponse{Ready: common.BoolPtr(true)}, nil)
+	s.expectUpdateDomain("handover").Return(&shared.UpdateDomainResponse{}, nil)
+
+	s.processor.checkDomains()
+	s.Equal(float64(2), s.gaugeValue("handover_domains"))
+	s.Equal(int64(1), s.counterValue("handover_completed"))
+	s.Equal(int64(1), s.counterValue("handover_errors"))
+}
+
+func (s *processorSuite) TestCheckDomains_UpdateDomainFailed() {
+	s.expectListDomains(s.newDomain("handover", true, shared.DomainFailoverStateHandover, testCurrentCluster))
+	s.expectReadiness("handover").Return(&admin.CheckFailoverReadinessResponse{Ready: common.BoolPtr(true)}, nil)
+	s.expectUpdateDomain("handover").Return(nil, &shared.BadRequestError{})
+
+	s.processor.checkDomains()
+	s.Equal(int64(0), s.counterValue("handover_completed"))
+	s.Equal(int64(1), s.counterValue("handover_errors"))
+}
+
+func (s *processorSuite) newDomain(
+	name string,
+	isGlobal bool,
+	failoverState shared.DomainFailoverState,
+	activeCluster string,
+) *shared.DescribeDomainResponse {
+	return &shared.DescribeDomainResponse{
+		DomainInfo:     &shared.DomainInfo{Name: common.StringPtr(name)},
+		IsGlobalDomain: common.BoolPtr(isGlobal),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName:        common.StringPtr(activeCluster),
+			PendingActiveClusterName: common.StringPtr(testTargetCluster),
+			FailoverState:            failoverState.Ptr(),
+		},
+	}
+}
+
+func (s *processorSuite) expectListDomains(domains ...*shared.DescribeDomainResponse) {
+	s.mockFrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(&shared.ListDomainsResponse{
+		Domains: domains,
+	}, nil)
+}
+
+func (s *processorSuite) expectReadiness(domainName string) *gomock.Call {
+	return s.mockAdminClient.EXPECT().CheckFailoverReadiness(gomock.Any(), &admin.CheckFailoverReadinessRequest{
+		Domain:        common.StringPtr(domainName),
+		TargetCluster: common.StringPtr(testTargetCluster),
+	})
+}
+
+func (s *processorSuite) expectUpdateDomain(domainName string) *gomock.Call {
+	return s.mockFrontendClient.EXPECT().UpdateDomain(gomock.Any(), &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(testTargetCluster),
+		},
+		SecurityToken: common.StringPtr(testSecurityToken),
+	})
+}
+
+func (s *processorSuite) counterValue(name string) int64 {
+	var value int64
+	for _, counter := range s.scope.Snapshot().Counters() {
+		if counter.Name() == "test."+name {
+			value += counter.Value()
+		}
+	}
+	return value
+}
+
+func (s *processorSuite) gaugeValue(name string) float64 {
+	for _, gauge := range s.scope.Snapshot().Gauges() {
+		if gauge.Name() == "test."+name {
+			return gauge.Value()
+		}
+	}
+	return 0
+}
@@ -225,6 +225,10 @@ func (p *esProcessorImpl) getKeyForKafkaMsg(request elastic.BulkableRequest) str
 			return ""
 		}
 
+		// the fields of update requests are under the partial document
+		if doc, ok := body["doc"].(map[string]interface{}); ok {
+			body = doc
+		}
 		k, ok := body[es.KafkaKey]
 		if !ok {
 			// must be bug in code and bad deployment, check processor that add es requests
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
@@ -39,9 +40,11 @@ type (
 		esClient            es.Client
 		logger              log.Logger
 		metricsClient       metrics.Client
+		meter               metering.Meter
 		dynamicCollection   *dynamicconfig.Collection
 		visibilityProcessor *indexProcessor
 		visibilityIndexName string
+		esConfig            *es.Config
 	}
 
 	// Config contains all configs for indexer
@@ -61,7 +64,7 @@ const (
 
 // NewIndexer create a new Indexer
 func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esConfig *es.Config,
-	logger log.Logger, metricsClient metrics.Client) *Indexer {
+	logger log.Logger, metricsClient metrics.Client, meter metering.Meter) *Indexer {
 	logger = logger.WithTags(tag.ComponentIndexer)
 
 	return &Indexer{
@@ -70,7 +73,9 @@ func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esC
 		esClient:            esClient,
 		logger:              logger,
 		metricsClient:       metricsClient,
+		meter:               meter,
 		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
+		esConfig:            esConfig,
 	}
 }
 
@@ -79,7 +84,7 @@ func (x Indexer) Start() error {
 	visibilityApp := common.VisibilityAppName
 	visConsumerName := getConsumerName(x.visibilityIndexName)
 	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
-		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
+		visibilityProcessorName, x.esConfig, x.config, x.logger, x.metricsClient, x.meter)
 	return x.visibilityProcessor.Start()
 }
 
@@ -21,6 +21,7 @@
 package indexer
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/olivere/elastic"
@@ -33,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metering"
 	"github.com/uber/cadence/common/metrics"
 	"sync"
 	"sync/atomic"
@@ -48,14 +50,20 @@ type indexProcessor struct {
 	esProcessor     ESProcessor
 	esProcessorName string
 	esIndexName     string
+	esConfig        *es.Config
 	config          *Config
 	logger          log.Logger
 	metricsClient   metrics.Client
+	meter           metering.Meter
 	isStarted       int32
 	isStopped       int32
 	shutdownWG      sync.WaitGroup
 	shutdownCh      chan struct{}
 	msgEncoder      codec.BinaryEncoder
+
+	sync.RWMutex
+	rolloverIndices map[string]struct{} // rolled over indices known to exist with the alias
+	legacyCutover   time.Time           // workflows started before are kept in the legacy index, zero if there is none
 }
 
 const (
@@ -63,26 +71,33 @@ const (
 	esDocType        = "_doc"
 
 	versionTypeExternal = "external"
+
+	rolloverRetentionInterval = time.Hour
 )
 
 var (
 	errUnknownMessageType = &shared.BadRequestError{Message: "unknown message type"}
+	errMissingStartTime   = &shared.BadRequestError{Message: "missing start time for rolled over index"}
 )
 
 func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
-	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
+	esProcessorName string, esConfig *es.Config, config *Config, logger log.Logger, metricsClient metrics.Client,
+	meter metering.Meter) *indexProcessor {
 	return &indexProcessor{
 		appName:         appName,
 		consumerName:    consumerName,
 		kafkaClient:     kafkaClient,
 		esClient:        esClient,
 		esProcessorName: esProcessorName,
-		esIndexName:     esIndexName,
+		esIndexName:     esConfig.GetVisibilityIndex(),
+		esConfig:        esConfig,
 		config:          config,
 		logger:          logger.WithTags(tag.ComponentIndexerProcessor),
 		metricsClient:   metricsClient,
+		meter:           meter,
 		shutdownCh:      make(chan struct{}),
 		msgEncoder:      codec.NewThriftRWEncoder(),
+		rolloverIndices: make(map[string]struct{}),
 	}
 }
 
@@ -92,6 +107,13 @@ func (p *indexProcessor) Start() error {
 	}
 
 	p.logger.Info("", tag.LifeCycleStarting)
+	if p.esConfig.IsIndexRolloverEnabled() {
+		if err := p.migrateToRolloverAlias(); err != nil {
+			p.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
+			return err
+		}
+	}
+
 	consumer, err := p.kafkaClient.NewConsumer(p.appName, p.consumerName, p.config.IndexerConcurrency())
 	if err != nil {
 		p.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
@@ -113,6 +135,10 @@ func (p *indexProcessor) Start() error {
 	p.esProcessor = esProcessor
 	p.shutdownWG.Add(1)
 	go p.processorPump()
+	if p.esConfig.IsIndexRolloverEnabled() {
+		p.shutdownWG.Add(1)
+		go p.rolloverRetentionPump()
+	}
 
 	p.logger.Info("", tag.LifeCycleStarted)
 	return nil
@@ -205,19 +231,53 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 	var req elastic.BulkableRequest
 	switch indexMsg.GetMessageType() {
 	case indexer.MessageTypeIndex:
+		index, err := p.getIndexForMessage(indexMsg)
+		if err != nil {
+			logger.Error("Failed to get index for message.", tag.Error(err))
+			p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+			return err
+		}
 		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
 		doc := p.generateESDoc(indexMsg, keyToKafkaMsg)
 		req = elastic.NewBulkIndexRequest().
-			Index(p.esIndexName).
+			Index(index).
 			Type(esDocType).
 			Id(docID).
 			VersionType(versionTypeExternal).
 			Version(indexMsg.GetVersion()).
 			Doc(doc)
+	case indexer.MessageTypeUpdate:
+		index, err := p.getIndexForMessage(indexMsg)
+		if err != nil {
+			logger.Error("Failed to get index for message.", tag.Error(err))
+			p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+			return err
+		}
+		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
+		// partial update of an existing document, which is not recreated once deleted
+		doc := p.generateESDoc(indexMsg, keyToKafkaMsg)
+		if attr, ok := doc[definition.Attr].(map[string]interface{}); ok && len(attr) == 0 {
+			delete(doc, definition.Attr)
+		}
+		req = elastic.NewBulkUpdateRequest().
+			Index(index).
+			Type(esDocType).
+			Id(docID).
+			Doc(doc)
 	case indexer.MessageTypeDelete:
+		index, err := p.getIndexForDeletion(indexMsg)
+		if err != nil {
+			logger.Error("Failed to get index for message.", tag.Error(err))
+			return err
+		}
+		if len(index) == 0 {
+			// the record is removed by dropping the whole index once it expires
+			kafkaMsg.Ack()
+			return nil
+		}
 		keyToKafkaMsg = docID
 		req = elastic.NewBulkDeleteRequest().
-			Index(p.esIndexName).
+			Index(index).
 			Type(esDocType).
 			Id(docID).
 			VersionType(versionTypeExternal).
@@ -229,9 +289,236 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 	}
 
 	p.esProcessor.Add(req, keyToKafkaMsg, kafkaMsg)
+	p.meter.Add(indexMsg.GetDomainID(), metering.CounterESDocuments, 1)
+	return nil
+}
+
+// getIndexForMessage returns the index the message is written to, creating rolled over indices on first use
+// so that they join the alias searched by the visibility store
+func (p *indexProcessor) getIndexForMessage(msg *indexer.Message) (string, error) {
+	if !p.esConfig.IsIndexRolloverEnabled() {
+		return p.esIndexName, nil
+	}
+	index, err := p.getRolloverIndex(msg)
+	if err != nil {
+		return "", err
+	}
+	if p.isRolloverIndexKnown(index) {
+		return index, nil
+	}
+
+	// creating the index and its mappings is idempotent, so workers racing on a new index only repeat the calls
+	ctx := context.Background()
+	if err := p.esClient.CreateIndexWithAlias(ctx, index, p.esIndexName); err != nil {
+		return "", err
+	}
+	if err := p.putSearchAttributeMappings(ctx, index); err != nil {
+		return "", err
+	}
+	p.addRolloverIndex(index)
+	return index, nil
+}
+
+// getIndexForDeletion returns the index holding the record to delete, or an empty string if the record
+// can only be removed by dropping its index, that is when its start time is unknown or the index is gone
+func (p *indexProcessor) getIndexForDeletion(msg *indexer.Message) (string, error) {
+	if !p.esConfig.IsIndexRolloverEnabled() {
+		return p.esIndexName, nil
+	}
+	index, err := p.getRolloverIndex(msg)
+	if err == errMissingStartTime {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if p.isRolloverIndexKnown(index) {
+		return index, nil
+	}
+
+	// deleting from a missing index would create it outside of the alias
+	exists, err := p.esClient.IndexExists(context.Background(), index)
+	if err != nil || !exists {
+		return "", err
+	}
+	p.addRolloverIndex(index)
+	return index, nil
+}
+
+// getRolloverIndex returns the rolled over index of the workflow start time, or the legacy index
+// for workflows started before rollover was enabled
+func (p *indexProcessor) getRolloverIndex(msg *indexer.Message) (string, error) {
+	field, ok := msg.Fields[es.StartTime]
+	if !ok || field.IntData == nil {
+		return "", errMissingStartTime
+	}
+	startTime := time.Unix(0, field.GetIntData())
+
+	p.RLock()
+	cutover := p.legacyCutover
+	p.RUnlock()
+	if startTime.Before(cutover) {
+		return p.esConfig.GetLegacyVisibilityIndex(), nil
+	}
+	return p.esConfig.GetVisibilityIndexForStartTime(startTime), nil
+}
+
+func (p *indexProcessor) isRolloverIndexKnown(index string) bool {
+	p.RLock()
+	defer p.RUnlock()
+	_, ok := p.rolloverIndices[index]
+	return ok
+}
+
+func (p *indexProcessor) addRolloverIndex(index string) {
+	p.Lock()
+	defer p.Unlock()
+	p.rolloverIndices[index] = struct{}{}
+}
+
+// migrateToRolloverAlias moves the records of a visibility index created before rollover was enabled
+// to the legacy index, and replaces the index with the alias over the legacy and rolled over indices.
+// Every step is idempotent, so a migration interrupted by a failure or run by several hosts at once
+// completes on the next start. Records written to the old index by hosts without rollover during the
+// migration are lost, so rollover should be enabled on all indexer hosts at once.
+func (p *indexProcessor) migrateToRolloverAlias() error {
+	ctx := context.Background()
+	legacyIndex := p.esConfig.GetLegacyVisibilityIndex()
+
+	indices, err := p.esClient.GetAliasIndices(ctx, p.esIndexName)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		exists, err := p.esClient.IndexExists(ctx, p.esIndexName)
+		if err != nil {
+			return err
+		}
+		legacyExists, err := p.esClient.IndexExists(ctx, legacyIndex)
+		if err != nil {
+			return err
+		}
+		if exists {
+			p.logger.Info("Migrating visibility index to rolled over indices.", tag.ESIndex(p.esIndexName))
+			if !legacyExists {
+				if err := p.esClient.CreateIndex(ctx, legacyIndex); err != nil {
+					return err
+				}
+				legacyExists = true
+			}
+			if err := p.putSearchAttributeMappings(ctx, legacyIndex); err != nil {
+				return err
+			}
+			if err := p.esClient.Reindex(ctx, p.esIndexName, legacyIndex); err != nil {
+				return err
+			}
+			if err := p.esClient.DeleteIndex(ctx, p.esIndexName); err != nil && !elastic.IsNotFound(err) {
+				return err
+			}
+		}
+		if !legacyExists {
+			// nothing to migrate, the alias is created with the first rolled over index
+			return nil
+		}
+		if err := p.esClient.CreateIndexWithAlias(ctx, legacyIndex, p.esIndexName); err != nil {
+			return err
+		}
+		p.logger.Info("Migrated visibility index to rolled over indices.", tag.ESIndex(legacyIndex))
+		indices = []string{legacyIndex}
+	}
+
+	for _, index := range indices {
+		if index == legacyIndex {
+			// the legacy index is created right before the records are moved
+			cutover, err := p.esClient.GetIndexCreationTime(ctx, legacyIndex)
+			if err != nil {
+				return err
+			}
+			p.Lock()
+			p.legacyCutover = cutover
+			p.Unlock()
+		}
+		p.addRolloverIndex(index)
+	}
+	return nil
+}
+
+// putSearchAttributeMappings adds the custom search attributes, which are not part of the index template,
+// to the mapping of a new rolled over index
+func (p *indexProcessor) putSearchAttributeMappings(ctx context.Context, index string) error {
+	for key, valueType := range p.config.ValidSearchAttributes() {
+		if definition.IsSystemIndexedKey(key) {
+			continue
+		}
+		esType := es.ConvertIndexedValueTypeToESDataType(common.ConvertIndexedValueTypeToThriftType(valueType, p.logger))
+		if len(esType) == 0 {
+			continue
+		}
+		if err := p.esClient.PutMapping(ctx, index, definition.Attr, key, esType); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// rolloverRetentionPump periodically drops rolled over indices past their retention, this replaces
+// deleting visibility records one by one
+func (p *indexProcessor) rolloverRetentionPump() {
+	defer p.shutdownWG.Done()
+
+	ticker := time.NewTicker(rolloverRetentionInterval)
+	defer ticker.Stop()
+	for {
+		p.deleteExpiredRolloverIndices()
+		select {
+		case <-ticker.C:
+		case <-p.shutdownCh:
+			return
+		}
+	}
+}
+
+func (p *indexProcessor) deleteExpiredRolloverIndices() {
+	ctx := context.Background()
+	indices, err := p.esClient.GetAliasIndices(ctx, p.esIndexName)
+	if err != nil {
+		p.logger.Warn("Failed to list rolled over indices.", tag.Error(err))
+		return
+	}
+
+	now := time.Now()
+	for _, index := range indices {
+		if !p.esConfig.IsRolloverIndexExpired(index, now) && !p.isLegacyIndexExpired(index, now) {
+			continue
+		}
+		if err := p.esClient.DeleteIndex(ctx, index); err != nil && !elastic.IsNotFound(err) {
+			p.logger.Warn("Failed to delete expired rolled over index.", tag.ESIndex(index), tag.Error(err))
+			continue
+		}
+		p.logger.Info("Deleted expired rolled over index.", tag.ESIndex(index))
+		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorRolloverIndexDeleted)
+
+		p.Lock()
+		delete(p.rolloverIndices, index)
+		if index == p.esConfig.GetLegacyVisibilityIndex() {
+			p.legacyCutover = time.Time{}
+		}
+		p.Unlock()
+	}
+}
+
+// isLegacyIndexExpired returns whether the legacy index is past IndexRolloverRetention counted from the cutover,
+// by then all workflows it holds have been closed for longer than their retention
+func (p *indexProcessor) isLegacyIndexExpired(index string, now time.Time) bool {
+	if index != p.esConfig.GetLegacyVisibilityIndex() || p.esConfig.IndexRolloverRetention <= 0 {
+		return false
+	}
+	p.RLock()
+	cutover := p.legacyCutover
+	p.RUnlock()
+	return !cutover.IsZero() && now.Sub(cutover) > p.esConfig.IndexRolloverRetention
+}
+
 func (p *indexProcessor) generateESDoc(msg *indexer.Message, keyToKafkaMsg string) map[string]interface{} {
 	doc := p.dumpFieldsToMap(msg.Fields)
 	fulfillDoc(doc, msg, keyToKafkaMsg)
@@ -266,7 +553,7 @@ func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[s
 		case indexer.FieldTypeBool:
 			doc[k] = v.GetBoolData()
 		case indexer.FieldTypeBinary:
-			if k == definition.Memo {
+			if k == definition.Memo || k == definition.HistoryArchivalInfo {
 				doc[k] = v.GetBinaryData()
 			} else { // custom search attributes
 				attr[k] = p.decodeSearchAttrBinary(v.GetBinaryData(), k)
@@ -284,7 +571,9 @@ func (p *indexProcessor) isValidFieldToES(field string) bool {
 	if _, ok := p.config.ValidSearchAttributes()[field]; ok {
 		return true
 	}
-	if field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding {
+	switch field {
+	case definition.Memo, definition.KafkaKey, definition.Encoding,
+		definition.HistoryArchivalInfo, definition.HistoryArchivalEncoding:
 		return true
 	}
 	return false
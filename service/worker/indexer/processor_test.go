@@ -0,0 +1,220 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	es "github.com/uber/cadence/common/elasticsearch"
+	esMocks "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metering"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+func newRolloverIndexProcessorForTest(esClient es.Client) *indexProcessor {
+	esConfig := &es.Config{
+		Indices:                map[string]string{common.VisibilityAppName: testIndex},
+		IndexRollover:          es.IndexRolloverDaily,
+		IndexRolloverRetention: 24 * time.Hour,
+	}
+	return newIndexProcessor(common.VisibilityAppName, "test-consumer", nil, esClient, "test-processor",
+		esConfig, &Config{ValidSearchAttributes: dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+			es.StartTime:         float64(shared.IndexedValueTypeInt),
+			"CustomKeywordField": float64(shared.IndexedValueTypeKeyword),
+		})}, loggerimpl.NewNopLogger(), metrics.NewClient(tally.NoopScope, metrics.Worker), metering.NewNoopMeter())
+}
+
+func Test_GetIndexForMessage_Rollover(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+
+	startTime := time.Date(2019, 8, 21, 10, 0, 0, 0, time.UTC).UnixNano()
+	msg := &indexer.Message{
+		Fields: map[string]*indexer.Field{
+			es.StartTime: {Type: &es.FieldTypeInt, IntData: common.Int64Ptr(startTime)},
+		},
+	}
+
+	// the rolled over index is created with the alias only once
+	mockESClient.On("CreateIndexWithAlias", mock.Anything, testIndex+"-2019.08.21", testIndex).Return(nil).Once()
+	mockESClient.On("PutMapping", mock.Anything, testIndex+"-2019.08.21", definition.Attr, "CustomKeywordField", "keyword").Return(nil).Once()
+	index, err := p.getIndexForMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, testIndex+"-2019.08.21", index)
+	index, err = p.getIndexForMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, testIndex+"-2019.08.21", index)
+	mockESClient.AssertExpectations(t)
+
+	_, err = p.getIndexForMessage(&indexer.Message{})
+	require.Equal(t, errMissingStartTime, err)
+}
+
+func Test_DeleteExpiredRolloverIndices(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+
+	expired := testIndex + "-" + time.Now().AddDate(0, 0, -3).UTC().Format("2006.01.02")
+	current := testIndex + "-" + time.Now().UTC().Format("2006.01.02")
+	mockESClient.On("GetAliasIndices", mock.Anything, testIndex).Return([]string{expired, current, testIndex + "-legacy"}, nil).Once()
+	mockESClient.On("DeleteIndex", mock.Anything, expired).Return(nil).Once()
+
+	p.deleteExpiredRolloverIndices()
+	mockESClient.AssertExpectations(t)
+}
+
+func Test_GetIndexForMessage_LegacyCutover(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+	p.legacyCutover = time.Date(2019, 8, 21, 0, 0, 0, 0, time.UTC)
+	p.rolloverIndices[testIndex+"-legacy"] = struct{}{}
+	p.rolloverIndices[testIndex+"-2019.08.21"] = struct{}{}
+
+	newMessage := func(startTime time.Time) *indexer.Message {
+		return &indexer.Message{
+			Fields: map[string]*indexer.Field{
+				es.StartTime: {Type: &es.FieldTypeInt, IntData: common.Int64Ptr(startTime.UnixNano())},
+			},
+		}
+	}
+	index, err := p.getIndexForMessage(newMessage(p.legacyCutover.Add(-time.Hour)))
+	require.NoError(t, err)
+	require.Equal(t, testIndex+"-legacy", index)
+	index, err = p.getIndexForMessage(newMessage(p.legacyCutover.Add(time.Hour)))
+	require.NoError(t, err)
+	require.Equal(t, testIndex+"-2019.08.21", index)
+	mockESClient.AssertExpectations(t)
+}
+
+func Test_GetIndexForDeletion(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+	p.rolloverIndices[testIndex+"-2019.08.21"] = struct{}{}
+
+	newMessage := func(startTime time.Time) *indexer.Message {
+		return &indexer.Message{
+			Fields: map[string]*indexer.Field{
+				es.StartTime: {Type: &es.FieldTypeInt, IntData: common.Int64Ptr(startTime.UnixNano())},
+			},
+		}
+	}
+	index, err := p.getIndexForDeletion(newMessage(time.Date(2019, 8, 21, 10, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	require.Equal(t, testIndex+"-2019.08.21", index)
+
+	// an existing index created by another host is looked up once
+	mockESClient.On("IndexExists", mock.Anything, testIndex+"-2019.08.22").Return(true, nil).Once()
+	index, err = p.getIndexForDeletion(newMessage(time.Date(2019, 8, 22, 10, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	require.Equal(t, testIndex+"-2019.08.22", index)
+	index, err = p.getIndexForDeletion(newMessage(time.Date(2019, 8, 22, 11, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	require.Equal(t, testIndex+"-2019.08.22", index)
+
+	// the index is already dropped
+	mockESClient.On("IndexExists", mock.Anything, testIndex+"-2019.08.01").Return(false, nil).Once()
+	index, err = p.getIndexForDeletion(newMessage(time.Date(2019, 8, 1, 10, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	require.Equal(t, "", index)
+
+	// the start time is unknown
+	index, err = p.getIndexForDeletion(&indexer.Message{})
+	require.NoError(t, err)
+	require.Equal(t, "", index)
+	mockESClient.AssertExpectations(t)
+
+	p.esConfig.IndexRollover = ""
+	index, err = p.getIndexForDeletion(&indexer.Message{})
+	require.NoError(t, err)
+	require.Equal(t, testIndex, index)
+}
+
+func Test_MigrateToRolloverAlias(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+
+	cutover := time.Date(2019, 8, 21, 10, 0, 0, 0, time.UTC)
+	legacyIndex := testIndex + "-legacy"
+	mockESClient.On("GetAliasIndices", mock.Anything, testIndex).Return(nil, nil).Once()
+	mockESClient.On("IndexExists", mock.Anything, testIndex).Return(true, nil).Once()
+	mockESClient.On("IndexExists", mock.Anything, legacyIndex).Return(false, nil).Once()
+	mockESClient.On("CreateIndex", mock.Anything, legacyIndex).Return(nil).Once()
+	mockESClient.On("PutMapping", mock.Anything, legacyIndex, definition.Attr, "CustomKeywordField", "keyword").Return(nil).Once()
+	mockESClient.On("Reindex", mock.Anything, testIndex, legacyIndex).Return(nil).Once()
+	mockESClient.On("DeleteIndex", mock.Anything, testIndex).Return(nil).Once()
+	mockESClient.On("CreateIndexWithAlias", mock.Anything, legacyIndex, testIndex).Return(nil).Once()
+	mockESClient.On("GetIndexCreationTime", mock.Anything, legacyIndex).Return(cutover, nil).Once()
+
+	require.NoError(t, p.migrateToRolloverAlias())
+	require.Equal(t, cutover, p.legacyCutover)
+	require.True(t, p.isRolloverIndexKnown(legacyIndex))
+	mockESClient.AssertExpectations(t)
+}
+
+func Test_MigrateToRolloverAlias_AlreadyMigrated(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+
+	cutover := time.Date(2019, 8, 21, 10, 0, 0, 0, time.UTC)
+	mockESClient.On("GetAliasIndices", mock.Anything, testIndex).Return([]string{testIndex + "-2019.08.21", testIndex + "-legacy"}, nil).Once()
+	mockESClient.On("GetIndexCreationTime", mock.Anything, testIndex+"-legacy").Return(cutover, nil).Once()
+
+	require.NoError(t, p.migrateToRolloverAlias())
+	require.Equal(t, cutover, p.legacyCutover)
+	require.True(t, p.isRolloverIndexKnown(testIndex+"-2019.08.21"))
+	mockESClient.AssertExpectations(t)
+}
+
+func Test_MigrateToRolloverAlias_NoIndex(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+
+	mockESClient.On("GetAliasIndices", mock.Anything, testIndex).Return(nil, nil).Once()
+	mockESClient.On("IndexExists", mock.Anything, testIndex).Return(false, nil).Once()
+	mockESClient.On("IndexExists", mock.Anything, testIndex+"-legacy").Return(false, nil).Once()
+
+	require.NoError(t, p.migrateToRolloverAlias())
+	require.True(t, p.legacyCutover.IsZero())
+	mockESClient.AssertExpectations(t)
+}
+
+func Test_DeleteExpiredRolloverIndices_Legacy(t *testing.T) {
+	mockESClient := &esMocks.Client{}
+	p := newRolloverIndexProcessorForTest(mockESClient)
+	p.legacyCutover = time.Now().AddDate(0, 0, -2)
+
+	mockESClient.On("GetAliasIndices", mock.Anything, testIndex).Return([]string{testIndex + "-legacy"}, nil).Once()
+	mockESClient.On("DeleteIndex", mock.Anything, testIndex+"-legacy").Return(nil).Once()
+
+	p.deleteExpiredRolloverIndices()
+	require.True(t, p.legacyCutover.IsZero())
+	mockESClient.AssertExpectations(t)
+}
@@ -0,0 +1,356 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/service/worker/parity"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+)
+
+const (
+	migratorContextKey = "domainMigratorContext"
+
+	promoteActivityName     = "cadence-sys-migration-promote-activity"
+	replicateActivityName   = "cadence-sys-migration-replicate-activity"
+	waitForReplicationName  = "cadence-sys-migration-wait-replication-activity"
+	failoverActivityName    = "cadence-sys-migration-failover-activity"
+	demoteActivityName      = "cadence-sys-migration-demote-activity"
+	parityMismatchErrReason = "cadence-sys-migration-parity-mismatch"
+
+	pageSize                 = 100
+	infiniteDuration         = 20 * 365 * 24 * time.Hour
+	activityHeartBeatTimeout = 30 * time.Second
+	// domainPropagationDelay is how long history hosts take to pick up the promoted domain from their domain cache,
+	// executions started until then are created without replication state and are replicated by the migration
+	domainPropagationDelay = time.Minute
+)
+
+var (
+	migrationActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: infiniteDuration,
+	}
+
+	migrationActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    5 * time.Minute,
+		RetryPolicy:            &migrationActivityRetryPolicy,
+	}
+
+	replicateActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       activityHeartBeatTimeout,
+		RetryPolicy:            &migrationActivityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: domain.MigrationWFTypeName})
+	activity.RegisterWithOptions(PromoteActivity, activity.RegisterOptions{Name: promoteActivityName})
+	activity.RegisterWithOptions(ReplicateActivity, activity.RegisterOptions{Name: replicateActivityName})
+	activity.RegisterWithOptions(WaitForReplicationActivity, activity.RegisterOptions{Name: waitForReplicationName})
+	activity.RegisterWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
+	activity.RegisterWithOptions(DemoteActivity, activity.RegisterOptions{Name: demoteActivityName})
+}
+
+// MigrationWorkflow moves a local domain from the source cluster to the target cluster:
+// 1. the domain is promoted to a global domain replicated to both clusters
+// 2. the executions which are open in the source cluster are replicated to the target cluster
+// 3. once replication caught up and parity of the open executions is verified, the domain fails over
+// 4. the domain is converted back into a local domain of the target cluster
+// Closed executions are not replicated, they stay readable in the source cluster until their retention expires.
+func MigrationWorkflow(ctx workflow.Context, params domain.MigrationParams) error {
+	if err := validateParams(params); err != nil {
+		return err
+	}
+	opt := workflow.WithActivityOptions(ctx, migrationActivityOptions)
+	if err := workflow.ExecuteActivity(opt, promoteActivityName, params).Get(ctx, nil); err != nil {
+		return err
+	}
+	if err := workflow.Sleep(ctx, domainPropagationDelay); err != nil {
+		return err
+	}
+
+	replicateOpt := workflow.WithActivityOptions(ctx, replicateActivityOptions)
+	if err := workflow.ExecuteActivity(replicateOpt, replicateActivityName, params).Get(ctx, nil); err != nil {
+		return err
+	}
+	if err := workflow.ExecuteActivity(opt, waitForReplicationName, params).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	childOpt := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID:                   workflow.GetInfo(ctx).WorkflowExecution.ID + "-parity",
+		TaskList:                     parity.ParityTaskListName,
+		ExecutionStartToCloseTimeout: infiniteDuration,
+	})
+	var report parity.Report
+	if err := workflow.ExecuteChildWorkflow(childOpt, parity.ParityWFTypeName, parity.ParityParams{
+		DomainName:    params.DomainName,
+		TargetCluster: params.TargetCluster,
+		StartTime:     time.Unix(0, 0),
+		EndTime:       workflow.Now(ctx),
+		OpenOnly:      true,
+	}).Get(ctx, &report); err != nil {
+		return err
+	}
+	if report.MissingCount+report.CloseStatusMismatchCount+report.HistoryMismatchCount+report.ExtraCount > 0 {
+		// the domain is left active in the source cluster, the migration can be started again
+		return cadence.NewCustomError(parityMismatchErrReason, report)
+	}
+
+	if err := workflow.ExecuteActivity(opt, failoverActivityName, params).Get(ctx, nil); err != nil {
+		return err
+	}
+	return workflow.ExecuteActivity(opt, demoteActivityName, params).Get(ctx, nil)
+}
+
+func validateParams(params domain.MigrationParams) error {
+	if params.DomainName == "" || params.SourceCluster == "" || params.TargetCluster == "" {
+		return fmt.Errorf("must provide required parameters: DomainName/SourceCluster/TargetCluster")
+	}
+	if params.SourceCluster == params.TargetCluster {
+		return fmt.Errorf("SourceCluster and TargetCluster must be different")
+	}
+	return nil
+}
+
+// PromoteActivity promotes the domain to a global domain replicated to the source and target clusters,
+// and waits for the domain to be replicated to the target cluster
+func PromoteActivity(ctx context.Context, params domain.MigrationParams) error {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	client := migrator.clientBean.GetFrontendClient()
+
+	resp, err := client.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(params.DomainName),
+	})
+	if err != nil {
+		return migrator.activityError(ctx, err)
+	}
+	// the domain is already promoted by a previous attempt
+	if !resp.GetIsGlobalDomain() {
+		if _, err := client.UpdateDomain(ctx, &shared.UpdateDomainRequest{
+			Name: common.StringPtr(params.DomainName),
+			ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+				Clusters: []*shared.ClusterReplicationConfiguration{
+					{ClusterName: common.StringPtr(params.SourceCluster)},
+					{ClusterName: common.StringPtr(params.TargetCluster)},
+				},
+			},
+			PromoteToGlobalDomain: common.BoolPtr(true),
+			SecurityToken:         common.StringPtr(migrator.cfg.AdminOperationToken()),
+		}); err != nil {
+			return migrator.activityError(ctx, err)
+		}
+	}
+
+	if _, err := migrator.clientBean.GetRemoteFrontendClient(params.TargetCluster).DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(params.DomainName),
+	}); err != nil {
+		return migrator.activityError(ctx, err)
+	}
+	return nil
+}
+
+// ReplicateActivity replicates the executions which are open in the source cluster to the target cluster
+func ReplicateActivity(ctx context.Context, params domain.MigrationParams) error {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	client := migrator.clientBean.GetFrontendClient()
+	historyClient := migrator.clientBean.GetHistoryClient()
+
+	resp, err := client.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(params.DomainName),
+	})
+	if err != nil {
+		return migrator.activityError(ctx, err)
+	}
+	domainID := resp.DomainInfo.GetUUID()
+
+	var pageToken []byte
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &pageToken); err != nil {
+			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			pageToken = nil
+		}
+	}
+
+	for {
+		listResp, err := client.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(params.DomainName),
+			MaximumPageSize: common.Int32Ptr(pageSize),
+			NextPageToken:   pageToken,
+			StartTimeFilter: &shared.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+			},
+		})
+		if err != nil {
+			return migrator.activityError(ctx, err)
+		}
+
+		for _, info := range listResp.Executions {
+			err := historyClient.ReplicateWorkflowExecution(ctx, &h.ReplicateWorkflowExecutionRequest{
+				DomainUUID: common.StringPtr(domainID),
+				Execution:  info.Execution,
+			})
+			if err != nil {
+				if _, ok := err.(*shared.EntityNotExistsError); ok {
+					// closed after it was listed, closed executions are not replicated
+					continue
+				}
+				return migrator.activityError(ctx, err)
+			}
+			migrator.metricsClient.IncCounter(metrics.DomainMigratorScope, metrics.DomainMigrationReplicatedCount)
+		}
+
+		pageToken = listResp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+		activity.RecordHeartbeat(ctx, pageToken)
+	}
+}
+
+// WaitForReplicationActivity returns once the domain is ready to fail over to the target cluster,
+// i.e. the replication tasks sent so far are applied by the target cluster
+func WaitForReplicationActivity(ctx context.Context, params domain.MigrationParams) error {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	client := migrator.clientBean.GetRemoteAdminClient(params.SourceCluster)
+
+	resp, err := client.CheckFailoverReadiness(ctx, &admin.CheckFailoverReadinessRequest{
+		Domain:        common.StringPtr(params.DomainName),
+		TargetCluster: common.StringPtr(params.TargetCluster),
+	})
+	if err != nil {
+		return migrator.activityError(ctx, err)
+	}
+	if resp.GetReady() {
+		return nil
+	}
+
+	var failed []string
+	for _, check := range resp.Checks {
+		if check.GetStatus() == admin.FailoverReadinessCheckStatusFailed {
+			failed = append(failed, fmt.Sprintf("%v: %v", check.GetName(), check.GetDetails()))
+		}
+	}
+	// retried by the activity retry policy until replication caught up
+	return fmt.Errorf("domain is not ready to fail over: %v", strings.Join(failed, "; "))
+}
+
+// FailoverActivity gracefully fails the domain over to the target cluster and returns once the target cluster is active
+func FailoverActivity(ctx context.Context, params domain.MigrationParams) error {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	client := migrator.clientBean.GetFrontendClient()
+
+	resp, err := client.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(params.DomainName),
+	})
+	if err != nil {
+		return migrator.activityError(ctx, err)
+	}
+	replicationConfig := resp.ReplicationConfiguration
+	if replicationConfig.GetActiveClusterName() == params.TargetCluster {
+		return nil
+	}
+
+	// a previous attempt already started the graceful failover
+	if replicationConfig.GetFailoverState() != shared.DomainFailoverStateHandover ||
+		replicationConfig.GetPendingActiveClusterName() != params.TargetCluster {
+		if _, err := client.UpdateDomain(ctx, &shared.UpdateDomainRequest{
+			Name: common.StringPtr(params.DomainName),
+			ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+				ActiveClusterName: common.StringPtr(params.TargetCluster),
+			},
+			GracefulFailover: common.BoolPtr(true),
+			SecurityToken:    common.StringPtr(migrator.cfg.AdminOperationToken()),
+		}); err != nil {
+			return migrator.activityError(ctx, err)
+		}
+	}
+	// retried by the activity retry policy until the handover finished
+	return fmt.Errorf("domain is handing over to cluster %v", params.TargetCluster)
+}
+
+// DemoteActivity converts the domain into a local domain of the target cluster
+func DemoteActivity(ctx context.Context, params domain.MigrationParams) error {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	client := migrator.clientBean.GetRemoteFrontendClient(params.TargetCluster)
+
+	resp, err := client.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(params.DomainName),
+	})
+	if err != nil {
+		return migrator.activityError(ctx, err)
+	}
+	// the domain is already demoted by a previous attempt
+	if !resp.GetIsGlobalDomain() {
+		return nil
+	}
+	if resp.ReplicationConfiguration.GetActiveClusterName() != params.TargetCluster {
+		// retried by the activity retry policy until the failover is replicated to the target cluster
+		return fmt.Errorf("domain is not active in cluster %v yet", params.TargetCluster)
+	}
+
+	if _, err := client.UpdateDomain(ctx, &shared.UpdateDomainRequest{
+		Name:                common.StringPtr(params.DomainName),
+		DemoteToLocalDomain: common.BoolPtr(true),
+		SecurityToken:       common.StringPtr(migrator.cfg.AdminOperationToken()),
+	}); err != nil {
+		return migrator.activityError(ctx, err)
+	}
+	getActivityLogger(ctx).Info("Domain migrated.", tag.ClusterName(params.TargetCluster))
+	return nil
+}
+
+func (m *Migrator) activityError(ctx context.Context, err error) error {
+	m.metricsClient.IncCounter(metrics.DomainMigratorScope, metrics.DomainMigrationFailures)
+	getActivityLogger(ctx).Warn("Domain migration step failed.", tag.Error(err))
+	return err
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	wfInfo := activity.GetInfo(ctx)
+	return migrator.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowDomainName(wfInfo.WorkflowDomain),
+	)
+}
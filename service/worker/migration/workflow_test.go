@@ -0,0 +1,203 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/parity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+type migrationWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller         *gomock.Controller
+	mockClientBean     *client.MockClientBean
+	mockFrontendClient *workflowservicetest.MockClient
+	mockRemoteClient   *workflowservicetest.MockClient
+	mockHistoryClient  *historyservicetest.MockClient
+	params             domain.MigrationParams
+}
+
+func TestMigrationWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(migrationWorkflowTestSuite))
+}
+
+func (s *migrationWorkflowTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockClientBean = &client.MockClientBean{}
+	s.mockFrontendClient = workflowservicetest.NewMockClient(s.controller)
+	s.mockRemoteClient = workflowservicetest.NewMockClient(s.controller)
+	s.mockHistoryClient = historyservicetest.NewMockClient(s.controller)
+	s.mockClientBean.On("GetFrontendClient").Return(s.mockFrontendClient)
+	s.mockClientBean.On("GetRemoteFrontendClient", "standby").Return(s.mockRemoteClient)
+	s.mockClientBean.On("GetHistoryClient").Return(s.mockHistoryClient)
+	s.params = domain.MigrationParams{
+		DomainName:    "domain",
+		SourceCluster: "active",
+		TargetCluster: "standby",
+	}
+}
+
+func (s *migrationWorkflowTestSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *migrationWorkflowTestSuite) TestValidateParams() {
+	s.NoError(validateParams(s.params))
+	s.Error(validateParams(domain.MigrationParams{DomainName: "domain", TargetCluster: "standby"}))
+	s.Error(validateParams(domain.MigrationParams{DomainName: "domain", SourceCluster: "active", TargetCluster: "active"}))
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	var steps []string
+	for _, name := range []string{promoteActivityName, replicateActivityName, waitForReplicationName, failoverActivityName, demoteActivityName} {
+		step := name
+		env.OnActivity(step, mock.Anything, s.params).Return(func(ctx context.Context, params domain.MigrationParams) error {
+			steps = append(steps, step)
+			return nil
+		})
+	}
+	env.OnWorkflow(parity.ParityWFTypeName, mock.Anything, mock.Anything).Return(parity.Report{ExecutionCount: 2}, nil)
+
+	env.ExecuteWorkflow(domain.MigrationWFTypeName, s.params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	s.Equal([]string{promoteActivityName, replicateActivityName, waitForReplicationName, failoverActivityName, demoteActivityName}, steps)
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_ParityMismatch() {
+	env := s.NewTestWorkflowEnvironment()
+	var steps []string
+	for _, name := range []string{promoteActivityName, replicateActivityName, waitForReplicationName, failoverActivityName, demoteActivityName} {
+		step := name
+		env.OnActivity(step, mock.Anything, s.params).Return(func(ctx context.Context, params domain.MigrationParams) error {
+			steps = append(steps, step)
+			return nil
+		})
+	}
+	env.OnWorkflow(parity.ParityWFTypeName, mock.Anything, mock.Anything).Return(parity.Report{ExecutionCount: 2, MissingCount: 1}, nil)
+
+	env.ExecuteWorkflow(domain.MigrationWFTypeName, s.params)
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+	// the domain must not fail over when the target cluster misses executions
+	s.Equal([]string{promoteActivityName, replicateActivityName, waitForReplicationName}, steps)
+}
+
+func (s *migrationWorkflowTestSuite) TestReplicateActivity() {
+	s.mockFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&shared.DescribeDomainResponse{
+		DomainInfo: &shared.DomainInfo{UUID: common.StringPtr("domainID")},
+	}, nil)
+	s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&shared.ListOpenWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			{Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid1"), RunId: common.StringPtr("rid1")}},
+			{Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid2"), RunId: common.StringPtr("rid2")}},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().ReplicateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	// closed after it was listed
+	s.mockHistoryClient.EXPECT().ReplicateWorkflowExecution(gomock.Any(), gomock.Any()).Return(&shared.EntityNotExistsError{})
+
+	env := s.newTestActivityEnvironment()
+	_, err := env.ExecuteActivity(replicateActivityName, s.params)
+	s.NoError(err)
+}
+
+func (s *migrationWorkflowTestSuite) TestFailoverActivity_StartsGracefulFailover() {
+	s.mockFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&shared.DescribeDomainResponse{
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr("active"),
+			FailoverState:     shared.DomainFailoverStateActive.Ptr(),
+		},
+	}, nil)
+	s.mockFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *shared.UpdateDomainRequest, _ ...interface{}) (*shared.UpdateDomainResponse, error) {
+			s.True(request.GetGracefulFailover())
+			s.Equal("standby", request.ReplicationConfiguration.GetActiveClusterName())
+			s.Equal("token", request.GetSecurityToken())
+			return &shared.UpdateDomainResponse{}, nil
+		})
+
+	env := s.newTestActivityEnvironment()
+	// the activity is retried until the handover finished
+	_, err := env.ExecuteActivity(failoverActivityName, s.params)
+	s.Error(err)
+}
+
+func (s *migrationWorkflowTestSuite) TestDemoteActivity() {
+	s.mockRemoteClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&shared.DescribeDomainResponse{
+		IsGlobalDomain: common.BoolPtr(true),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr("standby"),
+		},
+	}, nil)
+	s.mockRemoteClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *shared.UpdateDomainRequest, _ ...interface{}) (*shared.UpdateDomainResponse, error) {
+			s.True(request.GetDemoteToLocalDomain())
+			return &shared.UpdateDomainResponse{}, nil
+		})
+
+	env := s.newTestActivityEnvironment()
+	_, err := env.ExecuteActivity(demoteActivityName, s.params)
+	s.NoError(err)
+
+	// demoted by a previous attempt
+	s.mockRemoteClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&shared.DescribeDomainResponse{
+		IsGlobalDomain: common.BoolPtr(false),
+	}, nil)
+	_, err = env.ExecuteActivity(demoteActivityName, s.params)
+	s.NoError(err)
+}
+
+func (s *migrationWorkflowTestSuite) newTestActivityEnvironment() *testsuite.TestActivityEnvironment {
+	migrator := New(&BootstrapParams{
+		Config:        Config{AdminOperationToken: dynamicconfig.GetStringPropertyFn("token")},
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		Logger:        loggerimpl.NewLogger(zap.NewNop()),
+		TallyScope:    tally.NoopScope,
+		ClientBean:    s.mockClientBean,
+	})
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), migratorContextKey, migrator),
+	})
+	return env
+}
@@ -119,6 +119,8 @@ func ProcessorActivity(ctx context.Context, request Request) error {
 					},
 					Reason:   common.StringPtr("by parent close policy"),
 					Identity: common.StringPtr(processorWFTypeName),
+					// the parent close policy is chosen when the child is started, it overrides the termination protection
+					Force: common.BoolPtr(true),
 				},
 			})
 		case shared.ParentClosePolicyRequestCancel:
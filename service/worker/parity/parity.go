@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parity
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/worker"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the parity verifier sub-system
+	BootstrapParams struct {
+		// ClusterMetadata is the metadata of the current cluster
+		ClusterMetadata cluster.Metadata
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+	}
+
+	// Verifier is the background sub-system that executes replication parity verification workflows.
+	// It is also the context object that gets passed around within the parity activities
+	Verifier struct {
+		currentClusterName string
+		svcClient          workflowserviceclient.Interface
+		clientBean         client.Bean
+		metricsClient      metrics.Client
+		tallyScope         tally.Scope
+		logger             log.Logger
+		serializer         persistence.PayloadSerializer
+	}
+)
+
+// New returns a new instance of the parity Verifier
+func New(params *BootstrapParams) *Verifier {
+	return &Verifier{
+		currentClusterName: params.ClusterMetadata.GetCurrentClusterName(),
+		svcClient:          params.ServiceClient,
+		clientBean:         params.ClientBean,
+		metricsClient:      params.MetricsClient,
+		tallyScope:         params.TallyScope,
+		logger:             params.Logger.WithTags(tag.ComponentParityVerifier),
+		serializer:         persistence.NewPayloadSerializer(),
+	}
+}
+
+// Start starts the worker for parity verification workflows
+func (v *Verifier) Start() error {
+	ctx := context.WithValue(context.Background(), verifierContextKey, v)
+	workerOpts := worker.Options{
+		MetricsScope:              v.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	parityWorker := worker.New(v.svcClient, common.SystemLocalDomainName, ParityTaskListName, workerOpts)
+	return parityWorker.Start()
+}
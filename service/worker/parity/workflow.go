@@ -0,0 +1,467 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/shared"
+	a "github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+)
+
+const (
+	verifierContextKey = "parityVerifierContext"
+	// ParityTaskListName is the tasklist name
+	ParityTaskListName = "cadence-sys-parity-tasklist"
+	// ParityWFTypeName is the workflow type
+	ParityWFTypeName   = "cadence-sys-parity-workflow"
+	parityActivityName = "cadence-sys-parity-activity"
+
+	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout
+	DefaultActivityHeartBeatTimeout = time.Second * 30
+	// DefaultMaxReportedMismatches is the default value for MaxReportedMismatches
+	DefaultMaxReportedMismatches = 100
+
+	pageSize         = 100
+	historyPageSize  = 1000
+	infiniteDuration = 20 * 365 * 24 * time.Hour
+
+	// executions of the current cluster are compared against the target cluster, then executions
+	// of the target cluster are checked to exist in the current cluster
+	listPhaseOpen         = 0
+	listPhaseClosed       = 1
+	listPhaseTargetOpen   = 2
+	listPhaseTargetClosed = 3
+	listPhaseCompleted    = 4
+)
+
+const (
+	// MismatchTypeMissing indicates the execution does not exist in the target cluster
+	MismatchTypeMissing = "missing"
+	// MismatchTypeCloseStatus indicates the execution has a different close status in the target cluster
+	MismatchTypeCloseStatus = "close_status"
+	// MismatchTypeHistory indicates the execution has a different history in the target cluster
+	MismatchTypeHistory = "history"
+	// MismatchTypeExtra indicates the execution exists in the target cluster only
+	MismatchTypeExtra = "extra"
+)
+
+type (
+	// ParityParams is the parameters for the replication parity verification workflow
+	ParityParams struct {
+		// DomainName is the global domain to verify
+		DomainName string
+		// TargetCluster is the cluster to compare the current cluster against
+		TargetCluster string
+		// StartTime and EndTime bound the start time of the executions to verify
+		StartTime time.Time
+		EndTime   time.Time
+
+		// Below are all optional
+		// MaxReportedMismatches caps the number of mismatches kept in the report. Default to DefaultMaxReportedMismatches
+		MaxReportedMismatches int
+		// ActivityHeartBeatTimeout is the timeout for activity heartbeat
+		ActivityHeartBeatTimeout time.Duration
+		// OpenOnly limits the verification to the executions which are open in either cluster
+		OpenOnly bool
+	}
+
+	// Mismatch describes a single execution which differs between the clusters
+	Mismatch struct {
+		WorkflowID string
+		RunID      string
+		Type       string
+		Details    string
+	}
+
+	// Report is the result of the replication parity verification, it is also used as heartbeat details
+	Report struct {
+		// Number of executions of the current cluster verified
+		ExecutionCount int
+		// Number of executions of the target cluster checked to exist in the current cluster
+		TargetExecutionCount int
+		// Number of executions missing in the target cluster
+		MissingCount int
+		// Number of executions with a different close status in the target cluster
+		CloseStatusMismatchCount int
+		// Number of executions with a different history in the target cluster
+		HistoryMismatchCount int
+		// Number of executions which exist in the target cluster only
+		ExtraCount int
+		// Mismatches found, capped at MaxReportedMismatches
+		Mismatches []Mismatch
+
+		// progress of the verification
+		Phase     int
+		PageToken []byte
+	}
+)
+
+var (
+	parityActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: infiniteDuration,
+	}
+
+	parityActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		RetryPolicy:            &parityActivityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(ParityWorkflow, workflow.RegisterOptions{Name: ParityWFTypeName})
+	activity.RegisterWithOptions(ParityActivity, activity.RegisterOptions{Name: parityActivityName})
+}
+
+// ParityWorkflow is the workflow that verifies the replication parity of a domain
+// between the current cluster and a target cluster
+func ParityWorkflow(ctx workflow.Context, params ParityParams) (Report, error) {
+	params = setDefaultParams(params)
+	if err := validateParams(params); err != nil {
+		return Report{}, err
+	}
+	options := parityActivityOptions
+	options.HeartbeatTimeout = params.ActivityHeartBeatTimeout
+	opt := workflow.WithActivityOptions(ctx, options)
+	var report Report
+	err := workflow.ExecuteActivity(opt, parityActivityName, params).Get(ctx, &report)
+	return report, err
+}
+
+func validateParams(params ParityParams) error {
+	if params.DomainName == "" || params.TargetCluster == "" {
+		return fmt.Errorf("must provide required parameters: DomainName/TargetCluster")
+	}
+	if params.EndTime.Before(params.StartTime) {
+		return fmt.Errorf("EndTime must not be before StartTime")
+	}
+	return nil
+}
+
+func setDefaultParams(params ParityParams) ParityParams {
+	if params.MaxReportedMismatches <= 0 {
+		params.MaxReportedMismatches = DefaultMaxReportedMismatches
+	}
+	if params.ActivityHeartBeatTimeout <= 0 {
+		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
+	}
+	if params.EndTime.IsZero() {
+		params.EndTime = time.Now()
+	}
+	return params
+}
+
+// ParityActivity is the activity for verifying the replication parity of a domain
+func ParityActivity(ctx context.Context, params ParityParams) (Report, error) {
+	verifier := ctx.Value(verifierContextKey).(*Verifier)
+
+	report := Report{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &report); err != nil {
+			verifier.metricsClient.IncCounter(metrics.ParityVerifierScope, metrics.ParityVerifierFailures)
+			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			report = Report{}
+		}
+	}
+
+	startTimeFilter := &shared.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(params.StartTime.UnixNano()),
+		LatestTime:   common.Int64Ptr(params.EndTime.UnixNano()),
+	}
+	for report.Phase != listPhaseCompleted {
+		client := verifier.clientBean.GetFrontendClient()
+		if report.Phase == listPhaseTargetOpen || report.Phase == listPhaseTargetClosed {
+			client = verifier.clientBean.GetRemoteFrontendClient(params.TargetCluster)
+		}
+
+		var executions []*shared.WorkflowExecutionInfo
+		var nextPageToken []byte
+		if report.Phase == listPhaseOpen || report.Phase == listPhaseTargetOpen {
+			resp, err := client.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+				Domain:          common.StringPtr(params.DomainName),
+				MaximumPageSize: common.Int32Ptr(pageSize),
+				NextPageToken:   report.PageToken,
+				StartTimeFilter: startTimeFilter,
+			})
+			if err != nil {
+				return Report{}, err
+			}
+			executions, nextPageToken = resp.Executions, resp.NextPageToken
+		} else {
+			resp, err := client.ListClosedWorkflowExecutions(ctx, &shared.ListClosedWorkflowExecutionsRequest{
+				Domain:          common.StringPtr(params.DomainName),
+				MaximumPageSize: common.Int32Ptr(pageSize),
+				NextPageToken:   report.PageToken,
+				StartTimeFilter: startTimeFilter,
+			})
+			if err != nil {
+				return Report{}, err
+			}
+			executions, nextPageToken = resp.Executions, resp.NextPageToken
+		}
+
+		for _, info := range executions {
+			var mismatch *Mismatch
+			var err error
+			if report.Phase == listPhaseOpen || report.Phase == listPhaseClosed {
+				mismatch, err = verifier.verifyExecution(ctx, params, info)
+			} else {
+				mismatch, err = verifier.verifyTargetExecution(ctx, params, info)
+			}
+			if err != nil {
+				verifier.metricsClient.IncCounter(metrics.ParityVerifierScope, metrics.ParityVerifierFailures)
+				return Report{}, err
+			}
+			verifier.metricsClient.IncCounter(metrics.ParityVerifierScope, metrics.ParityVerifiedCount)
+			if mismatch != nil {
+				verifier.metricsClient.IncCounter(metrics.ParityVerifierScope, metrics.ParityMismatchCount)
+			}
+			report.add(report.Phase, mismatch, params.MaxReportedMismatches)
+		}
+
+		report.PageToken = nextPageToken
+		if len(nextPageToken) == 0 {
+			report.Phase = nextPhase(report.Phase, params.OpenOnly)
+		}
+		activity.RecordHeartbeat(ctx, report)
+	}
+
+	return report, nil
+}
+
+func nextPhase(phase int, openOnly bool) int {
+	switch phase {
+	case listPhaseOpen:
+		if openOnly {
+			return listPhaseTargetOpen
+		}
+		return listPhaseClosed
+	case listPhaseClosed:
+		return listPhaseTargetOpen
+	case listPhaseTargetOpen:
+		if openOnly {
+			return listPhaseCompleted
+		}
+		return listPhaseTargetClosed
+	default:
+		return listPhaseCompleted
+	}
+}
+
+// verifyTargetExecution checks that an execution listed in the target cluster exists in the current
+// cluster, executions which exist in both were compared when the current cluster was listed
+func (v *Verifier) verifyTargetExecution(
+	ctx context.Context,
+	params ParityParams,
+	info *shared.WorkflowExecutionInfo,
+) (*Mismatch, error) {
+	execution := info.Execution
+	_, err := v.clientBean.GetFrontendClient().DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(params.DomainName),
+		Execution: execution,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return &Mismatch{
+				WorkflowID: execution.GetWorkflowId(),
+				RunID:      execution.GetRunId(),
+				Type:       MismatchTypeExtra,
+			}, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (v *Verifier) verifyExecution(
+	ctx context.Context,
+	params ParityParams,
+	info *shared.WorkflowExecutionInfo,
+) (*Mismatch, error) {
+	execution := info.Execution
+	mismatch := &Mismatch{
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+	}
+
+	remoteClient := v.clientBean.GetRemoteFrontendClient(params.TargetCluster)
+	resp, err := remoteClient.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(params.DomainName),
+		Execution: execution,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			mismatch.Type = MismatchTypeMissing
+			return mismatch, nil
+		}
+		return nil, err
+	}
+
+	remoteInfo := resp.WorkflowExecutionInfo
+	if info.CloseStatus == nil && remoteInfo.CloseStatus == nil {
+		// history of a running workflow is still growing, so it cannot be compared
+		return nil, nil
+	}
+	if info.CloseStatus == nil || remoteInfo.CloseStatus == nil || info.GetCloseStatus() != remoteInfo.GetCloseStatus() {
+		mismatch.Type = MismatchTypeCloseStatus
+		mismatch.Details = fmt.Sprintf("current: %v, target: %v", formatCloseStatus(info.CloseStatus), formatCloseStatus(remoteInfo.CloseStatus))
+		return mismatch, nil
+	}
+
+	localChecksum, err := v.historyChecksum(ctx, v.clientBean.GetRemoteAdminClient(v.currentClusterName), params.DomainName, execution)
+	if err != nil {
+		return nil, err
+	}
+	remoteChecksum, err := v.historyChecksum(ctx, v.clientBean.GetRemoteAdminClient(params.TargetCluster), params.DomainName, execution)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			mismatch.Type = MismatchTypeMissing
+			return mismatch, nil
+		}
+		return nil, err
+	}
+	if localChecksum != remoteChecksum {
+		mismatch.Type = MismatchTypeHistory
+		mismatch.Details = fmt.Sprintf("current checksum: %v, target checksum: %v", localChecksum, remoteChecksum)
+		return mismatch, nil
+	}
+	return nil, nil
+}
+
+func (v *Verifier) historyChecksum(
+	ctx context.Context,
+	client a.Client,
+	domainName string,
+	execution *shared.WorkflowExecution,
+) (uint32, error) {
+	checksum := uint32(0)
+	var token []byte
+	for {
+		resp, err := client.GetWorkflowExecutionRawHistory(ctx, &admin.GetWorkflowExecutionRawHistoryRequest{
+			Domain:          common.StringPtr(domainName),
+			Execution:       execution,
+			FirstEventId:    common.Int64Ptr(common.FirstEventID),
+			NextEventId:     common.Int64Ptr(common.EndEventID),
+			MaximumPageSize: common.Int32Ptr(historyPageSize),
+			NextPageToken:   token,
+		})
+		if err != nil {
+			return 0, err
+		}
+		checksum, err = updateChecksum(checksum, v.serializer, resp.HistoryBatches)
+		if err != nil {
+			return 0, err
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return checksum, nil
+		}
+		activity.RecordHeartbeat(ctx)
+	}
+}
+
+// updateChecksum hashes the decoded events of the batches, so that the checksum does not depend on
+// how the history is batched in either cluster. Events written before a domain was
+// promoted to a global domain carry the empty version in the current cluster and the promoted version
+// in the target cluster, so the version is left out of the checksum
+func updateChecksum(
+	checksum uint32,
+	serializer persistence.PayloadSerializer,
+	batches []*shared.DataBlob,
+) (uint32, error) {
+	for _, batch := range batches {
+		if batch.GetEncodingType() != shared.EncodingTypeThriftRW {
+			return 0, fmt.Errorf("unknown history encoding type: %v", batch.GetEncodingType())
+		}
+		events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlob(batch.Data, common.EncodingTypeThriftRW))
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range events {
+			event.Version = nil
+			// JSON encodes maps in key order, unlike thrift
+			data, err := json.Marshal(event)
+			if err != nil {
+				return 0, err
+			}
+			checksum = crc32.Update(checksum, crc32.IEEETable, data)
+		}
+	}
+	return checksum, nil
+}
+
+func (r *Report) add(phase int, mismatch *Mismatch, maxReportedMismatches int) {
+	if phase == listPhaseTargetOpen || phase == listPhaseTargetClosed {
+		r.TargetExecutionCount++
+	} else {
+		r.ExecutionCount++
+	}
+	if mismatch == nil {
+		return
+	}
+	switch mismatch.Type {
+	case MismatchTypeMissing:
+		r.MissingCount++
+	case MismatchTypeCloseStatus:
+		r.CloseStatusMismatchCount++
+	case MismatchTypeHistory:
+		r.HistoryMismatchCount++
+	case MismatchTypeExtra:
+		r.ExtraCount++
+	}
+	if len(r.Mismatches) < maxReportedMismatches {
+		r.Mismatches = append(r.Mismatches, *mismatch)
+	}
+}
+
+func formatCloseStatus(status *shared.WorkflowExecutionCloseStatus) string {
+	if status == nil {
+		return "Running"
+	}
+	return status.String()
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	verifier := ctx.Value(verifierContextKey).(*Verifier)
+	wfInfo := activity.GetInfo(ctx)
+	return verifier.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowDomainName(wfInfo.WorkflowDomain),
+	)
+}
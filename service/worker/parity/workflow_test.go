@@ -0,0 +1,328 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+)
+
+func TestValidateParams(t *testing.T) {
+	now := time.Now()
+	assert.Error(t, validateParams(setDefaultParams(ParityParams{TargetCluster: "standby"})))
+	assert.Error(t, validateParams(setDefaultParams(ParityParams{DomainName: "domain"})))
+	assert.Error(t, validateParams(setDefaultParams(ParityParams{
+		DomainName:    "domain",
+		TargetCluster: "standby",
+		StartTime:     now,
+		EndTime:       now.Add(-time.Hour),
+	})))
+
+	params := setDefaultParams(ParityParams{DomainName: "domain", TargetCluster: "standby"})
+	assert.NoError(t, validateParams(params))
+	assert.Equal(t, DefaultMaxReportedMismatches, params.MaxReportedMismatches)
+	assert.Equal(t, DefaultActivityHeartBeatTimeout, params.ActivityHeartBeatTimeout)
+	assert.False(t, params.EndTime.IsZero())
+}
+
+func TestReportAdd(t *testing.T) {
+	report := Report{}
+	report.add(listPhaseOpen, nil, 3)
+	report.add(listPhaseOpen, &Mismatch{WorkflowID: "wid1", Type: MismatchTypeMissing}, 3)
+	report.add(listPhaseClosed, &Mismatch{WorkflowID: "wid2", Type: MismatchTypeCloseStatus}, 3)
+	report.add(listPhaseClosed, &Mismatch{WorkflowID: "wid3", Type: MismatchTypeHistory}, 3)
+	report.add(listPhaseTargetOpen, nil, 3)
+	report.add(listPhaseTargetClosed, &Mismatch{WorkflowID: "wid4", Type: MismatchTypeExtra}, 3)
+
+	assert.Equal(t, 4, report.ExecutionCount)
+	assert.Equal(t, 2, report.TargetExecutionCount)
+	assert.Equal(t, 1, report.MissingCount)
+	assert.Equal(t, 1, report.CloseStatusMismatchCount)
+	assert.Equal(t, 1, report.HistoryMismatchCount)
+	assert.Equal(t, 1, report.ExtraCount)
+	assert.Len(t, report.Mismatches, 3)
+	assert.Equal(t, "wid1", report.Mismatches[0].WorkflowID)
+	assert.Equal(t, "wid3", report.Mismatches[2].WorkflowID)
+}
+
+func TestNextPhase(t *testing.T) {
+	var phases []int
+	for phase := listPhaseOpen; phase != listPhaseCompleted; phase = nextPhase(phase, false) {
+		phases = append(phases, phase)
+	}
+	assert.Equal(t, []int{listPhaseOpen, listPhaseClosed, listPhaseTargetOpen, listPhaseTargetClosed}, phases)
+
+	phases = nil
+	for phase := listPhaseOpen; phase != listPhaseCompleted; phase = nextPhase(phase, true) {
+		phases = append(phases, phase)
+	}
+	assert.Equal(t, []int{listPhaseOpen, listPhaseTargetOpen}, phases)
+}
+
+func TestUpdateChecksum(t *testing.T) {
+	serializer := persistence.NewPayloadSerializer()
+	events := testHistory(common.EmptyVersion)
+	checksum, err := updateChecksum(0, serializer, testBatches(t, events))
+	assert.NoError(t, err)
+
+	// checksum must not depend on how the history is batched and paged
+	paged, err := updateChecksum(0, serializer, testBatches(t, events[:1]))
+	assert.NoError(t, err)
+	paged, err = updateChecksum(paged, serializer, testBatches(t, events[1:2], events[2:]))
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, paged)
+
+	// nor on the version the events were relabelled with when the domain was promoted
+	promoted, err := updateChecksum(0, serializer, testBatches(t, testHistory(10)))
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, promoted)
+
+	other := testHistory(common.EmptyVersion)
+	other[2].WorkflowExecutionCompletedEventAttributes.Result = []byte("other")
+	otherChecksum, err := updateChecksum(0, serializer, testBatches(t, other))
+	assert.NoError(t, err)
+	assert.NotEqual(t, checksum, otherChecksum)
+}
+
+type parityActivityTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller        *gomock.Controller
+	mockClientBean    *client.MockClientBean
+	mockLocalClient   *workflowservicetest.MockClient
+	mockRemoteClient  *workflowservicetest.MockClient
+	mockLocalAdmin    *adminservicetest.MockClient
+	mockRemoteAdmin   *adminservicetest.MockClient
+	params            ParityParams
+	closedStatus      *shared.WorkflowExecutionCloseStatus
+	completedHistory  []*shared.DataBlob
+	relabeledHistory  []*shared.DataBlob
+	differentHistory  []*shared.DataBlob
+	notExistsResponse error
+}
+
+func TestParityActivityTestSuite(t *testing.T) {
+	suite.Run(t, new(parityActivityTestSuite))
+}
+
+func (s *parityActivityTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockClientBean = &client.MockClientBean{}
+	s.mockLocalClient = workflowservicetest.NewMockClient(s.controller)
+	s.mockRemoteClient = workflowservicetest.NewMockClient(s.controller)
+	s.mockLocalAdmin = adminservicetest.NewMockClient(s.controller)
+	s.mockRemoteAdmin = adminservicetest.NewMockClient(s.controller)
+	s.mockClientBean.On("GetFrontendClient").Return(s.mockLocalClient)
+	s.mockClientBean.On("GetRemoteFrontendClient", "standby").Return(s.mockRemoteClient)
+	s.mockClientBean.On("GetRemoteAdminClient", "active").Return(s.mockLocalAdmin)
+	s.mockClientBean.On("GetRemoteAdminClient", "standby").Return(s.mockRemoteAdmin)
+	s.params = setDefaultParams(ParityParams{DomainName: "domain", TargetCluster: "standby"})
+	s.closedStatus = shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+
+	events := testHistory(common.EmptyVersion)
+	s.completedHistory = testBatches(s.T(), events)
+	s.relabeledHistory = testBatches(s.T(), testHistory(10)[:1], testHistory(10)[1:])
+	different := testHistory(10)
+	different[2].WorkflowExecutionCompletedEventAttributes.Result = []byte("other")
+	s.differentHistory = testBatches(s.T(), different)
+	s.notExistsResponse = &shared.EntityNotExistsError{}
+}
+
+func (s *parityActivityTestSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *parityActivityTestSuite) TestParityActivity() {
+	// current cluster: wid1 open, wid2 open and missing in the target, wid3 closed with the same
+	// history, wid4 closed with another history
+	s.mockLocalClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&shared.ListOpenWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{testExecutionInfo("wid1", nil), testExecutionInfo("wid2", nil)},
+	}, nil)
+	s.mockLocalClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&shared.ListClosedWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{testExecutionInfo("wid3", s.closedStatus), testExecutionInfo("wid4", s.closedStatus)},
+	}, nil)
+	s.expectDescribe(s.mockRemoteClient, "wid1", testExecutionInfo("wid1", nil), nil)
+	s.expectDescribe(s.mockRemoteClient, "wid2", nil, s.notExistsResponse)
+	s.expectDescribe(s.mockRemoteClient, "wid3", testExecutionInfo("wid3", s.closedStatus), nil)
+	s.expectDescribe(s.mockRemoteClient, "wid4", testExecutionInfo("wid4", s.closedStatus), nil)
+	s.expectRawHistory(s.mockLocalAdmin, "wid3", s.completedHistory)
+	s.expectRawHistory(s.mockRemoteAdmin, "wid3", s.relabeledHistory)
+	s.expectRawHistory(s.mockLocalAdmin, "wid4", s.completedHistory)
+	s.expectRawHistory(s.mockRemoteAdmin, "wid4", s.differentHistory)
+
+	// target cluster: wid1 open, wid5 open and missing in the current cluster, wid3 and wid4 closed
+	s.mockRemoteClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&shared.ListOpenWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{testExecutionInfo("wid1", nil), testExecutionInfo("wid5", nil)},
+	}, nil)
+	s.mockRemoteClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&shared.ListClosedWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{testExecutionInfo("wid3", s.closedStatus), testExecutionInfo("wid4", s.closedStatus)},
+	}, nil)
+	s.expectDescribe(s.mockLocalClient, "wid1", testExecutionInfo("wid1", nil), nil)
+	s.expectDescribe(s.mockLocalClient, "wid5", nil, s.notExistsResponse)
+	s.expectDescribe(s.mockLocalClient, "wid3", testExecutionInfo("wid3", s.closedStatus), nil)
+	s.expectDescribe(s.mockLocalClient, "wid4", testExecutionInfo("wid4", s.closedStatus), nil)
+
+	env := s.newTestActivityEnvironment()
+	result, err := env.ExecuteActivity(parityActivityName, s.params)
+	s.NoError(err)
+	var report Report
+	s.NoError(result.Get(&report))
+	s.Equal(4, report.ExecutionCount)
+	s.Equal(4, report.TargetExecutionCount)
+	s.Equal(1, report.MissingCount)
+	s.Equal(1, report.HistoryMismatchCount)
+	s.Equal(1, report.ExtraCount)
+	s.Equal([]Mismatch{
+		{WorkflowID: "wid2", RunID: "wid2-run", Type: MismatchTypeMissing},
+		{WorkflowID: "wid4", RunID: "wid4-run", Type: MismatchTypeHistory, Details: report.Mismatches[1].Details},
+		{WorkflowID: "wid5", RunID: "wid5-run", Type: MismatchTypeExtra},
+	}, report.Mismatches)
+}
+
+func (s *parityActivityTestSuite) TestParityActivity_OpenOnly() {
+	s.params.OpenOnly = true
+	s.mockLocalClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&shared.ListOpenWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{testExecutionInfo("wid1", nil)},
+	}, nil)
+	s.expectDescribe(s.mockRemoteClient, "wid1", testExecutionInfo("wid1", s.closedStatus), nil)
+	s.mockRemoteClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&shared.ListOpenWorkflowExecutionsResponse{}, nil)
+
+	env := s.newTestActivityEnvironment()
+	result, err := env.ExecuteActivity(parityActivityName, s.params)
+	s.NoError(err)
+	var report Report
+	s.NoError(result.Get(&report))
+	s.Equal(1, report.ExecutionCount)
+	s.Equal(1, report.CloseStatusMismatchCount)
+	s.Equal(MismatchTypeCloseStatus, report.Mismatches[0].Type)
+}
+
+func (s *parityActivityTestSuite) expectDescribe(
+	mockClient *workflowservicetest.MockClient,
+	workflowID string,
+	info *shared.WorkflowExecutionInfo,
+	err error,
+) {
+	var response *shared.DescribeWorkflowExecutionResponse
+	if info != nil {
+		response = &shared.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: info}
+	}
+	mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr("domain"),
+		Execution: testExecutionInfo(workflowID, nil).Execution,
+	}).Return(response, err)
+}
+
+func (s *parityActivityTestSuite) expectRawHistory(
+	mockClient *adminservicetest.MockClient,
+	workflowID string,
+	batches []*shared.DataBlob,
+) {
+	mockClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest, _ ...interface{}) (*admin.GetWorkflowExecutionRawHistoryResponse, error) {
+			s.Equal(workflowID, request.Execution.GetWorkflowId())
+			return &admin.GetWorkflowExecutionRawHistoryResponse{HistoryBatches: batches}, nil
+		})
+}
+
+func (s *parityActivityTestSuite) newTestActivityEnvironment() *testsuite.TestActivityEnvironment {
+	verifier := &Verifier{
+		currentClusterName: "active",
+		clientBean:         s.mockClientBean,
+		metricsClient:      metrics.NewClient(tally.NoopScope, metrics.Worker),
+		logger:             loggerimpl.NewNopLogger(),
+		serializer:         persistence.NewPayloadSerializer(),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), verifierContextKey, verifier),
+	})
+	return env
+}
+
+func testExecutionInfo(workflowID string, closeStatus *shared.WorkflowExecutionCloseStatus) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(workflowID + "-run"),
+		},
+		CloseStatus: closeStatus,
+	}
+}
+
+func testHistory(version int64) []*shared.HistoryEvent {
+	return []*shared.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			Version:   common.Int64Ptr(version),
+			EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+				Input:  []byte("input"),
+				Header: &shared.Header{Fields: map[string][]byte{"key1": []byte("value1"), "key2": []byte("value2")}},
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(2),
+			Version:   common.Int64Ptr(version),
+			EventType: shared.EventTypeDecisionTaskScheduled.Ptr(),
+		},
+		{
+			EventId:   common.Int64Ptr(3),
+			Version:   common.Int64Ptr(version),
+			EventType: shared.EventTypeWorkflowExecutionCompleted.Ptr(),
+			WorkflowExecutionCompletedEventAttributes: &shared.WorkflowExecutionCompletedEventAttributes{
+				Result: []byte("result"),
+			},
+		},
+	}
+}
+
+func testBatches(t *testing.T, batches ...[]*shared.HistoryEvent) []*shared.DataBlob {
+	serializer := persistence.NewPayloadSerializer()
+	var blobs []*shared.DataBlob
+	for _, events := range batches {
+		blob, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+		assert.NoError(t, err)
+		blobs = append(blobs, &shared.DataBlob{
+			EncodingType: shared.EncodingTypeThriftRW.Ptr(),
+			Data:         blob.Data,
+		})
+	}
+	return blobs
+}
@@ -44,6 +44,7 @@ const (
 )
 
 func newDomainReplicationMessageProcessor(
+	currentCluster string,
 	sourceCluster string,
 	logger log.Logger,
 	remotePeer workflowserviceclient.Interface,
@@ -56,6 +57,7 @@ func newDomainReplicationMessageProcessor(
 
 	return &domainReplicationMessageProcessor{
 		status:                 common.DaemonStatusInitialized,
+		currentCluster:         currentCluster,
 		sourceCluster:          sourceCluster,
 		logger:                 logger,
 		remotePeer:             remotePeer,
@@ -71,6 +73,7 @@ func newDomainReplicationMessageProcessor(
 type (
 	domainReplicationMessageProcessor struct {
 		status                 int32
+		currentCluster         string
 		sourceCluster          string
 		logger                 log.Logger
 		remotePeer             workflowserviceclient.Interface
@@ -92,7 +95,6 @@ func (p *domainReplicationMessageProcessor) Start() {
 }
 
 // TODO: need to make sure only one worker is processing per source DC
-// TODO: store checkpoints in DB
 func (p *domainReplicationMessageProcessor) processorLoop() {
 	timer := time.NewTimer(getWaitDuration())
 
@@ -113,6 +115,7 @@ func (p *domainReplicationMessageProcessor) getAndHandleDomainReplicationTasks()
 	request := &replicator.GetDomainReplicationMessagesRequest{
 		LastRetrivedMessageId:  common.Int64Ptr(p.lastRetrievedMessageID),
 		LastProcessedMessageId: common.Int64Ptr(p.lastProcessedMessageID),
+		ClusterName:            common.StringPtr(p.currentCluster),
 	}
 	response, err := p.remotePeer.GetDomainReplicationMessages(ctx, request)
 	defer cancel()
@@ -112,10 +112,13 @@ func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTas
 			HistoryArchivalURI:       task.Config.GetHistoryArchivalURI(),
 			VisibilityArchivalStatus: task.Config.GetVisibilityArchivalStatus(),
 			VisibilityArchivalURI:    task.Config.GetVisibilityArchivalURI(),
+			KnownTaskLists:           task.Config.GetKnownTaskLists(),
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
-			ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
-			Clusters:          domainReplicator.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters),
+			ActiveClusterName:        task.ReplicationConfig.GetActiveClusterName(),
+			Clusters:                 domainReplicator.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters),
+			FailoverState:            task.ReplicationConfig.GetFailoverState(),
+			PendingActiveClusterName: task.ReplicationConfig.GetPendingActiveClusterName(),
 		},
 		IsGlobalDomain:  true, // local domain will not be replicated
 		ConfigVersion:   task.GetConfigVersion(),
@@ -205,6 +208,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 		Info:                        resp.Info,
 		Config:                      resp.Config,
 		ReplicationConfig:           resp.ReplicationConfig,
+		IsGlobalDomain:              resp.IsGlobalDomain,
 		ConfigVersion:               resp.ConfigVersion,
 		FailoverVersion:             resp.FailoverVersion,
 		FailoverNotificationVersion: resp.FailoverNotificationVersion,
@@ -228,6 +232,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 			HistoryArchivalURI:       task.Config.GetHistoryArchivalURI(),
 			VisibilityArchivalStatus: task.Config.GetVisibilityArchivalStatus(),
 			VisibilityArchivalURI:    task.Config.GetVisibilityArchivalURI(),
+			KnownTaskLists:           task.Config.GetKnownTaskLists(),
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Config.BadBinaries = *task.Config.GetBadBinaries()
@@ -238,6 +243,9 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 	if resp.FailoverVersion < task.GetFailoverVersion() {
 		recordUpdated = true
 		request.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
+		request.ReplicationConfig.FailoverState = task.ReplicationConfig.GetFailoverState()
+		request.ReplicationConfig.PendingActiveClusterName = task.ReplicationConfig.GetPendingActiveClusterName()
+		request.ReplicationConfig.PreviousFailoverVersion = resp.FailoverVersion
 		request.FailoverVersion = task.GetFailoverVersion()
 		request.FailoverNotificationVersion = notificationVersion
 	}
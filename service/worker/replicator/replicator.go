@@ -109,6 +109,7 @@ func (r *Replicator) Start() error {
 		if clusterName != currentClusterName {
 			if replicationConsumerConfig.Type == config.ReplicationConsumerTypeRPC {
 				processor := newDomainReplicationMessageProcessor(
+					currentClusterName,
 					clusterName,
 					r.logger.WithTags(tag.ComponentReplicationTaskProcessor, tag.SourceCluster(clusterName)),
 					r.clientBean.GetRemoteFrontendClient(clusterName),
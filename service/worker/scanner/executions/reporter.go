@@ -0,0 +1,316 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+)
+
+type (
+	// Reporter is the type that holds the state for the executions reporter daemon
+	Reporter struct {
+		visibilityDB     p.VisibilityManager
+		domainDB         p.MetadataManager
+		executionFactory p.ExecutionManagerFactory
+		executionDBs     map[int]p.ExecutionManager
+		numHistoryShards int
+		topK             dynamicconfig.IntPropertyFnWithDomainFilter
+		limiter          *rate.Limiter
+		metrics          metrics.Client
+		logger           log.Logger
+		isInTest         bool
+	}
+
+	// WorkflowStats is the execution stats of a single open workflow
+	WorkflowStats struct {
+		WorkflowID           string
+		RunID                string
+		WorkflowType         string
+		HistorySize          int64
+		EventCount           int64
+		PendingActivityCount int
+	}
+
+	// DomainReport is the list of heaviest open workflows of a domain, heaviest first
+	DomainReport struct {
+		DomainName   string
+		ScannedCount int
+		Workflows    []WorkflowStats
+	}
+
+	// Report is the result of one complete run of the reporter
+	Report struct {
+		Domains []DomainReport
+	}
+
+	// statsHeap is a min-heap of workflow stats, the lightest workflow is at the root
+	statsHeap []WorkflowStats
+)
+
+const (
+	pageSize = 1000
+)
+
+// NewReporter returns an instance of executions reporter daemon
+// The Reporter can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the domains in the system. For
+// each domain with a positive top-K, the mutable state of every
+// open workflow is read and the K heaviest workflows, ordered by
+// history size, event count and pending activity count, are
+// logged and returned as part of the report.
+func NewReporter(
+	visibilityDB p.VisibilityManager,
+	domainDB p.MetadataManager,
+	executionFactory p.ExecutionManagerFactory,
+	numHistoryShards int,
+	topK dynamicconfig.IntPropertyFnWithDomainFilter,
+	rps int,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Reporter {
+	return &Reporter{
+		visibilityDB:     visibilityDB,
+		domainDB:         domainDB,
+		executionFactory: executionFactory,
+		executionDBs:     make(map[int]p.ExecutionManager),
+		numHistoryShards: numHistoryShards,
+		topK:             topK,
+		limiter:          rate.NewLimiter(rate.Limit(rps), rps),
+		metrics:          metricsClient,
+		logger:           logger,
+	}
+}
+
+// Run runs the reporter
+func (r *Reporter) Run(ctx context.Context) (*Report, error) {
+	defer r.closeExecutionDBs()
+
+	report := &Report{}
+	var nextPageToken []byte
+	for {
+		resp, err := r.domainDB.ListDomains(&p.ListDomainsRequest{
+			PageSize:      pageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, domain := range resp.Domains {
+			domainReport, err := r.reportDomain(ctx, domain)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				r.metrics.IncCounter(metrics.ExecutionsReporterScope, metrics.ExecutionsReporterErrorCount)
+				r.logger.Error("failed to report executions of domain",
+					tag.WorkflowDomainName(domain.Info.Name), tag.Error(err))
+				continue
+			}
+			if domainReport != nil && len(domainReport.Workflows) > 0 {
+				report.Domains = append(report.Domains, *domainReport)
+			}
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return report, nil
+		}
+	}
+}
+
+func (r *Reporter) reportDomain(ctx context.Context, domain *p.GetDomainResponse) (*DomainReport, error) {
+	topK := r.topK(domain.Info.Name)
+	if topK <= 0 {
+		return nil, nil
+	}
+
+	heaviest := make(statsHeap, 0, topK)
+	report := &DomainReport{DomainName: domain.Info.Name}
+	var nextPageToken []byte
+	for {
+		if err := r.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := r.visibilityDB.ListOpenWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+			DomainUUID:        domain.Info.ID,
+			Domain:            domain.Info.Name,
+			EarliestStartTime: 0,
+			LatestStartTime:   time.Now().UnixNano(),
+			PageSize:          pageSize,
+			NextPageToken:     nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, execution := range resp.Executions {
+			stats, err := r.getStats(ctx, domain.Info.ID, execution)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				if _, ok := err.(*shared.EntityNotExistsError); !ok {
+					r.metrics.IncCounter(metrics.ExecutionsReporterScope, metrics.ExecutionsReporterErrorCount)
+					r.logger.Warn("failed to get execution stats", tag.WorkflowDomainName(domain.Info.Name),
+						tag.WorkflowID(execution.Execution.GetWorkflowId()), tag.WorkflowRunID(execution.Execution.GetRunId()), tag.Error(err))
+				}
+				continue
+			}
+			report.ScannedCount++
+			r.metrics.IncCounter(metrics.ExecutionsReporterScope, metrics.ExecutionsReporterScannedCount)
+			heaviest.offer(stats, topK)
+		}
+
+		if !r.isInTest {
+			activity.RecordHeartbeat(ctx)
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	report.Workflows = heaviest.sorted()
+	for _, stats := range report.Workflows {
+		r.logger.Info("heavy workflow execution",
+			tag.WorkflowDomainName(domain.Info.Name),
+			tag.WorkflowID(stats.WorkflowID),
+			tag.WorkflowRunID(stats.RunID),
+			tag.WorkflowType(stats.WorkflowType),
+			tag.WorkflowHistorySizeBytes(int(stats.HistorySize)),
+			tag.WorkflowEventCount(int(stats.EventCount)),
+			tag.WorkflowPendingActivityCount(stats.PendingActivityCount))
+	}
+	return report, nil
+}
+
+func (r *Reporter) getStats(ctx context.Context, domainID string, execution *shared.WorkflowExecutionInfo) (WorkflowStats, error) {
+	if err := r.limiter.Wait(ctx); err != nil {
+		return WorkflowStats{}, err
+	}
+	executionDB, err := r.getExecutionDB(common.WorkflowIDToHistoryShard(execution.Execution.GetWorkflowId(), r.numHistoryShards))
+	if err != nil {
+		return WorkflowStats{}, err
+	}
+	resp, err := executionDB.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID:  domainID,
+		Execution: *execution.Execution,
+	})
+	if err != nil {
+		return WorkflowStats{}, err
+	}
+
+	stats := WorkflowStats{
+		WorkflowID:           execution.Execution.GetWorkflowId(),
+		RunID:                execution.Execution.GetRunId(),
+		WorkflowType:         execution.Type.GetName(),
+		EventCount:           resp.State.ExecutionInfo.NextEventID - common.FirstEventID,
+		PendingActivityCount: len(resp.State.ActivityInfos),
+	}
+	if resp.State.ExecutionStats != nil {
+		stats.HistorySize = resp.State.ExecutionStats.HistorySize
+	}
+	return stats, nil
+}
+
+func (r *Reporter) getExecutionDB(shardID int) (p.ExecutionManager, error) {
+	if executionDB, ok := r.executionDBs[shardID]; ok {
+		return executionDB, nil
+	}
+	executionDB, err := r.executionFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	r.executionDBs[shardID] = executionDB
+	return executionDB, nil
+}
+
+func (r *Reporter) closeExecutionDBs() {
+	for shardID, executionDB := range r.executionDBs {
+		executionDB.Close()
+		delete(r.executionDBs, shardID)
+	}
+}
+
+// heavierThan returns true if s is heavier than other
+func (s WorkflowStats) heavierThan(other WorkflowStats) bool {
+	if s.HistorySize != other.HistorySize {
+		return s.HistorySize > other.HistorySize
+	}
+	if s.EventCount != other.EventCount {
+		return s.EventCount > other.EventCount
+	}
+	return s.PendingActivityCount > other.PendingActivityCount
+}
+
+// offer adds stats to the heap if it is among the k heaviest seen so far
+func (h *statsHeap) offer(stats WorkflowStats, k int) {
+	if h.Len() < k {
+		heap.Push(h, stats)
+		return
+	}
+	if stats.heavierThan((*h)[0]) {
+		(*h)[0] = stats
+		heap.Fix(h, 0)
+	}
+}
+
+// sorted returns the content of the heap, heaviest first
+func (h statsHeap) sorted() []WorkflowStats {
+	result := make([]WorkflowStats, len(h))
+	copy(result, h)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].heavierThan(result[j])
+	})
+	return result
+}
+
+func (h statsHeap) Len() int           { return len(h) }
+func (h statsHeap) Less(i, j int) bool { return h[j].heavierThan(h[i]) }
+func (h statsHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *statsHeap) Push(x interface{}) {
+	*h = append(*h, x.(WorkflowStats))
+}
+
+func (h *statsHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/zap"
+)
+
+type (
+	ReporterTestSuite struct {
+		suite.Suite
+		logger log.Logger
+		metric metrics.Client
+
+		visibilityDB     *mocks.VisibilityManager
+		domainDB         *mocks.MetadataManager
+		executionFactory *mocks.ExecutionManagerFactory
+		executionDB      *mocks.ExecutionManager
+	}
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+)
+
+func TestReporterTestSuite(t *testing.T) {
+	suite.Run(t, new(ReporterTestSuite))
+}
+
+func (s *ReporterTestSuite) SetupTest() {
+	zapLogger, err := zap.NewDevelopment()
+	s.Require().NoError(err)
+	s.logger = loggerimpl.NewLogger(zapLogger)
+	s.metric = metrics.NewClient(tally.NoopScope, metrics.Worker)
+	s.visibilityDB = &mocks.VisibilityManager{}
+	s.domainDB = &mocks.MetadataManager{}
+	s.executionFactory = &mocks.ExecutionManagerFactory{}
+	s.executionDB = &mocks.ExecutionManager{}
+}
+
+func (s *ReporterTestSuite) TearDownTest() {
+	s.visibilityDB.AssertExpectations(s.T())
+	s.domainDB.AssertExpectations(s.T())
+	s.executionFactory.AssertExpectations(s.T())
+	s.executionDB.AssertExpectations(s.T())
+}
+
+func (s *ReporterTestSuite) newTestReporter(topK int) *Reporter {
+	reporter := NewReporter(s.visibilityDB, s.domainDB, s.executionFactory, 1,
+		dynamicconfig.GetIntPropertyFilteredByDomain(topK), 1000, s.metric, s.logger)
+	reporter.isInTest = true
+	return reporter
+}
+
+func (s *ReporterTestSuite) TestRun_ReportsHeaviestWorkflows() {
+	s.domainDB.On("ListDomains", &p.ListDomainsRequest{PageSize: pageSize}).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{s.domain()},
+	}, nil).Once()
+	s.visibilityDB.On("ListOpenWorkflowExecutions", mock.Anything).Return(&p.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			s.execution("light"), s.execution("heavy"), s.execution("busy"), s.execution("deleted"),
+		},
+	}, nil).Once()
+	s.executionFactory.On("NewExecutionManager", 0).Return(s.executionDB, nil).Once()
+	s.expectGetWorkflowExecution("light", 100, 10, 0)
+	s.expectGetWorkflowExecution("heavy", 5000, 20, 1)
+	s.expectGetWorkflowExecution("busy", 100, 10, 30)
+	s.executionDB.On("GetWorkflowExecution", s.getRequest("deleted")).Return(nil, &shared.EntityNotExistsError{}).Once()
+	s.executionDB.On("Close").Once()
+
+	report, err := s.newTestReporter(2).Run(context.Background())
+	s.NoError(err)
+	s.Len(report.Domains, 1)
+	s.Equal(testDomainName, report.Domains[0].DomainName)
+	s.Equal(3, report.Domains[0].ScannedCount)
+	s.Equal([]WorkflowStats{
+		{WorkflowID: "test-workflow-id-heavy", RunID: "heavy", WorkflowType: "test-workflow-type", HistorySize: 5000, EventCount: 20, PendingActivityCount: 1},
+		{WorkflowID: "test-workflow-id-busy", RunID: "busy", WorkflowType: "test-workflow-type", HistorySize: 100, EventCount: 10, PendingActivityCount: 30},
+	}, report.Domains[0].Workflows)
+}
+
+func (s *ReporterTestSuite) TestRun_SkipsDomainsWithoutTopK() {
+	s.domainDB.On("ListDomains", &p.ListDomainsRequest{PageSize: pageSize}).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{s.domain()},
+	}, nil).Once()
+
+	report, err := s.newTestReporter(0).Run(context.Background())
+	s.NoError(err)
+	s.Empty(report.Domains)
+}
+
+func (s *ReporterTestSuite) TestStatsHeap_KeepsKHeaviest() {
+	h := make(statsHeap, 0, 3)
+	for _, size := range []int64{5, 1, 9, 3, 7, 2} {
+		h.offer(WorkflowStats{HistorySize: size}, 3)
+	}
+	var sizes []int64
+	for _, stats := range h.sorted() {
+		sizes = append(sizes, stats.HistorySize)
+	}
+	s.Equal([]int64{9, 7, 5}, sizes)
+}
+
+func (s *ReporterTestSuite) expectGetWorkflowExecution(runID string, historySize int64, eventCount int64, pendingActivityCount int) {
+	activityInfos := make(map[int64]*p.ActivityInfo)
+	for i := 0; i < pendingActivityCount; i++ {
+		activityInfos[int64(i)] = &p.ActivityInfo{ScheduleID: int64(i)}
+	}
+	s.executionDB.On("GetWorkflowExecution", s.getRequest(runID)).Return(&p.GetWorkflowExecutionResponse{
+		State: &p.WorkflowMutableState{
+			ExecutionInfo:  &p.WorkflowExecutionInfo{NextEventID: eventCount + common.FirstEventID},
+			ExecutionStats: &p.ExecutionStats{HistorySize: historySize},
+			ActivityInfos:  activityInfos,
+		},
+	}, nil).Once()
+}
+
+func (s *ReporterTestSuite) getRequest(runID string) *p.GetWorkflowExecutionRequest {
+	return &p.GetWorkflowExecutionRequest{
+		DomainID:  testDomainID,
+		Execution: *s.execution(runID).Execution,
+	}
+}
+
+func (s *ReporterTestSuite) domain() *p.GetDomainResponse {
+	return &p.GetDomainResponse{
+		Info:   &p.DomainInfo{ID: testDomainID, Name: testDomainName},
+		Config: &p.DomainConfig{},
+	}
+}
+
+func (s *ReporterTestSuite) execution(runID string) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("test-workflow-id-" + runID),
+			RunId:      common.StringPtr(runID),
+		},
+		Type:      &shared.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+		StartTime: common.Int64Ptr(time.Now().Add(-time.Hour).UnixNano()),
+	}
+}
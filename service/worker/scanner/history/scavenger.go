@@ -83,8 +83,11 @@ const (
 // returned object. Calling the Run() method will result in one
 // complete iteration over all of the history branches in the system. For
 // each branch, the scavenger will attempt
-//  - describe the corresponding workflow execution
+//  - describe the corresponding workflow execution, converting it to zombie if it is
+//    neither current nor closed
 //  - deletion of history itself, if there are no workflow execution
+// Before the first page, the scavenger also goes through the branches quarantined
+// as corrupted on read, releasing the ones which no longer exist and reporting the rest
 func NewScavenger(
 	db p.HistoryV2Manager,
 	rps int,
@@ -117,6 +120,14 @@ func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error)
 		go s.startTaskProcessor(ctx, taskCh, respCh)
 	}
 
+	if s.hbd.CurrentPage == 0 {
+		if err := s.processQuarantinedBranches(ctx); err != nil {
+			// quarantined branches will be retried by the next run, do not block the scan
+			s.logger.Error("encounter error when processing quarantined history branches", tag.Error(err))
+			s.metrics.IncCounter(metrics.HistoryScavengerScope, metrics.HistoryScavengerErrorCount)
+		}
+	}
+
 	for {
 		resp, err := s.db.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
 			PageSize:      pageSize,
@@ -198,6 +209,79 @@ func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error)
 	return s.hbd, nil
 }
 
+// processQuarantinedBranches releases the quarantined history branches which have been
+// deleted since, and reports the remaining ones which still need operator attention
+func (s *Scavenger) processQuarantinedBranches(
+	ctx context.Context,
+) error {
+
+	var nextPageToken []byte
+	for {
+		resp, err := s.db.GetQuarantinedHistoryBranches(&p.GetQuarantinedHistoryBranchesRequest{
+			PageSize:      pageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, br := range resp.Branches {
+			if err := s.limiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			shardID := br.ShardID
+			treeResp, err := s.db.GetHistoryTree(&p.GetHistoryTreeRequest{
+				TreeID:  br.TreeID,
+				ShardID: &shardID,
+			})
+			if err != nil {
+				return err
+			}
+
+			if !containsHistoryBranch(treeResp.Branches, br.BranchID) {
+				if err := s.db.DeleteQuarantinedHistoryBranch(&p.DeleteQuarantinedHistoryBranchRequest{
+					TreeID:   br.TreeID,
+					BranchID: br.BranchID,
+				}); err != nil {
+					return err
+				}
+				s.metrics.IncCounter(metrics.HistoryScavengerScope, metrics.HistoryScavengerQuarantineClearedCount)
+				continue
+			}
+
+			s.metrics.IncCounter(metrics.HistoryScavengerScope, metrics.HistoryScavengerQuarantinedCount)
+			s.logger.Error("history branch is quarantined as corrupted",
+				tag.WorkflowDomainName(br.DomainName),
+				tag.ShardID(br.ShardID),
+				tag.WorkflowTreeID(br.TreeID),
+				tag.WorkflowBranchID(br.BranchID),
+				tag.WorkflowFirstEventID(br.MinEventID),
+				tag.WorkflowNextEventID(br.MaxEventID),
+				tag.DetailInfo(br.Reason),
+			)
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func containsHistoryBranch(
+	branches []*shared.HistoryBranch,
+	branchID string,
+) bool {
+
+	for _, branch := range branches {
+		if branch.GetBranchID() == branchID {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Scavenger) startTaskProcessor(
 	ctx context.Context,
 	taskCh chan taskDetail,
@@ -226,7 +310,10 @@ func (s *Scavenger) startTaskProcessor(
 
 			// this checks if the mutableState still exists
 			// if not then the history branch is garbage, we need to delete the history branch
-			_, err = s.client.DescribeMutableState(ctx, &history.DescribeMutableStateRequest{
+			// if the workflow is running but no longer current, it will be converted to zombie
+			// and cleaned up by history service at retention
+			var resp *history.DetectZombieWorkflowExecutionResponse
+			resp, err = s.client.DetectZombieWorkflowExecution(ctx, &history.DetectZombieWorkflowExecutionRequest{
 				DomainUUID: common.StringPtr(task.domainID),
 				Execution: &shared.WorkflowExecution{
 					WorkflowId: common.StringPtr(task.workflowID),
@@ -265,11 +352,14 @@ func (s *Scavenger) startTaskProcessor(
 						respCh <- nil
 					}
 				} else {
-					s.logger.Error("encounter error when describing the mutable state",
+					s.logger.Error("encounter error when detecting zombie workflow",
 						getTaskLoggingTags(err, task)...)
 					respCh <- err
 				}
 			} else {
+				if resp.GetIsZombie() {
+					s.metrics.IncCounter(metrics.HistoryScavengerScope, metrics.HistoryScavengerZombieCount)
+				}
 				// no garbage
 				respCh <- nil
 			}
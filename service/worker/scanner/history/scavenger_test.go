@@ -71,9 +71,16 @@ func (s *ScavengerTestSuite) createTestScavenger(rps int) (*mocks.HistoryV2Manag
 	return db, workflowClient, scvgr, controller
 }
 
+func (s *ScavengerTestSuite) mockNoQuarantinedBranches(db *mocks.HistoryV2Manager) {
+	db.On("GetQuarantinedHistoryBranches", &p.GetQuarantinedHistoryBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&p.GetQuarantinedHistoryBranchesResponse{}, nil).Once()
+}
+
 func (s *ScavengerTestSuite) TestAllSkipTasksTwoPages() {
 	db, _, scvgr, controller := s.createTestScavenger(100)
 	defer controller.Finish()
+	s.mockNoQuarantinedBranches(db)
 	db.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
 		PageSize: pageSize,
 	}).Return(&p.GetAllHistoryTreeBranchesResponse{
@@ -126,6 +133,7 @@ func (s *ScavengerTestSuite) TestAllSkipTasksTwoPages() {
 func (s *ScavengerTestSuite) TestAllErrorSplittingTasksTwoPages() {
 	db, _, scvgr, controller := s.createTestScavenger(100)
 	defer controller.Finish()
+	s.mockNoQuarantinedBranches(db)
 	db.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
 		PageSize: pageSize,
 	}).Return(&p.GetAllHistoryTreeBranchesResponse{
@@ -178,6 +186,7 @@ func (s *ScavengerTestSuite) TestAllErrorSplittingTasksTwoPages() {
 func (s *ScavengerTestSuite) TestNoGarbageTwoPages() {
 	db, client, scvgr, controller := s.createTestScavenger(100)
 	defer controller.Finish()
+	s.mockNoQuarantinedBranches(db)
 	db.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
 		PageSize: pageSize,
 	}).Return(&p.GetAllHistoryTreeBranchesResponse{
@@ -218,28 +227,28 @@ func (s *ScavengerTestSuite) TestNoGarbageTwoPages() {
 		},
 	}, nil).Once()
 
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID1"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID1"),
 			RunId:      common.StringPtr("runID1"),
 		},
 	}).Return(nil, nil)
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID2"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID2"),
 			RunId:      common.StringPtr("runID2"),
 		},
 	}).Return(nil, nil)
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID3"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID3"),
 			RunId:      common.StringPtr("runID3"),
 		},
 	}).Return(nil, nil)
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID4"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID4"),
@@ -256,9 +265,44 @@ func (s *ScavengerTestSuite) TestNoGarbageTwoPages() {
 	s.Equal(0, len(hbd.NextPageToken))
 }
 
+func (s *ScavengerTestSuite) TestZombieDetectedOnePage() {
+	db, client, scvgr, controller := s.createTestScavenger(100)
+	defer controller.Finish()
+	s.mockNoQuarantinedBranches(db)
+	db.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&p.GetAllHistoryTreeBranchesResponse{
+		Branches: []p.HistoryBranchDetail{
+			{
+				TreeID:   "treeID1",
+				BranchID: "branchID1",
+				ForkTime: time.Now().Add(-cleanUpThreshold * 2),
+				Info:     p.BuildHistoryGarbageCleanupInfo("domainID1", "workflowID1", "runID1"),
+			},
+		},
+	}, nil).Once()
+
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr("domainID1"),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflowID1"),
+			RunId:      common.StringPtr("runID1"),
+		},
+	}).Return(&history.DetectZombieWorkflowExecutionResponse{IsZombie: common.BoolPtr(true)}, nil)
+
+	hbd, err := scvgr.Run(context.Background())
+	s.Nil(err)
+	s.Equal(0, hbd.SkipCount)
+	s.Equal(1, hbd.SuccCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(1, hbd.CurrentPage)
+	s.Equal(0, len(hbd.NextPageToken))
+}
+
 func (s *ScavengerTestSuite) TestDeletingBranchesTwoPages() {
 	db, client, scvgr, controller := s.createTestScavenger(100)
 	defer controller.Finish()
+	s.mockNoQuarantinedBranches(db)
 	db.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
 		PageSize: pageSize,
 	}).Return(&p.GetAllHistoryTreeBranchesResponse{
@@ -298,28 +342,28 @@ func (s *ScavengerTestSuite) TestDeletingBranchesTwoPages() {
 		},
 	}, nil).Once()
 
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID1"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID1"),
 			RunId:      common.StringPtr("runID1"),
 		},
 	}).Return(nil, &shared.EntityNotExistsError{})
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID2"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID2"),
 			RunId:      common.StringPtr("runID2"),
 		},
 	}).Return(nil, &shared.EntityNotExistsError{})
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID3"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID3"),
 			RunId:      common.StringPtr("runID3"),
 		},
 	}).Return(nil, &shared.EntityNotExistsError{})
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID4"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID4"),
@@ -364,6 +408,7 @@ func (s *ScavengerTestSuite) TestDeletingBranchesTwoPages() {
 func (s *ScavengerTestSuite) TestMixesTwoPages() {
 	db, client, scvgr, controller := s.createTestScavenger(100)
 	defer controller.Finish()
+	s.mockNoQuarantinedBranches(db)
 	db.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
 		PageSize: pageSize,
 	}).Return(&p.GetAllHistoryTreeBranchesResponse{
@@ -414,7 +459,7 @@ func (s *ScavengerTestSuite) TestMixesTwoPages() {
 		},
 	}, nil).Once()
 
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID3"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID3"),
@@ -422,14 +467,14 @@ func (s *ScavengerTestSuite) TestMixesTwoPages() {
 		},
 	}).Return(nil, &shared.EntityNotExistsError{})
 
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID4"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID4"),
 			RunId:      common.StringPtr("runID4"),
 		},
 	}).Return(nil, &shared.EntityNotExistsError{})
-	client.EXPECT().DescribeMutableState(gomock.Any(), &history.DescribeMutableStateRequest{
+	client.EXPECT().DetectZombieWorkflowExecution(gomock.Any(), &history.DetectZombieWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr("domainID5"),
 		Execution: &shared.WorkflowExecution{
 			WorkflowId: common.StringPtr("workflowID5"),
@@ -459,3 +504,60 @@ func (s *ScavengerTestSuite) TestMixesTwoPages() {
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
+
+func (s *ScavengerTestSuite) TestQuarantinedBranches() {
+	db, _, scvgr, controller := s.createTestScavenger(100)
+	defer controller.Finish()
+	db.On("GetQuarantinedHistoryBranches", &p.GetQuarantinedHistoryBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&p.GetQuarantinedHistoryBranchesResponse{
+		NextPageToken: []byte("page1"),
+		Branches: []p.QuarantinedHistoryBranch{
+			{
+				TreeID:   "treeID1",
+				BranchID: "branchID1",
+				ShardID:  1,
+			},
+		},
+	}, nil).Once()
+	db.On("GetQuarantinedHistoryBranches", &p.GetQuarantinedHistoryBranchesRequest{
+		PageSize:      pageSize,
+		NextPageToken: []byte("page1"),
+	}).Return(&p.GetQuarantinedHistoryBranchesResponse{
+		Branches: []p.QuarantinedHistoryBranch{
+			{
+				TreeID:   "treeID2",
+				BranchID: "branchID2",
+				ShardID:  2,
+			},
+		},
+	}, nil).Once()
+
+	// branch still exists, stays quarantined
+	db.On("GetHistoryTree", &p.GetHistoryTreeRequest{
+		TreeID:  "treeID1",
+		ShardID: common.IntPtr(1),
+	}).Return(&p.GetHistoryTreeResponse{
+		Branches: []*shared.HistoryBranch{
+			{TreeID: common.StringPtr("treeID1"), BranchID: common.StringPtr("branchID1")},
+		},
+	}, nil).Once()
+	// branch has been deleted, released from quarantine
+	db.On("GetHistoryTree", &p.GetHistoryTreeRequest{
+		TreeID:  "treeID2",
+		ShardID: common.IntPtr(2),
+	}).Return(&p.GetHistoryTreeResponse{}, nil).Once()
+	db.On("DeleteQuarantinedHistoryBranch", &p.DeleteQuarantinedHistoryBranchRequest{
+		TreeID:   "treeID2",
+		BranchID: "branchID2",
+	}).Return(nil).Once()
+
+	db.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&p.GetAllHistoryTreeBranchesResponse{}, nil).Once()
+
+	hbd, err := scvgr.Run(context.Background())
+	s.Nil(err)
+	s.Equal(1, hbd.CurrentPage)
+	db.AssertExpectations(s.T())
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
@@ -52,6 +53,12 @@ type (
 		Persistence *config.Persistence
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// VisibilityTieringAgeDays is the age in days after which closed visibility
+		// records are moved to the visibility archival store of the domain
+		VisibilityTieringAgeDays dynamicconfig.IntPropertyFnWithDomainFilter
+		// ExecutionsReportTopK is the number of heaviest open workflows of a domain
+		// reported by the executions reporter
+		ExecutionsReportTopK dynamicconfig.IntPropertyFnWithDomainFilter
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -68,21 +75,26 @@ type (
 		Logger        log.Logger
 		// TallyScope is an instance of tally metrics scope
 		TallyScope tally.Scope
+		// ArchiverProvider is used to get the visibility archiver of a domain
+		ArchiverProvider provider.ArchiverProvider
 	}
 
 	// scannerContext is the context object that get's
 	// passed around within the scanner workflows / activities
 	scannerContext struct {
-		taskDB        p.TaskManager
-		domainDB      p.MetadataManager
-		historyDB     p.HistoryV2Manager
-		cfg           Config
-		sdkClient     workflowserviceclient.Interface
-		clientBean    client.Bean
-		metricsClient metrics.Client
-		tallyScope    tally.Scope
-		logger        log.Logger
-		zapLogger     *zap.Logger
+		taskDB           p.TaskManager
+		domainDB         p.MetadataManager
+		historyDB        p.HistoryV2Manager
+		visibilityDB     p.VisibilityManager
+		executionFactory p.ExecutionManagerFactory
+		cfg              Config
+		sdkClient        workflowserviceclient.Interface
+		clientBean       client.Bean
+		archiverProvider provider.ArchiverProvider
+		metricsClient    metrics.Client
+		tallyScope       tally.Scope
+		logger           log.Logger
+		zapLogger        *zap.Logger
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -107,13 +119,14 @@ func New(params *BootstrapParams) *Scanner {
 	}
 	return &Scanner{
 		context: scannerContext{
-			cfg:           cfg,
-			sdkClient:     params.SDKClient,
-			clientBean:    params.ClientBean,
-			metricsClient: params.MetricsClient,
-			tallyScope:    params.TallyScope,
-			zapLogger:     zapLogger,
-			logger:        params.Logger,
+			cfg:              cfg,
+			sdkClient:        params.SDKClient,
+			clientBean:       params.ClientBean,
+			archiverProvider: params.ArchiverProvider,
+			metricsClient:    params.MetricsClient,
+			tallyScope:       params.TallyScope,
+			zapLogger:        zapLogger,
+			logger:           params.Logger,
 		},
 	}
 }
@@ -136,6 +149,8 @@ func (s *Scanner) Start() error {
 	} else if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeCassandra {
 		go s.startWorkflowWithRetry(historyScannerWFStartOptions, historyScannerWFTypeName)
 	}
+	go s.startWorkflowWithRetry(visibilityTiererWFStartOptions, visibilityTiererWFTypeName)
+	go s.startWorkflowWithRetry(executionsReporterWFStartOptions, executionsReporterWFTypeName)
 
 	worker := worker.New(s.context.sdkClient, common.SystemLocalDomainName, tlScannerTaskListName, workerOpts)
 	return worker.Start()
@@ -183,8 +198,14 @@ func (s *Scanner) buildContext() error {
 	if err != nil {
 		return err
 	}
+	visibilityDB, err := pFactory.NewVisibilityManager()
+	if err != nil {
+		return err
+	}
 	s.context.taskDB = taskDB
 	s.context.domainDB = domainDB
 	s.context.historyDB = historyDB
+	s.context.visibilityDB = visibilityDB
+	s.context.executionFactory = pFactory
 	return nil
 }
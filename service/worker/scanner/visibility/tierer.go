@@ -0,0 +1,219 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package visibility
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+)
+
+type (
+	// Tierer is the type that holds the state for the visibility tiering daemon
+	Tierer struct {
+		visibilityDB     p.VisibilityManager
+		domainDB         p.MetadataManager
+		archiverProvider provider.ArchiverProvider
+		tieringAgeDays   dynamicconfig.IntPropertyFnWithDomainFilter
+		limiter          *rate.Limiter
+		metrics          metrics.Client
+		logger           log.Logger
+		isInTest         bool
+	}
+)
+
+const (
+	pageSize = 1000
+)
+
+// NewTierer returns an instance of visibility tierer daemon
+// The Tierer can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the domains in the system. For
+// each domain with visibility archival and tiering enabled, closed
+// visibility records older than the tiering age are archived to the
+// visibility archival store of the domain and deleted from the DB.
+// Frontend transparently merges archived records into closed workflow
+// listings within the same time range.
+func NewTierer(
+	visibilityDB p.VisibilityManager,
+	domainDB p.MetadataManager,
+	archiverProvider provider.ArchiverProvider,
+	tieringAgeDays dynamicconfig.IntPropertyFnWithDomainFilter,
+	rps int,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Tierer {
+	return &Tierer{
+		visibilityDB:     visibilityDB,
+		domainDB:         domainDB,
+		archiverProvider: archiverProvider,
+		tieringAgeDays:   tieringAgeDays,
+		limiter:          rate.NewLimiter(rate.Limit(rps), rps),
+		metrics:          metricsClient,
+		logger:           logger,
+	}
+}
+
+// Run runs the tierer
+func (t *Tierer) Run(ctx context.Context) error {
+	var nextPageToken []byte
+	for {
+		resp, err := t.domainDB.ListDomains(&p.ListDomainsRequest{
+			PageSize:      pageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, domain := range resp.Domains {
+			if err := t.tierDomain(ctx, domain); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				t.metrics.IncCounter(metrics.VisibilityTiererScope, metrics.VisibilityTiererErrorCount)
+				t.logger.Error("failed to tier visibility records of domain",
+					tag.WorkflowDomainName(domain.Info.Name), tag.Error(err))
+			}
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (t *Tierer) tierDomain(ctx context.Context, domain *p.GetDomainResponse) error {
+	ageDays := t.tieringAgeDays(domain.Info.Name)
+	if ageDays <= 0 || domain.Config.VisibilityArchivalStatus != shared.ArchivalStatusEnabled {
+		return nil
+	}
+
+	URI, err := archiver.NewURI(domain.Config.VisibilityArchivalURI)
+	if err != nil {
+		return err
+	}
+	visibilityArchiver, err := t.archiverProvider.GetVisibilityArchiver(URI.Scheme(), common.WorkerServiceName)
+	if err != nil {
+		return err
+	}
+
+	cutoff := time.Now().Add(-time.Duration(ageDays) * 24 * time.Hour).UnixNano()
+	var nextPageToken []byte
+	for {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := t.visibilityDB.ListClosedWorkflowExecutions(&p.ListWorkflowExecutionsRequest{
+			DomainUUID:        domain.Info.ID,
+			Domain:            domain.Info.Name,
+			EarliestStartTime: 0,
+			LatestStartTime:   cutoff,
+			PageSize:          pageSize,
+			NextPageToken:     nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, execution := range resp.Executions {
+			if execution.GetCloseTime() >= cutoff {
+				t.metrics.IncCounter(metrics.VisibilityTiererScope, metrics.VisibilityTiererSkipCount)
+				continue
+			}
+			if err := t.tierExecution(ctx, visibilityArchiver, URI, domain, execution); err != nil {
+				return err
+			}
+			t.metrics.IncCounter(metrics.VisibilityTiererScope, metrics.VisibilityTiererArchivedCount)
+		}
+
+		if !t.isInTest {
+			activity.RecordHeartbeat(ctx)
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (t *Tierer) tierExecution(
+	ctx context.Context,
+	visibilityArchiver archiver.VisibilityArchiver,
+	URI archiver.URI,
+	domain *p.GetDomainResponse,
+	execution *shared.WorkflowExecutionInfo,
+) error {
+	request := &archiver.ArchiveVisibilityRequest{
+		DomainID:           domain.Info.ID,
+		WorkflowID:         execution.Execution.GetWorkflowId(),
+		RunID:              execution.Execution.GetRunId(),
+		WorkflowTypeName:   execution.Type.GetName(),
+		StartTimestamp:     execution.GetStartTime(),
+		ExecutionTimestamp: execution.GetExecutionTime(),
+		CloseTimestamp:     execution.GetCloseTime(),
+		CloseStatus:        execution.GetCloseStatus(),
+		HistoryLength:      execution.GetHistoryLength(),
+		Memo:               execution.Memo,
+		SearchAttributes:   convertSearchAttributesToString(execution.SearchAttributes),
+		HistoryArchivalURI: domain.Config.HistoryArchivalURI,
+	}
+	if err := visibilityArchiver.Archive(ctx, URI, request); err != nil {
+		return err
+	}
+
+	if err := t.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	// only delete the DB record once it is durable in the archival store,
+	// archiving the same record again on a later run is idempotent
+	return t.visibilityDB.DeleteWorkflowExecution(&p.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:       domain.Info.ID,
+		WorkflowID:     request.WorkflowID,
+		RunID:          request.RunID,
+		StartTimestamp: request.StartTimestamp,
+		CloseTimestamp: request.CloseTimestamp,
+	})
+}
+
+func convertSearchAttributesToString(searchAttributes *shared.SearchAttributes) map[string]string {
+	searchAttrStr := make(map[string]string)
+	if searchAttributes == nil {
+		return searchAttrStr
+	}
+	for k, v := range searchAttributes.IndexedFields {
+		searchAttrStr[k] = string(v)
+	}
+	return searchAttrStr
+}
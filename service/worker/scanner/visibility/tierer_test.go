@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package visibility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/zap"
+)
+
+type (
+	TiererTestSuite struct {
+		suite.Suite
+		logger log.Logger
+		metric metrics.Client
+
+		visibilityDB       *mocks.VisibilityManager
+		domainDB           *mocks.MetadataManager
+		archiverProvider   *provider.MockArchiverProvider
+		visibilityArchiver *archiver.VisibilityArchiverMock
+	}
+)
+
+const (
+	testDomainID              = "test-domain-id"
+	testDomainName            = "test-domain"
+	testVisibilityArchivalURI = "file:///tmp/test-visibility"
+)
+
+func TestTiererTestSuite(t *testing.T) {
+	suite.Run(t, new(TiererTestSuite))
+}
+
+func (s *TiererTestSuite) SetupTest() {
+	zapLogger, err := zap.NewDevelopment()
+	s.Require().NoError(err)
+	s.logger = loggerimpl.NewLogger(zapLogger)
+	s.metric = metrics.NewClient(tally.NoopScope, metrics.Worker)
+	s.visibilityDB = &mocks.VisibilityManager{}
+	s.domainDB = &mocks.MetadataManager{}
+	s.archiverProvider = &provider.MockArchiverProvider{}
+	s.visibilityArchiver = &archiver.VisibilityArchiverMock{}
+}
+
+func (s *TiererTestSuite) TearDownTest() {
+	s.visibilityDB.AssertExpectations(s.T())
+	s.domainDB.AssertExpectations(s.T())
+	s.archiverProvider.AssertExpectations(s.T())
+	s.visibilityArchiver.AssertExpectations(s.T())
+}
+
+func (s *TiererTestSuite) newTestTierer(ageDays int) *Tierer {
+	tierer := NewTierer(s.visibilityDB, s.domainDB, s.archiverProvider,
+		dynamicconfig.GetIntPropertyFilteredByDomain(ageDays), 1000, s.metric, s.logger)
+	tierer.isInTest = true
+	return tierer
+}
+
+func (s *TiererTestSuite) TestRun_TiersOldRecords() {
+	s.domainDB.On("ListDomains", &p.ListDomainsRequest{PageSize: pageSize}).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{s.domain(shared.ArchivalStatusEnabled)},
+	}, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", "file", common.WorkerServiceName).Return(s.visibilityArchiver, nil).Once()
+
+	now := time.Now()
+	oldExecution := s.execution("old-run", now.Add(-10*24*time.Hour))
+	recentExecution := s.execution("recent-run", now.Add(-time.Hour))
+	s.visibilityDB.On("ListClosedWorkflowExecutions", mock.Anything).Return(&p.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{oldExecution, recentExecution},
+	}, nil).Once()
+	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.ArchiveVisibilityRequest) bool {
+		return request.DomainID == testDomainID && request.RunID == "old-run" && request.CloseTimestamp == oldExecution.GetCloseTime()
+	})).Return(nil).Once()
+	s.visibilityDB.On("DeleteWorkflowExecution", &p.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:       testDomainID,
+		WorkflowID:     "test-workflow-id",
+		RunID:          "old-run",
+		StartTimestamp: oldExecution.GetStartTime(),
+		CloseTimestamp: oldExecution.GetCloseTime(),
+	}).Return(nil).Once()
+
+	s.NoError(s.newTestTierer(7).Run(context.Background()))
+}
+
+func (s *TiererTestSuite) TestRun_SkipsDomainsWithoutVisibilityArchival() {
+	s.domainDB.On("ListDomains", &p.ListDomainsRequest{PageSize: pageSize}).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{s.domain(shared.ArchivalStatusDisabled)},
+	}, nil).Once()
+
+	s.NoError(s.newTestTierer(7).Run(context.Background()))
+}
+
+func (s *TiererTestSuite) TestRun_TieringDisabled() {
+	s.domainDB.On("ListDomains", &p.ListDomainsRequest{PageSize: pageSize}).Return(&p.ListDomainsResponse{
+		Domains: []*p.GetDomainResponse{s.domain(shared.ArchivalStatusEnabled)},
+	}, nil).Once()
+
+	s.NoError(s.newTestTierer(0).Run(context.Background()))
+}
+
+func (s *TiererTestSuite) domain(visibilityArchivalStatus shared.ArchivalStatus) *p.GetDomainResponse {
+	return &p.GetDomainResponse{
+		Info: &p.DomainInfo{ID: testDomainID, Name: testDomainName},
+		Config: &p.DomainConfig{
+			VisibilityArchivalStatus: visibilityArchivalStatus,
+			VisibilityArchivalURI:    testVisibilityArchivalURI,
+		},
+	}
+}
+
+func (s *TiererTestSuite) execution(runID string, closeTime time.Time) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("test-workflow-id"),
+			RunId:      common.StringPtr(runID),
+		},
+		Type:        &shared.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+		StartTime:   common.Int64Ptr(closeTime.Add(-time.Minute).UnixNano()),
+		CloseTime:   common.Int64Ptr(closeTime.UnixNano()),
+		CloseStatus: shared.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}
+}
@@ -25,8 +25,10 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/history"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
+	"github.com/uber/cadence/service/worker/scanner/visibility"
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
 	cclient "go.uber.org/cadence/client"
@@ -53,6 +55,14 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+
+	visibilityTiererWFID         = "cadence-sys-visibility-tierer"
+	visibilityTiererWFTypeName   = "cadence-sys-visibility-tierer-workflow"
+	visibilityTiererActivityName = "cadence-sys-visibility-tierer-activity"
+
+	executionsReporterWFID         = "cadence-sys-executions-reporter"
+	executionsReporterWFTypeName   = "cadence-sys-executions-reporter-workflow"
+	executionsReporterActivityName = "cadence-sys-executions-reporter-activity"
 )
 
 var (
@@ -83,6 +93,22 @@ var (
 		ExecutionStartToCloseTimeout: infiniteDuration,
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 	}
+	visibilityTiererWFStartOptions = cclient.StartWorkflowOptions{
+		ID: visibilityTiererWFID,
+		// served by the scanner worker polling the task-list scanner task list
+		TaskList:                     tlScannerTaskListName,
+		ExecutionStartToCloseTimeout: 5 * 24 * time.Hour,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 3 * * *",
+	}
+	executionsReporterWFStartOptions = cclient.StartWorkflowOptions{
+		ID: executionsReporterWFID,
+		// served by the scanner worker polling the task-list scanner task list
+		TaskList:                     tlScannerTaskListName,
+		ExecutionStartToCloseTimeout: 5 * 24 * time.Hour,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 5 * * *",
+	}
 )
 
 func init() {
@@ -90,6 +116,10 @@ func init() {
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 	activity.RegisterWithOptions(TaskListScavengerActivity, activity.RegisterOptions{Name: taskListScavengerActivityName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
+	workflow.RegisterWithOptions(VisibilityTiererWorkflow, workflow.RegisterOptions{Name: visibilityTiererWFTypeName})
+	activity.RegisterWithOptions(VisibilityTiererActivity, activity.RegisterOptions{Name: visibilityTiererActivityName})
+	workflow.RegisterWithOptions(ExecutionsReporterWorkflow, workflow.RegisterOptions{Name: executionsReporterWFTypeName})
+	activity.RegisterWithOptions(ExecutionsReporterActivity, activity.RegisterOptions{Name: executionsReporterActivityName})
 }
 
 // TaskListScannerWorkflow is the workflow that runs the task-list scanner background daemon
@@ -105,6 +135,23 @@ func HistoryScannerWorkflow(ctx workflow.Context) error {
 	return future.Get(ctx, nil)
 }
 
+// VisibilityTiererWorkflow is the workflow that runs the visibility tierer background daemon
+func VisibilityTiererWorkflow(ctx workflow.Context) error {
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), visibilityTiererActivityName)
+	return future.Get(ctx, nil)
+}
+
+// ExecutionsReporterWorkflow is the workflow that runs the executions reporter background daemon,
+// the report of the heaviest open workflows per domain is the result of each run
+func ExecutionsReporterWorkflow(ctx workflow.Context) (*executions.Report, error) {
+	var report executions.Report
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), executionsReporterActivityName)
+	if err := future.Get(ctx, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(aCtx context.Context) (history.ScavengerHeartbeatDetails, error) {
 	ctx := aCtx.Value(scannerContextKey).(scannerContext)
@@ -138,3 +185,34 @@ func TaskListScavengerActivity(aCtx context.Context) error {
 	}
 	return nil
 }
+
+// VisibilityTiererActivity is the activity that runs visibility tierer
+func VisibilityTiererActivity(aCtx context.Context) error {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	tierer := visibility.NewTierer(
+		ctx.visibilityDB,
+		ctx.domainDB,
+		ctx.archiverProvider,
+		ctx.cfg.VisibilityTieringAgeDays,
+		ctx.cfg.PersistenceMaxQPS(),
+		ctx.metricsClient,
+		ctx.logger,
+	)
+	return tierer.Run(aCtx)
+}
+
+// ExecutionsReporterActivity is the activity that runs executions reporter
+func ExecutionsReporterActivity(aCtx context.Context) (*executions.Report, error) {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	reporter := executions.NewReporter(
+		ctx.visibilityDB,
+		ctx.domainDB,
+		ctx.executionFactory,
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.ExecutionsReportTopK,
+		ctx.cfg.PersistenceMaxQPS(),
+		ctx.metricsClient,
+		ctx.logger,
+	)
+	return reporter.Run(aCtx)
+}
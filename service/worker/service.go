@@ -34,13 +34,17 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	espersistence "github.com/uber/cadence/common/persistence/elasticsearch"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/handover"
 	"github.com/uber/cadence/service/worker/indexer"
+	"github.com/uber/cadence/service/worker/migration"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
+	"github.com/uber/cadence/service/worker/parity"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
 )
@@ -50,6 +54,9 @@ type (
 	// 1. Replicator: Handles applying replication tasks generated by remote clusters.
 	// 2. Indexer: Handles uploading of visibility records to elastic search.
 	// 3. Archiver: Handles archival of workflow histories.
+	// 4. Handover: Finishes graceful domain failovers once replication has drained.
+	// 5. Parity verifier: Verifies replication parity of a domain between clusters.
+	// 6. Domain migrator: Moves a local domain and its open workflow executions to another cluster.
 	Service struct {
 		stopC         chan struct{}
 		isStopped     int32
@@ -66,7 +73,10 @@ type (
 		IndexerCfg                    *indexer.Config
 		ScannerCfg                    *scanner.Config
 		BatcherCfg                    *batcher.Config
+		HandoverCfg                   *handover.Config
+		MigrationCfg                  *migration.Config
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
+		AdvancedVisibilityWritingMode dynamicconfig.StringPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
 	}
@@ -106,23 +116,32 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
 		},
 		ScannerCfg: &scanner.Config{
-			PersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
-			Persistence:       &params.PersistenceConfig,
-			ClusterMetadata:   params.ClusterMetadata,
+			PersistenceMaxQPS:        dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
+			Persistence:              &params.PersistenceConfig,
+			ClusterMetadata:          params.ClusterMetadata,
+			VisibilityTieringAgeDays: dc.GetIntPropertyFilteredByDomain(dynamicconfig.VisibilityTieringAgeDays, 0),
+			ExecutionsReportTopK:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.ExecutionsReportTopK, 0),
 		},
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		HandoverCfg: &handover.Config{
+			CheckInterval:       dc.GetDurationProperty(dynamicconfig.WorkerHandoverCheckInterval, 10*time.Second),
+			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
+		},
+		MigrationCfg: &migration.Config{
+			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
+		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 	}
-	advancedVisWritingMode := dc.GetStringProperty(
+	config.AdvancedVisibilityWritingMode = dc.GetStringProperty(
 		dynamicconfig.AdvancedVisibilityWritingMode,
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)
-	if advancedVisWritingMode() != common.AdvancedVisibilityWritingModeOff {
+	if config.AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
 		config.IndexerCfg = &indexer.Config{
 			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 1000),
 			ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
@@ -160,6 +179,9 @@ func (s *Service) Start() {
 	s.startScanner(base)
 	if replicatorEnabled {
 		s.startReplicator(base, pFactory)
+		s.startHandoverProcessor(base)
+		s.startParityVerifier(base)
+		s.startDomainMigrator(base)
 	}
 	if archiverEnabled {
 		s.startArchiver(base, pFactory)
@@ -216,12 +238,13 @@ func (s *Service) startBatcher(base service.Service) {
 
 func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
-		Config:        *s.config.ScannerCfg,
-		SDKClient:     s.params.PublicClient,
-		ClientBean:    base.GetClientBean(),
-		MetricsClient: s.metricsClient,
-		Logger:        s.logger,
-		TallyScope:    s.params.MetricScope,
+		Config:           *s.config.ScannerCfg,
+		SDKClient:        s.params.PublicClient,
+		ClientBean:       base.GetClientBean(),
+		MetricsClient:    s.metricsClient,
+		Logger:           s.logger,
+		TallyScope:       s.params.MetricScope,
+		ArchiverProvider: base.GetArchiverProvider(),
 	}
 	scanner := scanner.New(params)
 	if err := scanner.Start(); err != nil {
@@ -252,6 +275,48 @@ func (s *Service) startReplicator(base service.Service, pFactory persistencefact
 	}
 }
 
+func (s *Service) startHandoverProcessor(base service.Service) {
+	params := &handover.BootstrapParams{
+		Config:          *s.config.HandoverCfg,
+		ClusterMetadata: base.GetClusterMetadata(),
+		ClientBean:      base.GetClientBean(),
+		MetricsClient:   s.metricsClient,
+		Logger:          s.logger,
+	}
+	processor := handover.New(params)
+	processor.Start()
+}
+
+func (s *Service) startParityVerifier(base service.Service) {
+	params := &parity.BootstrapParams{
+		ClusterMetadata: base.GetClusterMetadata(),
+		ServiceClient:   s.params.PublicClient,
+		MetricsClient:   s.metricsClient,
+		Logger:          s.logger,
+		TallyScope:      s.params.MetricScope,
+		ClientBean:      base.GetClientBean(),
+	}
+	verifier := parity.New(params)
+	if err := verifier.Start(); err != nil {
+		s.logger.Fatal("error starting parity verifier", tag.Error(err))
+	}
+}
+
+func (s *Service) startDomainMigrator(base service.Service) {
+	params := &migration.BootstrapParams{
+		Config:        *s.config.MigrationCfg,
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.metricsClient,
+		Logger:        s.logger,
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    base.GetClientBean(),
+	}
+	migrator := migration.New(params)
+	if err := migrator.Start(); err != nil {
+		s.logger.Fatal("error starting domain migrator", tag.Error(err))
+	}
+}
+
 func (s *Service) startIndexer(base service.Service) {
 	indexer := indexer.NewIndexer(
 		s.config.IndexerCfg,
@@ -259,7 +324,8 @@ func (s *Service) startIndexer(base service.Service) {
 		s.params.ESClient,
 		s.params.ESConfig,
 		s.logger,
-		s.metricsClient)
+		s.metricsClient,
+		base.GetMeter())
 	if err := indexer.Start(); err != nil {
 		indexer.Stop()
 		s.logger.Fatal("fail to start indexer", tag.Error(err))
@@ -281,6 +347,24 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 	if err != nil {
 		s.logger.Fatal("failed to start archiver, could not create MetadataManager", tag.Error(err))
 	}
+	visibilityManager, err := pFactory.NewVisibilityManager()
+	if err != nil {
+		s.logger.Fatal("failed to start archiver, could not create VisibilityManager", tag.Error(err))
+	}
+	var esVisibilityManager persistence.VisibilityManager
+	if s.params.ESConfig != nil {
+		visibilityProducer, err := base.GetMessagingClient().NewProducer(common.VisibilityAppName)
+		if err != nil {
+			s.logger.Fatal("failed to start archiver, could not create visibility producer", tag.Error(err))
+		}
+		esVisibilityManager = espersistence.NewESVisibilityManager(nil, nil, nil, visibilityProducer, s.metricsClient, s.logger)
+	}
+	visibilityManager = persistence.NewVisibilityManagerWrapper(
+		visibilityManager,
+		esVisibilityManager,
+		dynamicconfig.GetBoolPropertyFnFilteredByDomain(false), // archiver never reads visibility
+		s.config.AdvancedVisibilityWritingMode,
+	)
 	domainCache := cache.NewDomainCache(metadataMgr, s.params.ClusterMetadata, s.metricsClient, s.logger)
 	domainCache.Start()
 	historyArchiverBootstrapContainer := &carchiver.HistoryBootstrapContainer{
@@ -304,14 +388,15 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 	}
 
 	bc := &archiver.BootstrapContainer{
-		PublicClient:     publicClient,
-		MetricsClient:    s.metricsClient,
-		Logger:           s.logger,
-		HistoryManager:   historyManager,
-		HistoryV2Manager: historyV2Manager,
-		DomainCache:      domainCache,
-		Config:           s.config.ArchiverConfig,
-		ArchiverProvider: archiverProvider,
+		PublicClient:      publicClient,
+		MetricsClient:     s.metricsClient,
+		Logger:            s.logger,
+		HistoryManager:    historyManager,
+		HistoryV2Manager:  historyV2Manager,
+		VisibilityManager: visibilityManager,
+		DomainCache:       domainCache,
+		Config:            s.config.ArchiverConfig,
+		ArchiverProvider:  archiverProvider,
 	}
 	clientWorker := archiver.NewClientWorker(bc)
 	if err := clientWorker.Start(); err != nil {
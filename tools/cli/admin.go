@@ -20,7 +20,10 @@
 
 package cli
 
-import "github.com/urfave/cli"
+import (
+	"github.com/uber/cadence/common/service/config"
+	"github.com/urfave/cli"
+)
 
 func newAdminWorkflowCommands() []cli.Command {
 	return []cli.Command{
@@ -151,6 +154,108 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:    "purge",
+			Aliases: []string{"pg"},
+			Usage:   "Delete the mutableState, current execution, history and visibility records of a workflow execution through the admin API",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for deleting the workflow execution, recorded in the server logs",
+				},
+				cli.BoolFlag{
+					Name:  FlagForceWithAlias,
+					Usage: "Delete running or corrupted workflow executions and skip errors of individual deletion steps",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPurgeWorkflow(c)
+			},
+		},
+		{
+			Name:    "reindex",
+			Aliases: []string{"ri"},
+			Usage:   "Re-send the start fields, memo and search attributes of running workflow executions to visibility",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run is reindexed if not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagOpenWithAlias,
+					Usage: "Reindex all open workflow executions of the domain instead of a single one",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 1000,
+					Usage: "Page size used to list open workflow executions",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 30,
+					Usage: "Reindex requests per second",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReindexWorkflow(c)
+			},
+		},
+		{
+			Name:    "protect",
+			Aliases: []string{"pt"},
+			Usage:   "Protect a running workflow execution from termination unless the termination is forced",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run is protected if not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagUnprotect,
+					Usage: "Remove the termination protection instead of setting it",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSetTerminationProtection(c)
+			},
+		},
+		{
+			Name:    "annotate",
+			Aliases: []string{"an"},
+			Usage:   "Record an operator note in the OperatorAnnotations memo field of a running workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagAnnotationMessageWithAlias,
+					Usage: "Note to record for the workflow execution",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAnnotateWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -192,6 +297,118 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminRemoveTask(c)
 			},
 		},
+		{
+			Name:    "describeQueue",
+			Aliases: []string{"dq"},
+			Usage:   "describe the ack levels of a queue of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardID for the cadence cluster to manage",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueType,
+					Usage: "queue type: TRANSFER, TIMER or REPLICATION",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeQueue(c)
+			},
+		},
+		{
+			Name:    "resetQueue",
+			Aliases: []string{"rq"},
+			Usage:   "reset the ack level of a queue of a shard, so that the tasks after it are processed again",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardID for the cadence cluster to manage",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueType,
+					Usage: "queue type: TRANSFER, TIMER or REPLICATION",
+				},
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "cluster of the ack level to reset, the ack level of the shard is reset if not provided",
+				},
+				cli.Int64Flag{
+					Name:  FlagAckLevel,
+					Usage: "new ack level, task ID for transfer and replication queues and unix nanoseconds for timer queue",
+				},
+				cli.BoolFlag{
+					Name:  FlagForceWithAlias,
+					Usage: "allow moving the ack level forward, which skips the tasks before the new ack level",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminResetQueue(c)
+			},
+		},
+		{
+			Name:    "reshard",
+			Aliases: []string{"rs"},
+			Usage:   "move executions into a keyspace for a different number of history shards, Cassandra only",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagNumberOfShards,
+					Usage: "NumberOfShards the executions are currently stored in(see config for numHistoryShards)",
+				},
+				cli.IntFlag{
+					Name:  FlagNewNumberOfShards,
+					Usage: "NumberOfShards to store the executions in",
+				},
+				cli.IntFlag{
+					Name:  FlagRangeSizeBits,
+					Value: 20,
+					Usage: "number of bits of the task ID sequence of a shard range, must match history config",
+				},
+				cli.BoolFlag{
+					Name: FlagOnline,
+					Usage: "move the executions left in the old layout of a running cluster which has the target keyspace " +
+						"as executions keyspace and the keyspace as resharding keyspace, instead of copying the executions of a stopped cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "name of the current cluster, required with --" + FlagOnline,
+				},
+				cli.StringFlag{
+					Name:  FlagDBEngine,
+					Value: config.StoreTypeCassandra,
+					Usage: "type of the persistence, only cassandra is supported",
+				},
+
+				// for cassandra connection
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "cassandra host address",
+				},
+				cli.IntFlag{
+					Name:  FlagPort,
+					Value: 9042,
+					Usage: "cassandra port for the host",
+				},
+				cli.StringFlag{
+					Name:  FlagUsername,
+					Usage: "cassandra username",
+				},
+				cli.StringFlag{
+					Name:  FlagPassword,
+					Usage: "cassandra password",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyspace,
+					Usage: "cassandra keyspace to copy executions from",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetKeyspace,
+					Usage: "cassandra keyspace to copy executions to, must have the schema set up and no shards unless --" + FlagOnline + " is set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReshard(c)
+			},
+		},
 	}
 }
 
@@ -241,6 +458,20 @@ func newAdminHistoryHostCommands() []cli.Command {
 				AdminGetShardID(c)
 			},
 		},
+		{
+			Name:    "distribution",
+			Aliases: []string{"dist"},
+			Usage:   "Describe how history shards are distributed across history hosts",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagPrintFullyDetailWithAlias,
+					Usage: "Print fully detail",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeShardDistribution(c)
+			},
+		},
 	}
 }
 
@@ -286,6 +517,56 @@ func newAdminDomainCommands() []cli.Command {
 				AdminGetDomainIDOrName(c)
 			},
 		},
+		{
+			Name:    "failover-readiness",
+			Aliases: []string{"fr"},
+			Usage:   "Check whether a domain is ready to fail over to the target cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "DomainName",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetCluster,
+					Usage: "Name of the cluster to fail over to",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCheckFailoverReadiness(c)
+			},
+		},
+		{
+			Name:    "undeprecate",
+			Aliases: []string{"undep"},
+			Usage:   "Revert the deprecation of a domain so that new workflow executions can be started in it",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "DomainName",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUndeprecateDomain(c)
+			},
+		},
+		{
+			Name:    "migrate",
+			Aliases: []string{"mig"},
+			Usage:   "Move a local domain and its open workflow executions to the target cluster, run it in the master cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "DomainName",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetCluster,
+					Usage: "Name of the cluster to move the domain to",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMigrateDomain(c)
+			},
+		},
 	}
 }
 
@@ -656,6 +937,20 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminDescribeTaskList(c)
 			},
 		},
+		{
+			Name:    "workers",
+			Aliases: []string{"w"},
+			Usage:   "List the workers of tasklist with their client versions and last poll times",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListWorkers(c)
+			},
+		},
 	}
 }
 
@@ -682,3 +977,38 @@ func newAdminClusterCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminConfigCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the dynamic config keys used by a frontend host with the values applied for the given domain, tasklist and shard",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagKeyPrefixWithAlias,
+					Usage: "Optional prefix of the dynamic config key names",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Optional TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "Optional ShardID",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeDynamicConfig(c)
+			},
+		},
+	}
+}
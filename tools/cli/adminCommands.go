@@ -31,10 +31,13 @@ import (
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
 	cassp "github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/tokenbucket"
 	"github.com/uber/cadence/tools/cassandra"
 	"github.com/urfave/cli"
 )
@@ -182,6 +185,164 @@ func describeMutableState(c *cli.Context) *admin.DescribeWorkflowExecutionRespon
 	return resp
 }
 
+// AdminAnnotateWorkflow records an operator note in the memo of a running workflow execution
+func AdminAnnotateWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	message := getRequiredOption(c, FlagAnnotationMessage)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.AddOperatorAnnotation(ctx, &shared.AddOperatorAnnotationRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Message:  common.StringPtr(message),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Annotate workflow failed", err)
+	}
+	fmt.Println("Annotation is added to workflow memo.")
+}
+
+// AdminPurgeWorkflow deletes a workflow execution through the admin API
+func AdminPurgeWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+	reason := getRequiredOption(c, FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.DeleteWorkflowExecution(ctx, &admin.DeleteWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		Force:    common.BoolPtr(c.Bool(FlagForce)),
+		Reason:   common.StringPtr(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Delete workflow execution failed", err)
+	}
+	fmt.Println("Workflow execution is deleted.")
+}
+
+// AdminSetTerminationProtection sets or removes the termination protection of a running workflow execution
+func AdminSetTerminationProtection(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	protected := !c.Bool(FlagUnprotect)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.SetTerminationProtection(ctx, &admin.SetTerminationProtectionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		TerminationProtected: common.BoolPtr(protected),
+		Identity:             common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Set termination protection failed", err)
+	}
+	if protected {
+		fmt.Println("Workflow execution is termination protected.")
+	} else {
+		fmt.Println("Workflow execution termination protection is removed.")
+	}
+}
+
+// AdminReindexWorkflow re-sends a running workflow execution, or all open workflow executions of a domain,
+// to visibility through the admin API
+func AdminReindexWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	reindex := func(execution *shared.WorkflowExecution) error {
+		ctx, cancel := newContext(c)
+		defer cancel()
+		return adminClient.ReindexWorkflowExecution(ctx, &admin.ReindexWorkflowExecutionRequest{
+			Domain:    common.StringPtr(domain),
+			Execution: execution,
+		})
+	}
+
+	if !c.Bool(FlagOpen) {
+		wid := getRequiredOption(c, FlagWorkflowID)
+		err := reindex(&shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(c.String(FlagRunID)),
+		})
+		if err != nil {
+			ErrorAndExit("Reindex workflow execution failed", err)
+		}
+		fmt.Println("Workflow execution is reindexed.")
+		return
+	}
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	ratelimiter := tokenbucket.New(c.Int(FlagRPS), clock.NewRealTimeSource())
+	reindexed, skipped := 0, 0
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContextForLongPoll(c)
+		resp, err := frontendClient.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(domain),
+			MaximumPageSize: common.Int32Ptr(int32(c.Int(FlagPageSize))),
+			NextPageToken:   nextPageToken,
+			StartTimeFilter: &shared.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+			},
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list open workflow executions", err)
+		}
+
+		for _, info := range resp.Executions {
+			if ok, waitTime := ratelimiter.TryConsume(1); !ok {
+				time.Sleep(waitTime)
+			}
+			if err := reindex(info.Execution); err != nil {
+				if _, ok := err.(*shared.EntityNotExistsError); ok {
+					// closed since listed, the close already updated visibility
+					skipped++
+					continue
+				}
+				ErrorAndExit(fmt.Sprintf("Reindex of workflow %v, run %v failed",
+					info.Execution.GetWorkflowId(), info.Execution.GetRunId()), err)
+			}
+			reindexed++
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	fmt.Printf("Reindexed %v open workflow executions, skipped %v closed ones.\n", reindexed, skipped)
+}
+
 // AdminDeleteWorkflow describe a new workflow execution for admin
 func AdminDeleteWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -282,6 +443,10 @@ func readOneRow(query *gocql.Query) (map[string]interface{}, error) {
 }
 
 func connectToCassandra(c *cli.Context) *gocql.Session {
+	return connectToCassandraKeyspace(c, getRequiredOption(c, FlagKeyspace))
+}
+
+func connectToCassandraKeyspace(c *cli.Context, ksp string) *gocql.Session {
 	host := getRequiredOption(c, FlagAddress)
 	if !c.IsSet(FlagPort) {
 		ErrorAndExit("port is required", nil)
@@ -289,7 +454,6 @@ func connectToCassandra(c *cli.Context) *gocql.Session {
 	port := c.Int(FlagPort)
 	user := c.String(FlagUsername)
 	pw := c.String(FlagPassword)
-	ksp := getRequiredOption(c, FlagKeyspace)
 
 	clusterCfg, err := cassandra.NewCassandraCluster(host, port, user, pw, ksp, 10)
 	clusterCfg.SerialConsistency = gocql.LocalSerial
@@ -362,6 +526,52 @@ func AdminGetShardID(c *cli.Context) {
 	fmt.Printf("ShardID for workflowID: %v is %v \n", wid, shardID)
 }
 
+// AdminReshard moves executions into a keyspace for a different number of history shards
+func AdminReshard(c *cli.Context) {
+	if engine := c.String(FlagDBEngine); engine != config.StoreTypeCassandra {
+		ErrorAndExit(fmt.Sprintf("Resharding is not supported for %v persistence, only cassandra executions can be resharded", engine), nil)
+	}
+	oldNumShards := getRequiredIntOption(c, FlagNumberOfShards)
+	newNumShards := getRequiredIntOption(c, FlagNewNumberOfShards)
+	if oldNumShards <= 0 || newNumShards <= 0 {
+		ErrorAndExit("numberOfShards must be positive", nil)
+	}
+	rangeSizeBits := c.Int(FlagRangeSizeBits)
+	if rangeSizeBits <= 0 {
+		ErrorAndExit("rangeSizeBits must be positive", nil)
+	}
+	sourceKeyspace := getRequiredOption(c, FlagKeyspace)
+	targetKeyspace := getRequiredOption(c, FlagTargetKeyspace)
+	if sourceKeyspace == targetKeyspace {
+		ErrorAndExit("target keyspace must be different from the source keyspace", nil)
+	}
+
+	online := c.Bool(FlagOnline)
+	var clusterName string
+	if online {
+		clusterName = getRequiredOption(c, FlagCluster)
+	}
+
+	source := connectToCassandraKeyspace(c, sourceKeyspace)
+	defer source.Close()
+	target := connectToCassandraKeyspace(c, targetKeyspace)
+	defer target.Close()
+
+	logger := loggerimpl.NewNopLogger()
+	resharder := cassp.NewResharder(source, target, oldNumShards, newNumShards, uint(rangeSizeBits), logger)
+	if online {
+		if err := resharder.ReshardOnline(clusterName); err != nil {
+			ErrorAndExit("Reshard has failed", err)
+		}
+		fmt.Printf("Moved executions of %v shards into %v shards of keyspace %v\n", oldNumShards, newNumShards, targetKeyspace)
+		return
+	}
+	if err := resharder.Reshard(); err != nil {
+		ErrorAndExit("Reshard has failed", err)
+	}
+	fmt.Printf("Copied executions of %v shards into %v shards of keyspace %v\n", oldNumShards, newNumShards, targetKeyspace)
+}
+
 // AdminRemoveTask describes history host
 func AdminRemoveTask(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -402,6 +612,58 @@ func AdminShardManagement(c *cli.Context) {
 	}
 }
 
+// AdminDescribeQueue describes the ack levels of a queue of a shard
+func AdminDescribeQueue(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sid := getRequiredIntOption(c, FlagShardID)
+	queueType := getRequiredQueueType(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeQueue(ctx, &shared.DescribeQueueRequest{
+		ShardID: common.Int32Ptr(int32(sid)),
+		Type:    queueType.Ptr(),
+	})
+	if err != nil {
+		ErrorAndExit("Describe queue has failed", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// AdminResetQueue resets the ack level of a queue of a shard
+func AdminResetQueue(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sid := getRequiredIntOption(c, FlagShardID)
+	queueType := getRequiredQueueType(c)
+	ackLevel := getRequiredInt64Option(c, FlagAckLevel)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &shared.ResetQueueStateRequest{
+		ShardID:  common.Int32Ptr(int32(sid)),
+		Type:     queueType.Ptr(),
+		AckLevel: common.Int64Ptr(ackLevel),
+		Force:    common.BoolPtr(c.Bool(FlagForce)),
+	}
+	if c.IsSet(FlagCluster) {
+		req.ClusterName = common.StringPtr(c.String(FlagCluster))
+	}
+	err := adminClient.ResetQueueState(ctx, req)
+	if err != nil {
+		ErrorAndExit("Reset queue has failed", err)
+	}
+}
+
+func getRequiredQueueType(c *cli.Context) shared.QueueType {
+	var queueType shared.QueueType
+	if err := queueType.UnmarshalText([]byte(getRequiredOption(c, FlagQueueType))); err != nil {
+		ErrorAndExit("Invalid queue type", err)
+	}
+	return queueType
+}
+
 // AdminDescribeHistoryHost describes history host
 func AdminDescribeHistoryHost(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
@@ -437,6 +699,28 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 
 	if !printFully {
 		resp.ShardIDs = nil
+		resp.Shards = nil
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// AdminDescribeShardDistribution describes the distribution of history shards across history hosts
+func AdminDescribeShardDistribution(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	printFully := c.Bool(FlagPrintFullyDetail)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeShardDistribution(ctx, &admin.DescribeShardDistributionRequest{})
+	if err != nil {
+		ErrorAndExit("Describe shard distribution failed", err)
+	}
+
+	if !printFully {
+		for _, host := range resp.Hosts {
+			host.ShardIDs = nil
+		}
 	}
 	prettyPrintJSONObject(resp)
 }
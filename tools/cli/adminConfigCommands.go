@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminDescribeDynamicConfig displays the dynamic config keys used by a frontend host with their applied values
+func AdminDescribeDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	request := &admin.DescribeDynamicConfigRequest{
+		KeyPrefix: common.StringPtr(c.String(FlagKeyPrefix)),
+	}
+	if domain := c.GlobalString(FlagDomain); domain != "" {
+		request.Domain = common.StringPtr(domain)
+	}
+	if c.IsSet(FlagTaskList) {
+		request.TaskList = common.StringPtr(c.String(FlagTaskList))
+	}
+	if c.IsSet(FlagTaskListType) {
+		switch strings.ToLower(c.String(FlagTaskListType)) {
+		case "decision":
+			request.TaskType = s.TaskListTypeDecision.Ptr()
+		case "activity":
+			request.TaskType = s.TaskListTypeActivity.Ptr()
+		default:
+			ErrorAndExit("Unknown TaskList type.", nil)
+		}
+	}
+	if c.IsSet(FlagShardID) {
+		request.ShardID = common.Int32Ptr(int32(c.Int(FlagShardID)))
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeDynamicConfig(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DescribeDynamicConfig failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Name", "Type", "Filters", "Default Value", "Value", "Overridden", "Overrides"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.SetAutoWrapText(false)
+	for _, entry := range response.Entries {
+		table.Append([]string{
+			entry.GetName(),
+			entry.GetValueType(),
+			strings.Join(entry.Filters, ","),
+			entry.GetDefaultValue(),
+			entry.GetValue(),
+			strconv.FormatBool(entry.GetIsOverridden()),
+			formatDynamicConfigOverrides(entry.Overrides),
+		})
+	}
+	table.Render()
+}
+
+// formatDynamicConfigOverrides prints one override per line, e.g. "100 {domainName:samples-domain}"
+func formatDynamicConfigOverrides(overrides []*admin.DynamicConfigOverride) string {
+	lines := make([]string, 0, len(overrides))
+	for _, override := range overrides {
+		constraints := make([]string, 0, len(override.Constraints))
+		for name, value := range override.Constraints {
+			constraints = append(constraints, name+":"+value)
+		}
+		sort.Strings(constraints)
+		lines = append(lines, fmt.Sprintf("%v {%v}", override.GetValue(), strings.Join(constraints, ",")))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminCheckFailoverReadiness checks whether a domain is ready to fail over to the target cluster
+func AdminCheckFailoverReadiness(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.CheckFailoverReadiness(ctx, &admin.CheckFailoverReadinessRequest{
+		Domain:        &domain,
+		TargetCluster: &targetCluster,
+	})
+	if err != nil {
+		ErrorAndExit("Check failover readiness failed.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Check", "Status", "Details"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, check := range resp.Checks {
+		table.Append([]string{check.GetName(), check.GetStatus().String(), check.GetDetails()})
+	}
+	table.Render()
+
+	if resp.GetReady() {
+		fmt.Printf("Domain %v is ready to fail over to %v\n", domain, targetCluster)
+	} else {
+		fmt.Println(colorRed(fmt.Sprintf("Domain %v is NOT ready to fail over to %v", domain, targetCluster)))
+	}
+}
+
+// AdminUndeprecateDomain reverts the deprecation of a domain
+func AdminUndeprecateDomain(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.UndeprecateDomain(ctx, &admin.UndeprecateDomainRequest{
+		Name: &domain,
+	})
+	if err != nil {
+		ErrorAndExit("Undeprecate domain failed.", err)
+	}
+	fmt.Printf("Domain %v is undeprecated.\n", domain)
+}
+
+// AdminMigrateDomain starts the migration of a local domain to the target cluster
+func AdminMigrateDomain(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.MigrateDomain(ctx, &admin.MigrateDomainRequest{
+		Domain:        &domain,
+		TargetCluster: &targetCluster,
+		Identity:      common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Migrate domain failed.", err)
+	}
+	fmt.Printf("Migration of domain %v to %v started, workflowID: %v, runID: %v\n", domain, targetCluster, resp.GetWorkflowId(), resp.GetRunId())
+}
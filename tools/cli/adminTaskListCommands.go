@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
@@ -103,3 +104,48 @@ func printPollerInfo(pollers []*s.PollerInfo, taskListType s.TaskListType) {
 	}
 	table.Render()
 }
+
+// AdminListWorkers displays the workers of the decision and activity task lists of the given name
+func AdminListWorkers(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.ListWorkers(ctx, &admin.ListWorkersRequest{
+		Domain:   common.StringPtr(domain),
+		TaskList: &s.TaskList{Name: common.StringPtr(taskList)},
+	})
+	if err != nil {
+		ErrorAndExit("Operation ListWorkers failed.", err)
+	}
+
+	workers := response.Workers
+	if len(workers) == 0 {
+		ErrorAndExit(colorMagenta("No worker for tasklist: "+taskList), nil)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Identity", "Type", "Client", "Library Version", "Feature Version", "Last Poll Time", "Stale"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, worker := range workers {
+		table.Append([]string{
+			worker.GetIdentity(),
+			worker.GetTaskListType().String(),
+			worker.GetClientImpl(),
+			worker.GetClientLibraryVersion(),
+			worker.GetClientFeatureVersion(),
+			convertTime(worker.GetLastPollTime(), false),
+			strconv.FormatBool(worker.GetStale()),
+		})
+	}
+	table.Render()
+
+	if response.GetOnlyStaleWorkers() {
+		fmt.Println(colorRed("All workers of the tasklist are stale."))
+	}
+}
@@ -59,6 +59,11 @@ func NewCliApp() *cli.App {
 			Usage:  "optional timeout for context of RPC call in seconds",
 			EnvVar: "CADENCE_CONTEXT_TIMEOUT",
 		},
+		cli.StringFlag{
+			Name:   FlagCompression,
+			Usage:  "optional compression codec requested for responses, gzip or snappy",
+			EnvVar: "CADENCE_CLI_COMPRESSION",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -132,6 +137,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on cluster",
 					Subcommands: newAdminClusterCommands(),
 				},
+				{
+					Name:        "config",
+					Aliases:     []string{"conf"},
+					Usage:       "Run admin operation on dynamic config",
+					Subcommands: newAdminConfigCommands(),
+				},
 			},
 		},
 		{
@@ -140,6 +151,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence cluster",
 			Subcommands: newClusterCommands(),
 		},
+		{
+			Name:        "bench",
+			Aliases:     []string{"b"},
+			Usage:       "Run synthetic load against a cluster or its persistence layer",
+			Subcommands: newBenchCommands(),
+		},
 	}
 
 	// set builder if not customized
@@ -21,6 +21,8 @@
 package cli
 
 import (
+	"bytes"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -210,6 +212,20 @@ func (s *cliAppSuite) TestDomainDescribe() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainDescribe_GlobalDomain() {
+	resp := &serverShared.DescribeDomainResponse{
+		DomainInfo:               describeDomainResponseServer.DomainInfo,
+		Configuration:            describeDomainResponseServer.Configuration,
+		ReplicationConfiguration: describeDomainResponseServer.ReplicationConfiguration,
+		IsGlobalDomain:           common.BoolPtr(true),
+		FailoverVersion:          common.Int64Ptr(11),
+		PreviousFailoverVersion:  common.Int64Ptr(10),
+	}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "describe"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDomainDescribe_DomainNotExist() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, &shared.EntityNotExistsError{})
@@ -517,6 +533,34 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminDescribeDynamicConfig() {
+	resp := &admin.DescribeDynamicConfigResponse{
+		Entries: []*admin.DynamicConfigEntry{
+			{
+				Name:         common.StringPtr("frontend.domainrps"),
+				ValueType:    common.StringPtr("int"),
+				DefaultValue: common.StringPtr("1200"),
+				Value:        common.StringPtr("100"),
+				IsOverridden: common.BoolPtr(true),
+				Filters:      []string{"domainName"},
+				Overrides: []*admin.DynamicConfigOverride{
+					{Value: common.StringPtr("100"), Constraints: map[string]string{"domainName": domainName}},
+				},
+			},
+		},
+	}
+	request := &admin.DescribeDynamicConfigRequest{
+		KeyPrefix: common.StringPtr("frontend."),
+		Domain:    common.StringPtr(domainName),
+		TaskType:  serverShared.TaskListTypeActivity.Ptr(),
+	}
+	s.serverAdminClient.EXPECT().DescribeDynamicConfig(gomock.Any(), request).Return(resp, nil).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "conf", "desc", "--kp", "frontend.", "--tlt", "activity"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "conf", "desc", "--kp", "frontend.", "--tlt", "activity", "--pjson"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -752,3 +796,26 @@ func (s *cliAppSuite) TestConvertArray() {
 		s.Nil(res)
 	}
 }
+
+func (s *cliAppSuite) TestBenchStats() {
+	stats := newBenchStats()
+	stats.record(benchOpStartWorkflow, 1, 10*time.Millisecond, nil)
+	stats.record(benchOpStartWorkflow, 1, 30*time.Millisecond, nil)
+	stats.record(benchOpStartWorkflow, 2, 20*time.Millisecond, nil)
+	stats.record(benchOpStartWorkflow, 3, 0, errors.New("start failed"))
+	stats.record(benchOpRespondDecision, -1, 5*time.Millisecond, nil)
+
+	s.Equal(int64(1), stats.ops[benchOpStartWorkflow].errors)
+	s.Equal(map[int]int64{1: 2, 2: 1}, stats.shards)
+
+	var out bytes.Buffer
+	stats.print(&out, time.Second)
+	s.Contains(out.String(), benchOpStartWorkflow)
+	s.Contains(out.String(), benchOpRespondDecision)
+	s.Contains(out.String(), "Shards: 2, operations per shard: min 1, avg 1.5, max 2")
+
+	latencies := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond}
+	s.Equal(20*time.Millisecond, benchPercentile(latencies, 50))
+	s.Equal(30*time.Millisecond, benchPercentile(latencies, 99))
+	s.Equal(time.Duration(0), benchPercentile(nil, 50))
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newBenchCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "workflow",
+			Aliases: []string{"wf"},
+			Usage:   "start workflows with activities through the frontend and complete them with built-in pollers",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagWorkflows,
+					Value: 1000,
+					Usage: "number of workflows to start",
+				},
+				cli.IntFlag{
+					Name:  FlagActivities,
+					Value: 1,
+					Usage: "number of sequential activities executed by each workflow",
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Value: 10,
+					Usage: "number of concurrent starters, decision pollers and activity pollers",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "max workflow starts per second",
+				},
+				cli.IntFlag{
+					Name:  FlagNumberOfShards,
+					Usage: "NumberOfShards of the cluster (see config for numHistoryShards), used to report shard level throughput",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskList,
+					Usage: "TaskList of the benchmark workflows, a random one is used if not provided",
+				},
+			},
+			Action: func(c *cli.Context) {
+				BenchWorkflow(c)
+			},
+		},
+		{
+			Name:    "persistence",
+			Aliases: []string{"p"},
+			Usage:   "write synthetic workflow executions and histories directly into a cassandra keyspace or a mysql database, which must be dedicated to the benchmark",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagWorkflows,
+					Value: 1000,
+					Usage: "number of workflow executions to write",
+				},
+				cli.IntFlag{
+					Name:  FlagActivities,
+					Value: 1,
+					Usage: "number of activities of each workflow execution, each one is a history append and a mutable state update",
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Value: 10,
+					Usage: "number of concurrent workflow executions being written",
+				},
+				cli.IntFlag{
+					Name:  FlagNumberOfShards,
+					Usage: "NumberOfShards to distribute the workflow executions over (see config for numHistoryShards)",
+				},
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Value: "active",
+					Usage: "current cluster name written into the shard records",
+				},
+
+				// for persistence connection
+				cli.StringFlag{
+					Name:  FlagDBEngine,
+					Value: benchDBEngineCassandra,
+					Usage: "type of the persistence, cassandra or mysql",
+				},
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "persistence host address",
+				},
+				cli.IntFlag{
+					Name:  FlagPort,
+					Usage: "persistence port for the host, e.g. 9042 for cassandra or 3306 for mysql",
+				},
+				cli.StringFlag{
+					Name:  FlagUsername,
+					Usage: "persistence username",
+				},
+				cli.StringFlag{
+					Name:  FlagPassword,
+					Usage: "persistence password",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyspace,
+					Usage: "cassandra keyspace, must not be used by a cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagDatabaseName,
+					Usage: "mysql database, must not be used by a cluster",
+				},
+			},
+			Action: func(c *cli.Context) {
+				BenchPersistence(c)
+			},
+		},
+	}
+}
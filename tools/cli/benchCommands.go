@@ -0,0 +1,726 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pborman/uuid"
+	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	cassp "github.com/uber/cadence/common/persistence/cassandra"
+	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/tokenbucket"
+	"github.com/urfave/cli"
+)
+
+const (
+	benchWorkflowType     = "cadence-bench-workflow"
+	benchActivityType     = "cadence-bench-activity"
+	benchWorkflowTimeout  = 10 * time.Minute
+	benchDecisionTimeout  = 10 * time.Second
+	benchIdleTimeout      = time.Minute
+	benchHottestShardsMax = 10
+
+	benchOpStartWorkflow           = "StartWorkflowExecution"
+	benchOpRespondDecision         = "RespondDecisionTaskCompleted"
+	benchOpRespondActivity         = "RespondActivityTaskCompleted"
+	benchOpWorkflowExecution       = "WorkflowExecution"
+	benchOpAcquireShard            = "AcquireShard"
+	benchOpAppendHistoryNodes      = "AppendHistoryNodes"
+	benchOpCreateWorkflowExecution = "CreateWorkflowExecution"
+	benchOpGetWorkflowExecution    = "GetWorkflowExecution"
+	benchOpUpdateWorkflowExecution = "UpdateWorkflowExecution"
+
+	benchDBEngineCassandra = "cassandra"
+	benchDBEngineMySQL     = "mysql"
+)
+
+type (
+	// benchStats collects the latencies of the benchmarked operations and the number of operations per shard
+	benchStats struct {
+		sync.Mutex
+		ops    map[string]*benchOpStats
+		shards map[int]int64
+	}
+
+	benchOpStats struct {
+		latencies []time.Duration
+		errors    int64
+	}
+
+	workflowBench struct {
+		c             *cli.Context
+		client        serverFrontend.Interface
+		domain        string
+		taskList      string
+		numWorkflows  int
+		numActivities int
+		concurrency   int
+		numShards     int
+		rateLimiter   tokenbucket.TokenBucket
+		stats         *benchStats
+
+		nextWorkflow int64
+		started      int64
+		completed    int64
+		startTimes   sync.Map // workflowID -> time.Time
+	}
+
+	persistenceBench struct {
+		factory        pfactory.DataStoreFactory
+		shardManager   persistence.ShardManager
+		historyManager persistence.HistoryV2Manager
+		clusterName    string
+		domainID       string
+		numWorkflows   int
+		numActivities  int
+		concurrency    int
+		numShards      int
+		stats          *benchStats
+
+		nextWorkflow  int64
+		transactionID int64
+
+		sync.Mutex
+		shards map[int]*benchShard
+	}
+
+	benchShard struct {
+		rangeID          int64
+		executionManager persistence.ExecutionManager
+	}
+)
+
+// BenchWorkflow drives workflows with activities through the frontend and reports the latency of each operation
+func BenchWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	numWorkflows := c.Int(FlagWorkflows)
+	numActivities := c.Int(FlagActivities)
+	concurrency := c.Int(FlagConcurrency)
+	if numWorkflows <= 0 || numActivities < 0 || concurrency <= 0 {
+		ErrorAndExit("workflows and concurrency must be positive and activities must not be negative", nil)
+	}
+	taskList := c.String(FlagTaskList)
+	if taskList == "" {
+		taskList = "cadence-bench-" + uuid.New()
+	}
+
+	bench := &workflowBench{
+		c:             c,
+		client:        cFactory.ServerFrontendClient(c),
+		domain:        domain,
+		taskList:      taskList,
+		numWorkflows:  numWorkflows,
+		numActivities: numActivities,
+		concurrency:   concurrency,
+		numShards:     c.Int(FlagNumberOfShards),
+		rateLimiter:   tokenbucket.New(c.Int(FlagRPS), clock.NewRealTimeSource()),
+		stats:         newBenchStats(),
+	}
+
+	startTime := time.Now()
+	if !bench.run() {
+		fmt.Println(colorRed(fmt.Sprintf("Stopped after no workflow completed for %v", benchIdleTimeout)))
+	}
+	fmt.Printf("Completed %v of %v started workflows on tasklist %v\n",
+		atomic.LoadInt64(&bench.completed), atomic.LoadInt64(&bench.started), taskList)
+	bench.stats.print(os.Stdout, time.Since(startTime))
+}
+
+// BenchPersistence writes synthetic workflow executions and histories into cassandra or mysql and reports the latency of each operation
+func BenchPersistence(c *cli.Context) {
+	numWorkflows := c.Int(FlagWorkflows)
+	numActivities := c.Int(FlagActivities)
+	concurrency := c.Int(FlagConcurrency)
+	numShards := getRequiredIntOption(c, FlagNumberOfShards)
+	if numWorkflows <= 0 || numActivities < 0 || concurrency <= 0 || numShards <= 0 {
+		ErrorAndExit("workflows, concurrency and numberOfShards must be positive and activities must not be negative", nil)
+	}
+	if !c.IsSet(FlagPort) {
+		ErrorAndExit("port is required", nil)
+	}
+
+	logger := loggerimpl.NewNopLogger()
+	factory := newBenchDataStoreFactory(c, logger)
+	defer factory.Close()
+
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		ErrorAndExit("connect to persistence failed", err)
+	}
+	historyStore, err := factory.NewHistoryV2Store()
+	if err != nil {
+		ErrorAndExit("connect to persistence failed", err)
+	}
+
+	bench := &persistenceBench{
+		factory:        factory,
+		shardManager:   shardStore,
+		historyManager: persistence.NewHistoryV2ManagerImpl(historyStore, logger, dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit)),
+		clusterName:    c.String(FlagCluster),
+		domainID:       uuid.New(),
+		numWorkflows:   numWorkflows,
+		numActivities:  numActivities,
+		concurrency:    concurrency,
+		numShards:      numShards,
+		stats:          newBenchStats(),
+		transactionID:  time.Now().UnixNano(),
+		shards:         make(map[int]*benchShard),
+	}
+
+	startTime := time.Now()
+	bench.run()
+	fmt.Printf("Wrote %v workflow executions with %v activities each into %v shards\n", numWorkflows, numActivities, numShards)
+	bench.stats.print(os.Stdout, time.Since(startTime))
+}
+
+func newBenchDataStoreFactory(c *cli.Context, logger log.Logger) pfactory.DataStoreFactory {
+	switch engine := c.String(FlagDBEngine); engine {
+	case benchDBEngineCassandra:
+		return cassp.NewFactory(config.Cassandra{
+			Hosts:    getRequiredOption(c, FlagAddress),
+			Port:     c.Int(FlagPort),
+			User:     c.String(FlagUsername),
+			Password: c.String(FlagPassword),
+			Keyspace: getRequiredOption(c, FlagKeyspace),
+		}, c.String(FlagCluster), logger)
+	case benchDBEngineMySQL:
+		return sql.NewFactory(config.SQL{
+			User:            c.String(FlagUsername),
+			Password:        c.String(FlagPassword),
+			DriverName:      benchDBEngineMySQL,
+			DatabaseName:    getRequiredOption(c, FlagDatabaseName),
+			ConnectAddr:     fmt.Sprintf("%v:%v", getRequiredOption(c, FlagAddress), c.Int(FlagPort)),
+			ConnectProtocol: "tcp",
+			NumShards:       1,
+		}, c.String(FlagCluster), logger)
+	default:
+		ErrorAndExit(fmt.Sprintf("unknown db engine %v, must be %v or %v", engine, benchDBEngineCassandra, benchDBEngineMySQL), nil)
+		return nil
+	}
+}
+
+func (b *workflowBench) run() bool {
+	done := make(chan struct{})
+	defer close(done)
+	for i := 0; i < b.concurrency; i++ {
+		go b.pollDecisionTasks(done)
+		go b.pollActivityTasks(done)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < b.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.startWorkflows()
+		}()
+	}
+	wg.Wait()
+
+	lastCompleted := int64(-1)
+	lastProgress := time.Now()
+	for {
+		completed := atomic.LoadInt64(&b.completed)
+		if completed >= atomic.LoadInt64(&b.started) {
+			return true
+		}
+		if completed != lastCompleted {
+			lastCompleted = completed
+			lastProgress = time.Now()
+		} else if time.Since(lastProgress) > benchIdleTimeout {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (b *workflowBench) startWorkflows() {
+	for atomic.AddInt64(&b.nextWorkflow, 1) <= int64(b.numWorkflows) {
+		for ok, waitTime := b.rateLimiter.TryConsume(1); !ok; ok, waitTime = b.rateLimiter.TryConsume(1) {
+			time.Sleep(waitTime)
+		}
+
+		workflowID := "cadence-bench-" + uuid.New()
+		ctx, cancel := newContext(b.c)
+		startTime := time.Now()
+		_, err := b.client.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(b.domain),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr(benchWorkflowType)},
+			TaskList:                            &shared.TaskList{Name: common.StringPtr(b.taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(benchWorkflowTimeout.Seconds())),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(benchDecisionTimeout.Seconds())),
+			Identity:                            common.StringPtr(getCliIdentity()),
+			RequestId:                           common.StringPtr(uuid.New()),
+		})
+		cancel()
+		b.stats.record(benchOpStartWorkflow, b.shardID(workflowID), time.Since(startTime), err)
+		if err == nil {
+			b.startTimes.Store(workflowID, startTime)
+			atomic.AddInt64(&b.started, 1)
+		}
+	}
+}
+
+func (b *workflowBench) pollDecisionTasks(done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		ctx, cancel := newContextForLongPoll(b.c)
+		task, err := b.client.PollForDecisionTask(ctx, &shared.PollForDecisionTaskRequest{
+			Domain:   common.StringPtr(b.domain),
+			TaskList: &shared.TaskList{Name: common.StringPtr(b.taskList)},
+			Identity: common.StringPtr(getCliIdentity()),
+		})
+		cancel()
+		if err != nil || len(task.TaskToken) == 0 {
+			continue
+		}
+
+		completedActivities := 0
+		for _, event := range task.History.GetEvents() {
+			if event.GetEventType() == shared.EventTypeActivityTaskCompleted {
+				completedActivities++
+			}
+		}
+		closeWorkflow := completedActivities >= b.numActivities
+		decision := &shared.Decision{
+			DecisionType: shared.DecisionTypeCompleteWorkflowExecution.Ptr(),
+			CompleteWorkflowExecutionDecisionAttributes: &shared.CompleteWorkflowExecutionDecisionAttributes{},
+		}
+		if !closeWorkflow {
+			decision = &shared.Decision{
+				DecisionType: shared.DecisionTypeScheduleActivityTask.Ptr(),
+				ScheduleActivityTaskDecisionAttributes: &shared.ScheduleActivityTaskDecisionAttributes{
+					ActivityId:                    common.StringPtr(strconv.Itoa(completedActivities)),
+					ActivityType:                  &shared.ActivityType{Name: common.StringPtr(benchActivityType)},
+					TaskList:                      &shared.TaskList{Name: common.StringPtr(b.taskList)},
+					ScheduleToCloseTimeoutSeconds: common.Int32Ptr(int32(benchWorkflowTimeout.Seconds())),
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(int32(benchWorkflowTimeout.Seconds())),
+					StartToCloseTimeoutSeconds:    common.Int32Ptr(int32(benchWorkflowTimeout.Seconds())),
+				},
+			}
+		}
+
+		workflowID := task.WorkflowExecution.GetWorkflowId()
+		ctx, cancel = newContext(b.c)
+		respondTime := time.Now()
+		_, err = b.client.RespondDecisionTaskCompleted(ctx, &shared.RespondDecisionTaskCompletedRequest{
+			TaskToken: task.TaskToken,
+			Decisions: []*shared.Decision{decision},
+			Identity:  common.StringPtr(getCliIdentity()),
+		})
+		cancel()
+		b.stats.record(benchOpRespondDecision, b.shardID(workflowID), time.Since(respondTime), err)
+		if err == nil && closeWorkflow {
+			if startTime, ok := b.startTimes.Load(workflowID); ok {
+				b.stats.record(benchOpWorkflowExecution, b.shardID(workflowID), time.Since(startTime.(time.Time)), nil)
+			}
+			atomic.AddInt64(&b.completed, 1)
+		}
+	}
+}
+
+func (b *workflowBench) pollActivityTasks(done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		ctx, cancel := newContextForLongPoll(b.c)
+		task, err := b.client.PollForActivityTask(ctx, &shared.PollForActivityTaskRequest{
+			Domain:   common.StringPtr(b.domain),
+			TaskList: &shared.TaskList{Name: common.StringPtr(b.taskList)},
+			Identity: common.StringPtr(getCliIdentity()),
+		})
+		cancel()
+		if err != nil || len(task.TaskToken) == 0 {
+			continue
+		}
+
+		ctx, cancel = newContext(b.c)
+		respondTime := time.Now()
+		err = b.client.RespondActivityTaskCompleted(ctx, &shared.RespondActivityTaskCompletedRequest{
+			TaskToken: task.TaskToken,
+			Identity:  common.StringPtr(getCliIdentity()),
+		})
+		cancel()
+		b.stats.record(benchOpRespondActivity, b.shardID(task.WorkflowExecution.GetWorkflowId()), time.Since(respondTime), err)
+	}
+}
+
+// shardID returns the history shard of the workflow, or -1 if the number of shards is unknown
+func (b *workflowBench) shardID(workflowID string) int {
+	if b.numShards <= 0 {
+		return -1
+	}
+	return common.WorkflowIDToHistoryShard(workflowID, b.numShards)
+}
+
+func (b *persistenceBench) run() {
+	var wg sync.WaitGroup
+	for i := 0; i < b.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.AddInt64(&b.nextWorkflow, 1) <= int64(b.numWorkflows) {
+				// errors are recorded in the stats, move on to the next workflow
+				b.writeWorkflow("cadence-bench-" + uuid.New())
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// writeWorkflow writes the history and mutable state of a workflow executing the configured
+// number of activities, in the same sequence of writes the history service would issue
+func (b *persistenceBench) writeWorkflow(workflowID string) {
+	shardID := common.WorkflowIDToHistoryShard(workflowID, b.numShards)
+	shard, err := b.getShard(shardID)
+	if err != nil {
+		return
+	}
+
+	runID := uuid.New()
+	branchToken, err := persistence.NewHistoryBranchToken(runID)
+	if err != nil {
+		ErrorAndExit("NewHistoryBranchToken err", err)
+	}
+	info := &persistence.WorkflowExecutionInfo{
+		CreateRequestID:      uuid.New(),
+		DomainID:             b.domainID,
+		WorkflowID:           workflowID,
+		RunID:                runID,
+		TaskList:             benchWorkflowType,
+		WorkflowTypeName:     benchWorkflowType,
+		WorkflowTimeout:      int32(benchWorkflowTimeout.Seconds()),
+		DecisionTimeoutValue: int32(benchDecisionTimeout.Seconds()),
+		State:                persistence.WorkflowStateRunning,
+		CloseStatus:          persistence.WorkflowCloseStatusNone,
+		LastFirstEventID:     common.FirstEventID,
+		NextEventID:          common.FirstEventID,
+		LastProcessedEvent:   common.EmptyEventID,
+		DecisionScheduleID:   common.EmptyEventID,
+		DecisionStartedID:    common.EmptyEventID,
+		StartTimestamp:       time.Now(),
+		LastUpdatedTimestamp: time.Now(),
+		EventStoreVersion:    persistence.EventStoreVersionV2,
+		BranchToken:          branchToken,
+	}
+
+	if err := b.appendHistory(shardID, info, true,
+		shared.EventTypeWorkflowExecutionStarted,
+		shared.EventTypeDecisionTaskScheduled,
+	); err != nil {
+		return
+	}
+	info.DecisionScheduleID = info.NextEventID - 1
+	startTime := time.Now()
+	_, err = shard.executionManager.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
+		RangeID:            shard.rangeID,
+		CreateWorkflowMode: persistence.CreateWorkflowModeBrandNew,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:  info,
+			ExecutionStats: &persistence.ExecutionStats{},
+		},
+	})
+	b.stats.record(benchOpCreateWorkflowExecution, shardID, time.Since(startTime), err)
+	if err != nil {
+		return
+	}
+
+	startTime = time.Now()
+	_, err = shard.executionManager.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		DomainID: b.domainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	})
+	b.stats.record(benchOpGetWorkflowExecution, shardID, time.Since(startTime), err)
+	if err != nil {
+		return
+	}
+
+	for i := 0; i < b.numActivities; i++ {
+		if err := b.appendHistory(shardID, info, false,
+			shared.EventTypeDecisionTaskStarted,
+			shared.EventTypeDecisionTaskCompleted,
+			shared.EventTypeActivityTaskScheduled,
+			shared.EventTypeActivityTaskStarted,
+			shared.EventTypeActivityTaskCompleted,
+			shared.EventTypeDecisionTaskScheduled,
+		); err != nil {
+			return
+		}
+		info.LastProcessedEvent = info.DecisionScheduleID + 2
+		info.DecisionScheduleID = info.NextEventID - 1
+		if err := b.updateWorkflow(shardID, shard, info); err != nil {
+			return
+		}
+	}
+
+	if err := b.appendHistory(shardID, info, false,
+		shared.EventTypeDecisionTaskStarted,
+		shared.EventTypeDecisionTaskCompleted,
+		shared.EventTypeWorkflowExecutionCompleted,
+	); err != nil {
+		return
+	}
+	info.LastProcessedEvent = info.DecisionScheduleID + 2
+	info.DecisionScheduleID = common.EmptyEventID
+	info.State = persistence.WorkflowStateCompleted
+	info.CloseStatus = persistence.WorkflowCloseStatusCompleted
+	b.updateWorkflow(shardID, shard, info)
+}
+
+// appendHistory appends a batch of events of the given types and advances the next event ID of the workflow
+func (b *persistenceBench) appendHistory(
+	shardID int,
+	info *persistence.WorkflowExecutionInfo,
+	isNewBranch bool,
+	eventTypes ...shared.EventType,
+) error {
+	events := make([]*shared.HistoryEvent, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		events = append(events, &shared.HistoryEvent{
+			EventId:   common.Int64Ptr(info.NextEventID + int64(len(events))),
+			EventType: eventType.Ptr(),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			Version:   common.Int64Ptr(common.EmptyVersion),
+		})
+	}
+
+	startTime := time.Now()
+	_, err := b.historyManager.AppendHistoryNodes(&persistence.AppendHistoryNodesRequest{
+		IsNewBranch:   isNewBranch,
+		Info:          persistence.BuildHistoryGarbageCleanupInfo(info.DomainID, info.WorkflowID, info.RunID),
+		BranchToken:   info.BranchToken,
+		Events:        events,
+		TransactionID: atomic.AddInt64(&b.transactionID, 1),
+		ShardID:       common.IntPtr(shardID),
+	})
+	b.stats.record(benchOpAppendHistoryNodes, shardID, time.Since(startTime), err)
+	if err != nil {
+		return err
+	}
+
+	info.LastFirstEventID = info.NextEventID
+	info.NextEventID += int64(len(events))
+	return nil
+}
+
+func (b *persistenceBench) updateWorkflow(
+	shardID int,
+	shard *benchShard,
+	info *persistence.WorkflowExecutionInfo,
+) error {
+	startTime := time.Now()
+	_, err := shard.executionManager.UpdateWorkflowExecution(&persistence.UpdateWorkflowExecutionRequest{
+		RangeID: shard.rangeID,
+		Mode:    persistence.UpdateWorkflowModeUpdateCurrent,
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:  info,
+			ExecutionStats: &persistence.ExecutionStats{},
+			Condition:      info.LastFirstEventID,
+		},
+	})
+	b.stats.record(benchOpUpdateWorkflowExecution, shardID, time.Since(startTime), err)
+	return err
+}
+
+// getShard creates the shard on first use, shards which already exist may be owned by
+// a running cluster so the benchmark stops instead of taking them over
+func (b *persistenceBench) getShard(shardID int) (*benchShard, error) {
+	b.Lock()
+	defer b.Unlock()
+
+	if shard, ok := b.shards[shardID]; ok {
+		return shard, nil
+	}
+
+	startTime := time.Now()
+	shardInfo := &persistence.ShardInfo{
+		ShardID:                 shardID,
+		Owner:                   getCliIdentity(),
+		RangeID:                 1,
+		ClusterTransferAckLevel: map[string]int64{b.clusterName: 0},
+		ClusterTimerAckLevel:    map[string]time.Time{b.clusterName: time.Time{}},
+	}
+	err := b.shardManager.CreateShard(&persistence.CreateShardRequest{ShardInfo: shardInfo})
+	if _, ok := err.(*persistence.ShardAlreadyExistError); ok {
+		ErrorAndExit(fmt.Sprintf("Shard %v already exists, the benchmark must write into a dedicated keyspace or database", shardID), err)
+	}
+	if err != nil {
+		b.stats.record(benchOpAcquireShard, shardID, time.Since(startTime), err)
+		return nil, err
+	}
+
+	store, err := b.factory.NewExecutionStore(shardID)
+	if err != nil {
+		ErrorAndExit("connect to persistence failed", err)
+	}
+	shard := &benchShard{
+		rangeID:          shardInfo.RangeID,
+		executionManager: persistence.NewExecutionManagerImpl(store, loggerimpl.NewNopLogger()),
+	}
+	b.shards[shardID] = shard
+	b.stats.record(benchOpAcquireShard, shardID, time.Since(startTime), nil)
+	return shard, nil
+}
+
+func newBenchStats() *benchStats {
+	return &benchStats{
+		ops:    make(map[string]*benchOpStats),
+		shards: make(map[int]int64),
+	}
+}
+
+// record adds the outcome of an operation, a negative shardID excludes it from the shard level report
+func (s *benchStats) record(op string, shardID int, latency time.Duration, err error) {
+	s.Lock()
+	defer s.Unlock()
+
+	opStats, ok := s.ops[op]
+	if !ok {
+		opStats = &benchOpStats{}
+		s.ops[op] = opStats
+	}
+	if err != nil {
+		opStats.errors++
+		return
+	}
+	opStats.latencies = append(opStats.latencies, latency)
+	if shardID >= 0 {
+		s.shards[shardID]++
+	}
+}
+
+func (s *benchStats) print(w io.Writer, elapsed time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+
+	seconds := elapsed.Seconds()
+	if seconds <= 0 {
+		seconds = 1
+	}
+
+	ops := make([]string, 0, len(s.ops))
+	for op := range s.ops {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	fmt.Fprintf(w, "Elapsed: %v\n", elapsed.Round(time.Millisecond))
+	table := tablewriter.NewWriter(w)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Operation", "Count", "Errors", "Per Second", "P50", "P90", "P99", "Max"})
+	table.SetHeaderLine(false)
+	for _, op := range ops {
+		opStats := s.ops[op]
+		latencies := opStats.latencies
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		table.Append([]string{
+			op,
+			strconv.Itoa(len(latencies)),
+			strconv.FormatInt(opStats.errors, 10),
+			fmt.Sprintf("%.1f", float64(len(latencies))/seconds),
+			benchPercentile(latencies, 50).String(),
+			benchPercentile(latencies, 90).String(),
+			benchPercentile(latencies, 99).String(),
+			benchPercentile(latencies, 100).String(),
+		})
+	}
+	table.Render()
+
+	if len(s.shards) == 0 {
+		return
+	}
+	shardIDs := make([]int, 0, len(s.shards))
+	total := int64(0)
+	for shardID, count := range s.shards {
+		shardIDs = append(shardIDs, shardID)
+		total += count
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		if s.shards[shardIDs[i]] != s.shards[shardIDs[j]] {
+			return s.shards[shardIDs[i]] > s.shards[shardIDs[j]]
+		}
+		return shardIDs[i] < shardIDs[j]
+	})
+	fmt.Fprintf(w, "Shards: %v, operations per shard: min %v, avg %.1f, max %v\n",
+		len(shardIDs),
+		s.shards[shardIDs[len(shardIDs)-1]],
+		float64(total)/float64(len(shardIDs)),
+		s.shards[shardIDs[0]],
+	)
+
+	table = tablewriter.NewWriter(w)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Shard", "Operations", "Per Second"})
+	table.SetHeaderLine(false)
+	for i := 0; i < len(shardIDs) && i < benchHottestShardsMax; i++ {
+		count := s.shards[shardIDs[i]]
+		table.Append([]string{
+			strconv.Itoa(shardIDs[i]),
+			strconv.FormatInt(count, 10),
+			fmt.Sprintf("%.1f", float64(count)/seconds),
+		})
+	}
+	table.Render()
+}
+
+func benchPercentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
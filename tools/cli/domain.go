@@ -152,10 +152,18 @@ func newDomainCommands() []cli.Command {
 					Name:  FlagActiveClusterNameWithAlias,
 					Usage: "Active cluster name",
 				},
+				cli.BoolFlag{
+					Name:  FlagGracefulFailoverWithAlias,
+					Usage: "Hand over the domain to the new active cluster once replication drains, writes are rejected until then",
+				},
 				cli.StringFlag{ // use StringFlag instead of buggy StringSliceFlag
 					Name:  FlagClustersWithAlias,
 					Usage: "Clusters",
 				},
+				cli.BoolFlag{
+					Name:  FlagPromoteGlobalDomain,
+					Usage: "Convert a local domain into a global domain replicated to the given clusters",
+				},
 				cli.StringFlag{
 					Name:  FlagDomainDataWithAlias,
 					Usage: "Domain data of key value pairs, in format of k1:v1,k2:v2,k3:v3 ",
@@ -189,6 +197,10 @@ func newDomainCommands() []cli.Command {
 					Name:  FlagRemoveBadBinary,
 					Usage: "Binary checksum to remove for resetting workflow",
 				},
+				cli.StringFlag{
+					Name:  FlagKnownTaskLists,
+					Usage: "Comma separated task lists known to the domain, replaces the current ones, empty value clears them",
+				},
 				cli.StringFlag{
 					Name:  FlagReason,
 					Usage: "Reason for the operation",
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
@@ -147,6 +148,10 @@ func UpdateDomain(c *cli.Context) {
 			Name:                     common.StringPtr(domain),
 			ReplicationConfiguration: replicationConfig,
 		}
+		if c.Bool(FlagGracefulFailover) {
+			fmt.Printf("Domain will be handed over to %s once replication drains.\n", activeCluster)
+			updateRequest.GracefulFailover = common.BoolPtr(true)
+		}
 	} else {
 		resp, err := frontendClient.DescribeDomain(ctx, &shared.DescribeDomainRequest{
 			Name: common.StringPtr(domain),
@@ -224,6 +229,16 @@ func UpdateDomain(c *cli.Context) {
 			badBinaryToDelete = common.StringPtr(c.String(FlagRemoveBadBinary))
 		}
 
+		var knownTaskLists []string
+		if c.IsSet(FlagKnownTaskLists) {
+			knownTaskLists = []string{}
+			for _, taskList := range strings.Split(c.String(FlagKnownTaskLists), ",") {
+				if taskList = strings.TrimSpace(taskList); taskList != "" {
+					knownTaskLists = append(knownTaskLists, taskList)
+				}
+			}
+		}
+
 		updateInfo := &shared.UpdateDomainInfo{
 			Description: common.StringPtr(description),
 			OwnerEmail:  common.StringPtr(ownerEmail),
@@ -237,6 +252,7 @@ func UpdateDomain(c *cli.Context) {
 			VisibilityArchivalStatus:               archivalStatus(c, FlagVisibilityArchivalStatus),
 			VisibilityArchivalURI:                  common.StringPtr(c.String(FlagVisibilityArchivalURI)),
 			BadBinaries:                            binBinaries,
+			KnownTaskLists:                         knownTaskLists,
 		}
 		replicationConfig := &shared.DomainReplicationConfiguration{
 			Clusters: clusters,
@@ -248,6 +264,9 @@ func UpdateDomain(c *cli.Context) {
 			ReplicationConfiguration: replicationConfig,
 			DeleteBadBinary:          badBinaryToDelete,
 		}
+		if c.Bool(FlagPromoteGlobalDomain) {
+			updateRequest.PromoteToGlobalDomain = common.BoolPtr(true)
+		}
 	}
 
 	securityToken := c.String(FlagSecurityToken)
@@ -305,6 +324,25 @@ func DescribeDomain(c *cli.Context) {
 		formatStr = formatStr + "HistoryArchivalURI: %v\n"
 		descValues = append(descValues, resp.Configuration.GetHistoryArchivalURI())
 	}
+	if len(resp.Configuration.KnownTaskLists) > 0 {
+		formatStr = formatStr + "KnownTaskLists: %v\n"
+		descValues = append(descValues, strings.Join(resp.Configuration.KnownTaskLists, ","))
+	}
+	if resp.GetIsGlobalDomain() {
+		formatStr = formatStr + "FailoverVersion: %v\nPreviousFailoverVersion: %v\nInitialFailoverVersions: %v\n"
+		descValues = append(descValues,
+			resp.GetFailoverVersion(),
+			resp.GetPreviousFailoverVersion(),
+			initialFailoverVersionsToString(resp.ReplicationConfiguration.Clusters),
+		)
+	}
+	if resp.ReplicationConfiguration.GetFailoverState() == shared.DomainFailoverStateHandover {
+		formatStr = formatStr + "FailoverState: %v\nPendingActiveClusterName: %v\n"
+		descValues = append(descValues,
+			resp.ReplicationConfiguration.GetFailoverState().String(),
+			resp.ReplicationConfiguration.GetPendingActiveClusterName(),
+		)
+	}
 	// TODO ycyang: uncomment once visibility archival is implemented
 	// formatStr = formatStr + "VisibilityArchivalStatus: %v\n"
 	// descValues = append(descValues, resp.Configuration.GetVisibilityArchivalStatus().String())
@@ -358,3 +396,11 @@ func clustersToString(clusters []*shared.ClusterReplicationConfiguration) string
 	}
 	return res
 }
+
+func initialFailoverVersionsToString(clusters []*shared.ClusterReplicationConfiguration) string {
+	var res []string
+	for _, cluster := range clusters {
+		res = append(res, fmt.Sprintf("%v:%v", cluster.GetClusterName(), cluster.GetInitialFailoverVersion()))
+	}
+	return strings.Join(res, ", ")
+}
@@ -26,9 +26,11 @@ import (
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/compression"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/middleware"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/transport/tchannel"
 	"go.uber.org/zap"
@@ -97,13 +99,21 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 		b.logger.Fatal("Failed to create transport channel", zap.Error(err))
 	}
 
+	outboundMiddleware := []middleware.UnaryOutbound{&versionMiddleware{}}
+	if codec := c.GlobalString(FlagCompression); codec != "" {
+		if !compression.IsSupportedCodec(codec) {
+			b.logger.Fatal("Unsupported compression codec", zap.String("codec", codec))
+		}
+		outboundMiddleware = append(outboundMiddleware, compression.NewOutboundMiddleware(codec))
+	}
+
 	b.dispatcher = yarpc.NewDispatcher(yarpc.Config{
 		Name: cadenceClientName,
 		Outbounds: yarpc.Outbounds{
 			cadenceFrontendService: {Unary: ch.NewSingleOutbound(b.hostPort)},
 		},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
-			Unary: &versionMiddleware{},
+			Unary: yarpc.UnaryOutboundMiddleware(outboundMiddleware...),
 		},
 	})
 
@@ -28,6 +28,8 @@ const (
 	FlagUsername                          = "username"
 	FlagPassword                          = "password"
 	FlagKeyspace                          = "keyspace"
+	FlagDBEngine                          = "db_engine"
+	FlagDatabaseName                      = "database_name"
 	FlagAddress                           = "address"
 	FlagAddressWithAlias                  = FlagAddress + ", ad"
 	FlagHistoryAddress                    = "history_address"
@@ -43,6 +45,10 @@ const (
 	FlagTreeID                            = "tree_id"
 	FlagBranchID                          = "branch_id"
 	FlagNumberOfShards                    = "number_of_shards"
+	FlagNewNumberOfShards                 = "new_number_of_shards"
+	FlagTargetKeyspace                    = "target_keyspace"
+	FlagRangeSizeBits                     = "range_size_bits"
+	FlagOnline                            = "online"
 	FlagRunIDWithAlias                    = FlagRunID + ", rid, r"
 	FlagTargetCluster                     = "target_cluster"
 	FlagMinEventID                        = "min_event_id"
@@ -64,6 +70,7 @@ const (
 	FlagDecisionTimeoutWithAlias          = FlagDecisionTimeout + ", dt"
 	FlagContextTimeout                    = "context_timeout"
 	FlagContextTimeoutWithAlias           = FlagContextTimeout + ", ct"
+	FlagCompression                       = "compression"
 	FlagInput                             = "input"
 	FlagInputWithAlias                    = FlagInput + ", i"
 	FlagInputFile                         = "input_file"
@@ -140,6 +147,9 @@ const (
 	FlagShowDetailWithAlias               = FlagShowDetail + ", sd"
 	FlagActiveClusterName                 = "active_cluster"
 	FlagActiveClusterNameWithAlias        = FlagActiveClusterName + ", ac"
+	FlagGracefulFailover                  = "graceful_failover"
+	FlagGracefulFailoverWithAlias         = FlagGracefulFailover + ", gf"
+	FlagPromoteGlobalDomain               = "promote_global_domain"
 	FlagClusters                          = "clusters"
 	FlagClustersWithAlias                 = FlagClusters + ", cl"
 	FlagIsGlobalDomain                    = "global_domain"
@@ -160,6 +170,13 @@ const (
 	FlagHeadersModeWithAlias              = FlagHeadersMode + ", he"
 	FlagMessageType                       = "message_type"
 	FlagMessageTypeWithAlias              = FlagMessageType + ", mt"
+	FlagAnnotationMessage                 = "message"
+	FlagAnnotationMessageWithAlias        = FlagAnnotationMessage + ", msg"
+	FlagForce                             = "force"
+	FlagForceWithAlias                    = FlagForce + ", f"
+	FlagUnprotect                         = "unprotect"
+	FlagQueueType                         = "queue_type"
+	FlagAckLevel                          = "ack_level"
 	FlagURL                               = "url"
 	FlagMuttleyDestination                = "muttely_destination"
 	FlagMuttleyDestinationWithAlias       = FlagMuttleyDestination + ", muttley"
@@ -172,8 +189,11 @@ const (
 	FlagSearchAttributesKey               = "search_attr_key"
 	FlagSearchAttributesVal               = "search_attr_value"
 	FlagSearchAttributesType              = "search_attr_type"
+	FlagKeyPrefix                         = "key_prefix"
+	FlagKeyPrefixWithAlias                = FlagKeyPrefix + ", kp"
 	FlagAddBadBinary                      = "add_bad_binary"
 	FlagRemoveBadBinary                   = "remove_bad_binary"
+	FlagKnownTaskLists                    = "known_task_lists"
 	FlagResetType                         = "reset_type"
 	FlagResetPointsOnly                   = "reset_points_only"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
@@ -189,6 +209,9 @@ const (
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
 	FlagYes                               = "yes"
+	FlagWorkflows                         = "workflows"
+	FlagConcurrency                       = "concurrency"
+	FlagActivities                        = "activities"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -281,7 +304,7 @@ func getFlagsForStart() []cli.Flag {
 		cli.IntFlag{
 			Name: FlagWorkflowIDReusePolicyAlias,
 			Usage: "Optional input to configure if the same workflow ID is allow to use for new workflow execution. " +
-				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate",
+				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate, 3: TerminateIfRunning",
 		},
 		cli.StringFlag{
 			Name:  FlagInputWithAlias,
@@ -23,6 +23,7 @@ package cli
 import (
 	"strings"
 
+	"github.com/uber/cadence/common/batch"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/urfave/cli"
 )
@@ -124,6 +125,14 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagReasonWithAlias,
 					Usage: "The reason you want to terminate the workflow",
 				},
+				cli.BoolFlag{
+					Name:  FlagForceWithAlias,
+					Usage: "Terminate the workflow even if it is termination protected",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Security token with permission to force the termination",
+				},
 			},
 			Action: func(c *cli.Context) {
 				TerminateWorkflow(c)
@@ -441,7 +450,7 @@ func newBatchCommands() []cli.Command {
 				},
 				cli.StringFlag{
 					Name:  FlagBatchTypeWithAlias,
-					Usage: "Types supported: " + strings.Join(batcher.AllBatchTypes, ","),
+					Usage: "Types supported: " + strings.Join(batch.AllBatchTypes, ","),
 				},
 				//below are optional
 				cli.StringFlag{
@@ -28,7 +28,7 @@ import (
 	"strings"
 
 	"github.com/uber/cadence/common"
-	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/common/batch"
 	"github.com/urfave/cli"
 	"go.uber.org/cadence/.gen/go/shared"
 	cclient "go.uber.org/cadence/client"
@@ -76,7 +76,7 @@ func DescribeBatchJob(c *cli.Context) {
 		output["msg"] = "batch job is running"
 		if len(wf.PendingActivities) > 0 {
 			hbdBinary := wf.PendingActivities[0].HeartbeatDetails
-			hbd := batcher.HeartBeatDetails{}
+			hbd := batch.HeartBeatDetails{}
 			err := json.Unmarshal(hbdBinary, &hbd)
 			if err != nil {
 				ErrorAndExit("Failed to describe batch job", err)
@@ -131,11 +131,11 @@ func StartBatchJob(c *cli.Context) {
 	reason := getRequiredOption(c, FlagReason)
 	batchType := getRequiredOption(c, FlagBatchType)
 	if !validateBatchType(batchType) {
-		ErrorAndExit("batchType is not valid, supported:"+strings.Join(batcher.AllBatchTypes, ","), nil)
+		ErrorAndExit("batchType is not valid, supported:"+strings.Join(batch.AllBatchTypes, ","), nil)
 	}
 	operator := getCurrentUserFromEnv()
 	var sigName, sigVal string
-	if batchType == batcher.BatchTypeSignal {
+	if batchType == batch.BatchTypeSignal {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
@@ -173,8 +173,8 @@ func StartBatchJob(c *cli.Context) {
 	tcCtx, cancel = newContext(c)
 	defer cancel()
 	options := cclient.StartWorkflowOptions{
-		TaskList:                     batcher.BatcherTaskListName,
-		ExecutionStartToCloseTimeout: batcher.InfiniteDuration,
+		TaskList:                     batch.BatcherTaskListName,
+		ExecutionStartToCloseTimeout: batch.InfiniteDuration,
 		Memo: map[string]interface{}{
 			"Reason": reason,
 		},
@@ -183,18 +183,18 @@ func StartBatchJob(c *cli.Context) {
 			"Operator":     operator,
 		},
 	}
-	params := batcher.BatchParams{
+	params := batch.BatchParams{
 		DomainName: domain,
 		Query:      query,
 		Reason:     reason,
 		BatchType:  batchType,
-		SignalParams: batcher.SignalParams{
+		SignalParams: batch.SignalParams{
 			SignalName: sigName,
-			Input:      sigVal,
+			Input:      []byte(sigVal),
 		},
 		RPS: rps,
 	}
-	wf, err := client.StartWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
+	wf, err := client.StartWorkflow(tcCtx, options, batch.BatchWFTypeName, params)
 	if err != nil {
 		ErrorAndExit("Failed to start batch job", err)
 	}
@@ -206,7 +206,7 @@ func StartBatchJob(c *cli.Context) {
 }
 
 func validateBatchType(bt string) bool {
-	for _, b := range batcher.AllBatchTypes {
+	for _, b := range batch.AllBatchTypes {
 		if b == bt {
 			return true
 		}
@@ -92,6 +92,10 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 	defer cancel()
 	history, err := GetHistory(ctx, wfClient, wid, rid)
 	if err != nil {
+		if _, ok := err.(*s.EntityNotExistsError); ok && rid == "" {
+			// archived history can only be read when run id is specified
+			ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s. If the workflow has been archived, specify --%s to read its archived history.", wid, FlagRunID), err)
+		}
 		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
 	}
 
@@ -414,7 +418,25 @@ func TerminateWorkflow(c *cli.Context) {
 
 	ctx, cancel := newContext(c)
 	defer cancel()
-	err := wfClient.TerminateWorkflow(ctx, wid, rid, reason, nil)
+
+	var err error
+	if c.Bool(FlagForce) {
+		// the client does not expose forced termination, go through the frontend directly
+		frontendClient := cFactory.ServerFrontendClient(c)
+		err = frontendClient.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{
+			Domain: common.StringPtr(getRequiredGlobalOption(c, FlagDomain)),
+			WorkflowExecution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(wid),
+				RunId:      common.StringPtr(rid),
+			},
+			Reason:        common.StringPtr(reason),
+			Identity:      common.StringPtr(getCliIdentity()),
+			Force:         common.BoolPtr(true),
+			SecurityToken: common.StringPtr(c.String(FlagSecurityToken)),
+		})
+	} else {
+		err = wfClient.TerminateWorkflow(ctx, wid, rid, reason, nil)
+	}
 
 	if err != nil {
 		ErrorAndExit("Terminate workflow failed.", err)
@@ -793,6 +815,7 @@ type pendingActivityInfo struct {
 	LastFailureReason      *string `json:",omitempty"`
 	LastWorkerIdentity     *string `json:",omitempty"`
 	LastFailureDetails     *string `json:",omitempty"` // change from []byte
+	NextRetryTimestamp     *string `json:",omitempty"` // change from *int64
 }
 
 func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecutionResponse,
@@ -827,6 +850,7 @@ func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecu
 			ExpirationTimestamp:    timestampPtrToStringPtr(pa.ExpirationTimestamp, false),
 			LastFailureReason:      pa.LastFailureReason,
 			LastWorkerIdentity:     pa.LastWorkerIdentity,
+			NextRetryTimestamp:     timestampPtrToStringPtr(pa.NextRetryTimestamp, false),
 		}
 		if pa.HeartbeatDetails != nil {
 			tmpAct.HeartbeatDetails = common.StringPtr(string(pa.HeartbeatDetails))